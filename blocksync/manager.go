@@ -11,7 +11,6 @@ import (
 	"sync/atomic"
 	"time"
 
-	"github.com/BOXFoundation/boxd/consensus/dpos"
 	"github.com/BOXFoundation/boxd/core/chain"
 	coreTypes "github.com/BOXFoundation/boxd/core/types"
 	"github.com/BOXFoundation/boxd/crypto"
@@ -112,7 +111,7 @@ type SyncManager struct {
 
 	proc      goprocess.Process
 	chain     *chain.BlockChain
-	consensus *dpos.Dpos
+	consensus coreTypes.Consensus
 	p2pNet    p2p.Net
 
 	messageCh         chan p2p.Message
@@ -144,7 +143,7 @@ func (sm *SyncManager) resetAll() {
 
 // NewSyncManager returns new block sync manager.
 func NewSyncManager(blockChain *chain.BlockChain, p2pNet p2p.Net,
-	consensus *dpos.Dpos, parent goprocess.Process) *SyncManager {
+	consensus coreTypes.Consensus, parent goprocess.Process) *SyncManager {
 	return &SyncManager{
 		status:       freeStatus,
 		chain:        blockChain,
@@ -528,7 +527,7 @@ func (sm *SyncManager) pickOnePeer(syncStatus syncStatus) (peer.ID, error) {
 			(v.(peerStatus) == locateDonePeerStatus ||
 				v.(peerStatus) == checkedDonePeerStatus) {
 			synced, existed := sm.p2pNet.PeerSynced(k.(peer.ID))
-			if existed && synced {
+			if existed && synced && sm.servesBlocks(k.(peer.ID)) {
 				preferedID = k.(peer.ID)
 				return false
 			}
@@ -547,7 +546,7 @@ func (sm *SyncManager) pickOnePeer(syncStatus syncStatus) (peer.ID, error) {
 			break
 		}
 		synced, _ := sm.p2pNet.PeerSynced(pid)
-		if synced {
+		if synced && sm.servesBlocks(pid) {
 			return pid, nil
 		}
 		syncIds = append(syncIds, pid)
@@ -568,13 +567,19 @@ func (sm *SyncManager) pickOnePeer(syncStatus syncStatus) (peer.ID, error) {
 			return pid, errNoPeerToSync
 		}
 		synced, _ := sm.p2pNet.PeerSynced(pid)
-		if synced {
+		if synced && sm.servesBlocks(pid) {
 			return pid, nil
 		}
 		ids = append(ids, pid)
 	}
 }
 
+// servesBlocks checks if peer pid announced serving full blocks to sync
+func (sm *SyncManager) servesBlocks(pid peer.ID) bool {
+	version, ok := sm.p2pNet.PeerVersion(pid)
+	return ok && version.HasServices(p2p.ServiceFullBlocks)
+}
+
 func (sm *SyncManager) setTimeoutPeersErrStatus(status peerStatus) {
 	sm.stalePeers.Range(func(k, v interface{}) bool {
 		if v != nil && v.(peerStatus) == status {
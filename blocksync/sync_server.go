@@ -78,7 +78,7 @@ func (sm *SyncManager) handleSyncMessage() {
 }
 
 func (sm *SyncManager) onLocateRequest(msg p2p.Message) error {
-	sm.chain.Bus().Publish(eventbus.TopicConnEvent, msg.From(), eventbus.SyncMsgEvent)
+	p2p.PublishConnEvent(sm.chain.Bus(), msg.From(), eventbus.SyncMsgEvent)
 
 	// not to been sync when the node is in sync status
 	if sm.getStatus() != freeStatus {
@@ -152,7 +152,7 @@ func (sm *SyncManager) onLocateResponse(msg p2p.Message) error {
 }
 
 func (sm *SyncManager) onCheckRequest(msg p2p.Message) error {
-	sm.chain.Bus().Publish(eventbus.TopicConnEvent, msg.From(), eventbus.SyncMsgEvent)
+	p2p.PublishConnEvent(sm.chain.Bus(), msg.From(), eventbus.SyncMsgEvent)
 	// not to been sync when the node is in sync status
 	if sm.getStatus() != freeStatus {
 		logger.Infof("now be in sync, send message[0x%X] zeroHash to peer %s",
@@ -229,7 +229,7 @@ func (sm *SyncManager) onCheckResponse(msg p2p.Message) error {
 }
 
 func (sm *SyncManager) onBlocksRequest(msg p2p.Message) (err error) {
-	sm.chain.Bus().Publish(eventbus.TopicConnEvent, msg.From(), eventbus.SyncMsgEvent)
+	p2p.PublishConnEvent(sm.chain.Bus(), msg.From(), eventbus.SyncMsgEvent)
 	//
 	sb := newSyncBlocks(math.MaxUint32)
 	defer func() {
@@ -9,6 +9,8 @@ package eventbus
 import (
 	"fmt"
 	"reflect"
+	"runtime/debug"
+	"strings"
 	"sync"
 
 	"github.com/BOXFoundation/boxd/log"
@@ -16,39 +18,40 @@ import (
 
 var logger = log.NewLogger("boxd") // logger for node package
 
-//BusSubscriber defines subscription-related bus behavior
+// BusSubscriber defines subscription-related bus behavior
 type BusSubscriber interface {
 	Subscribe(topic string, fn interface{}) error
 	SubscribeAsync(topic string, fn interface{}, transactional bool) error
 	SubscribeOnce(topic string, fn interface{}) error
 	SubscribeOnceAsync(topic string, fn interface{}) error
+	SubscribeQueued(topic string, fn interface{}, queue *Queue) error
 	Unsubscribe(topic string, handler interface{}) error
 }
 
-//BusPublisher defines publishing-related bus behavior
+// BusPublisher defines publishing-related bus behavior
 type BusPublisher interface {
 	Publish(topic string, args ...interface{})
 }
 
-//MsgReplier defines worker behavior for message sent by sender
+// MsgReplier defines worker behavior for message sent by sender
 type MsgReplier interface {
 	Reply(topic string, fn interface{}, transactional bool) error
 	StopReply(topic string, fn interface{}) error
 }
 
-//MsgSender sends message to replier who should reply the message
+// MsgSender sends message to replier who should reply the message
 type MsgSender interface {
 	Send(topic string, args ...interface{})
 }
 
-//BusController defines bus control behavior (checking handler's presence, synchronization)
+// BusController defines bus control behavior (checking handler's presence, synchronization)
 type BusController interface {
 	HasSubscriber(topic string) bool
 	HasReplier(topic string) bool
 	WaitAsync()
 }
 
-//Bus englobes global (subscribe, publish, control) bus behavior
+// Bus englobes global (subscribe, publish, control) bus behavior
 type Bus interface {
 	BusController
 	BusSubscriber
@@ -60,7 +63,9 @@ type Bus interface {
 // EventBus - box for handlers and callbacks.
 type EventBus struct {
 	pubHandlers map[string][]*eventHandler
-	subLock     sync.Mutex // a lock for the map
+	// handlers of topic patterns, by the prefix of topics they match
+	wildcardHandlers map[string][]*eventHandler
+	subLock          sync.Mutex // a lock for the maps
 
 	sendHandlers map[string]*eventHandler
 	replyLock    sync.Mutex // a lock for the map
@@ -73,7 +78,9 @@ type eventHandler struct {
 	flagOnce      bool
 	async         bool
 	transactional bool
-	sync.Mutex    // lock for an event handler - useful for running async callbacks serially
+	sync.Mutex           // lock for an event handler - useful for running async callbacks serially
+	queue         *Queue // queue of events to the handler if subscribed with one
+	wildcard      bool   // true if subscribed to a topic pattern, called with the topic first
 }
 
 var defaultBus Bus
@@ -90,11 +97,12 @@ func Default() Bus {
 // New returns new EventBus with empty handlers.
 func New() Bus {
 	return &EventBus{
-		pubHandlers:  make(map[string][]*eventHandler),
-		subLock:      sync.Mutex{},
-		sendHandlers: make(map[string]*eventHandler),
-		replyLock:    sync.Mutex{},
-		wg:           sync.WaitGroup{},
+		pubHandlers:      make(map[string][]*eventHandler),
+		wildcardHandlers: make(map[string][]*eventHandler),
+		subLock:          sync.Mutex{},
+		sendHandlers:     make(map[string]*eventHandler),
+		replyLock:        sync.Mutex{},
+		wg:               sync.WaitGroup{},
 	}
 }
 
@@ -105,15 +113,34 @@ func (bus *EventBus) doSubscribe(topic string, fn interface{}, handler *eventHan
 	if !(reflect.TypeOf(fn).Kind() == reflect.Func) {
 		return fmt.Errorf("%s is not of type reflect.Func", reflect.TypeOf(fn).Kind())
 	}
-	bus.pubHandlers[topic] = append(bus.pubHandlers[topic], handler)
+	if _, ok := topicPrefix(topic); ok {
+		if err := checkWildcardHandler(topic, reflect.TypeOf(fn)); err != nil {
+			return err
+		}
+		handler.wildcard = true
+	} else if err := checkHandler(topic, reflect.TypeOf(fn)); err != nil {
+		return err
+	}
+	handlers, key := bus.handlersOf(topic)
+	handlers[key] = append(handlers[key], handler)
 	return nil
 }
 
-// Subscribe subscribes to a topic.
+// handlersOf returns the map of handlers of topic and its key in the map,
+// which is the prefix of topics matched if topic is a pattern
+func (bus *EventBus) handlersOf(topic string) (map[string][]*eventHandler, string) {
+	if prefix, ok := topicPrefix(topic); ok {
+		return bus.wildcardHandlers, prefix
+	}
+	return bus.pubHandlers, topic
+}
+
+// Subscribe subscribes to a topic, or all topics matched by a pattern ending with
+// Wildcard, e.g. "p2p:*", whose handler must be a WildcardHandler.
 // Returns error if `fn` is not a function.
 func (bus *EventBus) Subscribe(topic string, fn interface{}) error {
 	return bus.doSubscribe(topic, fn, &eventHandler{
-		reflect.ValueOf(fn), false, false, false, sync.Mutex{},
+		reflect.ValueOf(fn), false, false, false, sync.Mutex{}, nil, false,
 	})
 }
 
@@ -123,7 +150,7 @@ func (bus *EventBus) Subscribe(topic string, fn interface{}) error {
 // Returns error if `fn` is not a function.
 func (bus *EventBus) SubscribeAsync(topic string, fn interface{}, transactional bool) error {
 	return bus.doSubscribe(topic, fn, &eventHandler{
-		reflect.ValueOf(fn), false, true, transactional, sync.Mutex{},
+		reflect.ValueOf(fn), false, true, transactional, sync.Mutex{}, nil, false,
 	})
 }
 
@@ -131,7 +158,7 @@ func (bus *EventBus) SubscribeAsync(topic string, fn interface{}, transactional
 // Returns error if `fn` is not a function.
 func (bus *EventBus) SubscribeOnce(topic string, fn interface{}) error {
 	return bus.doSubscribe(topic, fn, &eventHandler{
-		reflect.ValueOf(fn), true, false, false, sync.Mutex{},
+		reflect.ValueOf(fn), true, false, false, sync.Mutex{}, nil, false,
 	})
 }
 
@@ -140,17 +167,40 @@ func (bus *EventBus) SubscribeOnce(topic string, fn interface{}) error {
 // Returns error if `fn` is not a function.
 func (bus *EventBus) SubscribeOnceAsync(topic string, fn interface{}) error {
 	return bus.doSubscribe(topic, fn, &eventHandler{
-		reflect.ValueOf(fn), true, true, false, sync.Mutex{},
+		reflect.ValueOf(fn), true, true, false, sync.Mutex{}, nil, false,
+	})
+}
+
+// SubscribeQueued subscribes to a topic with a callback called in the
+// goroutine of queue, which is bounded and blocks or drops events published
+// when full by its policy, so that a slow callback can't stall publishers
+// more than the policy allows.
+// Returns error if `fn` is not a function.
+func (bus *EventBus) SubscribeQueued(topic string, fn interface{}, queue *Queue) error {
+	if queue == nil {
+		return fmt.Errorf("queue of topic %s is nil", topic)
+	}
+	return bus.doSubscribe(topic, fn, &eventHandler{
+		reflect.ValueOf(fn), false, true, false, sync.Mutex{}, queue, false,
 	})
 }
 
-// HasSubscriber returns true if exists any callback subscribed to the topic.
+// HasSubscriber returns true if exists any callback subscribed to the topic,
+// or to a pattern matching it.
 func (bus *EventBus) HasSubscriber(topic string) bool {
 	bus.subLock.Lock()
 	defer bus.subLock.Unlock()
-	_, ok := bus.pubHandlers[topic]
-	if ok {
-		return len(bus.pubHandlers[topic]) > 0
+	handlers, key := bus.handlersOf(topic)
+	if len(handlers[key]) > 0 {
+		return true
+	}
+	if _, ok := topicPrefix(topic); ok {
+		return false
+	}
+	for prefix, handlers := range bus.wildcardHandlers {
+		if len(handlers) > 0 && strings.HasPrefix(topic, prefix) {
+			return true
+		}
 	}
 	return false
 }
@@ -168,7 +218,7 @@ func (bus *EventBus) HasReplier(topic string) bool {
 func (bus *EventBus) Unsubscribe(topic string, handler interface{}) error {
 	bus.subLock.Lock()
 	defer bus.subLock.Unlock()
-	if _, ok := bus.pubHandlers[topic]; ok && len(bus.pubHandlers[topic]) > 0 {
+	if handlers, key := bus.handlersOf(topic); len(handlers[key]) > 0 {
 		bus.removeHandler(topic, reflect.ValueOf(handler))
 		return nil
 	}
@@ -176,60 +226,107 @@ func (bus *EventBus) Unsubscribe(topic string, handler interface{}) error {
 }
 
 // Publish executes callback defined for a topic. Any additional argument will be transferred to the callback.
+// Callbacks of patterns matching the topic are called with the topic followed by the arguments.
+// Payloads not of the types registered for the topic are dropped. Callbacks are called without the bus
+// locked, so they may publish or subscribe themselves.
 func (bus *EventBus) Publish(topic string, args ...interface{}) {
-	bus.subLock.Lock() // will unlock if handler is not found or always after setUpPublish
-	defer bus.subLock.Unlock()
-	if handlers, ok := bus.pubHandlers[topic]; ok && 0 < len(handlers) {
-		// Handlers slice may be changed by removeHandler and Unsubscribe during iteration,
-		// so make a copy and iterate the copied slice.
-		copyHandlers := make([]*eventHandler, 0, len(handlers))
-		copyHandlers = append(copyHandlers, handlers...)
-		for _, handler := range copyHandlers {
+	if _, ok := topicPrefix(topic); ok {
+		logger.Errorf("Failed to publish: topic %s is a pattern", topic)
+		return
+	}
+	if err := checkPayload(topic, args); err != nil {
+		logger.Errorf("Failed to publish: %v", err)
+		return
+	}
+	bus.subLock.Lock()
+	handlers := bus.pubHandlers[topic]
+	// Handlers slice may be changed by removeHandler and Unsubscribe during iteration,
+	// so make a copy and iterate the copied slice.
+	copyHandlers := make([]*eventHandler, 0, len(handlers))
+	copyHandlers = append(copyHandlers, handlers...)
+	for _, handler := range copyHandlers {
+		if handler.flagOnce {
+			bus.removeHandler(topic, handler.callBack)
+		}
+	}
+	var wildcardHandlers []*eventHandler
+	for prefix, handlers := range bus.wildcardHandlers {
+		if !strings.HasPrefix(topic, prefix) {
+			continue
+		}
+		for _, handler := range handlers {
 			if handler.flagOnce {
-				bus.removeHandler(topic, handler.callBack)
-			}
-			if !handler.async {
-				bus.doPublish(handler, args...)
-			} else {
-				bus.wg.Add(1)
-				go bus.doPublishAsync(handler, args...)
+				bus.removeHandler(prefix+Wildcard, handler.callBack)
 			}
 		}
+		wildcardHandlers = append(wildcardHandlers, handlers...)
+	}
+	bus.subLock.Unlock()
+
+	for _, handler := range copyHandlers {
+		bus.dispatch(topic, handler, args)
+	}
+	for _, handler := range wildcardHandlers {
+		bus.dispatch(topic, handler, args)
 	}
 }
 
-func (bus *EventBus) doPublish(handler *eventHandler, args ...interface{}) {
-	passedArguments := bus.setUpPublish(args...)
+// dispatch calls handler with args published on topic, in the way it's subscribed
+func (bus *EventBus) dispatch(topic string, handler *eventHandler, args []interface{}) {
+	switch {
+	case handler.queue != nil:
+		handler.queue.push(&queuedEvent{bus: bus, topic: topic, handler: handler, args: args})
+	case !handler.async:
+		bus.doPublish(topic, handler, args...)
+	default:
+		bus.wg.Add(1)
+		go bus.doPublishAsync(topic, handler, args...)
+	}
+}
+
+// doPublish calls handler with args, which are dropped if it can't take them.
+// Panics of handler are recovered, so that they never take down publishers or
+// other handlers. Args failed to handle are published on TopicDeadLetter
+func (bus *EventBus) doPublish(topic string, handler *eventHandler, args ...interface{}) {
+	callArguments := args
+	if handler.wildcard {
+		callArguments = append([]interface{}{topic}, args...)
+	}
+	passedArguments, err := callArgs(handler.callBack.Type(), callArguments)
+	if err != nil {
+		logger.Errorf("Failed to call handler of topic %s: %v", topic, err)
+		bus.deadLetter(topic, args, err)
+		return
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			logger.Errorf("Handler of topic %s panicked: %v\n%s", topic, r, debug.Stack())
+			bus.deadLetter(topic, args, recovered(r))
+		}
+	}()
 	handler.callBack.Call(passedArguments)
 }
 
-func (bus *EventBus) doPublishAsync(handler *eventHandler, args ...interface{}) {
+func (bus *EventBus) doPublishAsync(topic string, handler *eventHandler, args ...interface{}) {
 	defer bus.wg.Done()
 	if handler.transactional {
 		handler.Lock()
 		defer handler.Unlock()
 	}
-	bus.doPublish(handler, args...)
+	bus.doPublish(topic, handler, args...)
 }
 
 func (bus *EventBus) removeHandler(topic string, callback reflect.Value) {
-	if handlers, ok := bus.pubHandlers[topic]; ok {
+	all, key := bus.handlersOf(topic)
+	if handlers, ok := all[key]; ok {
 		var copy = make([]*eventHandler, 0)
 		for _, h := range handlers {
 			if h.callBack != callback {
 				copy = append(copy, h)
 			}
 		}
-		bus.pubHandlers[topic] = copy
-	}
-}
-
-func (bus *EventBus) setUpPublish(args ...interface{}) []reflect.Value {
-	passedArguments := make([]reflect.Value, 0)
-	for _, arg := range args {
-		passedArguments = append(passedArguments, reflect.ValueOf(arg))
+		all[key] = copy
 	}
-	return passedArguments
 }
 
 // Reply receives send-reply message on a topic.
@@ -266,7 +363,7 @@ func (bus *EventBus) Reply(topic string, fn interface{}, transactional bool) err
 	}
 
 	bus.sendHandlers[topic] = &eventHandler{
-		v, false, false, transactional, sync.Mutex{},
+		v, false, false, transactional, sync.Mutex{}, nil, false,
 	}
 	return nil
 }
@@ -294,7 +391,7 @@ func (bus *EventBus) Send(topic string, args ...interface{}) {
 
 	if handler, ok := bus.sendHandlers[topic]; ok {
 		bus.wg.Add(1)
-		go bus.doPublishAsync(handler, args...)
+		go bus.doPublishAsync(topic, handler, args...)
 	}
 }
 
@@ -0,0 +1,43 @@
+// Copyright (c) 2018 ContentBox Authors.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package eventbus
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// DeadLetter is an event a handler failed to handle, published on
+// TopicDeadLetter
+type DeadLetter struct {
+	// Topic the event is published on
+	Topic string
+	// Args of the event, the original payload
+	Args []interface{}
+	// Err tells why the handler failed, the value recovered if it panicked
+	Err error
+}
+
+func init() {
+	RegisterTopic(TopicDeadLetter, reflect.TypeOf((*DeadLetter)(nil)))
+}
+
+// deadLetter publishes args of topic a handler failed to handle with err on
+// TopicDeadLetter. Those failed on TopicDeadLetter are only logged, so that
+// failing handlers of it never loop
+func (bus *EventBus) deadLetter(topic string, args []interface{}, err error) {
+	if topic == TopicDeadLetter {
+		return
+	}
+	bus.Publish(TopicDeadLetter, &DeadLetter{Topic: topic, Args: args, Err: err})
+}
+
+// recovered converts the value recovered from a panic to an error
+func recovered(r interface{}) error {
+	if err, ok := r.(error); ok {
+		return err
+	}
+	return fmt.Errorf("%v", r)
+}
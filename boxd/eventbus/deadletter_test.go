@@ -0,0 +1,49 @@
+// Copyright (c) 2018 ContentBox Authors.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package eventbus
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/facebookgo/ensure"
+)
+
+func TestPanickingHandler(t *testing.T) {
+	bus := New()
+	var letters []*DeadLetter
+	ensure.Nil(t, bus.Subscribe(TopicDeadLetter, func(letter *DeadLetter) {
+		letters = append(letters, letter)
+		panic("dead letters failed to handle are not published again")
+	}))
+
+	var calls int
+	bus.Subscribe("topic", func(a int) { panic(errors.New("boom")) })
+	bus.Subscribe("topic", func(a int) { calls++ })
+	bus.Subscribe("test:*", func(topic string, args ...interface{}) { panic(topic) })
+
+	bus.Publish("topic", 1)
+	ensure.DeepEqual(t, calls, 1)
+	ensure.DeepEqual(t, letters, []*DeadLetter{
+		{Topic: "topic", Args: []interface{}{1}, Err: errors.New("boom")},
+	})
+
+	// the original payload is dead lettered for pattern handlers too
+	letters = nil
+	bus.Publish("test:a", 2)
+	ensure.DeepEqual(t, letters, []*DeadLetter{
+		{Topic: "test:a", Args: []interface{}{2}, Err: errors.New("test:a")},
+	})
+
+	// async handlers, and those not taking the payload
+	letters = nil
+	bus.SubscribeAsync("async", func() { panic("boom") }, false)
+	bus.Publish("async")
+	bus.WaitAsync()
+	bus.Publish("topic", "a")
+	ensure.DeepEqual(t, len(letters), 3)
+	ensure.DeepEqual(t, letters[0].Topic, "async")
+	ensure.DeepEqual(t, letters[1].Args, []interface{}{"a"})
+}
@@ -49,4 +49,34 @@
 //   var c = make(chan int)
 //   bus.Send("task:add", 11, 11, c)
 //   fmt.Print(<-c) // 22, replier is triggerred async
+//
+// Queued subscriber, called in the goroutine of a bounded queue, which may
+// be shared by handlers to see events in order. Publishers block when it's
+// full, or events are dropped with QueueDrop:
+//
+//   var queue = NewQueue(1024, QueueDrop)
+//   bus.SubscribeQueued("topic", handler, queue)
+//   defer queue.Close()
+//
+// Wildcard subscriber, called with the topic and args published on all topics
+// prefixed with "p2p:", or on all topics with "*":
+//
+//   bus.Subscribe("p2p:*", func(topic string, args ...interface{}) {})
+//
+// Panics of handlers are recovered. Events handlers fail to handle, panicking
+// or not taking their payloads, are published on TopicDeadLetter as
+// *DeadLetter, while other handlers keep handling them.
+//
+// Typed topics:
+//
+// Payload types of a topic can be registered with RegisterTopic. Handlers
+// not taking them fail to subscribe, and payloads not of them are dropped
+// with an error logged when published. Handlers are never called with args
+// they can't take, registered or not. Typed helpers of topics, e.g.
+//
+//   chain.SubscribeChainUpdate(bus, func(msg *chain.UpdateMsg) {})
+//   chain.PublishChainUpdate(bus, msg)
+//
+// are generated by gentopics into the packages of their payload types, which
+// also registers them. Run go generate ./... after changing topics.
 package eventbus
@@ -0,0 +1,177 @@
+// Copyright (c) 2018 ContentBox Authors.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+// Command gentopics generates typed helpers to subscribe to and publish on
+// eventbus topics, and registers payload types of the topics with eventbus.
+// It's run by go generate in the packages defining the payload types:
+//
+//	//go:generate go run ../../boxd/eventbus/gentopics -imports github.com/BOXFoundation/boxd/core/types ChainUpdate=msg:*UpdateMsg
+//
+// Each topic is given as <Name>=<param>:<type>[,<param>:<type>...] for the
+// topic eventbus.Topic<Name> published with the params. Helpers generated
+// are Subscribe<Name>, Subscribe<Name>Async, Subscribe<Name>Queued,
+// Unsubscribe<Name> and Publish<Name>, written to topics_gen.go unless -out is set.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"io/ioutil"
+	"os"
+	"strings"
+	"text/template"
+)
+
+const eventbusPath = "github.com/BOXFoundation/boxd/boxd/eventbus"
+
+type param struct {
+	Name string
+	Type string
+}
+
+type topic struct {
+	Name   string
+	Params []param
+}
+
+// Decl returns the params declared, e.g. "msg *UpdateMsg"
+func (t *topic) Decl() string {
+	var decls []string
+	for _, p := range t.Params {
+		decls = append(decls, p.Name+" "+p.Type)
+	}
+	return strings.Join(decls, ", ")
+}
+
+// Types returns types of the params, e.g. "*UpdateMsg"
+func (t *topic) Types() string {
+	var types []string
+	for _, p := range t.Params {
+		types = append(types, p.Type)
+	}
+	return strings.Join(types, ", ")
+}
+
+// Names returns names of the params, e.g. "msg"
+func (t *topic) Names() string {
+	var names []string
+	for _, p := range t.Params {
+		names = append(names, p.Name)
+	}
+	return strings.Join(names, ", ")
+}
+
+var tmpl = template.Must(template.New("topics").Parse(`// Code generated by gentopics. DO NOT EDIT.
+
+package {{.Package}}
+
+import (
+	"reflect"
+{{range .Imports}}
+	{{.}}{{end}}
+)
+
+func init() {
+{{- range .Topics}}
+	eventbus.RegisterTopic(eventbus.Topic{{.Name}}{{range .Params}},
+		reflect.TypeOf((*{{.Type}})(nil)).Elem(){{end}})
+{{- end}}
+}
+{{range .Topics}}
+// Subscribe{{.Name}} subscribes fn to eventbus.Topic{{.Name}}
+func Subscribe{{.Name}}(bus eventbus.BusSubscriber, fn func({{.Types}})) error {
+	return bus.Subscribe(eventbus.Topic{{.Name}}, fn)
+}
+
+// Subscribe{{.Name}}Async subscribes fn to eventbus.Topic{{.Name}}, called
+// asynchronously, serially if transactional
+func Subscribe{{.Name}}Async(bus eventbus.BusSubscriber, fn func({{.Types}}), transactional bool) error {
+	return bus.SubscribeAsync(eventbus.Topic{{.Name}}, fn, transactional)
+}
+
+// Subscribe{{.Name}}Queued subscribes fn to eventbus.Topic{{.Name}}, called
+// in the goroutine of queue
+func Subscribe{{.Name}}Queued(bus eventbus.BusSubscriber, fn func({{.Types}}), queue *eventbus.Queue) error {
+	return bus.SubscribeQueued(eventbus.Topic{{.Name}}, fn, queue)
+}
+
+// Unsubscribe{{.Name}} unsubscribes fn from eventbus.Topic{{.Name}}
+func Unsubscribe{{.Name}}(bus eventbus.BusSubscriber, fn func({{.Types}})) error {
+	return bus.Unsubscribe(eventbus.Topic{{.Name}}, fn)
+}
+
+// Publish{{.Name}} publishes on eventbus.Topic{{.Name}}
+func Publish{{.Name}}(bus eventbus.BusPublisher, {{.Decl}}) {
+	bus.Publish(eventbus.Topic{{.Name}}, {{.Names}})
+}
+{{end}}`))
+
+func main() {
+	var (
+		pkg     = flag.String("package", os.Getenv("GOPACKAGE"), "package of the generated file")
+		out     = flag.String("out", "topics_gen.go", "generated file")
+		imports = flag.String("imports", "", "comma separated imports of payload types, as [alias=]path")
+	)
+	flag.Parse()
+
+	topics, err := parseTopics(flag.Args())
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	var specs = []string{fmt.Sprintf("%q", eventbusPath)}
+	for _, imp := range strings.Split(*imports, ",") {
+		if imp = strings.TrimSpace(imp); imp == "" {
+			continue
+		}
+		if i := strings.Index(imp, "="); i >= 0 {
+			specs = append(specs, fmt.Sprintf("%s %q", imp[:i], imp[i+1:]))
+		} else {
+			specs = append(specs, fmt.Sprintf("%q", imp))
+		}
+	}
+
+	var buf bytes.Buffer
+	data := struct {
+		Package string
+		Imports []string
+		Topics  []*topic
+	}{*pkg, specs, topics}
+	if err := tmpl.Execute(&buf, data); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "%v\n%s", err, buf.Bytes())
+		os.Exit(1)
+	}
+	if err := ioutil.WriteFile(*out, src, 0644); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+// parseTopics parses topics given as <Name>=<param>:<type>[,<param>:<type>...]
+func parseTopics(args []string) ([]*topic, error) {
+	var topics []*topic
+	for _, arg := range args {
+		i := strings.Index(arg, "=")
+		if i <= 0 {
+			return nil, fmt.Errorf("invalid topic %s", arg)
+		}
+		t := &topic{Name: arg[:i]}
+		for _, p := range strings.Split(arg[i+1:], ",") {
+			j := strings.Index(p, ":")
+			if j <= 0 || j == len(p)-1 {
+				return nil, fmt.Errorf("invalid param %s of topic %s", p, t.Name)
+			}
+			t.Params = append(t.Params, param{Name: p[:j], Type: p[j+1:]})
+		}
+		topics = append(topics, t)
+	}
+	return topics, nil
+}
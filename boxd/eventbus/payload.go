@@ -0,0 +1,117 @@
+// Copyright (c) 2018 ContentBox Authors.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package eventbus
+
+import (
+	"fmt"
+	"reflect"
+	"sync"
+)
+
+var (
+	// payload types of topics registered
+	payloads   = make(map[string][]reflect.Type)
+	payloadsMu sync.RWMutex
+)
+
+// RegisterTopic registers types of the payload published on topic. Handlers
+// subscribed to the topic must take them, and payloads published on it must
+// be of them, or they are rejected. It's called by helpers generated by
+// gentopics
+func RegisterTopic(topic string, types ...reflect.Type) {
+	payloadsMu.Lock()
+	defer payloadsMu.Unlock()
+	payloads[topic] = types
+}
+
+// payloadTypes returns payload types of topic, nil if it's not registered
+func payloadTypes(topic string) []reflect.Type {
+	payloadsMu.RLock()
+	defer payloadsMu.RUnlock()
+	return payloads[topic]
+}
+
+// checkHandler checks if handler fn takes the payload registered for topic
+func checkHandler(topic string, fn reflect.Type) error {
+	types := payloadTypes(topic)
+	if types == nil {
+		return nil
+	}
+	if fn.NumIn() != len(types) {
+		return fmt.Errorf("handler %s of topic %s must take %v", fn, topic, types)
+	}
+	for i, t := range types {
+		if !t.AssignableTo(fn.In(i)) {
+			return fmt.Errorf("handler %s of topic %s must take %v", fn, topic, types)
+		}
+	}
+	return nil
+}
+
+// checkPayload checks if args are of the payload types registered for topic
+func checkPayload(topic string, args []interface{}) error {
+	types := payloadTypes(topic)
+	if types == nil {
+		return nil
+	}
+	if len(args) != len(types) {
+		return fmt.Errorf("payload %s of topic %s must be of %v", typesOf(args), topic, types)
+	}
+	for i, t := range types {
+		if !assignable(args[i], t) {
+			return fmt.Errorf("payload %s of topic %s must be of %v", typesOf(args), topic, types)
+		}
+	}
+	return nil
+}
+
+// callArgs converts args to arguments to call fn with, or returns an error
+// if fn doesn't take them
+func callArgs(fn reflect.Type, args []interface{}) ([]reflect.Value, error) {
+	if !fn.IsVariadic() && fn.NumIn() != len(args) ||
+		fn.IsVariadic() && len(args) < fn.NumIn()-1 {
+		return nil, fmt.Errorf("%s can't take %s", fn, typesOf(args))
+	}
+	values := make([]reflect.Value, len(args))
+	for i, arg := range args {
+		var t reflect.Type
+		if fn.IsVariadic() && i >= fn.NumIn()-1 {
+			t = fn.In(fn.NumIn() - 1).Elem()
+		} else {
+			t = fn.In(i)
+		}
+		if !assignable(arg, t) {
+			return nil, fmt.Errorf("%s can't take %s", fn, typesOf(args))
+		}
+		if arg == nil {
+			values[i] = reflect.Zero(t)
+		} else {
+			values[i] = reflect.ValueOf(arg)
+		}
+	}
+	return values, nil
+}
+
+// assignable checks if arg can be assigned to a value of type t, nil to
+// those of nillable kinds
+func assignable(arg interface{}, t reflect.Type) bool {
+	if arg != nil {
+		return reflect.TypeOf(arg).AssignableTo(t)
+	}
+	switch t.Kind() {
+	case reflect.Ptr, reflect.Interface, reflect.Map, reflect.Slice, reflect.Func, reflect.Chan:
+		return true
+	}
+	return false
+}
+
+// typesOf returns types of args, for logging
+func typesOf(args []interface{}) string {
+	types := make([]reflect.Type, len(args))
+	for i, arg := range args {
+		types[i] = reflect.TypeOf(arg)
+	}
+	return fmt.Sprint(types)
+}
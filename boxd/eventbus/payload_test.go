@@ -0,0 +1,49 @@
+// Copyright (c) 2018 ContentBox Authors.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package eventbus
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/facebookgo/ensure"
+)
+
+type payload struct{ n int }
+
+func TestRegisteredPayload(t *testing.T) {
+	bus := New()
+	RegisterTopic("test:typed", reflect.TypeOf((*payload)(nil)), reflect.TypeOf(""))
+
+	var got []*payload
+	ensure.NotNil(t, bus.Subscribe("test:typed", func(p *payload) {}))
+	ensure.NotNil(t, bus.Subscribe("test:typed", func(p payload, s string) {}))
+	ensure.Nil(t, bus.Subscribe("test:typed", func(p *payload, s string) { got = append(got, p) }))
+
+	bus.Publish("test:typed", &payload{1}, "a")
+	// mismatched payloads are dropped
+	bus.Publish("test:typed", payload{2}, "b")
+	bus.Publish("test:typed", &payload{3})
+	bus.Publish("test:typed", nil, "c")
+	ensure.DeepEqual(t, got, []*payload{{1}, nil})
+}
+
+func TestMismatchedHandler(t *testing.T) {
+	bus := New()
+	var calls int
+	bus.Subscribe("topic", func(a int) { calls++ })
+	bus.SubscribeAsync("topic", func(a int) { calls++ }, true)
+
+	// handlers of unregistered topics are not called with args they can't take
+	bus.Publish("topic", "a")
+	bus.Publish("topic")
+	bus.Publish("topic", nil)
+	bus.WaitAsync()
+	ensure.DeepEqual(t, calls, 0)
+
+	bus.Publish("topic", 1)
+	bus.WaitAsync()
+	ensure.DeepEqual(t, calls, 2)
+}
@@ -0,0 +1,133 @@
+// Copyright (c) 2018 ContentBox Authors.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package eventbus
+
+import (
+	"fmt"
+	"sync"
+	"sync/atomic"
+)
+
+// QueuePolicy tells what publishing does when a subscriber queue is full
+type QueuePolicy int
+
+const (
+	// QueueBlock blocks publishers until the queue has room
+	QueueBlock QueuePolicy = iota
+	// QueueDrop drops the event, so that slow subscribers never stall
+	// publishers
+	QueueDrop
+)
+
+// ParseQueuePolicy parses policy named "block" or "drop"
+func ParseQueuePolicy(name string) (QueuePolicy, error) {
+	switch name {
+	case "block":
+		return QueueBlock, nil
+	case "drop":
+		return QueueDrop, nil
+	}
+	return 0, fmt.Errorf("invalid queue policy %s", name)
+}
+
+// queuedEvent is an event queued to call handler of topic with args
+type queuedEvent struct {
+	bus     *EventBus
+	topic   string
+	handler *eventHandler
+	args    []interface{}
+}
+
+// Queue is a bounded queue of events published to handlers subscribed with
+// it. Handlers are called serially in a goroutine of the queue, in the order
+// events are published, so that handlers of several topics sharing a queue
+// see them in order
+type Queue struct {
+	events  chan *queuedEvent
+	policy  QueuePolicy
+	dropped uint64
+
+	done      chan struct{}
+	closeOnce sync.Once
+	// closed is set once events are no longer pushed, guarded by mtx
+	mtx    sync.RWMutex
+	closed bool
+}
+
+// NewQueue creates a queue of size events with policy when it's full, and
+// starts delivering events. It must be closed after handlers subscribed with
+// it are unsubscribed
+func NewQueue(size int, policy QueuePolicy) *Queue {
+	q := &Queue{
+		events: make(chan *queuedEvent, size),
+		policy: policy,
+		done:   make(chan struct{}),
+	}
+	go q.loop()
+	return q
+}
+
+// Close stops delivering events, those queued are dropped
+func (q *Queue) Close() {
+	q.closeOnce.Do(func() { close(q.done) })
+}
+
+// Dropped returns the number of events dropped since the queue is created
+func (q *Queue) Dropped() uint64 {
+	return atomic.LoadUint64(&q.dropped)
+}
+
+// push queues event, blocking or dropping it if the queue is full by policy
+func (q *Queue) push(event *queuedEvent) {
+	q.mtx.RLock()
+	defer q.mtx.RUnlock()
+	if q.closed {
+		return
+	}
+	event.bus.wg.Add(1)
+	if q.policy == QueueDrop {
+		select {
+		case q.events <- event:
+		case <-q.done:
+			event.bus.wg.Done()
+		default:
+			event.bus.wg.Done()
+			if atomic.AddUint64(&q.dropped, 1)%1000 == 1 {
+				logger.Warnf("Subscriber queue of topic %s is full, %d events dropped",
+					event.topic, q.Dropped())
+			}
+		}
+		return
+	}
+	select {
+	case q.events <- event:
+	case <-q.done:
+		event.bus.wg.Done()
+	}
+}
+
+// loop delivers events until the queue is closed
+func (q *Queue) loop() {
+	for {
+		select {
+		case event := <-q.events:
+			event.bus.doPublish(event.topic, event.handler, event.args...)
+			event.bus.wg.Done()
+		case <-q.done:
+			// wait for events being pushed, which give up on done
+			q.mtx.Lock()
+			q.closed = true
+			q.mtx.Unlock()
+			for {
+				select {
+				case event := <-q.events:
+					event.bus.wg.Done()
+				default:
+					return
+				}
+			}
+		}
+	}
+}
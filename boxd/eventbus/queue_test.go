@@ -0,0 +1,109 @@
+// Copyright (c) 2018 ContentBox Authors.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package eventbus
+
+import (
+	"testing"
+	"time"
+
+	"github.com/facebookgo/ensure"
+)
+
+func TestQueueOrder(t *testing.T) {
+	bus := New()
+	queue := NewQueue(16, QueueBlock)
+	defer queue.Close()
+	var got []int
+	ensure.Nil(t, bus.SubscribeQueued("topic1", func(i int) { got = append(got, i) }, queue))
+	ensure.Nil(t, bus.SubscribeQueued("topic2", func(i int) { got = append(got, -i) }, queue))
+	ensure.NotNil(t, bus.SubscribeQueued("topic3", func(i int) {}, nil))
+
+	for i := 1; i <= 100; i++ {
+		bus.Publish("topic1", i)
+		bus.Publish("topic2", i)
+	}
+	bus.WaitAsync()
+	ensure.DeepEqual(t, len(got), 200)
+	for i := 0; i < 100; i++ {
+		ensure.DeepEqual(t, got[2*i], i+1)
+		ensure.DeepEqual(t, got[2*i+1], -i-1)
+	}
+}
+
+func TestQueueDrop(t *testing.T) {
+	bus := New()
+	queue := NewQueue(2, QueueDrop)
+	defer queue.Close()
+	release := make(chan struct{})
+	var got []int
+	bus.SubscribeQueued("topic", func(i int) {
+		<-release
+		got = append(got, i)
+	}, queue)
+
+	// the handler takes one and blocks, two are queued, the rest dropped
+	done := make(chan struct{})
+	go func() {
+		for i := 0; i < 10; i++ {
+			bus.Publish("topic", i)
+			time.Sleep(time.Millisecond)
+		}
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("publisher is blocked by slow subscriber")
+	}
+	close(release)
+	bus.WaitAsync()
+	ensure.DeepEqual(t, len(got)+int(queue.Dropped()), 10)
+	ensure.True(t, queue.Dropped() >= 7)
+}
+
+func TestQueueBlock(t *testing.T) {
+	bus := New()
+	queue := NewQueue(1, QueueBlock)
+	defer queue.Close()
+	release := make(chan struct{})
+	var got []int
+	bus.SubscribeQueued("topic", func(i int) {
+		<-release
+		got = append(got, i)
+	}, queue)
+
+	done := make(chan struct{})
+	go func() {
+		for i := 0; i < 5; i++ {
+			bus.Publish("topic", i)
+		}
+		close(done)
+	}()
+	select {
+	case <-done:
+		t.Fatal("publisher is not blocked by full queue")
+	case <-time.After(50 * time.Millisecond):
+	}
+	close(release)
+	<-done
+	bus.WaitAsync()
+	ensure.DeepEqual(t, got, []int{0, 1, 2, 3, 4})
+	ensure.DeepEqual(t, queue.Dropped(), uint64(0))
+}
+
+func TestQueueClose(t *testing.T) {
+	bus := New()
+	queue := NewQueue(4, QueueBlock)
+	release := make(chan struct{})
+	bus.SubscribeQueued("topic", func(i int) { <-release }, queue)
+	for i := 0; i < 3; i++ {
+		bus.Publish("topic", i)
+	}
+	queue.Close()
+	close(release)
+	// events queued are dropped, later ones are not queued
+	bus.Publish("topic", 3)
+	bus.WaitAsync()
+}
@@ -5,12 +5,32 @@
 package eventbus
 
 const (
-	// TopicSetDebugLevel is topic for changing debug level
+	// TopicSetDebugLevel is topic for changing debug level, globally or of a
+	// module
 	TopicSetDebugLevel = "rpc:setdebuglevel"
 	// TopicUpdateNetworkID is topic for updating network id
 	TopicUpdateNetworkID = "rpc:updatenetworkid"
 	// TopicGetAddressBook is topic for listing p2p peer status
 	TopicGetAddressBook = "rpc:getaddressbook"
+	// TopicGetConnectedPeerCount is topic for counting connected p2p peers
+	TopicGetConnectedPeerCount = "rpc:getconnectedpeercount"
+	// TopicGetNetworkInfo is topic for getting state of p2p network
+	TopicGetNetworkInfo = "rpc:getnetworkinfo"
+	// TopicGetPeerInfo is topic for getting states of connected p2p peers
+	TopicGetPeerInfo = "rpc:getpeerinfo"
+	// TopicConnectPeer is topic for connecting to a p2p peer
+	TopicConnectPeer = "rpc:connectpeer"
+	// TopicDisconnectPeer is topic for disconnecting a p2p peer
+	TopicDisconnectPeer = "rpc:disconnectpeer"
+	// TopicBanPeer is topic for banning a p2p peer id or ip
+	TopicBanPeer = "rpc:banpeer"
+	// TopicUnbanPeer is topic for lifting a ban of p2p peer id or ip
+	TopicUnbanPeer = "rpc:unbanpeer"
+	// TopicListBans is topic for listing banned p2p peer ids and ips
+	TopicListBans = "rpc:listbans"
+	// TopicGetServiceStatus is topic for getting statuses of services the
+	// node runs
+	TopicGetServiceStatus = "rpc:getservicestatus"
 
 	//TopicP2PPeerAddr is a event topic for new peer addr found or peer addr updated
 	TopicP2PPeerAddr = "p2p:peeraddr"
@@ -18,16 +38,87 @@ const (
 	// TopicConnEvent is a event topic of events for score updated
 	TopicConnEvent = "p2p:connevent"
 
+	// TopicTrafficAnomaly is a event topic for peers sending too much
+	TopicTrafficAnomaly = "p2p:trafficanomaly"
+
+	// TopicPeerBanned is a event topic for a peer id, ip or subnet banned,
+	// with the p2p.Ban
+	TopicPeerBanned = "p2p:peerbanned"
+
+	// TopicPeerUnbanned is a event topic for the ban of a peer id, ip or
+	// subnet lifted before it expires
+	TopicPeerUnbanned = "p2p:peerunbanned"
+
 	////////////////////////////// chain /////////////////////////////
 
 	// TopicChainUpdate is topic for notifying that the chain is updated,
 	// either chain reorg, or chain extended.
 	TopicChainUpdate = "chain:update"
 
+	// TopicEternalBlock is topic for notifying that the eternal block advances,
+	// blocks up to it are final
+	TopicEternalBlock = "chain:eternal"
+
+	// TopicDoubleMint is topic for notifying that a miner minted different
+	// blocks at the same time, with the *types.DoubleMintEvidence
+	TopicDoubleMint = "chain:doublemint"
+
+	// TopicTxConfirmed is topic for notifying that a tx is included in a
+	// block connected to main chain, with the block
+	TopicTxConfirmed = "chain:txconfirmed"
+
+	////////////////////////////// consensus /////////////////////////////
+
+	// TopicEpochChange is topic for notifying that an epoch rolls over, with
+	// the *types.EpochChange
+	TopicEpochChange = "consensus:epoch"
+
+	// TopicMinerSetChange is topic for notifying that miners of an epoch
+	// differ from those of the last one, with the *types.MinerSetChange
+	TopicMinerSetChange = "consensus:minerset"
+
+	////////////////////////////// txpool /////////////////////////////
+
+	// TopicMempoolTxAdded is topic for notifying that a tx is accepted into
+	// txpool
+	TopicMempoolTxAdded = "txpool:added"
+
+	// TopicMempoolTxRemoved is topic for notifying that a tx leaves txpool,
+	// with a types.TxRemoveReason telling why
+	TopicMempoolTxRemoved = "txpool:removed"
+
+	// TopicTxReplaced is topic for notifying that a tx in txpool is replaced
+	// by a conflicting one paying more
+	TopicTxReplaced = "txpool:replaced"
+
+	// TopicTxConflicted is topic for notifying that a tx in txpool is double
+	// spent by a confirmed one, and removed with its descendants
+	TopicTxConflicted = "txpool:conflicted"
+
+	////////////////////////////// eventbus /////////////////////////////
+
+	// TopicDeadLetter is topic for notifying that a handler failed to handle
+	// an event, either panicking or not taking its payload, with the
+	// *DeadLetter
+	TopicDeadLetter = "eventbus:deadletter"
+
 	////////////////////////////// db /////////////////////////////
 
 	// TopicGetDatabaseKeys is topic for get keys of a specified storage
 	TopicGetDatabaseKeys = "rpc:database:keys"
 	// TopicGetDatabaseValue is topic for get value of specified key
 	TopicGetDatabaseValue = "rpc:database:get"
+	// TopicBackupDatabase is topic for backing up database to a named backup
+	TopicBackupDatabase = "rpc:database:backup"
+
+	// TopicDiskSpace is topic for notifying that the level of free space on
+	// volumes of the database changes, with the storage.DiskSpaceLevel and
+	// free space in bytes, e.g. to prune data when it's low
+	TopicDiskSpace = "storage:diskspace"
+
+	////////////////////////////// diagnostics /////////////////////////////
+
+	// TopicCaptureProfile is topic for capturing a runtime profile to a
+	// named file
+	TopicCaptureProfile = "rpc:diagnostics:profile"
 )
@@ -0,0 +1,39 @@
+// Copyright (c) 2018 ContentBox Authors.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package eventbus
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// Wildcard ends topic patterns, e.g. "p2p:*", subscribing to all topics
+// prefixed with the rest of it. "*" alone subscribes to all topics
+const Wildcard = "*"
+
+// WildcardHandler is the handler of a topic pattern, called with the topic
+// published and its args
+type WildcardHandler func(topic string, args ...interface{})
+
+var wildcardHandlerType = reflect.TypeOf((WildcardHandler)(nil))
+
+// topicPrefix returns the prefix of topics matched by topic if it's a
+// pattern ending with Wildcard
+func topicPrefix(topic string) (string, bool) {
+	if !strings.HasSuffix(topic, Wildcard) {
+		return "", false
+	}
+	return strings.TrimSuffix(topic, Wildcard), true
+}
+
+// checkWildcardHandler checks if handler fn of pattern takes the topic and
+// args published, as a WildcardHandler does
+func checkWildcardHandler(pattern string, fn reflect.Type) error {
+	if !fn.ConvertibleTo(wildcardHandlerType) {
+		return fmt.Errorf("handler %s of topic %s must be of %s", fn, pattern, wildcardHandlerType)
+	}
+	return nil
+}
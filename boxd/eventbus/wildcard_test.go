@@ -0,0 +1,66 @@
+// Copyright (c) 2018 ContentBox Authors.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package eventbus
+
+import (
+	"reflect"
+	"sync"
+	"testing"
+
+	"github.com/facebookgo/ensure"
+)
+
+func TestWildcardSubscribe(t *testing.T) {
+	bus := New()
+	RegisterTopic("test:wildcard", reflect.TypeOf(0))
+
+	var mtx sync.Mutex
+	var got []interface{}
+	handler := func(topic string, args ...interface{}) {
+		mtx.Lock()
+		defer mtx.Unlock()
+		got = append(got, topic)
+		got = append(got, args...)
+	}
+	ensure.NotNil(t, bus.Subscribe("test:*", func(a int) {}))
+	ensure.Nil(t, bus.Subscribe("test:*", handler))
+	ensure.Nil(t, bus.SubscribeAsync("*", WildcardHandler(handler), true))
+	ensure.True(t, bus.HasSubscriber("test:wildcard"))
+	ensure.True(t, bus.HasSubscriber("test:*"))
+	ensure.False(t, bus.HasSubscriber("other:*"))
+
+	bus.Publish("test:wildcard", 1)
+	bus.WaitAsync()
+	ensure.DeepEqual(t, got, []interface{}{"test:wildcard", 1, "test:wildcard", 1})
+
+	got = nil
+	// payloads mismatched, and patterns are not published
+	bus.Publish("test:wildcard", "a")
+	bus.Publish("test:*", 1)
+	bus.Publish("other:topic")
+	bus.WaitAsync()
+	ensure.DeepEqual(t, got, []interface{}{"other:topic"})
+
+	got = nil
+	ensure.Nil(t, bus.Unsubscribe("*", WildcardHandler(handler)))
+	ensure.Nil(t, bus.Unsubscribe("test:*", handler))
+	ensure.NotNil(t, bus.Unsubscribe("test:*", handler))
+	ensure.False(t, bus.HasSubscriber("test:wildcard"))
+	bus.Publish("test:wildcard", 2)
+	bus.WaitAsync()
+	ensure.DeepEqual(t, len(got), 0)
+}
+
+func TestWildcardSubscribeOnce(t *testing.T) {
+	bus := New()
+	var topics []string
+	bus.SubscribeOnce("test:*", func(topic string, args ...interface{}) {
+		topics = append(topics, topic)
+	})
+	bus.Publish("test:a")
+	bus.Publish("test:b")
+	ensure.DeepEqual(t, topics, []string{"test:a"})
+	ensure.False(t, bus.HasSubscriber("test:*"))
+}
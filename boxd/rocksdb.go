@@ -0,0 +1,14 @@
+// Copyright (c) 2018 ContentBox Authors.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+//go:build cgo
+// +build cgo
+
+package boxd
+
+// rocksdb wraps the rocksdb C++ library, so it is only available with cgo.
+// Build with CGO_ENABLED=0 to get a pure Go binary storing data in badgerdb
+import (
+	_ "github.com/BOXFoundation/boxd/storage/rocksdb" // init rocksdb
+)
@@ -8,15 +8,20 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"os/signal"
+	"path/filepath"
 	"runtime"
 	"sync"
+	"syscall"
 	"time"
 
 	"github.com/BOXFoundation/boxd/blocksync"
 	"github.com/BOXFoundation/boxd/boxd/eventbus"
 	"github.com/BOXFoundation/boxd/boxd/service"
 	config "github.com/BOXFoundation/boxd/config"
-	"github.com/BOXFoundation/boxd/consensus/dpos"
+	"github.com/BOXFoundation/boxd/consensus"
+	_ "github.com/BOXFoundation/boxd/consensus/dpos" // init dpos
+	_ "github.com/BOXFoundation/boxd/consensus/solo" // init solo
 	"github.com/BOXFoundation/boxd/core/chain"
 	"github.com/BOXFoundation/boxd/core/txpool"
 	"github.com/BOXFoundation/boxd/log"
@@ -24,13 +29,16 @@ import (
 	p2p "github.com/BOXFoundation/boxd/p2p"
 	grpcserver "github.com/BOXFoundation/boxd/rpc/server"
 	storage "github.com/BOXFoundation/boxd/storage"
-	_ "github.com/BOXFoundation/boxd/storage/memdb"   // init memdb
-	_ "github.com/BOXFoundation/boxd/storage/rocksdb" // init rocksdb
+	_ "github.com/BOXFoundation/boxd/storage/badgerdb" // init badgerdb
+	_ "github.com/BOXFoundation/boxd/storage/memdb"    // init memdb
 	"github.com/jbenet/goprocess"
 )
 
 var logger = log.NewLogger("boxd") // logger for node package
 
+// defaultShutdownTimeout bounds the wait for services to stop on shutdown
+const defaultShutdownTimeout = 30 * time.Second
+
 // Server is the boxd server instance, which contains all services,
 // including grpc, p2p, database...
 type Server struct {
@@ -45,21 +53,46 @@ type Server struct {
 	blockChain  *chain.BlockChain
 	txPool      *txpool.TransactionPool
 	syncManager *blocksync.SyncManager
-	consensus   *dpos.Dpos
+	consensus   consensus.Engine
+
+	// services run by the server, supervised till it stops
+	services *service.Registry
+
+	// stopping is closed once the server starts to shut down
+	stopping chan struct{}
+	stopOnce sync.Once
 }
 
 // NewServer new a boxd server
 func NewServer(cfg *config.Config) *Server {
 	server := &Server{
-		proc: goprocess.WithSignals(os.Interrupt),
-		bus:  eventbus.Default(),
-		cfg:  cfg,
+		proc:     goprocess.WithParent(goprocess.Background()),
+		bus:      eventbus.Default(),
+		cfg:      cfg,
+		stopping: make(chan struct{}),
 	}
+	server.services = service.NewRegistry(func(name string) {
+		go server.Stop()
+	})
 	server.initEventListener()
 	server.proc.SetTeardown(server.teardown)
+	server.proc.Go(server.waitSignals)
 	return server
 }
 
+// waitSignals stops the server on interrupt or termination signals
+func (server *Server) waitSignals(p goprocess.Process) {
+	sigCh := make(chan os.Signal, 1)
+	signal.Notify(sigCh, os.Interrupt, syscall.SIGTERM)
+	defer signal.Stop(sigCh)
+	select {
+	case sig := <-sigCh:
+		logger.Infof("Received signal %v.", sig)
+		go server.Stop()
+	case <-p.Closing():
+	}
+}
+
 // teardown
 func (server *Server) teardown() error {
 	done := make(chan int)
@@ -101,6 +134,26 @@ func (server *Server) Prepare() {
 		logger.Fatalf("Failed to initialize database: %v", err)
 	}
 	server.database = database
+	database.OnDiskSpace(func(level storage.DiskSpaceLevel, free uint64) {
+		server.bus.Publish(eventbus.TopicDiskSpace, level, free)
+	})
+
+	// verify chain data, which is to check only or to repair damaged. Chain
+	// data is checked as well if the node was not shut down cleanly
+	verifyDB := cfg.VerifyDB
+	if verifyDB == "" && database.Unclean() {
+		logger.Warn("The node was not shut down cleanly, checking chain data...")
+		verifyDB = "check"
+	}
+	switch verifyDB {
+	case "":
+	case "check", "repair":
+		if _, err := chain.VerifyDatabase(database, verifyDB == "repair"); err != nil {
+			logger.Fatalf("Failed to verify database: %v", err)
+		}
+	default:
+		logger.Fatalf("Invalid verifydb mode: %s", cfg.VerifyDB)
+	}
 
 	// ########################################################
 	// prepare box peer.
@@ -127,66 +180,100 @@ func (server *Server) Prepare() {
 	server.blockChain = blockChain
 
 	// prepare txpool.
-	txPool := txpool.NewTransactionPool(blockChain.Proc(), peer, blockChain, server.bus)
+	txPool := txpool.NewTransactionPool(blockChain.Proc(), peer, blockChain, server.bus, &cfg.TxPool)
 	server.txPool = txPool
 
 	// prepare consensus.
-	consensus, err := dpos.NewDpos(txPool.Proc(), blockChain, txPool, peer, &cfg.Dpos)
+	engine, err := consensus.New(cfg.Consensus, &consensus.Params{
+		Proc:   txPool.Proc(),
+		Chain:  blockChain,
+		TxPool: txPool,
+		Net:    peer,
+		Config: cfg.ConsensusConfig(),
+	})
 	if err != nil {
-		logger.Fatalf("Failed to new Dpos. Err: %v", err)
-	}
-	server.consensus = consensus
-
-	// prepare grpc server.
-	if cfg.RPC.Enabled {
-		server.grpcsvr, _ = grpcserver.NewServer(txPool.Proc(), &cfg.RPC, blockChain, txPool, server.bus)
+		logger.Fatalf("Failed to new consensus. Err: %v", err)
 	}
+	server.consensus = engine
 
 	// prepare sync manager.
-	syncManager := blocksync.NewSyncManager(blockChain, peer, consensus, blockChain.Proc())
+	syncManager := blocksync.NewSyncManager(blockChain, peer, engine, blockChain.Proc())
 	server.syncManager = syncManager
-	server.blockChain.Setup(consensus, syncManager)
+	server.blockChain.Setup(engine, syncManager)
 
+	// services are started by the registry in order of their dependencies
+	if err := server.registerServices(); err != nil {
+		logger.Fatalf("Failed to register services. Err: %v", err)
+	}
 }
 
-var _ service.Server = (*Server)(nil)
-
-// Run to start node server.
-func (server *Server) Run() error {
-
-	var proc = server.proc
+// registerServices declares services the server runs and their dependencies.
+// Critical services stop the node if they fail, the others are restarted
+func (server *Server) registerServices() error {
 	var cfg = server.cfg
-
-	if err := server.peer.Run(); err != nil {
-		logger.Fatalf("Failed to start peer. Err: %v", err)
-	}
-
-	if err := server.blockChain.Run(); err != nil {
-		logger.Fatalf("Failed to start blockchain. Err: %v", err)
+	specs := []service.Spec{
+		{Name: "database", Critical: true, Start: func() (goprocess.Process, error) {
+			return server.database.Proc(), nil
+		}},
+		{Name: "p2p", Deps: []string{"database"}, Critical: true, Start: func() (goprocess.Process, error) {
+			return server.peer.Proc(), server.peer.Run()
+		}},
+		{Name: "chain", Deps: []string{"p2p"}, Critical: true, Start: func() (goprocess.Process, error) {
+			return server.blockChain.Proc(), server.blockChain.Run()
+		}},
+		{Name: "txpool", Deps: []string{"chain"}, Critical: true, Start: func() (goprocess.Process, error) {
+			return server.txPool.Proc(), server.txPool.Run()
+		}},
+		{Name: "consensus", Deps: []string{"txpool"}, Critical: true, Start: func() (goprocess.Process, error) {
+			return nil, server.consensus.Start()
+		}},
+		{Name: "sync", Deps: []string{"chain", "consensus"}, Critical: true, Start: func() (goprocess.Process, error) {
+			server.syncManager.Run()
+			if len(cfg.P2p.Seeds) > 0 {
+				server.syncManager.StartSync()
+			}
+			return nil, nil
+		}},
+		{Name: "metrics", Start: func() (goprocess.Process, error) {
+			metrics.Run(&cfg.Metrics, server.proc)
+			metrics.RunDiagnostics(&cfg.Metrics.Diagnostics, server.proc)
+			metrics.RunTracing(&cfg.Metrics.Tracing, server.proc)
+			return nil, nil
+		}},
 	}
-
-	if err := server.txPool.Run(); err != nil {
-		logger.Fatalf("Failed to start txpool. Err: %v", err)
+	if cfg.RPC.Enabled {
+		specs = append(specs, service.Spec{Name: "rpc", Deps: []string{"txpool", "consensus"}, Start: server.startRPC})
 	}
-
-	if server.consensus.EnableMint() {
-		if err := server.consensus.Setup(); err != nil {
-			logger.Fatalf("Failed to Setup dpos, Err: %v", err)
-		}
-		if err := server.consensus.Run(); err != nil {
-			logger.Fatalf("Failed to start consensus. Err: %v", err)
+	for _, spec := range specs {
+		if err := server.services.Register(spec); err != nil {
+			return err
 		}
 	}
+	return nil
+}
 
-	server.syncManager.Run()
-	metrics.Run(&cfg.Metrics, proc)
-	if len(cfg.P2p.Seeds) > 0 {
-		server.syncManager.StartSync()
+// startRPC starts the grpc server, replacing the one crashed if any
+func (server *Server) startRPC() (goprocess.Process, error) {
+	if server.grpcsvr != nil {
+		server.grpcsvr.Stop()
+	}
+	grpcsvr, err := grpcserver.NewServer(server.txPool.Proc(), &server.cfg.RPC, server.blockChain, server.txPool, server.consensus, server.bus)
+	if err != nil {
+		return nil, err
 	}
+	server.grpcsvr = grpcsvr
+	return grpcsvr.Proc(), grpcsvr.Run()
+}
 
-	if cfg.RPC.Enabled {
-		server.grpcsvr, _ = grpcserver.NewServer(server.txPool.Proc(), &cfg.RPC, server.blockChain, server.txPool, server.bus)
-		server.grpcsvr.Run()
+var _ service.Server = (*Server)(nil)
+
+// Run to start node server.
+func (server *Server) Run() error {
+
+	var proc = server.proc
+
+	if err := server.services.Start(); err != nil {
+		logger.Fatalf("Failed to start services. Err: %v", err)
 	}
 
 	// goprocesses dependencies
@@ -203,13 +290,20 @@ func (server *Server) Run() error {
 	//          rpc    consensus
 
 	select {
+	case <-server.stopping:
 	case <-proc.Closing():
-		logger.Info("Box server is shutting down...")
 	}
+	logger.Info("Box server is shutting down...")
 
+	timeout := server.shutdownTimeout()
 	select {
 	case <-proc.Closed():
 		logger.Info("Box server is down.")
+	case <-time.After(timeout):
+		// the database is not marked closed cleanly, so chain data is
+		// checked at next start
+		logger.Errorf("Box server failed to shut down in %v.", timeout)
+		return fmt.Errorf("timeout to shutdown box server")
 	}
 
 	return nil
@@ -220,15 +314,47 @@ func (server *Server) Proc() goprocess.Process {
 	return server.proc
 }
 
-// Stop the server
+// Stop the server. Services are stopped in order, so that data received is
+// written before the database is closed. P2p stops taking connections and
+// messages first, and blocks received are processed by the chain. Then the
+// goprocess tree is closed from its leaves: consensus and rpc with the node
+// wallet, and txpool, whose async events are handled before chain and p2p
+// are closed. The database, the root of services, is marked closed cleanly
+// and closed last
 func (server *Server) Stop() {
+	server.stopOnce.Do(func() {
+		close(server.stopping)
+		server.services.Stop()
+		if server.peer != nil {
+			server.peer.StopIntake()
+		}
+		if server.blockChain != nil {
+			server.blockChain.Drain()
+		}
+		if server.txPool != nil {
+			server.txPool.Proc().Close()
+			server.bus.WaitAsync()
+		}
+	})
 	server.proc.Close()
 }
 
+// shutdownTimeout returns the configured timeout to shut down the server
+func (server *Server) shutdownTimeout() time.Duration {
+	if server.cfg.ShutdownTimeout > 0 {
+		return server.cfg.ShutdownTimeout
+	}
+	return defaultShutdownTimeout
+}
+
 func (server *Server) initEventListener() {
 	// TopicSetDebugLevel
-	server.bus.Reply(eventbus.TopicSetDebugLevel, func(newLevel string, out chan<- bool) {
-		out <- log.SetLogLevel(newLevel)
+	server.bus.Reply(eventbus.TopicSetDebugLevel, func(newLevel, module string, out chan<- bool) {
+		if module == "" {
+			out <- log.SetLogLevel(newLevel)
+			return
+		}
+		out <- log.SetModuleLogLevel(module, newLevel)
 	}, false)
 
 	// TopicUpdateNetworkID
@@ -307,4 +433,63 @@ func (server *Server) initEventListener() {
 			result = v
 		}
 	}, false)
+
+	// TopicGetServiceStatus
+	server.bus.Reply(eventbus.TopicGetServiceStatus, func(out chan<- []service.Status) {
+		out <- server.services.Status()
+	}, false)
+
+	// TopicBackupDatabase
+	server.bus.Reply(eventbus.TopicBackupDatabase, func(name string, out chan<- interface{}) {
+		if err := server.database.CheckDiskSpace(false); err != nil {
+			logger.Errorf("Failed to back up database. Err: %v", err)
+			out <- err
+			return
+		}
+		dir := filepath.Join(server.cfg.Workspace, "backup", server.cfg.Network, name)
+		if err := server.database.Backup(dir); err != nil {
+			logger.Errorf("Failed to back up database to %s. Err: %v", dir, err)
+			out <- err
+			return
+		}
+		out <- dir
+	}, false)
+
+	// TopicCaptureProfile
+	server.bus.Reply(eventbus.TopicCaptureProfile, func(profile string, duration time.Duration, name string,
+		out chan<- interface{}) {
+		path, err := server.captureProfile(profile, duration, name)
+		if err != nil {
+			logger.Errorf("Failed to capture %s profile. Err: %v", profile, err)
+			out <- err
+			return
+		}
+		out <- path
+	}, false)
+}
+
+// captureProfile captures profile into file name in the profiles directory
+// of workspace, and returns the file path
+func (server *Server) captureProfile(profile string, duration time.Duration, name string) (string, error) {
+	if err := server.database.CheckDiskSpace(false); err != nil {
+		return "", err
+	}
+	dir := filepath.Join(server.cfg.Workspace, "profiles", server.cfg.Network)
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return "", err
+	}
+	path := filepath.Join(dir, name)
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0600)
+	if err != nil {
+		return "", err
+	}
+	err = metrics.CaptureProfile(f, profile, duration)
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		os.Remove(path)
+		return "", err
+	}
+	return path, nil
 }
@@ -18,12 +18,22 @@ type ChainReader interface {
 
 	// interface to read transactions
 	LoadTxByHash(crypto.HashType) (*types.Transaction, error)
+	LoadBlockInfoByTxHash(crypto.HashType) (*types.Block, *types.Transaction, error)
 
 	//interface to reader block status
 	GetBlockHeight() uint32
+	// ReadSnapshot runs fn while no block is connected or disconnected
+	ReadSnapshot(fn func() error) error
 	GetBlockHash(uint32) (*crypto.HashType, error)
 	LoadBlockByHash(crypto.HashType) (*types.Block, error)
+	// EternalBlock returns the latest final block
+	EternalBlock() *types.Block
 
 	// address related search method
-	GetTransactionsByAddr(types.Address) ([]*types.Transaction, error)
+	GetTransactionsByAddr(types.Address) ([]*types.TxRecord, error)
+
+	// ListTokens returns tokens issued from offset, and the number of all tokens
+	ListTokens(offset, limit uint32) ([]*types.TokenInfo, uint32, error)
+	// GetTokenTransactions returns txs moving a token from offset, and the number of all of them
+	GetTokenTransactions(token types.OutPoint, offset, limit uint32) ([]*types.TxRecord, uint32, error)
 }
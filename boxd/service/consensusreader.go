@@ -0,0 +1,20 @@
+// Copyright (c) 2018 ContentBox Authors.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package service
+
+import "github.com/BOXFoundation/boxd/core/types"
+
+// ConsensusReader defines operations consensus exposes on miners and
+// candidates
+type ConsensusReader interface {
+	// GetCandidates returns candidates with their votes as of the tail block,
+	// most voted first, and the height of the tail block
+	GetCandidates() ([]*types.CandidateInfo, uint32, error)
+	// GetMinerSchedule returns miners of the current epoch with their slots
+	GetMinerSchedule() (*types.MinerSchedule, error)
+	// GetMinerStats returns blocks each miner produced and was expected to
+	// produce in the last epochs epochs, all kept if epochs is 0
+	GetMinerStats(epochs uint32) ([]*types.MinerStats, error)
+}
@@ -0,0 +1,299 @@
+// Copyright (c) 2018 ContentBox Authors.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package service
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/BOXFoundation/boxd/log"
+	"github.com/jbenet/goprocess"
+)
+
+var logger = log.NewLogger("service")
+
+// default delays of restarting crashed services, doubled on each crash in a
+// row up to the max
+const (
+	defaultMinBackoff = time.Second
+	defaultMaxBackoff = time.Minute
+)
+
+// errors of registering services
+var (
+	ErrServiceExists   = errors.New("service is already registered")
+	ErrUnknownService  = errors.New("service depends on an unknown service")
+	ErrDependencyCycle = errors.New("services depend on each other")
+)
+
+// State is the state of a service run by the registry
+type State string
+
+// states of services
+const (
+	StatePending    State = "pending"
+	StateRunning    State = "running"
+	StateRestarting State = "restarting"
+	StateFailed     State = "failed"
+	StateStopped    State = "stopped"
+)
+
+// Spec declares a service run by the registry
+type Spec struct {
+	Name string
+	// Deps are names of services started before it
+	Deps []string
+	// Critical services stop the node if they fail, others are restarted
+	// with backoff
+	Critical bool
+	// Start starts the service and returns the goprocess it runs in, which
+	// closing before the registry stops means the service crashed. Services
+	// without a goprocess return nil, and are not supervised. Start is called
+	// again to restart a crashed service
+	Start func() (goprocess.Process, error)
+}
+
+// Status is the status of a service run by the registry
+type Status struct {
+	Name     string
+	State    State
+	Critical bool
+	// Restarts is the number of times the service is restarted
+	Restarts int
+	// Err is the last error the service failed with
+	Err string
+	// Since is the time the service entered the state
+	Since time.Time
+}
+
+// Registry starts services in order of their dependencies, and supervises
+// them, restarting crashed non-critical services with backoff
+type Registry struct {
+	proc  goprocess.Process
+	specs []*Spec
+	index map[string]*Spec
+
+	mtx    sync.RWMutex
+	status map[string]*Status
+
+	// onFailure is called with the name of a critical service that fails
+	onFailure  func(name string)
+	minBackoff time.Duration
+	maxBackoff time.Duration
+}
+
+// NewRegistry creates a registry calling onFailure with the name of a
+// critical service that fails
+func NewRegistry(onFailure func(name string)) *Registry {
+	return &Registry{
+		proc:       goprocess.WithParent(goprocess.Background()),
+		index:      make(map[string]*Spec),
+		status:     make(map[string]*Status),
+		onFailure:  onFailure,
+		minBackoff: defaultMinBackoff,
+		maxBackoff: defaultMaxBackoff,
+	}
+}
+
+// Register registers a service to start
+func (r *Registry) Register(spec Spec) error {
+	if _, ok := r.index[spec.Name]; ok {
+		return fmt.Errorf("%v: %s", ErrServiceExists, spec.Name)
+	}
+	r.specs = append(r.specs, &spec)
+	r.index[spec.Name] = &spec
+	r.status[spec.Name] = &Status{Name: spec.Name, State: StatePending, Critical: spec.Critical, Since: time.Now()}
+	return nil
+}
+
+// Order returns services in the order to start them, each after those it
+// depends on and otherwise in the order registered
+func (r *Registry) Order() ([]*Spec, error) {
+	var order []*Spec
+	// visiting marks services whose deps are being visited, to find cycles
+	visited := make(map[string]bool)
+	visiting := make(map[string]bool)
+	var visit func(spec *Spec) error
+	visit = func(spec *Spec) error {
+		if visited[spec.Name] {
+			return nil
+		}
+		if visiting[spec.Name] {
+			return fmt.Errorf("%v: %s", ErrDependencyCycle, spec.Name)
+		}
+		visiting[spec.Name] = true
+		for _, name := range spec.Deps {
+			dep, ok := r.index[name]
+			if !ok {
+				return fmt.Errorf("%v: %s depends on %s", ErrUnknownService, spec.Name, name)
+			}
+			if err := visit(dep); err != nil {
+				return err
+			}
+		}
+		visiting[spec.Name] = false
+		visited[spec.Name] = true
+		order = append(order, spec)
+		return nil
+	}
+	for _, spec := range r.specs {
+		if err := visit(spec); err != nil {
+			return nil, err
+		}
+	}
+	return order, nil
+}
+
+// Start starts services in order of their dependencies. It returns an error
+// if dependencies are invalid or a critical service fails to start, while
+// non-critical ones failing to start are restarted later
+func (r *Registry) Start() error {
+	order, err := r.Order()
+	if err != nil {
+		return err
+	}
+	for _, spec := range order {
+		spec := spec
+		proc, err := spec.Start()
+		if err != nil {
+			r.setState(spec.Name, StateFailed, err)
+			if spec.Critical {
+				return fmt.Errorf("failed to start %s: %v", spec.Name, err)
+			}
+			logger.Errorf("Failed to start service %s. Err: %v", spec.Name, err)
+			r.proc.Go(func(p goprocess.Process) { r.restart(p, spec, 0) })
+			continue
+		}
+		logger.Infof("Service %s started", spec.Name)
+		r.setState(spec.Name, StateRunning, nil)
+		r.supervise(spec, proc, 0)
+	}
+	return nil
+}
+
+// supervise watches proc of the service, restarting it at attempt if it
+// crashes
+func (r *Registry) supervise(spec *Spec, proc goprocess.Process, attempt int) {
+	if proc == nil {
+		return
+	}
+	started := time.Now()
+	r.proc.Go(func(p goprocess.Process) {
+		select {
+		case <-p.Closing():
+			return
+		case <-proc.Closed():
+		}
+		// services are closed by the goprocess tree on shutdown, which stops
+		// the registry first
+		select {
+		case <-p.Closing():
+			return
+		default:
+		}
+		err := fmt.Errorf("service exited after running for %v", time.Since(started))
+		r.setState(spec.Name, StateFailed, err)
+		if spec.Critical {
+			logger.Errorf("Critical service %s crashed, stopping the node", spec.Name)
+			if r.onFailure != nil {
+				r.onFailure(spec.Name)
+			}
+			return
+		}
+		logger.Errorf("Service %s crashed. Err: %v", spec.Name, err)
+		// crashes in a row back off longer, until the service keeps running
+		// longer than the max backoff
+		if time.Since(started) >= r.maxBackoff {
+			attempt = 0
+		}
+		r.restart(p, spec, attempt)
+	})
+}
+
+// restart starts the service again after backoff of attempt, retrying until
+// it starts or p closes
+func (r *Registry) restart(p goprocess.Process, spec *Spec, attempt int) {
+	for {
+		delay := r.backoff(attempt)
+		r.setState(spec.Name, StateRestarting, nil)
+		logger.Infof("Restarting service %s in %v", spec.Name, delay)
+		select {
+		case <-p.Closing():
+			return
+		case <-time.After(delay):
+		}
+
+		r.mtx.Lock()
+		r.status[spec.Name].Restarts++
+		r.mtx.Unlock()
+		proc, err := spec.Start()
+		if err == nil {
+			logger.Infof("Service %s restarted", spec.Name)
+			r.setState(spec.Name, StateRunning, nil)
+			r.supervise(spec, proc, attempt+1)
+			return
+		}
+		logger.Errorf("Failed to restart service %s. Err: %v", spec.Name, err)
+		r.setState(spec.Name, StateFailed, err)
+		attempt++
+	}
+}
+
+// backoff returns the delay before restart attempt of a service
+func (r *Registry) backoff(attempt int) time.Duration {
+	delay := r.minBackoff
+	for i := 0; i < attempt && delay < r.maxBackoff; i++ {
+		delay *= 2
+	}
+	if delay > r.maxBackoff {
+		delay = r.maxBackoff
+	}
+	return delay
+}
+
+// setState sets the state of the service, and the error it failed with if
+// any. States are kept stopped once the registry stops
+func (r *Registry) setState(name string, state State, err error) {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+	select {
+	case <-r.proc.Closing():
+		return
+	default:
+	}
+	status := r.status[name]
+	if status.State != state {
+		status.State = state
+		status.Since = time.Now()
+	}
+	if err != nil {
+		status.Err = err.Error()
+	}
+}
+
+// Status returns statuses of services in the order registered
+func (r *Registry) Status() []Status {
+	r.mtx.RLock()
+	defer r.mtx.RUnlock()
+	statuses := make([]Status, 0, len(r.specs))
+	for _, spec := range r.specs {
+		statuses = append(statuses, *r.status[spec.Name])
+	}
+	return statuses
+}
+
+// Stop stops supervising services, which are marked stopped. Services are
+// stopped by their goprocess tree
+func (r *Registry) Stop() {
+	r.proc.Close()
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+	for _, status := range r.status {
+		status.State = StateStopped
+		status.Since = time.Now()
+	}
+}
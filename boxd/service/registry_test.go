@@ -0,0 +1,134 @@
+// Copyright (c) 2018 ContentBox Authors.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package service
+
+import (
+	"errors"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/facebookgo/ensure"
+	"github.com/jbenet/goprocess"
+)
+
+func TestRegistryOrder(t *testing.T) {
+	r := NewRegistry(nil)
+	var started []string
+	start := func(name string) func() (goprocess.Process, error) {
+		return func() (goprocess.Process, error) {
+			started = append(started, name)
+			return nil, nil
+		}
+	}
+	ensure.Nil(t, r.Register(Spec{Name: "rpc", Deps: []string{"txpool", "chain"}, Start: start("rpc")}))
+	ensure.Nil(t, r.Register(Spec{Name: "txpool", Deps: []string{"chain"}, Start: start("txpool")}))
+	ensure.Nil(t, r.Register(Spec{Name: "metrics", Start: start("metrics")}))
+	ensure.Nil(t, r.Register(Spec{Name: "chain", Deps: []string{"p2p"}, Start: start("chain")}))
+	ensure.Nil(t, r.Register(Spec{Name: "p2p", Start: start("p2p")}))
+	ensure.True(t, strings.HasPrefix(r.Register(Spec{Name: "p2p"}).Error(), ErrServiceExists.Error()))
+
+	ensure.Nil(t, r.Start())
+	defer r.Stop()
+	ensure.DeepEqual(t, started, []string{"p2p", "chain", "txpool", "rpc", "metrics"})
+	for _, status := range r.Status() {
+		ensure.DeepEqual(t, status.State, StateRunning)
+	}
+}
+
+func TestRegistryInvalidDeps(t *testing.T) {
+	r := NewRegistry(nil)
+	ensure.Nil(t, r.Register(Spec{Name: "chain", Deps: []string{"p2p"}}))
+	_, err := r.Order()
+	ensure.True(t, strings.HasPrefix(err.Error(), ErrUnknownService.Error()))
+
+	ensure.Nil(t, r.Register(Spec{Name: "p2p", Deps: []string{"txpool"}}))
+	ensure.Nil(t, r.Register(Spec{Name: "txpool", Deps: []string{"chain"}}))
+	_, err = r.Order()
+	ensure.True(t, strings.HasPrefix(err.Error(), ErrDependencyCycle.Error()))
+	ensure.NotNil(t, r.Start())
+}
+
+func TestRegistryRestart(t *testing.T) {
+	r := NewRegistry(nil)
+	r.minBackoff, r.maxBackoff = time.Millisecond, 10*time.Millisecond
+	var mtx sync.Mutex
+	var procs []goprocess.Process
+	starts := 0
+	ensure.Nil(t, r.Register(Spec{Name: "rpc", Start: func() (goprocess.Process, error) {
+		mtx.Lock()
+		defer mtx.Unlock()
+		starts++
+		// the second start fails, and is retried
+		if starts == 2 {
+			return nil, errors.New("address in use")
+		}
+		proc := goprocess.WithParent(goprocess.Background())
+		procs = append(procs, proc)
+		return proc, nil
+	}}))
+	ensure.Nil(t, r.Start())
+
+	mtx.Lock()
+	procs[0].Close()
+	mtx.Unlock()
+	waitFor(t, func() bool {
+		mtx.Lock()
+		defer mtx.Unlock()
+		return len(procs) == 2 && r.Status()[0].State == StateRunning
+	})
+	status := r.Status()[0]
+	ensure.DeepEqual(t, status.Restarts, 2)
+	ensure.DeepEqual(t, status.Err, "address in use")
+
+	// services closed after the registry stops are not restarted
+	r.Stop()
+	procs[1].Close()
+	time.Sleep(20 * time.Millisecond)
+	ensure.DeepEqual(t, len(procs), 2)
+	ensure.DeepEqual(t, r.Status()[0].State, StateStopped)
+}
+
+func TestRegistryCriticalFailure(t *testing.T) {
+	failed := make(chan string, 1)
+	r := NewRegistry(func(name string) { failed <- name })
+	proc := goprocess.WithParent(goprocess.Background())
+	ensure.Nil(t, r.Register(Spec{Name: "chain", Critical: true, Start: func() (goprocess.Process, error) {
+		return proc, nil
+	}}))
+	ensure.Nil(t, r.Register(Spec{Name: "p2p", Critical: true, Start: func() (goprocess.Process, error) {
+		return nil, errors.New("failed to listen")
+	}}))
+	ensure.NotNil(t, r.Start())
+	ensure.DeepEqual(t, r.Status()[1].State, StateFailed)
+
+	proc.Close()
+	select {
+	case name := <-failed:
+		ensure.DeepEqual(t, name, "chain")
+	case <-time.After(time.Second):
+		t.Fatal("critical failure is not reported")
+	}
+	ensure.DeepEqual(t, r.Status()[0].State, StateFailed)
+	r.Stop()
+}
+
+func TestRegistryBackoff(t *testing.T) {
+	r := NewRegistry(nil)
+	ensure.DeepEqual(t, r.backoff(0), time.Second)
+	ensure.DeepEqual(t, r.backoff(3), 8*time.Second)
+	ensure.DeepEqual(t, r.backoff(100), time.Minute)
+}
+
+func waitFor(t *testing.T, cond func() bool) {
+	deadline := time.Now().Add(time.Second)
+	for !cond() {
+		if time.Now().After(deadline) {
+			t.Fatal("condition is not met in time")
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
@@ -9,6 +9,15 @@ import "github.com/BOXFoundation/boxd/core/types"
 // TxHandler defines basic operations txpool exposes
 type TxHandler interface {
 	ProcessTx(tx *types.Transaction, broadcast bool) error
+	// TestAcceptTx validates tx as ProcessTx does without adding or relaying
+	// it, returning its fee and size
+	TestAcceptTx(tx *types.Transaction) (uint64, int, error)
+	// ResendTx broadcasts tx again, admitting it first if it's not in pool
+	ResendTx(tx *types.Transaction) error
 	// GetTransactionsInPool gets all transactions in memory pool
 	GetTransactionsInPool() []*types.Transaction
+	// GetMempoolEntries gets all transactions in memory pool with their fees
+	GetMempoolEntries() []*types.MempoolEntry
+	// GetMempoolInfo summarizes memory pool
+	GetMempoolInfo() *types.MempoolInfo
 }
@@ -9,18 +9,22 @@ import (
 	"fmt"
 	"path"
 	"strconv"
+	"time"
 
 	"github.com/BOXFoundation/boxd/commands/box/root"
 	"github.com/BOXFoundation/boxd/core/types"
 	"github.com/BOXFoundation/boxd/crypto"
 	"github.com/BOXFoundation/boxd/rpc/client"
+	"github.com/BOXFoundation/boxd/rpc/pb"
 	"github.com/BOXFoundation/boxd/util"
 	"github.com/BOXFoundation/boxd/wallet"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
+	"google.golang.org/grpc"
 )
 
 var walletDir string
+var mempoolVerbose bool
 var defaultWalletDir = path.Join(util.HomeDir(), ".box_keystore")
 
 // rootCmd represents the base command when called without any subcommands
@@ -32,29 +36,58 @@ var rootCmd = &cobra.Command{
 	//	Run: func(cmd *cobra.Command, args []string) { },
 }
 
+var listMempoolCmd = &cobra.Command{
+	Use:   "listmempooltxs",
+	Short: "List transactions in mempool by fee rate, highest first",
+	Run:   listMempoolTxsCmdFunc,
+}
+
 func init() {
 	root.RootCmd.AddCommand(rootCmd)
+	listMempoolCmd.Flags().BoolVarP(&mempoolVerbose, "verbose", "v", false, "list fees, sizes and ages of transactions")
 	rootCmd.PersistentFlags().StringVar(&walletDir, "wallet_dir", defaultWalletDir, "Specify directory to search keystore files")
 	rootCmd.AddCommand(
 		&cobra.Command{
-			Use:   "addnode [netaddr] add|remove",
-			Short: "Add or remove a peer node",
-			Run: func(cmd *cobra.Command, args []string) {
-				fmt.Println("addnode called")
-			},
+			Use:   "addnode [multiaddr]",
+			Short: "Connect to a peer node at a multiaddr ending with its peer id",
+			Run:   addNodeCmdFunc,
 		},
 		&cobra.Command{
-			Use:   "createrawtx",
-			Short: "Create a raw transaction",
-			Run: func(cmd *cobra.Command, args []string) {
-				fmt.Println("createrawtx called")
-			},
+			Use:   "backupdb [name]",
+			Short: "Back up database of boxd without stopping it, named by the backup time by default",
+			Run:   backupDBCmdFunc,
+		},
+		&cobra.Command{
+			Use:   "banpeer [peerid|ip|subnet] [seconds]",
+			Short: "Ban a peer id, ip or subnet for some seconds, one day by default",
+			Run:   banPeerCmdFunc,
+		},
+		&cobra.Command{
+			Use:   "captureprofile [cpu|trace|goroutine|heap|allocs|threadcreate|block|mutex] [seconds] [name]",
+			Short: "Capture a runtime profile of boxd to a file in its workspace, cpu and trace sample 30 seconds by default",
+			Run:   captureProfileCmdFunc,
+		},
+		&cobra.Command{
+			Use:   "convertaddress [address]",
+			Short: "Convert an address between base58 and bech32 formats",
+			Run:   convertAddressCmdFunc,
+		},
+		&cobra.Command{
+			Use:   "createrawtx [from] [toaddress] [amount] ...",
+			Short: "Create an unsigned raw transaction funded by an address",
+			Run:   createRawTxCmdFunc,
 		},
 		&cobra.Command{
-			Use:   "debuglevel [debug|info|warning|error|fatal]",
-			Short: "Set the debug level of boxd",
+			Use:   "debuglevel [debug|info|warning|error|fatal|default] [module]",
+			Short: "Set the debug level of boxd, or of a module like chain, script, p2p or pscore",
 			Run:   debugLevelCmdFunc,
 		},
+		&cobra.Command{
+			Use:   "listbans",
+			Short: "List banned peer ids, ips and subnets",
+			Run:   listBansCmdFunc,
+		},
+		listMempoolCmd,
 		&cobra.Command{
 			Use:   "networkid [id]",
 			Short: "Update networkid of boxd",
@@ -73,14 +106,19 @@ to quickly create a Cobra application.`,
 				fmt.Println("decoderawtx called")
 			},
 		},
+		&cobra.Command{
+			Use:   "estimatefee [target_blocks]",
+			Short: "Estimate the fee price for a tx to be packed within target blocks",
+			Run:   estimateFeeCmdFunc,
+		},
 		&cobra.Command{
 			Use:   "getbalance [address]",
 			Short: "Get the balance for any given address",
 			Run:   getBalanceCmdFunc,
 		},
 		&cobra.Command{
-			Use:   "getblock [hash]",
-			Short: "Get the block with a specific hash",
+			Use:   "getblock [hash|height] [verbosity]",
+			Short: "Get a block, serialized if verbosity is 0, with tx hashes if 1, or decoded if 2, the default",
 			Run:   getBlockCmdFunc,
 		},
 		&cobra.Command{
@@ -94,8 +132,8 @@ to quickly create a Cobra application.`,
 			Run:   getBlockHashCmdFunc,
 		},
 		&cobra.Command{
-			Use:   "getblockheader [hash]",
-			Short: "Get the block header for a hash",
+			Use:   "getblockheader [hash|height] [verbosity]",
+			Short: "Get a block header, serialized if verbosity is 0, or decoded if 1, the default",
 			Run:   getBlockHeaderCmdFunc,
 		},
 		&cobra.Command{
@@ -106,22 +144,45 @@ to quickly create a Cobra application.`,
 			},
 		},
 		&cobra.Command{
-			Use:   "getnetworkinfo [network]",
-			Short: "Get the basic info and performance metrics of a network",
-			Run: func(cmd *cobra.Command, args []string) {
-				fmt.Println("getnetworkinfo called")
-			},
+			Use:   "getmempoolinfo",
+			Short: "Get the size, bytes and min fee rate of mempool",
+			Run:   getMempoolInfoCmdFunc,
+		},
+		&cobra.Command{
+			Use:   "getnetworkinfo",
+			Short: "Get the network id, listen addresses and peer count of the local node",
+			Run:   getNetworkInfoCmdFunc,
+		},
+		&cobra.Command{
+			Use:   "getnodestatus",
+			Short: "Get chain, sync, peer, mempool, wallet and health status of the node",
+			Run:   getNodeStatusCmdFunc,
+		},
+		&cobra.Command{
+			Use:   "getpeerinfo [peerid]",
+			Short: "Get the score, latency and traffic of connected peers",
+			Run:   getPeerInfoCmdFunc,
 		},
 		&cobra.Command{
 			Use:   "getrawtx [txhash]",
 			Short: "Get the raw transaction for a txid",
 			Run:   getRawTxCmdFunc,
 		},
+		&cobra.Command{
+			Use:   "gettxdetail [txhash]",
+			Short: "Get the decoded transaction and its location in the chain",
+			Run:   getTxDetailCmdFunc,
+		},
 		&cobra.Command{
 			Use:   "gettxpool",
 			Short: "Get transactions in pool",
 			Run:   getTxPoolCmdFunc,
 		},
+		&cobra.Command{
+			Use:   "removenode [peerid]",
+			Short: "Disconnect a peer node",
+			Run:   removeNodeCmdFunc,
+		},
 		&cobra.Command{
 			Use:   "searchrawtxs [address]",
 			Short: "Search transactions for a given address",
@@ -141,6 +202,11 @@ to quickly create a Cobra application.`,
 			Short: "Sign a message with a publickey",
 			Run:   signMessageCmdFunc,
 		},
+		&cobra.Command{
+			Use:   "unbanpeer [peerid|ip]",
+			Short: "Lift the ban of a peer id or ip",
+			Run:   unbanPeerCmdFunc,
+		},
 		&cobra.Command{
 			Use:   "validateaddress [address]",
 			Short: "Check if an address is valid",
@@ -164,13 +230,16 @@ to quickly create a Cobra application.`,
 }
 
 func debugLevelCmdFunc(cmd *cobra.Command, args []string) {
-	level := "info"
+	level, module := "info", ""
 	if len(args) > 0 {
 		level = args[0]
 	}
+	if len(args) > 1 {
+		module = args[1]
+	}
 	conn := client.NewConnectionWithViper(viper.GetViper())
 	defer conn.Close()
-	client.SetDebugLevel(conn, level)
+	client.SetDebugLevel(conn, level, module)
 }
 
 func updateNetworkID(cmd *cobra.Command, args []string) {
@@ -188,6 +257,142 @@ func updateNetworkID(cmd *cobra.Command, args []string) {
 	client.UpdateNetworkID(conn, id)
 }
 
+func addNodeCmdFunc(cmd *cobra.Command, args []string) {
+	if len(args) < 1 {
+		fmt.Println("Parameter multiaddr required")
+		return
+	}
+	conn := client.NewConnectionWithViper(viper.GetViper())
+	defer conn.Close()
+	if err := client.ConnectPeer(conn, args[0]); err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Println("Connected to", args[0])
+}
+
+func removeNodeCmdFunc(cmd *cobra.Command, args []string) {
+	if len(args) < 1 {
+		fmt.Println("Parameter peer id required")
+		return
+	}
+	conn := client.NewConnectionWithViper(viper.GetViper())
+	defer conn.Close()
+	if err := client.DisconnectPeer(conn, args[0]); err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Println("Disconnected", args[0])
+}
+
+func backupDBCmdFunc(cmd *cobra.Command, args []string) {
+	var name string
+	if len(args) > 0 {
+		name = args[0]
+	}
+	conn := client.NewConnectionWithViper(viper.GetViper())
+	defer conn.Close()
+	dir, err := client.BackupDatabase(conn, name)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Println("Backed up database to", dir)
+}
+
+func captureProfileCmdFunc(cmd *cobra.Command, args []string) {
+	if len(args) < 1 {
+		fmt.Println("Parameter profile required")
+		return
+	}
+	var seconds uint64
+	if len(args) > 1 {
+		var err error
+		if seconds, err = strconv.ParseUint(args[1], 10, 32); err != nil {
+			fmt.Println("Invalid seconds: ", args[1])
+			return
+		}
+	}
+	var name string
+	if len(args) > 2 {
+		name = args[2]
+	}
+	conn := client.NewConnectionWithViper(viper.GetViper())
+	defer conn.Close()
+	path, err := client.CaptureProfile(conn, args[0], uint32(seconds), name)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Println("Captured profile to", path)
+}
+
+func banPeerCmdFunc(cmd *cobra.Command, args []string) {
+	if len(args) < 1 {
+		fmt.Println("Parameter peer id, ip or subnet required")
+		return
+	}
+	duration := 24 * time.Hour
+	if len(args) > 1 {
+		seconds, err := strconv.ParseUint(args[1], 10, 32)
+		if err != nil || seconds == 0 {
+			fmt.Println("Invalid seconds: ", args[1])
+			return
+		}
+		duration = time.Duration(seconds) * time.Second
+	}
+	conn := client.NewConnectionWithViper(viper.GetViper())
+	defer conn.Close()
+	if err := client.BanPeer(conn, args[0], duration); err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Printf("Banned %s for %s\n", args[0], duration)
+}
+
+func unbanPeerCmdFunc(cmd *cobra.Command, args []string) {
+	if len(args) < 1 {
+		fmt.Println("Parameter peer id, ip or subnet required")
+		return
+	}
+	conn := client.NewConnectionWithViper(viper.GetViper())
+	defer conn.Close()
+	if err := client.UnbanPeer(conn, args[0]); err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Println("Unbanned", args[0])
+}
+
+func listBansCmdFunc(cmd *cobra.Command, args []string) {
+	conn := client.NewConnectionWithViper(viper.GetViper())
+	defer conn.Close()
+	bans, err := client.ListBans(conn)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	for _, ban := range bans {
+		fmt.Printf("%s\tuntil %s\n", ban.Target, time.Unix(ban.Until, 0).Format(time.RFC3339))
+	}
+}
+
+func convertAddressCmdFunc(cmd *cobra.Command, args []string) {
+	if len(args) < 1 {
+		fmt.Println("Parameter address required")
+		return
+	}
+	conn := client.NewConnectionWithViper(viper.GetViper())
+	defer conn.Close()
+	resp, err := client.ConvertAddress(conn, args[0])
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Println("base58:", resp.Base58)
+	fmt.Println("bech32:", resp.Bech32)
+}
+
 func getBalanceCmdFunc(cmd *cobra.Command, args []string) {
 	addrs := make([]string, 0)
 	if len(args) < 1 {
@@ -220,20 +425,48 @@ func getBalanceCmdFunc(cmd *cobra.Command, args []string) {
 }
 
 func getBlockCmdFunc(cmd *cobra.Command, args []string) {
-	fmt.Println("getblock called")
+	getBlockVerbose(args, 2, client.GetBlockVerbose)
+}
+
+func getBlockHeaderCmdFunc(cmd *cobra.Command, args []string) {
+	getBlockVerbose(args, 1, client.GetBlockHeaderVerbose)
+}
+
+// getBlockVerbose parses block hash or height and verbosity from args, and
+// prints the block fetched by get
+func getBlockVerbose(args []string, verbosity uint32,
+	get func(*grpc.ClientConn, string, uint32, uint32) (*rpcpb.GetBlockVerboseResponse, error)) {
 	if len(args) == 0 {
-		fmt.Println("Parameter block hash required")
+		fmt.Println("Parameter block hash or height required")
 		return
 	}
-	hash := args[0]
+	var hash string
+	var height uint32
+	if h, err := strconv.ParseUint(args[0], 10, 32); err == nil {
+		height = uint32(h)
+	} else {
+		hash = args[0]
+	}
+	if len(args) > 1 {
+		v, err := strconv.ParseUint(args[1], 10, 32)
+		if err != nil {
+			fmt.Println("Invalid verbosity: ", args[1])
+			return
+		}
+		verbosity = uint32(v)
+	}
 	conn := client.NewConnectionWithViper(viper.GetViper())
 	defer conn.Close()
-	block, err := client.GetBlock(conn, hash)
+	resp, err := get(conn, hash, height, verbosity)
 	if err != nil {
 		fmt.Println(err)
-	} else {
-		fmt.Printf("Block info of hash %s is\n%s\n", hash, util.PrettyPrint(block))
+		return
+	}
+	if verbosity == 0 {
+		fmt.Println(hex.EncodeToString(resp.Raw))
+		return
 	}
+	fmt.Println(util.PrettyPrint(resp.Block))
 }
 
 func getBlockCountCmdFunc(cmd *cobra.Command, args []string) {
@@ -269,21 +502,72 @@ func getBlockHashCmdFunc(cmd *cobra.Command, args []string) {
 	}
 }
 
-func getBlockHeaderCmdFunc(cmd *cobra.Command, args []string) {
-	fmt.Println("getblockheader called")
-	if len(args) == 0 {
-		fmt.Println("Parameter block hash required")
+func getMempoolInfoCmdFunc(cmd *cobra.Command, args []string) {
+	conn := client.NewConnectionWithViper(viper.GetViper())
+	defer conn.Close()
+	info, err := client.GetMempoolInfo(conn)
+	if err != nil {
+		fmt.Println(err)
 		return
 	}
-	hash := args[0]
+	fmt.Println(util.PrettyPrint(info))
+}
+
+func listMempoolTxsCmdFunc(cmd *cobra.Command, args []string) {
 	conn := client.NewConnectionWithViper(viper.GetViper())
 	defer conn.Close()
-	header, err := client.GetBlockHeader(conn, hash)
+	resp, err := client.ListMempoolTransactions(conn, mempoolVerbose)
 	if err != nil {
 		fmt.Println(err)
-	} else {
-		fmt.Printf("Block Header of hash %s is\n%s\n", hash, util.PrettyPrint(header))
+		return
+	}
+	if !mempoolVerbose {
+		for _, hash := range resp.Hashes {
+			fmt.Println(hash)
+		}
+		return
+	}
+	for _, entry := range resp.Entries {
+		fmt.Printf("%s\tsize: %d\tfee: %d\tfee per kb: %d\tage: %ds\n",
+			entry.Hash, entry.TxSize, entry.Fee, entry.FeePerKb, entry.Age)
+	}
+}
+
+func getNetworkInfoCmdFunc(cmd *cobra.Command, args []string) {
+	conn := client.NewConnectionWithViper(viper.GetViper())
+	defer conn.Close()
+	info, err := client.GetNetworkInfo(conn)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Println(util.PrettyPrint(info))
+}
+
+func getNodeStatusCmdFunc(cmd *cobra.Command, args []string) {
+	conn := client.NewConnectionWithViper(viper.GetViper())
+	defer conn.Close()
+	status, err := client.GetNodeStatus(conn)
+	if err != nil {
+		fmt.Println(err)
+		return
 	}
+	fmt.Println(util.PrettyPrint(status))
+}
+
+func getPeerInfoCmdFunc(cmd *cobra.Command, args []string) {
+	id := ""
+	if len(args) > 0 {
+		id = args[0]
+	}
+	conn := client.NewConnectionWithViper(viper.GetViper())
+	defer conn.Close()
+	peers, err := client.GetPeerInfo(conn, id)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Println(util.PrettyPrint(peers))
 }
 
 func getRawTxCmdFunc(cmd *cobra.Command, args []string) {
@@ -296,12 +580,84 @@ func getRawTxCmdFunc(cmd *cobra.Command, args []string) {
 	hash.SetString(args[0])
 	conn := client.NewConnectionWithViper(viper.GetViper())
 	defer conn.Close()
-	tx, err := client.GetRawTransaction(conn, hash.GetBytes())
+	resp, err := client.GetRawTransactionInfo(conn, hash.GetBytes())
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Println(util.PrettyPrint(resp.Tx))
+	fmt.Println("Raw Tx:", hex.EncodeToString(resp.Raw))
+	fmt.Printf("Block: %s, height: %d, confirmations: %d\n", resp.BlockHash, resp.BlockHeight, resp.Confirmations)
+}
+
+func getTxDetailCmdFunc(cmd *cobra.Command, args []string) {
+	if len(args) < 1 {
+		fmt.Println("Param txhash required")
+		return
+	}
+	conn := client.NewConnectionWithViper(viper.GetViper())
+	defer conn.Close()
+	detail, err := client.GetTransactionDetail(conn, args[0])
 	if err != nil {
 		fmt.Println(err)
 	} else {
-		fmt.Println(util.PrettyPrint(tx))
+		fmt.Println(util.PrettyPrint(detail))
+	}
+}
+
+func estimateFeeCmdFunc(cmd *cobra.Command, args []string) {
+	var target uint64 = 1
+	if len(args) > 0 {
+		t, err := strconv.ParseUint(args[0], 10, 32)
+		if err != nil {
+			fmt.Println("Invalid target blocks: ", args[0])
+			return
+		}
+		target = t
+	}
+	conn := client.NewConnectionWithViper(viper.GetViper())
+	defer conn.Close()
+	price, err := client.EstimateFee(conn, uint32(target))
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Printf("Fee price: %d box per byte\n", price)
+}
+
+func createRawTxCmdFunc(cmd *cobra.Command, args []string) {
+	if len(args) < 3 || len(args)%2 == 0 {
+		fmt.Println("Invalid argument number")
+		return
+	}
+	fromAddr, err := types.NewAddress(args[0])
+	if err != nil {
+		fmt.Println("Invalid address: ", args[0])
+		return
+	}
+	targets := make([]*rpcpb.TxOutTarget, 0, len(args)/2)
+	for i := 1; i < len(args); i += 2 {
+		amount, err := strconv.ParseUint(args[i+1], 10, 64)
+		if err != nil {
+			fmt.Println("Invalid amount: ", args[i+1])
+			return
+		}
+		targets = append(targets, &rpcpb.TxOutTarget{Addr: args[i], Amount: amount})
+	}
+	conn := client.NewConnectionWithViper(viper.GetViper())
+	defer conn.Close()
+	tx, _, err := client.CreateRawTransaction(conn, fromAddr, targets)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	rawTx, err := tx.Marshal()
+	if err != nil {
+		fmt.Println(err)
+		return
 	}
+	fmt.Println(util.PrettyPrint(tx))
+	fmt.Println("Raw Tx:", hex.EncodeToString(rawTx))
 }
 
 func getTxPoolCmdFunc(cmd *cobra.Command, args []string) {
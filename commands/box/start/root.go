@@ -51,8 +51,17 @@ func init() {
 	startCmd.Flags().Bool("rpc", true, "start rpc server (default true).")
 	viper.BindPFlag("rpc.enabled", startCmd.Flags().Lookup("rpc"))
 
-	startCmd.Flags().String("database", "rocksdb", "database name [rocksdb|mem]")
+	startCmd.Flags().String("database", "rocksdb", "database name [rocksdb|badgerdb|memdb]")
 	viper.BindPFlag("database.name", startCmd.Flags().Lookup("database"))
 
+	startCmd.Flags().String("restore", "", "restore database from a backup directory before starting, the database must be empty.")
+	viper.BindPFlag("database.restore", startCmd.Flags().Lookup("restore"))
+
+	startCmd.Flags().String("verifydb", "", "verify chain data in database before starting [check|repair].")
+	viper.BindPFlag("verifydb", startCmd.Flags().Lookup("verifydb"))
+
+	startCmd.Flags().Bool("ephemeral", false, "keep chain data in memory only, which is lost on exit.")
+	viper.BindPFlag("ephemeral", startCmd.Flags().Lookup("ephemeral"))
+
 	viper.SetDefault("p2p.key_path", "peer.key")
 }
@@ -23,6 +23,28 @@ var cfgFile string
 var walletDir string
 var defaultWalletDir = path.Join(util.HomeDir(), ".box_keystore")
 
+var (
+	listTokensOffset uint32
+	listTokensLimit  uint32
+)
+
+var (
+	tokenTxsOffset uint32
+	tokenTxsLimit  uint32
+)
+
+var tokenHistoryCmd = &cobra.Command{
+	Use:   "history [token_tx_hash] [token_tx_out_idx]",
+	Short: "List txs issuing or transferring a token in chain order",
+	Run:   tokenHistoryCmdFunc,
+}
+
+var listTokensCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List tokens issued on the chain",
+	Run:   listTokensCmdFunc,
+}
+
 // rootCmd represents the base command when called without any subcommands
 var rootCmd = &cobra.Command{
 	Use:   "token",
@@ -58,7 +80,13 @@ func init() {
 			Short: "get token balance",
 			Run:   getTokenBalanceCmdFunc,
 		},
+		listTokensCmd,
+		tokenHistoryCmd,
 	)
+	listTokensCmd.Flags().Uint32Var(&listTokensOffset, "offset", 0, "Number of tokens to skip")
+	listTokensCmd.Flags().Uint32Var(&listTokensLimit, "limit", 0, "Max number of tokens to list, 0 means 100")
+	tokenHistoryCmd.Flags().Uint32Var(&tokenTxsOffset, "offset", 0, "Number of txs to skip")
+	tokenHistoryCmd.Flags().Uint32Var(&tokenTxsLimit, "limit", 0, "Max number of txs to list, 0 means 100")
 }
 
 func createTokenCmdFunc(cmd *cobra.Command, args []string) {
@@ -186,6 +214,41 @@ func getTokenBalanceCmdFunc(cmd *cobra.Command, args []string) {
 	fmt.Printf("Token balance of %s: %d\n", args[0], balance)
 }
 
+func listTokensCmdFunc(cmd *cobra.Command, args []string) {
+	conn := client.NewConnectionWithViper(viper.GetViper())
+	defer conn.Close()
+	tokens, total, err := client.ListTokens(conn, listTokensOffset, listTokensLimit)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Println(util.PrettyPrint(tokens))
+	fmt.Printf("Listed %d of %d tokens\n", len(tokens), total)
+}
+
+func tokenHistoryCmdFunc(cmd *cobra.Command, args []string) {
+	if len(args) != 2 {
+		fmt.Println("Invalid argument number")
+		return
+	}
+	tokenTxHash := &crypto.HashType{}
+	err1 := tokenTxHash.SetString(args[0])
+	tokenTxOutIdx, err2 := strconv.Atoi(args[1])
+	if err1 != nil || err2 != nil {
+		fmt.Println("Invalid argument format")
+		return
+	}
+	conn := client.NewConnectionWithViper(viper.GetViper())
+	defer conn.Close()
+	txs, total, err := client.GetTokenTransactions(conn, tokenTxHash, uint32(tokenTxOutIdx), tokenTxsOffset, tokenTxsLimit)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Println(util.PrettyPrint(txs))
+	fmt.Printf("Listed %d of %d txs\n", len(txs), total)
+}
+
 func parseSendTarget(args []string) (map[types.Address]uint64, error) {
 	targets := make(map[types.Address]uint64)
 	for i := 0; i < len(args)/2; i++ {
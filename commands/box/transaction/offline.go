@@ -0,0 +1,239 @@
+// Copyright (c) 2018 ContentBox Authors.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package transactioncmd
+
+import (
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"strings"
+
+	corepb "github.com/BOXFoundation/boxd/core/pb"
+	"github.com/BOXFoundation/boxd/core/types"
+	"github.com/BOXFoundation/boxd/rpc/client"
+	"github.com/BOXFoundation/boxd/rpc/pb"
+	"github.com/BOXFoundation/boxd/wallet"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"golang.org/x/crypto/ssh/terminal"
+)
+
+// Transactions are passed between create, sign and broadcast as files of
+// base64 encoded partially signed transactions, so that they can be signed
+// on a machine without network access
+
+var (
+	createChangeAddr  string
+	createFeePerByte  uint64
+	createReplaceable bool
+	txOutFile         string
+)
+
+var createCmd = &cobra.Command{
+	Use:   "create [fromaddress] [toaddress] [amount] [[toaddress] [amount]]...",
+	Short: "Create an unsigned transaction with the node, to sign offline",
+	Run:   createCmdFunc,
+}
+
+var signCmd = &cobra.Command{
+	Use:   "sign [file]",
+	Short: "Sign a transaction read from file, or stdin if -, with local keystore files, which works offline",
+	Run:   signCmdFunc,
+}
+
+var broadcastCmd = &cobra.Command{
+	Use:   "broadcast [file]",
+	Short: "Finalize a signed transaction read from file, or stdin if -, and send it to the node",
+	Run:   broadcastCmdFunc,
+}
+
+func createCmdFunc(cmd *cobra.Command, args []string) {
+	if len(args) < 3 || len(args)%2 == 0 {
+		fmt.Fprintln(os.Stderr, "Invalid argument number")
+		return
+	}
+	from, err := types.NewAddress(args[0])
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "Invalid address", err)
+		return
+	}
+	targets, err := parseSendTarget(args[1:])
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	req := &rpcpb.CreateRawTransactionRequest{
+		From:        from.String(),
+		ChangeAddr:  createChangeAddr,
+		FeePerByte:  createFeePerByte,
+		Replaceable: createReplaceable,
+	}
+	// keep outputs in the order given, which the targets map loses
+	for i := 1; i < len(args); i += 2 {
+		addr, _ := types.NewAddress(args[i])
+		req.Outputs = append(req.Outputs, &rpcpb.TxOutTarget{Addr: addr.String(), Amount: targets[addr]})
+	}
+	conn := client.NewConnectionWithViper(viper.GetViper())
+	defer conn.Close()
+	r, err := client.CreateRawTransactionWithOptions(conn, req)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	p, err := newCreatedPSBT(r)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	fmt.Fprintln(os.Stderr, "Fee:", r.Fee)
+	if err := writePSBT(os.Stdout, p); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+	}
+}
+
+func signCmdFunc(cmd *cobra.Command, args []string) {
+	if len(args) < 1 {
+		fmt.Fprintln(os.Stderr, "Param file required")
+		return
+	}
+	p, err := readPSBT(args[0])
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	signed, err := signPSBTLocally(p)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	fmt.Fprintln(os.Stderr, "Signed inputs:", signed)
+	if err := writePSBT(os.Stdout, p); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+	}
+}
+
+func broadcastCmdFunc(cmd *cobra.Command, args []string) {
+	if len(args) < 1 {
+		fmt.Println("Param file required")
+		return
+	}
+	content, err := readTxFile(args[0])
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	tx, err := decodeSignedTx(content)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	conn := client.NewConnectionWithViper(viper.GetViper())
+	defer conn.Close()
+	if err := client.SendRawTransaction(conn, tx); err != nil {
+		fmt.Println(err)
+		return
+	}
+	hash, _ := tx.TxHash()
+	fmt.Println("Tx Hash:", hash.String())
+}
+
+// newCreatedPSBT returns the partially signed transaction of the tx created
+// by the node, with the outputs it spends
+func newCreatedPSBT(r *rpcpb.CreateRawTransactionResponse) (*wallet.PSBT, error) {
+	tx := &types.Transaction{}
+	if err := tx.FromProtoMessage(r.Tx); err != nil {
+		return nil, err
+	}
+	utxos := make([]*corepb.TxOut, 0, len(r.Utxos))
+	for _, utxo := range r.Utxos {
+		utxos = append(utxos, utxo.TxOut)
+	}
+	return wallet.NewPSBT(tx, utxos)
+}
+
+// decodeSignedTx returns the transaction to broadcast from content, a signed
+// raw transaction in hex or a fully signed psbt
+func decodeSignedTx(content string) (*types.Transaction, error) {
+	tx := &types.Transaction{}
+	if rawTx, err := hex.DecodeString(content); err == nil && tx.Unmarshal(rawTx) == nil {
+		return tx, nil
+	}
+	p, err := wallet.DecodePSBT(content)
+	if err != nil {
+		return nil, fmt.Errorf("invalid transaction: %v", err)
+	}
+	complete, err := p.Finalize()
+	if err != nil {
+		return nil, err
+	}
+	if !complete {
+		return nil, fmt.Errorf("transaction is not fully signed")
+	}
+	return p.Extract()
+}
+
+// readTxFile returns the trimmed content of file, or of stdin if file is -
+func readTxFile(file string) (string, error) {
+	var content []byte
+	var err error
+	if file == "-" {
+		content, err = ioutil.ReadAll(os.Stdin)
+	} else {
+		content, err = ioutil.ReadFile(file)
+	}
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(string(content)), nil
+}
+
+// readPSBT reads a partially signed transaction from file, or stdin if file
+// is -
+func readPSBT(file string) (*wallet.PSBT, error) {
+	content, err := readTxFile(file)
+	if err != nil {
+		return nil, err
+	}
+	p, err := wallet.DecodePSBT(content)
+	if err != nil {
+		return nil, fmt.Errorf("invalid psbt: %v", err)
+	}
+	return p, nil
+}
+
+// writePSBT writes p to the output file if set, or w otherwise. Nothing but
+// p is written to w, so that it can be piped to the next command
+func writePSBT(w io.Writer, p *wallet.PSBT) error {
+	encoded, err := p.Encode()
+	if err != nil {
+		return err
+	}
+	if txOutFile == "" {
+		_, err := fmt.Fprintln(w, encoded)
+		return err
+	}
+	if err := ioutil.WriteFile(txOutFile, []byte(encoded+"\n"), 0600); err != nil {
+		return err
+	}
+	fmt.Fprintln(os.Stderr, "Transaction written to", txOutFile)
+	return nil
+}
+
+// readPassphrase reads passphrase from the terminal rather than stdin, which
+// may be piped a transaction. Prompts go to stderr, keeping stdout for it
+var readPassphrase = func() (string, error) {
+	fmt.Fprintln(os.Stderr, "Please Input Your Passphrase")
+	tty, err := os.Open("/dev/tty")
+	if err != nil {
+		// no controlling terminal, as on windows
+		input, err := terminal.ReadPassword(int(os.Stdin.Fd()))
+		return string(input), err
+	}
+	defer tty.Close()
+	input, err := terminal.ReadPassword(int(tty.Fd()))
+	return string(input), err
+}
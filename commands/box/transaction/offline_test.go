@@ -0,0 +1,86 @@
+// Copyright (c) 2018 ContentBox Authors.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package transactioncmd
+
+import (
+	"bytes"
+	"encoding/hex"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	corepb "github.com/BOXFoundation/boxd/core/pb"
+	"github.com/BOXFoundation/boxd/core/types"
+	"github.com/BOXFoundation/boxd/crypto"
+	"github.com/BOXFoundation/boxd/rpc/pb"
+	"github.com/BOXFoundation/boxd/script"
+	"github.com/BOXFoundation/boxd/wallet"
+	"github.com/facebookgo/ensure"
+)
+
+func TestOfflineRoundTrip(t *testing.T) {
+	dir, err := ioutil.TempDir("", "offline")
+	ensure.Nil(t, err)
+	defer os.RemoveAll(dir)
+	walletDir, txOutFile = dir, ""
+	readPassphrase = func() (string, error) { return "passphrase", nil }
+
+	wltMgr, err := wallet.NewWalletManager(dir)
+	ensure.Nil(t, err)
+	pubKeyHash, _, err := wltMgr.NewAccount("passphrase")
+	ensure.Nil(t, err)
+	pkHash, err := hex.DecodeString(pubKeyHash)
+	ensure.Nil(t, err)
+	scriptPubKey := *script.PayToPubKeyHashScript(pkHash)
+
+	// the node creates tx spending an output of the account
+	tx := &types.Transaction{
+		Vin: []*types.TxIn{{
+			PrevOutPoint: types.OutPoint{Hash: crypto.DoubleHashH([]byte("prev"))},
+			Sequence:     types.SequenceFinal,
+		}},
+		Vout: []*corepb.TxOut{{Value: 90, ScriptPubKey: scriptPubKey}},
+	}
+	msg, err := tx.ToProtoMessage()
+	ensure.Nil(t, err)
+	resp := &rpcpb.CreateRawTransactionResponse{
+		Tx:    msg.(*corepb.Transaction),
+		Utxos: []*rpcpb.Utxo{{TxOut: &corepb.TxOut{Value: 100, ScriptPubKey: scriptPubKey}}},
+		Fee:   10,
+	}
+
+	// create writes nothing but the psbt, which sign reads from stdin or file
+	p, err := newCreatedPSBT(resp)
+	ensure.Nil(t, err)
+	created := new(bytes.Buffer)
+	ensure.Nil(t, writePSBT(created, p))
+	ensure.DeepEqual(t, strings.Count(created.String(), "\n"), 1)
+	unsignedFile := filepath.Join(dir, "unsigned")
+	ensure.Nil(t, ioutil.WriteFile(unsignedFile, created.Bytes(), 0600))
+	_, err = decodeSignedTx(strings.TrimSpace(created.String()))
+	ensure.NotNil(t, err)
+
+	p, err = readPSBT(unsignedFile)
+	ensure.Nil(t, err)
+	signed, err := signPSBTLocally(p)
+	ensure.Nil(t, err)
+	ensure.DeepEqual(t, signed, 1)
+	signedOut := new(bytes.Buffer)
+	ensure.Nil(t, writePSBT(signedOut, p))
+
+	// broadcast takes the signed psbt as well as the raw tx it finalizes to
+	signedTx, err := decodeSignedTx(strings.TrimSpace(signedOut.String()))
+	ensure.Nil(t, err)
+	ensure.True(t, len(signedTx.Vin[0].ScriptSig) > 0)
+	rawTx, err := signedTx.Marshal()
+	ensure.Nil(t, err)
+	decoded, err := decodeSignedTx(hex.EncodeToString(rawTx))
+	ensure.Nil(t, err)
+	hash, _ := signedTx.TxHash()
+	decodedHash, _ := decoded.TxHash()
+	ensure.DeepEqual(t, decodedHash, hash)
+}
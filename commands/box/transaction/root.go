@@ -5,9 +5,12 @@
 package transactioncmd
 
 import (
+	"encoding/hex"
 	"fmt"
+	"os"
 	"path"
 	"strconv"
+	"strings"
 
 	root "github.com/BOXFoundation/boxd/commands/box/root"
 	"github.com/BOXFoundation/boxd/core/types"
@@ -20,6 +23,32 @@ import (
 
 var cfgFile string
 var walletDir string
+var signerAddr string
+var signerTLSCert string
+
+var (
+	psbtRedeemScripts []string
+	psbtSignByNode    bool
+	psbtSend          bool
+)
+
+var createPSBTCmd = &cobra.Command{
+	Use:   "createpsbt [rawtx]",
+	Short: "Create a partially signed transaction from an unsigned raw transaction for offline signing",
+	Run:   createPSBTCmdFunc,
+}
+
+var signPSBTCmd = &cobra.Command{
+	Use:   "signpsbt [psbt]",
+	Short: "Sign a partially signed transaction with local accounts, which works offline",
+	Run:   signPSBTCmdFunc,
+}
+
+var finalizePSBTCmd = &cobra.Command{
+	Use:   "finalizepsbt [psbt]",
+	Short: "Finalize a partially signed transaction and extract the signed raw transaction",
+	Run:   finalizePSBTCmdFunc,
+}
 var defaultWalletDir = path.Join(util.HomeDir(), ".box_keystore")
 
 // rootCmd represents the base command when called without any subcommands
@@ -41,6 +70,8 @@ to quickly create a Cobra application.`,
 func init() {
 	root.RootCmd.AddCommand(rootCmd)
 	rootCmd.PersistentFlags().StringVar(&walletDir, "wallet_dir", defaultWalletDir, "Specify directory to search keystore files")
+	rootCmd.PersistentFlags().StringVar(&signerAddr, "signer", "", "Specify host:port of the signing daemon holding keys of external accounts")
+	rootCmd.PersistentFlags().StringVar(&signerTLSCert, "signer_tls_cert", "", "Specify certificate file of the signing daemon, required unless it's on a loopback address")
 	rootCmd.AddCommand(
 		&cobra.Command{
 			Use:   "listutxos",
@@ -66,12 +97,29 @@ func init() {
 		},
 		&cobra.Command{
 			Use:   "signrawtx [rawtx]",
-			Short: "Sign a transaction with privatekey and send it to the network",
-			Run: func(cmd *cobra.Command, args []string) {
-				fmt.Println("signrawtx called")
-			},
+			Short: "Sign a raw transaction with accounts unlocked on the node",
+			Run:   signRawTxCmdFunc,
 		},
+		createPSBTCmd,
+		signPSBTCmd,
+		&cobra.Command{
+			Use:   "mergepsbt [psbt]...",
+			Short: "Merge signatures of partially signed transactions of the same transaction",
+			Run:   mergePSBTCmdFunc,
+		},
+		finalizePSBTCmd,
+		createCmd,
+		signCmd,
+		broadcastCmd,
 	)
+	createPSBTCmd.Flags().StringSliceVar(&psbtRedeemScripts, "redeem_script", nil, "Redeem script of a p2sh input in format index:hex, repeatable")
+	signPSBTCmd.Flags().BoolVar(&psbtSignByNode, "node", false, "Sign with accounts unlocked on the node instead of local ones")
+	finalizePSBTCmd.Flags().BoolVar(&psbtSend, "send", false, "Send the transaction to the node once finalized")
+	createCmd.Flags().StringVar(&createChangeAddr, "change", "", "Address to send change to, the sender by default")
+	createCmd.Flags().Uint64Var(&createFeePerByte, "fee_per_byte", 0, "Fee price in box per byte, the node fee price by default")
+	createCmd.Flags().BoolVar(&createReplaceable, "replaceable", false, "Allow the transaction to be replaced in mempool by one paying more")
+	createCmd.Flags().StringVar(&txOutFile, "out", "", "File to write the unsigned transaction to instead of stdout")
+	signCmd.Flags().StringVar(&txOutFile, "out", "", "File to write the signed transaction to instead of stdout")
 }
 
 func listAllUtxoCmdFunc(cmd *cobra.Command, args []string) {
@@ -96,25 +144,12 @@ func sendFromCmdFunc(cmd *cobra.Command, args []string) {
 		fmt.Println(err)
 		return
 	}
-	wltMgr, err := wallet.NewWalletManager(walletDir)
-	if err != nil {
-		fmt.Println(err)
-		return
-	}
-	account, exists := wltMgr.GetAccount(args[0])
-	if !exists {
-		fmt.Printf("Account %s not managed\n", args[0])
-		return
-	}
-	passphrase, err := wallet.ReadPassphraseStdin()
+	account, closeSigner, err := loadSigningAccount(args[0])
 	if err != nil {
 		fmt.Println(err)
 		return
 	}
-	if err := account.UnlockWithPassphrase(passphrase); err != nil {
-		fmt.Println("Fail to unlock account", err)
-		return
-	}
+	defer closeSigner()
 	fromAddr, err := types.NewAddress(args[0])
 	if err != nil {
 		fmt.Println("Invalid address: ", args[0])
@@ -158,37 +193,286 @@ func sendManyCmdFunc(cmd *cobra.Command, args []string) {
 		fmt.Println(err)
 		return
 	}
-	wltMgr, err := wallet.NewWalletManager(walletDir)
+	account, closeSigner, err := loadSigningAccount(args[0])
 	if err != nil {
 		fmt.Println(err)
 		return
 	}
-	account, exists := wltMgr.GetAccount(args[0])
-	if !exists {
-		fmt.Printf("Account %s not managed\n", args[0])
+	defer closeSigner()
+	fromAddr, err := types.NewAddress(args[0])
+	if err != nil {
+		fmt.Println("Invalid address: ", args[0])
+	}
+	conn := client.NewConnectionWithViper(viper.GetViper())
+	defer conn.Close()
+	tx, err := client.CreateTransaction(conn, fromAddr, target, account.PublicKey(), account)
+	if err != nil {
+		fmt.Println(err)
+	} else {
+		hash, _ := tx.TxHash()
+		fmt.Println("Tx Hash:", hash.String())
+		fmt.Println(util.PrettyPrint(tx))
+	}
+}
+
+func signRawTxCmdFunc(cmd *cobra.Command, args []string) {
+	if len(args) < 1 {
+		fmt.Println("Param rawtx required")
 		return
 	}
-	passphrase, err := wallet.ReadPassphraseStdin()
+	rawTx, err := hex.DecodeString(args[0])
+	if err != nil {
+		fmt.Println("Invalid raw tx", err)
+		return
+	}
+	tx := &types.Transaction{}
+	if err := tx.Unmarshal(rawTx); err != nil {
+		fmt.Println("Invalid raw tx", err)
+		return
+	}
+	conn := client.NewConnectionWithViper(viper.GetViper())
+	defer conn.Close()
+	signedTx, complete, err := client.SignRawTransaction(conn, tx)
 	if err != nil {
 		fmt.Println(err)
 		return
 	}
+	signedRawTx, err := signedTx.Marshal()
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Println("Complete:", complete)
+	fmt.Println("Raw Tx:", hex.EncodeToString(signedRawTx))
+}
+
+// loadSigningAccount returns the account of address ready to sign, which is
+// unlocked with passphrase from stdin, or routed to the signing daemon if its
+// key is held there. The returned func closes the connection to the daemon
+func loadSigningAccount(address string) (*wallet.Account, func(), error) {
+	wltMgr, err := wallet.NewWalletManager(walletDir)
+	if err != nil {
+		return nil, nil, err
+	}
+	closeSigner := func() {}
+	if signerAddr != "" {
+		signer, err := wallet.NewRemoteSigner(&wallet.RemoteSignerConfig{Address: signerAddr, TLSCert: signerTLSCert})
+		if err != nil {
+			return nil, nil, err
+		}
+		closeSigner = func() { signer.Close() }
+		if _, err := wltMgr.AttachSigner(signer); err != nil {
+			closeSigner()
+			return nil, nil, err
+		}
+	}
+	account, exists := wltMgr.GetAccount(address)
+	if !exists {
+		closeSigner()
+		return nil, nil, fmt.Errorf("Account %s not managed", address)
+	}
+	if account.External() {
+		return account, closeSigner, nil
+	}
+	passphrase, err := wallet.ReadPassphraseStdin()
+	if err != nil {
+		closeSigner()
+		return nil, nil, err
+	}
 	if err := account.UnlockWithPassphrase(passphrase); err != nil {
-		fmt.Println("Fail to unlock account", err)
+		closeSigner()
+		return nil, nil, fmt.Errorf("Fail to unlock account %v", err)
+	}
+	return account, closeSigner, nil
+}
+
+func createPSBTCmdFunc(cmd *cobra.Command, args []string) {
+	if len(args) < 1 {
+		fmt.Println("Param rawtx required")
 		return
 	}
-	fromAddr, err := types.NewAddress(args[0])
+	rawTx, err := hex.DecodeString(args[0])
 	if err != nil {
-		fmt.Println("Invalid address: ", args[0])
+		fmt.Println("Invalid raw tx", err)
+		return
+	}
+	tx := &types.Transaction{}
+	if err := tx.Unmarshal(rawTx); err != nil {
+		fmt.Println("Invalid raw tx", err)
+		return
+	}
+	redeemScripts := make(map[uint32][]byte)
+	for _, arg := range psbtRedeemScripts {
+		parts := strings.SplitN(arg, ":", 2)
+		if len(parts) != 2 {
+			fmt.Println("Invalid redeem script, index:hex expected:", arg)
+			return
+		}
+		index, err := strconv.ParseUint(parts[0], 10, 32)
+		if err != nil {
+			fmt.Println("Invalid redeem script index", err)
+			return
+		}
+		if redeemScripts[uint32(index)], err = hex.DecodeString(parts[1]); err != nil {
+			fmt.Println("Invalid redeem script", err)
+			return
+		}
 	}
 	conn := client.NewConnectionWithViper(viper.GetViper())
 	defer conn.Close()
-	tx, err := client.CreateTransaction(conn, fromAddr, target, account.PublicKey(), account)
+	psbt, err := client.CreatePSBT(conn, tx, redeemScripts)
 	if err != nil {
 		fmt.Println(err)
-	} else {
-		hash, _ := tx.TxHash()
-		fmt.Println("Tx Hash:", hash.String())
-		fmt.Println(util.PrettyPrint(tx))
+		return
+	}
+	fmt.Println("PSBT:", psbt)
+}
+
+func signPSBTCmdFunc(cmd *cobra.Command, args []string) {
+	if len(args) < 1 {
+		fmt.Println("Param psbt required")
+		return
+	}
+	if psbtSignByNode {
+		conn := client.NewConnectionWithViper(viper.GetViper())
+		defer conn.Close()
+		psbt, signed, err := client.SignPSBT(conn, args[0])
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Println("Signed inputs:", signed)
+		fmt.Println("PSBT:", psbt)
+		return
+	}
+	p, err := wallet.DecodePSBT(args[0])
+	if err != nil {
+		fmt.Println("Invalid psbt", err)
+		return
+	}
+	signed, err := signPSBTLocally(p)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	printPSBT(p)
+	fmt.Println("Signed inputs:", signed)
+}
+
+// signPSBTLocally signs inputs of p with accounts in keystore files of the
+// wallet directory, or held by the signing daemon if set, without the node.
+// It returns the number of inputs signed
+func signPSBTLocally(p *wallet.PSBT) (int, error) {
+	wltMgr, err := wallet.NewWalletManager(walletDir)
+	if err != nil {
+		return 0, err
+	}
+	if signerAddr != "" {
+		signer, err := wallet.NewRemoteSigner(&wallet.RemoteSignerConfig{Address: signerAddr, TLSCert: signerTLSCert})
+		if err != nil {
+			return 0, err
+		}
+		defer signer.Close()
+		if _, err := wltMgr.AttachSigner(signer); err != nil {
+			return 0, err
+		}
+	}
+	// the passphrase is asked once, when a local key is needed
+	var passphrase *string
+	return p.SignInputs(func(addr types.Address) (*wallet.Account, bool) {
+		acc, ok := wltMgr.GetAccount(addr.String())
+		if !ok || acc.WatchOnly() {
+			return nil, false
+		}
+		if acc.External() {
+			return acc, true
+		}
+		if passphrase == nil {
+			input, err := readPassphrase()
+			if err != nil {
+				fmt.Fprintln(os.Stderr, err)
+				return nil, false
+			}
+			passphrase = &input
+		}
+		if err := acc.UnlockWithPassphrase(*passphrase); err != nil {
+			fmt.Fprintln(os.Stderr, "Fail to unlock account", addr, err)
+			return nil, false
+		}
+		return acc, true
+	})
+}
+
+func mergePSBTCmdFunc(cmd *cobra.Command, args []string) {
+	if len(args) < 2 {
+		fmt.Println("At least 2 psbts required")
+		return
+	}
+	psbts := make([]*wallet.PSBT, 0, len(args))
+	for _, arg := range args {
+		p, err := wallet.DecodePSBT(arg)
+		if err != nil {
+			fmt.Println("Invalid psbt", err)
+			return
+		}
+		psbts = append(psbts, p)
+	}
+	if err := psbts[0].Merge(psbts[1:]...); err != nil {
+		fmt.Println(err)
+		return
+	}
+	printPSBT(psbts[0])
+}
+
+func finalizePSBTCmdFunc(cmd *cobra.Command, args []string) {
+	if len(args) < 1 {
+		fmt.Println("Param psbt required")
+		return
+	}
+	p, err := wallet.DecodePSBT(args[0])
+	if err != nil {
+		fmt.Println("Invalid psbt", err)
+		return
+	}
+	complete, err := p.Finalize()
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Println("Complete:", complete)
+	if !complete {
+		printPSBT(p)
+		return
+	}
+	tx, err := p.Extract()
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	rawTx, err := tx.Marshal()
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Println("Raw Tx:", hex.EncodeToString(rawTx))
+	if !psbtSend {
+		return
+	}
+	conn := client.NewConnectionWithViper(viper.GetViper())
+	defer conn.Close()
+	if err := client.SendRawTransaction(conn, tx); err != nil {
+		fmt.Println(err)
+		return
+	}
+	hash, _ := tx.TxHash()
+	fmt.Println("Tx Hash:", hash.String())
+}
+
+func printPSBT(p *wallet.PSBT) {
+	encoded, err := p.Encode()
+	if err != nil {
+		fmt.Println(err)
+		return
 	}
+	fmt.Println("PSBT:", encoded)
 }
@@ -9,10 +9,13 @@ import (
 	"fmt"
 	"path"
 	"strconv"
+	"strings"
+	"time"
 
 	root "github.com/BOXFoundation/boxd/commands/box/root"
 	"github.com/BOXFoundation/boxd/crypto"
 	"github.com/BOXFoundation/boxd/rpc/client"
+	"github.com/BOXFoundation/boxd/rpc/pb"
 	"github.com/BOXFoundation/boxd/util"
 	"github.com/BOXFoundation/boxd/wallet"
 	"github.com/spf13/cobra"
@@ -23,6 +26,80 @@ var cfgFile string
 var walletDir string
 var defaultWalletDir = path.Join(util.HomeDir(), ".box_keystore")
 
+var (
+	txDirection string
+	txStartTime int64
+	txEndTime   int64
+	txMinAmount uint64
+	txTokenOnly bool
+	txWallet    bool
+	txAll       bool
+)
+
+var (
+	mnemonicPassphrase string
+	gapLimit           uint32
+)
+
+var accountLabel string
+
+var multisigByNode bool
+
+var consolidateReq = &rpcpb.ConsolidateUtxosRequest{}
+
+var (
+	issueTokenReq    = &rpcpb.IssueTokenRequest{}
+	transferTokenReq = &rpcpb.TransferTokenRequest{}
+)
+
+var consolidateCmd = &cobra.Command{
+	Use:   "consolidate [address]",
+	Short: "Sweep small utxos of an account unlocked on the node into single outputs",
+	Run:   consolidateCmdFunc,
+}
+
+var issueTokenCmd = &cobra.Command{
+	Use:   "issuetoken [address] [name] [supply]",
+	Short: "Issue a token from an account unlocked on the node",
+	Run:   issueTokenCmdFunc,
+}
+
+var transferTokenCmd = &cobra.Command{
+	Use:   "transfertoken [address] [tokenhash] [tokenindex] [to] [amount]",
+	Short: "Transfer tokens from an account unlocked on the node",
+	Run:   transferTokenCmdFunc,
+}
+
+var createMultisigCmd = &cobra.Command{
+	Use:   "createmultisig [m] [participant]...",
+	Short: "Combine hex public keys or addresses of local accounts of participants into an m-of-n multisig address",
+	Run:   createMultisigCmdFunc,
+}
+
+var listAccountsCmd = &cobra.Command{
+	Use:   "listaccounts",
+	Short: "List local accounts",
+	Run:   listAccountCmdFunc,
+}
+
+var createHDWalletCmd = &cobra.Command{
+	Use:   "createhdwallet [words]",
+	Short: "Create a hd wallet from a random 12 or 24 words mnemonic, accounts are derived from it afterwards",
+	Run:   createHDWalletCmdFunc,
+}
+
+var importMnemonicCmd = &cobra.Command{
+	Use:   "importmnemonic [word]...",
+	Short: "Restore the hd wallet managed by the node from a mnemonic and rescan the chain",
+	Run:   importMnemonicCmdFunc,
+}
+
+var listTransactionsCmd = &cobra.Command{
+	Use:   "listtransactions [account] [limit] [cursor]",
+	Short: "List transactions for an account",
+	Run:   listTransactionsCmdFunc,
+}
+
 // rootCmd represents the base command when called without any subcommands
 var rootCmd = &cobra.Command{
 	Use:   "wallet",
@@ -93,11 +170,7 @@ func init() {
 				fmt.Println("importwallet called")
 			},
 		},
-		&cobra.Command{
-			Use:   "listaccounts",
-			Short: "List local accounts",
-			Run:   listAccountCmdFunc,
-		},
+		listAccountsCmd,
 		&cobra.Command{
 			Use:   "listreceivedbyaccount",
 			Short: "List received transactions groups by account",
@@ -112,12 +185,94 @@ func init() {
 				fmt.Println("listreceivedbyaddress called")
 			},
 		},
+		listTransactionsCmd,
+		&cobra.Command{
+			Use:   "unlockaccount [address] [timeout]",
+			Short: "Unlock an account managed by the node for timeout seconds",
+			Run:   unlockAccountCmdFunc,
+		},
+		&cobra.Command{
+			Use:   "lockaccount [address]",
+			Short: "Lock an account managed by the node",
+			Run:   lockAccountCmdFunc,
+		},
+		createHDWalletCmd,
+		&cobra.Command{
+			Use:   "importhdseed [seed]",
+			Short: "Create a hd wallet from a seed in hex format",
+			Run:   importHDSeedCmdFunc,
+		},
+		&cobra.Command{
+			Use:   "newhdaccount",
+			Short: "Derive a new hd account",
+			Run:   newHDAccountCmdFunc,
+		},
+		&cobra.Command{
+			Use:   "deriveaddress [account] [change]",
+			Short: "Derive a fresh receive or change address of a hd account managed by the node",
+			Run:   deriveAddressCmdFunc,
+		},
+		&cobra.Command{
+			Use:   "scanhdwallet [gap_limit]",
+			Short: "Scan the chain for used addresses of the hd wallet managed by the node",
+			Run:   scanHDWalletCmdFunc,
+		},
+		importMnemonicCmd,
+		&cobra.Command{
+			Use:   "importaddress [address]",
+			Short: "Import an address as watch-only into the wallet managed by the node",
+			Run:   importAddressCmdFunc,
+		},
+		&cobra.Command{
+			Use:   "changepassphrase [address]",
+			Short: "Change the passphrase of an account managed by the node",
+			Run:   changePassphraseCmdFunc,
+		},
+		&cobra.Command{
+			Use:   "exportmnemonic",
+			Short: "Export the mnemonic of the hd wallet from local keystore files",
+			Run:   exportMnemonicCmdFunc,
+		},
 		&cobra.Command{
-			Use:   "listtransactions [account] [offset] [limit]",
-			Short: "List transactions for an account",
-			Run:   listTransactionsCmdFunc,
+			Use:   "setlabel [address] [label]",
+			Short: "Label an account managed by the node, an empty label clears it",
+			Run:   setLabelCmdFunc,
 		},
+		&cobra.Command{
+			Use:   "setnote [address] [key] [value]",
+			Short: "Set a note of an account managed by the node, an empty value removes it",
+			Run:   setNoteCmdFunc,
+		},
+		&cobra.Command{
+			Use:   "settxlabel [txhash] [label]",
+			Short: "Label a transaction of accounts managed by the node, an empty label clears it",
+			Run:   setTxLabelCmdFunc,
+		},
+		consolidateCmd,
+		issueTokenCmd,
+		transferTokenCmd,
+		createMultisigCmd,
 	)
+	listTransactionsCmd.Flags().StringVar(&txDirection, "direction", "all", "Filter transactions by direction: all, sent or received")
+	listTransactionsCmd.Flags().Int64Var(&txStartTime, "start", 0, "Only list transactions in blocks no earlier than the unix timestamp")
+	listTransactionsCmd.Flags().Int64Var(&txEndTime, "end", 0, "Only list transactions in blocks no later than the unix timestamp")
+	listTransactionsCmd.Flags().Uint64Var(&txMinAmount, "min_amount", 0, "Only list transactions moving at least the amount")
+	listTransactionsCmd.Flags().BoolVar(&txTokenOnly, "token_only", false, "Only list token transactions")
+	listTransactionsCmd.Flags().BoolVar(&txAll, "all", false, "Stream the whole history in chunks of limit transactions instead of a single page")
+	listTransactionsCmd.Flags().BoolVar(&txWallet, "wallet", false, "List transactions of all accounts managed by the node, watch-only ones included, the account param is ignored")
+	createHDWalletCmd.Flags().StringVar(&mnemonicPassphrase, "mnemonic_passphrase", "", "Optional BIP39 passphrase mixed into the seed")
+	importMnemonicCmd.Flags().StringVar(&mnemonicPassphrase, "mnemonic_passphrase", "", "Optional BIP39 passphrase mixed into the seed")
+	importMnemonicCmd.Flags().Uint32Var(&gapLimit, "gap_limit", 0, "Consecutive unused addresses the rescan stops at, 0 means 20")
+	listAccountsCmd.Flags().StringVar(&accountLabel, "label", "", "Only list accounts whose labels contain the text")
+	consolidateCmd.Flags().StringVar(&consolidateReq.ToAddr, "to", "", "Address utxos are swept to, the account itself if not set")
+	consolidateCmd.Flags().Uint64Var(&consolidateReq.FeePerByte, "fee", 0, "Fee price in box per byte, node fee price if not set")
+	consolidateCmd.Flags().Uint64Var(&consolidateReq.Threshold, "threshold", 0, "Only sweep utxos worth less, 0 sweeps all")
+	consolidateCmd.Flags().Uint32Var(&consolidateReq.MaxInputs, "max_inputs", 0, "Inputs per transaction, 0 means 100")
+	consolidateCmd.Flags().BoolVar(&consolidateReq.DryRun, "dry_run", false, "Show consolidation transactions without sending them")
+	issueTokenCmd.Flags().StringVar(&issueTokenReq.ToAddr, "to", "", "Address the supply is issued to, the account itself if not set")
+	issueTokenCmd.Flags().Uint64Var(&issueTokenReq.FeePerByte, "fee", 0, "Fee price in box per byte, node fee price if not set")
+	transferTokenCmd.Flags().Uint64Var(&transferTokenReq.FeePerByte, "fee", 0, "Fee price in box per byte, node fee price if not set")
+	createMultisigCmd.Flags().BoolVar(&multisigByNode, "node", false, "Look up participant addresses in accounts unlocked on the node instead of local ones")
 }
 
 func newAccountCmdFunc(cmd *cobra.Command, args []string) {
@@ -179,8 +334,25 @@ func listAccountCmdFunc(cmd *cobra.Command, args []string) {
 		fmt.Println(err)
 		return
 	}
-	for _, acc := range wltMgr.ListAccounts() {
-		fmt.Println("Managed Address:", acc.Addr(), "Public Key Hash:", hex.EncodeToString(acc.PubKeyHash()))
+	for _, acc := range wltMgr.FindAccountsByLabel(accountLabel) {
+		if acc.WatchOnly() {
+			fmt.Println("Watch-only Address:", acc.Addr(), "Public Key Hash:", hex.EncodeToString(acc.PubKeyHash()))
+		} else {
+			fmt.Println("Managed Address:", acc.Addr(), "Public Key Hash:", hex.EncodeToString(acc.PubKeyHash()))
+		}
+		meta, ok := wltMgr.AccountMeta(acc.Addr())
+		if !ok {
+			continue
+		}
+		if meta.Label != "" {
+			fmt.Println("  Label:", meta.Label)
+		}
+		if meta.Created != 0 {
+			fmt.Println("  Created:", time.Unix(meta.Created, 0).Format(time.RFC3339))
+		}
+		for k, v := range meta.Notes {
+			fmt.Printf("  %s: %s\n", k, v)
+		}
 	}
 }
 
@@ -210,39 +382,507 @@ func dumpPrivKeyCmdFunc(cmd *cobra.Command, args []string) {
 }
 
 func listTransactionsCmdFunc(cmd *cobra.Command, args []string) {
-	var addr string
-	var offset, limit uint32
-	if len(args) < 1 {
+	if len(args) < 1 && !txWallet {
 		fmt.Println("Param address required")
 		return
 	}
-	addr = args[0]
-	if len(args) > 2 {
-		uint64Val, err := strconv.ParseUint(args[2], 10, 32)
+	req := &rpcpb.ListTransactionsRequest{
+		Wallet:    txWallet,
+		Limit:     20,
+		StartTime: txStartTime,
+		EndTime:   txEndTime,
+		MinAmount: txMinAmount,
+		TokenOnly: txTokenOnly,
+	}
+	if len(args) > 0 && !txWallet {
+		req.Addr = args[0]
+	}
+	if len(args) > 1 {
+		uint64Val, err := strconv.ParseUint(args[1], 10, 32)
 		if err != nil {
 			fmt.Println("Invalid param limit", err)
 			return
 		}
-		offset = uint32(uint64Val)
-	} else {
-		limit = 20
+		req.Limit = uint32(uint64Val)
 	}
+	if len(args) > 2 {
+		req.Cursor = args[2]
+	}
+	direction, ok := rpcpb.TxDirection_value[strings.ToUpper(txDirection)]
+	if !ok {
+		fmt.Println("Invalid param direction", txDirection)
+		return
+	}
+	req.Direction = rpcpb.TxDirection(direction)
+	conn := client.NewConnectionWithViper(viper.GetViper())
+	defer conn.Close()
+	if txAll {
+		var count int
+		err := client.StreamTransactions(conn, req, func(entries []*rpcpb.TransactionEntry, cursor string) error {
+			fmt.Println(util.PrettyPrint(entries))
+			count += len(entries)
+			return nil
+		})
+		if err != nil {
+			fmt.Println(err)
+		}
+		fmt.Printf("Listed %d transactions\n", count)
+		return
+	}
+	entries, nextCursor, err := client.ListTransactions(conn, req)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Println(util.PrettyPrint(entries))
+	if nextCursor != "" {
+		fmt.Println("Next cursor:", nextCursor)
+	}
+}
+
+func unlockAccountCmdFunc(cmd *cobra.Command, args []string) {
+	if len(args) < 1 {
+		fmt.Println("Param address required")
+		return
+	}
+	var timeout uint64
 	if len(args) > 1 {
-		uint64Val, err := strconv.ParseUint(args[1], 10, 32)
+		t, err := strconv.ParseUint(args[1], 10, 32)
 		if err != nil {
-			fmt.Println("Invalid param offset", err)
+			fmt.Println("Invalid timeout: ", args[1])
 			return
 		}
-		limit = uint32(uint64Val)
-	} else {
-		offset = 0
+		timeout = t
+	}
+	passphrase, err := wallet.ReadPassphraseStdin()
+	if err != nil {
+		fmt.Println(err)
+		return
 	}
 	conn := client.NewConnectionWithViper(viper.GetViper())
 	defer conn.Close()
-	txs, err := client.ListTransactions(conn, addr, offset, limit)
+	if err := client.UnlockAccount(conn, args[0], passphrase, uint32(timeout)); err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Println("Account unlocked:", args[0])
+}
+
+func lockAccountCmdFunc(cmd *cobra.Command, args []string) {
+	if len(args) < 1 {
+		fmt.Println("Param address required")
+		return
+	}
+	conn := client.NewConnectionWithViper(viper.GetViper())
+	defer conn.Close()
+	if err := client.LockAccount(conn, args[0]); err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Println("Account locked:", args[0])
+}
+
+func createHDWalletCmdFunc(cmd *cobra.Command, args []string) {
+	words := wallet.MnemonicWords12
+	if len(args) > 0 {
+		w, err := strconv.Atoi(args[0])
+		if err != nil {
+			fmt.Println("Invalid words: ", args[0])
+			return
+		}
+		words = w
+	}
+	wltMgr, err := wallet.NewWalletManager(walletDir)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	passphrase, err := wallet.ReadPassphraseStdin()
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	mnemonic, err := wltMgr.CreateHDWallet(words, mnemonicPassphrase, passphrase)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Printf("Created hd wallet, write down the mnemonic to recover it:\n%s\n", mnemonic)
+}
+
+func importHDSeedCmdFunc(cmd *cobra.Command, args []string) {
+	if len(args) < 1 {
+		fmt.Println("Param seed required")
+		return
+	}
+	seed, err := hex.DecodeString(args[0])
+	if err != nil {
+		fmt.Println("Invalid seed", err)
+		return
+	}
+	wltMgr, err := wallet.NewWalletManager(walletDir)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	passphrase, err := wallet.ReadPassphraseStdin()
 	if err != nil {
 		fmt.Println(err)
 		return
 	}
-	fmt.Println(util.PrettyPrint(txs))
+	if err := wltMgr.ImportHDSeed(seed, passphrase); err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Println("Imported hd wallet, run scanhdwallet to recover used addresses")
+}
+
+func newHDAccountCmdFunc(cmd *cobra.Command, args []string) {
+	wltMgr, err := wallet.NewWalletManager(walletDir)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	passphrase, err := wallet.ReadPassphraseStdin()
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	index, err := wltMgr.NewHDAccount(passphrase)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Println("Created hd account:", index)
+}
+
+func deriveAddressCmdFunc(cmd *cobra.Command, args []string) {
+	var account uint64
+	if len(args) > 0 {
+		a, err := strconv.ParseUint(args[0], 10, 32)
+		if err != nil {
+			fmt.Println("Invalid account: ", args[0])
+			return
+		}
+		account = a
+	}
+	var change bool
+	if len(args) > 1 {
+		c, err := strconv.ParseBool(args[1])
+		if err != nil {
+			fmt.Println("Invalid change flag: ", args[1])
+			return
+		}
+		change = c
+	}
+	conn := client.NewConnectionWithViper(viper.GetViper())
+	defer conn.Close()
+	addr, path, err := client.DeriveAddress(conn, uint32(account), change)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Printf("Address: %s\nPath: %s\n", addr, path)
+}
+
+func scanHDWalletCmdFunc(cmd *cobra.Command, args []string) {
+	var limit uint64
+	if len(args) > 0 {
+		g, err := strconv.ParseUint(args[0], 10, 32)
+		if err != nil {
+			fmt.Println("Invalid gap limit: ", args[0])
+			return
+		}
+		limit = g
+	}
+	conn := client.NewConnectionWithViper(viper.GetViper())
+	defer conn.Close()
+	addrs, balance, err := client.ScanHDWallet(conn, uint32(limit))
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	printScanResult(addrs, balance)
+}
+
+func importMnemonicCmdFunc(cmd *cobra.Command, args []string) {
+	if len(args) < 1 {
+		fmt.Println("Param mnemonic required")
+		return
+	}
+	mnemonic := strings.Join(args, " ")
+	if _, err := wallet.MnemonicToEntropy(mnemonic); err != nil {
+		fmt.Println(err)
+		return
+	}
+	passphrase, err := wallet.ReadPassphraseStdin()
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	conn := client.NewConnectionWithViper(viper.GetViper())
+	defer conn.Close()
+	addrs, balance, err := client.ImportMnemonic(conn, mnemonic, mnemonicPassphrase, passphrase, gapLimit)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	printScanResult(addrs, balance)
+}
+
+func exportMnemonicCmdFunc(cmd *cobra.Command, args []string) {
+	passphrase, err := wallet.ReadPassphraseStdin()
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	wltMgr, err := wallet.NewWalletManager(walletDir)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	mnemonic, err := wltMgr.ExportMnemonic(passphrase)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Println("Mnemonic:", mnemonic)
+}
+
+func changePassphraseCmdFunc(cmd *cobra.Command, args []string) {
+	if len(args) < 1 {
+		fmt.Println("Param address required")
+		return
+	}
+	fmt.Println("Old passphrase:")
+	oldPassphrase, err := wallet.ReadPassphraseStdin()
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Println("New passphrase:")
+	newPassphrase, err := wallet.ReadPassphraseStdin()
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	conn := client.NewConnectionWithViper(viper.GetViper())
+	defer conn.Close()
+	if err := client.ChangePassphrase(conn, args[0], oldPassphrase, newPassphrase); err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Println("Passphrase changed:", args[0])
+}
+
+func importAddressCmdFunc(cmd *cobra.Command, args []string) {
+	if len(args) < 1 {
+		fmt.Println("Param address required")
+		return
+	}
+	conn := client.NewConnectionWithViper(viper.GetViper())
+	defer conn.Close()
+	if err := client.ImportAddress(conn, args[0]); err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Println("Imported watch-only address:", args[0])
+}
+
+func printScanResult(addrs []string, balance uint64) {
+	fmt.Printf("Found %d used addresses\n", len(addrs))
+	for _, addr := range addrs {
+		fmt.Println(addr)
+	}
+	fmt.Println("Balance of hd wallet:", balance)
+}
+
+func setLabelCmdFunc(cmd *cobra.Command, args []string) {
+	if len(args) < 1 {
+		fmt.Println("Param address required")
+		return
+	}
+	label := ""
+	if len(args) > 1 {
+		label = strings.Join(args[1:], " ")
+	}
+	conn := client.NewConnectionWithViper(viper.GetViper())
+	defer conn.Close()
+	if err := client.SetAccountLabel(conn, args[0], label); err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Printf("Label of %s set to %q\n", args[0], label)
+}
+
+func setNoteCmdFunc(cmd *cobra.Command, args []string) {
+	if len(args) < 2 {
+		fmt.Println("Params address and key required")
+		return
+	}
+	value := ""
+	if len(args) > 2 {
+		value = strings.Join(args[2:], " ")
+	}
+	conn := client.NewConnectionWithViper(viper.GetViper())
+	defer conn.Close()
+	if err := client.SetAccountNote(conn, args[0], args[1], value); err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Printf("Note %s of %s set to %q\n", args[1], args[0], value)
+}
+
+func setTxLabelCmdFunc(cmd *cobra.Command, args []string) {
+	if len(args) < 1 {
+		fmt.Println("Param txhash required")
+		return
+	}
+	label := ""
+	if len(args) > 1 {
+		label = strings.Join(args[1:], " ")
+	}
+	conn := client.NewConnectionWithViper(viper.GetViper())
+	defer conn.Close()
+	if err := client.SetTransactionLabel(conn, args[0], label); err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Printf("Label of %s set to %q\n", args[0], label)
+}
+
+func consolidateCmdFunc(cmd *cobra.Command, args []string) {
+	if len(args) < 1 {
+		fmt.Println("Param address required")
+		return
+	}
+	consolidateReq.Addr = args[0]
+	conn := client.NewConnectionWithViper(viper.GetViper())
+	defer conn.Close()
+	txs, dust, err := client.ConsolidateUtxos(conn, consolidateReq)
+	for _, tx := range txs {
+		fmt.Printf("Tx Hash: %s Inputs: %d Value: %d Fee: %d\n", tx.Hash, tx.Inputs, tx.Value, tx.Fee)
+	}
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Println("Dust utxos left out:", dust)
+}
+
+func issueTokenCmdFunc(cmd *cobra.Command, args []string) {
+	if len(args) < 3 {
+		fmt.Println("Params address, name and supply required")
+		return
+	}
+	supply, err := strconv.ParseUint(args[2], 10, 64)
+	if err != nil {
+		fmt.Println("Invalid supply: ", args[2])
+		return
+	}
+	issueTokenReq.Addr, issueTokenReq.Name, issueTokenReq.TotalSupply = args[0], args[1], supply
+	conn := client.NewConnectionWithViper(viper.GetViper())
+	defer conn.Close()
+	r, err := client.IssueToken(conn, issueTokenReq)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Printf("Tx Hash: %s Fee: %d\n", r.Hash, r.Fee)
+	fmt.Printf("Token ID: %s 0\n", r.Hash)
+}
+
+func transferTokenCmdFunc(cmd *cobra.Command, args []string) {
+	if len(args) < 5 {
+		fmt.Println("Params address, tokenhash, tokenindex, to and amount required")
+		return
+	}
+	index, err := strconv.ParseUint(args[2], 10, 32)
+	if err != nil {
+		fmt.Println("Invalid token index: ", args[2])
+		return
+	}
+	amount, err := strconv.ParseUint(args[4], 10, 64)
+	if err != nil {
+		fmt.Println("Invalid amount: ", args[4])
+		return
+	}
+	transferTokenReq.Addr, transferTokenReq.TokenHash, transferTokenReq.TokenIndex = args[0], args[1], uint32(index)
+	transferTokenReq.ToAddr, transferTokenReq.Amount = args[3], amount
+	conn := client.NewConnectionWithViper(viper.GetViper())
+	defer conn.Close()
+	r, err := client.TransferToken(conn, transferTokenReq)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Printf("Tx Hash: %s Fee: %d\n", r.Hash, r.Fee)
+}
+
+func createMultisigCmdFunc(cmd *cobra.Command, args []string) {
+	if len(args) < 2 {
+		fmt.Println("Params m and at least one participant required")
+		return
+	}
+	m, err := strconv.ParseUint(args[0], 10, 32)
+	if err != nil {
+		fmt.Println("Invalid m: ", args[0])
+		return
+	}
+	if multisigByNode {
+		conn := client.NewConnectionWithViper(viper.GetViper())
+		defer conn.Close()
+		r, err := client.CreateMultisig(conn, uint32(m), args[1:])
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		printMultisig(r.Addr, r.RedeemScript, r.PubKeys)
+		return
+	}
+	wltMgr, err := wallet.NewWalletManager(walletDir)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	// public keys of local accounts are known once unlocked, the passphrase
+	// is asked once, when one is needed
+	var passphrase *string
+	multisig, err := wallet.NewMultisig(int(m), args[1:], func(addr string) (*wallet.Account, bool) {
+		acc, ok := wltMgr.GetAccount(addr)
+		if !ok || acc.WatchOnly() {
+			return nil, false
+		}
+		if acc.External() {
+			return acc, true
+		}
+		if passphrase == nil {
+			input, err := wallet.ReadPassphraseStdin()
+			if err != nil {
+				fmt.Println(err)
+				return nil, false
+			}
+			passphrase = &input
+		}
+		if err := acc.UnlockWithPassphrase(*passphrase); err != nil {
+			fmt.Println("Fail to unlock account", addr, err)
+			return nil, false
+		}
+		return acc, true
+	})
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	pubKeys := make([]string, 0, multisig.Keys.N())
+	for _, pubKey := range multisig.Keys.PubKeys {
+		pubKeys = append(pubKeys, hex.EncodeToString(pubKey.Serialize()))
+	}
+	printMultisig(multisig.Address.String(), multisig.RedeemScript, pubKeys)
+}
+
+func printMultisig(addr string, redeemScript []byte, pubKeys []string) {
+	fmt.Println("Address:", addr)
+	fmt.Println("Redeem Script:", hex.EncodeToString(redeemScript))
+	fmt.Println("Public Keys:", strings.Join(pubKeys, " "))
 }
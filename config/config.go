@@ -9,8 +9,12 @@ import (
 	"os"
 	"path/filepath"
 	"strings"
+	"time"
 
 	"github.com/BOXFoundation/boxd/consensus/dpos"
+	"github.com/BOXFoundation/boxd/consensus/solo"
+	"github.com/BOXFoundation/boxd/core/txpool"
+	"github.com/BOXFoundation/boxd/core/types"
 	logtypes "github.com/BOXFoundation/boxd/log/types"
 	"github.com/BOXFoundation/boxd/metrics"
 	"github.com/BOXFoundation/boxd/p2p"
@@ -41,8 +45,17 @@ type Config struct {
 	P2p       p2p.Config      `mapstructure:"p2p"`
 	RPC       rpc.Config      `mapstructure:"rpc"`
 	Database  storage.Config  `mapstructure:"database"`
+	Ephemeral bool            `mapstructure:"ephemeral"`
+	VerifyDB  string          `mapstructure:"verifydb"`
+	Consensus string          `mapstructure:"consensus"`
 	Dpos      dpos.Config     `mapstructure:"dpos"`
+	Solo      solo.Config     `mapstructure:"solo"`
+	TxPool    txpool.Config   `mapstructure:"txpool"`
 	Metrics   metrics.Config  `mapstructure:"metrics"`
+	// ShutdownTimeout bounds the wait for services to stop in order on
+	// shutdown, after which the node exits and chain data is checked at
+	// next start
+	ShutdownTimeout time.Duration `mapstructure:"shutdown_timeout"`
 }
 
 var format = `workspace: %s
@@ -76,6 +89,11 @@ func (c *Config) Prepare() {
 		fmt.Println("Incorrect network name ", c.Network)
 		os.Exit(1)
 	}
+	// bech32 addresses are of the network
+	if err := types.SetAddressNetwork(c.Network); err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
 
 	// check log file configuration
 	for _, hook := range c.Log.Hooks {
@@ -104,10 +122,33 @@ func (c *Config) Prepare() {
 		}
 	}
 
+	// log file rotated by the node is in the log dir of workspace unless
+	// absolute
+	if filename := c.Log.File.Filename; len(filename) > 0 && !filepath.IsAbs(filename) {
+		if strings.Contains(filename, "/") {
+			fmt.Println("Incorrect log filename ", filename)
+			os.Exit(1)
+		}
+		c.Log.File.Filename = filepath.Join(c.Workspace, "logs", c.Network, filename)
+	}
+
 	// database
-	dbpath := filepath.Join(c.Workspace, "database", c.Network)
-	mkDirAll(dbpath)
-	c.Database.Path = dbpath
+	if c.Ephemeral {
+		// ephemeral nodes keep chain data in memory, which is lost on exit
+		c.Database.Name = "memdb"
+	} else {
+		dbpath := filepath.Join(c.Workspace, "database", c.Network)
+		mkDirAll(dbpath)
+		c.Database.Path = dbpath
+	}
+
+	// node key encrypting tables, in workspace unless absolute
+	if len(c.Database.KeyPath) == 0 {
+		c.Database.KeyPath = "db.key"
+	}
+	if !filepath.IsAbs(c.Database.KeyPath) {
+		c.Database.KeyPath = filepath.Join(c.Workspace, c.Database.KeyPath)
+	}
 
 	// p2p
 	var keyPath = c.P2p.KeyPath
@@ -123,9 +164,41 @@ func (c *Config) Prepare() {
 		c.P2p.KeyPath = filepath.Join(c.Workspace, keyPath)
 	}
 
+	// rpc
+	if len(c.RPC.WalletDir) > 0 && !filepath.IsAbs(c.RPC.WalletDir) {
+		c.RPC.WalletDir = filepath.Join(c.Workspace, c.RPC.WalletDir)
+	}
+
 	// dpos
 	var keystorePath = c.Dpos.Keypath
 	c.Dpos.Keypath = filepath.Join(c.Workspace, keystorePath)
+	c.Dpos.BLSKeyPath = blsKeyPath(c.Workspace, c.Dpos.BLSKeyPath)
+
+	// solo
+	if len(c.Solo.Keypath) > 0 && !filepath.IsAbs(c.Solo.Keypath) {
+		c.Solo.Keypath = filepath.Join(c.Workspace, c.Solo.Keypath)
+	}
+	c.Solo.BLSKeyPath = blsKeyPath(c.Workspace, c.Solo.BLSKeyPath)
+}
+
+// blsKeyPath returns the path of the BLS key of miners in workspace,
+// bls.key if not set
+func blsKeyPath(workspace, path string) string {
+	if len(path) == 0 {
+		path = "bls.key"
+	}
+	if filepath.IsAbs(path) {
+		return path
+	}
+	return filepath.Join(workspace, path)
+}
+
+// ConsensusConfig returns configurations of the consensus engine in use
+func (c *Config) ConsensusConfig() interface{} {
+	if c.Consensus == solo.Name {
+		return &c.Solo
+	}
+	return &c.Dpos
 }
 
 func mkDirAll(p string) {
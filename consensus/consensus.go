@@ -0,0 +1,59 @@
+// Copyright (c) 2018 ContentBox Authors.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package consensus
+
+import (
+	"fmt"
+
+	"github.com/BOXFoundation/boxd/boxd/service"
+	"github.com/BOXFoundation/boxd/core/chain"
+	"github.com/BOXFoundation/boxd/core/txpool"
+	"github.com/BOXFoundation/boxd/core/types"
+	"github.com/BOXFoundation/boxd/p2p"
+	"github.com/jbenet/goprocess"
+)
+
+// DefaultEngine is the consensus engine used if none is configured
+const DefaultEngine = "dpos"
+
+// Engine is a consensus engine producing and validating blocks
+type Engine interface {
+	types.Consensus
+	service.ConsensusReader
+	// Start starts the engine on the node, minting blocks if enabled
+	Start() error
+}
+
+// Params are services an engine runs with and its configurations
+type Params struct {
+	Proc   goprocess.Process
+	Chain  *chain.BlockChain
+	TxPool *txpool.TransactionPool
+	Net    p2p.Net
+	// Config is the configurations of the engine, of the type it registers
+	// with
+	Config interface{}
+}
+
+// newEngineFunc defines the function to create a new consensus engine.
+type newEngineFunc func(*Params) (Engine, error)
+
+var engines = make(map[string]newEngineFunc)
+
+// Register registers a new consensus engine implementation
+func Register(name string, fn newEngineFunc) {
+	engines[name] = fn
+}
+
+// New creates a consensus engine associate with specified name
+func New(name string, params *Params) (Engine, error) {
+	if len(name) == 0 {
+		name = DefaultEngine
+	}
+	if fn, ok := engines[name]; ok {
+		return fn(params)
+	}
+	return nil, fmt.Errorf("consensus engine %s is not found", name)
+}
@@ -0,0 +1,26 @@
+// Copyright (c) 2018 ContentBox Authors.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package consensus
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/facebookgo/ensure"
+)
+
+func TestNewEngine(t *testing.T) {
+	errTest := errors.New("test engine")
+	Register("test", func(params *Params) (Engine, error) {
+		ensure.DeepEqual(t, params.Config, "cfg")
+		return nil, errTest
+	})
+	defer delete(engines, "test")
+
+	_, err := New("test", &Params{Config: "cfg"})
+	ensure.DeepEqual(t, err, errTest)
+	_, err = New("unknown", &Params{})
+	ensure.NotNil(t, err)
+}
@@ -5,8 +5,6 @@
 package dpos
 
 import (
-	"bytes"
-	"sync"
 	"time"
 
 	chain "github.com/BOXFoundation/boxd/core/chain"
@@ -18,71 +16,95 @@ import (
 	"github.com/jbenet/goprocess"
 )
 
-// bft check eternal status
-type status int
-
-// EternalBlockMsgKeyType is renamed EternalBlockMsgKey type
-type EternalBlockMsgKeyType [EternalBlockMsgKeySize]byte
-
 // Define const.
 const (
-	EternalBlockMsgChBufferSize        = 65536
-	MaxEternalBlockMsgCacheTime        = 10 * 60
-	MinConfirmMsgNumberForEternalBlock = 2 * PeriodSize / 3
-	EternalBlockMsgKeySize             = crypto.HashSize + 8
-
-	free status = iota
-	underway
+	EternalBlockMsgChBufferSize = 65536
+	MaxEternalBlockMsgCacheTime = 10 * 60
 )
 
-// BftService use for quick identification of eternal block.
+// voteSet collects eternal votes on a block, one of each miner
+type voteSet struct {
+	timestamp  int64
+	signatures map[types.AddressHash][]byte
+}
+
+// pendingJustification is a justification received before its block
+type pendingJustification struct {
+	justification *types.Justification
+	received      int64
+}
+
+// BftService finalizes blocks as eternal once more than 2/3 of miners vote
+// for them, and relays the justification proving it so that any node can
+// verify the eternal block on its own.
 type BftService struct {
-	eternalBlockMsgCh       chan p2p.Message
-	notifiee                p2p.Net
-	chain                   *chain.BlockChain
-	consensus               *Dpos
-	cache                   *sync.Map
-	existEternalBlockMsgKey *lru.Cache
-	checkStatus             status
-	proc                    goprocess.Process
+	eternalBlockMsgCh  chan p2p.Message
+	justificationMsgCh chan p2p.Message
+	ownVoteCh          chan *EternalBlockMsg
+	notifiee           p2p.Net
+	chain              *chain.BlockChain
+	consensus          *Dpos
+	votes              map[crypto.HashType]*voteSet
+	pending            map[crypto.HashType]*pendingJustification
+	justified          *lru.Cache
+	proc               goprocess.Process
 }
 
 // NewBftService new bft service for eternalBlockMsg.
 func NewBftService(consensus *Dpos) (*BftService, error) {
 
 	bft := &BftService{
-		eternalBlockMsgCh: make(chan p2p.Message, EternalBlockMsgChBufferSize),
-		notifiee:          consensus.net,
-		chain:             consensus.chain,
-		consensus:         consensus,
-		checkStatus:       free,
-		cache:             new(sync.Map),
-		proc:              goprocess.WithParent(consensus.proc),
+		eternalBlockMsgCh:  make(chan p2p.Message, EternalBlockMsgChBufferSize),
+		justificationMsgCh: make(chan p2p.Message, EternalBlockMsgChBufferSize),
+		ownVoteCh:          make(chan *EternalBlockMsg, EternalBlockMsgChBufferSize),
+		notifiee:           consensus.net,
+		chain:              consensus.chain,
+		consensus:          consensus,
+		votes:              make(map[crypto.HashType]*voteSet),
+		pending:            make(map[crypto.HashType]*pendingJustification),
+		proc:               goprocess.WithParent(consensus.proc),
 	}
 
-	bft.existEternalBlockMsgKey, _ = lru.New(64)
+	var err error
+	if bft.justified, err = lru.New(64); err != nil {
+		return nil, err
+	}
 	return bft, nil
 }
 
-// Start bft service to handle eternalBlockMsg.
+// Start bft service to handle eternalBlockMsg and justifications.
 func (bft *BftService) Start() {
 	bft.subscribeMessageNotifiee()
 	bft.proc.Go(bft.loop)
-	bft.proc.Go(bft.checkEternalBlock)
 }
 
 func (bft *BftService) subscribeMessageNotifiee() {
 	bft.notifiee.Subscribe(p2p.NewNotifiee(p2p.EternalBlockMsg, p2p.Repeatable, bft.eternalBlockMsgCh))
+	bft.notifiee.Subscribe(p2p.NewNotifiee(p2p.EternalJustificationMsg, p2p.Repeatable, bft.justificationMsgCh))
 }
 
+// loop handles all votes and justifications, so that the eternal block is
+// only updated here
 func (bft *BftService) loop(p goprocess.Process) {
-	logger.Info("Start BftService to quick identification of eternal block...")
+	logger.Info("Start BftService to finalize eternal blocks...")
+	timerChan := time.NewTicker(time.Second)
+	defer timerChan.Stop()
 	for {
 		select {
 		case msg := <-bft.eternalBlockMsgCh:
 			if err := bft.handleEternalBlockMsg(msg); err != nil {
 				logger.Warnf("Failed to handle eternalBlockMsg. Err: %s", err.Error())
 			}
+		case vote := <-bft.ownVoteCh:
+			if err := bft.handleVote(vote); err != nil {
+				logger.Warnf("Failed to handle own eternal vote. Err: %s", err.Error())
+			}
+		case msg := <-bft.justificationMsgCh:
+			if err := bft.handleJustificationMsg(msg); err != nil {
+				logger.Warnf("Failed to handle justification. Err: %s", err.Error())
+			}
+		case <-timerChan.C:
+			bft.retryPending()
 		case <-p.Closing():
 			logger.Info("Quit bftservice loop.")
 			return
@@ -90,140 +112,157 @@ func (bft *BftService) loop(p goprocess.Process) {
 	}
 }
 
-// checkEternalBlock check to update eternal block.
-func (bft *BftService) checkEternalBlock(p goprocess.Process) {
-	logger.Info("Start to check eternalBlock...")
-	timerChan := time.NewTicker(time.Second)
-	defer timerChan.Stop()
-	for {
-		select {
-		case <-timerChan.C:
-			bft.maybeUpdateEternalBlock()
-		case <-p.Closing():
-			logger.Info("Quit checkEternalBlock loop.")
-			return
-		}
+// addOwnVote counts the vote this miner broadcasts to other miners
+func (bft *BftService) addOwnVote(vote *EternalBlockMsg) {
+	select {
+	case bft.ownVoteCh <- vote:
+	default:
+		logger.Warnf("Drop own eternal vote on block %s, too many votes queued", vote.hash)
 	}
 }
 
-func (bft *BftService) maybeUpdateEternalBlock() {
-	if bft.checkStatus == underway {
-		return
-	}
-	defer func() {
-		bft.checkStatus = free
-	}()
-	bft.checkStatus = underway
-	bft.tryToUpdateEternal()
-	if bft.chain.TailBlock().Height-bft.chain.EternalBlock().Height > MinConfirmMsgNumberForEternalBlock {
-		block, err := bft.chain.LoadBlockByHeight(bft.chain.EternalBlock().Height + 1)
-		if err != nil {
-			logger.Errorf("Failed to update eternal block. LoadBlockByHeight occurs error: %s", err.Error())
-			return
-		}
-		if err := bft.chain.SetEternal(block); err != nil {
-			logger.Errorf("Failed to setEternal block. Height: %d, Hash: %v, err: %s", block.Height, block.Hash, err.Error())
-			return
-		}
-		logger.Infof("Eternal block has changed! Hash: %s Height: %d", block.BlockHash(), block.Height)
+// minerKeys returns BLS public keys of miners of the current period
+func (bft *BftService) minerKeys() map[types.AddressHash]*crypto.BLSPublicKey {
+	keys := make(map[types.AddressHash]*crypto.BLSPublicKey)
+	for _, period := range bft.consensus.context.periodContext.period {
+		keys[period.addr] = period.blsPubKey
 	}
+	return keys
 }
 
-func (bft *BftService) tryToUpdateEternal() {
-
-	now := time.Now().Unix()
-	bft.cache.Range(func(k, v interface{}) bool {
-		value := v.([]*EternalBlockMsg)
-		if value[0].timestamp > now || now-value[0].timestamp > MaxEternalBlockMsgCacheTime {
-			bft.cache.Delete(k)
-		}
-		if len(value) <= MinConfirmMsgNumberForEternalBlock {
-			return true
-		}
-		if bft.updateEternal(value[0]) {
-			bft.cache.Delete(k)
-		}
-		return true
-	})
-}
+func (bft *BftService) handleEternalBlockMsg(msg p2p.Message) error {
 
-func (bft *BftService) updateEternal(msg *EternalBlockMsg) bool {
-	block, err := bft.chain.LoadBlockByHash(msg.hash)
-	if err != nil {
-		return false
+	// quick check
+	peerID := msg.From().Pretty()
+	if !util.InArray(peerID, bft.consensus.context.periodContext.periodPeers) {
+		return ErrNotMintPeer
 	}
 
-	if block.Height <= bft.chain.EternalBlock().Height {
-		return true
+	eternalBlockMsg := new(EternalBlockMsg)
+	if err := eternalBlockMsg.Unmarshal(msg.Body()); err != nil {
+		return err
 	}
-	if err := bft.chain.SetEternal(block); err != nil {
-		return false
+	voter, err := bft.checkVote(eternalBlockMsg)
+	if err != nil || voter == nil {
+		return err
 	}
-	logger.Infof("Eternal block has changed! Hash: %s Height: %d", block.BlockHash(), block.Height)
-	return true
+	for _, v := range bft.consensus.context.periodContext.period {
+		if v.addr == *voter && v.peerID == peerID {
+			return bft.addVote(eternalBlockMsg, *voter)
+		}
+	}
+	return ErrNotMintPeer
 }
 
-func (bft *BftService) generateKey(hash crypto.HashType, timestamp int64) *EternalBlockMsgKeyType {
-	buf := make([]byte, EternalBlockMsgKeySize)
-	copy(buf, hash[:])
-	w := bytes.NewBuffer(buf[crypto.HashSize:])
-	util.WriteInt64(w, timestamp)
-	result := new(EternalBlockMsgKeyType)
-	copy(result[:], buf)
-	return result
+func (bft *BftService) handleVote(vote *EternalBlockMsg) error {
+	voter, err := bft.checkVote(vote)
+	if err != nil || voter == nil {
+		return err
+	}
+	return bft.addVote(vote, *voter)
 }
 
-func (bft *BftService) handleEternalBlockMsg(msg p2p.Message) error {
+// checkVote returns the miner voting, verifying its BLS signature, nil if
+// the block is justified already
+func (bft *BftService) checkVote(vote *EternalBlockMsg) (*types.AddressHash, error) {
+	if bft.justified.Contains(vote.hash) {
+		logger.Debugf("Enough eternalBlockMsgs has been received.")
+		return nil, nil
+	}
+	now := time.Now().Unix()
+	if vote.timestamp > now || now-vote.timestamp > MaxEternalBlockMsgCacheTime {
+		return nil, ErrIllegalMsg
+	}
+	pubKey, ok := bft.minerKeys()[vote.voter]
+	if !ok || pubKey == nil {
+		return nil, ErrNotMintPeer
+	}
+	if !types.VerifyEternalVote(pubKey, &vote.hash, vote.signature) {
+		return nil, ErrIllegalMsg
+	}
+	voter := vote.voter
+	return &voter, nil
+}
 
-	// quick check
-	peerID := msg.From().Pretty()
-	if !util.InArray(peerID, bft.consensus.context.periodContext.periodPeers) {
-		return ErrNotMintPeer
+// addVote counts vote of voter, and aggregates votes into a justification
+// once more than 2/3 of miners vote for the block
+func (bft *BftService) addVote(vote *EternalBlockMsg, voter types.AddressHash) error {
+	votes, ok := bft.votes[vote.hash]
+	if !ok {
+		votes = &voteSet{timestamp: vote.timestamp, signatures: make(map[types.AddressHash][]byte)}
+		bft.votes[vote.hash] = votes
+	}
+	votes.signatures[voter] = vote.signature
+	if len(votes.signatures) < types.Quorum(len(bft.minerKeys())) {
+		return nil
 	}
 
-	eternalBlockMsg := new(EternalBlockMsg)
-	if err := eternalBlockMsg.Unmarshal(msg.Body()); err != nil {
+	justification, err := types.NewJustification(&vote.hash, votes.signatures)
+	if err != nil {
 		return err
 	}
+	delete(bft.votes, vote.hash)
+	bft.justified.Add(vote.hash, struct{}{})
+	return bft.acceptJustification(justification)
+}
 
-	key := bft.generateKey(eternalBlockMsg.hash, eternalBlockMsg.timestamp)
+func (bft *BftService) handleJustificationMsg(msg p2p.Message) error {
+	justification := new(types.Justification)
+	if err := justification.Unmarshal(msg.Body()); err != nil {
+		return err
+	}
+	if bft.justified.Contains(justification.Hash) {
+		return nil
+	}
+	if err := justification.Verify(bft.minerKeys()); err != nil {
+		return err
+	}
+	bft.justified.Add(justification.Hash, struct{}{})
+	delete(bft.votes, justification.Hash)
+	return bft.acceptJustification(justification)
+}
 
-	if bft.existEternalBlockMsgKey.Contains(*key) {
-		logger.Debugf("Enough eternalBlockMsgs has been received.")
+// acceptJustification sets the block justification proves final eternal and
+// relays the justification, or keeps it till the block is received
+func (bft *BftService) acceptJustification(justification *types.Justification) error {
+	block, err := bft.chain.LoadBlockByHash(justification.Hash)
+	if err != nil {
+		bft.pending[justification.Hash] = &pendingJustification{
+			justification: justification,
+			received:      time.Now().Unix(),
+		}
 		return nil
 	}
-	now := time.Now().Unix()
-	if eternalBlockMsg.timestamp > now || now-eternalBlockMsg.timestamp > MaxEternalBlockMsgCacheTime {
-		return ErrIllegalMsg
+	if block.Height <= bft.chain.EternalBlock().Height {
+		return nil
+	}
+	if err := bft.chain.SetEternal(block, justification); err != nil {
+		return err
 	}
+	logger.Infof("Eternal block has changed! Hash: %s Height: %d", block.BlockHash(), block.Height)
+	return bft.notifiee.Broadcast(p2p.EternalJustificationMsg, justification)
+}
 
-	if pubkey, ok := crypto.RecoverCompact(eternalBlockMsg.hash[:], eternalBlockMsg.signature); ok {
-		addrPubKeyHash, err := types.NewAddressFromPubKey(pubkey)
-		if err != nil {
-			return err
+// retryPending accepts justifications whose blocks have been received since,
+// and drops stale votes and justifications
+func (bft *BftService) retryPending() {
+	now := time.Now().Unix()
+	for hash, votes := range bft.votes {
+		if votes.timestamp > now || now-votes.timestamp > MaxEternalBlockMsgCacheTime {
+			delete(bft.votes, hash)
 		}
-		addr := *addrPubKeyHash.Hash160()
-		var period *Period
-		for _, v := range bft.consensus.context.periodContext.period {
-			if v.addr == addr && peerID == v.peerID {
-				period = v
-			}
+	}
+	for hash, pending := range bft.pending {
+		if now-pending.received > MaxEternalBlockMsgCacheTime {
+			delete(bft.pending, hash)
+			continue
 		}
-		if period == nil {
-			return err
+		if _, err := bft.chain.LoadBlockByHash(hash); err != nil {
+			continue
 		}
-
-		if msg, ok := bft.cache.Load(*key); ok {
-			value := msg.([]*EternalBlockMsg)
-			value = append(value, eternalBlockMsg)
-			bft.cache.Store(*key, value)
-			if len(value) > MinConfirmMsgNumberForEternalBlock {
-				bft.existEternalBlockMsgKey.Add(*key, *key)
-			}
-		} else {
-			bft.cache.Store(*key, []*EternalBlockMsg{eternalBlockMsg})
+		delete(bft.pending, hash)
+		if err := bft.acceptJustification(pending.justification); err != nil {
+			logger.Warnf("Failed to accept justification of block %s. Err: %s", hash, err.Error())
 		}
 	}
-
-	return nil
 }
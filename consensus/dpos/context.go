@@ -5,12 +5,19 @@
 package dpos
 
 import (
+	"bytes"
+	"encoding/hex"
+	"sort"
+	"sync/atomic"
+
 	"github.com/BOXFoundation/boxd/consensus/dpos/pb"
 	"github.com/BOXFoundation/boxd/core"
 	"github.com/BOXFoundation/boxd/core/chain"
 	"github.com/BOXFoundation/boxd/core/types"
 	"github.com/BOXFoundation/boxd/crypto"
 	conv "github.com/BOXFoundation/boxd/p2p/convert"
+	"github.com/BOXFoundation/boxd/script"
+	"github.com/BOXFoundation/boxd/util"
 	proto "github.com/gogo/protobuf/proto"
 	peer "github.com/libp2p/go-libp2p-peer"
 )
@@ -28,6 +35,40 @@ type PeriodContext struct {
 	nextPeriod  []*Period
 	periodAddrs []types.AddressHash
 	periodPeers []string
+	// timingVal holds the *timing of chain parameters as of the tail block,
+	// default timing is used if unset, and penaltiesVal the penalties as of
+	// it
+	timingVal    atomic.Value
+	penaltiesVal atomic.Value
+}
+
+// timing returns the timing to map time to epochs and slots with
+func (pc *PeriodContext) timing() *timing {
+	if t, ok := pc.timingVal.Load().(*timing); ok {
+		return t
+	}
+	return defaultTiming
+}
+
+// setTiming sets the timing to map time to epochs and slots with
+func (pc *PeriodContext) setTiming(t *timing) {
+	pc.timingVal.Store(t)
+}
+
+// penalties returns penalties of miners double minting as of the tail block
+func (pc *PeriodContext) penalties() penalties {
+	ps, _ := pc.penaltiesVal.Load().(penalties)
+	return ps
+}
+
+// setPenalties sets penalties of miners double minting
+func (pc *PeriodContext) setPenalties(ps penalties) {
+	pc.penaltiesVal.Store(ps)
+}
+
+// epochOf returns the epoch unix time timestamp in seconds falls in
+func (pc *PeriodContext) epochOf(timestamp int64) int64 {
+	return pc.timing().epochOf(timestamp)
 }
 
 // InitPeriodContext initializes period context.
@@ -38,12 +79,20 @@ func InitPeriodContext() (*PeriodContext, error) {
 	periodPeers := make([]string, len(chain.GenesisPeriod))
 	for k, v := range chain.GenesisPeriod {
 		period := new(Period)
-		addr, err := types.NewAddress(v["addr"])
+		// genesis miners are written as mainnet addresses for all networks
+		addr, err := types.NewAddressOfNetwork(v["addr"], "mainnet")
 		if err != nil {
 			return nil, err
 		}
 		period.addr = *addr.Hash160()
 		period.peerID = v["peerID"]
+		pubKey, err := hex.DecodeString(v["blsPubKey"])
+		if err != nil {
+			return nil, err
+		}
+		if period.blsPubKey, err = crypto.BLSPublicKeyFromBytes(pubKey); err != nil {
+			return nil, err
+		}
 		periods[k] = period
 		periodAddrs[k] = period.addr
 		periodPeers[k] = period.peerID
@@ -145,12 +194,12 @@ func (pc *PeriodContext) Unmarshal(data []byte) error {
 func (pc *PeriodContext) FindMinerWithTimeStamp(timestamp int64) (*types.AddressHash, error) {
 
 	period := pc.period
-	offsetPeriod := (timestamp * SecondInMs) % (NewBlockTimeInterval * PeriodSize)
-	if (offsetPeriod % NewBlockTimeInterval) != 0 {
-		return nil, ErrWrongTimeToMint
+	offset, err := pc.timing().slot(timestamp)
+	if err != nil {
+		return nil, err
 	}
-	offset := offsetPeriod / NewBlockTimeInterval
-	offset = offset % PeriodSize
+	// miners of the period mint in turn through slots of the epoch
+	offset %= PeriodSize
 
 	var miner *types.AddressHash
 	if offset >= 0 && int(offset) < len(period) {
@@ -161,10 +210,61 @@ func (pc *PeriodContext) FindMinerWithTimeStamp(timestamp int64) (*types.Address
 	return miner, nil
 }
 
+// schedule returns miners of the epoch at nowMs with their slots. tail is
+// the tail block, slots after which are counted to the next epoch height.
+// Slots of miners penalized in the epoch are left out
+func (pc *PeriodContext) schedule(nowMs int64, tail *types.Block) *types.MinerSchedule {
+
+	t := pc.timing()
+	epoch := t.epochOf(nowMs / SecondInMs)
+	start := t.epochStart(epoch)
+	era := t.era(start)
+	interval := era.interval()
+	schedule := &types.MinerSchedule{
+		Epoch:         epoch,
+		SlotInterval:  interval,
+		NextEpochTime: t.epochStart(epoch + 1),
+	}
+	penalties := pc.penalties()
+	remaining := uint32(0)
+	for offset := int64(0); offset < int64(era.params.PeriodDuration); offset++ {
+		idx := int(offset % PeriodSize)
+		if idx >= len(pc.period) || penalties.isPenalized(pc.period[idx].addr, epoch) {
+			continue
+		}
+		period := pc.period[idx]
+		slotTime := start + offset*interval
+		schedule.Slots = append(schedule.Slots, &types.MinerSlot{
+			Time:   slotTime,
+			Miner:  period.addr,
+			PeerID: period.peerID,
+		})
+		if slotTime > tail.Header.TimeStamp {
+			remaining++
+		}
+	}
+	schedule.NextEpochHeight = tail.Height + remaining + 1
+
+	// miners stay the same if the next period is not elected
+	nextPeriod := pc.nextPeriod
+	if len(nextPeriod) == 0 {
+		nextPeriod = pc.period
+	}
+	for _, period := range nextPeriod {
+		if penalties.isPenalized(period.addr, epoch+1) {
+			continue
+		}
+		schedule.NextMiners = append(schedule.NextMiners, period.addr)
+	}
+	return schedule
+}
+
 // Period represents period info.
 type Period struct {
 	addr   types.AddressHash
 	peerID string
+	// blsPubKey verifies eternal votes of the miner
+	blsPubKey *crypto.BLSPublicKey
 }
 
 var _ conv.Convertible = (*Period)(nil)
@@ -172,9 +272,14 @@ var _ conv.Serializable = (*Period)(nil)
 
 // ToProtoMessage converts candidate to proto message.
 func (period *Period) ToProtoMessage() (proto.Message, error) {
+	var blsPubKey []byte
+	if period.blsPubKey != nil {
+		blsPubKey = period.blsPubKey.Serialize()
+	}
 	return &dpospb.Period{
-		Addr:   period.addr[:],
-		PeerId: period.peerID,
+		Addr:      period.addr[:],
+		PeerId:    period.peerID,
+		BlsPubKey: blsPubKey,
 	}, nil
 }
 
@@ -184,6 +289,14 @@ func (period *Period) FromProtoMessage(message proto.Message) error {
 		if message != nil {
 			copy(period.addr[:], message.Addr)
 			period.peerID = message.PeerId
+			period.blsPubKey = nil
+			if len(message.BlsPubKey) > 0 {
+				blsPubKey, err := crypto.BLSPublicKeyFromBytes(message.BlsPubKey)
+				if err != nil {
+					return err
+				}
+				period.blsPubKey = blsPubKey
+			}
 			return nil
 		}
 		return core.ErrEmptyProtoMessage
@@ -214,6 +327,15 @@ type CandidateContext struct {
 	height     uint32
 	candidates []*Candidate
 	addrs      []types.AddressHash
+	// eras are chain parameters scheduled by governance, and proposals are
+	// ones not backed by enough miners yet
+	eras      []*paramsEra
+	proposals []*proposal
+	// penalties are of miners double minting, as evidences on chain say
+	penalties penalties
+	// timestamp and miners are of the block txs are applied in, not stored
+	timestamp int64
+	miners    []types.AddressHash
 }
 
 // InitCandidateContext init candidate context
@@ -241,9 +363,25 @@ func (candidateContext *CandidateContext) ToProtoMessage() (proto.Message, error
 		}
 	}
 
+	eras := make([]*dpospb.ParamsEra, len(candidateContext.eras))
+	for k, v := range candidateContext.eras {
+		eras[k] = &dpospb.ParamsEra{Start: v.start, Epoch: v.epoch, Params: chainParamsToProto(&v.params)}
+	}
+	proposals := make([]*dpospb.Proposal, len(candidateContext.proposals))
+	for k, v := range candidateContext.proposals {
+		miners := make([][]byte, len(v.miners))
+		for i := range v.miners {
+			miners[i] = v.miners[i][:]
+		}
+		proposals[k] = &dpospb.Proposal{Params: chainParamsToProto(&v.params), Miners: miners}
+	}
+
 	return &dpospb.CandidateContext{
 		Height:     candidateContext.height,
 		Candidates: candidates,
+		Eras:       eras,
+		Proposals:  proposals,
+		Penalties:  candidateContext.penalties.toProto(),
 	}, nil
 }
 
@@ -262,9 +400,24 @@ func (candidateContext *CandidateContext) FromProtoMessage(message proto.Message
 				candidates[k] = candidate
 				addrs[k] = candidate.addr
 			}
+			var eras []*paramsEra
+			for _, v := range message.Eras {
+				eras = append(eras, &paramsEra{start: v.Start, epoch: v.Epoch, params: chainParamsFromProto(v.Params)})
+			}
+			var proposals []*proposal
+			for _, v := range message.Proposals {
+				p := &proposal{params: chainParamsFromProto(v.Params), miners: make([]types.AddressHash, len(v.Miners))}
+				for i, miner := range v.Miners {
+					copy(p.miners[i][:], miner)
+				}
+				proposals = append(proposals, p)
+			}
 			candidateContext.height = message.Height
 			candidateContext.candidates = candidates
 			candidateContext.addrs = addrs
+			candidateContext.eras = eras
+			candidateContext.proposals = proposals
+			candidateContext.penalties = penaltiesFromProto(message.Penalties)
 			return nil
 		}
 		return core.ErrEmptyProtoMessage
@@ -287,6 +440,90 @@ func (candidateContext *CandidateContext) Unmarshal(data []byte) error {
 	return candidateContext.FromProtoMessage(msg)
 }
 
+// applyTx registers a candidate, updates votes of one, proposes chain
+// parameters or penalizes a miner double minting as tx says, txs carrying no
+// candidate data are ignored
+func (candidateContext *CandidateContext) applyTx(tx *types.Transaction) error {
+
+	if tx.Data == nil {
+		return nil
+	}
+	content := tx.Data.Content
+	switch int(tx.Data.Type) {
+	case types.RegisterCandidateTx:
+		signUpContent := new(types.SignUpContent)
+		if err := signUpContent.Unmarshal(content); err != nil {
+			return err
+		}
+		if util.InArray(signUpContent.Addr(), candidateContext.addrs) {
+			return ErrDuplicateSignUpTx
+		}
+		candidate := &Candidate{
+			addr:  signUpContent.Addr(),
+			votes: 0,
+		}
+		candidateContext.candidates = append(candidateContext.candidates, candidate)
+		candidateContext.addrs = append(candidateContext.addrs, candidate.addr)
+	case types.VoteTx:
+		votesContent := new(types.VoteContent)
+		if err := votesContent.Unmarshal(content); err != nil {
+			return err
+		}
+		candidate := candidateContext.candidate(votesContent.Addr())
+		if candidate == nil {
+			return ErrCandidateNotFound
+		}
+		voter, err := voterOf(tx)
+		if err != nil {
+			return err
+		}
+		return candidate.addVotes(*voter, votesContent.Votes())
+	case types.GovernanceTx:
+		governanceContent := new(types.GovernanceContent)
+		if err := governanceContent.Unmarshal(content); err != nil {
+			return err
+		}
+		return candidateContext.propose(tx, governanceContent.Params())
+	case types.DoubleMintEvidenceTx:
+		evidence := new(types.DoubleMintEvidence)
+		if err := evidence.Unmarshal(content); err != nil {
+			return err
+		}
+		candidateContext.penalize(evidence)
+	default:
+	}
+	return nil
+}
+
+// penalize removes the miner of evidence from candidates and its slots for
+// PenaltyEpochs epochs after the one it double minted in
+func (candidateContext *CandidateContext) penalize(evidence *types.DoubleMintEvidence) {
+	until := newTiming(candidateContext.eras).epochOf(evidence.Timestamp()) + PenaltyEpochs
+	candidateContext.penalties = candidateContext.penalties.penalize(evidence.Miner, until)
+}
+
+// candidate returns the candidate of addr, nil if not registered
+func (candidateContext *CandidateContext) candidate(addr types.AddressHash) *Candidate {
+	for _, v := range candidateContext.candidates {
+		if v.addr == addr {
+			return v
+		}
+	}
+	return nil
+}
+
+// Candidates returns candidates with their votes, most voted first
+func (candidateContext *CandidateContext) Candidates() []*types.CandidateInfo {
+	infos := make([]*types.CandidateInfo, len(candidateContext.candidates))
+	for k, v := range candidateContext.candidates {
+		infos[k] = &types.CandidateInfo{Addr: v.addr, Votes: atomic.LoadInt64(&v.votes)}
+	}
+	sort.SliceStable(infos, func(i, j int) bool {
+		return infos[i].Votes > infos[j].Votes
+	})
+	return infos
+}
+
 // CandidateContextHash calc candidate context hash.
 func (candidateContext *CandidateContext) CandidateContextHash() (*crypto.HashType, error) {
 	bytes, err := candidateContext.Marshal()
@@ -305,6 +542,68 @@ type Candidate struct {
 	addr  types.AddressHash
 	votes int64
 	peer  peer.ID
+	// voters cast votes for the candidate, in address order
+	voters []*voter
+}
+
+// voter is an address with votes it casts for a candidate
+type voter struct {
+	addr  types.AddressHash
+	votes int64
+}
+
+// voterOf returns the address casting votes in tx, the signer of its first
+// input
+func voterOf(tx *types.Transaction) (*types.AddressHash, error) {
+	if len(tx.Vin) == 0 {
+		return nil, ErrInvalidVoter
+	}
+	addr, err := script.NewScriptFromBytes(tx.Vin[0].ScriptSig).ExtractSignerAddress()
+	if err != nil {
+		return nil, ErrInvalidVoter
+	}
+	return addr.Hash160(), nil
+}
+
+// addVotes adds votes of addr for the candidate, which are withdrawn if
+// negative. A voter withdraws no more than it casts, plus votes not
+// attributed to any voter
+func (candidate *Candidate) addVotes(addr types.AddressHash, votes int64) error {
+	i := sort.Search(len(candidate.voters), func(i int) bool {
+		return bytes.Compare(candidate.voters[i].addr[:], addr[:]) >= 0
+	})
+	found := i < len(candidate.voters) && candidate.voters[i].addr == addr
+	var current int64
+	if found {
+		current = candidate.voters[i].votes
+	}
+	// votes cast before voters were recorded belong to no voter, what one
+	// withdraws beyond its own comes out of them until they are migrated
+	if current+votes < 0 && candidate.unattributedVotes()+current+votes < 0 {
+		return ErrInsufficientVotes
+	}
+	switch {
+	case found && current+votes <= 0:
+		candidate.voters = append(candidate.voters[:i], candidate.voters[i+1:]...)
+	case found:
+		candidate.voters[i].votes += votes
+	case votes > 0:
+		candidate.voters = append(candidate.voters, nil)
+		copy(candidate.voters[i+1:], candidate.voters[i:])
+		candidate.voters[i] = &voter{addr: addr, votes: votes}
+	}
+	atomic.AddInt64(&candidate.votes, votes)
+	return nil
+}
+
+// unattributedVotes returns votes of the candidate recorded for no voter,
+// which are those cast before votes were tracked per voter
+func (candidate *Candidate) unattributedVotes() int64 {
+	votes := atomic.LoadInt64(&candidate.votes)
+	for _, v := range candidate.voters {
+		votes -= v.votes
+	}
+	return votes
 }
 
 var _ conv.Convertible = (*Candidate)(nil)
@@ -312,10 +611,15 @@ var _ conv.Serializable = (*Candidate)(nil)
 
 // ToProtoMessage converts candidate to proto message.
 func (candidate *Candidate) ToProtoMessage() (proto.Message, error) {
+	voters := make([]*dpospb.Voter, len(candidate.voters))
+	for i, v := range candidate.voters {
+		voters[i] = &dpospb.Voter{Addr: v.addr[:], Votes: v.votes}
+	}
 	return &dpospb.Candidate{
 		Addr:  candidate.addr[:],
 		Votes: candidate.votes,
 		// Peer:  candidate.peer.Pretty(),
+		Voters: voters,
 	}, nil
 }
 
@@ -325,6 +629,11 @@ func (candidate *Candidate) FromProtoMessage(message proto.Message) error {
 		if message != nil {
 			copy(candidate.addr[:], message.Addr)
 			candidate.votes = message.Votes
+			candidate.voters = make([]*voter, len(message.Voters))
+			for i, v := range message.Voters {
+				candidate.voters[i] = &voter{votes: v.Votes}
+				copy(candidate.voters[i].addr[:], v.Addr)
+			}
 			return nil
 		}
 		return core.ErrEmptyProtoMessage
@@ -352,9 +661,11 @@ func (candidate *Candidate) Unmarshal(data []byte) error {
 
 // EternalBlockMsg represents eternal block msg.
 type EternalBlockMsg struct {
-	hash      crypto.HashType
+	hash crypto.HashType
+	// signature is the BLS signature of voter on the eternal vote
 	signature []byte
 	timestamp int64
+	voter     types.AddressHash
 }
 
 var _ conv.Convertible = (*EternalBlockMsg)(nil)
@@ -366,6 +677,7 @@ func (ebm *EternalBlockMsg) ToProtoMessage() (proto.Message, error) {
 		Hash:      ebm.hash[:],
 		Timestamp: ebm.timestamp,
 		Signature: ebm.signature,
+		Voter:     ebm.voter[:],
 	}, nil
 }
 
@@ -376,6 +688,7 @@ func (ebm *EternalBlockMsg) FromProtoMessage(message proto.Message) error {
 			copy(ebm.hash[:], message.Hash)
 			ebm.timestamp = message.Timestamp
 			ebm.signature = message.Signature
+			copy(ebm.voter[:], message.Voter)
 			return nil
 		}
 		return core.ErrEmptyProtoMessage
@@ -0,0 +1,120 @@
+// Copyright (c) 2018 ContentBox Authors.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package dpos
+
+import (
+	"testing"
+
+	corepb "github.com/BOXFoundation/boxd/core/pb"
+	"github.com/BOXFoundation/boxd/core/types"
+	"github.com/BOXFoundation/boxd/crypto"
+	"github.com/BOXFoundation/boxd/script"
+	"github.com/facebookgo/ensure"
+)
+
+func newCandidateTx(txType int, content interface{ Marshal() ([]byte, error) }) *types.Transaction {
+	return newVoterTx(nil, txType, content)
+}
+
+// newVoterTx creates a candidate tx whose first input is signed by voter
+func newVoterTx(voter *crypto.PrivateKey, txType int, content interface{ Marshal() ([]byte, error) }) *types.Transaction {
+	data, _ := content.Marshal()
+	tx := &types.Transaction{Data: &corepb.Data{Type: int32(txType), Content: data}}
+	if voter != nil {
+		sig, _ := crypto.Sign(voter, &crypto.HashType{})
+		scriptSig := script.SignatureScript(sig, voter.PubKey().Serialize())
+		tx.Vin = []*types.TxIn{{ScriptSig: *scriptSig}}
+	}
+	return tx
+}
+
+func newVoter(t *testing.T) (*crypto.PrivateKey, types.AddressHash) {
+	privKey, pubKey, err := crypto.NewKeyPair()
+	ensure.Nil(t, err)
+	addr, err := types.NewAddressFromPubKey(pubKey)
+	ensure.Nil(t, err)
+	return privKey, *addr.Hash160()
+}
+
+func TestCandidateContextApplyTx(t *testing.T) {
+	alice, bob := types.AddressHash{0x01}, types.AddressHash{0x02}
+	carol, _ := newVoter(t)
+	dave, _ := newVoter(t)
+	context := InitCandidateContext()
+
+	// txs carrying no candidate data are ignored
+	ensure.Nil(t, context.applyTx(&types.Transaction{}))
+
+	ensure.Nil(t, context.applyTx(newCandidateTx(types.RegisterCandidateTx, types.NewSignUpContent(alice))))
+	ensure.DeepEqual(t, context.applyTx(newCandidateTx(types.RegisterCandidateTx, types.NewSignUpContent(alice))), ErrDuplicateSignUpTx)
+	ensure.DeepEqual(t, context.applyTx(newVoterTx(carol, types.VoteTx, types.NewVoteContent(bob, 1))), ErrCandidateNotFound)
+	ensure.Nil(t, context.applyTx(newCandidateTx(types.RegisterCandidateTx, types.NewSignUpContent(bob))))
+
+	// votes must be signed by the voter
+	ensure.DeepEqual(t, context.applyTx(newCandidateTx(types.VoteTx, types.NewVoteContent(alice, 3))), ErrInvalidVoter)
+	ensure.Nil(t, context.applyTx(newVoterTx(carol, types.VoteTx, types.NewVoteContent(alice, 3))))
+	ensure.Nil(t, context.applyTx(newVoterTx(carol, types.VoteTx, types.NewVoteContent(bob, 2))))
+	ensure.Nil(t, context.applyTx(newVoterTx(dave, types.VoteTx, types.NewVoteContent(bob, 3))))
+	ensure.Nil(t, context.applyTx(newVoterTx(carol, types.VoteTx, types.NewVoteContent(bob, -1))))
+	ensure.DeepEqual(t, context.applyTx(newVoterTx(carol, types.VoteTx, types.NewVoteContent(alice, -4))), ErrInsufficientVotes)
+	// a voter withdraws no more than it casts, though bob has enough votes
+	ensure.DeepEqual(t, context.applyTx(newVoterTx(dave, types.VoteTx, types.NewVoteContent(bob, -4))), ErrInsufficientVotes)
+
+	ensure.DeepEqual(t, context.Candidates(), []*types.CandidateInfo{{Addr: bob, Votes: 4}, {Addr: alice, Votes: 3}})
+
+	// votes are kept across storing
+	data, err := context.Marshal()
+	ensure.Nil(t, err)
+	stored := new(CandidateContext)
+	ensure.Nil(t, stored.Unmarshal(data))
+	ensure.DeepEqual(t, stored.Candidates(), context.Candidates())
+	ensure.DeepEqual(t, stored.applyTx(newCandidateTx(types.RegisterCandidateTx, types.NewSignUpContent(bob))), ErrDuplicateSignUpTx)
+	ensure.DeepEqual(t, stored.candidate(bob).voters, context.candidate(bob).voters)
+}
+
+func TestCandidateWithdrawLegacyVotes(t *testing.T) {
+	alice := types.AddressHash{0x01}
+	carol, _ := newVoter(t)
+	dave, _ := newVoter(t)
+
+	// candidates stored before votes were tracked per voter have no voters
+	data, err := (&Candidate{addr: alice, votes: 5}).Marshal()
+	ensure.Nil(t, err)
+	legacy := new(Candidate)
+	ensure.Nil(t, legacy.Unmarshal(data))
+	context := &CandidateContext{candidates: []*Candidate{legacy}, addrs: []types.AddressHash{alice}}
+
+	ensure.Nil(t, context.applyTx(newVoterTx(carol, types.VoteTx, types.NewVoteContent(alice, 2))))
+	ensure.Nil(t, context.applyTx(newVoterTx(dave, types.VoteTx, types.NewVoteContent(alice, -4))))
+	// 1 legacy vote is left, which carol may withdraw beyond its own
+	ensure.DeepEqual(t, context.applyTx(newVoterTx(dave, types.VoteTx, types.NewVoteContent(alice, -2))), ErrInsufficientVotes)
+	ensure.Nil(t, context.applyTx(newVoterTx(carol, types.VoteTx, types.NewVoteContent(alice, -3))))
+	ensure.DeepEqual(t, context.Candidates(), []*types.CandidateInfo{{Addr: alice, Votes: 0}})
+	ensure.DeepEqual(t, legacy.voters, []*voter{})
+}
+
+func TestPeriodContextSchedule(t *testing.T) {
+	alice, bob, carol := types.AddressHash{0x01}, types.AddressHash{0x02}, types.AddressHash{0x03}
+	pc := &PeriodContext{period: []*Period{{addr: alice, peerID: "a"}, {addr: bob, peerID: "b"}, {addr: carol, peerID: "c"}}}
+
+	// epochs last PeriodSize slots, the 2nd one starts at 30s
+	epochLen := NewBlockTimeInterval * PeriodSize / SecondInMs
+	tail := &types.Block{Header: &types.BlockHeader{TimeStamp: epochLen + 5}, Height: 10}
+	schedule := pc.schedule((epochLen+7)*SecondInMs, tail)
+	ensure.DeepEqual(t, schedule.Epoch, int64(1))
+	ensure.DeepEqual(t, schedule.SlotInterval, NewBlockTimeInterval/SecondInMs)
+	ensure.DeepEqual(t, schedule.Slots, []*types.MinerSlot{
+		{Time: epochLen, Miner: alice, PeerID: "a"},
+		{Time: epochLen + 5, Miner: bob, PeerID: "b"},
+		{Time: epochLen + 10, Miner: carol, PeerID: "c"},
+	})
+	ensure.DeepEqual(t, schedule.NextEpochTime, 2*epochLen)
+	// only carol's slot is left in the epoch
+	ensure.DeepEqual(t, schedule.NextEpochHeight, uint32(12))
+	ensure.DeepEqual(t, schedule.NextMiners, []types.AddressHash{alice, bob, carol})
+
+	pc.nextPeriod = []*Period{{addr: carol}}
+	ensure.DeepEqual(t, pc.schedule((epochLen+7)*SecondInMs, tail).NextMiners, []types.AddressHash{carol})
+}
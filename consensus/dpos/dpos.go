@@ -5,19 +5,20 @@
 package dpos
 
 import (
-	"container/heap"
 	"errors"
 	"sync"
-	"sync/atomic"
 	"time"
 
+	"github.com/BOXFoundation/boxd/boxd/eventbus"
 	"github.com/BOXFoundation/boxd/boxd/service"
+	"github.com/BOXFoundation/boxd/consensus"
 	"github.com/BOXFoundation/boxd/core/chain"
 	"github.com/BOXFoundation/boxd/core/txpool"
 	"github.com/BOXFoundation/boxd/core/types"
 	"github.com/BOXFoundation/boxd/crypto"
 	"github.com/BOXFoundation/boxd/log"
 	"github.com/BOXFoundation/boxd/p2p"
+	"github.com/BOXFoundation/boxd/storage"
 	"github.com/BOXFoundation/boxd/util"
 	"github.com/BOXFoundation/boxd/wallet"
 	"github.com/jbenet/goprocess"
@@ -31,7 +32,9 @@ const (
 	NewBlockTimeInterval = int64(5000)
 	MaxPackedTxTime      = int64(2000)
 	MaxBlockTimeOut      = 2
-	PeriodSize           = 6
+	// maxBlockHeaderSize is room left in a block for all but its txs
+	maxBlockHeaderSize = 1024
+	PeriodSize         = 6
 )
 
 // Config defines the configurations of dpos
@@ -39,6 +42,17 @@ type Config struct {
 	Keypath    string `mapstructure:"keypath"`
 	EnableMint bool   `mapstructure:"enable_mint"`
 	Passphrase string `mapstructure:"passphrase"`
+	// Signer is the signing daemon holding the miner key, used instead of the
+	// keystore in Keypath if its address is set
+	Signer wallet.RemoteSignerConfig `mapstructure:"signer"`
+	// Miner is the address of the miner key held by Signer
+	Miner string `mapstructure:"miner"`
+	// BLSKeyPath is the file of the BLS key signing eternal votes, generated
+	// if missing. Its public key must be the one of the miner in the period
+	BLSKeyPath string `mapstructure:"bls_key_path"`
+	// VoterRewardPercent is the percent of block subsidy paid to voters of
+	// the miner, no reward is shared if 0. All nodes must agree on it
+	VoterRewardPercent uint32 `mapstructure:"voter_reward_percent"`
 }
 
 // Dpos define dpos struct
@@ -49,19 +63,39 @@ type Dpos struct {
 	net         p2p.Net
 	proc        goprocess.Process
 	cfg         *Config
-	miner       *wallet.Account
+	signer      minerSigner
+	blsKey      *crypto.BLSPrivateKey
 	enableMint  bool
 	disableMint bool
+	stats       *minerStats
+	bft         *BftService
+}
+
+func init() {
+	// register dpos impl
+	consensus.Register("dpos", newEngine)
+}
+
+func newEngine(params *consensus.Params) (consensus.Engine, error) {
+	cfg, ok := params.Config.(*Config)
+	if !ok {
+		return nil, consensus.ErrInvalidConfig
+	}
+	return NewDpos(params.Proc, params.Chain, params.TxPool, params.Net, cfg)
 }
 
 // NewDpos new a dpos implement.
 func NewDpos(parent goprocess.Process, chain *chain.BlockChain, txpool *txpool.TransactionPool, net p2p.Net, cfg *Config) (*Dpos, error) {
+	if cfg.VoterRewardPercent > 100 {
+		return nil, ErrInvalidRewardPercent
+	}
 	dpos := &Dpos{
 		chain:  chain,
 		txpool: txpool,
 		net:    net,
 		proc:   goprocess.WithParent(parent),
 		cfg:    cfg,
+		stats:  newMinerStats(),
 	}
 
 	context := &ConsensusContext{}
@@ -71,6 +105,18 @@ func NewDpos(parent goprocess.Process, chain *chain.BlockChain, txpool *txpool.T
 		return nil, err
 	}
 	context.periodContext = period
+	if err := dpos.updateTiming(chain.TailBlock().BlockHash()); err != nil {
+		return nil, err
+	}
+
+	if err := dpos.loadMinerStats(); err != nil {
+		return nil, err
+	}
+	chain.Bus().Subscribe(eventbus.TopicChainUpdate, dpos.onChainUpdate)
+
+	if dpos.bft, err = NewBftService(dpos); err != nil {
+		return nil, err
+	}
 
 	return dpos, nil
 }
@@ -82,37 +128,89 @@ func (dpos *Dpos) EnableMint() bool {
 
 // Setup setup dpos
 func (dpos *Dpos) Setup() error {
-	account, err := wallet.NewAccountFromFile(dpos.cfg.Keypath)
+	blsKey, err := crypto.LoadBLSKey(dpos.cfg.BLSKeyPath)
+	if err != nil {
+		return err
+	}
+	dpos.blsKey = blsKey
+
+	if len(dpos.cfg.Signer.Address) > 0 {
+		signer, err := newRemoteMinerSigner(&dpos.cfg.Signer, dpos.cfg.Miner)
+		if err != nil {
+			return err
+		}
+		dpos.signer = signer
+		return dpos.checkBLSKey()
+	}
+	signer, err := newKeystoreSigner(dpos.cfg.Keypath, dpos.cfg.Passphrase)
 	if err != nil {
 		return err
 	}
-	dpos.miner = account
+	dpos.signer = signer
+
+	return dpos.checkBLSKey()
+}
 
+// checkBLSKey checks if the BLS key is the one of the miner in the period,
+// or its eternal votes would be rejected
+func (dpos *Dpos) checkBLSKey() error {
+	addr := dpos.signer.Addr().Hash160()
+	for _, period := range dpos.context.periodContext.period {
+		if period.addr != *addr {
+			continue
+		}
+		if period.blsPubKey == nil || !period.blsPubKey.IsEqual(dpos.blsKey.PubKey()) {
+			logger.Errorf("BLS key in %s is not the one of miner %s", dpos.cfg.BLSKeyPath, dpos.signer.Addr())
+			return ErrInvalidBLSKey
+		}
+	}
 	return nil
 }
 
 // implement interface service.Server
 var _ service.Server = (*Dpos)(nil)
 
+// implement interface consensus.Engine
+var _ consensus.Engine = (*Dpos)(nil)
+
+// Start finalizes eternal blocks, watches epochs, and mints blocks if
+// enabled
+func (dpos *Dpos) Start() error {
+	dpos.RunBftService()
+	if dpos.EnableMint() {
+		if err := dpos.Setup(); err != nil {
+			return err
+		}
+	}
+	dpos.proc.Go(dpos.watchEpochs)
+	if !dpos.EnableMint() {
+		return nil
+	}
+	return dpos.Run()
+}
+
 // Run start dpos
 func (dpos *Dpos) Run() error {
 	logger.Info("Dpos run")
-	if !dpos.ValidateMiner() {
+	if !dpos.isPeriodMiner() {
 		logger.Warn("You have no authority to mint block")
 		return ErrNoLegalPowerToMint
 	}
-
-	// peer can mint, start bftService.
-	bftService, err := NewBftService(dpos)
-	if err != nil {
-		return err
+	if signer, ok := dpos.signer.(*remoteMinerSigner); ok {
+		dpos.proc.Go(signer.keepAlive)
 	}
-	bftService.Start()
+
 	dpos.proc.Go(dpos.loop)
 
 	return nil
 }
 
+// RunBftService starts finalizing eternal blocks, which every node does
+// whether it mints or not
+func (dpos *Dpos) RunBftService() {
+	dpos.bft.Start()
+}
+
 // Proc returns the goprocess running the service
 func (dpos *Dpos) Proc() goprocess.Process {
 	return dpos.proc
@@ -159,11 +257,16 @@ func (dpos *Dpos) mint(timestamp int64) error {
 		return err
 	}
 	MetricsMintTurnCounter.Inc(1)
+	if err := dpos.signer.Ready(); err != nil {
+		MetricsMintRefusedCounter.Inc(1)
+		logger.Errorf("Refuse to mint a block in my turn at %d, miner signer is not ready. Err: %v", timestamp, err)
+		return err
+	}
 	logger.Infof("My turn to mint a block, time: %d", timestamp)
+	dpos.context.timestamp = timestamp
 	if err := dpos.LoadCandidates(); err != nil {
 		return err
 	}
-	dpos.context.timestamp = timestamp
 
 	return dpos.mintBlock()
 }
@@ -175,48 +278,47 @@ func (dpos *Dpos) checkMiner(timestamp int64) error {
 	if err != nil {
 		return err
 	}
-	addr, err := types.NewAddress(dpos.miner.Addr())
-	if err != nil {
-		return err
-	}
-	if *miner != *addr.Hash160() {
+	if *miner != *dpos.signer.Addr().Hash160() {
 		return ErrNotMyTurnToMint
 	}
+	// blocks minted in epochs the miner is penalized in are rejected
+	if dpos.context.periodContext.penalties().isPenalized(*miner, dpos.context.periodContext.epochOf(timestamp)) {
+		return ErrPenalizedMiner
+	}
 	return nil
 }
 
-// ValidateMiner verifies whether the miner has authority to mint.
+// ValidateMiner verifies whether the miner has authority to mint and its
+// signer is able to sign.
 func (dpos *Dpos) ValidateMiner() bool {
 
-	if dpos.miner == nil {
-		return false
-	}
-
-	addr, err := types.NewAddress(dpos.miner.Addr())
-	if err != nil {
+	if !dpos.isPeriodMiner() {
 		return false
 	}
-	if !util.InArray(*addr.Hash160(), dpos.context.periodContext.periodAddrs) {
-		return false
-	}
-	if err := dpos.miner.UnlockWithPassphrase(dpos.cfg.Passphrase); err != nil {
+	if err := dpos.signer.Ready(); err != nil {
 		logger.Error(err)
 		return false
 	}
 	return true
 }
 
+// isPeriodMiner checks if the miner key is of a miner of the period
+func (dpos *Dpos) isPeriodMiner() bool {
+	if dpos.signer == nil {
+		return false
+	}
+	return util.InArray(*dpos.signer.Addr().Hash160(), dpos.context.periodContext.periodAddrs)
+}
+
 func (dpos *Dpos) mintBlock() error {
 
 	tail := dpos.chain.TailBlock()
 	block := types.NewBlock(tail)
 	block.Header.TimeStamp = dpos.context.timestamp
-	if block.Height > 0 && block.Height%chain.PeriodDuration == 0 {
-		// TODO: period changed
-	} else {
-		block.Header.PeriodHash = tail.Header.PeriodHash
-	}
-	if err := dpos.PackTxs(block, dpos.miner.PubKeyHash()); err != nil {
+	// TODO: elect miners of a new period at epoch boundaries, the period
+	// carries on till then
+	block.Header.PeriodHash = tail.Header.PeriodHash
+	if err := dpos.PackTxs(block, dpos.signer.Addr().Hash()); err != nil {
 		logger.Warnf("Failed to pack txs. err: %s", err.Error())
 		return err
 	}
@@ -231,39 +333,12 @@ func (dpos *Dpos) mintBlock() error {
 	return nil
 }
 
-func lessFunc(queue *util.PriorityQueue, i, j int) bool {
-
-	txi := queue.Items(i).(*chain.TxWrap)
-	txj := queue.Items(j).(*chain.TxWrap)
-	if txi.FeePerKB == txj.FeePerKB {
-		return txi.AddedTimestamp < txj.AddedTimestamp
-	}
-	return txi.FeePerKB < txj.FeePerKB
-}
-
-// sort pending transactions in mempool
-func (dpos *Dpos) sortPendingTxs() []*chain.TxWrap {
-	pool := util.NewPriorityQueue(lessFunc)
-	pendingTxs := dpos.txpool.GetAllTxs()
-	for _, pendingTx := range pendingTxs {
-		// place onto heap sorted by FeePerKB
-		heap.Push(pool, pendingTx)
-	}
-
-	var sortedTxs []*chain.TxWrap
-	for pool.Len() > 0 {
-		txWrap := heap.Pop(pool).(*chain.TxWrap)
-		sortedTxs = append(sortedTxs, txWrap)
-	}
-	return sortedTxs
-}
-
 // PackTxs packed txs and add them to block.
 func (dpos *Dpos) PackTxs(block *types.Block, scriptAddr []byte) error {
 
-	// We sort txs in mempool by fees when packing while ensuring child tx is not packed before parent tx.
-	// otherwise the former's utxo is missing
-	sortedTxs := dpos.sortPendingTxs()
+	// We pack txs in mempool by package fee rate, so a child paying a high fee pulls its parents
+	// along. Child tx is never packed before parent tx, otherwise the former's utxo is missing
+	sortedTxs := dpos.txpool.GetTxsByPackageFeeRate()
 	// if i-th sortedTxs is packed into the block
 	txPacked := make([]bool, len(sortedTxs))
 
@@ -273,7 +348,32 @@ func (dpos *Dpos) PackTxs(block *types.Block, scriptAddr []byte) error {
 		logger.Error("Failed to create coinbaseTx")
 		return errors.New("Failed to create coinbaseTx")
 	}
+	// voters of the miner share the reward as of the tail block
+	rewards, err := dpos.VoterRewardOutputs(block)
+	if err != nil {
+		return err
+	}
+	for _, txOut := range rewards {
+		coinbaseTx.Vout[0].Value -= txOut.Value
+		coinbaseTx.Vout = append(coinbaseTx.Vout, txOut)
+	}
+	// evidence of a miner double minting is put on chain in the coinbase, so
+	// that all nodes penalize the miner
+	if err := dpos.attachEvidence(block, coinbaseTx); err != nil {
+		return err
+	}
 	blockTxns = append(blockTxns, coinbaseTx)
+	// txs are packed as long as the block stays within max block size, with
+	// room left for the header
+	blockSize, err := coinbaseTx.SerializeSize()
+	if err != nil {
+		return err
+	}
+	params, err := dpos.ChainParams(&block.Header.PrevBlockHash, block.Header.TimeStamp)
+	if err != nil {
+		return err
+	}
+	maxTxsSize := int(params.MaxBlockSize) - maxBlockHeaderSize
 	remainTimeInMs := dpos.context.timestamp + MaxPackedTxTime - time.Now().Unix()*SecondInMs
 	remainTimer := time.NewTimer(time.Duration(remainTimeInMs) * time.Millisecond)
 
@@ -293,11 +393,10 @@ PackingTxs:
 						continue
 					}
 
-					if err := dpos.prepareCandidateContext(txWrap.Tx); err != nil {
-						// TODO: abandon the error tx
+					txSize, err := txWrap.Tx.SerializeSize()
+					if err != nil || blockSize+txSize > maxTxsSize {
 						continue
 					}
-
 					txHash, _ := txWrap.Tx.TxHash()
 					utxoSet, err := chain.GetExtendedTxUtxoSet(txWrap.Tx, dpos.chain.DB(), spendableTxs)
 					if err != nil {
@@ -309,8 +408,15 @@ PackingTxs:
 						// This can only occur for a mempool tx if its parent txs (also in mempool) are not packed yet
 						continue
 					}
+					// candidate data is applied only to txs packed, as it is
+					// when the block is connected
+					if err := dpos.prepareCandidateContext(txWrap.Tx); err != nil {
+						// TODO: abandon the error tx
+						continue
+					}
 					spendableTxs.Store(*txHash, txWrap)
 					blockTxns = append(blockTxns, txWrap.Tx)
+					blockSize += txSize
 					txPacked[i] = true
 					found = true
 				}
@@ -356,20 +462,19 @@ func (dpos *Dpos) LoadPeriodContext() (*PeriodContext, error) {
 	return periodContext, nil
 }
 
-// BroadcastEternalMsgToMiners broadcast eternal message to miners
+// BroadcastEternalMsgToMiners broadcast the vote of the miner to set block
+// eternal to miners, and counts it
 func (dpos *Dpos) BroadcastEternalMsgToMiners(block *types.Block) error {
 
 	eternalBlockMsg := &EternalBlockMsg{}
 	hash := block.BlockHash()
-	signature, err := crypto.SignCompact(dpos.miner.PrivateKey(), hash[:])
-	if err != nil {
-		return err
-	}
 	eternalBlockMsg.hash = *hash
-	eternalBlockMsg.signature = signature
+	eternalBlockMsg.signature = types.SignEternalVote(dpos.blsKey, hash)
 	eternalBlockMsg.timestamp = block.Header.TimeStamp
+	eternalBlockMsg.voter = *dpos.signer.Addr().Hash160()
 	miners := dpos.context.periodContext.periodPeers
 
+	dpos.bft.addOwnVote(eternalBlockMsg)
 	return dpos.net.BroadcastToMiners(p2p.EternalBlockMsg, eternalBlockMsg, miners)
 }
 
@@ -388,86 +493,135 @@ func (dpos *Dpos) StorePeriodContext() error {
 func (dpos *Dpos) LoadCandidates() error {
 
 	tail := dpos.chain.TailBlock()
-	db := dpos.chain.DB()
+	candidatesContext, err := dpos.loadCandidateContext(tail.BlockHash())
+	if err != nil {
+		return err
+	}
+	candidatesContext.height = tail.Height + 1
+	candidatesContext.timestamp = dpos.context.timestamp
+	candidatesContext.miners = dpos.currentMiners()
+	dpos.context.candidateContext = candidatesContext
+	return nil
+}
 
-	candidates, err := db.Get(tail.Header.CandidatesHash[:])
+// updateTiming maps time to epochs and slots under chain parameters
+// scheduled as of block hash, and leaves out slots of miners penalized as of
+// it
+func (dpos *Dpos) updateTiming(hash *crypto.HashType) error {
+	candidatesContext, err := dpos.loadCandidateContext(hash)
 	if err != nil {
 		return err
 	}
-	if candidates != nil {
-		candidatesContext := new(CandidateContext)
-		if err := candidatesContext.Unmarshal(candidates); err != nil {
-			return err
-		}
-		candidatesContext.height = tail.Height + 1
-		dpos.context.candidateContext = candidatesContext
-		return nil
+	dpos.context.periodContext.setTiming(newTiming(candidatesContext.eras))
+	dpos.context.periodContext.setPenalties(candidatesContext.penalties)
+	return nil
+}
+
+// ChainParams returns chain parameters in effect at unix time timestamp in
+// seconds, as scheduled by governance as of block parent
+func (dpos *Dpos) ChainParams(parent *crypto.HashType, timestamp int64) (*types.ChainParams, error) {
+	candidateContext, err := dpos.loadCandidateContext(parent)
+	if err != nil {
+		return nil, err
 	}
+	params := newTiming(candidateContext.eras).era(timestamp).params
+	return &params, nil
+}
 
-	candidatesContext := InitCandidateContext()
-	dpos.context.candidateContext = candidatesContext
-	return nil
+// loadCandidateContext loads candidate context stored as of block hash, an
+// initial one if none is stored
+func (dpos *Dpos) loadCandidateContext(hash *crypto.HashType) (*CandidateContext, error) {
+
+	candidates, err := dpos.chain.DB().Get(chain.CandidatesKey(hash))
+	if err != nil {
+		return nil, err
+	}
+	if candidates == nil {
+		return InitCandidateContext(), nil
+	}
+	candidatesContext := new(CandidateContext)
+	if err := candidatesContext.Unmarshal(candidates); err != nil {
+		return nil, err
+	}
+	return candidatesContext, nil
 }
 
-// StoreCandidateContext store candidate context
-func (dpos *Dpos) StoreCandidateContext(hash *crypto.HashType) error {
-	if dpos.context.candidateContext == nil {
-		if err := dpos.LoadCandidates(); err != nil {
-			return err
+// StoreCandidateContext store candidate context as of block, which is the
+// context of its parent updated by its txs, into the batch of block writes.
+// Blocks of miners penalized as of the parent are rejected, including ones
+// attached by reorgs
+func (dpos *Dpos) StoreCandidateContext(block *types.Block, batch storage.BatchWriter) error {
+
+	hash := block.BlockHash()
+	candidatesContext, err := dpos.loadCandidateContext(&block.Header.PrevBlockHash)
+	if err != nil {
+		return err
+	}
+	if err := dpos.checkPenalty(block, candidatesContext); err != nil {
+		return err
+	}
+	candidatesContext.height = block.Height
+	candidatesContext.timestamp = block.Header.TimeStamp
+	candidatesContext.miners = dpos.currentMiners()
+	for _, tx := range block.Txs {
+		if err := candidatesContext.applyTx(tx); err != nil {
+			txHash, _ := tx.TxHash()
+			logger.Warnf("Failed to apply candidate data of tx %v in block %v: %v", txHash, hash, err)
 		}
 	}
-	bytes, err := dpos.context.candidateContext.Marshal()
+	bytes, err := candidatesContext.Marshal()
 	if err != nil {
 		return err
 	}
-	db := dpos.chain.DB()
-	return db.Put(chain.CandidatesKey(hash), bytes)
+	batch.Put(chain.CandidatesKey(hash), bytes)
+	return nil
 }
 
-// prepareCandidateContext prepare to update CandidateContext.
-func (dpos *Dpos) prepareCandidateContext(tx *types.Transaction) error {
+// GetCandidates returns candidates with their votes as of the tail block,
+// most voted first, and the height of the tail block. Candidates penalized
+// for double minting as of the tail block are left out. Ones missing too
+// many of their slots are flagged absent for reporting only, as seen by this
+// node, which neither reorders them nor affects the miner schedule
+func (dpos *Dpos) GetCandidates() ([]*types.CandidateInfo, uint32, error) {
 
-	if tx.Data == nil {
-		return nil
-	}
-	content := tx.Data.Content
-	candidateContext := dpos.context.candidateContext
-	switch int(tx.Data.Type) {
-	case types.RegisterCandidateTx:
-		signUpContent := new(types.SignUpContent)
-		if err := signUpContent.Unmarshal(content); err != nil {
-			return err
-		}
-		if util.InArray(signUpContent.Addr(), candidateContext.addrs) {
-			return ErrDuplicateSignUpTx
-		}
-		candidate := &Candidate{
-			addr:  signUpContent.Addr(),
-			votes: 0,
-		}
-		candidateContext.candidates = append(candidateContext.candidates, candidate)
-	case types.VoteTx:
-		votesContent := new(types.VoteContent)
-		if err := votesContent.Unmarshal(content); err != nil {
-			return err
-		}
-		if !util.InArray(votesContent.Addr(), dpos.context.candidateContext.addrs) {
-			return ErrCandidateNotFound
-		}
-		for _, v := range candidateContext.candidates {
-			if v.addr == votesContent.Addr() {
-				atomic.AddInt64(&v.votes, votesContent.Votes())
-			}
+	tail := dpos.chain.TailBlock()
+	candidatesContext, err := dpos.loadCandidateContext(tail.BlockHash())
+	if err != nil {
+		return nil, 0, err
+	}
+	epoch := newTiming(candidatesContext.eras).epochOf(time.Now().Unix())
+	absent := dpos.stats.absentMiners()
+	var candidates []*types.CandidateInfo
+	for _, candidate := range candidatesContext.Candidates() {
+		if candidatesContext.penalties.isPenalized(candidate.Addr, epoch) {
+			continue
 		}
-	default:
+		candidate.Absent = absent[candidate.Addr]
+		candidates = append(candidates, candidate)
 	}
-	return nil
+	return candidates, tail.Height, nil
+}
+
+// GetMinerStats returns blocks each miner produced and was expected to produce
+// in the last epochs epochs, all kept if epochs is 0, the miner missing most
+// first
+func (dpos *Dpos) GetMinerStats(epochs uint32) ([]*types.MinerStats, error) {
+	return dpos.stats.stats(epochs), nil
+}
+
+// GetMinerSchedule returns miners of the current epoch with their slots
+func (dpos *Dpos) GetMinerSchedule() (*types.MinerSchedule, error) {
+	return dpos.context.periodContext.schedule(time.Now().UnixNano()/int64(time.Millisecond), dpos.chain.TailBlock()), nil
+}
+
+// prepareCandidateContext prepare to update CandidateContext.
+func (dpos *Dpos) prepareCandidateContext(tx *types.Transaction) error {
+	return dpos.context.candidateContext.applyTx(tx)
 }
 
 func (dpos *Dpos) signBlock(block *types.Block) error {
 
-	hash := block.BlockHash()
-	signature, err := crypto.SignCompact(dpos.miner.PrivateKey(), hash[:])
+	signature, err := dpos.signer.SignCompact(block.BlockHash())
 	if err != nil {
 		return err
 	}
@@ -475,7 +629,8 @@ func (dpos *Dpos) signBlock(block *types.Block) error {
 	return nil
 }
 
-// VerifyMinerEpoch verifies miner epoch.
+// VerifyMinerEpoch verifies miner epoch, and that the miner is not penalized
+// in it as of the parent of block.
 func (dpos *Dpos) VerifyMinerEpoch(block *types.Block) error {
 
 	tail := dpos.chain.TailBlock()
@@ -483,6 +638,13 @@ func (dpos *Dpos) VerifyMinerEpoch(block *types.Block) error {
 	if err != nil {
 		return err
 	}
+	candidatesContext, err := dpos.loadCandidateContext(&block.Header.PrevBlockHash)
+	if err != nil {
+		return err
+	}
+	if err := dpos.checkPenalty(block, candidatesContext); err != nil {
+		return err
+	}
 
 	for idx := 0; idx < 2*PeriodSize/3; {
 		height := tail.Height - uint32(idx)
@@ -505,6 +667,24 @@ func (dpos *Dpos) VerifyMinerEpoch(block *types.Block) error {
 	return nil
 }
 
+// checkPenalty checks if the miner of block is penalized in its epoch as of
+// candidatesContext, the context of its parent
+func (dpos *Dpos) checkPenalty(block *types.Block, candidatesContext *CandidateContext) error {
+	if len(candidatesContext.penalties) == 0 {
+		return nil
+	}
+	miner, err := dpos.context.periodContext.FindMinerWithTimeStamp(block.Header.TimeStamp)
+	if err != nil {
+		return err
+	}
+	epoch := newTiming(candidatesContext.eras).epochOf(block.Header.TimeStamp)
+	if candidatesContext.penalties.isPenalized(*miner, epoch) {
+		logger.Warnf("Reject block %v of miner %x penalized in epoch %d", block.BlockHash(), miner[:], epoch)
+		return ErrPenalizedMiner
+	}
+	return nil
+}
+
 // VerifySign consensus verifies signature info.
 func (dpos *Dpos) VerifySign(block *types.Block) (bool, error) {
 
@@ -43,7 +43,7 @@ var (
 func NewDummyDpos(cfg *Config) *DummyDpos {
 
 	blockchain := chain.NewTestBlockChain()
-	txPool := txpool.NewTransactionPool(blockchain.Proc(), p2p.NewDummyPeer(), blockchain, bus)
+	txPool := txpool.NewTransactionPool(blockchain.Proc(), p2p.NewDummyPeer(), blockchain, bus, &txpool.Config{})
 	dpos, _ := NewDpos(txPool.Proc(), blockchain, txPool, p2p.NewDummyPeer(), cfg)
 	blockchain.Setup(dpos, nil)
 	dpos.Setup()
@@ -0,0 +1,114 @@
+// Copyright (c) 2018 ContentBox Authors.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package dpos
+
+import (
+	"time"
+
+	"github.com/BOXFoundation/boxd/core/types"
+	"github.com/jbenet/goprocess"
+)
+
+//go:generate go run ../../boxd/eventbus/gentopics -imports github.com/BOXFoundation/boxd/core/types EpochChange=change:*types.EpochChange MinerSetChange=change:*types.MinerSetChange
+
+// epochWatcher tracks the epoch and its miners to tell when either changes
+type epochWatcher struct {
+	epoch  int64
+	miners []types.AddressHash
+}
+
+// next moves the watcher to epoch with miners. It returns the epoch change
+// if epoch rolls over, and the miner set change if miners differ from
+// those of the last epoch. Nothing changes on the first epoch watched
+func (w *epochWatcher) next(epoch int64, miners []types.AddressHash) (*types.EpochChange, *types.MinerSetChange) {
+	if w.miners == nil {
+		w.epoch, w.miners = epoch, miners
+		return nil, nil
+	}
+	if epoch <= w.epoch {
+		return nil, nil
+	}
+	epochChange := &types.EpochChange{Epoch: epoch, Miners: miners}
+	setChange := &types.MinerSetChange{
+		Epoch:   epoch,
+		Entered: subtractMiners(miners, w.miners),
+		Left:    subtractMiners(w.miners, miners),
+		Miners:  miners,
+	}
+	w.epoch, w.miners = epoch, miners
+	if len(setChange.Entered) == 0 && len(setChange.Left) == 0 {
+		return epochChange, nil
+	}
+	return epochChange, setChange
+}
+
+// subtractMiners returns miners in a not in b, in the order of a
+func subtractMiners(a, b []types.AddressHash) []types.AddressHash {
+	in := make(map[types.AddressHash]bool, len(b))
+	for _, miner := range b {
+		in[miner] = true
+	}
+	var diff []types.AddressHash
+	for _, miner := range a {
+		if !in[miner] {
+			diff = append(diff, miner)
+		}
+	}
+	return diff
+}
+
+// watchEpochs publishes epoch transitions and miner set changes on the
+// eventbus till p closes
+func (dpos *Dpos) watchEpochs(p goprocess.Process) {
+	watcher := new(epochWatcher)
+	watcher.next(dpos.context.periodContext.epochOf(time.Now().Unix()), dpos.currentMiners())
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			epochChange, setChange := watcher.next(dpos.context.periodContext.epochOf(time.Now().Unix()), dpos.currentMiners())
+			if epochChange != nil {
+				PublishEpochChange(dpos.chain.Bus(), epochChange)
+			}
+			if setChange != nil {
+				dpos.onMinerSetChange(setChange)
+				PublishMinerSetChange(dpos.chain.Bus(), setChange)
+			}
+		case <-p.Closing():
+			return
+		}
+	}
+}
+
+// currentMiners returns miners of the current period
+func (dpos *Dpos) currentMiners() []types.AddressHash {
+	period := dpos.context.periodContext.period
+	miners := make([]types.AddressHash, len(period))
+	for i, v := range period {
+		miners[i] = v.addr
+	}
+	return miners
+}
+
+// onMinerSetChange logs miners entering or leaving the active set, and
+// whether the miner of the node is one of them
+func (dpos *Dpos) onMinerSetChange(change *types.MinerSetChange) {
+	logger.Infof("Miner set changes at epoch %d, %d entered and %d left", change.Epoch, len(change.Entered), len(change.Left))
+	if dpos.signer == nil {
+		return
+	}
+	own := *dpos.signer.Addr().Hash160()
+	for _, miner := range change.Entered {
+		if miner == own {
+			logger.Infof("Miner %s entered the active miner set at epoch %d", dpos.signer.Addr(), change.Epoch)
+		}
+	}
+	for _, miner := range change.Left {
+		if miner == own {
+			logger.Warnf("Miner %s left the active miner set at epoch %d", dpos.signer.Addr(), change.Epoch)
+		}
+	}
+}
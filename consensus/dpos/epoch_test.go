@@ -0,0 +1,37 @@
+// Copyright (c) 2018 ContentBox Authors.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package dpos
+
+import (
+	"testing"
+
+	"github.com/BOXFoundation/boxd/core/types"
+	"github.com/facebookgo/ensure"
+)
+
+func TestEpochWatcher(t *testing.T) {
+	alice, bob, carol := types.AddressHash{0x01}, types.AddressHash{0x02}, types.AddressHash{0x03}
+	watcher := new(epochWatcher)
+
+	// nothing changes on the first epoch watched, or within an epoch
+	epochChange, setChange := watcher.next(10, []types.AddressHash{alice, bob})
+	ensure.True(t, epochChange == nil && setChange == nil)
+	epochChange, setChange = watcher.next(10, []types.AddressHash{alice, carol})
+	ensure.True(t, epochChange == nil && setChange == nil)
+
+	// the same miners in another order are no change of the set
+	epochChange, setChange = watcher.next(11, []types.AddressHash{bob, alice})
+	ensure.DeepEqual(t, epochChange, &types.EpochChange{Epoch: 11, Miners: []types.AddressHash{bob, alice}})
+	ensure.True(t, setChange == nil)
+
+	epochChange, setChange = watcher.next(12, []types.AddressHash{carol, alice})
+	ensure.DeepEqual(t, epochChange.Epoch, int64(12))
+	ensure.DeepEqual(t, setChange, &types.MinerSetChange{
+		Epoch:   12,
+		Entered: []types.AddressHash{carol},
+		Left:    []types.AddressHash{bob},
+		Miners:  []types.AddressHash{carol, alice},
+	})
+}
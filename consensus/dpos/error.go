@@ -15,10 +15,16 @@ var (
 	ErrNotFoundMiner          = errors.New("Failed to find miner")
 	ErrDuplicateSignUpTx      = errors.New("Duplicate sign up tx")
 	ErrCandidateNotFound      = errors.New("Candidate not found")
+	ErrInsufficientVotes      = errors.New("Withdrawing more votes than the voter casts")
+	ErrInvalidVoter           = errors.New("Voter of the tx is not found in its first input")
+	ErrInvalidRewardPercent   = errors.New("Voter reward percent must be no more than 100")
 	ErrRepeatedMintAtSameTime = errors.New("Repeated mint at same time")
 	ErrFailedToVerifySign     = errors.New("Failed to verify sign block")
 	ErrNotMintPeer            = errors.New("Invalid mint peer")
 	ErrInvalidMinerEpoch      = errors.New("Invalid miner epoch")
+	ErrInvalidChainParams     = errors.New("Invalid chain parameters")
+	ErrNotMinerProposal       = errors.New("Governance tx is not signed by a miner")
+	ErrPenalizedMiner         = errors.New("Miner is penalized for double minting")
 
 	// context
 	ErrInvalidCandidateProtoMessage        = errors.New("Invalid candidate proto message")
@@ -32,4 +38,9 @@ var (
 	ErrNoNeedToUpdateEternalBlock = errors.New("No need to update Eternal block")
 	ErrIllegalMsg                 = errors.New("Illegal message from remote peer")
 	ErrEternalBlockMsgHashIsExist = errors.New("EternalBlockMsgHash is already exist")
+	ErrInvalidBLSKey              = errors.New("BLS key is not the one of the miner in the period")
+
+	// signer
+	ErrNoMinerAddress    = errors.New("Miner address must be set to sign with a remote signer")
+	ErrSignerUnreachable = errors.New("Miner signer is unreachable")
 )
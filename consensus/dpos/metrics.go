@@ -11,4 +11,13 @@ import (
 var (
 	// MetricsMintTurnCounter signs whose turn to mint
 	MetricsMintTurnCounter = metrics.NewCounter("box.dpos.mint.turn")
+	// MetricsMintRefusedCounter counts turns to mint refused as the miner
+	// signer is not ready
+	MetricsMintRefusedCounter = metrics.NewCounter("box.dpos.mint.refused")
+	// MetricsSignerReachableGauge is 1 if the remote miner signer is reachable,
+	// 0 otherwise
+	MetricsSignerReachableGauge = metrics.NewGauge("box.dpos.signer.reachable")
+	// MetricsSignerUnreachableCounter counts failed checks of the remote miner
+	// signer
+	MetricsSignerUnreachableCounter = metrics.NewCounter("box.dpos.signer.unreachable")
 )
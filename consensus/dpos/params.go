@@ -0,0 +1,220 @@
+// Copyright (c) 2018 ContentBox Authors.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package dpos
+
+import (
+	"github.com/BOXFoundation/boxd/consensus/dpos/pb"
+	"github.com/BOXFoundation/boxd/core/chain"
+	"github.com/BOXFoundation/boxd/core/types"
+	"github.com/BOXFoundation/boxd/util"
+)
+
+const (
+	// minBlockSize is the least max block size governance may set
+	minBlockSize = 1024
+	// maxEpochDuration is the longest time in seconds governance may set an
+	// epoch to last, a day
+	maxEpochDuration = 24 * 3600
+)
+
+// defaultParams returns chain parameters in effect till governance changes
+// them
+func defaultParams() types.ChainParams {
+	return types.ChainParams{
+		BlockInterval:  NewBlockTimeInterval,
+		PeriodDuration: PeriodSize,
+		MaxBlockSize:   chain.MaxBlockSize,
+	}
+}
+
+// validateParams checks if params are fit to be in effect
+func validateParams(params *types.ChainParams) error {
+	if params.BlockInterval <= MaxPackedTxTime || params.BlockInterval%SecondInMs != 0 {
+		return ErrInvalidChainParams
+	}
+	// each miner of a period mints as many slots in an epoch
+	if params.PeriodDuration == 0 || params.PeriodDuration%PeriodSize != 0 {
+		return ErrInvalidChainParams
+	}
+	if int64(params.PeriodDuration)*params.BlockInterval/SecondInMs > maxEpochDuration {
+		return ErrInvalidChainParams
+	}
+	if params.MaxBlockSize < minBlockSize || params.MaxBlockSize > chain.MaxBlockSize {
+		return ErrInvalidChainParams
+	}
+	return nil
+}
+
+// paramsEra is a span of time chain parameters are in effect in, from the
+// start of an epoch till the next era
+type paramsEra struct {
+	// start is the unix time in seconds the era starts at, and epoch is the
+	// epoch starting then
+	start  int64
+	epoch  int64
+	params types.ChainParams
+}
+
+// interval returns seconds between blocks in the era
+func (era *paramsEra) interval() int64 {
+	return era.params.BlockInterval / SecondInMs
+}
+
+// epochLen returns seconds an epoch lasts in the era
+func (era *paramsEra) epochLen() int64 {
+	return era.interval() * int64(era.params.PeriodDuration)
+}
+
+// timing maps time to epochs and slots under chain parameters of eras
+type timing struct {
+	// eras are in time order, the first one of default parameters from time 0
+	eras []*paramsEra
+}
+
+// defaultTiming maps time under default parameters, before any governance
+var defaultTiming = newTiming(nil)
+
+// newTiming creates a timing of eras scheduled by governance
+func newTiming(eras []*paramsEra) *timing {
+	return &timing{eras: append([]*paramsEra{{params: defaultParams()}}, eras...)}
+}
+
+// era returns the era unix time timestamp in seconds falls in
+func (t *timing) era(timestamp int64) *paramsEra {
+	for i := len(t.eras) - 1; i > 0; i-- {
+		if t.eras[i].start <= timestamp {
+			return t.eras[i]
+		}
+	}
+	return t.eras[0]
+}
+
+// epochOf returns the epoch unix time timestamp in seconds falls in
+func (t *timing) epochOf(timestamp int64) int64 {
+	era := t.era(timestamp)
+	return era.epoch + (timestamp-era.start)/era.epochLen()
+}
+
+// epochStart returns the unix time in seconds epoch starts at
+func (t *timing) epochStart(epoch int64) int64 {
+	era := t.eras[0]
+	for _, v := range t.eras[1:] {
+		if v.epoch <= epoch {
+			era = v
+		}
+	}
+	return era.start + (epoch-era.epoch)*era.epochLen()
+}
+
+// slot returns the offset in its epoch of the slot starting at unix time
+// timestamp in seconds, ErrWrongTimeToMint if no slot starts then
+func (t *timing) slot(timestamp int64) (int64, error) {
+	era := t.era(timestamp)
+	offset := (timestamp - era.start) % era.epochLen()
+	if offset%era.interval() != 0 {
+		return 0, ErrWrongTimeToMint
+	}
+	return offset / era.interval(), nil
+}
+
+// nextSlot returns the unix time in seconds the first slot after timestamp
+// starts at
+func (t *timing) nextSlot(timestamp int64) int64 {
+	era := t.era(timestamp)
+	next := era.start + ((timestamp-era.start)/era.interval()+1)*era.interval()
+	for _, v := range t.eras {
+		if v.start > timestamp && v.start < next {
+			next = v.start
+		}
+	}
+	return next
+}
+
+// proposal is chain parameters proposed by governance txs, with miners
+// signing them
+type proposal struct {
+	params types.ChainParams
+	miners []types.AddressHash
+}
+
+// propose counts the proposal of params by the signer of tx, which must be
+// a miner of the period. Each miner backs one proposal, its latest. Once
+// more than 2/3 of miners back a proposal, params take effect from the
+// epoch after the one txs are applied in
+func (candidateContext *CandidateContext) propose(tx *types.Transaction, params *types.ChainParams) error {
+	if err := validateParams(params); err != nil {
+		return err
+	}
+	proposer, err := voterOf(tx)
+	if err != nil || !util.InArray(*proposer, candidateContext.miners) {
+		return ErrNotMinerProposal
+	}
+
+	var backed *proposal
+	proposals := candidateContext.proposals[:0]
+	for _, v := range candidateContext.proposals {
+		for i, miner := range v.miners {
+			if miner == *proposer {
+				v.miners = append(v.miners[:i], v.miners[i+1:]...)
+				break
+			}
+		}
+		if v.params == *params {
+			backed = v
+		} else if len(v.miners) == 0 {
+			continue
+		}
+		proposals = append(proposals, v)
+	}
+	if backed == nil {
+		backed = &proposal{params: *params}
+		proposals = append(proposals, backed)
+	}
+	backed.miners = append(backed.miners, *proposer)
+	candidateContext.proposals = proposals
+
+	votes := 0
+	for _, miner := range backed.miners {
+		if util.InArray(miner, candidateContext.miners) {
+			votes++
+		}
+	}
+	if votes < types.Quorum(len(candidateContext.miners)) {
+		return nil
+	}
+
+	// params take effect at the next epoch, replacing ones scheduled at or
+	// after it
+	t := newTiming(candidateContext.eras)
+	epoch := t.epochOf(candidateContext.timestamp) + 1
+	eras := candidateContext.eras[:0]
+	for _, v := range candidateContext.eras {
+		if v.epoch < epoch {
+			eras = append(eras, v)
+		}
+	}
+	candidateContext.eras = append(eras, &paramsEra{start: t.epochStart(epoch), epoch: epoch, params: *params})
+	candidateContext.proposals = nil
+	return nil
+}
+
+func chainParamsToProto(params *types.ChainParams) *dpospb.ChainParams {
+	return &dpospb.ChainParams{
+		BlockInterval:  params.BlockInterval,
+		PeriodDuration: params.PeriodDuration,
+		MaxBlockSize:   params.MaxBlockSize,
+	}
+}
+
+func chainParamsFromProto(message *dpospb.ChainParams) types.ChainParams {
+	if message == nil {
+		return types.ChainParams{}
+	}
+	return types.ChainParams{
+		BlockInterval:  message.BlockInterval,
+		PeriodDuration: message.PeriodDuration,
+		MaxBlockSize:   message.MaxBlockSize,
+	}
+}
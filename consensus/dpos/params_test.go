@@ -0,0 +1,131 @@
+// Copyright (c) 2018 ContentBox Authors.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package dpos
+
+import (
+	"testing"
+
+	"github.com/BOXFoundation/boxd/core/types"
+	"github.com/facebookgo/ensure"
+)
+
+func TestTiming(t *testing.T) {
+	// epochs last 30s by default, and 60s from epoch 10 at 300s on
+	params := defaultParams()
+	params.BlockInterval = 10 * SecondInMs
+	timing := newTiming([]*paramsEra{{start: 300, epoch: 10, params: params}})
+
+	ensure.DeepEqual(t, timing.epochOf(299), int64(9))
+	ensure.DeepEqual(t, timing.epochOf(300), int64(10))
+	ensure.DeepEqual(t, timing.epochOf(359), int64(10))
+	ensure.DeepEqual(t, timing.epochOf(360), int64(11))
+	ensure.DeepEqual(t, timing.epochStart(9), int64(270))
+	ensure.DeepEqual(t, timing.epochStart(11), int64(360))
+
+	offset, err := timing.slot(295)
+	ensure.Nil(t, err)
+	ensure.DeepEqual(t, offset, int64(5))
+	offset, err = timing.slot(320)
+	ensure.Nil(t, err)
+	ensure.DeepEqual(t, offset, int64(2))
+	_, err = timing.slot(305)
+	ensure.DeepEqual(t, err, ErrWrongTimeToMint)
+
+	ensure.DeepEqual(t, timing.nextSlot(290), int64(295))
+	ensure.DeepEqual(t, timing.nextSlot(297), int64(300))
+	ensure.DeepEqual(t, timing.nextSlot(300), int64(310))
+}
+
+func TestCandidateContextPropose(t *testing.T) {
+	alice, aliceAddr := newVoter(t)
+	bob, bobAddr := newVoter(t)
+	carol, carolAddr := newVoter(t)
+	outsider, _ := newVoter(t)
+	context := InitCandidateContext()
+	context.miners = []types.AddressHash{aliceAddr, bobAddr, carolAddr}
+	context.timestamp = 100
+
+	params := defaultParams()
+	params.BlockInterval = 10 * SecondInMs
+	params.MaxBlockSize = 1 << 20
+	other := params
+	other.PeriodDuration = 2 * PeriodSize
+
+	invalid := params
+	invalid.BlockInterval = 1500
+	ensure.DeepEqual(t, context.applyTx(newVoterTx(alice, types.GovernanceTx, types.NewGovernanceContent(&invalid))), ErrInvalidChainParams)
+	// epochs are whole rounds of the period miners lasting a day at most
+	invalid = params
+	invalid.PeriodDuration = PeriodSize + 1
+	ensure.DeepEqual(t, context.applyTx(newVoterTx(alice, types.GovernanceTx, types.NewGovernanceContent(&invalid))), ErrInvalidChainParams)
+	invalid.PeriodDuration = PeriodSize * (maxEpochDuration/10/PeriodSize + 1)
+	ensure.DeepEqual(t, context.applyTx(newVoterTx(alice, types.GovernanceTx, types.NewGovernanceContent(&invalid))), ErrInvalidChainParams)
+	ensure.DeepEqual(t, context.applyTx(newVoterTx(outsider, types.GovernanceTx, types.NewGovernanceContent(&params))), ErrNotMinerProposal)
+
+	// a miner backs its latest proposal only
+	ensure.Nil(t, context.applyTx(newVoterTx(alice, types.GovernanceTx, types.NewGovernanceContent(&params))))
+	ensure.Nil(t, context.applyTx(newVoterTx(bob, types.GovernanceTx, types.NewGovernanceContent(&other))))
+	ensure.Nil(t, context.applyTx(newVoterTx(alice, types.GovernanceTx, types.NewGovernanceContent(&other))))
+	ensure.DeepEqual(t, len(context.proposals), 1)
+	ensure.DeepEqual(t, len(context.eras), 0)
+
+	// params take effect from the next epoch once 2/3+ of miners back them
+	ensure.Nil(t, context.applyTx(newVoterTx(bob, types.GovernanceTx, types.NewGovernanceContent(&params))))
+	ensure.Nil(t, context.applyTx(newVoterTx(carol, types.GovernanceTx, types.NewGovernanceContent(&params))))
+	ensure.Nil(t, context.applyTx(newVoterTx(alice, types.GovernanceTx, types.NewGovernanceContent(&params))))
+	ensure.DeepEqual(t, context.eras, []*paramsEra{{start: 120, epoch: 4, params: params}})
+	ensure.DeepEqual(t, len(context.proposals), 0)
+
+	// scheduled params are kept across storing
+	data, err := context.Marshal()
+	ensure.Nil(t, err)
+	stored := new(CandidateContext)
+	ensure.Nil(t, stored.Unmarshal(data))
+	ensure.DeepEqual(t, stored.eras, context.eras)
+}
+
+func TestGovernedEpochLength(t *testing.T) {
+	alice, aliceAddr := newVoter(t)
+	bob, bobAddr := newVoter(t)
+	context := InitCandidateContext()
+	context.miners = []types.AddressHash{aliceAddr, bobAddr}
+	context.timestamp = 100
+
+	// miners of the period mint two rounds an epoch from epoch 4 on
+	params := defaultParams()
+	params.PeriodDuration = 2 * PeriodSize
+	ensure.Nil(t, context.applyTx(newVoterTx(alice, types.GovernanceTx, types.NewGovernanceContent(&params))))
+	ensure.Nil(t, context.applyTx(newVoterTx(bob, types.GovernanceTx, types.NewGovernanceContent(&params))))
+	ensure.DeepEqual(t, context.eras, []*paramsEra{{start: 120, epoch: 4, params: params}})
+
+	// epochs last 30s till 120s and 60s from then on
+	timing := newTiming(context.eras)
+	ensure.DeepEqual(t, timing.epochOf(119), int64(3))
+	ensure.DeepEqual(t, timing.epochOf(179), int64(4))
+	ensure.DeepEqual(t, timing.epochOf(180), int64(5))
+	ensure.DeepEqual(t, timing.epochStart(3), int64(90))
+	ensure.DeepEqual(t, timing.epochStart(6), int64(240))
+
+	// slots of the 2nd round are minted by the period miners again
+	period := make([]*Period, PeriodSize)
+	for i := range period {
+		period[i] = &Period{addr: types.AddressHash{byte(i)}}
+	}
+	pc := &PeriodContext{period: period}
+	pc.setTiming(timing)
+	miner, err := pc.FindMinerWithTimeStamp(175)
+	ensure.Nil(t, err)
+	ensure.DeepEqual(t, *miner, period[5].addr)
+	miner, err = pc.FindMinerWithTimeStamp(150)
+	ensure.Nil(t, err)
+	ensure.DeepEqual(t, *miner, period[0].addr)
+
+	tail := &types.Block{Header: &types.BlockHeader{TimeStamp: 170}, Height: 20}
+	schedule := pc.schedule(170*SecondInMs, tail)
+	ensure.DeepEqual(t, schedule.Epoch, int64(4))
+	ensure.DeepEqual(t, len(schedule.Slots), 2*PeriodSize)
+	ensure.DeepEqual(t, schedule.NextEpochTime, int64(180))
+	ensure.DeepEqual(t, schedule.NextEpochHeight, uint32(22))
+}
@@ -29,7 +29,7 @@ func (m *PeriodContext) Reset()         { *m = PeriodContext{} }
 func (m *PeriodContext) String() string { return proto.CompactTextString(m) }
 func (*PeriodContext) ProtoMessage()    {}
 func (*PeriodContext) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dpos_2c0792c77d69a47e, []int{0}
+	return fileDescriptor_dpos_7bd0e244eb0788b9, []int{0}
 }
 func (m *PeriodContext) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -75,13 +75,15 @@ func (m *PeriodContext) GetNextPeriod() []*Period {
 type Period struct {
 	Addr   []byte `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
 	PeerId string `protobuf:"bytes,2,opt,name=peer_id,json=peerId,proto3" json:"peer_id,omitempty"`
+	// BLS public key the miner signs eternal votes with
+	BlsPubKey []byte `protobuf:"bytes,3,opt,name=bls_pub_key,json=blsPubKey,proto3" json:"bls_pub_key,omitempty"`
 }
 
 func (m *Period) Reset()         { *m = Period{} }
 func (m *Period) String() string { return proto.CompactTextString(m) }
 func (*Period) ProtoMessage()    {}
 func (*Period) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dpos_2c0792c77d69a47e, []int{1}
+	return fileDescriptor_dpos_7bd0e244eb0788b9, []int{1}
 }
 func (m *Period) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -124,16 +126,28 @@ func (m *Period) GetPeerId() string {
 	return ""
 }
 
+func (m *Period) GetBlsPubKey() []byte {
+	if m != nil {
+		return m.BlsPubKey
+	}
+	return nil
+}
+
 type CandidateContext struct {
 	Height     uint32       `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
 	Candidates []*Candidate `protobuf:"bytes,2,rep,name=candidates" json:"candidates,omitempty"`
+	// chain parameters scheduled by governance, in time order
+	Eras      []*ParamsEra `protobuf:"bytes,3,rep,name=eras" json:"eras,omitempty"`
+	Proposals []*Proposal  `protobuf:"bytes,4,rep,name=proposals" json:"proposals,omitempty"`
+	// miners double minting as evidences on chain say, in address order
+	Penalties []*Penalty `protobuf:"bytes,5,rep,name=penalties" json:"penalties,omitempty"`
 }
 
 func (m *CandidateContext) Reset()         { *m = CandidateContext{} }
 func (m *CandidateContext) String() string { return proto.CompactTextString(m) }
 func (*CandidateContext) ProtoMessage()    {}
 func (*CandidateContext) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dpos_2c0792c77d69a47e, []int{2}
+	return fileDescriptor_dpos_7bd0e244eb0788b9, []int{2}
 }
 func (m *CandidateContext) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -176,17 +190,265 @@ func (m *CandidateContext) GetCandidates() []*Candidate {
 	return nil
 }
 
+func (m *CandidateContext) GetEras() []*ParamsEra {
+	if m != nil {
+		return m.Eras
+	}
+	return nil
+}
+
+func (m *CandidateContext) GetProposals() []*Proposal {
+	if m != nil {
+		return m.Proposals
+	}
+	return nil
+}
+
+func (m *CandidateContext) GetPenalties() []*Penalty {
+	if m != nil {
+		return m.Penalties
+	}
+	return nil
+}
+
+type ChainParams struct {
+	BlockInterval  int64  `protobuf:"varint,1,opt,name=block_interval,json=blockInterval,proto3" json:"block_interval,omitempty"`
+	PeriodDuration uint32 `protobuf:"varint,2,opt,name=period_duration,json=periodDuration,proto3" json:"period_duration,omitempty"`
+	MaxBlockSize   uint32 `protobuf:"varint,3,opt,name=max_block_size,json=maxBlockSize,proto3" json:"max_block_size,omitempty"`
+}
+
+func (m *ChainParams) Reset()         { *m = ChainParams{} }
+func (m *ChainParams) String() string { return proto.CompactTextString(m) }
+func (*ChainParams) ProtoMessage()    {}
+func (*ChainParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dpos_7bd0e244eb0788b9, []int{3}
+}
+func (m *ChainParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChainParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChainParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *ChainParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChainParams.Merge(dst, src)
+}
+func (m *ChainParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChainParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChainParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChainParams proto.InternalMessageInfo
+
+func (m *ChainParams) GetBlockInterval() int64 {
+	if m != nil {
+		return m.BlockInterval
+	}
+	return 0
+}
+
+func (m *ChainParams) GetPeriodDuration() uint32 {
+	if m != nil {
+		return m.PeriodDuration
+	}
+	return 0
+}
+
+func (m *ChainParams) GetMaxBlockSize() uint32 {
+	if m != nil {
+		return m.MaxBlockSize
+	}
+	return 0
+}
+
+type ParamsEra struct {
+	Start  int64        `protobuf:"varint,1,opt,name=start,proto3" json:"start,omitempty"`
+	Epoch  int64        `protobuf:"varint,2,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	Params *ChainParams `protobuf:"bytes,3,opt,name=params" json:"params,omitempty"`
+}
+
+func (m *ParamsEra) Reset()         { *m = ParamsEra{} }
+func (m *ParamsEra) String() string { return proto.CompactTextString(m) }
+func (*ParamsEra) ProtoMessage()    {}
+func (*ParamsEra) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dpos_7bd0e244eb0788b9, []int{4}
+}
+func (m *ParamsEra) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ParamsEra) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ParamsEra.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *ParamsEra) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ParamsEra.Merge(dst, src)
+}
+func (m *ParamsEra) XXX_Size() int {
+	return m.Size()
+}
+func (m *ParamsEra) XXX_DiscardUnknown() {
+	xxx_messageInfo_ParamsEra.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ParamsEra proto.InternalMessageInfo
+
+func (m *ParamsEra) GetStart() int64 {
+	if m != nil {
+		return m.Start
+	}
+	return 0
+}
+
+func (m *ParamsEra) GetEpoch() int64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *ParamsEra) GetParams() *ChainParams {
+	if m != nil {
+		return m.Params
+	}
+	return nil
+}
+
+type Proposal struct {
+	Params *ChainParams `protobuf:"bytes,1,opt,name=params" json:"params,omitempty"`
+	Miners [][]byte     `protobuf:"bytes,2,rep,name=miners" json:"miners,omitempty"`
+}
+
+func (m *Proposal) Reset()         { *m = Proposal{} }
+func (m *Proposal) String() string { return proto.CompactTextString(m) }
+func (*Proposal) ProtoMessage()    {}
+func (*Proposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dpos_7bd0e244eb0788b9, []int{5}
+}
+func (m *Proposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Proposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Proposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *Proposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Proposal.Merge(dst, src)
+}
+func (m *Proposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *Proposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_Proposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Proposal proto.InternalMessageInfo
+
+func (m *Proposal) GetParams() *ChainParams {
+	if m != nil {
+		return m.Params
+	}
+	return nil
+}
+
+func (m *Proposal) GetMiners() [][]byte {
+	if m != nil {
+		return m.Miners
+	}
+	return nil
+}
+
+type Penalty struct {
+	Miner []byte `protobuf:"bytes,1,opt,name=miner,proto3" json:"miner,omitempty"`
+	// last epoch the miner is penalized in
+	Until int64 `protobuf:"varint,2,opt,name=until,proto3" json:"until,omitempty"`
+}
+
+func (m *Penalty) Reset()         { *m = Penalty{} }
+func (m *Penalty) String() string { return proto.CompactTextString(m) }
+func (*Penalty) ProtoMessage()    {}
+func (*Penalty) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dpos_7bd0e244eb0788b9, []int{6}
+}
+func (m *Penalty) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Penalty) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Penalty.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *Penalty) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Penalty.Merge(dst, src)
+}
+func (m *Penalty) XXX_Size() int {
+	return m.Size()
+}
+func (m *Penalty) XXX_DiscardUnknown() {
+	xxx_messageInfo_Penalty.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Penalty proto.InternalMessageInfo
+
+func (m *Penalty) GetMiner() []byte {
+	if m != nil {
+		return m.Miner
+	}
+	return nil
+}
+
+func (m *Penalty) GetUntil() int64 {
+	if m != nil {
+		return m.Until
+	}
+	return 0
+}
+
 type Candidate struct {
 	Addr  []byte `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
 	Votes int64  `protobuf:"varint,2,opt,name=votes,proto3" json:"votes,omitempty"`
 	Peer  string `protobuf:"bytes,3,opt,name=peer,proto3" json:"peer,omitempty"`
+	// in address order
+	Voters []*Voter `protobuf:"bytes,4,rep,name=voters" json:"voters,omitempty"`
 }
 
 func (m *Candidate) Reset()         { *m = Candidate{} }
 func (m *Candidate) String() string { return proto.CompactTextString(m) }
 func (*Candidate) ProtoMessage()    {}
 func (*Candidate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dpos_2c0792c77d69a47e, []int{3}
+	return fileDescriptor_dpos_7bd0e244eb0788b9, []int{7}
 }
 func (m *Candidate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -236,17 +498,78 @@ func (m *Candidate) GetPeer() string {
 	return ""
 }
 
+func (m *Candidate) GetVoters() []*Voter {
+	if m != nil {
+		return m.Voters
+	}
+	return nil
+}
+
+type Voter struct {
+	Addr  []byte `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
+	Votes int64  `protobuf:"varint,2,opt,name=votes,proto3" json:"votes,omitempty"`
+}
+
+func (m *Voter) Reset()         { *m = Voter{} }
+func (m *Voter) String() string { return proto.CompactTextString(m) }
+func (*Voter) ProtoMessage()    {}
+func (*Voter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dpos_7bd0e244eb0788b9, []int{8}
+}
+func (m *Voter) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Voter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Voter.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *Voter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Voter.Merge(dst, src)
+}
+func (m *Voter) XXX_Size() int {
+	return m.Size()
+}
+func (m *Voter) XXX_DiscardUnknown() {
+	xxx_messageInfo_Voter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Voter proto.InternalMessageInfo
+
+func (m *Voter) GetAddr() []byte {
+	if m != nil {
+		return m.Addr
+	}
+	return nil
+}
+
+func (m *Voter) GetVotes() int64 {
+	if m != nil {
+		return m.Votes
+	}
+	return 0
+}
+
 type EternalBlockMsg struct {
 	Hash      []byte `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
 	Timestamp int64  `protobuf:"varint,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// BLS signature of the voter
 	Signature []byte `protobuf:"bytes,3,opt,name=signature,proto3" json:"signature,omitempty"`
+	Voter     []byte `protobuf:"bytes,4,opt,name=voter,proto3" json:"voter,omitempty"`
 }
 
 func (m *EternalBlockMsg) Reset()         { *m = EternalBlockMsg{} }
 func (m *EternalBlockMsg) String() string { return proto.CompactTextString(m) }
 func (*EternalBlockMsg) ProtoMessage()    {}
 func (*EternalBlockMsg) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dpos_2c0792c77d69a47e, []int{4}
+	return fileDescriptor_dpos_7bd0e244eb0788b9, []int{9}
 }
 func (m *EternalBlockMsg) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -296,11 +619,23 @@ func (m *EternalBlockMsg) GetSignature() []byte {
 	return nil
 }
 
+func (m *EternalBlockMsg) GetVoter() []byte {
+	if m != nil {
+		return m.Voter
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*PeriodContext)(nil), "dpospb.PeriodContext")
 	proto.RegisterType((*Period)(nil), "dpospb.Period")
 	proto.RegisterType((*CandidateContext)(nil), "dpospb.candidateContext")
+	proto.RegisterType((*ChainParams)(nil), "dpospb.ChainParams")
+	proto.RegisterType((*ParamsEra)(nil), "dpospb.ParamsEra")
+	proto.RegisterType((*Proposal)(nil), "dpospb.Proposal")
+	proto.RegisterType((*Penalty)(nil), "dpospb.Penalty")
 	proto.RegisterType((*Candidate)(nil), "dpospb.Candidate")
+	proto.RegisterType((*Voter)(nil), "dpospb.Voter")
 	proto.RegisterType((*EternalBlockMsg)(nil), "dpospb.EternalBlockMsg")
 }
 func (m *PeriodContext) Marshal() (dAtA []byte, err error) {
@@ -372,6 +707,12 @@ func (m *Period) MarshalTo(dAtA []byte) (int, error) {
 		i = encodeVarintDpos(dAtA, i, uint64(len(m.PeerId)))
 		i += copy(dAtA[i:], m.PeerId)
 	}
+	if len(m.BlsPubKey) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintDpos(dAtA, i, uint64(len(m.BlsPubKey)))
+		i += copy(dAtA[i:], m.BlsPubKey)
+	}
 	return i, nil
 }
 
@@ -407,10 +748,46 @@ func (m *CandidateContext) MarshalTo(dAtA []byte) (int, error) {
 			i += n
 		}
 	}
+	if len(m.Eras) > 0 {
+		for _, msg := range m.Eras {
+			dAtA[i] = 0x1a
+			i++
+			i = encodeVarintDpos(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.Proposals) > 0 {
+		for _, msg := range m.Proposals {
+			dAtA[i] = 0x22
+			i++
+			i = encodeVarintDpos(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.Penalties) > 0 {
+		for _, msg := range m.Penalties {
+			dAtA[i] = 0x2a
+			i++
+			i = encodeVarintDpos(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
 	return i, nil
 }
 
-func (m *Candidate) Marshal() (dAtA []byte, err error) {
+func (m *ChainParams) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -420,32 +797,30 @@ func (m *Candidate) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Candidate) MarshalTo(dAtA []byte) (int, error) {
+func (m *ChainParams) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if len(m.Addr) > 0 {
-		dAtA[i] = 0xa
+	if m.BlockInterval != 0 {
+		dAtA[i] = 0x8
 		i++
-		i = encodeVarintDpos(dAtA, i, uint64(len(m.Addr)))
-		i += copy(dAtA[i:], m.Addr)
+		i = encodeVarintDpos(dAtA, i, uint64(m.BlockInterval))
 	}
-	if m.Votes != 0 {
+	if m.PeriodDuration != 0 {
 		dAtA[i] = 0x10
 		i++
-		i = encodeVarintDpos(dAtA, i, uint64(m.Votes))
+		i = encodeVarintDpos(dAtA, i, uint64(m.PeriodDuration))
 	}
-	if len(m.Peer) > 0 {
-		dAtA[i] = 0x1a
+	if m.MaxBlockSize != 0 {
+		dAtA[i] = 0x18
 		i++
-		i = encodeVarintDpos(dAtA, i, uint64(len(m.Peer)))
-		i += copy(dAtA[i:], m.Peer)
+		i = encodeVarintDpos(dAtA, i, uint64(m.MaxBlockSize))
 	}
 	return i, nil
 }
 
-func (m *EternalBlockMsg) Marshal() (dAtA []byte, err error) {
+func (m *ParamsEra) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -455,27 +830,212 @@ func (m *EternalBlockMsg) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EternalBlockMsg) MarshalTo(dAtA []byte) (int, error) {
+func (m *ParamsEra) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if len(m.Hash) > 0 {
-		dAtA[i] = 0xa
+	if m.Start != 0 {
+		dAtA[i] = 0x8
 		i++
-		i = encodeVarintDpos(dAtA, i, uint64(len(m.Hash)))
-		i += copy(dAtA[i:], m.Hash)
+		i = encodeVarintDpos(dAtA, i, uint64(m.Start))
 	}
-	if m.Timestamp != 0 {
+	if m.Epoch != 0 {
 		dAtA[i] = 0x10
 		i++
-		i = encodeVarintDpos(dAtA, i, uint64(m.Timestamp))
+		i = encodeVarintDpos(dAtA, i, uint64(m.Epoch))
 	}
-	if len(m.Signature) > 0 {
+	if m.Params != nil {
 		dAtA[i] = 0x1a
 		i++
-		i = encodeVarintDpos(dAtA, i, uint64(len(m.Signature)))
-		i += copy(dAtA[i:], m.Signature)
+		i = encodeVarintDpos(dAtA, i, uint64(m.Params.Size()))
+		n1, err := m.Params.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n1
+	}
+	return i, nil
+}
+
+func (m *Proposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Proposal) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Params != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintDpos(dAtA, i, uint64(m.Params.Size()))
+		n2, err := m.Params.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n2
+	}
+	if len(m.Miners) > 0 {
+		for _, b := range m.Miners {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintDpos(dAtA, i, uint64(len(b)))
+			i += copy(dAtA[i:], b)
+		}
+	}
+	return i, nil
+}
+
+func (m *Penalty) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Penalty) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Miner) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintDpos(dAtA, i, uint64(len(m.Miner)))
+		i += copy(dAtA[i:], m.Miner)
+	}
+	if m.Until != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintDpos(dAtA, i, uint64(m.Until))
+	}
+	return i, nil
+}
+
+func (m *Candidate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Candidate) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Addr) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintDpos(dAtA, i, uint64(len(m.Addr)))
+		i += copy(dAtA[i:], m.Addr)
+	}
+	if m.Votes != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintDpos(dAtA, i, uint64(m.Votes))
+	}
+	if len(m.Peer) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintDpos(dAtA, i, uint64(len(m.Peer)))
+		i += copy(dAtA[i:], m.Peer)
+	}
+	if len(m.Voters) > 0 {
+		for _, msg := range m.Voters {
+			dAtA[i] = 0x22
+			i++
+			i = encodeVarintDpos(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *Voter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Voter) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Addr) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintDpos(dAtA, i, uint64(len(m.Addr)))
+		i += copy(dAtA[i:], m.Addr)
+	}
+	if m.Votes != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintDpos(dAtA, i, uint64(m.Votes))
+	}
+	return i, nil
+}
+
+func (m *EternalBlockMsg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EternalBlockMsg) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Hash) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintDpos(dAtA, i, uint64(len(m.Hash)))
+		i += copy(dAtA[i:], m.Hash)
+	}
+	if m.Timestamp != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintDpos(dAtA, i, uint64(m.Timestamp))
+	}
+	if len(m.Signature) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintDpos(dAtA, i, uint64(len(m.Signature)))
+		i += copy(dAtA[i:], m.Signature)
+	}
+	if len(m.Voter) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintDpos(dAtA, i, uint64(len(m.Voter)))
+		i += copy(dAtA[i:], m.Voter)
 	}
 	return i, nil
 }
@@ -524,6 +1084,10 @@ func (m *Period) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovDpos(uint64(l))
 	}
+	l = len(m.BlsPubKey)
+	if l > 0 {
+		n += 1 + l + sovDpos(uint64(l))
+	}
 	return n
 }
 
@@ -542,6 +1106,96 @@ func (m *CandidateContext) Size() (n int) {
 			n += 1 + l + sovDpos(uint64(l))
 		}
 	}
+	if len(m.Eras) > 0 {
+		for _, e := range m.Eras {
+			l = e.Size()
+			n += 1 + l + sovDpos(uint64(l))
+		}
+	}
+	if len(m.Proposals) > 0 {
+		for _, e := range m.Proposals {
+			l = e.Size()
+			n += 1 + l + sovDpos(uint64(l))
+		}
+	}
+	if len(m.Penalties) > 0 {
+		for _, e := range m.Penalties {
+			l = e.Size()
+			n += 1 + l + sovDpos(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ChainParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockInterval != 0 {
+		n += 1 + sovDpos(uint64(m.BlockInterval))
+	}
+	if m.PeriodDuration != 0 {
+		n += 1 + sovDpos(uint64(m.PeriodDuration))
+	}
+	if m.MaxBlockSize != 0 {
+		n += 1 + sovDpos(uint64(m.MaxBlockSize))
+	}
+	return n
+}
+
+func (m *ParamsEra) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Start != 0 {
+		n += 1 + sovDpos(uint64(m.Start))
+	}
+	if m.Epoch != 0 {
+		n += 1 + sovDpos(uint64(m.Epoch))
+	}
+	if m.Params != nil {
+		l = m.Params.Size()
+		n += 1 + l + sovDpos(uint64(l))
+	}
+	return n
+}
+
+func (m *Proposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Params != nil {
+		l = m.Params.Size()
+		n += 1 + l + sovDpos(uint64(l))
+	}
+	if len(m.Miners) > 0 {
+		for _, b := range m.Miners {
+			l = len(b)
+			n += 1 + l + sovDpos(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Penalty) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Miner)
+	if l > 0 {
+		n += 1 + l + sovDpos(uint64(l))
+	}
+	if m.Until != 0 {
+		n += 1 + sovDpos(uint64(m.Until))
+	}
 	return n
 }
 
@@ -562,6 +1216,28 @@ func (m *Candidate) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovDpos(uint64(l))
 	}
+	if len(m.Voters) > 0 {
+		for _, e := range m.Voters {
+			l = e.Size()
+			n += 1 + l + sovDpos(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Voter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Addr)
+	if l > 0 {
+		n += 1 + l + sovDpos(uint64(l))
+	}
+	if m.Votes != 0 {
+		n += 1 + sovDpos(uint64(m.Votes))
+	}
 	return n
 }
 
@@ -582,6 +1258,10 @@ func (m *EternalBlockMsg) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovDpos(uint64(l))
 	}
+	l = len(m.Voter)
+	if l > 0 {
+		n += 1 + l + sovDpos(uint64(l))
+	}
 	return n
 }
 
@@ -598,7 +1278,560 @@ func sovDpos(x uint64) (n int) {
 func sozDpos(x uint64) (n int) {
 	return sovDpos(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
-func (m *PeriodContext) Unmarshal(dAtA []byte) error {
+func (m *PeriodContext) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDpos
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PeriodContext: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PeriodContext: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDpos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDpos
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Period = append(m.Period, &Period{})
+			if err := m.Period[len(m.Period)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDpos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDpos
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NextPeriod = append(m.NextPeriod, &Period{})
+			if err := m.NextPeriod[len(m.NextPeriod)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDpos(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDpos
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Period) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDpos
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Period: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Period: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addr", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDpos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthDpos
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addr = append(m.Addr[:0], dAtA[iNdEx:postIndex]...)
+			if m.Addr == nil {
+				m.Addr = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDpos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDpos
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PeerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlsPubKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDpos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthDpos
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlsPubKey = append(m.BlsPubKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.BlsPubKey == nil {
+				m.BlsPubKey = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDpos(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDpos
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CandidateContext) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDpos
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: candidateContext: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: candidateContext: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDpos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Candidates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDpos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDpos
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Candidates = append(m.Candidates, &Candidate{})
+			if err := m.Candidates[len(m.Candidates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Eras", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDpos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDpos
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Eras = append(m.Eras, &ParamsEra{})
+			if err := m.Eras[len(m.Eras)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDpos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDpos
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proposals = append(m.Proposals, &Proposal{})
+			if err := m.Proposals[len(m.Proposals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Penalties", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDpos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDpos
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Penalties = append(m.Penalties, &Penalty{})
+			if err := m.Penalties[len(m.Penalties)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDpos(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDpos
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChainParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDpos
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChainParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChainParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockInterval", wireType)
+			}
+			m.BlockInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDpos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockInterval |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodDuration", wireType)
+			}
+			m.PeriodDuration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDpos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PeriodDuration |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBlockSize", wireType)
+			}
+			m.MaxBlockSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDpos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxBlockSize |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDpos(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDpos
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ParamsEra) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -621,17 +1854,17 @@ func (m *PeriodContext) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PeriodContext: wiretype end group for non-group")
+			return fmt.Errorf("proto: ParamsEra: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PeriodContext: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ParamsEra: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
 			}
-			var msglen int
+			m.Start = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDpos
@@ -641,26 +1874,33 @@ func (m *PeriodContext) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.Start |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthDpos
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
 			}
-			m.Period = append(m.Period, &Period{})
-			if err := m.Period[len(m.Period)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDpos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NextPeriod", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -684,8 +1924,10 @@ func (m *PeriodContext) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NextPeriod = append(m.NextPeriod, &Period{})
-			if err := m.NextPeriod[len(m.NextPeriod)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Params == nil {
+				m.Params = &ChainParams{}
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -710,7 +1952,7 @@ func (m *PeriodContext) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Period) Unmarshal(dAtA []byte) error {
+func (m *Proposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -733,17 +1975,17 @@ func (m *Period) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Period: wiretype end group for non-group")
+			return fmt.Errorf("proto: Proposal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Period: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Proposal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Addr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDpos
@@ -753,28 +1995,30 @@ func (m *Period) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthDpos
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Addr = append(m.Addr[:0], dAtA[iNdEx:postIndex]...)
-			if m.Addr == nil {
-				m.Addr = []byte{}
+			if m.Params == nil {
+				m.Params = &ChainParams{}
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PeerId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Miners", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDpos
@@ -784,20 +2028,20 @@ func (m *Period) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				byteLen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthDpos
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PeerId = string(dAtA[iNdEx:postIndex])
+			m.Miners = append(m.Miners, make([]byte, postIndex-iNdEx))
+			copy(m.Miners[len(m.Miners)-1], dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -820,7 +2064,7 @@ func (m *Period) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CandidateContext) Unmarshal(dAtA []byte) error {
+func (m *Penalty) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -843,17 +2087,17 @@ func (m *CandidateContext) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: candidateContext: wiretype end group for non-group")
+			return fmt.Errorf("proto: Penalty: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: candidateContext: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Penalty: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Miner", wireType)
 			}
-			m.Height = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDpos
@@ -863,16 +2107,28 @@ func (m *CandidateContext) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Height |= (uint32(b) & 0x7F) << shift
+				byteLen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if byteLen < 0 {
+				return ErrInvalidLengthDpos
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Miner = append(m.Miner[:0], dAtA[iNdEx:postIndex]...)
+			if m.Miner == nil {
+				m.Miner = []byte{}
+			}
+			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Candidates", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Until", wireType)
 			}
-			var msglen int
+			m.Until = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDpos
@@ -882,23 +2138,11 @@ func (m *CandidateContext) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.Until |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthDpos
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Candidates = append(m.Candidates, &Candidate{})
-			if err := m.Candidates[len(m.Candidates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDpos(dAtA[iNdEx:])
@@ -1028,6 +2272,137 @@ func (m *Candidate) Unmarshal(dAtA []byte) error {
 			}
 			m.Peer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Voters", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDpos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDpos
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Voters = append(m.Voters, &Voter{})
+			if err := m.Voters[len(m.Voters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDpos(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDpos
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Voter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDpos
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Voter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Voter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addr", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDpos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthDpos
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addr = append(m.Addr[:0], dAtA[iNdEx:postIndex]...)
+			if m.Addr == nil {
+				m.Addr = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Votes", wireType)
+			}
+			m.Votes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDpos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Votes |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDpos(dAtA[iNdEx:])
@@ -1159,6 +2534,37 @@ func (m *EternalBlockMsg) Unmarshal(dAtA []byte) error {
 				m.Signature = []byte{}
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDpos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthDpos
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Voter = append(m.Voter[:0], dAtA[iNdEx:postIndex]...)
+			if m.Voter == nil {
+				m.Voter = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDpos(dAtA[iNdEx:])
@@ -1285,28 +2691,45 @@ var (
 	ErrIntOverflowDpos   = fmt.Errorf("proto: integer overflow")
 )
 
-func init() { proto.RegisterFile("dpos.proto", fileDescriptor_dpos_2c0792c77d69a47e) }
-
-var fileDescriptor_dpos_2c0792c77d69a47e = []byte{
-	// 309 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x91, 0xcf, 0x4a, 0x2b, 0x31,
-	0x18, 0xc5, 0x9b, 0xdb, 0xeb, 0x48, 0xbf, 0xb6, 0xfe, 0x09, 0xa2, 0xb3, 0x90, 0x50, 0x66, 0x21,
-	0xb3, 0xaa, 0xa8, 0xf8, 0x02, 0x2d, 0x2e, 0xba, 0x10, 0x24, 0x7b, 0x29, 0x69, 0x13, 0x3a, 0xc1,
-	0x76, 0x12, 0x92, 0x28, 0x7d, 0x0c, 0x1f, 0xcb, 0x65, 0x97, 0x2e, 0xa5, 0xf3, 0x22, 0x92, 0x64,
-	0xa6, 0x75, 0xd1, 0xdd, 0xf9, 0xce, 0xf9, 0x71, 0x72, 0x20, 0x00, 0x5c, 0x2b, 0x3b, 0xd4, 0x46,
-	0x39, 0x85, 0x13, 0xaf, 0xf5, 0x2c, 0x2b, 0xa0, 0xff, 0x22, 0x8c, 0x54, 0x7c, 0xac, 0x4a, 0x27,
-	0xd6, 0x0e, 0xdf, 0x40, 0xa2, 0x83, 0x91, 0xa2, 0x41, 0x3b, 0xef, 0xde, 0x9f, 0x0c, 0x23, 0x39,
-	0x8c, 0x18, 0xad, 0x53, 0x7c, 0x0b, 0xdd, 0x52, 0xac, 0xdd, 0xb4, 0x86, 0xff, 0x1d, 0x84, 0xc1,
-	0x23, 0x51, 0x67, 0x8f, 0x90, 0x44, 0x85, 0x31, 0xfc, 0x67, 0x9c, 0x9b, 0x14, 0x0d, 0x50, 0xde,
-	0xa3, 0x41, 0xe3, 0x2b, 0x38, 0xd6, 0x42, 0x98, 0xa9, 0xf4, 0x55, 0x28, 0xef, 0xf8, 0x77, 0x84,
-	0x99, 0xf0, 0xec, 0x15, 0xce, 0xe6, 0xac, 0xe4, 0x92, 0x33, 0x27, 0x9a, 0x8d, 0x97, 0x90, 0x14,
-	0x42, 0x2e, 0x0a, 0x17, 0x2a, 0xfa, 0xb4, 0xbe, 0xf0, 0x1d, 0xc0, 0x8e, 0xb5, 0xf5, 0xa4, 0xf3,
-	0x66, 0xd2, 0xb8, 0x49, 0xe8, 0x1f, 0x28, 0x9b, 0x40, 0x67, 0x17, 0x1c, 0x1c, 0x76, 0x01, 0x47,
-	0x1f, 0x2a, 0xd6, 0xa1, 0xbc, 0x4d, 0xe3, 0xe1, 0x49, 0xbf, 0x2f, 0x6d, 0x87, 0xad, 0x41, 0x67,
-	0x0c, 0x4e, 0x9f, 0x9c, 0x30, 0x25, 0x5b, 0x8e, 0x96, 0x6a, 0xfe, 0xf6, 0x6c, 0x17, 0x1e, 0x2b,
-	0x98, 0x2d, 0x9a, 0x42, 0xaf, 0xf1, 0x35, 0x74, 0x9c, 0x5c, 0x09, 0xeb, 0xd8, 0x4a, 0xd7, 0xa5,
-	0x7b, 0xc3, 0xa7, 0x56, 0x2e, 0x4a, 0xe6, 0xde, 0x8d, 0x08, 0xed, 0x3d, 0xba, 0x37, 0x46, 0xe9,
-	0xd7, 0x96, 0xa0, 0xcd, 0x96, 0xa0, 0x9f, 0x2d, 0x41, 0x9f, 0x15, 0x69, 0x6d, 0x2a, 0xd2, 0xfa,
-	0xae, 0x48, 0x6b, 0x96, 0x84, 0x6f, 0x7d, 0xf8, 0x0d, 0x00, 0x00, 0xff, 0xff, 0x40, 0xdc, 0x1e,
-	0x63, 0xe4, 0x01, 0x00, 0x00,
+func init() { proto.RegisterFile("dpos.proto", fileDescriptor_dpos_7bd0e244eb0788b9) }
+
+var fileDescriptor_dpos_7bd0e244eb0788b9 = []byte{
+	// 582 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0xcd, 0x6e, 0xd4, 0x30,
+	0x10, 0x6e, 0xd8, 0xdd, 0x94, 0xcc, 0x76, 0xb7, 0xc5, 0x54, 0x90, 0x03, 0x8a, 0xaa, 0x88, 0x42,
+	0x25, 0xc4, 0xa2, 0x16, 0xf1, 0x02, 0x2d, 0x3d, 0x54, 0x08, 0x51, 0x19, 0xc1, 0x35, 0x72, 0x1a,
+	0xab, 0xb1, 0x9a, 0x1f, 0xcb, 0xf6, 0x96, 0x6d, 0xaf, 0xbc, 0x00, 0x8f, 0xc5, 0xb1, 0x47, 0x8e,
+	0xa8, 0xfb, 0x22, 0xc8, 0x63, 0x27, 0x5b, 0xa4, 0x4a, 0x70, 0x9b, 0xef, 0x67, 0x3c, 0x9e, 0xf1,
+	0x24, 0x00, 0x85, 0x6c, 0xf5, 0x4c, 0xaa, 0xd6, 0xb4, 0x24, 0xb4, 0xb1, 0xcc, 0xd3, 0x12, 0x26,
+	0xa7, 0x5c, 0x89, 0xb6, 0x38, 0x6a, 0x1b, 0xc3, 0x17, 0x86, 0xbc, 0x80, 0x50, 0x22, 0x11, 0x07,
+	0x3b, 0x83, 0xbd, 0xf1, 0xc1, 0x74, 0xe6, 0x9c, 0x33, 0x67, 0xa3, 0x5e, 0x25, 0x6f, 0x60, 0xdc,
+	0xf0, 0x85, 0xc9, 0xbc, 0xf9, 0xc1, 0xbd, 0x66, 0xb0, 0x16, 0x17, 0xa7, 0x5f, 0x20, 0x74, 0x11,
+	0x21, 0x30, 0x64, 0x45, 0xa1, 0xe2, 0x60, 0x27, 0xd8, 0xdb, 0xa0, 0x18, 0x93, 0xa7, 0xb0, 0x2e,
+	0x39, 0x57, 0x99, 0xb0, 0x47, 0x05, 0x7b, 0x91, 0xad, 0xc3, 0xd5, 0x49, 0x41, 0x12, 0x18, 0xe7,
+	0x95, 0xce, 0xe4, 0x3c, 0xcf, 0x2e, 0xf8, 0x55, 0x3c, 0xc0, 0x9c, 0x28, 0xaf, 0xf4, 0xe9, 0x3c,
+	0xff, 0xc0, 0xaf, 0xd2, 0x65, 0x00, 0x5b, 0x67, 0xac, 0x29, 0x44, 0xc1, 0x0c, 0xef, 0x9a, 0x78,
+	0x02, 0x61, 0xc9, 0xc5, 0x79, 0x69, 0xb0, 0xc6, 0x84, 0x7a, 0x44, 0xf6, 0x01, 0x7a, 0xaf, 0xf6,
+	0x77, 0x7e, 0xd4, 0xdd, 0xf9, 0xa8, 0x53, 0xe8, 0x1d, 0x13, 0xd9, 0x85, 0x21, 0x57, 0x4c, 0xc7,
+	0x83, 0xbf, 0xcd, 0xa7, 0x4c, 0xb1, 0x5a, 0x1f, 0x2b, 0x46, 0x51, 0x26, 0x33, 0x88, 0xa4, 0x6a,
+	0x65, 0xab, 0x59, 0xa5, 0xe3, 0x21, 0x7a, 0xb7, 0x7a, 0xaf, 0x17, 0xe8, 0xca, 0x42, 0x5e, 0x43,
+	0x24, 0x79, 0xc3, 0x2a, 0x23, 0xb8, 0x8e, 0x47, 0xe8, 0xdf, 0x5c, 0x0d, 0xcf, 0x0a, 0x57, 0x74,
+	0xe5, 0x48, 0xbf, 0x07, 0x30, 0x3e, 0x2a, 0x99, 0x68, 0x5c, 0x5d, 0xb2, 0x0b, 0xd3, 0xbc, 0x6a,
+	0xcf, 0x2e, 0x32, 0xd1, 0x18, 0xae, 0x2e, 0x59, 0x85, 0x8d, 0x0e, 0xe8, 0x04, 0xd9, 0x13, 0x4f,
+	0x92, 0x97, 0xb0, 0xe9, 0xde, 0x27, 0x2b, 0xe6, 0x8a, 0x19, 0xd1, 0x36, 0x38, 0xdd, 0x09, 0x9d,
+	0x3a, 0xfa, 0xbd, 0x67, 0xc9, 0x73, 0x98, 0xd6, 0x6c, 0x91, 0xb9, 0x33, 0xb5, 0xb8, 0xe6, 0x38,
+	0xe8, 0x09, 0xdd, 0xa8, 0xd9, 0xe2, 0xd0, 0x92, 0x9f, 0xc5, 0x35, 0x4f, 0x0b, 0x88, 0xfa, 0xbe,
+	0xc9, 0x36, 0x8c, 0xb4, 0x61, 0xca, 0xf8, 0xca, 0x0e, 0x58, 0x96, 0xcb, 0xf6, 0xac, 0xc4, 0x3a,
+	0x03, 0xea, 0x00, 0x79, 0x05, 0xa1, 0xc4, 0x44, 0x3c, 0x76, 0x7c, 0xf0, 0xb8, 0x9f, 0xf9, 0xaa,
+	0x27, 0xea, 0x2d, 0xe9, 0x27, 0x78, 0xd8, 0x4d, 0xec, 0x4e, 0x62, 0xf0, 0xcf, 0x44, 0xfb, 0xea,
+	0xb5, 0x68, 0xb8, 0x72, 0x2f, 0xbb, 0x41, 0x3d, 0x4a, 0xdf, 0xc1, 0xba, 0x1f, 0xa9, 0xbd, 0x1e,
+	0x92, 0x7e, 0xf7, 0x1c, 0xb0, 0xec, 0xbc, 0x31, 0xa2, 0xea, 0x2e, 0x8d, 0x20, 0x95, 0x10, 0xf5,
+	0x2b, 0x71, 0xef, 0xce, 0x6e, 0xc3, 0xe8, 0xb2, 0x75, 0x8b, 0x84, 0x69, 0x08, 0xac, 0xd3, 0xae,
+	0x2e, 0x76, 0x1a, 0x51, 0x8c, 0xc9, 0x2e, 0x84, 0x56, 0x54, 0xdd, 0x6a, 0x4c, 0xba, 0x36, 0xbe,
+	0x5a, 0x96, 0x7a, 0x31, 0xdd, 0x87, 0x11, 0x12, 0xff, 0x5f, 0x2d, 0xfd, 0x06, 0x9b, 0xc7, 0x86,
+	0xab, 0x86, 0x55, 0xf8, 0x4c, 0x1f, 0xf5, 0xb9, 0x4d, 0x2e, 0x99, 0x2e, 0xbb, 0x64, 0x1b, 0x93,
+	0x67, 0x10, 0x19, 0x51, 0x73, 0x6d, 0x58, 0x2d, 0xfd, 0x01, 0x2b, 0xc2, 0xaa, 0x5a, 0x9c, 0x37,
+	0xcc, 0xcc, 0x15, 0xef, 0xbe, 0xb0, 0x9e, 0xe8, 0x0a, 0xab, 0x78, 0xe8, 0x66, 0x86, 0xe0, 0x30,
+	0xfe, 0x79, 0x9b, 0x04, 0x37, 0xb7, 0x49, 0xf0, 0xfb, 0x36, 0x09, 0x7e, 0x2c, 0x93, 0xb5, 0x9b,
+	0x65, 0xb2, 0xf6, 0x6b, 0x99, 0xac, 0xe5, 0x21, 0xfe, 0x61, 0xde, 0xfe, 0x19, 0x00, 0xbf, 0xcd,
+	0x44, 0xd0, 0x6f, 0x04, 0x00, 0x00,
 }
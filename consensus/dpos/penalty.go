@@ -0,0 +1,123 @@
+// Copyright (c) 2018 ContentBox Authors.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package dpos
+
+import (
+	"bytes"
+	"sort"
+
+	"github.com/BOXFoundation/boxd/consensus/dpos/pb"
+	"github.com/BOXFoundation/boxd/core/chain"
+	corepb "github.com/BOXFoundation/boxd/core/pb"
+	"github.com/BOXFoundation/boxd/core/types"
+)
+
+// PenaltyEpochs is how many epochs after the one a miner minted different
+// blocks at the same time in it is removed from candidates and its slots
+// for, a day under default chain parameters. All nodes must agree on it
+const PenaltyEpochs = 2880
+
+// penalty is the last epoch a miner double minting is penalized in
+type penalty struct {
+	miner types.AddressHash
+	until int64
+}
+
+// penalties are penalties of miners in address order, as evidences on chain
+// say
+type penalties []*penalty
+
+// penalize penalizes miner till epoch until. Penalties of several evidences
+// do not add up, the one lasting longest holds
+func (ps penalties) penalize(miner types.AddressHash, until int64) penalties {
+	i := sort.Search(len(ps), func(i int) bool {
+		return bytes.Compare(ps[i].miner[:], miner[:]) >= 0
+	})
+	if i < len(ps) && ps[i].miner == miner {
+		if until > ps[i].until {
+			ps[i] = &penalty{miner: miner, until: until}
+		}
+		return ps
+	}
+	ps = append(ps, nil)
+	copy(ps[i+1:], ps[i:])
+	ps[i] = &penalty{miner: miner, until: until}
+	return ps
+}
+
+// isPenalized checks if addr is removed from candidates and its slots in
+// epoch
+func (ps penalties) isPenalized(addr types.AddressHash, epoch int64) bool {
+	for _, p := range ps {
+		if p.miner == addr {
+			return epoch <= p.until
+		}
+	}
+	return false
+}
+
+func (ps penalties) toProto() []*dpospb.Penalty {
+	msgs := make([]*dpospb.Penalty, len(ps))
+	for k, v := range ps {
+		msgs[k] = &dpospb.Penalty{Miner: v.miner[:], Until: v.until}
+	}
+	return msgs
+}
+
+func penaltiesFromProto(msgs []*dpospb.Penalty) penalties {
+	var ps penalties
+	for _, v := range msgs {
+		p := &penalty{until: v.Until}
+		copy(p.miner[:], v.Miner)
+		ps = append(ps, p)
+	}
+	return ps
+}
+
+// pendingEvidence returns a double mint evidence stored by chain whose
+// penalty is not in effect as of candidatesContext yet, to be included in a
+// block minted in epoch. nil if there is none
+func (dpos *Dpos) pendingEvidence(candidatesContext *CandidateContext, epoch int64) *types.DoubleMintEvidence {
+	db := dpos.chain.DB()
+	timing := newTiming(candidatesContext.eras)
+	for _, key := range db.KeysWithPrefix([]byte(chain.EvidencePrefix)) {
+		data, err := db.Get(key)
+		if err != nil {
+			logger.Warnf("Failed to load double mint evidence %s: %v", key, err)
+			continue
+		}
+		evidence := new(types.DoubleMintEvidence)
+		if err := evidence.Unmarshal(data); err != nil {
+			logger.Warnf("Failed to load double mint evidence %s: %v", key, err)
+			continue
+		}
+		until := timing.epochOf(evidence.Timestamp()) + PenaltyEpochs
+		if until < epoch || candidatesContext.penalties.isPenalized(evidence.Miner, until) {
+			continue
+		}
+		return evidence
+	}
+	return nil
+}
+
+// attachEvidence carries a pending double mint evidence in coinbaseTx of
+// block, if any
+func (dpos *Dpos) attachEvidence(block *types.Block, coinbaseTx *types.Transaction) error {
+	candidatesContext, err := dpos.loadCandidateContext(&block.Header.PrevBlockHash)
+	if err != nil {
+		return err
+	}
+	epoch := newTiming(candidatesContext.eras).epochOf(block.Header.TimeStamp)
+	evidence := dpos.pendingEvidence(candidatesContext, epoch)
+	if evidence == nil {
+		return nil
+	}
+	data, err := evidence.Marshal()
+	if err != nil {
+		return err
+	}
+	coinbaseTx.Data = &corepb.Data{Type: types.DoubleMintEvidenceTx, Content: data}
+	return nil
+}
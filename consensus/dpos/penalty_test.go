@@ -0,0 +1,107 @@
+// Copyright (c) 2018 ContentBox Authors.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package dpos
+
+import (
+	"testing"
+
+	"github.com/BOXFoundation/boxd/core/chain"
+	"github.com/BOXFoundation/boxd/core/types"
+	"github.com/BOXFoundation/boxd/crypto"
+	"github.com/facebookgo/ensure"
+)
+
+// newEvidence creates the evidence of the miner of privKey minting two
+// blocks at timestamp
+func newEvidence(t *testing.T, privKey *crypto.PrivateKey, timestamp int64) *types.DoubleMintEvidence {
+	var blocks [2]*types.Block
+	for i := range blocks {
+		blocks[i] = &types.Block{Header: &types.BlockHeader{TimeStamp: timestamp, PrevBlockHash: crypto.HashType{byte(i)}}}
+		blocks[i].Signature, _ = crypto.SignCompact(privKey, blocks[i].BlockHash()[:])
+	}
+	evidence, err := types.NewDoubleMintEvidence(blocks[0], blocks[1])
+	ensure.Nil(t, err)
+	return evidence
+}
+
+func TestPenalties(t *testing.T) {
+	miner, other := types.AddressHash{0x02}, types.AddressHash{0x01}
+	var ps penalties
+	ensure.False(t, ps.isPenalized(miner, 10))
+
+	ps = ps.penalize(miner, 13)
+	ensure.True(t, ps.isPenalized(miner, 10))
+	ensure.True(t, ps.isPenalized(miner, 13))
+	ensure.False(t, ps.isPenalized(miner, 14))
+	ensure.False(t, ps.isPenalized(other, 10))
+
+	// the penalty lasting longest holds, miners are kept in address order
+	ps = ps.penalize(miner, 8)
+	ensure.True(t, ps.isPenalized(miner, 13))
+	ps = ps.penalize(miner, 15)
+	ensure.False(t, ps.isPenalized(miner, 16))
+	ps = ps.penalize(other, 11)
+	ensure.DeepEqual(t, ps, penalties{{miner: other, until: 11}, {miner: miner, until: 15}})
+}
+
+func TestCandidateContextPenalize(t *testing.T) {
+	privKey, miner := newVoter(t)
+	context := InitCandidateContext()
+	epochLen := NewBlockTimeInterval * PeriodSize / SecondInMs
+
+	// the miner is penalized from the epoch it double minted in on
+	ensure.Nil(t, context.applyTx(newCandidateTx(types.DoubleMintEvidenceTx, newEvidence(t, privKey, 10*epochLen))))
+	ensure.True(t, context.penalties.isPenalized(miner, 10))
+	ensure.True(t, context.penalties.isPenalized(miner, 10+PenaltyEpochs))
+	ensure.False(t, context.penalties.isPenalized(miner, 11+PenaltyEpochs))
+
+	// evidences not proving double minting are rejected
+	other, _ := newVoter(t)
+	evidence := newEvidence(t, privKey, 20*epochLen)
+	evidence.Second = newEvidence(t, other, 20*epochLen).Second
+	ensure.NotNil(t, context.applyTx(newCandidateTx(types.DoubleMintEvidenceTx, evidence)))
+	ensure.False(t, context.penalties.isPenalized(miner, 20+PenaltyEpochs))
+
+	// penalties are kept across storing
+	data, err := context.Marshal()
+	ensure.Nil(t, err)
+	stored := new(CandidateContext)
+	ensure.Nil(t, stored.Unmarshal(data))
+	ensure.DeepEqual(t, stored.penalties, context.penalties)
+}
+
+func TestPenalizedMinerBlockRejected(t *testing.T) {
+	miner := NewDummyDpos(cfgMiner).dpos
+	genesis := miner.chain.TailBlock()
+	block := types.NewBlock(genesis)
+	block.Header.TimeStamp = 1541824620
+	ensure.Nil(t, miner.signBlock(block))
+	addr := *miner.signer.Addr().Hash160()
+	epoch := defaultTiming.epochOf(block.Header.TimeStamp)
+
+	storePenalties := func(ps penalties) {
+		context := InitCandidateContext()
+		context.penalties = ps
+		data, err := context.Marshal()
+		ensure.Nil(t, err)
+		ensure.Nil(t, miner.chain.DB().Put(chain.CandidatesKey(genesis.BlockHash()), data))
+		ensure.Nil(t, miner.updateTiming(genesis.BlockHash()))
+	}
+
+	// blocks of the miner penalized in their epoch as of the parent are
+	// rejected, and the miner neither mints nor is scheduled then
+	storePenalties(penalties{{miner: addr, until: epoch}})
+	ensure.DeepEqual(t, miner.VerifyMinerEpoch(block), ErrPenalizedMiner)
+	ensure.DeepEqual(t, miner.checkMiner(block.Header.TimeStamp), ErrPenalizedMiner)
+	schedule := miner.context.periodContext.schedule(block.Header.TimeStamp*SecondInMs, genesis)
+	for _, slot := range schedule.Slots {
+		ensure.NotDeepEqual(t, slot.Miner, addr)
+	}
+
+	// once the penalty is over, blocks are accepted again
+	storePenalties(penalties{{miner: addr, until: epoch - 1}})
+	ensure.Nil(t, miner.VerifyMinerEpoch(block))
+	ensure.Nil(t, miner.checkMiner(block.Header.TimeStamp))
+}
@@ -0,0 +1,61 @@
+// Copyright (c) 2018 ContentBox Authors.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package dpos
+
+import (
+	"math/big"
+
+	"github.com/BOXFoundation/boxd/core/chain"
+	corepb "github.com/BOXFoundation/boxd/core/pb"
+	"github.com/BOXFoundation/boxd/core/types"
+	"github.com/BOXFoundation/boxd/script"
+)
+
+// VoterRewardOutputs returns coinbase outputs block must pay voters of its
+// miner, as of the parent block. None if voter rewards are disabled
+func (dpos *Dpos) VoterRewardOutputs(block *types.Block) ([]*corepb.TxOut, error) {
+	if dpos.cfg.VoterRewardPercent == 0 {
+		return nil, nil
+	}
+	miner, err := dpos.context.periodContext.FindMinerWithTimeStamp(block.Header.TimeStamp)
+	if err != nil {
+		return nil, err
+	}
+	candidateContext, err := dpos.loadCandidateContext(&block.Header.PrevBlockHash)
+	if err != nil {
+		return nil, err
+	}
+	reward := chain.CalcBlockSubsidy(block.Height) / 100 * uint64(dpos.cfg.VoterRewardPercent)
+	return voterRewards(candidateContext, *miner, reward), nil
+}
+
+// voterRewards shares reward among voters of miner in proportion to their
+// votes, rounded down, as outputs in voter address order
+func voterRewards(candidateContext *CandidateContext, miner types.AddressHash, reward uint64) []*corepb.TxOut {
+	candidate := candidateContext.candidate(miner)
+	if candidate == nil || reward == 0 {
+		return nil
+	}
+	total := new(big.Int)
+	for _, v := range candidate.voters {
+		total.Add(total, big.NewInt(v.votes))
+	}
+	if total.Sign() <= 0 {
+		return nil
+	}
+	var outputs []*corepb.TxOut
+	for _, v := range candidate.voters {
+		share := new(big.Int).SetUint64(reward)
+		share.Mul(share, big.NewInt(v.votes)).Div(share, total)
+		if share.Sign() == 0 {
+			continue
+		}
+		outputs = append(outputs, &corepb.TxOut{
+			Value:        share.Uint64(),
+			ScriptPubKey: *script.PayToPubKeyHashScript(v.addr[:]),
+		})
+	}
+	return outputs
+}
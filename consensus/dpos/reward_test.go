@@ -0,0 +1,43 @@
+// Copyright (c) 2018 ContentBox Authors.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package dpos
+
+import (
+	"testing"
+
+	"github.com/BOXFoundation/boxd/core/types"
+	"github.com/BOXFoundation/boxd/script"
+	"github.com/facebookgo/ensure"
+)
+
+func TestVoterRewards(t *testing.T) {
+	alice, bob := types.AddressHash{0x01}, types.AddressHash{0x02}
+	carol, carolAddr := newVoter(t)
+	dave, daveAddr := newVoter(t)
+	context := InitCandidateContext()
+	ensure.Nil(t, context.applyTx(newCandidateTx(types.RegisterCandidateTx, types.NewSignUpContent(alice))))
+	ensure.Nil(t, context.applyTx(newCandidateTx(types.RegisterCandidateTx, types.NewSignUpContent(bob))))
+	ensure.Nil(t, context.applyTx(newVoterTx(carol, types.VoteTx, types.NewVoteContent(alice, 1))))
+	ensure.Nil(t, context.applyTx(newVoterTx(dave, types.VoteTx, types.NewVoteContent(alice, 2))))
+
+	// shares are rounded down, in voter address order
+	rewards := voterRewards(context, alice, 100)
+	ensure.DeepEqual(t, len(rewards), 2)
+	shares := map[types.AddressHash]uint64{carolAddr: 33, daveAddr: 66}
+	for _, txOut := range rewards {
+		addr, err := script.NewScriptFromBytes(txOut.ScriptPubKey).ExtractAddress()
+		ensure.Nil(t, err)
+		ensure.DeepEqual(t, txOut.Value, shares[*addr.Hash160()])
+	}
+	first, _ := script.NewScriptFromBytes(rewards[0].ScriptPubKey).ExtractAddress()
+	second, _ := script.NewScriptFromBytes(rewards[1].ScriptPubKey).ExtractAddress()
+	ensure.True(t, string(first.Hash()) < string(second.Hash()))
+
+	// zero shares are skipped
+	ensure.DeepEqual(t, len(voterRewards(context, alice, 2)), 1)
+	// no reward if the miner has no voters
+	ensure.True(t, voterRewards(context, bob, 100) == nil)
+	ensure.True(t, voterRewards(context, types.AddressHash{0x03}, 100) == nil)
+}
@@ -0,0 +1,180 @@
+// Copyright (c) 2018 ContentBox Authors.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package dpos
+
+import (
+	"sync"
+	"time"
+
+	"github.com/BOXFoundation/boxd/core/types"
+	"github.com/BOXFoundation/boxd/crypto"
+	"github.com/BOXFoundation/boxd/wallet"
+	"github.com/jbenet/goprocess"
+)
+
+// signerCheckInterval is how often the remote signer is checked, and
+// reconnected if unreachable
+const signerCheckInterval = 5 * time.Second
+
+// minerSigner signs blocks and eternal votes with the miner key
+type minerSigner interface {
+	// Addr returns the address of the miner key
+	Addr() types.Address
+	// SignCompact signs hash with the miner key into a compact signature
+	SignCompact(hash *crypto.HashType) ([]byte, error)
+	// Ready checks if the signer is able to sign now
+	Ready() error
+}
+
+// keystoreSigner signs with the miner key in a keystore file on the node
+type keystoreSigner struct {
+	account    *wallet.Account
+	addr       types.Address
+	passphrase string
+}
+
+var _ minerSigner = (*keystoreSigner)(nil)
+
+func newKeystoreSigner(keypath, passphrase string) (*keystoreSigner, error) {
+	account, err := wallet.NewAccountFromFile(keypath)
+	if err != nil {
+		return nil, err
+	}
+	addr, err := types.NewAddress(account.Addr())
+	if err != nil {
+		return nil, err
+	}
+	return &keystoreSigner{account: account, addr: addr, passphrase: passphrase}, nil
+}
+
+func (ks *keystoreSigner) Addr() types.Address {
+	return ks.addr
+}
+
+func (ks *keystoreSigner) SignCompact(hash *crypto.HashType) ([]byte, error) {
+	return crypto.SignCompact(ks.account.PrivateKey(), hash[:])
+}
+
+// Ready unlocks the keystore with the passphrase in config
+func (ks *keystoreSigner) Ready() error {
+	if ks.account.PrivateKey() != nil {
+		return nil
+	}
+	return ks.account.UnlockWithPassphrase(ks.passphrase)
+}
+
+// remoteMinerSigner signs with the miner key held by a signing daemon, which
+// is reconnected to whenever found unreachable
+type remoteMinerSigner struct {
+	cfg  *wallet.RemoteSignerConfig
+	addr types.Address
+
+	mtx       sync.RWMutex
+	signer    *wallet.RemoteSigner
+	reachable bool
+}
+
+var _ minerSigner = (*remoteMinerSigner)(nil)
+
+func newRemoteMinerSigner(cfg *wallet.RemoteSignerConfig, miner string) (*remoteMinerSigner, error) {
+	if len(miner) == 0 {
+		return nil, ErrNoMinerAddress
+	}
+	addr, err := types.NewAddress(miner)
+	if err != nil {
+		return nil, err
+	}
+	signer, err := wallet.NewRemoteSigner(cfg)
+	if err != nil {
+		return nil, err
+	}
+	rs := &remoteMinerSigner{cfg: cfg, addr: addr, signer: signer}
+	rs.check()
+	return rs, nil
+}
+
+func (rs *remoteMinerSigner) Addr() types.Address {
+	return rs.addr
+}
+
+func (rs *remoteMinerSigner) SignCompact(hash *crypto.HashType) ([]byte, error) {
+	rs.mtx.RLock()
+	signer := rs.signer
+	rs.mtx.RUnlock()
+	return signer.SignCompact(rs.addr, hash)
+}
+
+// Ready returns ErrSignerUnreachable if the signing daemon failed the last
+// check
+func (rs *remoteMinerSigner) Ready() error {
+	rs.mtx.RLock()
+	defer rs.mtx.RUnlock()
+	if !rs.reachable {
+		return ErrSignerUnreachable
+	}
+	return nil
+}
+
+// keepAlive checks the signing daemon periodically till p closes
+func (rs *remoteMinerSigner) keepAlive(p goprocess.Process) {
+	ticker := time.NewTicker(signerCheckInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			rs.check()
+		case <-p.Closing():
+			rs.mtx.Lock()
+			rs.signer.Close()
+			rs.mtx.Unlock()
+			return
+		}
+	}
+}
+
+// check asks the signing daemon for the miner public key. The daemon is
+// dialed again if it fails, and an alarm is raised as mint is refused till
+// it recovers
+func (rs *remoteMinerSigner) check() {
+	rs.mtx.RLock()
+	signer, wasReachable := rs.signer, rs.reachable
+	rs.mtx.RUnlock()
+
+	_, err := signer.PublicKey(rs.addr)
+	if err == nil {
+		if !wasReachable {
+			logger.Infof("Miner signer %s is reachable, mint resumes", rs.cfg.Address)
+		}
+		rs.setReachable(signer, true)
+		return
+	}
+
+	MetricsSignerUnreachableCounter.Inc(1)
+	if wasReachable {
+		logger.Errorf("Miner signer %s is unreachable, refuse to mint till it recovers. Err: %v", rs.cfg.Address, err)
+	} else {
+		logger.Warnf("Miner signer %s is still unreachable. Err: %v", rs.cfg.Address, err)
+	}
+	redialed, err := wallet.NewRemoteSigner(rs.cfg)
+	if err != nil {
+		logger.Warnf("Failed to reconnect to miner signer %s. Err: %v", rs.cfg.Address, err)
+		rs.setReachable(signer, false)
+		return
+	}
+	signer.Close()
+	rs.setReachable(redialed, false)
+}
+
+func (rs *remoteMinerSigner) setReachable(signer *wallet.RemoteSigner, reachable bool) {
+	rs.mtx.Lock()
+	defer rs.mtx.Unlock()
+	rs.signer = signer
+	rs.reachable = reachable
+	if reachable {
+		MetricsSignerReachableGauge.Update(1)
+	} else {
+		MetricsSignerReachableGauge.Update(0)
+	}
+}
@@ -0,0 +1,102 @@
+// Copyright (c) 2018 ContentBox Authors.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package dpos
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/BOXFoundation/boxd/core/types"
+	"github.com/BOXFoundation/boxd/crypto"
+	"github.com/BOXFoundation/boxd/wallet"
+	"github.com/BOXFoundation/boxd/wallet/pb"
+	"github.com/facebookgo/ensure"
+	"google.golang.org/grpc"
+)
+
+// memSignerServer is a signing daemon holding a key in memory
+type memSignerServer struct {
+	privKey *crypto.PrivateKey
+	addr    types.Address
+}
+
+func (s *memSignerServer) ListAddresses(ctx context.Context, req *walletpb.ListAddressesRequest) (*walletpb.ListAddressesResponse, error) {
+	return &walletpb.ListAddressesResponse{Addrs: []string{s.addr.String()}}, nil
+}
+
+func (s *memSignerServer) GetPublicKey(ctx context.Context, req *walletpb.GetPublicKeyRequest) (*walletpb.GetPublicKeyResponse, error) {
+	return &walletpb.GetPublicKeyResponse{PublicKey: s.privKey.PubKey().Serialize()}, nil
+}
+
+func (s *memSignerServer) SignHash(ctx context.Context, req *walletpb.SignHashRequest) (*walletpb.SignHashResponse, error) {
+	hash := new(crypto.HashType)
+	copy(hash[:], req.Hash)
+	sig, err := crypto.Sign(s.privKey, hash)
+	if err != nil {
+		return nil, err
+	}
+	return &walletpb.SignHashResponse{Signature: sig.Serialize()}, nil
+}
+
+func (s *memSignerServer) SignCompact(ctx context.Context, req *walletpb.SignCompactRequest) (*walletpb.SignCompactResponse, error) {
+	sig, err := crypto.SignCompact(s.privKey, req.Hash)
+	if err != nil {
+		return nil, err
+	}
+	return &walletpb.SignCompactResponse{Signature: sig}, nil
+}
+
+func serveSigner(t *testing.T, addr string, srv *memSignerServer) *grpc.Server {
+	lis, err := net.Listen("tcp", addr)
+	ensure.Nil(t, err)
+	server := grpc.NewServer()
+	walletpb.RegisterRemoteSignerServer(server, srv)
+	go server.Serve(lis)
+	return server
+}
+
+func TestRemoteMinerSigner(t *testing.T) {
+	privKey, pubKey, _ := crypto.NewKeyPair()
+	addr, _ := types.NewAddressFromPubKey(pubKey)
+	srv := &memSignerServer{privKey: privKey, addr: addr}
+
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	ensure.Nil(t, err)
+	signerAddr := lis.Addr().String()
+	lis.Close()
+	server := serveSigner(t, signerAddr, srv)
+
+	cfg := &wallet.RemoteSignerConfig{Address: signerAddr, Timeout: 1}
+	_, err = newRemoteMinerSigner(cfg, "")
+	ensure.DeepEqual(t, err, ErrNoMinerAddress)
+
+	signer, err := newRemoteMinerSigner(cfg, addr.String())
+	ensure.Nil(t, err)
+	ensure.Nil(t, signer.Ready())
+	hash := crypto.DoubleHashH([]byte("block"))
+	sig, err := signer.SignCompact(&hash)
+	ensure.Nil(t, err)
+	recovered, ok := crypto.RecoverCompact(hash[:], sig)
+	ensure.True(t, ok)
+	ensure.DeepEqual(t, recovered.Serialize(), pubKey.Serialize())
+
+	// mint is refused while the daemon is down
+	server.Stop()
+	signer.check()
+	ensure.DeepEqual(t, signer.Ready(), ErrSignerUnreachable)
+
+	// and resumes once it is reconnected to
+	server = serveSigner(t, signerAddr, srv)
+	defer server.Stop()
+	for i := 0; i < 50 && signer.Ready() != nil; i++ {
+		time.Sleep(100 * time.Millisecond)
+		signer.check()
+	}
+	ensure.Nil(t, signer.Ready())
+	_, err = signer.SignCompact(&hash)
+	ensure.Nil(t, err)
+}
@@ -0,0 +1,237 @@
+// Copyright (c) 2018 ContentBox Authors.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package dpos
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/BOXFoundation/boxd/core/chain"
+	"github.com/BOXFoundation/boxd/core/types"
+	"github.com/BOXFoundation/boxd/metrics"
+)
+
+const (
+	// statsEpochs is how many recent epochs miner stats are kept for, an hour
+	statsEpochs = 120
+	// miners expected to produce at least absentMinSlots blocks in recent
+	// epochs and missing more than absentMissPercent of them are absent
+	absentMinSlots    = 12
+	absentMissPercent = 50
+)
+
+// slotCounts counts blocks produced and expected
+type slotCounts struct {
+	produced int64
+	expected int64
+}
+
+// minerStats counts blocks each miner produced and was expected to produce,
+// per epoch in recent epochs. Counts are kept by each node from the blocks it
+// connected, for reporting only, and play no part in consensus
+type minerStats struct {
+	mtx    sync.RWMutex
+	epochs map[int64]map[types.AddressHash]*slotCounts
+	latest int64
+}
+
+func newMinerStats() *minerStats {
+	return &minerStats{epochs: make(map[int64]map[types.AddressHash]*slotCounts)}
+}
+
+// update counts slots after the one of parent up to the one of block, each
+// expected of its miner, and block's own produced. Counts are taken back if
+// block is disconnected. Miners counted are returned
+func (s *minerStats) update(pc *PeriodContext, parent, block *types.Block, connected bool) map[types.AddressHash]bool {
+	delta := int64(1)
+	if !connected {
+		delta = -1
+	}
+	t := pc.timing()
+	epoch := t.epochOf(block.Header.TimeStamp)
+	windowStart := t.epochStart(epoch - statsEpochs + 1)
+
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	if connected && epoch > s.latest {
+		s.latest = epoch
+		s.prune()
+	}
+	miners := make(map[types.AddressHash]bool)
+	slotTime := t.nextSlot(parent.Header.TimeStamp)
+	if slotTime < windowStart {
+		slotTime = windowStart
+	}
+	for ; slotTime <= block.Header.TimeStamp; slotTime = t.nextSlot(slotTime) {
+		miner, err := pc.FindMinerWithTimeStamp(slotTime)
+		if err != nil {
+			continue
+		}
+		counts := s.counts(t.epochOf(slotTime), *miner, connected)
+		if counts == nil {
+			continue
+		}
+		counts.expected += delta
+		if slotTime == block.Header.TimeStamp {
+			counts.produced += delta
+		}
+		miners[*miner] = true
+	}
+	return miners
+}
+
+// counts returns counts of miner in epoch, created if create is true. It's
+// called with mtx held
+func (s *minerStats) counts(epoch int64, miner types.AddressHash, create bool) *slotCounts {
+	if epoch <= s.latest-statsEpochs {
+		return nil
+	}
+	miners, ok := s.epochs[epoch]
+	if !ok {
+		if !create {
+			return nil
+		}
+		miners = make(map[types.AddressHash]*slotCounts)
+		s.epochs[epoch] = miners
+	}
+	counts, ok := miners[miner]
+	if !ok {
+		if !create {
+			return nil
+		}
+		counts = &slotCounts{}
+		miners[miner] = counts
+	}
+	return counts
+}
+
+// prune drops epochs no longer recent. It's called with mtx held
+func (s *minerStats) prune() {
+	for epoch := range s.epochs {
+		if epoch <= s.latest-statsEpochs {
+			delete(s.epochs, epoch)
+		}
+	}
+}
+
+// stats returns counts of each miner summed over the last epochs epochs,
+// all kept if epochs is 0, with the miner missing most first
+func (s *minerStats) stats(epochs uint32) []*types.MinerStats {
+	s.mtx.RLock()
+	defer s.mtx.RUnlock()
+
+	byMiner := make(map[types.AddressHash]*types.MinerStats)
+	for epoch, miners := range s.epochs {
+		if epochs > 0 && epoch <= s.latest-int64(epochs) {
+			continue
+		}
+		for miner, counts := range miners {
+			if counts.expected == 0 {
+				// left by disconnected blocks
+				continue
+			}
+			stats, ok := byMiner[miner]
+			if !ok {
+				stats = &types.MinerStats{Miner: miner}
+				byMiner[miner] = stats
+			}
+			stats.Produced += uint32(counts.produced)
+			stats.Expected += uint32(counts.expected)
+			stats.Epochs = append(stats.Epochs, &types.EpochMinerStats{
+				Epoch:    epoch,
+				Produced: uint32(counts.produced),
+				Expected: uint32(counts.expected),
+			})
+		}
+	}
+	result := make([]*types.MinerStats, 0, len(byMiner))
+	for _, stats := range byMiner {
+		sort.Slice(stats.Epochs, func(i, j int) bool { return stats.Epochs[i].Epoch < stats.Epochs[j].Epoch })
+		stats.Absent = isAbsent(stats.Produced, stats.Expected)
+		result = append(result, stats)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		mi, mj := result[i].Expected-result[i].Produced, result[j].Expected-result[j].Produced
+		if mi != mj {
+			return mi > mj
+		}
+		return string(result[i].Miner[:]) < string(result[j].Miner[:])
+	})
+	return result
+}
+
+// absentMiners returns miners missing too many of their slots in recent
+// epochs
+func (s *minerStats) absentMiners() map[types.AddressHash]bool {
+	absent := make(map[types.AddressHash]bool)
+	for _, stats := range s.stats(0) {
+		if stats.Absent {
+			absent[stats.Miner] = true
+		}
+	}
+	return absent
+}
+
+// isAbsent checks if a miner producing produced of expected blocks misses
+// too many of them
+func isAbsent(produced, expected uint32) bool {
+	return expected >= absentMinSlots && (expected-produced)*100 > expected*absentMissPercent
+}
+
+// updateMinerMetrics sets gauges of blocks miners produced and were expected
+// to produce in recent epochs
+func (s *minerStats) updateMinerMetrics(miners map[types.AddressHash]bool) {
+	for _, stats := range s.stats(0) {
+		if !miners[stats.Miner] {
+			continue
+		}
+		metrics.NewGauge(fmt.Sprintf("box.dpos.miner.%x.produced", stats.Miner[:])).Update(int64(stats.Produced))
+		metrics.NewGauge(fmt.Sprintf("box.dpos.miner.%x.expected", stats.Miner[:])).Update(int64(stats.Expected))
+	}
+}
+
+// loadMinerStats counts blocks of recent epochs from the tail block back
+func (dpos *Dpos) loadMinerStats() error {
+	block := dpos.chain.TailBlock()
+	pc := dpos.context.periodContext
+	oldest := pc.epochOf(block.Header.TimeStamp) - statsEpochs + 1
+	miners := make(map[types.AddressHash]bool)
+	for block.Height > 0 && pc.epochOf(block.Header.TimeStamp) >= oldest {
+		parent, err := dpos.chain.LoadBlockByHash(block.Header.PrevBlockHash)
+		if err != nil {
+			return err
+		}
+		for miner := range dpos.stats.update(dpos.context.periodContext, parent, block, true) {
+			miners[miner] = true
+		}
+		block = parent
+	}
+	dpos.stats.updateMinerMetrics(miners)
+	return nil
+}
+
+// onChainUpdate follows chain parameters as of the new tail, and counts
+// slots of blocks connected to or disconnected from main chain
+func (dpos *Dpos) onChainUpdate(msg *chain.UpdateMsg) {
+	if msg.Block.Height == 0 {
+		return
+	}
+	// chain parameters are those as of the new tail
+	tail := msg.Block.BlockHash()
+	if !msg.Connected {
+		tail = &msg.Block.Header.PrevBlockHash
+	}
+	if err := dpos.updateTiming(tail); err != nil {
+		logger.Errorf("Failed to load chain parameters as of block %s: %v", tail, err)
+	}
+	parent, err := dpos.chain.LoadBlockByHash(msg.Block.Header.PrevBlockHash)
+	if err != nil {
+		logger.Errorf("Failed to load parent of block %s to count miner slots: %v", msg.Block.BlockHash(), err)
+		return
+	}
+	miners := dpos.stats.update(dpos.context.periodContext, parent, msg.Block, msg.Connected)
+	dpos.stats.updateMinerMetrics(miners)
+}
@@ -0,0 +1,69 @@
+// Copyright (c) 2018 ContentBox Authors.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package dpos
+
+import (
+	"testing"
+
+	"github.com/BOXFoundation/boxd/core/types"
+	"github.com/facebookgo/ensure"
+)
+
+func newStatsBlock(timestamp int64) *types.Block {
+	return &types.Block{Header: &types.BlockHeader{TimeStamp: timestamp}}
+}
+
+func TestMinerStatsUpdate(t *testing.T) {
+	alice, bob, carol := types.AddressHash{0x01}, types.AddressHash{0x02}, types.AddressHash{0x03}
+	pc := &PeriodContext{period: []*Period{{addr: alice}, {addr: bob}, {addr: carol}}}
+	epochLen := NewBlockTimeInterval * PeriodSize / SecondInMs
+	stats := newMinerStats()
+
+	// bob misses his slot between alice's and carol's in epoch 1
+	parent, block := newStatsBlock(epochLen), newStatsBlock(epochLen+10)
+	ensure.DeepEqual(t, stats.update(pc, parent, block, true), map[types.AddressHash]bool{bob: true, carol: true})
+	next := newStatsBlock(2 * epochLen)
+	ensure.DeepEqual(t, stats.update(pc, block, next, true), map[types.AddressHash]bool{alice: true})
+
+	ensure.DeepEqual(t, stats.stats(0), []*types.MinerStats{
+		{Miner: bob, Produced: 0, Expected: 1, Epochs: []*types.EpochMinerStats{{Epoch: 1, Produced: 0, Expected: 1}}},
+		{Miner: alice, Produced: 1, Expected: 1, Epochs: []*types.EpochMinerStats{{Epoch: 2, Produced: 1, Expected: 1}}},
+		{Miner: carol, Produced: 1, Expected: 1, Epochs: []*types.EpochMinerStats{{Epoch: 1, Produced: 1, Expected: 1}}},
+	})
+	// only the latest epoch
+	ensure.DeepEqual(t, stats.stats(1), []*types.MinerStats{
+		{Miner: alice, Produced: 1, Expected: 1, Epochs: []*types.EpochMinerStats{{Epoch: 2, Produced: 1, Expected: 1}}},
+	})
+
+	// disconnecting takes counts back
+	stats.update(pc, block, next, false)
+	ensure.DeepEqual(t, len(stats.stats(0)), 2)
+	ensure.DeepEqual(t, len(stats.stats(1)), 0)
+}
+
+func TestMinerStatsAbsent(t *testing.T) {
+	alice, bob, carol := types.AddressHash{0x01}, types.AddressHash{0x02}, types.AddressHash{0x03}
+	pc := &PeriodContext{period: []*Period{{addr: alice}, {addr: bob}, {addr: carol}}}
+	epochLen := NewBlockTimeInterval * PeriodSize / SecondInMs
+	stats := newMinerStats()
+
+	// bob misses one slot every epoch
+	for epoch := int64(1); epoch < absentMinSlots; epoch++ {
+		stats.update(pc, newStatsBlock(epoch*epochLen), newStatsBlock(epoch*epochLen+10), true)
+	}
+	ensure.DeepEqual(t, len(stats.absentMiners()), 0)
+	stats.update(pc, newStatsBlock(absentMinSlots*epochLen), newStatsBlock(absentMinSlots*epochLen+10), true)
+	ensure.DeepEqual(t, stats.absentMiners(), map[types.AddressHash]bool{bob: true})
+
+	// epochs no longer recent are dropped, only the last one bob missed a
+	// slot in is kept
+	last := (absentMinSlots + statsEpochs - 1) * epochLen
+	stats.update(pc, newStatsBlock(last), newStatsBlock(last+10), true)
+	ensure.DeepEqual(t, stats.stats(0)[0].Epochs, []*types.EpochMinerStats{
+		{Epoch: absentMinSlots, Produced: 0, Expected: 1},
+		{Epoch: absentMinSlots + statsEpochs - 1, Produced: 0, Expected: 1},
+	})
+	ensure.DeepEqual(t, len(stats.absentMiners()), 0)
+}
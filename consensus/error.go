@@ -0,0 +1,12 @@
+// Copyright (c) 2018 ContentBox Authors.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package consensus
+
+import "errors"
+
+// error
+var (
+	ErrInvalidConfig = errors.New("Configurations are not of the consensus engine")
+)
@@ -0,0 +1,16 @@
+// Copyright (c) 2018 ContentBox Authors.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package solo
+
+import "errors"
+
+// error
+var (
+	ErrNoMiner         = errors.New("Miner address must be set for solo")
+	ErrNotMiner        = errors.New("Key in keypath is not of the miner")
+	ErrMintDisabled    = errors.New("Mint is disabled")
+	ErrFailedCoinbase  = errors.New("Failed to create coinbaseTx")
+	ErrMinerKeyMissing = errors.New("Miner key is not loaded")
+)
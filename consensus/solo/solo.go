@@ -0,0 +1,297 @@
+// Copyright (c) 2018 ContentBox Authors.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package solo
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/BOXFoundation/boxd/consensus"
+	"github.com/BOXFoundation/boxd/core/chain"
+	corepb "github.com/BOXFoundation/boxd/core/pb"
+	"github.com/BOXFoundation/boxd/core/txpool"
+	"github.com/BOXFoundation/boxd/core/types"
+	"github.com/BOXFoundation/boxd/crypto"
+	"github.com/BOXFoundation/boxd/log"
+	"github.com/BOXFoundation/boxd/storage"
+	"github.com/BOXFoundation/boxd/wallet"
+	"github.com/jbenet/goprocess"
+)
+
+var logger = log.NewLogger("solo") // logger
+
+// Name is the name solo registers as a consensus engine with
+const Name = "solo"
+
+// defaultInterval is seconds between blocks if not configured
+const defaultInterval = int64(5)
+
+// Config defines the configurations of solo
+type Config struct {
+	Keypath    string `mapstructure:"keypath"`
+	EnableMint bool   `mapstructure:"enable_mint"`
+	Passphrase string `mapstructure:"passphrase"`
+	// Miner is the address of the only miner, all nodes accept blocks
+	// signed by it only
+	Miner string `mapstructure:"miner"`
+	// Interval is seconds between blocks, default is used if not set
+	Interval int64 `mapstructure:"interval"`
+	// BLSKeyPath is the file of the BLS key signing eternal votes, generated
+	// if missing
+	BLSKeyPath string `mapstructure:"bls_key_path"`
+}
+
+// Solo is a consensus engine for development and tests, where a single
+// miner mints all blocks at a fixed interval. Blocks the miner mints are
+// eternal at once.
+type Solo struct {
+	chain       *chain.BlockChain
+	txpool      *txpool.TransactionPool
+	proc        goprocess.Process
+	cfg         *Config
+	miner       types.Address
+	account     *wallet.Account
+	blsKey      *crypto.BLSPrivateKey
+	disableMint int32
+}
+
+func init() {
+	// register solo impl
+	consensus.Register(Name, newEngine)
+}
+
+func newEngine(params *consensus.Params) (consensus.Engine, error) {
+	cfg, ok := params.Config.(*Config)
+	if !ok {
+		return nil, consensus.ErrInvalidConfig
+	}
+	return NewSolo(params.Proc, params.Chain, params.TxPool, cfg)
+}
+
+// implement interface consensus.Engine
+var _ consensus.Engine = (*Solo)(nil)
+
+// NewSolo new a solo implement.
+func NewSolo(parent goprocess.Process, chain *chain.BlockChain, txpool *txpool.TransactionPool, cfg *Config) (*Solo, error) {
+	if len(cfg.Miner) == 0 {
+		return nil, ErrNoMiner
+	}
+	miner, err := types.NewAddress(cfg.Miner)
+	if err != nil {
+		return nil, err
+	}
+	return &Solo{
+		chain:  chain,
+		txpool: txpool,
+		proc:   goprocess.WithParent(parent),
+		cfg:    cfg,
+		miner:  miner,
+	}, nil
+}
+
+// Start mints blocks if enabled
+func (solo *Solo) Start() error {
+	if !solo.cfg.EnableMint {
+		return nil
+	}
+	account, err := wallet.NewAccountFromFile(solo.cfg.Keypath)
+	if err != nil {
+		return err
+	}
+	if account.Addr() != solo.miner.String() {
+		return ErrNotMiner
+	}
+	if err := account.UnlockWithPassphrase(solo.cfg.Passphrase); err != nil {
+		return err
+	}
+	if solo.blsKey, err = crypto.LoadBLSKey(solo.cfg.BLSKeyPath); err != nil {
+		return err
+	}
+	solo.account = account
+	return solo.Run()
+}
+
+// Run starts minting blocks with the miner key
+func (solo *Solo) Run() error {
+	if solo.account == nil {
+		return ErrMinerKeyMissing
+	}
+	logger.Infof("Solo run, miner: %s", solo.miner)
+	solo.proc.Go(solo.loop)
+	return nil
+}
+
+// Stop solo
+func (solo *Solo) Stop() {
+	solo.proc.Close()
+}
+
+// StopMint stops generating blocks.
+func (solo *Solo) StopMint() {
+	atomic.StoreInt32(&solo.disableMint, 1)
+}
+
+// RecoverMint resumes generating blocks.
+func (solo *Solo) RecoverMint() {
+	atomic.StoreInt32(&solo.disableMint, 0)
+}
+
+func (solo *Solo) interval() int64 {
+	if solo.cfg.Interval > 0 {
+		return solo.cfg.Interval
+	}
+	return defaultInterval
+}
+
+func (solo *Solo) loop(p goprocess.Process) {
+	ticker := time.NewTicker(time.Duration(solo.interval()) * time.Second)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			if err := solo.mint(time.Now().Unix()); err != nil {
+				logger.Warnf("Failed to mint block. Err: %v", err)
+			}
+		case <-p.Closing():
+			logger.Info("Stopped Solo Mining.")
+			return
+		}
+	}
+}
+
+func (solo *Solo) mint(timestamp int64) error {
+	if atomic.LoadInt32(&solo.disableMint) != 0 {
+		return ErrMintDisabled
+	}
+	block := types.NewBlock(solo.chain.TailBlock())
+	block.Header.TimeStamp = timestamp
+	if err := solo.packTxs(block); err != nil {
+		return err
+	}
+	signature, err := crypto.SignCompact(solo.account.PrivateKey(), block.BlockHash()[:])
+	if err != nil {
+		return err
+	}
+	block.Signature = signature
+	return solo.chain.ProcessBlock(block, true, true, "")
+}
+
+// packTxs packs all txs in mempool funded, parents before children
+func (solo *Solo) packTxs(block *types.Block) error {
+	coinbaseTx, err := chain.CreateCoinbaseTx(solo.miner.Hash(), block.Height)
+	if err != nil || coinbaseTx == nil {
+		return ErrFailedCoinbase
+	}
+	blockTxns := []*types.Transaction{coinbaseTx}
+
+	sortedTxs := solo.txpool.GetTxsByPackageFeeRate()
+	txPacked := make([]bool, len(sortedTxs))
+	spendableTxs := new(sync.Map)
+	for found := true; found; {
+		found = false
+		for i, txWrap := range sortedTxs {
+			if txPacked[i] {
+				continue
+			}
+			utxoSet, err := chain.GetExtendedTxUtxoSet(txWrap.Tx, solo.chain.DB(), spendableTxs)
+			if err != nil || !utxoSet.IsTxFunded(txWrap.Tx) {
+				continue
+			}
+			txHash, _ := txWrap.Tx.TxHash()
+			spendableTxs.Store(*txHash, txWrap)
+			blockTxns = append(blockTxns, txWrap.Tx)
+			txPacked[i] = true
+			found = true
+		}
+	}
+
+	block.Header.TxsRoot = *chain.CalcTxsHash(blockTxns)
+	block.Txs = blockTxns
+	logger.Infof("Finish packing txs. Height: %d, TxsNum: %d", block.Height, len(blockTxns))
+	return nil
+}
+
+// VerifySign checks if block is signed by the miner
+func (solo *Solo) VerifySign(block *types.Block) (bool, error) {
+	pubkey, ok := crypto.RecoverCompact(block.BlockHash()[:], block.Signature)
+	if !ok {
+		return false, nil
+	}
+	addr, err := types.NewAddressFromPubKey(pubkey)
+	if err != nil {
+		return false, err
+	}
+	return *addr.Hash160() == *solo.miner.Hash160(), nil
+}
+
+// VerifyMinerEpoch accepts any block as the miner is the only one
+func (solo *Solo) VerifyMinerEpoch(*types.Block) error { return nil }
+
+// StoreCandidateContext does nothing as solo has no candidates
+func (solo *Solo) StoreCandidateContext(*types.Block, storage.BatchWriter) error { return nil }
+
+// VoterRewardOutputs returns no rewards, as solo has no voters
+func (solo *Solo) VoterRewardOutputs(*types.Block) ([]*corepb.TxOut, error) {
+	return nil, nil
+}
+
+// ChainParams returns chain parameters of solo, which are fixed by config
+func (solo *Solo) ChainParams(*crypto.HashType, int64) (*types.ChainParams, error) {
+	return &types.ChainParams{
+		BlockInterval:  solo.interval() * 1000,
+		PeriodDuration: 1, // the only miner mints every slot
+		MaxBlockSize:   chain.MaxBlockSize,
+	}, nil
+}
+
+// ValidateMiner checks if the node holds the miner key
+func (solo *Solo) ValidateMiner() bool {
+	return solo.account != nil
+}
+
+// BroadcastEternalMsgToMiners sets block eternal, justified by the vote of
+// the miner alone
+func (solo *Solo) BroadcastEternalMsgToMiners(block *types.Block) error {
+	hash := block.BlockHash()
+	signature := types.SignEternalVote(solo.blsKey, hash)
+	justification, err := types.NewJustification(hash, map[types.AddressHash][]byte{
+		*solo.miner.Hash160(): signature,
+	})
+	if err != nil {
+		return err
+	}
+	return solo.chain.SetEternal(block, justification)
+}
+
+// GetCandidates returns the miner as the only candidate
+func (solo *Solo) GetCandidates() ([]*types.CandidateInfo, uint32, error) {
+	return []*types.CandidateInfo{{Addr: *solo.miner.Hash160()}}, solo.chain.TailBlock().Height, nil
+}
+
+// GetMinerSchedule returns the current slot as an epoch, which the miner
+// mints in
+func (solo *Solo) GetMinerSchedule() (*types.MinerSchedule, error) {
+	return solo.schedule(time.Now().Unix(), solo.chain.TailBlock()), nil
+}
+
+func (solo *Solo) schedule(now int64, tail *types.Block) *types.MinerSchedule {
+	interval := solo.interval()
+	epoch := now / interval
+	miner := *solo.miner.Hash160()
+	return &types.MinerSchedule{
+		Epoch:           epoch,
+		SlotInterval:    interval,
+		Slots:           []*types.MinerSlot{{Time: epoch * interval, Miner: miner}},
+		NextEpochTime:   (epoch + 1) * interval,
+		NextEpochHeight: tail.Height + 1,
+		NextMiners:      []types.AddressHash{miner},
+	}
+}
+
+// GetMinerStats returns no stats, which solo does not keep
+func (solo *Solo) GetMinerStats(uint32) ([]*types.MinerStats, error) {
+	return nil, nil
+}
@@ -0,0 +1,64 @@
+// Copyright (c) 2018 ContentBox Authors.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package solo
+
+import (
+	"testing"
+
+	"github.com/BOXFoundation/boxd/core/types"
+	"github.com/BOXFoundation/boxd/crypto"
+	"github.com/facebookgo/ensure"
+	"github.com/jbenet/goprocess"
+)
+
+func newTestSolo(t *testing.T) (*Solo, *crypto.PrivateKey) {
+	privKey, pubKey, err := crypto.NewKeyPair()
+	ensure.Nil(t, err)
+	addr, err := types.NewAddressFromPubKey(pubKey)
+	ensure.Nil(t, err)
+	solo, err := NewSolo(goprocess.Background(), nil, nil, &Config{Miner: addr.String(), Interval: 3})
+	ensure.Nil(t, err)
+	return solo, privKey
+}
+
+func TestNewSolo(t *testing.T) {
+	_, err := NewSolo(goprocess.Background(), nil, nil, &Config{})
+	ensure.DeepEqual(t, err, ErrNoMiner)
+}
+
+func TestSoloVerifySign(t *testing.T) {
+	solo, privKey := newTestSolo(t)
+	other, _, err := crypto.NewKeyPair()
+	ensure.Nil(t, err)
+
+	block := types.NewBlock(&types.Block{Header: &types.BlockHeader{}})
+	block.Header.TimeStamp = 100
+	block.Signature, err = crypto.SignCompact(privKey, block.BlockHash()[:])
+	ensure.Nil(t, err)
+	ok, err := solo.VerifySign(block)
+	ensure.Nil(t, err)
+	ensure.True(t, ok)
+
+	// blocks signed by others are rejected
+	block.Signature, err = crypto.SignCompact(other, block.BlockHash()[:])
+	ensure.Nil(t, err)
+	ok, err = solo.VerifySign(block)
+	ensure.Nil(t, err)
+	ensure.False(t, ok)
+}
+
+func TestSoloSchedule(t *testing.T) {
+	solo, _ := newTestSolo(t)
+	miner := *solo.miner.Hash160()
+	schedule := solo.schedule(10, &types.Block{Height: 4})
+	ensure.DeepEqual(t, schedule, &types.MinerSchedule{
+		Epoch:           3,
+		SlotInterval:    3,
+		Slots:           []*types.MinerSlot{{Time: 9, Miner: miner}},
+		NextEpochTime:   12,
+		NextEpochHeight: 5,
+		NextMiners:      []types.AddressHash{miner},
+	})
+}
@@ -6,6 +6,7 @@ package chain
 
 import (
 	"bytes"
+	"context"
 	"errors"
 	"fmt"
 	"sync"
@@ -28,6 +29,8 @@ import (
 	peer "github.com/libp2p/go-libp2p-peer"
 )
 
+//go:generate go run ../../boxd/eventbus/gentopics -imports github.com/BOXFoundation/boxd/core/types ChainUpdate=msg:*UpdateMsg EternalBlock=block:*types.Block DoubleMint=evidence:*types.DoubleMintEvidence TxConfirmed=tx:*types.Transaction,block:*types.Block
+
 // const defines constants
 const (
 	BlockMsgChBufferSize        = 1024
@@ -56,8 +59,12 @@ var _ service.ChainReader = (*BlockChain)(nil)
 type BlockChain struct {
 	notifiee                  p2p.Net
 	newblockMsgCh             chan p2p.Message
+	blockNotifiee             *p2p.Notifiee
+	drainCh                   chan chan struct{}
 	consensus                 types.Consensus
+	storage                   storage.Storage
 	db                        storage.Table
+	archive                   storage.Table
 	genesis                   *types.Block
 	tail                      *types.Block
 	eternal                   *types.Block
@@ -88,11 +95,13 @@ func NewBlockChain(parent goprocess.Process, notifiee p2p.Net, db storage.Storag
 	b := &BlockChain{
 		notifiee:                  notifiee,
 		newblockMsgCh:             make(chan p2p.Message, BlockMsgChBufferSize),
+		drainCh:                   make(chan chan struct{}),
 		proc:                      goprocess.WithParent(parent),
 		hashToOrphanBlock:         make(map[crypto.HashType]*types.Block),
 		orphanBlockHashToChildren: make(map[crypto.HashType][]*types.Block),
 		filterHolder:              NewFilterHolder(),
 		bus:                       eventbus.Default(),
+		storage:                   db,
 	}
 
 	var err error
@@ -103,6 +112,9 @@ func NewBlockChain(parent goprocess.Process, notifiee p2p.Net, db storage.Storag
 	if b.db, err = db.Table(BlockTableName); err != nil {
 		return nil, err
 	}
+	if b.archive, err = db.Table(BlockArchiveTableName); err != nil {
+		return nil, err
+	}
 
 	if b.genesis, err = b.loadGenesis(); err != nil {
 		logger.Error("Failed to load genesis block ", err)
@@ -119,6 +131,7 @@ func NewBlockChain(parent goprocess.Process, notifiee p2p.Net, db storage.Storag
 		return nil, err
 	}
 	b.LongestChainHeight = b.tail.Height
+	p2p.UpdateBestHeight(b.tail.Height)
 
 	if err = b.loadFilters(); err != nil {
 		logger.Error("Fail to load filters", err)
@@ -166,7 +179,45 @@ func (chain *BlockChain) Stop() {
 }
 
 func (chain *BlockChain) subscribeMessageNotifiee() {
-	chain.notifiee.Subscribe(p2p.NewNotifiee(p2p.NewBlockMsg, p2p.Unique, chain.newblockMsgCh))
+	chain.blockNotifiee = p2p.NewNotifiee(p2p.NewBlockMsg, p2p.Unique, chain.newblockMsgCh)
+	chain.notifiee.Subscribe(chain.blockNotifiee)
+}
+
+// Drain stops receiving blocks from peers, and returns once blocks received
+// are processed, or the blockchain is closing. It's called on shutdown so
+// that blocks are not dropped half processed
+func (chain *BlockChain) Drain() {
+	if chain.blockNotifiee != nil {
+		chain.notifiee.UnSubscribe(chain.blockNotifiee)
+	}
+	done := make(chan struct{})
+	select {
+	case chain.drainCh <- done:
+	case <-chain.proc.Closing():
+		return
+	}
+	select {
+	case <-done:
+	case <-chain.proc.Closing():
+	}
+}
+
+// drain processes blocks left in newblockMsgCh, stopping early if p is
+// closing
+func (chain *BlockChain) drain(p goprocess.Process) {
+	logger.Infof("Processing %d blocks received before shutdown", len(chain.newblockMsgCh))
+	for {
+		select {
+		case msg := <-chain.newblockMsgCh:
+			if err := chain.processBlockMsg(msg); err != nil {
+				logger.Warnf("Failed to processBlockMsg. Err: %s", err.Error())
+			}
+		case <-p.Closing():
+			return
+		default:
+			return
+		}
+	}
 }
 
 func (chain *BlockChain) loop(p goprocess.Process) {
@@ -179,6 +230,9 @@ func (chain *BlockChain) loop(p goprocess.Process) {
 			if err := chain.processBlockMsg(msg); err != nil {
 				logger.Warnf("Failed to processBlockMsg. Err: %s", err.Error())
 			}
+		case done := <-chain.drainCh:
+			chain.drain(p)
+			close(done)
 		case <-metricsTicker.C:
 			metrics.MetricsCachedBlockMsgGauge.Update(int64(len(chain.newblockMsgCh)))
 			metrics.MetricsBlockOrphanPoolSizeGauge.Update(int64(len(chain.hashToOrphanBlock)))
@@ -191,13 +245,37 @@ func (chain *BlockChain) loop(p goprocess.Process) {
 	}
 }
 
-func (chain *BlockChain) verifyRepeatedMint(block *types.Block) bool {
+// verifyRepeatedMint returns the block accepted at the timestamp of block if
+// it's a different one, nil otherwise
+func (chain *BlockChain) verifyRepeatedMint(block *types.Block) *types.Block {
 	if exist, ok := chain.repeatedMintCache.Get(block.Header.TimeStamp); ok {
-		if exist.(*types.Block).BlockHash() != block.BlockHash() {
-			return false
+		if *exist.(*types.Block).BlockHash() != *block.BlockHash() {
+			return exist.(*types.Block)
 		}
 	}
-	return true
+	return nil
+}
+
+// recordDoubleMint stores the evidence of block minted at the same time as
+// exist by the same miner and publishes it, so that miners put it on chain to
+// penalize the miner. Blocks not signed by the miner of exist prove nothing
+func (chain *BlockChain) recordDoubleMint(exist, block *types.Block) {
+	evidence, err := types.NewDoubleMintEvidence(exist, block)
+	if err != nil {
+		logger.Warnf("Block %v conflicts with %v at timestamp %d: %v", block.BlockHash(), exist.BlockHash(), block.Header.TimeStamp, err)
+		return
+	}
+	data, err := evidence.Marshal()
+	if err != nil {
+		logger.Errorf("Failed to marshal double mint evidence: %v", err)
+		return
+	}
+	if err := chain.db.Put(EvidenceKey(evidence.Miner, evidence.Timestamp()), data); err != nil {
+		logger.Errorf("Failed to store double mint evidence: %v", err)
+		return
+	}
+	logger.Warnf("Miner %x minted blocks %v and %v at timestamp %d", evidence.Miner[:], exist.BlockHash(), block.BlockHash(), block.Header.TimeStamp)
+	PublishDoubleMint(chain.bus, evidence)
 }
 
 func (chain *BlockChain) processBlockMsg(msg p2p.Message) error {
@@ -206,7 +284,8 @@ func (chain *BlockChain) processBlockMsg(msg p2p.Message) error {
 	if err := block.Unmarshal(msg.Body()); err != nil {
 		return err
 	}
-	if ok := chain.verifyRepeatedMint(block); !ok {
+	if exist := chain.verifyRepeatedMint(block); exist != nil {
+		chain.recordDoubleMint(exist, block)
 		return core.ErrRepeatedMintAtSameTime
 	}
 	if err := VerifyBlockTimeOut(block); err != nil {
@@ -215,42 +294,66 @@ func (chain *BlockChain) processBlockMsg(msg p2p.Message) error {
 
 	// process block
 	if err := chain.ProcessBlock(block, false, true, msg.From()); err != nil && util.InArray(err, core.EvilBehavior) {
-		chain.Bus().Publish(eventbus.TopicConnEvent, msg.From(), eventbus.BadBlockEvent)
+		p2p.PublishConnEvent(chain.Bus(), msg.From(), eventbus.BadBlockEvent)
 		return err
 	}
-	chain.Bus().Publish(eventbus.TopicConnEvent, msg.From(), eventbus.NewBlockEvent)
+	p2p.PublishConnEvent(chain.Bus(), msg.From(), eventbus.NewBlockEvent)
 	return nil
 }
 
 // ProcessBlock is used to handle new blocks.
-func (chain *BlockChain) ProcessBlock(block *types.Block, broadcast bool, fastConfirm bool, messageFrom peer.ID) error {
+func (chain *BlockChain) ProcessBlock(block *types.Block, broadcast bool, fastConfirm bool, messageFrom peer.ID) (err error) {
+	ctx, span := metrics.StartBlockSpan(context.Background(), "chain.ProcessBlock", block)
+	defer func() {
+		span.SetError(err)
+		span.Finish()
+	}()
 
 	chain.chainLock.Lock()
 	defer chain.chainLock.Unlock()
 
 	blockHash := block.BlockHash()
-	logger.Infof("Prepare to process block. Hash: %s, Height: %d", blockHash.String(), block.Height)
+	blockLogger := logger.WithFields(log.Fields{log.FieldHeight: block.Height, log.FieldPeer: messageFrom.Pretty()})
+	blockLogger.Infof("Prepare to process block. Hash: %s, Height: %d", blockHash.String(), block.Height)
+
+	// Refuse blocks before any is written if the disk is about to be full,
+	// rather than failing in the middle of connecting them. They are synced
+	// again once space is freed.
+	if checker, ok := chain.storage.(storage.DiskSpaceChecker); ok {
+		if err := checker.CheckDiskSpace(true); err != nil {
+			blockLogger.Errorf("Failed to process block. Hash: %s, Height: %d, Err: %v", blockHash.String(), block.Height, err)
+			return err
+		}
+	}
 
 	// The block must not already exist in the main chain or side chains.
 	if exists := chain.verifyExists(*blockHash); exists {
-		logger.Warnf("The block is already exist. Hash: %s, Height: %d", blockHash.String(), block.Height)
+		blockLogger.Warnf("The block is already exist. Hash: %s, Height: %d", blockHash.String(), block.Height)
 		return core.ErrBlockExists
 	}
 
-	if ok, err := chain.consensus.VerifySign(block); err != nil || !ok {
-		logger.Errorf("Failed to verify block signature. Hash: %v, Height: %d, Err: %v", block.BlockHash().String(), block.Height, err)
+	_, verifySpan := metrics.StartSpan(ctx, "consensus.VerifySign")
+	ok, err := chain.consensus.VerifySign(block)
+	verifySpan.SetError(err)
+	verifySpan.Finish()
+	if err != nil || !ok {
+		blockLogger.Errorf("Failed to verify block signature. Hash: %v, Height: %d, Err: %v", block.BlockHash().String(), block.Height, err)
 		return core.ErrFailedToVerifyWithConsensus
 	}
 
-	if err := validateBlock(block); err != nil {
-		logger.Errorf("Failed to validate block. Hash: %v, Height: %d, Err: %s", block.BlockHash(), block.Height, err.Error())
+	if err := traced(ctx, "chain.validateBlock", func() error { return validateBlock(block) }); err != nil {
+		blockLogger.Errorf("Failed to validate block. Hash: %v, Height: %d, Err: %s", block.BlockHash(), block.Height, err.Error())
+		return err
+	}
+	if err := traced(ctx, "chain.checkBlockSize", func() error { return checkBlockSize(block, MaxBlockSize) }); err != nil {
+		blockLogger.Errorf("Failed to validate block size. Hash: %v, Height: %d, Err: %s", block.BlockHash(), block.Height, err.Error())
 		return err
 	}
 	prevHash := block.Header.PrevBlockHash
 	if prevHashExists := chain.blockExists(prevHash); !prevHashExists {
 
 		// Orphan block.
-		logger.Infof("Adding orphan block %v with parent %v", blockHash.String(), prevHash.String())
+		blockLogger.Infof("Adding orphan block %v with parent %v", blockHash.String(), prevHash.String())
 		chain.addOrphanBlock(block, *blockHash, prevHash)
 		height := chain.tail.Height
 		if height < block.Height && messageFrom != "" {
@@ -264,13 +367,13 @@ func (chain *BlockChain) ProcessBlock(block *types.Block, broadcast bool, fastCo
 	}
 
 	// All context-free checks pass, try to accept the block into the chain.
-	if err := chain.tryAcceptBlock(block); err != nil {
-		logger.Errorf("Failed to accept the block into the main chain. Err: %s", err.Error())
+	if err := chain.tryAcceptBlock(ctx, block); err != nil {
+		blockLogger.Errorf("Failed to accept the block into the main chain. Err: %s", err.Error())
 		return err
 	}
 
-	if err := chain.processOrphans(block); err != nil {
-		logger.Errorf("Failed to processOrphans. Err: %s", err.Error())
+	if err := traced(ctx, "chain.processOrphans", func() error { return chain.processOrphans(ctx, block) }); err != nil {
+		blockLogger.Errorf("Failed to processOrphans. Err: %s", err.Error())
 		return err
 	}
 
@@ -280,7 +383,7 @@ func (chain *BlockChain) ProcessBlock(block *types.Block, broadcast bool, fastCo
 	if chain.consensus.ValidateMiner() && fastConfirm {
 		go chain.consensus.BroadcastEternalMsgToMiners(block)
 	}
-	logger.Infof("Accepted block hash: %v", blockHash.String())
+	blockLogger.Infof("Accepted block hash: %v", blockHash.String())
 	return nil
 }
 
@@ -306,7 +409,13 @@ func (chain *BlockChain) isInOrphanPool(blockHash crypto.HashType) bool {
 
 // tryAcceptBlock validates block within the chain context and see if it can be accepted.
 // Return whether it is on the main chain or not.
-func (chain *BlockChain) tryAcceptBlock(block *types.Block) error {
+func (chain *BlockChain) tryAcceptBlock(ctx context.Context, block *types.Block) (err error) {
+	ctx, span := metrics.StartBlockSpan(ctx, "chain.tryAcceptBlock", block)
+	defer func() {
+		span.SetError(err)
+		span.Finish()
+	}()
+
 	blockHash := block.BlockHash()
 	// must not be orphan if reaching here
 	parentBlock := chain.getParentBlock(block)
@@ -336,7 +445,7 @@ func (chain *BlockChain) tryAcceptBlock(block *types.Block) error {
 	// Case 1): The new block extends the main chain.
 	// We expect this to be the most common case.
 	if parentHash.IsEqual(tailHash) {
-		return chain.tryConnectBlockToMainChain(block)
+		return chain.tryConnectBlockToMainChain(ctx, block)
 	}
 
 	// Case 2): The block extends or creats a side chain, which is not longer than the main chain.
@@ -348,12 +457,12 @@ func (chain *BlockChain) tryAcceptBlock(block *types.Block) error {
 
 	// Case 3): Extended side chain is longer than the main chain and becomes the new main chain.
 	logger.Infof("REORGANIZE: Block %v is causing a reorganization.", blockHash.String())
-	if err := chain.reorganize(block); err != nil {
+	if err := traced(ctx, "chain.reorganize", func() error { return chain.reorganize(block) }); err != nil {
 		return err
 	}
 
 	// This block is now the end of the best chain.
-	if err := chain.SetTailBlock(block); err != nil {
+	if err := traced(ctx, "chain.SetTailBlock", func() error { return chain.SetTailBlock(block) }); err != nil {
 		logger.Errorf("Failed to set tail block. Hash: %s, Height: %d, Err: %s", block.BlockHash().String(), block.Height, err.Error())
 		return err
 	}
@@ -366,7 +475,7 @@ func (chain *BlockChain) addOrphanBlock(orphan *types.Block, orphanHash crypto.H
 	chain.orphanBlockHashToChildren[parentHash] = append(chain.orphanBlockHashToChildren[parentHash], orphan)
 }
 
-func (chain *BlockChain) processOrphans(block *types.Block) error {
+func (chain *BlockChain) processOrphans(ctx context.Context, block *types.Block) error {
 
 	// Start with processing at least the passed block.
 	acceptedBlocks := []*types.Block{block}
@@ -384,7 +493,7 @@ func (chain *BlockChain) processOrphans(block *types.Block) error {
 			// since it will not be accepted later if rejected once.
 			delete(chain.hashToOrphanBlock, *orphanHash)
 			// Potentially accept the block into the block chain.
-			if err := chain.tryAcceptBlock(orphan); err != nil {
+			if err := chain.tryAcceptBlock(ctx, orphan); err != nil {
 				return err
 			}
 			// Add this block to the list of blocks to process so any orphan
@@ -416,24 +525,27 @@ func (chain *BlockChain) getParentBlock(block *types.Block) *types.Block {
 
 // tryConnectBlockToMainChain tries to append the passed block to the main chain.
 // It enforces multiple rules such as double spends and script verification.
-func (chain *BlockChain) tryConnectBlockToMainChain(block *types.Block) error {
+func (chain *BlockChain) tryConnectBlockToMainChain(ctx context.Context, block *types.Block) error {
 	utxoSet := NewUtxoSet()
-	if err := utxoSet.LoadBlockUtxos(block, chain.db); err != nil {
+	if err := traced(ctx, "chain.LoadBlockUtxos", func() error { return utxoSet.LoadBlockUtxos(block, chain.db) }); err != nil {
 		return err
 	}
 
 	// Validate scripts here before utxoSet is updated; otherwise it may fail mistakenly
-	if err := validateBlockScripts(utxoSet, block); err != nil {
+	if err := traced(ctx, "chain.validateBlockScripts", func() error { return validateBlockScripts(utxoSet, block) }); err != nil {
 		return err
 	}
 
 	transactions := block.Txs
 	// Perform several checks on the inputs for each transaction.
 	// Also accumulate the total fees.
+	_, inputsSpan := metrics.StartSpan(ctx, "chain.ValidateTxInputs")
 	var totalFees uint64
 	for _, tx := range transactions {
 		txFee, err := ValidateTxInputs(utxoSet, tx, block.Height)
 		if err != nil {
+			inputsSpan.SetError(err)
+			inputsSpan.Finish()
 			return err
 		}
 
@@ -441,9 +553,12 @@ func (chain *BlockChain) tryConnectBlockToMainChain(block *types.Block) error {
 		lastTotalFees := totalFees
 		totalFees += txFee
 		if totalFees < lastTotalFees {
+			inputsSpan.SetError(core.ErrBadFees)
+			inputsSpan.Finish()
 			return core.ErrBadFees
 		}
 	}
+	inputsSpan.Finish()
 
 	// Ensure coinbase does not output more than block reward.
 	var totalCoinbaseOutput uint64
@@ -456,11 +571,17 @@ func (chain *BlockChain) tryConnectBlockToMainChain(block *types.Block) error {
 			totalCoinbaseOutput, expectedCoinbaseOutput)
 		return core.ErrBadCoinbaseValue
 	}
+	if err := chain.checkVoterRewards(block); err != nil {
+		return err
+	}
+	if err := chain.checkGovernedBlockSize(block); err != nil {
+		return err
+	}
 
-	if err := chain.applyBlock(block, utxoSet); err != nil {
+	if err := traced(ctx, "chain.applyBlock", func() error { return chain.applyBlock(block, utxoSet) }); err != nil {
 		return err
 	}
-	if err := chain.SetTailBlock(block); err != nil {
+	if err := traced(ctx, "chain.SetTailBlock", func() error { return chain.SetTailBlock(block) }); err != nil {
 		logger.Errorf("Failed to set tail block. Hash: %s, Height: %d, Err: %s", block.BlockHash().String(), block.Height, err.Error())
 		return err
 	}
@@ -468,6 +589,64 @@ func (chain *BlockChain) tryConnectBlockToMainChain(block *types.Block) error {
 	return nil
 }
 
+// traced runs step of processing a block within a span named name, a child
+// of the span in ctx
+func traced(ctx context.Context, name string, step func() error) error {
+	_, span := metrics.StartSpan(ctx, name)
+	err := step()
+	span.SetError(err)
+	span.Finish()
+	return err
+}
+
+// checkBlockSize ensures block serialized is no bigger than maxSize
+func checkBlockSize(block *types.Block, maxSize uint32) error {
+	data, err := block.Marshal()
+	if err != nil {
+		return err
+	}
+	if len(data) > int(maxSize) {
+		logger.Errorf("serialized block is too big - got %d, max %d", len(data), maxSize)
+		return core.ErrBlockTooBig
+	}
+	return nil
+}
+
+// checkGovernedBlockSize ensures block serialized is no bigger than chain
+// parameters in effect at its time allow. The parameters are the ones
+// governance scheduled as of its parent, so blocks of different forks are
+// checked against their own
+func (chain *BlockChain) checkGovernedBlockSize(block *types.Block) error {
+	params, err := chain.consensus.ChainParams(&block.Header.PrevBlockHash, block.Header.TimeStamp)
+	if err != nil {
+		return err
+	}
+	return checkBlockSize(block, params.MaxBlockSize)
+}
+
+// checkVoterRewards ensures the coinbase of block pays voters of its miner
+// their shares of the reward as consensus requires, right after the output
+// paying the miner
+func (chain *BlockChain) checkVoterRewards(block *types.Block) error {
+	expected, err := chain.consensus.VoterRewardOutputs(block)
+	if err != nil {
+		return err
+	}
+	if len(expected) == 0 {
+		return nil
+	}
+	outputs := block.Txs[0].Vout
+	if len(outputs) != len(expected)+1 {
+		return core.ErrBadVoterRewards
+	}
+	for i, txOut := range expected {
+		if outputs[i+1].Value != txOut.Value || !bytes.Equal(outputs[i+1].ScriptPubKey, txOut.ScriptPubKey) {
+			return core.ErrBadVoterRewards
+		}
+	}
+	return nil
+}
+
 // findFork returns final common block between the passed block and the main chain (i.e., fork point)
 // and blocks to be detached and attached
 func (chain *BlockChain) findFork(block *types.Block) (*types.Block, []*types.Block, []*types.Block) {
@@ -522,20 +701,44 @@ func (chain *BlockChain) revertBlock(block *types.Block) error {
 	if err := utxoSet.RevertBlock(block); err != nil {
 		return err
 	}
-	// save utxoset to database
-	if err := utxoSet.WriteUtxoSetToDB(chain.db); err != nil {
+
+	// all writes reverting the block go to one batch, committed atomically
+	batch := chain.storage.NewMultiBatch()
+	defer batch.Close()
+	db, err := batch.Table(BlockTableName)
+	if err != nil {
+		return err
+	}
+	archive, err := batch.Table(BlockArchiveTableName)
+	if err != nil {
 		return err
 	}
 
-	chain.db.Del(BlockKey(block.BlockHash()))
+	// save utxoset to database
+	if err := utxoSet.WriteUtxoSetToBatch(db); err != nil {
+		return err
+	}
 
-	chain.filterHolder.ResetFilters(block.Height)
+	archive.Del(BlockKey(block.BlockHash()))
+	// blocks stored before the archive table
+	db.Del(BlockKey(block.BlockHash()))
 
 	// save tx index
-	if err := chain.DelTxIndex(block); err != nil {
+	if err := delTxIndex(db, block); err != nil {
+		return err
+	}
+
+	// delete token index
+	if err := delTokenIndex(db, block); err != nil {
+		return err
+	}
+
+	if err := batch.Write(); err != nil {
 		return err
 	}
 
+	chain.filterHolder.ResetFilters(block.Height)
+
 	return chain.notifyBlockConnectionUpdate(block, false)
 }
 
@@ -550,28 +753,58 @@ func (chain *BlockChain) applyBlock(block *types.Block, utxoSet *UtxoSet) error
 	if err := utxoSet.ApplyBlock(block); err != nil {
 		return err
 	}
-	// save utxoset to database
-	if err := utxoSet.WriteUtxoSetToDB(chain.db); err != nil {
+	// the filter is built before utxos written are freed
+	filter := GetFilterForTransactionScript(block, utxoSet.utxoMap)
+	filterBytes, err := filter.Marshal()
+	if err != nil {
 		return err
 	}
 
-	if err := chain.StoreBlockToDb(block); err != nil {
+	// all writes applying the block go to one batch, committed atomically
+	batch := chain.storage.NewMultiBatch()
+	defer batch.Close()
+	db, err := batch.Table(BlockTableName)
+	if err != nil {
+		return err
+	}
+	archive, err := batch.Table(BlockArchiveTableName)
+	if err != nil {
 		return err
 	}
 
-	if err := chain.filterHolder.AddFilter(block.Height, *block.BlockHash(), chain.DB(), func() bloom.Filter {
-		return GetFilterForTransactionScript(block, utxoSet.utxoMap)
-	}); err != nil {
+	// save utxoset to database
+	if err := utxoSet.WriteUtxoSetToBatch(db); err != nil {
+		return err
+	}
+
+	if err := storeBlock(db, archive, block); err != nil {
 		return err
 	}
 
+	db.Put(FilterKey(*block.BlockHash()), filterBytes)
+
 	// save candidate context
-	if err := chain.consensus.StoreCandidateContext(block.BlockHash()); err != nil {
+	if err := chain.consensus.StoreCandidateContext(block, db); err != nil {
 		return err
 	}
 
 	// save tx index
-	if err := chain.WriteTxIndex(block); err != nil {
+	if err := writeTxIndex(db, block); err != nil {
+		return err
+	}
+
+	// save token index
+	if err := writeTokenIndex(db, block); err != nil {
+		return err
+	}
+
+	if err := batch.Write(); err != nil {
+		return err
+	}
+
+	if err := chain.filterHolder.AddFilter(block.Height, *block.BlockHash(), chain.DB(), func() bloom.Filter {
+		return filter
+	}); err != nil {
 		return err
 	}
 
@@ -579,10 +812,15 @@ func (chain *BlockChain) applyBlock(block *types.Block, utxoSet *UtxoSet) error
 }
 
 func (chain *BlockChain) notifyBlockConnectionUpdate(block *types.Block, connected bool) error {
-	chain.bus.Publish(eventbus.TopicChainUpdate, &UpdateMsg{
+	PublishChainUpdate(chain.bus, &UpdateMsg{
 		Connected: connected,
 		Block:     block,
 	})
+	if connected {
+		for _, tx := range block.Txs {
+			PublishTxConfirmed(chain.bus, tx, block)
+		}
+	}
 	return nil
 }
 
@@ -603,6 +841,9 @@ func (chain *BlockChain) reorganize(block *types.Block) error {
 	// From fork to tip, not including fork
 	for blockIdx := len(attachBlocks) - 1; blockIdx >= 0; blockIdx-- {
 		attachBlock := attachBlocks[blockIdx]
+		if err := chain.checkGovernedBlockSize(attachBlock); err != nil {
+			return err
+		}
 		if err := chain.applyBlock(attachBlock, nil); err != nil {
 			return err
 		}
@@ -626,14 +867,23 @@ func (chain *BlockChain) TailBlock() *types.Block {
 	return chain.tail
 }
 
-// SetEternal set block eternal status.
-func (chain *BlockChain) SetEternal(block *types.Block) error {
+// SetEternal set block eternal status, storing justification proving it
+// final with the block.
+func (chain *BlockChain) SetEternal(block *types.Block, justification *types.Justification) error {
 	eternal := chain.eternal
 	if eternal.Height < block.Height {
+		data, err := justification.Marshal()
+		if err != nil {
+			return err
+		}
+		if err := chain.db.Put(JustificationKey(block.BlockHash()), data); err != nil {
+			return err
+		}
 		if err := chain.StoreEternalBlock(block); err != nil {
 			return err
 		}
 		chain.eternal = block
+		PublishEternalBlock(chain.bus, block)
 		return nil
 	}
 	return core.ErrFailedToSetEternal
@@ -648,6 +898,22 @@ func (chain *BlockChain) StoreEternalBlock(block *types.Block) error {
 	return chain.db.Put(EternalKey, eternal)
 }
 
+// LoadJustification loads the justification of eternal block of hash from db.
+func (chain *BlockChain) LoadJustification(hash *crypto.HashType) (*types.Justification, error) {
+	data, err := chain.db.Get(JustificationKey(hash))
+	if err != nil {
+		return nil, err
+	}
+	if data == nil {
+		return nil, core.ErrJustificationNotFound
+	}
+	justification := new(types.Justification)
+	if err := justification.Unmarshal(data); err != nil {
+		return nil, err
+	}
+	return justification, nil
+}
+
 // EternalBlock return chain eternal block.
 func (chain *BlockChain) EternalBlock() *types.Block {
 	return chain.eternal
@@ -686,6 +952,14 @@ func (chain *BlockChain) GetBlockHeight() uint32 {
 	return chain.LongestChainHeight
 }
 
+// ReadSnapshot runs fn with the main chain held still, i.e., no block is
+// connected or disconnected until fn returns. fn must not process blocks
+func (chain *BlockChain) ReadSnapshot(fn func() error) error {
+	chain.chainLock.RLock()
+	defer chain.chainLock.RUnlock()
+	return fn()
+}
+
 // GetBlockHash finds the block in target height of main chain and returns it's hash
 func (chain *BlockChain) GetBlockHash(blockHeight uint32) (*crypto.HashType, error) {
 	block, err := chain.LoadBlockByHeight(blockHeight)
@@ -707,6 +981,7 @@ func (chain *BlockChain) SetTailBlock(tail *types.Block) error {
 	chain.heightToBlock.Add(tail.Height, tail)
 	chain.LongestChainHeight = tail.Height
 	chain.tail = tail
+	p2p.UpdateBestHeight(tail.Height)
 	logger.Infof("Change New Tail. Hash: %s Height: %d", tail.BlockHash().String(), tail.Height)
 
 	metrics.MetricsBlockHeightGauge.Update(int64(tail.Height))
@@ -715,7 +990,7 @@ func (chain *BlockChain) SetTailBlock(tail *types.Block) error {
 }
 
 func (chain *BlockChain) loadGenesis() (*types.Block, error) {
-	if ok, _ := chain.db.Has(genesisBlockKey); ok {
+	if data, _ := loadBlockData(chain.db, chain.archive, &GenesisHash); data != nil {
 		genesisBlockFromDb, err := chain.LoadBlockByHash(GenesisHash)
 		if err != nil {
 			return nil, err
@@ -727,7 +1002,7 @@ func (chain *BlockChain) loadGenesis() (*types.Block, error) {
 	if err != nil {
 		return nil, err
 	}
-	chain.db.Put(genesisBlockKey, genesisBin)
+	chain.archive.Put(genesisBlockKey, genesisBin)
 
 	return &GenesisBlock, nil
 
@@ -778,7 +1053,7 @@ func (chain *BlockChain) loadTailBlock() (*types.Block, error) {
 // LoadBlockByHash load block by hash from db.
 func (chain *BlockChain) LoadBlockByHash(hash crypto.HashType) (*types.Block, error) {
 
-	blockBin, err := chain.db.Get(BlockKey(&hash))
+	blockBin, err := loadBlockData(chain.db, chain.archive, &hash)
 	if err != nil {
 		return nil, err
 	}
@@ -819,11 +1094,38 @@ func (chain *BlockChain) LoadBlockByHeight(height uint32) (*types.Block, error)
 	return block, nil
 }
 
+// loadBlockData loads content of block of hash from archive, or from db if
+// it's stored before the archive table
+func loadBlockData(db, archive storage.Table, hash *crypto.HashType) ([]byte, error) {
+	data, err := archive.Get(BlockKey(hash))
+	if err != nil || data != nil {
+		return data, err
+	}
+	return db.Get(BlockKey(hash))
+}
+
 // StoreBlockToDb store block to db.
 func (chain *BlockChain) StoreBlockToDb(block *types.Block) error {
-	batch := chain.db.NewBatch()
+	batch := chain.storage.NewMultiBatch()
 	defer batch.Close()
+	db, err := batch.Table(BlockTableName)
+	if err != nil {
+		return err
+	}
+	archive, err := batch.Table(BlockArchiveTableName)
+	if err != nil {
+		return err
+	}
 
+	if err := storeBlock(db, archive, block); err != nil {
+		return err
+	}
+	return batch.Write()
+}
+
+// storeBlock enqueues writes storing block content into archive and its
+// height to hash key into batch
+func storeBlock(batch, archive storage.BatchWriter, block *types.Block) error {
 	hash := block.BlockHash()
 	batch.Put(BlockHashKey(block.Height), hash[:])
 
@@ -831,37 +1133,42 @@ func (chain *BlockChain) StoreBlockToDb(block *types.Block) error {
 	if err != nil {
 		return err
 	}
-	batch.Put(BlockKey(hash), data)
-
-	return batch.Write()
+	archive.Put(BlockKey(hash), data)
+	return nil
 }
 
 // LoadTxByHash load transaction with hash.
 func (chain *BlockChain) LoadTxByHash(hash crypto.HashType) (*types.Transaction, error) {
+	_, tx, err := chain.LoadBlockInfoByTxHash(hash)
+	return tx, err
+}
+
+// LoadBlockInfoByTxHash returns the transaction with hash and the block containing it.
+func (chain *BlockChain) LoadBlockInfoByTxHash(hash crypto.HashType) (*types.Block, *types.Transaction, error) {
 	txIndex, err := chain.db.Get(TxIndexKey(&hash))
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	height, idx, err := UnmarshalTxIndex(txIndex)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	block, err := chain.LoadBlockByHeight(height)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	tx := block.Txs[idx]
 	target, err := tx.TxHash()
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	if *target == hash {
-		return tx, nil
+		return block, tx, nil
 	}
 	logger.Errorf("Error reading tx hash, expect: %s got: %s", hash.String(), target.String())
-	return nil, errors.New("Failed to load tx with hash")
+	return nil, nil, errors.New("Failed to load tx with hash")
 }
 
 // WriteTxIndex builds tx index in block
@@ -869,6 +1176,14 @@ func (chain *BlockChain) WriteTxIndex(block *types.Block) error {
 	batch := chain.db.NewBatch()
 	defer batch.Close()
 
+	if err := writeTxIndex(batch, block); err != nil {
+		return err
+	}
+	return batch.Write()
+}
+
+// writeTxIndex enqueues writes of tx index in block into batch
+func writeTxIndex(batch storage.BatchWriter, block *types.Block) error {
 	for idx, tx := range block.Txs {
 		tiBuf, err := MarshalTxIndex(block.Height, uint32(idx))
 		if err != nil {
@@ -880,8 +1195,7 @@ func (chain *BlockChain) WriteTxIndex(block *types.Block) error {
 		}
 		batch.Put(TxIndexKey(txHash), tiBuf)
 	}
-
-	return batch.Write()
+	return nil
 }
 
 // DelTxIndex deletes tx index in block
@@ -889,6 +1203,14 @@ func (chain *BlockChain) DelTxIndex(block *types.Block) error {
 	batch := chain.db.NewBatch()
 	defer batch.Close()
 
+	if err := delTxIndex(batch, block); err != nil {
+		return err
+	}
+	return batch.Write()
+}
+
+// delTxIndex enqueues deletes of tx index in block into batch
+func delTxIndex(batch storage.BatchWriter, block *types.Block) error {
 	for _, tx := range block.Txs {
 		txHash, err := tx.TxHash()
 		if err != nil {
@@ -896,8 +1218,7 @@ func (chain *BlockChain) DelTxIndex(block *types.Block) error {
 		}
 		batch.Del(TxIndexKey(txHash))
 	}
-
-	return batch.Write()
+	return nil
 }
 
 // LocateForkPointAndFetchHeaders return block headers when get locate fork point request for sync service.
@@ -993,12 +1314,8 @@ func (chain *BlockChain) FetchNBlockAfterSpecificHash(hash crypto.HashType, num
 // of the transactions in the block, it will use the pre-calculated filter if there
 // is any
 func GetFilterForTransactionScript(block *types.Block, utxoUsed map[types.OutPoint]*types.UtxoWrap) bloom.Filter {
-	var vin, vout [][]byte
-	for _, tx := range block.Txs {
-		for _, out := range tx.Vout {
-			vout = append(vout, out.ScriptPubKey)
-		}
-	}
+	var vin [][]byte
+	vout := filterOutputScripts(block)
 	for _, utxo := range utxoUsed {
 		if utxo != nil && utxo.Output != nil {
 			vin = append(vin, utxo.Output.ScriptPubKey)
@@ -1009,17 +1326,29 @@ func GetFilterForTransactionScript(block *types.Block, utxoUsed map[types.OutPoi
 		filter.Add(scriptBytes)
 	}
 	for _, scriptBytes := range vout {
-		scriptPubKey := script.NewScriptFromBytes(scriptBytes)
-		if scriptPubKey.IsTokenIssue() || scriptPubKey.IsTokenTransfer() {
-			// token output: only store the p2pkh prefix part so we can retrieve it later
-			scriptBytes = *scriptPubKey.P2PKHScriptPrefix()
-		}
 		filter.Add(scriptBytes)
 	}
 	logger.Debugf("Create Block filter with %d inputs and %d outputs", len(vin), len(vout))
 	return filter
 }
 
+// filterOutputScripts returns scripts of outputs in block added to its filter
+func filterOutputScripts(block *types.Block) [][]byte {
+	var vout [][]byte
+	for _, tx := range block.Txs {
+		for _, out := range tx.Vout {
+			scriptBytes := out.ScriptPubKey
+			scriptPubKey := script.NewScriptFromBytes(scriptBytes)
+			if scriptPubKey.IsTokenIssue() || scriptPubKey.IsTokenTransfer() {
+				// token output: only store the p2pkh prefix part so we can retrieve it later
+				scriptBytes = *scriptPubKey.P2PKHScriptPrefix()
+			}
+			vout = append(vout, scriptBytes)
+		}
+	}
+	return vout
+}
+
 func (chain *BlockChain) loadFilters() error {
 	var i uint32 = 1
 	var utxoSet *UtxoSet
@@ -1046,35 +1375,57 @@ func (chain *BlockChain) loadFilters() error {
 }
 
 // GetTransactionsByAddr search the main chain about transaction relate to give address
-func (chain *BlockChain) GetTransactionsByAddr(addr types.Address) ([]*types.Transaction, error) {
+// The records are returned in chain order, along with their position in the chain
+func (chain *BlockChain) GetTransactionsByAddr(addr types.Address) ([]*types.TxRecord, error) {
 	payToPubKeyHashScript := *script.PayToPubKeyHashScript(addr.Hash())
 	hashes := chain.filterHolder.ListMatchedBlockHashes(payToPubKeyHashScript)
 	utxoSet := NewUtxoSet()
-	var txs []*types.Transaction
+	var records []*types.TxRecord
 	for _, hash := range hashes {
 		block, err := chain.LoadBlockByHash(hash)
 		if err != nil {
 			return nil, err
 		}
-		for _, tx := range block.Txs {
+		for txIdx, tx := range block.Txs {
+			record := &types.TxRecord{
+				Tx:        tx,
+				BlockHash: hash,
+				Height:    block.Height,
+				Index:     uint32(txIdx),
+				TimeStamp: block.Header.TimeStamp,
+			}
 			isRelated := false
-			for index, vout := range tx.Vout {
-				if bytes.Equal(vout.ScriptPubKey, payToPubKeyHashScript) {
-					utxoSet.AddUtxo(tx, uint32(index), block.Height)
+			for _, vin := range tx.Vin {
+				if utxo := utxoSet.FindUtxo(vin.PrevOutPoint); utxo != nil {
+					record.IsSent = true
+					record.Spent += utxo.Value()
+					if isTokenScript(utxo.Output.ScriptPubKey) {
+						record.HasToken = true
+					}
+					delete(utxoSet.utxoMap, vin.PrevOutPoint)
 					isRelated = true
 				}
 			}
-			for _, vin := range tx.Vin {
-				if utxoSet.FindUtxo(vin.PrevOutPoint) != nil {
-					delete(utxoSet.utxoMap, vin.PrevOutPoint)
+			for index, vout := range tx.Vout {
+				if util.IsPrefixed(vout.ScriptPubKey, payToPubKeyHashScript) {
+					utxoSet.AddUtxo(tx, uint32(index), block.Height)
+					record.Received += vout.Value
+					if isTokenScript(vout.ScriptPubKey) {
+						record.HasToken = true
+					}
 					isRelated = true
 				}
 			}
 			if isRelated {
-				txs = append(txs, tx)
+				records = append(records, record)
 			}
 		}
 	}
 	utxoSet = nil
-	return txs, nil
+	return records, nil
+}
+
+func isTokenScript(scriptBytes []byte) bool {
+	s := script.NewScriptFromBytes(scriptBytes)
+	return s.IsTokenIssue() || s.IsTokenTransfer()
 }
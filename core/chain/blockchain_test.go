@@ -7,9 +7,12 @@ package chain
 import (
 	"testing"
 
+	"github.com/BOXFoundation/boxd/boxd/eventbus"
 	"github.com/BOXFoundation/boxd/core"
+	"github.com/BOXFoundation/boxd/core/pb"
 	"github.com/BOXFoundation/boxd/core/types"
 	"github.com/BOXFoundation/boxd/crypto"
+	"github.com/BOXFoundation/boxd/script"
 	_ "github.com/BOXFoundation/boxd/storage/memdb"
 	"github.com/facebookgo/ensure"
 )
@@ -166,6 +169,63 @@ func TestBlockProcessing(t *testing.T) {
 
 }
 
+// forkParamsDpos limits the size of blocks by their parents
+type forkParamsDpos struct {
+	DummyDpos
+	maxSizes map[crypto.HashType]uint32
+}
+
+func (dpos *forkParamsDpos) ChainParams(parent *crypto.HashType, timestamp int64) (*types.ChainParams, error) {
+	params, _ := dpos.DummyDpos.ChainParams(parent, timestamp)
+	if maxSize, ok := dpos.maxSizes[*parent]; ok {
+		params.MaxBlockSize = maxSize
+	}
+	return params, nil
+}
+
+func TestBlockSizeOfForks(t *testing.T) {
+	chain := NewTestBlockChain()
+	consensus := &forkParamsDpos{maxSizes: make(map[crypto.HashType]uint32)}
+	chain.Setup(consensus, NewDummySyncManager())
+
+	// b0 -> b1 -> b2
+	//		   \-> b2A -> b3A
+	b1 := nextBlock(chain.TailBlock())
+	ensure.Nil(t, chain.ProcessBlock(b1, false, false, ""))
+	b2 := nextBlock(b1)
+	ensure.Nil(t, chain.ProcessBlock(b2, false, false, ""))
+	b2A := nextBlock(b1)
+	b2A.Header.TimeStamp = 1
+	ensure.Nil(t, chain.ProcessBlock(b2A, false, false, ""))
+
+	// governance as of b2 limits blocks to less than b3, as of b2A not
+	b3 := nextBlock(b2)
+	data, err := b3.Marshal()
+	ensure.Nil(t, err)
+	consensus.maxSizes[*b2.BlockHash()] = uint32(len(data) - 1)
+	ensure.DeepEqual(t, chain.ProcessBlock(b3, false, false, ""), core.ErrBlockTooBig)
+	ensure.DeepEqual(t, chain.TailBlock(), b2)
+
+	// b3A of the same size is attached by the reorg to the b2A fork
+	b3A := nextBlock(b2A)
+	ensure.Nil(t, chain.ProcessBlock(b3A, false, false, ""))
+	ensure.DeepEqual(t, chain.TailBlock(), b3A)
+
+	// b0 -> b1 -> b2A -> b3A -> b4A
+	//				   \-> b4B -> b5B
+	// the limit as of b4B is checked when the reorg attaches b5B
+	b4A := nextBlock(b3A)
+	b4B := nextBlock(b3A)
+	b4B.Header.TimeStamp = 1
+	b5B := nextBlock(b4B)
+	data, err = b5B.Marshal()
+	ensure.Nil(t, err)
+	consensus.maxSizes[*b4B.BlockHash()] = uint32(len(data) - 1)
+	ensure.Nil(t, chain.ProcessBlock(b4A, false, false, ""))
+	ensure.Nil(t, chain.ProcessBlock(b4B, false, false, ""))
+	ensure.DeepEqual(t, chain.ProcessBlock(b5B, false, false, ""), core.ErrBlockTooBig)
+}
+
 func TestBlockChain_WriteDelTxIndex(t *testing.T) {
 	ensure.NotNil(t, blockChain)
 
@@ -185,3 +245,152 @@ func TestBlockChain_WriteDelTxIndex(t *testing.T) {
 	_, err = blockChain.LoadTxByHash(*txhash)
 	ensure.NotNil(t, err)
 }
+
+func TestBlockChain_WriteDelTokenIndex(t *testing.T) {
+	ensure.NotNil(t, blockChain)
+
+	b0 := getTailBlock()
+	b1 := nextBlock(b0)
+	issueScript := script.IssueTokenScript(minerAddr.Hash(), &script.IssueParams{Name: "box token", TotalSupply: 100})
+	b1.Txs = append(b1.Txs, &types.Transaction{
+		Vin:  []*types.TxIn{{PrevOutPoint: types.OutPoint{Hash: crypto.DoubleHashH([]byte("in"))}}},
+		Vout: []*corepb.TxOut{{Value: 1, ScriptPubKey: *script.PayToPubKeyHashScript(minerAddr.Hash())}, {Value: 1, ScriptPubKey: *issueScript}},
+	})
+	txhash, _ := b1.Txs[1].TxHash()
+	token := types.OutPoint{Hash: *txhash, Index: 1}
+	transferScript := script.TransferTokenScript(minerAddr.Hash(), &script.TransferParams{TokenID: script.NewTokenID(token.Hash, token.Index), Amount: 40})
+	b1.Txs = append(b1.Txs, &types.Transaction{
+		Vin:  []*types.TxIn{{PrevOutPoint: token}},
+		Vout: []*corepb.TxOut{{Value: 1, ScriptPubKey: *transferScript}},
+	})
+	ensure.Nil(t, blockChain.StoreBlockToDb(b1))
+
+	_, total, err := blockChain.ListTokens(0, 0)
+	ensure.Nil(t, err)
+	ensure.Nil(t, blockChain.WriteTokenIndex(b1))
+	tokens, newTotal, err := blockChain.ListTokens(total, 10)
+	ensure.Nil(t, err)
+	ensure.DeepEqual(t, newTotal, total+1)
+	ensure.DeepEqual(t, len(tokens), 1)
+	ensure.DeepEqual(t, tokens[0], &types.TokenInfo{
+		Token:       token,
+		Name:        "box token",
+		TotalSupply: 100,
+		Issuer:      minerAddr.String(),
+		Height:      b1.Height,
+	})
+
+	// the issuance and the transfer are listed in chain order
+	records, txsTotal, err := blockChain.GetTokenTransactions(token, 0, 0)
+	ensure.Nil(t, err)
+	ensure.DeepEqual(t, txsTotal, uint32(2))
+	ensure.DeepEqual(t, len(records), 2)
+	ensure.DeepEqual(t, records[0].Tx, b1.Txs[1])
+	ensure.DeepEqual(t, records[1].Tx, b1.Txs[2])
+	ensure.DeepEqual(t, records[1].Index, uint32(2))
+	records, _, err = blockChain.GetTokenTransactions(token, 1, 1)
+	ensure.Nil(t, err)
+	ensure.DeepEqual(t, len(records), 1)
+	ensure.DeepEqual(t, records[0].Tx, b1.Txs[2])
+
+	ensure.Nil(t, blockChain.DelTokenIndex(b1))
+	_, newTotal, err = blockChain.ListTokens(0, 0)
+	ensure.Nil(t, err)
+	ensure.DeepEqual(t, newTotal, total)
+	_, txsTotal, err = blockChain.GetTokenTransactions(token, 0, 0)
+	ensure.Nil(t, err)
+	ensure.DeepEqual(t, txsTotal, uint32(0))
+}
+
+func TestSetEternalNotifies(t *testing.T) {
+	chain := NewTestBlockChain()
+	var notified []*types.Block
+	onEternalBlock := func(block *types.Block) { notified = append(notified, block) }
+	chain.Bus().Subscribe(eventbus.TopicEternalBlock, onEternalBlock)
+	defer chain.Bus().Unsubscribe(eventbus.TopicEternalBlock, onEternalBlock)
+
+	b1 := nextBlock(chain.EternalBlock())
+	justification := &types.Justification{Hash: *b1.BlockHash(),
+		Voters: []types.AddressHash{{0x01}, {0x02}}, Signature: []byte{0x03}}
+	ensure.Nil(t, chain.SetEternal(b1, justification))
+	ensure.DeepEqual(t, chain.EternalBlock(), b1)
+	ensure.DeepEqual(t, notified, []*types.Block{b1})
+	// the justification is stored with the block
+	stored, err := chain.LoadJustification(b1.BlockHash())
+	ensure.Nil(t, err)
+	ensure.DeepEqual(t, stored, justification)
+
+	// the eternal block never goes back
+	ensure.DeepEqual(t, chain.SetEternal(b1, justification), core.ErrFailedToSetEternal)
+	ensure.DeepEqual(t, len(notified), 1)
+}
+
+// txsFilterHolder matches the blocks of hashes for any script
+type txsFilterHolder struct {
+	BloomFilterHolder
+	hashes []crypto.HashType
+}
+
+func (h *txsFilterHolder) ListMatchedBlockHashes([]byte) []crypto.HashType {
+	return h.hashes
+}
+
+func TestGetTransactionsByAddr(t *testing.T) {
+	chain := NewTestBlockChain()
+	_, pubKey, _ := crypto.NewKeyPair()
+	addr, _ := types.NewAddressFromPubKey(pubKey)
+	addrScript := *script.PayToPubKeyHashScript(addr.Hash())
+	otherScript := *script.PayToPubKeyHashScript(minerAddr.Hash())
+
+	// b1 pays the address, b2 spends it with change back and issues a token
+	// to it
+	b1 := nextBlock(chain.TailBlock())
+	received := &types.Transaction{
+		Vin:  []*types.TxIn{{PrevOutPoint: types.OutPoint{Hash: crypto.DoubleHashH([]byte("in"))}}},
+		Vout: []*corepb.TxOut{{Value: 100, ScriptPubKey: addrScript}},
+	}
+	b1.Txs = append(b1.Txs, received)
+	receivedHash, _ := received.TxHash()
+	b2 := nextBlock(b1)
+	sent := &types.Transaction{
+		Vin:  []*types.TxIn{{PrevOutPoint: types.OutPoint{Hash: *receivedHash}}},
+		Vout: []*corepb.TxOut{{Value: 30, ScriptPubKey: otherScript}, {Value: 60, ScriptPubKey: addrScript}},
+	}
+	issueScript := script.IssueTokenScript(addr.Hash(), &script.IssueParams{Name: "box token", TotalSupply: 100})
+	issued := &types.Transaction{
+		Vin:  []*types.TxIn{{PrevOutPoint: types.OutPoint{Hash: crypto.DoubleHashH([]byte("other in"))}}},
+		Vout: []*corepb.TxOut{{Value: 1, ScriptPubKey: *issueScript}},
+	}
+	b2.Txs = append(b2.Txs, sent, issued)
+	ensure.Nil(t, chain.StoreBlockToDb(b1))
+	ensure.Nil(t, chain.StoreBlockToDb(b2))
+	chain.filterHolder = &txsFilterHolder{hashes: []crypto.HashType{*b1.BlockHash(), *b2.BlockHash()}}
+
+	records, err := chain.GetTransactionsByAddr(addr)
+	ensure.Nil(t, err)
+	ensure.DeepEqual(t, len(records), 3)
+	for i, expected := range []*types.Transaction{received, sent, issued} {
+		hash, _ := expected.TxHash()
+		recordHash, _ := records[i].Tx.TxHash()
+		ensure.DeepEqual(t, recordHash, hash)
+	}
+	ensure.DeepEqual(t, records[0].BlockHash, *b1.BlockHash())
+	ensure.DeepEqual(t, records[0].Height, b1.Height)
+	ensure.DeepEqual(t, records[0].Index, uint32(1))
+	ensure.DeepEqual(t, records[0].TimeStamp, b1.Header.TimeStamp)
+	ensure.False(t, records[0].IsSent)
+	ensure.DeepEqual(t, records[0].Amount(), uint64(100))
+
+	// the spend is sent, for the value paid out to others
+	ensure.DeepEqual(t, records[1].Height, b2.Height)
+	ensure.DeepEqual(t, records[1].Index, uint32(1))
+	ensure.True(t, records[1].IsSent)
+	ensure.DeepEqual(t, records[1].Spent, uint64(100))
+	ensure.DeepEqual(t, records[1].Received, uint64(60))
+	ensure.DeepEqual(t, records[1].Amount(), uint64(40))
+	ensure.False(t, records[1].HasToken)
+
+	ensure.DeepEqual(t, records[2].Index, uint32(2))
+	ensure.True(t, records[2].HasToken)
+	ensure.False(t, records[2].IsSent)
+}
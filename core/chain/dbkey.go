@@ -20,6 +20,10 @@ const (
 	// BlockTableName is the table name of db to store block chain data
 	BlockTableName = "core"
 
+	// BlockArchiveTableName is the table name of db to store block contents,
+	// which are append-only and can be stored apart from the other chain data
+	BlockArchiveTableName = "block"
+
 	// Tail is the db key name of tail block
 	Tail = "/tail"
 
@@ -65,6 +69,37 @@ const (
 	// key: /bf/1113b8bdad74cdc045e64e09b3e2f0502d1b7f9bd8123b28239a3360bd3a8757
 	// value: crypto hash
 	FilterPrefix = "/bf"
+
+	// TokenPrefix is the key prefix of database key to store token issuance index
+	// /tk/{zero padded hex encoded height}/{hex encoded tx hash}/{vout index}
+	// e.g.
+	// key: /tk/00003e2d/1113b8bdad74cdc045e64e09b3e2f0502d1b7f9bd8123b28239a3360bd3a8757/0
+	// value: token info
+	TokenPrefix = "/tk"
+
+	// TokenTxPrefix is the key prefix of database key to store txs moving a token
+	// /tt/{hex encoded token tx hash}/{zero padded hex encoded token vout index}/
+	//     {zero padded hex encoded height}/{zero padded hex encoded index in txs}
+	// e.g.
+	// key: /tt/1113b8bdad74cdc045e64e09b3e2f0502d1b7f9bd8123b28239a3360bd3a8757/00000000/00003e2d/00000001
+	// value: 4 bytes height + 4 bytes index in txs
+	TokenTxPrefix = "/tt"
+
+	// EvidencePrefix is the key prefix of database key to store evidences of
+	// miners minting different blocks at the same time
+	// /ev/{hex encoded miner address hash}/{zero padded hex encoded timestamp}
+	// e.g.
+	// key: /ev/816666b318349468f8146e76e4e3751d937c14cb/000000005c3b2e1a
+	// value: double mint evidence
+	EvidencePrefix = "/ev"
+
+	// JustificationPrefix is the key prefix of database key to store
+	// justifications of eternal blocks
+	// /jf/{hex encoded block hash}
+	// e.g.
+	// key: /jf/1113b8bdad74cdc045e64e09b3e2f0502d1b7f9bd8123b28239a3360bd3a8757
+	// value: justification
+	JustificationPrefix = "/jf"
 )
 
 var blkBase = key.NewKey(BlockPrefix)
@@ -73,6 +108,10 @@ var txixBase = key.NewKey(TxIndexPrefix)
 var utxoBase = key.NewKey(UtxoPrefix)
 var candidatesBase = key.NewKey(CandidatesPrefix)
 var filterBase = key.NewKey(FilterPrefix)
+var tokenBase = key.NewKey(TokenPrefix)
+var tokenTxBase = key.NewKey(TokenTxPrefix)
+var evidenceBase = key.NewKey(EvidencePrefix)
+var justificationBase = key.NewKey(JustificationPrefix)
 var genesisBlockKey = BlockKey(GenesisBlock.BlockHash())
 
 // TailKey is the db key to stoare tail block content
@@ -118,3 +157,34 @@ func FilterKey(hash crypto.HashType) []byte {
 	buf = append(buf[:], hash.GetBytes()...)
 	return buf
 }
+
+// TokenKey returns the db key to store info of the token issued at op in
+// block of height
+func TokenKey(height uint32, op *types.OutPoint) []byte {
+	return tokenBase.ChildString(fmt.Sprintf("%08x", height)).ChildString(op.Hash.String()).
+		ChildString(fmt.Sprintf("%x", op.Index)).Bytes()
+}
+
+// TokenTxPrefixKey returns the db key prefix of txs moving token
+func TokenTxPrefixKey(token *types.OutPoint) []byte {
+	return tokenTxBase.ChildString(token.Hash.String()).ChildString(fmt.Sprintf("%08x", token.Index)).Bytes()
+}
+
+// TokenTxKey returns the db key to store the tx at index in block of height
+// moving token
+func TokenTxKey(token *types.OutPoint, height, index uint32) []byte {
+	return tokenTxBase.ChildString(token.Hash.String()).ChildString(fmt.Sprintf("%08x", token.Index)).
+		ChildString(fmt.Sprintf("%08x", height)).ChildString(fmt.Sprintf("%08x", index)).Bytes()
+}
+
+// EvidenceKey returns the db key to store the evidence of miner minting
+// different blocks at timestamp
+func EvidenceKey(miner types.AddressHash, timestamp int64) []byte {
+	return evidenceBase.ChildString(fmt.Sprintf("%x", miner[:])).ChildString(fmt.Sprintf("%016x", timestamp)).Bytes()
+}
+
+// JustificationKey returns the db key to store the justification of eternal
+// block of hash
+func JustificationKey(hash *crypto.HashType) []byte {
+	return justificationBase.ChildString(hash.String()).Bytes()
+}
@@ -8,6 +8,7 @@ import (
 	"os"
 
 	"github.com/BOXFoundation/boxd/boxd/eventbus"
+	corepb "github.com/BOXFoundation/boxd/core/pb"
 	"github.com/BOXFoundation/boxd/core/types"
 	"github.com/BOXFoundation/boxd/crypto"
 	"github.com/BOXFoundation/boxd/p2p"
@@ -58,7 +59,7 @@ func (dpos *DummyDpos) Run() error { return nil }
 func (dpos *DummyDpos) Stop() {}
 
 // StoreCandidateContext store candidate context
-func (dpos *DummyDpos) StoreCandidateContext(*crypto.HashType) error { return nil }
+func (dpos *DummyDpos) StoreCandidateContext(*types.Block, storage.BatchWriter) error { return nil }
 
 // VerifySign verify sign
 func (dpos *DummyDpos) VerifySign(*types.Block) (bool, error) { return true, nil }
@@ -75,5 +76,15 @@ func (dpos *DummyDpos) StopMint() {}
 // BroadcastEternalMsgToMiners broadcast etrnalmsg to miners
 func (dpos *DummyDpos) BroadcastEternalMsgToMiners(block *types.Block) error { return nil }
 
+// VoterRewardOutputs returns no rewards
+func (dpos *DummyDpos) VoterRewardOutputs(block *types.Block) ([]*corepb.TxOut, error) {
+	return nil, nil
+}
+
+// ChainParams returns default chain parameters
+func (dpos *DummyDpos) ChainParams(*crypto.HashType, int64) (*types.ChainParams, error) {
+	return &types.ChainParams{PeriodDuration: PeriodDuration, MaxBlockSize: MaxBlockSize}, nil
+}
+
 // ValidateMiner validate miner
 func (dpos *DummyDpos) ValidateMiner() bool { return true }
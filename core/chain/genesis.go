@@ -50,30 +50,37 @@ var GenesisBlock = types.Block{
 // GenesisHash is the hash of genesis block
 var GenesisHash = *(GenesisBlock.BlockHash())
 
-// GenesisPeriod genesis period
+// GenesisPeriod genesis period, with hex encoded BLS public keys miners sign
+// eternal votes with
 var GenesisPeriod = []map[string]string{
 	{
-		"addr":   "b1ndoQmEd83y4Fza5PzbUQDYpT3mV772J5o",
-		"peerID": "12D3KooWFQ2naj8XZUVyGhFzBTEMrMc6emiCEDKLjaJMsK7p8Cza",
+		"addr":      "b1ndoQmEd83y4Fza5PzbUQDYpT3mV772J5o",
+		"peerID":    "12D3KooWFQ2naj8XZUVyGhFzBTEMrMc6emiCEDKLjaJMsK7p8Cza",
+		"blsPubKey": "b45b3bc5cf9712ba54eab6e84a2ede67dbf59ee72cf3d0518a9103bf6191a521a2cf0a768c75ed5020146d42c027c1e30186a79ce0ab775b2516bc9149183c28ed6af299e1b5b4336dc003dba8e03a3468b4b16e5ad9494f5a341e8da6e27cea",
 	},
 	{
-		"addr":   "b1b8bzyci5VYUJVKRU2HRMMQiUXnoULkKAJ",
-		"peerID": "12D3KooWKPRAK7vBBrVv9szEin55kBnJEEuHG4gDTQEM72ByZDpA",
+		"addr":      "b1b8bzyci5VYUJVKRU2HRMMQiUXnoULkKAJ",
+		"peerID":    "12D3KooWKPRAK7vBBrVv9szEin55kBnJEEuHG4gDTQEM72ByZDpA",
+		"blsPubKey": "92bb0fa34a5679fb9dc3f9594ef3f60eacc1a6cae6f8ad128fd51a7d9eab62abf637ad1c96b1683a77eebb37f747f4060dce47294c7c1cc9b2514550ceca87ef8df5a10f5934ce921d4d63f64c6cdbe4cad3d11b31a64feb57c4344f30f875fa",
 	},
 	{
-		"addr":   "b1jh8DSdB6kB7N7RanrudV1hzzMCCcoX6L7",
-		"peerID": "12D3KooWSdXLNeoRQQ2a7yiS6xLpTn3LdCr8B8fqPz94Bbi7itsi",
+		"addr":      "b1jh8DSdB6kB7N7RanrudV1hzzMCCcoX6L7",
+		"peerID":    "12D3KooWSdXLNeoRQQ2a7yiS6xLpTn3LdCr8B8fqPz94Bbi7itsi",
+		"blsPubKey": "8654d5897101c6d3f020eee06a8d1926cc97b4391b2f87a3935df66fd110a34cbfeda0c77175b9543f037c4c34ac7543158b029cf5603b72237ad0b04e8d974ffccf1ba316ab056a1607c48a7f7991dd0d6f6686c4795a783ee7af14fbc46d18",
 	},
 	{
-		"addr":   "b1UP5pbfJgZrF1ezoSHLdvkxvgF2BYLtGva",
-		"peerID": "12D3KooWRHVAwymCVcA8jqyjpP3r3HBkCW2q5AZRTBvtaungzFSJ",
+		"addr":      "b1UP5pbfJgZrF1ezoSHLdvkxvgF2BYLtGva",
+		"peerID":    "12D3KooWRHVAwymCVcA8jqyjpP3r3HBkCW2q5AZRTBvtaungzFSJ",
+		"blsPubKey": "863281dc9ef7f9aa68a1a64cca79a8a1fbe09717413865ae246bbdd6b938cc0e5426cac4e9de75dd10898e11633e125c0d043e23ebe0ec744596232b42d2e78339002caffc8e92668098e47ccdbc6d4855239d126e82db1e8b41044bad8c12e0",
 	},
 	{
-		"addr":   "b1ZWSdrg48g145VdcmBwMPVuDFdaxDLoktk",
-		"peerID": "12D3KooWQSaxCgbWakLcU69f4gmNFMszwhyHbwx4xPAhV7erDC2P",
+		"addr":      "b1ZWSdrg48g145VdcmBwMPVuDFdaxDLoktk",
+		"peerID":    "12D3KooWQSaxCgbWakLcU69f4gmNFMszwhyHbwx4xPAhV7erDC2P",
+		"blsPubKey": "a994cd0657718766ae2e2405ee508502390cf68ab38870c26d392a1f0b01a395835012e8444ddf97bf92746da3eb98ac11dc94e1531ee02e398e4db46aa7be3972abdfb137d83838bf569ae544a6e4b449018f369535a8445b637aea6cabd2c1",
 	},
 	{
-		"addr":   "b1fRtRnKF4qhQG7bSwqbgR2BMw9VfM2XpT4",
-		"peerID": "12D3KooWNcJQzHaNpW5vZDQbTcoLXVCyGS755hTpendGzb5Hqtcu",
+		"addr":      "b1fRtRnKF4qhQG7bSwqbgR2BMw9VfM2XpT4",
+		"peerID":    "12D3KooWNcJQzHaNpW5vZDQbTcoLXVCyGS755hTpendGzb5Hqtcu",
+		"blsPubKey": "afaa1944a261767fb0500d07cbe26e7b8f0fefbdd9d9dd0b0b51efafd31e78a96631ca2d2a72da23d1c78b2061d15ae61585a19851d14f37c746b5278155173f6a5c790e3468b32925fd146101df06f771033dbf8e4abfc3d3378e9173f3deaa",
 	},
 }
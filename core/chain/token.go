@@ -0,0 +1,286 @@
+// Copyright (c) 2018 ContentBox Authors.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package chain
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+
+	"github.com/BOXFoundation/boxd/core/types"
+	"github.com/BOXFoundation/boxd/script"
+	"github.com/BOXFoundation/boxd/storage"
+	"github.com/BOXFoundation/boxd/util"
+)
+
+// MarshalTokenInfo returns the bytes of token info stored in db
+func MarshalTokenInfo(info *types.TokenInfo) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := util.WriteBytes(&buf, info.Token.Hash[:]); err != nil {
+		return nil, err
+	}
+	if err := util.WriteUint32(&buf, info.Token.Index); err != nil {
+		return nil, err
+	}
+	if err := util.WriteVarBytes(&buf, []byte(info.Name)); err != nil {
+		return nil, err
+	}
+	if err := util.WriteUint64(&buf, info.TotalSupply); err != nil {
+		return nil, err
+	}
+	if err := util.WriteVarBytes(&buf, []byte(info.Issuer)); err != nil {
+		return nil, err
+	}
+	if err := util.WriteUint32(&buf, info.Height); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// UnmarshalTokenInfo returns token info from bytes stored in db
+func UnmarshalTokenInfo(data []byte) (*types.TokenInfo, error) {
+	buf := bytes.NewBuffer(data)
+	info := &types.TokenInfo{}
+	var err error
+	if err = util.ReadBytes(buf, info.Token.Hash[:]); err != nil {
+		return nil, err
+	}
+	if info.Token.Index, err = util.ReadUint32(buf); err != nil {
+		return nil, err
+	}
+	name, err := util.ReadVarBytes(buf)
+	if err != nil {
+		return nil, err
+	}
+	info.Name = string(name)
+	if info.TotalSupply, err = util.ReadUint64(buf); err != nil {
+		return nil, err
+	}
+	issuer, err := util.ReadVarBytes(buf)
+	if err != nil {
+		return nil, err
+	}
+	info.Issuer = string(issuer)
+	if info.Height, err = util.ReadUint32(buf); err != nil {
+		return nil, err
+	}
+	return info, nil
+}
+
+// blockTokens returns tokens issued in block
+func blockTokens(block *types.Block) ([]*types.TokenInfo, error) {
+	var tokens []*types.TokenInfo
+	for _, tx := range block.Txs {
+		txHash, err := tx.TxHash()
+		if err != nil {
+			return nil, err
+		}
+		for idx, txOut := range tx.Vout {
+			s := script.NewScriptFromBytes(txOut.ScriptPubKey)
+			if !s.IsTokenIssue() {
+				continue
+			}
+			// malformed scripts are left out rather than failing the block
+			params, err := s.GetIssueParams()
+			if err != nil {
+				continue
+			}
+			issuer, err := s.ExtractAddress()
+			if err != nil {
+				continue
+			}
+			tokens = append(tokens, &types.TokenInfo{
+				Token:       types.OutPoint{Hash: *txHash, Index: uint32(idx)},
+				Name:        params.Name,
+				TotalSupply: params.TotalSupply,
+				Issuer:      issuer.String(),
+				Height:      block.Height,
+			})
+		}
+	}
+	return tokens, nil
+}
+
+// tokenTx is the position of a tx moving a token, i.e., issuing or
+// transferring it
+type tokenTx struct {
+	token  types.OutPoint
+	height uint32
+	index  uint32
+}
+
+// blockTokenTxs returns txs in block moving each token, a tx moving several
+// tokens is returned once per token
+func blockTokenTxs(block *types.Block) ([]*tokenTx, error) {
+	var txs []*tokenTx
+	for txIdx, tx := range block.Txs {
+		txHash, err := tx.TxHash()
+		if err != nil {
+			return nil, err
+		}
+		tokens := make(map[types.OutPoint]bool)
+		for idx, txOut := range tx.Vout {
+			s := script.NewScriptFromBytes(txOut.ScriptPubKey)
+			if s.IsTokenIssue() {
+				tokens[types.OutPoint{Hash: *txHash, Index: uint32(idx)}] = true
+			} else if s.IsTokenTransfer() {
+				if params, err := s.GetTransferParams(); err == nil {
+					tokens[params.OutPoint] = true
+				}
+			}
+		}
+		for token := range tokens {
+			txs = append(txs, &tokenTx{token: token, height: block.Height, index: uint32(txIdx)})
+		}
+	}
+	return txs, nil
+}
+
+// WriteTokenIndex indexes tokens issued in block, and txs moving tokens
+func (chain *BlockChain) WriteTokenIndex(block *types.Block) error {
+	batch := chain.db.NewBatch()
+	defer batch.Close()
+
+	if err := writeTokenIndex(batch, block); err != nil {
+		return err
+	}
+	return batch.Write()
+}
+
+// writeTokenIndex enqueues writes of token index in block into batch
+func writeTokenIndex(batch storage.BatchWriter, block *types.Block) error {
+	tokens, err := blockTokens(block)
+	if err != nil {
+		return err
+	}
+	txs, err := blockTokenTxs(block)
+	if err != nil {
+		return err
+	}
+
+	for _, info := range tokens {
+		data, err := MarshalTokenInfo(info)
+		if err != nil {
+			return err
+		}
+		batch.Put(TokenKey(info.Height, &info.Token), data)
+	}
+	for _, tx := range txs {
+		tiBuf, err := MarshalTxIndex(tx.height, tx.index)
+		if err != nil {
+			return err
+		}
+		batch.Put(TokenTxKey(&tx.token, tx.height, tx.index), tiBuf)
+	}
+	return nil
+}
+
+// DelTokenIndex deletes index of tokens issued in block, and txs moving tokens
+func (chain *BlockChain) DelTokenIndex(block *types.Block) error {
+	batch := chain.db.NewBatch()
+	defer batch.Close()
+
+	if err := delTokenIndex(batch, block); err != nil {
+		return err
+	}
+	return batch.Write()
+}
+
+// delTokenIndex enqueues deletes of token index in block into batch
+func delTokenIndex(batch storage.BatchWriter, block *types.Block) error {
+	tokens, err := blockTokens(block)
+	if err != nil {
+		return err
+	}
+	txs, err := blockTokenTxs(block)
+	if err != nil {
+		return err
+	}
+
+	for _, info := range tokens {
+		batch.Del(TokenKey(info.Height, &info.Token))
+	}
+	for _, tx := range txs {
+		batch.Del(TokenTxKey(&tx.token, tx.height, tx.index))
+	}
+	return nil
+}
+
+// ListTokens returns at most limit tokens issued on the main chain ordered by
+// issuance height, starting from offset, and the number of all tokens
+func (chain *BlockChain) ListTokens(offset, limit uint32) ([]*types.TokenInfo, uint32, error) {
+	keys := chain.db.KeysWithPrefix(tokenBase.Bytes())
+	// keys are ordered by height as heights are zero padded
+	sort.Slice(keys, func(i, j int) bool {
+		return bytes.Compare(keys[i], keys[j]) < 0
+	})
+	total := uint32(len(keys))
+	if offset >= total {
+		return []*types.TokenInfo{}, total, nil
+	}
+	end := total
+	if limit > 0 && offset+limit < total {
+		end = offset + limit
+	}
+	tokens := make([]*types.TokenInfo, 0, end-offset)
+	for _, k := range keys[offset:end] {
+		data, err := chain.db.Get(k)
+		if err != nil {
+			return nil, 0, err
+		}
+		info, err := UnmarshalTokenInfo(data)
+		if err != nil {
+			return nil, 0, err
+		}
+		tokens = append(tokens, info)
+	}
+	return tokens, total, nil
+}
+
+// GetTokenTransactions returns at most limit txs issuing or transferring
+// token in chain order, starting from offset, and the number of all such txs.
+// Fields of records about a specific address are left zero
+func (chain *BlockChain) GetTokenTransactions(token types.OutPoint, offset, limit uint32) ([]*types.TxRecord, uint32, error) {
+	keys := chain.db.KeysWithPrefix(TokenTxPrefixKey(&token))
+	// keys are in chain order as heights and indexes are zero padded
+	sort.Slice(keys, func(i, j int) bool {
+		return bytes.Compare(keys[i], keys[j]) < 0
+	})
+	total := uint32(len(keys))
+	if offset >= total {
+		return []*types.TxRecord{}, total, nil
+	}
+	end := total
+	if limit > 0 && offset+limit < total {
+		end = offset + limit
+	}
+	records := make([]*types.TxRecord, 0, end-offset)
+	for _, k := range keys[offset:end] {
+		data, err := chain.db.Get(k)
+		if err != nil {
+			return nil, 0, err
+		}
+		height, index, err := UnmarshalTxIndex(data)
+		if err != nil {
+			return nil, 0, err
+		}
+		block, err := chain.LoadBlockByHeight(height)
+		if err != nil {
+			return nil, 0, err
+		}
+		if int(index) >= len(block.Txs) {
+			return nil, 0, fmt.Errorf("Token tx index %d out of range in block %d", index, height)
+		}
+		records = append(records, &types.TxRecord{
+			Tx:        block.Txs[index],
+			BlockHash: *block.BlockHash(),
+			Height:    height,
+			Index:     index,
+			TimeStamp: block.Header.TimeStamp,
+			HasToken:  true,
+		})
+	}
+	return records, total, nil
+}
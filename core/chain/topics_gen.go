@@ -0,0 +1,130 @@
+// Code generated by gentopics. DO NOT EDIT.
+
+package chain
+
+import (
+	"reflect"
+
+	"github.com/BOXFoundation/boxd/boxd/eventbus"
+	"github.com/BOXFoundation/boxd/core/types"
+)
+
+func init() {
+	eventbus.RegisterTopic(eventbus.TopicChainUpdate,
+		reflect.TypeOf((**UpdateMsg)(nil)).Elem())
+	eventbus.RegisterTopic(eventbus.TopicEternalBlock,
+		reflect.TypeOf((**types.Block)(nil)).Elem())
+	eventbus.RegisterTopic(eventbus.TopicDoubleMint,
+		reflect.TypeOf((**types.DoubleMintEvidence)(nil)).Elem())
+	eventbus.RegisterTopic(eventbus.TopicTxConfirmed,
+		reflect.TypeOf((**types.Transaction)(nil)).Elem(),
+		reflect.TypeOf((**types.Block)(nil)).Elem())
+}
+
+// SubscribeChainUpdate subscribes fn to eventbus.TopicChainUpdate
+func SubscribeChainUpdate(bus eventbus.BusSubscriber, fn func(*UpdateMsg)) error {
+	return bus.Subscribe(eventbus.TopicChainUpdate, fn)
+}
+
+// SubscribeChainUpdateAsync subscribes fn to eventbus.TopicChainUpdate, called
+// asynchronously, serially if transactional
+func SubscribeChainUpdateAsync(bus eventbus.BusSubscriber, fn func(*UpdateMsg), transactional bool) error {
+	return bus.SubscribeAsync(eventbus.TopicChainUpdate, fn, transactional)
+}
+
+// SubscribeChainUpdateQueued subscribes fn to eventbus.TopicChainUpdate, called
+// in the goroutine of queue
+func SubscribeChainUpdateQueued(bus eventbus.BusSubscriber, fn func(*UpdateMsg), queue *eventbus.Queue) error {
+	return bus.SubscribeQueued(eventbus.TopicChainUpdate, fn, queue)
+}
+
+// UnsubscribeChainUpdate unsubscribes fn from eventbus.TopicChainUpdate
+func UnsubscribeChainUpdate(bus eventbus.BusSubscriber, fn func(*UpdateMsg)) error {
+	return bus.Unsubscribe(eventbus.TopicChainUpdate, fn)
+}
+
+// PublishChainUpdate publishes on eventbus.TopicChainUpdate
+func PublishChainUpdate(bus eventbus.BusPublisher, msg *UpdateMsg) {
+	bus.Publish(eventbus.TopicChainUpdate, msg)
+}
+
+// SubscribeEternalBlock subscribes fn to eventbus.TopicEternalBlock
+func SubscribeEternalBlock(bus eventbus.BusSubscriber, fn func(*types.Block)) error {
+	return bus.Subscribe(eventbus.TopicEternalBlock, fn)
+}
+
+// SubscribeEternalBlockAsync subscribes fn to eventbus.TopicEternalBlock, called
+// asynchronously, serially if transactional
+func SubscribeEternalBlockAsync(bus eventbus.BusSubscriber, fn func(*types.Block), transactional bool) error {
+	return bus.SubscribeAsync(eventbus.TopicEternalBlock, fn, transactional)
+}
+
+// SubscribeEternalBlockQueued subscribes fn to eventbus.TopicEternalBlock, called
+// in the goroutine of queue
+func SubscribeEternalBlockQueued(bus eventbus.BusSubscriber, fn func(*types.Block), queue *eventbus.Queue) error {
+	return bus.SubscribeQueued(eventbus.TopicEternalBlock, fn, queue)
+}
+
+// UnsubscribeEternalBlock unsubscribes fn from eventbus.TopicEternalBlock
+func UnsubscribeEternalBlock(bus eventbus.BusSubscriber, fn func(*types.Block)) error {
+	return bus.Unsubscribe(eventbus.TopicEternalBlock, fn)
+}
+
+// PublishEternalBlock publishes on eventbus.TopicEternalBlock
+func PublishEternalBlock(bus eventbus.BusPublisher, block *types.Block) {
+	bus.Publish(eventbus.TopicEternalBlock, block)
+}
+
+// SubscribeDoubleMint subscribes fn to eventbus.TopicDoubleMint
+func SubscribeDoubleMint(bus eventbus.BusSubscriber, fn func(*types.DoubleMintEvidence)) error {
+	return bus.Subscribe(eventbus.TopicDoubleMint, fn)
+}
+
+// SubscribeDoubleMintAsync subscribes fn to eventbus.TopicDoubleMint, called
+// asynchronously, serially if transactional
+func SubscribeDoubleMintAsync(bus eventbus.BusSubscriber, fn func(*types.DoubleMintEvidence), transactional bool) error {
+	return bus.SubscribeAsync(eventbus.TopicDoubleMint, fn, transactional)
+}
+
+// SubscribeDoubleMintQueued subscribes fn to eventbus.TopicDoubleMint, called
+// in the goroutine of queue
+func SubscribeDoubleMintQueued(bus eventbus.BusSubscriber, fn func(*types.DoubleMintEvidence), queue *eventbus.Queue) error {
+	return bus.SubscribeQueued(eventbus.TopicDoubleMint, fn, queue)
+}
+
+// UnsubscribeDoubleMint unsubscribes fn from eventbus.TopicDoubleMint
+func UnsubscribeDoubleMint(bus eventbus.BusSubscriber, fn func(*types.DoubleMintEvidence)) error {
+	return bus.Unsubscribe(eventbus.TopicDoubleMint, fn)
+}
+
+// PublishDoubleMint publishes on eventbus.TopicDoubleMint
+func PublishDoubleMint(bus eventbus.BusPublisher, evidence *types.DoubleMintEvidence) {
+	bus.Publish(eventbus.TopicDoubleMint, evidence)
+}
+
+// SubscribeTxConfirmed subscribes fn to eventbus.TopicTxConfirmed
+func SubscribeTxConfirmed(bus eventbus.BusSubscriber, fn func(*types.Transaction, *types.Block)) error {
+	return bus.Subscribe(eventbus.TopicTxConfirmed, fn)
+}
+
+// SubscribeTxConfirmedAsync subscribes fn to eventbus.TopicTxConfirmed, called
+// asynchronously, serially if transactional
+func SubscribeTxConfirmedAsync(bus eventbus.BusSubscriber, fn func(*types.Transaction, *types.Block), transactional bool) error {
+	return bus.SubscribeAsync(eventbus.TopicTxConfirmed, fn, transactional)
+}
+
+// SubscribeTxConfirmedQueued subscribes fn to eventbus.TopicTxConfirmed, called
+// in the goroutine of queue
+func SubscribeTxConfirmedQueued(bus eventbus.BusSubscriber, fn func(*types.Transaction, *types.Block), queue *eventbus.Queue) error {
+	return bus.SubscribeQueued(eventbus.TopicTxConfirmed, fn, queue)
+}
+
+// UnsubscribeTxConfirmed unsubscribes fn from eventbus.TopicTxConfirmed
+func UnsubscribeTxConfirmed(bus eventbus.BusSubscriber, fn func(*types.Transaction, *types.Block)) error {
+	return bus.Unsubscribe(eventbus.TopicTxConfirmed, fn)
+}
+
+// PublishTxConfirmed publishes on eventbus.TopicTxConfirmed
+func PublishTxConfirmed(bus eventbus.BusPublisher, tx *types.Transaction, block *types.Block) {
+	bus.Publish(eventbus.TopicTxConfirmed, tx, block)
+}
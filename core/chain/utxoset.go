@@ -99,7 +99,15 @@ type TxWrap struct {
 	Tx             *types.Transaction
 	AddedTimestamp int64
 	Height         uint32
+	Fee            uint64
 	FeePerKB       uint64
+	// AncestorFee and AncestorSize total the tx and its unconfirmed
+	// ancestors in pool, DescendantFee and DescendantSize the tx and its
+	// descendants in pool
+	AncestorFee    uint64
+	AncestorSize   int64
+	DescendantFee  uint64
+	DescendantSize int64
 }
 
 // GetExtendedTxUtxoSet returns tx's utxo set from both db & txs in spendableTxs
@@ -240,8 +248,8 @@ func (u *UtxoSet) ApplyTxWithScriptFilter(tx *types.Transaction, blockHeight uin
 	return nil
 }
 
-// WriteUtxoSetToDB store utxo set to database.
-func (u *UtxoSet) WriteUtxoSetToDB(db storage.Table) error {
+// WriteUtxoSetToBatch enqueues writes storing utxo set into batch.
+func (u *UtxoSet) WriteUtxoSetToBatch(batch storage.BatchWriter) error {
 
 	for outpoint, utxoWrap := range u.utxoMap {
 		if utxoWrap == nil || !utxoWrap.IsModified {
@@ -250,10 +258,7 @@ func (u *UtxoSet) WriteUtxoSetToDB(db storage.Table) error {
 		utxoKey := UtxoKey(&outpoint)
 		// Remove the utxo entry if it is spent.
 		if utxoWrap.IsSpent {
-			err := db.Del(utxoKey)
-			if err != nil {
-				return err
-			}
+			batch.Del(utxoKey)
 			continue
 		} else if utxoWrap.IsModified {
 			// Serialize and store the utxo entry.
@@ -261,10 +266,7 @@ func (u *UtxoSet) WriteUtxoSetToDB(db storage.Table) error {
 			if err != nil {
 				return err
 			}
-			err = db.Put(utxoKey, serialized)
-			if err != nil {
-				return err
-			}
+			batch.Put(utxoKey, serialized)
 		}
 	}
 	// free memory
@@ -143,7 +143,26 @@ func IsTxFinalized(tx *types.Transaction, blockHeight uint32, blockTime int64) b
 	return true
 }
 
+// validateBlockScripts verifies scripts of txs in block. Signatures of all
+// txs are verified at once in a batch, taken as valid while scripts run; if
+// any script or the batch fails, txs are validated again one by one, so that
+// the result and error are the same as without the batch
 func validateBlockScripts(utxoSet *UtxoSet, block *types.Block) error {
+	batch := new(script.SigBatch)
+	// Skip coinbases.
+	for _, tx := range block.Txs[1:] {
+		if err := validateTxScripts(utxoSet, tx, batch); err != nil {
+			return validateBlockScriptsSerially(utxoSet, block)
+		}
+	}
+	if !batch.Verify() {
+		return validateBlockScriptsSerially(utxoSet, block)
+	}
+
+	return nil
+}
+
+func validateBlockScriptsSerially(utxoSet *UtxoSet, block *types.Block) error {
 	// Skip coinbases.
 	for _, tx := range block.Txs[1:] {
 		if err := ValidateTxScripts(utxoSet, tx); err != nil {
@@ -157,6 +176,12 @@ func validateBlockScripts(utxoSet *UtxoSet, block *types.Block) error {
 // ValidateTxScripts verifies unlocking script for each input to ensure it is authorized to spend the utxo
 // Coinbase tx will not reach here
 func ValidateTxScripts(utxoSet *UtxoSet, tx *types.Transaction) error {
+	return validateTxScripts(utxoSet, tx, nil)
+}
+
+// validateTxScripts verifies scripts of tx like ValidateTxScripts, deferring
+// signatures to batch if it's not nil
+func validateTxScripts(utxoSet *UtxoSet, tx *types.Transaction, batch *script.SigBatch) error {
 	txHash, _ := tx.TxHash()
 	for txInIdx, txIn := range tx.Vin {
 		// Ensure the referenced input transaction exists and is not spent.
@@ -173,6 +198,12 @@ func ValidateTxScripts(utxoSet *UtxoSet, tx *types.Transaction) error {
 		prevScriptPubKey := script.NewScriptFromBytes(utxo.Output.ScriptPubKey)
 		scriptSig := script.NewScriptFromBytes(txIn.ScriptSig)
 
+		if batch != nil {
+			if err := script.ValidateDeferred(scriptSig, prevScriptPubKey, tx, txInIdx, batch); err != nil {
+				return err
+			}
+			continue
+		}
 		if err := script.Validate(scriptSig, prevScriptPubKey, tx, txInIdx); err != nil {
 			return err
 		}
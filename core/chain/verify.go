@@ -0,0 +1,274 @@
+// Copyright (c) 2018 ContentBox Authors.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package chain
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/BOXFoundation/boxd/core"
+	"github.com/BOXFoundation/boxd/core/types"
+	"github.com/BOXFoundation/boxd/crypto"
+	"github.com/BOXFoundation/boxd/storage"
+	"github.com/BOXFoundation/boxd/util/bloom"
+)
+
+// kinds of chain data checked by VerifyDatabase
+const (
+	DamagedBlock   = "block"
+	DamagedHashKey = "hash key"
+	DamagedTxIndex = "tx index"
+	DamagedFilter  = "filter"
+)
+
+// DamagedRange is a range of main chain heights where data of a kind is
+// damaged
+type DamagedRange struct {
+	Kind  string
+	Start uint32
+	End   uint32
+}
+
+func (r DamagedRange) String() string {
+	return fmt.Sprintf("%s damaged at heights [%d, %d]", r.Kind, r.Start, r.End)
+}
+
+// VerifyDatabase checks chain data in db. Blocks of main chain, the primary
+// records, are walked back from the tail block through their parents. Height
+// to hash keys, tx index and filters derived from them are checked against
+// them, and rebuilt from them if repair is set. Damaged ranges found are
+// returned, along with ErrBrokenChain if blocks are damaged, which can't be
+// repaired, or ErrDamagedChainData if derived data is damaged and not repaired
+func VerifyDatabase(db storage.Storage, repair bool) ([]DamagedRange, error) {
+	t, err := db.Table(BlockTableName)
+	if err != nil {
+		return nil, err
+	}
+	archive, err := db.Table(BlockArchiveTableName)
+	if err != nil {
+		return nil, err
+	}
+	v := &verifier{db: t, archive: archive, repair: repair}
+
+	hashes, err := v.mainChain()
+	if err != nil {
+		return v.ranges, err
+	}
+	logger.Infof("Verifying chain data of %d blocks...", len(hashes)-1)
+	for height := uint32(1); height < uint32(len(hashes)); height++ {
+		if err := v.verifyBlock(height, hashes); err != nil {
+			return v.ranges, err
+		}
+	}
+
+	for _, r := range v.ranges {
+		logger.Warn(r)
+	}
+	if len(v.ranges) > 0 && !repair {
+		return v.ranges, core.ErrDamagedChainData
+	}
+	if len(v.ranges) > 0 {
+		logger.Infof("Repaired chain data in %d damaged ranges", len(v.ranges))
+	} else {
+		logger.Info("Chain data verified")
+	}
+	return v.ranges, nil
+}
+
+// verifier walks chain data of a database
+type verifier struct {
+	db      storage.Table
+	archive storage.Table
+	repair  bool
+	ranges  []DamagedRange
+}
+
+// mainChain returns hashes of main chain blocks indexed by height, walking
+// back from the tail block
+func (v *verifier) mainChain() ([]crypto.HashType, error) {
+	data, err := v.db.Get(TailKey)
+	if err != nil {
+		return nil, err
+	}
+	if data == nil {
+		// nothing but genesis
+		return []crypto.HashType{GenesisHash}, nil
+	}
+	block := new(types.Block)
+	if err := block.Unmarshal(data); err != nil {
+		v.damaged(DamagedBlock, 0, 0)
+		return nil, core.ErrBrokenChain
+	}
+
+	hashes := make([]crypto.HashType, block.Height+1)
+	for {
+		hashes[block.Height] = *block.BlockHash()
+		if block.Height == 0 {
+			break
+		}
+		height, prevHash := block.Height-1, block.Header.PrevBlockHash
+		if block, err = v.loadBlock(&prevHash); err != nil {
+			return nil, err
+		}
+		if block == nil || block.Height != height {
+			v.damaged(DamagedBlock, 0, height)
+			logger.Errorf("Main chain broken at height %d", height)
+			return nil, core.ErrBrokenChain
+		}
+	}
+	if hashes[0] != GenesisHash {
+		v.damaged(DamagedBlock, 0, 0)
+		return nil, core.ErrBrokenChain
+	}
+	return hashes, nil
+}
+
+// loadBlock loads block of hash, nil if it's missing or damaged
+func (v *verifier) loadBlock(hash *crypto.HashType) (*types.Block, error) {
+	data, err := loadBlockData(v.db, v.archive, hash)
+	if err != nil || data == nil {
+		return nil, err
+	}
+	block := new(types.Block)
+	if err := block.Unmarshal(data); err != nil || *block.BlockHash() != *hash {
+		return nil, nil
+	}
+	return block, nil
+}
+
+// verifyBlock checks data derived from main chain block at height
+func (v *verifier) verifyBlock(height uint32, hashes []crypto.HashType) error {
+	hash := hashes[height]
+	block, err := v.loadBlock(&hash)
+	if err != nil {
+		return err
+	}
+	if block == nil {
+		v.damaged(DamagedBlock, height, height)
+		return core.ErrBrokenChain
+	}
+
+	// height to hash key
+	if data, err := v.db.Get(BlockHashKey(height)); err != nil {
+		return err
+	} else if !bytes.Equal(data, hash[:]) {
+		v.damaged(DamagedHashKey, height, height)
+		if v.repair {
+			if err := v.db.Put(BlockHashKey(height), hash[:]); err != nil {
+				return err
+			}
+		}
+	}
+
+	// tx index
+	for idx, tx := range block.Txs {
+		txHash, err := tx.TxHash()
+		if err != nil {
+			return err
+		}
+		data, err := v.db.Get(TxIndexKey(txHash))
+		if err != nil {
+			return err
+		}
+		if h, i, err := UnmarshalTxIndex(data); err == nil && h == height && i == uint32(idx) {
+			continue
+		}
+		v.damaged(DamagedTxIndex, height, height)
+		if !v.repair {
+			break
+		}
+		data, err = MarshalTxIndex(height, uint32(idx))
+		if err != nil {
+			return err
+		}
+		if err := v.db.Put(TxIndexKey(txHash), data); err != nil {
+			return err
+		}
+	}
+
+	// filter, which must contain all outputs of the block
+	if v.filterValid(block) {
+		return nil
+	}
+	v.damaged(DamagedFilter, height, height)
+	if !v.repair {
+		return nil
+	}
+	utxoUsed, err := v.spentOutputs(block, hashes)
+	if err != nil {
+		return err
+	}
+	data, err := GetFilterForTransactionScript(block, utxoUsed).Marshal()
+	if err != nil {
+		return err
+	}
+	return v.db.Put(FilterKey(hash), data)
+}
+
+// filterValid checks if the filter of block is stored and contains all
+// outputs of the block
+func (v *verifier) filterValid(block *types.Block) bool {
+	data, err := v.db.Get(FilterKey(*block.BlockHash()))
+	if err != nil || data == nil {
+		return false
+	}
+	filter, err := bloom.LoadFilter(data)
+	if err != nil {
+		return false
+	}
+	for _, script := range filterOutputScripts(block) {
+		if !filter.Matches(script) {
+			return false
+		}
+	}
+	return true
+}
+
+// spentOutputs returns outputs spent by block, loaded from blocks of the txs
+// creating them through tx index, which must have been verified
+func (v *verifier) spentOutputs(block *types.Block, hashes []crypto.HashType) (map[types.OutPoint]*types.UtxoWrap, error) {
+	outputs := make(map[types.OutPoint]*types.UtxoWrap)
+	for _, tx := range block.Txs {
+		if IsCoinBase(tx) {
+			continue
+		}
+		for _, txIn := range tx.Vin {
+			op := txIn.PrevOutPoint
+			data, err := v.db.Get(TxIndexKey(&op.Hash))
+			if err != nil {
+				return nil, err
+			}
+			height, idx, err := UnmarshalTxIndex(data)
+			if err != nil || height >= uint32(len(hashes)) {
+				// outputs of unknown txs are left out
+				continue
+			}
+			prev, err := v.loadBlock(&hashes[height])
+			if err != nil {
+				return nil, err
+			}
+			if prev == nil || int(idx) >= len(prev.Txs) || int(op.Index) >= len(prev.Txs[idx].Vout) {
+				continue
+			}
+			outputs[op] = &types.UtxoWrap{Output: prev.Txs[idx].Vout[op.Index]}
+		}
+	}
+	return outputs, nil
+}
+
+// damaged records data of kind at heights [start, end] damaged, merging it
+// into the last range of the kind if adjacent
+func (v *verifier) damaged(kind string, start, end uint32) {
+	for i := len(v.ranges) - 1; i >= 0; i-- {
+		if r := &v.ranges[i]; r.Kind == kind {
+			if r.End+1 >= start {
+				r.End = end
+				return
+			}
+			break
+		}
+	}
+	v.ranges = append(v.ranges, DamagedRange{Kind: kind, Start: start, End: end})
+}
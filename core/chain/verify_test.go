@@ -0,0 +1,99 @@
+// Copyright (c) 2018 ContentBox Authors.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package chain
+
+import (
+	"testing"
+
+	"github.com/BOXFoundation/boxd/core"
+	"github.com/BOXFoundation/boxd/core/types"
+	"github.com/BOXFoundation/boxd/storage"
+	_ "github.com/BOXFoundation/boxd/storage/memdb"
+	"github.com/facebookgo/ensure"
+	"github.com/jbenet/goprocess"
+)
+
+// storeTestChain stores blocks with data derived from them as applying them
+// does, the last one being the tail
+func storeTestChain(t *testing.T, db storage.Storage, blocks ...*types.Block) {
+	for _, block := range blocks {
+		batch := db.NewMultiBatch()
+		table, err := batch.Table(BlockTableName)
+		ensure.Nil(t, err)
+		archive, err := batch.Table(BlockArchiveTableName)
+		ensure.Nil(t, err)
+		ensure.Nil(t, storeBlock(table, archive, block))
+		ensure.Nil(t, writeTxIndex(table, block))
+		filter, err := GetFilterForTransactionScript(block, nil).Marshal()
+		ensure.Nil(t, err)
+		table.Put(FilterKey(*block.BlockHash()), filter)
+		ensure.Nil(t, batch.Write())
+		batch.Close()
+	}
+	data, err := blocks[len(blocks)-1].Marshal()
+	ensure.Nil(t, err)
+	table, err := db.Table(BlockTableName)
+	ensure.Nil(t, err)
+	ensure.Nil(t, table.Put(TailKey, data))
+}
+
+func TestVerifyDatabase(t *testing.T) {
+	db, err := storage.NewDatabase(goprocess.Background(), &storage.Config{Name: "memdb"})
+	ensure.Nil(t, err)
+	defer db.Proc().Close()
+	table, err := db.Table(BlockTableName)
+	ensure.Nil(t, err)
+	archive, err := db.Table(BlockArchiveTableName)
+	ensure.Nil(t, err)
+
+	b1 := nextBlock(&GenesisBlock)
+	b2 := nextBlock(b1)
+	b3 := nextBlock(b2)
+	storeTestChain(t, db, &GenesisBlock, b1, b2, b3)
+
+	ranges, err := VerifyDatabase(db, false)
+	ensure.Nil(t, err)
+	ensure.DeepEqual(t, len(ranges), 0)
+
+	// damage derived data
+	tx2, _ := b2.Txs[0].TxHash()
+	tx3, _ := b3.Txs[0].TxHash()
+	ensure.Nil(t, table.Put(FilterKey(*b1.BlockHash()), []byte("damaged")))
+	ensure.Nil(t, table.Del(BlockHashKey(2)))
+	ensure.Nil(t, table.Del(TxIndexKey(tx2)))
+	ensure.Nil(t, table.Del(TxIndexKey(tx3)))
+	expected := []DamagedRange{
+		{Kind: DamagedFilter, Start: 1, End: 1},
+		{Kind: DamagedHashKey, Start: 2, End: 2},
+		{Kind: DamagedTxIndex, Start: 2, End: 3},
+	}
+
+	ranges, err = VerifyDatabase(db, false)
+	ensure.DeepEqual(t, err, core.ErrDamagedChainData)
+	ensure.DeepEqual(t, ranges, expected)
+
+	ranges, err = VerifyDatabase(db, true)
+	ensure.Nil(t, err)
+	ensure.DeepEqual(t, ranges, expected)
+
+	ranges, err = VerifyDatabase(db, false)
+	ensure.Nil(t, err)
+	ensure.DeepEqual(t, len(ranges), 0)
+
+	// blocks stored before the archive table are read from the chain table
+	data, err := archive.Get(BlockKey(b1.BlockHash()))
+	ensure.Nil(t, err)
+	ensure.Nil(t, archive.Del(BlockKey(b1.BlockHash())))
+	ensure.Nil(t, table.Put(BlockKey(b1.BlockHash()), data))
+	ranges, err = VerifyDatabase(db, false)
+	ensure.Nil(t, err)
+	ensure.DeepEqual(t, len(ranges), 0)
+
+	// blocks can't be repaired
+	ensure.Nil(t, archive.Del(BlockKey(b2.BlockHash())))
+	ranges, err = VerifyDatabase(db, true)
+	ensure.DeepEqual(t, err, core.ErrBrokenChain)
+	ensure.DeepEqual(t, ranges, []DamagedRange{{Kind: DamagedBlock, Start: 0, End: 2}})
+}
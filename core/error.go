@@ -23,12 +23,14 @@ var (
 	ErrTooManySigOps               = errors.New("Too many signature operations in a block")
 	ErrBadFees                     = errors.New("total fees for block overflows accumulator")
 	ErrBadCoinbaseValue            = errors.New("Coinbase pays more than expected value")
+	ErrBadVoterRewards             = errors.New("Coinbase does not pay voters of the miner their shares")
 	ErrUnfinalizedTx               = errors.New("Transaction has not been finalized")
 	ErrWrongBlockHeight            = errors.New("Wrong block height")
 	ErrFailedToVerifyWithConsensus = errors.New("Failed to verify block with consensus")
 	ErrBlockIsNil                  = errors.New("Block is nil")
 	ErrOrphanBlockExists           = errors.New("Orphan block already exists")
 	ErrFailedToSetEternal          = errors.New("Failed to set eternal block")
+	ErrJustificationNotFound       = errors.New("Justification of the block not found")
 	ErrTokenInputsOutputNotEqual   = errors.New("Tx input tokens and output tokens unequal")
 	ErrParentBlockNotExist         = errors.New("Parent block does not exist")
 	ErrBlockTimeOut                = errors.New("The block is timeout")
@@ -45,6 +47,24 @@ var (
 	ErrNonLocalMessage            = errors.New("Received non-local message")
 	ErrLocalMessageNotChainUpdate = errors.New("Received local message is not a chain update")
 	ErrDoubleSpendTx              = errors.New("transaction must not use any of the same outputs as other transactions already in the pool")
+	ErrInsufficientFee            = errors.New("Transaction fee is less than the minimum relay fee")
+	ErrTxPoolFull                 = errors.New("Transaction pool is full")
+	ErrOrphanTooLarge             = errors.New("Orphan transaction is too large")
+	ErrOrphanQuotaExceeded        = errors.New("Too many orphan transactions from the peer")
+	ErrTooManyReplacements        = errors.New("Transaction replaces too many pending transactions")
+	ErrInsufficientReplacementFee = errors.New("Transaction pays too little fee to replace pending transactions")
+	ErrReplacementSpendsConflict  = errors.New("Transaction spends outputs of pending transactions it replaces")
+	ErrPeerTxRateExceeded         = errors.New("Peer sends transactions faster than allowed")
+	ErrAddrTxRateExceeded         = errors.New("Address spends in transactions faster than allowed")
+
+	//policy.go
+	ErrTxTooLarge           = errors.New("Transaction is larger than the standard size")
+	ErrScriptSigTooLarge    = errors.New("Transaction input signature script is too large")
+	ErrScriptSigNotPushOnly = errors.New("Transaction input signature script does not only push data")
+	ErrNonStandardScript    = errors.New("Transaction output script is not standard")
+	ErrDataOutputTooLarge   = errors.New("Transaction OP_RETURN output carries too much data")
+	ErrMultipleDataOutputs  = errors.New("Transaction has more than one OP_RETURN output")
+	ErrDustOutput           = errors.New("Transaction output value is dust")
 
 	//block.go
 	ErrSerializeHeader                = errors.New("Serialize block header error")
@@ -52,6 +72,10 @@ var (
 	ErrInvalidBlockHeaderProtoMessage = errors.New("Invalid block header proto message")
 	ErrInvalidBlockProtoMessage       = errors.New("Invalid block proto message")
 
+	//justification.go
+	ErrInvalidJustificationProtoMessage = errors.New("Invalid justification proto message")
+	ErrInvalidJustification             = errors.New("Block is not signed by more than 2/3 of miners")
+
 	//transaction.go
 	ErrSerializeOutPoint           = errors.New("serialize outPoint error")
 	ErrInvalidOutPointProtoMessage = errors.New("Invalid OutPoint proto message")
@@ -60,8 +84,10 @@ var (
 	ErrInvalidTxProtoMessage       = errors.New("Invalid tx proto message")
 
 	//address.go
-	ErrInvalidPKHash        = errors.New("pkHash must be 20 bytes")
-	ErrInvalidAddressString = errors.New("invalid box address format")
+	ErrInvalidPKHash         = errors.New("pkHash must be 20 bytes")
+	ErrInvalidAddressString  = errors.New("invalid box address format")
+	ErrInvalidAddressNetwork = errors.New("address is not of the network")
+	ErrUnknownNetwork        = errors.New("unknown network")
 
 	//utils.go
 	ErrNoTxInputs           = errors.New("Transaction has no inputs")
@@ -83,5 +109,9 @@ var (
 	ErrInvalidFilterHeight = errors.New("Filter can only be added in chain sequence")
 	ErrLoadBlockFilters    = errors.New("Fail to load block filters")
 
-	EvilBehavior = []interface{}{ErrInvalidTime, ErrNoTransactions, ErrBlockTooBig, ErrFirstTxNotCoinbase, ErrMultipleCoinbases, ErrBadMerkleRoot, ErrDuplicateTx, ErrTooManySigOps, ErrBadFees, ErrBadCoinbaseValue, ErrUnfinalizedTx, ErrWrongBlockHeight, ErrDuplicateTxInPool, ErrDuplicateTxInOrphanPool, ErrCoinbaseTx, ErrNonStandardTransaction, ErrOutPutAlreadySpent, ErrOrphanTransaction, ErrDoubleSpendTx}
+	//verify.go
+	ErrBrokenChain      = errors.New("Blocks of main chain are missing or damaged")
+	ErrDamagedChainData = errors.New("Chain data derived from blocks is damaged")
+
+	EvilBehavior = []interface{}{ErrInvalidTime, ErrNoTransactions, ErrBlockTooBig, ErrFirstTxNotCoinbase, ErrMultipleCoinbases, ErrBadMerkleRoot, ErrDuplicateTx, ErrTooManySigOps, ErrBadFees, ErrBadCoinbaseValue, ErrBadVoterRewards, ErrUnfinalizedTx, ErrWrongBlockHeight, ErrDuplicateTxInPool, ErrDuplicateTxInOrphanPool, ErrCoinbaseTx, ErrNonStandardTransaction, ErrOutPutAlreadySpent, ErrOrphanTransaction, ErrDoubleSpendTx}
 )
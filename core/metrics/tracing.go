@@ -0,0 +1,40 @@
+// Copyright (c) 2018 ContentBox Authors.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package metrics
+
+import (
+	"context"
+
+	"github.com/BOXFoundation/boxd/core/types"
+	"github.com/BOXFoundation/boxd/metrics"
+)
+
+// StartSpan starts a span of a step of processing blocks or txs
+func StartSpan(ctx context.Context, name string) (context.Context, *metrics.Span) {
+	return metrics.StartSpan(ctx, name)
+}
+
+// StartBlockSpan starts a span of processing block, with its hash and height
+// as attributes
+func StartBlockSpan(ctx context.Context, name string, block *types.Block) (context.Context, *metrics.Span) {
+	ctx, span := metrics.StartSpan(ctx, name)
+	if span != nil {
+		span.SetAttribute("block.hash", block.BlockHash().String())
+		span.SetAttribute("block.height", block.Height)
+		span.SetAttribute("block.txs", len(block.Txs))
+	}
+	return ctx, span
+}
+
+// StartTxSpan starts a span of processing tx, with its hash as attribute
+func StartTxSpan(ctx context.Context, name string, tx *types.Transaction) (context.Context, *metrics.Span) {
+	ctx, span := metrics.StartSpan(ctx, name)
+	if span != nil {
+		if txHash, err := tx.TxHash(); err == nil {
+			span.SetAttribute("tx.hash", txHash.String())
+		}
+	}
+	return ctx, span
+}
@@ -34,7 +34,7 @@ func (m *BlockHeader) Reset()         { *m = BlockHeader{} }
 func (m *BlockHeader) String() string { return proto.CompactTextString(m) }
 func (*BlockHeader) ProtoMessage()    {}
 func (*BlockHeader) Descriptor() ([]byte, []int) {
-	return fileDescriptor_block_58645f32f7e44a85, []int{0}
+	return fileDescriptor_block_dc7ee794d14a02aa, []int{0}
 }
 func (m *BlockHeader) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -123,7 +123,7 @@ func (m *Block) Reset()         { *m = Block{} }
 func (m *Block) String() string { return proto.CompactTextString(m) }
 func (*Block) ProtoMessage()    {}
 func (*Block) Descriptor() ([]byte, []int) {
-	return fileDescriptor_block_58645f32f7e44a85, []int{1}
+	return fileDescriptor_block_dc7ee794d14a02aa, []int{1}
 }
 func (m *Block) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -180,6 +180,68 @@ func (m *Block) GetSignature() []byte {
 	return nil
 }
 
+type Justification struct {
+	Hash []byte `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	// addresses of miners voting, in ascending order
+	Voters [][]byte `protobuf:"bytes,3,rep,name=voters" json:"voters,omitempty"`
+	// aggregate BLS signature of the voters
+	Signature []byte `protobuf:"bytes,4,opt,name=signature,proto3" json:"signature,omitempty"`
+}
+
+func (m *Justification) Reset()         { *m = Justification{} }
+func (m *Justification) String() string { return proto.CompactTextString(m) }
+func (*Justification) ProtoMessage()    {}
+func (*Justification) Descriptor() ([]byte, []int) {
+	return fileDescriptor_block_dc7ee794d14a02aa, []int{2}
+}
+func (m *Justification) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Justification) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Justification.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *Justification) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Justification.Merge(dst, src)
+}
+func (m *Justification) XXX_Size() int {
+	return m.Size()
+}
+func (m *Justification) XXX_DiscardUnknown() {
+	xxx_messageInfo_Justification.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Justification proto.InternalMessageInfo
+
+func (m *Justification) GetHash() []byte {
+	if m != nil {
+		return m.Hash
+	}
+	return nil
+}
+
+func (m *Justification) GetVoters() [][]byte {
+	if m != nil {
+		return m.Voters
+	}
+	return nil
+}
+
+func (m *Justification) GetSignature() []byte {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
+}
+
 type Transaction struct {
 	Version  int32    `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
 	Vin      []*TxIn  `protobuf:"bytes,2,rep,name=vin" json:"vin,omitempty"`
@@ -193,7 +255,7 @@ func (m *Transaction) Reset()         { *m = Transaction{} }
 func (m *Transaction) String() string { return proto.CompactTextString(m) }
 func (*Transaction) ProtoMessage()    {}
 func (*Transaction) Descriptor() ([]byte, []int) {
-	return fileDescriptor_block_58645f32f7e44a85, []int{2}
+	return fileDescriptor_block_dc7ee794d14a02aa, []int{3}
 }
 func (m *Transaction) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -274,7 +336,7 @@ func (m *TxIn) Reset()         { *m = TxIn{} }
 func (m *TxIn) String() string { return proto.CompactTextString(m) }
 func (*TxIn) ProtoMessage()    {}
 func (*TxIn) Descriptor() ([]byte, []int) {
-	return fileDescriptor_block_58645f32f7e44a85, []int{3}
+	return fileDescriptor_block_dc7ee794d14a02aa, []int{4}
 }
 func (m *TxIn) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -333,7 +395,7 @@ func (m *TxOut) Reset()         { *m = TxOut{} }
 func (m *TxOut) String() string { return proto.CompactTextString(m) }
 func (*TxOut) ProtoMessage()    {}
 func (*TxOut) Descriptor() ([]byte, []int) {
-	return fileDescriptor_block_58645f32f7e44a85, []int{4}
+	return fileDescriptor_block_dc7ee794d14a02aa, []int{5}
 }
 func (m *TxOut) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -385,7 +447,7 @@ func (m *OutPoint) Reset()         { *m = OutPoint{} }
 func (m *OutPoint) String() string { return proto.CompactTextString(m) }
 func (*OutPoint) ProtoMessage()    {}
 func (*OutPoint) Descriptor() ([]byte, []int) {
-	return fileDescriptor_block_58645f32f7e44a85, []int{5}
+	return fileDescriptor_block_dc7ee794d14a02aa, []int{6}
 }
 func (m *OutPoint) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -437,7 +499,7 @@ func (m *Data) Reset()         { *m = Data{} }
 func (m *Data) String() string { return proto.CompactTextString(m) }
 func (*Data) ProtoMessage()    {}
 func (*Data) Descriptor() ([]byte, []int) {
-	return fileDescriptor_block_58645f32f7e44a85, []int{6}
+	return fileDescriptor_block_dc7ee794d14a02aa, []int{7}
 }
 func (m *Data) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -492,7 +554,7 @@ func (m *UtxoWrap) Reset()         { *m = UtxoWrap{} }
 func (m *UtxoWrap) String() string { return proto.CompactTextString(m) }
 func (*UtxoWrap) ProtoMessage()    {}
 func (*UtxoWrap) Descriptor() ([]byte, []int) {
-	return fileDescriptor_block_58645f32f7e44a85, []int{7}
+	return fileDescriptor_block_dc7ee794d14a02aa, []int{8}
 }
 func (m *UtxoWrap) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -559,6 +621,7 @@ func (m *UtxoWrap) GetIsModified() bool {
 func init() {
 	proto.RegisterType((*BlockHeader)(nil), "corepb.BlockHeader")
 	proto.RegisterType((*Block)(nil), "corepb.Block")
+	proto.RegisterType((*Justification)(nil), "corepb.Justification")
 	proto.RegisterType((*Transaction)(nil), "corepb.Transaction")
 	proto.RegisterType((*TxIn)(nil), "corepb.TxIn")
 	proto.RegisterType((*TxOut)(nil), "corepb.TxOut")
@@ -674,6 +737,44 @@ func (m *Block) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
+func (m *Justification) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Justification) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Hash) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintBlock(dAtA, i, uint64(len(m.Hash)))
+		i += copy(dAtA[i:], m.Hash)
+	}
+	if len(m.Voters) > 0 {
+		for _, b := range m.Voters {
+			dAtA[i] = 0x1a
+			i++
+			i = encodeVarintBlock(dAtA, i, uint64(len(b)))
+			i += copy(dAtA[i:], b)
+		}
+	}
+	if len(m.Signature) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintBlock(dAtA, i, uint64(len(m.Signature)))
+		i += copy(dAtA[i:], m.Signature)
+	}
+	return i, nil
+}
+
 func (m *Transaction) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -999,6 +1100,29 @@ func (m *Block) Size() (n int) {
 	return n
 }
 
+func (m *Justification) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovBlock(uint64(l))
+	}
+	if len(m.Voters) > 0 {
+		for _, b := range m.Voters {
+			l = len(b)
+			n += 1 + l + sovBlock(uint64(l))
+		}
+	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovBlock(uint64(l))
+	}
+	return n
+}
+
 func (m *Transaction) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1534,6 +1658,147 @@ func (m *Block) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *Justification) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBlock
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Justification: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Justification: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBlock
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = append(m.Hash[:0], dAtA[iNdEx:postIndex]...)
+			if m.Hash == nil {
+				m.Hash = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Voters", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBlock
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Voters = append(m.Voters, make([]byte, postIndex-iNdEx))
+			copy(m.Voters[len(m.Voters)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBlock
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signature = append(m.Signature[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signature == nil {
+				m.Signature = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBlock(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBlock
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *Transaction) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -2436,48 +2701,50 @@ var (
 	ErrIntOverflowBlock   = fmt.Errorf("proto: integer overflow")
 )
 
-func init() { proto.RegisterFile("block.proto", fileDescriptor_block_58645f32f7e44a85) }
-
-var fileDescriptor_block_58645f32f7e44a85 = []byte{
-	// 636 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x54, 0xcb, 0x6e, 0xd3, 0x40,
-	0x14, 0xad, 0x1b, 0x27, 0x71, 0x6e, 0x92, 0x16, 0x0d, 0x08, 0xb9, 0x3c, 0x4c, 0x6a, 0x51, 0x88,
-	0x84, 0xd4, 0x05, 0x54, 0x7c, 0x40, 0xcb, 0xa2, 0x08, 0xa1, 0x56, 0xd3, 0x22, 0x96, 0xd6, 0xc4,
-	0x1e, 0x92, 0x51, 0x9b, 0x19, 0xe3, 0x19, 0x47, 0xc9, 0x5f, 0xc0, 0xa7, 0xf0, 0x05, 0x6c, 0x59,
-	0x76, 0xc9, 0x12, 0xb5, 0x2b, 0xfe, 0x02, 0xcd, 0x9d, 0x49, 0x13, 0x21, 0xc1, 0x2e, 0xe7, 0xdc,
-	0xe3, 0x73, 0x9f, 0x13, 0xe8, 0x8e, 0x2e, 0x55, 0x7e, 0xb1, 0x5f, 0x56, 0xca, 0x28, 0xd2, 0xca,
-	0x55, 0xc5, 0xcb, 0x51, 0xfa, 0x3b, 0x80, 0xee, 0xa1, 0xe5, 0x8f, 0x39, 0x2b, 0x78, 0x45, 0x62,
-	0x68, 0xcf, 0x78, 0xa5, 0x85, 0x92, 0x71, 0x30, 0x08, 0x86, 0x4d, 0xba, 0x84, 0xe4, 0x19, 0x6c,
-	0x97, 0x15, 0x9f, 0x65, 0xe8, 0x92, 0x4d, 0x98, 0x9e, 0xc4, 0x9b, 0x83, 0x60, 0xd8, 0xa3, 0x7d,
-	0x4b, 0x3b, 0x0f, 0xa6, 0x27, 0x64, 0x07, 0x22, 0x33, 0xd7, 0x59, 0xa5, 0x94, 0x89, 0x1b, 0x28,
-	0x68, 0x9b, 0xb9, 0xa6, 0x4a, 0x19, 0xf2, 0x18, 0xc0, 0x88, 0x29, 0xcf, 0xb4, 0x61, 0xd3, 0x32,
-	0x0e, 0x07, 0xc1, 0xb0, 0x41, 0x3b, 0x96, 0x39, 0xb3, 0x04, 0xb9, 0x07, 0xcd, 0x29, 0x1b, 0x8b,
-	0x3c, 0x6e, 0x0e, 0x82, 0x61, 0x9f, 0x3a, 0x40, 0x9e, 0x40, 0xb7, 0xe4, 0x95, 0x50, 0x85, 0xcb,
-	0xd9, 0x42, 0x4b, 0x70, 0x14, 0x26, 0x7c, 0x0e, 0xdb, 0x39, 0x93, 0x85, 0x28, 0x98, 0xe1, 0xda,
-	0x89, 0xda, 0x28, 0xda, 0x5a, 0xd1, 0x56, 0x98, 0x7e, 0x0d, 0xa0, 0x89, 0x75, 0x92, 0x17, 0xd0,
-	0x9a, 0x60, 0xbf, 0xd8, 0x64, 0xf7, 0xe5, 0xdd, 0x7d, 0x37, 0x8e, 0xfd, 0xb5, 0x51, 0x50, 0x2f,
-	0x21, 0x7b, 0xd0, 0x30, 0x73, 0x1d, 0x6f, 0x0e, 0x1a, 0xeb, 0xca, 0xf3, 0x8a, 0x49, 0xcd, 0x72,
-	0x23, 0x94, 0xa4, 0x36, 0x4e, 0xee, 0x5b, 0x4f, 0x31, 0x9e, 0xb8, 0xae, 0xfb, 0xd4, 0x23, 0xf2,
-	0x08, 0x3a, 0x5a, 0x8c, 0x25, 0x33, 0x75, 0xc5, 0xb1, 0xe7, 0x1e, 0x5d, 0x11, 0xe9, 0xf7, 0x00,
-	0xba, 0x6b, 0x56, 0xff, 0x99, 0x7f, 0x02, 0x8d, 0x99, 0x90, 0xbe, 0x8c, 0xde, 0x6d, 0x19, 0xf3,
-	0xb7, 0x92, 0xda, 0x00, 0xd9, 0x85, 0x70, 0xa6, 0x6a, 0x9b, 0xdd, 0x0a, 0xfa, 0x2b, 0xc1, 0x49,
-	0x6d, 0x28, 0x86, 0xc8, 0x00, 0xc2, 0x82, 0x19, 0x86, 0x55, 0xac, 0x79, 0xbc, 0x61, 0x86, 0x51,
-	0x8c, 0xfc, 0x63, 0x05, 0x0f, 0xa1, 0x83, 0x4b, 0xb7, 0xab, 0xc2, 0x05, 0x34, 0x68, 0x64, 0x89,
-	0x73, 0x31, 0xe5, 0xe9, 0x02, 0x42, 0x5b, 0x04, 0x79, 0x0d, 0x5b, 0x78, 0x1f, 0xaa, 0x36, 0x59,
-	0xa9, 0x84, 0x34, 0x7e, 0xb6, 0x77, 0x96, 0x69, 0x4e, 0x6a, 0x73, 0x6a, 0x79, 0xda, 0xb3, 0xba,
-	0x25, 0xb2, 0x47, 0xa1, 0xf3, 0x4a, 0x94, 0x26, 0xd3, 0x62, 0xec, 0x4f, 0xaa, 0xe3, 0x98, 0x33,
-	0x31, 0x26, 0x0f, 0x20, 0xd2, 0xfc, 0x73, 0xcd, 0x65, 0xce, 0xfd, 0x60, 0x6f, 0x71, 0x7a, 0x04,
-	0x4d, 0x6c, 0xcf, 0x96, 0x3d, 0x63, 0x97, 0x35, 0xc7, 0x94, 0x21, 0x75, 0x80, 0x3c, 0x85, 0x2d,
-	0xef, 0x5c, 0xd6, 0xa3, 0xec, 0x82, 0x2f, 0xbc, 0x7b, 0xcf, 0xb1, 0xa7, 0xf5, 0xe8, 0x1d, 0x5f,
-	0xa4, 0x07, 0x10, 0xdd, 0xd6, 0x42, 0x20, 0xc4, 0xfb, 0x09, 0x50, 0x87, 0xbf, 0xad, 0xb7, 0x90,
-	0x05, 0x9f, 0xe3, 0xc7, 0x7d, 0xea, 0x40, 0x7a, 0x00, 0xa1, 0x1d, 0x9b, 0xfd, 0xc2, 0x2c, 0x4a,
-	0xee, 0x97, 0x85, 0xbf, 0xed, 0x0e, 0x73, 0x25, 0x0d, 0x97, 0xc6, 0x27, 0x5c, 0xc2, 0xf4, 0x5b,
-	0x00, 0xd1, 0x07, 0x33, 0x57, 0x1f, 0x2b, 0x56, 0x92, 0x3d, 0x68, 0xa9, 0xda, 0x94, 0xf5, 0x72,
-	0x50, 0x7f, 0xad, 0xcc, 0x07, 0xc9, 0x2e, 0xf4, 0xfc, 0x93, 0x73, 0xd7, 0xe5, 0xca, 0x70, 0x8f,
-	0xf9, 0xd8, 0x9d, 0xd8, 0x0e, 0x44, 0x42, 0x67, 0xba, 0xb4, 0x19, 0xed, 0x8c, 0x22, 0xda, 0x16,
-	0xfa, 0xcc, 0x42, 0xfb, 0x7a, 0x84, 0xce, 0x72, 0x25, 0xe4, 0x88, 0x69, 0x77, 0x7f, 0x11, 0x05,
-	0xa1, 0x8f, 0x3c, 0xe3, 0x05, 0x53, 0x55, 0x88, 0x4f, 0x82, 0x17, 0xb8, 0x77, 0x14, 0xbc, 0xf7,
-	0xcc, 0x61, 0xfc, 0xe3, 0x3a, 0x09, 0xae, 0xae, 0x93, 0xe0, 0xd7, 0x75, 0x12, 0x7c, 0xb9, 0x49,
-	0x36, 0xae, 0x6e, 0x92, 0x8d, 0x9f, 0x37, 0xc9, 0xc6, 0xa8, 0x85, 0x7f, 0x25, 0xaf, 0xfe, 0x04,
-	0x00, 0x00, 0xff, 0xff, 0xde, 0x0c, 0xce, 0x6f, 0x59, 0x04, 0x00, 0x00,
+func init() { proto.RegisterFile("block.proto", fileDescriptor_block_dc7ee794d14a02aa) }
+
+var fileDescriptor_block_dc7ee794d14a02aa = []byte{
+	// 670 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x54, 0xcb, 0x4e, 0xdb, 0x4c,
+	0x18, 0xc5, 0xd8, 0x49, 0x9c, 0x2f, 0x0e, 0xa0, 0xf9, 0xd1, 0x2f, 0xf7, 0xe6, 0x1a, 0xab, 0xb4,
+	0x91, 0x2a, 0xb1, 0xa0, 0xa8, 0x0f, 0x00, 0x5d, 0x50, 0xaa, 0x0a, 0x34, 0x50, 0x75, 0x69, 0x4d,
+	0xec, 0x21, 0x19, 0x41, 0x66, 0x5c, 0xcf, 0x38, 0x4a, 0xde, 0xa2, 0x7d, 0x94, 0x3e, 0x41, 0xb7,
+	0x5d, 0xb2, 0xec, 0xb2, 0x82, 0x55, 0xdf, 0xa2, 0x9a, 0x4b, 0x20, 0xaa, 0x5a, 0x76, 0x3e, 0xe7,
+	0x3b, 0x3e, 0xdf, 0xd5, 0x86, 0xde, 0xf0, 0x52, 0x14, 0x17, 0x3b, 0x55, 0x2d, 0x94, 0x40, 0xed,
+	0x42, 0xd4, 0xb4, 0x1a, 0x66, 0xbf, 0x3c, 0xe8, 0xed, 0x6b, 0xfe, 0x90, 0x92, 0x92, 0xd6, 0x28,
+	0x86, 0xce, 0x94, 0xd6, 0x92, 0x09, 0x1e, 0x7b, 0xa9, 0x37, 0x68, 0xe1, 0x05, 0x44, 0xcf, 0x61,
+	0xbd, 0xaa, 0xe9, 0x34, 0x37, 0x2e, 0xf9, 0x98, 0xc8, 0x71, 0xbc, 0x9a, 0x7a, 0x83, 0x08, 0xf7,
+	0x35, 0x6d, 0x3d, 0x88, 0x1c, 0xa3, 0x07, 0x10, 0xaa, 0x99, 0xcc, 0x6b, 0x21, 0x54, 0xec, 0x1b,
+	0x41, 0x47, 0xcd, 0x24, 0x16, 0x42, 0xa1, 0x27, 0x00, 0x8a, 0x4d, 0x68, 0x2e, 0x15, 0x99, 0x54,
+	0x71, 0x90, 0x7a, 0x03, 0x1f, 0x77, 0x35, 0x73, 0xaa, 0x09, 0xb4, 0x09, 0xad, 0x09, 0x19, 0xb1,
+	0x22, 0x6e, 0xa5, 0xde, 0xa0, 0x8f, 0x2d, 0x40, 0x4f, 0xa1, 0x57, 0xd1, 0x9a, 0x89, 0xd2, 0xe6,
+	0x6c, 0x1b, 0x4b, 0xb0, 0x94, 0x49, 0xf8, 0x02, 0xd6, 0x0b, 0xc2, 0x4b, 0x56, 0x12, 0x45, 0xa5,
+	0x15, 0x75, 0x8c, 0x68, 0xed, 0x8e, 0xd6, 0xc2, 0xec, 0x8b, 0x07, 0x2d, 0x53, 0x27, 0x7a, 0x09,
+	0xed, 0xb1, 0xe9, 0xd7, 0x34, 0xd9, 0xdb, 0xfd, 0x6f, 0xc7, 0x8e, 0x63, 0x67, 0x69, 0x14, 0xd8,
+	0x49, 0xd0, 0x36, 0xf8, 0x6a, 0x26, 0xe3, 0xd5, 0xd4, 0x5f, 0x56, 0x9e, 0xd5, 0x84, 0x4b, 0x52,
+	0x28, 0x26, 0x38, 0xd6, 0x71, 0xf4, 0xbf, 0xf6, 0x64, 0xa3, 0xb1, 0xed, 0xba, 0x8f, 0x1d, 0x42,
+	0x8f, 0xa1, 0x2b, 0xd9, 0x88, 0x13, 0xd5, 0xd4, 0xd4, 0xf4, 0x1c, 0xe1, 0x3b, 0x22, 0xcb, 0xa1,
+	0x7f, 0xd4, 0x48, 0xc5, 0xce, 0x59, 0x41, 0xb4, 0x17, 0x42, 0x10, 0x98, 0x16, 0x3c, 0xa3, 0x34,
+	0xcf, 0xda, 0x7a, 0x2a, 0x14, 0xad, 0x65, 0xec, 0xa7, 0xfe, 0x20, 0xc2, 0x0e, 0xdd, 0x6f, 0x7d,
+	0x14, 0x84, 0xab, 0x1b, 0x7e, 0xf6, 0xcd, 0x83, 0xde, 0x52, 0xad, 0xf7, 0x2c, 0x38, 0x01, 0x7f,
+	0xca, 0xb8, 0xeb, 0x33, 0xba, 0xed, 0x73, 0xf6, 0x96, 0x63, 0x1d, 0x40, 0x5b, 0x10, 0x4c, 0x45,
+	0xa3, 0x4c, 0x0d, 0xbd, 0xdd, 0xfe, 0x9d, 0xe0, 0xb8, 0x51, 0xd8, 0x84, 0x50, 0x0a, 0x41, 0x49,
+	0x14, 0x31, 0xb5, 0x2c, 0x79, 0xbc, 0x21, 0x8a, 0x60, 0x13, 0xf9, 0xc7, 0x8e, 0x1f, 0x41, 0xd7,
+	0x5c, 0x95, 0xbe, 0x05, 0xb3, 0x61, 0x1f, 0x87, 0x9a, 0x38, 0x63, 0x13, 0x9a, 0xcd, 0x21, 0xd0,
+	0x45, 0xa0, 0xd7, 0xb0, 0x66, 0x0e, 0x50, 0x34, 0x2a, 0xaf, 0x04, 0xe3, 0xca, 0x2d, 0x6f, 0x63,
+	0x91, 0xe6, 0xb8, 0x51, 0x27, 0x9a, 0xc7, 0x91, 0xd6, 0x2d, 0x90, 0xbe, 0x3a, 0x59, 0xd4, 0xac,
+	0x52, 0xb9, 0x64, 0x23, 0x77, 0xb3, 0x5d, 0xcb, 0x9c, 0xb2, 0x11, 0x7a, 0x08, 0xa1, 0xa4, 0x9f,
+	0x1a, 0xca, 0x0b, 0xea, 0x36, 0x77, 0x8b, 0xb3, 0x03, 0x68, 0x99, 0xf6, 0x74, 0xd9, 0x53, 0x72,
+	0xd9, 0x50, 0x93, 0x32, 0xc0, 0x16, 0xa0, 0x67, 0xb0, 0xe6, 0x9c, 0xab, 0x66, 0x98, 0x5f, 0xd0,
+	0xb9, 0x73, 0x8f, 0x2c, 0x7b, 0xd2, 0x0c, 0xdf, 0xd1, 0x79, 0xb6, 0x07, 0xe1, 0x6d, 0x2d, 0x7f,
+	0xdb, 0xee, 0x26, 0xb4, 0x18, 0x2f, 0xe9, 0xcc, 0xbc, 0xdc, 0xc7, 0x16, 0x64, 0x7b, 0x10, 0xe8,
+	0xb1, 0xe9, 0x37, 0xd4, 0xbc, 0xa2, 0x6e, 0x59, 0xe6, 0x59, 0xef, 0xb0, 0x10, 0x5c, 0x51, 0xae,
+	0x5c, 0xc2, 0x05, 0xcc, 0xbe, 0x7a, 0x10, 0x7e, 0x50, 0x33, 0xf1, 0xb1, 0x26, 0x15, 0xda, 0x86,
+	0xb6, 0x68, 0x54, 0xd5, 0x2c, 0x06, 0xf5, 0xc7, 0xca, 0x5c, 0x10, 0x6d, 0x41, 0xe4, 0xbe, 0x69,
+	0x7b, 0xbe, 0xb6, 0x0c, 0xfb, 0xb7, 0x38, 0x34, 0x94, 0xfe, 0xa6, 0x99, 0xcc, 0x65, 0xa5, 0x33,
+	0xea, 0x19, 0x85, 0xb8, 0xc3, 0xe4, 0xa9, 0x86, 0xfa, 0xf3, 0x64, 0x32, 0x2f, 0x04, 0xe3, 0x43,
+	0x22, 0xed, 0x15, 0x86, 0x18, 0x98, 0x3c, 0x70, 0x8c, 0x13, 0x4c, 0x44, 0xc9, 0xce, 0x19, 0x2d,
+	0xe3, 0xd6, 0x42, 0xf0, 0xde, 0x31, 0xfb, 0xf1, 0xf7, 0xeb, 0xc4, 0xbb, 0xba, 0x4e, 0xbc, 0x9f,
+	0xd7, 0x89, 0xf7, 0xf9, 0x26, 0x59, 0xb9, 0xba, 0x49, 0x56, 0x7e, 0xdc, 0x24, 0x2b, 0xc3, 0xb6,
+	0xf9, 0x57, 0xbd, 0xfa, 0x3d, 0x00, 0xf0, 0x7a, 0xe8, 0x97, 0xba, 0x04, 0x00, 0x00,
 }
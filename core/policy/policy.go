@@ -0,0 +1,120 @@
+// Copyright (c) 2018 ContentBox Authors.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package policy
+
+import (
+	"github.com/BOXFoundation/boxd/core"
+	corepb "github.com/BOXFoundation/boxd/core/pb"
+	"github.com/BOXFoundation/boxd/core/types"
+	"github.com/BOXFoundation/boxd/script"
+)
+
+// Standardness rules below only apply to txs entering mempool and relayed.
+// Blocks holding txs breaking them are still valid
+
+const (
+	// DefaultMaxTxSize is the default max bytes of a standard tx
+	DefaultMaxTxSize = 100000
+	// DefaultMaxDataSize is the default max bytes of an OP_RETURN output
+	// script
+	DefaultMaxDataSize = 80
+
+	// MaxScriptSigSize is the max bytes of a standard signature script, large
+	// enough for a 15-of-15 multisig p2sh redeem
+	MaxScriptSigSize = 1650
+
+	// spendingInputSize estimates bytes of an input spending a p2pkh output,
+	// which counts in the cost of an output
+	spendingInputSize = 148
+	// txOutOverhead estimates bytes of an output besides its script: value and
+	// field tags
+	txOutOverhead = 12
+)
+
+// Config defines standardness rules. Defaults are used if sizes are not set
+type Config struct {
+	// MaxTxSize is the max bytes of a standard tx
+	MaxTxSize int `mapstructure:"max_tx_size"`
+	// MaxDataSize is the max bytes of an OP_RETURN output script
+	MaxDataSize int `mapstructure:"max_data_size"`
+	// DustRelayFee is the fee per 1000 bytes an output's value must be three
+	// times the cost to create and spend at. Dust outputs are not checked if 0
+	DustRelayFee uint64 `mapstructure:"dust_relay_fee"`
+	// AcceptNonStandard turns off all standardness checks
+	AcceptNonStandard bool `mapstructure:"accept_non_standard"`
+}
+
+func (cfg *Config) maxTxSize() int {
+	if cfg.MaxTxSize == 0 {
+		return DefaultMaxTxSize
+	}
+	return cfg.MaxTxSize
+}
+
+func (cfg *Config) maxDataSize() int {
+	if cfg.MaxDataSize == 0 {
+		return DefaultMaxDataSize
+	}
+	return cfg.MaxDataSize
+}
+
+// CheckTransactionStandard checks if tx is standard and so may be admitted
+// into mempool and relayed
+func CheckTransactionStandard(tx *types.Transaction, cfg *Config) error {
+	if cfg.AcceptNonStandard {
+		return nil
+	}
+
+	txSize, err := tx.SerializeSize()
+	if err != nil {
+		return err
+	}
+	if txSize > cfg.maxTxSize() {
+		return core.ErrTxTooLarge
+	}
+
+	for _, txIn := range tx.Vin {
+		if len(txIn.ScriptSig) > MaxScriptSigSize {
+			return core.ErrScriptSigTooLarge
+		}
+		if !script.NewScriptFromBytes(txIn.ScriptSig).IsPushOnly() {
+			return core.ErrScriptSigNotPushOnly
+		}
+	}
+
+	dataOutputs := 0
+	for _, txOut := range tx.Vout {
+		sc := script.NewScriptFromBytes(txOut.ScriptPubKey)
+		switch {
+		case sc.IsOpReturn():
+			if len(txOut.ScriptPubKey) > cfg.maxDataSize() {
+				return core.ErrDataOutputTooLarge
+			}
+			dataOutputs++
+		case sc.IsTokenIssue() || sc.IsTokenTransfer():
+			// token outputs carry tokens regardless of their box value
+		case sc.IsPayToPubKeyHash() || sc.IsPayToScriptHash():
+			if IsDust(txOut, cfg.DustRelayFee) {
+				return core.ErrDustOutput
+			}
+		default:
+			return core.ErrNonStandardScript
+		}
+	}
+	if dataOutputs > 1 {
+		return core.ErrMultipleDataOutputs
+	}
+	return nil
+}
+
+// IsDust returns if txOut's value is less than three times the fee to create
+// and spend it at dustRelayFee per 1000 bytes, costing more than it's worth
+func IsDust(txOut *corepb.TxOut, dustRelayFee uint64) bool {
+	if dustRelayFee == 0 {
+		return false
+	}
+	cost := uint64(len(txOut.ScriptPubKey)+txOutOverhead+spendingInputSize) * dustRelayFee / 1000
+	return txOut.Value < 3*cost
+}
@@ -0,0 +1,66 @@
+// Copyright (c) 2018 ContentBox Authors.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package policy
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/BOXFoundation/boxd/core"
+	corepb "github.com/BOXFoundation/boxd/core/pb"
+	"github.com/BOXFoundation/boxd/core/types"
+	"github.com/BOXFoundation/boxd/script"
+	"github.com/facebookgo/ensure"
+)
+
+var pubKeyHash = bytes.Repeat([]byte{0x01}, 20)
+
+func newTx(scriptSig []byte, scriptPubKeys ...[]byte) *types.Transaction {
+	tx := &types.Transaction{
+		Vin: []*types.TxIn{{ScriptSig: scriptSig}},
+	}
+	for _, scriptPubKey := range scriptPubKeys {
+		tx.Vout = append(tx.Vout, &corepb.TxOut{Value: 1000, ScriptPubKey: scriptPubKey})
+	}
+	return tx
+}
+
+func TestCheckTransactionStandard(t *testing.T) {
+	cfg := &Config{}
+	p2pkh := []byte(*script.PayToPubKeyHashScript(pubKeyHash))
+	scriptSig := []byte(*script.NewScript().AddOperand([]byte{0x02}).AddOperand([]byte{0x03}))
+	data := func(n int) []byte {
+		return []byte(*script.NewScript().AddOpCode(script.OPRETURN).AddOperand(make([]byte, n)))
+	}
+
+	ensure.Nil(t, CheckTransactionStandard(newTx(scriptSig, p2pkh), cfg))
+	ensure.Nil(t, CheckTransactionStandard(newTx(scriptSig, p2pkh, data(40)), cfg))
+
+	// scripts
+	ensure.DeepEqual(t, CheckTransactionStandard(newTx(p2pkh, p2pkh), cfg), core.ErrScriptSigNotPushOnly)
+	ensure.DeepEqual(t, CheckTransactionStandard(newTx(scriptSig, scriptSig), cfg), core.ErrNonStandardScript)
+	ensure.DeepEqual(t, CheckTransactionStandard(newTx(scriptSig, data(100)), cfg), core.ErrDataOutputTooLarge)
+	ensure.DeepEqual(t, CheckTransactionStandard(newTx(scriptSig, data(10), data(10)), cfg), core.ErrMultipleDataOutputs)
+
+	// size
+	bigScriptSig := []byte(*script.NewScript().AddOperand(make([]byte, 1000)).AddOperand(make([]byte, 1000)))
+	ensure.DeepEqual(t, CheckTransactionStandard(newTx(bigScriptSig, p2pkh), cfg), core.ErrScriptSigTooLarge)
+	ensure.DeepEqual(t, CheckTransactionStandard(newTx(scriptSig, p2pkh), &Config{MaxTxSize: 10}), core.ErrTxTooLarge)
+
+	// dust
+	ensure.DeepEqual(t, CheckTransactionStandard(newTx(scriptSig, p2pkh), &Config{DustRelayFee: 10000}), core.ErrDustOutput)
+	ensure.Nil(t, CheckTransactionStandard(newTx(scriptSig, p2pkh), &Config{DustRelayFee: 1000}))
+
+	// overrides
+	ensure.Nil(t, CheckTransactionStandard(newTx(scriptSig, scriptSig), &Config{AcceptNonStandard: true}))
+}
+
+func TestIsDust(t *testing.T) {
+	p2pkh := []byte(*script.PayToPubKeyHashScript(pubKeyHash))
+	// (25 + 12 + 148) bytes cost 185 at 1000 per 1000 bytes
+	ensure.True(t, IsDust(&corepb.TxOut{Value: 554, ScriptPubKey: p2pkh}, 1000))
+	ensure.False(t, IsDust(&corepb.TxOut{Value: 555, ScriptPubKey: p2pkh}, 1000))
+	ensure.False(t, IsDust(&corepb.TxOut{Value: 0, ScriptPubKey: p2pkh}, 0))
+}
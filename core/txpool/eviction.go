@@ -0,0 +1,169 @@
+// Copyright (c) 2018 ContentBox Authors.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package txpool
+
+import (
+	"sync/atomic"
+	"time"
+
+	"github.com/BOXFoundation/boxd/core/chain"
+	"github.com/BOXFoundation/boxd/core/types"
+	"github.com/BOXFoundation/boxd/crypto"
+)
+
+const (
+	// defaultMaxSize and defaultMaxTxs bound the pool if not configured
+	defaultMaxSize = 64 * 1024 * 1024
+	defaultMaxTxs  = 100000
+
+	// incrementalRelayFee is added per 1000 bytes to the fee rate of evicted
+	// txs to become the min fee rate, so that replacing them pays for relay
+	incrementalRelayFee = 1000
+	// evictionFeeHalflife is the period the raised min fee rate halves in
+	evictionFeeHalflife = 10 * time.Minute
+
+	// defaultExpiry is how long txs stay in pool if not configured
+	defaultExpiry = 72 * time.Hour
+)
+
+func (cfg *Config) expiry() time.Duration {
+	if cfg.Expiry == 0 {
+		return defaultExpiry
+	}
+	return cfg.Expiry
+}
+
+func (cfg *Config) maxSize() int64 {
+	if cfg.MaxSize == 0 {
+		return defaultMaxSize
+	}
+	return int64(cfg.MaxSize)
+}
+
+func (cfg *Config) maxTxs() int {
+	if cfg.MaxTxs == 0 {
+		return defaultMaxTxs
+	}
+	return int(cfg.MaxTxs)
+}
+
+// evictionFee is the min fee rate raised by evictions, decaying over time
+type evictionFee struct {
+	feePerKB  uint64
+	updatedAt time.Time
+}
+
+// at returns the fee rate decayed till now
+func (f *evictionFee) at(now time.Time) uint64 {
+	if f.feePerKB == 0 {
+		return 0
+	}
+	halvings := uint(now.Sub(f.updatedAt) / evictionFeeHalflife)
+	if halvings >= 64 {
+		return 0
+	}
+	return f.feePerKB >> halvings
+}
+
+// raise raises the fee rate over feePerKB of an evicted tx
+func (f *evictionFee) raise(feePerKB uint64, now time.Time) {
+	if fee := feePerKB + incrementalRelayFee; fee > f.at(now) {
+		f.feePerKB, f.updatedAt = fee, now
+	}
+}
+
+// minFeePerKB returns the fee rate txs pay at least to be admitted, the min
+// relay fee or higher after evictions
+func (tx_pool *TransactionPool) minFeePerKB(now time.Time) uint64 {
+	tx_pool.feeMutex.Lock()
+	fee := tx_pool.evictionFee.at(now)
+	tx_pool.feeMutex.Unlock()
+	if fee < tx_pool.cfg.MinRelayFee {
+		return tx_pool.cfg.MinRelayFee
+	}
+	return fee
+}
+
+// full checks if the pool holds more txs or bytes than allowed
+func (tx_pool *TransactionPool) full() bool {
+	return lengthOfSyncMap(tx_pool.hashToTx) > tx_pool.cfg.maxTxs() ||
+		atomic.LoadInt64(&tx_pool.size) > tx_pool.cfg.maxSize()
+}
+
+// limitSize evicts txs with the lowest fee rates, and their descendants,
+// till the pool is within bounds. It returns hashes of evicted txs
+func (tx_pool *TransactionPool) limitSize() map[crypto.HashType]struct{} {
+	evicted := make(map[crypto.HashType]struct{})
+	if !tx_pool.full() {
+		return evicted
+	}
+	txs := tx_pool.GetTxsByFeeRate()
+	for i := len(txs) - 1; i >= 0 && tx_pool.full(); i-- {
+		txHash, _ := txs[i].Tx.TxHash()
+		if _, ok := evicted[*txHash]; ok {
+			continue
+		}
+		tx_pool.feeMutex.Lock()
+		tx_pool.evictionFee.raise(txs[i].FeePerKB, time.Now())
+		tx_pool.feeMutex.Unlock()
+		for _, tx := range tx_pool.evictTx(txs[i].Tx, types.TxRemoveEvicted) {
+			hash, _ := tx.TxHash()
+			evicted[*hash] = struct{}{}
+		}
+	}
+	logger.Infof("Evicted %d txs from full pool, min fee per KB is %d", len(evicted), tx_pool.minFeePerKB(time.Now()))
+	return evicted
+}
+
+// evictTx removes tx and its descendants from the pool for reason. It
+// returns the removed txs
+func (tx_pool *TransactionPool) evictTx(tx *types.Transaction, reason types.TxRemoveReason) []*types.Transaction {
+	removedTxs := []*types.Transaction{tx}
+	// Note: use index here instead of range because removedTxs can be extended inside the loop
+	for i := 0; i < len(removedTxs); i++ {
+		removedTx := removedTxs[i]
+		removedTxHash, _ := removedTx.TxHash()
+		outPoint := types.OutPoint{Hash: *removedTxHash}
+		for txOutIdx := range removedTx.Vout {
+			outPoint.Index = uint32(txOutIdx)
+			if childTx, exists := tx_pool.findTransaction(outPoint); exists {
+				removedTxs = append(removedTxs, childTx)
+			}
+		}
+	}
+	for _, removedTx := range removedTxs {
+		tx_pool.removeTx(removedTx, reason, false /* non-recursive */)
+	}
+	return removedTxs
+}
+
+// expireTxs removes txs added to the pool longer than the expiry ago, and
+// their descendants
+func (tx_pool *TransactionPool) expireTxs(now time.Time) {
+	tx_pool.txMutex.Lock()
+	defer tx_pool.txMutex.Unlock()
+
+	deadline := now.Add(-tx_pool.cfg.expiry()).Unix()
+	expired := 0
+	for _, txWrap := range tx_pool.GetAllTxs() {
+		if txWrap.AddedTimestamp >= deadline {
+			continue
+		}
+		// descendants of txs expired earlier are gone
+		if hash, _ := txWrap.Tx.TxHash(); !tx_pool.isTransactionInPool(hash) {
+			continue
+		}
+		expired += len(tx_pool.evictTx(txWrap.Tx, types.TxRemoveExpired))
+	}
+	if expired > 0 {
+		logger.Infof("Expired %d txs in pool", expired)
+	}
+}
+
+// wrapSize returns the size of a tx in pool
+func wrapSize(txWrap *chain.TxWrap) int64 {
+	size, _ := txWrap.Tx.SerializeSize()
+	return int64(size)
+}
@@ -0,0 +1,90 @@
+// Copyright (c) 2018 ContentBox Authors.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package txpool
+
+import (
+	"testing"
+	"time"
+
+	"github.com/BOXFoundation/boxd/boxd/eventbus"
+	"github.com/BOXFoundation/boxd/core/chain"
+	"github.com/BOXFoundation/boxd/core/types"
+	"github.com/BOXFoundation/boxd/p2p"
+	"github.com/facebookgo/ensure"
+)
+
+func TestEvictionFee(t *testing.T) {
+	var f evictionFee
+	now := time.Now()
+	ensure.DeepEqual(t, f.at(now), uint64(0))
+
+	f.raise(3000, now)
+	ensure.DeepEqual(t, f.at(now), uint64(3000+incrementalRelayFee))
+	// lower evicted fee rates don't lower it
+	f.raise(1000, now)
+	ensure.DeepEqual(t, f.at(now), uint64(3000+incrementalRelayFee))
+
+	ensure.DeepEqual(t, f.at(now.Add(evictionFeeHalflife)), uint64(2000))
+	ensure.DeepEqual(t, f.at(now.Add(2*evictionFeeHalflife)), uint64(1000))
+	ensure.DeepEqual(t, f.at(now.Add(64*evictionFeeHalflife)), uint64(0))
+}
+
+func TestLimitSize(t *testing.T) {
+	pool := NewTransactionPool(proc, p2p.NewDummyPeer(), chain.NewTestBlockChain(), bus, &Config{MaxTxs: 2})
+	add := func(tx *types.Transaction, fee uint64) {
+		size, _ := tx.SerializeSize()
+		pool.addTx(tx, chainHeight, fee, size)
+	}
+	coinbase, _ := chain.CreateCoinbaseTx(addr.Hash(), chainHeight+1)
+	// a <- b, with a paying the least
+	a := createChildTx(tx0)
+	b := createChildTx(a)
+	c := createChildTx(coinbase)
+	add(a, 10)
+	add(b, 1000)
+	add(c, 500)
+	ensure.True(t, pool.full())
+
+	evicted := pool.limitSize()
+	ensure.DeepEqual(t, len(evicted), 2)
+	ensure.False(t, pool.isTransactionInPool(getTxHash(a)))
+	ensure.False(t, pool.isTransactionInPool(getTxHash(b)))
+	ensure.True(t, pool.isTransactionInPool(getTxHash(c)))
+	ensure.False(t, pool.full())
+	size, _ := c.SerializeSize()
+	ensure.DeepEqual(t, pool.size, int64(size))
+	ensure.True(t, pool.minFeePerKB(time.Now()) > incrementalRelayFee)
+}
+
+func TestExpireTxs(t *testing.T) {
+	pool := NewTransactionPool(proc, p2p.NewDummyPeer(), chain.NewTestBlockChain(), bus, &Config{Expiry: time.Hour})
+	add := func(tx *types.Transaction) {
+		size, _ := tx.SerializeSize()
+		pool.addTx(tx, chainHeight, 1000, size)
+	}
+	var removed []types.TxRemoveReason
+	onTxRemoved := func(tx *types.Transaction, reason types.TxRemoveReason) {
+		removed = append(removed, reason)
+	}
+	bus.Subscribe(eventbus.TopicMempoolTxRemoved, onTxRemoved)
+	defer bus.Unsubscribe(eventbus.TopicMempoolTxRemoved, onTxRemoved)
+
+	// a <- b, with a added too long ago
+	coinbase, _ := chain.CreateCoinbaseTx(addr.Hash(), chainHeight+1)
+	a := createChildTx(tx0)
+	b := createChildTx(a)
+	c := createChildTx(coinbase)
+	add(a)
+	add(b)
+	add(c)
+	v, _ := pool.hashToTx.Load(*getTxHash(a))
+	v.(*chain.TxWrap).AddedTimestamp -= int64(2 * time.Hour / time.Second)
+
+	pool.expireTxs(time.Now())
+	ensure.False(t, pool.isTransactionInPool(getTxHash(a)))
+	ensure.False(t, pool.isTransactionInPool(getTxHash(b)))
+	ensure.True(t, pool.isTransactionInPool(getTxHash(c)))
+	ensure.DeepEqual(t, removed, []types.TxRemoveReason{types.TxRemoveExpired, types.TxRemoveExpired})
+}
@@ -0,0 +1,106 @@
+// Copyright (c) 2018 ContentBox Authors.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package txpool
+
+import (
+	"time"
+
+	"github.com/BOXFoundation/boxd/core"
+	"github.com/BOXFoundation/boxd/core/types"
+	"github.com/BOXFoundation/boxd/crypto"
+	peer "github.com/libp2p/go-libp2p-peer"
+)
+
+const (
+	// maxOrphans bounds the orphan pool, whose oldest orphans are evicted
+	// for new ones when full
+	maxOrphans = 1000
+	// maxOrphansPerPeer bounds orphans from a peer, not to fill the pool
+	maxOrphansPerPeer = 100
+	// maxOrphanSize is the max size of orphans held, not to waste memory on
+	// large txs without known parents
+	maxOrphanSize = 100000
+	// orphanTTL is how long an orphan waits for its parents
+	orphanTTL = 20 * time.Minute
+	// expireInterval is the interval to remove expired orphans and txs
+	expireInterval = 5 * time.Minute
+)
+
+// orphanTx is a tx held in orphan pool till its parents appear
+type orphanTx struct {
+	tx        *types.Transaction
+	from      peer.ID // empty if local
+	expiresAt time.Time
+}
+
+// checkOrphan checks if a new orphan tx of size from peer can be held
+func (tx_pool *TransactionPool) checkOrphan(size int, from peer.ID) error {
+	if size > maxOrphanSize {
+		return core.ErrOrphanTooLarge
+	}
+	if from == "" {
+		return nil
+	}
+	tx_pool.orphanMutex.Lock()
+	defer tx_pool.orphanMutex.Unlock()
+	if tx_pool.orphansFrom[from] >= maxOrphansPerPeer {
+		return core.ErrOrphanQuotaExceeded
+	}
+	return nil
+}
+
+// countOrphan adds delta to the number of orphans from peer
+func (tx_pool *TransactionPool) countOrphan(from peer.ID, delta int) {
+	if from == "" {
+		return
+	}
+	tx_pool.orphanMutex.Lock()
+	defer tx_pool.orphanMutex.Unlock()
+	if tx_pool.orphansFrom[from] += delta; tx_pool.orphansFrom[from] <= 0 {
+		delete(tx_pool.orphansFrom, from)
+	}
+}
+
+// limitOrphans evicts the oldest orphans till there is room for a new one
+func (tx_pool *TransactionPool) limitOrphans() {
+	for lengthOfSyncMap(tx_pool.hashToOrphanTx) >= maxOrphans {
+		var oldest *orphanTx
+		tx_pool.hashToOrphanTx.Range(func(k, v interface{}) bool {
+			if orphan := v.(*orphanTx); oldest == nil || orphan.expiresAt.Before(oldest.expiresAt) {
+				oldest = orphan
+			}
+			return true
+		})
+		if oldest == nil {
+			return
+		}
+		tx_pool.removeOrphan(oldest.tx)
+	}
+}
+
+// expireOrphans removes orphans whose parents don't appear in time
+func (tx_pool *TransactionPool) expireOrphans(now time.Time) {
+	var expired []*types.Transaction
+	tx_pool.hashToOrphanTx.Range(func(k, v interface{}) bool {
+		if orphan := v.(*orphanTx); !now.Before(orphan.expiresAt) {
+			expired = append(expired, orphan.tx)
+		}
+		return true
+	})
+	for _, tx := range expired {
+		tx_pool.removeOrphan(tx)
+	}
+	if len(expired) > 0 {
+		logger.Debugf("Removed %d expired orphan transactions", len(expired))
+	}
+}
+
+// getOrphan returns the orphan with txHash
+func (tx_pool *TransactionPool) getOrphan(txHash *crypto.HashType) (*orphanTx, bool) {
+	if v, exists := tx_pool.hashToOrphanTx.Load(*txHash); exists {
+		return v.(*orphanTx), true
+	}
+	return nil, false
+}
@@ -0,0 +1,54 @@
+// Copyright (c) 2018 ContentBox Authors.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package txpool
+
+import (
+	"testing"
+	"time"
+
+	"github.com/BOXFoundation/boxd/core"
+	"github.com/BOXFoundation/boxd/core/chain"
+	"github.com/BOXFoundation/boxd/p2p"
+	"github.com/facebookgo/ensure"
+	peer "github.com/libp2p/go-libp2p-peer"
+)
+
+func TestOrphanQuota(t *testing.T) {
+	pool := NewTransactionPool(proc, p2p.NewDummyPeer(), chain.NewTestBlockChain(), bus, &Config{})
+	from := peer.ID("peer")
+	parent := createChildTx(tx0)
+	orphan := createChildTx(parent)
+
+	ensure.DeepEqual(t, pool.checkOrphan(maxOrphanSize+1, from), core.ErrOrphanTooLarge)
+	pool.orphansFrom[from] = maxOrphansPerPeer
+	ensure.DeepEqual(t, pool.addOrphan(orphan, from), core.ErrOrphanQuotaExceeded)
+	ensure.False(t, pool.isOrphanInPool(getTxHash(orphan)))
+	// local orphans aren't limited by quotas
+	ensure.Nil(t, pool.addOrphan(orphan, ""))
+	ensure.True(t, pool.isOrphanInPool(getTxHash(orphan)))
+
+	pool.orphansFrom[from] = 0
+	other := createChildTx(orphan)
+	ensure.Nil(t, pool.addOrphan(other, from))
+	ensure.DeepEqual(t, pool.orphansFrom[from], 1)
+	pool.removeOrphan(other)
+	_, counted := pool.orphansFrom[from]
+	ensure.False(t, counted)
+}
+
+func TestExpireOrphans(t *testing.T) {
+	pool := NewTransactionPool(proc, p2p.NewDummyPeer(), chain.NewTestBlockChain(), bus, &Config{})
+	parent := createChildTx(tx0)
+	orphan := createChildTx(parent)
+	ensure.Nil(t, pool.addOrphan(orphan, peer.ID("peer")))
+
+	pool.expireOrphans(time.Now())
+	ensure.True(t, pool.isOrphanInPool(getTxHash(orphan)))
+	pool.expireOrphans(time.Now().Add(orphanTTL))
+	ensure.False(t, pool.isOrphanInPool(getTxHash(orphan)))
+	ensure.DeepEqual(t, len(pool.orphansFrom), 0)
+	_, exists := pool.outPointToOrphan.Load(orphan.Vin[0].PrevOutPoint)
+	ensure.False(t, exists)
+}
@@ -0,0 +1,191 @@
+// Copyright (c) 2018 ContentBox Authors.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package txpool
+
+import (
+	"container/heap"
+
+	"github.com/BOXFoundation/boxd/core/chain"
+	"github.com/BOXFoundation/boxd/core/types"
+	"github.com/BOXFoundation/boxd/crypto"
+	"github.com/BOXFoundation/boxd/util"
+)
+
+// txPackage is a tx with its ancestors not selected into a block yet
+type txPackage struct {
+	txWrap *chain.TxWrap
+	fee    uint64
+	size   int64
+}
+
+// feePerKB returns the fee rate of the package as a whole
+func (p *txPackage) feePerKB() uint64 {
+	return calcFeePerKB(p.fee, int(p.size))
+}
+
+// lessPackage orders packages with higher fee rate first, and earlier added
+// first for equal fee rates
+func lessPackage(pq *util.PriorityQueue, i, j int) bool {
+	pi, pj := pq.Items(i).(*txPackage), pq.Items(j).(*txPackage)
+	if fi, fj := pi.feePerKB(), pj.feePerKB(); fi != fj {
+		return fi > fj
+	}
+	return pi.txWrap.AddedTimestamp < pj.txWrap.AddedTimestamp
+}
+
+// ancestors returns txs in pool tx spends outputs of, directly or not, with
+// parents before their children. tx itself need not be in pool
+func (tx_pool *TransactionPool) ancestors(tx *types.Transaction) []*chain.TxWrap {
+	var ancestors []*chain.TxWrap
+	visited := make(map[crypto.HashType]bool)
+	var visit func(tx *types.Transaction)
+	visit = func(tx *types.Transaction) {
+		for _, txIn := range tx.Vin {
+			parentHash := txIn.PrevOutPoint.Hash
+			if visited[parentHash] {
+				continue
+			}
+			v, exists := tx_pool.hashToTx.Load(parentHash)
+			if !exists {
+				continue
+			}
+			visited[parentHash] = true
+			parent := v.(*chain.TxWrap)
+			visit(parent.Tx)
+			ancestors = append(ancestors, parent)
+		}
+	}
+	visit(tx)
+	return ancestors
+}
+
+// descendants returns txs in pool spending outputs of tx, directly or not
+func (tx_pool *TransactionPool) descendants(tx *types.Transaction) []*chain.TxWrap {
+	var descendants []*chain.TxWrap
+	visited := make(map[crypto.HashType]bool)
+	txs := []*types.Transaction{tx}
+	// Note: use index here instead of range because txs can be extended inside the loop
+	for i := 0; i < len(txs); i++ {
+		txHash, _ := txs[i].TxHash()
+		outPoint := types.OutPoint{Hash: *txHash}
+		for txOutIdx := range txs[i].Vout {
+			outPoint.Index = uint32(txOutIdx)
+			childTx, exists := tx_pool.findTransaction(outPoint)
+			if !exists {
+				continue
+			}
+			childHash, _ := childTx.TxHash()
+			if visited[*childHash] {
+				continue
+			}
+			visited[*childHash] = true
+			if v, exists := tx_pool.hashToTx.Load(*childHash); exists {
+				descendants = append(descendants, v.(*chain.TxWrap))
+			}
+			txs = append(txs, childTx)
+		}
+	}
+	return descendants
+}
+
+// updatePackages adds txWrap's fee and size to ancestor totals of its
+// descendants and descendant totals of its ancestors if it enters the pool,
+// or subtracts them if it leaves. Its own totals are initialized on entering
+func (tx_pool *TransactionPool) updatePackages(txWrap *chain.TxWrap, entering bool) {
+	size := wrapSize(txWrap)
+	ancestors, descendants := tx_pool.ancestors(txWrap.Tx), tx_pool.descendants(txWrap.Tx)
+	if entering {
+		txWrap.AncestorFee, txWrap.AncestorSize = txWrap.Fee, size
+		txWrap.DescendantFee, txWrap.DescendantSize = txWrap.Fee, size
+		for _, ancestor := range ancestors {
+			txWrap.AncestorFee += ancestor.Fee
+			txWrap.AncestorSize += wrapSize(ancestor)
+		}
+		for _, descendant := range descendants {
+			txWrap.DescendantFee += descendant.Fee
+			txWrap.DescendantSize += wrapSize(descendant)
+		}
+	}
+	for _, ancestor := range ancestors {
+		if entering {
+			ancestor.DescendantFee += txWrap.Fee
+			ancestor.DescendantSize += size
+		} else {
+			ancestor.DescendantFee -= txWrap.Fee
+			ancestor.DescendantSize -= size
+		}
+	}
+	for _, descendant := range descendants {
+		if entering {
+			descendant.AncestorFee += txWrap.Fee
+			descendant.AncestorSize += size
+		} else {
+			descendant.AncestorFee -= txWrap.Fee
+			descendant.AncestorSize -= size
+		}
+	}
+}
+
+// GetTxsByPackageFeeRate returns all transactions in mempool in the order
+// blocks should include them: the tx whose package, itself and ancestors
+// not included yet, pays the highest fee rate goes first, preceded by those
+// ancestors. So a child paying a high fee pulls its cheap parents along, and
+// parents always come before their children
+func (tx_pool *TransactionPool) GetTxsByPackageFeeRate() []*chain.TxWrap {
+	tx_pool.txMutex.Lock()
+	defer tx_pool.txMutex.Unlock()
+
+	txs := tx_pool.GetAllTxs()
+	// txHash -> package of the tx, updated as its ancestors are included
+	packages := make(map[crypto.HashType]*txPackage, len(txs))
+	pq := util.NewPriorityQueue(lessPackage)
+	for _, txWrap := range txs {
+		txHash, _ := txWrap.Tx.TxHash()
+		pkg := &txPackage{txWrap: txWrap, fee: txWrap.AncestorFee, size: txWrap.AncestorSize}
+		packages[*txHash] = pkg
+		heap.Push(pq, pkg)
+	}
+
+	sorted := make([]*chain.TxWrap, 0, len(txs))
+	included := make(map[crypto.HashType]bool, len(txs))
+	for pq.Len() > 0 {
+		pkg := heap.Pop(pq).(*txPackage)
+		txHash, _ := pkg.txWrap.Tx.TxHash()
+		// skip included txs and outdated packages, the updated ones are
+		// pushed again
+		if included[*txHash] || packages[*txHash] != pkg {
+			continue
+		}
+		var pkgTxs []*chain.TxWrap
+		for _, ancestor := range tx_pool.ancestors(pkg.txWrap.Tx) {
+			if hash, _ := ancestor.Tx.TxHash(); !included[*hash] {
+				pkgTxs = append(pkgTxs, ancestor)
+			}
+		}
+		pkgTxs = append(pkgTxs, pkg.txWrap)
+		for _, txWrap := range pkgTxs {
+			hash, _ := txWrap.Tx.TxHash()
+			included[*hash] = true
+			sorted = append(sorted, txWrap)
+		}
+		// included txs no longer count in packages of their descendants
+		for _, txWrap := range pkgTxs {
+			for _, descendant := range tx_pool.descendants(txWrap.Tx) {
+				hash, _ := descendant.Tx.TxHash()
+				if included[*hash] {
+					continue
+				}
+				old, exists := packages[*hash]
+				if !exists {
+					continue
+				}
+				updated := &txPackage{txWrap: descendant, fee: old.fee - txWrap.Fee, size: old.size - wrapSize(txWrap)}
+				packages[*hash] = updated
+				heap.Push(pq, updated)
+			}
+		}
+	}
+	return sorted
+}
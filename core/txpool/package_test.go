@@ -0,0 +1,57 @@
+// Copyright (c) 2018 ContentBox Authors.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package txpool
+
+import (
+	"testing"
+
+	"github.com/BOXFoundation/boxd/core/chain"
+	"github.com/BOXFoundation/boxd/core/types"
+	"github.com/BOXFoundation/boxd/p2p"
+	"github.com/facebookgo/ensure"
+)
+
+func TestPackageFeeRate(t *testing.T) {
+	pool := NewTransactionPool(proc, p2p.NewDummyPeer(), chain.NewTestBlockChain(), bus, &Config{})
+	add := func(tx *types.Transaction, fee uint64) {
+		size, _ := tx.SerializeSize()
+		pool.addTx(tx, chainHeight, fee, size)
+	}
+	wrap := func(tx *types.Transaction) *chain.TxWrap {
+		v, _ := pool.hashToTx.Load(*getTxHash(tx))
+		return v.(*chain.TxWrap)
+	}
+	coinbase, _ := chain.CreateCoinbaseTx(addr.Hash(), chainHeight+1)
+	// a <- b, with a cheap parent a and a child b paying more than c
+	a := createChildTx(tx0)
+	b := createChildTx(a)
+	c := createChildTx(coinbase)
+	add(a, 10)
+	add(c, 500)
+	add(b, 1000)
+
+	size := wrapSize(wrap(a))
+	ensure.DeepEqual(t, wrap(a).DescendantFee, uint64(1010))
+	ensure.DeepEqual(t, wrap(a).DescendantSize, 2*size)
+	ensure.DeepEqual(t, wrap(b).AncestorFee, uint64(1010))
+	ensure.DeepEqual(t, wrap(b).AncestorSize, 2*size)
+	ensure.DeepEqual(t, wrap(c).AncestorFee, uint64(500))
+
+	// b pulls a ahead of c
+	txs := pool.GetTxsByPackageFeeRate()
+	ensure.DeepEqual(t, len(txs), 3)
+	ensure.DeepEqual(t, txs[0].Tx, a)
+	ensure.DeepEqual(t, txs[1].Tx, b)
+	ensure.DeepEqual(t, txs[2].Tx, c)
+
+	// a confirmed parent no longer counts
+	pool.removeTx(a, types.TxRemoveConfirmed, false /* non-recursive */)
+	ensure.DeepEqual(t, wrap(b).AncestorFee, uint64(1000))
+	ensure.DeepEqual(t, wrap(b).AncestorSize, wrapSize(wrap(b)))
+	// a parent back in pool counts again
+	add(a, 10)
+	ensure.DeepEqual(t, wrap(a).DescendantFee, uint64(1010))
+	ensure.DeepEqual(t, wrap(b).AncestorFee, uint64(1010))
+}
@@ -0,0 +1,102 @@
+// Copyright (c) 2018 ContentBox Authors.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package txpool
+
+import (
+	"sync"
+	"time"
+
+	"github.com/BOXFoundation/boxd/core/chain"
+	"github.com/BOXFoundation/boxd/core/types"
+	"github.com/BOXFoundation/boxd/script"
+)
+
+const (
+	// txRateWindow is the time window txs from a peer and txs spending from
+	// an address are counted in
+	txRateWindow = time.Minute
+	// defaultMaxTxsPerPeer and defaultMaxTxsPerAddr bound txs in a window
+	// if not configured
+	defaultMaxTxsPerPeer = 600
+	defaultMaxTxsPerAddr = 60
+)
+
+func (cfg *Config) maxTxsPerPeer() uint32 {
+	if cfg.MaxTxsPerPeer == 0 {
+		return defaultMaxTxsPerPeer
+	}
+	return cfg.MaxTxsPerPeer
+}
+
+func (cfg *Config) maxTxsPerAddr() uint32 {
+	if cfg.MaxTxsPerAddr == 0 {
+		return defaultMaxTxsPerAddr
+	}
+	return cfg.MaxTxsPerAddr
+}
+
+// txRateCounter counts txs by key in fixed time windows, counts are dropped
+// as a new window starts
+type txRateCounter struct {
+	mtx         sync.Mutex
+	windowStart time.Time
+	counts      map[string]uint32
+}
+
+func newTxRateCounter() *txRateCounter {
+	return &txRateCounter{counts: make(map[string]uint32)}
+}
+
+// roll starts a new window if the current one is over. It's called with
+// mtx held
+func (c *txRateCounter) roll(now time.Time) {
+	if now.Sub(c.windowStart) >= txRateWindow {
+		c.windowStart = now
+		c.counts = make(map[string]uint32)
+	}
+}
+
+// allow checks if none of keys reaches limit in the current window
+func (c *txRateCounter) allow(keys []string, limit uint32, now time.Time) bool {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	c.roll(now)
+	for _, key := range keys {
+		if c.counts[key] >= limit {
+			return false
+		}
+	}
+	return true
+}
+
+// add counts a tx for each of keys in the current window
+func (c *txRateCounter) add(keys []string, now time.Time) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	c.roll(now)
+	for _, key := range keys {
+		c.counts[key]++
+	}
+}
+
+// spentAddrs returns addresses owning outputs tx spends, each once. Outputs
+// of non-standard scripts are skipped
+func spentAddrs(utxoSet *chain.UtxoSet, tx *types.Transaction) []string {
+	var addrs []string
+	seen := make(map[string]bool)
+	for _, txIn := range tx.Vin {
+		utxo := utxoSet.FindUtxo(txIn.PrevOutPoint)
+		if utxo == nil {
+			continue
+		}
+		addr, err := script.NewScriptFromBytes(utxo.Output.ScriptPubKey).ExtractAddress()
+		if err != nil || seen[addr.String()] {
+			continue
+		}
+		seen[addr.String()] = true
+		addrs = append(addrs, addr.String())
+	}
+	return addrs
+}
@@ -0,0 +1,29 @@
+// Copyright (c) 2018 ContentBox Authors.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package txpool
+
+import (
+	"testing"
+	"time"
+
+	"github.com/facebookgo/ensure"
+)
+
+func TestTxRateCounter(t *testing.T) {
+	c := newTxRateCounter()
+	now := time.Now()
+	alice, bob := []string{"alice"}, []string{"alice", "bob"}
+	ensure.True(t, c.allow(alice, 2, now))
+	c.add(alice, now)
+	c.add(bob, now)
+	ensure.False(t, c.allow(alice, 2, now))
+	// any key reaching the limit rejects
+	ensure.False(t, c.allow(bob, 2, now))
+	ensure.True(t, c.allow([]string{"bob"}, 2, now))
+
+	// counts are dropped in a new window
+	ensure.True(t, c.allow(alice, 2, now.Add(txRateWindow)))
+	ensure.DeepEqual(t, len(c.counts), 0)
+}
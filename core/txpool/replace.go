@@ -0,0 +1,116 @@
+// Copyright (c) 2018 ContentBox Authors.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package txpool
+
+import (
+	"math"
+
+	"github.com/BOXFoundation/boxd/core"
+	"github.com/BOXFoundation/boxd/core/chain"
+	"github.com/BOXFoundation/boxd/core/types"
+	"github.com/BOXFoundation/boxd/crypto"
+)
+
+const (
+	// maxReplaceableSequence is the sequence of inputs below which a tx
+	// signals it may be replaced by a conflicting one paying more, as BIP125
+	maxReplaceableSequence = math.MaxUint32 - 1
+	// maxReplacements bounds txs, conflicts and their descendants, a
+	// replacement evicts
+	maxReplacements = 100
+)
+
+// signalsReplacement checks if tx opts in to be replaced
+func signalsReplacement(tx *types.Transaction) bool {
+	for _, txIn := range tx.Vin {
+		if txIn.Sequence < maxReplaceableSequence {
+			return true
+		}
+	}
+	return false
+}
+
+// replacedTxs returns txs in pool tx replaces, the ones spending the same
+// outpoints and their descendants. Conflicts must signal replacement
+func (tx_pool *TransactionPool) replacedTxs(tx *types.Transaction) ([]*chain.TxWrap, error) {
+	var replaced []*types.Transaction
+	seen := make(map[crypto.HashType]bool)
+	for _, txIn := range tx.Vin {
+		conflict, exists := tx_pool.findTransaction(txIn.PrevOutPoint)
+		if !exists {
+			continue
+		}
+		if !signalsReplacement(conflict) {
+			return nil, core.ErrOutPutAlreadySpent
+		}
+		if hash, _ := conflict.TxHash(); !seen[*hash] {
+			seen[*hash] = true
+			replaced = append(replaced, conflict)
+		}
+	}
+	// Note: use index here instead of range because replaced can be extended inside the loop
+	for i := 0; i < len(replaced); i++ {
+		replacedHash, _ := replaced[i].TxHash()
+		outPoint := types.OutPoint{Hash: *replacedHash}
+		for txOutIdx := range replaced[i].Vout {
+			outPoint.Index = uint32(txOutIdx)
+			childTx, exists := tx_pool.findTransaction(outPoint)
+			if !exists {
+				continue
+			}
+			if hash, _ := childTx.TxHash(); !seen[*hash] {
+				seen[*hash] = true
+				replaced = append(replaced, childTx)
+			}
+		}
+		if len(replaced) > maxReplacements {
+			return nil, core.ErrTooManyReplacements
+		}
+	}
+
+	txWraps := make([]*chain.TxWrap, 0, len(replaced))
+	for _, replacedTx := range replaced {
+		hash, _ := replacedTx.TxHash()
+		if v, exists := tx_pool.hashToTx.Load(*hash); exists {
+			txWraps = append(txWraps, v.(*chain.TxWrap))
+		}
+	}
+	return txWraps, nil
+}
+
+// checkReplacement checks if tx of size paying fee can replace txs. It must
+// not spend their outputs, must pay a higher fee rate than each of them, and
+// must pay for them and its own relay at the incremental relay fee
+func checkReplacement(tx *types.Transaction, fee uint64, size int, replaced []*chain.TxWrap) error {
+	replacedHashes := make(map[crypto.HashType]bool, len(replaced))
+	var replacedFee uint64
+	feePerKB := calcFeePerKB(fee, size)
+	for _, txWrap := range replaced {
+		hash, _ := txWrap.Tx.TxHash()
+		replacedHashes[*hash] = true
+		replacedFee += txWrap.Fee
+		if feePerKB <= txWrap.FeePerKB {
+			return core.ErrInsufficientReplacementFee
+		}
+	}
+	for _, txIn := range tx.Vin {
+		if replacedHashes[txIn.PrevOutPoint.Hash] {
+			return core.ErrReplacementSpendsConflict
+		}
+	}
+	if fee < replacedFee+calcRequiredMinFee(size, incrementalRelayFee) {
+		return core.ErrInsufficientReplacementFee
+	}
+	return nil
+}
+
+// replaceTxs removes replaced txs from pool, publishing their replacement by
+// tx for wallets to update
+func (tx_pool *TransactionPool) replaceTxs(replaced []*chain.TxWrap, tx *types.Transaction) {
+	for _, txWrap := range replaced {
+		tx_pool.removeTx(txWrap.Tx, types.TxRemoveReplaced, false /* non-recursive */)
+		PublishTxReplaced(tx_pool.bus, txWrap.Tx, tx)
+	}
+}
@@ -0,0 +1,110 @@
+// Copyright (c) 2018 ContentBox Authors.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package txpool
+
+import (
+	"math"
+	"testing"
+
+	"github.com/BOXFoundation/boxd/core"
+	"github.com/BOXFoundation/boxd/core/chain"
+	"github.com/BOXFoundation/boxd/core/types"
+	"github.com/BOXFoundation/boxd/p2p"
+	"github.com/facebookgo/ensure"
+)
+
+func TestSignalsReplacement(t *testing.T) {
+	tx := createChildTx(tx0)
+	ensure.True(t, signalsReplacement(tx))
+	tx.Vin[0].Sequence = maxReplaceableSequence
+	ensure.False(t, signalsReplacement(tx))
+	tx.Vin[0].Sequence = math.MaxUint32
+	ensure.False(t, signalsReplacement(tx))
+}
+
+func TestDefaultTxNotReplaceable(t *testing.T) {
+	pool := NewTransactionPool(proc, p2p.NewDummyPeer(), chain.NewTestBlockChain(), bus, &Config{})
+	// inputs are built final by default
+	a := createChildTx(tx0)
+	for _, txIn := range a.Vin {
+		txIn.Sequence = types.SequenceFinal
+	}
+	size, _ := a.SerializeSize()
+	pool.addTx(a, chainHeight, 1000, size)
+
+	c := createChildTx(tx0)
+	c.Vout[0].Value--
+	_, err := pool.replacedTxs(c)
+	ensure.DeepEqual(t, err, core.ErrOutPutAlreadySpent)
+
+	// only txs opting in are replaced
+	a.Vin[0].Sequence = types.SequenceReplaceable
+	ensure.True(t, signalsReplacement(a))
+	replaced, err := pool.replacedTxs(c)
+	ensure.Nil(t, err)
+	ensure.DeepEqual(t, len(replaced), 1)
+}
+
+func TestReplacedTxs(t *testing.T) {
+	pool := NewTransactionPool(proc, p2p.NewDummyPeer(), chain.NewTestBlockChain(), bus, &Config{})
+	add := func(tx *types.Transaction, fee uint64) {
+		size, _ := tx.SerializeSize()
+		pool.addTx(tx, chainHeight, fee, size)
+	}
+	// a <- b in pool, c double spends a
+	a := createChildTx(tx0)
+	b := createChildTx(a)
+	add(a, 1000)
+	add(b, 1000)
+	c := createChildTx(tx0)
+	c.Vout[0].Value--
+
+	replaced, err := pool.replacedTxs(c)
+	ensure.Nil(t, err)
+	ensure.DeepEqual(t, len(replaced), 2)
+	ensure.DeepEqual(t, getTxHash(replaced[0].Tx), getTxHash(a))
+	ensure.DeepEqual(t, getTxHash(replaced[1].Tx), getTxHash(b))
+
+	size, _ := c.SerializeSize()
+	ensure.DeepEqual(t, checkReplacement(c, 1500, size, replaced), core.ErrInsufficientReplacementFee)
+	ensure.Nil(t, checkReplacement(c, 2000+uint64(size), size, replaced))
+	// spending a replaced tx is not allowed
+	ensure.DeepEqual(t, checkReplacement(b, 1<<20, size, replaced), core.ErrReplacementSpendsConflict)
+
+	// conflicts not signaling can't be replaced
+	a.Vin[0].Sequence = math.MaxUint32
+	_, err = pool.replacedTxs(c)
+	ensure.DeepEqual(t, err, core.ErrOutPutAlreadySpent)
+}
+
+func TestConflictedTxs(t *testing.T) {
+	pool := NewTransactionPool(proc, p2p.NewDummyPeer(), chain.NewTestBlockChain(), bus, &Config{})
+	add := func(tx *types.Transaction) {
+		size, _ := tx.SerializeSize()
+		pool.addTx(tx, chainHeight, 1000, size)
+	}
+	var conflicted, by []*types.Transaction
+	onTxConflicted := func(tx, confirmed *types.Transaction) {
+		conflicted = append(conflicted, tx)
+		by = append(by, confirmed)
+	}
+	SubscribeTxConflicted(bus, onTxConflicted)
+	defer UnsubscribeTxConflicted(bus, onTxConflicted)
+
+	// a <- b in pool, c double spending a is confirmed
+	a := createChildTx(tx0)
+	b := createChildTx(a)
+	add(a)
+	add(b)
+	c := createChildTx(tx0)
+	c.Vout[0].Value--
+
+	pool.removeDoubleSpendTxs(c)
+	ensure.False(t, pool.isTransactionInPool(getTxHash(a)))
+	ensure.False(t, pool.isTransactionInPool(getTxHash(b)))
+	// only the tx double spent directly is conflicted by c
+	ensure.DeepEqual(t, conflicted, []*types.Transaction{a})
+	ensure.DeepEqual(t, by, []*types.Transaction{c})
+}
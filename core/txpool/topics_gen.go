@@ -0,0 +1,132 @@
+// Code generated by gentopics. DO NOT EDIT.
+
+package txpool
+
+import (
+	"reflect"
+
+	"github.com/BOXFoundation/boxd/boxd/eventbus"
+	"github.com/BOXFoundation/boxd/core/types"
+)
+
+func init() {
+	eventbus.RegisterTopic(eventbus.TopicMempoolTxAdded,
+		reflect.TypeOf((**types.Transaction)(nil)).Elem())
+	eventbus.RegisterTopic(eventbus.TopicMempoolTxRemoved,
+		reflect.TypeOf((**types.Transaction)(nil)).Elem(),
+		reflect.TypeOf((*types.TxRemoveReason)(nil)).Elem())
+	eventbus.RegisterTopic(eventbus.TopicTxReplaced,
+		reflect.TypeOf((**types.Transaction)(nil)).Elem(),
+		reflect.TypeOf((**types.Transaction)(nil)).Elem())
+	eventbus.RegisterTopic(eventbus.TopicTxConflicted,
+		reflect.TypeOf((**types.Transaction)(nil)).Elem(),
+		reflect.TypeOf((**types.Transaction)(nil)).Elem())
+}
+
+// SubscribeMempoolTxAdded subscribes fn to eventbus.TopicMempoolTxAdded
+func SubscribeMempoolTxAdded(bus eventbus.BusSubscriber, fn func(*types.Transaction)) error {
+	return bus.Subscribe(eventbus.TopicMempoolTxAdded, fn)
+}
+
+// SubscribeMempoolTxAddedAsync subscribes fn to eventbus.TopicMempoolTxAdded, called
+// asynchronously, serially if transactional
+func SubscribeMempoolTxAddedAsync(bus eventbus.BusSubscriber, fn func(*types.Transaction), transactional bool) error {
+	return bus.SubscribeAsync(eventbus.TopicMempoolTxAdded, fn, transactional)
+}
+
+// SubscribeMempoolTxAddedQueued subscribes fn to eventbus.TopicMempoolTxAdded, called
+// in the goroutine of queue
+func SubscribeMempoolTxAddedQueued(bus eventbus.BusSubscriber, fn func(*types.Transaction), queue *eventbus.Queue) error {
+	return bus.SubscribeQueued(eventbus.TopicMempoolTxAdded, fn, queue)
+}
+
+// UnsubscribeMempoolTxAdded unsubscribes fn from eventbus.TopicMempoolTxAdded
+func UnsubscribeMempoolTxAdded(bus eventbus.BusSubscriber, fn func(*types.Transaction)) error {
+	return bus.Unsubscribe(eventbus.TopicMempoolTxAdded, fn)
+}
+
+// PublishMempoolTxAdded publishes on eventbus.TopicMempoolTxAdded
+func PublishMempoolTxAdded(bus eventbus.BusPublisher, tx *types.Transaction) {
+	bus.Publish(eventbus.TopicMempoolTxAdded, tx)
+}
+
+// SubscribeMempoolTxRemoved subscribes fn to eventbus.TopicMempoolTxRemoved
+func SubscribeMempoolTxRemoved(bus eventbus.BusSubscriber, fn func(*types.Transaction, types.TxRemoveReason)) error {
+	return bus.Subscribe(eventbus.TopicMempoolTxRemoved, fn)
+}
+
+// SubscribeMempoolTxRemovedAsync subscribes fn to eventbus.TopicMempoolTxRemoved, called
+// asynchronously, serially if transactional
+func SubscribeMempoolTxRemovedAsync(bus eventbus.BusSubscriber, fn func(*types.Transaction, types.TxRemoveReason), transactional bool) error {
+	return bus.SubscribeAsync(eventbus.TopicMempoolTxRemoved, fn, transactional)
+}
+
+// SubscribeMempoolTxRemovedQueued subscribes fn to eventbus.TopicMempoolTxRemoved, called
+// in the goroutine of queue
+func SubscribeMempoolTxRemovedQueued(bus eventbus.BusSubscriber, fn func(*types.Transaction, types.TxRemoveReason), queue *eventbus.Queue) error {
+	return bus.SubscribeQueued(eventbus.TopicMempoolTxRemoved, fn, queue)
+}
+
+// UnsubscribeMempoolTxRemoved unsubscribes fn from eventbus.TopicMempoolTxRemoved
+func UnsubscribeMempoolTxRemoved(bus eventbus.BusSubscriber, fn func(*types.Transaction, types.TxRemoveReason)) error {
+	return bus.Unsubscribe(eventbus.TopicMempoolTxRemoved, fn)
+}
+
+// PublishMempoolTxRemoved publishes on eventbus.TopicMempoolTxRemoved
+func PublishMempoolTxRemoved(bus eventbus.BusPublisher, tx *types.Transaction, reason types.TxRemoveReason) {
+	bus.Publish(eventbus.TopicMempoolTxRemoved, tx, reason)
+}
+
+// SubscribeTxReplaced subscribes fn to eventbus.TopicTxReplaced
+func SubscribeTxReplaced(bus eventbus.BusSubscriber, fn func(*types.Transaction, *types.Transaction)) error {
+	return bus.Subscribe(eventbus.TopicTxReplaced, fn)
+}
+
+// SubscribeTxReplacedAsync subscribes fn to eventbus.TopicTxReplaced, called
+// asynchronously, serially if transactional
+func SubscribeTxReplacedAsync(bus eventbus.BusSubscriber, fn func(*types.Transaction, *types.Transaction), transactional bool) error {
+	return bus.SubscribeAsync(eventbus.TopicTxReplaced, fn, transactional)
+}
+
+// SubscribeTxReplacedQueued subscribes fn to eventbus.TopicTxReplaced, called
+// in the goroutine of queue
+func SubscribeTxReplacedQueued(bus eventbus.BusSubscriber, fn func(*types.Transaction, *types.Transaction), queue *eventbus.Queue) error {
+	return bus.SubscribeQueued(eventbus.TopicTxReplaced, fn, queue)
+}
+
+// UnsubscribeTxReplaced unsubscribes fn from eventbus.TopicTxReplaced
+func UnsubscribeTxReplaced(bus eventbus.BusSubscriber, fn func(*types.Transaction, *types.Transaction)) error {
+	return bus.Unsubscribe(eventbus.TopicTxReplaced, fn)
+}
+
+// PublishTxReplaced publishes on eventbus.TopicTxReplaced
+func PublishTxReplaced(bus eventbus.BusPublisher, tx *types.Transaction, by *types.Transaction) {
+	bus.Publish(eventbus.TopicTxReplaced, tx, by)
+}
+
+// SubscribeTxConflicted subscribes fn to eventbus.TopicTxConflicted
+func SubscribeTxConflicted(bus eventbus.BusSubscriber, fn func(*types.Transaction, *types.Transaction)) error {
+	return bus.Subscribe(eventbus.TopicTxConflicted, fn)
+}
+
+// SubscribeTxConflictedAsync subscribes fn to eventbus.TopicTxConflicted, called
+// asynchronously, serially if transactional
+func SubscribeTxConflictedAsync(bus eventbus.BusSubscriber, fn func(*types.Transaction, *types.Transaction), transactional bool) error {
+	return bus.SubscribeAsync(eventbus.TopicTxConflicted, fn, transactional)
+}
+
+// SubscribeTxConflictedQueued subscribes fn to eventbus.TopicTxConflicted, called
+// in the goroutine of queue
+func SubscribeTxConflictedQueued(bus eventbus.BusSubscriber, fn func(*types.Transaction, *types.Transaction), queue *eventbus.Queue) error {
+	return bus.SubscribeQueued(eventbus.TopicTxConflicted, fn, queue)
+}
+
+// UnsubscribeTxConflicted unsubscribes fn from eventbus.TopicTxConflicted
+func UnsubscribeTxConflicted(bus eventbus.BusSubscriber, fn func(*types.Transaction, *types.Transaction)) error {
+	return bus.Unsubscribe(eventbus.TopicTxConflicted, fn)
+}
+
+// PublishTxConflicted publishes on eventbus.TopicTxConflicted
+func PublishTxConflicted(bus eventbus.BusPublisher, tx *types.Transaction, by *types.Transaction) {
+	bus.Publish(eventbus.TopicTxConflicted, tx, by)
+}
@@ -5,8 +5,10 @@
 package txpool
 
 import (
-	"errors"
+	"context"
+	"sort"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/BOXFoundation/boxd/boxd/eventbus"
@@ -14,14 +16,18 @@ import (
 	"github.com/BOXFoundation/boxd/core"
 	"github.com/BOXFoundation/boxd/core/chain"
 	"github.com/BOXFoundation/boxd/core/metrics"
+	"github.com/BOXFoundation/boxd/core/policy"
 	"github.com/BOXFoundation/boxd/core/types"
 	"github.com/BOXFoundation/boxd/crypto"
 	"github.com/BOXFoundation/boxd/log"
 	"github.com/BOXFoundation/boxd/p2p"
 	"github.com/BOXFoundation/boxd/util"
 	"github.com/jbenet/goprocess"
+	peer "github.com/libp2p/go-libp2p-peer"
 )
 
+//go:generate go run ../../boxd/eventbus/gentopics -imports github.com/BOXFoundation/boxd/core/types MempoolTxAdded=tx:*types.Transaction MempoolTxRemoved=tx:*types.Transaction,reason:types.TxRemoveReason TxReplaced=tx:*types.Transaction,by:*types.Transaction TxConflicted=tx:*types.Transaction,by:*types.Transaction
+
 // const defines constants
 const (
 	TxMsgBufferChSize          = 65536
@@ -34,8 +40,32 @@ var logger = log.NewLogger("txpool") // logger
 
 var _ service.TxHandler = (*TransactionPool)(nil)
 
+// Config defines the configuration of transaction pool
+type Config struct {
+	// MinRelayFee is the minimum fee per 1000 bytes for a tx to be admitted
+	// and relayed
+	MinRelayFee uint64 `mapstructure:"min_relay_fee"`
+	// MaxSize and MaxTxs bound total bytes and number of txs in pool, over
+	// which txs with the lowest fee rates are evicted. Defaults are used if
+	// not set
+	MaxSize uint64 `mapstructure:"max_size"`
+	MaxTxs  uint32 `mapstructure:"max_txs"`
+	// MaxTxsPerPeer bounds txs a peer sends per minute, peers sending more
+	// are punished. MaxTxsPerAddr bounds txs from peers spending from an
+	// address admitted per minute. Defaults are used if not set
+	MaxTxsPerPeer uint32 `mapstructure:"max_txs_per_peer"`
+	MaxTxsPerAddr uint32 `mapstructure:"max_txs_per_addr"`
+	// Expiry is how long txs stay in pool without being mined, default is
+	// used if not set
+	Expiry time.Duration `mapstructure:"expiry"`
+	// Policy holds standardness rules txs must follow to be admitted and
+	// relayed
+	Policy policy.Config `mapstructure:"policy"`
+}
+
 // TransactionPool define struct.
 type TransactionPool struct {
+	size                int64 // total bytes of txs in pool, accessed atomically
 	notifiee            p2p.Net
 	newTxMsgCh          chan p2p.Message
 	newChainUpdateMsgCh chan *chain.UpdateMsg
@@ -48,17 +78,24 @@ type TransactionPool struct {
 	// types.OutPoint -> *types.Transaction
 	outPointToTx *sync.Map
 	txMutex      sync.Mutex
-	// crypto.HashType -> *types.Transaction
+	// crypto.HashType -> *orphanTx
 	hashToOrphanTx *sync.Map
 	// outpoint -> orphans spending it; outpoints can be arbitrary, valid or invalid
 	// Use map here since there can be multiple spending txs and we don't know which
 	// one will be accepted, unlike in outPointToTx where first seen tx is accepted
 	// types.OutPoint -> (crypto.HashType -> *types.Transaction)
 	outPointToOrphan *sync.Map
+	cfg              *Config
+	feeMutex         sync.Mutex
+	evictionFee      evictionFee
+	orphanMutex      sync.Mutex
+	orphansFrom      map[peer.ID]int // number of orphans from each peer
+	peerRate         *txRateCounter  // txs received from each peer
+	addrRate         *txRateCounter  // txs from peers spending from each address
 }
 
 // NewTransactionPool new a transaction pool.
-func NewTransactionPool(parent goprocess.Process, notifiee p2p.Net, c *chain.BlockChain, bus eventbus.Bus) *TransactionPool {
+func NewTransactionPool(parent goprocess.Process, notifiee p2p.Net, c *chain.BlockChain, bus eventbus.Bus, cfg *Config) *TransactionPool {
 	return &TransactionPool{
 		newTxMsgCh:          make(chan p2p.Message, TxMsgBufferChSize),
 		newChainUpdateMsgCh: make(chan *chain.UpdateMsg, ChainUpdateMsgBufferChSize),
@@ -70,6 +107,10 @@ func NewTransactionPool(parent goprocess.Process, notifiee p2p.Net, c *chain.Blo
 		hashToOrphanTx:      new(sync.Map),
 		outPointToOrphan:    new(sync.Map),
 		outPointToTx:        new(sync.Map),
+		cfg:                 cfg,
+		orphansFrom:         make(map[peer.ID]int),
+		peerRate:            newTxRateCounter(),
+		addrRate:            newTxRateCounter(),
 	}
 }
 
@@ -83,7 +124,7 @@ func (tx_pool *TransactionPool) Run() error {
 	tx_pool.notifiee.Subscribe(tx_pool.txNotifee)
 
 	// chain update msg
-	tx_pool.bus.Subscribe(eventbus.TopicChainUpdate, tx_pool.receiveChainUpdateMsg)
+	chain.SubscribeChainUpdate(tx_pool.bus, tx_pool.receiveChainUpdateMsg)
 
 	tx_pool.proc.Go(tx_pool.loop).SetTeardown(tx_pool.teardown)
 	return nil
@@ -115,19 +156,24 @@ func (tx_pool *TransactionPool) loop(p goprocess.Process) {
 	logger.Info("Waitting for new tx message...")
 	metricsTicker := time.NewTicker(metricsLoopInterval)
 	defer metricsTicker.Stop()
+	expireTicker := time.NewTicker(expireInterval)
+	defer expireTicker.Stop()
 	for {
 		select {
 		case msg := <-tx_pool.newTxMsgCh:
 			tx_pool.processTxMsg(msg)
 		case msg := <-tx_pool.newChainUpdateMsgCh:
 			tx_pool.processChainUpdateMsg(msg)
+		case now := <-expireTicker.C:
+			tx_pool.expireOrphans(now)
+			tx_pool.expireTxs(now)
 		case <-metricsTicker.C:
 			metrics.MetricsTxPoolSizeGauge.Update(int64(lengthOfSyncMap(tx_pool.hashToTx)))
 			metrics.MetricsOrphanTxPoolSizeGauge.Update(int64(lengthOfSyncMap(tx_pool.hashToOrphanTx)))
 		case <-p.Closing():
 			logger.Info("Quit transaction pool loop.")
 			tx_pool.notifiee.UnSubscribe(tx_pool.txNotifee)
-			tx_pool.bus.Unsubscribe(eventbus.TopicChainUpdate, tx_pool.receiveChainUpdateMsg)
+			chain.UnsubscribeChainUpdate(tx_pool.bus, tx_pool.receiveChainUpdateMsg)
 			return
 		}
 	}
@@ -147,22 +193,26 @@ func (tx_pool *TransactionPool) processChainUpdateMsg(msg *chain.UpdateMsg) erro
 // Add all transactions contained in this block into mempool
 func (tx_pool *TransactionPool) addBlockTxs(block *types.Block) error {
 	for _, tx := range block.Txs[1:] {
-		if err := tx_pool.maybeAcceptTx(tx, false /* do not broadcast */, true); err != nil {
+		if err := tx_pool.maybeAcceptTx(context.Background(), tx, "", false /* do not broadcast */, true); err != nil {
 			return err
 		}
 	}
 	return nil
 }
 
-// Remove all transactions contained in this block and their double spends from main and orphan pool
+// Remove all transactions contained in this block and their double spends from main and orphan pool,
+// then accept orphans spending them
 func (tx_pool *TransactionPool) removeBlockTxs(block *types.Block) error {
 	for _, tx := range block.Txs[1:] {
 		// Since the passed tx is confirmed in a new block, all its childrent remain valid, thus no recursive removal.
-		tx_pool.removeTx(tx, false /* non-recursive */)
+		tx_pool.removeTx(tx, types.TxRemoveConfirmed, false /* non-recursive */)
 		tx_pool.removeDoubleSpendTxs(tx)
 		tx_pool.removeOrphan(tx)
 		tx_pool.removeDoubleSpendOrphans(tx)
 	}
+	for _, tx := range block.Txs {
+		tx_pool.processOrphans(tx)
+	}
 	return nil
 }
 
@@ -173,98 +223,225 @@ func (tx_pool *TransactionPool) processTxMsg(msg p2p.Message) error {
 		return err
 	}
 
-	if err := tx_pool.ProcessTx(tx, false); err != nil && util.InArray(err, core.EvilBehavior) {
-		tx_pool.chain.Bus().Publish(eventbus.TopicConnEvent, msg.From(), eventbus.BadTxEvent)
+	// peers flooding txs are punished
+	from, now := []string{string(msg.From())}, time.Now()
+	if !tx_pool.peerRate.allow(from, tx_pool.cfg.maxTxsPerPeer(), now) {
+		logger.Debugf("Peer %v sends txs faster than allowed", msg.From().Pretty())
+		p2p.PublishConnEvent(tx_pool.chain.Bus(), msg.From(), eventbus.BadTxEvent)
+		return core.ErrPeerTxRateExceeded
+	}
+	tx_pool.peerRate.add(from, now)
+
+	if err := tx_pool.processTx(tx, msg.From(), false); err != nil && util.InArray(err, core.EvilBehavior) {
+		p2p.PublishConnEvent(tx_pool.chain.Bus(), msg.From(), eventbus.BadTxEvent)
 		return err
 	}
-	tx_pool.chain.Bus().Publish(eventbus.TopicConnEvent, msg.From(), eventbus.NewTxEvent)
+	p2p.PublishConnEvent(tx_pool.chain.Bus(), msg.From(), eventbus.NewTxEvent)
 	return nil
 }
 
 // ProcessTx is used to handle new transactions.
 // utxoSet: utxos associated with the tx
 func (tx_pool *TransactionPool) ProcessTx(tx *types.Transaction, broadcast bool) error {
+	return tx_pool.processTx(tx, "", broadcast)
+}
 
-	if err := tx_pool.maybeAcceptTx(tx, broadcast, true); err != nil {
+// ResendTx broadcasts tx again, admitting it first if it's not in pool,
+// e.g. evicted or dropped on restart
+func (tx_pool *TransactionPool) ResendTx(tx *types.Transaction) error {
+	txHash, err := tx.TxHash()
+	if err != nil {
 		return err
 	}
-	return tx_pool.processOrphans(tx)
+	if !tx_pool.isTransactionInPool(txHash) {
+		return tx_pool.processTx(tx, "", true)
+	}
+	tx_pool.notifiee.Broadcast(p2p.TransactionMsg, tx)
+	return nil
+}
+
+// processTx handles new transactions from peer, or local ones if from is empty
+func (tx_pool *TransactionPool) processTx(tx *types.Transaction, from peer.ID, broadcast bool) (err error) {
+	ctx, span := metrics.StartTxSpan(context.Background(), "txpool.ProcessTx", tx)
+	span.SetAttribute("tx.local", from == "")
+	defer func() {
+		span.SetError(err)
+		span.Finish()
+	}()
+
+	if err := tx_pool.maybeAcceptTx(ctx, tx, from, broadcast, true); err != nil {
+		return err
+	}
+	_, orphansSpan := metrics.StartSpan(ctx, "txpool.processOrphans")
+	err = tx_pool.processOrphans(tx)
+	orphansSpan.SetError(err)
+	orphansSpan.Finish()
+	return err
 }
 
 // Potentially accept the transaction to the memory pool.
-func (tx_pool *TransactionPool) maybeAcceptTx(tx *types.Transaction, broadcast, detectDupOrphan bool) error {
+// from is the peer sending it, or empty if local
+func (tx_pool *TransactionPool) maybeAcceptTx(ctx context.Context, tx *types.Transaction, from peer.ID, broadcast, detectDupOrphan bool) error {
+
+	_, lockSpan := metrics.StartSpan(ctx, "txpool.lock")
+	tx_pool.txMutex.Lock()
+	defer tx_pool.txMutex.Unlock()
+	lockSpan.Finish()
+	txHash, _ := tx.TxHash()
+
+	_, checkSpan := metrics.StartSpan(ctx, "txpool.checkTx")
+	check, err := tx_pool.checkTx(tx, from, detectDupOrphan)
+	checkSpan.SetError(err)
+	checkSpan.Finish()
+	if err == core.ErrOrphanTransaction {
+		// Add orphan transaction
+		if err := tx_pool.addOrphan(tx, from); err != nil {
+			logger.Debugf("Orphan tx %v is not held: %v", txHash.String(), err)
+			return err
+		}
+		return core.ErrOrphanTransaction
+	}
+	if err != nil {
+		return err
+	}
+
+	// evict replaced txs and add transaction to pool.
+	tx_pool.replaceTxs(check.replaced, tx)
+	tx_pool.addTx(tx, check.height, check.fee, check.size)
+	tx_pool.addrRate.add(check.addrs, time.Now())
+
+	// evict txs paying the least if the pool overflows, possibly this one
+	if _, evicted := tx_pool.limitSize()[*txHash]; evicted {
+		return core.ErrTxPoolFull
+	}
+
+	// Broadcast this tx.
+	if broadcast {
+		tx_pool.notifiee.Broadcast(p2p.TransactionMsg, tx)
+	}
+	return nil
+}
 
+// txCheck is what checking a tx for admission finds out
+type txCheck struct {
+	fee  uint64
+	size int
+	// height of the next block
+	height uint32
+	// txs in pool the tx replaces
+	replaced []*chain.TxWrap
+	// addresses tx spends from, counted against their rate limit if the tx
+	// comes from a peer
+	addrs []string
+}
+
+// TestAcceptTx validates tx as ProcessTx does, without adding it to the pool
+// or relaying it. It returns the fee and size of tx if it would be accepted.
+// Txs spending missing outputs fail with ErrOrphanTransaction
+func (tx_pool *TransactionPool) TestAcceptTx(tx *types.Transaction) (uint64, int, error) {
 	tx_pool.txMutex.Lock()
 	defer tx_pool.txMutex.Unlock()
+
+	check, err := tx_pool.checkTx(tx, "", true)
+	if err != nil {
+		return 0, 0, err
+	}
+	return check.fee, check.size, nil
+}
+
+// checkTx runs all checks for tx to be admitted into the pool, without
+// changing the pool. from is the peer sending tx, or empty if local. It fails
+// with ErrOrphanTransaction if any output tx spends is missing. It's called
+// with txMutex held
+func (tx_pool *TransactionPool) checkTx(tx *types.Transaction, from peer.ID, detectDupOrphan bool) (*txCheck, error) {
 	txHash, _ := tx.TxHash()
+	txLogger := logger.WithFields(log.Fields{log.FieldTxHash: txHash.String(), log.FieldPeer: from.Pretty()})
 
 	// Don't accept the transaction if it already exists in the pool.
 	// This applies to orphan transactions as well
 	if tx_pool.isTransactionInPool(txHash) || detectDupOrphan && tx_pool.isOrphanInPool(txHash) {
-		logger.Debugf("Tx %v already exists", txHash.String())
-		return core.ErrDuplicateTxInPool
+		txLogger.Debugf("Tx %v already exists", txHash.String())
+		return nil, core.ErrDuplicateTxInPool
 	}
 
 	// TODO: check tx is already exist in the main chain??
 
 	// Perform preliminary sanity checks on the transaction.
 	if err := chain.ValidateTransactionPreliminary(tx); err != nil {
-		logger.Debugf("Tx %v fails sanity check: %v", txHash.String(), err)
-		return err
+		txLogger.Debugf("Tx %v fails sanity check: %v", txHash.String(), err)
+		return nil, err
 	}
 
 	// A standalone transaction must not be a coinbase transaction.
 	if chain.IsCoinBase(tx) {
-		logger.Debugf("Tx %v is an individual coinbase", txHash.String())
-		return core.ErrCoinbaseTx
+		txLogger.Debugf("Tx %v is an individual coinbase", txHash.String())
+		return nil, core.ErrCoinbaseTx
 	}
 
 	// ensure it is a standard transaction
-	if err := tx_pool.checkTransactionStandard(tx); err != nil {
-		logger.Debugf("Tx %v is not standard: %v", txHash.String(), err)
-		return core.ErrNonStandardTransaction
+	if err := policy.CheckTransactionStandard(tx, &tx_pool.cfg.Policy); err != nil {
+		txLogger.Debugf("Tx %v is not standard: %v", txHash.String(), err)
+		return nil, err
 	}
 
-	// Quickly detects if the tx double spends with any transaction in the pool.
+	// Quickly detects if the tx double spends with any transaction in the pool,
+	// which is only allowed to replace ones signaling replacement.
 	// Double spending with the main chain txs will be checked in ValidateTxInputs.
-	if err := tx_pool.checkPoolDoubleSpend(tx); err != nil {
-		logger.Debugf("Tx %v double spends outputs spent by other pending txs: %v", txHash.String(), err)
-		return err
+	replaced, err := tx_pool.replacedTxs(tx)
+	if err != nil {
+		txLogger.Debugf("Tx %v double spends outputs spent by other pending txs: %v", txHash.String(), err)
+		return nil, err
 	}
 
 	utxoSet, err := chain.GetExtendedTxUtxoSet(tx, tx_pool.chain.DB(), tx_pool.hashToTx)
 	if err != nil {
-		logger.Errorf("Could not get extended utxo set for tx %v", txHash)
-		return err
+		txLogger.Errorf("Could not get extended utxo set for tx %v", txHash)
+		return nil, err
 	}
 
 	// A tx is an orphan if any of its spending utxo does not exist
 	if !utxoSet.IsTxFunded(tx) {
-		// Add orphan transaction
-		tx_pool.addOrphan(tx)
-		return core.ErrOrphanTransaction
+		return nil, core.ErrOrphanTransaction
+	}
+
+	// txs from peers spending from an address are rate limited, local ones
+	// are not
+	var addrs []string
+	if from != "" {
+		addrs = spentAddrs(utxoSet, tx)
+		if !tx_pool.addrRate.allow(addrs, tx_pool.cfg.maxTxsPerAddr(), time.Now()) {
+			txLogger.Debugf("Tx %v spends from addresses sending txs faster than allowed", txHash.String())
+			return nil, core.ErrAddrTxRateExceeded
+		}
 	}
 
 	nextBlockHeight := tx_pool.chain.LongestChainHeight + 1
 
 	txFee, err := chain.ValidateTxInputs(utxoSet, tx, nextBlockHeight)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	// TODO: checkInputsStandard
 
 	// TODO: GetSigOpCost check
 
-	// TODO: Whether the minfee limit is needed？
-	// how to calc the minfee, or use a fixed value.
+	// txs paying less than the minimum relay fee rate are neither admitted
+	// nor relayed
 	txSize, err := tx.SerializeSize()
 	if err != nil {
-		return err
+		return nil, err
 	}
-	minFee := calcRequiredMinFee(txSize)
-	if txFee < minFee {
-		return errors.New("txFee is less than minFee")
+	if txFee < calcRequiredMinFee(txSize, tx_pool.minFeePerKB(time.Now())) {
+		txLogger.Debugf("Tx %v pays fee %d for %d bytes below min relay fee", txHash.String(), txFee, txSize)
+		return nil, core.ErrInsufficientFee
+	}
+
+	if len(replaced) > 0 {
+		if err := checkReplacement(tx, txFee, txSize, replaced); err != nil {
+			txLogger.Debugf("Tx %v can't replace %d pending txs: %v", txHash.String(), len(replaced), err)
+			return nil, err
+		}
 	}
 
 	// TODO: priority check
@@ -273,18 +450,9 @@ func (tx_pool *TransactionPool) maybeAcceptTx(tx *types.Transaction, broadcast,
 
 	// verify crypto signatures for each input
 	if err = chain.ValidateTxScripts(utxoSet, tx); err != nil {
-		return err
-	}
-
-	feePerKB := txFee * 1000 / (uint64)(txSize)
-	// add transaction to pool.
-	tx_pool.addTx(tx, nextBlockHeight, feePerKB)
-
-	// Broadcast this tx.
-	if broadcast {
-		tx_pool.notifiee.Broadcast(p2p.TransactionMsg, tx)
+		return nil, err
 	}
-	return nil
+	return &txCheck{fee: txFee, size: txSize, height: nextBlockHeight, replaced: replaced, addrs: addrs}, nil
 }
 
 func (tx_pool *TransactionPool) isTransactionInPool(txHash *crypto.HashType) bool {
@@ -304,20 +472,6 @@ func (tx_pool *TransactionPool) isOrphanInPool(txHash *crypto.HashType) bool {
 	return exists
 }
 
-func (tx_pool *TransactionPool) checkTransactionStandard(tx *types.Transaction) error {
-	// TODO:
-	return nil
-}
-
-func (tx_pool *TransactionPool) checkPoolDoubleSpend(tx *types.Transaction) error {
-	for _, txIn := range tx.Vin {
-		if _, exists := tx_pool.findTransaction(txIn.PrevOutPoint); exists {
-			return core.ErrOutPutAlreadySpent
-		}
-	}
-	return nil
-}
-
 // ProcessOrphans used to handle orphan transactions
 func (tx_pool *TransactionPool) processOrphans(tx *types.Transaction) error {
 	// Start with processing at least the passed tx.
@@ -339,7 +493,7 @@ func (tx_pool *TransactionPool) processOrphans(tx *types.Transaction) error {
 			orphans := v.(*sync.Map)
 			orphans.Range(func(k, v interface{}) bool {
 				orphan := v.(*types.Transaction)
-				if err := tx_pool.maybeAcceptTx(orphan, false, false); err != nil {
+				if err := tx_pool.maybeAcceptTx(context.Background(), orphan, "", false, false); err != nil {
 					return true
 				}
 				tx_pool.removeOrphan(orphan)
@@ -358,16 +512,19 @@ func (tx_pool *TransactionPool) processOrphans(tx *types.Transaction) error {
 }
 
 // Add transaction into tx pool
-func (tx_pool *TransactionPool) addTx(tx *types.Transaction, height uint32, feePerKB uint64) {
+func (tx_pool *TransactionPool) addTx(tx *types.Transaction, height uint32, fee uint64, txSize int) {
 	txHash, _ := tx.TxHash()
 
 	txWrap := &chain.TxWrap{
 		Tx:             tx,
 		AddedTimestamp: time.Now().Unix(),
 		Height:         height,
-		FeePerKB:       feePerKB,
+		Fee:            fee,
+		FeePerKB:       calcFeePerKB(fee, txSize),
 	}
+	tx_pool.updatePackages(txWrap, true)
 	tx_pool.hashToTx.Store(*txHash, txWrap)
+	atomic.AddInt64(&tx_pool.size, int64(txSize))
 
 	// outputs spent by this new tx
 	for _, txIn := range tx.Vin {
@@ -375,17 +532,24 @@ func (tx_pool *TransactionPool) addTx(tx *types.Transaction, height uint32, feeP
 	}
 
 	// TODO: build address - tx index.
+
+	PublishMempoolTxAdded(tx_pool.bus, tx)
 }
 
-// Remove transaction from tx pool. Note we do not recursively remove dependent txs here
-func (tx_pool *TransactionPool) removeTx(tx *types.Transaction, recursive bool) {
+// Remove transaction from tx pool for reason. Note we do not recursively remove dependent txs here
+func (tx_pool *TransactionPool) removeTx(tx *types.Transaction, reason types.TxRemoveReason, recursive bool) {
 	txHash, _ := tx.TxHash()
 
 	// Unspend the referenced outpoints.
 	for _, txIn := range tx.Vin {
 		tx_pool.outPointToTx.Delete(txIn.PrevOutPoint)
 	}
-	tx_pool.hashToTx.Delete(*txHash)
+	if v, exists := tx_pool.hashToTx.Load(*txHash); exists {
+		tx_pool.updatePackages(v.(*chain.TxWrap), false)
+		atomic.AddInt64(&tx_pool.size, -wrapSize(v.(*chain.TxWrap)))
+		tx_pool.hashToTx.Delete(*txHash)
+		PublishMempoolTxRemoved(tx_pool.bus, tx, reason)
+	}
 
 	if !recursive {
 		return
@@ -408,8 +572,8 @@ func (tx_pool *TransactionPool) removeTx(tx *types.Transaction, recursive bool)
 
 			// Move the child tx from main pool to orphan pool
 			// The outer loop is already a recursion, so no more recursion within
-			tx_pool.removeTx(childTx, false /* non-recursive */)
-			tx_pool.addOrphan(childTx)
+			tx_pool.removeTx(childTx, reason, false /* non-recursive */)
+			tx_pool.addOrphan(childTx, "")
 
 			removedTxs = append(removedTxs, childTx)
 		}
@@ -420,24 +584,39 @@ func (tx_pool *TransactionPool) removeTx(tx *types.Transaction, recursive bool)
 func (tx_pool *TransactionPool) removeDoubleSpendTxs(tx *types.Transaction) {
 	for _, txIn := range tx.Vin {
 		if doubleSpentTx, exists := tx_pool.findTransaction(txIn.PrevOutPoint); exists {
-			tx_pool.removeTx(doubleSpentTx, true /* recursive */)
+			tx_pool.removeTx(doubleSpentTx, types.TxRemoveConflicted, true /* recursive */)
+			PublishTxConflicted(tx_pool.bus, doubleSpentTx, tx)
 		}
 	}
 }
 
 // Add orphan
-func (tx_pool *TransactionPool) addOrphan(tx *types.Transaction) {
+// Add orphan from peer, or local one if from is empty, to wait for its parents.
+// An orphan already held keeps its sender and expiry
+func (tx_pool *TransactionPool) addOrphan(tx *types.Transaction, from peer.ID) error {
 
 	txHash, _ := tx.TxHash()
-	tx_pool.hashToOrphanTx.Store(*txHash, tx)
-	for _, txIn := range tx.Vin {
-		v := new(sync.Map)
-		v.Store(*txHash, tx)
-		tx_pool.outPointToOrphan.LoadOrStore(txIn.PrevOutPoint, v)
+	if tx_pool.isOrphanInPool(txHash) {
+		return nil
+	}
+	size, err := tx.SerializeSize()
+	if err != nil {
+		return err
+	}
+	if err := tx_pool.checkOrphan(size, from); err != nil {
+		return err
+	}
+	tx_pool.limitOrphans()
 
+	tx_pool.hashToOrphanTx.Store(*txHash, &orphanTx{tx: tx, from: from, expiresAt: time.Now().Add(orphanTTL)})
+	tx_pool.countOrphan(from, 1)
+	for _, txIn := range tx.Vin {
+		v, _ := tx_pool.outPointToOrphan.LoadOrStore(txIn.PrevOutPoint, new(sync.Map))
+		v.(*sync.Map).Store(*txHash, tx)
 	}
 
 	logger.Debugf("Stored orphan transaction %v", txHash.String())
+	return nil
 }
 
 // Remove orphan
@@ -467,7 +646,10 @@ func (tx_pool *TransactionPool) removeOrphan(tx *types.Transaction) {
 		}
 	}
 
-	tx_pool.hashToOrphanTx.Delete(*txHash)
+	if orphan, exists := tx_pool.getOrphan(txHash); exists {
+		tx_pool.hashToOrphanTx.Delete(*txHash)
+		tx_pool.countOrphan(orphan.from, -1)
+	}
 	logger.Debugf("Removed orphan transaction %v", txHash.String())
 }
 
@@ -495,6 +677,14 @@ func (tx_pool *TransactionPool) GetAllTxs() []*chain.TxWrap {
 	return txs
 }
 
+// GetTxsByFeeRate returns all transactions in mempool, with higher fee rate
+// first and earlier added first for equal fee rates
+func (tx_pool *TransactionPool) GetTxsByFeeRate() []*chain.TxWrap {
+	txs := tx_pool.GetAllTxs()
+	sortByFeeRate(txs)
+	return txs
+}
+
 // GetTransactionsInPool gets all transactions in memory pool
 func (tx_pool *TransactionPool) GetTransactionsInPool() []*types.Transaction {
 
@@ -507,8 +697,68 @@ func (tx_pool *TransactionPool) GetTransactionsInPool() []*types.Transaction {
 	return txs
 }
 
-func calcRequiredMinFee(txSize int) uint64 {
-	return 0
+// GetMempoolEntries gets all transactions in memory pool with their fees
+func (tx_pool *TransactionPool) GetMempoolEntries() []*types.MempoolEntry {
+	var entries []*types.MempoolEntry
+	for _, txWrap := range tx_pool.GetAllTxs() {
+		size, _ := txWrap.Tx.SerializeSize()
+		entries = append(entries, &types.MempoolEntry{
+			Tx:             txWrap.Tx,
+			Size:           size,
+			Fee:            txWrap.Fee,
+			FeePerKB:       txWrap.FeePerKB,
+			AddedTimestamp: txWrap.AddedTimestamp,
+			Height:         txWrap.Height,
+			AncestorFee:    txWrap.AncestorFee,
+			AncestorSize:   txWrap.AncestorSize,
+			DescendantFee:  txWrap.DescendantFee,
+			DescendantSize: txWrap.DescendantSize,
+		})
+	}
+	return entries
+}
+
+// GetMempoolInfo summarizes memory pool
+func (tx_pool *TransactionPool) GetMempoolInfo() *types.MempoolInfo {
+	info := &types.MempoolInfo{
+		Orphans:     lengthOfSyncMap(tx_pool.hashToOrphanTx),
+		MinFeePerKB: tx_pool.minFeePerKB(time.Now()),
+	}
+	for _, txWrap := range tx_pool.GetAllTxs() {
+		size, _ := txWrap.Tx.SerializeSize()
+		info.Size++
+		info.Bytes += size
+	}
+	return info
+}
+
+// calcFeePerKB returns the fee per 1000 bytes of a tx of txSize paying fee
+func calcFeePerKB(fee uint64, txSize int) uint64 {
+	if txSize <= 0 {
+		return 0
+	}
+	return fee * 1000 / uint64(txSize)
+}
+
+// calcRequiredMinFee returns the minimum fee of a tx of txSize at
+// minRelayFee per 1000 bytes. Any tx pays at least minRelayFee if not zero
+func calcRequiredMinFee(txSize int, minRelayFee uint64) uint64 {
+	minFee := uint64(txSize) * minRelayFee / 1000
+	if minFee == 0 {
+		return minRelayFee
+	}
+	return minFee
+}
+
+// sortByFeeRate sorts txs by fee rate in descending order, and by added
+// time for equal fee rates
+func sortByFeeRate(txs []*chain.TxWrap) {
+	sort.SliceStable(txs, func(i, j int) bool {
+		if txs[i].FeePerKB == txs[j].FeePerKB {
+			return txs[i].AddedTimestamp < txs[j].AddedTimestamp
+		}
+		return txs[i].FeePerKB > txs[j].FeePerKB
+	})
 }
 
 func lengthOfSyncMap(target *sync.Map) int {
@@ -25,7 +25,7 @@ import (
 var (
 	proc        = goprocess.WithSignals(os.Interrupt)
 	bus         = eventbus.New()
-	txpool      = NewTransactionPool(proc, p2p.NewDummyPeer(), chain.NewTestBlockChain(), bus)
+	txpool      = NewTransactionPool(proc, p2p.NewDummyPeer(), chain.NewTestBlockChain(), bus, &Config{})
 	chainHeight = uint32(0)
 
 	txOutIdx = uint32(0)
@@ -116,7 +116,8 @@ func TestProcessTx(t *testing.T) {
 	verifyProcessTx(t, tx0, core.ErrCoinbaseTx, false, false)
 
 	// manually add tx0 into pool as utxo to bootstrap; otherwise no tx can be accepted
-	txpool.addTx(tx0, chainHeight, 0)
+	txSize, _ := tx0.SerializeSize()
+	txpool.addTx(tx0, chainHeight, 0, txSize)
 
 	// tx0(m) <- tx1(m)
 	// tx1 is admitted into main pool since it spends from a valid UTXO, i.e., coinbaseTx
@@ -163,7 +164,7 @@ func TestProcessTx(t *testing.T) {
 	ensure.DeepEqual(t, len(txpool.GetAllTxs()), 7)
 
 	// recursively remove tx4 and its children
-	txpool.removeTx(tx4, true /* recursive */)
+	txpool.removeTx(tx4, types.TxRemoveConflicted, true /* recursive */)
 	// tx0(m) <- tx1(m) <- tx2(m) <- tx3(m)
 	// tx5(o) <- tx6(o)
 	ensure.DeepEqual(t, len(txpool.GetAllTxs()), 4)
@@ -172,10 +173,47 @@ func TestProcessTx(t *testing.T) {
 	verifyTxInPool(t, tx6, false, true)
 
 	// non-recursively remove tx1: its children remain in main pool
-	txpool.removeTx(tx1, false /* recursive */)
+	txpool.removeTx(tx1, types.TxRemoveConfirmed, false /* recursive */)
 	// tx0(m)
 	// tx2(m) <- tx3(m)
 	// tx5(o) <- tx6(o)
 	ensure.DeepEqual(t, len(txpool.GetAllTxs()), 3)
 	verifyTxInPool(t, tx1, false, false)
 }
+
+func TestCalcRequiredMinFee(t *testing.T) {
+	ensure.DeepEqual(t, calcRequiredMinFee(250, 0), uint64(0))
+	ensure.DeepEqual(t, calcRequiredMinFee(250, 1000), uint64(250))
+	// any tx pays at least the min relay fee
+	ensure.DeepEqual(t, calcRequiredMinFee(250, 3), uint64(3))
+}
+
+func TestSortByFeeRate(t *testing.T) {
+	low := &chain.TxWrap{FeePerKB: 10, AddedTimestamp: 1}
+	highLate := &chain.TxWrap{FeePerKB: 100, AddedTimestamp: 3}
+	highEarly := &chain.TxWrap{FeePerKB: 100, AddedTimestamp: 2}
+	txs := []*chain.TxWrap{low, highLate, highEarly}
+	sortByFeeRate(txs)
+	ensure.DeepEqual(t, txs, []*chain.TxWrap{highEarly, highLate, low})
+	ensure.DeepEqual(t, calcFeePerKB(25, 250), uint64(100))
+}
+
+func TestTestAcceptTx(t *testing.T) {
+	pool := NewTransactionPool(proc, p2p.NewDummyPeer(), chain.NewTestBlockChain(), bus, &Config{})
+	txSize, _ := tx0.SerializeSize()
+	pool.addTx(tx0, chainHeight, 0, txSize)
+
+	// an acceptable tx is neither added nor relayed
+	tx := createChildTx(tx0)
+	_, size, err := pool.TestAcceptTx(tx)
+	ensure.Nil(t, err)
+	txSize, _ = tx.SerializeSize()
+	ensure.DeepEqual(t, size, txSize)
+	ensure.False(t, pool.isTransactionInPool(getTxHash(tx)))
+
+	// nor is an orphan held
+	orphan := createChildTx(tx)
+	_, _, err = pool.TestAcceptTx(orphan)
+	ensure.DeepEqual(t, err, core.ErrOrphanTransaction)
+	ensure.False(t, pool.isOrphanInPool(getTxHash(orphan)))
+}
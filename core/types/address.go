@@ -10,12 +10,59 @@ import (
 	"golang.org/x/crypto/ripemd160"
 )
 
-var addressTypeP2PKHPrefix = [2]byte{FixPrefix, 0x26}
-var addressTypeP2SHPrefix = [2]byte{FixPrefix, 0x2b}
+// bech32 addresses encode the address type in the first byte of data
+const (
+	bech32TypeP2PKH = 0x00
+	bech32TypeP2SH  = 0x01
+)
+
+// NetworkParams are the formats of addresses on a network, distinct from
+// those on other networks so that coins are never sent across networks
+type NetworkParams struct {
+	// Base58Lead is the leading char of base58 addresses
+	Base58Lead byte
+	// P2PKHPrefix and P2SHPrefix are version bytes of base58 addresses
+	P2PKHPrefix [AddressPrefixLength]byte
+	P2SHPrefix  [AddressPrefixLength]byte
+	// Bech32HRP is the human readable part of bech32 addresses
+	Bech32HRP string
+}
+
+// Networks are address formats of networks by name
+var Networks = map[string]*NetworkParams{
+	"mainnet": {
+		Base58Lead:  BoxPrefix,
+		P2PKHPrefix: [AddressPrefixLength]byte{FixPrefix, 0x26},
+		P2SHPrefix:  [AddressPrefixLength]byte{FixPrefix, 0x2b},
+		Bech32HRP:   "box",
+	},
+	"testnet": {
+		Base58Lead:  TestnetPrefix,
+		P2PKHPrefix: [AddressPrefixLength]byte{0x1c, 0xb8},
+		P2SHPrefix:  [AddressPrefixLength]byte{0x1c, 0xbd},
+		Bech32HRP:   "tbox",
+	},
+}
+
+// network is the address formats of the network the node runs on, only
+// which are accepted
+var network = Networks["mainnet"]
+
+// SetAddressNetwork sets the network addresses are encoded for and accepted
+// from, mainnet by default. It must be called before any address is parsed
+func SetAddressNetwork(name string) error {
+	params, ok := Networks[name]
+	if !ok {
+		return core.ErrUnknownNetwork
+	}
+	network = params
+	return nil
+}
 
 // const
 const (
 	BoxPrefix           = 'b'
+	TestnetPrefix       = 't'
 	AddressPrefixLength = 2
 	FixPrefix           = 0x13
 
@@ -29,6 +76,8 @@ type AddressHash [ripemd160.Size]byte
 // Address is an interface type for any type of destination a transaction output may spend to.
 type Address interface {
 	String() string
+	// Bech32 returns the address in bech32 format of the network
+	Bech32() string
 	SetString(string) error
 	Hash() []byte
 	Hash160() *AddressHash
@@ -50,13 +99,25 @@ func NewAddressFromPubKey(pubKey *crypto.PublicKey) (*AddressPubKeyHash, error)
 	return newAddressPubKeyHash(pkHash)
 }
 
-// NewAddress creates an address from string
+// NewAddress creates an address from string of the network the node runs on
 func NewAddress(address string) (Address, error) {
 	addr := &AddressPubKeyHash{}
 	err := addr.SetString(address)
 	return addr, err
 }
 
+// NewAddressOfNetwork creates an address from string of network name, e.g.
+// those configured for all networks
+func NewAddressOfNetwork(address, name string) (Address, error) {
+	params, ok := Networks[name]
+	if !ok {
+		return nil, core.ErrUnknownNetwork
+	}
+	addr := &AddressPubKeyHash{}
+	err := addr.setString(address, params)
+	return addr, err
+}
+
 func newAddressPubKeyHash(pkHash []byte) (*AddressPubKeyHash, error) {
 	// Check for a valid pubkey hash length.
 	if len(pkHash) != ripemd160.Size {
@@ -75,39 +136,162 @@ func (a *AddressPubKeyHash) Hash() []byte {
 
 // String returns a human-readable string for the pay-to-pubkey-hash address.
 func (a *AddressPubKeyHash) String() string {
-	return encodeAddress(a.hash[:])
+	return encodeAddress(network.P2PKHPrefix, a.hash[:])
+}
+
+// Bech32 returns the pay-to-pubkey-hash address in bech32 format of the
+// network.
+func (a *AddressPubKeyHash) Bech32() string {
+	addr, _ := crypto.Bech32Encode(network.Bech32HRP, append([]byte{bech32TypeP2PKH}, a.hash[:]...))
+	return addr
 }
 
 // SetString sets the Address's internal byte array using byte array decoded from input
-// base58 format string, returns error if input string is invalid
+// base58 or bech32 format string, returns error if input string is invalid or
+// of another network
 func (a *AddressPubKeyHash) SetString(in string) error {
-	if len(in) != EncodeAddressLength || in[0] != BoxPrefix {
-		return core.ErrInvalidAddressString
-	}
-	rawBytes, err := crypto.Base58CheckDecode(in)
+	return a.setString(in, network)
+}
+
+func (a *AddressPubKeyHash) setString(in string, params *NetworkParams) error {
+	hash, _, err := decodeAddress(in, params)
 	if err != nil {
 		return err
 	}
-	if len(rawBytes) != 22 {
-		return core.ErrInvalidAddressString
+	a.hash = *hash
+	return nil
+}
+
+// Hash160 returns the underlying array of the pubkey hash.
+func (a *AddressPubKeyHash) Hash160() *AddressHash {
+	return &a.hash
+}
+
+// AddressScriptHash is an Address for a pay-to-script-hash (P2SH) transaction,
+// e.g. of a multisig redeem script.
+type AddressScriptHash struct {
+	hash AddressHash
+}
+
+// NewAddressScriptHash returns a new AddressScriptHash. scriptHash must be 20 bytes.
+func NewAddressScriptHash(scriptHash []byte) (*AddressScriptHash, error) {
+	if len(scriptHash) != ripemd160.Size {
+		return nil, core.ErrInvalidPKHash
 	}
-	var prefix [2]byte
-	copy(prefix[:], rawBytes[:2])
-	if prefix != addressTypeP2PKHPrefix && prefix != addressTypeP2SHPrefix {
+	addr := &AddressScriptHash{}
+	copy(addr.hash[:], scriptHash)
+	return addr, nil
+}
+
+// NewAddressFromScript returns a new AddressScriptHash of a redeem script
+func NewAddressFromScript(redeemScript []byte) (*AddressScriptHash, error) {
+	return NewAddressScriptHash(crypto.Hash160(redeemScript))
+}
+
+// Hash returns the bytes to be included in a txout script to pay to a script hash.
+func (a *AddressScriptHash) Hash() []byte {
+	return a.hash[:]
+}
+
+// String returns a human-readable string for the pay-to-script-hash address.
+func (a *AddressScriptHash) String() string {
+	return encodeAddress(network.P2SHPrefix, a.hash[:])
+}
+
+// Bech32 returns the pay-to-script-hash address in bech32 format of the
+// network.
+func (a *AddressScriptHash) Bech32() string {
+	addr, _ := crypto.Bech32Encode(network.Bech32HRP, append([]byte{bech32TypeP2SH}, a.hash[:]...))
+	return addr
+}
+
+// SetString sets the Address's internal byte array using byte array decoded
+// from input base58 or bech32 format string, returns error if input string is
+// invalid, of another network or not pay to script hash
+func (a *AddressScriptHash) SetString(in string) error {
+	hash, isP2SH, err := decodeAddress(in, network)
+	if err != nil {
+		return err
+	}
+	if !isP2SH {
 		return core.ErrInvalidAddressString
 	}
-	copy(a.hash[:], rawBytes[2:])
+	a.hash = *hash
 	return nil
 }
 
-// Hash160 returns the underlying array of the pubkey hash.
-func (a *AddressPubKeyHash) Hash160() *AddressHash {
+// Hash160 returns the underlying array of the script hash.
+func (a *AddressScriptHash) Hash160() *AddressHash {
 	return &a.hash
 }
 
-func encodeAddress(hash []byte) string {
-	b := make([]byte, 0, len(hash)+2)
-	b = append(b, addressTypeP2PKHPrefix[:]...)
+// isBase58Lead checks if c leads base58 addresses of any network
+func isBase58Lead(c byte) bool {
+	for _, params := range Networks {
+		if c == params.Base58Lead {
+			return true
+		}
+	}
+	return false
+}
+
+// decodeAddress decodes a base58 or bech32 address of the network, returning
+// its hash and whether it's pay to script hash
+func decodeAddress(in string, params *NetworkParams) (*AddressHash, bool, error) {
+	if len(in) != EncodeAddressLength || !isBase58Lead(in[0]) {
+		return decodeBech32Address(in, params)
+	}
+	rawBytes, err := crypto.Base58CheckDecode(in)
+	if err != nil {
+		return nil, false, err
+	}
+	if len(rawBytes) != AddressPrefixLength+ripemd160.Size {
+		return nil, false, core.ErrInvalidAddressString
+	}
+	var prefix [AddressPrefixLength]byte
+	copy(prefix[:], rawBytes[:AddressPrefixLength])
+	if prefix != params.P2PKHPrefix && prefix != params.P2SHPrefix {
+		for _, other := range Networks {
+			if prefix == other.P2PKHPrefix || prefix == other.P2SHPrefix {
+				return nil, false, core.ErrInvalidAddressNetwork
+			}
+		}
+		return nil, false, core.ErrInvalidAddressString
+	}
+	hash := &AddressHash{}
+	copy(hash[:], rawBytes[AddressPrefixLength:])
+	return hash, prefix == params.P2SHPrefix, nil
+}
+
+// decodeBech32Address decodes a bech32 address of the network, returning its
+// hash and whether it's pay to script hash
+func decodeBech32Address(in string, params *NetworkParams) (*AddressHash, bool, error) {
+	hrp, rawBytes, err := crypto.Bech32Decode(in)
+	if err != nil {
+		return nil, false, core.ErrInvalidAddressString
+	}
+	if hrp != params.Bech32HRP {
+		for _, other := range Networks {
+			if hrp == other.Bech32HRP {
+				return nil, false, core.ErrInvalidAddressNetwork
+			}
+		}
+		return nil, false, core.ErrInvalidAddressString
+	}
+	if len(rawBytes) != 1+ripemd160.Size {
+		return nil, false, core.ErrInvalidAddressString
+	}
+	if rawBytes[0] != bech32TypeP2PKH && rawBytes[0] != bech32TypeP2SH {
+		return nil, false, core.ErrInvalidAddressString
+	}
+	hash := &AddressHash{}
+	copy(hash[:], rawBytes[1:])
+	return hash, rawBytes[0] == bech32TypeP2SH, nil
+}
+
+func encodeAddress(prefix [AddressPrefixLength]byte, hash []byte) string {
+	b := make([]byte, 0, len(hash)+AddressPrefixLength)
+	b = append(b, prefix[:]...)
 	b = append(b, hash[:]...)
 	return crypto.Base58CheckEncode(b)
 }
@@ -166,3 +166,131 @@ func TestNewAddress(t *testing.T) {
 		})
 	}
 }
+
+func TestBech32Address(t *testing.T) {
+	pkHash := []byte{
+		0x0e, 0xf0, 0x30, 0x10, 0x7f, 0xd2, 0x6e, 0x0b, 0x6b, 0xf4,
+		0x05, 0x12, 0xbc, 0xa2, 0xce, 0xb1, 0xdd, 0x80, 0xad, 0xaa}
+	addr, _ := NewAddressPubKeyHash(pkHash)
+	if got := addr.Bech32(); got != "box1qq80qvqs0lfxuzmt7sz3909ze6camq9d4g7vqphz" {
+		t.Errorf("Bech32() = %v", got)
+	}
+
+	tests := []struct {
+		name    string
+		addr    string
+		errWant error
+	}{
+		{"p2pkh", "box1qq80qvqs0lfxuzmt7sz3909ze6camq9d4g7vqphz", nil},
+		{"uppercase", "BOX1QQ80QVQS0LFXUZMT7SZ3909ZE6CAMQ9D4G7VQPHZ", nil},
+		{"p2sh", "box1qy80qvqs0lfxuzmt7sz3909ze6camq9d4gm0wy28", nil},
+		{"other network", "tbox1qq80qvqs0lfxuzmt7sz3909ze6camq9d4gchk6f5", core.ErrInvalidAddressNetwork},
+		{"invalid checksum", "box1qq80qvqs0lfxuzmt7sz3909ze6camq9d4g7vqphx", core.ErrInvalidAddressString},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := NewAddress(test.addr)
+			if err != test.errWant {
+				t.Errorf("NewAddress() = %v, want: %v", err, test.errWant)
+				return
+			} else if err != nil {
+				return
+			}
+			if got.String() != addr.String() {
+				t.Errorf("NewAddress() = %v, want: %v", got, addr)
+			}
+		})
+	}
+
+	// addresses are of the network set only
+	if err := SetAddressNetwork("testnet"); err != nil {
+		t.Fatal(err)
+	}
+	defer SetAddressNetwork("mainnet")
+	if _, err := NewAddress("box1qq80qvqs0lfxuzmt7sz3909ze6camq9d4g7vqphz"); err != core.ErrInvalidAddressNetwork {
+		t.Errorf("NewAddress() = %v, want: %v", err, core.ErrInvalidAddressNetwork)
+	}
+	if got := addr.Bech32(); got != "tbox1qq80qvqs0lfxuzmt7sz3909ze6camq9d4gchk6f5" {
+		t.Errorf("Bech32() = %v", got)
+	}
+	if err := SetAddressNetwork("devnet"); err != core.ErrUnknownNetwork {
+		t.Errorf("SetAddressNetwork() = %v, want: %v", err, core.ErrUnknownNetwork)
+	}
+}
+
+func TestNetworkAddress(t *testing.T) {
+	pkHash := []byte{
+		0x0e, 0xf0, 0x30, 0x10, 0x7f, 0xd2, 0x6e, 0x0b, 0x6b, 0xf4,
+		0x05, 0x12, 0xbc, 0xa2, 0xce, 0xb1, 0xdd, 0x80, 0xad, 0xaa}
+	addr, _ := NewAddressPubKeyHash(pkHash)
+	mainnet, testnet := "b1VAnrX665aeExMaPeW6pk3FZKCLuywUaHw", "t1KEbCZMwbZvrcHAdcN9eoE71K5ZyjRpUcw"
+
+	// addresses of testnet are rejected on mainnet
+	for _, in := range []string{testnet, "t3Kvc86rP9UFEhSs4jTpFDrTwTbrhGxDiNm"} {
+		if _, err := NewAddress(in); err != core.ErrInvalidAddressNetwork {
+			t.Errorf("NewAddress(%s) = %v, want: %v", in, err, core.ErrInvalidAddressNetwork)
+		}
+	}
+	if got, err := NewAddressOfNetwork(testnet, "testnet"); err != nil || !bytes.Equal(got.Hash(), pkHash) {
+		t.Errorf("NewAddressOfNetwork() = %v, %v", got, err)
+	}
+
+	// and the other way around
+	if err := SetAddressNetwork("testnet"); err != nil {
+		t.Fatal(err)
+	}
+	defer SetAddressNetwork("mainnet")
+	if got := addr.String(); got != testnet {
+		t.Errorf("String() = %v, want: %v", got, testnet)
+	}
+	for _, in := range []string{testnet, "t3Kvc86rP9UFEhSs4jTpFDrTwTbrhGxDiNm"} {
+		if got, err := NewAddress(in); err != nil || !bytes.Equal(got.Hash(), pkHash) {
+			t.Errorf("NewAddress(%s) = %v, %v", in, got, err)
+		}
+	}
+	for _, in := range []string{mainnet, "b3Vron4aXdUxd3XGpmbmRAfcVTiddaXpdEv"} {
+		if _, err := NewAddress(in); err != core.ErrInvalidAddressNetwork {
+			t.Errorf("NewAddress(%s) = %v, want: %v", in, err, core.ErrInvalidAddressNetwork)
+		}
+	}
+	if got, err := NewAddressOfNetwork(mainnet, "mainnet"); err != nil || !bytes.Equal(got.Hash(), pkHash) {
+		t.Errorf("NewAddressOfNetwork() = %v, %v", got, err)
+	}
+}
+
+func TestAddressScriptHash(t *testing.T) {
+	scriptHash := []byte{
+		0x0e, 0xf0, 0x30, 0x10, 0x7f, 0xd2, 0x6e, 0x0b, 0x6b, 0xf4,
+		0x05, 0x12, 0xbc, 0xa2, 0xce, 0xb1, 0xdd, 0x80, 0xad, 0xaa}
+	addr, err := NewAddressScriptHash(scriptHash)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := "b3Vron4aXdUxd3XGpmbmRAfcVTiddaXpdEv"
+	if got := addr.String(); got != want {
+		t.Errorf("String() = %v, want: %v", got, want)
+	}
+	for _, in := range []string{want, addr.Bech32()} {
+		got := &AddressScriptHash{}
+		if err := got.SetString(in); err != nil || !bytes.Equal(got.Hash(), scriptHash) {
+			t.Errorf("SetString(%s) = %v, hash: %x", in, err, got.Hash())
+		}
+	}
+
+	// pay to pubkey hash addresses are not script hash ones
+	pkhAddr, _ := NewAddressPubKeyHash(scriptHash)
+	for _, in := range []string{pkhAddr.String(), pkhAddr.Bech32()} {
+		if err := (&AddressScriptHash{}).SetString(in); err != core.ErrInvalidAddressString {
+			t.Errorf("SetString(%s) = %v, want: %v", in, err, core.ErrInvalidAddressString)
+		}
+	}
+
+	redeemScript := []byte{0x51, 0xae}
+	fromScript, _ := NewAddressFromScript(redeemScript)
+	if !bytes.Equal(fromScript.Hash(), crypto.Hash160(redeemScript)) {
+		t.Errorf("NewAddressFromScript() = %x", fromScript.Hash())
+	}
+	if _, err := NewAddressScriptHash(scriptHash[1:]); err != core.ErrInvalidPKHash {
+		t.Errorf("NewAddressScriptHash() = %v, want: %v", err, core.ErrInvalidPKHash)
+	}
+}
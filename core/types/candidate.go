@@ -0,0 +1,90 @@
+// Copyright (c) 2018 ContentBox Authors.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package types
+
+// CandidateInfo describes a registered candidate to be a miner
+type CandidateInfo struct {
+	Addr AddressHash
+	// Votes is the total of votes cast for the candidate minus withdrawn ones
+	Votes int64
+	// Absent tells if the candidate misses too many of its slots as a miner,
+	// as seen by this node. It's reported only
+	Absent bool
+}
+
+// MinerSlot is a time slot a miner mints a block in
+type MinerSlot struct {
+	// Time is the unix time in seconds the slot starts at
+	Time   int64
+	Miner  AddressHash
+	PeerID string
+}
+
+// MinerSchedule describes miners of an epoch and the slots they mint in
+type MinerSchedule struct {
+	// Epoch is the number of epochs passed since unix time 0
+	Epoch int64
+	// SlotInterval is the length of a slot in seconds
+	SlotInterval int64
+	// Slots are slots of the epoch in time order
+	Slots []*MinerSlot
+	// NextEpochTime is the unix time in seconds the next epoch starts at
+	NextEpochTime int64
+	// NextEpochHeight is the height of the first block of the next epoch if
+	// no slot till then is missed
+	NextEpochHeight uint32
+	// NextMiners are miners of the next epoch
+	NextMiners []AddressHash
+}
+
+// EpochMinerStats counts blocks a miner produced and was expected to produce
+// in an epoch
+type EpochMinerStats struct {
+	Epoch    int64
+	Produced uint32
+	Expected uint32
+}
+
+// MinerStats counts blocks a miner produced and was expected to produce in
+// recent epochs
+type MinerStats struct {
+	Miner    AddressHash
+	Produced uint32
+	Expected uint32
+	// Absent tells if the miner misses too many of its slots, as seen by
+	// this node. It's reported only
+	Absent bool
+	// Epochs breaks the counts down by epoch, in epoch order
+	Epochs []*EpochMinerStats
+}
+
+// EpochChange describes an epoch rolled over to
+type EpochChange struct {
+	// Epoch is the number of epochs passed since unix time 0
+	Epoch  int64
+	Miners []AddressHash
+}
+
+// MinerSetChange describes miners entering or leaving the active set at the
+// start of an epoch
+type MinerSetChange struct {
+	Epoch int64
+	// Entered are miners of the epoch not of the last one, and Left are
+	// miners of the last epoch not of this one
+	Entered []AddressHash
+	Left    []AddressHash
+	Miners  []AddressHash
+}
+
+// ChainParams are chain parameters adjustable by governance txs
+type ChainParams struct {
+	// BlockInterval is milliseconds between blocks
+	BlockInterval int64
+	// PeriodDuration is how many slots an epoch lasts, a multiple of the
+	// miners of a period, who mint in turn
+	PeriodDuration uint32
+	// MaxBlockSize is the max bytes of a block serialized
+	MaxBlockSize uint32
+}
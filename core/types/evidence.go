@@ -0,0 +1,106 @@
+// Copyright (c) 2018 ContentBox Authors.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package types
+
+import (
+	"bytes"
+	"errors"
+
+	"github.com/BOXFoundation/boxd/crypto"
+	"github.com/BOXFoundation/boxd/util"
+)
+
+// ErrInvalidDoubleMintEvidence is returned if two blocks do not prove a miner
+// minted both at the same timestamp
+var ErrInvalidDoubleMintEvidence = errors.New("Blocks are not minted by the same miner at the same time")
+
+// DoubleMintEvidence proves a miner minted two different blocks at the same
+// timestamp, i.e. in the same slot
+type DoubleMintEvidence struct {
+	Miner AddressHash
+	// First and Second are the conflicting blocks, without txs
+	First  *Block
+	Second *Block
+}
+
+// NewDoubleMintEvidence creates the evidence of first and second, which must
+// be different blocks signed by the same miner at the same timestamp
+func NewDoubleMintEvidence(first, second *Block) (*DoubleMintEvidence, error) {
+	if first.Header.TimeStamp != second.Header.TimeStamp || *first.BlockHash() == *second.BlockHash() {
+		return nil, ErrInvalidDoubleMintEvidence
+	}
+	miner, err := blockMiner(first)
+	if err != nil {
+		return nil, err
+	}
+	if other, err := blockMiner(second); err != nil || *other != *miner {
+		return nil, ErrInvalidDoubleMintEvidence
+	}
+	return &DoubleMintEvidence{
+		Miner:  *miner,
+		First:  &Block{Header: first.Header, Signature: first.Signature, Height: first.Height},
+		Second: &Block{Header: second.Header, Signature: second.Signature, Height: second.Height},
+	}, nil
+}
+
+// blockMiner recovers the address signing block
+func blockMiner(block *Block) (*AddressHash, error) {
+	pubKey, ok := crypto.RecoverCompact(block.BlockHash()[:], block.Signature)
+	if !ok {
+		return nil, ErrInvalidDoubleMintEvidence
+	}
+	addr, err := NewAddressFromPubKey(pubKey)
+	if err != nil {
+		return nil, err
+	}
+	return addr.Hash160(), nil
+}
+
+// Timestamp returns the timestamp both blocks are minted at
+func (e *DoubleMintEvidence) Timestamp() int64 {
+	return e.First.Header.TimeStamp
+}
+
+// Marshal marshals the DoubleMintEvidence to a binary representation of it.
+func (e *DoubleMintEvidence) Marshal() (data []byte, err error) {
+
+	var w bytes.Buffer
+	for _, block := range []*Block{e.First, e.Second} {
+		data, err := block.Marshal()
+		if err != nil {
+			return nil, err
+		}
+		if err := util.WriteVarBytes(&w, data); err != nil {
+			return nil, err
+		}
+	}
+
+	return w.Bytes(), nil
+}
+
+// Unmarshal unmarshals DoubleMintEvidence from binary data, which is
+// verified again
+func (e *DoubleMintEvidence) Unmarshal(data []byte) error {
+
+	var r = bytes.NewBuffer(data)
+	var blocks [2]*Block
+	for i := range blocks {
+		varbytes, err := util.ReadVarBytes(r)
+		if err != nil {
+			return err
+		}
+		blocks[i] = new(Block)
+		if err := blocks[i].Unmarshal(varbytes); err != nil {
+			return err
+		}
+	}
+	evidence, err := NewDoubleMintEvidence(blocks[0], blocks[1])
+	if err != nil {
+		return err
+	}
+	*e = *evidence
+
+	return nil
+}
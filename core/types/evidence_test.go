@@ -0,0 +1,47 @@
+// Copyright (c) 2018 ContentBox Authors.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package types
+
+import (
+	"testing"
+
+	"github.com/BOXFoundation/boxd/crypto"
+	"github.com/facebookgo/ensure"
+)
+
+func newSignedBlock(privKey *crypto.PrivateKey, timestamp int64, prevHash crypto.HashType) *Block {
+	block := &Block{Header: &BlockHeader{TimeStamp: timestamp, PrevBlockHash: prevHash}, Height: 10}
+	block.Signature, _ = crypto.SignCompact(privKey, block.BlockHash()[:])
+	return block
+}
+
+func TestDoubleMintEvidence(t *testing.T) {
+	privKey, pubKey, _ := crypto.NewKeyPair()
+	otherKey, _, _ := crypto.NewKeyPair()
+	miner, _ := NewAddressFromPubKey(pubKey)
+
+	first := newSignedBlock(privKey, 100, crypto.HashType{0x01})
+	second := newSignedBlock(privKey, 100, crypto.HashType{0x02})
+	evidence, err := NewDoubleMintEvidence(first, second)
+	ensure.Nil(t, err)
+	ensure.DeepEqual(t, evidence.Miner, *miner.Hash160())
+	ensure.DeepEqual(t, evidence.Timestamp(), int64(100))
+
+	data, err := evidence.Marshal()
+	ensure.Nil(t, err)
+	decoded := new(DoubleMintEvidence)
+	ensure.Nil(t, decoded.Unmarshal(data))
+	ensure.DeepEqual(t, decoded.Miner, evidence.Miner)
+	ensure.DeepEqual(t, *decoded.First.BlockHash(), *first.BlockHash())
+	ensure.DeepEqual(t, *decoded.Second.BlockHash(), *second.BlockHash())
+
+	// same block, different timestamps or different miners prove nothing
+	_, err = NewDoubleMintEvidence(first, first)
+	ensure.DeepEqual(t, err, ErrInvalidDoubleMintEvidence)
+	_, err = NewDoubleMintEvidence(first, newSignedBlock(privKey, 105, crypto.HashType{0x02}))
+	ensure.DeepEqual(t, err, ErrInvalidDoubleMintEvidence)
+	_, err = NewDoubleMintEvidence(first, newSignedBlock(otherKey, 100, crypto.HashType{0x02}))
+	ensure.DeepEqual(t, err, ErrInvalidDoubleMintEvidence)
+}
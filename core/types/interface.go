@@ -5,7 +5,9 @@
 package types
 
 import (
+	corepb "github.com/BOXFoundation/boxd/core/pb"
 	"github.com/BOXFoundation/boxd/crypto"
+	"github.com/BOXFoundation/boxd/storage"
 	peer "github.com/libp2p/go-libp2p-peer"
 )
 
@@ -13,13 +15,19 @@ import (
 type Consensus interface {
 	Run() error
 	Stop()
-	StoreCandidateContext(*crypto.HashType) error
+	// StoreCandidateContext enqueues the candidate context as of block into
+	// the batch of its other writes
+	StoreCandidateContext(*Block, storage.BatchWriter) error
 	VerifySign(*Block) (bool, error)
 	VerifyMinerEpoch(*Block) error
 	StopMint()
 	RecoverMint()
 	BroadcastEternalMsgToMiners(*Block) error
 	ValidateMiner() bool
+	VoterRewardOutputs(*Block) ([]*corepb.TxOut, error)
+	// ChainParams returns chain parameters in effect at unix time timestamp,
+	// as of block parent
+	ChainParams(parent *crypto.HashType, timestamp int64) (*ChainParams, error)
 }
 
 // SyncManager define sync manager interface
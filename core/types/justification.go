@@ -0,0 +1,159 @@
+// Copyright (c) 2018 ContentBox Authors.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package types
+
+import (
+	"bytes"
+	"sort"
+
+	"github.com/BOXFoundation/boxd/core"
+	corepb "github.com/BOXFoundation/boxd/core/pb"
+	"github.com/BOXFoundation/boxd/crypto"
+	conv "github.com/BOXFoundation/boxd/p2p/convert"
+	proto "github.com/gogo/protobuf/proto"
+)
+
+// eternalVotePrefix separates eternal votes from block signatures, which are
+// made by the same keys on block hashes too
+var eternalVotePrefix = []byte("box:eternal:")
+
+// EternalVoteHash returns the hash miners sign to vote block of blockHash
+// eternal
+func EternalVoteHash(blockHash *crypto.HashType) crypto.HashType {
+	return crypto.DoubleHashH(append(append([]byte{}, eternalVotePrefix...), blockHash[:]...))
+}
+
+// Justification proves a block is final with eternal votes of more than 2/3
+// of miners, aggregated into one BLS signature
+type Justification struct {
+	Hash crypto.HashType
+	// Voters are miners voting, in ascending order
+	Voters    []AddressHash
+	Signature []byte
+}
+
+var _ conv.Convertible = (*Justification)(nil)
+var _ conv.Serializable = (*Justification)(nil)
+
+// Quorum returns the least number of votes out of miners more than 2/3
+func Quorum(miners int) int {
+	return miners*2/3 + 1
+}
+
+// SignEternalVote signs the eternal vote on block of blockHash with the BLS
+// key of a miner
+func SignEternalVote(blsKey *crypto.BLSPrivateKey, blockHash *crypto.HashType) []byte {
+	voteHash := EternalVoteHash(blockHash)
+	return blsKey.Sign(voteHash[:]).Serialize()
+}
+
+// VerifyEternalVote checks if signature is the eternal vote on block of
+// blockHash signed by the BLS key of pubKey
+func VerifyEternalVote(pubKey *crypto.BLSPublicKey, blockHash *crypto.HashType, signature []byte) bool {
+	sig, err := crypto.BLSSignatureFromBytes(signature)
+	if err != nil {
+		return false
+	}
+	voteHash := EternalVoteHash(blockHash)
+	return sig.VerifySignature(pubKey, voteHash[:])
+}
+
+// NewJustification aggregates eternal vote signatures of voters on block of
+// blockHash into a justification
+func NewJustification(blockHash *crypto.HashType, signatures map[AddressHash][]byte) (*Justification, error) {
+	j := &Justification{Hash: *blockHash}
+	for voter := range signatures {
+		j.Voters = append(j.Voters, voter)
+	}
+	sort.Slice(j.Voters, func(i, k int) bool {
+		return bytes.Compare(j.Voters[i][:], j.Voters[k][:]) < 0
+	})
+	sigs := make([]*crypto.BLSSignature, len(j.Voters))
+	for i, voter := range j.Voters {
+		sig, err := crypto.BLSSignatureFromBytes(signatures[voter])
+		if err != nil {
+			return nil, err
+		}
+		sigs[i] = sig
+	}
+	sig, err := crypto.AggregateBLSSignatures(sigs...)
+	if err != nil {
+		return nil, err
+	}
+	j.Signature = sig.Serialize()
+	return j, nil
+}
+
+// Verify checks if the justification is signed by more than 2/3 of miners,
+// each counted once, given BLS public keys of the miners
+func (j *Justification) Verify(miners map[AddressHash]*crypto.BLSPublicKey) error {
+	if len(j.Voters) < Quorum(len(miners)) {
+		return core.ErrInvalidJustification
+	}
+	pubKeys := make([]*crypto.BLSPublicKey, len(j.Voters))
+	for i, voter := range j.Voters {
+		// ascending order rules out repeated voters
+		if i > 0 && bytes.Compare(j.Voters[i-1][:], voter[:]) >= 0 {
+			return core.ErrInvalidJustification
+		}
+		pubKey, ok := miners[voter]
+		if !ok || pubKey == nil {
+			return core.ErrInvalidJustification
+		}
+		pubKeys[i] = pubKey
+	}
+	pubKey, err := crypto.AggregateBLSPublicKeys(pubKeys...)
+	if err != nil {
+		return err
+	}
+	if !VerifyEternalVote(pubKey, &j.Hash, j.Signature) {
+		return core.ErrInvalidJustification
+	}
+	return nil
+}
+
+// ToProtoMessage converts Justification to proto message.
+func (j *Justification) ToProtoMessage() (proto.Message, error) {
+	voters := make([][]byte, len(j.Voters))
+	for i := range j.Voters {
+		voters[i] = j.Voters[i][:]
+	}
+	return &corepb.Justification{
+		Hash:      j.Hash[:],
+		Voters:    voters,
+		Signature: j.Signature,
+	}, nil
+}
+
+// FromProtoMessage converts proto message to Justification.
+func (j *Justification) FromProtoMessage(message proto.Message) error {
+	if message, ok := message.(*corepb.Justification); ok {
+		if message != nil {
+			copy(j.Hash[:], message.Hash)
+			j.Voters = make([]AddressHash, len(message.Voters))
+			for i, voter := range message.Voters {
+				copy(j.Voters[i][:], voter)
+			}
+			j.Signature = message.Signature
+			return nil
+		}
+		return core.ErrEmptyProtoMessage
+	}
+	return core.ErrInvalidJustificationProtoMessage
+}
+
+// Marshal method marshal Justification object to binary
+func (j *Justification) Marshal() (data []byte, err error) {
+	return conv.MarshalConvertible(j)
+}
+
+// Unmarshal method unmarshal binary data to Justification object
+func (j *Justification) Unmarshal(data []byte) error {
+	msg := &corepb.Justification{}
+	if err := proto.Unmarshal(data, msg); err != nil {
+		return err
+	}
+	return j.FromProtoMessage(msg)
+}
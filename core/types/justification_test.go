@@ -0,0 +1,82 @@
+// Copyright (c) 2018 ContentBox Authors.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package types
+
+import (
+	"testing"
+
+	"github.com/BOXFoundation/boxd/core"
+	"github.com/BOXFoundation/boxd/crypto"
+	"github.com/facebookgo/ensure"
+)
+
+func TestJustificationVerify(t *testing.T) {
+	hash := crypto.HashType{0x01}
+	miners := make(map[AddressHash]*crypto.BLSPublicKey)
+	signatures := make(map[AddressHash][]byte)
+	var voters []AddressHash
+	var blsKeys []*crypto.BLSPrivateKey
+	for i := 0; i < 6; i++ {
+		_, pubKey, _ := crypto.NewKeyPair()
+		addr, _ := NewAddressFromPubKey(pubKey)
+		blsKey, blsPubKey, _ := crypto.NewBLSKeyPair()
+		miners[*addr.Hash160()] = blsPubKey
+		voters = append(voters, *addr.Hash160())
+		blsKeys = append(blsKeys, blsKey)
+		signature := SignEternalVote(blsKey, &hash)
+		ensure.True(t, VerifyEternalVote(blsPubKey, &hash, signature))
+		if i < 5 {
+			signatures[*addr.Hash160()] = signature
+		}
+	}
+	ensure.DeepEqual(t, Quorum(len(miners)), 5)
+
+	justification, err := NewJustification(&hash, signatures)
+	ensure.Nil(t, err)
+	ensure.DeepEqual(t, len(justification.Voters), 5)
+	ensure.Nil(t, justification.Verify(miners))
+	data, err := justification.Marshal()
+	ensure.Nil(t, err)
+	decoded := new(Justification)
+	ensure.Nil(t, decoded.Unmarshal(data))
+	ensure.DeepEqual(t, decoded, justification)
+
+	// 2/3 of miners are not enough, nor are repeated votes
+	short := &Justification{Hash: hash, Voters: justification.Voters[:4], Signature: justification.Signature}
+	ensure.DeepEqual(t, short.Verify(miners), core.ErrInvalidJustification)
+	repeated := &Justification{Hash: hash, Signature: justification.Signature,
+		Voters: append(justification.Voters[:4:4], justification.Voters[3])}
+	ensure.DeepEqual(t, repeated.Verify(miners), core.ErrInvalidJustification)
+
+	// votes of others than miners or on other blocks are invalid
+	others := make(map[AddressHash]*crypto.BLSPublicKey)
+	for voter, pubKey := range miners {
+		if voter != justification.Voters[0] {
+			others[voter] = pubKey
+		}
+	}
+	ensure.DeepEqual(t, justification.Verify(others), core.ErrInvalidJustification)
+	otherBlock := &Justification{Hash: crypto.HashType{0x02}, Voters: justification.Voters,
+		Signature: justification.Signature}
+	ensure.DeepEqual(t, otherBlock.Verify(miners), core.ErrInvalidJustification)
+
+	// voters must be those signing
+	var missing AddressHash
+	for _, voter := range voters {
+		if _, ok := signatures[voter]; !ok {
+			missing = voter
+		}
+	}
+	signatures[missing] = SignEternalVote(blsKeys[5], &hash)
+	all, err := NewJustification(&hash, signatures)
+	ensure.Nil(t, err)
+	ensure.Nil(t, all.Verify(miners))
+	forged := &Justification{Hash: hash, Voters: all.Voters, Signature: justification.Signature}
+	ensure.DeepEqual(t, forged.Verify(miners), core.ErrInvalidJustification)
+
+	// signatures on the block hash itself are no votes
+	blockSignature := blsKeys[0].Sign(hash[:]).Serialize()
+	ensure.False(t, VerifyEternalVote(miners[voters[0]], &hash, blockSignature))
+}
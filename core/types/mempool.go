@@ -0,0 +1,70 @@
+// Copyright (c) 2018 ContentBox Authors.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package types
+
+import "fmt"
+
+// MempoolEntry describes a transaction waiting in tx pool
+type MempoolEntry struct {
+	Tx       *Transaction
+	Size     int
+	Fee      uint64
+	FeePerKB uint64
+	// AddedTimestamp is the unix time the tx entered tx pool
+	AddedTimestamp int64
+	// Height is the height of the next block when the tx entered tx pool
+	Height uint32
+	// AncestorFee and AncestorSize total the tx and its ancestors in tx
+	// pool, DescendantFee and DescendantSize the tx and its descendants
+	AncestorFee    uint64
+	AncestorSize   int64
+	DescendantFee  uint64
+	DescendantSize int64
+}
+
+// TxRemoveReason tells why a tx leaves tx pool
+type TxRemoveReason int
+
+// reasons of txs leaving tx pool
+const (
+	// TxRemoveConfirmed is a tx included in a block connected to main chain
+	TxRemoveConfirmed TxRemoveReason = iota
+	// TxRemoveConflicted is a tx double spent by a confirmed tx, or a
+	// descendant of one
+	TxRemoveConflicted
+	// TxRemoveEvicted is a tx evicted from full tx pool, or a descendant of one
+	TxRemoveEvicted
+	// TxRemoveExpired is a tx in tx pool for too long, or a descendant of one
+	TxRemoveExpired
+	// TxRemoveReplaced is a tx replaced by a conflicting one paying more, or
+	// a descendant of one
+	TxRemoveReplaced
+)
+
+func (r TxRemoveReason) String() string {
+	switch r {
+	case TxRemoveConfirmed:
+		return "confirmed"
+	case TxRemoveConflicted:
+		return "conflicted"
+	case TxRemoveEvicted:
+		return "evicted"
+	case TxRemoveExpired:
+		return "expired"
+	case TxRemoveReplaced:
+		return "replaced"
+	}
+	return fmt.Sprintf("TxRemoveReason(%d)", int(r))
+}
+
+// MempoolInfo summarizes tx pool
+type MempoolInfo struct {
+	// Size is the number of txs in main pool, orphans excluded
+	Size    int
+	Bytes   int
+	Orphans int
+	// MinFeePerKB is the min fee rate tx pool admits txs at
+	MinFeePerKB uint64
+}
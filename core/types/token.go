@@ -0,0 +1,17 @@
+// Copyright (c) 2018 ContentBox Authors.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package types
+
+// TokenInfo describes a token issued on the main chain
+type TokenInfo struct {
+	// Token is the outpoint the token is issued at, which identifies it
+	Token       OutPoint
+	Name        string
+	TotalSupply uint64
+	// Issuer is the address the whole supply is issued to
+	Issuer string
+	// Height is the height of the block issuing the token
+	Height uint32
+}
@@ -5,6 +5,8 @@
 package types
 
 import (
+	"math"
+
 	"github.com/BOXFoundation/boxd/core"
 	corepb "github.com/BOXFoundation/boxd/core/pb"
 	"github.com/BOXFoundation/boxd/crypto"
@@ -17,6 +19,16 @@ const (
 	GeneralTx = iota
 	RegisterCandidateTx
 	VoteTx
+	GovernanceTx
+	DoubleMintEvidenceTx
+)
+
+// Sequences of inputs of txs built by the node and clients. Inputs are final
+// by default, and txs opt in to be replaced in mempool by a conflicting tx
+// paying more with replaceable inputs, as BIP125
+const (
+	SequenceFinal       uint32 = math.MaxUint32
+	SequenceReplaceable uint32 = math.MaxUint32 - 2
 )
 
 // Transaction defines a transaction.
@@ -15,6 +15,12 @@ type SignUpContent struct {
 	addr AddressHash
 }
 
+// NewSignUpContent creates the content of a tx registering addr as a
+// candidate
+func NewSignUpContent(addr AddressHash) *SignUpContent {
+	return &SignUpContent{addr: addr}
+}
+
 // Marshal marshals the SignUpContent to a binary representation of it.
 func (sc *SignUpContent) Marshal() (data []byte, err error) {
 
@@ -50,6 +56,12 @@ type VoteContent struct {
 	votes int64
 }
 
+// NewVoteContent creates the content of a tx casting votes for candidate
+// addr, withdrawing them if votes is negative
+func NewVoteContent(addr AddressHash, votes int64) *VoteContent {
+	return &VoteContent{addr: addr, votes: votes}
+}
+
 // Marshal marshals the VoteContent to a binary representation of it.
 func (vc *VoteContent) Marshal() (data []byte, err error) {
 
@@ -88,3 +100,54 @@ func (vc *VoteContent) Addr() AddressHash {
 func (vc *VoteContent) Votes() int64 {
 	return vc.votes
 }
+
+// GovernanceContent identify the tx of governance type, proposing chain
+// parameters
+type GovernanceContent struct {
+	params ChainParams
+}
+
+// NewGovernanceContent creates the content of a tx proposing params
+func NewGovernanceContent(params *ChainParams) *GovernanceContent {
+	return &GovernanceContent{params: *params}
+}
+
+// Marshal marshals the GovernanceContent to a binary representation of it.
+func (gc *GovernanceContent) Marshal() (data []byte, err error) {
+
+	var w bytes.Buffer
+	if err := util.WriteInt64(&w, gc.params.BlockInterval); err != nil {
+		return nil, err
+	}
+	if err := util.WriteUint32(&w, gc.params.PeriodDuration); err != nil {
+		return nil, err
+	}
+	if err := util.WriteUint32(&w, gc.params.MaxBlockSize); err != nil {
+		return nil, err
+	}
+
+	return w.Bytes(), nil
+}
+
+// Unmarshal unmarshals GovernanceContent from binary data.
+func (gc *GovernanceContent) Unmarshal(data []byte) error {
+	var r = bytes.NewBuffer(data)
+	var err error
+	if gc.params.BlockInterval, err = util.ReadInt64(r); err != nil {
+		return err
+	}
+	if gc.params.PeriodDuration, err = util.ReadUint32(r); err != nil {
+		return err
+	}
+	if gc.params.MaxBlockSize, err = util.ReadUint32(r); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Params returns chain parameters proposed in governanceContent.
+func (gc *GovernanceContent) Params() *ChainParams {
+	params := gc.params
+	return &params
+}
@@ -0,0 +1,40 @@
+// Copyright (c) 2018 ContentBox Authors.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package types
+
+import (
+	"github.com/BOXFoundation/boxd/crypto"
+)
+
+// TxRecord describes a transaction on the main chain from the view of an address
+type TxRecord struct {
+	Tx        *Transaction
+	BlockHash crypto.HashType
+	Height    uint32
+	// Index is the position of the tx within its block
+	Index     uint32
+	TimeStamp int64
+
+	// IsSent is set if the tx spends any output owned by the address
+	IsSent bool
+	// Spent is the total value of the address's outputs consumed by the tx
+	Spent uint64
+	// Received is the total value the tx pays to the address
+	Received uint64
+	// HasToken is set if the tx moves tokens from or to the address
+	HasToken bool
+}
+
+// Amount returns the net value the tx moves for the address: the value paid
+// out to others if the address funds it, or the value received otherwise
+func (r *TxRecord) Amount() uint64 {
+	if r.IsSent {
+		if r.Spent > r.Received {
+			return r.Spent - r.Received
+		}
+		return 0
+	}
+	return r.Received
+}
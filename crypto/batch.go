@@ -0,0 +1,61 @@
+// Copyright (c) 2018 ContentBox Authors.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package crypto
+
+import (
+	"runtime"
+	"sync"
+	"sync/atomic"
+)
+
+// minBatchPerWorker is the least number of signatures a worker verifies, so
+// that small batches are not slowed down by goroutines
+const minBatchPerWorker = 16
+
+// VerifyBatch verifies that each of sigs is created by the public key of the
+// same index over the hash of the same index, returning true only if all
+// are. ECDSA signatures keep only x of the point R, so they can't be
+// combined into one check algebraically as Schnorr or BLS ones; they are
+// verified in parallel instead, stopping at the first invalid one
+func VerifyBatch(sigs []*Signature, pubKeys []*PublicKey, hashes []*HashType) bool {
+	if len(sigs) != len(pubKeys) || len(sigs) != len(hashes) {
+		return false
+	}
+	workers := runtime.NumCPU()
+	if n := (len(sigs) + minBatchPerWorker - 1) / minBatchPerWorker; n < workers {
+		workers = n
+	}
+	if workers <= 1 {
+		for i, sig := range sigs {
+			if !sig.VerifySignature(pubKeys[i], hashes[i]) {
+				return false
+			}
+		}
+		return true
+	}
+
+	var (
+		next    int64 = -1
+		invalid int32
+		wg      sync.WaitGroup
+	)
+	wg.Add(workers)
+	for w := 0; w < workers; w++ {
+		go func() {
+			defer wg.Done()
+			for atomic.LoadInt32(&invalid) == 0 {
+				i := int(atomic.AddInt64(&next, 1))
+				if i >= len(sigs) {
+					return
+				}
+				if !sigs[i].VerifySignature(pubKeys[i], hashes[i]) {
+					atomic.StoreInt32(&invalid, 1)
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	return invalid == 0
+}
@@ -0,0 +1,68 @@
+// Copyright (c) 2018 ContentBox Authors.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package crypto
+
+import (
+	"testing"
+
+	"github.com/facebookgo/ensure"
+)
+
+func newBatch(t testing.TB, n int) ([]*Signature, []*PublicKey, []*HashType) {
+	sigs := make([]*Signature, n)
+	pubKeys := make([]*PublicKey, n)
+	hashes := make([]*HashType, n)
+	for i := 0; i < n; i++ {
+		privKey, pubKey, err := NewKeyPair()
+		ensure.Nil(t, err)
+		hash := DoubleHashH([]byte{byte(i), byte(i >> 8)})
+		sig, err := Sign(privKey, &hash)
+		ensure.Nil(t, err)
+		sigs[i], pubKeys[i], hashes[i] = sig, pubKey, &hash
+	}
+	return sigs, pubKeys, hashes
+}
+
+func TestVerifyBatch(t *testing.T) {
+	// small batches are verified serially, larger ones in parallel
+	for _, n := range []int{0, 1, 5, 100} {
+		sigs, pubKeys, hashes := newBatch(t, n)
+		ensure.True(t, VerifyBatch(sigs, pubKeys, hashes))
+		if n == 0 {
+			continue
+		}
+
+		// any invalid signature fails the batch
+		last := len(sigs) - 1
+		hashes[0], hashes[last] = hashes[last], hashes[0]
+		if n == 1 {
+			hashes[0] = &HashType{0x01}
+		}
+		ensure.False(t, VerifyBatch(sigs, pubKeys, hashes))
+		ensure.False(t, VerifyBatch(sigs, pubKeys[:last], hashes))
+	}
+}
+
+func BenchmarkVerifySerial(b *testing.B) {
+	sigs, pubKeys, hashes := newBatch(b, 1000)
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		for i, sig := range sigs {
+			if !sig.VerifySignature(pubKeys[i], hashes[i]) {
+				b.Fatal("invalid signature")
+			}
+		}
+	}
+}
+
+func BenchmarkVerifyBatch(b *testing.B) {
+	sigs, pubKeys, hashes := newBatch(b, 1000)
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		if !VerifyBatch(sigs, pubKeys, hashes) {
+			b.Fatal("invalid signature")
+		}
+	}
+}
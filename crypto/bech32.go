@@ -0,0 +1,33 @@
+// Copyright (c) 2018 ContentBox Authors.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package crypto
+
+import (
+	"github.com/btcsuite/btcutil/bech32"
+)
+
+// Bech32Encode converts input bytes to bech32 format with human readable
+// part hrp, checksummed by the bech32 BCH code
+func Bech32Encode(hrp string, in []byte) (string, error) {
+	data, err := bech32.ConvertBits(in, 8, 5, true)
+	if err != nil {
+		return "", err
+	}
+	return bech32.Encode(hrp, data)
+}
+
+// Bech32Decode converts a bech32 format string to its lowercase human
+// readable part and byte array content, checking the checksum
+func Bech32Decode(in string) (string, []byte, error) {
+	hrp, data, err := bech32.Decode(in)
+	if err != nil {
+		return "", nil, ErrInvalidBech32Encoding
+	}
+	content, err := bech32.ConvertBits(data, 5, 8, false)
+	if err != nil {
+		return "", nil, ErrInvalidBech32Encoding
+	}
+	return hrp, content, nil
+}
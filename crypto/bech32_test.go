@@ -0,0 +1,30 @@
+// Copyright (c) 2018 ContentBox Authors.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package crypto
+
+import (
+	"testing"
+
+	"github.com/facebookgo/ensure"
+)
+
+func TestBech32(t *testing.T) {
+	data := []byte{0x00, 0x0e, 0xf0, 0x30, 0x10, 0x7f, 0xd2, 0x6e, 0x0b, 0x6b, 0xf4,
+		0x05, 0x12, 0xbc, 0xa2, 0xce, 0xb1, 0xdd, 0x80, 0xad, 0xaa}
+	encoded, err := Bech32Encode("box", data)
+	ensure.Nil(t, err)
+	ensure.DeepEqual(t, encoded, "box1qq80qvqs0lfxuzmt7sz3909ze6camq9d4g7vqphz")
+
+	hrp, decoded, err := Bech32Decode(encoded)
+	ensure.Nil(t, err)
+	ensure.DeepEqual(t, hrp, "box")
+	ensure.DeepEqual(t, decoded, data)
+
+	// checksum errors and base58 strings are rejected
+	_, _, err = Bech32Decode("box1qq80qvqs0lfxuzmt7sz3909ze6camq9d4g7vqphx")
+	ensure.DeepEqual(t, err, ErrInvalidBech32Encoding)
+	_, _, err = Bech32Decode("b1VAnrX665aeExMaPeW6pk3FZKCLuywUaHw")
+	ensure.DeepEqual(t, err, ErrInvalidBech32Encoding)
+}
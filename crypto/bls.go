@@ -0,0 +1,209 @@
+// Copyright (c) 2018 ContentBox Authors.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package crypto
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/hex"
+	"io/ioutil"
+	"os"
+
+	"github.com/cloudflare/circl/ecc/bls12381"
+)
+
+// BLS signatures are points of G1 and public keys points of G2 of the
+// BLS12-381 pairing, serialized compressed as in the IETF BLS signature
+// draft, so that signatures are short and those of the same message
+// aggregate into one verifiable against the sum of the public keys. Public
+// keys aggregated must be trusted, e.g. configured, or have their possession
+// proven, or rogue keys can forge aggregates.
+const (
+	// BLSPrivateKeySize is the size of a serialized BLS private key
+	BLSPrivateKeySize = bls12381.ScalarSize
+	// BLSPublicKeySize is the size of a serialized BLS public key
+	BLSPublicKeySize = bls12381.G2SizeCompressed
+	// BLSSignatureSize is the size of a serialized BLS signature
+	BLSSignatureSize = bls12381.G1SizeCompressed
+)
+
+// blsDomain separates messages hashed onto G1 from other hashes, the tag of
+// the proof of possession suite signing in G1, as keys aggregated are trusted
+var blsDomain = []byte("BLS_SIG_BLS12381G1_XMD:SHA-256_SSWU_RO_POP_")
+
+// BLSPrivateKey is a BLS private key, a non zero scalar less than the order
+// of the groups
+type BLSPrivateKey struct {
+	k *bls12381.Scalar
+}
+
+// BLSPublicKey is a BLS public key
+type BLSPublicKey struct {
+	p *bls12381.G2
+}
+
+// BLSSignature is a BLS signature, or an aggregate of ones on the same
+// message
+type BLSSignature struct {
+	p *bls12381.G1
+}
+
+// NewBLSKeyPair returns a new BLS private and public key pair
+func NewBLSKeyPair() (*BLSPrivateKey, *BLSPublicKey, error) {
+	k := new(bls12381.Scalar)
+	for k.IsZero() == 1 {
+		if err := k.Random(rand.Reader); err != nil {
+			return nil, nil, err
+		}
+	}
+	privKey := &BLSPrivateKey{k: k}
+	return privKey, privKey.PubKey(), nil
+}
+
+// BLSKeyPairFromBytes returns a BLS private and public key pair from private
+// key serialized
+func BLSKeyPairFromBytes(privKeyBytes []byte) (*BLSPrivateKey, *BLSPublicKey, error) {
+	if len(privKeyBytes) != BLSPrivateKeySize {
+		return nil, nil, ErrInvalidBLSPrivateKey
+	}
+	k := new(bls12381.Scalar)
+	if err := k.UnmarshalBinary(privKeyBytes); err != nil || k.IsZero() == 1 {
+		return nil, nil, ErrInvalidBLSPrivateKey
+	}
+	privKey := &BLSPrivateKey{k: k}
+	return privKey, privKey.PubKey(), nil
+}
+
+// LoadBLSKey loads the hex encoded BLS private key in file path, generating
+// it if the file doesn't exist
+func LoadBLSKey(path string) (*BLSPrivateKey, error) {
+	data, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		privKey, _, err := NewBLSKeyPair()
+		if err != nil {
+			return nil, err
+		}
+		logger.Infof("Generating BLS key at %s", path)
+		return privKey, ioutil.WriteFile(path, []byte(hex.EncodeToString(privKey.Serialize())), 0600)
+	}
+	if err != nil {
+		return nil, err
+	}
+	privKeyBytes, err := hex.DecodeString(string(bytes.TrimSpace(data)))
+	if err != nil {
+		return nil, ErrInvalidBLSPrivateKey
+	}
+	privKey, _, err := BLSKeyPairFromBytes(privKeyBytes)
+	return privKey, err
+}
+
+// Serialize converts BLS private key into byte array
+func (p *BLSPrivateKey) Serialize() []byte {
+	buf, _ := p.k.MarshalBinary()
+	return buf
+}
+
+// PubKey returns the BLSPublicKey corresponding to this private key
+func (p *BLSPrivateKey) PubKey() *BLSPublicKey {
+	pubKey := new(bls12381.G2)
+	pubKey.ScalarMult(p.k, bls12381.G2Generator())
+	return &BLSPublicKey{p: pubKey}
+}
+
+// Sign signs message with the BLS private key
+func (p *BLSPrivateKey) Sign(message []byte) *BLSSignature {
+	sig := new(bls12381.G1)
+	sig.ScalarMult(p.k, hashToG1(message))
+	return &BLSSignature{p: sig}
+}
+
+// Serialize converts BLS public key into byte array
+func (p *BLSPublicKey) Serialize() []byte {
+	return p.p.BytesCompressed()
+}
+
+// IsEqual returns if the passed BLS public key is equivalent to this one
+func (p *BLSPublicKey) IsEqual(other *BLSPublicKey) bool {
+	return p.p.IsEqual(other.p)
+}
+
+// BLSPublicKeyFromBytes returns BLS public key from raw bytes, which must be
+// a point of G2 other than the identity
+func BLSPublicKeyFromBytes(publicKeyStr []byte) (*BLSPublicKey, error) {
+	if len(publicKeyStr) != BLSPublicKeySize {
+		return nil, ErrInvalidBLSPublicKey
+	}
+	// SetBytes checks the point is in G2, not only on the curve
+	p := new(bls12381.G2)
+	if err := p.SetBytes(publicKeyStr); err != nil || p.IsIdentity() {
+		return nil, ErrInvalidBLSPublicKey
+	}
+	return &BLSPublicKey{p: p}, nil
+}
+
+// AggregateBLSPublicKeys sums BLS public keys, to verify aggregate signatures
+// of their private keys against
+func AggregateBLSPublicKeys(pubKeys ...*BLSPublicKey) (*BLSPublicKey, error) {
+	if len(pubKeys) == 0 {
+		return nil, ErrInvalidBLSPublicKey
+	}
+	sum := *pubKeys[0].p
+	for _, pubKey := range pubKeys[1:] {
+		sum.Add(&sum, pubKey.p)
+	}
+	return &BLSPublicKey{p: &sum}, nil
+}
+
+// Serialize converts BLS signature into byte array
+func (sig *BLSSignature) Serialize() []byte {
+	return sig.p.BytesCompressed()
+}
+
+// BLSSignatureFromBytes returns BLS signature from raw bytes, which must be
+// a point of G1
+func BLSSignatureFromBytes(sigStr []byte) (*BLSSignature, error) {
+	if len(sigStr) != BLSSignatureSize {
+		return nil, ErrInvalidBLSSignature
+	}
+	p := new(bls12381.G1)
+	if err := p.SetBytes(sigStr); err != nil {
+		return nil, ErrInvalidBLSSignature
+	}
+	return &BLSSignature{p: p}, nil
+}
+
+// AggregateBLSSignatures sums BLS signatures on the same message into one,
+// which is verified against the aggregate of their public keys
+func AggregateBLSSignatures(sigs ...*BLSSignature) (*BLSSignature, error) {
+	if len(sigs) == 0 {
+		return nil, ErrInvalidBLSSignature
+	}
+	sum := *sigs[0].p
+	for _, sig := range sigs[1:] {
+		sum.Add(&sum, sig.p)
+	}
+	return &BLSSignature{p: &sum}, nil
+}
+
+// VerifySignature verifies that the given BLS public key, or an aggregate of
+// ones, created signature over message
+func (sig *BLSSignature) VerifySignature(pubKey *BLSPublicKey, message []byte) bool {
+	// e(sig, g2) / e(H(m), pk) == 1. Points are copied, as pairing
+	// normalizes them in place
+	s := *sig.p
+	return bls12381.ProdPairFrac(
+		[]*bls12381.G1{&s, hashToG1(message)},
+		[]*bls12381.G2{bls12381.G2Generator(), pubKey.p},
+		[]int{1, -1},
+	).IsIdentity()
+}
+
+// hashToG1 hashes message onto a point of G1 with hash_to_curve of the IETF
+// draft, in constant time unlike try-and-increment
+func hashToG1(message []byte) *bls12381.G1 {
+	p := new(bls12381.G1)
+	p.Hash(message, blsDomain)
+	return p
+}
@@ -0,0 +1,91 @@
+// Copyright (c) 2018 ContentBox Authors.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package crypto
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/facebookgo/ensure"
+)
+
+func TestBLSSignature(t *testing.T) {
+	privKey, pubKey, err := NewBLSKeyPair()
+	ensure.Nil(t, err)
+	message := []byte("message")
+	sig := privKey.Sign(message)
+	ensure.True(t, sig.VerifySignature(pubKey, message))
+	ensure.False(t, sig.VerifySignature(pubKey, []byte("other message")))
+
+	_, otherPubKey, _ := NewBLSKeyPair()
+	ensure.False(t, sig.VerifySignature(otherPubKey, message))
+
+	// serialization round trips
+	decodedPrivKey, decodedPubKey, err := BLSKeyPairFromBytes(privKey.Serialize())
+	ensure.Nil(t, err)
+	ensure.True(t, decodedPubKey.IsEqual(pubKey))
+	ensure.DeepEqual(t, decodedPrivKey.Serialize(), privKey.Serialize())
+	decodedPubKey, err = BLSPublicKeyFromBytes(pubKey.Serialize())
+	ensure.Nil(t, err)
+	ensure.True(t, decodedPubKey.IsEqual(pubKey))
+	ensure.DeepEqual(t, len(pubKey.Serialize()), BLSPublicKeySize)
+	decodedSig, err := BLSSignatureFromBytes(sig.Serialize())
+	ensure.Nil(t, err)
+	ensure.DeepEqual(t, len(sig.Serialize()), BLSSignatureSize)
+	ensure.True(t, decodedSig.VerifySignature(pubKey, message))
+
+	_, err = BLSPublicKeyFromBytes(make([]byte, BLSPublicKeySize))
+	ensure.DeepEqual(t, err, ErrInvalidBLSPublicKey)
+	// the identity, compressed, is a valid point but no key
+	identity := make([]byte, BLSPublicKeySize)
+	identity[0] = 0xc0
+	_, err = BLSPublicKeyFromBytes(identity)
+	ensure.DeepEqual(t, err, ErrInvalidBLSPublicKey)
+	_, err = BLSPublicKeyFromBytes(append(pubKey.Serialize(), 0))
+	ensure.DeepEqual(t, err, ErrInvalidBLSPublicKey)
+	_, err = BLSSignatureFromBytes(sig.Serialize()[1:])
+	ensure.DeepEqual(t, err, ErrInvalidBLSSignature)
+	_, _, err = BLSKeyPairFromBytes(make([]byte, BLSPrivateKeySize))
+	ensure.DeepEqual(t, err, ErrInvalidBLSPrivateKey)
+}
+
+func TestBLSAggregate(t *testing.T) {
+	message := []byte("message")
+	var pubKeys []*BLSPublicKey
+	var sigs []*BLSSignature
+	for i := 0; i < 4; i++ {
+		privKey, pubKey, _ := NewBLSKeyPair()
+		pubKeys = append(pubKeys, pubKey)
+		sigs = append(sigs, privKey.Sign(message))
+	}
+	sig, err := AggregateBLSSignatures(sigs...)
+	ensure.Nil(t, err)
+	pubKey, err := AggregateBLSPublicKeys(pubKeys...)
+	ensure.Nil(t, err)
+	ensure.True(t, sig.VerifySignature(pubKey, message))
+
+	// the aggregate of others or fewer keys doesn't verify
+	pubKey, _ = AggregateBLSPublicKeys(pubKeys[:3]...)
+	ensure.False(t, sig.VerifySignature(pubKey, message))
+	pubKey, _ = AggregateBLSPublicKeys(append(pubKeys[:3:3], pubKeys[0])...)
+	ensure.False(t, sig.VerifySignature(pubKey, message))
+
+	_, err = AggregateBLSSignatures()
+	ensure.NotNil(t, err)
+}
+
+func TestLoadBLSKey(t *testing.T) {
+	dir, err := ioutil.TempDir("", "bls")
+	ensure.Nil(t, err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "bls.key")
+	privKey, err := LoadBLSKey(path)
+	ensure.Nil(t, err)
+	loaded, err := LoadBLSKey(path)
+	ensure.Nil(t, err)
+	ensure.DeepEqual(t, loaded.Serialize(), privKey.Serialize())
+}
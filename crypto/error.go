@@ -12,4 +12,19 @@ var (
 	ErrInvalidBase58Encoding     = errors.New("Invalid base58 encoding")
 	ErrInvalidBase58Checksum     = errors.New("Invalid base58 checksum")
 	ErrInvalidBase58StringLength = errors.New("Invalid base58 string length, not enough bytes for checksum")
+
+	//bech32.go
+	ErrInvalidBech32Encoding = errors.New("Invalid bech32 encoding")
+
+	//bls.go
+	ErrInvalidBLSPrivateKey = errors.New("Invalid BLS private key")
+	ErrInvalidBLSPublicKey  = errors.New("Invalid BLS public key")
+	ErrInvalidBLSSignature  = errors.New("Invalid BLS signature")
+
+	//multisig.go
+	ErrInvalidMultisigThreshold   = errors.New("Invalid multisig, m-of-n requires 1 <= m <= n <= 16")
+	ErrDuplicateMultisigKey       = errors.New("Duplicate public key in multisig")
+	ErrNotMultisigKey             = errors.New("Public key is not a participant of the multisig")
+	ErrInvalidPartialSignature    = errors.New("Invalid partial signature")
+	ErrNotEnoughPartialSignatures = errors.New("Not enough partial signatures for the multisig")
 )
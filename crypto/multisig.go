@@ -0,0 +1,152 @@
+// Copyright (c) 2018 ContentBox Authors.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package crypto
+
+import (
+	"bytes"
+	"sort"
+)
+
+// MaxMultisigKeys is the most participants of a multisig, as counts of keys
+// and signatures are pushed with OP_1 to OP_16 in redeem scripts
+const MaxMultisigKeys = 16
+
+// MultisigKeys are public keys of participants of an m-of-n multisig. Keys
+// are sorted by their serialization, so that participants exchanging keys in
+// any order agree on the same redeem script and address
+type MultisigKeys struct {
+	M       int
+	PubKeys []*PublicKey
+}
+
+// NewMultisigKeys combines public keys of participants into an m-of-n
+// multisig, n being the number of keys
+func NewMultisigKeys(m int, pubKeys []*PublicKey) (*MultisigKeys, error) {
+	n := len(pubKeys)
+	if n == 0 || n > MaxMultisigKeys || m < 1 || m > n {
+		return nil, ErrInvalidMultisigThreshold
+	}
+	sorted := make([]*PublicKey, n)
+	copy(sorted, pubKeys)
+	sort.Slice(sorted, func(i, j int) bool {
+		return bytes.Compare(sorted[i].Serialize(), sorted[j].Serialize()) < 0
+	})
+	for i := 1; i < n; i++ {
+		if bytes.Equal(sorted[i-1].Serialize(), sorted[i].Serialize()) {
+			return nil, ErrDuplicateMultisigKey
+		}
+	}
+	return &MultisigKeys{M: m, PubKeys: sorted}, nil
+}
+
+// GenerateMultisigKeys generates keys of n participants of an m-of-n
+// multisig at once, e.g. for tests or one party holding all keys. Private
+// keys are returned in the order of the combined public keys
+func GenerateMultisigKeys(m, n int) ([]*PrivateKey, *MultisigKeys, error) {
+	if n < 1 || n > MaxMultisigKeys {
+		return nil, nil, ErrInvalidMultisigThreshold
+	}
+	privKeys := make([]*PrivateKey, n)
+	pubKeys := make([]*PublicKey, n)
+	for i := 0; i < n; i++ {
+		privKey, pubKey, err := NewKeyPair()
+		if err != nil {
+			return nil, nil, err
+		}
+		privKeys[i], pubKeys[i] = privKey, pubKey
+	}
+	keys, err := NewMultisigKeys(m, pubKeys)
+	if err != nil {
+		return nil, nil, err
+	}
+	sort.Slice(privKeys, func(i, j int) bool {
+		return keys.Index(privKeys[i].PubKey()) < keys.Index(privKeys[j].PubKey())
+	})
+	return privKeys, keys, nil
+}
+
+// N returns the number of participants
+func (k *MultisigKeys) N() int {
+	return len(k.PubKeys)
+}
+
+// Index returns the position of pubKey in the keys, -1 if it's not a
+// participant
+func (k *MultisigKeys) Index(pubKey *PublicKey) int {
+	serialized := pubKey.Serialize()
+	for i, key := range k.PubKeys {
+		if bytes.Equal(key.Serialize(), serialized) {
+			return i
+		}
+	}
+	return -1
+}
+
+// Combine picks M of partial signatures over hash, in the order of their
+// keys as OP_CHECKMULTISIG requires. Signatures of other keys or invalid ones
+// are errors, duplicates of a key are ignored
+func (k *MultisigKeys) Combine(sigs []*PartialSignature, hash *HashType) ([]*Signature, error) {
+	byIndex := make([]*Signature, k.N())
+	for _, sig := range sigs {
+		i := k.Index(sig.PubKey)
+		if i < 0 {
+			return nil, ErrNotMultisigKey
+		}
+		if !sig.Sig.VerifySignature(sig.PubKey, hash) {
+			return nil, ErrInvalidPartialSignature
+		}
+		byIndex[i] = sig.Sig
+	}
+	combined := make([]*Signature, 0, k.M)
+	for _, sig := range byIndex {
+		if sig != nil && len(combined) < k.M {
+			combined = append(combined, sig)
+		}
+	}
+	if len(combined) < k.M {
+		return nil, ErrNotEnoughPartialSignatures
+	}
+	return combined, nil
+}
+
+// PartialSignature is a participant's signature of a multisig along with
+// its public key, passed around until enough are collected
+type PartialSignature struct {
+	PubKey *PublicKey
+	Sig    *Signature
+}
+
+// SignPartial signs hash as a participant of a multisig
+func SignPartial(privKey *PrivateKey, hash *HashType) (*PartialSignature, error) {
+	sig, err := Sign(privKey, hash)
+	if err != nil {
+		return nil, err
+	}
+	return &PartialSignature{PubKey: privKey.PubKey(), Sig: sig}, nil
+}
+
+// Serialize serializes the partial signature as the 33 bytes compressed
+// public key followed by the DER encoded signature
+func (p *PartialSignature) Serialize() []byte {
+	return append(p.PubKey.Serialize(), p.Sig.Serialize()...)
+}
+
+// PartialSignatureFromBytes parses a partial signature serialized by
+// Serialize
+func PartialSignatureFromBytes(b []byte) (*PartialSignature, error) {
+	const pubKeyLen = 33
+	if len(b) <= pubKeyLen {
+		return nil, ErrInvalidPartialSignature
+	}
+	pubKey, err := PublicKeyFromBytes(b[:pubKeyLen])
+	if err != nil {
+		return nil, ErrInvalidPartialSignature
+	}
+	sig, err := SigFromBytes(b[pubKeyLen:])
+	if err != nil {
+		return nil, ErrInvalidPartialSignature
+	}
+	return &PartialSignature{PubKey: pubKey, Sig: sig}, nil
+}
@@ -0,0 +1,82 @@
+// Copyright (c) 2018 ContentBox Authors.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package crypto
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/facebookgo/ensure"
+)
+
+func TestNewMultisigKeys(t *testing.T) {
+	privKeys, keys, err := GenerateMultisigKeys(2, 3)
+	ensure.Nil(t, err)
+	ensure.DeepEqual(t, keys.M, 2)
+	ensure.DeepEqual(t, keys.N(), 3)
+	for i, privKey := range privKeys {
+		ensure.DeepEqual(t, keys.Index(privKey.PubKey()), i)
+	}
+	for i := 1; i < keys.N(); i++ {
+		ensure.True(t, bytes.Compare(keys.PubKeys[i-1].Serialize(), keys.PubKeys[i].Serialize()) < 0)
+	}
+
+	// participants agree on keys whatever order they are exchanged in
+	reversed := []*PublicKey{keys.PubKeys[2], keys.PubKeys[1], keys.PubKeys[0]}
+	keys2, err := NewMultisigKeys(2, reversed)
+	ensure.Nil(t, err)
+	ensure.DeepEqual(t, keys2, keys)
+	ensure.DeepEqual(t, reversed[0], keys.PubKeys[2])
+
+	_, other, _ := NewKeyPair()
+	ensure.DeepEqual(t, keys.Index(other), -1)
+
+	for _, m := range []int{0, 4} {
+		_, err = NewMultisigKeys(m, keys.PubKeys)
+		ensure.DeepEqual(t, err, ErrInvalidMultisigThreshold)
+	}
+	_, _, err = GenerateMultisigKeys(1, MaxMultisigKeys+1)
+	ensure.DeepEqual(t, err, ErrInvalidMultisigThreshold)
+	_, err = NewMultisigKeys(1, []*PublicKey{other, keys.PubKeys[0], other})
+	ensure.DeepEqual(t, err, ErrDuplicateMultisigKey)
+}
+
+func TestCombinePartialSignatures(t *testing.T) {
+	privKeys, keys, err := GenerateMultisigKeys(2, 3)
+	ensure.Nil(t, err)
+	hash := DoubleHashH([]byte("multisig"))
+
+	partials := make([]*PartialSignature, 0, len(privKeys))
+	for _, privKey := range privKeys {
+		partial, err := SignPartial(privKey, &hash)
+		ensure.Nil(t, err)
+		// serialize & deserialize
+		parsed, err := PartialSignatureFromBytes(partial.Serialize())
+		ensure.Nil(t, err)
+		ensure.DeepEqual(t, parsed, partial)
+		partials = append(partials, parsed)
+	}
+
+	// signatures are picked in the order of keys
+	sigs, err := keys.Combine([]*PartialSignature{partials[2], partials[0], partials[2]}, &hash)
+	ensure.Nil(t, err)
+	ensure.DeepEqual(t, sigs, []*Signature{partials[0].Sig, partials[2].Sig})
+	sigs, err = keys.Combine(partials, &hash)
+	ensure.Nil(t, err)
+	ensure.DeepEqual(t, sigs, []*Signature{partials[0].Sig, partials[1].Sig})
+
+	_, err = keys.Combine(partials[:1], &hash)
+	ensure.DeepEqual(t, err, ErrNotEnoughPartialSignatures)
+	other := DoubleHashH([]byte("other"))
+	_, err = keys.Combine(partials, &other)
+	ensure.DeepEqual(t, err, ErrInvalidPartialSignature)
+	outsider, _, _ := NewKeyPair()
+	partial, _ := SignPartial(outsider, &hash)
+	_, err = keys.Combine(append(partials, partial), &hash)
+	ensure.DeepEqual(t, err, ErrNotMultisigKey)
+
+	_, err = PartialSignatureFromBytes(partials[0].PubKey.Serialize())
+	ensure.DeepEqual(t, err, ErrInvalidPartialSignature)
+}
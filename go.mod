@@ -4,7 +4,9 @@ require (
 	github.com/BurntSushi/toml v0.3.1 // indirect
 	github.com/btcsuite/btcd v0.0.0-20181013004428-67e573d211ac
 	github.com/btcsuite/btcutil v0.0.0-20180706230648-ab6388e0c60a
+	github.com/cloudflare/circl v1.3.7
 	github.com/coreos/go-semver v0.0.0-20180108230905-e214231b295a // indirect
+	github.com/dgraph-io/badger v1.6.2
 	github.com/facebookgo/ensure v0.0.0-20160127193407-b4ab57deab51
 	github.com/facebookgo/stack v0.0.0-20160209184415-751773369052 // indirect
 	github.com/facebookgo/subset v0.0.0-20150612182917-8dac2c3c4870 // indirect
@@ -43,11 +45,11 @@ require (
 	github.com/libp2p/go-flow-metrics v0.0.0-20171227170445-3b3bcfcf78f2 // indirect
 	github.com/libp2p/go-libp2p v6.0.19+incompatible
 	github.com/libp2p/go-libp2p-blankhost v0.3.14 // indirect
-	github.com/libp2p/go-libp2p-circuit v2.2.8+incompatible // indirect
+	github.com/libp2p/go-libp2p-circuit v2.2.8+incompatible
 	github.com/libp2p/go-libp2p-crypto v0.0.0-20180811164718-137c71cdda7a
 	github.com/libp2p/go-libp2p-host v3.0.14+incompatible
 	github.com/libp2p/go-libp2p-interface-connmgr v0.0.20
-	github.com/libp2p/go-libp2p-interface-pnet v0.0.0-20180606072403-86e6fc84b906 // indirect
+	github.com/libp2p/go-libp2p-interface-pnet v0.0.0-20180606072403-86e6fc84b906
 	github.com/libp2p/go-libp2p-kbucket v2.2.11+incompatible
 	github.com/libp2p/go-libp2p-loggables v1.1.23 // indirect
 	github.com/libp2p/go-libp2p-metrics v2.1.7+incompatible // indirect
@@ -98,7 +100,7 @@ require (
 	github.com/whyrusleeping/mafmt v0.0.0-20180627004827-1dc32401ee9f // indirect
 	github.com/whyrusleeping/multiaddr-filter v0.0.0-20160516205228-e903e4adabd7 // indirect
 	github.com/whyrusleeping/yamux v1.1.2 // indirect
-	golang.org/x/crypto v0.0.0-20181015023909-0c41d7ab0a0e
+	golang.org/x/crypto v0.17.0
 	golang.org/x/net v0.0.0-20181023162649-9b4f9f5ad519
 	google.golang.org/genproto v0.0.0-20181016170114-94acd270e44e
 	google.golang.org/grpc v1.15.0
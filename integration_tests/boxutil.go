@@ -19,6 +19,10 @@ import (
 const (
 	rpcTimeout  = 3 * time.Second
 	rpcInterval = 300 * time.Millisecond
+
+	// max length of a signed p2pkh scriptSig and length of a p2pkh scriptPubKey
+	p2pkhScriptSigLen    = 107
+	p2pkhScriptPubKeyLen = 25
 )
 
 // KeyStore defines key structure
@@ -172,6 +176,24 @@ func utxosWithBalanceFor(accAddr string, balance uint64, peerAddr string) []*rpc
 	return r.Utxos
 }
 
+// maxFeeFor returns the most fee a tx sending from accAddr to outCnt addresses
+// pays at the fee rate estimated by peerAddr, i.e., when it spends all utxos
+// of accAddr and has a change output
+func maxFeeFor(accAddr string, outCnt int, peerAddr string) uint64 {
+	utxos := utxosFor(accAddr, peerAddr)
+	conn, err := grpc.Dial(peerAddr, grpc.WithInsecure())
+	if err != nil {
+		logger.Panic(err)
+	}
+	defer conn.Close()
+	price, err := client.EstimateFee(conn, 1)
+	if err != nil {
+		logger.Panic(err)
+	}
+	scriptBytes := len(utxos)*p2pkhScriptSigLen + (outCnt+1)*p2pkhScriptPubKeyLen
+	return uint64(scriptBytes) * price
+}
+
 func chainHeightFor(peerAddr string) (int, error) {
 	// create grpc conn
 	conn, err := grpc.Dial(peerAddr, grpc.WithInsecure())
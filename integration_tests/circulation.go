@@ -133,8 +133,16 @@ func txRepeatTest(fromAddr, toAddr string, execPeer string, times int) {
 		fromAddr, fromBalancePre, toAddr, toBalancePre)
 	transfer := uint64(0)
 	logger.Infof("start to send tx from %s to %s %d times", fromAddr, toAddr, times)
-	// remain at 1/5 balance as transaction fee
-	base := fromBalancePre / uint64(times) / 5 * 2
+	// reserve fees of all txs, a tx never spends more utxos than fromAddr has now
+	fee := maxFeeFor(fromAddr, 1, execPeer) * uint64(times)
+	base := uint64(0)
+	if fromBalancePre > fee {
+		base = (fromBalancePre - fee) / uint64(times) / 2
+	}
+	if base == 0 {
+		logger.Warnf("balance of %s is not enough to pay fee %d, exit", fromAddr, fee)
+		return
+	}
 	for i := 0; i < times; i++ {
 		amount := base + uint64(rand.Int63n(int64(base)))
 		logger.Debugf("sent %d from %s to %s on peer %s", amount, fromAddr, toAddr, execPeer)
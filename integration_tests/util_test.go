@@ -5,11 +5,17 @@
 package main
 
 import (
+	"context"
 	"encoding/json"
 	"io/ioutil"
+	"net"
 	"os"
 	"reflect"
 	"testing"
+
+	"github.com/BOXFoundation/boxd/core/types"
+	"github.com/BOXFoundation/boxd/rpc/pb"
+	"google.golang.org/grpc"
 )
 
 func TestGetIniKV(t *testing.T) {
@@ -46,3 +52,49 @@ func TestParseIPList(t *testing.T) {
 		t.Fatalf("want: %+v, got: %+v", testIPList, list)
 	}
 }
+
+// feeTestPeer serves balance, utxos and fee rate of one address
+type feeTestPeer struct {
+	rpcpb.TransactionCommandServer
+	addr    string
+	utxos   []*rpcpb.Utxo
+	feeRate uint64
+}
+
+func (p *feeTestPeer) GetBalance(ctx context.Context, req *rpcpb.GetBalanceRequest) (*rpcpb.GetBalanceResponse, error) {
+	return &rpcpb.GetBalanceResponse{Balances: map[string]uint64{p.addr: 300}}, nil
+}
+
+func (p *feeTestPeer) FundTransaction(ctx context.Context, req *rpcpb.FundTransactionRequest) (*rpcpb.ListUtxosResponse, error) {
+	return &rpcpb.ListUtxosResponse{Utxos: p.utxos}, nil
+}
+
+func (p *feeTestPeer) EstimateFee(ctx context.Context, req *rpcpb.EstimateFeeRequest) (*rpcpb.EstimateFeeResponse, error) {
+	return &rpcpb.EstimateFeeResponse{BoxPerByte: p.feeRate}, nil
+}
+
+func TestMaxFeeFor(t *testing.T) {
+	addr, err := types.NewAddressPubKeyHash(make([]byte, 20))
+	if err != nil {
+		t.Fatal(err)
+	}
+	peer := &feeTestPeer{
+		addr:    addr.String(),
+		utxos:   []*rpcpb.Utxo{{}, {}, {}},
+		feeRate: 4,
+	}
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	server := grpc.NewServer()
+	rpcpb.RegisterTransactionCommandServer(server, peer)
+	go server.Serve(lis)
+	defer server.Stop()
+
+	// all 3 utxos are spent, paying 2 outputs with the change one
+	want := uint64(3*p2pkhScriptSigLen+3*p2pkhScriptPubKeyLen) * 4
+	if fee := maxFeeFor(addr.String(), 2, lis.Addr().String()); fee != want {
+		t.Fatalf("want: %d, got: %d", want, fee)
+	}
+}
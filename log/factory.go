@@ -9,7 +9,19 @@ import (
 	log "github.com/BOXFoundation/boxd/log/types"
 )
 
-var loggerMap = map[string]log.Logger{}
+// Fields are structured data attached to logs
+type Fields = log.Fields
+
+// keys of fields logs commonly carry
+const (
+	FieldModule = log.FieldModule
+	FieldHeight = log.FieldHeight
+	FieldPeer   = log.FieldPeer
+	FieldTxHash = log.FieldTxHash
+)
+
+// DefaultLevel makes a module follow the global level
+const DefaultLevel = log.DefaultLevel
 
 // Setup loggers globally
 func Setup(cfg *log.Config) {
@@ -18,24 +30,21 @@ func Setup(cfg *log.Config) {
 
 // NewLogger creates a new logger.
 func NewLogger(tag string) log.Logger {
-	newLogger := log.NewLogger(ll.LoggerName, tag)
-	if newLogger != nil {
-		loggerMap[tag] = newLogger
-	}
-	return newLogger
+	return log.NewLogger(ll.LoggerName, tag)
+}
+
+// SetLogLevel sets log level of all modules not having their own levels
+func SetLogLevel(newLevel string) bool {
+	return log.SetLevel(ll.LoggerName, newLevel)
+}
+
+// SetModuleLogLevel sets log level of module, overriding the global level, or
+// makes it follow the global level again if newLevel is DefaultLevel
+func SetModuleLogLevel(module, newLevel string) bool {
+	return log.SetModuleLevel(ll.LoggerName, module, newLevel)
 }
 
-// SetLogLevel sets all loggers log level
-func SetLogLevel(newLevel string) (ok bool) {
-	ok = true
-	for _, logger := range loggerMap {
-		originLevel := logger.LogLevel()
-		logger.SetLogLevel(newLevel)
-		currentLevel := logger.LogLevel()
-		if currentLevel != newLevel {
-			logger.Infof("Error setting log level from %s to %s", originLevel, newLevel)
-			ok = false
-		}
-	}
-	return
+// ModuleLogLevels returns log levels in effect of all modules
+func ModuleLogLevels() map[string]string {
+	return log.ModuleLevels(ll.LoggerName)
 }
@@ -43,3 +43,32 @@ func TestLogrusSetup(t *testing.T) {
 		t.Errorf("Invalid log level %s. It should be %s.", logger.LogLevel(), oldLevel)
 	}
 }
+
+func TestModuleLogLevel(t *testing.T) {
+	chainLogger, p2pLogger := NewLogger("test:chain"), NewLogger("test:p2p")
+	defer SetModuleLogLevel("test:chain", DefaultLevel)
+
+	if !SetLogLevel("info") || !SetModuleLogLevel("test:chain", "debug") {
+		t.Fatal("Failed to set log levels.")
+	}
+	if chainLogger.LogLevel() != "debug" || p2pLogger.LogLevel() != "info" {
+		t.Errorf("Invalid log levels %s and %s.", chainLogger.LogLevel(), p2pLogger.LogLevel())
+	}
+	if levels := ModuleLogLevels(); levels["test:chain"] != "debug" || levels["test:p2p"] != "info" {
+		t.Errorf("Invalid module log levels %v.", levels)
+	}
+
+	// modules having their own levels keep them
+	SetLogLevel("error")
+	if chainLogger.LogLevel() != "debug" || p2pLogger.LogLevel() != "error" {
+		t.Errorf("Invalid log levels %s and %s.", chainLogger.LogLevel(), p2pLogger.LogLevel())
+	}
+	SetModuleLogLevel("test:chain", DefaultLevel)
+	if chainLogger.LogLevel() != "error" {
+		t.Errorf("Invalid log level %s. It should be error.", chainLogger.LogLevel())
+	}
+
+	if SetModuleLogLevel("test:unknown", "debug") || SetModuleLogLevel("test:chain", "verbose") {
+		t.Error("Set invalid module log level.")
+	}
+}
@@ -0,0 +1,252 @@
+// Copyright (c) 2018 ContentBox Authors.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package rotate
+
+import (
+	"compress/gzip"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	log "github.com/BOXFoundation/boxd/log/types"
+)
+
+// backupTimeFormat is the format of the time rotated files are named with,
+// e.g. box-2018-10-01T08-00-00.000000000.log, which sorts them in rotation
+// order
+const backupTimeFormat = "2006-01-02T15-04-05.000000000"
+
+const megabyte = 1024 * 1024
+
+// File is a log file rotated by size and time. Rotated files are renamed with
+// the time they're rotated, and compressed and removed in the background
+type File struct {
+	cfg     log.FileConfig
+	maxSize int64
+
+	mtx      sync.Mutex
+	file     *os.File
+	size     int64
+	deadline time.Time
+	closed   bool
+
+	// rotated files are processed one at a time
+	cleanupMtx sync.Mutex
+	cleanupWg  sync.WaitGroup
+}
+
+// NewFile opens file to log to as configured, appending to it if existing
+func NewFile(cfg *log.FileConfig) (*File, error) {
+	if len(cfg.Filename) == 0 {
+		return nil, fmt.Errorf("log file name is empty")
+	}
+	f := &File{cfg: *cfg, maxSize: int64(cfg.MaxSize) * megabyte}
+	if err := f.open(); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+func (f *File) open() error {
+	if err := os.MkdirAll(filepath.Dir(f.cfg.Filename), 0700); err != nil {
+		return err
+	}
+	file, err := os.OpenFile(f.cfg.Filename, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0600)
+	if err != nil {
+		return err
+	}
+	info, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return err
+	}
+	f.file, f.size = file, info.Size()
+	if f.cfg.Interval > 0 {
+		f.deadline = time.Now().Truncate(f.cfg.Interval).Add(f.cfg.Interval)
+	}
+	return nil
+}
+
+// Write writes p to the file, rotating it first if it's time to or p does
+// not fit in the size limit
+func (f *File) Write(p []byte) (int, error) {
+	f.mtx.Lock()
+	defer f.mtx.Unlock()
+
+	if f.closed {
+		return 0, os.ErrClosed
+	}
+	// reopen the file if failed to after rotation
+	if f.file == nil {
+		if err := f.open(); err != nil {
+			return 0, err
+		}
+	}
+	overflow := f.maxSize > 0 && f.size > 0 && f.size+int64(len(p)) > f.maxSize
+	expired := !f.deadline.IsZero() && !time.Now().Before(f.deadline)
+	if overflow || expired {
+		if err := f.rotate(); err != nil {
+			return 0, err
+		}
+	}
+	n, err := f.file.Write(p)
+	f.size += int64(n)
+	return n, err
+}
+
+// Rotate rotates the file now
+func (f *File) Rotate() error {
+	f.mtx.Lock()
+	defer f.mtx.Unlock()
+	if f.closed {
+		return os.ErrClosed
+	}
+	if f.file == nil {
+		return f.open()
+	}
+	return f.rotate()
+}
+
+// rotate renames the file after the time and opens a new one. It's called
+// with mtx held
+func (f *File) rotate() error {
+	if err := f.file.Close(); err != nil {
+		return err
+	}
+	f.file = nil
+	backup := f.backupName(time.Now())
+	if err := os.Rename(f.cfg.Filename, backup); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	f.cleanupWg.Add(1)
+	go func() {
+		defer f.cleanupWg.Done()
+		f.cleanup(backup)
+	}()
+	return f.open()
+}
+
+// backupName returns the name of file rotated at t, unique among existing ones
+func (f *File) backupName(t time.Time) string {
+	ext := filepath.Ext(f.cfg.Filename)
+	prefix := strings.TrimSuffix(f.cfg.Filename, ext) + "-" + t.Format(backupTimeFormat)
+	name := prefix + ext
+	for i := 1; exists(name) || exists(name+".gz"); i++ {
+		name = fmt.Sprintf("%s-%d%s", prefix, i, ext)
+	}
+	return name
+}
+
+func exists(name string) bool {
+	_, err := os.Stat(name)
+	return err == nil
+}
+
+// cleanup compresses the file just rotated if configured, and removes rotated
+// files beyond retention limits
+func (f *File) cleanup(backup string) {
+	f.cleanupMtx.Lock()
+	defer f.cleanupMtx.Unlock()
+
+	if f.cfg.Compress {
+		if err := compress(backup); err != nil {
+			fmt.Fprintf(os.Stderr, "Failed to compress log file %s: %v\n", backup, err)
+		}
+	}
+	if f.cfg.MaxBackups <= 0 && f.cfg.MaxAge <= 0 {
+		return
+	}
+	backups, err := f.Backups()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to list rotated log files: %v\n", err)
+		return
+	}
+	for i, name := range backups {
+		remove := f.cfg.MaxBackups > 0 && i < len(backups)-f.cfg.MaxBackups
+		if !remove && f.cfg.MaxAge > 0 {
+			if info, err := os.Stat(name); err == nil && time.Since(info.ModTime()) > f.cfg.MaxAge {
+				remove = true
+			}
+		}
+		if remove {
+			if err := os.Remove(name); err != nil {
+				fmt.Fprintf(os.Stderr, "Failed to remove log file %s: %v\n", name, err)
+			}
+		}
+	}
+}
+
+// Backups returns files rotated, oldest first
+func (f *File) Backups() ([]string, error) {
+	ext := filepath.Ext(f.cfg.Filename)
+	prefix := strings.TrimSuffix(filepath.Base(f.cfg.Filename), ext) + "-"
+	entries, err := ioutil.ReadDir(filepath.Dir(f.cfg.Filename))
+	if err != nil {
+		return nil, err
+	}
+	var backups []string
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasPrefix(name, prefix) {
+			continue
+		}
+		stamp := strings.TrimSuffix(strings.TrimSuffix(name, ".gz"), ext)[len(prefix):]
+		if len(stamp) < len(backupTimeFormat) {
+			continue
+		}
+		if _, err := time.Parse(backupTimeFormat, stamp[:len(backupTimeFormat)]); err != nil {
+			continue
+		}
+		backups = append(backups, filepath.Join(filepath.Dir(f.cfg.Filename), name))
+	}
+	sort.Strings(backups)
+	return backups, nil
+}
+
+// compress gzips name into name.gz and removes it
+func compress(name string) error {
+	src, err := os.Open(name)
+	if err != nil {
+		return err
+	}
+	defer src.Close()
+	dst, err := os.OpenFile(name+".gz", os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0600)
+	if err != nil {
+		return err
+	}
+	zw := gzip.NewWriter(dst)
+	_, err = io.Copy(zw, src)
+	if err == nil {
+		err = zw.Close()
+	}
+	if cerr := dst.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		os.Remove(name + ".gz")
+		return err
+	}
+	return os.Remove(name)
+}
+
+// Close closes the file, waiting for files rotated to be processed
+func (f *File) Close() error {
+	f.mtx.Lock()
+	var err error
+	f.closed = true
+	if f.file != nil {
+		err = f.file.Close()
+		f.file = nil
+	}
+	f.mtx.Unlock()
+	f.cleanupWg.Wait()
+	return err
+}
@@ -0,0 +1,119 @@
+// Copyright (c) 2018 ContentBox Authors.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package rotate
+
+import (
+	"compress/gzip"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	log "github.com/BOXFoundation/boxd/log/types"
+	"github.com/facebookgo/ensure"
+	"github.com/sirupsen/logrus"
+)
+
+func newTestFile(t *testing.T, cfg *log.FileConfig) (*File, func()) {
+	dir, err := ioutil.TempDir("", "rotate")
+	ensure.Nil(t, err)
+	cfg.Filename = filepath.Join(dir, "box.log")
+	f, err := NewFile(cfg)
+	ensure.Nil(t, err)
+	return f, func() { os.RemoveAll(dir) }
+}
+
+func TestRotateBySize(t *testing.T) {
+	f, clean := newTestFile(t, &log.FileConfig{MaxBackups: 2})
+	defer clean()
+	f.maxSize = 10
+
+	for _, line := range []string{"first\n", "second\n", "third\n", "fourth\n"} {
+		_, err := f.Write([]byte(line))
+		ensure.Nil(t, err)
+	}
+	ensure.Nil(t, f.Close())
+
+	// the oldest rotated file is removed
+	backups, err := f.Backups()
+	ensure.Nil(t, err)
+	ensure.DeepEqual(t, len(backups), 2)
+	data, err := ioutil.ReadFile(backups[0])
+	ensure.Nil(t, err)
+	ensure.DeepEqual(t, string(data), "second\n")
+	data, err = ioutil.ReadFile(f.cfg.Filename)
+	ensure.Nil(t, err)
+	ensure.DeepEqual(t, string(data), "fourth\n")
+
+	_, err = f.Write([]byte("closed\n"))
+	ensure.DeepEqual(t, err, os.ErrClosed)
+}
+
+func TestRotateByInterval(t *testing.T) {
+	f, clean := newTestFile(t, &log.FileConfig{Interval: time.Hour, Compress: true})
+	defer clean()
+
+	_, err := f.Write([]byte("yesterday\n"))
+	ensure.Nil(t, err)
+	f.deadline = time.Now().Add(-time.Second)
+	_, err = f.Write([]byte("today\n"))
+	ensure.Nil(t, err)
+	ensure.True(t, f.deadline.After(time.Now()))
+	ensure.Nil(t, f.Close())
+
+	backups, err := f.Backups()
+	ensure.Nil(t, err)
+	ensure.DeepEqual(t, len(backups), 1)
+	ensure.True(t, strings.HasSuffix(backups[0], ".log.gz"))
+	gz, err := os.Open(backups[0])
+	ensure.Nil(t, err)
+	defer gz.Close()
+	zr, err := gzip.NewReader(gz)
+	ensure.Nil(t, err)
+	data, err := ioutil.ReadAll(zr)
+	ensure.Nil(t, err)
+	ensure.DeepEqual(t, string(data), "yesterday\n")
+}
+
+func TestRetentionByAge(t *testing.T) {
+	f, clean := newTestFile(t, &log.FileConfig{MaxAge: time.Hour})
+	defer clean()
+
+	ensure.Nil(t, f.Rotate())
+	f.cleanupWg.Wait()
+	backups, err := f.Backups()
+	ensure.Nil(t, err)
+	ensure.DeepEqual(t, len(backups), 1)
+	old := time.Now().Add(-2 * time.Hour)
+	ensure.Nil(t, os.Chtimes(backups[0], old, old))
+
+	ensure.Nil(t, f.Rotate())
+	ensure.Nil(t, f.Close())
+	remained, err := f.Backups()
+	ensure.Nil(t, err)
+	ensure.DeepEqual(t, len(remained), 1)
+	ensure.NotDeepEqual(t, remained[0], backups[0])
+}
+
+func TestHook(t *testing.T) {
+	f, clean := newTestFile(t, &log.FileConfig{})
+	defer clean()
+
+	logger := logrus.New()
+	logger.Out = ioutil.Discard
+	logger.AddHook(NewHook(f, "json"))
+	logger.WithField("module", "chain").Info("hello")
+	ensure.Nil(t, f.Close())
+
+	data, err := ioutil.ReadFile(f.cfg.Filename)
+	ensure.Nil(t, err)
+	entry := make(map[string]interface{})
+	ensure.Nil(t, json.Unmarshal(data, &entry))
+	ensure.DeepEqual(t, entry["module"], "chain")
+	ensure.DeepEqual(t, entry["msg"], "hello")
+}
@@ -0,0 +1,47 @@
+// Copyright (c) 2018 ContentBox Authors.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package rotate
+
+import (
+	"github.com/sirupsen/logrus"
+)
+
+// Hook writes logs of all levels to a rotated file, formatted on its own
+// regardless of the output of the logger
+type Hook struct {
+	file      *File
+	formatter logrus.Formatter
+}
+
+// NewHook creates a hook writing to file with formatter json or text
+func NewHook(file *File, formatter string) *Hook {
+	hook := &Hook{file: file}
+	if formatter == "json" {
+		hook.formatter = &logrus.JSONFormatter{}
+	} else {
+		hook.formatter = &logrus.TextFormatter{DisableColors: true, FullTimestamp: true}
+	}
+	return hook
+}
+
+// Levels returns all levels, as logs are filtered by loggers
+func (hook *Hook) Levels() []logrus.Level {
+	return logrus.AllLevels
+}
+
+// Fire writes entry to the file
+func (hook *Hook) Fire(entry *logrus.Entry) error {
+	data, err := hook.formatter.Format(entry)
+	if err != nil {
+		return err
+	}
+	_, err = hook.file.Write(data)
+	return err
+}
+
+// Close closes the file
+func (hook *Hook) Close() error {
+	return hook.file.Close()
+}
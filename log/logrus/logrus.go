@@ -5,10 +5,15 @@
 package logruslog
 
 import (
+	"fmt"
+	"os"
+	"sync"
+	"sync/atomic"
+
+	"github.com/BOXFoundation/boxd/log/logrus/hooks/rotate"
 	source "github.com/BOXFoundation/boxd/log/logrus/hooks/source"
 	log "github.com/BOXFoundation/boxd/log/types"
 	"github.com/heirko/go-contrib/logrusHelper"
-	mate "github.com/heralight/logrus_mate"
 	_ "github.com/heralight/logrus_mate/hooks/file"              // file log hook
 	_ "github.com/heralight/logrus_mate/hooks/filewithformatter" // file log hook
 	_ "github.com/heralight/logrus_mate/hooks/slack"             // slack log hook
@@ -18,12 +23,34 @@ import (
 type logrusLogger struct {
 	logger *logrus.Logger
 	tag    string
+	module *module
+	fields logrus.Fields
 }
 
 var _ log.Logger = (*logrusLogger)(nil)
 
 var defaultLogrusLogger = logrus.New()
 
+// module is the level of loggers of a tag, if it has its own
+type module struct {
+	level int32
+}
+
+// noLevel is the level of modules following the global level
+const noLevel = -1
+
+var (
+	modulesMtx sync.Mutex
+	modules    = map[string]*module{}
+	// globalLevel is the level of modules not having their own. Levels of
+	// modules are checked by loggers, while defaultLogrusLogger is kept at
+	// the most verbose level in effect so as not to filter out any
+	globalLevel = uint32(defaultLogrusLogger.Level)
+
+	// fileHook writes to the log file rotated by the node, if configured
+	fileHook *rotate.Hook
+)
+
 // LoggerName is the name of the logger impl
 const LoggerName = "logrus"
 
@@ -32,83 +59,226 @@ func init() {
 	defaultLogrusLogger.AddHook(sourceHook)
 
 	log.Register(LoggerName, &log.LoggerEntry{
-		Setup:     Setup,
-		NewLogger: NewLogger,
+		Setup:          Setup,
+		NewLogger:      NewLogger,
+		SetLevel:       SetLevel,
+		SetModuleLevel: SetModuleLevel,
+		ModuleLevels:   ModuleLevels,
 	})
 }
 
 // Setup setups logrus logger
 func Setup(cfg *log.Config) {
+	modulesMtx.Lock()
+	defer modulesMtx.Unlock()
+	defaultLogrusLogger.SetLevel(logrus.Level(atomic.LoadUint32(&globalLevel)))
 	logrusHelper.SetConfig(
 		defaultLogrusLogger,
-		mate.LoggerConfig(*cfg),
+		cfg.LoggerConfig,
 	)
+	atomic.StoreUint32(&globalLevel, uint32(defaultLogrusLogger.Level))
+	syncLevel()
+	setupFile(&cfg.File)
+}
+
+// setupFile replaces the hook writing to the log file rotated by the node
+func setupFile(cfg *log.FileConfig) {
+	if fileHook != nil {
+		hooks := make(logrus.LevelHooks)
+		for level, levelHooks := range defaultLogrusLogger.Hooks {
+			for _, hook := range levelHooks {
+				if hook != logrus.Hook(fileHook) {
+					hooks[level] = append(hooks[level], hook)
+				}
+			}
+		}
+		defaultLogrusLogger.ReplaceHooks(hooks)
+		fileHook.Close()
+		fileHook = nil
+	}
+	if len(cfg.Filename) == 0 {
+		return
+	}
+	file, err := rotate.NewFile(cfg)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to open log file %s: %v\n", cfg.Filename, err)
+		return
+	}
+	fileHook = rotate.NewHook(file, cfg.Formatter)
+	defaultLogrusLogger.AddHook(fileHook)
 }
 
 // NewLogger creates a new logrus logger.
 func NewLogger(tag string) log.Logger {
+	modulesMtx.Lock()
+	defer modulesMtx.Unlock()
+	m, ok := modules[tag]
+	if !ok {
+		m = &module{level: noLevel}
+		modules[tag] = m
+	}
 	return &logrusLogger{
 		logger: defaultLogrusLogger,
 		tag:    tag,
+		module: m,
+		fields: logrus.Fields{log.FieldModule: tag},
+	}
+}
+
+// SetLevel sets the level of all modules not having their own levels
+func SetLevel(level string) bool {
+	lvl, err := logrus.ParseLevel(level)
+	if err != nil {
+		return false
+	}
+	modulesMtx.Lock()
+	defer modulesMtx.Unlock()
+	atomic.StoreUint32(&globalLevel, uint32(lvl))
+	syncLevel()
+	return true
+}
+
+// SetModuleLevel sets the level of loggers of module, or makes it follow the
+// global level if level is log.DefaultLevel
+func SetModuleLevel(name, level string) bool {
+	modulesMtx.Lock()
+	defer modulesMtx.Unlock()
+	m, ok := modules[name]
+	if !ok {
+		return false
+	}
+	if level == log.DefaultLevel {
+		atomic.StoreInt32(&m.level, noLevel)
+	} else {
+		lvl, err := logrus.ParseLevel(level)
+		if err != nil {
+			return false
+		}
+		atomic.StoreInt32(&m.level, int32(lvl))
+	}
+	syncLevel()
+	return true
+}
+
+// ModuleLevels returns levels in effect of all modules
+func ModuleLevels() map[string]string {
+	modulesMtx.Lock()
+	defer modulesMtx.Unlock()
+	levels := make(map[string]string, len(modules))
+	for name, m := range modules {
+		levels[name] = m.effectiveLevel().String()
 	}
+	return levels
+}
+
+// syncLevel keeps defaultLogrusLogger at the most verbose level of modules.
+// It's called with modulesMtx held
+func syncLevel() {
+	level := logrus.Level(atomic.LoadUint32(&globalLevel))
+	for _, m := range modules {
+		if l := m.effectiveLevel(); l > level {
+			level = l
+		}
+	}
+	defaultLogrusLogger.SetLevel(level)
+}
+
+func (m *module) effectiveLevel() logrus.Level {
+	if level := atomic.LoadInt32(&m.level); level != noLevel {
+		return logrus.Level(level)
+	}
+	return logrus.Level(atomic.LoadUint32(&globalLevel))
+}
+
+func (log *logrusLogger) enabled(level logrus.Level) bool {
+	return log.module.effectiveLevel() >= level
 }
 
 func (log *logrusLogger) entry() *logrus.Entry {
-	return log.logger.WithFields(logrus.Fields{
-		"tag": log.tag,
-	})
+	return log.logger.WithFields(log.fields)
 }
 
-// SetLogLevel is to set the log level
-func (log *logrusLogger) SetLogLevel(level string) {
-	if lvl, err := logrus.ParseLevel(level); err == nil {
-		log.logger.Level = lvl
+// WithFields returns a logger adding fields to what it logs
+func (log *logrusLogger) WithFields(fields log.Fields) log.Logger {
+	merged := make(logrus.Fields, len(log.fields)+len(fields))
+	for k, v := range log.fields {
+		merged[k] = v
+	}
+	for k, v := range fields {
+		merged[k] = v
+	}
+	return &logrusLogger{
+		logger: log.logger,
+		tag:    log.tag,
+		module: log.module,
+		fields: merged,
 	}
 }
 
-// SetLogLevel is to set the log level
+// SetLogLevel sets the level of all modules not having their own levels
+func (log *logrusLogger) SetLogLevel(level string) {
+	SetLevel(level)
+}
+
+// LogLevel returns the level in effect for the module of the logger
 func (log *logrusLogger) LogLevel() string {
-	return log.logger.Level.String()
+	return log.module.effectiveLevel().String()
 }
 
 // Debugf prints Debug level log
 func (log *logrusLogger) Debugf(f string, v ...interface{}) {
-	log.entry().Debugf(f, v...)
+	if log.enabled(logrus.DebugLevel) {
+		log.entry().Debugf(f, v...)
+	}
 }
 
 // Debug prints Debug level log
 func (log *logrusLogger) Debug(v ...interface{}) {
-	log.entry().Debug(v...)
+	if log.enabled(logrus.DebugLevel) {
+		log.entry().Debug(v...)
+	}
 }
 
 // Infof prints Info level log
 func (log *logrusLogger) Infof(f string, v ...interface{}) {
-	log.entry().Infof(f, v...)
+	if log.enabled(logrus.InfoLevel) {
+		log.entry().Infof(f, v...)
+	}
 }
 
 // Info prints Info level log
 func (log *logrusLogger) Info(v ...interface{}) {
-	log.entry().Info(v...)
+	if log.enabled(logrus.InfoLevel) {
+		log.entry().Info(v...)
+	}
 }
 
 // Warnf prints Warn level log
 func (log *logrusLogger) Warnf(f string, v ...interface{}) {
-	log.entry().Warnf(f, v...)
+	if log.enabled(logrus.WarnLevel) {
+		log.entry().Warnf(f, v...)
+	}
 }
 
 // Warn prints Warn level log
 func (log *logrusLogger) Warn(v ...interface{}) {
-	log.entry().Warn(v...)
+	if log.enabled(logrus.WarnLevel) {
+		log.entry().Warn(v...)
+	}
 }
 
 // Errorf prints Error level log
 func (log *logrusLogger) Errorf(f string, v ...interface{}) {
-	log.entry().Errorf(f, v...)
+	if log.enabled(logrus.ErrorLevel) {
+		log.entry().Errorf(f, v...)
+	}
 }
 
 // Error prints Error level log
 func (log *logrusLogger) Error(v ...interface{}) {
-	log.entry().Error(v...)
+	if log.enabled(logrus.ErrorLevel) {
+		log.entry().Error(v...)
+	}
 }
 
 // Fatalf prints Fatal level log
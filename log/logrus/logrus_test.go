@@ -0,0 +1,43 @@
+// Copyright (c) 2018 ContentBox Authors.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package logruslog
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	log "github.com/BOXFoundation/boxd/log/types"
+	"github.com/sirupsen/logrus"
+)
+
+func TestStructuredLogs(t *testing.T) {
+	var buf bytes.Buffer
+	out, formatter := defaultLogrusLogger.Out, defaultLogrusLogger.Formatter
+	defaultLogrusLogger.Out, defaultLogrusLogger.Formatter = &buf, &logrus.JSONFormatter{}
+	defer func() {
+		defaultLogrusLogger.Out, defaultLogrusLogger.Formatter = out, formatter
+		SetModuleLevel("test:chain", log.DefaultLevel)
+	}()
+
+	chainLogger, p2pLogger := NewLogger("test:chain"), NewLogger("test:p2p")
+	SetLevel("info")
+	SetModuleLevel("test:chain", "debug")
+
+	p2pLogger.Debugf("filtered out")
+	if buf.Len() != 0 {
+		t.Fatalf("Debug log of module at info level: %s", buf.String())
+	}
+
+	chainLogger.WithFields(log.Fields{log.FieldHeight: 10, log.FieldTxHash: "abcd"}).Debugf("block %d", 10)
+	entry := make(map[string]interface{})
+	if err := json.Unmarshal(buf.Bytes(), &entry); err != nil {
+		t.Fatalf("Invalid json log %s: %v", buf.String(), err)
+	}
+	if entry[log.FieldModule] != "test:chain" || entry[log.FieldHeight] != float64(10) ||
+		entry[log.FieldTxHash] != "abcd" || entry["msg"] != "block 10" || entry["level"] != "debug" {
+		t.Errorf("Invalid json log %v.", entry)
+	}
+}
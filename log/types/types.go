@@ -6,14 +6,19 @@ package types
 
 import (
 	"fmt"
+	"time"
 
 	mate "github.com/heralight/logrus_mate"
 )
 
 // Logger defines the box log functions
 type Logger interface {
+	// SetLogLevel sets the level of all modules not having their own levels
 	SetLogLevel(level string)
+	// LogLevel returns the level in effect for the module of the logger
 	LogLevel() string
+	// WithFields returns a logger adding fields to what it logs
+	WithFields(fields Fields) Logger
 	Debugf(f string, v ...interface{})
 	Debug(v ...interface{})
 	Infof(f string, v ...interface{})
@@ -28,16 +33,59 @@ type Logger interface {
 	Panic(v ...interface{})
 }
 
+// Fields are structured data attached to logs, which are kept as they are by
+// the json formatter
+type Fields map[string]interface{}
+
+// keys of fields logs commonly carry
+const (
+	FieldModule = "module"
+	FieldHeight = "height"
+	FieldPeer   = "peer"
+	FieldTxHash = "txhash"
+)
+
+// DefaultLevel is the level which makes a module follow the global level
+const DefaultLevel = "default"
+
 // Config is the configuration of the logrus logger
-type Config mate.LoggerConfig
+type Config struct {
+	mate.LoggerConfig `mapstructure:",squash"`
+	// File is written to if its filename is set, and rotated and cleaned up by
+	// the node itself
+	File FileConfig `mapstructure:"file"`
+}
+
+// FileConfig is the configuration of a log file rotated by size and time
+type FileConfig struct {
+	Filename string `mapstructure:"filename"`
+	// Formatter is json or text, which is the default
+	Formatter string `mapstructure:"formatter"`
+	// MaxSize is the size in megabytes a file is rotated at, 0 for no limit
+	MaxSize int `mapstructure:"max_size"`
+	// Interval is how often a file is rotated, e.g. 24h, 0 for never
+	Interval time.Duration `mapstructure:"interval"`
+	// MaxBackups is the number of rotated files kept, 0 for no limit
+	MaxBackups int `mapstructure:"max_backups"`
+	// MaxAge is how long rotated files are kept, 0 for no limit
+	MaxAge time.Duration `mapstructure:"max_age"`
+	// Compress rotated files with gzip
+	Compress bool `mapstructure:"compress"`
+}
 
 type setupFunc func(*Config)
 type newLoggerFunc func(string) Logger
+type setLevelFunc func(level string) bool
+type setModuleLevelFunc func(module, level string) bool
+type moduleLevelsFunc func() map[string]string
 
 // LoggerEntry is a logger impl entry
 type LoggerEntry struct {
-	Setup     setupFunc
-	NewLogger newLoggerFunc
+	Setup          setupFunc
+	NewLogger      newLoggerFunc
+	SetLevel       setLevelFunc
+	SetModuleLevel setModuleLevelFunc
+	ModuleLevels   moduleLevelsFunc
 }
 
 var loggerEntryMap = map[string]*LoggerEntry{}
@@ -65,3 +113,36 @@ func NewLogger(name, tag string) Logger {
 	fmt.Printf("Invalid logger: %s", name)
 	return nil
 }
+
+// SetLevel sets the level of all modules not having their own levels. It
+// returns false if level is unknown
+func SetLevel(name, level string) bool {
+	if entry, ok := loggerEntryMap[name]; ok {
+		return entry.SetLevel(level)
+	}
+
+	fmt.Printf("Invalid logger: %s", name)
+	return false
+}
+
+// SetModuleLevel sets the level of loggers of module, overriding the global
+// level, or makes it follow the global level again if level is DefaultLevel.
+// It returns false if module or level is unknown
+func SetModuleLevel(name, module, level string) bool {
+	if entry, ok := loggerEntryMap[name]; ok {
+		return entry.SetModuleLevel(module, level)
+	}
+
+	fmt.Printf("Invalid logger: %s", name)
+	return false
+}
+
+// ModuleLevels returns levels in effect of all modules
+func ModuleLevels(name string) map[string]string {
+	if entry, ok := loggerEntryMap[name]; ok {
+		return entry.ModuleLevels()
+	}
+
+	fmt.Printf("Invalid logger: %s", name)
+	return nil
+}
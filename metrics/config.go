@@ -12,4 +12,7 @@ type Config struct {
 	User     string   `mapstructure:"user"`
 	Password string   `mapstructure:"password"`
 	Tags     []string `mapstructure:"tags"`
+	// Diagnostics and Tracing are run regardless of Enable
+	Diagnostics DiagnosticsConfig `mapstructure:"diagnostics"`
+	Tracing     TracingConfig     `mapstructure:"tracing"`
 }
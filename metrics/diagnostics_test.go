@@ -0,0 +1,72 @@
+// Copyright (c) 2018 ContentBox Authors.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package metrics
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/facebookgo/ensure"
+)
+
+func TestDiagnosticsHandler(t *testing.T) {
+	server := httptest.NewServer(diagnosticsHandler(&DiagnosticsConfig{User: "box", Password: "secret"}))
+	defer server.Close()
+
+	get := func(path, user, password string) (*http.Response, []byte) {
+		req, err := http.NewRequest("GET", server.URL+path, nil)
+		ensure.Nil(t, err)
+		if user != "" {
+			req.SetBasicAuth(user, password)
+		}
+		resp, err := http.DefaultClient.Do(req)
+		ensure.Nil(t, err)
+		defer resp.Body.Close()
+		body, err := ioutil.ReadAll(resp.Body)
+		ensure.Nil(t, err)
+		return resp, body
+	}
+
+	// unauthenticated requests are refused
+	resp, _ := get("/debug/gcstats", "", "")
+	ensure.DeepEqual(t, resp.StatusCode, http.StatusUnauthorized)
+	resp, _ = get("/debug/pprof/heap", "box", "wrong")
+	ensure.DeepEqual(t, resp.StatusCode, http.StatusUnauthorized)
+
+	resp, body := get("/debug/gcstats", "box", "secret")
+	ensure.DeepEqual(t, resp.StatusCode, http.StatusOK)
+	stats := &GCStats{}
+	ensure.Nil(t, json.Unmarshal(body, stats))
+	ensure.True(t, stats.Goroutines > 0)
+	ensure.True(t, stats.HeapAlloc > 0)
+
+	resp, body = get("/debug/goroutines", "box", "secret")
+	ensure.DeepEqual(t, resp.StatusCode, http.StatusOK)
+	ensure.True(t, strings.Contains(string(body), "TestDiagnosticsHandler"))
+
+	resp, body = get("/debug/pprof/heap", "box", "secret")
+	ensure.DeepEqual(t, resp.StatusCode, http.StatusOK)
+	ensure.True(t, len(body) > 0)
+}
+
+func TestCaptureProfile(t *testing.T) {
+	for _, profile := range []string{"heap", "goroutine"} {
+		var buf bytes.Buffer
+		ensure.Nil(t, CaptureProfile(&buf, profile, 0))
+		ensure.True(t, buf.Len() > 0)
+	}
+	var buf bytes.Buffer
+	ensure.Nil(t, CaptureProfile(&buf, "cpu", 100*time.Millisecond))
+	ensure.True(t, buf.Len() > 0)
+
+	ensure.DeepEqual(t, CaptureProfile(&buf, "unknown", 0), ErrUnknownProfile)
+	ensure.DeepEqual(t, CaptureProfile(&buf, "cpu", MaxProfileDuration+time.Second), ErrProfileDuration)
+}
@@ -64,6 +64,11 @@ func NewGauge(name string) metrics.Gauge {
 	return metrics.GetOrRegisterGauge(name, metrics.DefaultRegistry)
 }
 
+// NewGaugeFloat64 create a new metrics GaugeFloat64
+func NewGaugeFloat64(name string) metrics.GaugeFloat64 {
+	return metrics.GetOrRegisterGaugeFloat64(name, metrics.DefaultRegistry)
+}
+
 // NewHistogramWithUniformSample create a new metrics History with Uniform Sample algorithm.
 func NewHistogramWithUniformSample(name string, reservoirSize int) metrics.Histogram {
 	return metrics.GetOrRegisterHistogram(name, nil, metrics.NewUniformSample(reservoirSize))
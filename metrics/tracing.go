@@ -0,0 +1,355 @@
+// Copyright (c) 2018 ContentBox Authors.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package metrics
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/jbenet/goprocess"
+)
+
+const (
+	// spans ended are queued up to spanQueueSize, and exported in batches of
+	// up to spanBatchSize, at least every spanExportInterval
+	spanQueueSize      = 4096
+	spanBatchSize      = 512
+	spanExportInterval = 5 * time.Second
+
+	spanExportTimeout = 10 * time.Second
+)
+
+// TracingConfig is the configuration of tracing block and transaction
+// processing. Spans are exported to Endpoint in OTLP/HTTP json, which
+// OpenTelemetry collectors accept at http://host:4318/v1/traces
+type TracingConfig struct {
+	Enable      bool   `mapstructure:"enable"`
+	Endpoint    string `mapstructure:"endpoint"`
+	ServiceName string `mapstructure:"service_name"`
+	// SampleRatio is the ratio of traces sampled, none if 0 or less and all
+	// if 1 or more
+	SampleRatio float64 `mapstructure:"sample_ratio"`
+}
+
+// SpanExporter exports spans ended
+type SpanExporter interface {
+	Export(spans []*Span) error
+}
+
+// Span is a timed operation in a trace, e.g. processing a block. Methods of
+// a nil span do nothing, which is what StartSpan returns if tracing is off or
+// the trace is not sampled
+type Span struct {
+	TraceID    [16]byte
+	SpanID     [8]byte
+	ParentID   [8]byte
+	Name       string
+	Start      time.Time
+	End        time.Time
+	Attributes []Attribute
+	Err        error
+
+	tracer *tracer
+}
+
+// Attribute is a key value pair describing a span, e.g. block.height
+type Attribute struct {
+	Key   string
+	Value interface{}
+}
+
+type spanKey struct{}
+
+// StartSpan starts a span named name, a child of the span in ctx if any. It
+// returns ctx carrying the new span for its children. Spans must be finished
+// by calling Finish
+func StartSpan(ctx context.Context, name string) (context.Context, *Span) {
+	t := currentTracer()
+	if t == nil {
+		return ctx, nil
+	}
+	parent, ok := ctx.Value(spanKey{}).(*Span)
+	if ok && parent == nil {
+		// within a trace not sampled
+		return ctx, nil
+	}
+	span := &Span{Name: name, Start: time.Now(), tracer: t}
+	if parent != nil {
+		span.TraceID = parent.TraceID
+		span.ParentID = parent.SpanID
+	} else {
+		rand.Read(span.TraceID[:])
+		if !t.sampled(span.TraceID) {
+			return context.WithValue(ctx, spanKey{}, (*Span)(nil)), nil
+		}
+	}
+	rand.Read(span.SpanID[:])
+	return context.WithValue(ctx, spanKey{}, span), span
+}
+
+// SetAttribute adds an attribute to span. value is a string, bool, integer
+// or float
+func (span *Span) SetAttribute(key string, value interface{}) {
+	if span == nil {
+		return
+	}
+	span.Attributes = append(span.Attributes, Attribute{Key: key, Value: value})
+}
+
+// SetError marks span failed with err if not nil
+func (span *Span) SetError(err error) {
+	if span == nil || err == nil {
+		return
+	}
+	span.Err = err
+}
+
+// Finish ends span and queues it to be exported
+func (span *Span) Finish() {
+	if span == nil {
+		return
+	}
+	span.End = time.Now()
+	select {
+	case span.tracer.queue <- span:
+	default:
+		NewCounter("box.tracing.spans.dropped").Inc(1)
+	}
+}
+
+// tracer batches spans ended to its exporter
+type tracer struct {
+	queue     chan *Span
+	exporter  SpanExporter
+	threshold uint64
+}
+
+var (
+	tracerMtx sync.RWMutex
+	global    *tracer
+)
+
+func currentTracer() *tracer {
+	tracerMtx.RLock()
+	defer tracerMtx.RUnlock()
+	return global
+}
+
+func setTracer(t *tracer) {
+	tracerMtx.Lock()
+	global = t
+	tracerMtx.Unlock()
+}
+
+func newTracer(ratio float64, exporter SpanExporter) *tracer {
+	var threshold uint64
+	if ratio >= 1 {
+		threshold = math.MaxUint64
+	} else if ratio > 0 {
+		threshold = uint64(ratio * math.MaxUint64)
+	}
+	return &tracer{queue: make(chan *Span, spanQueueSize), exporter: exporter, threshold: threshold}
+}
+
+// sampled decides whether a trace is sampled by its id, so that the decision
+// is consistent wherever made
+func (t *tracer) sampled(traceID [16]byte) bool {
+	return t.threshold == math.MaxUint64 || binary.BigEndian.Uint64(traceID[8:]) < t.threshold
+}
+
+// loop exports spans queued until p is closed
+func (t *tracer) loop(p goprocess.Process) {
+	ticker := time.NewTicker(spanExportInterval)
+	defer ticker.Stop()
+	batch := make([]*Span, 0, spanBatchSize)
+	export := func() {
+		if len(batch) == 0 {
+			return
+		}
+		if err := t.exporter.Export(batch); err != nil {
+			logger.Warnf("Failed to export %d spans: %v", len(batch), err)
+		}
+		batch = make([]*Span, 0, spanBatchSize)
+	}
+	for {
+		select {
+		case span := <-t.queue:
+			if batch = append(batch, span); len(batch) == spanBatchSize {
+				export()
+			}
+		case <-ticker.C:
+			export()
+		case <-p.Closing():
+			for n := len(t.queue); n > 0; n-- {
+				batch = append(batch, <-t.queue)
+			}
+			export()
+			return
+		}
+	}
+}
+
+// RunTracing starts tracing and exports spans until parent is closed
+func RunTracing(config *TracingConfig, parent goprocess.Process) {
+	if !config.Enable {
+		return
+	}
+	if len(config.Endpoint) == 0 {
+		logger.Error("Tracing requires an endpoint to export spans to")
+		return
+	}
+	t := newTracer(config.SampleRatio, newOTLPExporter(config.Endpoint, config.ServiceName))
+	setTracer(t)
+	logger.Infof("Exporting traces to %s", config.Endpoint)
+	parent.Go(func(p goprocess.Process) {
+		t.loop(p)
+		setTracer(nil)
+	})
+}
+
+// otlpExporter posts spans to an OTLP/HTTP endpoint in json
+type otlpExporter struct {
+	endpoint string
+	resource otlpResource
+	client   *http.Client
+}
+
+func newOTLPExporter(endpoint, serviceName string) *otlpExporter {
+	if len(serviceName) == 0 {
+		serviceName = "boxd"
+	}
+	return &otlpExporter{
+		endpoint: endpoint,
+		resource: otlpResource{Attributes: []otlpAttribute{otlpAttr(Attribute{"service.name", serviceName})}},
+		client:   &http.Client{Timeout: spanExportTimeout},
+	}
+}
+
+type otlpTraces struct {
+	ResourceSpans []otlpResourceSpans `json:"resourceSpans"`
+}
+
+type otlpResourceSpans struct {
+	Resource   otlpResource     `json:"resource"`
+	ScopeSpans []otlpScopeSpans `json:"scopeSpans"`
+}
+
+type otlpResource struct {
+	Attributes []otlpAttribute `json:"attributes"`
+}
+
+type otlpScopeSpans struct {
+	Scope struct {
+		Name string `json:"name"`
+	} `json:"scope"`
+	Spans []otlpSpan `json:"spans"`
+}
+
+type otlpSpan struct {
+	TraceID           string          `json:"traceId"`
+	SpanID            string          `json:"spanId"`
+	ParentSpanID      string          `json:"parentSpanId,omitempty"`
+	Name              string          `json:"name"`
+	Kind              int             `json:"kind"`
+	StartTimeUnixNano string          `json:"startTimeUnixNano"`
+	EndTimeUnixNano   string          `json:"endTimeUnixNano"`
+	Attributes        []otlpAttribute `json:"attributes,omitempty"`
+	Status            otlpStatus      `json:"status"`
+}
+
+type otlpStatus struct {
+	Code    int    `json:"code,omitempty"`
+	Message string `json:"message,omitempty"`
+}
+
+type otlpAttribute struct {
+	Key   string                 `json:"key"`
+	Value map[string]interface{} `json:"value"`
+}
+
+// otlp span kind internal and status code error
+const (
+	otlpKindInternal = 1
+	otlpStatusError  = 2
+)
+
+func otlpAttr(attr Attribute) otlpAttribute {
+	var value map[string]interface{}
+	switch v := attr.Value.(type) {
+	case string:
+		value = map[string]interface{}{"stringValue": v}
+	case bool:
+		value = map[string]interface{}{"boolValue": v}
+	case int:
+		value = map[string]interface{}{"intValue": strconv.FormatInt(int64(v), 10)}
+	case int32:
+		value = map[string]interface{}{"intValue": strconv.FormatInt(int64(v), 10)}
+	case int64:
+		value = map[string]interface{}{"intValue": strconv.FormatInt(v, 10)}
+	case uint32:
+		value = map[string]interface{}{"intValue": strconv.FormatUint(uint64(v), 10)}
+	case uint64:
+		value = map[string]interface{}{"intValue": strconv.FormatUint(v, 10)}
+	case float64:
+		value = map[string]interface{}{"doubleValue": v}
+	default:
+		value = map[string]interface{}{"stringValue": fmt.Sprint(v)}
+	}
+	return otlpAttribute{Key: attr.Key, Value: value}
+}
+
+// encode converts spans into an otlp export request
+func (e *otlpExporter) encode(spans []*Span) *otlpTraces {
+	scope := otlpScopeSpans{Spans: make([]otlpSpan, 0, len(spans))}
+	scope.Scope.Name = "github.com/BOXFoundation/boxd"
+	for _, span := range spans {
+		s := otlpSpan{
+			TraceID:           hex.EncodeToString(span.TraceID[:]),
+			SpanID:            hex.EncodeToString(span.SpanID[:]),
+			Name:              span.Name,
+			Kind:              otlpKindInternal,
+			StartTimeUnixNano: strconv.FormatInt(span.Start.UnixNano(), 10),
+			EndTimeUnixNano:   strconv.FormatInt(span.End.UnixNano(), 10),
+		}
+		if span.ParentID != [8]byte{} {
+			s.ParentSpanID = hex.EncodeToString(span.ParentID[:])
+		}
+		for _, attr := range span.Attributes {
+			s.Attributes = append(s.Attributes, otlpAttr(attr))
+		}
+		if span.Err != nil {
+			s.Status = otlpStatus{Code: otlpStatusError, Message: span.Err.Error()}
+		}
+		scope.Spans = append(scope.Spans, s)
+	}
+	return &otlpTraces{ResourceSpans: []otlpResourceSpans{{Resource: e.resource, ScopeSpans: []otlpScopeSpans{scope}}}}
+}
+
+// Export posts spans to the endpoint
+func (e *otlpExporter) Export(spans []*Span) error {
+	body, err := json.Marshal(e.encode(spans))
+	if err != nil {
+		return err
+	}
+	resp, err := e.client.Post(e.endpoint, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode/100 != 2 {
+		return fmt.Errorf("trace endpoint responds %s", resp.Status)
+	}
+	return nil
+}
@@ -0,0 +1,134 @@
+// Copyright (c) 2018 ContentBox Authors.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package metrics
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/facebookgo/ensure"
+	"github.com/jbenet/goprocess"
+)
+
+type memExporter struct {
+	sync.Mutex
+	spans []*Span
+}
+
+func (e *memExporter) Export(spans []*Span) error {
+	e.Lock()
+	defer e.Unlock()
+	e.spans = append(e.spans, spans...)
+	return nil
+}
+
+func TestSpanDisabled(t *testing.T) {
+	setTracer(nil)
+	ctx, span := StartSpan(context.Background(), "off")
+	ensure.True(t, span == nil)
+	ensure.DeepEqual(t, ctx, context.Background())
+	span.SetAttribute("block.height", 1)
+	span.SetError(errors.New("failed"))
+	span.Finish()
+}
+
+func TestSpans(t *testing.T) {
+	exporter := &memExporter{}
+	tracer := newTracer(1, exporter)
+	setTracer(tracer)
+	defer setTracer(nil)
+
+	proc := goprocess.Go(tracer.loop)
+	ctx, root := StartSpan(context.Background(), "chain.ProcessBlock")
+	root.SetAttribute("block.height", uint32(10))
+	_, child := StartSpan(ctx, "chain.applyBlock")
+	child.SetError(errors.New("failed"))
+	child.Finish()
+	root.Finish()
+	// spans queued are exported on close
+	ensure.Nil(t, proc.Close())
+
+	ensure.DeepEqual(t, len(exporter.spans), 2)
+	ensure.DeepEqual(t, exporter.spans[0], child)
+	ensure.DeepEqual(t, exporter.spans[1], root)
+	ensure.DeepEqual(t, child.TraceID, root.TraceID)
+	ensure.DeepEqual(t, child.ParentID, root.SpanID)
+	ensure.DeepEqual(t, root.ParentID, [8]byte{})
+	ensure.DeepEqual(t, root.Attributes, []Attribute{{"block.height", uint32(10)}})
+	ensure.NotNil(t, child.Err)
+	ensure.False(t, root.End.Before(child.End))
+}
+
+func TestSpanSampling(t *testing.T) {
+	defer setTracer(nil)
+
+	tests := []struct {
+		ratio    float64
+		min, max int
+	}{
+		{-1, 0, 0},
+		{0, 0, 0},
+		{0.5, 400, 600},
+		{1, 1000, 1000},
+		{2, 1000, 1000},
+	}
+	for _, tc := range tests {
+		setTracer(newTracer(tc.ratio, &memExporter{}))
+		sampled := 0
+		for i := 0; i < 1000; i++ {
+			ctx, root := StartSpan(context.Background(), "root")
+			_, child := StartSpan(ctx, "child")
+			// children follow the decision of their roots
+			ensure.DeepEqual(t, root == nil, child == nil)
+			if root != nil {
+				sampled++
+			}
+		}
+		ensure.True(t, sampled >= tc.min && sampled <= tc.max, tc.ratio, sampled)
+	}
+}
+
+func TestOTLPExporter(t *testing.T) {
+	var received otlpTraces
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ensure.DeepEqual(t, r.Header.Get("Content-Type"), "application/json")
+		ensure.Nil(t, json.NewDecoder(r.Body).Decode(&received))
+	}))
+	defer server.Close()
+
+	setTracer(newTracer(1, nil))
+	ctx, root := StartSpan(context.Background(), "txpool.ProcessTx")
+	_, child := StartSpan(ctx, "txpool.checkTx")
+	setTracer(nil)
+	root.SetAttribute("tx.hash", "abcd")
+	root.SetAttribute("tx.local", true)
+	child.SetError(errors.New("insufficient fee"))
+
+	exporter := newOTLPExporter(server.URL, "")
+	ensure.Nil(t, exporter.Export([]*Span{child, root}))
+
+	ensure.DeepEqual(t, len(received.ResourceSpans), 1)
+	resource := received.ResourceSpans[0]
+	ensure.DeepEqual(t, resource.Resource.Attributes[0].Key, "service.name")
+	ensure.DeepEqual(t, resource.Resource.Attributes[0].Value["stringValue"], "boxd")
+	spans := resource.ScopeSpans[0].Spans
+	ensure.DeepEqual(t, len(spans), 2)
+	ensure.DeepEqual(t, spans[0].TraceID, hex.EncodeToString(root.TraceID[:]))
+	ensure.DeepEqual(t, spans[0].ParentSpanID, spans[1].SpanID)
+	ensure.DeepEqual(t, spans[0].Status, otlpStatus{Code: otlpStatusError, Message: "insufficient fee"})
+	ensure.DeepEqual(t, spans[1].ParentSpanID, "")
+	ensure.DeepEqual(t, spans[1].Status, otlpStatus{})
+	ensure.DeepEqual(t, spans[1].Attributes[0].Value["stringValue"], "abcd")
+	ensure.DeepEqual(t, spans[1].Attributes[1].Value["boolValue"], true)
+
+	server.Config.Handler = http.NotFoundHandler()
+	ensure.NotNil(t, exporter.Export([]*Span{root}))
+}
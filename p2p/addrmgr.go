@@ -0,0 +1,252 @@
+// Copyright (c) 2018 ContentBox Authors.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package p2p
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	mrand "math/rand"
+	"net"
+	"sync"
+	"time"
+
+	peer "github.com/libp2p/go-libp2p-peer"
+	ma "github.com/multiformats/go-multiaddr"
+)
+
+const (
+	// newBucketCount and triedBucketCount are the numbers of buckets of
+	// addresses not yet and once connected
+	newBucketCount   = 256
+	triedBucketCount = 64
+	// bucketSize is the max number of addresses in a bucket
+	bucketSize = 64
+	// newBucketsPerSource is the number of new buckets addresses from a
+	// source group may be put in, and triedBucketsPerGroup is the number of
+	// tried buckets addresses in a group may be put in
+	newBucketsPerSource  = 32
+	triedBucketsPerGroup = 8
+	// maxAddrAttempts is the number of failed attempts after which a new
+	// address is forgotten
+	maxAddrAttempts = 10
+)
+
+// knownAddr is an address known by addrManager
+type knownAddr struct {
+	pid         peer.ID
+	addr        ma.Multiaddr
+	group       string
+	tried       bool
+	bucket      int
+	attempts    int
+	lastSuccess time.Time
+}
+
+// addrManager keeps known peer addresses in new and tried buckets, placed by
+// ip groups of the addresses and of peers telling them with a secret key, as
+// bitcoin does. A source may only fill a few new buckets, and peers are
+// picked across buckets, so that an attacker controlling a few ip ranges
+// can't occupy all connections of the node, i.e. eclipse it
+type addrManager struct {
+	mutex        sync.Mutex
+	key          []byte
+	rand         *mrand.Rand
+	addrs        map[peer.ID]*knownAddr
+	newBuckets   []map[peer.ID]*knownAddr
+	triedBuckets []map[peer.ID]*knownAddr
+}
+
+// newAddrManager returns an empty addrManager with a random key
+func newAddrManager() *addrManager {
+	key := make([]byte, 32)
+	rand.Read(key)
+	am := &addrManager{
+		key:          key,
+		rand:         mrand.New(mrand.NewSource(time.Now().UnixNano())),
+		addrs:        make(map[peer.ID]*knownAddr),
+		newBuckets:   make([]map[peer.ID]*knownAddr, newBucketCount),
+		triedBuckets: make([]map[peer.ID]*knownAddr, triedBucketCount),
+	}
+	for i := range am.newBuckets {
+		am.newBuckets[i] = make(map[peer.ID]*knownAddr)
+	}
+	for i := range am.triedBuckets {
+		am.triedBuckets[i] = make(map[peer.ID]*knownAddr)
+	}
+	return am
+}
+
+// ipGroup returns the group of ip, /16 for ipv4 and /32 for ipv6. Loopback,
+// private and unknown ips are in the empty group, which isn't limited
+func ipGroup(ip net.IP) string {
+	if ip == nil || ip.IsLoopback() || ip.IsUnspecified() || ip.IsLinkLocalUnicast() || isPrivateIP(ip) {
+		return ""
+	}
+	if ip4 := ip.To4(); ip4 != nil {
+		return ip4.Mask(net.CIDRMask(16, 32)).String()
+	}
+	return ip.Mask(net.CIDRMask(32, 128)).String()
+}
+
+// hash returns a uint64 of the keyed hash of parts
+func (am *addrManager) hash(parts ...string) uint64 {
+	h := sha256.New()
+	h.Write(am.key)
+	for _, part := range parts {
+		h.Write([]byte(part))
+		h.Write([]byte{0})
+	}
+	return binary.BigEndian.Uint64(h.Sum(nil))
+}
+
+func (am *addrManager) newBucket(group, sourceGroup string) int {
+	i := am.hash(group, sourceGroup) % newBucketsPerSource
+	return int(am.hash(sourceGroup, fmt.Sprint(i)) % newBucketCount)
+}
+
+func (am *addrManager) triedBucket(pid peer.ID, group string) int {
+	i := am.hash(string(pid)) % triedBucketsPerGroup
+	return int(am.hash(group, fmt.Sprint(i)) % triedBucketCount)
+}
+
+// add adds address addr of pid told by a peer at ip source, nil for local
+// sources like seeds. Known peers are kept where they are
+func (am *addrManager) add(pid peer.ID, addr ma.Multiaddr, source net.IP) {
+	am.mutex.Lock()
+	defer am.mutex.Unlock()
+	if _, ok := am.addrs[pid]; ok {
+		return
+	}
+	ka := &knownAddr{pid: pid, addr: addr, group: ipGroup(addrIP(addr))}
+	ka.bucket = am.newBucket(ka.group, ipGroup(source))
+	am.insert(ka)
+}
+
+// insert puts ka in its bucket, evicting an address there if full
+func (am *addrManager) insert(ka *knownAddr) {
+	bucket := am.newBuckets[ka.bucket]
+	if ka.tried {
+		bucket = am.triedBuckets[ka.bucket]
+	}
+	if len(bucket) >= bucketSize {
+		evictee := am.randomAddr(bucket)
+		delete(bucket, evictee.pid)
+		delete(am.addrs, evictee.pid)
+		if ka.tried {
+			// addresses evicted from tried buckets are still good to try
+			evictee.tried = false
+			evictee.bucket = am.newBucket(evictee.group, "")
+			if len(am.newBuckets[evictee.bucket]) < bucketSize {
+				am.newBuckets[evictee.bucket][evictee.pid] = evictee
+				am.addrs[evictee.pid] = evictee
+			}
+		}
+	}
+	bucket[ka.pid] = ka
+	am.addrs[ka.pid] = ka
+}
+
+// remove removes ka from its bucket
+func (am *addrManager) remove(ka *knownAddr) {
+	if ka.tried {
+		delete(am.triedBuckets[ka.bucket], ka.pid)
+	} else {
+		delete(am.newBuckets[ka.bucket], ka.pid)
+	}
+	delete(am.addrs, ka.pid)
+}
+
+// good marks pid connected, moving it to tried buckets
+func (am *addrManager) good(pid peer.ID) {
+	am.mutex.Lock()
+	defer am.mutex.Unlock()
+	ka, ok := am.addrs[pid]
+	if !ok {
+		return
+	}
+	ka.attempts = 0
+	ka.lastSuccess = time.Now()
+	if ka.tried {
+		return
+	}
+	am.remove(ka)
+	ka.tried = true
+	ka.bucket = am.triedBucket(pid, ka.group)
+	am.insert(ka)
+}
+
+// attempt counts a connection attempt to pid. New addresses failing too many
+// times are forgotten
+func (am *addrManager) attempt(pid peer.ID) {
+	am.mutex.Lock()
+	defer am.mutex.Unlock()
+	ka, ok := am.addrs[pid]
+	if !ok {
+		return
+	}
+	if ka.attempts++; !ka.tried && ka.attempts >= maxAddrAttempts {
+		am.remove(ka)
+	}
+}
+
+// pick returns at most n peers, picked randomly from tried and new buckets
+// evenly, each from a distinct ip group except the unlimited one. Peers
+// which skip returns true for are left out
+func (am *addrManager) pick(n int, skip func(peer.ID, ma.Multiaddr) bool) []peer.ID {
+	// skip is called without lock, as it may look into connections
+	am.mutex.Lock()
+	all := make([]knownAddr, 0, len(am.addrs))
+	for _, ka := range am.addrs {
+		all = append(all, *ka)
+	}
+	am.mutex.Unlock()
+
+	var tried, fresh []knownAddr
+	for _, ka := range all {
+		if skip(ka.pid, ka.addr) {
+			continue
+		}
+		if ka.tried {
+			tried = append(tried, ka)
+		} else {
+			fresh = append(fresh, ka)
+		}
+	}
+
+	am.mutex.Lock()
+	defer am.mutex.Unlock()
+	am.rand.Shuffle(len(tried), func(i, j int) { tried[i], tried[j] = tried[j], tried[i] })
+	am.rand.Shuffle(len(fresh), func(i, j int) { fresh[i], fresh[j] = fresh[j], fresh[i] })
+	var pids []peer.ID
+	groups := make(map[string]bool)
+	for len(pids) < n && (len(tried) > 0 || len(fresh) > 0) {
+		var ka knownAddr
+		if len(fresh) == 0 || len(tried) > 0 && am.rand.Intn(2) == 0 {
+			ka, tried = tried[0], tried[1:]
+		} else {
+			ka, fresh = fresh[0], fresh[1:]
+		}
+		if ka.group != "" && groups[ka.group] {
+			continue
+		}
+		groups[ka.group] = true
+		pids = append(pids, ka.pid)
+	}
+	return pids
+}
+
+// randomAddr returns a random address in bucket, which must not be empty
+func (am *addrManager) randomAddr(bucket map[peer.ID]*knownAddr) *knownAddr {
+	i := am.rand.Intn(len(bucket))
+	for _, ka := range bucket {
+		if i == 0 {
+			return ka
+		}
+		i--
+	}
+	return nil
+}
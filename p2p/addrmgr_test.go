@@ -0,0 +1,77 @@
+// Copyright (c) 2018 ContentBox Authors.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package p2p
+
+import (
+	"fmt"
+	"net"
+	"testing"
+
+	"github.com/facebookgo/ensure"
+	peer "github.com/libp2p/go-libp2p-peer"
+	ma "github.com/multiformats/go-multiaddr"
+)
+
+func TestIPGroup(t *testing.T) {
+	ensure.DeepEqual(t, ipGroup(net.ParseIP("1.2.3.4")), "1.2.0.0")
+	ensure.DeepEqual(t, ipGroup(net.ParseIP("1.2.200.100")), "1.2.0.0")
+	ensure.DeepEqual(t, ipGroup(net.ParseIP("2001:db8:1::1")), "2001:db8::")
+	ensure.DeepEqual(t, ipGroup(net.ParseIP("127.0.0.1")), "")
+	ensure.DeepEqual(t, ipGroup(net.ParseIP("192.168.1.1")), "")
+	ensure.DeepEqual(t, ipGroup(nil), "")
+}
+
+func testAddr(t *testing.T, ip string) ma.Multiaddr {
+	addr, err := ma.NewMultiaddr(fmt.Sprintf("/ip4/%s/tcp/19199", ip))
+	ensure.Nil(t, err)
+	return addr
+}
+
+func TestAddrManager(t *testing.T) {
+	am := newAddrManager()
+	none := func(peer.ID, ma.Multiaddr) bool { return false }
+
+	// peers in the same group are picked once in a round
+	sameGroup := []peer.ID{peerID(), peerID(), peerID()}
+	for i, pid := range sameGroup {
+		am.add(pid, testAddr(t, fmt.Sprintf("1.2.3.%d", i+1)), nil)
+	}
+	other := peerID()
+	am.add(other, testAddr(t, "5.6.7.8"), nil)
+	ensure.DeepEqual(t, len(am.pick(10, none)), 2)
+
+	// the unlimited group isn't
+	for i := 0; i < 3; i++ {
+		am.add(peerID(), testAddr(t, fmt.Sprintf("127.0.0.%d", i+1)), nil)
+	}
+	ensure.DeepEqual(t, len(am.pick(10, none)), 5)
+	ensure.DeepEqual(t, len(am.pick(3, none)), 3)
+	ensure.DeepEqual(t, am.pick(10, func(pid peer.ID, _ ma.Multiaddr) bool { return pid != other }), []peer.ID{other})
+
+	// connected peers are moved to tried buckets
+	am.good(other)
+	ensure.True(t, am.addrs[other].tried)
+	ensure.DeepEqual(t, len(am.triedBuckets[am.addrs[other].bucket]), 1)
+
+	// new addresses failing too many times are forgotten, tried ones are not
+	for i := 0; i < maxAddrAttempts; i++ {
+		am.attempt(sameGroup[0])
+		am.attempt(other)
+	}
+	_, ok := am.addrs[sameGroup[0]]
+	ensure.False(t, ok)
+	_, ok = am.addrs[other]
+	ensure.True(t, ok)
+}
+
+func TestAddrManagerBucketSize(t *testing.T) {
+	am := newAddrManager()
+	// a single source fills a limited number of new buckets
+	source := net.ParseIP("9.9.9.9")
+	for i := 0; i < 4*newBucketsPerSource*bucketSize; i++ {
+		am.add(peerID(), testAddr(t, fmt.Sprintf("%d.%d.1.1", 1+i/250%250, 1+i%250)), source)
+	}
+	ensure.True(t, len(am.addrs) <= newBucketsPerSource*bucketSize)
+}
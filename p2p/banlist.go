@@ -0,0 +1,228 @@
+// Copyright (c) 2018 ContentBox Authors.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package p2p
+
+import (
+	"net"
+	"net/url"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/BOXFoundation/boxd/storage"
+	key "github.com/BOXFoundation/boxd/storage/key"
+	peer "github.com/libp2p/go-libp2p-peer"
+	ma "github.com/multiformats/go-multiaddr"
+)
+
+// BanTableName is the table name of db to store bans
+const BanTableName = "ban"
+
+// Bans are stored under the following db key pattern, subnets escaped:
+// /bans/<b58 peer id, ip or subnet>
+var banBase = key.NewKey("/bans")
+
+// Ban is a peer id, ip or subnet banned until some time
+type Ban struct {
+	Target string
+	Until  time.Time
+}
+
+// BanList keeps bans of peer ids, ips and subnets, persisted in storage so
+// that they survive restarts
+type BanList struct {
+	mutex   sync.Mutex
+	bans    map[string]time.Time
+	subnets map[string]*net.IPNet
+	store   storage.Table
+}
+
+// NewBanList creates a BanList, loading unexpired bans from s
+func NewBanList(s storage.Table) (*BanList, error) {
+	bl := &BanList{bans: make(map[string]time.Time), subnets: make(map[string]*net.IPNet), store: s}
+	now := time.Now()
+	for _, k := range s.KeysWithPrefix(banBase.Bytes()) {
+		buf, err := s.Get(k)
+		if err != nil {
+			return nil, err
+		}
+		var until time.Time
+		if err := until.UnmarshalBinary(buf); err != nil {
+			return nil, err
+		}
+		if !until.After(now) {
+			s.Del(k)
+			continue
+		}
+		target, err := url.PathUnescape(key.NewKeyFromBytes(k).BaseName())
+		if err != nil {
+			return nil, err
+		}
+		bl.add(target, until)
+	}
+	return bl, nil
+}
+
+// banTarget returns the canonical form of a peer id, ip or subnet to ban
+func banTarget(target string) (string, error) {
+	if pid, err := peer.IDB58Decode(target); err == nil {
+		return pid.Pretty(), nil
+	}
+	if ip := net.ParseIP(target); ip != nil {
+		return ip.String(), nil
+	}
+	if _, subnet, err := net.ParseCIDR(target); err == nil {
+		return subnet.String(), nil
+	}
+	return "", ErrInvalidBanTarget
+}
+
+func banKey(target string) []byte {
+	return banBase.ChildString(url.PathEscape(target)).Bytes()
+}
+
+// add records a ban of canonical target. The mutex must be held if bl is shared
+func (bl *BanList) add(target string, until time.Time) {
+	bl.bans[target] = until
+	if strings.Contains(target, "/") {
+		_, bl.subnets[target], _ = net.ParseCIDR(target)
+	}
+}
+
+// remove drops the ban of target. The mutex must be held
+func (bl *BanList) remove(target string) error {
+	if err := bl.store.Del(banKey(target)); err != nil {
+		return err
+	}
+	delete(bl.bans, target)
+	delete(bl.subnets, target)
+	return nil
+}
+
+// Ban bans target, a peer id, ip or subnet in CIDR notation, for d
+func (bl *BanList) Ban(target string, d time.Duration) error {
+	_, err := bl.ban(target, d)
+	return err
+}
+
+// ban bans target for d, and returns the ban of its canonical form
+func (bl *BanList) ban(target string, d time.Duration) (Ban, error) {
+	target, err := banTarget(target)
+	if err != nil {
+		return Ban{}, err
+	}
+	if d <= 0 {
+		return Ban{}, ErrInvalidBanDuration
+	}
+	until := time.Now().Add(d)
+	buf, err := until.MarshalBinary()
+	if err != nil {
+		return Ban{}, err
+	}
+
+	bl.mutex.Lock()
+	defer bl.mutex.Unlock()
+	if err := bl.store.Put(banKey(target), buf); err != nil {
+		return Ban{}, err
+	}
+	bl.add(target, until)
+	return Ban{Target: target, Until: until}, nil
+}
+
+// Unban lifts the ban of target
+func (bl *BanList) Unban(target string) error {
+	_, err := bl.unban(target)
+	return err
+}
+
+// unban lifts the ban of target, and returns its canonical form
+func (bl *BanList) unban(target string) (string, error) {
+	target, err := banTarget(target)
+	if err != nil {
+		return "", err
+	}
+
+	bl.mutex.Lock()
+	defer bl.mutex.Unlock()
+	if _, ok := bl.bans[target]; !ok {
+		return "", ErrNotBanned
+	}
+	return target, bl.remove(target)
+}
+
+// IsBanned checks if target is banned. Expired bans are dropped
+func (bl *BanList) IsBanned(target string) bool {
+	bl.mutex.Lock()
+	defer bl.mutex.Unlock()
+	return bl.isBanned(target, time.Now())
+}
+
+func (bl *BanList) isBanned(target string, now time.Time) bool {
+	until, ok := bl.bans[target]
+	if !ok {
+		return false
+	}
+	if !until.After(now) {
+		bl.remove(target)
+		return false
+	}
+	return true
+}
+
+// IsBannedIP checks if ip, or a subnet it's in, is banned
+func (bl *BanList) IsBannedIP(ip net.IP) bool {
+	bl.mutex.Lock()
+	defer bl.mutex.Unlock()
+	now := time.Now()
+	if bl.isBanned(ip.String(), now) {
+		return true
+	}
+	for target, subnet := range bl.subnets {
+		if subnet.Contains(ip) && bl.isBanned(target, now) {
+			return true
+		}
+	}
+	return false
+}
+
+// IsBannedPeer checks if pid or the ip of addr is banned
+func (bl *BanList) IsBannedPeer(pid peer.ID, addr ma.Multiaddr) bool {
+	if bl.IsBanned(pid.Pretty()) {
+		return true
+	}
+	if ip := addrIP(addr); ip != nil {
+		return bl.IsBannedIP(ip)
+	}
+	return false
+}
+
+// addrIP returns the ip of multiaddr addr, or nil if it has none
+func addrIP(addr ma.Multiaddr) net.IP {
+	if addr == nil {
+		return nil
+	}
+	for _, code := range []int{ma.P_IP4, ma.P_IP6} {
+		if ip, err := addr.ValueForProtocol(code); err == nil {
+			return net.ParseIP(ip)
+		}
+	}
+	return nil
+}
+
+// Bans returns unexpired bans ordered by target
+func (bl *BanList) Bans() []Ban {
+	bl.mutex.Lock()
+	defer bl.mutex.Unlock()
+	now := time.Now()
+	bans := make([]Ban, 0, len(bl.bans))
+	for target, until := range bl.bans {
+		if until.After(now) {
+			bans = append(bans, Ban{Target: target, Until: until})
+		}
+	}
+	sort.Slice(bans, func(i, j int) bool { return bans[i].Target < bans[j].Target })
+	return bans
+}
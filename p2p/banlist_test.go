@@ -0,0 +1,72 @@
+// Copyright (c) 2018 ContentBox Authors.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package p2p
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/BOXFoundation/boxd/storage/memdb"
+	"github.com/facebookgo/ensure"
+	peer "github.com/libp2p/go-libp2p-peer"
+	ma "github.com/multiformats/go-multiaddr"
+)
+
+const testPeerID = "QmVjYrCGiysX3FjeqyVWTCtgTrnYWdBkkPwFWrDMGRMhSy"
+
+func TestBanList(t *testing.T) {
+	db, _ := memdb.NewMemoryDB("", nil)
+	bl, err := NewBanList(db)
+	ensure.Nil(t, err)
+
+	ensure.DeepEqual(t, bl.Ban("not a target", time.Hour), ErrInvalidBanTarget)
+	ensure.DeepEqual(t, bl.Ban("127.0.0.1", 0), ErrInvalidBanDuration)
+	ensure.DeepEqual(t, bl.Unban("127.0.0.1"), ErrNotBanned)
+
+	ensure.Nil(t, bl.Ban(testPeerID, time.Hour))
+	ensure.Nil(t, bl.Ban("10.0.0.1", time.Hour))
+	ensure.True(t, bl.IsBanned(testPeerID))
+	ensure.True(t, bl.IsBanned("10.0.0.1"))
+	ensure.False(t, bl.IsBanned("10.0.0.2"))
+
+	pid, _ := peer.IDB58Decode(testPeerID)
+	addr, _ := ma.NewMultiaddr("/ip4/10.0.0.2/tcp/19199")
+	ensure.True(t, bl.IsBannedPeer(pid, addr))
+	other, _ := peer.IDB58Decode("QmPbXnwgNDMYTPhrzyGzcQBkWGBkAhxmXB3w4EQgFVTTxr")
+	ensure.False(t, bl.IsBannedPeer(other, addr))
+	addr, _ = ma.NewMultiaddr("/ip4/10.0.0.1/tcp/19199")
+	ensure.True(t, bl.IsBannedPeer(other, addr))
+
+	// bans are reloaded from storage
+	bl, err = NewBanList(db)
+	ensure.Nil(t, err)
+	bans := bl.Bans()
+	ensure.DeepEqual(t, len(bans), 2)
+	ensure.DeepEqual(t, bans[0].Target, "10.0.0.1")
+	ensure.DeepEqual(t, bans[1].Target, testPeerID)
+
+	ensure.Nil(t, bl.Unban("10.0.0.1"))
+	bl, _ = NewBanList(db)
+	ensure.False(t, bl.IsBanned("10.0.0.1"))
+
+	// subnets cover ips in them
+	ensure.Nil(t, bl.Ban("192.168.1.7/24", time.Hour))
+	ensure.True(t, bl.IsBanned("192.168.1.0/24"))
+	addr, _ = ma.NewMultiaddr("/ip4/192.168.1.20/tcp/19199")
+	ensure.True(t, bl.IsBannedPeer(other, addr))
+	addr, _ = ma.NewMultiaddr("/ip4/192.168.2.20/tcp/19199")
+	ensure.False(t, bl.IsBannedPeer(other, addr))
+	bl, _ = NewBanList(db)
+	ensure.True(t, bl.IsBannedIP(net.ParseIP("192.168.1.20")))
+	ensure.Nil(t, bl.Unban("192.168.1.0/24"))
+	ensure.False(t, bl.IsBannedIP(net.ParseIP("192.168.1.20")))
+
+	// expired bans are dropped
+	ensure.Nil(t, bl.Ban("10.0.0.3", time.Millisecond))
+	time.Sleep(2 * time.Millisecond)
+	ensure.False(t, bl.IsBanned("10.0.0.3"))
+	ensure.DeepEqual(t, len(bl.Bans()), 1)
+}
@@ -0,0 +1,64 @@
+// Copyright (c) 2018 ContentBox Authors.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package p2p
+
+import (
+	libp2p "github.com/libp2p/go-libp2p"
+	circuit "github.com/libp2p/go-libp2p-circuit"
+	peer "github.com/libp2p/go-libp2p-peer"
+	ma "github.com/multiformats/go-multiaddr"
+)
+
+// circuitAddrs returns circuit addresses through relays, multiaddrs ending
+// with peer ids of relay nodes, to announce when not reachable directly
+func circuitAddrs(relays []string) ([]ma.Multiaddr, error) {
+	var addrs []ma.Multiaddr
+	for _, relay := range relays {
+		addr, err := ma.NewMultiaddr(relay + "/p2p-circuit")
+		if err != nil {
+			return nil, ErrInvalidPeerAddr
+		}
+		addrs = append(addrs, addr)
+	}
+	return addrs, nil
+}
+
+// withCircuitAddrs returns listen addrs, with circuits appended if none of
+// addrs is public, e.g. behind NAT without port mapping
+func withCircuitAddrs(addrs []ma.Multiaddr, circuits []ma.Multiaddr) []ma.Multiaddr {
+	for _, addr := range addrs {
+		if shareableAddr(addr, nil) {
+			return addrs
+		}
+	}
+	return append(addrs, circuits...)
+}
+
+// relayOptions returns libp2p options to dial and accept connections through
+// relays, relaying for others too if hop, and announcing circuits through
+// relays when not reachable directly
+func relayOptions(hop bool, relays []string) ([]libp2p.Option, error) {
+	circuits, err := circuitAddrs(relays)
+	if err != nil {
+		return nil, err
+	}
+	var relayOpts []circuit.RelayOpt
+	if hop {
+		relayOpts = append(relayOpts, circuit.OptHop)
+	}
+	opts := []libp2p.Option{libp2p.EnableRelay(relayOpts...)}
+	if len(circuits) > 0 {
+		opts = append(opts, libp2p.AddrsFactory(func(addrs []ma.Multiaddr) []ma.Multiaddr {
+			return withCircuitAddrs(addrs, circuits)
+		}))
+	}
+	return opts, nil
+}
+
+// isRelay checks if pid is a configured relay
+func (p *BoxPeer) isRelay(pid peer.ID) bool {
+	_, ok := p.relays[pid]
+	return ok
+}
@@ -0,0 +1,34 @@
+// Copyright (c) 2018 ContentBox Authors.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package p2p
+
+import (
+	"testing"
+
+	"github.com/facebookgo/ensure"
+	ma "github.com/multiformats/go-multiaddr"
+)
+
+func TestCircuitAddrs(t *testing.T) {
+	relay := "/ip4/1.2.3.4/tcp/19199/p2p/" + testPeerID
+	addrs, err := circuitAddrs([]string{relay})
+	ensure.Nil(t, err)
+	ensure.DeepEqual(t, len(addrs), 1)
+	ensure.DeepEqual(t, addrs[0].String(), relay+"/p2p-circuit")
+
+	_, err = circuitAddrs([]string{"not a multiaddr"})
+	ensure.DeepEqual(t, err, ErrInvalidPeerAddr)
+}
+
+func TestWithCircuitAddrs(t *testing.T) {
+	circuits, err := circuitAddrs([]string{"/ip4/1.2.3.4/tcp/19199/p2p/" + testPeerID})
+	ensure.Nil(t, err)
+
+	private := []ma.Multiaddr{testAddr(t, "127.0.0.1"), testAddr(t, "192.168.1.2")}
+	ensure.DeepEqual(t, len(withCircuitAddrs(private, circuits)), 3)
+
+	public := append(private, testAddr(t, "5.6.7.8"))
+	ensure.DeepEqual(t, len(withCircuitAddrs(public, circuits)), 3)
+}
@@ -5,13 +5,37 @@
 package p2p
 
 import (
+	"bytes"
+	"compress/gzip"
+	"io"
+	"io/ioutil"
+
 	"github.com/golang/snappy"
 )
 
 var (
 	compressFlag = 1 << 7
+	gzipFlag     = 1 << 6
+)
+
+// compression codecs to config
+const (
+	CompressionSnappy = "snappy"
+	CompressionGzip   = "gzip"
+	CompressionNone   = "none"
 )
 
+// features exchanged in ping and pong messages, codecs the sender decodes
+const (
+	featureSnappy uint8 = 1 << iota
+	featureGzip
+
+	localFeatures = featureSnappy | featureGzip
+)
+
+// minCompressLength is the body length below which messages are sent as is
+const minCompressLength = 512
+
 // MaxEncodedLen = 0xffffffff 3GB
 func compress(dst, src []byte) []byte {
 	return snappy.Encode(dst, src)
@@ -20,3 +44,81 @@ func compress(dst, src []byte) []byte {
 func decompress(dst, src []byte) ([]byte, error) {
 	return snappy.Decode(dst, src)
 }
+
+func gzipCompress(src []byte) ([]byte, error) {
+	var buf bytes.Buffer
+	w := gzip.NewWriter(&buf)
+	if _, err := w.Write(src); err != nil {
+		return nil, err
+	}
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// gzipDecompress decompresses src, failing if it inflates beyond
+// MaxMessageDataLength
+func gzipDecompress(src []byte) ([]byte, error) {
+	r, err := gzip.NewReader(bytes.NewReader(src))
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+	data, err := ioutil.ReadAll(io.LimitReader(r, MaxMessageDataLength+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(data) > MaxMessageDataLength {
+		return nil, ErrExceedMaxDataLength
+	}
+	return data, nil
+}
+
+// selectCompression returns the codec to compress messages to a peer with,
+// the preferred one if the peer decodes it, or else snappy if it does.
+// Peers which don't exchange features only decode snappy
+func selectCompression(preferred string, remoteFeatures uint8, known bool) string {
+	if !known {
+		remoteFeatures = featureSnappy
+	}
+	switch {
+	case preferred == CompressionNone:
+		return CompressionNone
+	case preferred == CompressionGzip && remoteFeatures&featureGzip != 0:
+		return CompressionGzip
+	case remoteFeatures&featureSnappy != 0:
+		return CompressionSnappy
+	}
+	return CompressionNone
+}
+
+// encodeBody compresses body with codec, returning the flag set in the
+// message header
+func encodeBody(codec string, body []byte) (int, []byte, error) {
+	if len(body) < minCompressLength {
+		return 0, body, nil
+	}
+	switch codec {
+	case CompressionSnappy:
+		return compressFlag, compress(nil, body), nil
+	case CompressionGzip:
+		data, err := gzipCompress(body)
+		if err != nil {
+			return 0, nil, err
+		}
+		return gzipFlag, data, nil
+	}
+	return 0, body, nil
+}
+
+// decodeBody decompresses body according to flags in the message header
+func decodeBody(flags int, body []byte) ([]byte, error) {
+	switch {
+	case flags&compressFlag != 0:
+		return decompress(nil, body)
+	case flags&gzipFlag != 0:
+		return gzipDecompress(body)
+	}
+	return body, nil
+}
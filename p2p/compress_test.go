@@ -0,0 +1,56 @@
+// Copyright (c) 2018 ContentBox Authors.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package p2p
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/facebookgo/ensure"
+)
+
+func TestSelectCompression(t *testing.T) {
+	tests := []struct {
+		preferred string
+		features  uint8
+		known     bool
+		expect    string
+	}{
+		{CompressionSnappy, 0, false, CompressionSnappy},
+		{CompressionGzip, 0, false, CompressionSnappy},
+		{CompressionGzip, featureSnappy | featureGzip, true, CompressionGzip},
+		{CompressionGzip, featureSnappy, true, CompressionSnappy},
+		{CompressionSnappy, featureGzip, true, CompressionNone},
+		{CompressionNone, featureSnappy | featureGzip, true, CompressionNone},
+	}
+	for _, test := range tests {
+		ensure.DeepEqual(t, selectCompression(test.preferred, test.features, test.known), test.expect)
+	}
+}
+
+func TestEncodeBody(t *testing.T) {
+	body := bytes.Repeat([]byte("box"), minCompressLength)
+	for codec, flag := range map[string]int{
+		CompressionSnappy: compressFlag,
+		CompressionGzip:   gzipFlag,
+		CompressionNone:   0,
+	} {
+		flags, data, err := encodeBody(codec, body)
+		ensure.Nil(t, err)
+		ensure.DeepEqual(t, flags, flag)
+		if flag != 0 {
+			ensure.True(t, len(data) < len(body))
+		}
+		decoded, err := decodeBody(flags, data)
+		ensure.Nil(t, err)
+		ensure.DeepEqual(t, decoded, body)
+	}
+
+	// short bodies are not compressed
+	flags, data, err := encodeBody(CompressionGzip, []byte("box"))
+	ensure.Nil(t, err)
+	ensure.DeepEqual(t, flags, 0)
+	ensure.DeepEqual(t, data, []byte("box"))
+}
@@ -6,6 +6,8 @@ package p2p
 
 import (
 	"time"
+
+	"github.com/BOXFoundation/boxd/p2p/pscore"
 )
 
 // Config for peer configuration
@@ -20,4 +22,97 @@ type Config struct {
 	AddPeers        []string      `mapstructure:"addpeer"`
 	ConnMaxCapacity uint32        `mapstructure:"conn_max_capacity"`
 	ConnLoadFactor  float32       `mapstructure:"conn_load_factor"`
+	// peers scoring below BanScoreThreshold are banned with their ips for
+	// BanDuration. Defaults are used if not set
+	BanScoreThreshold int64         `mapstructure:"ban_score_threshold"`
+	BanDuration       time.Duration `mapstructure:"ban_duration"`
+	// peer ids, ips or subnets always allowed, exempt from bans and score
+	// based disconnection
+	Whitelist []string `mapstructure:"whitelist"`
+	// peer ids, ips or subnets never connected unless whitelisted
+	Blacklist []string `mapstructure:"blacklist"`
+	// max number of connections accepted and made. Defaults are used if not set
+	MaxInbound  uint32 `mapstructure:"max_inbound"`
+	MaxOutbound uint32 `mapstructure:"max_outbound"`
+	// hostnames whose TXT records hold peer multiaddrs, as dnsaddr=<multiaddr>
+	DNSSeeds []string `mapstructure:"dns_seeds"`
+	// multiaddrs ending with peer ids of peers always kept connected and
+	// protected from bans and evictions
+	PersistentPeers []string `mapstructure:"persistent_peers"`
+	// codec to compress blocks and sync messages with, snappy, gzip or none.
+	// Peers not decoding it get snappy. Default is snappy
+	Compression string `mapstructure:"compression"`
+	// caps of bytes per second sent and received, in total and with each
+	// peer. 0 means unlimited
+	UploadRate       uint64 `mapstructure:"upload_rate"`
+	DownloadRate     uint64 `mapstructure:"download_rate"`
+	PeerUploadRate   uint64 `mapstructure:"peer_upload_rate"`
+	PeerDownloadRate uint64 `mapstructure:"peer_download_rate"`
+	// scores of peer events, limits, thresholds and decay periods
+	Score pscore.Config `mapstructure:"score"`
+	// max number of connections with peers in an ip group, /16 for ipv4 and
+	// /32 for ipv6. Default is used if not set
+	MaxPeersPerGroup uint32 `mapstructure:"max_peers_per_group"`
+	// relay connections between other peers through the node
+	RelayHop bool `mapstructure:"relay_hop"`
+	// multiaddrs ending with peer ids of relay nodes, kept connected and
+	// announced as circuits when the node isn't reachable directly
+	Relays []string `mapstructure:"relays"`
+	// pre-shared key of a private network, 32 bytes in hex. Only nodes with
+	// the same key complete transport handshakes. Empty means public network
+	NetworkKey string `mapstructure:"network_key"`
+	// messages and bytes received from a peer within a minute above which a
+	// traffic anomaly is published. 0 means no limit
+	TrafficWarnMsgs  uint64 `mapstructure:"traffic_warn_msgs"`
+	TrafficWarnBytes uint64 `mapstructure:"traffic_warn_bytes"`
+}
+
+const (
+	defaultBanScoreThreshold = -500
+	defaultBanDuration       = 24 * time.Hour
+	defaultMaxInbound        = 150
+	defaultMaxOutbound       = 50
+	defaultMaxPeersPerGroup  = 4
+)
+
+func (c *Config) maxInbound() int {
+	if c.MaxInbound == 0 {
+		return defaultMaxInbound
+	}
+	return int(c.MaxInbound)
+}
+
+func (c *Config) maxOutbound() int {
+	if c.MaxOutbound == 0 {
+		return defaultMaxOutbound
+	}
+	return int(c.MaxOutbound)
+}
+
+func (c *Config) maxPeersPerGroup() int {
+	if c.MaxPeersPerGroup == 0 {
+		return defaultMaxPeersPerGroup
+	}
+	return int(c.MaxPeersPerGroup)
+}
+
+func (c *Config) banScoreThreshold() int64 {
+	if c.BanScoreThreshold == 0 {
+		return defaultBanScoreThreshold
+	}
+	return c.BanScoreThreshold
+}
+
+func (c *Config) banDuration() time.Duration {
+	if c.BanDuration <= 0 {
+		return defaultBanDuration
+	}
+	return c.BanDuration
+}
+
+func (c *Config) compression() string {
+	if c.Compression == "" {
+		return CompressionSnappy
+	}
+	return c.Compression
 }
@@ -8,6 +8,7 @@ import (
 	"errors"
 	"io"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/BOXFoundation/boxd/boxd/eventbus"
@@ -19,6 +20,7 @@ import (
 	libp2pnet "github.com/libp2p/go-libp2p-net"
 	peer "github.com/libp2p/go-libp2p-peer"
 	peerstore "github.com/libp2p/go-libp2p-peerstore"
+	multiaddr "github.com/multiformats/go-multiaddr"
 )
 
 // const
@@ -35,6 +37,12 @@ const (
 
 // Conn represents a connection to a remote node
 type Conn struct {
+	// stats accessed atomically, kept first to be 64-bit aligned
+	bytesSent   uint64
+	bytesRecv   uint64
+	lastMsgTime int64 // unix nano of the last received message
+	drained     int32 // set once neither queueing nor handling messages
+
 	stream             libp2pnet.Stream
 	peer               *BoxPeer
 	remotePeer         peer.ID
@@ -45,6 +53,37 @@ type Conn struct {
 	proc               goprocess.Process
 	procHeartbeat      goprocess.Process
 	mutex              sync.Mutex
+
+	inbound    bool
+	pingSentAt time.Time
+	latency    time.Duration // round trip of the last ping
+	slowPongs  int           // pongs in a row slower than the high latency
+
+	addrRequested bool      // waiting for addresses requested
+	addrServedAt  time.Time // when addresses were last sent on request
+
+	remoteFeatures      uint8 // features exchanged in ping and pong
+	remoteFeaturesKnown bool
+
+	version     *PeerVersion // announced by the remote peer
+	versionSent bool
+	verAcked    bool
+
+	uploadLimiter   *rateLimiter
+	downloadLimiter *rateLimiter
+
+	traffic *trafficStats
+
+	remoteLeft bool // remote peer sent a disconnect notice
+	closed     bool
+}
+
+// outMessage is a marshalled message queued to send
+type outMessage struct {
+	code     uint32
+	data     []byte
+	priority uint8
+	sent     chan struct{} // closed once written if not nil
 }
 
 // NewConn create a stream to remote peer.
@@ -57,6 +96,10 @@ func NewConn(stream libp2pnet.Stream, peer *BoxPeer, peerID peer.ID) *Conn {
 		isEstablished:      false,
 		isSynced:           false,
 		establishSucceedCh: make(chan bool, 1),
+		inbound:            stream != nil,
+		uploadLimiter:      newRateLimiter(peer.config.PeerUploadRate),
+		downloadLimiter:    newRateLimiter(peer.config.PeerDownloadRate),
+		traffic:            newTrafficStats(),
 	}
 }
 
@@ -68,11 +111,18 @@ func (conn *Conn) Loop(parent goprocess.Process) {
 		conn.proc.Go(conn.loop).SetTeardown(conn.Close)
 
 		go conn.pq.Run(conn.proc, func(i interface{}) {
-			data := i.([]byte)
+			msg := i.(*outMessage)
+			data := msg.data
+			throttle(conn.proc, len(data), msg.priority, conn.peer.uploadLimiter, conn.uploadLimiter)
 			if _, err := conn.stream.Write(data); err != nil {
 				logger.Error("Failed to write message. ", err)
 			} else {
 				metricsWriteMeter.Mark(int64(len(data) / 8))
+				atomic.AddUint64(&conn.bytesSent, uint64(len(data)))
+				conn.traffic.sent(msg.code, len(data))
+			}
+			if msg.sent != nil {
+				close(msg.sent)
 			}
 		})
 	}
@@ -81,6 +131,13 @@ func (conn *Conn) Loop(parent goprocess.Process) {
 
 func (conn *Conn) loop(proc goprocess.Process) {
 	if conn.stream == nil {
+		if conn.peer.stopping() {
+			return
+		}
+		if conn.peer.isRejected(conn.remotePeer, nil) {
+			logger.Debugf("Skip connecting to banned peer %s", conn.remotePeer.Pretty())
+			return
+		}
 		ctx := goprocessctx.OnClosingContext(proc)
 		s, err := conn.peer.host.NewStream(ctx, conn.remotePeer, ProtocolID)
 		if err != nil {
@@ -88,6 +145,10 @@ func (conn *Conn) loop(proc goprocess.Process) {
 			return
 		}
 		conn.stream = s
+		if err := conn.sendVersion(); err != nil {
+			logger.Errorf("Failed to send version to peer %s, err = %s", conn.remotePeer.Pretty(), err.Error())
+			return
+		}
 		if err := conn.Ping(); err != nil {
 			logger.Errorf("Failed to ping peer %s, err = %s", conn.remotePeer.Pretty(), err.Error())
 			return
@@ -108,8 +169,13 @@ func (conn *Conn) loop(proc goprocess.Process) {
 			logger.Errorf("ReadMessage occurs error. Err: %s", err.Error())
 			return
 		}
+		throttle(proc, int(msg.dataLength), attributeOf(msg.code).priority, conn.peer.downloadLimiter, conn.downloadLimiter)
 		//logger.Debugf("Receiving message %02x from peer %s", msg.Code(), conn.remotePeer.Pretty())
 		if err := conn.Handle(msg); err != nil {
+			if err == ErrPeerLeft {
+				logger.Info("Peer left gracefully ", conn.remotePeer.Pretty())
+				return
+			}
 			logger.Error("Failed to handle message. ", err)
 			return
 		}
@@ -128,8 +194,8 @@ func (conn *Conn) readMessage(r io.Reader) (*remoteMessage, error) {
 	}
 
 	reserved := msg.messageHeader.reserved
-	if len(reserved) != 0 && int(reserved[0])&compressFlag != 0 {
-		data, err := decompress(nil, msg.body)
+	if len(reserved) != 0 {
+		data, err := decodeBody(int(reserved[0]), msg.body)
 		if err != nil {
 			return nil, err
 		}
@@ -137,6 +203,9 @@ func (conn *Conn) readMessage(r io.Reader) (*remoteMessage, error) {
 	}
 
 	metricsReadMeter.Mark(msg.Len())
+	atomic.AddUint64(&conn.bytesRecv, uint64(msg.Len()))
+	atomic.StoreInt64(&conn.lastMsgTime, time.Now().UnixNano())
+	conn.accountRecv(msg.code, int(msg.Len()))
 	if err != nil {
 		return nil, err
 	}
@@ -145,12 +214,26 @@ func (conn *Conn) readMessage(r io.Reader) (*remoteMessage, error) {
 
 // Handle is called on loop
 func (conn *Conn) Handle(msg *remoteMessage) error {
+	if msg.code == DisconnectMsg {
+		return conn.OnDisconnect()
+	}
+	if conn.draining() {
+		// messages arriving while stopping are dropped
+		return nil
+	}
+
 	// handle handshake messages
 	switch msg.code {
 	case Ping:
+		conn.setRemoteFeatures(msg.reserved)
 		return conn.OnPing(msg.body)
 	case Pong:
+		conn.setRemoteFeatures(msg.reserved)
 		return conn.OnPong(msg.body)
+	case VersionMsg:
+		return conn.OnVersion(msg.body)
+	case VerAckMsg:
+		return conn.OnVerAck(msg.body)
 	}
 	if !conn.Established() {
 		// return error in case no handshake with remote peer
@@ -163,6 +246,10 @@ func (conn *Conn) Handle(msg *remoteMessage) error {
 		return conn.OnPeerDiscover(msg.body)
 	case PeerDiscoverReply:
 		return conn.OnPeerDiscoverReply(msg.body)
+	case GetAddrMsg:
+		return conn.OnGetAddr(msg.body)
+	case AddrMsg:
+		return conn.OnAddr(msg.body)
 	default:
 		// others, notify its subscriber
 		conn.peer.notifier.Notify(msg)
@@ -177,6 +264,9 @@ func (conn *Conn) heartBeatService(p goprocess.Process) {
 	for {
 		select {
 		case <-t.C:
+			if conn.draining() {
+				continue
+			}
 			if err := conn.Ping(); err != nil {
 				logger.Errorf("Failed to ping peer. PeerID: %s", conn.remotePeer.Pretty())
 			}
@@ -189,6 +279,9 @@ func (conn *Conn) heartBeatService(p goprocess.Process) {
 
 // Ping the target node
 func (conn *Conn) Ping() error {
+	conn.mutex.Lock()
+	conn.pingSentAt = time.Now()
+	conn.mutex.Unlock()
 	return conn.Write(Ping, []byte(PingBody))
 }
 
@@ -198,9 +291,7 @@ func (conn *Conn) OnPing(data []byte) error {
 		return ErrMessageDataContent
 	}
 
-	conn.peer.bus.Publish(eventbus.TopicConnEvent, conn.remotePeer, eventbus.HeartBeatEvent)
-	conn.Establish() // establish connection
-
+	PublishConnEvent(conn.peer.bus, conn.remotePeer, eventbus.HeartBeatEvent)
 	return conn.Write(Pong, []byte(PongBody))
 }
 
@@ -209,15 +300,30 @@ func (conn *Conn) OnPong(data []byte) error {
 	if PongBody != string(data) {
 		return ErrMessageDataContent
 	}
-	conn.peer.bus.Publish(eventbus.TopicConnEvent, conn.remotePeer, eventbus.HeartBeatEvent)
-	if !conn.Establish() {
-		conn.mutex.Lock()
-		if conn.procHeartbeat == nil {
-			conn.procHeartbeat = conn.proc.Go(conn.heartBeatService)
-		}
+	PublishConnEvent(conn.peer.bus, conn.remotePeer, eventbus.HeartBeatEvent)
+	conn.mutex.Lock()
+	if conn.pingSentAt.IsZero() {
 		conn.mutex.Unlock()
+		return nil
+	}
+	params := conn.peer.scoremgr.params
+	conn.latency = time.Since(conn.pingSentAt)
+	if conn.latency > params.HighLatency {
+		conn.slowPongs++
+	} else {
+		conn.slowPongs = 0
 	}
+	slow := conn.slowPongs >= params.HighLatencyStreak
+	if slow {
+		conn.slowPongs = 0
+	}
+	latency := conn.latency
+	conn.mutex.Unlock()
 
+	if slow && params.PunishHighLatency != 0 {
+		logger.Debugf("Punish peer %s for high latency %v", conn.remotePeer.Pretty(), latency)
+		conn.peer.scoremgr.peerScore(conn.remotePeer).Punish(params.PunishHighLatency, time.Now())
+	}
 	return nil
 }
 
@@ -233,7 +339,7 @@ func (conn *Conn) PeerDiscover() error {
 		select {
 		case <-conn.establishSucceedCh:
 		case <-establishedTimeout.C:
-			conn.peer.bus.Publish(eventbus.TopicConnEvent, conn.peer.id, eventbus.ConnTimeOutEvent)
+			PublishConnEvent(conn.peer.bus, conn.peer.id, eventbus.ConnTimeOutEvent)
 			conn.proc.Close()
 			return errors.New("Handshaking timeout")
 		}
@@ -245,7 +351,7 @@ func (conn *Conn) PeerDiscover() error {
 func (conn *Conn) OnPeerDiscover(body []byte) error {
 	// get random peers from routeTable
 	peers := conn.peer.table.GetRandomPeers(conn.stream.Conn().LocalPeer())
-	msg := &p2ppb.Peers{Peers: make([]*p2ppb.PeerInfo, len(peers)), IsSynced: isSynced}
+	msg := &p2ppb.Peers{Peers: make([]*p2ppb.PeerInfo, len(peers)), IsSynced: IsSynced()}
 
 	for i, v := range peers {
 		peerInfo := &p2ppb.PeerInfo{
@@ -277,21 +383,56 @@ func (conn *Conn) OnPeerDiscoverReply(body []byte) error {
 	return nil
 }
 
+// setRemoteFeatures records features the remote peer sends in the reserved
+// header of ping and pong messages, following the flags byte
+func (conn *Conn) setRemoteFeatures(reserved []byte) {
+	if len(reserved) < 2 {
+		return
+	}
+	conn.mutex.Lock()
+	conn.remoteFeatures = reserved[1]
+	conn.remoteFeaturesKnown = true
+	conn.mutex.Unlock()
+}
+
+// compression returns the codec to compress messages to the remote peer with
+func (conn *Conn) compression() string {
+	conn.mutex.Lock()
+	defer conn.mutex.Unlock()
+	return selectCompression(conn.peer.config.compression(), conn.remoteFeatures, conn.remoteFeaturesKnown)
+}
+
 func (conn *Conn) Write(opcode uint32, body []byte) error {
-	msgAttr := msgToAttribute[opcode]
-	if msgAttr == nil {
-		msgAttr = defaultMessageAttribute
+	return conn.writeWithPriority(opcode, body, attributeOf(opcode).priority)
+}
+
+// writeWithPriority queues message with priority instead of the one of its
+// code
+func (conn *Conn) writeWithPriority(opcode uint32, body []byte, priority uint8) error {
+	if conn.draining() {
+		return ErrConnDraining
+	}
+	msgAttr := attributeOf(opcode)
+	flags := 0
+	if msgAttr.compress {
+		var err error
+		if flags, body, err = encodeBody(conn.compression(), body); err != nil {
+			return err
+		}
 	}
 	reserve := []byte{}
-	if msgAttr != nil && msgAttr.compress {
-		reserve = append(reserve, byte(compressFlag))
-		body = compress(nil, body)
+	switch {
+	case opcode == Ping || opcode == Pong:
+		// features follow the flags byte in handshake messages
+		reserve = append(reserve, byte(flags), localFeatures)
+	case flags != 0:
+		reserve = append(reserve, byte(flags))
 	}
 	data, err := newMessageData(conn.peer.config.Magic, opcode, reserve, body).Marshal()
 	if err != nil {
 		return err
 	}
-	err = conn.pq.Push(data, int(msgAttr.priority))
+	err = conn.pq.Push(&outMessage{code: opcode, data: data, priority: priority}, int(priority))
 	return err
 }
 
@@ -299,6 +440,10 @@ func (conn *Conn) Write(opcode uint32, body []byte) error {
 func (conn *Conn) Close() error {
 	conn.mutex.Lock()
 	defer conn.mutex.Unlock()
+	if conn.closed {
+		return nil
+	}
+	conn.closed = true
 
 	pid := conn.remotePeer
 	logger.Info("Closing connection with ", pid.Pretty())
@@ -306,7 +451,10 @@ func (conn *Conn) Close() error {
 		conn.peer.conns.Delete(pid)
 	}
 	if conn.stream != nil {
-		conn.peer.bus.Publish(eventbus.TopicConnEvent, pid, eventbus.PeerDisconnEvent)
+		// peers leaving gracefully aren't taken as unsteady
+		if !conn.remoteLeft {
+			PublishConnEvent(conn.peer.bus, pid, eventbus.PeerDisconnEvent)
+		}
 		addrs := conn.peer.table.peerStore.Addrs(pid)
 		conn.peer.table.peerStore.SetAddrs(pid, addrs, peerstore.RecentlyConnectedAddrTTL)
 		return conn.stream.Close()
@@ -314,6 +462,55 @@ func (conn *Conn) Close() error {
 	return nil
 }
 
+// ConnInfo is the state of a connection to a remote peer
+type ConnInfo struct {
+	ID      peer.ID
+	Addr    string
+	Inbound bool
+	Synced  bool
+	Score   int64
+	// Latency is the round trip of the last ping, zero if none answered yet
+	Latency     time.Duration
+	BytesSent   uint64
+	BytesRecv   uint64
+	LastMsgTime time.Time
+	// Traffic is by message code
+	Traffic []MsgTraffic
+}
+
+// Info returns the state of the connection
+func (conn *Conn) Info() ConnInfo {
+	info := ConnInfo{
+		ID:        conn.remotePeer,
+		Inbound:   conn.inbound,
+		BytesSent: atomic.LoadUint64(&conn.bytesSent),
+		BytesRecv: atomic.LoadUint64(&conn.bytesRecv),
+		Traffic:   conn.traffic.snapshot(),
+	}
+	if t := atomic.LoadInt64(&conn.lastMsgTime); t != 0 {
+		info.LastMsgTime = time.Unix(0, t)
+	}
+	conn.mutex.Lock()
+	info.Synced = conn.isSynced
+	info.Latency = conn.latency
+	if conn.stream != nil {
+		info.Addr = conn.stream.Conn().RemoteMultiaddr().String()
+	}
+	conn.mutex.Unlock()
+	return info
+}
+
+// RemoteAddr returns the multiaddr of the remote peer, or nil if no stream
+// is opened yet
+func (conn *Conn) RemoteAddr() multiaddr.Multiaddr {
+	conn.mutex.Lock()
+	defer conn.mutex.Unlock()
+	if conn.stream == nil {
+		return nil
+	}
+	return conn.stream.Conn().RemoteMultiaddr()
+}
+
 // Established returns whether the connection is established.
 func (conn *Conn) Established() bool {
 	conn.mutex.Lock()
@@ -339,7 +536,8 @@ func (conn *Conn) establish() {
 	conn.establishSucceedCh <- true
 	pid := conn.remotePeer
 	conn.peer.conns.Store(pid, conn)
-	conn.peer.bus.Publish(eventbus.TopicConnEvent, pid, eventbus.PeerConnEvent)
+	conn.peer.table.addrmgr.good(pid)
+	PublishConnEvent(conn.peer.bus, pid, eventbus.PeerConnEvent)
 	logger.Infof("Succeed to establish connection with peer %s, addrs: %v", conn.remotePeer.Pretty(), conn.peer.table.peerStore.PeerInfo(conn.remotePeer))
 }
 
@@ -0,0 +1,88 @@
+// Copyright (c) 2018 ContentBox Authors.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package p2p
+
+import (
+	"net"
+	"strings"
+	"time"
+
+	"github.com/jbenet/goprocess"
+	multiaddr "github.com/multiformats/go-multiaddr"
+)
+
+const (
+	// dnsSeedInterval is the interval to check if dns seeds are to be resolved
+	dnsSeedInterval = 5 * time.Minute
+	// dnsSeedPeerCount is the peer count below which dns seeds are resolved
+	dnsSeedPeerCount = 8
+	// dnsAddrPrefix prefixes peer multiaddrs in TXT records of dns seeds, e.g.
+	// dnsaddr=/ip4/1.2.3.4/tcp/19199/p2p/<peer id>
+	dnsAddrPrefix = "dnsaddr="
+)
+
+// lookupDNSSeed resolves hostname to peer multiaddrs in its TXT records.
+// Records not holding a multiaddr ending with a peer id are skipped
+func lookupDNSSeed(hostname string, lookupTXT func(string) ([]string, error)) ([]multiaddr.Multiaddr, error) {
+	records, err := lookupTXT(hostname)
+	if err != nil {
+		return nil, err
+	}
+	var addrs []multiaddr.Multiaddr
+	for _, record := range records {
+		if !strings.HasPrefix(record, dnsAddrPrefix) {
+			continue
+		}
+		maddr, err := multiaddr.NewMultiaddr(strings.TrimPrefix(record, dnsAddrPrefix))
+		if err != nil {
+			logger.Debugf("Skip invalid record %s of dns seed %s", record, hostname)
+			continue
+		}
+		if _, _, err := DecapsulatePeerMultiAddr(maddr); err != nil {
+			logger.Debugf("Skip record %s without peer id of dns seed %s", record, hostname)
+			continue
+		}
+		addrs = append(addrs, maddr)
+	}
+	return addrs, nil
+}
+
+// resolveDNSSeeds adds peers resolved from dns seeds to peerstore
+func (p *BoxPeer) resolveDNSSeeds() {
+	for _, hostname := range p.config.DNSSeeds {
+		addrs, err := lookupDNSSeed(hostname, net.LookupTXT)
+		if err != nil {
+			logger.Warnf("Failed to resolve dns seed %s: %v", hostname, err)
+			continue
+		}
+		logger.Infof("Resolved %d peers from dns seed %s", len(addrs), hostname)
+		for _, maddr := range addrs {
+			if _, pid, _ := DecapsulatePeerMultiAddr(maddr); pid == p.id {
+				continue
+			}
+			if err := p.AddToPeerstore(maddr); err != nil {
+				logger.Warnf("Failed to add peer %s from dns seed to peerstore: %v", maddr, err)
+			}
+		}
+	}
+}
+
+// dnsSeedLoop resolves dns seeds again whenever peers are few, until proc
+// closes
+func (p *BoxPeer) dnsSeedLoop(proc goprocess.Process) {
+	ticker := time.NewTicker(dnsSeedInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			if inbound, outbound := p.connCounts(); inbound+outbound < dnsSeedPeerCount {
+				p.resolveDNSSeeds()
+			}
+		case <-proc.Closing():
+			logger.Info("Quit dns seed loop.")
+			return
+		}
+	}
+}
@@ -0,0 +1,41 @@
+// Copyright (c) 2018 ContentBox Authors.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package p2p
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/facebookgo/ensure"
+)
+
+func TestLookupDNSSeed(t *testing.T) {
+	records := map[string][]string{
+		"seed.boxd.io": {
+			"dnsaddr=/ip4/1.2.3.4/tcp/19199/p2p/" + testPeerID,
+			// without peer id
+			"dnsaddr=/ip4/1.2.3.5/tcp/19199",
+			"dnsaddr=not a multiaddr",
+			"v=spf1 -all",
+		},
+	}
+	lookupTXT := func(hostname string) ([]string, error) {
+		if r, ok := records[hostname]; ok {
+			return r, nil
+		}
+		return nil, errors.New("no such host")
+	}
+
+	addrs, err := lookupDNSSeed("seed.boxd.io", lookupTXT)
+	ensure.Nil(t, err)
+	ensure.DeepEqual(t, len(addrs), 1)
+	haddr, pid, err := DecapsulatePeerMultiAddr(addrs[0])
+	ensure.Nil(t, err)
+	ensure.DeepEqual(t, haddr.String(), "/ip4/1.2.3.4/tcp/19199")
+	ensure.DeepEqual(t, pid.Pretty(), testPeerID)
+
+	_, err = lookupDNSSeed("unknown.boxd.io", lookupTXT)
+	ensure.NotNil(t, err)
+}
@@ -50,3 +50,8 @@ func (d *DummyPeer) BroadcastToMiners(code uint32, msg conv.Convertible, miners
 func (d *DummyPeer) PeerSynced(peers peer.ID) (bool, bool) {
 	return false, false
 }
+
+// PeerVersion get the version of a remote peer
+func (d *DummyPeer) PeerVersion(peer.ID) (*PeerVersion, bool) {
+	return nil, false
+}
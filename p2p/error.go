@@ -22,4 +22,34 @@ var (
 	ErrMessageHeader           = errors.New("Invalid p2p message header data")
 	ErrMessageDataBody         = errors.New("Invalid p2p message body")
 	ErrFromProtoMessageMessage = errors.New("Invalid proto message")
+
+	//banlist.go
+	ErrInvalidBanTarget   = errors.New("Invalid ban target, neither a peer id, an ip nor a subnet")
+	ErrInvalidBanDuration = errors.New("Ban duration must be positive")
+	ErrNotBanned          = errors.New("Target is not banned")
+
+	//peerlist.go
+	ErrInvalidPeerListEntry = errors.New("Invalid peer list entry, neither a peer id, an ip nor a subnet")
+
+	//peer.go
+	ErrInvalidPeerAddr  = errors.New("Invalid peer multiaddr")
+	ErrInvalidPeerID    = errors.New("Invalid peer id")
+	ErrPeerBanned       = errors.New("Peer is banned")
+	ErrPeerNotConnected = errors.New("Peer is not connected")
+
+	//version.go
+	ErrIncompatibleVersion = errors.New("Incompatible protocol version")
+
+	//compress.go
+	ErrInvalidCompression = errors.New("Invalid compression, neither snappy, gzip nor none")
+
+	//pex.go
+	ErrTooManyAddrs = errors.New("Too many addrs in a message")
+
+	//pnet.go
+	ErrInvalidNetworkKey = errors.New("Invalid network key, not 32 bytes in hex")
+
+	//shutdown.go
+	ErrConnDraining = errors.New("Connection is draining")
+	ErrPeerLeft     = errors.New("Peer left")
 )
@@ -26,4 +26,5 @@ type Net interface {
 	PickOnePeer(peersExclusive ...peer.ID) peer.ID
 	BroadcastToMiners(uint32, conv.Convertible, []string) error
 	PeerSynced(peers peer.ID) (bool, bool)
+	PeerVersion(peer.ID) (*PeerVersion, bool)
 }
@@ -46,6 +46,19 @@ const (
 	LightSyncRequest = 0x17
 	LightSyncReponse = 0x18
 
+	// Peer exchange
+	GetAddrMsg = 0x19
+	AddrMsg    = 0x1a
+
+	// Handshake
+	VersionMsg = 0x1b
+	VerAckMsg  = 0x1c
+
+	// Disconnect notice
+	DisconnectMsg = 0x1d
+
+	EternalJustificationMsg = 0x1e
+
 	MaxMessageDataLength = 1024 * 1024 * 1024 // 1GB
 )
 
@@ -74,6 +87,20 @@ var msgToAttribute = map[uint32]*messageAttribute{
 	EternalBlockMsg:         &messageAttribute{compress: false, priority: highPriority},
 	LightSyncRequest:        &messageAttribute{compress: false, priority: midPriority},
 	LightSyncReponse:        &messageAttribute{compress: false, priority: midPriority},
+	GetAddrMsg:              &messageAttribute{compress: false, priority: lowPriority},
+	AddrMsg:                 &messageAttribute{compress: true, priority: lowPriority},
+	VersionMsg:              &messageAttribute{compress: false, priority: topPriority},
+	VerAckMsg:               &messageAttribute{compress: false, priority: topPriority},
+	DisconnectMsg:           &messageAttribute{compress: false, priority: topPriority},
+	EternalJustificationMsg: &messageAttribute{compress: false, priority: highPriority},
+}
+
+// attributeOf returns the attribute of messages with code
+func attributeOf(code uint32) *messageAttribute {
+	if msgAttr := msgToAttribute[code]; msgAttr != nil {
+		return msgAttr
+	}
+	return defaultMessageAttribute
 }
 
 // NetworkNamtToMagic is a map from network name to magic number.
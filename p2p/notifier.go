@@ -9,8 +9,7 @@ import (
 	"sync"
 	"time"
 
-	"github.com/BOXFoundation/boxd/crypto"
-	lru "github.com/hashicorp/golang-lru"
+	"github.com/BOXFoundation/boxd/util/bloom"
 	"github.com/jbenet/goprocess"
 )
 
@@ -26,6 +25,12 @@ const (
 	UniquePerPeer
 
 	metricsLoopInterval = 2 * time.Second
+
+	// seen messages are remembered in a rolling filter of dedupGeneration
+	// messages a generation, for dedupPeriod at least
+	dedupGeneration = 65536
+	dedupFPRate     = 0.000001
+	dedupPeriod     = 10 * time.Minute
 )
 
 // Notifier dispatcher & distribute business message.
@@ -33,7 +38,7 @@ type Notifier struct {
 	notifierMap *sync.Map
 	proc        goprocess.Process
 	receiveCh   chan Message
-	cache       *lru.Cache
+	seen        *bloom.RollingFilter
 }
 
 // Notifiee represent message receiver.
@@ -45,12 +50,11 @@ type Notifiee struct {
 
 // NewNotifier new a notifiee
 func NewNotifier() *Notifier {
-	notifier := &Notifier{
+	return &Notifier{
 		notifierMap: new(sync.Map),
 		receiveCh:   make(chan Message, 65536),
+		seen:        bloom.NewRollingFilter(dedupGeneration, dedupFPRate, dedupPeriod),
 	}
-	notifier.cache, _ = lru.New(65536)
-	return notifier
 }
 
 // NewNotifiee return a message notifiee.
@@ -101,24 +105,21 @@ func (notifier *Notifier) Notify(msg Message) {
 	notifier.receiveCh <- msg
 }
 
+// filter checks if msg is to be dispatched, not seen before if unique, or
+// from its sender if unique per peer
 func (notifier *Notifier) filter(msg Message, frequency Frequency) bool {
 	if frequency == Repeatable {
 		return true
 	}
-	key := notifier.lruKey(msg, frequency)
-	if notifier.cache.Contains(key) {
-		return false
-	}
-	notifier.cache.Add(key, msg)
-	return true
+	return !notifier.seen.MatchesAndAdd(dedupKey(msg, frequency))
 }
 
-func (notifier *Notifier) lruKey(msg Message, frequency Frequency) crypto.HashType {
-	key := []byte(msg.Body())
+// dedupKey returns the hash of msg body, with its sender if unique per peer
+func dedupKey(msg Message, frequency Frequency) []byte {
+	h := sha256.New()
+	h.Write(msg.Body())
 	if frequency == UniquePerPeer {
-		key = append(key, msg.From()...)
+		h.Write([]byte(msg.From()))
 	}
-
-	hash := sha256.Sum256(msg.Body())
-	return hash
+	return h.Sum(nil)
 }
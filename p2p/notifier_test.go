@@ -0,0 +1,28 @@
+// Copyright (c) 2018 ContentBox Authors.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package p2p
+
+import (
+	"testing"
+
+	"github.com/facebookgo/ensure"
+)
+
+func TestNotifierFilter(t *testing.T) {
+	notifier := NewNotifier()
+	body := []byte("tx")
+	from1 := &remoteMessage{message: newMessageData(Mainnet, TransactionMsg, nil, body), from: peerID()}
+	from2 := &remoteMessage{message: newMessageData(Mainnet, TransactionMsg, nil, body), from: peerID()}
+
+	ensure.True(t, notifier.filter(from1, Repeatable))
+	ensure.True(t, notifier.filter(from1, Repeatable))
+
+	ensure.True(t, notifier.filter(from1, UniquePerPeer))
+	ensure.False(t, notifier.filter(from1, UniquePerPeer))
+	ensure.True(t, notifier.filter(from2, UniquePerPeer))
+
+	ensure.True(t, notifier.filter(from1, Unique))
+	ensure.False(t, notifier.filter(from2, Unique))
+}
@@ -33,7 +33,7 @@ func (m *MessageHeader) Reset()         { *m = MessageHeader{} }
 func (m *MessageHeader) String() string { return proto.CompactTextString(m) }
 func (*MessageHeader) ProtoMessage()    {}
 func (*MessageHeader) Descriptor() ([]byte, []int) {
-	return fileDescriptor_message_782a7e5380777082, []int{0}
+	return fileDescriptor_message_9d59d6d15c81c26c, []int{0}
 }
 func (m *MessageHeader) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_MessageHeader.Unmarshal(m, b)
@@ -100,7 +100,7 @@ func (m *Peers) Reset()         { *m = Peers{} }
 func (m *Peers) String() string { return proto.CompactTextString(m) }
 func (*Peers) ProtoMessage()    {}
 func (*Peers) Descriptor() ([]byte, []int) {
-	return fileDescriptor_message_782a7e5380777082, []int{1}
+	return fileDescriptor_message_9d59d6d15c81c26c, []int{1}
 }
 func (m *Peers) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Peers.Unmarshal(m, b)
@@ -146,7 +146,7 @@ func (m *PeerInfo) Reset()         { *m = PeerInfo{} }
 func (m *PeerInfo) String() string { return proto.CompactTextString(m) }
 func (*PeerInfo) ProtoMessage()    {}
 func (*PeerInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_message_782a7e5380777082, []int{2}
+	return fileDescriptor_message_9d59d6d15c81c26c, []int{2}
 }
 func (m *PeerInfo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PeerInfo.Unmarshal(m, b)
@@ -180,30 +180,88 @@ func (m *PeerInfo) GetAddrs() []string {
 	return nil
 }
 
+type Version struct {
+	ProtocolVersion      uint32   `protobuf:"varint,1,opt,name=protocol_version,json=protocolVersion,proto3" json:"protocol_version,omitempty"`
+	Services             uint64   `protobuf:"varint,2,opt,name=services,proto3" json:"services,omitempty"`
+	BestHeight           uint32   `protobuf:"varint,3,opt,name=best_height,json=bestHeight,proto3" json:"best_height,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Version) Reset()         { *m = Version{} }
+func (m *Version) String() string { return proto.CompactTextString(m) }
+func (*Version) ProtoMessage()    {}
+func (*Version) Descriptor() ([]byte, []int) {
+	return fileDescriptor_message_9d59d6d15c81c26c, []int{3}
+}
+func (m *Version) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Version.Unmarshal(m, b)
+}
+func (m *Version) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Version.Marshal(b, m, deterministic)
+}
+func (dst *Version) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Version.Merge(dst, src)
+}
+func (m *Version) XXX_Size() int {
+	return xxx_messageInfo_Version.Size(m)
+}
+func (m *Version) XXX_DiscardUnknown() {
+	xxx_messageInfo_Version.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Version proto.InternalMessageInfo
+
+func (m *Version) GetProtocolVersion() uint32 {
+	if m != nil {
+		return m.ProtocolVersion
+	}
+	return 0
+}
+
+func (m *Version) GetServices() uint64 {
+	if m != nil {
+		return m.Services
+	}
+	return 0
+}
+
+func (m *Version) GetBestHeight() uint32 {
+	if m != nil {
+		return m.BestHeight
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*MessageHeader)(nil), "p2ppb.MessageHeader")
 	proto.RegisterType((*Peers)(nil), "p2ppb.Peers")
 	proto.RegisterType((*PeerInfo)(nil), "p2ppb.PeerInfo")
+	proto.RegisterType((*Version)(nil), "p2ppb.Version")
 }
 
-func init() { proto.RegisterFile("message.proto", fileDescriptor_message_782a7e5380777082) }
-
-var fileDescriptor_message_782a7e5380777082 = []byte{
-	// 243 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x3c, 0x90, 0xd1, 0x4a, 0xec, 0x30,
-	0x10, 0x86, 0x49, 0xbb, 0x39, 0x74, 0x67, 0xb7, 0x47, 0x08, 0x5e, 0x04, 0x6f, 0x2c, 0x15, 0xa1,
-	0x57, 0x45, 0xd6, 0x47, 0xf0, 0x46, 0x45, 0x41, 0xe2, 0x03, 0x48, 0x36, 0x33, 0x76, 0x8b, 0xb6,
-	0x0d, 0x49, 0x15, 0x7c, 0x16, 0x5f, 0x56, 0x32, 0xd5, 0xbd, 0x9b, 0xff, 0xfb, 0x7e, 0xc2, 0x4c,
-	0xa0, 0x1c, 0x28, 0x46, 0xdb, 0x51, 0xeb, 0xc3, 0x34, 0x4f, 0x4a, 0xfa, 0x9d, 0xf7, 0xfb, 0xfa,
-	0x5b, 0x40, 0xf9, 0xb8, 0x88, 0x5b, 0xb2, 0x48, 0x41, 0x9d, 0x82, 0x1c, 0x6c, 0xd7, 0x3b, 0x2d,
-	0x2a, 0xd1, 0x94, 0x66, 0x09, 0x4a, 0xc1, 0xca, 0x4d, 0x48, 0x3a, 0x63, 0xc8, 0xb3, 0x3a, 0x87,
-	0x0d, 0xda, 0xd9, 0xbe, 0xbc, 0xd3, 0xd8, 0xcd, 0x07, 0x9d, 0xb3, 0x82, 0x84, 0x1e, 0x98, 0xa8,
-	0x0b, 0x28, 0xb9, 0xe0, 0x0e, 0xe4, 0xde, 0xe2, 0xc7, 0xa0, 0x57, 0x5c, 0xd9, 0x26, 0x78, 0xf3,
-	0xcb, 0xd4, 0x19, 0x14, 0x81, 0x22, 0x85, 0x4f, 0x42, 0x2d, 0x2b, 0xd1, 0x6c, 0xcd, 0x31, 0xd7,
-	0xf7, 0x20, 0x9f, 0x88, 0x42, 0x54, 0x97, 0x20, 0x7d, 0x1a, 0xb4, 0xa8, 0xf2, 0x66, 0xb3, 0x3b,
-	0x69, 0x79, 0xfb, 0x36, 0xc9, 0xbb, 0xf1, 0x75, 0x32, 0x8b, 0x4d, 0x6f, 0xf5, 0xf1, 0xf9, 0x6b,
-	0x74, 0x84, 0xbc, 0x69, 0x61, 0x8e, 0xb9, 0xbe, 0x82, 0xe2, 0xaf, 0xae, 0xfe, 0x43, 0xd6, 0x23,
-	0x1f, 0xb8, 0x36, 0x59, 0x8f, 0xe9, 0x66, 0x8b, 0x18, 0xa2, 0xce, 0xaa, 0xbc, 0x59, 0x9b, 0x25,
-	0xec, 0xff, 0xf1, 0x4f, 0x5d, 0xff, 0x04, 0x00, 0x00, 0xff, 0xff, 0x50, 0x56, 0x27, 0x3e, 0x3a,
-	0x01, 0x00, 0x00,
+func init() { proto.RegisterFile("message.proto", fileDescriptor_message_9d59d6d15c81c26c) }
+
+var fileDescriptor_message_9d59d6d15c81c26c = []byte{
+	// 292 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x3c, 0x90, 0x51, 0x4b, 0xc3, 0x30,
+	0x14, 0x85, 0x69, 0xb7, 0x6a, 0x77, 0xb7, 0x3a, 0x09, 0x3e, 0x84, 0xbd, 0x58, 0x2a, 0x42, 0x7d,
+	0x19, 0x32, 0x7f, 0x82, 0x2f, 0x53, 0x14, 0x24, 0x82, 0xaf, 0x23, 0x4b, 0xae, 0x6d, 0x70, 0x6b,
+	0x6a, 0x52, 0x07, 0xfe, 0x16, 0xff, 0xac, 0xe4, 0x76, 0xdd, 0x5b, 0xce, 0x77, 0x0e, 0xe1, 0xdc,
+	0x03, 0xd9, 0x1e, 0xbd, 0x97, 0x15, 0x2e, 0x5b, 0x67, 0x3b, 0xcb, 0x92, 0x76, 0xd5, 0xb6, 0xdb,
+	0xe2, 0x2f, 0x82, 0xec, 0xb5, 0x37, 0xd6, 0x28, 0x35, 0x3a, 0x76, 0x05, 0xc9, 0x5e, 0x56, 0x46,
+	0xf1, 0x28, 0x8f, 0xca, 0x4c, 0xf4, 0x82, 0x31, 0x18, 0x2b, 0xab, 0x91, 0xc7, 0x04, 0xe9, 0xcd,
+	0xae, 0x61, 0xaa, 0x65, 0x27, 0x37, 0x3b, 0x6c, 0xaa, 0xae, 0xe6, 0x23, 0xb2, 0x20, 0xa0, 0x17,
+	0x22, 0xec, 0x06, 0x32, 0x0a, 0xa8, 0x1a, 0xd5, 0x97, 0xff, 0xd9, 0xf3, 0x31, 0x45, 0x66, 0x01,
+	0x3e, 0x1e, 0x19, 0x5b, 0x40, 0xea, 0xd0, 0xa3, 0x3b, 0xa0, 0xe6, 0x49, 0x1e, 0x95, 0x33, 0x71,
+	0xd2, 0xc5, 0x33, 0x24, 0x6f, 0x88, 0xce, 0xb3, 0x5b, 0x48, 0xda, 0xf0, 0xe0, 0x51, 0x3e, 0x2a,
+	0xa7, 0xab, 0xf9, 0x92, 0xda, 0x2f, 0x83, 0xf9, 0xd4, 0x7c, 0x5a, 0xd1, 0xbb, 0xe1, 0x2f, 0xe3,
+	0xdf, 0x7f, 0x1b, 0x85, 0x9a, 0x9a, 0xa6, 0xe2, 0xa4, 0x8b, 0x7b, 0x48, 0x87, 0x38, 0xbb, 0x80,
+	0xd8, 0x68, 0x3a, 0x70, 0x22, 0x62, 0xa3, 0xc3, 0xcd, 0x52, 0x6b, 0xe7, 0x79, 0x9c, 0x8f, 0xca,
+	0x89, 0xe8, 0x45, 0xf1, 0x0d, 0xe7, 0x1f, 0xe8, 0xbc, 0xb1, 0x0d, 0xbb, 0x83, 0x4b, 0x9a, 0x4d,
+	0xd9, 0xdd, 0xe6, 0xd0, 0xb3, 0xe3, 0x3e, 0xf3, 0x81, 0x0f, 0xd1, 0x05, 0xa4, 0xa1, 0xbd, 0x51,
+	0xe8, 0xa9, 0xc3, 0x58, 0x9c, 0x74, 0x58, 0x6c, 0x8b, 0xbe, 0xdb, 0xd4, 0x68, 0xaa, 0xba, 0x1b,
+	0x16, 0x0b, 0x68, 0x4d, 0x64, 0x7b, 0x46, 0xbf, 0x3d, 0xfc, 0x0f, 0x00, 0x3b, 0x0c, 0xba, 0xc5,
+	0xad, 0x01, 0x00, 0x00,
 }
@@ -5,12 +5,17 @@
 package p2p
 
 import (
+	"context"
 	"crypto/rand"
 	"encoding/base64"
 	"fmt"
 	"io/ioutil"
+	"net"
 	"os"
+	"sort"
 	"sync"
+	"sync/atomic"
+	"time"
 
 	"github.com/BOXFoundation/boxd/boxd/eventbus"
 	"github.com/BOXFoundation/boxd/boxd/service"
@@ -33,7 +38,9 @@ import (
 var (
 	logger = log.NewLogger("p2p")
 
-	isSynced = false
+	// synced is 1 once the local chain has caught up with peers, it's set
+	// by sync manager and read by rpc and peers concurrently
+	synced int32
 )
 
 // BoxPeer represents a connected remote node.
@@ -49,7 +56,15 @@ type BoxPeer struct {
 	connmgr         *ConnManager
 	scoremgr        *ScoreManager
 	addrbook        service.Server
+	banlist         *BanList
+	whitelist       *peerList
+	blacklist       *peerList
+	persistentPeers map[peer.ID]string
+	relays          map[peer.ID]string
+	uploadLimiter   *rateLimiter
+	downloadLimiter *rateLimiter
 	bus             eventbus.Bus
+	stopped         int32 // set atomically once Stop is called
 }
 
 var _ Net = (*BoxPeer)(nil) // BoxPeer implements Net interface
@@ -76,15 +91,46 @@ func NewBoxPeer(parent goprocess.Process, config *Config, s storage.Storage, bus
 	}
 	boxPeer.addrbook = addrbook.(service.Server)
 
+	t, err := s.Table(BanTableName)
+	if err != nil {
+		return nil, err
+	}
+	if boxPeer.banlist, err = NewBanList(t); err != nil {
+		return nil, err
+	}
+	if boxPeer.whitelist, err = newPeerList(config.Whitelist); err != nil {
+		return nil, err
+	}
+	if boxPeer.blacklist, err = newPeerList(config.Blacklist); err != nil {
+		return nil, err
+	}
+	if boxPeer.persistentPeers, err = parsePersistentPeers(config.PersistentPeers); err != nil {
+		return nil, err
+	}
+	if boxPeer.relays, err = parsePersistentPeers(config.Relays); err != nil {
+		return nil, err
+	}
+	relayOpts, err := relayOptions(config.RelayHop, config.Relays)
+	if err != nil {
+		return nil, err
+	}
+	boxPeer.uploadLimiter = newRateLimiter(config.UploadRate)
+	boxPeer.downloadLimiter = newRateLimiter(config.DownloadRate)
+	switch config.compression() {
+	case CompressionSnappy, CompressionGzip, CompressionNone:
+	default:
+		return nil, ErrInvalidCompression
+	}
+
 	ps, err := pstore.NewDefaultPeerstoreWithAddrBook(proc, s, addrbook)
 	if err != nil {
 		return nil, err
 	}
 	boxPeer.connmgr = NewConnManager(ps)
-	boxPeer.scoremgr = NewScoreManager(proc, bus, boxPeer)
+	boxPeer.scoremgr = NewScoreManager(proc, bus, boxPeer, ps)
 
 	// seed peer never sync
-	isSynced = len(config.Seeds) == 0
+	UpdateSynced(len(config.Seeds) == 0 && len(config.DNSSeeds) == 0)
 
 	opts := []libp2p.Option{
 		// TODO: to support ipv6
@@ -97,8 +143,17 @@ func NewBoxPeer(parent goprocess.Process, config *Config, s storage.Storage, bus
 		libp2p.ConnectionManager(boxPeer.connmgr),
 		libp2p.NATPortMap(),
 	}
+	opts = append(opts, relayOpts...)
+	if config.NetworkKey != "" {
+		protector, err := newProtector(config.NetworkKey)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, libp2p.PrivateNetwork(protector))
+	}
 	boxPeer.host, err = libp2p.New(ctx, opts...)
 	boxPeer.host.SetStreamHandler(ProtocolID, boxPeer.handleStream)
+	boxPeer.host.Network().Notify(&libp2pnet.NotifyBundle{ConnectedF: boxPeer.dropBannedConn})
 	boxPeer.table = NewTable(boxPeer)
 
 	fulladdr, _ := PeerMultiAddr(boxPeer.host)
@@ -148,10 +203,109 @@ func saveNetworkIdentity(path string, key crypto.PrivKey) error {
 }
 
 func (p *BoxPeer) handleStream(s libp2pnet.Stream) {
+	if p.stopping() {
+		s.Reset()
+		return
+	}
+	if p.isRejected(s.Conn().RemotePeer(), s.Conn().RemoteMultiaddr()) {
+		s.Reset()
+		return
+	}
+	if !p.admitInbound(s.Conn().RemotePeer(), s.Conn().RemoteMultiaddr()) {
+		s.Reset()
+		return
+	}
 	conn := NewConn(s, p, s.Conn().RemotePeer())
 	conn.Loop(p.proc)
 }
 
+// connCounts returns the numbers of inbound and outbound connections
+func (p *BoxPeer) connCounts() (inbound, outbound int) {
+	p.conns.Range(func(k, v interface{}) bool {
+		if v.(*Conn).inbound {
+			inbound++
+		} else {
+			outbound++
+		}
+		return true
+	})
+	return
+}
+
+// outboundFull checks if no more outbound connections are to be made
+func (p *BoxPeer) outboundFull() bool {
+	_, outbound := p.connCounts()
+	return outbound >= p.config.maxOutbound()
+}
+
+// groupFull checks if no more connections are to be made with peers in the
+// ip group of ip, to avoid being surrounded by peers of an attacker
+func (p *BoxPeer) groupFull(ip net.IP) bool {
+	group := ipGroup(ip)
+	if group == "" {
+		return false
+	}
+	count := 0
+	p.conns.Range(func(k, v interface{}) bool {
+		if ipGroup(addrIP(v.(*Conn).RemoteAddr())) == group {
+			count++
+		}
+		return true
+	})
+	return count >= p.config.maxPeersPerGroup()
+}
+
+// admitInbound checks if a connection from pid at addr is accepted. When
+// inbound connections are full, the lowest scoring inbound peer which isn't
+// protected is evicted to make room, so that the node keeps connected to
+// new peers under churn. It's refused only if no peer can be evicted, unless
+// protected itself
+func (p *BoxPeer) admitInbound(pid peer.ID, addr multiaddr.Multiaddr) bool {
+	if _, ok := p.conns.Load(pid); ok {
+		return true
+	}
+	if p.groupFull(addrIP(addr)) && !p.isProtected(pid, addr) {
+		logger.Debugf("Refuse inbound peer %s as its ip group is full", pid.Pretty())
+		return false
+	}
+	if inbound, _ := p.connCounts(); inbound < p.config.maxInbound() {
+		return true
+	}
+	var inbounds []*Conn
+	p.conns.Range(func(k, v interface{}) bool {
+		if conn := v.(*Conn); conn.inbound {
+			inbounds = append(inbounds, conn)
+		}
+		return true
+	})
+	evictee := selectEvictee(inbounds, p.scoremgr.Score, func(conn *Conn) bool {
+		return p.isProtected(conn.remotePeer, conn.RemoteAddr())
+	})
+	if evictee == nil {
+		return p.isProtected(pid, addr)
+	}
+	logger.Infof("Evict peer %s for new inbound peer %s as inbound connections are full",
+		evictee.remotePeer.Pretty(), pid.Pretty())
+	evictee.Close()
+	return true
+}
+
+// selectEvictee returns the lowest scoring conn among conns which isn't
+// protected, or nil if all are
+func selectEvictee(conns []*Conn, score func(peer.ID) int64, protected func(*Conn) bool) *Conn {
+	var evictee *Conn
+	var lowest int64
+	for _, conn := range conns {
+		if protected(conn) {
+			continue
+		}
+		if s := score(conn.remotePeer); evictee == nil || s < lowest {
+			evictee, lowest = conn, s
+		}
+	}
+	return evictee
+}
+
 // implement interface service.Server
 var _ service.Server = (*BoxPeer)(nil)
 
@@ -161,11 +315,59 @@ func (p *BoxPeer) Run() error {
 	p.connmgr.Loop(p.proc)
 	p.addrbook.Run()
 
-	if len(p.config.Seeds) > 0 {
+	if len(p.config.DNSSeeds) > 0 {
+		// peers from dns seeds are added before the first discovery
+		p.resolveDNSSeeds()
+		p.proc.Go(p.dnsSeedLoop)
+	}
+	if len(p.config.Seeds) > 0 || len(p.config.DNSSeeds) > 0 {
 		p.connectSeeds()
 		p.table.Loop(p.proc)
 	}
 	p.notifier.Loop(p.proc)
+	p.proc.Go(p.pexLoop)
+	for pid, addr := range p.persistentPeers {
+		pid, addr := pid, addr
+		p.proc.Go(func(proc goprocess.Process) { p.keepPersistentPeer(proc, pid, addr) })
+	}
+	// relays are kept connected to be reachable through
+	for pid, addr := range p.relays {
+		if p.isPersistent(pid) {
+			continue
+		}
+		pid, addr := pid, addr
+		p.proc.Go(func(proc goprocess.Process) { p.keepPersistentPeer(proc, pid, addr) })
+	}
+
+	p.bus.Reply(eventbus.TopicGetConnectedPeerCount, func(out chan<- int) {
+		count := 0
+		p.conns.Range(func(k, v interface{}) bool {
+			count++
+			return true
+		})
+		out <- count
+	}, false)
+	p.bus.Reply(eventbus.TopicGetNetworkInfo, func(out chan<- NetworkInfo) {
+		out <- p.NetworkInfo()
+	}, false)
+	p.bus.Reply(eventbus.TopicGetPeerInfo, func(out chan<- []ConnInfo) {
+		out <- p.ConnInfos()
+	}, false)
+	p.bus.Reply(eventbus.TopicConnectPeer, func(ctx context.Context, addr string, out chan<- error) {
+		out <- p.ConnectPeer(ctx, addr)
+	}, false)
+	p.bus.Reply(eventbus.TopicDisconnectPeer, func(id string, out chan<- error) {
+		out <- p.DisconnectPeer(id)
+	}, false)
+	p.bus.Reply(eventbus.TopicBanPeer, func(target string, d time.Duration, out chan<- error) {
+		out <- p.BanPeer(target, d)
+	}, false)
+	p.bus.Reply(eventbus.TopicUnbanPeer, func(target string, out chan<- error) {
+		out <- p.UnbanPeer(target)
+	}, false)
+	p.bus.Reply(eventbus.TopicListBans, func(out chan<- []Ban) {
+		out <- p.banlist.Bans()
+	}, false)
 
 	return nil
 }
@@ -175,11 +377,23 @@ func (p *BoxPeer) Proc() goprocess.Process {
 	return p.proc
 }
 
-// Stop box peer service
+// Stop box peer service, flushing queued messages to peers and saying
+// goodbye before closing connections
 func (p *BoxPeer) Stop() {
+	p.StopIntake()
 	p.proc.Close()
 }
 
+// StopIntake stops accepting connections and messages from peers, flushing
+// queued messages to peers and saying goodbye before closing connections.
+// The service keeps running till stopped, so that services depending on it
+// are closed first
+func (p *BoxPeer) StopIntake() {
+	if atomic.CompareAndSwapInt32(&p.stopped, 0, 1) {
+		p.drain()
+	}
+}
+
 func (p *BoxPeer) connectSeeds() {
 	for _, v := range p.config.Seeds {
 		if err := p.AddAddrToPeerstore(v); err != nil {
@@ -209,9 +423,157 @@ func (p *BoxPeer) AddToPeerstore(maddr multiaddr.Multiaddr) error {
 	// Peer that is connected or observed from other peers should have different TTL.
 	p.host.Peerstore().AddAddr(pid, haddr, peerstore.PermanentAddrTTL)
 	p.table.routeTable.Update(pid)
+	p.table.addrmgr.add(pid, haddr, nil)
+	return nil
+}
+
+// NetworkInfo is the state of the node in p2p network
+type NetworkInfo struct {
+	ID          peer.ID
+	NetworkID   uint32
+	ListenAddrs []string
+	PeerCount   int
+	Synced      bool
+}
+
+// NetworkInfo returns the state of the node in p2p network
+func (p *BoxPeer) NetworkInfo() NetworkInfo {
+	info := NetworkInfo{ID: p.id, NetworkID: p.config.Magic, Synced: IsSynced()}
+	for _, addr := range p.host.Addrs() {
+		info.ListenAddrs = append(info.ListenAddrs, addr.String())
+	}
+	p.conns.Range(func(k, v interface{}) bool {
+		info.PeerCount++
+		return true
+	})
+	return info
+}
+
+// ConnInfos returns states of established connections ordered by peer id
+func (p *BoxPeer) ConnInfos() []ConnInfo {
+	var infos []ConnInfo
+	p.conns.Range(func(k, v interface{}) bool {
+		info := v.(*Conn).Info()
+		info.Score = p.scoremgr.Score(info.ID)
+		infos = append(infos, info)
+		return true
+	})
+	sort.Slice(infos, func(i, j int) bool { return infos[i].ID < infos[j].ID })
+	return infos
+}
+
+// ConnectPeer connects to the peer at multiaddr addr, which ends with the
+// peer id, e.g. /ip4/127.0.0.1/tcp/19199/p2p/<peer id>
+func (p *BoxPeer) ConnectPeer(ctx context.Context, addr string) error {
+	maddr, err := multiaddr.NewMultiaddr(addr)
+	if err != nil {
+		return ErrInvalidPeerAddr
+	}
+	haddr, pid, err := DecapsulatePeerMultiAddr(maddr)
+	if err != nil || pid == p.id {
+		return ErrInvalidPeerAddr
+	}
+	if p.isRejected(pid, haddr) {
+		return ErrPeerBanned
+	}
+	if err := p.AddToPeerstore(maddr); err != nil {
+		return err
+	}
+	if err := p.host.Connect(ctx, peerstore.PeerInfo{ID: pid, Addrs: []multiaddr.Multiaddr{haddr}}); err != nil {
+		return err
+	}
+	if _, ok := p.conns.Load(pid); !ok {
+		conn := NewConn(nil, p, pid)
+		conn.Loop(p.proc)
+	}
 	return nil
 }
 
+// DisconnectPeer closes connections with peer of id
+func (p *BoxPeer) DisconnectPeer(id string) error {
+	pid, err := peer.IDB58Decode(id)
+	if err != nil {
+		return ErrInvalidPeerID
+	}
+	c, ok := p.conns.Load(pid)
+	if !ok && len(p.host.Network().ConnsToPeer(pid)) == 0 {
+		return ErrPeerNotConnected
+	}
+	if ok {
+		c.(*Conn).Close()
+	}
+	return p.host.Network().ClosePeer(pid)
+}
+
+// BanPeer bans target, a peer id, ip or subnet, for d, and closes connections
+// with peers it covers
+func (p *BoxPeer) BanPeer(target string, d time.Duration) error {
+	ban, err := p.banlist.ban(target, d)
+	if err != nil {
+		return err
+	}
+	PublishPeerBanned(p.bus, ban)
+	for _, c := range p.host.Network().Conns() {
+		p.dropBannedConn(p.host.Network(), c)
+	}
+	return nil
+}
+
+// UnbanPeer lifts the ban of target, a peer id, ip or subnet
+func (p *BoxPeer) UnbanPeer(target string) error {
+	target, err := p.banlist.unban(target)
+	if err != nil {
+		return err
+	}
+	PublishPeerUnbanned(p.bus, target)
+	return nil
+}
+
+// banMisbehavingPeer bans the peer of conn and its ip for the configured
+// duration, as its score drops below the threshold. Connections with it are
+// closed by BanPeer
+func (p *BoxPeer) banMisbehavingPeer(conn *Conn, score int64) {
+	targets := []string{conn.remotePeer.Pretty()}
+	if ip := addrIP(conn.RemoteAddr()); ip != nil {
+		targets = append(targets, ip.String())
+	}
+	d := p.config.banDuration()
+	logger.Infof("Ban peer %v for %v because of low score %v", targets, d, score)
+	for _, target := range targets {
+		if err := p.BanPeer(target, d); err != nil {
+			logger.Errorf("Failed to ban %s: %v", target, err)
+		}
+	}
+}
+
+// isWhitelisted checks if pid or the ip of addr is configured always allowed
+func (p *BoxPeer) isWhitelisted(pid peer.ID, addr multiaddr.Multiaddr) bool {
+	return p.whitelist.contains(pid, addr)
+}
+
+// isRejected checks if connections with pid at addr are refused, as it's
+// blacklisted or banned and not protected. addr may be nil if unknown
+func (p *BoxPeer) isRejected(pid peer.ID, addr multiaddr.Multiaddr) bool {
+	if p.isProtected(pid, addr) {
+		return false
+	}
+	return p.blacklist.contains(pid, addr) || p.banlist.IsBannedPeer(pid, addr)
+}
+
+// dropBannedConn closes c if its remote peer is blacklisted or banned
+func (p *BoxPeer) dropBannedConn(_ libp2pnet.Network, c libp2pnet.Conn) {
+	pid := c.RemotePeer()
+	if !p.isRejected(pid, c.RemoteMultiaddr()) {
+		return
+	}
+	logger.Infof("Drop connection with banned peer %s at %s", pid.Pretty(), c.RemoteMultiaddr())
+	if conn, ok := p.conns.Load(pid); ok {
+		conn.(*Conn).Close()
+	}
+	// notifiees are called with the conn still being set up
+	go c.Close()
+}
+
 ////////// implements Net interface //////////
 
 // Broadcast business message.
@@ -221,6 +583,10 @@ func (p *BoxPeer) Broadcast(code uint32, msg conv.Convertible) error {
 	if err != nil {
 		return err
 	}
+	if code == NewBlockMsg {
+		p.relay(code, body)
+		return nil
+	}
 
 	p.conns.Range(func(k, v interface{}) bool {
 		conn := v.(*Conn)
@@ -294,26 +660,49 @@ func (p *BoxPeer) Conns() *sync.Map {
 	return p.conns
 }
 
-// PickOnePeer picks a peer not in peersExclusive and return its id
+// PickOnePeer picks the peer with the lowest latency not in peersExclusive
+// and return its id
 func (p *BoxPeer) PickOnePeer(peersExclusive ...peer.ID) peer.ID {
 	var pid peer.ID
+	var best time.Duration
 	p.conns.Range(func(k, v interface{}) bool {
-		if !util.InArray(k, peersExclusive) {
-			pid = k.(peer.ID)
-			return false
+		if util.InArray(k, peersExclusive) {
+			return true
+		}
+		latency := v.(*Conn).Info().Latency
+		if pid == "" || fasterLatency(latency, best) {
+			pid, best = k.(peer.ID), latency
 		}
 		return true
 	})
 	return pid
 }
 
+// fasterLatency checks if latency a is lower than b. Unknown latencies, zero,
+// are the highest
+func fasterLatency(a, b time.Duration) bool {
+	if a == 0 {
+		return false
+	}
+	return b == 0 || a < b
+}
+
 // PeerSynced get sync states of remote peers
 func (p *BoxPeer) PeerSynced(peerID peer.ID) (bool, bool) {
 	val, ok := p.conns.Load(peerID)
 	return val.(*Conn).isSynced, ok
 }
 
+// IsSynced returns whether the local chain has caught up with peers
+func IsSynced() bool {
+	return atomic.LoadInt32(&synced) == 1
+}
+
 // UpdateSynced update peers' isSynced
-func UpdateSynced(synced bool) {
-	isSynced = synced
+func UpdateSynced(isSynced bool) {
+	var v int32
+	if isSynced {
+		v = 1
+	}
+	atomic.StoreInt32(&synced, v)
 }
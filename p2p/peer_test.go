@@ -0,0 +1,93 @@
+// Copyright (c) 2018 ContentBox Authors.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package p2p
+
+import (
+	"bytes"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/BOXFoundation/boxd/boxd/eventbus"
+	"github.com/facebookgo/ensure"
+	peer "github.com/libp2p/go-libp2p-peer"
+)
+
+func TestSelectEvictee(t *testing.T) {
+	var conns []*Conn
+	scores := make(map[peer.ID]int64)
+	for i, id := range []string{
+		testPeerID,
+		"QmPbXnwgNDMYTPhrzyGzcQBkWGBkAhxmXB3w4EQgFVTTxr",
+		"QmYyQSo1c1Ym7orWxLYvCrM2EmxFTANf8wXmmE7DWjhx5N",
+	} {
+		pid, _ := peer.IDB58Decode(id)
+		conns = append(conns, &Conn{remotePeer: pid, inbound: true})
+		scores[pid] = int64(100 - 50*i)
+	}
+	score := func(pid peer.ID) int64 { return scores[pid] }
+	none := func(*Conn) bool { return false }
+
+	ensure.DeepEqual(t, selectEvictee(conns, score, none), conns[2])
+	// protected conns are never evicted
+	ensure.DeepEqual(t, selectEvictee(conns, score, func(c *Conn) bool { return c == conns[2] }), conns[1])
+	ensure.True(t, selectEvictee(conns, score, func(*Conn) bool { return true }) == nil)
+	ensure.True(t, selectEvictee(nil, score, none) == nil)
+}
+
+func TestFasterLatency(t *testing.T) {
+	ensure.True(t, fasterLatency(time.Millisecond, time.Second))
+	ensure.False(t, fasterLatency(time.Second, time.Millisecond))
+	// unknown latencies are the highest
+	ensure.True(t, fasterLatency(time.Second, 0))
+	ensure.False(t, fasterLatency(0, time.Second))
+	ensure.False(t, fasterLatency(0, 0))
+}
+
+func TestConnInfo(t *testing.T) {
+	pid, _ := peer.IDB58Decode(testPeerID)
+	p := &BoxPeer{config: &Config{Magic: Mainnet}, bus: eventbus.New(), conns: new(sync.Map), scoremgr: &ScoreManager{scores: new(sync.Map)}}
+	conn := &Conn{peer: p, remotePeer: pid, traffic: newTrafficStats()}
+	info := conn.Info()
+	ensure.DeepEqual(t, info.ID, pid)
+	ensure.False(t, info.Inbound)
+	ensure.True(t, info.LastMsgTime.IsZero())
+	ensure.DeepEqual(t, info.Latency, time.Duration(0))
+
+	// messages read are counted, and the time of the last one kept
+	msg := newMessageData(Mainnet, Pong, nil, []byte(PongBody))
+	data, err := msg.Marshal()
+	ensure.Nil(t, err)
+	for i := 0; i < 2; i++ {
+		_, err := conn.readMessage(bytes.NewReader(data))
+		ensure.Nil(t, err)
+	}
+	// latency is measured from the last ping to its pong
+	conn.pingSentAt = time.Now().Add(-10 * time.Millisecond)
+	ensure.Nil(t, conn.OnPong([]byte(PongBody)))
+	info = conn.Info()
+	ensure.DeepEqual(t, info.BytesRecv, uint64(2*msg.Len()))
+	ensure.False(t, info.LastMsgTime.IsZero())
+	ensure.True(t, info.Latency >= 10*time.Millisecond)
+	ensure.DeepEqual(t, info.Traffic, []MsgTraffic{{Code: Pong, MsgsRecv: 2, BytesRecv: uint64(2 * msg.Len())}})
+
+	// messages of other networks are neither read nor counted
+	other, _ := newMessageData(Testnet, Pong, nil, []byte(PongBody)).Marshal()
+	_, err = conn.readMessage(bytes.NewReader(other))
+	ensure.DeepEqual(t, err, ErrMagic)
+	ensure.DeepEqual(t, conn.Info().BytesRecv, info.BytesRecv)
+
+	// conns are listed by peer id, with their scores
+	otherPid, _ := peer.IDB58Decode("QmPbXnwgNDMYTPhrzyGzcQBkWGBkAhxmXB3w4EQgFVTTxr")
+	p.conns.Store(pid, conn)
+	p.conns.Store(otherPid, &Conn{peer: p, remotePeer: otherPid, inbound: true, traffic: newTrafficStats()})
+	infos := p.ConnInfos()
+	ensure.DeepEqual(t, len(infos), 2)
+	ensure.True(t, infos[0].ID < infos[1].ID)
+	for _, info := range infos {
+		ensure.DeepEqual(t, info.Inbound, info.ID == otherPid)
+		ensure.DeepEqual(t, info.Score, int64(0))
+	}
+}
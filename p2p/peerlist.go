@@ -0,0 +1,61 @@
+// Copyright (c) 2018 ContentBox Authors.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package p2p
+
+import (
+	"net"
+
+	peer "github.com/libp2p/go-libp2p-peer"
+	ma "github.com/multiformats/go-multiaddr"
+)
+
+// peerList is a configured list of peer ids and ip ranges
+type peerList struct {
+	ids     map[peer.ID]struct{}
+	subnets []*net.IPNet
+}
+
+// newPeerList parses entries, each a peer id, an ip or a subnet in CIDR
+// notation
+func newPeerList(entries []string) (*peerList, error) {
+	l := &peerList{ids: make(map[peer.ID]struct{})}
+	for _, entry := range entries {
+		if pid, err := peer.IDB58Decode(entry); err == nil {
+			l.ids[pid] = struct{}{}
+			continue
+		}
+		if ip := net.ParseIP(entry); ip != nil {
+			bits := 8 * net.IPv6len
+			if ip4 := ip.To4(); ip4 != nil {
+				ip, bits = ip4, 8*net.IPv4len
+			}
+			l.subnets = append(l.subnets, &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)})
+			continue
+		}
+		_, subnet, err := net.ParseCIDR(entry)
+		if err != nil {
+			return nil, ErrInvalidPeerListEntry
+		}
+		l.subnets = append(l.subnets, subnet)
+	}
+	return l, nil
+}
+
+// contains checks if pid, or the ip of addr if not nil, is in the list
+func (l *peerList) contains(pid peer.ID, addr ma.Multiaddr) bool {
+	if _, ok := l.ids[pid]; ok {
+		return true
+	}
+	ip := addrIP(addr)
+	if ip == nil {
+		return false
+	}
+	for _, subnet := range l.subnets {
+		if subnet.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,55 @@
+// Copyright (c) 2018 ContentBox Authors.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package p2p
+
+import (
+	"testing"
+
+	"github.com/BOXFoundation/boxd/storage/memdb"
+	"github.com/facebookgo/ensure"
+	peer "github.com/libp2p/go-libp2p-peer"
+	ma "github.com/multiformats/go-multiaddr"
+)
+
+func TestPeerList(t *testing.T) {
+	_, err := newPeerList([]string{"not an entry"})
+	ensure.DeepEqual(t, err, ErrInvalidPeerListEntry)
+
+	l, err := newPeerList([]string{testPeerID, "10.0.0.1", "192.168.0.0/16"})
+	ensure.Nil(t, err)
+
+	pid, _ := peer.IDB58Decode(testPeerID)
+	other, _ := peer.IDB58Decode("QmPbXnwgNDMYTPhrzyGzcQBkWGBkAhxmXB3w4EQgFVTTxr")
+	ensure.True(t, l.contains(pid, nil))
+	ensure.False(t, l.contains(other, nil))
+
+	for addr, expected := range map[string]bool{
+		"/ip4/10.0.0.1/tcp/19199":    true,
+		"/ip4/10.0.0.2/tcp/19199":    false,
+		"/ip4/192.168.3.4/tcp/19199": true,
+		"/ip4/192.169.3.4/tcp/19199": false,
+	} {
+		maddr, _ := ma.NewMultiaddr(addr)
+		ensure.DeepEqual(t, l.contains(other, maddr), expected, addr)
+	}
+}
+
+func TestWhitelistOverridesBlacklist(t *testing.T) {
+	db, _ := memdb.NewMemoryDB("", nil)
+	banlist, err := NewBanList(db)
+	ensure.Nil(t, err)
+	whitelist, err := newPeerList([]string{testPeerID})
+	ensure.Nil(t, err)
+	blacklist, err := newPeerList([]string{"10.0.0.0/8"})
+	ensure.Nil(t, err)
+	p := &BoxPeer{whitelist: whitelist, blacklist: blacklist, banlist: banlist}
+
+	pid, _ := peer.IDB58Decode(testPeerID)
+	other, _ := peer.IDB58Decode("QmPbXnwgNDMYTPhrzyGzcQBkWGBkAhxmXB3w4EQgFVTTxr")
+	addr, _ := ma.NewMultiaddr("/ip4/10.1.2.3/tcp/19199")
+	// the whitelisted peer is accepted from within the blacklisted subnet
+	ensure.False(t, p.isRejected(pid, addr))
+	ensure.True(t, p.isRejected(other, addr))
+}
@@ -0,0 +1,92 @@
+// Copyright (c) 2018 ContentBox Authors.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package p2p
+
+import (
+	"context"
+	"time"
+
+	"github.com/jbenet/goprocess"
+	goprocessctx "github.com/jbenet/goprocess/context"
+	peer "github.com/libp2p/go-libp2p-peer"
+	multiaddr "github.com/multiformats/go-multiaddr"
+)
+
+const (
+	// persistentPeerCheckInterval is the interval to check if persistent peers
+	// are still connected
+	persistentPeerCheckInterval = 10 * time.Second
+	// minReconnectBackoff and maxReconnectBackoff bound the wait before
+	// reconnecting to a persistent peer, doubled on each failure
+	minReconnectBackoff = time.Second
+	maxReconnectBackoff = 5 * time.Minute
+)
+
+// parsePersistentPeers returns peer ids of addrs, multiaddrs ending with
+// peer ids
+func parsePersistentPeers(addrs []string) (map[peer.ID]string, error) {
+	peers := make(map[peer.ID]string)
+	for _, addr := range addrs {
+		maddr, err := multiaddr.NewMultiaddr(addr)
+		if err != nil {
+			return nil, ErrInvalidPeerAddr
+		}
+		_, pid, err := DecapsulatePeerMultiAddr(maddr)
+		if err != nil {
+			return nil, ErrInvalidPeerAddr
+		}
+		peers[pid] = addr
+	}
+	return peers, nil
+}
+
+// isPersistent checks if pid is configured to be always connected
+func (p *BoxPeer) isPersistent(pid peer.ID) bool {
+	_, ok := p.persistentPeers[pid]
+	return ok
+}
+
+// isProtected checks if pid at addr is exempt from bans, evictions and score
+// based disconnection, as it's whitelisted, persistent or a relay
+func (p *BoxPeer) isProtected(pid peer.ID, addr multiaddr.Multiaddr) bool {
+	return p.isPersistent(pid) || p.isRelay(pid) || p.isWhitelisted(pid, addr)
+}
+
+// keepPersistentPeer keeps connected to persistent peer pid at addr until
+// proc closes, reconnecting with exponential backoff
+func (p *BoxPeer) keepPersistentPeer(proc goprocess.Process, pid peer.ID, addr string) {
+	backoff := time.Duration(0)
+	for {
+		wait := persistentPeerCheckInterval
+		if _, ok := p.conns.Load(pid); !ok {
+			ctx, cancel := context.WithTimeout(goprocessctx.OnClosingContext(proc), persistentPeerCheckInterval)
+			err := p.ConnectPeer(ctx, addr)
+			cancel()
+			if err != nil {
+				backoff = nextReconnectBackoff(backoff)
+				wait = backoff
+				logger.Warnf("Failed to connect to persistent peer %s, retry in %v: %v", pid.Pretty(), wait, err)
+			} else {
+				backoff = 0
+			}
+		}
+		select {
+		case <-time.After(wait):
+		case <-proc.Closing():
+			return
+		}
+	}
+}
+
+// nextReconnectBackoff returns the backoff after one which failed
+func nextReconnectBackoff(backoff time.Duration) time.Duration {
+	if backoff < minReconnectBackoff {
+		return minReconnectBackoff
+	}
+	if backoff *= 2; backoff > maxReconnectBackoff {
+		return maxReconnectBackoff
+	}
+	return backoff
+}
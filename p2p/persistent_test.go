@@ -0,0 +1,36 @@
+// Copyright (c) 2018 ContentBox Authors.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package p2p
+
+import (
+	"testing"
+	"time"
+
+	"github.com/facebookgo/ensure"
+)
+
+func TestParsePersistentPeers(t *testing.T) {
+	addr := "/ip4/1.2.3.4/tcp/19199/p2p/" + testPeerID
+	peers, err := parsePersistentPeers([]string{addr})
+	ensure.Nil(t, err)
+	ensure.DeepEqual(t, len(peers), 1)
+	for pid, a := range peers {
+		ensure.DeepEqual(t, pid.Pretty(), testPeerID)
+		ensure.DeepEqual(t, a, addr)
+	}
+
+	_, err = parsePersistentPeers([]string{"/ip4/1.2.3.4/tcp/19199"})
+	ensure.DeepEqual(t, err, ErrInvalidPeerAddr)
+	_, err = parsePersistentPeers([]string{"not a multiaddr"})
+	ensure.DeepEqual(t, err, ErrInvalidPeerAddr)
+}
+
+func TestNextReconnectBackoff(t *testing.T) {
+	backoff := nextReconnectBackoff(0)
+	ensure.DeepEqual(t, backoff, minReconnectBackoff)
+	ensure.DeepEqual(t, nextReconnectBackoff(backoff), 2*time.Second)
+	ensure.DeepEqual(t, nextReconnectBackoff(4*time.Minute), maxReconnectBackoff)
+	ensure.DeepEqual(t, nextReconnectBackoff(maxReconnectBackoff), maxReconnectBackoff)
+}
@@ -0,0 +1,218 @@
+// Copyright (c) 2018 ContentBox Authors.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package p2p
+
+import (
+	"math/rand"
+	"net"
+	"time"
+
+	"github.com/BOXFoundation/boxd/p2p/pb"
+	proto "github.com/gogo/protobuf/proto"
+	"github.com/jbenet/goprocess"
+	peer "github.com/libp2p/go-libp2p-peer"
+	peerstore "github.com/libp2p/go-libp2p-peerstore"
+	ma "github.com/multiformats/go-multiaddr"
+)
+
+const (
+	// pexInterval is the interval to request addresses from a random peer
+	pexInterval = 2 * time.Minute
+	// pexMinRequestInterval is the least interval a peer may request addresses
+	// in. More frequent requests are ignored
+	pexMinRequestInterval = time.Minute
+	// maxPexAddrs is the max number of peers in an addr message
+	maxPexAddrs = 32
+)
+
+// privateSubnets are ip ranges not reachable from the public internet
+var privateSubnets []*net.IPNet
+
+func init() {
+	for _, cidr := range []string{
+		"10.0.0.0/8",
+		"172.16.0.0/12",
+		"192.168.0.0/16",
+		"100.64.0.0/10",
+		"fc00::/7",
+	} {
+		_, subnet, _ := net.ParseCIDR(cidr)
+		privateSubnets = append(privateSubnets, subnet)
+	}
+}
+
+func isPrivateIP(ip net.IP) bool {
+	for _, subnet := range privateSubnets {
+		if subnet.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// shareableAddr checks if addr is worth exchanging with a peer at ip remote.
+// Loopback and private addresses are only reachable from peers on the same
+// host or network, and remote may be nil if unknown
+func shareableAddr(addr ma.Multiaddr, remote net.IP) bool {
+	ip := addrIP(addr)
+	if ip == nil || ip.IsUnspecified() || ip.IsMulticast() || ip.IsLinkLocalUnicast() {
+		return false
+	}
+	if ip.IsLoopback() {
+		return remote != nil && remote.IsLoopback()
+	}
+	if isPrivateIP(ip) {
+		return remote != nil && (remote.IsLoopback() || isPrivateIP(remote))
+	}
+	return true
+}
+
+// selectPexPeers returns at most maxPexAddrs of infos randomly to send to a
+// peer at ip remote, with addresses which are shareable and good
+func selectPexPeers(infos []peerstore.PeerInfo, remote net.IP, good func(peer.ID, ma.Multiaddr) bool) []*p2ppb.PeerInfo {
+	var peers []*p2ppb.PeerInfo
+	for _, i := range rand.Perm(len(infos)) {
+		info := infos[i]
+		peerInfo := &p2ppb.PeerInfo{Id: info.ID.Pretty()}
+		for _, addr := range info.Addrs {
+			if shareableAddr(addr, remote) && good(info.ID, addr) {
+				peerInfo.Addrs = append(peerInfo.Addrs, addr.String())
+			}
+		}
+		if len(peerInfo.Addrs) == 0 {
+			continue
+		}
+		if peers = append(peers, peerInfo); len(peers) == maxPexAddrs {
+			break
+		}
+	}
+	return peers
+}
+
+// goodPexAddr checks if pid at addr is good to exchange, neither rejected
+// nor scoring negative
+func (p *BoxPeer) goodPexAddr(pid peer.ID, addr ma.Multiaddr) bool {
+	return !p.isRejected(pid, addr) && p.scoremgr.Score(pid) >= 0
+}
+
+// pexPeers returns known good peers to send to peer pid at ip remote
+func (p *BoxPeer) pexPeers(pid peer.ID, remote net.IP) []*p2ppb.PeerInfo {
+	var infos []peerstore.PeerInfo
+	for _, id := range p.table.peerStore.Peers() {
+		if id == p.id || id == pid {
+			continue
+		}
+		infos = append(infos, p.table.peerStore.PeerInfo(id))
+	}
+	return selectPexPeers(infos, remote, p.goodPexAddr)
+}
+
+// addPexPeers adds peers received from a peer at ip remote to peerstore,
+// skipping addresses not shareable or not good
+func (p *BoxPeer) addPexPeers(peers []*p2ppb.PeerInfo, remote net.IP) {
+	for _, v := range peers {
+		pid, err := peer.IDB58Decode(v.Id)
+		if err != nil || pid == p.id {
+			continue
+		}
+		var addrs []ma.Multiaddr
+		for _, s := range v.Addrs {
+			addr, err := ma.NewMultiaddr(s)
+			if err != nil || !shareableAddr(addr, remote) || !p.goodPexAddr(pid, addr) {
+				continue
+			}
+			addrs = append(addrs, addr)
+		}
+		if len(addrs) == 0 {
+			continue
+		}
+		p.table.peerStore.AddAddrs(pid, addrs, peerstore.OwnObservedAddrTTL)
+		p.table.routeTable.Update(pid)
+		p.table.addrmgr.add(pid, addrs[0], remote)
+	}
+}
+
+// pexLoop requests addresses from a random connected peer periodically,
+// until proc closes
+func (p *BoxPeer) pexLoop(proc goprocess.Process) {
+	ticker := time.NewTicker(pexInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			if p.outboundFull() {
+				continue
+			}
+			pid := p.PickOnePeer()
+			if pid == "" {
+				continue
+			}
+			if c, ok := p.conns.Load(pid); ok {
+				if err := c.(*Conn).RequestAddrs(); err != nil {
+					logger.Warnf("Failed to request addrs from peer %s: %v", pid.Pretty(), err)
+				}
+			}
+		case <-proc.Closing():
+			logger.Info("Quit pex loop.")
+			return
+		}
+	}
+}
+
+// RequestAddrs requests a sample of known good addresses from the remote peer
+func (conn *Conn) RequestAddrs() error {
+	conn.mutex.Lock()
+	conn.addrRequested = true
+	conn.mutex.Unlock()
+	return conn.Write(GetAddrMsg, []byte{})
+}
+
+// OnGetAddr handle GetAddrMsg message, replying known good addresses at most
+// once in pexMinRequestInterval
+func (conn *Conn) OnGetAddr(body []byte) error {
+	now := time.Now()
+	conn.mutex.Lock()
+	limited := now.Sub(conn.addrServedAt) < pexMinRequestInterval
+	if !limited {
+		conn.addrServedAt = now
+	}
+	conn.mutex.Unlock()
+	if limited {
+		logger.Debugf("Ignore frequent getaddr from peer %s", conn.remotePeer.Pretty())
+		return nil
+	}
+
+	msg := &p2ppb.Peers{
+		Peers:    conn.peer.pexPeers(conn.remotePeer, addrIP(conn.RemoteAddr())),
+		IsSynced: IsSynced(),
+	}
+	body, err := proto.Marshal(msg)
+	if err != nil {
+		return err
+	}
+	return conn.Write(AddrMsg, body)
+}
+
+// OnAddr handle AddrMsg message. Addresses not requested are ignored
+func (conn *Conn) OnAddr(body []byte) error {
+	conn.mutex.Lock()
+	requested := conn.addrRequested
+	conn.addrRequested = false
+	conn.mutex.Unlock()
+	if !requested {
+		logger.Debugf("Ignore unsolicited addr from peer %s", conn.remotePeer.Pretty())
+		return nil
+	}
+
+	peers := new(p2ppb.Peers)
+	if err := proto.Unmarshal(body, peers); err != nil {
+		return err
+	}
+	if len(peers.Peers) > maxPexAddrs {
+		return ErrTooManyAddrs
+	}
+	conn.peer.addPexPeers(peers.Peers, addrIP(conn.RemoteAddr()))
+	return nil
+}
@@ -0,0 +1,65 @@
+// Copyright (c) 2018 ContentBox Authors.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package p2p
+
+import (
+	"net"
+	"testing"
+
+	"github.com/facebookgo/ensure"
+	peer "github.com/libp2p/go-libp2p-peer"
+	peerstore "github.com/libp2p/go-libp2p-peerstore"
+	ma "github.com/multiformats/go-multiaddr"
+)
+
+func TestShareableAddr(t *testing.T) {
+	public := net.ParseIP("8.8.8.8")
+	private := net.ParseIP("192.168.1.2")
+	loopback := net.ParseIP("127.0.0.1")
+	tests := []struct {
+		addr   string
+		remote net.IP
+		expect bool
+	}{
+		{"/ip4/1.2.3.4/tcp/19199", public, true},
+		{"/ip4/1.2.3.4/tcp/19199", nil, true},
+		{"/ip4/0.0.0.0/tcp/19199", public, false},
+		{"/ip4/169.254.1.1/tcp/19199", private, false},
+		{"/ip4/10.0.0.1/tcp/19199", public, false},
+		{"/ip4/10.0.0.1/tcp/19199", private, true},
+		{"/ip4/10.0.0.1/tcp/19199", loopback, true},
+		{"/ip4/127.0.0.1/tcp/19199", private, false},
+		{"/ip4/127.0.0.1/tcp/19199", loopback, true},
+		{"/ip6/::1/tcp/19199", nil, false},
+	}
+	for _, test := range tests {
+		addr, err := ma.NewMultiaddr(test.addr)
+		ensure.Nil(t, err)
+		ensure.DeepEqual(t, shareableAddr(addr, test.remote), test.expect, test.addr)
+	}
+}
+
+func TestSelectPexPeers(t *testing.T) {
+	good, _ := peer.IDB58Decode(testPeerID)
+	bad, _ := peer.IDB58Decode("QmPbXnwgNDMYTPhrzyGzcQBkWGBkAhxmXB3w4EQgFVTTxr")
+	public, _ := ma.NewMultiaddr("/ip4/1.2.3.4/tcp/19199")
+	private, _ := ma.NewMultiaddr("/ip4/10.0.0.1/tcp/19199")
+	infos := []peerstore.PeerInfo{
+		{ID: good, Addrs: []ma.Multiaddr{public, private}},
+		{ID: bad, Addrs: []ma.Multiaddr{public}},
+	}
+	isGood := func(pid peer.ID, _ ma.Multiaddr) bool { return pid == good }
+
+	peers := selectPexPeers(infos, net.ParseIP("8.8.8.8"), isGood)
+	ensure.DeepEqual(t, len(peers), 1)
+	ensure.DeepEqual(t, peers[0].Id, testPeerID)
+	ensure.DeepEqual(t, peers[0].Addrs, []string{"/ip4/1.2.3.4/tcp/19199"})
+
+	var many []peerstore.PeerInfo
+	for i := 0; i < 2*maxPexAddrs; i++ {
+		many = append(many, infos[0])
+	}
+	ensure.DeepEqual(t, len(selectPexPeers(many, nil, isGood)), maxPexAddrs)
+}
@@ -0,0 +1,101 @@
+// Copyright (c) 2018 ContentBox Authors.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package p2p
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"net"
+	"sync"
+
+	ipnet "github.com/libp2p/go-libp2p-interface-pnet"
+)
+
+// networkKeyLength is the length of a network pre-shared key
+const networkKeyLength = 32
+
+// protector encrypts connections with a network pre-shared key, so that
+// nodes without the key fail transport handshakes with the network
+type protector struct {
+	key []byte
+}
+
+var _ ipnet.Protector = (*protector)(nil)
+
+// newProtector returns a protector with hexKey, 32 bytes in hex
+func newProtector(hexKey string) (*protector, error) {
+	key, err := hex.DecodeString(hexKey)
+	if err != nil || len(key) != networkKeyLength {
+		return nil, ErrInvalidNetworkKey
+	}
+	return &protector{key: key}, nil
+}
+
+// Protect wraps conn to be encrypted with the key, each direction with its
+// own random iv sent ahead of data
+func (p *protector) Protect(conn net.Conn) (net.Conn, error) {
+	block, err := aes.NewCipher(p.key)
+	if err != nil {
+		return nil, err
+	}
+	return &pskConn{Conn: conn, block: block}, nil
+}
+
+// Fingerprint returns the hash of the key, to identify the network
+func (p *protector) Fingerprint() []byte {
+	hash := sha256.Sum256(p.key)
+	return hash[:]
+}
+
+// pskConn is a conn encrypted with a pre-shared key. Ivs are exchanged
+// lazily on first read and write, not to block Protect
+type pskConn struct {
+	net.Conn
+	block cipher.Block
+
+	readOnce  sync.Once
+	readErr   error
+	reader    cipher.Stream
+	writeOnce sync.Once
+	writeErr  error
+	writer    cipher.Stream
+}
+
+func (c *pskConn) Read(b []byte) (int, error) {
+	c.readOnce.Do(func() {
+		iv := make([]byte, c.block.BlockSize())
+		if _, c.readErr = io.ReadFull(c.Conn, iv); c.readErr == nil {
+			c.reader = cipher.NewCTR(c.block, iv)
+		}
+	})
+	if c.readErr != nil {
+		return 0, c.readErr
+	}
+	n, err := c.Conn.Read(b)
+	c.reader.XORKeyStream(b[:n], b[:n])
+	return n, err
+}
+
+func (c *pskConn) Write(b []byte) (int, error) {
+	c.writeOnce.Do(func() {
+		iv := make([]byte, c.block.BlockSize())
+		if _, c.writeErr = rand.Read(iv); c.writeErr != nil {
+			return
+		}
+		if _, c.writeErr = c.Conn.Write(iv); c.writeErr == nil {
+			c.writer = cipher.NewCTR(c.block, iv)
+		}
+	})
+	if c.writeErr != nil {
+		return 0, c.writeErr
+	}
+	out := make([]byte, len(b))
+	c.writer.XORKeyStream(out, b)
+	return c.Conn.Write(out)
+}
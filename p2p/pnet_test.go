@@ -0,0 +1,57 @@
+// Copyright (c) 2018 ContentBox Authors.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package p2p
+
+import (
+	"io"
+	"net"
+	"strings"
+	"testing"
+
+	"github.com/facebookgo/ensure"
+)
+
+func TestNewProtector(t *testing.T) {
+	_, err := newProtector(strings.Repeat("ab", networkKeyLength))
+	ensure.Nil(t, err)
+	_, err = newProtector(strings.Repeat("ab", networkKeyLength-1))
+	ensure.DeepEqual(t, err, ErrInvalidNetworkKey)
+	_, err = newProtector("not hex")
+	ensure.DeepEqual(t, err, ErrInvalidNetworkKey)
+}
+
+// exchange writes msg through a conn protected with writeKey and returns
+// what is read through the other end protected with readKey
+func exchange(t *testing.T, writeKey, readKey string, msg []byte) []byte {
+	p1, err := newProtector(writeKey)
+	ensure.Nil(t, err)
+	p2, err := newProtector(readKey)
+	ensure.Nil(t, err)
+	c1, c2 := net.Pipe()
+	defer c1.Close()
+	defer c2.Close()
+	w, err := p1.Protect(c1)
+	ensure.Nil(t, err)
+	r, err := p2.Protect(c2)
+	ensure.Nil(t, err)
+
+	go func() {
+		w.Write(msg[:3])
+		w.Write(msg[3:])
+	}()
+	buf := make([]byte, len(msg))
+	_, err = io.ReadFull(r, buf)
+	ensure.Nil(t, err)
+	return buf
+}
+
+func TestProtector(t *testing.T) {
+	key := strings.Repeat("ab", networkKeyLength)
+	msg := []byte("/multistream/1.0.0")
+	ensure.DeepEqual(t, exchange(t, key, key, msg), msg)
+
+	other := strings.Repeat("cd", networkKeyLength)
+	ensure.NotDeepEqual(t, exchange(t, key, other, msg), msg)
+}
@@ -53,6 +53,8 @@ func (pq *PriorityMsgQueue) Run(proc goprocess.Process, f func(interface{})) {
 			select {
 			case <-pq.notify:
 				p = top
+			case <-proc.Closing():
+				return
 			}
 		}
 	}
@@ -21,3 +21,16 @@ func TestPriorityQueue(t *testing.T) {
 	pq.Push("box", 1)
 	<-ch
 }
+
+func TestPriorityQueueClose(t *testing.T) {
+	pq := New(2, 20)
+	proc := goprocess.WithParent(goprocess.Background())
+	done := make(chan struct{})
+	go func() {
+		pq.Run(proc, func(interface{}) {})
+		close(done)
+	}()
+	// Run quits even when idle
+	proc.Close()
+	<-done
+}
@@ -0,0 +1,186 @@
+// Copyright (c) 2018 ContentBox Authors.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package pscore
+
+import (
+	"time"
+)
+
+// Config holds scores of peer events, limits, thresholds and decay periods
+// of peer scores. Values not set are replaced by defaults, while a score set
+// to 0 disables scoring of its event
+type Config struct {
+	// BaseScore is the score of peers without events
+	BaseScore *int64 `mapstructure:"base_score"`
+	// PunishLimit and RewardLimit are the upper limits of punishment and
+	// achievement
+	PunishLimit *int64 `mapstructure:"punish_limit"`
+	RewardLimit *int64 `mapstructure:"reward_limit"`
+	// punishment and achievement decay to half in halflifes, and to zero
+	// after lifetimes
+	PunishHalflife time.Duration `mapstructure:"punish_halflife"`
+	PunishLifetime time.Duration `mapstructure:"punish_lifetime"`
+	RewardHalflife time.Duration `mapstructure:"reward_halflife"`
+	RewardLifetime time.Duration `mapstructure:"reward_lifetime"`
+
+	PunishConnTimeOut      *int64 `mapstructure:"punish_conn_timeout"`
+	PunishBadBlock         *int64 `mapstructure:"punish_bad_block"`
+	PunishBadTx            *int64 `mapstructure:"punish_bad_tx"`
+	PunishSyncMsg          *int64 `mapstructure:"punish_sync_msg"`
+	PunishNoHeartBeat      *int64 `mapstructure:"punish_no_heart_beat"`
+	PunishConnUnsteadiness *int64 `mapstructure:"punish_conn_unsteadiness"`
+	RewardNewBlock         *int64 `mapstructure:"reward_new_block"`
+	RewardNewTx            *int64 `mapstructure:"reward_new_tx"`
+
+	// events are scored once counted more than thresholds in a scoring round,
+	// and no heartbeat is punished if heartbeats are fewer than
+	// HeartBeatCeiling
+	ConnTimeOutThreshold *int `mapstructure:"conn_timeout_threshold"`
+	BadBlockThreshold    *int `mapstructure:"bad_block_threshold"`
+	BadTxThreshold       *int `mapstructure:"bad_tx_threshold"`
+	SyncMsgThreshold     *int `mapstructure:"sync_msg_threshold"`
+	HeartBeatCeiling     *int `mapstructure:"heart_beat_ceiling"`
+	DisconnThreshold     *int `mapstructure:"disconn_threshold"`
+	NewBlockThreshold    *int `mapstructure:"new_block_threshold"`
+	NewTxThreshold       *int `mapstructure:"new_tx_threshold"`
+
+	// peers answering HighLatencyStreak pings in a row slower than
+	// HighLatency are punished by PunishHighLatency
+	HighLatency       time.Duration `mapstructure:"high_latency"`
+	HighLatencyStreak *int          `mapstructure:"high_latency_streak"`
+	PunishHighLatency *int64        `mapstructure:"punish_high_latency"`
+}
+
+// Params are resolved scoring parameters shared by peer scores
+type Params struct {
+	BaseScore      int64
+	PunishLimit    int64
+	RewardLimit    int64
+	PunishHalflife time.Duration
+	PunishLifetime time.Duration
+	RewardHalflife time.Duration
+	RewardLifetime time.Duration
+
+	PunishConnTimeOut      int64
+	PunishBadBlock         int64
+	PunishBadTx            int64
+	PunishSyncMsg          int64
+	PunishNoHeartBeat      int64
+	PunishConnUnsteadiness int64
+	RewardNewBlock         int64
+	RewardNewTx            int64
+
+	ConnTimeOutThreshold int
+	BadBlockThreshold    int
+	BadTxThreshold       int
+	SyncMsgThreshold     int
+	HeartBeatCeiling     int
+	DisconnThreshold     int
+	NewBlockThreshold    int
+	NewTxThreshold       int
+
+	HighLatency       time.Duration
+	HighLatencyStreak int
+	PunishHighLatency int64
+
+	punishFactors *factors
+	rewardFactors *factors
+}
+
+// defaults are the params of values not configured
+var defaults = Params{
+	BaseScore:      100,
+	PunishLimit:    1000,
+	RewardLimit:    900,
+	PunishHalflife: time.Minute,
+	PunishLifetime: 30 * time.Minute,
+	RewardHalflife: 10 * time.Minute,
+	RewardLifetime: 5 * time.Hour,
+
+	PunishConnTimeOut:      40,
+	PunishBadBlock:         100,
+	PunishBadTx:            30,
+	PunishSyncMsg:          20,
+	PunishNoHeartBeat:      60,
+	PunishConnUnsteadiness: 100,
+	RewardNewBlock:         80,
+	RewardNewTx:            10,
+
+	HeartBeatCeiling: 5,
+	DisconnThreshold: 3,
+
+	HighLatency:       2 * time.Second,
+	HighLatencyStreak: 3,
+	PunishHighLatency: 50,
+}
+
+// DefaultParams are the params with defaults only
+var DefaultParams = NewParams(nil)
+
+// NewParams returns params of cfg with defaults for values not set. cfg may
+// be nil for defaults only
+func NewParams(cfg *Config) *Params {
+	p := defaults
+	if cfg != nil {
+		setInt64(&p.BaseScore, cfg.BaseScore)
+		setInt64(&p.PunishLimit, cfg.PunishLimit)
+		setInt64(&p.RewardLimit, cfg.RewardLimit)
+		setDuration(&p.PunishHalflife, cfg.PunishHalflife)
+		setDuration(&p.PunishLifetime, cfg.PunishLifetime)
+		setDuration(&p.RewardHalflife, cfg.RewardHalflife)
+		setDuration(&p.RewardLifetime, cfg.RewardLifetime)
+
+		setInt64(&p.PunishConnTimeOut, cfg.PunishConnTimeOut)
+		setInt64(&p.PunishBadBlock, cfg.PunishBadBlock)
+		setInt64(&p.PunishBadTx, cfg.PunishBadTx)
+		setInt64(&p.PunishSyncMsg, cfg.PunishSyncMsg)
+		setInt64(&p.PunishNoHeartBeat, cfg.PunishNoHeartBeat)
+		setInt64(&p.PunishConnUnsteadiness, cfg.PunishConnUnsteadiness)
+		setInt64(&p.RewardNewBlock, cfg.RewardNewBlock)
+		setInt64(&p.RewardNewTx, cfg.RewardNewTx)
+
+		setInt(&p.ConnTimeOutThreshold, cfg.ConnTimeOutThreshold)
+		setInt(&p.BadBlockThreshold, cfg.BadBlockThreshold)
+		setInt(&p.BadTxThreshold, cfg.BadTxThreshold)
+		setInt(&p.SyncMsgThreshold, cfg.SyncMsgThreshold)
+		setInt(&p.HeartBeatCeiling, cfg.HeartBeatCeiling)
+		setInt(&p.DisconnThreshold, cfg.DisconnThreshold)
+		setInt(&p.NewBlockThreshold, cfg.NewBlockThreshold)
+		setInt(&p.NewTxThreshold, cfg.NewTxThreshold)
+
+		setDuration(&p.HighLatency, cfg.HighLatency)
+		setInt(&p.HighLatencyStreak, cfg.HighLatencyStreak)
+		setInt64(&p.PunishHighLatency, cfg.PunishHighLatency)
+	}
+	p.punishFactors = newFactors(seconds(p.PunishHalflife), seconds(p.PunishLifetime), 64)
+	p.rewardFactors = newFactors(seconds(p.RewardHalflife), seconds(p.RewardLifetime), 512)
+	return &p
+}
+
+func setInt64(dst *int64, v *int64) {
+	if v != nil {
+		*dst = *v
+	}
+}
+
+func setInt(dst *int, v *int) {
+	if v != nil {
+		*dst = *v
+	}
+}
+
+func setDuration(dst *time.Duration, v time.Duration) {
+	if v > 0 {
+		*dst = v
+	}
+}
+
+// seconds returns d in whole seconds, at least 1
+func seconds(d time.Duration) int {
+	if s := int(d / time.Second); s > 0 {
+		return s
+	}
+	return 1
+}
@@ -6,6 +6,7 @@ package pscore
 
 import (
 	"container/list"
+	"encoding/gob"
 	"fmt"
 	"math"
 	"sync"
@@ -18,52 +19,8 @@ import (
 
 var logger = log.NewLogger("pscore")
 
-const (
-	// baseScore indicates the default score of the peer.
-	baseScore = 100
-
-	// punishLimit indicates the upper limit of publishment.
-	punishLimit = 1000
-
-	// awardLimit indicates the upper limit of achievement.
-	rewardLimit = 900
-
-	// ConnCleanupLoopInterval indicates the loop interval for conn cleaning up
-	ConnCleanupLoopInterval = 30 * time.Second
-)
-
-const (
-	punishConnTimeOutScore     = 40
-	punishConnTimeOutThreshold = 0
-
-	punishBadBlockScore     = 100
-	punishBadBlockThreshold = 0
-
-	punishBadTxScore     = 30
-	punishBadTxThreshold = 0
-
-	punishSyncMsgScore     = 20
-	punishSyncMsgThreshold = 0
-
-	punishNoHeartBeatScore = 60
-	punishHeartBeatCeiling = 5
-
-	punishConnUnsteadinessScore = 100
-	punishDisconnThreshold      = 3
-
-	rewardNewBlockScore     = 80
-	rewardNewBlockThreshold = 0
-
-	rewardNewTxScore     = 10
-	rewardNewTxThreshold = 0
-)
-
-var (
-	// PunishFactors contains factors of punishment.
-	PunishFactors = newFactors(60, 1800, 64)
-	// RechieveFactors contains factors of achievement.
-	RechieveFactors = newFactors(600, 18000, 512)
-)
+// ConnCleanupLoopInterval indicates the loop interval for conn cleaning up
+const ConnCleanupLoopInterval = 30 * time.Second
 
 type factors struct {
 
@@ -123,6 +80,7 @@ func (factors *factors) decayRate(t int64) float64 {
 // declaration.
 type DynamicPeerScore struct {
 	pid         peer.ID
+	params      *Params
 	lastUnix    int64
 	punishment  float64
 	achievement float64
@@ -139,10 +97,74 @@ type DynamicPeerScore struct {
 	mtx sync.Mutex
 }
 
-// NewDynamicPeerScore returns new DynamicPeerScore.
-func NewDynamicPeerScore(pid peer.ID) *DynamicPeerScore {
+// NewDynamicPeerScore returns new DynamicPeerScore scored with params.
+func NewDynamicPeerScore(pid peer.ID, params *Params) *DynamicPeerScore {
+	return &DynamicPeerScore{
+		pid:    pid,
+		params: params,
+	}
+}
+
+// ScoreState is the state of a DynamicPeerScore kept across restarts, so that
+// misbehaving peers don't start over with a clean score
+type ScoreState struct {
+	LastUnix    int64
+	Punishment  float64
+	Achievement float64
+
+	TimeOutCounter  int
+	BadBlockCounter int
+	BadTxCounter    int
+	SyncCounter     int
+	HbCounter       int
+	DisconnCounter  int
+	NewBlockCounter int
+	NewTxCounter    int
+}
+
+func init() {
+	// states are stored as gob encoded peer metadata
+	gob.Register(ScoreState{})
+}
+
+// NewDynamicPeerScoreFromState returns DynamicPeerScore scored with params,
+// restored from state.
+func NewDynamicPeerScoreFromState(pid peer.ID, state ScoreState, params *Params) *DynamicPeerScore {
 	return &DynamicPeerScore{
-		pid: pid,
+		pid:             pid,
+		params:          params,
+		lastUnix:        state.LastUnix,
+		punishment:      state.Punishment,
+		achievement:     state.Achievement,
+		timeOutCounter:  state.TimeOutCounter,
+		badBlockCounter: state.BadBlockCounter,
+		badTxCounter:    state.BadTxCounter,
+		syncCounter:     state.SyncCounter,
+		hbCounter:       state.HbCounter,
+		disconnCounter:  state.DisconnCounter,
+		newBlockCounter: state.NewBlockCounter,
+		newTxCounter:    state.NewTxCounter,
+	}
+}
+
+// State returns the current state of the peer score.
+//
+// This function is safe for concurrent access.
+func (s *DynamicPeerScore) State() ScoreState {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	return ScoreState{
+		LastUnix:        s.lastUnix,
+		Punishment:      s.punishment,
+		Achievement:     s.achievement,
+		TimeOutCounter:  s.timeOutCounter,
+		BadBlockCounter: s.badBlockCounter,
+		BadTxCounter:    s.badTxCounter,
+		SyncCounter:     s.syncCounter,
+		HbCounter:       s.hbCounter,
+		DisconnCounter:  s.disconnCounter,
+		NewBlockCounter: s.newBlockCounter,
+		NewTxCounter:    s.newTxCounter,
 	}
 }
 
@@ -177,55 +199,56 @@ func (s *DynamicPeerScore) score(t time.Time) int64 {
 	dt := t.UnixNano()/1e6 - s.lastUnix
 	s.verifyLifeTime(dt)
 
+	p := s.params
 	if dt > 0 {
-		var punishment, achievement int
-		if s.timeOutCounter > punishConnTimeOutThreshold {
-			punishment += punishConnTimeOutScore * s.timeOutCounter
+		var punishment, achievement int64
+		if s.timeOutCounter > p.ConnTimeOutThreshold {
+			punishment += p.PunishConnTimeOut * int64(s.timeOutCounter)
 			s.timeOutCounter = 0
 		}
-		if s.badBlockCounter > punishBadBlockThreshold {
-			punishment += punishBadBlockScore * s.badBlockCounter
+		if s.badBlockCounter > p.BadBlockThreshold {
+			punishment += p.PunishBadBlock * int64(s.badBlockCounter)
 			s.badBlockCounter = 0
 		}
-		if s.badTxCounter > punishBadTxThreshold {
-			punishment += punishBadTxScore * s.badTxCounter
+		if s.badTxCounter > p.BadTxThreshold {
+			punishment += p.PunishBadTx * int64(s.badTxCounter)
 			s.badTxCounter = 0
 		}
-		if s.syncCounter > punishSyncMsgThreshold {
-			punishment += punishSyncMsgScore * s.syncCounter
+		if s.syncCounter > p.SyncMsgThreshold {
+			punishment += p.PunishSyncMsg * int64(s.syncCounter)
 			s.syncCounter = 0
 		}
-		if s.hbCounter < punishHeartBeatCeiling {
-			punishment += punishNoHeartBeatScore
+		if s.hbCounter < p.HeartBeatCeiling {
+			punishment += p.PunishNoHeartBeat
 			s.hbCounter = 0
 		}
-		if s.disconnCounter > punishDisconnThreshold {
-			punishment += punishConnUnsteadinessScore
+		if s.disconnCounter > p.DisconnThreshold {
+			punishment += p.PunishConnUnsteadiness
 			s.disconnCounter = 0
 		}
-		if s.newBlockCounter > rewardNewBlockThreshold {
-			achievement += rewardNewBlockScore * s.newBlockCounter
+		if s.newBlockCounter > p.NewBlockThreshold {
+			achievement += p.RewardNewBlock * int64(s.newBlockCounter)
 			s.newBlockCounter = 0
 		}
-		if s.newTxCounter > rewardNewTxThreshold {
-			achievement += rewardNewTxScore * s.newTxCounter
+		if s.newTxCounter > p.NewTxThreshold {
+			achievement += p.RewardNewTx * int64(s.newTxCounter)
 			s.newTxCounter = 0
 		}
-		s.punish(int64(punishment), t)
-		s.reward(int64(achievement), t)
+		s.punish(punishment, t)
+		s.reward(achievement, t)
 
-		return baseScore + int64(s.achievement) - int64(s.punishment)
+		return p.BaseScore + int64(s.achievement) - int64(s.punishment)
 	}
 
-	return baseScore + int64(s.achievement*RechieveFactors.decayRate(dt)) - int64(s.punishment*PunishFactors.decayRate(dt))
+	return p.BaseScore + int64(s.achievement*p.rewardFactors.decayRate(dt)) - int64(s.punishment*p.punishFactors.decayRate(dt))
 }
 
 // verifyLifeTime reset punishment or achievement when lifetime < dt
 func (s *DynamicPeerScore) verifyLifeTime(dt int64) {
-	if PunishFactors.lifetime < int(dt/1000) {
+	if s.params.punishFactors.lifetime < int(dt/1000) {
 		s.punishment = 0
 	}
-	if RechieveFactors.lifetime < int(dt/1000) {
+	if s.params.rewardFactors.lifetime < int(dt/1000) {
 		s.achievement = 0
 	}
 }
@@ -245,18 +268,18 @@ func (s *DynamicPeerScore) reward(achievement int64, t time.Time) int64 {
 
 	if dt > 0 {
 		if s.achievement > 1 {
-			s.achievement *= RechieveFactors.decayRate(dt)
+			s.achievement *= s.params.rewardFactors.decayRate(dt)
 		}
 		if s.punishment > 1 {
-			s.punishment *= PunishFactors.decayRate(dt)
+			s.punishment *= s.params.punishFactors.decayRate(dt)
 		}
 		s.achievement += float64(achievement)
-		if s.achievement > rewardLimit {
-			s.achievement = rewardLimit
+		if s.achievement > float64(s.params.RewardLimit) {
+			s.achievement = float64(s.params.RewardLimit)
 		}
 		s.lastUnix = tu
 	}
-	return baseScore + int64(s.achievement) - int64(s.punishment)
+	return s.params.BaseScore + int64(s.achievement) - int64(s.punishment)
 }
 
 // punish increases the punishment. The resulting score is calculated
@@ -274,19 +297,29 @@ func (s *DynamicPeerScore) punish(punishment int64, t time.Time) int64 {
 
 	if dt > 0 {
 		if s.achievement > 1 {
-			s.achievement *= RechieveFactors.decayRate(dt)
+			s.achievement *= s.params.rewardFactors.decayRate(dt)
 		}
 		if s.punishment > 1 {
-			s.punishment *= PunishFactors.decayRate(dt)
+			s.punishment *= s.params.punishFactors.decayRate(dt)
 		}
 		s.punishment += float64(punishment)
-		if s.punishment > punishLimit {
-			s.punishment = punishLimit
+		if s.punishment > float64(s.params.PunishLimit) {
+			s.punishment = float64(s.params.PunishLimit)
 		}
 		s.lastUnix = tu
 	}
 
-	return baseScore + int64(s.achievement) - int64(s.punishment)
+	return s.params.BaseScore + int64(s.achievement) - int64(s.punishment)
+}
+
+// Punish increases the punishment at time t, for misbehaviors not counted
+// as events, e.g. sustained high latency. The resulting score is returned.
+//
+// This function is safe for concurrent access.
+func (s *DynamicPeerScore) Punish(punishment int64, t time.Time) int64 {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	return s.punish(punishment, t)
 }
 
 // Record record event
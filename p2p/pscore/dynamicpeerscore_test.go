@@ -0,0 +1,92 @@
+// Copyright (c) 2018 ContentBox Authors.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package pscore
+
+import (
+	"bytes"
+	"encoding/gob"
+	"testing"
+	"time"
+
+	"github.com/BOXFoundation/boxd/boxd/eventbus"
+	"github.com/facebookgo/ensure"
+	peer "github.com/libp2p/go-libp2p-peer"
+)
+
+func TestScoreStateRoundTrip(t *testing.T) {
+	pid, _ := peer.IDB58Decode("QmVjYrCGiysX3FjeqyVWTCtgTrnYWdBkkPwFWrDMGRMhSy")
+	score := NewDynamicPeerScore(pid, DefaultParams)
+	score.Record(eventbus.BadBlockEvent)
+	score.Record(eventbus.NewTxEvent)
+	now := time.Now()
+	expected := score.Score(now)
+	score.Record(eventbus.BadTxEvent)
+
+	// states are persisted as gob encoded interface values
+	var val interface{} = score.State()
+	var buf bytes.Buffer
+	ensure.Nil(t, gob.NewEncoder(&buf).Encode(&val))
+	var decoded interface{}
+	ensure.Nil(t, gob.NewDecoder(&buf).Decode(&decoded))
+	state, ok := decoded.(ScoreState)
+	ensure.True(t, ok)
+	ensure.DeepEqual(t, state, score.State())
+
+	restored := NewDynamicPeerScoreFromState(pid, state, DefaultParams)
+	ensure.DeepEqual(t, restored.Score(now), expected)
+	ensure.DeepEqual(t, restored.State().BadTxCounter, 1)
+}
+
+func TestPunish(t *testing.T) {
+	pid, _ := peer.IDB58Decode("QmVjYrCGiysX3FjeqyVWTCtgTrnYWdBkkPwFWrDMGRMhSy")
+	score := NewDynamicPeerScore(pid, DefaultParams)
+	now := time.Now()
+	ensure.DeepEqual(t, score.Punish(50, now), DefaultParams.BaseScore-50)
+	ensure.DeepEqual(t, score.Punish(2*DefaultParams.PunishLimit, now.Add(time.Millisecond)), DefaultParams.BaseScore-DefaultParams.PunishLimit)
+}
+
+func TestNewParams(t *testing.T) {
+	badBlock := int64(500)
+	params := NewParams(&Config{PunishBadBlock: &badBlock, PunishHalflife: 2 * time.Minute})
+	ensure.DeepEqual(t, params.PunishBadBlock, int64(500))
+	ensure.DeepEqual(t, params.punishFactors.halflife, 120)
+	// values not set are defaults
+	ensure.DeepEqual(t, params.BaseScore, DefaultParams.BaseScore)
+	ensure.DeepEqual(t, params.rewardFactors.halflife, 600)
+	ensure.DeepEqual(t, params.HighLatency, 2*time.Second)
+	ensure.DeepEqual(t, params.HighLatencyStreak, 3)
+	ensure.DeepEqual(t, params.PunishHighLatency, int64(50))
+
+	streak := 5
+	latency := NewParams(&Config{HighLatency: time.Second, HighLatencyStreak: &streak})
+	ensure.DeepEqual(t, latency.HighLatency, time.Second)
+	ensure.DeepEqual(t, latency.HighLatencyStreak, 5)
+
+	pid, _ := peer.IDB58Decode("QmVjYrCGiysX3FjeqyVWTCtgTrnYWdBkkPwFWrDMGRMhSy")
+	score := NewDynamicPeerScore(pid, params)
+	now := time.Now()
+	for i := 0; i < DefaultParams.HeartBeatCeiling; i++ {
+		score.Record(eventbus.HeartBeatEvent)
+	}
+	score.Record(eventbus.BadBlockEvent)
+	ensure.DeepEqual(t, score.Score(now), DefaultParams.BaseScore-500)
+}
+
+func TestZeroScoreDisablesEvent(t *testing.T) {
+	var zero int64
+	params := NewParams(&Config{PunishBadBlock: &zero, PunishNoHeartBeat: &zero})
+	ensure.DeepEqual(t, params.PunishBadBlock, int64(0))
+	ensure.DeepEqual(t, params.PunishBadTx, DefaultParams.PunishBadTx)
+
+	pid, _ := peer.IDB58Decode("QmVjYrCGiysX3FjeqyVWTCtgTrnYWdBkkPwFWrDMGRMhSy")
+	score := NewDynamicPeerScore(pid, params)
+	score.Record(eventbus.BadBlockEvent)
+	score.Record(eventbus.BadBlockEvent)
+	ensure.DeepEqual(t, score.Score(time.Now()), DefaultParams.BaseScore)
+
+	score = NewDynamicPeerScore(pid, params)
+	score.Record(eventbus.BadTxEvent)
+	ensure.DeepEqual(t, score.Score(time.Now()), DefaultParams.BaseScore-DefaultParams.PunishBadTx)
+}
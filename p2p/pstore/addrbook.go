@@ -23,6 +23,8 @@ import (
 	mh "github.com/multiformats/go-multihash"
 )
 
+//go:generate go run ../../boxd/eventbus/gentopics -imports peer=github.com/libp2p/go-libp2p-peer,ma=github.com/multiformats/go-multiaddr P2PPeerAddr=pid:peer.ID,addr:ma.Multiaddr
+
 // Peer addresses are stored under the following db key pattern:
 // /peers/addr/<b58 peer id no padding>/<hash of maddr>
 var abBase = key.NewKey("/peers/addrs")
@@ -308,8 +310,8 @@ func (ab *addrBook) AddrStream(ctx context.Context, p peer.ID) <-chan ma.Multiad
 				subch <- addr
 			}
 		}
-		ab.bus.SubscribeAsync(eventbus.TopicP2PPeerAddr, subf, false)
-		defer ab.bus.Unsubscribe(eventbus.TopicP2PPeerAddr, subf)
+		SubscribeP2PPeerAddrAsync(ab.bus, subf, false)
+		defer UnsubscribeP2PPeerAddr(ab.bus, subf)
 
 		for {
 			select {
@@ -415,7 +417,7 @@ func (ab *addrBook) setAddrs(p peer.ID, addrs []ma.Multiaddr, ttl time.Duration,
 	// Update was successful, so publish event only for new addresses.
 	for i, addr := range addrs {
 		if !existed[i] {
-			ab.bus.Publish(eventbus.TopicP2PPeerAddr, p, addr)
+			PublishP2PPeerAddr(ab.bus, p, addr)
 		}
 	}
 	return nil
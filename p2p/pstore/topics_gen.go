@@ -0,0 +1,44 @@
+// Code generated by gentopics. DO NOT EDIT.
+
+package pstore
+
+import (
+	"reflect"
+
+	"github.com/BOXFoundation/boxd/boxd/eventbus"
+	peer "github.com/libp2p/go-libp2p-peer"
+	ma "github.com/multiformats/go-multiaddr"
+)
+
+func init() {
+	eventbus.RegisterTopic(eventbus.TopicP2PPeerAddr,
+		reflect.TypeOf((*peer.ID)(nil)).Elem(),
+		reflect.TypeOf((*ma.Multiaddr)(nil)).Elem())
+}
+
+// SubscribeP2PPeerAddr subscribes fn to eventbus.TopicP2PPeerAddr
+func SubscribeP2PPeerAddr(bus eventbus.BusSubscriber, fn func(peer.ID, ma.Multiaddr)) error {
+	return bus.Subscribe(eventbus.TopicP2PPeerAddr, fn)
+}
+
+// SubscribeP2PPeerAddrAsync subscribes fn to eventbus.TopicP2PPeerAddr, called
+// asynchronously, serially if transactional
+func SubscribeP2PPeerAddrAsync(bus eventbus.BusSubscriber, fn func(peer.ID, ma.Multiaddr), transactional bool) error {
+	return bus.SubscribeAsync(eventbus.TopicP2PPeerAddr, fn, transactional)
+}
+
+// SubscribeP2PPeerAddrQueued subscribes fn to eventbus.TopicP2PPeerAddr, called
+// in the goroutine of queue
+func SubscribeP2PPeerAddrQueued(bus eventbus.BusSubscriber, fn func(peer.ID, ma.Multiaddr), queue *eventbus.Queue) error {
+	return bus.SubscribeQueued(eventbus.TopicP2PPeerAddr, fn, queue)
+}
+
+// UnsubscribeP2PPeerAddr unsubscribes fn from eventbus.TopicP2PPeerAddr
+func UnsubscribeP2PPeerAddr(bus eventbus.BusSubscriber, fn func(peer.ID, ma.Multiaddr)) error {
+	return bus.Unsubscribe(eventbus.TopicP2PPeerAddr, fn)
+}
+
+// PublishP2PPeerAddr publishes on eventbus.TopicP2PPeerAddr
+func PublishP2PPeerAddr(bus eventbus.BusPublisher, pid peer.ID, addr ma.Multiaddr) {
+	bus.Publish(eventbus.TopicP2PPeerAddr, pid, addr)
+}
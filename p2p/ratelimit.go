@@ -0,0 +1,76 @@
+// Copyright (c) 2018 ContentBox Authors.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package p2p
+
+import (
+	"sync"
+	"time"
+
+	"github.com/jbenet/goprocess"
+)
+
+// rateLimiter is a token bucket limiting bytes per second, bursting up to
+// one second of traffic. Messages may overdraw it, so ones larger than the
+// burst still pass, and the traffic after waits until it's repaid. A nil
+// rateLimiter is unlimited
+type rateLimiter struct {
+	mutex  sync.Mutex
+	rate   float64
+	tokens float64
+	last   time.Time
+}
+
+// newRateLimiter returns a rateLimiter of rate bytes per second, or nil if
+// rate is 0
+func newRateLimiter(rate uint64) *rateLimiter {
+	if rate == 0 {
+		return nil
+	}
+	return &rateLimiter{rate: float64(rate), tokens: float64(rate), last: time.Now()}
+}
+
+// reserve takes n bytes at now, returning how long to wait before they are
+// sent or received
+func (l *rateLimiter) reserve(n int, now time.Time) time.Duration {
+	if l == nil {
+		return 0
+	}
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	if elapsed := now.Sub(l.last); elapsed > 0 {
+		l.tokens += elapsed.Seconds() * l.rate
+		if l.tokens > l.rate {
+			l.tokens = l.rate
+		}
+		l.last = now
+	}
+	wait := time.Duration(0)
+	if l.tokens < 0 {
+		wait = time.Duration(-l.tokens / l.rate * float64(time.Second))
+	}
+	l.tokens -= float64(n)
+	return wait
+}
+
+// throttle takes n bytes of a message with priority from limiters, and waits
+// until they are allowed or proc closes. Top priority messages, e.g. block
+// relay, are counted but never delayed, so that they aren't starved by bulk
+// sync traffic
+func throttle(proc goprocess.Process, n int, priority uint8, limiters ...*rateLimiter) {
+	now := time.Now()
+	wait := time.Duration(0)
+	for _, l := range limiters {
+		if d := l.reserve(n, now); d > wait {
+			wait = d
+		}
+	}
+	if wait == 0 || priority == topPriority {
+		return
+	}
+	select {
+	case <-time.After(wait):
+	case <-proc.Closing():
+	}
+}
@@ -0,0 +1,31 @@
+// Copyright (c) 2018 ContentBox Authors.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package p2p
+
+import (
+	"testing"
+	"time"
+
+	"github.com/facebookgo/ensure"
+)
+
+func TestRateLimiter(t *testing.T) {
+	var unlimited *rateLimiter
+	ensure.DeepEqual(t, unlimited.reserve(1<<20, time.Now()), time.Duration(0))
+	ensure.True(t, newRateLimiter(0) == nil)
+
+	l := newRateLimiter(1000)
+	now := l.last
+	// bursts up to the rate, overdrawing with the last one
+	ensure.DeepEqual(t, l.reserve(600, now), time.Duration(0))
+	ensure.DeepEqual(t, l.reserve(900, now), time.Duration(0))
+	// waits until overdrawn 500 bytes are repaid
+	ensure.DeepEqual(t, l.reserve(100, now), 500*time.Millisecond)
+	// repaid over time
+	ensure.DeepEqual(t, l.reserve(100, now.Add(time.Second)), time.Duration(0))
+	// never accumulates beyond the burst
+	l.reserve(0, now.Add(time.Hour))
+	ensure.DeepEqual(t, l.tokens, float64(1000))
+}
@@ -0,0 +1,64 @@
+// Copyright (c) 2018 ContentBox Authors.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package p2p
+
+import (
+	"sort"
+
+	peer "github.com/libp2p/go-libp2p-peer"
+)
+
+// relayOrder returns conns to relay to, ordered by scores desc. Conns
+// scoring below dropScore, close to be banned, are left out, and those from
+// the returned index on score negative to be deprioritized
+func relayOrder(conns []*Conn, score func(peer.ID) int64, dropScore int64) ([]*Conn, int) {
+	scores := make(map[*Conn]int64, len(conns))
+	var ordered []*Conn
+	for _, conn := range conns {
+		s := score(conn.remotePeer)
+		if s < dropScore {
+			continue
+		}
+		scores[conn] = s
+		ordered = append(ordered, conn)
+	}
+	sort.SliceStable(ordered, func(i, j int) bool {
+		return scores[ordered[i]] > scores[ordered[j]]
+	})
+	low := sort.Search(len(ordered), func(i int) bool {
+		return scores[ordered[i]] < 0
+	})
+	return ordered, low
+}
+
+// relay sends message to connected peers by their scores, so that high
+// scoring peers get new blocks first, negative scoring ones after with low
+// priority, and peers close to be banned not at all
+func (p *BoxPeer) relay(code uint32, body []byte) {
+	var conns []*Conn
+	p.conns.Range(func(k, v interface{}) bool {
+		if conn := v.(*Conn); conn.remotePeer != p.id {
+			conns = append(conns, conn)
+		}
+		return true
+	})
+	ordered, low := relayOrder(conns, p.scoremgr.Score, p.config.banScoreThreshold()/2)
+	if dropped := len(conns) - len(ordered); dropped > 0 {
+		logger.Debugf("Skip relaying message %02x to %d low scoring peers", code, dropped)
+	}
+	go func() {
+		for i, conn := range ordered {
+			var err error
+			if i < low {
+				err = conn.Write(code, body)
+			} else {
+				err = conn.writeWithPriority(code, body, lowPriority)
+			}
+			if err != nil {
+				logger.Warnf("Failed to relay message %02x to peer %s: %v", code, conn.remotePeer.Pretty(), err)
+			}
+		}
+	}()
+}
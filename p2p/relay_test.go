@@ -0,0 +1,40 @@
+// Copyright (c) 2018 ContentBox Authors.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package p2p
+
+import (
+	"testing"
+
+	"github.com/facebookgo/ensure"
+	peer "github.com/libp2p/go-libp2p-peer"
+)
+
+func TestRelayOrder(t *testing.T) {
+	var conns []*Conn
+	scores := make(map[peer.ID]int64)
+	for i, id := range []string{
+		testPeerID,
+		"QmPbXnwgNDMYTPhrzyGzcQBkWGBkAhxmXB3w4EQgFVTTxr",
+		"QmYyQSo1c1Ym7orWxLYvCrM2EmxFTANf8wXmmE7DWjhx5N",
+		"QmVjYrCGiysX3FjeqyVWTCtgTrnYWdBkkPwFWrDMGRMhSy",
+	} {
+		pid, _ := peer.IDB58Decode(id)
+		conns = append(conns, &Conn{remotePeer: pid})
+		scores[pid] = []int64{-100, 200, -300, 50}[i]
+	}
+	score := func(pid peer.ID) int64 { return scores[pid] }
+
+	ordered, low := relayOrder(conns, score, -250)
+	ensure.DeepEqual(t, ordered, []*Conn{conns[1], conns[3], conns[0]})
+	ensure.DeepEqual(t, low, 2)
+
+	ordered, low = relayOrder(conns, score, -1000)
+	ensure.DeepEqual(t, ordered, []*Conn{conns[1], conns[3], conns[0], conns[2]})
+	ensure.DeepEqual(t, low, 2)
+
+	ordered, low = relayOrder(nil, score, -250)
+	ensure.DeepEqual(t, len(ordered), 0)
+	ensure.DeepEqual(t, low, 0)
+}
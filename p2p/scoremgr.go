@@ -13,6 +13,14 @@ import (
 	"github.com/BOXFoundation/boxd/p2p/pscore"
 	"github.com/jbenet/goprocess"
 	peer "github.com/libp2p/go-libp2p-peer"
+	peerstore "github.com/libp2p/go-libp2p-peerstore"
+)
+
+const (
+	// peerScoreKey is the peer metadata key scores are persisted under
+	peerScoreKey = "score"
+	// scoreSaveInterval is the interval scores are persisted in
+	scoreSaveInterval = 5 * time.Minute
 )
 
 // peerConnScore is used for peer.Gc to score the conn
@@ -26,18 +34,23 @@ type ScoreManager struct {
 	scores *sync.Map
 	bus    eventbus.Bus
 	peer   *BoxPeer
+	md     peerstore.PeerMetadata
+	params *pscore.Params
 	Mutex  sync.Mutex
 	proc   goprocess.Process
 }
 
-// NewScoreManager returns new ScoreManager.
-func NewScoreManager(parent goprocess.Process, bus eventbus.Bus, boxPeer *BoxPeer) *ScoreManager {
+// NewScoreManager returns new ScoreManager. Scores are persisted in md, and
+// restored when peers show up again.
+func NewScoreManager(parent goprocess.Process, bus eventbus.Bus, boxPeer *BoxPeer, md peerstore.PeerMetadata) *ScoreManager {
 	scoreMgr := new(ScoreManager)
 	scoreMgr.scores = new(sync.Map)
 	scoreMgr.bus = bus
 	scoreMgr.peer = boxPeer
+	scoreMgr.md = md
+	scoreMgr.params = pscore.NewParams(&boxPeer.config.Score)
 
-	scoreMgr.bus.Subscribe(eventbus.TopicConnEvent, scoreMgr.record)
+	SubscribeConnEvent(scoreMgr.bus, scoreMgr.record)
 	scoreMgr.run(parent)
 
 	return scoreMgr
@@ -47,11 +60,16 @@ func (sm *ScoreManager) run(parent goprocess.Process) {
 	sm.proc = parent.Go(func(p goprocess.Process) {
 		loopTicker := time.NewTicker(pscore.ConnCleanupLoopInterval)
 		defer loopTicker.Stop()
+		saveTicker := time.NewTicker(scoreSaveInterval)
+		defer saveTicker.Stop()
 		for {
 			select {
 			case <-loopTicker.C:
 				sm.clearUp()
+			case <-saveTicker.C:
+				sm.save()
 			case <-p.Closing():
+				sm.save()
 				logger.Info("Quit score manager loop.")
 				return
 			}
@@ -60,32 +78,67 @@ func (sm *ScoreManager) run(parent goprocess.Process) {
 }
 
 func (sm *ScoreManager) record(pid peer.ID, event eventbus.BusEvent) {
-	peerScore, _ := sm.scores.Load(pid)
-	if peerScore == nil {
-		peerScore = pscore.NewDynamicPeerScore(pid)
-		sm.scores.Store(pid, peerScore)
+	sm.peerScore(pid).Record(event)
+}
+
+// peerScore returns the score of pid, restoring it if persisted
+func (sm *ScoreManager) peerScore(pid peer.ID) *pscore.DynamicPeerScore {
+	if peerScore, ok := sm.scores.Load(pid); ok {
+		return peerScore.(*pscore.DynamicPeerScore)
+	}
+	peerScore := pscore.NewDynamicPeerScore(pid, sm.params)
+	if val, err := sm.md.Get(pid, peerScoreKey); err == nil {
+		if state, ok := val.(pscore.ScoreState); ok {
+			peerScore = pscore.NewDynamicPeerScoreFromState(pid, state, sm.params)
+		}
+	}
+	actual, _ := sm.scores.LoadOrStore(pid, peerScore)
+	return actual.(*pscore.DynamicPeerScore)
+}
+
+// save persists scores of all peers seen
+func (sm *ScoreManager) save() {
+	sm.scores.Range(func(k, v interface{}) bool {
+		pid := k.(peer.ID)
+		if err := sm.md.Put(pid, peerScoreKey, v.(*pscore.DynamicPeerScore).State()); err != nil {
+			logger.Warnf("Failed to save score of peer %s: %v", pid.Pretty(), err)
+		}
+		return true
+	})
+}
+
+// Score returns the current score of peer pid
+func (sm *ScoreManager) Score(pid peer.ID) int64 {
+	peerScore, ok := sm.scores.Load(pid)
+	if !ok {
+		return 0
 	}
-	peerScore.(*pscore.DynamicPeerScore).Record(event)
+	return peerScore.(*pscore.DynamicPeerScore).Score(time.Now())
 }
 
-// clearUp close the lowest grade peers' conn on time when conn pool is almost full
+// clearUp bans peers scoring too low, and close the lowest grade peers' conn
+// on time when conn pool is almost full
 func (sm *ScoreManager) clearUp() {
 	var queue []peerConnScore
 	t := time.Now()
 	sm.peer.conns.Range(func(k, v interface{}) bool {
 		pid := k.(peer.ID)
 		conn := v.(*Conn)
-		peerScore, _ := sm.scores.Load(pid)
-		// to prevent nil when no msg receive but ticker arrive
-		if peerScore == nil {
-			peerScore = pscore.NewDynamicPeerScore(pid)
-			sm.scores.Store(pid, peerScore)
+		// protected peers are never disconnected for their scores
+		if sm.peer.isProtected(pid, conn.RemoteAddr()) {
+			return true
 		}
+		// the score is created when no msg receive but ticker arrive
+		peerScore := sm.peerScore(pid)
 
 		connScore := peerConnScore{
-			score: peerScore.(*pscore.DynamicPeerScore).Score(t),
+			score: peerScore.Score(t),
 			conn:  conn,
 		}
+		if connScore.score < sm.peer.config.banScoreThreshold() {
+			sm.peer.banMisbehavingPeer(conn, connScore.score)
+			return true
+		}
 		queue = append(queue, connScore)
 		return true
 	})
@@ -0,0 +1,89 @@
+// Copyright (c) 2018 ContentBox Authors.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package p2p
+
+import (
+	"sync/atomic"
+	"time"
+)
+
+// drainTimeout bounds the wait for queued messages to be flushed to peers
+// on stop
+const drainTimeout = 5 * time.Second
+
+// stopping checks if the node is stopping, neither accepting connections
+// nor queueing messages
+func (p *BoxPeer) stopping() bool {
+	return atomic.LoadInt32(&p.stopped) != 0
+}
+
+// drain stops conns from queueing messages, flushes queued ones followed by
+// a disconnect notice, and closes their streams, within drainTimeout
+func (p *BoxPeer) drain() {
+	var conns []*Conn
+	var dones []<-chan struct{}
+	p.conns.Range(func(k, v interface{}) bool {
+		conn := v.(*Conn)
+		conns = append(conns, conn)
+		dones = append(dones, conn.drain())
+		return true
+	})
+	timeout := time.After(drainTimeout)
+wait:
+	for i, done := range dones {
+		select {
+		case <-done:
+		case <-timeout:
+			logger.Warnf("Timeout flushing messages to %d peers", len(dones)-i)
+			break wait
+		}
+	}
+	// closing streams unblocks reads of conn loops for them to quit
+	for _, conn := range conns {
+		conn.Close()
+	}
+}
+
+// draining checks if conn neither queues nor handles messages any more
+func (conn *Conn) draining() bool {
+	return atomic.LoadInt32(&conn.drained) != 0
+}
+
+// drain stops conn from queueing messages and queues a disconnect notice
+// behind queued messages, returning a channel closed once it's sent
+func (conn *Conn) drain() <-chan struct{} {
+	done := make(chan struct{})
+	if !atomic.CompareAndSwapInt32(&conn.drained, 0, 1) {
+		close(done)
+		return done
+	}
+	conn.mutex.Lock()
+	running := conn.proc != nil && conn.stream != nil
+	conn.mutex.Unlock()
+	if !running {
+		close(done)
+		return done
+	}
+	data, err := newMessageData(conn.peer.config.Magic, DisconnectMsg, nil, nil).Marshal()
+	if err != nil {
+		close(done)
+		return done
+	}
+	// lowest priority for the notice to be sent after all queued messages
+	msg := &outMessage{code: DisconnectMsg, data: data, priority: lowPriority, sent: done}
+	if err := conn.pq.Push(msg, int(lowPriority)); err != nil {
+		close(done)
+	}
+	return done
+}
+
+// OnDisconnect handles the disconnect notice of remote peer leaving
+// gracefully, not to be taken as an unsteady connection
+func (conn *Conn) OnDisconnect() error {
+	conn.mutex.Lock()
+	conn.remoteLeft = true
+	conn.mutex.Unlock()
+	return ErrPeerLeft
+}
@@ -0,0 +1,33 @@
+// Copyright (c) 2018 ContentBox Authors.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package p2p
+
+import (
+	"testing"
+
+	"github.com/facebookgo/ensure"
+)
+
+func TestConnDrain(t *testing.T) {
+	conn := &Conn{}
+	ensure.False(t, conn.draining())
+
+	// nothing to flush for a conn not running
+	<-conn.drain()
+	ensure.True(t, conn.draining())
+	<-conn.drain()
+	ensure.DeepEqual(t, conn.Write(TransactionMsg, []byte{}), ErrConnDraining)
+
+	// messages but the disconnect notice are dropped while draining
+	msg := &remoteMessage{message: newMessageData(Mainnet, NewBlockMsg, nil, nil)}
+	ensure.Nil(t, conn.Handle(msg))
+}
+
+func TestOnDisconnect(t *testing.T) {
+	conn := &Conn{}
+	msg := &remoteMessage{message: newMessageData(Mainnet, DisconnectMsg, nil, nil)}
+	ensure.DeepEqual(t, conn.Handle(msg), ErrPeerLeft)
+	ensure.True(t, conn.remoteLeft)
+}
@@ -7,6 +7,7 @@ package p2p
 import (
 	"math"
 	"math/rand"
+	"net"
 	"time"
 
 	"github.com/BOXFoundation/boxd/p2p/pb"
@@ -29,6 +30,7 @@ const (
 type Table struct {
 	peerStore  peerstore.Peerstore
 	routeTable *kbucket.RoutingTable
+	addrmgr    *addrManager
 	peer       *BoxPeer
 	proc       goprocess.Process
 }
@@ -38,6 +40,7 @@ func NewTable(peer *BoxPeer) *Table {
 
 	table := &Table{
 		peerStore: peer.host.Peerstore(),
+		addrmgr:   newAddrManager(),
 		peer:      peer,
 	}
 	table.routeTable = kbucket.NewRoutingTable(
@@ -56,6 +59,12 @@ func NewTable(peer *BoxPeer) *Table {
 // Loop for discover new peer.
 func (t *Table) Loop(parent goprocess.Process) {
 	var cnt float64
+	// peers known before, e.g. restored from database, are to discover too
+	for _, pid := range t.peerStore.Peers() {
+		if addrs := t.peerStore.Addrs(pid); len(addrs) > 0 && pid != t.peer.id {
+			t.addrmgr.add(pid, addrs[0], nil)
+		}
+	}
 	t.peerDiscover()
 	t.proc = parent.Go(func(p goprocess.Process) {
 		interval := time.Duration(calcTimeInterval(cnt) * 1000)
@@ -87,37 +96,27 @@ func calcTimeInterval(val float64) float64 {
 
 func (t *Table) peerDiscover() {
 	logger.Info("do peer discover")
-	var all peer.IDSlice
-	for _, p := range t.peerStore.Peers() {
-		// skip peer without address
-		if len(t.peerStore.Addrs(p)) > 0 {
-			all = append(all, p)
-		}
-	}
-	// TODO check peer score
-	if len(all) <= MaxPeerCountToSyncRouteTable {
-		// TODO sort by peer score
-		for _, v := range all {
-			go t.lookup(v)
-		}
-		return
-	}
-
-	// Randomly select some peer to do sync routes from the established and unconnected peers
-	// 3/4 from established peers, and 1/4 from unconnected peers
 	var establishedID []peer.ID
 	t.peer.conns.Range(func(k, v interface{}) bool {
 		establishedID = append(establishedID, k.(peer.ID))
 		return true
 	})
 
-	var unestablishedID []peer.ID
-	for _, v := range all {
-		if !util.InArray(v, establishedID) {
-			unestablishedID = append(unestablishedID, v)
+	// unconnected peers are picked by the address manager across ip groups,
+	// leaving out groups connected too many times
+	unestablishedID := t.addrmgr.pick(MaxPeerCountToSyncRouteTable, func(pid peer.ID, addr ma.Multiaddr) bool {
+		return pid == t.peer.id || util.InArray(pid, establishedID) ||
+			t.peer.isRejected(pid, addr) || t.peer.groupFull(addrIP(addr))
+	})
+	if len(establishedID)+len(unestablishedID) <= MaxPeerCountToSyncRouteTable {
+		for _, v := range append(establishedID, unestablishedID...) {
+			go t.lookup(v)
 		}
+		return
 	}
 
+	// Randomly select some peer to do sync routes from the established and unconnected peers
+	// 3/4 from established peers, and 1/4 from unconnected peers
 	var peerIDs []peer.ID
 	if len(unestablishedID) < MaxPeerCountToSyncRouteTable/4 {
 		peerIDs = append(peerIDs, unestablishedID...)
@@ -145,6 +144,10 @@ func (t *Table) lookup(pid peer.ID) {
 		conn = c.(*Conn)
 	} else {
 		// unestablished peer
+		if t.peer.outboundFull() {
+			return
+		}
+		t.addrmgr.attempt(pid)
 		conn = NewConn(nil, t.peer, pid)
 		conn.Loop(t.peer.proc)
 	}
@@ -186,12 +189,13 @@ func (t *Table) AddPeers(conn *Conn, peers *p2ppb.Peers) {
 		logger.Errorf("Add too many peers. peers num: %d", len(peers.Peers))
 		conn.Close()
 	}
+	source := addrIP(conn.RemoteAddr())
 	for _, v := range peers.Peers {
-		t.addPeerInfo(v.Id, v.Addrs)
+		t.addPeerInfo(v.Id, v.Addrs, source)
 	}
 }
 
-func (t *Table) addPeerInfo(prettyID string, addrStr []string) error {
+func (t *Table) addPeerInfo(prettyID string, addrStr []string, source net.IP) error {
 	pid, err := peer.IDB58Decode(prettyID)
 	if err != nil {
 		return nil
@@ -211,6 +215,9 @@ func (t *Table) addPeerInfo(prettyID string, addrStr []string) error {
 
 	}
 	t.routeTable.Update(pid)
+	if len(addrs) > 0 {
+		t.addrmgr.add(pid, addrs[0], source)
+	}
 
 	return nil
 }
@@ -0,0 +1,130 @@
+// Code generated by gentopics. DO NOT EDIT.
+
+package p2p
+
+import (
+	"reflect"
+
+	"github.com/BOXFoundation/boxd/boxd/eventbus"
+	peer "github.com/libp2p/go-libp2p-peer"
+)
+
+func init() {
+	eventbus.RegisterTopic(eventbus.TopicConnEvent,
+		reflect.TypeOf((*peer.ID)(nil)).Elem(),
+		reflect.TypeOf((*eventbus.BusEvent)(nil)).Elem())
+	eventbus.RegisterTopic(eventbus.TopicTrafficAnomaly,
+		reflect.TypeOf((*TrafficAnomaly)(nil)).Elem())
+	eventbus.RegisterTopic(eventbus.TopicPeerBanned,
+		reflect.TypeOf((*Ban)(nil)).Elem())
+	eventbus.RegisterTopic(eventbus.TopicPeerUnbanned,
+		reflect.TypeOf((*string)(nil)).Elem())
+}
+
+// SubscribeConnEvent subscribes fn to eventbus.TopicConnEvent
+func SubscribeConnEvent(bus eventbus.BusSubscriber, fn func(peer.ID, eventbus.BusEvent)) error {
+	return bus.Subscribe(eventbus.TopicConnEvent, fn)
+}
+
+// SubscribeConnEventAsync subscribes fn to eventbus.TopicConnEvent, called
+// asynchronously, serially if transactional
+func SubscribeConnEventAsync(bus eventbus.BusSubscriber, fn func(peer.ID, eventbus.BusEvent), transactional bool) error {
+	return bus.SubscribeAsync(eventbus.TopicConnEvent, fn, transactional)
+}
+
+// SubscribeConnEventQueued subscribes fn to eventbus.TopicConnEvent, called
+// in the goroutine of queue
+func SubscribeConnEventQueued(bus eventbus.BusSubscriber, fn func(peer.ID, eventbus.BusEvent), queue *eventbus.Queue) error {
+	return bus.SubscribeQueued(eventbus.TopicConnEvent, fn, queue)
+}
+
+// UnsubscribeConnEvent unsubscribes fn from eventbus.TopicConnEvent
+func UnsubscribeConnEvent(bus eventbus.BusSubscriber, fn func(peer.ID, eventbus.BusEvent)) error {
+	return bus.Unsubscribe(eventbus.TopicConnEvent, fn)
+}
+
+// PublishConnEvent publishes on eventbus.TopicConnEvent
+func PublishConnEvent(bus eventbus.BusPublisher, pid peer.ID, event eventbus.BusEvent) {
+	bus.Publish(eventbus.TopicConnEvent, pid, event)
+}
+
+// SubscribeTrafficAnomaly subscribes fn to eventbus.TopicTrafficAnomaly
+func SubscribeTrafficAnomaly(bus eventbus.BusSubscriber, fn func(TrafficAnomaly)) error {
+	return bus.Subscribe(eventbus.TopicTrafficAnomaly, fn)
+}
+
+// SubscribeTrafficAnomalyAsync subscribes fn to eventbus.TopicTrafficAnomaly, called
+// asynchronously, serially if transactional
+func SubscribeTrafficAnomalyAsync(bus eventbus.BusSubscriber, fn func(TrafficAnomaly), transactional bool) error {
+	return bus.SubscribeAsync(eventbus.TopicTrafficAnomaly, fn, transactional)
+}
+
+// SubscribeTrafficAnomalyQueued subscribes fn to eventbus.TopicTrafficAnomaly, called
+// in the goroutine of queue
+func SubscribeTrafficAnomalyQueued(bus eventbus.BusSubscriber, fn func(TrafficAnomaly), queue *eventbus.Queue) error {
+	return bus.SubscribeQueued(eventbus.TopicTrafficAnomaly, fn, queue)
+}
+
+// UnsubscribeTrafficAnomaly unsubscribes fn from eventbus.TopicTrafficAnomaly
+func UnsubscribeTrafficAnomaly(bus eventbus.BusSubscriber, fn func(TrafficAnomaly)) error {
+	return bus.Unsubscribe(eventbus.TopicTrafficAnomaly, fn)
+}
+
+// PublishTrafficAnomaly publishes on eventbus.TopicTrafficAnomaly
+func PublishTrafficAnomaly(bus eventbus.BusPublisher, anomaly TrafficAnomaly) {
+	bus.Publish(eventbus.TopicTrafficAnomaly, anomaly)
+}
+
+// SubscribePeerBanned subscribes fn to eventbus.TopicPeerBanned
+func SubscribePeerBanned(bus eventbus.BusSubscriber, fn func(Ban)) error {
+	return bus.Subscribe(eventbus.TopicPeerBanned, fn)
+}
+
+// SubscribePeerBannedAsync subscribes fn to eventbus.TopicPeerBanned, called
+// asynchronously, serially if transactional
+func SubscribePeerBannedAsync(bus eventbus.BusSubscriber, fn func(Ban), transactional bool) error {
+	return bus.SubscribeAsync(eventbus.TopicPeerBanned, fn, transactional)
+}
+
+// SubscribePeerBannedQueued subscribes fn to eventbus.TopicPeerBanned, called
+// in the goroutine of queue
+func SubscribePeerBannedQueued(bus eventbus.BusSubscriber, fn func(Ban), queue *eventbus.Queue) error {
+	return bus.SubscribeQueued(eventbus.TopicPeerBanned, fn, queue)
+}
+
+// UnsubscribePeerBanned unsubscribes fn from eventbus.TopicPeerBanned
+func UnsubscribePeerBanned(bus eventbus.BusSubscriber, fn func(Ban)) error {
+	return bus.Unsubscribe(eventbus.TopicPeerBanned, fn)
+}
+
+// PublishPeerBanned publishes on eventbus.TopicPeerBanned
+func PublishPeerBanned(bus eventbus.BusPublisher, ban Ban) {
+	bus.Publish(eventbus.TopicPeerBanned, ban)
+}
+
+// SubscribePeerUnbanned subscribes fn to eventbus.TopicPeerUnbanned
+func SubscribePeerUnbanned(bus eventbus.BusSubscriber, fn func(string)) error {
+	return bus.Subscribe(eventbus.TopicPeerUnbanned, fn)
+}
+
+// SubscribePeerUnbannedAsync subscribes fn to eventbus.TopicPeerUnbanned, called
+// asynchronously, serially if transactional
+func SubscribePeerUnbannedAsync(bus eventbus.BusSubscriber, fn func(string), transactional bool) error {
+	return bus.SubscribeAsync(eventbus.TopicPeerUnbanned, fn, transactional)
+}
+
+// SubscribePeerUnbannedQueued subscribes fn to eventbus.TopicPeerUnbanned, called
+// in the goroutine of queue
+func SubscribePeerUnbannedQueued(bus eventbus.BusSubscriber, fn func(string), queue *eventbus.Queue) error {
+	return bus.SubscribeQueued(eventbus.TopicPeerUnbanned, fn, queue)
+}
+
+// UnsubscribePeerUnbanned unsubscribes fn from eventbus.TopicPeerUnbanned
+func UnsubscribePeerUnbanned(bus eventbus.BusSubscriber, fn func(string)) error {
+	return bus.Unsubscribe(eventbus.TopicPeerUnbanned, fn)
+}
+
+// PublishPeerUnbanned publishes on eventbus.TopicPeerUnbanned
+func PublishPeerUnbanned(bus eventbus.BusPublisher, target string) {
+	bus.Publish(eventbus.TopicPeerUnbanned, target)
+}
@@ -0,0 +1,136 @@
+// Copyright (c) 2018 ContentBox Authors.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package p2p
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	metrics "github.com/BOXFoundation/boxd/metrics"
+	peer "github.com/libp2p/go-libp2p-peer"
+)
+
+//go:generate go run ../boxd/eventbus/gentopics -imports peer=github.com/libp2p/go-libp2p-peer ConnEvent=pid:peer.ID,event:eventbus.BusEvent TrafficAnomaly=anomaly:TrafficAnomaly PeerBanned=ban:Ban PeerUnbanned=target:string
+
+// trafficWindow is the period over which traffic received from a peer is
+// checked against anomaly thresholds
+const trafficWindow = time.Minute
+
+// MsgTraffic is the traffic of messages with a code exchanged with a peer
+type MsgTraffic struct {
+	Code      uint32
+	MsgsSent  uint64
+	BytesSent uint64
+	MsgsRecv  uint64
+	BytesRecv uint64
+}
+
+// TrafficAnomaly is published on eventbus.TopicTrafficAnomaly when traffic
+// received from a peer within trafficWindow exceeds thresholds
+type TrafficAnomaly struct {
+	ID    peer.ID
+	Msgs  uint64
+	Bytes uint64
+}
+
+// trafficStats accounts traffic with a peer by message code
+type trafficStats struct {
+	mutex sync.Mutex
+	codes map[uint32]*MsgTraffic
+
+	// received in the current window, and whether it's warned of
+	windowStart time.Time
+	windowMsgs  uint64
+	windowBytes uint64
+	warned      bool
+}
+
+func newTrafficStats() *trafficStats {
+	return &trafficStats{codes: make(map[uint32]*MsgTraffic)}
+}
+
+func (s *trafficStats) traffic(code uint32) *MsgTraffic {
+	t, ok := s.codes[code]
+	if !ok {
+		t = &MsgTraffic{Code: code}
+		s.codes[code] = t
+	}
+	return t
+}
+
+// sent accounts a message with code of n bytes sent
+func (s *trafficStats) sent(code uint32, n int) {
+	s.mutex.Lock()
+	t := s.traffic(code)
+	t.MsgsSent++
+	t.BytesSent += uint64(n)
+	s.mutex.Unlock()
+	prefix := trafficMetricsPrefix(code)
+	metrics.NewCounter(prefix + ".sent.msgs").Inc(1)
+	metrics.NewMeter(prefix + ".sent.bytes").Mark(int64(n))
+}
+
+// recv accounts a message with code of n bytes received at now. It returns
+// true once per window when messages or bytes in the window exceed maxMsgs
+// or maxBytes, zero meaning no limit
+func (s *trafficStats) recv(code uint32, n int, now time.Time, maxMsgs, maxBytes uint64) bool {
+	prefix := trafficMetricsPrefix(code)
+	metrics.NewCounter(prefix + ".recv.msgs").Inc(1)
+	metrics.NewMeter(prefix + ".recv.bytes").Mark(int64(n))
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	t := s.traffic(code)
+	t.MsgsRecv++
+	t.BytesRecv += uint64(n)
+
+	if now.Sub(s.windowStart) >= trafficWindow {
+		s.windowStart, s.windowMsgs, s.windowBytes, s.warned = now, 0, 0, false
+	}
+	s.windowMsgs++
+	s.windowBytes += uint64(n)
+	if s.warned {
+		return false
+	}
+	s.warned = (maxMsgs != 0 && s.windowMsgs > maxMsgs) || (maxBytes != 0 && s.windowBytes > maxBytes)
+	return s.warned
+}
+
+// window returns messages and bytes received in the current window
+func (s *trafficStats) window() (uint64, uint64) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return s.windowMsgs, s.windowBytes
+}
+
+// snapshot returns traffic by message code, ordered by code
+func (s *trafficStats) snapshot() []MsgTraffic {
+	s.mutex.Lock()
+	traffic := make([]MsgTraffic, 0, len(s.codes))
+	for _, t := range s.codes {
+		traffic = append(traffic, *t)
+	}
+	s.mutex.Unlock()
+	sort.Slice(traffic, func(i, j int) bool { return traffic[i].Code < traffic[j].Code })
+	return traffic
+}
+
+func trafficMetricsPrefix(code uint32) string {
+	return fmt.Sprintf("box.p2p.msg.%02x", code)
+}
+
+// accountRecv accounts a message with code of n bytes received, publishing
+// a traffic anomaly if the peer sends too much
+func (conn *Conn) accountRecv(code uint32, n int) {
+	config := conn.peer.config
+	if !conn.traffic.recv(code, n, time.Now(), config.TrafficWarnMsgs, config.TrafficWarnBytes) {
+		return
+	}
+	msgs, bytes := conn.traffic.window()
+	logger.Warnf("Peer %s sent %d messages of %d bytes within %v", conn.remotePeer.Pretty(), msgs, bytes, trafficWindow)
+	PublishTrafficAnomaly(conn.peer.bus, TrafficAnomaly{ID: conn.remotePeer, Msgs: msgs, Bytes: bytes})
+}
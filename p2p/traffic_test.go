@@ -0,0 +1,45 @@
+// Copyright (c) 2018 ContentBox Authors.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package p2p
+
+import (
+	"testing"
+	"time"
+
+	"github.com/facebookgo/ensure"
+)
+
+func TestTrafficStats(t *testing.T) {
+	s := newTrafficStats()
+	s.sent(TransactionMsg, 100)
+	s.sent(TransactionMsg, 50)
+	s.sent(Ping, 10)
+	now := time.Now()
+	s.recv(NewBlockMsg, 1000, now, 0, 0)
+
+	ensure.DeepEqual(t, s.snapshot(), []MsgTraffic{
+		{Code: Ping, MsgsSent: 1, BytesSent: 10},
+		{Code: NewBlockMsg, MsgsRecv: 1, BytesRecv: 1000},
+		{Code: TransactionMsg, MsgsSent: 2, BytesSent: 150},
+	})
+}
+
+func TestTrafficAnomaly(t *testing.T) {
+	s := newTrafficStats()
+	now := time.Now()
+	// warned once when messages exceed within a window
+	ensure.False(t, s.recv(TransactionMsg, 10, now, 2, 0))
+	ensure.False(t, s.recv(TransactionMsg, 10, now, 2, 0))
+	ensure.True(t, s.recv(TransactionMsg, 10, now, 2, 0))
+	ensure.False(t, s.recv(TransactionMsg, 10, now, 2, 0))
+	msgs, bytes := s.window()
+	ensure.DeepEqual(t, msgs, uint64(4))
+	ensure.DeepEqual(t, bytes, uint64(40))
+
+	// counted again in the next window
+	now = now.Add(trafficWindow)
+	ensure.False(t, s.recv(TransactionMsg, 10, now, 2, 0))
+	ensure.True(t, s.recv(TransactionMsg, 1000, now, 2, 500))
+}
@@ -0,0 +1,157 @@
+// Copyright (c) 2018 ContentBox Authors.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package p2p
+
+import (
+	"sync/atomic"
+
+	"github.com/BOXFoundation/boxd/p2p/pb"
+	proto "github.com/gogo/protobuf/proto"
+	peer "github.com/libp2p/go-libp2p-peer"
+)
+
+// ProtocolVersion is the version of p2p protocol the node speaks. Peers
+// speaking versions below MinProtocolVersion are rejected at handshake
+const (
+	ProtocolVersion    uint32 = 2
+	MinProtocolVersion uint32 = 2
+)
+
+// ServiceFlag is a bitmask of services a node provides to its peers
+type ServiceFlag uint64
+
+// services announced in version messages
+const (
+	// ServiceFullBlocks means serving full blocks to sync
+	ServiceFullBlocks ServiceFlag = 1 << iota
+	// ServiceFilters means serving bloom filtered blocks to light clients
+	ServiceFilters
+	// ServiceCompactRelay means relaying compact blocks
+	ServiceCompactRelay
+)
+
+// localServices are the services the node provides
+const localServices = ServiceFullBlocks | ServiceFilters
+
+// bestHeight is the height of the local chain tail, accessed atomically
+var bestHeight uint32
+
+// UpdateBestHeight updates the local chain height announced to peers
+func UpdateBestHeight(height uint32) {
+	atomic.StoreUint32(&bestHeight, height)
+}
+
+// PeerVersion is what a peer announces in the version handshake
+type PeerVersion struct {
+	ProtocolVersion uint32
+	Services        ServiceFlag
+	BestHeight      uint32
+}
+
+// HasServices checks if the peer provides all services
+func (v *PeerVersion) HasServices(services ServiceFlag) bool {
+	return v.Services&services == services
+}
+
+// checkVersion checks if a peer announcing v is compatible
+func checkVersion(v *p2ppb.Version) error {
+	if v.ProtocolVersion < MinProtocolVersion {
+		return ErrIncompatibleVersion
+	}
+	return nil
+}
+
+// sendVersion announces local version to the remote peer, once per conn
+func (conn *Conn) sendVersion() error {
+	conn.mutex.Lock()
+	sent := conn.versionSent
+	conn.versionSent = true
+	conn.mutex.Unlock()
+	if sent {
+		return nil
+	}
+	body, err := proto.Marshal(&p2ppb.Version{
+		ProtocolVersion: ProtocolVersion,
+		Services:        uint64(localServices),
+		BestHeight:      atomic.LoadUint32(&bestHeight),
+	})
+	if err != nil {
+		return err
+	}
+	return conn.Write(VersionMsg, body)
+}
+
+// OnVersion handle VersionMsg message. Incompatible peers are rejected,
+// otherwise the version is acknowledged, with local version if not sent yet
+func (conn *Conn) OnVersion(body []byte) error {
+	msg := new(p2ppb.Version)
+	if err := proto.Unmarshal(body, msg); err != nil {
+		return err
+	}
+	if err := checkVersion(msg); err != nil {
+		logger.Warnf("Reject peer %s with protocol version %d, lower than %d",
+			conn.remotePeer.Pretty(), msg.ProtocolVersion, MinProtocolVersion)
+		return err
+	}
+
+	conn.mutex.Lock()
+	conn.version = &PeerVersion{
+		ProtocolVersion: msg.ProtocolVersion,
+		Services:        ServiceFlag(msg.Services),
+		BestHeight:      msg.BestHeight,
+	}
+	conn.mutex.Unlock()
+
+	if err := conn.sendVersion(); err != nil {
+		return err
+	}
+	if err := conn.Write(VerAckMsg, []byte{}); err != nil {
+		return err
+	}
+	conn.tryEstablish()
+	return nil
+}
+
+// OnVerAck handle VerAckMsg message
+func (conn *Conn) OnVerAck(body []byte) error {
+	conn.mutex.Lock()
+	conn.verAcked = true
+	conn.mutex.Unlock()
+	conn.tryEstablish()
+	return nil
+}
+
+// tryEstablish establishes the connection once versions are exchanged both
+// ways, and starts heartbeats measuring latency then
+func (conn *Conn) tryEstablish() {
+	conn.mutex.Lock()
+	ready := conn.version != nil && conn.verAcked
+	conn.mutex.Unlock()
+	if !ready || conn.Establish() {
+		return
+	}
+	conn.mutex.Lock()
+	if conn.procHeartbeat == nil {
+		conn.procHeartbeat = conn.proc.Go(conn.heartBeatService)
+	}
+	conn.mutex.Unlock()
+}
+
+// Version returns the version the remote peer announced, or nil if not yet
+func (conn *Conn) Version() *PeerVersion {
+	conn.mutex.Lock()
+	defer conn.mutex.Unlock()
+	return conn.version
+}
+
+// PeerVersion returns the version connected peer pid announced
+func (p *BoxPeer) PeerVersion(pid peer.ID) (*PeerVersion, bool) {
+	c, ok := p.conns.Load(pid)
+	if !ok {
+		return nil, false
+	}
+	v := c.(*Conn).Version()
+	return v, v != nil
+}
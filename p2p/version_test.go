@@ -0,0 +1,25 @@
+// Copyright (c) 2018 ContentBox Authors.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package p2p
+
+import (
+	"testing"
+
+	"github.com/BOXFoundation/boxd/p2p/pb"
+	"github.com/facebookgo/ensure"
+)
+
+func TestCheckVersion(t *testing.T) {
+	ensure.Nil(t, checkVersion(&p2ppb.Version{ProtocolVersion: ProtocolVersion}))
+	ensure.DeepEqual(t, checkVersion(&p2ppb.Version{ProtocolVersion: MinProtocolVersion - 1}), ErrIncompatibleVersion)
+}
+
+func TestPeerVersionHasServices(t *testing.T) {
+	v := &PeerVersion{Services: ServiceFullBlocks | ServiceFilters}
+	ensure.True(t, v.HasServices(ServiceFullBlocks))
+	ensure.True(t, v.HasServices(ServiceFullBlocks|ServiceFilters))
+	ensure.False(t, v.HasServices(ServiceCompactRelay))
+	ensure.False(t, v.HasServices(ServiceFullBlocks|ServiceCompactRelay))
+}
@@ -203,7 +203,7 @@ func generateTx(fromAddr types.Address, utxos []*rpcpb.Utxo, targets []*Transfer
 				Index: utxo.GetOutPoint().GetIndex(),
 			},
 			ScriptSig: []byte{},
-			Sequence:  uint32(i),
+			Sequence:  types.SequenceFinal,
 		}
 		tokenInfo, amount := extractTokenInfo(utxo)
 		if tokenInfo != nil && amount > 0 {
@@ -335,7 +335,7 @@ func generateTokenIssueTransaction(issueScript []byte, utxos []*rpcpb.Utxo, chan
 				Index: utxo.GetOutPoint().GetIndex(),
 			},
 			ScriptSig: []byte{},
-			Sequence:  uint32(0),
+			Sequence:  types.SequenceFinal,
 		}
 	}
 	tx := &corepb.Transaction{}
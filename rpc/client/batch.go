@@ -0,0 +1,31 @@
+// Copyright (c) 2018 ContentBox Authors.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package client
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/BOXFoundation/boxd/rpc/pb"
+	"google.golang.org/grpc"
+)
+
+// Batch runs read-only calls in one round trip at one chain snapshot, it
+// returns results in the order of calls together with the snapshot height
+func Batch(conn *grpc.ClientConn, calls []*rpcpb.BatchCall) ([]*rpcpb.BatchResult, uint32, error) {
+	c := rpcpb.NewBatchCommandClient(conn)
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	r, err := c.Batch(ctx, &rpcpb.BatchRequest{Calls: calls})
+	if err != nil {
+		return nil, 0, err
+	}
+	if r.Code != 0 {
+		return nil, 0, errors.New(r.Message)
+	}
+	return r.Results, r.Height, nil
+}
@@ -0,0 +1,130 @@
+// Copyright (c) 2018 ContentBox Authors.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package client
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/BOXFoundation/boxd/rpc/pb"
+	"google.golang.org/grpc"
+)
+
+// RegisterCandidate registers an account unlocked in node wallet as a candidate
+func RegisterCandidate(conn *grpc.ClientConn, req *rpcpb.RegisterCandidateRequest) (*rpcpb.CandidateTxResponse, error) {
+	c := rpcpb.NewCandidateCommandClient(conn)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	r, err := c.RegisterCandidate(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if r.Code != 0 {
+		return nil, errors.New(r.Message)
+	}
+	return r, nil
+}
+
+// Vote casts votes for a candidate, paying fee from an account unlocked in
+// node wallet
+func Vote(conn *grpc.ClientConn, req *rpcpb.VoteRequest) (*rpcpb.CandidateTxResponse, error) {
+	c := rpcpb.NewCandidateCommandClient(conn)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	r, err := c.Vote(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if r.Code != 0 {
+		return nil, errors.New(r.Message)
+	}
+	return r, nil
+}
+
+// WithdrawVote withdraws votes from a candidate, paying fee from an account
+// unlocked in node wallet
+func WithdrawVote(conn *grpc.ClientConn, req *rpcpb.VoteRequest) (*rpcpb.CandidateTxResponse, error) {
+	c := rpcpb.NewCandidateCommandClient(conn)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	r, err := c.WithdrawVote(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if r.Code != 0 {
+		return nil, errors.New(r.Message)
+	}
+	return r, nil
+}
+
+// ProposeChainParams proposes chain parameters as a miner, paying fee from
+// the miner account unlocked in node wallet
+func ProposeChainParams(conn *grpc.ClientConn, req *rpcpb.ProposeChainParamsRequest) (*rpcpb.CandidateTxResponse, error) {
+	c := rpcpb.NewCandidateCommandClient(conn)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	r, err := c.ProposeChainParams(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if r.Code != 0 {
+		return nil, errors.New(r.Message)
+	}
+	return r, nil
+}
+
+// ListCandidates lists candidates with their votes, most voted first
+func ListCandidates(conn *grpc.ClientConn) (*rpcpb.ListCandidatesResponse, error) {
+	c := rpcpb.NewCandidateCommandClient(conn)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	r, err := c.ListCandidates(ctx, &rpcpb.ListCandidatesRequest{})
+	if err != nil {
+		return nil, err
+	}
+	if r.Code != 0 {
+		return nil, errors.New(r.Message)
+	}
+	return r, nil
+}
+
+// GetMinerSchedule returns miners of the current epoch with their slots
+func GetMinerSchedule(conn *grpc.ClientConn) (*rpcpb.GetMinerScheduleResponse, error) {
+	c := rpcpb.NewConsensusCommandClient(conn)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	r, err := c.GetMinerSchedule(ctx, &rpcpb.GetMinerScheduleRequest{})
+	if err != nil {
+		return nil, err
+	}
+	if r.Code != 0 {
+		return nil, errors.New(r.Message)
+	}
+	return r, nil
+}
+
+// GetMinerStats returns blocks each miner produced and was expected to produce
+// in the last epochs epochs, all kept by the node if epochs is 0
+func GetMinerStats(conn *grpc.ClientConn, epochs uint32) (*rpcpb.GetMinerStatsResponse, error) {
+	c := rpcpb.NewConsensusCommandClient(conn)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	r, err := c.GetMinerStats(ctx, &rpcpb.GetMinerStatsRequest{Epochs: epochs})
+	if err != nil {
+		return nil, err
+	}
+	if r.Code != 0 {
+		return nil, errors.New(r.Message)
+	}
+	return r, nil
+}
@@ -6,15 +6,19 @@ package client
 
 import (
 	"context"
+	"errors"
+	"io"
 	"time"
 
 	"github.com/BOXFoundation/boxd/core/types"
+	"github.com/BOXFoundation/boxd/metrics"
 	pb "github.com/BOXFoundation/boxd/rpc/pb"
 	"google.golang.org/grpc"
 )
 
-// SetDebugLevel calls the DebugLevel gRPC methods.
-func SetDebugLevel(conn *grpc.ClientConn, level string) error {
+// SetDebugLevel calls the DebugLevel gRPC methods. Level of module is set if
+// module is not empty, otherwise the global one
+func SetDebugLevel(conn *grpc.ClientConn, level, module string) error {
 
 	c := pb.NewContorlCommandClient(conn)
 
@@ -22,8 +26,8 @@ func SetDebugLevel(conn *grpc.ClientConn, level string) error {
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
 
-	logger.Infof("Set debug level %s", level)
-	r, err := c.SetDebugLevel(ctx, &pb.DebugLevelRequest{Level: level})
+	logger.Infof("Set debug level %s %s", level, module)
+	r, err := c.SetDebugLevel(ctx, &pb.DebugLevelRequest{Level: level, Module: module})
 	if err != nil {
 		return err
 	}
@@ -115,3 +119,220 @@ func GetBlock(conn *grpc.ClientConn, hash string) (*types.Block, error) {
 	err = block.FromProtoMessage(r.Block)
 	return block, err
 }
+
+// ConnectPeer connects the node to the peer at multiaddr addr
+func ConnectPeer(conn *grpc.ClientConn, addr string) error {
+	c := pb.NewContorlCommandClient(conn)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+	defer cancel()
+
+	logger.Infof("Connect to peer %s", addr)
+	r, err := c.ConnectPeer(ctx, &pb.ConnectPeerRequest{Addr: addr})
+	if err != nil {
+		return err
+	}
+	logger.Infof("Result: %d, Message: %s", r.Code, r.Message)
+	return nil
+}
+
+// DisconnectPeer disconnects the node from peer of id
+func DisconnectPeer(conn *grpc.ClientConn, id string) error {
+	c := pb.NewContorlCommandClient(conn)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	logger.Infof("Disconnect peer %s", id)
+	r, err := c.DisconnectPeer(ctx, &pb.DisconnectPeerRequest{PeerId: id})
+	if err != nil {
+		return err
+	}
+	logger.Infof("Result: %d, Message: %s", r.Code, r.Message)
+	return nil
+}
+
+// BanPeer bans a peer id, ip or subnet for duration
+func BanPeer(conn *grpc.ClientConn, target string, duration time.Duration) error {
+	c := pb.NewContorlCommandClient(conn)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	logger.Infof("Ban %s for %s", target, duration)
+	r, err := c.BanPeer(ctx, &pb.BanPeerRequest{Target: target, Duration: int64(duration / time.Second)})
+	if err != nil {
+		return err
+	}
+	logger.Infof("Result: %d, Message: %s", r.Code, r.Message)
+	return nil
+}
+
+// UnbanPeer lifts the ban of a peer id, ip or subnet
+func UnbanPeer(conn *grpc.ClientConn, target string) error {
+	c := pb.NewContorlCommandClient(conn)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	logger.Infof("Unban %s", target)
+	r, err := c.UnbanPeer(ctx, &pb.UnbanPeerRequest{Target: target})
+	if err != nil {
+		return err
+	}
+	logger.Infof("Result: %d, Message: %s", r.Code, r.Message)
+	return nil
+}
+
+// ListBans returns banned peer ids and ips
+func ListBans(conn *grpc.ClientConn) ([]*pb.Ban, error) {
+	c := pb.NewContorlCommandClient(conn)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	r, err := c.ListBans(ctx, &pb.ListBansRequest{})
+	if err != nil {
+		return nil, err
+	}
+	return r.Bans, nil
+}
+
+// ConvertAddress returns an address in base58 and bech32 formats
+func ConvertAddress(conn *grpc.ClientConn, addr string) (*pb.ConvertAddressResponse, error) {
+	c := pb.NewContorlCommandClient(conn)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	return c.ConvertAddress(ctx, &pb.ConvertAddressRequest{Addr: addr})
+}
+
+// CaptureProfile captures a runtime profile of the node into file name,
+// named by the profile and capture time if empty, and returns its path on the
+// node. cpu and trace profiles sample over seconds, 30 if 0
+func CaptureProfile(conn *grpc.ClientConn, profile string, seconds uint32, name string) (string, error) {
+	c := pb.NewContorlCommandClient(conn)
+
+	timeout := time.Duration(seconds) * time.Second
+	if timeout == 0 {
+		timeout = metrics.DefaultProfileDuration
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), timeout+30*time.Second)
+	defer cancel()
+
+	r, err := c.CaptureProfile(ctx, &pb.CaptureProfileRequest{Profile: profile, Seconds: seconds, Name: name})
+	if err != nil {
+		return "", err
+	}
+	if r.Code != 0 {
+		return "", errors.New(r.Message)
+	}
+	return r.Path, nil
+}
+
+// GetNetworkInfo returns the state of the node in p2p network
+func GetNetworkInfo(conn *grpc.ClientConn) (*pb.GetNetworkInfoResponse, error) {
+	c := pb.NewContorlCommandClient(conn)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	return c.GetNetworkInfo(ctx, &pb.GetNetworkInfoRequest{})
+}
+
+// GetNodeStatus returns chain, sync, peer, mempool, wallet, service and health
+// status of the node
+func GetNodeStatus(conn *grpc.ClientConn) (*pb.GetNodeStatusResponse, error) {
+	c := pb.NewContorlCommandClient(conn)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	return c.GetNodeStatus(ctx, &pb.GetNodeStatusRequest{})
+}
+
+// GetPeerInfo returns states of connected peers, or of peer id if not empty
+func GetPeerInfo(conn *grpc.ClientConn, id string) ([]*pb.PeerInfo, error) {
+	c := pb.NewContorlCommandClient(conn)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	r, err := c.GetPeerInfo(ctx, &pb.GetPeerInfoRequest{PeerId: id})
+	if err != nil {
+		return nil, err
+	}
+	return r.Peers, nil
+}
+
+// GetBlockVerbose returns the block of hash, or at height if hash is empty.
+// The block is serialized if verbosity is 0, with tx hashes if 1, or with
+// decoded txs if 2
+func GetBlockVerbose(conn *grpc.ClientConn, hash string, height, verbosity uint32) (*pb.GetBlockVerboseResponse, error) {
+	c := pb.NewContorlCommandClient(conn)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	return c.GetBlockVerbose(ctx, &pb.GetBlockVerboseRequest{Hash: hash, Height: height, Verbosity: verbosity})
+}
+
+// GetBlockHeaderVerbose returns the block header of hash, or at height if hash
+// is empty. The header is serialized if verbosity is 0
+func GetBlockHeaderVerbose(conn *grpc.ClientConn, hash string, height, verbosity uint32) (*pb.GetBlockVerboseResponse, error) {
+	c := pb.NewContorlCommandClient(conn)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	return c.GetBlockHeaderVerbose(ctx, &pb.GetBlockVerboseRequest{Hash: hash, Height: height, Verbosity: verbosity})
+}
+
+// SubscribeEternalBlocks calls handle with the eternal block and then each one
+// it advances to, until handle fails or the stream ends. Blocks up to the one
+// handled are final
+func SubscribeEternalBlocks(conn *grpc.ClientConn, handle func(*pb.EternalBlock) error) error {
+	c := pb.NewContorlCommandClient(conn)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	stream, err := c.SubscribeEternalBlocks(ctx, &pb.SubscribeEternalBlocksRequest{})
+	if err != nil {
+		return err
+	}
+	for {
+		r, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if r.Code != 0 {
+			return errors.New(r.Message)
+		}
+		if err := handle(r); err != nil {
+			return err
+		}
+	}
+}
+
+// BackupDatabase backs up database of the node to a backup named name, named
+// by the backup time if empty, and returns the backup directory
+func BackupDatabase(conn *grpc.ClientConn, name string) (string, error) {
+	c := pb.NewDatabaseCommandClient(conn)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Minute)
+	defer cancel()
+
+	r, err := c.BackupDatabase(ctx, &pb.BackupDatabaseRequest{Name: name})
+	if err != nil {
+		return "", err
+	}
+	if r.Code != 0 {
+		return "", errors.New(r.Message)
+	}
+	return r.Dir, nil
+}
@@ -0,0 +1,32 @@
+// Copyright (c) 2018 ContentBox Authors.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package client
+
+import (
+	"github.com/BOXFoundation/boxd/rpc/pb"
+	"google.golang.org/grpc/status"
+)
+
+// errorDetailTypeURL is the type url of ErrorDetail packed in grpc status
+const errorDetailTypeURL = "type.googleapis.com/rpcpb.ErrorDetail"
+
+// ErrorDetailOf returns the ErrorDetail carried by err returned from rpc
+// calls, nil if there is none
+func ErrorDetailOf(err error) *rpcpb.ErrorDetail {
+	st, ok := status.FromError(err)
+	if !ok || st == nil {
+		return nil
+	}
+	for _, d := range st.Proto().Details {
+		if d.TypeUrl != errorDetailTypeURL {
+			continue
+		}
+		detail := &rpcpb.ErrorDetail{}
+		if detail.Unmarshal(d.Value) == nil {
+			return detail
+		}
+	}
+	return nil
+}
@@ -164,3 +164,43 @@ func GetTokenBalance(conn *grpc.ClientConn, addr types.Address, tokenTxHash *cry
 	}
 	return 0
 }
+
+// ListTokens returns a page of tokens issued on the chain, and the number of
+// all tokens
+func ListTokens(conn *grpc.ClientConn, offset, limit uint32) ([]*rpcpb.TokenInfo, uint32, error) {
+	c := rpcpb.NewTransactionCommandClient(conn)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	r, err := c.ListTokens(ctx, &rpcpb.ListTokensRequest{Offset: offset, Limit: limit})
+	if err != nil {
+		return nil, 0, err
+	}
+	if r.Code != 0 {
+		return nil, 0, fmt.Errorf(r.Message)
+	}
+	return r.Tokens, r.Total, nil
+}
+
+// GetTokenTransactions returns a page of txs issuing or transferring token in
+// chain order, and the number of all such txs
+func GetTokenTransactions(conn *grpc.ClientConn, tokenTxHash *crypto.HashType, tokenTxOutIdx uint32,
+	offset, limit uint32) ([]*rpcpb.TokenTransaction, uint32, error) {
+	c := rpcpb.NewTransactionCommandClient(conn)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	r, err := c.GetTokenTransactions(ctx, &rpcpb.GetTokenTransactionsRequest{
+		Token: &corepb.OutPoint{
+			Hash:  tokenTxHash.GetBytes(),
+			Index: tokenTxOutIdx,
+		},
+		Offset: offset,
+		Limit:  limit,
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+	if r.Code != 0 {
+		return nil, 0, fmt.Errorf(r.Message)
+	}
+	return r.Txs, r.Total, nil
+}
@@ -7,6 +7,7 @@ package client
 import (
 	"context"
 	"fmt"
+	"io"
 	"time"
 
 	"github.com/BOXFoundation/boxd/core/pb"
@@ -25,6 +26,21 @@ func GetFeePrice(conn *grpc.ClientConn) (uint64, error) {
 	return r.BoxPerByte, err
 }
 
+// EstimateFee gets the fee price recommended for a transaction to be packed within targetBlocks
+func EstimateFee(conn *grpc.ClientConn, targetBlocks uint32) (uint64, error) {
+	c := rpcpb.NewTransactionCommandClient(conn)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	r, err := c.EstimateFee(ctx, &rpcpb.EstimateFeeRequest{TargetBlocks: targetBlocks})
+	if err != nil {
+		return 0, err
+	}
+	if r.Code != 0 {
+		return 0, fmt.Errorf(r.Message)
+	}
+	return r.BoxPerByte, nil
+}
+
 // FundTransaction gets the utxo of a public key
 func FundTransaction(conn *grpc.ClientConn, addr types.Address, amount uint64) (*rpcpb.ListUtxosResponse, error) {
 	p2pkScript, err := getScriptAddressFromPubKeyHash(addr.Hash())
@@ -143,6 +159,121 @@ func CreateTransaction(conn *grpc.ClientConn, fromAddress types.Address, targets
 	return transaction, nil
 }
 
+// CreateRawTransaction asks the node to build an unsigned transaction paying
+// targets from fromAddr, it returns the tx together with the utxos it spends
+func CreateRawTransaction(conn *grpc.ClientConn, fromAddr types.Address, targets []*rpcpb.TxOutTarget) (*types.Transaction, []*rpcpb.Utxo, error) {
+	r, err := CreateRawTransactionWithOptions(conn, &rpcpb.CreateRawTransactionRequest{
+		From:    fromAddr.String(),
+		Outputs: targets,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	tx := &types.Transaction{}
+	if err := tx.FromProtoMessage(r.Tx); err != nil {
+		return nil, nil, err
+	}
+	return tx, r.Utxos, nil
+}
+
+// CreateRawTransactionWithOptions asks the node to build an unsigned
+// transaction as requested, with the change address and fee price if set
+func CreateRawTransactionWithOptions(conn *grpc.ClientConn, req *rpcpb.CreateRawTransactionRequest) (*rpcpb.CreateRawTransactionResponse, error) {
+	c := rpcpb.NewTransactionCommandClient(conn)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	logger.Debugf("Create raw transaction from: %s", req.From)
+
+	r, err := c.CreateRawTransaction(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if r.Code != 0 {
+		return nil, fmt.Errorf(r.Message)
+	}
+	return r, nil
+}
+
+// SignRawTransaction asks the node to sign tx with its unlocked accounts,
+// it returns the signed tx and whether all of its inputs are signed
+func SignRawTransaction(conn *grpc.ClientConn, tx *types.Transaction) (*types.Transaction, bool, error) {
+	c := rpcpb.NewTransactionCommandClient(conn)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	msg, err := tx.ToProtoMessage()
+	if err != nil {
+		return nil, false, err
+	}
+	r, err := c.SignRawTransaction(ctx, &rpcpb.SignRawTransactionRequest{Tx: msg.(*corepb.Transaction)})
+	if err != nil {
+		return nil, false, err
+	}
+	if r.Code != 0 {
+		return nil, false, fmt.Errorf(r.Message)
+	}
+	signedTx := &types.Transaction{}
+	if err := signedTx.FromProtoMessage(r.Tx); err != nil {
+		return nil, false, err
+	}
+	return signedTx, r.Complete, nil
+}
+
+// SendRawTransaction sends a signed transaction to the node
+func SendRawTransaction(conn *grpc.ClientConn, tx *types.Transaction) error {
+	c := rpcpb.NewTransactionCommandClient(conn)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	msg, err := tx.ToProtoMessage()
+	if err != nil {
+		return err
+	}
+	_, err = c.SendTransaction(ctx, &rpcpb.SendTransactionRequest{Tx: msg.(*corepb.Transaction)})
+	return err
+}
+
+// CreatePSBT asks the node to create a base64 encoded PSBT of tx, filling in
+// outputs its inputs spend. redeemScripts are of p2sh inputs keyed by index
+func CreatePSBT(conn *grpc.ClientConn, tx *types.Transaction, redeemScripts map[uint32][]byte) (string, error) {
+	c := rpcpb.NewTransactionCommandClient(conn)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	msg, err := tx.ToProtoMessage()
+	if err != nil {
+		return "", err
+	}
+	r, err := c.CreatePSBT(ctx, &rpcpb.CreatePSBTRequest{
+		Tx:            msg.(*corepb.Transaction),
+		RedeemScripts: redeemScripts,
+	})
+	if err != nil {
+		return "", err
+	}
+	if r.Code != 0 {
+		return "", fmt.Errorf(r.Message)
+	}
+	return r.Psbt, nil
+}
+
+// SignPSBT asks the node to sign psbt with its unlocked accounts, it returns
+// the psbt and the number of inputs signed
+func SignPSBT(conn *grpc.ClientConn, psbt string) (string, uint32, error) {
+	c := rpcpb.NewTransactionCommandClient(conn)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	r, err := c.SignPSBT(ctx, &rpcpb.SignPSBTRequest{Psbt: psbt})
+	if err != nil {
+		return "", 0, err
+	}
+	if r.Code != 0 {
+		return "", 0, fmt.Errorf(r.Message)
+	}
+	return r.Psbt, r.Signed, nil
+}
+
 // GetRawTransaction get the transaction info of given hash
 func GetRawTransaction(conn *grpc.ClientConn, hash []byte) (*types.Transaction, error) {
 	c := rpcpb.NewTransactionCommandClient(conn)
@@ -159,6 +290,34 @@ func GetRawTransaction(conn *grpc.ClientConn, hash []byte) (*types.Transaction,
 	return tx, err
 }
 
+// GetRawTransactionInfo gets the serialized transaction of given hash along
+// with the block it's in and its confirmations
+func GetRawTransactionInfo(conn *grpc.ClientConn, hash []byte) (*rpcpb.GetRawTransactionResponse, error) {
+	c := rpcpb.NewTransactionCommandClient(conn)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	logger.Debugf("Get raw transaction of hash: %x", hash)
+
+	return c.GetRawTransaction(ctx, &rpcpb.GetRawTransactionRequest{Hash: hash})
+}
+
+// GetTransactionDetail gets the decoded transaction of given hash along with its chain location
+func GetTransactionDetail(conn *grpc.ClientConn, hash string) (*rpcpb.GetTransactionDetailResponse, error) {
+	c := rpcpb.NewTransactionCommandClient(conn)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	logger.Debugf("Get transaction detail of hash: %s", hash)
+
+	r, err := c.GetTransactionDetail(ctx, &rpcpb.GetTransactionDetailRequest{Hash: hash})
+	if err != nil {
+		return nil, err
+	}
+	if r.Code != 0 {
+		return nil, fmt.Errorf(r.Message)
+	}
+	return r, nil
+}
+
 // GetTransactionsInPool gets all transactions in memory pool
 func GetTransactionsInPool(conn *grpc.ClientConn) ([]*types.Transaction, error) {
 	c := rpcpb.NewTransactionCommandClient(conn)
@@ -180,6 +339,52 @@ func GetTransactionsInPool(conn *grpc.ClientConn) ([]*types.Transaction, error)
 	return txs, nil
 }
 
+// GetMempoolInfo summarizes txs in mempool
+func GetMempoolInfo(conn *grpc.ClientConn) (*rpcpb.GetMempoolInfoResponse, error) {
+	c := rpcpb.NewTransactionCommandClient(conn)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	return c.GetMempoolInfo(ctx, &rpcpb.GetMempoolInfoRequest{})
+}
+
+// ListMempoolTransactions lists txs in mempool by fee rate, with their fees
+// and ages if verbose
+func ListMempoolTransactions(conn *grpc.ClientConn, verbose bool) (*rpcpb.ListMempoolTransactionsResponse, error) {
+	c := rpcpb.NewTransactionCommandClient(conn)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	return c.ListMempoolTransactions(ctx, &rpcpb.ListMempoolTransactionsRequest{Verbose: verbose})
+}
+
+// SubscribeFilteredBlocks uploads a serialized bloom filter and calls handle
+// with each chain update filtered by it, until handle fails or the stream
+// ends. No deadline is set as the subscription lasts until cancelled
+func SubscribeFilteredBlocks(conn *grpc.ClientConn, filter []byte, handle func(*rpcpb.FilteredBlock) error) error {
+	c := rpcpb.NewTransactionCommandClient(conn)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	stream, err := c.SubscribeFilteredBlocks(ctx, &rpcpb.SubscribeFilteredBlocksRequest{Filter: filter})
+	if err != nil {
+		return err
+	}
+	for {
+		r, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if r.Code != 0 {
+			return fmt.Errorf(r.Message)
+		}
+		if err := handle(r); err != nil {
+			return err
+		}
+	}
+}
+
 //ListUtxos list all utxos
 func ListUtxos(conn *grpc.ClientConn) (*rpcpb.ListUtxosResponse, error) {
 	c := rpcpb.NewTransactionCommandClient(conn)
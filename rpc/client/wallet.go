@@ -6,34 +6,322 @@ package client
 
 import (
 	"context"
+	"errors"
 	"google.golang.org/grpc"
+	"io"
 	"log"
 	"time"
 
-	"github.com/BOXFoundation/boxd/core/types"
 	"github.com/BOXFoundation/boxd/rpc/pb"
 )
 
-// ListTransactions list transactions of certain address
-func ListTransactions(conn *grpc.ClientConn, addr string, offset, limit uint32) ([]*types.Transaction, error) {
+// ListTransactions list transactions of certain address, it returns the
+// matched transactions together with the cursor of the next page
+func ListTransactions(conn *grpc.ClientConn, req *rpcpb.ListTransactionsRequest) ([]*rpcpb.TransactionEntry, string, error) {
 	c := rpcpb.NewWalletCommandClient(conn)
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
-	log.Printf("List Transactions of address: %s", addr)
+	log.Printf("List Transactions of address: %s", req.Addr)
 
-	r, err := c.ListTransactions(ctx, &rpcpb.ListTransactionsRequest{Addr: addr, Offset: offset, Limit: limit})
+	r, err := c.ListTransactions(ctx, req)
 	if err != nil {
-		return nil, err
+		return nil, "", err
+	}
+	if r.Code != 0 {
+		return nil, "", errors.New(r.Message)
 	}
+	return r.Transactions, r.NextCursor, nil
+}
+
+// StreamTransactions streams transactions of certain address latest first,
+// calling handle with each chunk and the cursor to resume after it. No
+// deadline is set as a large history may take long to stream
+func StreamTransactions(conn *grpc.ClientConn, req *rpcpb.ListTransactionsRequest,
+	handle func(entries []*rpcpb.TransactionEntry, cursor string) error) error {
+	c := rpcpb.NewWalletCommandClient(conn)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	log.Printf("Stream Transactions of address: %s", req.Addr)
 
-	txs := make([]*types.Transaction, len(r.Transactions))
-	for i, rpcTx := range r.Transactions {
-		tx := &types.Transaction{}
-		err = tx.FromProtoMessage(rpcTx)
+	stream, err := c.StreamTransactions(ctx, req)
+	if err != nil {
+		return err
+	}
+	for {
+		r, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
 		if err != nil {
-			return nil, err
+			return err
+		}
+		if r.Code != 0 {
+			return errors.New(r.Message)
 		}
-		txs[i] = tx
+		if err := handle(r.Transactions, r.NextCursor); err != nil {
+			return err
+		}
+	}
+}
+
+// UnlockAccount unlocks an account managed by the node for timeout seconds,
+// 0 keeps it unlocked until LockAccount is called
+func UnlockAccount(conn *grpc.ClientConn, addr, passphrase string, timeout uint32) error {
+	c := rpcpb.NewWalletCommandClient(conn)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	r, err := c.UnlockAccount(ctx, &rpcpb.UnlockAccountRequest{
+		Addr:       addr,
+		Passphrase: passphrase,
+		Timeout:    timeout,
+	})
+	if err != nil {
+		return err
+	}
+	if r.Code != 0 {
+		return errors.New(r.Message)
+	}
+	return nil
+}
+
+// LockAccount locks an account managed by the node
+func LockAccount(conn *grpc.ClientConn, addr string) error {
+	c := rpcpb.NewWalletCommandClient(conn)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	r, err := c.LockAccount(ctx, &rpcpb.LockAccountRequest{Addr: addr})
+	if err != nil {
+		return err
+	}
+	if r.Code != 0 {
+		return errors.New(r.Message)
+	}
+	return nil
+}
+
+// DeriveAddress derives a fresh receive address, or a change address if change
+// is true, of a hd account managed by the node. The address and its derivation
+// path are returned
+func DeriveAddress(conn *grpc.ClientConn, account uint32, change bool) (string, string, error) {
+	c := rpcpb.NewWalletCommandClient(conn)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	r, err := c.DeriveAddress(ctx, &rpcpb.DeriveAddressRequest{Account: account, Change: change})
+	if err != nil {
+		return "", "", err
+	}
+	if r.Code != 0 {
+		return "", "", errors.New(r.Message)
+	}
+	return r.Addr, r.Path, nil
+}
+
+// ScanHDWallet makes the node look for used addresses of its hd wallet on
+// chain. Addresses found and the total balance of hd addresses are returned
+func ScanHDWallet(conn *grpc.ClientConn, gapLimit uint32) ([]string, uint64, error) {
+	c := rpcpb.NewWalletCommandClient(conn)
+	// scanning queries the chain address by address, give it more time
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	r, err := c.ScanHDWallet(ctx, &rpcpb.ScanHDWalletRequest{GapLimit: gapLimit})
+	if err != nil {
+		return nil, 0, err
+	}
+	if r.Code != 0 {
+		return nil, 0, errors.New(r.Message)
+	}
+	return r.Addrs, r.Balance, nil
+}
+
+// ImportMnemonic restores the hd wallet of the node from a mnemonic and
+// rescans the chain for it, the results are the same as ScanHDWallet
+func ImportMnemonic(conn *grpc.ClientConn, mnemonic, mnemonicPassphrase, passphrase string, gapLimit uint32) ([]string, uint64, error) {
+	c := rpcpb.NewWalletCommandClient(conn)
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	r, err := c.ImportMnemonic(ctx, &rpcpb.ImportMnemonicRequest{
+		Mnemonic:           mnemonic,
+		MnemonicPassphrase: mnemonicPassphrase,
+		Passphrase:         passphrase,
+		GapLimit:           gapLimit,
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+	if r.Code != 0 {
+		return nil, 0, errors.New(r.Message)
+	}
+	return r.Addrs, r.Balance, nil
+}
+
+// ChangePassphrase changes the passphrase of an account managed by the node
+func ChangePassphrase(conn *grpc.ClientConn, addr, oldPassphrase, newPassphrase string) error {
+	c := rpcpb.NewWalletCommandClient(conn)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	r, err := c.ChangePassphrase(ctx, &rpcpb.ChangePassphraseRequest{
+		Addr:          addr,
+		OldPassphrase: oldPassphrase,
+		NewPassphrase: newPassphrase,
+	})
+	if err != nil {
+		return err
+	}
+	if r.Code != 0 {
+		return errors.New(r.Message)
+	}
+	return nil
+}
+
+// ImportAddress imports an address as watch-only into the wallet of the node
+func ImportAddress(conn *grpc.ClientConn, addr string) error {
+	c := rpcpb.NewWalletCommandClient(conn)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	r, err := c.ImportAddress(ctx, &rpcpb.ImportAddressRequest{Addr: addr})
+	if err != nil {
+		return err
+	}
+	if r.Code != 0 {
+		return errors.New(r.Message)
+	}
+	return nil
+}
+
+// ListAccounts lists accounts in node wallet whose labels contain label
+func ListAccounts(conn *grpc.ClientConn, label string) ([]*rpcpb.AccountInfo, error) {
+	c := rpcpb.NewWalletCommandClient(conn)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	r, err := c.ListAccounts(ctx, &rpcpb.ListAccountsRequest{Label: label})
+	if err != nil {
+		return nil, err
+	}
+	if r.Code != 0 {
+		return nil, errors.New(r.Message)
+	}
+	return r.Accounts, nil
+}
+
+// SetAccountLabel labels an account in node wallet
+func SetAccountLabel(conn *grpc.ClientConn, addr, label string) error {
+	c := rpcpb.NewWalletCommandClient(conn)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	r, err := c.SetAccountLabel(ctx, &rpcpb.SetAccountLabelRequest{Addr: addr, Label: label})
+	if err != nil {
+		return err
+	}
+	if r.Code != 0 {
+		return errors.New(r.Message)
+	}
+	return nil
+}
+
+// SetTransactionLabel labels a transaction of node wallet
+func SetTransactionLabel(conn *grpc.ClientConn, hash, label string) error {
+	c := rpcpb.NewWalletCommandClient(conn)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	r, err := c.SetTransactionLabel(ctx, &rpcpb.SetTransactionLabelRequest{Hash: hash, Label: label})
+	if err != nil {
+		return err
+	}
+	if r.Code != 0 {
+		return errors.New(r.Message)
+	}
+	return nil
+}
+
+// SetAccountNote sets a key/value note of an account in node wallet
+func SetAccountNote(conn *grpc.ClientConn, addr, key, value string) error {
+	c := rpcpb.NewWalletCommandClient(conn)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	r, err := c.SetAccountNote(ctx, &rpcpb.SetAccountNoteRequest{Addr: addr, Key: key, Value: value})
+	if err != nil {
+		return err
+	}
+	if r.Code != 0 {
+		return errors.New(r.Message)
+	}
+	return nil
+}
+
+// ConsolidateUtxos asks the node to sweep small utxos of an account into
+// single outputs, it returns the consolidation txs and the number of dust
+// utxos left out
+func ConsolidateUtxos(conn *grpc.ClientConn, req *rpcpb.ConsolidateUtxosRequest) ([]*rpcpb.ConsolidationTx, uint32, error) {
+	c := rpcpb.NewWalletCommandClient(conn)
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	r, err := c.ConsolidateUtxos(ctx, req)
+	if err != nil {
+		return nil, 0, err
+	}
+	if r.Code != 0 {
+		return r.Txs, r.Dust, errors.New(r.Message)
+	}
+	return r.Txs, r.Dust, nil
+}
+
+// IssueToken issues a token from an account unlocked in node wallet
+func IssueToken(conn *grpc.ClientConn, req *rpcpb.IssueTokenRequest) (*rpcpb.TokenTxResponse, error) {
+	c := rpcpb.NewWalletCommandClient(conn)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	r, err := c.IssueToken(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if r.Code != 0 {
+		return nil, errors.New(r.Message)
+	}
+	return r, nil
+}
+
+// TransferToken transfers tokens from an account unlocked in node wallet
+func TransferToken(conn *grpc.ClientConn, req *rpcpb.TransferTokenRequest) (*rpcpb.TokenTxResponse, error) {
+	c := rpcpb.NewWalletCommandClient(conn)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	r, err := c.TransferToken(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if r.Code != 0 {
+		return nil, errors.New(r.Message)
+	}
+	return r, nil
+}
+
+// CreateMultisig asks the node to combine participants, hex public keys or
+// addresses of accounts unlocked on the node, into an m-of-n multisig
+func CreateMultisig(conn *grpc.ClientConn, m uint32, participants []string) (*rpcpb.CreateMultisigResponse, error) {
+	c := rpcpb.NewWalletCommandClient(conn)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	r, err := c.CreateMultisig(ctx, &rpcpb.CreateMultisigRequest{M: m, Participants: participants})
+	if err != nil {
+		return nil, err
+	}
+	if r.Code != 0 {
+		return nil, errors.New(r.Message)
 	}
-	return txs, nil
+	return r, nil
 }
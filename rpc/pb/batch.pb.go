@@ -0,0 +1,2385 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: batch.proto
+
+package rpcpb
+
+import proto "github.com/gogo/protobuf/proto"
+import fmt "fmt"
+import math "math"
+import _ "google.golang.org/genproto/googleapis/api/annotations"
+
+import (
+	context "golang.org/x/net/context"
+	grpc "google.golang.org/grpc"
+)
+
+import io "io"
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
+
+type BatchRequest struct {
+	Calls []*BatchCall `protobuf:"bytes,1,rep,name=calls" json:"calls,omitempty"`
+}
+
+func (m *BatchRequest) Reset()         { *m = BatchRequest{} }
+func (m *BatchRequest) String() string { return proto.CompactTextString(m) }
+func (*BatchRequest) ProtoMessage()    {}
+func (*BatchRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_batch_1436a63f1a485b4c, []int{0}
+}
+func (m *BatchRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BatchRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BatchRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *BatchRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchRequest.Merge(dst, src)
+}
+func (m *BatchRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *BatchRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BatchRequest proto.InternalMessageInfo
+
+func (m *BatchRequest) GetCalls() []*BatchCall {
+	if m != nil {
+		return m.Calls
+	}
+	return nil
+}
+
+type BatchCall struct {
+	// Types that are valid to be assigned to Call:
+	//	*BatchCall_GetBlockHeight
+	//	*BatchCall_GetBlockHash
+	//	*BatchCall_GetBalance
+	//	*BatchCall_GetTokenBalance
+	//	*BatchCall_ListUtxos
+	//	*BatchCall_ListTransactions
+	//	*BatchCall_GetRawTransaction
+	Call isBatchCall_Call `protobuf_oneof:"call"`
+}
+
+func (m *BatchCall) Reset()         { *m = BatchCall{} }
+func (m *BatchCall) String() string { return proto.CompactTextString(m) }
+func (*BatchCall) ProtoMessage()    {}
+func (*BatchCall) Descriptor() ([]byte, []int) {
+	return fileDescriptor_batch_1436a63f1a485b4c, []int{1}
+}
+func (m *BatchCall) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BatchCall) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BatchCall.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *BatchCall) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchCall.Merge(dst, src)
+}
+func (m *BatchCall) XXX_Size() int {
+	return m.Size()
+}
+func (m *BatchCall) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchCall.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BatchCall proto.InternalMessageInfo
+
+type isBatchCall_Call interface {
+	isBatchCall_Call()
+	MarshalTo([]byte) (int, error)
+	Size() int
+}
+
+type BatchCall_GetBlockHeight struct {
+	GetBlockHeight *GetBlockHeightRequest `protobuf:"bytes,1,opt,name=get_block_height,json=getBlockHeight,oneof"`
+}
+type BatchCall_GetBlockHash struct {
+	GetBlockHash *GetBlockHashRequest `protobuf:"bytes,2,opt,name=get_block_hash,json=getBlockHash,oneof"`
+}
+type BatchCall_GetBalance struct {
+	GetBalance *GetBalanceRequest `protobuf:"bytes,3,opt,name=get_balance,json=getBalance,oneof"`
+}
+type BatchCall_GetTokenBalance struct {
+	GetTokenBalance *GetTokenBalanceRequest `protobuf:"bytes,4,opt,name=get_token_balance,json=getTokenBalance,oneof"`
+}
+type BatchCall_ListUtxos struct {
+	ListUtxos *ListUtxosRequest `protobuf:"bytes,5,opt,name=list_utxos,json=listUtxos,oneof"`
+}
+type BatchCall_ListTransactions struct {
+	ListTransactions *ListTransactionsRequest `protobuf:"bytes,6,opt,name=list_transactions,json=listTransactions,oneof"`
+}
+type BatchCall_GetRawTransaction struct {
+	GetRawTransaction *GetRawTransactionRequest `protobuf:"bytes,7,opt,name=get_raw_transaction,json=getRawTransaction,oneof"`
+}
+
+func (*BatchCall_GetBlockHeight) isBatchCall_Call()    {}
+func (*BatchCall_GetBlockHash) isBatchCall_Call()      {}
+func (*BatchCall_GetBalance) isBatchCall_Call()        {}
+func (*BatchCall_GetTokenBalance) isBatchCall_Call()   {}
+func (*BatchCall_ListUtxos) isBatchCall_Call()         {}
+func (*BatchCall_ListTransactions) isBatchCall_Call()  {}
+func (*BatchCall_GetRawTransaction) isBatchCall_Call() {}
+
+func (m *BatchCall) GetCall() isBatchCall_Call {
+	if m != nil {
+		return m.Call
+	}
+	return nil
+}
+
+func (m *BatchCall) GetGetBlockHeight() *GetBlockHeightRequest {
+	if x, ok := m.GetCall().(*BatchCall_GetBlockHeight); ok {
+		return x.GetBlockHeight
+	}
+	return nil
+}
+
+func (m *BatchCall) GetGetBlockHash() *GetBlockHashRequest {
+	if x, ok := m.GetCall().(*BatchCall_GetBlockHash); ok {
+		return x.GetBlockHash
+	}
+	return nil
+}
+
+func (m *BatchCall) GetGetBalance() *GetBalanceRequest {
+	if x, ok := m.GetCall().(*BatchCall_GetBalance); ok {
+		return x.GetBalance
+	}
+	return nil
+}
+
+func (m *BatchCall) GetGetTokenBalance() *GetTokenBalanceRequest {
+	if x, ok := m.GetCall().(*BatchCall_GetTokenBalance); ok {
+		return x.GetTokenBalance
+	}
+	return nil
+}
+
+func (m *BatchCall) GetListUtxos() *ListUtxosRequest {
+	if x, ok := m.GetCall().(*BatchCall_ListUtxos); ok {
+		return x.ListUtxos
+	}
+	return nil
+}
+
+func (m *BatchCall) GetListTransactions() *ListTransactionsRequest {
+	if x, ok := m.GetCall().(*BatchCall_ListTransactions); ok {
+		return x.ListTransactions
+	}
+	return nil
+}
+
+func (m *BatchCall) GetGetRawTransaction() *GetRawTransactionRequest {
+	if x, ok := m.GetCall().(*BatchCall_GetRawTransaction); ok {
+		return x.GetRawTransaction
+	}
+	return nil
+}
+
+// XXX_OneofFuncs is for the internal use of the proto package.
+func (*BatchCall) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
+	return _BatchCall_OneofMarshaler, _BatchCall_OneofUnmarshaler, _BatchCall_OneofSizer, []interface{}{
+		(*BatchCall_GetBlockHeight)(nil),
+		(*BatchCall_GetBlockHash)(nil),
+		(*BatchCall_GetBalance)(nil),
+		(*BatchCall_GetTokenBalance)(nil),
+		(*BatchCall_ListUtxos)(nil),
+		(*BatchCall_ListTransactions)(nil),
+		(*BatchCall_GetRawTransaction)(nil),
+	}
+}
+
+func _BatchCall_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
+	m := msg.(*BatchCall)
+	// call
+	switch x := m.Call.(type) {
+	case *BatchCall_GetBlockHeight:
+		_ = b.EncodeVarint(1<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.GetBlockHeight); err != nil {
+			return err
+		}
+	case *BatchCall_GetBlockHash:
+		_ = b.EncodeVarint(2<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.GetBlockHash); err != nil {
+			return err
+		}
+	case *BatchCall_GetBalance:
+		_ = b.EncodeVarint(3<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.GetBalance); err != nil {
+			return err
+		}
+	case *BatchCall_GetTokenBalance:
+		_ = b.EncodeVarint(4<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.GetTokenBalance); err != nil {
+			return err
+		}
+	case *BatchCall_ListUtxos:
+		_ = b.EncodeVarint(5<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.ListUtxos); err != nil {
+			return err
+		}
+	case *BatchCall_ListTransactions:
+		_ = b.EncodeVarint(6<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.ListTransactions); err != nil {
+			return err
+		}
+	case *BatchCall_GetRawTransaction:
+		_ = b.EncodeVarint(7<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.GetRawTransaction); err != nil {
+			return err
+		}
+	case nil:
+	default:
+		return fmt.Errorf("BatchCall.Call has unexpected type %T", x)
+	}
+	return nil
+}
+
+func _BatchCall_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error) {
+	m := msg.(*BatchCall)
+	switch tag {
+	case 1: // call.get_block_height
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(GetBlockHeightRequest)
+		err := b.DecodeMessage(msg)
+		m.Call = &BatchCall_GetBlockHeight{msg}
+		return true, err
+	case 2: // call.get_block_hash
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(GetBlockHashRequest)
+		err := b.DecodeMessage(msg)
+		m.Call = &BatchCall_GetBlockHash{msg}
+		return true, err
+	case 3: // call.get_balance
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(GetBalanceRequest)
+		err := b.DecodeMessage(msg)
+		m.Call = &BatchCall_GetBalance{msg}
+		return true, err
+	case 4: // call.get_token_balance
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(GetTokenBalanceRequest)
+		err := b.DecodeMessage(msg)
+		m.Call = &BatchCall_GetTokenBalance{msg}
+		return true, err
+	case 5: // call.list_utxos
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(ListUtxosRequest)
+		err := b.DecodeMessage(msg)
+		m.Call = &BatchCall_ListUtxos{msg}
+		return true, err
+	case 6: // call.list_transactions
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(ListTransactionsRequest)
+		err := b.DecodeMessage(msg)
+		m.Call = &BatchCall_ListTransactions{msg}
+		return true, err
+	case 7: // call.get_raw_transaction
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(GetRawTransactionRequest)
+		err := b.DecodeMessage(msg)
+		m.Call = &BatchCall_GetRawTransaction{msg}
+		return true, err
+	default:
+		return false, nil
+	}
+}
+
+func _BatchCall_OneofSizer(msg proto.Message) (n int) {
+	m := msg.(*BatchCall)
+	// call
+	switch x := m.Call.(type) {
+	case *BatchCall_GetBlockHeight:
+		s := proto.Size(x.GetBlockHeight)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *BatchCall_GetBlockHash:
+		s := proto.Size(x.GetBlockHash)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *BatchCall_GetBalance:
+		s := proto.Size(x.GetBalance)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *BatchCall_GetTokenBalance:
+		s := proto.Size(x.GetTokenBalance)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *BatchCall_ListUtxos:
+		s := proto.Size(x.ListUtxos)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *BatchCall_ListTransactions:
+		s := proto.Size(x.ListTransactions)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *BatchCall_GetRawTransaction:
+		s := proto.Size(x.GetRawTransaction)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case nil:
+	default:
+		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
+	}
+	return n
+}
+
+type BatchResponse struct {
+	Code    int32  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	// the tip all calls are run at
+	Height  uint32 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	TipHash string `protobuf:"bytes,4,opt,name=tip_hash,json=tipHash,proto3" json:"tip_hash,omitempty"`
+	// results are in the order of calls, each carrying its own code
+	Results []*BatchResult `protobuf:"bytes,5,rep,name=results" json:"results,omitempty"`
+}
+
+func (m *BatchResponse) Reset()         { *m = BatchResponse{} }
+func (m *BatchResponse) String() string { return proto.CompactTextString(m) }
+func (*BatchResponse) ProtoMessage()    {}
+func (*BatchResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_batch_1436a63f1a485b4c, []int{2}
+}
+func (m *BatchResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BatchResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BatchResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *BatchResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchResponse.Merge(dst, src)
+}
+func (m *BatchResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *BatchResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BatchResponse proto.InternalMessageInfo
+
+func (m *BatchResponse) GetCode() int32 {
+	if m != nil {
+		return m.Code
+	}
+	return 0
+}
+
+func (m *BatchResponse) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+func (m *BatchResponse) GetHeight() uint32 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *BatchResponse) GetTipHash() string {
+	if m != nil {
+		return m.TipHash
+	}
+	return ""
+}
+
+func (m *BatchResponse) GetResults() []*BatchResult {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
+type BatchResult struct {
+	// Types that are valid to be assigned to Result:
+	//	*BatchResult_GetBlockHeight
+	//	*BatchResult_GetBlockHash
+	//	*BatchResult_GetBalance
+	//	*BatchResult_GetTokenBalance
+	//	*BatchResult_ListUtxos
+	//	*BatchResult_ListTransactions
+	//	*BatchResult_GetRawTransaction
+	Result isBatchResult_Result `protobuf_oneof:"result"`
+}
+
+func (m *BatchResult) Reset()         { *m = BatchResult{} }
+func (m *BatchResult) String() string { return proto.CompactTextString(m) }
+func (*BatchResult) ProtoMessage()    {}
+func (*BatchResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_batch_1436a63f1a485b4c, []int{3}
+}
+func (m *BatchResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BatchResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BatchResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *BatchResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchResult.Merge(dst, src)
+}
+func (m *BatchResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *BatchResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BatchResult proto.InternalMessageInfo
+
+type isBatchResult_Result interface {
+	isBatchResult_Result()
+	MarshalTo([]byte) (int, error)
+	Size() int
+}
+
+type BatchResult_GetBlockHeight struct {
+	GetBlockHeight *GetBlockHeightResponse `protobuf:"bytes,1,opt,name=get_block_height,json=getBlockHeight,oneof"`
+}
+type BatchResult_GetBlockHash struct {
+	GetBlockHash *GetBlockHashResponse `protobuf:"bytes,2,opt,name=get_block_hash,json=getBlockHash,oneof"`
+}
+type BatchResult_GetBalance struct {
+	GetBalance *GetBalanceResponse `protobuf:"bytes,3,opt,name=get_balance,json=getBalance,oneof"`
+}
+type BatchResult_GetTokenBalance struct {
+	GetTokenBalance *GetTokenBalanceResponse `protobuf:"bytes,4,opt,name=get_token_balance,json=getTokenBalance,oneof"`
+}
+type BatchResult_ListUtxos struct {
+	ListUtxos *ListUtxosResponse `protobuf:"bytes,5,opt,name=list_utxos,json=listUtxos,oneof"`
+}
+type BatchResult_ListTransactions struct {
+	ListTransactions *ListTransactionsResponse `protobuf:"bytes,6,opt,name=list_transactions,json=listTransactions,oneof"`
+}
+type BatchResult_GetRawTransaction struct {
+	GetRawTransaction *GetRawTransactionResponse `protobuf:"bytes,7,opt,name=get_raw_transaction,json=getRawTransaction,oneof"`
+}
+
+func (*BatchResult_GetBlockHeight) isBatchResult_Result()    {}
+func (*BatchResult_GetBlockHash) isBatchResult_Result()      {}
+func (*BatchResult_GetBalance) isBatchResult_Result()        {}
+func (*BatchResult_GetTokenBalance) isBatchResult_Result()   {}
+func (*BatchResult_ListUtxos) isBatchResult_Result()         {}
+func (*BatchResult_ListTransactions) isBatchResult_Result()  {}
+func (*BatchResult_GetRawTransaction) isBatchResult_Result() {}
+
+func (m *BatchResult) GetResult() isBatchResult_Result {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+func (m *BatchResult) GetGetBlockHeight() *GetBlockHeightResponse {
+	if x, ok := m.GetResult().(*BatchResult_GetBlockHeight); ok {
+		return x.GetBlockHeight
+	}
+	return nil
+}
+
+func (m *BatchResult) GetGetBlockHash() *GetBlockHashResponse {
+	if x, ok := m.GetResult().(*BatchResult_GetBlockHash); ok {
+		return x.GetBlockHash
+	}
+	return nil
+}
+
+func (m *BatchResult) GetGetBalance() *GetBalanceResponse {
+	if x, ok := m.GetResult().(*BatchResult_GetBalance); ok {
+		return x.GetBalance
+	}
+	return nil
+}
+
+func (m *BatchResult) GetGetTokenBalance() *GetTokenBalanceResponse {
+	if x, ok := m.GetResult().(*BatchResult_GetTokenBalance); ok {
+		return x.GetTokenBalance
+	}
+	return nil
+}
+
+func (m *BatchResult) GetListUtxos() *ListUtxosResponse {
+	if x, ok := m.GetResult().(*BatchResult_ListUtxos); ok {
+		return x.ListUtxos
+	}
+	return nil
+}
+
+func (m *BatchResult) GetListTransactions() *ListTransactionsResponse {
+	if x, ok := m.GetResult().(*BatchResult_ListTransactions); ok {
+		return x.ListTransactions
+	}
+	return nil
+}
+
+func (m *BatchResult) GetGetRawTransaction() *GetRawTransactionResponse {
+	if x, ok := m.GetResult().(*BatchResult_GetRawTransaction); ok {
+		return x.GetRawTransaction
+	}
+	return nil
+}
+
+// XXX_OneofFuncs is for the internal use of the proto package.
+func (*BatchResult) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
+	return _BatchResult_OneofMarshaler, _BatchResult_OneofUnmarshaler, _BatchResult_OneofSizer, []interface{}{
+		(*BatchResult_GetBlockHeight)(nil),
+		(*BatchResult_GetBlockHash)(nil),
+		(*BatchResult_GetBalance)(nil),
+		(*BatchResult_GetTokenBalance)(nil),
+		(*BatchResult_ListUtxos)(nil),
+		(*BatchResult_ListTransactions)(nil),
+		(*BatchResult_GetRawTransaction)(nil),
+	}
+}
+
+func _BatchResult_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
+	m := msg.(*BatchResult)
+	// result
+	switch x := m.Result.(type) {
+	case *BatchResult_GetBlockHeight:
+		_ = b.EncodeVarint(1<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.GetBlockHeight); err != nil {
+			return err
+		}
+	case *BatchResult_GetBlockHash:
+		_ = b.EncodeVarint(2<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.GetBlockHash); err != nil {
+			return err
+		}
+	case *BatchResult_GetBalance:
+		_ = b.EncodeVarint(3<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.GetBalance); err != nil {
+			return err
+		}
+	case *BatchResult_GetTokenBalance:
+		_ = b.EncodeVarint(4<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.GetTokenBalance); err != nil {
+			return err
+		}
+	case *BatchResult_ListUtxos:
+		_ = b.EncodeVarint(5<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.ListUtxos); err != nil {
+			return err
+		}
+	case *BatchResult_ListTransactions:
+		_ = b.EncodeVarint(6<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.ListTransactions); err != nil {
+			return err
+		}
+	case *BatchResult_GetRawTransaction:
+		_ = b.EncodeVarint(7<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.GetRawTransaction); err != nil {
+			return err
+		}
+	case nil:
+	default:
+		return fmt.Errorf("BatchResult.Result has unexpected type %T", x)
+	}
+	return nil
+}
+
+func _BatchResult_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error) {
+	m := msg.(*BatchResult)
+	switch tag {
+	case 1: // result.get_block_height
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(GetBlockHeightResponse)
+		err := b.DecodeMessage(msg)
+		m.Result = &BatchResult_GetBlockHeight{msg}
+		return true, err
+	case 2: // result.get_block_hash
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(GetBlockHashResponse)
+		err := b.DecodeMessage(msg)
+		m.Result = &BatchResult_GetBlockHash{msg}
+		return true, err
+	case 3: // result.get_balance
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(GetBalanceResponse)
+		err := b.DecodeMessage(msg)
+		m.Result = &BatchResult_GetBalance{msg}
+		return true, err
+	case 4: // result.get_token_balance
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(GetTokenBalanceResponse)
+		err := b.DecodeMessage(msg)
+		m.Result = &BatchResult_GetTokenBalance{msg}
+		return true, err
+	case 5: // result.list_utxos
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(ListUtxosResponse)
+		err := b.DecodeMessage(msg)
+		m.Result = &BatchResult_ListUtxos{msg}
+		return true, err
+	case 6: // result.list_transactions
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(ListTransactionsResponse)
+		err := b.DecodeMessage(msg)
+		m.Result = &BatchResult_ListTransactions{msg}
+		return true, err
+	case 7: // result.get_raw_transaction
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(GetRawTransactionResponse)
+		err := b.DecodeMessage(msg)
+		m.Result = &BatchResult_GetRawTransaction{msg}
+		return true, err
+	default:
+		return false, nil
+	}
+}
+
+func _BatchResult_OneofSizer(msg proto.Message) (n int) {
+	m := msg.(*BatchResult)
+	// result
+	switch x := m.Result.(type) {
+	case *BatchResult_GetBlockHeight:
+		s := proto.Size(x.GetBlockHeight)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *BatchResult_GetBlockHash:
+		s := proto.Size(x.GetBlockHash)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *BatchResult_GetBalance:
+		s := proto.Size(x.GetBalance)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *BatchResult_GetTokenBalance:
+		s := proto.Size(x.GetTokenBalance)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *BatchResult_ListUtxos:
+		s := proto.Size(x.ListUtxos)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *BatchResult_ListTransactions:
+		s := proto.Size(x.ListTransactions)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *BatchResult_GetRawTransaction:
+		s := proto.Size(x.GetRawTransaction)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case nil:
+	default:
+		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
+	}
+	return n
+}
+
+func init() {
+	proto.RegisterType((*BatchRequest)(nil), "rpcpb.BatchRequest")
+	proto.RegisterType((*BatchCall)(nil), "rpcpb.BatchCall")
+	proto.RegisterType((*BatchResponse)(nil), "rpcpb.BatchResponse")
+	proto.RegisterType((*BatchResult)(nil), "rpcpb.BatchResult")
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// BatchCommandClient is the client API for BatchCommand service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type BatchCommandClient interface {
+	// run read-only calls in order at one chain snapshot
+	Batch(ctx context.Context, in *BatchRequest, opts ...grpc.CallOption) (*BatchResponse, error)
+}
+
+type batchCommandClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewBatchCommandClient(cc *grpc.ClientConn) BatchCommandClient {
+	return &batchCommandClient{cc}
+}
+
+func (c *batchCommandClient) Batch(ctx context.Context, in *BatchRequest, opts ...grpc.CallOption) (*BatchResponse, error) {
+	out := new(BatchResponse)
+	err := c.cc.Invoke(ctx, "/rpcpb.BatchCommand/Batch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// BatchCommandServer is the server API for BatchCommand service.
+type BatchCommandServer interface {
+	// run read-only calls in order at one chain snapshot
+	Batch(context.Context, *BatchRequest) (*BatchResponse, error)
+}
+
+func RegisterBatchCommandServer(s *grpc.Server, srv BatchCommandServer) {
+	s.RegisterService(&_BatchCommand_serviceDesc, srv)
+}
+
+func _BatchCommand_Batch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BatchCommandServer).Batch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.BatchCommand/Batch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BatchCommandServer).Batch(ctx, req.(*BatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _BatchCommand_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "rpcpb.BatchCommand",
+	HandlerType: (*BatchCommandServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Batch",
+			Handler:    _BatchCommand_Batch_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "batch.proto",
+}
+
+func (m *BatchRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BatchRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Calls) > 0 {
+		for _, msg := range m.Calls {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintBatch(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *BatchCall) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BatchCall) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Call != nil {
+		nn1, err := m.Call.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += nn1
+	}
+	return i, nil
+}
+
+func (m *BatchCall_GetBlockHeight) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.GetBlockHeight != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintBatch(dAtA, i, uint64(m.GetBlockHeight.Size()))
+		n2, err := m.GetBlockHeight.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n2
+	}
+	return i, nil
+}
+func (m *BatchCall_GetBlockHash) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.GetBlockHash != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintBatch(dAtA, i, uint64(m.GetBlockHash.Size()))
+		n3, err := m.GetBlockHash.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n3
+	}
+	return i, nil
+}
+func (m *BatchCall_GetBalance) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.GetBalance != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintBatch(dAtA, i, uint64(m.GetBalance.Size()))
+		n4, err := m.GetBalance.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n4
+	}
+	return i, nil
+}
+func (m *BatchCall_GetTokenBalance) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.GetTokenBalance != nil {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintBatch(dAtA, i, uint64(m.GetTokenBalance.Size()))
+		n5, err := m.GetTokenBalance.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n5
+	}
+	return i, nil
+}
+func (m *BatchCall_ListUtxos) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.ListUtxos != nil {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintBatch(dAtA, i, uint64(m.ListUtxos.Size()))
+		n6, err := m.ListUtxos.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n6
+	}
+	return i, nil
+}
+func (m *BatchCall_ListTransactions) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.ListTransactions != nil {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintBatch(dAtA, i, uint64(m.ListTransactions.Size()))
+		n7, err := m.ListTransactions.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n7
+	}
+	return i, nil
+}
+func (m *BatchCall_GetRawTransaction) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.GetRawTransaction != nil {
+		dAtA[i] = 0x3a
+		i++
+		i = encodeVarintBatch(dAtA, i, uint64(m.GetRawTransaction.Size()))
+		n8, err := m.GetRawTransaction.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n8
+	}
+	return i, nil
+}
+func (m *BatchResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BatchResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Code != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintBatch(dAtA, i, uint64(m.Code))
+	}
+	if len(m.Message) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintBatch(dAtA, i, uint64(len(m.Message)))
+		i += copy(dAtA[i:], m.Message)
+	}
+	if m.Height != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintBatch(dAtA, i, uint64(m.Height))
+	}
+	if len(m.TipHash) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintBatch(dAtA, i, uint64(len(m.TipHash)))
+		i += copy(dAtA[i:], m.TipHash)
+	}
+	if len(m.Results) > 0 {
+		for _, msg := range m.Results {
+			dAtA[i] = 0x2a
+			i++
+			i = encodeVarintBatch(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *BatchResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BatchResult) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Result != nil {
+		nn9, err := m.Result.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += nn9
+	}
+	return i, nil
+}
+
+func (m *BatchResult_GetBlockHeight) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.GetBlockHeight != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintBatch(dAtA, i, uint64(m.GetBlockHeight.Size()))
+		n10, err := m.GetBlockHeight.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n10
+	}
+	return i, nil
+}
+func (m *BatchResult_GetBlockHash) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.GetBlockHash != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintBatch(dAtA, i, uint64(m.GetBlockHash.Size()))
+		n11, err := m.GetBlockHash.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n11
+	}
+	return i, nil
+}
+func (m *BatchResult_GetBalance) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.GetBalance != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintBatch(dAtA, i, uint64(m.GetBalance.Size()))
+		n12, err := m.GetBalance.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n12
+	}
+	return i, nil
+}
+func (m *BatchResult_GetTokenBalance) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.GetTokenBalance != nil {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintBatch(dAtA, i, uint64(m.GetTokenBalance.Size()))
+		n13, err := m.GetTokenBalance.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n13
+	}
+	return i, nil
+}
+func (m *BatchResult_ListUtxos) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.ListUtxos != nil {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintBatch(dAtA, i, uint64(m.ListUtxos.Size()))
+		n14, err := m.ListUtxos.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n14
+	}
+	return i, nil
+}
+func (m *BatchResult_ListTransactions) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.ListTransactions != nil {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintBatch(dAtA, i, uint64(m.ListTransactions.Size()))
+		n15, err := m.ListTransactions.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n15
+	}
+	return i, nil
+}
+func (m *BatchResult_GetRawTransaction) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.GetRawTransaction != nil {
+		dAtA[i] = 0x3a
+		i++
+		i = encodeVarintBatch(dAtA, i, uint64(m.GetRawTransaction.Size()))
+		n16, err := m.GetRawTransaction.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n16
+	}
+	return i, nil
+}
+func encodeVarintBatch(dAtA []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return offset + 1
+}
+func (m *BatchRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Calls) > 0 {
+		for _, e := range m.Calls {
+			l = e.Size()
+			n += 1 + l + sovBatch(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *BatchCall) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Call != nil {
+		n += m.Call.Size()
+	}
+	return n
+}
+
+func (m *BatchCall_GetBlockHeight) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GetBlockHeight != nil {
+		l = m.GetBlockHeight.Size()
+		n += 1 + l + sovBatch(uint64(l))
+	}
+	return n
+}
+func (m *BatchCall_GetBlockHash) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GetBlockHash != nil {
+		l = m.GetBlockHash.Size()
+		n += 1 + l + sovBatch(uint64(l))
+	}
+	return n
+}
+func (m *BatchCall_GetBalance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GetBalance != nil {
+		l = m.GetBalance.Size()
+		n += 1 + l + sovBatch(uint64(l))
+	}
+	return n
+}
+func (m *BatchCall_GetTokenBalance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GetTokenBalance != nil {
+		l = m.GetTokenBalance.Size()
+		n += 1 + l + sovBatch(uint64(l))
+	}
+	return n
+}
+func (m *BatchCall_ListUtxos) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ListUtxos != nil {
+		l = m.ListUtxos.Size()
+		n += 1 + l + sovBatch(uint64(l))
+	}
+	return n
+}
+func (m *BatchCall_ListTransactions) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ListTransactions != nil {
+		l = m.ListTransactions.Size()
+		n += 1 + l + sovBatch(uint64(l))
+	}
+	return n
+}
+func (m *BatchCall_GetRawTransaction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GetRawTransaction != nil {
+		l = m.GetRawTransaction.Size()
+		n += 1 + l + sovBatch(uint64(l))
+	}
+	return n
+}
+func (m *BatchResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Code != 0 {
+		n += 1 + sovBatch(uint64(m.Code))
+	}
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovBatch(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovBatch(uint64(m.Height))
+	}
+	l = len(m.TipHash)
+	if l > 0 {
+		n += 1 + l + sovBatch(uint64(l))
+	}
+	if len(m.Results) > 0 {
+		for _, e := range m.Results {
+			l = e.Size()
+			n += 1 + l + sovBatch(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *BatchResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Result != nil {
+		n += m.Result.Size()
+	}
+	return n
+}
+
+func (m *BatchResult_GetBlockHeight) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GetBlockHeight != nil {
+		l = m.GetBlockHeight.Size()
+		n += 1 + l + sovBatch(uint64(l))
+	}
+	return n
+}
+func (m *BatchResult_GetBlockHash) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GetBlockHash != nil {
+		l = m.GetBlockHash.Size()
+		n += 1 + l + sovBatch(uint64(l))
+	}
+	return n
+}
+func (m *BatchResult_GetBalance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GetBalance != nil {
+		l = m.GetBalance.Size()
+		n += 1 + l + sovBatch(uint64(l))
+	}
+	return n
+}
+func (m *BatchResult_GetTokenBalance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GetTokenBalance != nil {
+		l = m.GetTokenBalance.Size()
+		n += 1 + l + sovBatch(uint64(l))
+	}
+	return n
+}
+func (m *BatchResult_ListUtxos) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ListUtxos != nil {
+		l = m.ListUtxos.Size()
+		n += 1 + l + sovBatch(uint64(l))
+	}
+	return n
+}
+func (m *BatchResult_ListTransactions) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ListTransactions != nil {
+		l = m.ListTransactions.Size()
+		n += 1 + l + sovBatch(uint64(l))
+	}
+	return n
+}
+func (m *BatchResult_GetRawTransaction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GetRawTransaction != nil {
+		l = m.GetRawTransaction.Size()
+		n += 1 + l + sovBatch(uint64(l))
+	}
+	return n
+}
+
+func sovBatch(x uint64) (n int) {
+	for {
+		n++
+		x >>= 7
+		if x == 0 {
+			break
+		}
+	}
+	return n
+}
+func sozBatch(x uint64) (n int) {
+	return sovBatch(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *BatchRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBatch
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BatchRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BatchRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Calls", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBatch
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Calls = append(m.Calls, &BatchCall{})
+			if err := m.Calls[len(m.Calls)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBatch(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBatch
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BatchCall) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBatch
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BatchCall: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BatchCall: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GetBlockHeight", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBatch
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &GetBlockHeightRequest{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Call = &BatchCall_GetBlockHeight{v}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GetBlockHash", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBatch
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &GetBlockHashRequest{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Call = &BatchCall_GetBlockHash{v}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GetBalance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBatch
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &GetBalanceRequest{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Call = &BatchCall_GetBalance{v}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GetTokenBalance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBatch
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &GetTokenBalanceRequest{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Call = &BatchCall_GetTokenBalance{v}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ListUtxos", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBatch
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &ListUtxosRequest{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Call = &BatchCall_ListUtxos{v}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ListTransactions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBatch
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &ListTransactionsRequest{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Call = &BatchCall_ListTransactions{v}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GetRawTransaction", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBatch
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &GetRawTransactionRequest{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Call = &BatchCall_GetRawTransaction{v}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBatch(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBatch
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BatchResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBatch
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BatchResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BatchResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			m.Code = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Code |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBatch
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TipHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBatch
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TipHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBatch
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Results = append(m.Results, &BatchResult{})
+			if err := m.Results[len(m.Results)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBatch(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBatch
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BatchResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBatch
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BatchResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BatchResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GetBlockHeight", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBatch
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &GetBlockHeightResponse{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Result = &BatchResult_GetBlockHeight{v}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GetBlockHash", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBatch
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &GetBlockHashResponse{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Result = &BatchResult_GetBlockHash{v}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GetBalance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBatch
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &GetBalanceResponse{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Result = &BatchResult_GetBalance{v}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GetTokenBalance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBatch
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &GetTokenBalanceResponse{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Result = &BatchResult_GetTokenBalance{v}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ListUtxos", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBatch
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &ListUtxosResponse{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Result = &BatchResult_ListUtxos{v}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ListTransactions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBatch
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &ListTransactionsResponse{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Result = &BatchResult_ListTransactions{v}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GetRawTransaction", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBatch
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &GetRawTransactionResponse{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Result = &BatchResult_GetRawTransaction{v}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBatch(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBatch
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipBatch(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowBatch
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowBatch
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+			return iNdEx, nil
+		case 1:
+			iNdEx += 8
+			return iNdEx, nil
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowBatch
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			iNdEx += length
+			if length < 0 {
+				return 0, ErrInvalidLengthBatch
+			}
+			return iNdEx, nil
+		case 3:
+			for {
+				var innerWire uint64
+				var start int = iNdEx
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return 0, ErrIntOverflowBatch
+					}
+					if iNdEx >= l {
+						return 0, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					innerWire |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				innerWireType := int(innerWire & 0x7)
+				if innerWireType == 4 {
+					break
+				}
+				next, err := skipBatch(dAtA[start:])
+				if err != nil {
+					return 0, err
+				}
+				iNdEx = start + next
+			}
+			return iNdEx, nil
+		case 4:
+			return iNdEx, nil
+		case 5:
+			iNdEx += 4
+			return iNdEx, nil
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+	}
+	panic("unreachable")
+}
+
+var (
+	ErrInvalidLengthBatch = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowBatch   = fmt.Errorf("proto: integer overflow")
+)
+
+func init() { proto.RegisterFile("batch.proto", fileDescriptor_batch_1436a63f1a485b4c) }
+
+var fileDescriptor_batch_1436a63f1a485b4c = []byte{
+	// 611 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x94, 0x4f, 0x6f, 0xd3, 0x3e,
+	0x18, 0xc7, 0x9b, 0x2d, 0xed, 0xd6, 0xa7, 0xdb, 0x7e, 0xad, 0x37, 0xfd, 0xc8, 0xca, 0x96, 0x55,
+	0x39, 0xa0, 0x09, 0xa1, 0x56, 0x14, 0x09, 0xf1, 0xef, 0xd4, 0x1e, 0x28, 0x62, 0x20, 0x11, 0x0d,
+	0x89, 0x5b, 0xe5, 0x66, 0x56, 0x1a, 0xcd, 0x8d, 0x43, 0xec, 0x52, 0xce, 0x5c, 0xb8, 0x22, 0xb8,
+	0xf3, 0x7a, 0x38, 0x4e, 0xe2, 0xc2, 0x11, 0xb5, 0xbc, 0x10, 0x14, 0x27, 0x4d, 0xdc, 0x36, 0xe1,
+	0xd6, 0xe7, 0xf9, 0xfa, 0xf9, 0x58, 0xae, 0x3f, 0x31, 0xd4, 0x46, 0x58, 0x38, 0xe3, 0x76, 0x10,
+	0x32, 0xc1, 0x50, 0x39, 0x0c, 0x9c, 0x60, 0xd4, 0x3c, 0x71, 0x19, 0x73, 0x29, 0xe9, 0xe0, 0xc0,
+	0xeb, 0x60, 0xdf, 0x67, 0x02, 0x0b, 0x8f, 0xf9, 0x3c, 0x5e, 0xd4, 0xdc, 0x77, 0x98, 0x2f, 0x42,
+	0x46, 0x93, 0xb2, 0x21, 0x42, 0xec, 0x73, 0xec, 0x44, 0x4b, 0x92, 0xd6, 0xde, 0x0c, 0x53, 0x4a,
+	0x44, 0x5c, 0x59, 0x0f, 0x61, 0xaf, 0x17, 0xed, 0x61, 0x93, 0xf7, 0x53, 0xc2, 0x05, 0xba, 0x03,
+	0x65, 0x07, 0x53, 0xca, 0x0d, 0xad, 0xb5, 0x7d, 0x5e, 0xeb, 0xd6, 0xdb, 0x72, 0xd3, 0xb6, 0x5c,
+	0xd3, 0xc7, 0x94, 0xda, 0x71, 0x6c, 0x7d, 0xd6, 0xa1, 0x9a, 0x36, 0xd1, 0x00, 0xea, 0x2e, 0x11,
+	0xc3, 0x11, 0x65, 0xce, 0xf5, 0x70, 0x4c, 0x3c, 0x77, 0x2c, 0x0c, 0xad, 0xa5, 0x9d, 0xd7, 0xba,
+	0x27, 0x09, 0xe0, 0x39, 0x11, 0xbd, 0x28, 0x1d, 0xc8, 0x30, 0xd9, 0x6d, 0x50, 0xb2, 0x0f, 0xdc,
+	0x95, 0x00, 0xf5, 0xe0, 0x40, 0x21, 0x61, 0x3e, 0x36, 0xb6, 0x24, 0xa7, 0xb9, 0xce, 0xc1, 0x7c,
+	0x9c, 0x51, 0xf6, 0x5c, 0xa5, 0x8d, 0x9e, 0x42, 0x4d, 0x32, 0x30, 0xc5, 0xbe, 0x43, 0x8c, 0x6d,
+	0x09, 0x30, 0x14, 0x40, 0x1c, 0x64, 0xe3, 0xe0, 0xa6, 0x4d, 0xf4, 0x12, 0x1a, 0xd1, 0xb0, 0x60,
+	0xd7, 0xc4, 0x4f, 0x11, 0xba, 0x44, 0x9c, 0x66, 0x88, 0xcb, 0x28, 0xde, 0xe0, 0xfc, 0xe7, 0xae,
+	0x26, 0xe8, 0x11, 0x00, 0xf5, 0xb8, 0x18, 0x4e, 0xc5, 0x47, 0xc6, 0x8d, 0xb2, 0xa4, 0xdc, 0x4a,
+	0x28, 0x17, 0x1e, 0x17, 0x6f, 0xa3, 0x7e, 0x36, 0x5f, 0xa5, 0xcb, 0x1e, 0x7a, 0x05, 0x0d, 0x39,
+	0xa9, 0xdc, 0x1f, 0x37, 0x2a, 0x12, 0x60, 0x2a, 0x80, 0x4b, 0x25, 0xce, 0x38, 0x75, 0xba, 0x16,
+	0xa1, 0x37, 0x70, 0x18, 0x9d, 0x2a, 0xc4, 0x33, 0x95, 0x68, 0xec, 0x48, 0xe0, 0x59, 0x76, 0x2e,
+	0x1b, 0xcf, 0x94, 0xb9, 0x8c, 0xd8, 0x70, 0xd7, 0xb3, 0x5e, 0x05, 0xf4, 0x48, 0x05, 0xeb, 0xbb,
+	0x06, 0xfb, 0x89, 0x42, 0x3c, 0x60, 0x3e, 0x27, 0x08, 0x81, 0xee, 0xb0, 0x2b, 0x22, 0x0d, 0x28,
+	0xdb, 0xf2, 0x37, 0x32, 0x60, 0x67, 0x42, 0x38, 0xc7, 0x2e, 0x91, 0x17, 0x5a, 0xb5, 0x97, 0x25,
+	0xfa, 0x1f, 0x2a, 0x89, 0x31, 0xd1, 0x45, 0xed, 0xdb, 0x49, 0x85, 0x8e, 0x61, 0x57, 0x78, 0x41,
+	0xec, 0x80, 0x1e, 0x8f, 0x08, 0x2f, 0x90, 0x17, 0x7c, 0x0f, 0x76, 0x42, 0xc2, 0xa7, 0x54, 0x44,
+	0xff, 0x69, 0xa4, 0x29, 0x52, 0x35, 0xb5, 0x65, 0x64, 0x2f, 0x97, 0x58, 0x5f, 0x75, 0xa8, 0x29,
+	0x01, 0x7a, 0x51, 0x28, 0xeb, 0x69, 0x81, 0xac, 0xf1, 0xb9, 0x72, 0x6c, 0xed, 0x17, 0xd8, 0x7a,
+	0x3b, 0xd7, 0xd6, 0x14, 0xb3, 0xaa, 0xeb, 0xb3, 0x3c, 0x5d, 0x8f, 0x73, 0x74, 0x4d, 0xe7, 0x55,
+	0x5f, 0x2f, 0x8a, 0x7d, 0x35, 0x8b, 0x7c, 0x4d, 0x41, 0x1b, 0xc2, 0x3e, 0xce, 0x11, 0xd6, 0xd8,
+	0x14, 0x36, 0x05, 0x28, 0xc6, 0xbe, 0x2e, 0x36, 0xf6, 0xac, 0xd0, 0xd8, 0x14, 0xb4, 0xa9, 0xac,
+	0xfd, 0x2f, 0x65, 0x5b, 0xc5, 0xca, 0xa6, 0xc8, 0x1c, 0x67, 0x77, 0xa1, 0x12, 0x5b, 0xd1, 0x7d,
+	0x97, 0xbc, 0x7b, 0x7d, 0x36, 0x99, 0x60, 0xff, 0x0a, 0x0d, 0xa0, 0x2c, 0x6b, 0x74, 0xb8, 0xaa,
+	0x92, 0xfc, 0x00, 0x9a, 0x47, 0xab, 0xcd, 0x78, 0x0b, 0xeb, 0xe8, 0xd3, 0xcf, 0x3f, 0xdf, 0xb6,
+	0x0e, 0xac, 0x6a, 0xe7, 0xc3, 0xfd, 0x8e, 0x7c, 0xa9, 0x9f, 0x68, 0x77, 0x7b, 0xc6, 0x8f, 0xb9,
+	0xa9, 0xdd, 0xcc, 0x4d, 0xed, 0xf7, 0xdc, 0xd4, 0xbe, 0x2c, 0xcc, 0xd2, 0xcd, 0xc2, 0x2c, 0xfd,
+	0x5a, 0x98, 0xa5, 0x51, 0x45, 0x3e, 0xb9, 0x0f, 0xfe, 0x0e, 0x00, 0x34, 0xe0, 0xbe, 0xc7, 0xd6,
+	0x05, 0x00, 0x00,
+}
@@ -0,0 +1,111 @@
+// Code generated by protoc-gen-grpc-gateway. DO NOT EDIT.
+// source: batch.proto
+
+/*
+Package rpcpb is a reverse proxy.
+
+It translates gRPC into RESTful JSON APIs.
+*/
+package rpcpb
+
+import (
+	"io"
+	"net/http"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"github.com/grpc-ecosystem/grpc-gateway/utilities"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/grpclog"
+	"google.golang.org/grpc/status"
+)
+
+var _ codes.Code
+var _ io.Reader
+var _ status.Status
+var _ = runtime.String
+var _ = utilities.NewDoubleArray
+
+func request_BatchCommand_Batch_0(ctx context.Context, marshaler runtime.Marshaler, client BatchCommandClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var protoReq BatchRequest
+	var metadata runtime.ServerMetadata
+
+	if err := marshaler.NewDecoder(req.Body).Decode(&protoReq); err != nil && err != io.EOF {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	msg, err := client.Batch(ctx, &protoReq, grpc.Header(&metadata.HeaderMD), grpc.Trailer(&metadata.TrailerMD))
+	return msg, metadata, err
+
+}
+
+// RegisterBatchCommandHandlerFromEndpoint is same as RegisterBatchCommandHandler but
+// automatically dials to "endpoint" and closes the connection when "ctx" gets done.
+func RegisterBatchCommandHandlerFromEndpoint(ctx context.Context, mux *runtime.ServeMux, endpoint string, opts []grpc.DialOption) (err error) {
+	conn, err := grpc.Dial(endpoint, opts...)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			if cerr := conn.Close(); cerr != nil {
+				grpclog.Infof("Failed to close conn to %s: %v", endpoint, cerr)
+			}
+			return
+		}
+		go func() {
+			<-ctx.Done()
+			if cerr := conn.Close(); cerr != nil {
+				grpclog.Infof("Failed to close conn to %s: %v", endpoint, cerr)
+			}
+		}()
+	}()
+
+	return RegisterBatchCommandHandler(ctx, mux, conn)
+}
+
+// RegisterBatchCommandHandler registers the http handlers for service BatchCommand to "mux".
+// The handlers forward requests to the grpc endpoint over "conn".
+func RegisterBatchCommandHandler(ctx context.Context, mux *runtime.ServeMux, conn *grpc.ClientConn) error {
+	return RegisterBatchCommandHandlerClient(ctx, mux, NewBatchCommandClient(conn))
+}
+
+// RegisterBatchCommandHandlerClient registers the http handlers for service BatchCommand
+// to "mux". The handlers forward requests to the grpc endpoint over the given implementation of "BatchCommandClient".
+// Note: the gRPC framework executes interceptors within the gRPC handler. If the passed in "BatchCommandClient"
+// doesn't go through the normal gRPC flow (creating a gRPC client etc.) then it will be up to the passed in
+// "BatchCommandClient" to call the correct interceptors.
+func RegisterBatchCommandHandlerClient(ctx context.Context, mux *runtime.ServeMux, client BatchCommandClient) error {
+
+	mux.Handle("POST", pattern_BatchCommand_Batch_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		rctx, err := runtime.AnnotateContext(ctx, mux, req)
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := request_BatchCommand_Batch_0(rctx, inboundMarshaler, client, req, pathParams)
+		ctx = runtime.NewServerMetadataContext(ctx, md)
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+
+		forward_BatchCommand_Batch_0(ctx, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+
+	})
+
+	return nil
+}
+
+var (
+	pattern_BatchCommand_Batch_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1}, []string{"v1", "batch"}, ""))
+)
+
+var (
+	forward_BatchCommand_Batch_0 = runtime.ForwardResponseMessage
+)
@@ -0,0 +1,2177 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: candidate.proto
+
+package rpcpb
+
+import proto "github.com/gogo/protobuf/proto"
+import fmt "fmt"
+import math "math"
+import pb "github.com/BOXFoundation/boxd/core/pb"
+import _ "google.golang.org/genproto/googleapis/api/annotations"
+
+import (
+	context "golang.org/x/net/context"
+	grpc "google.golang.org/grpc"
+)
+
+import io "io"
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
+
+type RegisterCandidateRequest struct {
+	// the account registering as a candidate and paying the fee, it must be
+	// unlocked on the node
+	Addr string `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
+	// node fee price is used if not set
+	FeePerByte uint64 `protobuf:"varint,2,opt,name=fee_per_byte,json=feePerByte,proto3" json:"fee_per_byte,omitempty"`
+}
+
+func (m *RegisterCandidateRequest) Reset()         { *m = RegisterCandidateRequest{} }
+func (m *RegisterCandidateRequest) String() string { return proto.CompactTextString(m) }
+func (*RegisterCandidateRequest) ProtoMessage()    {}
+func (*RegisterCandidateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_candidate_74d642052320ca86, []int{0}
+}
+func (m *RegisterCandidateRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RegisterCandidateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RegisterCandidateRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *RegisterCandidateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RegisterCandidateRequest.Merge(dst, src)
+}
+func (m *RegisterCandidateRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *RegisterCandidateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RegisterCandidateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RegisterCandidateRequest proto.InternalMessageInfo
+
+func (m *RegisterCandidateRequest) GetAddr() string {
+	if m != nil {
+		return m.Addr
+	}
+	return ""
+}
+
+func (m *RegisterCandidateRequest) GetFeePerByte() uint64 {
+	if m != nil {
+		return m.FeePerByte
+	}
+	return 0
+}
+
+type VoteRequest struct {
+	// the account paying the fee, it must be unlocked on the node
+	Addr string `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
+	// the registered candidate votes are cast for or withdrawn from
+	Candidate string `protobuf:"bytes,2,opt,name=candidate,proto3" json:"candidate,omitempty"`
+	Votes     uint64 `protobuf:"varint,3,opt,name=votes,proto3" json:"votes,omitempty"`
+	// node fee price is used if not set
+	FeePerByte uint64 `protobuf:"varint,4,opt,name=fee_per_byte,json=feePerByte,proto3" json:"fee_per_byte,omitempty"`
+}
+
+func (m *VoteRequest) Reset()         { *m = VoteRequest{} }
+func (m *VoteRequest) String() string { return proto.CompactTextString(m) }
+func (*VoteRequest) ProtoMessage()    {}
+func (*VoteRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_candidate_74d642052320ca86, []int{1}
+}
+func (m *VoteRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VoteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VoteRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *VoteRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VoteRequest.Merge(dst, src)
+}
+func (m *VoteRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *VoteRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_VoteRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VoteRequest proto.InternalMessageInfo
+
+func (m *VoteRequest) GetAddr() string {
+	if m != nil {
+		return m.Addr
+	}
+	return ""
+}
+
+func (m *VoteRequest) GetCandidate() string {
+	if m != nil {
+		return m.Candidate
+	}
+	return ""
+}
+
+func (m *VoteRequest) GetVotes() uint64 {
+	if m != nil {
+		return m.Votes
+	}
+	return 0
+}
+
+func (m *VoteRequest) GetFeePerByte() uint64 {
+	if m != nil {
+		return m.FeePerByte
+	}
+	return 0
+}
+
+type ProposeChainParamsRequest struct {
+	// the miner proposing chain parameters and paying the fee, it must be
+	// unlocked on the node. Parameters take effect from the next epoch once
+	// more than 2/3 of miners propose them
+	Addr string `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
+	// milliseconds between blocks, in whole seconds
+	BlockInterval int64 `protobuf:"varint,2,opt,name=block_interval,json=blockInterval,proto3" json:"block_interval,omitempty"`
+	// slots an epoch lasts, a multiple of the miners of a period
+	PeriodDuration uint32 `protobuf:"varint,3,opt,name=period_duration,json=periodDuration,proto3" json:"period_duration,omitempty"`
+	// max bytes of a block serialized
+	MaxBlockSize uint32 `protobuf:"varint,4,opt,name=max_block_size,json=maxBlockSize,proto3" json:"max_block_size,omitempty"`
+	// node fee price is used if not set
+	FeePerByte uint64 `protobuf:"varint,5,opt,name=fee_per_byte,json=feePerByte,proto3" json:"fee_per_byte,omitempty"`
+}
+
+func (m *ProposeChainParamsRequest) Reset()         { *m = ProposeChainParamsRequest{} }
+func (m *ProposeChainParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*ProposeChainParamsRequest) ProtoMessage()    {}
+func (*ProposeChainParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_candidate_74d642052320ca86, []int{2}
+}
+func (m *ProposeChainParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProposeChainParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProposeChainParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *ProposeChainParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProposeChainParamsRequest.Merge(dst, src)
+}
+func (m *ProposeChainParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProposeChainParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProposeChainParamsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProposeChainParamsRequest proto.InternalMessageInfo
+
+func (m *ProposeChainParamsRequest) GetAddr() string {
+	if m != nil {
+		return m.Addr
+	}
+	return ""
+}
+
+func (m *ProposeChainParamsRequest) GetBlockInterval() int64 {
+	if m != nil {
+		return m.BlockInterval
+	}
+	return 0
+}
+
+func (m *ProposeChainParamsRequest) GetPeriodDuration() uint32 {
+	if m != nil {
+		return m.PeriodDuration
+	}
+	return 0
+}
+
+func (m *ProposeChainParamsRequest) GetMaxBlockSize() uint32 {
+	if m != nil {
+		return m.MaxBlockSize
+	}
+	return 0
+}
+
+func (m *ProposeChainParamsRequest) GetFeePerByte() uint64 {
+	if m != nil {
+		return m.FeePerByte
+	}
+	return 0
+}
+
+type CandidateTxResponse struct {
+	Code    int32  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	// hash of the tx sent, it takes effect once the tx is in a block
+	Hash string          `protobuf:"bytes,3,opt,name=hash,proto3" json:"hash,omitempty"`
+	Fee  uint64          `protobuf:"varint,4,opt,name=fee,proto3" json:"fee,omitempty"`
+	Tx   *pb.Transaction `protobuf:"bytes,5,opt,name=tx" json:"tx,omitempty"`
+}
+
+func (m *CandidateTxResponse) Reset()         { *m = CandidateTxResponse{} }
+func (m *CandidateTxResponse) String() string { return proto.CompactTextString(m) }
+func (*CandidateTxResponse) ProtoMessage()    {}
+func (*CandidateTxResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_candidate_74d642052320ca86, []int{3}
+}
+func (m *CandidateTxResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CandidateTxResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CandidateTxResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *CandidateTxResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CandidateTxResponse.Merge(dst, src)
+}
+func (m *CandidateTxResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *CandidateTxResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CandidateTxResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CandidateTxResponse proto.InternalMessageInfo
+
+func (m *CandidateTxResponse) GetCode() int32 {
+	if m != nil {
+		return m.Code
+	}
+	return 0
+}
+
+func (m *CandidateTxResponse) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+func (m *CandidateTxResponse) GetHash() string {
+	if m != nil {
+		return m.Hash
+	}
+	return ""
+}
+
+func (m *CandidateTxResponse) GetFee() uint64 {
+	if m != nil {
+		return m.Fee
+	}
+	return 0
+}
+
+func (m *CandidateTxResponse) GetTx() *pb.Transaction {
+	if m != nil {
+		return m.Tx
+	}
+	return nil
+}
+
+type ListCandidatesRequest struct {
+}
+
+func (m *ListCandidatesRequest) Reset()         { *m = ListCandidatesRequest{} }
+func (m *ListCandidatesRequest) String() string { return proto.CompactTextString(m) }
+func (*ListCandidatesRequest) ProtoMessage()    {}
+func (*ListCandidatesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_candidate_74d642052320ca86, []int{4}
+}
+func (m *ListCandidatesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListCandidatesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListCandidatesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *ListCandidatesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListCandidatesRequest.Merge(dst, src)
+}
+func (m *ListCandidatesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListCandidatesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListCandidatesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListCandidatesRequest proto.InternalMessageInfo
+
+type Candidate struct {
+	Addr  string `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
+	Votes int64  `protobuf:"varint,2,opt,name=votes,proto3" json:"votes,omitempty"`
+	// if the candidate misses too many of its slots as a miner, as counted
+	// by the node answering. It's reported only, and doesn't affect ranking
+	// or consensus
+	Absent bool `protobuf:"varint,3,opt,name=absent,proto3" json:"absent,omitempty"`
+}
+
+func (m *Candidate) Reset()         { *m = Candidate{} }
+func (m *Candidate) String() string { return proto.CompactTextString(m) }
+func (*Candidate) ProtoMessage()    {}
+func (*Candidate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_candidate_74d642052320ca86, []int{5}
+}
+func (m *Candidate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Candidate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Candidate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *Candidate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Candidate.Merge(dst, src)
+}
+func (m *Candidate) XXX_Size() int {
+	return m.Size()
+}
+func (m *Candidate) XXX_DiscardUnknown() {
+	xxx_messageInfo_Candidate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Candidate proto.InternalMessageInfo
+
+func (m *Candidate) GetAddr() string {
+	if m != nil {
+		return m.Addr
+	}
+	return ""
+}
+
+func (m *Candidate) GetVotes() int64 {
+	if m != nil {
+		return m.Votes
+	}
+	return 0
+}
+
+func (m *Candidate) GetAbsent() bool {
+	if m != nil {
+		return m.Absent
+	}
+	return false
+}
+
+type ListCandidatesResponse struct {
+	Code    int32  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	// height of the block candidates are listed as of
+	Height uint32 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	// most voted first
+	Candidates []*Candidate `protobuf:"bytes,4,rep,name=candidates" json:"candidates,omitempty"`
+}
+
+func (m *ListCandidatesResponse) Reset()         { *m = ListCandidatesResponse{} }
+func (m *ListCandidatesResponse) String() string { return proto.CompactTextString(m) }
+func (*ListCandidatesResponse) ProtoMessage()    {}
+func (*ListCandidatesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_candidate_74d642052320ca86, []int{6}
+}
+func (m *ListCandidatesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListCandidatesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListCandidatesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *ListCandidatesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListCandidatesResponse.Merge(dst, src)
+}
+func (m *ListCandidatesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListCandidatesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListCandidatesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListCandidatesResponse proto.InternalMessageInfo
+
+func (m *ListCandidatesResponse) GetCode() int32 {
+	if m != nil {
+		return m.Code
+	}
+	return 0
+}
+
+func (m *ListCandidatesResponse) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+func (m *ListCandidatesResponse) GetHeight() uint32 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *ListCandidatesResponse) GetCandidates() []*Candidate {
+	if m != nil {
+		return m.Candidates
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*RegisterCandidateRequest)(nil), "rpcpb.RegisterCandidateRequest")
+	proto.RegisterType((*VoteRequest)(nil), "rpcpb.VoteRequest")
+	proto.RegisterType((*ProposeChainParamsRequest)(nil), "rpcpb.ProposeChainParamsRequest")
+	proto.RegisterType((*CandidateTxResponse)(nil), "rpcpb.CandidateTxResponse")
+	proto.RegisterType((*ListCandidatesRequest)(nil), "rpcpb.ListCandidatesRequest")
+	proto.RegisterType((*Candidate)(nil), "rpcpb.Candidate")
+	proto.RegisterType((*ListCandidatesResponse)(nil), "rpcpb.ListCandidatesResponse")
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// CandidateCommandClient is the client API for CandidateCommand service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type CandidateCommandClient interface {
+	RegisterCandidate(ctx context.Context, in *RegisterCandidateRequest, opts ...grpc.CallOption) (*CandidateTxResponse, error)
+	Vote(ctx context.Context, in *VoteRequest, opts ...grpc.CallOption) (*CandidateTxResponse, error)
+	WithdrawVote(ctx context.Context, in *VoteRequest, opts ...grpc.CallOption) (*CandidateTxResponse, error)
+	ProposeChainParams(ctx context.Context, in *ProposeChainParamsRequest, opts ...grpc.CallOption) (*CandidateTxResponse, error)
+	ListCandidates(ctx context.Context, in *ListCandidatesRequest, opts ...grpc.CallOption) (*ListCandidatesResponse, error)
+}
+
+type candidateCommandClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewCandidateCommandClient(cc *grpc.ClientConn) CandidateCommandClient {
+	return &candidateCommandClient{cc}
+}
+
+func (c *candidateCommandClient) RegisterCandidate(ctx context.Context, in *RegisterCandidateRequest, opts ...grpc.CallOption) (*CandidateTxResponse, error) {
+	out := new(CandidateTxResponse)
+	err := c.cc.Invoke(ctx, "/rpcpb.CandidateCommand/RegisterCandidate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *candidateCommandClient) Vote(ctx context.Context, in *VoteRequest, opts ...grpc.CallOption) (*CandidateTxResponse, error) {
+	out := new(CandidateTxResponse)
+	err := c.cc.Invoke(ctx, "/rpcpb.CandidateCommand/Vote", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *candidateCommandClient) WithdrawVote(ctx context.Context, in *VoteRequest, opts ...grpc.CallOption) (*CandidateTxResponse, error) {
+	out := new(CandidateTxResponse)
+	err := c.cc.Invoke(ctx, "/rpcpb.CandidateCommand/WithdrawVote", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *candidateCommandClient) ProposeChainParams(ctx context.Context, in *ProposeChainParamsRequest, opts ...grpc.CallOption) (*CandidateTxResponse, error) {
+	out := new(CandidateTxResponse)
+	err := c.cc.Invoke(ctx, "/rpcpb.CandidateCommand/ProposeChainParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *candidateCommandClient) ListCandidates(ctx context.Context, in *ListCandidatesRequest, opts ...grpc.CallOption) (*ListCandidatesResponse, error) {
+	out := new(ListCandidatesResponse)
+	err := c.cc.Invoke(ctx, "/rpcpb.CandidateCommand/ListCandidates", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CandidateCommandServer is the server API for CandidateCommand service.
+type CandidateCommandServer interface {
+	RegisterCandidate(context.Context, *RegisterCandidateRequest) (*CandidateTxResponse, error)
+	Vote(context.Context, *VoteRequest) (*CandidateTxResponse, error)
+	WithdrawVote(context.Context, *VoteRequest) (*CandidateTxResponse, error)
+	ProposeChainParams(context.Context, *ProposeChainParamsRequest) (*CandidateTxResponse, error)
+	ListCandidates(context.Context, *ListCandidatesRequest) (*ListCandidatesResponse, error)
+}
+
+func RegisterCandidateCommandServer(s *grpc.Server, srv CandidateCommandServer) {
+	s.RegisterService(&_CandidateCommand_serviceDesc, srv)
+}
+
+func _CandidateCommand_RegisterCandidate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisterCandidateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CandidateCommandServer).RegisterCandidate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.CandidateCommand/RegisterCandidate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CandidateCommandServer).RegisterCandidate(ctx, req.(*RegisterCandidateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CandidateCommand_Vote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CandidateCommandServer).Vote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.CandidateCommand/Vote",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CandidateCommandServer).Vote(ctx, req.(*VoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CandidateCommand_WithdrawVote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CandidateCommandServer).WithdrawVote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.CandidateCommand/WithdrawVote",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CandidateCommandServer).WithdrawVote(ctx, req.(*VoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CandidateCommand_ProposeChainParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProposeChainParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CandidateCommandServer).ProposeChainParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.CandidateCommand/ProposeChainParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CandidateCommandServer).ProposeChainParams(ctx, req.(*ProposeChainParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CandidateCommand_ListCandidates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCandidatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CandidateCommandServer).ListCandidates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.CandidateCommand/ListCandidates",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CandidateCommandServer).ListCandidates(ctx, req.(*ListCandidatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _CandidateCommand_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "rpcpb.CandidateCommand",
+	HandlerType: (*CandidateCommandServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "RegisterCandidate",
+			Handler:    _CandidateCommand_RegisterCandidate_Handler,
+		},
+		{
+			MethodName: "Vote",
+			Handler:    _CandidateCommand_Vote_Handler,
+		},
+		{
+			MethodName: "WithdrawVote",
+			Handler:    _CandidateCommand_WithdrawVote_Handler,
+		},
+		{
+			MethodName: "ProposeChainParams",
+			Handler:    _CandidateCommand_ProposeChainParams_Handler,
+		},
+		{
+			MethodName: "ListCandidates",
+			Handler:    _CandidateCommand_ListCandidates_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "candidate.proto",
+}
+
+func (m *RegisterCandidateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RegisterCandidateRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Addr) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCandidate(dAtA, i, uint64(len(m.Addr)))
+		i += copy(dAtA[i:], m.Addr)
+	}
+	if m.FeePerByte != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintCandidate(dAtA, i, uint64(m.FeePerByte))
+	}
+	return i, nil
+}
+
+func (m *VoteRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VoteRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Addr) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCandidate(dAtA, i, uint64(len(m.Addr)))
+		i += copy(dAtA[i:], m.Addr)
+	}
+	if len(m.Candidate) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCandidate(dAtA, i, uint64(len(m.Candidate)))
+		i += copy(dAtA[i:], m.Candidate)
+	}
+	if m.Votes != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintCandidate(dAtA, i, uint64(m.Votes))
+	}
+	if m.FeePerByte != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintCandidate(dAtA, i, uint64(m.FeePerByte))
+	}
+	return i, nil
+}
+
+func (m *ProposeChainParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProposeChainParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Addr) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCandidate(dAtA, i, uint64(len(m.Addr)))
+		i += copy(dAtA[i:], m.Addr)
+	}
+	if m.BlockInterval != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintCandidate(dAtA, i, uint64(m.BlockInterval))
+	}
+	if m.PeriodDuration != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintCandidate(dAtA, i, uint64(m.PeriodDuration))
+	}
+	if m.MaxBlockSize != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintCandidate(dAtA, i, uint64(m.MaxBlockSize))
+	}
+	if m.FeePerByte != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintCandidate(dAtA, i, uint64(m.FeePerByte))
+	}
+	return i, nil
+}
+
+func (m *CandidateTxResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CandidateTxResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Code != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintCandidate(dAtA, i, uint64(m.Code))
+	}
+	if len(m.Message) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCandidate(dAtA, i, uint64(len(m.Message)))
+		i += copy(dAtA[i:], m.Message)
+	}
+	if len(m.Hash) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintCandidate(dAtA, i, uint64(len(m.Hash)))
+		i += copy(dAtA[i:], m.Hash)
+	}
+	if m.Fee != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintCandidate(dAtA, i, uint64(m.Fee))
+	}
+	if m.Tx != nil {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintCandidate(dAtA, i, uint64(m.Tx.Size()))
+		n1, err := m.Tx.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n1
+	}
+	return i, nil
+}
+
+func (m *ListCandidatesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListCandidatesRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	return i, nil
+}
+
+func (m *Candidate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Candidate) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Addr) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCandidate(dAtA, i, uint64(len(m.Addr)))
+		i += copy(dAtA[i:], m.Addr)
+	}
+	if m.Votes != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintCandidate(dAtA, i, uint64(m.Votes))
+	}
+	if m.Absent {
+		dAtA[i] = 0x18
+		i++
+		if m.Absent {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	return i, nil
+}
+
+func (m *ListCandidatesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListCandidatesResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Code != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintCandidate(dAtA, i, uint64(m.Code))
+	}
+	if len(m.Message) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCandidate(dAtA, i, uint64(len(m.Message)))
+		i += copy(dAtA[i:], m.Message)
+	}
+	if m.Height != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintCandidate(dAtA, i, uint64(m.Height))
+	}
+	if len(m.Candidates) > 0 {
+		for _, msg := range m.Candidates {
+			dAtA[i] = 0x22
+			i++
+			i = encodeVarintCandidate(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func encodeVarintCandidate(dAtA []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return offset + 1
+}
+func (m *RegisterCandidateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Addr)
+	if l > 0 {
+		n += 1 + l + sovCandidate(uint64(l))
+	}
+	if m.FeePerByte != 0 {
+		n += 1 + sovCandidate(uint64(m.FeePerByte))
+	}
+	return n
+}
+
+func (m *VoteRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Addr)
+	if l > 0 {
+		n += 1 + l + sovCandidate(uint64(l))
+	}
+	l = len(m.Candidate)
+	if l > 0 {
+		n += 1 + l + sovCandidate(uint64(l))
+	}
+	if m.Votes != 0 {
+		n += 1 + sovCandidate(uint64(m.Votes))
+	}
+	if m.FeePerByte != 0 {
+		n += 1 + sovCandidate(uint64(m.FeePerByte))
+	}
+	return n
+}
+
+func (m *ProposeChainParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Addr)
+	if l > 0 {
+		n += 1 + l + sovCandidate(uint64(l))
+	}
+	if m.BlockInterval != 0 {
+		n += 1 + sovCandidate(uint64(m.BlockInterval))
+	}
+	if m.PeriodDuration != 0 {
+		n += 1 + sovCandidate(uint64(m.PeriodDuration))
+	}
+	if m.MaxBlockSize != 0 {
+		n += 1 + sovCandidate(uint64(m.MaxBlockSize))
+	}
+	if m.FeePerByte != 0 {
+		n += 1 + sovCandidate(uint64(m.FeePerByte))
+	}
+	return n
+}
+
+func (m *CandidateTxResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Code != 0 {
+		n += 1 + sovCandidate(uint64(m.Code))
+	}
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovCandidate(uint64(l))
+	}
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovCandidate(uint64(l))
+	}
+	if m.Fee != 0 {
+		n += 1 + sovCandidate(uint64(m.Fee))
+	}
+	if m.Tx != nil {
+		l = m.Tx.Size()
+		n += 1 + l + sovCandidate(uint64(l))
+	}
+	return n
+}
+
+func (m *ListCandidatesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *Candidate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Addr)
+	if l > 0 {
+		n += 1 + l + sovCandidate(uint64(l))
+	}
+	if m.Votes != 0 {
+		n += 1 + sovCandidate(uint64(m.Votes))
+	}
+	if m.Absent {
+		n += 2
+	}
+	return n
+}
+
+func (m *ListCandidatesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Code != 0 {
+		n += 1 + sovCandidate(uint64(m.Code))
+	}
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovCandidate(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovCandidate(uint64(m.Height))
+	}
+	if len(m.Candidates) > 0 {
+		for _, e := range m.Candidates {
+			l = e.Size()
+			n += 1 + l + sovCandidate(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovCandidate(x uint64) (n int) {
+	for {
+		n++
+		x >>= 7
+		if x == 0 {
+			break
+		}
+	}
+	return n
+}
+func sozCandidate(x uint64) (n int) {
+	return sovCandidate(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *RegisterCandidateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCandidate
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RegisterCandidateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RegisterCandidateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCandidate
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCandidate
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeePerByte", wireType)
+			}
+			m.FeePerByte = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCandidate
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FeePerByte |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCandidate(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCandidate
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VoteRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCandidate
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VoteRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VoteRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCandidate
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCandidate
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Candidate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCandidate
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCandidate
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Candidate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Votes", wireType)
+			}
+			m.Votes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCandidate
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Votes |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeePerByte", wireType)
+			}
+			m.FeePerByte = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCandidate
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FeePerByte |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCandidate(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCandidate
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProposeChainParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCandidate
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProposeChainParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProposeChainParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCandidate
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCandidate
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockInterval", wireType)
+			}
+			m.BlockInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCandidate
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockInterval |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodDuration", wireType)
+			}
+			m.PeriodDuration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCandidate
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PeriodDuration |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBlockSize", wireType)
+			}
+			m.MaxBlockSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCandidate
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxBlockSize |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeePerByte", wireType)
+			}
+			m.FeePerByte = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCandidate
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FeePerByte |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCandidate(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCandidate
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CandidateTxResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCandidate
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CandidateTxResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CandidateTxResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			m.Code = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCandidate
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Code |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCandidate
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCandidate
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCandidate
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCandidate
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			m.Fee = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCandidate
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Fee |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tx", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCandidate
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCandidate
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Tx == nil {
+				m.Tx = &pb.Transaction{}
+			}
+			if err := m.Tx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCandidate(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCandidate
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListCandidatesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCandidate
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListCandidatesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListCandidatesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCandidate(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCandidate
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Candidate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCandidate
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Candidate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Candidate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCandidate
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCandidate
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Votes", wireType)
+			}
+			m.Votes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCandidate
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Votes |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Absent", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCandidate
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Absent = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCandidate(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCandidate
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListCandidatesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCandidate
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListCandidatesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListCandidatesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			m.Code = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCandidate
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Code |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCandidate
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCandidate
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCandidate
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Candidates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCandidate
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCandidate
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Candidates = append(m.Candidates, &Candidate{})
+			if err := m.Candidates[len(m.Candidates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCandidate(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCandidate
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipCandidate(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowCandidate
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowCandidate
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+			return iNdEx, nil
+		case 1:
+			iNdEx += 8
+			return iNdEx, nil
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowCandidate
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			iNdEx += length
+			if length < 0 {
+				return 0, ErrInvalidLengthCandidate
+			}
+			return iNdEx, nil
+		case 3:
+			for {
+				var innerWire uint64
+				var start int = iNdEx
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return 0, ErrIntOverflowCandidate
+					}
+					if iNdEx >= l {
+						return 0, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					innerWire |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				innerWireType := int(innerWire & 0x7)
+				if innerWireType == 4 {
+					break
+				}
+				next, err := skipCandidate(dAtA[start:])
+				if err != nil {
+					return 0, err
+				}
+				iNdEx = start + next
+			}
+			return iNdEx, nil
+		case 4:
+			return iNdEx, nil
+		case 5:
+			iNdEx += 4
+			return iNdEx, nil
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+	}
+	panic("unreachable")
+}
+
+var (
+	ErrInvalidLengthCandidate = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowCandidate   = fmt.Errorf("proto: integer overflow")
+)
+
+func init() { proto.RegisterFile("candidate.proto", fileDescriptor_candidate_74d642052320ca86) }
+
+var fileDescriptor_candidate_74d642052320ca86 = []byte{
+	// 677 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0x4f, 0x4f, 0x13, 0x4f,
+	0x18, 0xc7, 0xd9, 0xfe, 0xe1, 0xf7, 0xeb, 0x03, 0x14, 0x1c, 0xb0, 0xd4, 0x02, 0xa5, 0xae, 0xa2,
+	0x84, 0x43, 0x57, 0xf0, 0xc6, 0xb1, 0x18, 0x13, 0x13, 0x8d, 0xcd, 0x8a, 0xe8, 0xad, 0x99, 0xdd,
+	0x7d, 0xe8, 0x4e, 0xec, 0xee, 0xac, 0x33, 0x53, 0x28, 0x1c, 0x7d, 0x01, 0x86, 0xc4, 0xb7, 0xe1,
+	0xfb, 0xd0, 0x23, 0x89, 0x17, 0x8f, 0x06, 0x7c, 0x21, 0x66, 0x67, 0xb7, 0x8b, 0xa5, 0x40, 0x0c,
+	0xb7, 0x79, 0x9e, 0x99, 0xfd, 0x7c, 0x9f, 0xbf, 0x0b, 0xb3, 0x2e, 0x0d, 0x3d, 0xe6, 0x51, 0x85,
+	0xcd, 0x48, 0x70, 0xc5, 0x49, 0x51, 0x44, 0x6e, 0xe4, 0xd4, 0x36, 0xbb, 0x4c, 0xf9, 0x7d, 0xa7,
+	0xe9, 0xf2, 0xc0, 0x6a, 0xbd, 0x7e, 0xff, 0x9c, 0xf7, 0x43, 0x8f, 0x2a, 0xc6, 0x43, 0xcb, 0xe1,
+	0x03, 0xcf, 0x72, 0xb9, 0x40, 0x2b, 0x72, 0x2c, 0xa7, 0xc7, 0xdd, 0x0f, 0xc9, 0x97, 0xb5, 0xe5,
+	0x2e, 0xe7, 0xdd, 0x1e, 0x5a, 0x34, 0x62, 0x16, 0x0d, 0x43, 0xae, 0xf4, 0x7b, 0x99, 0xdc, 0x9a,
+	0x6d, 0xa8, 0xda, 0xd8, 0x65, 0x52, 0xa1, 0xd8, 0x19, 0x4a, 0xda, 0xf8, 0xb1, 0x8f, 0x52, 0x11,
+	0x02, 0x05, 0xea, 0x79, 0xa2, 0x6a, 0x34, 0x8c, 0xf5, 0x92, 0xad, 0xcf, 0xa4, 0x01, 0xd3, 0xfb,
+	0x88, 0x9d, 0x08, 0x45, 0xc7, 0x39, 0x52, 0x58, 0xcd, 0x35, 0x8c, 0xf5, 0x82, 0x0d, 0xfb, 0x88,
+	0x6d, 0x14, 0xad, 0x23, 0x85, 0xe6, 0x21, 0x4c, 0xed, 0xf1, 0x9b, 0x21, 0xcb, 0x50, 0xca, 0xf2,
+	0xd3, 0x84, 0x92, 0x7d, 0xe1, 0x20, 0x0b, 0x50, 0x3c, 0xe0, 0x0a, 0x65, 0x35, 0xaf, 0xd9, 0x89,
+	0x31, 0x26, 0x5c, 0x18, 0x13, 0xfe, 0x66, 0xc0, 0xbd, 0xb6, 0xe0, 0x11, 0x97, 0xb8, 0xe3, 0x53,
+	0x16, 0xb6, 0xa9, 0xa0, 0x81, 0xbc, 0x29, 0x8e, 0x35, 0x28, 0xeb, 0x4a, 0x75, 0x58, 0xa8, 0x50,
+	0x1c, 0xd0, 0x9e, 0x0e, 0x26, 0x6f, 0xcf, 0x68, 0xef, 0x8b, 0xd4, 0x49, 0x1e, 0xc3, 0x6c, 0x84,
+	0x82, 0x71, 0xaf, 0xe3, 0xf5, 0x85, 0xae, 0x9e, 0x0e, 0x6d, 0xc6, 0x2e, 0x27, 0xee, 0x67, 0xa9,
+	0x97, 0x3c, 0x84, 0x72, 0x40, 0x07, 0x9d, 0x84, 0x29, 0xd9, 0x71, 0x12, 0xe5, 0x8c, 0x3d, 0x1d,
+	0xd0, 0x41, 0x2b, 0x76, 0xbe, 0x61, 0xc7, 0x38, 0x96, 0x49, 0x71, 0x2c, 0x93, 0xcf, 0x06, 0xcc,
+	0x67, 0xdd, 0xd8, 0x1d, 0xd8, 0x28, 0x23, 0x1e, 0x4a, 0x8c, 0x73, 0x70, 0xb9, 0x87, 0x3a, 0x87,
+	0xa2, 0xad, 0xcf, 0xa4, 0x0a, 0xff, 0x05, 0x28, 0x25, 0xed, 0x0e, 0x2b, 0x39, 0x34, 0xe3, 0xd7,
+	0x3e, 0x95, 0xbe, 0x8e, 0xb5, 0x64, 0xeb, 0x33, 0x99, 0x83, 0xfc, 0x3e, 0x0e, 0x8b, 0x17, 0x1f,
+	0xc9, 0x03, 0xc8, 0xa9, 0x81, 0x8e, 0x61, 0x6a, 0x6b, 0xbe, 0x19, 0x0f, 0x50, 0xe4, 0x34, 0x77,
+	0x05, 0x0d, 0x25, 0x75, 0xe3, 0xa4, 0xec, 0x9c, 0x1a, 0x98, 0x8b, 0x70, 0xf7, 0x25, 0x93, 0x2a,
+	0x8b, 0x69, 0x58, 0x55, 0xf3, 0x15, 0x94, 0x32, 0xe7, 0x95, 0x25, 0xce, 0x9a, 0x99, 0x54, 0x36,
+	0x31, 0x48, 0x05, 0x26, 0xa9, 0x23, 0x31, 0x54, 0x3a, 0xb8, 0xff, 0xed, 0xd4, 0x32, 0x4f, 0x0c,
+	0xa8, 0x5c, 0x16, 0xba, 0x55, 0xee, 0x15, 0x98, 0xf4, 0x91, 0x75, 0x7d, 0x95, 0x76, 0x2a, 0xb5,
+	0xc8, 0x13, 0x80, 0x6c, 0xd0, 0x64, 0xb5, 0xd0, 0xc8, 0xaf, 0x4f, 0x6d, 0xcd, 0x35, 0xf5, 0x6e,
+	0x35, 0x2f, 0xe6, 0xff, 0xaf, 0x37, 0x5b, 0x5f, 0x0b, 0x30, 0x97, 0xdd, 0xec, 0xf0, 0x20, 0xa0,
+	0xa1, 0x47, 0x24, 0xdc, 0x19, 0xdb, 0x1a, 0xb2, 0x9a, 0x72, 0xae, 0xdb, 0xa7, 0x5a, 0xed, 0xb2,
+	0xd0, 0x45, 0x6b, 0xcd, 0xfb, 0x9f, 0x7e, 0xfc, 0xfe, 0x92, 0x5b, 0x32, 0x2b, 0xd6, 0xc1, 0xa6,
+	0x95, 0xc9, 0x5b, 0x22, 0x65, 0x6d, 0x1b, 0x1b, 0xe4, 0x2d, 0x14, 0xe2, 0xc5, 0x22, 0x24, 0xc5,
+	0xec, 0xf1, 0x7f, 0x43, 0xaf, 0x68, 0xf4, 0xa2, 0x49, 0x46, 0xd1, 0x71, 0x27, 0x62, 0x2c, 0xc2,
+	0xf4, 0x3b, 0xa6, 0x7c, 0x4f, 0xd0, 0xc3, 0x5b, 0xe1, 0xd7, 0x34, 0x7e, 0x75, 0xdb, 0xd8, 0x30,
+	0x6b, 0xa3, 0x0a, 0x87, 0x29, 0x36, 0x56, 0x22, 0xc7, 0x40, 0xc6, 0x97, 0x93, 0x34, 0x52, 0xf0,
+	0xb5, 0x7b, 0x7b, 0xa3, 0xf4, 0x23, 0x2d, 0xdd, 0x30, 0x97, 0x46, 0x75, 0xa3, 0x04, 0x16, 0x69,
+	0x4e, 0x9c, 0x22, 0x83, 0xf2, 0xe8, 0x54, 0x91, 0xe5, 0x94, 0x7a, 0xe5, 0x54, 0xd7, 0x56, 0xae,
+	0xb9, 0x4d, 0x65, 0x6b, 0x5a, 0x76, 0x81, 0x5c, 0x2a, 0x68, 0x8f, 0x49, 0xd5, 0xaa, 0x7e, 0x3f,
+	0xab, 0x1b, 0xa7, 0x67, 0x75, 0xe3, 0xd7, 0x59, 0xdd, 0x38, 0x39, 0xaf, 0x4f, 0x9c, 0x9e, 0xd7,
+	0x27, 0x7e, 0x9e, 0xd7, 0x27, 0x9c, 0x49, 0xfd, 0xc3, 0x7d, 0xfa, 0x67, 0x00, 0x41, 0x46, 0x06,
+	0x25, 0xdb, 0x05, 0x00, 0x00,
+}
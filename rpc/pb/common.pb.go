@@ -21,6 +21,64 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
 
+// ErrorCode is the registered code of rpc errors. Code fields of responses
+// hold its value, and failed calls carry it in an ErrorDetail of their grpc
+// status
+type ErrorCode int32
+
+const (
+	ErrorCode_OK ErrorCode = 0
+	// the error is not classified, as code -1 replied before codes registered
+	ErrorCode_UNKNOWN            ErrorCode = -1
+	ErrorCode_INVALID_ARGUMENT   ErrorCode = 1
+	ErrorCode_INVALID_ADDRESS    ErrorCode = 2
+	ErrorCode_NOT_FOUND          ErrorCode = 3
+	ErrorCode_INSUFFICIENT_FUNDS ErrorCode = 4
+	// the node is still syncing blocks from peers
+	ErrorCode_NOT_SYNCED ErrorCode = 5
+	// the tx is refused by tx pool, the reason tells why
+	ErrorCode_TX_REJECTED     ErrorCode = 6
+	ErrorCode_WALLET_DISABLED ErrorCode = 7
+	// the account can't sign, as it's not managed by node wallet, still
+	// locked or watch-only
+	ErrorCode_ACCOUNT_LOCKED ErrorCode = 8
+	ErrorCode_TIMEOUT        ErrorCode = 9
+)
+
+var ErrorCode_name = map[int32]string{
+	0:  "OK",
+	-1: "UNKNOWN",
+	1:  "INVALID_ARGUMENT",
+	2:  "INVALID_ADDRESS",
+	3:  "NOT_FOUND",
+	4:  "INSUFFICIENT_FUNDS",
+	5:  "NOT_SYNCED",
+	6:  "TX_REJECTED",
+	7:  "WALLET_DISABLED",
+	8:  "ACCOUNT_LOCKED",
+	9:  "TIMEOUT",
+}
+var ErrorCode_value = map[string]int32{
+	"OK":                 0,
+	"UNKNOWN":            -1,
+	"INVALID_ARGUMENT":   1,
+	"INVALID_ADDRESS":    2,
+	"NOT_FOUND":          3,
+	"INSUFFICIENT_FUNDS": 4,
+	"NOT_SYNCED":         5,
+	"TX_REJECTED":        6,
+	"WALLET_DISABLED":    7,
+	"ACCOUNT_LOCKED":     8,
+	"TIMEOUT":            9,
+}
+
+func (x ErrorCode) String() string {
+	return proto.EnumName(ErrorCode_name, int32(x))
+}
+func (ErrorCode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_common_ba1db0a68d6fd523, []int{0}
+}
+
 type Utxo struct {
 	OutPoint    *pb.OutPoint `protobuf:"bytes,1,opt,name=out_point,json=outPoint" json:"out_point,omitempty"`
 	TxOut       *pb.TxOut    `protobuf:"bytes,2,opt,name=tx_out,json=txOut" json:"tx_out,omitempty"`
@@ -33,7 +91,7 @@ func (m *Utxo) Reset()         { *m = Utxo{} }
 func (m *Utxo) String() string { return proto.CompactTextString(m) }
 func (*Utxo) ProtoMessage()    {}
 func (*Utxo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_common_a5e71acf58e73035, []int{0}
+	return fileDescriptor_common_ba1db0a68d6fd523, []int{0}
 }
 func (m *Utxo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -98,6 +156,7 @@ func (m *Utxo) GetIsSpent() bool {
 }
 
 type BaseResponse struct {
+	// value of ErrorCode, kept int32 so that replies over http stay numeric
 	Code    int32  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
 	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
 }
@@ -106,7 +165,7 @@ func (m *BaseResponse) Reset()         { *m = BaseResponse{} }
 func (m *BaseResponse) String() string { return proto.CompactTextString(m) }
 func (*BaseResponse) ProtoMessage()    {}
 func (*BaseResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_common_a5e71acf58e73035, []int{1}
+	return fileDescriptor_common_ba1db0a68d6fd523, []int{1}
 }
 func (m *BaseResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -149,9 +208,64 @@ func (m *BaseResponse) GetMessage() string {
 	return ""
 }
 
+// ErrorDetail is attached to the grpc status of failed calls
+type ErrorDetail struct {
+	Code   ErrorCode `protobuf:"varint,1,opt,name=code,proto3,enum=rpcpb.ErrorCode" json:"code,omitempty"`
+	Reason string    `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (m *ErrorDetail) Reset()         { *m = ErrorDetail{} }
+func (m *ErrorDetail) String() string { return proto.CompactTextString(m) }
+func (*ErrorDetail) ProtoMessage()    {}
+func (*ErrorDetail) Descriptor() ([]byte, []int) {
+	return fileDescriptor_common_ba1db0a68d6fd523, []int{2}
+}
+func (m *ErrorDetail) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ErrorDetail) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ErrorDetail.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *ErrorDetail) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ErrorDetail.Merge(dst, src)
+}
+func (m *ErrorDetail) XXX_Size() int {
+	return m.Size()
+}
+func (m *ErrorDetail) XXX_DiscardUnknown() {
+	xxx_messageInfo_ErrorDetail.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ErrorDetail proto.InternalMessageInfo
+
+func (m *ErrorDetail) GetCode() ErrorCode {
+	if m != nil {
+		return m.Code
+	}
+	return ErrorCode_OK
+}
+
+func (m *ErrorDetail) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*Utxo)(nil), "rpcpb.Utxo")
 	proto.RegisterType((*BaseResponse)(nil), "rpcpb.BaseResponse")
+	proto.RegisterType((*ErrorDetail)(nil), "rpcpb.ErrorDetail")
+	proto.RegisterEnum("rpcpb.ErrorCode", ErrorCode_name, ErrorCode_value)
 }
 func (m *Utxo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
@@ -245,6 +359,35 @@ func (m *BaseResponse) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
+func (m *ErrorDetail) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ErrorDetail) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Code != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintCommon(dAtA, i, uint64(m.Code))
+	}
+	if len(m.Reason) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCommon(dAtA, i, uint64(len(m.Reason)))
+		i += copy(dAtA[i:], m.Reason)
+	}
+	return i, nil
+}
+
 func encodeVarintCommon(dAtA []byte, offset int, v uint64) int {
 	for v >= 1<<7 {
 		dAtA[offset] = uint8(v&0x7f | 0x80)
@@ -296,6 +439,22 @@ func (m *BaseResponse) Size() (n int) {
 	return n
 }
 
+func (m *ErrorDetail) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Code != 0 {
+		n += 1 + sovCommon(uint64(m.Code))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovCommon(uint64(l))
+	}
+	return n
+}
+
 func sovCommon(x uint64) (n int) {
 	for {
 		n++
@@ -582,6 +741,104 @@ func (m *BaseResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ErrorDetail) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCommon
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ErrorDetail: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ErrorDetail: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			m.Code = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Code |= (ErrorCode(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCommon
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCommon(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCommon
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipCommon(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -687,27 +944,40 @@ var (
 	ErrIntOverflowCommon   = fmt.Errorf("proto: integer overflow")
 )
 
-func init() { proto.RegisterFile("common.proto", fileDescriptor_common_a5e71acf58e73035) }
-
-var fileDescriptor_common_a5e71acf58e73035 = []byte{
-	// 302 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x34, 0x90, 0xcf, 0x4a, 0x03, 0x31,
-	0x18, 0xc4, 0x1b, 0xed, 0xf6, 0x4f, 0xda, 0x82, 0xe4, 0x14, 0x3d, 0xac, 0xb5, 0x78, 0xe8, 0xc5,
-	0x5d, 0xd4, 0xab, 0xa7, 0x0a, 0xe2, 0xad, 0x12, 0x15, 0xbc, 0x2d, 0x9b, 0x34, 0xb4, 0x41, 0x37,
-	0x5f, 0x68, 0xbe, 0xc0, 0x3e, 0x86, 0xcf, 0xe3, 0x13, 0x78, 0xec, 0xd1, 0xa3, 0xb4, 0x2f, 0x22,
-	0x1b, 0xdb, 0x53, 0x66, 0x7e, 0x99, 0x21, 0x43, 0xe8, 0x50, 0x41, 0x55, 0x81, 0xcd, 0xdc, 0x1a,
-	0x10, 0x58, 0xb2, 0x76, 0xca, 0xc9, 0xb3, 0xeb, 0xa5, 0xc1, 0x55, 0x90, 0x99, 0x82, 0x2a, 0x9f,
-	0xcd, 0xdf, 0x1e, 0x20, 0xd8, 0x45, 0x89, 0x06, 0x6c, 0x2e, 0xa1, 0x5e, 0xe4, 0x0a, 0xd6, 0x3a,
-	0x77, 0x32, 0x97, 0x1f, 0xa0, 0xde, 0xff, 0x9b, 0x93, 0x2f, 0x42, 0xdb, 0xaf, 0x58, 0x03, 0xbb,
-	0xa2, 0x7d, 0x08, 0x58, 0x38, 0x30, 0x16, 0x39, 0x19, 0x93, 0xe9, 0xe0, 0xe6, 0x24, 0x6b, 0x1a,
-	0x4e, 0x66, 0xf3, 0x80, 0x4f, 0x0d, 0x17, 0x3d, 0xd8, 0x2b, 0x76, 0x49, 0x3b, 0x58, 0x17, 0x10,
-	0x90, 0x1f, 0xc5, 0xec, 0xe8, 0x90, 0x7d, 0xa9, 0xe7, 0x01, 0x45, 0x82, 0xcd, 0xc1, 0x2e, 0xe8,
-	0x30, 0x3e, 0x56, 0xac, 0xb4, 0x59, 0xae, 0x90, 0x1f, 0x8f, 0xc9, 0x74, 0x24, 0x06, 0x91, 0x3d,
-	0x46, 0xc4, 0xce, 0xe9, 0xc0, 0xf8, 0x42, 0x81, 0xb1, 0xb2, 0xf4, 0x9a, 0xb7, 0xc7, 0x64, 0xda,
-	0x13, 0xd4, 0xf8, 0xfb, 0x3d, 0x61, 0xa7, 0xb4, 0x67, 0x7c, 0xe1, 0x9d, 0xb6, 0xc8, 0x93, 0x78,
-	0xdb, 0x35, 0xfe, 0xb9, 0xb1, 0x93, 0x3b, 0x3a, 0x9c, 0x95, 0x5e, 0x0b, 0xed, 0x1d, 0x58, 0xaf,
-	0x19, 0xa3, 0x6d, 0x05, 0x0b, 0x1d, 0xe7, 0x27, 0x22, 0x6a, 0xc6, 0x69, 0xb7, 0xd2, 0xde, 0x97,
-	0x4b, 0x1d, 0x97, 0xf6, 0xc5, 0xc1, 0xce, 0xf8, 0xf7, 0x36, 0x25, 0x9b, 0x6d, 0x4a, 0x7e, 0xb7,
-	0x29, 0xf9, 0xdc, 0xa5, 0xad, 0xcd, 0x2e, 0x6d, 0xfd, 0xec, 0xd2, 0x96, 0xec, 0xc4, 0xbf, 0xb9,
-	0xfd, 0x0b, 0x00, 0x00, 0xff, 0xff, 0xe5, 0x14, 0x0d, 0x92, 0x65, 0x01, 0x00, 0x00,
+func init() { proto.RegisterFile("common.proto", fileDescriptor_common_ba1db0a68d6fd523) }
+
+var fileDescriptor_common_ba1db0a68d6fd523 = []byte{
+	// 508 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x4c, 0x92, 0xcd, 0x6e, 0xd3, 0x4e,
+	0x14, 0xc5, 0xe3, 0x36, 0x9f, 0xd7, 0x6d, 0x3a, 0x9a, 0x7f, 0x55, 0xf9, 0xcf, 0xc2, 0x84, 0xa8,
+	0x8b, 0x08, 0x09, 0x47, 0x94, 0x2d, 0x9b, 0xc4, 0x9e, 0x80, 0x49, 0x3a, 0x46, 0xfe, 0xa0, 0x65,
+	0x65, 0xd9, 0xce, 0x28, 0xb1, 0x68, 0x3c, 0x96, 0x67, 0x2c, 0xe5, 0x31, 0x78, 0x1e, 0x9e, 0x80,
+	0x65, 0xc5, 0x8a, 0x25, 0x4a, 0x1e, 0x04, 0x64, 0x93, 0x54, 0xcc, 0x66, 0xee, 0x3d, 0xf3, 0x3b,
+	0x67, 0xae, 0x46, 0x03, 0x67, 0x09, 0xdf, 0x6c, 0x78, 0x66, 0xe4, 0x05, 0x97, 0x1c, 0xb7, 0x8a,
+	0x3c, 0xc9, 0xe3, 0x67, 0xaf, 0x57, 0xa9, 0x5c, 0x97, 0xb1, 0x91, 0xf0, 0xcd, 0x78, 0xea, 0xdc,
+	0xcf, 0x78, 0x99, 0x2d, 0x23, 0x99, 0xf2, 0x6c, 0x1c, 0xf3, 0xed, 0x72, 0x9c, 0xf0, 0x82, 0x8d,
+	0xf3, 0x78, 0x1c, 0x3f, 0xf0, 0xe4, 0xcb, 0x5f, 0xe7, 0xf0, 0x9b, 0x02, 0xcd, 0x40, 0x6e, 0x39,
+	0x7e, 0x05, 0x3d, 0x5e, 0xca, 0x30, 0xe7, 0x69, 0x26, 0x35, 0x65, 0xa0, 0x8c, 0xd4, 0x1b, 0x64,
+	0x54, 0x8e, 0x3c, 0x36, 0x9c, 0x52, 0x7e, 0xac, 0x74, 0xb7, 0xcb, 0x0f, 0x15, 0xbe, 0x86, 0xb6,
+	0xdc, 0x86, 0xbc, 0x94, 0xda, 0x49, 0xcd, 0x9e, 0x1f, 0x59, 0x7f, 0xeb, 0x94, 0xd2, 0x6d, 0xc9,
+	0x6a, 0xc3, 0x2f, 0xe0, 0xac, 0xbe, 0x2c, 0x5c, 0xb3, 0x74, 0xb5, 0x96, 0xda, 0xe9, 0x40, 0x19,
+	0x9d, 0xbb, 0x6a, 0xad, 0xbd, 0xaf, 0x25, 0xfc, 0x1c, 0xd4, 0x54, 0x84, 0x09, 0x4f, 0xb3, 0x38,
+	0x12, 0x4c, 0x6b, 0x0e, 0x94, 0x51, 0xd7, 0x85, 0x54, 0x98, 0x07, 0x05, 0xff, 0x0f, 0xdd, 0x54,
+	0x84, 0x22, 0x67, 0x99, 0xd4, 0x5a, 0xf5, 0x69, 0x27, 0x15, 0x5e, 0xd5, 0x0e, 0xdf, 0xc2, 0xd9,
+	0x34, 0x12, 0xcc, 0x65, 0x22, 0xe7, 0x99, 0x60, 0x18, 0x43, 0x33, 0xe1, 0x4b, 0x56, 0x8f, 0xdf,
+	0x72, 0xeb, 0x1a, 0x6b, 0xd0, 0xd9, 0x30, 0x21, 0xa2, 0x15, 0xab, 0x27, 0xed, 0xb9, 0xc7, 0x76,
+	0x38, 0x07, 0x95, 0x14, 0x05, 0x2f, 0x2c, 0x26, 0xa3, 0xf4, 0x01, 0x5f, 0xff, 0x63, 0xee, 0xdf,
+	0x20, 0xa3, 0x7e, 0x52, 0xa3, 0x26, 0x4c, 0xbe, 0x64, 0x87, 0xb8, 0x2b, 0x68, 0x17, 0x2c, 0x12,
+	0x3c, 0x3b, 0xa4, 0x1d, 0xba, 0x97, 0x3f, 0x14, 0xe8, 0x3d, 0xb1, 0xb8, 0x0d, 0x27, 0xce, 0x1c,
+	0x35, 0xf0, 0x25, 0x74, 0x02, 0x3a, 0xa7, 0xce, 0x1d, 0x45, 0xbf, 0x8f, 0x4b, 0xc1, 0x97, 0x80,
+	0x6c, 0xfa, 0x69, 0xb2, 0xb0, 0xad, 0x70, 0xe2, 0xbe, 0x0b, 0x6e, 0x09, 0xf5, 0x91, 0x82, 0xff,
+	0x83, 0x8b, 0x27, 0xd5, 0xb2, 0x5c, 0xe2, 0x79, 0xe8, 0x04, 0x9f, 0x43, 0x8f, 0x3a, 0x7e, 0x38,
+	0x73, 0x02, 0x6a, 0xa1, 0x53, 0x7c, 0x05, 0xd8, 0xa6, 0x5e, 0x30, 0x9b, 0xd9, 0xa6, 0x4d, 0xa8,
+	0x1f, 0xce, 0x02, 0x6a, 0x79, 0xa8, 0x89, 0xfb, 0x00, 0x15, 0xe6, 0x7d, 0xa6, 0x26, 0xb1, 0x50,
+	0x0b, 0x5f, 0x80, 0xea, 0xdf, 0x87, 0x2e, 0xf9, 0x40, 0x4c, 0x9f, 0x58, 0xa8, 0x5d, 0x85, 0xdf,
+	0x4d, 0x16, 0x0b, 0xe2, 0x87, 0x96, 0xed, 0x4d, 0xa6, 0x0b, 0x62, 0xa1, 0x0e, 0xc6, 0xd0, 0x9f,
+	0x98, 0xa6, 0x13, 0x50, 0x3f, 0x5c, 0x38, 0xe6, 0x9c, 0x58, 0xa8, 0x8b, 0x55, 0xe8, 0xf8, 0xf6,
+	0x2d, 0x71, 0x02, 0x1f, 0xf5, 0xa6, 0xda, 0xf7, 0x9d, 0xae, 0x3c, 0xee, 0x74, 0xe5, 0xd7, 0x4e,
+	0x57, 0xbe, 0xee, 0xf5, 0xc6, 0xe3, 0x5e, 0x6f, 0xfc, 0xdc, 0xeb, 0x8d, 0xb8, 0x5d, 0xff, 0x9e,
+	0x37, 0x7f, 0x06, 0x00, 0x97, 0x53, 0x5f, 0x23, 0x87, 0x02, 0x00, 0x00,
 }
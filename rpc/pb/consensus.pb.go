@@ -0,0 +1,2132 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: consensus.proto
+
+package rpcpb
+
+import proto "github.com/gogo/protobuf/proto"
+import fmt "fmt"
+import math "math"
+import _ "google.golang.org/genproto/googleapis/api/annotations"
+
+import (
+	context "golang.org/x/net/context"
+	grpc "google.golang.org/grpc"
+)
+
+import io "io"
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
+
+type GetMinerScheduleRequest struct {
+}
+
+func (m *GetMinerScheduleRequest) Reset()         { *m = GetMinerScheduleRequest{} }
+func (m *GetMinerScheduleRequest) String() string { return proto.CompactTextString(m) }
+func (*GetMinerScheduleRequest) ProtoMessage()    {}
+func (*GetMinerScheduleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_consensus_fa4f165deb6475a4, []int{0}
+}
+func (m *GetMinerScheduleRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetMinerScheduleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetMinerScheduleRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *GetMinerScheduleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetMinerScheduleRequest.Merge(dst, src)
+}
+func (m *GetMinerScheduleRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetMinerScheduleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetMinerScheduleRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetMinerScheduleRequest proto.InternalMessageInfo
+
+type MinerSlot struct {
+	// unix time in seconds the slot starts at
+	Time   int64  `protobuf:"varint,1,opt,name=time,proto3" json:"time,omitempty"`
+	Miner  string `protobuf:"bytes,2,opt,name=miner,proto3" json:"miner,omitempty"`
+	PeerId string `protobuf:"bytes,3,opt,name=peer_id,json=peerId,proto3" json:"peer_id,omitempty"`
+}
+
+func (m *MinerSlot) Reset()         { *m = MinerSlot{} }
+func (m *MinerSlot) String() string { return proto.CompactTextString(m) }
+func (*MinerSlot) ProtoMessage()    {}
+func (*MinerSlot) Descriptor() ([]byte, []int) {
+	return fileDescriptor_consensus_fa4f165deb6475a4, []int{1}
+}
+func (m *MinerSlot) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MinerSlot) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MinerSlot.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *MinerSlot) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MinerSlot.Merge(dst, src)
+}
+func (m *MinerSlot) XXX_Size() int {
+	return m.Size()
+}
+func (m *MinerSlot) XXX_DiscardUnknown() {
+	xxx_messageInfo_MinerSlot.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MinerSlot proto.InternalMessageInfo
+
+func (m *MinerSlot) GetTime() int64 {
+	if m != nil {
+		return m.Time
+	}
+	return 0
+}
+
+func (m *MinerSlot) GetMiner() string {
+	if m != nil {
+		return m.Miner
+	}
+	return ""
+}
+
+func (m *MinerSlot) GetPeerId() string {
+	if m != nil {
+		return m.PeerId
+	}
+	return ""
+}
+
+type GetMinerScheduleResponse struct {
+	Code    int32  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	// number of epochs passed since unix time 0
+	Epoch int64 `protobuf:"varint,3,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	// length of a slot in seconds
+	SlotInterval int64 `protobuf:"varint,4,opt,name=slot_interval,json=slotInterval,proto3" json:"slot_interval,omitempty"`
+	// active miners, in slot order
+	Miners []string `protobuf:"bytes,5,rep,name=miners" json:"miners,omitempty"`
+	// slots of the current epoch in time order
+	Slots []*MinerSlot `protobuf:"bytes,6,rep,name=slots" json:"slots,omitempty"`
+	// unix time in seconds the next epoch starts at
+	NextEpochTime int64 `protobuf:"varint,7,opt,name=next_epoch_time,json=nextEpochTime,proto3" json:"next_epoch_time,omitempty"`
+	// height of the first block of the next epoch if no slot till then is
+	// missed
+	NextEpochHeight uint32   `protobuf:"varint,8,opt,name=next_epoch_height,json=nextEpochHeight,proto3" json:"next_epoch_height,omitempty"`
+	NextMiners      []string `protobuf:"bytes,9,rep,name=next_miners,json=nextMiners" json:"next_miners,omitempty"`
+}
+
+func (m *GetMinerScheduleResponse) Reset()         { *m = GetMinerScheduleResponse{} }
+func (m *GetMinerScheduleResponse) String() string { return proto.CompactTextString(m) }
+func (*GetMinerScheduleResponse) ProtoMessage()    {}
+func (*GetMinerScheduleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_consensus_fa4f165deb6475a4, []int{2}
+}
+func (m *GetMinerScheduleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetMinerScheduleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetMinerScheduleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *GetMinerScheduleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetMinerScheduleResponse.Merge(dst, src)
+}
+func (m *GetMinerScheduleResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetMinerScheduleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetMinerScheduleResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetMinerScheduleResponse proto.InternalMessageInfo
+
+func (m *GetMinerScheduleResponse) GetCode() int32 {
+	if m != nil {
+		return m.Code
+	}
+	return 0
+}
+
+func (m *GetMinerScheduleResponse) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+func (m *GetMinerScheduleResponse) GetEpoch() int64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *GetMinerScheduleResponse) GetSlotInterval() int64 {
+	if m != nil {
+		return m.SlotInterval
+	}
+	return 0
+}
+
+func (m *GetMinerScheduleResponse) GetMiners() []string {
+	if m != nil {
+		return m.Miners
+	}
+	return nil
+}
+
+func (m *GetMinerScheduleResponse) GetSlots() []*MinerSlot {
+	if m != nil {
+		return m.Slots
+	}
+	return nil
+}
+
+func (m *GetMinerScheduleResponse) GetNextEpochTime() int64 {
+	if m != nil {
+		return m.NextEpochTime
+	}
+	return 0
+}
+
+func (m *GetMinerScheduleResponse) GetNextEpochHeight() uint32 {
+	if m != nil {
+		return m.NextEpochHeight
+	}
+	return 0
+}
+
+func (m *GetMinerScheduleResponse) GetNextMiners() []string {
+	if m != nil {
+		return m.NextMiners
+	}
+	return nil
+}
+
+type GetMinerStatsRequest struct {
+	// number of recent epochs counted, all kept by the node if 0
+	Epochs uint32 `protobuf:"varint,1,opt,name=epochs,proto3" json:"epochs,omitempty"`
+}
+
+func (m *GetMinerStatsRequest) Reset()         { *m = GetMinerStatsRequest{} }
+func (m *GetMinerStatsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetMinerStatsRequest) ProtoMessage()    {}
+func (*GetMinerStatsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_consensus_fa4f165deb6475a4, []int{3}
+}
+func (m *GetMinerStatsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetMinerStatsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetMinerStatsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *GetMinerStatsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetMinerStatsRequest.Merge(dst, src)
+}
+func (m *GetMinerStatsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetMinerStatsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetMinerStatsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetMinerStatsRequest proto.InternalMessageInfo
+
+func (m *GetMinerStatsRequest) GetEpochs() uint32 {
+	if m != nil {
+		return m.Epochs
+	}
+	return 0
+}
+
+type EpochMinerStats struct {
+	Epoch    int64  `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	Produced uint32 `protobuf:"varint,2,opt,name=produced,proto3" json:"produced,omitempty"`
+	Expected uint32 `protobuf:"varint,3,opt,name=expected,proto3" json:"expected,omitempty"`
+}
+
+func (m *EpochMinerStats) Reset()         { *m = EpochMinerStats{} }
+func (m *EpochMinerStats) String() string { return proto.CompactTextString(m) }
+func (*EpochMinerStats) ProtoMessage()    {}
+func (*EpochMinerStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_consensus_fa4f165deb6475a4, []int{4}
+}
+func (m *EpochMinerStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EpochMinerStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EpochMinerStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *EpochMinerStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EpochMinerStats.Merge(dst, src)
+}
+func (m *EpochMinerStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *EpochMinerStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_EpochMinerStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EpochMinerStats proto.InternalMessageInfo
+
+func (m *EpochMinerStats) GetEpoch() int64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *EpochMinerStats) GetProduced() uint32 {
+	if m != nil {
+		return m.Produced
+	}
+	return 0
+}
+
+func (m *EpochMinerStats) GetExpected() uint32 {
+	if m != nil {
+		return m.Expected
+	}
+	return 0
+}
+
+type MinerStats struct {
+	Miner    string `protobuf:"bytes,1,opt,name=miner,proto3" json:"miner,omitempty"`
+	Produced uint32 `protobuf:"varint,2,opt,name=produced,proto3" json:"produced,omitempty"`
+	Expected uint32 `protobuf:"varint,3,opt,name=expected,proto3" json:"expected,omitempty"`
+	// if the miner misses too many of its slots, as counted by the node
+	// answering. It's reported only, and doesn't affect consensus
+	Absent bool `protobuf:"varint,4,opt,name=absent,proto3" json:"absent,omitempty"`
+	// counts of each epoch in epoch order
+	Epochs []*EpochMinerStats `protobuf:"bytes,5,rep,name=epochs" json:"epochs,omitempty"`
+}
+
+func (m *MinerStats) Reset()         { *m = MinerStats{} }
+func (m *MinerStats) String() string { return proto.CompactTextString(m) }
+func (*MinerStats) ProtoMessage()    {}
+func (*MinerStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_consensus_fa4f165deb6475a4, []int{5}
+}
+func (m *MinerStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MinerStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MinerStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *MinerStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MinerStats.Merge(dst, src)
+}
+func (m *MinerStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *MinerStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_MinerStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MinerStats proto.InternalMessageInfo
+
+func (m *MinerStats) GetMiner() string {
+	if m != nil {
+		return m.Miner
+	}
+	return ""
+}
+
+func (m *MinerStats) GetProduced() uint32 {
+	if m != nil {
+		return m.Produced
+	}
+	return 0
+}
+
+func (m *MinerStats) GetExpected() uint32 {
+	if m != nil {
+		return m.Expected
+	}
+	return 0
+}
+
+func (m *MinerStats) GetAbsent() bool {
+	if m != nil {
+		return m.Absent
+	}
+	return false
+}
+
+func (m *MinerStats) GetEpochs() []*EpochMinerStats {
+	if m != nil {
+		return m.Epochs
+	}
+	return nil
+}
+
+type GetMinerStatsResponse struct {
+	Code    int32  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	// the miner missing most first
+	Miners []*MinerStats `protobuf:"bytes,3,rep,name=miners" json:"miners,omitempty"`
+}
+
+func (m *GetMinerStatsResponse) Reset()         { *m = GetMinerStatsResponse{} }
+func (m *GetMinerStatsResponse) String() string { return proto.CompactTextString(m) }
+func (*GetMinerStatsResponse) ProtoMessage()    {}
+func (*GetMinerStatsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_consensus_fa4f165deb6475a4, []int{6}
+}
+func (m *GetMinerStatsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetMinerStatsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetMinerStatsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *GetMinerStatsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetMinerStatsResponse.Merge(dst, src)
+}
+func (m *GetMinerStatsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetMinerStatsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetMinerStatsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetMinerStatsResponse proto.InternalMessageInfo
+
+func (m *GetMinerStatsResponse) GetCode() int32 {
+	if m != nil {
+		return m.Code
+	}
+	return 0
+}
+
+func (m *GetMinerStatsResponse) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+func (m *GetMinerStatsResponse) GetMiners() []*MinerStats {
+	if m != nil {
+		return m.Miners
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*GetMinerScheduleRequest)(nil), "rpcpb.GetMinerScheduleRequest")
+	proto.RegisterType((*MinerSlot)(nil), "rpcpb.MinerSlot")
+	proto.RegisterType((*GetMinerScheduleResponse)(nil), "rpcpb.GetMinerScheduleResponse")
+	proto.RegisterType((*GetMinerStatsRequest)(nil), "rpcpb.GetMinerStatsRequest")
+	proto.RegisterType((*EpochMinerStats)(nil), "rpcpb.EpochMinerStats")
+	proto.RegisterType((*MinerStats)(nil), "rpcpb.MinerStats")
+	proto.RegisterType((*GetMinerStatsResponse)(nil), "rpcpb.GetMinerStatsResponse")
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// ConsensusCommandClient is the client API for ConsensusCommand service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type ConsensusCommandClient interface {
+	GetMinerSchedule(ctx context.Context, in *GetMinerScheduleRequest, opts ...grpc.CallOption) (*GetMinerScheduleResponse, error)
+	GetMinerStats(ctx context.Context, in *GetMinerStatsRequest, opts ...grpc.CallOption) (*GetMinerStatsResponse, error)
+}
+
+type consensusCommandClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewConsensusCommandClient(cc *grpc.ClientConn) ConsensusCommandClient {
+	return &consensusCommandClient{cc}
+}
+
+func (c *consensusCommandClient) GetMinerSchedule(ctx context.Context, in *GetMinerScheduleRequest, opts ...grpc.CallOption) (*GetMinerScheduleResponse, error) {
+	out := new(GetMinerScheduleResponse)
+	err := c.cc.Invoke(ctx, "/rpcpb.ConsensusCommand/GetMinerSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *consensusCommandClient) GetMinerStats(ctx context.Context, in *GetMinerStatsRequest, opts ...grpc.CallOption) (*GetMinerStatsResponse, error) {
+	out := new(GetMinerStatsResponse)
+	err := c.cc.Invoke(ctx, "/rpcpb.ConsensusCommand/GetMinerStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ConsensusCommandServer is the server API for ConsensusCommand service.
+type ConsensusCommandServer interface {
+	GetMinerSchedule(context.Context, *GetMinerScheduleRequest) (*GetMinerScheduleResponse, error)
+	GetMinerStats(context.Context, *GetMinerStatsRequest) (*GetMinerStatsResponse, error)
+}
+
+func RegisterConsensusCommandServer(s *grpc.Server, srv ConsensusCommandServer) {
+	s.RegisterService(&_ConsensusCommand_serviceDesc, srv)
+}
+
+func _ConsensusCommand_GetMinerSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMinerScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConsensusCommandServer).GetMinerSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.ConsensusCommand/GetMinerSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConsensusCommandServer).GetMinerSchedule(ctx, req.(*GetMinerScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConsensusCommand_GetMinerStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMinerStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConsensusCommandServer).GetMinerStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.ConsensusCommand/GetMinerStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConsensusCommandServer).GetMinerStats(ctx, req.(*GetMinerStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _ConsensusCommand_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "rpcpb.ConsensusCommand",
+	HandlerType: (*ConsensusCommandServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetMinerSchedule",
+			Handler:    _ConsensusCommand_GetMinerSchedule_Handler,
+		},
+		{
+			MethodName: "GetMinerStats",
+			Handler:    _ConsensusCommand_GetMinerStats_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "consensus.proto",
+}
+
+func (m *GetMinerScheduleRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetMinerScheduleRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	return i, nil
+}
+
+func (m *MinerSlot) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MinerSlot) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Time != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintConsensus(dAtA, i, uint64(m.Time))
+	}
+	if len(m.Miner) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintConsensus(dAtA, i, uint64(len(m.Miner)))
+		i += copy(dAtA[i:], m.Miner)
+	}
+	if len(m.PeerId) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintConsensus(dAtA, i, uint64(len(m.PeerId)))
+		i += copy(dAtA[i:], m.PeerId)
+	}
+	return i, nil
+}
+
+func (m *GetMinerScheduleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetMinerScheduleResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Code != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintConsensus(dAtA, i, uint64(m.Code))
+	}
+	if len(m.Message) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintConsensus(dAtA, i, uint64(len(m.Message)))
+		i += copy(dAtA[i:], m.Message)
+	}
+	if m.Epoch != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintConsensus(dAtA, i, uint64(m.Epoch))
+	}
+	if m.SlotInterval != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintConsensus(dAtA, i, uint64(m.SlotInterval))
+	}
+	if len(m.Miners) > 0 {
+		for _, s := range m.Miners {
+			dAtA[i] = 0x2a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.Slots) > 0 {
+		for _, msg := range m.Slots {
+			dAtA[i] = 0x32
+			i++
+			i = encodeVarintConsensus(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.NextEpochTime != 0 {
+		dAtA[i] = 0x38
+		i++
+		i = encodeVarintConsensus(dAtA, i, uint64(m.NextEpochTime))
+	}
+	if m.NextEpochHeight != 0 {
+		dAtA[i] = 0x40
+		i++
+		i = encodeVarintConsensus(dAtA, i, uint64(m.NextEpochHeight))
+	}
+	if len(m.NextMiners) > 0 {
+		for _, s := range m.NextMiners {
+			dAtA[i] = 0x4a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	return i, nil
+}
+
+func (m *GetMinerStatsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetMinerStatsRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Epochs != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintConsensus(dAtA, i, uint64(m.Epochs))
+	}
+	return i, nil
+}
+
+func (m *EpochMinerStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EpochMinerStats) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintConsensus(dAtA, i, uint64(m.Epoch))
+	}
+	if m.Produced != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintConsensus(dAtA, i, uint64(m.Produced))
+	}
+	if m.Expected != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintConsensus(dAtA, i, uint64(m.Expected))
+	}
+	return i, nil
+}
+
+func (m *MinerStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MinerStats) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Miner) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintConsensus(dAtA, i, uint64(len(m.Miner)))
+		i += copy(dAtA[i:], m.Miner)
+	}
+	if m.Produced != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintConsensus(dAtA, i, uint64(m.Produced))
+	}
+	if m.Expected != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintConsensus(dAtA, i, uint64(m.Expected))
+	}
+	if m.Absent {
+		dAtA[i] = 0x20
+		i++
+		if m.Absent {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if len(m.Epochs) > 0 {
+		for _, msg := range m.Epochs {
+			dAtA[i] = 0x2a
+			i++
+			i = encodeVarintConsensus(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *GetMinerStatsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetMinerStatsResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Code != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintConsensus(dAtA, i, uint64(m.Code))
+	}
+	if len(m.Message) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintConsensus(dAtA, i, uint64(len(m.Message)))
+		i += copy(dAtA[i:], m.Message)
+	}
+	if len(m.Miners) > 0 {
+		for _, msg := range m.Miners {
+			dAtA[i] = 0x1a
+			i++
+			i = encodeVarintConsensus(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func encodeVarintConsensus(dAtA []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return offset + 1
+}
+func (m *GetMinerScheduleRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MinerSlot) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Time != 0 {
+		n += 1 + sovConsensus(uint64(m.Time))
+	}
+	l = len(m.Miner)
+	if l > 0 {
+		n += 1 + l + sovConsensus(uint64(l))
+	}
+	l = len(m.PeerId)
+	if l > 0 {
+		n += 1 + l + sovConsensus(uint64(l))
+	}
+	return n
+}
+
+func (m *GetMinerScheduleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Code != 0 {
+		n += 1 + sovConsensus(uint64(m.Code))
+	}
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovConsensus(uint64(l))
+	}
+	if m.Epoch != 0 {
+		n += 1 + sovConsensus(uint64(m.Epoch))
+	}
+	if m.SlotInterval != 0 {
+		n += 1 + sovConsensus(uint64(m.SlotInterval))
+	}
+	if len(m.Miners) > 0 {
+		for _, s := range m.Miners {
+			l = len(s)
+			n += 1 + l + sovConsensus(uint64(l))
+		}
+	}
+	if len(m.Slots) > 0 {
+		for _, e := range m.Slots {
+			l = e.Size()
+			n += 1 + l + sovConsensus(uint64(l))
+		}
+	}
+	if m.NextEpochTime != 0 {
+		n += 1 + sovConsensus(uint64(m.NextEpochTime))
+	}
+	if m.NextEpochHeight != 0 {
+		n += 1 + sovConsensus(uint64(m.NextEpochHeight))
+	}
+	if len(m.NextMiners) > 0 {
+		for _, s := range m.NextMiners {
+			l = len(s)
+			n += 1 + l + sovConsensus(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GetMinerStatsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Epochs != 0 {
+		n += 1 + sovConsensus(uint64(m.Epochs))
+	}
+	return n
+}
+
+func (m *EpochMinerStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		n += 1 + sovConsensus(uint64(m.Epoch))
+	}
+	if m.Produced != 0 {
+		n += 1 + sovConsensus(uint64(m.Produced))
+	}
+	if m.Expected != 0 {
+		n += 1 + sovConsensus(uint64(m.Expected))
+	}
+	return n
+}
+
+func (m *MinerStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Miner)
+	if l > 0 {
+		n += 1 + l + sovConsensus(uint64(l))
+	}
+	if m.Produced != 0 {
+		n += 1 + sovConsensus(uint64(m.Produced))
+	}
+	if m.Expected != 0 {
+		n += 1 + sovConsensus(uint64(m.Expected))
+	}
+	if m.Absent {
+		n += 2
+	}
+	if len(m.Epochs) > 0 {
+		for _, e := range m.Epochs {
+			l = e.Size()
+			n += 1 + l + sovConsensus(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GetMinerStatsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Code != 0 {
+		n += 1 + sovConsensus(uint64(m.Code))
+	}
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovConsensus(uint64(l))
+	}
+	if len(m.Miners) > 0 {
+		for _, e := range m.Miners {
+			l = e.Size()
+			n += 1 + l + sovConsensus(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovConsensus(x uint64) (n int) {
+	for {
+		n++
+		x >>= 7
+		if x == 0 {
+			break
+		}
+	}
+	return n
+}
+func sozConsensus(x uint64) (n int) {
+	return sovConsensus(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GetMinerScheduleRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowConsensus
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetMinerScheduleRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetMinerScheduleRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipConsensus(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthConsensus
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MinerSlot) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowConsensus
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MinerSlot: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MinerSlot: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			m.Time = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConsensus
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Time |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Miner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConsensus
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConsensus
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Miner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConsensus
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConsensus
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PeerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipConsensus(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthConsensus
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetMinerScheduleResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowConsensus
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetMinerScheduleResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetMinerScheduleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			m.Code = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConsensus
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Code |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConsensus
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConsensus
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConsensus
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlotInterval", wireType)
+			}
+			m.SlotInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConsensus
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SlotInterval |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Miners", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConsensus
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConsensus
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Miners = append(m.Miners, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slots", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConsensus
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthConsensus
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Slots = append(m.Slots, &MinerSlot{})
+			if err := m.Slots[len(m.Slots)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextEpochTime", wireType)
+			}
+			m.NextEpochTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConsensus
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextEpochTime |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextEpochHeight", wireType)
+			}
+			m.NextEpochHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConsensus
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextEpochHeight |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextMiners", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConsensus
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConsensus
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NextMiners = append(m.NextMiners, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipConsensus(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthConsensus
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetMinerStatsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowConsensus
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetMinerStatsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetMinerStatsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epochs", wireType)
+			}
+			m.Epochs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConsensus
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epochs |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipConsensus(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthConsensus
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EpochMinerStats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowConsensus
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EpochMinerStats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EpochMinerStats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConsensus
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Produced", wireType)
+			}
+			m.Produced = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConsensus
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Produced |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expected", wireType)
+			}
+			m.Expected = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConsensus
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Expected |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipConsensus(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthConsensus
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MinerStats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowConsensus
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MinerStats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MinerStats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Miner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConsensus
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConsensus
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Miner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Produced", wireType)
+			}
+			m.Produced = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConsensus
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Produced |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expected", wireType)
+			}
+			m.Expected = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConsensus
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Expected |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Absent", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConsensus
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Absent = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epochs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConsensus
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthConsensus
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Epochs = append(m.Epochs, &EpochMinerStats{})
+			if err := m.Epochs[len(m.Epochs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipConsensus(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthConsensus
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetMinerStatsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowConsensus
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetMinerStatsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetMinerStatsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			m.Code = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConsensus
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Code |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConsensus
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConsensus
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Miners", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConsensus
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthConsensus
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Miners = append(m.Miners, &MinerStats{})
+			if err := m.Miners[len(m.Miners)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipConsensus(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthConsensus
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipConsensus(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowConsensus
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowConsensus
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+			return iNdEx, nil
+		case 1:
+			iNdEx += 8
+			return iNdEx, nil
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowConsensus
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			iNdEx += length
+			if length < 0 {
+				return 0, ErrInvalidLengthConsensus
+			}
+			return iNdEx, nil
+		case 3:
+			for {
+				var innerWire uint64
+				var start int = iNdEx
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return 0, ErrIntOverflowConsensus
+					}
+					if iNdEx >= l {
+						return 0, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					innerWire |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				innerWireType := int(innerWire & 0x7)
+				if innerWireType == 4 {
+					break
+				}
+				next, err := skipConsensus(dAtA[start:])
+				if err != nil {
+					return 0, err
+				}
+				iNdEx = start + next
+			}
+			return iNdEx, nil
+		case 4:
+			return iNdEx, nil
+		case 5:
+			iNdEx += 4
+			return iNdEx, nil
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+	}
+	panic("unreachable")
+}
+
+var (
+	ErrInvalidLengthConsensus = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowConsensus   = fmt.Errorf("proto: integer overflow")
+)
+
+func init() { proto.RegisterFile("consensus.proto", fileDescriptor_consensus_fa4f165deb6475a4) }
+
+var fileDescriptor_consensus_fa4f165deb6475a4 = []byte{
+	// 560 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0xc1, 0x6e, 0xd3, 0x40,
+	0x10, 0xad, 0xeb, 0x3a, 0x4d, 0xa6, 0x58, 0x49, 0x57, 0x25, 0x5d, 0x42, 0xe5, 0x5a, 0x46, 0xaa,
+	0x02, 0x87, 0x44, 0x94, 0x3f, 0xa0, 0x42, 0xd0, 0x03, 0x1c, 0x0c, 0xf7, 0xc8, 0xb1, 0x47, 0x89,
+	0x25, 0xc7, 0x6b, 0xbc, 0x9b, 0xaa, 0x67, 0xbe, 0x00, 0x89, 0x3f, 0xe0, 0x2b, 0xf8, 0x04, 0x8e,
+	0x95, 0xb8, 0x70, 0x44, 0x09, 0xbf, 0xc0, 0x1d, 0xed, 0xac, 0xe3, 0x26, 0x81, 0x5e, 0x7a, 0xf3,
+	0x9b, 0x99, 0xbc, 0x37, 0xf3, 0x66, 0x36, 0xd0, 0x8e, 0x45, 0x2e, 0x31, 0x97, 0x73, 0x39, 0x28,
+	0x4a, 0xa1, 0x04, 0x73, 0xca, 0x22, 0x2e, 0xc6, 0xbd, 0x93, 0x89, 0x10, 0x93, 0x0c, 0x87, 0x51,
+	0x91, 0x0e, 0xa3, 0x3c, 0x17, 0x2a, 0x52, 0xa9, 0xc8, 0xab, 0xa2, 0xe0, 0x11, 0x1c, 0xbf, 0x46,
+	0xf5, 0x36, 0xcd, 0xb1, 0x7c, 0x1f, 0x4f, 0x31, 0x99, 0x67, 0x18, 0xe2, 0xc7, 0x39, 0x4a, 0x15,
+	0xbc, 0x83, 0x96, 0x89, 0x67, 0x42, 0x31, 0x06, 0x7b, 0x2a, 0x9d, 0x21, 0xb7, 0x7c, 0xab, 0x6f,
+	0x87, 0xf4, 0xcd, 0x8e, 0xc0, 0x99, 0xe9, 0x02, 0xbe, 0xeb, 0x5b, 0xfd, 0x56, 0x68, 0x00, 0x3b,
+	0x86, 0xfd, 0x02, 0xb1, 0x1c, 0xa5, 0x09, 0xb7, 0x29, 0xde, 0xd0, 0xf0, 0x32, 0x09, 0xbe, 0xed,
+	0x02, 0xff, 0x57, 0x4b, 0x16, 0xba, 0x6d, 0xcd, 0x1f, 0x8b, 0xc4, 0xf0, 0x3b, 0x21, 0x7d, 0x33,
+	0x0e, 0xfb, 0x33, 0x94, 0x32, 0x9a, 0x60, 0xa5, 0xb0, 0x82, 0x5a, 0x19, 0x0b, 0x11, 0x4f, 0x49,
+	0xc1, 0x0e, 0x0d, 0x60, 0x4f, 0xc0, 0x95, 0x99, 0x50, 0xa3, 0x34, 0x57, 0x58, 0x5e, 0x45, 0x19,
+	0xdf, 0xa3, 0xec, 0x03, 0x1d, 0xbc, 0xac, 0x62, 0xac, 0x0b, 0x0d, 0xea, 0x53, 0x72, 0xc7, 0xb7,
+	0x75, 0x77, 0x06, 0xb1, 0x33, 0x70, 0x74, 0x9d, 0xe4, 0x0d, 0xdf, 0xee, 0x1f, 0x9c, 0x77, 0x06,
+	0xe4, 0xde, 0xa0, 0x76, 0x20, 0x34, 0x69, 0x76, 0x06, 0xed, 0x1c, 0xaf, 0xd5, 0x88, 0x24, 0x47,
+	0xe4, 0xc9, 0x3e, 0xc9, 0xb8, 0x3a, 0xfc, 0x4a, 0x47, 0x3f, 0x68, 0x73, 0x9e, 0xc1, 0xe1, 0x5a,
+	0xdd, 0x14, 0xd3, 0xc9, 0x54, 0xf1, 0xa6, 0x6f, 0xf5, 0xdd, 0xb0, 0x5d, 0x57, 0xbe, 0xa1, 0x30,
+	0x3b, 0x85, 0x03, 0xaa, 0xad, 0x1a, 0x6b, 0x51, 0x63, 0xa0, 0x43, 0x24, 0x2f, 0x83, 0x01, 0x1c,
+	0xd5, 0xce, 0xa9, 0x48, 0xc9, 0x6a, 0x45, 0x7a, 0x18, 0xe2, 0x97, 0xe4, 0x9b, 0x1b, 0x56, 0x28,
+	0x18, 0x41, 0x9b, 0xf8, 0x6f, 0x7f, 0x71, 0x6b, 0x99, 0xb5, 0x6e, 0x59, 0x0f, 0x9a, 0x45, 0x29,
+	0x92, 0x79, 0x8c, 0x09, 0x79, 0xec, 0x86, 0x35, 0xd6, 0x39, 0xbc, 0x2e, 0x30, 0x56, 0x68, 0x36,
+	0xe9, 0x86, 0x35, 0x0e, 0xbe, 0x5a, 0x00, 0x9b, 0xe4, 0xe6, 0x12, 0xac, 0xf5, 0x4b, 0xb8, 0x27,
+	0xb9, 0x9e, 0x2a, 0x1a, 0x4b, 0xcc, 0x15, 0x2d, 0xb0, 0x19, 0x56, 0x88, 0x0d, 0xea, 0x69, 0x1d,
+	0xda, 0x51, 0xb7, 0xda, 0xd1, 0xd6, 0xa8, 0xb5, 0x0b, 0x05, 0x3c, 0xdc, 0x72, 0xed, 0x5e, 0xc7,
+	0xf6, 0xb4, 0xbe, 0x18, 0x9b, 0x64, 0x0f, 0x37, 0x4e, 0xc3, 0x28, 0x9a, 0x82, 0xf3, 0x3f, 0x16,
+	0x74, 0x2e, 0x56, 0xcf, 0xf0, 0x42, 0xcc, 0x66, 0x51, 0x9e, 0x30, 0x09, 0x9d, 0xed, 0xb3, 0x67,
+	0x5e, 0xc5, 0x71, 0xc7, 0xdb, 0xeb, 0x9d, 0xde, 0x99, 0x37, 0x23, 0x04, 0xde, 0xa7, 0x1f, 0xbf,
+	0xbf, 0xec, 0x72, 0xd6, 0x1d, 0x5e, 0x3d, 0x1f, 0xd6, 0x2f, 0x7f, 0x28, 0x57, 0x02, 0x19, 0xb8,
+	0x1b, 0xb3, 0xb3, 0xc7, 0xdb, 0x8c, 0x6b, 0x77, 0xd4, 0x3b, 0xf9, 0x7f, 0xb2, 0xd2, 0xf2, 0x49,
+	0xab, 0xc7, 0xf8, 0xa6, 0x96, 0x99, 0x59, 0x57, 0xbe, 0xe4, 0xdf, 0x17, 0x9e, 0x75, 0xb3, 0xf0,
+	0xac, 0x5f, 0x0b, 0xcf, 0xfa, 0xbc, 0xf4, 0x76, 0x6e, 0x96, 0xde, 0xce, 0xcf, 0xa5, 0xb7, 0x33,
+	0x6e, 0xd0, 0xdf, 0xcc, 0x8b, 0xbf, 0x03, 0x00, 0x0c, 0xe6, 0x82, 0xa1, 0x9e, 0x04, 0x00, 0x00,
+}
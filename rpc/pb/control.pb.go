@@ -30,13 +30,17 @@ const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
 // The request message containing debug level.
 type DebugLevelRequest struct {
 	Level string `protobuf:"bytes,1,opt,name=level,proto3" json:"level,omitempty"`
+	// module to set level of, e.g. chain, script, p2p or pscore. Level of
+	// all modules not having their own is set if empty. Level default makes
+	// a module follow the global level again
+	Module string `protobuf:"bytes,2,opt,name=module,proto3" json:"module,omitempty"`
 }
 
 func (m *DebugLevelRequest) Reset()         { *m = DebugLevelRequest{} }
 func (m *DebugLevelRequest) String() string { return proto.CompactTextString(m) }
 func (*DebugLevelRequest) ProtoMessage()    {}
 func (*DebugLevelRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_control_64397726cecc4593, []int{0}
+	return fileDescriptor_control_5ba66d7dcfcd869f, []int{0}
 }
 func (m *DebugLevelRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -72,6 +76,13 @@ func (m *DebugLevelRequest) GetLevel() string {
 	return ""
 }
 
+func (m *DebugLevelRequest) GetModule() string {
+	if m != nil {
+		return m.Module
+	}
+	return ""
+}
+
 type UpdateNetworkIDRequest struct {
 	Id uint32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 }
@@ -80,7 +91,7 @@ func (m *UpdateNetworkIDRequest) Reset()         { *m = UpdateNetworkIDRequest{}
 func (m *UpdateNetworkIDRequest) String() string { return proto.CompactTextString(m) }
 func (*UpdateNetworkIDRequest) ProtoMessage()    {}
 func (*UpdateNetworkIDRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_control_64397726cecc4593, []int{1}
+	return fileDescriptor_control_5ba66d7dcfcd869f, []int{1}
 }
 func (m *UpdateNetworkIDRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -123,7 +134,7 @@ func (m *GetBlockHeightRequest) Reset()         { *m = GetBlockHeightRequest{} }
 func (m *GetBlockHeightRequest) String() string { return proto.CompactTextString(m) }
 func (*GetBlockHeightRequest) ProtoMessage()    {}
 func (*GetBlockHeightRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_control_64397726cecc4593, []int{2}
+	return fileDescriptor_control_5ba66d7dcfcd869f, []int{2}
 }
 func (m *GetBlockHeightRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -162,7 +173,7 @@ func (m *GetBlockHeightResponse) Reset()         { *m = GetBlockHeightResponse{}
 func (m *GetBlockHeightResponse) String() string { return proto.CompactTextString(m) }
 func (*GetBlockHeightResponse) ProtoMessage()    {}
 func (*GetBlockHeightResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_control_64397726cecc4593, []int{3}
+	return fileDescriptor_control_5ba66d7dcfcd869f, []int{3}
 }
 func (m *GetBlockHeightResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -220,7 +231,7 @@ func (m *GetBlockHashRequest) Reset()         { *m = GetBlockHashRequest{} }
 func (m *GetBlockHashRequest) String() string { return proto.CompactTextString(m) }
 func (*GetBlockHashRequest) ProtoMessage()    {}
 func (*GetBlockHashRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_control_64397726cecc4593, []int{4}
+	return fileDescriptor_control_5ba66d7dcfcd869f, []int{4}
 }
 func (m *GetBlockHashRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -266,7 +277,7 @@ func (m *GetBlockHashResponse) Reset()         { *m = GetBlockHashResponse{} }
 func (m *GetBlockHashResponse) String() string { return proto.CompactTextString(m) }
 func (*GetBlockHashResponse) ProtoMessage()    {}
 func (*GetBlockHashResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_control_64397726cecc4593, []int{5}
+	return fileDescriptor_control_5ba66d7dcfcd869f, []int{5}
 }
 func (m *GetBlockHashResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -324,7 +335,7 @@ func (m *GetBlockRequest) Reset()         { *m = GetBlockRequest{} }
 func (m *GetBlockRequest) String() string { return proto.CompactTextString(m) }
 func (*GetBlockRequest) ProtoMessage()    {}
 func (*GetBlockRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_control_64397726cecc4593, []int{6}
+	return fileDescriptor_control_5ba66d7dcfcd869f, []int{6}
 }
 func (m *GetBlockRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -370,7 +381,7 @@ func (m *GetBlockHeaderResponse) Reset()         { *m = GetBlockHeaderResponse{}
 func (m *GetBlockHeaderResponse) String() string { return proto.CompactTextString(m) }
 func (*GetBlockHeaderResponse) ProtoMessage()    {}
 func (*GetBlockHeaderResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_control_64397726cecc4593, []int{7}
+	return fileDescriptor_control_5ba66d7dcfcd869f, []int{7}
 }
 func (m *GetBlockHeaderResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -430,7 +441,7 @@ func (m *GetBlockResponse) Reset()         { *m = GetBlockResponse{} }
 func (m *GetBlockResponse) String() string { return proto.CompactTextString(m) }
 func (*GetBlockResponse) ProtoMessage()    {}
 func (*GetBlockResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_control_64397726cecc4593, []int{8}
+	return fileDescriptor_control_5ba66d7dcfcd869f, []int{8}
 }
 func (m *GetBlockResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -480,6 +491,249 @@ func (m *GetBlockResponse) GetBlock() *pb.Block {
 	return nil
 }
 
+type GetBlockVerboseRequest struct {
+	// the block at height is fetched if hash is empty
+	Hash   string `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	Height uint32 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	// 0: raw bytes, 1: header and tx hashes, 2: decoded txs
+	Verbosity uint32 `protobuf:"varint,3,opt,name=verbosity,proto3" json:"verbosity,omitempty"`
+}
+
+func (m *GetBlockVerboseRequest) Reset()         { *m = GetBlockVerboseRequest{} }
+func (m *GetBlockVerboseRequest) String() string { return proto.CompactTextString(m) }
+func (*GetBlockVerboseRequest) ProtoMessage()    {}
+func (*GetBlockVerboseRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_control_5ba66d7dcfcd869f, []int{9}
+}
+func (m *GetBlockVerboseRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetBlockVerboseRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetBlockVerboseRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *GetBlockVerboseRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetBlockVerboseRequest.Merge(dst, src)
+}
+func (m *GetBlockVerboseRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetBlockVerboseRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetBlockVerboseRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetBlockVerboseRequest proto.InternalMessageInfo
+
+func (m *GetBlockVerboseRequest) GetHash() string {
+	if m != nil {
+		return m.Hash
+	}
+	return ""
+}
+
+func (m *GetBlockVerboseRequest) GetHeight() uint32 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *GetBlockVerboseRequest) GetVerbosity() uint32 {
+	if m != nil {
+		return m.Verbosity
+	}
+	return 0
+}
+
+type BlockInfo struct {
+	Hash   string `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	Height uint32 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	// 0 if the block is not on main chain
+	Confirmations uint32          `protobuf:"varint,3,opt,name=confirmations,proto3" json:"confirmations,omitempty"`
+	BlockSize     uint32          `protobuf:"varint,4,opt,name=block_size,json=blockSize,proto3" json:"block_size,omitempty"`
+	Header        *pb.BlockHeader `protobuf:"bytes,5,opt,name=header" json:"header,omitempty"`
+	TxCount       uint32          `protobuf:"varint,6,opt,name=tx_count,json=txCount,proto3" json:"tx_count,omitempty"`
+	// hash of the next block on main chain, if any
+	NextHash string `protobuf:"bytes,7,opt,name=next_hash,json=nextHash,proto3" json:"next_hash,omitempty"`
+	// set if verbosity is 1
+	TxHashes []string `protobuf:"bytes,8,rep,name=tx_hashes,json=txHashes" json:"tx_hashes,omitempty"`
+	// set if verbosity is 2
+	Txs []*TransactionDetail `protobuf:"bytes,9,rep,name=txs" json:"txs,omitempty"`
+}
+
+func (m *BlockInfo) Reset()         { *m = BlockInfo{} }
+func (m *BlockInfo) String() string { return proto.CompactTextString(m) }
+func (*BlockInfo) ProtoMessage()    {}
+func (*BlockInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_control_5ba66d7dcfcd869f, []int{10}
+}
+func (m *BlockInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BlockInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BlockInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *BlockInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockInfo.Merge(dst, src)
+}
+func (m *BlockInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *BlockInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockInfo proto.InternalMessageInfo
+
+func (m *BlockInfo) GetHash() string {
+	if m != nil {
+		return m.Hash
+	}
+	return ""
+}
+
+func (m *BlockInfo) GetHeight() uint32 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *BlockInfo) GetConfirmations() uint32 {
+	if m != nil {
+		return m.Confirmations
+	}
+	return 0
+}
+
+func (m *BlockInfo) GetBlockSize() uint32 {
+	if m != nil {
+		return m.BlockSize
+	}
+	return 0
+}
+
+func (m *BlockInfo) GetHeader() *pb.BlockHeader {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *BlockInfo) GetTxCount() uint32 {
+	if m != nil {
+		return m.TxCount
+	}
+	return 0
+}
+
+func (m *BlockInfo) GetNextHash() string {
+	if m != nil {
+		return m.NextHash
+	}
+	return ""
+}
+
+func (m *BlockInfo) GetTxHashes() []string {
+	if m != nil {
+		return m.TxHashes
+	}
+	return nil
+}
+
+func (m *BlockInfo) GetTxs() []*TransactionDetail {
+	if m != nil {
+		return m.Txs
+	}
+	return nil
+}
+
+type GetBlockVerboseResponse struct {
+	Code    int32  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	// serialized block or header, set if verbosity is 0
+	Raw   []byte     `protobuf:"bytes,3,opt,name=raw,proto3" json:"raw,omitempty"`
+	Block *BlockInfo `protobuf:"bytes,4,opt,name=block" json:"block,omitempty"`
+}
+
+func (m *GetBlockVerboseResponse) Reset()         { *m = GetBlockVerboseResponse{} }
+func (m *GetBlockVerboseResponse) String() string { return proto.CompactTextString(m) }
+func (*GetBlockVerboseResponse) ProtoMessage()    {}
+func (*GetBlockVerboseResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_control_5ba66d7dcfcd869f, []int{11}
+}
+func (m *GetBlockVerboseResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetBlockVerboseResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetBlockVerboseResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *GetBlockVerboseResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetBlockVerboseResponse.Merge(dst, src)
+}
+func (m *GetBlockVerboseResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetBlockVerboseResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetBlockVerboseResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetBlockVerboseResponse proto.InternalMessageInfo
+
+func (m *GetBlockVerboseResponse) GetCode() int32 {
+	if m != nil {
+		return m.Code
+	}
+	return 0
+}
+
+func (m *GetBlockVerboseResponse) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+func (m *GetBlockVerboseResponse) GetRaw() []byte {
+	if m != nil {
+		return m.Raw
+	}
+	return nil
+}
+
+func (m *GetBlockVerboseResponse) GetBlock() *BlockInfo {
+	if m != nil {
+		return m.Block
+	}
+	return nil
+}
+
 type Node struct {
 	Id    string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	Addrs []string `protobuf:"bytes,2,rep,name=addrs" json:"addrs,omitempty"`
@@ -490,7 +744,7 @@ func (m *Node) Reset()         { *m = Node{} }
 func (m *Node) String() string { return proto.CompactTextString(m) }
 func (*Node) ProtoMessage()    {}
 func (*Node) Descriptor() ([]byte, []int) {
-	return fileDescriptor_control_64397726cecc4593, []int{9}
+	return fileDescriptor_control_5ba66d7dcfcd869f, []int{12}
 }
 func (m *Node) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -547,7 +801,7 @@ func (m *GetNodeInfoRequest) Reset()         { *m = GetNodeInfoRequest{} }
 func (m *GetNodeInfoRequest) String() string { return proto.CompactTextString(m) }
 func (*GetNodeInfoRequest) ProtoMessage()    {}
 func (*GetNodeInfoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_control_64397726cecc4593, []int{10}
+	return fileDescriptor_control_5ba66d7dcfcd869f, []int{13}
 }
 func (m *GetNodeInfoRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -584,7 +838,7 @@ func (m *GetNodeInfoResponse) Reset()         { *m = GetNodeInfoResponse{} }
 func (m *GetNodeInfoResponse) String() string { return proto.CompactTextString(m) }
 func (*GetNodeInfoResponse) ProtoMessage()    {}
 func (*GetNodeInfoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_control_64397726cecc4593, []int{11}
+	return fileDescriptor_control_5ba66d7dcfcd869f, []int{14}
 }
 func (m *GetNodeInfoResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -620,853 +874,7393 @@ func (m *GetNodeInfoResponse) GetNodes() []*Node {
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*DebugLevelRequest)(nil), "rpcpb.DebugLevelRequest")
-	proto.RegisterType((*UpdateNetworkIDRequest)(nil), "rpcpb.UpdateNetworkIDRequest")
-	proto.RegisterType((*GetBlockHeightRequest)(nil), "rpcpb.GetBlockHeightRequest")
-	proto.RegisterType((*GetBlockHeightResponse)(nil), "rpcpb.GetBlockHeightResponse")
-	proto.RegisterType((*GetBlockHashRequest)(nil), "rpcpb.GetBlockHashRequest")
-	proto.RegisterType((*GetBlockHashResponse)(nil), "rpcpb.GetBlockHashResponse")
-	proto.RegisterType((*GetBlockRequest)(nil), "rpcpb.GetBlockRequest")
-	proto.RegisterType((*GetBlockHeaderResponse)(nil), "rpcpb.GetBlockHeaderResponse")
-	proto.RegisterType((*GetBlockResponse)(nil), "rpcpb.GetBlockResponse")
-	proto.RegisterType((*Node)(nil), "rpcpb.Node")
-	proto.RegisterType((*GetNodeInfoRequest)(nil), "rpcpb.GetNodeInfoRequest")
-	proto.RegisterType((*GetNodeInfoResponse)(nil), "rpcpb.GetNodeInfoResponse")
+type GetNetworkInfoRequest struct {
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+func (m *GetNetworkInfoRequest) Reset()         { *m = GetNetworkInfoRequest{} }
+func (m *GetNetworkInfoRequest) String() string { return proto.CompactTextString(m) }
+func (*GetNetworkInfoRequest) ProtoMessage()    {}
+func (*GetNetworkInfoRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_control_5ba66d7dcfcd869f, []int{15}
+}
+func (m *GetNetworkInfoRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetNetworkInfoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetNetworkInfoRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *GetNetworkInfoRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetNetworkInfoRequest.Merge(dst, src)
+}
+func (m *GetNetworkInfoRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetNetworkInfoRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetNetworkInfoRequest.DiscardUnknown(m)
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_GetNetworkInfoRequest proto.InternalMessageInfo
 
-// ContorlCommandClient is the client API for ContorlCommand service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type ContorlCommandClient interface {
-	// set boxd debug level
-	SetDebugLevel(ctx context.Context, in *DebugLevelRequest, opts ...grpc.CallOption) (*BaseResponse, error)
-	UpdateNetworkID(ctx context.Context, in *UpdateNetworkIDRequest, opts ...grpc.CallOption) (*BaseResponse, error)
-	GetBlockHeight(ctx context.Context, in *GetBlockHeightRequest, opts ...grpc.CallOption) (*GetBlockHeightResponse, error)
-	GetBlockHash(ctx context.Context, in *GetBlockHashRequest, opts ...grpc.CallOption) (*GetBlockHashResponse, error)
-	GetBlockHeader(ctx context.Context, in *GetBlockRequest, opts ...grpc.CallOption) (*GetBlockHeaderResponse, error)
-	GetBlock(ctx context.Context, in *GetBlockRequest, opts ...grpc.CallOption) (*GetBlockResponse, error)
-	GetNodeInfo(ctx context.Context, in *GetNodeInfoRequest, opts ...grpc.CallOption) (*GetNodeInfoResponse, error)
+type GetNetworkInfoResponse struct {
+	Code        int32    `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message     string   `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	PeerId      string   `protobuf:"bytes,3,opt,name=peer_id,json=peerId,proto3" json:"peer_id,omitempty"`
+	NetworkId   uint32   `protobuf:"varint,4,opt,name=network_id,json=networkId,proto3" json:"network_id,omitempty"`
+	ListenAddrs []string `protobuf:"bytes,5,rep,name=listen_addrs,json=listenAddrs" json:"listen_addrs,omitempty"`
+	PeerCount   uint32   `protobuf:"varint,6,opt,name=peer_count,json=peerCount,proto3" json:"peer_count,omitempty"`
+	Synced      bool     `protobuf:"varint,7,opt,name=synced,proto3" json:"synced,omitempty"`
 }
 
-type contorlCommandClient struct {
-	cc *grpc.ClientConn
+func (m *GetNetworkInfoResponse) Reset()         { *m = GetNetworkInfoResponse{} }
+func (m *GetNetworkInfoResponse) String() string { return proto.CompactTextString(m) }
+func (*GetNetworkInfoResponse) ProtoMessage()    {}
+func (*GetNetworkInfoResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_control_5ba66d7dcfcd869f, []int{16}
 }
-
-func NewContorlCommandClient(cc *grpc.ClientConn) ContorlCommandClient {
-	return &contorlCommandClient{cc}
+func (m *GetNetworkInfoResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *contorlCommandClient) SetDebugLevel(ctx context.Context, in *DebugLevelRequest, opts ...grpc.CallOption) (*BaseResponse, error) {
-	out := new(BaseResponse)
-	err := c.cc.Invoke(ctx, "/rpcpb.ContorlCommand/SetDebugLevel", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *GetNetworkInfoResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetNetworkInfoResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (dst *GetNetworkInfoResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetNetworkInfoResponse.Merge(dst, src)
+}
+func (m *GetNetworkInfoResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetNetworkInfoResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetNetworkInfoResponse.DiscardUnknown(m)
 }
 
-func (c *contorlCommandClient) UpdateNetworkID(ctx context.Context, in *UpdateNetworkIDRequest, opts ...grpc.CallOption) (*BaseResponse, error) {
-	out := new(BaseResponse)
-	err := c.cc.Invoke(ctx, "/rpcpb.ContorlCommand/UpdateNetworkID", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_GetNetworkInfoResponse proto.InternalMessageInfo
+
+func (m *GetNetworkInfoResponse) GetCode() int32 {
+	if m != nil {
+		return m.Code
 	}
-	return out, nil
+	return 0
 }
 
-func (c *contorlCommandClient) GetBlockHeight(ctx context.Context, in *GetBlockHeightRequest, opts ...grpc.CallOption) (*GetBlockHeightResponse, error) {
-	out := new(GetBlockHeightResponse)
-	err := c.cc.Invoke(ctx, "/rpcpb.ContorlCommand/GetBlockHeight", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *GetNetworkInfoResponse) GetMessage() string {
+	if m != nil {
+		return m.Message
 	}
-	return out, nil
+	return ""
 }
 
-func (c *contorlCommandClient) GetBlockHash(ctx context.Context, in *GetBlockHashRequest, opts ...grpc.CallOption) (*GetBlockHashResponse, error) {
-	out := new(GetBlockHashResponse)
-	err := c.cc.Invoke(ctx, "/rpcpb.ContorlCommand/GetBlockHash", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *GetNetworkInfoResponse) GetPeerId() string {
+	if m != nil {
+		return m.PeerId
 	}
-	return out, nil
+	return ""
 }
 
-func (c *contorlCommandClient) GetBlockHeader(ctx context.Context, in *GetBlockRequest, opts ...grpc.CallOption) (*GetBlockHeaderResponse, error) {
-	out := new(GetBlockHeaderResponse)
-	err := c.cc.Invoke(ctx, "/rpcpb.ContorlCommand/GetBlockHeader", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *GetNetworkInfoResponse) GetNetworkId() uint32 {
+	if m != nil {
+		return m.NetworkId
 	}
-	return out, nil
+	return 0
 }
 
-func (c *contorlCommandClient) GetBlock(ctx context.Context, in *GetBlockRequest, opts ...grpc.CallOption) (*GetBlockResponse, error) {
-	out := new(GetBlockResponse)
-	err := c.cc.Invoke(ctx, "/rpcpb.ContorlCommand/GetBlock", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *GetNetworkInfoResponse) GetListenAddrs() []string {
+	if m != nil {
+		return m.ListenAddrs
 	}
-	return out, nil
+	return nil
 }
 
-func (c *contorlCommandClient) GetNodeInfo(ctx context.Context, in *GetNodeInfoRequest, opts ...grpc.CallOption) (*GetNodeInfoResponse, error) {
-	out := new(GetNodeInfoResponse)
-	err := c.cc.Invoke(ctx, "/rpcpb.ContorlCommand/GetNodeInfo", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *GetNetworkInfoResponse) GetPeerCount() uint32 {
+	if m != nil {
+		return m.PeerCount
 	}
-	return out, nil
+	return 0
 }
 
-// ContorlCommandServer is the server API for ContorlCommand service.
-type ContorlCommandServer interface {
-	// set boxd debug level
-	SetDebugLevel(context.Context, *DebugLevelRequest) (*BaseResponse, error)
-	UpdateNetworkID(context.Context, *UpdateNetworkIDRequest) (*BaseResponse, error)
-	GetBlockHeight(context.Context, *GetBlockHeightRequest) (*GetBlockHeightResponse, error)
-	GetBlockHash(context.Context, *GetBlockHashRequest) (*GetBlockHashResponse, error)
-	GetBlockHeader(context.Context, *GetBlockRequest) (*GetBlockHeaderResponse, error)
-	GetBlock(context.Context, *GetBlockRequest) (*GetBlockResponse, error)
-	GetNodeInfo(context.Context, *GetNodeInfoRequest) (*GetNodeInfoResponse, error)
+func (m *GetNetworkInfoResponse) GetSynced() bool {
+	if m != nil {
+		return m.Synced
+	}
+	return false
 }
 
-func RegisterContorlCommandServer(s *grpc.Server, srv ContorlCommandServer) {
-	s.RegisterService(&_ContorlCommand_serviceDesc, srv)
+type GetPeerInfoRequest struct {
+	PeerId string `protobuf:"bytes,1,opt,name=peer_id,json=peerId,proto3" json:"peer_id,omitempty"`
 }
 
-func _ContorlCommand_SetDebugLevel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DebugLevelRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(ContorlCommandServer).SetDebugLevel(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/rpcpb.ContorlCommand/SetDebugLevel",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ContorlCommandServer).SetDebugLevel(ctx, req.(*DebugLevelRequest))
+func (m *GetPeerInfoRequest) Reset()         { *m = GetPeerInfoRequest{} }
+func (m *GetPeerInfoRequest) String() string { return proto.CompactTextString(m) }
+func (*GetPeerInfoRequest) ProtoMessage()    {}
+func (*GetPeerInfoRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_control_5ba66d7dcfcd869f, []int{17}
+}
+func (m *GetPeerInfoRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetPeerInfoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetPeerInfoRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (dst *GetPeerInfoRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetPeerInfoRequest.Merge(dst, src)
+}
+func (m *GetPeerInfoRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetPeerInfoRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetPeerInfoRequest.DiscardUnknown(m)
 }
 
-func _ContorlCommand_UpdateNetworkID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateNetworkIDRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+var xxx_messageInfo_GetPeerInfoRequest proto.InternalMessageInfo
+
+func (m *GetPeerInfoRequest) GetPeerId() string {
+	if m != nil {
+		return m.PeerId
 	}
-	if interceptor == nil {
-		return srv.(ContorlCommandServer).UpdateNetworkID(ctx, in)
+	return ""
+}
+
+type PeerInfo struct {
+	Id   string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Addr string `protobuf:"bytes,2,opt,name=addr,proto3" json:"addr,omitempty"`
+	// whether the peer dialed the node
+	Inbound bool `protobuf:"varint,3,opt,name=inbound,proto3" json:"inbound,omitempty"`
+	Synced  bool `protobuf:"varint,4,opt,name=synced,proto3" json:"synced,omitempty"`
+	// DynamicPeerScore value
+	Score int64 `protobuf:"varint,5,opt,name=score,proto3" json:"score,omitempty"`
+	// round trip of the last ping in milliseconds, 0 for inbound peers
+	Latency   int64  `protobuf:"varint,6,opt,name=latency,proto3" json:"latency,omitempty"`
+	BytesSent uint64 `protobuf:"varint,7,opt,name=bytes_sent,json=bytesSent,proto3" json:"bytes_sent,omitempty"`
+	BytesRecv uint64 `protobuf:"varint,8,opt,name=bytes_recv,json=bytesRecv,proto3" json:"bytes_recv,omitempty"`
+	// unix time of the last message received
+	LastMsgTime int64 `protobuf:"varint,9,opt,name=last_msg_time,json=lastMsgTime,proto3" json:"last_msg_time,omitempty"`
+	// traffic by p2p message code
+	Traffic []*MessageTraffic `protobuf:"bytes,10,rep,name=traffic" json:"traffic,omitempty"`
+}
+
+func (m *PeerInfo) Reset()         { *m = PeerInfo{} }
+func (m *PeerInfo) String() string { return proto.CompactTextString(m) }
+func (*PeerInfo) ProtoMessage()    {}
+func (*PeerInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_control_5ba66d7dcfcd869f, []int{18}
+}
+func (m *PeerInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PeerInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PeerInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/rpcpb.ContorlCommand/UpdateNetworkID",
+}
+func (dst *PeerInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PeerInfo.Merge(dst, src)
+}
+func (m *PeerInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *PeerInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_PeerInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PeerInfo proto.InternalMessageInfo
+
+func (m *PeerInfo) GetId() string {
+	if m != nil {
+		return m.Id
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ContorlCommandServer).UpdateNetworkID(ctx, req.(*UpdateNetworkIDRequest))
+	return ""
+}
+
+func (m *PeerInfo) GetAddr() string {
+	if m != nil {
+		return m.Addr
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _ContorlCommand_GetBlockHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetBlockHeightRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *PeerInfo) GetInbound() bool {
+	if m != nil {
+		return m.Inbound
 	}
-	if interceptor == nil {
-		return srv.(ContorlCommandServer).GetBlockHeight(ctx, in)
+	return false
+}
+
+func (m *PeerInfo) GetSynced() bool {
+	if m != nil {
+		return m.Synced
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/rpcpb.ContorlCommand/GetBlockHeight",
+	return false
+}
+
+func (m *PeerInfo) GetScore() int64 {
+	if m != nil {
+		return m.Score
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ContorlCommandServer).GetBlockHeight(ctx, req.(*GetBlockHeightRequest))
+	return 0
+}
+
+func (m *PeerInfo) GetLatency() int64 {
+	if m != nil {
+		return m.Latency
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _ContorlCommand_GetBlockHash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetBlockHashRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *PeerInfo) GetBytesSent() uint64 {
+	if m != nil {
+		return m.BytesSent
 	}
-	if interceptor == nil {
-		return srv.(ContorlCommandServer).GetBlockHash(ctx, in)
+	return 0
+}
+
+func (m *PeerInfo) GetBytesRecv() uint64 {
+	if m != nil {
+		return m.BytesRecv
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/rpcpb.ContorlCommand/GetBlockHash",
+	return 0
+}
+
+func (m *PeerInfo) GetLastMsgTime() int64 {
+	if m != nil {
+		return m.LastMsgTime
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ContorlCommandServer).GetBlockHash(ctx, req.(*GetBlockHashRequest))
+	return 0
+}
+
+func (m *PeerInfo) GetTraffic() []*MessageTraffic {
+	if m != nil {
+		return m.Traffic
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _ContorlCommand_GetBlockHeader_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetBlockRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+type MessageTraffic struct {
+	Code      uint32 `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	MsgsSent  uint64 `protobuf:"varint,2,opt,name=msgs_sent,json=msgsSent,proto3" json:"msgs_sent,omitempty"`
+	BytesSent uint64 `protobuf:"varint,3,opt,name=bytes_sent,json=bytesSent,proto3" json:"bytes_sent,omitempty"`
+	MsgsRecv  uint64 `protobuf:"varint,4,opt,name=msgs_recv,json=msgsRecv,proto3" json:"msgs_recv,omitempty"`
+	BytesRecv uint64 `protobuf:"varint,5,opt,name=bytes_recv,json=bytesRecv,proto3" json:"bytes_recv,omitempty"`
+}
+
+func (m *MessageTraffic) Reset()         { *m = MessageTraffic{} }
+func (m *MessageTraffic) String() string { return proto.CompactTextString(m) }
+func (*MessageTraffic) ProtoMessage()    {}
+func (*MessageTraffic) Descriptor() ([]byte, []int) {
+	return fileDescriptor_control_5ba66d7dcfcd869f, []int{19}
+}
+func (m *MessageTraffic) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MessageTraffic) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MessageTraffic.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if interceptor == nil {
-		return srv.(ContorlCommandServer).GetBlockHeader(ctx, in)
+}
+func (dst *MessageTraffic) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MessageTraffic.Merge(dst, src)
+}
+func (m *MessageTraffic) XXX_Size() int {
+	return m.Size()
+}
+func (m *MessageTraffic) XXX_DiscardUnknown() {
+	xxx_messageInfo_MessageTraffic.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MessageTraffic proto.InternalMessageInfo
+
+func (m *MessageTraffic) GetCode() uint32 {
+	if m != nil {
+		return m.Code
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/rpcpb.ContorlCommand/GetBlockHeader",
+	return 0
+}
+
+func (m *MessageTraffic) GetMsgsSent() uint64 {
+	if m != nil {
+		return m.MsgsSent
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ContorlCommandServer).GetBlockHeader(ctx, req.(*GetBlockRequest))
+	return 0
+}
+
+func (m *MessageTraffic) GetBytesSent() uint64 {
+	if m != nil {
+		return m.BytesSent
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _ContorlCommand_GetBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetBlockRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *MessageTraffic) GetMsgsRecv() uint64 {
+	if m != nil {
+		return m.MsgsRecv
 	}
-	if interceptor == nil {
-		return srv.(ContorlCommandServer).GetBlock(ctx, in)
+	return 0
+}
+
+func (m *MessageTraffic) GetBytesRecv() uint64 {
+	if m != nil {
+		return m.BytesRecv
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/rpcpb.ContorlCommand/GetBlock",
+	return 0
+}
+
+type GetPeerInfoResponse struct {
+	Code    int32       `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message string      `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Peers   []*PeerInfo `protobuf:"bytes,3,rep,name=peers" json:"peers,omitempty"`
+}
+
+func (m *GetPeerInfoResponse) Reset()         { *m = GetPeerInfoResponse{} }
+func (m *GetPeerInfoResponse) String() string { return proto.CompactTextString(m) }
+func (*GetPeerInfoResponse) ProtoMessage()    {}
+func (*GetPeerInfoResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_control_5ba66d7dcfcd869f, []int{20}
+}
+func (m *GetPeerInfoResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetPeerInfoResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetPeerInfoResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ContorlCommandServer).GetBlock(ctx, req.(*GetBlockRequest))
+}
+func (dst *GetPeerInfoResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetPeerInfoResponse.Merge(dst, src)
+}
+func (m *GetPeerInfoResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetPeerInfoResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetPeerInfoResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetPeerInfoResponse proto.InternalMessageInfo
+
+func (m *GetPeerInfoResponse) GetCode() int32 {
+	if m != nil {
+		return m.Code
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _ContorlCommand_GetNodeInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetNodeInfoRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *GetPeerInfoResponse) GetMessage() string {
+	if m != nil {
+		return m.Message
 	}
-	if interceptor == nil {
-		return srv.(ContorlCommandServer).GetNodeInfo(ctx, in)
+	return ""
+}
+
+func (m *GetPeerInfoResponse) GetPeers() []*PeerInfo {
+	if m != nil {
+		return m.Peers
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/rpcpb.ContorlCommand/GetNodeInfo",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ContorlCommandServer).GetNodeInfo(ctx, req.(*GetNodeInfoRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-var _ContorlCommand_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "rpcpb.ContorlCommand",
-	HandlerType: (*ContorlCommandServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "SetDebugLevel",
-			Handler:    _ContorlCommand_SetDebugLevel_Handler,
-		},
-		{
-			MethodName: "UpdateNetworkID",
-			Handler:    _ContorlCommand_UpdateNetworkID_Handler,
-		},
-		{
-			MethodName: "GetBlockHeight",
-			Handler:    _ContorlCommand_GetBlockHeight_Handler,
-		},
-		{
-			MethodName: "GetBlockHash",
-			Handler:    _ContorlCommand_GetBlockHash_Handler,
-		},
-		{
-			MethodName: "GetBlockHeader",
-			Handler:    _ContorlCommand_GetBlockHeader_Handler,
-		},
-		{
-			MethodName: "GetBlock",
-			Handler:    _ContorlCommand_GetBlock_Handler,
-		},
-		{
-			MethodName: "GetNodeInfo",
-			Handler:    _ContorlCommand_GetNodeInfo_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "control.proto",
+type ConnectPeerRequest struct {
+	Addr string `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
 }
 
-func (m *DebugLevelRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *ConnectPeerRequest) Reset()         { *m = ConnectPeerRequest{} }
+func (m *ConnectPeerRequest) String() string { return proto.CompactTextString(m) }
+func (*ConnectPeerRequest) ProtoMessage()    {}
+func (*ConnectPeerRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_control_5ba66d7dcfcd869f, []int{21}
+}
+func (m *ConnectPeerRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConnectPeerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConnectPeerRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (dst *ConnectPeerRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConnectPeerRequest.Merge(dst, src)
+}
+func (m *ConnectPeerRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConnectPeerRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConnectPeerRequest.DiscardUnknown(m)
 }
 
-func (m *DebugLevelRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Level) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintControl(dAtA, i, uint64(len(m.Level)))
-		i += copy(dAtA[i:], m.Level)
+var xxx_messageInfo_ConnectPeerRequest proto.InternalMessageInfo
+
+func (m *ConnectPeerRequest) GetAddr() string {
+	if m != nil {
+		return m.Addr
 	}
-	return i, nil
+	return ""
 }
 
-func (m *UpdateNetworkIDRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+type DisconnectPeerRequest struct {
+	PeerId string `protobuf:"bytes,1,opt,name=peer_id,json=peerId,proto3" json:"peer_id,omitempty"`
 }
 
-func (m *UpdateNetworkIDRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Id != 0 {
-		dAtA[i] = 0x8
-		i++
-		i = encodeVarintControl(dAtA, i, uint64(m.Id))
+func (m *DisconnectPeerRequest) Reset()         { *m = DisconnectPeerRequest{} }
+func (m *DisconnectPeerRequest) String() string { return proto.CompactTextString(m) }
+func (*DisconnectPeerRequest) ProtoMessage()    {}
+func (*DisconnectPeerRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_control_5ba66d7dcfcd869f, []int{22}
+}
+func (m *DisconnectPeerRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DisconnectPeerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DisconnectPeerRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return i, nil
+}
+func (dst *DisconnectPeerRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DisconnectPeerRequest.Merge(dst, src)
+}
+func (m *DisconnectPeerRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *DisconnectPeerRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DisconnectPeerRequest.DiscardUnknown(m)
 }
 
-func (m *GetBlockHeightRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_DisconnectPeerRequest proto.InternalMessageInfo
+
+func (m *DisconnectPeerRequest) GetPeerId() string {
+	if m != nil {
+		return m.PeerId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *GetBlockHeightRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	return i, nil
+type BanPeerRequest struct {
+	// peer id, ip or subnet in CIDR notation, e.g. 10.0.0.0/24
+	Target string `protobuf:"bytes,1,opt,name=target,proto3" json:"target,omitempty"`
+	// seconds the ban lasts
+	Duration int64 `protobuf:"varint,2,opt,name=duration,proto3" json:"duration,omitempty"`
 }
 
-func (m *GetBlockHeightResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *BanPeerRequest) Reset()         { *m = BanPeerRequest{} }
+func (m *BanPeerRequest) String() string { return proto.CompactTextString(m) }
+func (*BanPeerRequest) ProtoMessage()    {}
+func (*BanPeerRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_control_5ba66d7dcfcd869f, []int{23}
+}
+func (m *BanPeerRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BanPeerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BanPeerRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (dst *BanPeerRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BanPeerRequest.Merge(dst, src)
+}
+func (m *BanPeerRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *BanPeerRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BanPeerRequest.DiscardUnknown(m)
 }
 
-func (m *GetBlockHeightResponse) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Code != 0 {
-		dAtA[i] = 0x8
-		i++
-		i = encodeVarintControl(dAtA, i, uint64(m.Code))
-	}
-	if len(m.Message) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintControl(dAtA, i, uint64(len(m.Message)))
-		i += copy(dAtA[i:], m.Message)
-	}
-	if m.Height != 0 {
-		dAtA[i] = 0x18
-		i++
-		i = encodeVarintControl(dAtA, i, uint64(m.Height))
+var xxx_messageInfo_BanPeerRequest proto.InternalMessageInfo
+
+func (m *BanPeerRequest) GetTarget() string {
+	if m != nil {
+		return m.Target
 	}
-	return i, nil
+	return ""
 }
 
-func (m *GetBlockHashRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *BanPeerRequest) GetDuration() int64 {
+	if m != nil {
+		return m.Duration
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *GetBlockHashRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Height != 0 {
-		dAtA[i] = 0x8
-		i++
-		i = encodeVarintControl(dAtA, i, uint64(m.Height))
-	}
-	return i, nil
+type UnbanPeerRequest struct {
+	Target string `protobuf:"bytes,1,opt,name=target,proto3" json:"target,omitempty"`
 }
 
-func (m *GetBlockHashResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *UnbanPeerRequest) Reset()         { *m = UnbanPeerRequest{} }
+func (m *UnbanPeerRequest) String() string { return proto.CompactTextString(m) }
+func (*UnbanPeerRequest) ProtoMessage()    {}
+func (*UnbanPeerRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_control_5ba66d7dcfcd869f, []int{24}
+}
+func (m *UnbanPeerRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UnbanPeerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UnbanPeerRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (dst *UnbanPeerRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnbanPeerRequest.Merge(dst, src)
+}
+func (m *UnbanPeerRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *UnbanPeerRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnbanPeerRequest.DiscardUnknown(m)
 }
 
-func (m *GetBlockHashResponse) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Code != 0 {
-		dAtA[i] = 0x8
-		i++
-		i = encodeVarintControl(dAtA, i, uint64(m.Code))
-	}
-	if len(m.Message) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintControl(dAtA, i, uint64(len(m.Message)))
-		i += copy(dAtA[i:], m.Message)
-	}
-	if len(m.Hash) > 0 {
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintControl(dAtA, i, uint64(len(m.Hash)))
-		i += copy(dAtA[i:], m.Hash)
+var xxx_messageInfo_UnbanPeerRequest proto.InternalMessageInfo
+
+func (m *UnbanPeerRequest) GetTarget() string {
+	if m != nil {
+		return m.Target
 	}
-	return i, nil
+	return ""
 }
 
-func (m *GetBlockRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+type ListBansRequest struct {
 }
 
-func (m *GetBlockRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.BlockHash) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintControl(dAtA, i, uint64(len(m.BlockHash)))
-		i += copy(dAtA[i:], m.BlockHash)
+func (m *ListBansRequest) Reset()         { *m = ListBansRequest{} }
+func (m *ListBansRequest) String() string { return proto.CompactTextString(m) }
+func (*ListBansRequest) ProtoMessage()    {}
+func (*ListBansRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_control_5ba66d7dcfcd869f, []int{25}
+}
+func (m *ListBansRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListBansRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListBansRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return i, nil
+}
+func (dst *ListBansRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListBansRequest.Merge(dst, src)
+}
+func (m *ListBansRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListBansRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListBansRequest.DiscardUnknown(m)
 }
 
-func (m *GetBlockHeaderResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+var xxx_messageInfo_ListBansRequest proto.InternalMessageInfo
+
+type Ban struct {
+	Target string `protobuf:"bytes,1,opt,name=target,proto3" json:"target,omitempty"`
+	// unix time the ban expires at
+	Until int64 `protobuf:"varint,2,opt,name=until,proto3" json:"until,omitempty"`
 }
 
-func (m *GetBlockHeaderResponse) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Code != 0 {
-		dAtA[i] = 0x8
-		i++
-		i = encodeVarintControl(dAtA, i, uint64(m.Code))
-	}
-	if len(m.Message) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintControl(dAtA, i, uint64(len(m.Message)))
-		i += copy(dAtA[i:], m.Message)
-	}
-	if m.Header != nil {
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintControl(dAtA, i, uint64(m.Header.Size()))
-		n1, err := m.Header.MarshalTo(dAtA[i:])
+func (m *Ban) Reset()         { *m = Ban{} }
+func (m *Ban) String() string { return proto.CompactTextString(m) }
+func (*Ban) ProtoMessage()    {}
+func (*Ban) Descriptor() ([]byte, []int) {
+	return fileDescriptor_control_5ba66d7dcfcd869f, []int{26}
+}
+func (m *Ban) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Ban) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Ban.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i += n1
+		return b[:n], nil
 	}
-	return i, nil
+}
+func (dst *Ban) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Ban.Merge(dst, src)
+}
+func (m *Ban) XXX_Size() int {
+	return m.Size()
+}
+func (m *Ban) XXX_DiscardUnknown() {
+	xxx_messageInfo_Ban.DiscardUnknown(m)
 }
 
-func (m *GetBlockResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_Ban proto.InternalMessageInfo
+
+func (m *Ban) GetTarget() string {
+	if m != nil {
+		return m.Target
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *GetBlockResponse) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Code != 0 {
-		dAtA[i] = 0x8
-		i++
-		i = encodeVarintControl(dAtA, i, uint64(m.Code))
-	}
-	if len(m.Message) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintControl(dAtA, i, uint64(len(m.Message)))
-		i += copy(dAtA[i:], m.Message)
+func (m *Ban) GetUntil() int64 {
+	if m != nil {
+		return m.Until
 	}
-	if m.Block != nil {
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintControl(dAtA, i, uint64(m.Block.Size()))
-		n2, err := m.Block.MarshalTo(dAtA[i:])
+	return 0
+}
+
+type ListBansResponse struct {
+	Code    int32  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Bans    []*Ban `protobuf:"bytes,3,rep,name=bans" json:"bans,omitempty"`
+}
+
+func (m *ListBansResponse) Reset()         { *m = ListBansResponse{} }
+func (m *ListBansResponse) String() string { return proto.CompactTextString(m) }
+func (*ListBansResponse) ProtoMessage()    {}
+func (*ListBansResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_control_5ba66d7dcfcd869f, []int{27}
+}
+func (m *ListBansResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListBansResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListBansResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i += n2
+		return b[:n], nil
 	}
-	return i, nil
+}
+func (dst *ListBansResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListBansResponse.Merge(dst, src)
+}
+func (m *ListBansResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListBansResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListBansResponse.DiscardUnknown(m)
 }
 
-func (m *Node) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ListBansResponse proto.InternalMessageInfo
+
+func (m *ListBansResponse) GetCode() int32 {
+	if m != nil {
+		return m.Code
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *Node) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Id) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintControl(dAtA, i, uint64(len(m.Id)))
-		i += copy(dAtA[i:], m.Id)
-	}
-	if len(m.Addrs) > 0 {
-		for _, s := range m.Addrs {
-			dAtA[i] = 0x12
-			i++
-			l = len(s)
-			for l >= 1<<7 {
-				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
-				l >>= 7
-				i++
-			}
-			dAtA[i] = uint8(l)
-			i++
-			i += copy(dAtA[i:], s)
-		}
-	}
-	if len(m.Ttl) > 0 {
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintControl(dAtA, i, uint64(len(m.Ttl)))
-		i += copy(dAtA[i:], m.Ttl)
+func (m *ListBansResponse) GetMessage() string {
+	if m != nil {
+		return m.Message
 	}
-	return i, nil
+	return ""
 }
 
-func (m *GetNodeInfoRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *ListBansResponse) GetBans() []*Ban {
+	if m != nil {
+		return m.Bans
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *GetNodeInfoRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	return i, nil
+type ConvertAddressRequest struct {
+	// address in base58 or bech32 format
+	Addr string `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
 }
 
-func (m *GetNodeInfoResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *ConvertAddressRequest) Reset()         { *m = ConvertAddressRequest{} }
+func (m *ConvertAddressRequest) String() string { return proto.CompactTextString(m) }
+func (*ConvertAddressRequest) ProtoMessage()    {}
+func (*ConvertAddressRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_control_5ba66d7dcfcd869f, []int{28}
 }
-
-func (m *GetNodeInfoResponse) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Nodes) > 0 {
-		for _, msg := range m.Nodes {
-			dAtA[i] = 0xa
-			i++
-			i = encodeVarintControl(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
+func (m *ConvertAddressRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConvertAddressRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConvertAddressRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return i, nil
 }
-
-func encodeVarintControl(dAtA []byte, offset int, v uint64) int {
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return offset + 1
+func (dst *ConvertAddressRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConvertAddressRequest.Merge(dst, src)
 }
-func (m *DebugLevelRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Level)
-	if l > 0 {
-		n += 1 + l + sovControl(uint64(l))
-	}
-	return n
+func (m *ConvertAddressRequest) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *UpdateNetworkIDRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Id != 0 {
-		n += 1 + sovControl(uint64(m.Id))
-	}
-	return n
+func (m *ConvertAddressRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConvertAddressRequest.DiscardUnknown(m)
 }
 
-func (m *GetBlockHeightRequest) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_ConvertAddressRequest proto.InternalMessageInfo
+
+func (m *ConvertAddressRequest) GetAddr() string {
+	if m != nil {
+		return m.Addr
 	}
-	var l int
-	_ = l
-	return n
+	return ""
 }
 
-func (m *GetBlockHeightResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Code != 0 {
-		n += 1 + sovControl(uint64(m.Code))
-	}
-	l = len(m.Message)
-	if l > 0 {
-		n += 1 + l + sovControl(uint64(l))
+type ConvertAddressResponse struct {
+	Code    int32  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Base58  string `protobuf:"bytes,3,opt,name=base58,proto3" json:"base58,omitempty"`
+	// bech32 format of the network the node runs on
+	Bech32 string `protobuf:"bytes,4,opt,name=bech32,proto3" json:"bech32,omitempty"`
+}
+
+func (m *ConvertAddressResponse) Reset()         { *m = ConvertAddressResponse{} }
+func (m *ConvertAddressResponse) String() string { return proto.CompactTextString(m) }
+func (*ConvertAddressResponse) ProtoMessage()    {}
+func (*ConvertAddressResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_control_5ba66d7dcfcd869f, []int{29}
+}
+func (m *ConvertAddressResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConvertAddressResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConvertAddressResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.Height != 0 {
-		n += 1 + sovControl(uint64(m.Height))
+}
+func (dst *ConvertAddressResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConvertAddressResponse.Merge(dst, src)
+}
+func (m *ConvertAddressResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConvertAddressResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConvertAddressResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConvertAddressResponse proto.InternalMessageInfo
+
+func (m *ConvertAddressResponse) GetCode() int32 {
+	if m != nil {
+		return m.Code
 	}
-	return n
+	return 0
 }
 
-func (m *GetBlockHashRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ConvertAddressResponse) GetMessage() string {
+	if m != nil {
+		return m.Message
 	}
-	var l int
-	_ = l
-	if m.Height != 0 {
-		n += 1 + sovControl(uint64(m.Height))
+	return ""
+}
+
+func (m *ConvertAddressResponse) GetBase58() string {
+	if m != nil {
+		return m.Base58
 	}
-	return n
+	return ""
 }
 
-func (m *GetBlockHashResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ConvertAddressResponse) GetBech32() string {
+	if m != nil {
+		return m.Bech32
 	}
-	var l int
-	_ = l
-	if m.Code != 0 {
-		n += 1 + sovControl(uint64(m.Code))
+	return ""
+}
+
+type CaptureProfileRequest struct {
+	// cpu, trace, goroutine, heap, allocs, threadcreate, block or mutex
+	Profile string `protobuf:"bytes,1,opt,name=profile,proto3" json:"profile,omitempty"`
+	// seconds cpu and trace profiles sample over, 30 if not set
+	Seconds uint32 `protobuf:"varint,2,opt,name=seconds,proto3" json:"seconds,omitempty"`
+	// file name, without path separators. The profile and capture time are
+	// used if it's empty
+	Name string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (m *CaptureProfileRequest) Reset()         { *m = CaptureProfileRequest{} }
+func (m *CaptureProfileRequest) String() string { return proto.CompactTextString(m) }
+func (*CaptureProfileRequest) ProtoMessage()    {}
+func (*CaptureProfileRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_control_5ba66d7dcfcd869f, []int{30}
+}
+func (m *CaptureProfileRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CaptureProfileRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CaptureProfileRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = len(m.Message)
-	if l > 0 {
-		n += 1 + l + sovControl(uint64(l))
+}
+func (dst *CaptureProfileRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CaptureProfileRequest.Merge(dst, src)
+}
+func (m *CaptureProfileRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *CaptureProfileRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CaptureProfileRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CaptureProfileRequest proto.InternalMessageInfo
+
+func (m *CaptureProfileRequest) GetProfile() string {
+	if m != nil {
+		return m.Profile
 	}
-	l = len(m.Hash)
-	if l > 0 {
-		n += 1 + l + sovControl(uint64(l))
+	return ""
+}
+
+func (m *CaptureProfileRequest) GetSeconds() uint32 {
+	if m != nil {
+		return m.Seconds
 	}
-	return n
+	return 0
 }
 
-func (m *GetBlockRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *CaptureProfileRequest) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	var l int
-	_ = l
-	l = len(m.BlockHash)
-	if l > 0 {
-		n += 1 + l + sovControl(uint64(l))
+	return ""
+}
+
+type CaptureProfileResponse struct {
+	Code    int32  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	// path of the profile file on the node
+	Path string `protobuf:"bytes,3,opt,name=path,proto3" json:"path,omitempty"`
+}
+
+func (m *CaptureProfileResponse) Reset()         { *m = CaptureProfileResponse{} }
+func (m *CaptureProfileResponse) String() string { return proto.CompactTextString(m) }
+func (*CaptureProfileResponse) ProtoMessage()    {}
+func (*CaptureProfileResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_control_5ba66d7dcfcd869f, []int{31}
+}
+func (m *CaptureProfileResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CaptureProfileResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CaptureProfileResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (dst *CaptureProfileResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CaptureProfileResponse.Merge(dst, src)
+}
+func (m *CaptureProfileResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *CaptureProfileResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CaptureProfileResponse.DiscardUnknown(m)
 }
 
-func (m *GetBlockHeaderResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_CaptureProfileResponse proto.InternalMessageInfo
+
+func (m *CaptureProfileResponse) GetCode() int32 {
+	if m != nil {
+		return m.Code
 	}
-	var l int
-	_ = l
-	if m.Code != 0 {
-		n += 1 + sovControl(uint64(m.Code))
+	return 0
+}
+
+func (m *CaptureProfileResponse) GetMessage() string {
+	if m != nil {
+		return m.Message
 	}
-	l = len(m.Message)
-	if l > 0 {
-		n += 1 + l + sovControl(uint64(l))
+	return ""
+}
+
+func (m *CaptureProfileResponse) GetPath() string {
+	if m != nil {
+		return m.Path
 	}
-	if m.Header != nil {
-		l = m.Header.Size()
-		n += 1 + l + sovControl(uint64(l))
+	return ""
+}
+
+type GetNodeStatusRequest struct {
+}
+
+func (m *GetNodeStatusRequest) Reset()         { *m = GetNodeStatusRequest{} }
+func (m *GetNodeStatusRequest) String() string { return proto.CompactTextString(m) }
+func (*GetNodeStatusRequest) ProtoMessage()    {}
+func (*GetNodeStatusRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_control_5ba66d7dcfcd869f, []int{32}
+}
+func (m *GetNodeStatusRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetNodeStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetNodeStatusRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (dst *GetNodeStatusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetNodeStatusRequest.Merge(dst, src)
+}
+func (m *GetNodeStatusRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetNodeStatusRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetNodeStatusRequest.DiscardUnknown(m)
 }
 
-func (m *GetBlockResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_GetNodeStatusRequest proto.InternalMessageInfo
+
+type GetNodeStatusResponse struct {
+	Code    int32  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	// the tail block of the chain
+	Height  uint32 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	TipHash string `protobuf:"bytes,4,opt,name=tip_hash,json=tipHash,proto3" json:"tip_hash,omitempty"`
+	TipTime int64  `protobuf:"varint,5,opt,name=tip_time,json=tipTime,proto3" json:"tip_time,omitempty"`
+	// the latest final block
+	EternalHeight uint32 `protobuf:"varint,6,opt,name=eternal_height,json=eternalHeight,proto3" json:"eternal_height,omitempty"`
+	EternalHash   string `protobuf:"bytes,7,opt,name=eternal_hash,json=eternalHash,proto3" json:"eternal_hash,omitempty"`
+	Synced        bool   `protobuf:"varint,8,opt,name=synced,proto3" json:"synced,omitempty"`
+	PeerCount     uint32 `protobuf:"varint,9,opt,name=peer_count,json=peerCount,proto3" json:"peer_count,omitempty"`
+	// txs in mempool, orphans excluded, and their total size
+	MempoolSize    uint32 `protobuf:"varint,10,opt,name=mempool_size,json=mempoolSize,proto3" json:"mempool_size,omitempty"`
+	MempoolBytes   uint32 `protobuf:"varint,11,opt,name=mempool_bytes,json=mempoolBytes,proto3" json:"mempool_bytes,omitempty"`
+	MempoolOrphans uint32 `protobuf:"varint,12,opt,name=mempool_orphans,json=mempoolOrphans,proto3" json:"mempool_orphans,omitempty"`
+	// whether node side signing is enabled, and any account is unlocked
+	WalletEnabled  bool `protobuf:"varint,13,opt,name=wallet_enabled,json=walletEnabled,proto3" json:"wallet_enabled,omitempty"`
+	WalletUnlocked bool `protobuf:"varint,14,opt,name=wallet_unlocked,json=walletUnlocked,proto3" json:"wallet_unlocked,omitempty"`
+	// whether the node is serving, i.e. synced with peers connected
+	Healthy bool `protobuf:"varint,15,opt,name=healthy,proto3" json:"healthy,omitempty"`
+	// whether subsystems are serving by their health check service names,
+	// e.g. boxd.chain
+	Subsystems map[string]bool `protobuf:"bytes,16,rep,name=subsystems" json:"subsystems,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	// statuses of services the node runs, in the order registered
+	Services []*ServiceStatus `protobuf:"bytes,17,rep,name=services" json:"services,omitempty"`
+}
+
+func (m *GetNodeStatusResponse) Reset()         { *m = GetNodeStatusResponse{} }
+func (m *GetNodeStatusResponse) String() string { return proto.CompactTextString(m) }
+func (*GetNodeStatusResponse) ProtoMessage()    {}
+func (*GetNodeStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_control_5ba66d7dcfcd869f, []int{33}
+}
+func (m *GetNodeStatusResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetNodeStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetNodeStatusResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	if m.Code != 0 {
-		n += 1 + sovControl(uint64(m.Code))
+}
+func (dst *GetNodeStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetNodeStatusResponse.Merge(dst, src)
+}
+func (m *GetNodeStatusResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetNodeStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetNodeStatusResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetNodeStatusResponse proto.InternalMessageInfo
+
+func (m *GetNodeStatusResponse) GetCode() int32 {
+	if m != nil {
+		return m.Code
 	}
-	l = len(m.Message)
-	if l > 0 {
-		n += 1 + l + sovControl(uint64(l))
+	return 0
+}
+
+func (m *GetNodeStatusResponse) GetMessage() string {
+	if m != nil {
+		return m.Message
 	}
-	if m.Block != nil {
-		l = m.Block.Size()
-		n += 1 + l + sovControl(uint64(l))
+	return ""
+}
+
+func (m *GetNodeStatusResponse) GetHeight() uint32 {
+	if m != nil {
+		return m.Height
 	}
-	return n
+	return 0
 }
 
-func (m *Node) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *GetNodeStatusResponse) GetTipHash() string {
+	if m != nil {
+		return m.TipHash
 	}
-	var l int
-	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovControl(uint64(l))
+	return ""
+}
+
+func (m *GetNodeStatusResponse) GetTipTime() int64 {
+	if m != nil {
+		return m.TipTime
 	}
-	if len(m.Addrs) > 0 {
-		for _, s := range m.Addrs {
-			l = len(s)
-			n += 1 + l + sovControl(uint64(l))
-		}
+	return 0
+}
+
+func (m *GetNodeStatusResponse) GetEternalHeight() uint32 {
+	if m != nil {
+		return m.EternalHeight
 	}
-	l = len(m.Ttl)
-	if l > 0 {
-		n += 1 + l + sovControl(uint64(l))
+	return 0
+}
+
+func (m *GetNodeStatusResponse) GetEternalHash() string {
+	if m != nil {
+		return m.EternalHash
 	}
-	return n
+	return ""
 }
 
-func (m *GetNodeInfoRequest) Size() (n int) {
+func (m *GetNodeStatusResponse) GetSynced() bool {
+	if m != nil {
+		return m.Synced
+	}
+	return false
+}
+
+func (m *GetNodeStatusResponse) GetPeerCount() uint32 {
+	if m != nil {
+		return m.PeerCount
+	}
+	return 0
+}
+
+func (m *GetNodeStatusResponse) GetMempoolSize() uint32 {
+	if m != nil {
+		return m.MempoolSize
+	}
+	return 0
+}
+
+func (m *GetNodeStatusResponse) GetMempoolBytes() uint32 {
+	if m != nil {
+		return m.MempoolBytes
+	}
+	return 0
+}
+
+func (m *GetNodeStatusResponse) GetMempoolOrphans() uint32 {
+	if m != nil {
+		return m.MempoolOrphans
+	}
+	return 0
+}
+
+func (m *GetNodeStatusResponse) GetWalletEnabled() bool {
+	if m != nil {
+		return m.WalletEnabled
+	}
+	return false
+}
+
+func (m *GetNodeStatusResponse) GetWalletUnlocked() bool {
+	if m != nil {
+		return m.WalletUnlocked
+	}
+	return false
+}
+
+func (m *GetNodeStatusResponse) GetHealthy() bool {
+	if m != nil {
+		return m.Healthy
+	}
+	return false
+}
+
+func (m *GetNodeStatusResponse) GetSubsystems() map[string]bool {
+	if m != nil {
+		return m.Subsystems
+	}
+	return nil
+}
+
+func (m *GetNodeStatusResponse) GetServices() []*ServiceStatus {
+	if m != nil {
+		return m.Services
+	}
+	return nil
+}
+
+type ServiceStatus struct {
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// pending, running, restarting, failed or stopped
+	State string `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"`
+	// whether the node stops if the service fails, other services are
+	// restarted
+	Critical bool   `protobuf:"varint,3,opt,name=critical,proto3" json:"critical,omitempty"`
+	Restarts uint32 `protobuf:"varint,4,opt,name=restarts,proto3" json:"restarts,omitempty"`
+	// the last error the service failed with
+	Error string `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+	// unix time the service entered the state
+	Since int64 `protobuf:"varint,6,opt,name=since,proto3" json:"since,omitempty"`
+}
+
+func (m *ServiceStatus) Reset()         { *m = ServiceStatus{} }
+func (m *ServiceStatus) String() string { return proto.CompactTextString(m) }
+func (*ServiceStatus) ProtoMessage()    {}
+func (*ServiceStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_control_5ba66d7dcfcd869f, []int{34}
+}
+func (m *ServiceStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ServiceStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ServiceStatus.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *ServiceStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ServiceStatus.Merge(dst, src)
+}
+func (m *ServiceStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *ServiceStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_ServiceStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ServiceStatus proto.InternalMessageInfo
+
+func (m *ServiceStatus) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ServiceStatus) GetState() string {
+	if m != nil {
+		return m.State
+	}
+	return ""
+}
+
+func (m *ServiceStatus) GetCritical() bool {
+	if m != nil {
+		return m.Critical
+	}
+	return false
+}
+
+func (m *ServiceStatus) GetRestarts() uint32 {
+	if m != nil {
+		return m.Restarts
+	}
+	return 0
+}
+
+func (m *ServiceStatus) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func (m *ServiceStatus) GetSince() int64 {
+	if m != nil {
+		return m.Since
+	}
+	return 0
+}
+
+type SubscribeEternalBlocksRequest struct {
+}
+
+func (m *SubscribeEternalBlocksRequest) Reset()         { *m = SubscribeEternalBlocksRequest{} }
+func (m *SubscribeEternalBlocksRequest) String() string { return proto.CompactTextString(m) }
+func (*SubscribeEternalBlocksRequest) ProtoMessage()    {}
+func (*SubscribeEternalBlocksRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_control_5ba66d7dcfcd869f, []int{35}
+}
+func (m *SubscribeEternalBlocksRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SubscribeEternalBlocksRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SubscribeEternalBlocksRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *SubscribeEternalBlocksRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubscribeEternalBlocksRequest.Merge(dst, src)
+}
+func (m *SubscribeEternalBlocksRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SubscribeEternalBlocksRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubscribeEternalBlocksRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SubscribeEternalBlocksRequest proto.InternalMessageInfo
+
+type EternalBlock struct {
+	Code      int32  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message   string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Hash      string `protobuf:"bytes,3,opt,name=hash,proto3" json:"hash,omitempty"`
+	Height    uint32 `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+	Timestamp int64  `protobuf:"varint,5,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+}
+
+func (m *EternalBlock) Reset()         { *m = EternalBlock{} }
+func (m *EternalBlock) String() string { return proto.CompactTextString(m) }
+func (*EternalBlock) ProtoMessage()    {}
+func (*EternalBlock) Descriptor() ([]byte, []int) {
+	return fileDescriptor_control_5ba66d7dcfcd869f, []int{36}
+}
+func (m *EternalBlock) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EternalBlock) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EternalBlock.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *EternalBlock) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EternalBlock.Merge(dst, src)
+}
+func (m *EternalBlock) XXX_Size() int {
+	return m.Size()
+}
+func (m *EternalBlock) XXX_DiscardUnknown() {
+	xxx_messageInfo_EternalBlock.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EternalBlock proto.InternalMessageInfo
+
+func (m *EternalBlock) GetCode() int32 {
+	if m != nil {
+		return m.Code
+	}
+	return 0
+}
+
+func (m *EternalBlock) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+func (m *EternalBlock) GetHash() string {
+	if m != nil {
+		return m.Hash
+	}
+	return ""
+}
+
+func (m *EternalBlock) GetHeight() uint32 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *EternalBlock) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*DebugLevelRequest)(nil), "rpcpb.DebugLevelRequest")
+	proto.RegisterType((*UpdateNetworkIDRequest)(nil), "rpcpb.UpdateNetworkIDRequest")
+	proto.RegisterType((*GetBlockHeightRequest)(nil), "rpcpb.GetBlockHeightRequest")
+	proto.RegisterType((*GetBlockHeightResponse)(nil), "rpcpb.GetBlockHeightResponse")
+	proto.RegisterType((*GetBlockHashRequest)(nil), "rpcpb.GetBlockHashRequest")
+	proto.RegisterType((*GetBlockHashResponse)(nil), "rpcpb.GetBlockHashResponse")
+	proto.RegisterType((*GetBlockRequest)(nil), "rpcpb.GetBlockRequest")
+	proto.RegisterType((*GetBlockHeaderResponse)(nil), "rpcpb.GetBlockHeaderResponse")
+	proto.RegisterType((*GetBlockResponse)(nil), "rpcpb.GetBlockResponse")
+	proto.RegisterType((*GetBlockVerboseRequest)(nil), "rpcpb.GetBlockVerboseRequest")
+	proto.RegisterType((*BlockInfo)(nil), "rpcpb.BlockInfo")
+	proto.RegisterType((*GetBlockVerboseResponse)(nil), "rpcpb.GetBlockVerboseResponse")
+	proto.RegisterType((*Node)(nil), "rpcpb.Node")
+	proto.RegisterType((*GetNodeInfoRequest)(nil), "rpcpb.GetNodeInfoRequest")
+	proto.RegisterType((*GetNodeInfoResponse)(nil), "rpcpb.GetNodeInfoResponse")
+	proto.RegisterType((*GetNetworkInfoRequest)(nil), "rpcpb.GetNetworkInfoRequest")
+	proto.RegisterType((*GetNetworkInfoResponse)(nil), "rpcpb.GetNetworkInfoResponse")
+	proto.RegisterType((*GetPeerInfoRequest)(nil), "rpcpb.GetPeerInfoRequest")
+	proto.RegisterType((*PeerInfo)(nil), "rpcpb.PeerInfo")
+	proto.RegisterType((*MessageTraffic)(nil), "rpcpb.MessageTraffic")
+	proto.RegisterType((*GetPeerInfoResponse)(nil), "rpcpb.GetPeerInfoResponse")
+	proto.RegisterType((*ConnectPeerRequest)(nil), "rpcpb.ConnectPeerRequest")
+	proto.RegisterType((*DisconnectPeerRequest)(nil), "rpcpb.DisconnectPeerRequest")
+	proto.RegisterType((*BanPeerRequest)(nil), "rpcpb.BanPeerRequest")
+	proto.RegisterType((*UnbanPeerRequest)(nil), "rpcpb.UnbanPeerRequest")
+	proto.RegisterType((*ListBansRequest)(nil), "rpcpb.ListBansRequest")
+	proto.RegisterType((*Ban)(nil), "rpcpb.Ban")
+	proto.RegisterType((*ListBansResponse)(nil), "rpcpb.ListBansResponse")
+	proto.RegisterType((*ConvertAddressRequest)(nil), "rpcpb.ConvertAddressRequest")
+	proto.RegisterType((*ConvertAddressResponse)(nil), "rpcpb.ConvertAddressResponse")
+	proto.RegisterType((*CaptureProfileRequest)(nil), "rpcpb.CaptureProfileRequest")
+	proto.RegisterType((*CaptureProfileResponse)(nil), "rpcpb.CaptureProfileResponse")
+	proto.RegisterType((*GetNodeStatusRequest)(nil), "rpcpb.GetNodeStatusRequest")
+	proto.RegisterType((*GetNodeStatusResponse)(nil), "rpcpb.GetNodeStatusResponse")
+	proto.RegisterMapType((map[string]bool)(nil), "rpcpb.GetNodeStatusResponse.SubsystemsEntry")
+	proto.RegisterType((*ServiceStatus)(nil), "rpcpb.ServiceStatus")
+	proto.RegisterType((*SubscribeEternalBlocksRequest)(nil), "rpcpb.SubscribeEternalBlocksRequest")
+	proto.RegisterType((*EternalBlock)(nil), "rpcpb.EternalBlock")
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// ContorlCommandClient is the client API for ContorlCommand service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type ContorlCommandClient interface {
+	// set boxd debug level
+	SetDebugLevel(ctx context.Context, in *DebugLevelRequest, opts ...grpc.CallOption) (*BaseResponse, error)
+	UpdateNetworkID(ctx context.Context, in *UpdateNetworkIDRequest, opts ...grpc.CallOption) (*BaseResponse, error)
+	GetBlockHeight(ctx context.Context, in *GetBlockHeightRequest, opts ...grpc.CallOption) (*GetBlockHeightResponse, error)
+	GetBlockHash(ctx context.Context, in *GetBlockHashRequest, opts ...grpc.CallOption) (*GetBlockHashResponse, error)
+	GetBlockHeader(ctx context.Context, in *GetBlockRequest, opts ...grpc.CallOption) (*GetBlockHeaderResponse, error)
+	GetBlock(ctx context.Context, in *GetBlockRequest, opts ...grpc.CallOption) (*GetBlockResponse, error)
+	// get a block by hash or height, raw or decoded as verbosity asks
+	GetBlockVerbose(ctx context.Context, in *GetBlockVerboseRequest, opts ...grpc.CallOption) (*GetBlockVerboseResponse, error)
+	// get a block header by hash or height, raw if verbosity is 0
+	GetBlockHeaderVerbose(ctx context.Context, in *GetBlockVerboseRequest, opts ...grpc.CallOption) (*GetBlockVerboseResponse, error)
+	// stream the eternal block, i.e., the latest final one, when subscribed
+	// and whenever it advances. Blocks up to it are never reverted
+	SubscribeEternalBlocks(ctx context.Context, in *SubscribeEternalBlocksRequest, opts ...grpc.CallOption) (ContorlCommand_SubscribeEternalBlocksClient, error)
+	GetNodeInfo(ctx context.Context, in *GetNodeInfoRequest, opts ...grpc.CallOption) (*GetNodeInfoResponse, error)
+	GetNetworkInfo(ctx context.Context, in *GetNetworkInfoRequest, opts ...grpc.CallOption) (*GetNetworkInfoResponse, error)
+	// get states of connected peers, or of one if peer_id is given
+	GetPeerInfo(ctx context.Context, in *GetPeerInfoRequest, opts ...grpc.CallOption) (*GetPeerInfoResponse, error)
+	// connect to a peer at a multiaddr ending with its peer id
+	ConnectPeer(ctx context.Context, in *ConnectPeerRequest, opts ...grpc.CallOption) (*BaseResponse, error)
+	DisconnectPeer(ctx context.Context, in *DisconnectPeerRequest, opts ...grpc.CallOption) (*BaseResponse, error)
+	// ban a peer id, ip or subnet, bans are kept across restarts
+	BanPeer(ctx context.Context, in *BanPeerRequest, opts ...grpc.CallOption) (*BaseResponse, error)
+	UnbanPeer(ctx context.Context, in *UnbanPeerRequest, opts ...grpc.CallOption) (*BaseResponse, error)
+	ListBans(ctx context.Context, in *ListBansRequest, opts ...grpc.CallOption) (*ListBansResponse, error)
+	// convert an address between base58 and bech32 formats
+	ConvertAddress(ctx context.Context, in *ConvertAddressRequest, opts ...grpc.CallOption) (*ConvertAddressResponse, error)
+	// capture a runtime profile of the node to a file in its workspace. It is
+	// left off the http gateway, as it writes files and slows the node
+	CaptureProfile(ctx context.Context, in *CaptureProfileRequest, opts ...grpc.CallOption) (*CaptureProfileResponse, error)
+	// get chain, sync, peer, mempool, wallet and health status of the node at
+	// once, for dashboards and health checks
+	GetNodeStatus(ctx context.Context, in *GetNodeStatusRequest, opts ...grpc.CallOption) (*GetNodeStatusResponse, error)
+}
+
+type contorlCommandClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewContorlCommandClient(cc *grpc.ClientConn) ContorlCommandClient {
+	return &contorlCommandClient{cc}
+}
+
+func (c *contorlCommandClient) SetDebugLevel(ctx context.Context, in *DebugLevelRequest, opts ...grpc.CallOption) (*BaseResponse, error) {
+	out := new(BaseResponse)
+	err := c.cc.Invoke(ctx, "/rpcpb.ContorlCommand/SetDebugLevel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *contorlCommandClient) UpdateNetworkID(ctx context.Context, in *UpdateNetworkIDRequest, opts ...grpc.CallOption) (*BaseResponse, error) {
+	out := new(BaseResponse)
+	err := c.cc.Invoke(ctx, "/rpcpb.ContorlCommand/UpdateNetworkID", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *contorlCommandClient) GetBlockHeight(ctx context.Context, in *GetBlockHeightRequest, opts ...grpc.CallOption) (*GetBlockHeightResponse, error) {
+	out := new(GetBlockHeightResponse)
+	err := c.cc.Invoke(ctx, "/rpcpb.ContorlCommand/GetBlockHeight", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *contorlCommandClient) GetBlockHash(ctx context.Context, in *GetBlockHashRequest, opts ...grpc.CallOption) (*GetBlockHashResponse, error) {
+	out := new(GetBlockHashResponse)
+	err := c.cc.Invoke(ctx, "/rpcpb.ContorlCommand/GetBlockHash", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *contorlCommandClient) GetBlockHeader(ctx context.Context, in *GetBlockRequest, opts ...grpc.CallOption) (*GetBlockHeaderResponse, error) {
+	out := new(GetBlockHeaderResponse)
+	err := c.cc.Invoke(ctx, "/rpcpb.ContorlCommand/GetBlockHeader", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *contorlCommandClient) GetBlock(ctx context.Context, in *GetBlockRequest, opts ...grpc.CallOption) (*GetBlockResponse, error) {
+	out := new(GetBlockResponse)
+	err := c.cc.Invoke(ctx, "/rpcpb.ContorlCommand/GetBlock", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *contorlCommandClient) GetBlockVerbose(ctx context.Context, in *GetBlockVerboseRequest, opts ...grpc.CallOption) (*GetBlockVerboseResponse, error) {
+	out := new(GetBlockVerboseResponse)
+	err := c.cc.Invoke(ctx, "/rpcpb.ContorlCommand/GetBlockVerbose", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *contorlCommandClient) GetBlockHeaderVerbose(ctx context.Context, in *GetBlockVerboseRequest, opts ...grpc.CallOption) (*GetBlockVerboseResponse, error) {
+	out := new(GetBlockVerboseResponse)
+	err := c.cc.Invoke(ctx, "/rpcpb.ContorlCommand/GetBlockHeaderVerbose", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *contorlCommandClient) SubscribeEternalBlocks(ctx context.Context, in *SubscribeEternalBlocksRequest, opts ...grpc.CallOption) (ContorlCommand_SubscribeEternalBlocksClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_ContorlCommand_serviceDesc.Streams[0], "/rpcpb.ContorlCommand/SubscribeEternalBlocks", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &contorlCommandSubscribeEternalBlocksClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ContorlCommand_SubscribeEternalBlocksClient interface {
+	Recv() (*EternalBlock, error)
+	grpc.ClientStream
+}
+
+type contorlCommandSubscribeEternalBlocksClient struct {
+	grpc.ClientStream
+}
+
+func (x *contorlCommandSubscribeEternalBlocksClient) Recv() (*EternalBlock, error) {
+	m := new(EternalBlock)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *contorlCommandClient) GetNodeInfo(ctx context.Context, in *GetNodeInfoRequest, opts ...grpc.CallOption) (*GetNodeInfoResponse, error) {
+	out := new(GetNodeInfoResponse)
+	err := c.cc.Invoke(ctx, "/rpcpb.ContorlCommand/GetNodeInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *contorlCommandClient) GetNetworkInfo(ctx context.Context, in *GetNetworkInfoRequest, opts ...grpc.CallOption) (*GetNetworkInfoResponse, error) {
+	out := new(GetNetworkInfoResponse)
+	err := c.cc.Invoke(ctx, "/rpcpb.ContorlCommand/GetNetworkInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *contorlCommandClient) GetPeerInfo(ctx context.Context, in *GetPeerInfoRequest, opts ...grpc.CallOption) (*GetPeerInfoResponse, error) {
+	out := new(GetPeerInfoResponse)
+	err := c.cc.Invoke(ctx, "/rpcpb.ContorlCommand/GetPeerInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *contorlCommandClient) ConnectPeer(ctx context.Context, in *ConnectPeerRequest, opts ...grpc.CallOption) (*BaseResponse, error) {
+	out := new(BaseResponse)
+	err := c.cc.Invoke(ctx, "/rpcpb.ContorlCommand/ConnectPeer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *contorlCommandClient) DisconnectPeer(ctx context.Context, in *DisconnectPeerRequest, opts ...grpc.CallOption) (*BaseResponse, error) {
+	out := new(BaseResponse)
+	err := c.cc.Invoke(ctx, "/rpcpb.ContorlCommand/DisconnectPeer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *contorlCommandClient) BanPeer(ctx context.Context, in *BanPeerRequest, opts ...grpc.CallOption) (*BaseResponse, error) {
+	out := new(BaseResponse)
+	err := c.cc.Invoke(ctx, "/rpcpb.ContorlCommand/BanPeer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *contorlCommandClient) UnbanPeer(ctx context.Context, in *UnbanPeerRequest, opts ...grpc.CallOption) (*BaseResponse, error) {
+	out := new(BaseResponse)
+	err := c.cc.Invoke(ctx, "/rpcpb.ContorlCommand/UnbanPeer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *contorlCommandClient) ListBans(ctx context.Context, in *ListBansRequest, opts ...grpc.CallOption) (*ListBansResponse, error) {
+	out := new(ListBansResponse)
+	err := c.cc.Invoke(ctx, "/rpcpb.ContorlCommand/ListBans", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *contorlCommandClient) ConvertAddress(ctx context.Context, in *ConvertAddressRequest, opts ...grpc.CallOption) (*ConvertAddressResponse, error) {
+	out := new(ConvertAddressResponse)
+	err := c.cc.Invoke(ctx, "/rpcpb.ContorlCommand/ConvertAddress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *contorlCommandClient) CaptureProfile(ctx context.Context, in *CaptureProfileRequest, opts ...grpc.CallOption) (*CaptureProfileResponse, error) {
+	out := new(CaptureProfileResponse)
+	err := c.cc.Invoke(ctx, "/rpcpb.ContorlCommand/CaptureProfile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *contorlCommandClient) GetNodeStatus(ctx context.Context, in *GetNodeStatusRequest, opts ...grpc.CallOption) (*GetNodeStatusResponse, error) {
+	out := new(GetNodeStatusResponse)
+	err := c.cc.Invoke(ctx, "/rpcpb.ContorlCommand/GetNodeStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ContorlCommandServer is the server API for ContorlCommand service.
+type ContorlCommandServer interface {
+	// set boxd debug level
+	SetDebugLevel(context.Context, *DebugLevelRequest) (*BaseResponse, error)
+	UpdateNetworkID(context.Context, *UpdateNetworkIDRequest) (*BaseResponse, error)
+	GetBlockHeight(context.Context, *GetBlockHeightRequest) (*GetBlockHeightResponse, error)
+	GetBlockHash(context.Context, *GetBlockHashRequest) (*GetBlockHashResponse, error)
+	GetBlockHeader(context.Context, *GetBlockRequest) (*GetBlockHeaderResponse, error)
+	GetBlock(context.Context, *GetBlockRequest) (*GetBlockResponse, error)
+	// get a block by hash or height, raw or decoded as verbosity asks
+	GetBlockVerbose(context.Context, *GetBlockVerboseRequest) (*GetBlockVerboseResponse, error)
+	// get a block header by hash or height, raw if verbosity is 0
+	GetBlockHeaderVerbose(context.Context, *GetBlockVerboseRequest) (*GetBlockVerboseResponse, error)
+	// stream the eternal block, i.e., the latest final one, when subscribed
+	// and whenever it advances. Blocks up to it are never reverted
+	SubscribeEternalBlocks(*SubscribeEternalBlocksRequest, ContorlCommand_SubscribeEternalBlocksServer) error
+	GetNodeInfo(context.Context, *GetNodeInfoRequest) (*GetNodeInfoResponse, error)
+	GetNetworkInfo(context.Context, *GetNetworkInfoRequest) (*GetNetworkInfoResponse, error)
+	// get states of connected peers, or of one if peer_id is given
+	GetPeerInfo(context.Context, *GetPeerInfoRequest) (*GetPeerInfoResponse, error)
+	// connect to a peer at a multiaddr ending with its peer id
+	ConnectPeer(context.Context, *ConnectPeerRequest) (*BaseResponse, error)
+	DisconnectPeer(context.Context, *DisconnectPeerRequest) (*BaseResponse, error)
+	// ban a peer id, ip or subnet, bans are kept across restarts
+	BanPeer(context.Context, *BanPeerRequest) (*BaseResponse, error)
+	UnbanPeer(context.Context, *UnbanPeerRequest) (*BaseResponse, error)
+	ListBans(context.Context, *ListBansRequest) (*ListBansResponse, error)
+	// convert an address between base58 and bech32 formats
+	ConvertAddress(context.Context, *ConvertAddressRequest) (*ConvertAddressResponse, error)
+	// capture a runtime profile of the node to a file in its workspace. It is
+	// left off the http gateway, as it writes files and slows the node
+	CaptureProfile(context.Context, *CaptureProfileRequest) (*CaptureProfileResponse, error)
+	// get chain, sync, peer, mempool, wallet and health status of the node at
+	// once, for dashboards and health checks
+	GetNodeStatus(context.Context, *GetNodeStatusRequest) (*GetNodeStatusResponse, error)
+}
+
+func RegisterContorlCommandServer(s *grpc.Server, srv ContorlCommandServer) {
+	s.RegisterService(&_ContorlCommand_serviceDesc, srv)
+}
+
+func _ContorlCommand_SetDebugLevel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DebugLevelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContorlCommandServer).SetDebugLevel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.ContorlCommand/SetDebugLevel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContorlCommandServer).SetDebugLevel(ctx, req.(*DebugLevelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ContorlCommand_UpdateNetworkID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateNetworkIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContorlCommandServer).UpdateNetworkID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.ContorlCommand/UpdateNetworkID",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContorlCommandServer).UpdateNetworkID(ctx, req.(*UpdateNetworkIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ContorlCommand_GetBlockHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBlockHeightRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContorlCommandServer).GetBlockHeight(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.ContorlCommand/GetBlockHeight",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContorlCommandServer).GetBlockHeight(ctx, req.(*GetBlockHeightRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ContorlCommand_GetBlockHash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBlockHashRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContorlCommandServer).GetBlockHash(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.ContorlCommand/GetBlockHash",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContorlCommandServer).GetBlockHash(ctx, req.(*GetBlockHashRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ContorlCommand_GetBlockHeader_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBlockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContorlCommandServer).GetBlockHeader(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.ContorlCommand/GetBlockHeader",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContorlCommandServer).GetBlockHeader(ctx, req.(*GetBlockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ContorlCommand_GetBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBlockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContorlCommandServer).GetBlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.ContorlCommand/GetBlock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContorlCommandServer).GetBlock(ctx, req.(*GetBlockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ContorlCommand_GetBlockVerbose_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBlockVerboseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContorlCommandServer).GetBlockVerbose(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.ContorlCommand/GetBlockVerbose",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContorlCommandServer).GetBlockVerbose(ctx, req.(*GetBlockVerboseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ContorlCommand_GetBlockHeaderVerbose_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBlockVerboseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContorlCommandServer).GetBlockHeaderVerbose(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.ContorlCommand/GetBlockHeaderVerbose",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContorlCommandServer).GetBlockHeaderVerbose(ctx, req.(*GetBlockVerboseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ContorlCommand_SubscribeEternalBlocks_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeEternalBlocksRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ContorlCommandServer).SubscribeEternalBlocks(m, &contorlCommandSubscribeEternalBlocksServer{stream})
+}
+
+type ContorlCommand_SubscribeEternalBlocksServer interface {
+	Send(*EternalBlock) error
+	grpc.ServerStream
+}
+
+type contorlCommandSubscribeEternalBlocksServer struct {
+	grpc.ServerStream
+}
+
+func (x *contorlCommandSubscribeEternalBlocksServer) Send(m *EternalBlock) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ContorlCommand_GetNodeInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNodeInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContorlCommandServer).GetNodeInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.ContorlCommand/GetNodeInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContorlCommandServer).GetNodeInfo(ctx, req.(*GetNodeInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ContorlCommand_GetNetworkInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNetworkInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContorlCommandServer).GetNetworkInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.ContorlCommand/GetNetworkInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContorlCommandServer).GetNetworkInfo(ctx, req.(*GetNetworkInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ContorlCommand_GetPeerInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPeerInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContorlCommandServer).GetPeerInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.ContorlCommand/GetPeerInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContorlCommandServer).GetPeerInfo(ctx, req.(*GetPeerInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ContorlCommand_ConnectPeer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConnectPeerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContorlCommandServer).ConnectPeer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.ContorlCommand/ConnectPeer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContorlCommandServer).ConnectPeer(ctx, req.(*ConnectPeerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ContorlCommand_DisconnectPeer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DisconnectPeerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContorlCommandServer).DisconnectPeer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.ContorlCommand/DisconnectPeer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContorlCommandServer).DisconnectPeer(ctx, req.(*DisconnectPeerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ContorlCommand_BanPeer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BanPeerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContorlCommandServer).BanPeer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.ContorlCommand/BanPeer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContorlCommandServer).BanPeer(ctx, req.(*BanPeerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ContorlCommand_UnbanPeer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnbanPeerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContorlCommandServer).UnbanPeer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.ContorlCommand/UnbanPeer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContorlCommandServer).UnbanPeer(ctx, req.(*UnbanPeerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ContorlCommand_ListBans_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBansRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContorlCommandServer).ListBans(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.ContorlCommand/ListBans",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContorlCommandServer).ListBans(ctx, req.(*ListBansRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ContorlCommand_ConvertAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConvertAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContorlCommandServer).ConvertAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.ContorlCommand/ConvertAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContorlCommandServer).ConvertAddress(ctx, req.(*ConvertAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ContorlCommand_CaptureProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CaptureProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContorlCommandServer).CaptureProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.ContorlCommand/CaptureProfile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContorlCommandServer).CaptureProfile(ctx, req.(*CaptureProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ContorlCommand_GetNodeStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNodeStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContorlCommandServer).GetNodeStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.ContorlCommand/GetNodeStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContorlCommandServer).GetNodeStatus(ctx, req.(*GetNodeStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _ContorlCommand_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "rpcpb.ContorlCommand",
+	HandlerType: (*ContorlCommandServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SetDebugLevel",
+			Handler:    _ContorlCommand_SetDebugLevel_Handler,
+		},
+		{
+			MethodName: "UpdateNetworkID",
+			Handler:    _ContorlCommand_UpdateNetworkID_Handler,
+		},
+		{
+			MethodName: "GetBlockHeight",
+			Handler:    _ContorlCommand_GetBlockHeight_Handler,
+		},
+		{
+			MethodName: "GetBlockHash",
+			Handler:    _ContorlCommand_GetBlockHash_Handler,
+		},
+		{
+			MethodName: "GetBlockHeader",
+			Handler:    _ContorlCommand_GetBlockHeader_Handler,
+		},
+		{
+			MethodName: "GetBlock",
+			Handler:    _ContorlCommand_GetBlock_Handler,
+		},
+		{
+			MethodName: "GetBlockVerbose",
+			Handler:    _ContorlCommand_GetBlockVerbose_Handler,
+		},
+		{
+			MethodName: "GetBlockHeaderVerbose",
+			Handler:    _ContorlCommand_GetBlockHeaderVerbose_Handler,
+		},
+		{
+			MethodName: "GetNodeInfo",
+			Handler:    _ContorlCommand_GetNodeInfo_Handler,
+		},
+		{
+			MethodName: "GetNetworkInfo",
+			Handler:    _ContorlCommand_GetNetworkInfo_Handler,
+		},
+		{
+			MethodName: "GetPeerInfo",
+			Handler:    _ContorlCommand_GetPeerInfo_Handler,
+		},
+		{
+			MethodName: "ConnectPeer",
+			Handler:    _ContorlCommand_ConnectPeer_Handler,
+		},
+		{
+			MethodName: "DisconnectPeer",
+			Handler:    _ContorlCommand_DisconnectPeer_Handler,
+		},
+		{
+			MethodName: "BanPeer",
+			Handler:    _ContorlCommand_BanPeer_Handler,
+		},
+		{
+			MethodName: "UnbanPeer",
+			Handler:    _ContorlCommand_UnbanPeer_Handler,
+		},
+		{
+			MethodName: "ListBans",
+			Handler:    _ContorlCommand_ListBans_Handler,
+		},
+		{
+			MethodName: "ConvertAddress",
+			Handler:    _ContorlCommand_ConvertAddress_Handler,
+		},
+		{
+			MethodName: "CaptureProfile",
+			Handler:    _ContorlCommand_CaptureProfile_Handler,
+		},
+		{
+			MethodName: "GetNodeStatus",
+			Handler:    _ContorlCommand_GetNodeStatus_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "SubscribeEternalBlocks",
+			Handler:       _ContorlCommand_SubscribeEternalBlocks_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "control.proto",
+}
+
+func (m *DebugLevelRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DebugLevelRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Level) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintControl(dAtA, i, uint64(len(m.Level)))
+		i += copy(dAtA[i:], m.Level)
+	}
+	if len(m.Module) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintControl(dAtA, i, uint64(len(m.Module)))
+		i += copy(dAtA[i:], m.Module)
+	}
+	return i, nil
+}
+
+func (m *UpdateNetworkIDRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateNetworkIDRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintControl(dAtA, i, uint64(m.Id))
+	}
+	return i, nil
+}
+
+func (m *GetBlockHeightRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetBlockHeightRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	return i, nil
+}
+
+func (m *GetBlockHeightResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetBlockHeightResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Code != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintControl(dAtA, i, uint64(m.Code))
+	}
+	if len(m.Message) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintControl(dAtA, i, uint64(len(m.Message)))
+		i += copy(dAtA[i:], m.Message)
+	}
+	if m.Height != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintControl(dAtA, i, uint64(m.Height))
+	}
+	return i, nil
+}
+
+func (m *GetBlockHashRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetBlockHashRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintControl(dAtA, i, uint64(m.Height))
+	}
+	return i, nil
+}
+
+func (m *GetBlockHashResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetBlockHashResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Code != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintControl(dAtA, i, uint64(m.Code))
+	}
+	if len(m.Message) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintControl(dAtA, i, uint64(len(m.Message)))
+		i += copy(dAtA[i:], m.Message)
+	}
+	if len(m.Hash) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintControl(dAtA, i, uint64(len(m.Hash)))
+		i += copy(dAtA[i:], m.Hash)
+	}
+	return i, nil
+}
+
+func (m *GetBlockRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetBlockRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.BlockHash) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintControl(dAtA, i, uint64(len(m.BlockHash)))
+		i += copy(dAtA[i:], m.BlockHash)
+	}
+	return i, nil
+}
+
+func (m *GetBlockHeaderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetBlockHeaderResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Code != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintControl(dAtA, i, uint64(m.Code))
+	}
+	if len(m.Message) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintControl(dAtA, i, uint64(len(m.Message)))
+		i += copy(dAtA[i:], m.Message)
+	}
+	if m.Header != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintControl(dAtA, i, uint64(m.Header.Size()))
+		n1, err := m.Header.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n1
+	}
+	return i, nil
+}
+
+func (m *GetBlockResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetBlockResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Code != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintControl(dAtA, i, uint64(m.Code))
+	}
+	if len(m.Message) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintControl(dAtA, i, uint64(len(m.Message)))
+		i += copy(dAtA[i:], m.Message)
+	}
+	if m.Block != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintControl(dAtA, i, uint64(m.Block.Size()))
+		n2, err := m.Block.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n2
+	}
+	return i, nil
+}
+
+func (m *GetBlockVerboseRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetBlockVerboseRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Hash) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintControl(dAtA, i, uint64(len(m.Hash)))
+		i += copy(dAtA[i:], m.Hash)
+	}
+	if m.Height != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintControl(dAtA, i, uint64(m.Height))
+	}
+	if m.Verbosity != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintControl(dAtA, i, uint64(m.Verbosity))
+	}
+	return i, nil
+}
+
+func (m *BlockInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlockInfo) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Hash) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintControl(dAtA, i, uint64(len(m.Hash)))
+		i += copy(dAtA[i:], m.Hash)
+	}
+	if m.Height != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintControl(dAtA, i, uint64(m.Height))
+	}
+	if m.Confirmations != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintControl(dAtA, i, uint64(m.Confirmations))
+	}
+	if m.BlockSize != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintControl(dAtA, i, uint64(m.BlockSize))
+	}
+	if m.Header != nil {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintControl(dAtA, i, uint64(m.Header.Size()))
+		n3, err := m.Header.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n3
+	}
+	if m.TxCount != 0 {
+		dAtA[i] = 0x30
+		i++
+		i = encodeVarintControl(dAtA, i, uint64(m.TxCount))
+	}
+	if len(m.NextHash) > 0 {
+		dAtA[i] = 0x3a
+		i++
+		i = encodeVarintControl(dAtA, i, uint64(len(m.NextHash)))
+		i += copy(dAtA[i:], m.NextHash)
+	}
+	if len(m.TxHashes) > 0 {
+		for _, s := range m.TxHashes {
+			dAtA[i] = 0x42
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.Txs) > 0 {
+		for _, msg := range m.Txs {
+			dAtA[i] = 0x4a
+			i++
+			i = encodeVarintControl(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *GetBlockVerboseResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetBlockVerboseResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Code != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintControl(dAtA, i, uint64(m.Code))
+	}
+	if len(m.Message) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintControl(dAtA, i, uint64(len(m.Message)))
+		i += copy(dAtA[i:], m.Message)
+	}
+	if len(m.Raw) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintControl(dAtA, i, uint64(len(m.Raw)))
+		i += copy(dAtA[i:], m.Raw)
+	}
+	if m.Block != nil {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintControl(dAtA, i, uint64(m.Block.Size()))
+		n4, err := m.Block.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n4
+	}
+	return i, nil
+}
+
+func (m *Node) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Node) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintControl(dAtA, i, uint64(len(m.Id)))
+		i += copy(dAtA[i:], m.Id)
+	}
+	if len(m.Addrs) > 0 {
+		for _, s := range m.Addrs {
+			dAtA[i] = 0x12
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.Ttl) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintControl(dAtA, i, uint64(len(m.Ttl)))
+		i += copy(dAtA[i:], m.Ttl)
+	}
+	return i, nil
+}
+
+func (m *GetNodeInfoRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetNodeInfoRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	return i, nil
+}
+
+func (m *GetNodeInfoResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetNodeInfoResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Nodes) > 0 {
+		for _, msg := range m.Nodes {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintControl(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *GetNetworkInfoRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetNetworkInfoRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	return i, nil
+}
+
+func (m *GetNetworkInfoResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetNetworkInfoResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Code != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintControl(dAtA, i, uint64(m.Code))
+	}
+	if len(m.Message) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintControl(dAtA, i, uint64(len(m.Message)))
+		i += copy(dAtA[i:], m.Message)
+	}
+	if len(m.PeerId) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintControl(dAtA, i, uint64(len(m.PeerId)))
+		i += copy(dAtA[i:], m.PeerId)
+	}
+	if m.NetworkId != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintControl(dAtA, i, uint64(m.NetworkId))
+	}
+	if len(m.ListenAddrs) > 0 {
+		for _, s := range m.ListenAddrs {
+			dAtA[i] = 0x2a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if m.PeerCount != 0 {
+		dAtA[i] = 0x30
+		i++
+		i = encodeVarintControl(dAtA, i, uint64(m.PeerCount))
+	}
+	if m.Synced {
+		dAtA[i] = 0x38
+		i++
+		if m.Synced {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	return i, nil
+}
+
+func (m *GetPeerInfoRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetPeerInfoRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.PeerId) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintControl(dAtA, i, uint64(len(m.PeerId)))
+		i += copy(dAtA[i:], m.PeerId)
+	}
+	return i, nil
+}
+
+func (m *PeerInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PeerInfo) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintControl(dAtA, i, uint64(len(m.Id)))
+		i += copy(dAtA[i:], m.Id)
+	}
+	if len(m.Addr) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintControl(dAtA, i, uint64(len(m.Addr)))
+		i += copy(dAtA[i:], m.Addr)
+	}
+	if m.Inbound {
+		dAtA[i] = 0x18
+		i++
+		if m.Inbound {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.Synced {
+		dAtA[i] = 0x20
+		i++
+		if m.Synced {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.Score != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintControl(dAtA, i, uint64(m.Score))
+	}
+	if m.Latency != 0 {
+		dAtA[i] = 0x30
+		i++
+		i = encodeVarintControl(dAtA, i, uint64(m.Latency))
+	}
+	if m.BytesSent != 0 {
+		dAtA[i] = 0x38
+		i++
+		i = encodeVarintControl(dAtA, i, uint64(m.BytesSent))
+	}
+	if m.BytesRecv != 0 {
+		dAtA[i] = 0x40
+		i++
+		i = encodeVarintControl(dAtA, i, uint64(m.BytesRecv))
+	}
+	if m.LastMsgTime != 0 {
+		dAtA[i] = 0x48
+		i++
+		i = encodeVarintControl(dAtA, i, uint64(m.LastMsgTime))
+	}
+	if len(m.Traffic) > 0 {
+		for _, msg := range m.Traffic {
+			dAtA[i] = 0x52
+			i++
+			i = encodeVarintControl(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *MessageTraffic) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MessageTraffic) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Code != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintControl(dAtA, i, uint64(m.Code))
+	}
+	if m.MsgsSent != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintControl(dAtA, i, uint64(m.MsgsSent))
+	}
+	if m.BytesSent != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintControl(dAtA, i, uint64(m.BytesSent))
+	}
+	if m.MsgsRecv != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintControl(dAtA, i, uint64(m.MsgsRecv))
+	}
+	if m.BytesRecv != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintControl(dAtA, i, uint64(m.BytesRecv))
+	}
+	return i, nil
+}
+
+func (m *GetPeerInfoResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetPeerInfoResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Code != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintControl(dAtA, i, uint64(m.Code))
+	}
+	if len(m.Message) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintControl(dAtA, i, uint64(len(m.Message)))
+		i += copy(dAtA[i:], m.Message)
+	}
+	if len(m.Peers) > 0 {
+		for _, msg := range m.Peers {
+			dAtA[i] = 0x1a
+			i++
+			i = encodeVarintControl(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *ConnectPeerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConnectPeerRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Addr) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintControl(dAtA, i, uint64(len(m.Addr)))
+		i += copy(dAtA[i:], m.Addr)
+	}
+	return i, nil
+}
+
+func (m *DisconnectPeerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DisconnectPeerRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.PeerId) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintControl(dAtA, i, uint64(len(m.PeerId)))
+		i += copy(dAtA[i:], m.PeerId)
+	}
+	return i, nil
+}
+
+func (m *BanPeerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BanPeerRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Target) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintControl(dAtA, i, uint64(len(m.Target)))
+		i += copy(dAtA[i:], m.Target)
+	}
+	if m.Duration != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintControl(dAtA, i, uint64(m.Duration))
+	}
+	return i, nil
+}
+
+func (m *UnbanPeerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UnbanPeerRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Target) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintControl(dAtA, i, uint64(len(m.Target)))
+		i += copy(dAtA[i:], m.Target)
+	}
+	return i, nil
+}
+
+func (m *ListBansRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListBansRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	return i, nil
+}
+
+func (m *Ban) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Ban) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Target) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintControl(dAtA, i, uint64(len(m.Target)))
+		i += copy(dAtA[i:], m.Target)
+	}
+	if m.Until != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintControl(dAtA, i, uint64(m.Until))
+	}
+	return i, nil
+}
+
+func (m *ListBansResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListBansResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Code != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintControl(dAtA, i, uint64(m.Code))
+	}
+	if len(m.Message) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintControl(dAtA, i, uint64(len(m.Message)))
+		i += copy(dAtA[i:], m.Message)
+	}
+	if len(m.Bans) > 0 {
+		for _, msg := range m.Bans {
+			dAtA[i] = 0x1a
+			i++
+			i = encodeVarintControl(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *ConvertAddressRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConvertAddressRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Addr) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintControl(dAtA, i, uint64(len(m.Addr)))
+		i += copy(dAtA[i:], m.Addr)
+	}
+	return i, nil
+}
+
+func (m *ConvertAddressResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConvertAddressResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Code != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintControl(dAtA, i, uint64(m.Code))
+	}
+	if len(m.Message) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintControl(dAtA, i, uint64(len(m.Message)))
+		i += copy(dAtA[i:], m.Message)
+	}
+	if len(m.Base58) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintControl(dAtA, i, uint64(len(m.Base58)))
+		i += copy(dAtA[i:], m.Base58)
+	}
+	if len(m.Bech32) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintControl(dAtA, i, uint64(len(m.Bech32)))
+		i += copy(dAtA[i:], m.Bech32)
+	}
+	return i, nil
+}
+
+func (m *CaptureProfileRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CaptureProfileRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Profile) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintControl(dAtA, i, uint64(len(m.Profile)))
+		i += copy(dAtA[i:], m.Profile)
+	}
+	if m.Seconds != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintControl(dAtA, i, uint64(m.Seconds))
+	}
+	if len(m.Name) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintControl(dAtA, i, uint64(len(m.Name)))
+		i += copy(dAtA[i:], m.Name)
+	}
+	return i, nil
+}
+
+func (m *CaptureProfileResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CaptureProfileResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Code != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintControl(dAtA, i, uint64(m.Code))
+	}
+	if len(m.Message) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintControl(dAtA, i, uint64(len(m.Message)))
+		i += copy(dAtA[i:], m.Message)
+	}
+	if len(m.Path) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintControl(dAtA, i, uint64(len(m.Path)))
+		i += copy(dAtA[i:], m.Path)
+	}
+	return i, nil
+}
+
+func (m *GetNodeStatusRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetNodeStatusRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	return i, nil
+}
+
+func (m *GetNodeStatusResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetNodeStatusResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Code != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintControl(dAtA, i, uint64(m.Code))
+	}
+	if len(m.Message) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintControl(dAtA, i, uint64(len(m.Message)))
+		i += copy(dAtA[i:], m.Message)
+	}
+	if m.Height != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintControl(dAtA, i, uint64(m.Height))
+	}
+	if len(m.TipHash) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintControl(dAtA, i, uint64(len(m.TipHash)))
+		i += copy(dAtA[i:], m.TipHash)
+	}
+	if m.TipTime != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintControl(dAtA, i, uint64(m.TipTime))
+	}
+	if m.EternalHeight != 0 {
+		dAtA[i] = 0x30
+		i++
+		i = encodeVarintControl(dAtA, i, uint64(m.EternalHeight))
+	}
+	if len(m.EternalHash) > 0 {
+		dAtA[i] = 0x3a
+		i++
+		i = encodeVarintControl(dAtA, i, uint64(len(m.EternalHash)))
+		i += copy(dAtA[i:], m.EternalHash)
+	}
+	if m.Synced {
+		dAtA[i] = 0x40
+		i++
+		if m.Synced {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.PeerCount != 0 {
+		dAtA[i] = 0x48
+		i++
+		i = encodeVarintControl(dAtA, i, uint64(m.PeerCount))
+	}
+	if m.MempoolSize != 0 {
+		dAtA[i] = 0x50
+		i++
+		i = encodeVarintControl(dAtA, i, uint64(m.MempoolSize))
+	}
+	if m.MempoolBytes != 0 {
+		dAtA[i] = 0x58
+		i++
+		i = encodeVarintControl(dAtA, i, uint64(m.MempoolBytes))
+	}
+	if m.MempoolOrphans != 0 {
+		dAtA[i] = 0x60
+		i++
+		i = encodeVarintControl(dAtA, i, uint64(m.MempoolOrphans))
+	}
+	if m.WalletEnabled {
+		dAtA[i] = 0x68
+		i++
+		if m.WalletEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.WalletUnlocked {
+		dAtA[i] = 0x70
+		i++
+		if m.WalletUnlocked {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.Healthy {
+		dAtA[i] = 0x78
+		i++
+		if m.Healthy {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if len(m.Subsystems) > 0 {
+		for k, _ := range m.Subsystems {
+			dAtA[i] = 0x82
+			i++
+			dAtA[i] = 0x1
+			i++
+			v := m.Subsystems[k]
+			mapSize := 1 + len(k) + sovControl(uint64(len(k))) + 1 + 1
+			i = encodeVarintControl(dAtA, i, uint64(mapSize))
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintControl(dAtA, i, uint64(len(k)))
+			i += copy(dAtA[i:], k)
+			dAtA[i] = 0x10
+			i++
+			if v {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i++
+		}
+	}
+	if len(m.Services) > 0 {
+		for _, msg := range m.Services {
+			dAtA[i] = 0x8a
+			i++
+			dAtA[i] = 0x1
+			i++
+			i = encodeVarintControl(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *ServiceStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ServiceStatus) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Name) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintControl(dAtA, i, uint64(len(m.Name)))
+		i += copy(dAtA[i:], m.Name)
+	}
+	if len(m.State) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintControl(dAtA, i, uint64(len(m.State)))
+		i += copy(dAtA[i:], m.State)
+	}
+	if m.Critical {
+		dAtA[i] = 0x18
+		i++
+		if m.Critical {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.Restarts != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintControl(dAtA, i, uint64(m.Restarts))
+	}
+	if len(m.Error) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintControl(dAtA, i, uint64(len(m.Error)))
+		i += copy(dAtA[i:], m.Error)
+	}
+	if m.Since != 0 {
+		dAtA[i] = 0x30
+		i++
+		i = encodeVarintControl(dAtA, i, uint64(m.Since))
+	}
+	return i, nil
+}
+
+func (m *SubscribeEternalBlocksRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SubscribeEternalBlocksRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	return i, nil
+}
+
+func (m *EternalBlock) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EternalBlock) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Code != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintControl(dAtA, i, uint64(m.Code))
+	}
+	if len(m.Message) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintControl(dAtA, i, uint64(len(m.Message)))
+		i += copy(dAtA[i:], m.Message)
+	}
+	if len(m.Hash) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintControl(dAtA, i, uint64(len(m.Hash)))
+		i += copy(dAtA[i:], m.Hash)
+	}
+	if m.Height != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintControl(dAtA, i, uint64(m.Height))
+	}
+	if m.Timestamp != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintControl(dAtA, i, uint64(m.Timestamp))
+	}
+	return i, nil
+}
+
+func encodeVarintControl(dAtA []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return offset + 1
+}
+func (m *DebugLevelRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Level)
+	if l > 0 {
+		n += 1 + l + sovControl(uint64(l))
+	}
+	l = len(m.Module)
+	if l > 0 {
+		n += 1 + l + sovControl(uint64(l))
+	}
+	return n
+}
+
+func (m *UpdateNetworkIDRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovControl(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *GetBlockHeightRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *GetBlockHeightResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Code != 0 {
+		n += 1 + sovControl(uint64(m.Code))
+	}
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovControl(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovControl(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *GetBlockHashRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovControl(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *GetBlockHashResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Code != 0 {
+		n += 1 + sovControl(uint64(m.Code))
+	}
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovControl(uint64(l))
+	}
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovControl(uint64(l))
+	}
+	return n
+}
+
+func (m *GetBlockRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BlockHash)
+	if l > 0 {
+		n += 1 + l + sovControl(uint64(l))
+	}
+	return n
+}
+
+func (m *GetBlockHeaderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Code != 0 {
+		n += 1 + sovControl(uint64(m.Code))
+	}
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovControl(uint64(l))
+	}
+	if m.Header != nil {
+		l = m.Header.Size()
+		n += 1 + l + sovControl(uint64(l))
+	}
+	return n
+}
+
+func (m *GetBlockResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Code != 0 {
+		n += 1 + sovControl(uint64(m.Code))
+	}
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovControl(uint64(l))
+	}
+	if m.Block != nil {
+		l = m.Block.Size()
+		n += 1 + l + sovControl(uint64(l))
+	}
+	return n
+}
+
+func (m *GetBlockVerboseRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovControl(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovControl(uint64(m.Height))
+	}
+	if m.Verbosity != 0 {
+		n += 1 + sovControl(uint64(m.Verbosity))
+	}
+	return n
+}
+
+func (m *BlockInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovControl(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovControl(uint64(m.Height))
+	}
+	if m.Confirmations != 0 {
+		n += 1 + sovControl(uint64(m.Confirmations))
+	}
+	if m.BlockSize != 0 {
+		n += 1 + sovControl(uint64(m.BlockSize))
+	}
+	if m.Header != nil {
+		l = m.Header.Size()
+		n += 1 + l + sovControl(uint64(l))
+	}
+	if m.TxCount != 0 {
+		n += 1 + sovControl(uint64(m.TxCount))
+	}
+	l = len(m.NextHash)
+	if l > 0 {
+		n += 1 + l + sovControl(uint64(l))
+	}
+	if len(m.TxHashes) > 0 {
+		for _, s := range m.TxHashes {
+			l = len(s)
+			n += 1 + l + sovControl(uint64(l))
+		}
+	}
+	if len(m.Txs) > 0 {
+		for _, e := range m.Txs {
+			l = e.Size()
+			n += 1 + l + sovControl(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GetBlockVerboseResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Code != 0 {
+		n += 1 + sovControl(uint64(m.Code))
+	}
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovControl(uint64(l))
+	}
+	l = len(m.Raw)
+	if l > 0 {
+		n += 1 + l + sovControl(uint64(l))
+	}
+	if m.Block != nil {
+		l = m.Block.Size()
+		n += 1 + l + sovControl(uint64(l))
+	}
+	return n
+}
+
+func (m *Node) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovControl(uint64(l))
+	}
+	if len(m.Addrs) > 0 {
+		for _, s := range m.Addrs {
+			l = len(s)
+			n += 1 + l + sovControl(uint64(l))
+		}
+	}
+	l = len(m.Ttl)
+	if l > 0 {
+		n += 1 + l + sovControl(uint64(l))
+	}
+	return n
+}
+
+func (m *GetNodeInfoRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *GetNodeInfoResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Nodes) > 0 {
+		for _, e := range m.Nodes {
+			l = e.Size()
+			n += 1 + l + sovControl(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GetNetworkInfoRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *GetNetworkInfoResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Code != 0 {
+		n += 1 + sovControl(uint64(m.Code))
+	}
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovControl(uint64(l))
+	}
+	l = len(m.PeerId)
+	if l > 0 {
+		n += 1 + l + sovControl(uint64(l))
+	}
+	if m.NetworkId != 0 {
+		n += 1 + sovControl(uint64(m.NetworkId))
+	}
+	if len(m.ListenAddrs) > 0 {
+		for _, s := range m.ListenAddrs {
+			l = len(s)
+			n += 1 + l + sovControl(uint64(l))
+		}
+	}
+	if m.PeerCount != 0 {
+		n += 1 + sovControl(uint64(m.PeerCount))
+	}
+	if m.Synced {
+		n += 2
+	}
+	return n
+}
+
+func (m *GetPeerInfoRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PeerId)
+	if l > 0 {
+		n += 1 + l + sovControl(uint64(l))
+	}
+	return n
+}
+
+func (m *PeerInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovControl(uint64(l))
+	}
+	l = len(m.Addr)
+	if l > 0 {
+		n += 1 + l + sovControl(uint64(l))
+	}
+	if m.Inbound {
+		n += 2
+	}
+	if m.Synced {
+		n += 2
+	}
+	if m.Score != 0 {
+		n += 1 + sovControl(uint64(m.Score))
+	}
+	if m.Latency != 0 {
+		n += 1 + sovControl(uint64(m.Latency))
+	}
+	if m.BytesSent != 0 {
+		n += 1 + sovControl(uint64(m.BytesSent))
+	}
+	if m.BytesRecv != 0 {
+		n += 1 + sovControl(uint64(m.BytesRecv))
+	}
+	if m.LastMsgTime != 0 {
+		n += 1 + sovControl(uint64(m.LastMsgTime))
+	}
+	if len(m.Traffic) > 0 {
+		for _, e := range m.Traffic {
+			l = e.Size()
+			n += 1 + l + sovControl(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MessageTraffic) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Code != 0 {
+		n += 1 + sovControl(uint64(m.Code))
+	}
+	if m.MsgsSent != 0 {
+		n += 1 + sovControl(uint64(m.MsgsSent))
+	}
+	if m.BytesSent != 0 {
+		n += 1 + sovControl(uint64(m.BytesSent))
+	}
+	if m.MsgsRecv != 0 {
+		n += 1 + sovControl(uint64(m.MsgsRecv))
+	}
+	if m.BytesRecv != 0 {
+		n += 1 + sovControl(uint64(m.BytesRecv))
+	}
+	return n
+}
+
+func (m *GetPeerInfoResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Code != 0 {
+		n += 1 + sovControl(uint64(m.Code))
+	}
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovControl(uint64(l))
+	}
+	if len(m.Peers) > 0 {
+		for _, e := range m.Peers {
+			l = e.Size()
+			n += 1 + l + sovControl(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ConnectPeerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Addr)
+	if l > 0 {
+		n += 1 + l + sovControl(uint64(l))
+	}
+	return n
+}
+
+func (m *DisconnectPeerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PeerId)
+	if l > 0 {
+		n += 1 + l + sovControl(uint64(l))
+	}
+	return n
+}
+
+func (m *BanPeerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Target)
+	if l > 0 {
+		n += 1 + l + sovControl(uint64(l))
+	}
+	if m.Duration != 0 {
+		n += 1 + sovControl(uint64(m.Duration))
+	}
+	return n
+}
+
+func (m *UnbanPeerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Target)
+	if l > 0 {
+		n += 1 + l + sovControl(uint64(l))
+	}
+	return n
+}
+
+func (m *ListBansRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *Ban) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Target)
+	if l > 0 {
+		n += 1 + l + sovControl(uint64(l))
+	}
+	if m.Until != 0 {
+		n += 1 + sovControl(uint64(m.Until))
+	}
+	return n
+}
+
+func (m *ListBansResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Code != 0 {
+		n += 1 + sovControl(uint64(m.Code))
+	}
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovControl(uint64(l))
+	}
+	if len(m.Bans) > 0 {
+		for _, e := range m.Bans {
+			l = e.Size()
+			n += 1 + l + sovControl(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ConvertAddressRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Addr)
+	if l > 0 {
+		n += 1 + l + sovControl(uint64(l))
+	}
+	return n
+}
+
+func (m *ConvertAddressResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Code != 0 {
+		n += 1 + sovControl(uint64(m.Code))
+	}
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovControl(uint64(l))
+	}
+	l = len(m.Base58)
+	if l > 0 {
+		n += 1 + l + sovControl(uint64(l))
+	}
+	l = len(m.Bech32)
+	if l > 0 {
+		n += 1 + l + sovControl(uint64(l))
+	}
+	return n
+}
+
+func (m *CaptureProfileRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Profile)
+	if l > 0 {
+		n += 1 + l + sovControl(uint64(l))
+	}
+	if m.Seconds != 0 {
+		n += 1 + sovControl(uint64(m.Seconds))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovControl(uint64(l))
+	}
+	return n
+}
+
+func (m *CaptureProfileResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Code != 0 {
+		n += 1 + sovControl(uint64(m.Code))
+	}
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovControl(uint64(l))
+	}
+	l = len(m.Path)
+	if l > 0 {
+		n += 1 + l + sovControl(uint64(l))
+	}
+	return n
+}
+
+func (m *GetNodeStatusRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *GetNodeStatusResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Code != 0 {
+		n += 1 + sovControl(uint64(m.Code))
+	}
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovControl(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovControl(uint64(m.Height))
+	}
+	l = len(m.TipHash)
+	if l > 0 {
+		n += 1 + l + sovControl(uint64(l))
+	}
+	if m.TipTime != 0 {
+		n += 1 + sovControl(uint64(m.TipTime))
+	}
+	if m.EternalHeight != 0 {
+		n += 1 + sovControl(uint64(m.EternalHeight))
+	}
+	l = len(m.EternalHash)
+	if l > 0 {
+		n += 1 + l + sovControl(uint64(l))
+	}
+	if m.Synced {
+		n += 2
+	}
+	if m.PeerCount != 0 {
+		n += 1 + sovControl(uint64(m.PeerCount))
+	}
+	if m.MempoolSize != 0 {
+		n += 1 + sovControl(uint64(m.MempoolSize))
+	}
+	if m.MempoolBytes != 0 {
+		n += 1 + sovControl(uint64(m.MempoolBytes))
+	}
+	if m.MempoolOrphans != 0 {
+		n += 1 + sovControl(uint64(m.MempoolOrphans))
+	}
+	if m.WalletEnabled {
+		n += 2
+	}
+	if m.WalletUnlocked {
+		n += 2
+	}
+	if m.Healthy {
+		n += 2
+	}
+	if len(m.Subsystems) > 0 {
+		for k, v := range m.Subsystems {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovControl(uint64(len(k))) + 1 + 1
+			n += mapEntrySize + 2 + sovControl(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Services) > 0 {
+		for _, e := range m.Services {
+			l = e.Size()
+			n += 2 + l + sovControl(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ServiceStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovControl(uint64(l))
+	}
+	l = len(m.State)
+	if l > 0 {
+		n += 1 + l + sovControl(uint64(l))
+	}
+	if m.Critical {
+		n += 2
+	}
+	if m.Restarts != 0 {
+		n += 1 + sovControl(uint64(m.Restarts))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovControl(uint64(l))
+	}
+	if m.Since != 0 {
+		n += 1 + sovControl(uint64(m.Since))
+	}
+	return n
+}
+
+func (m *SubscribeEternalBlocksRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *EternalBlock) Size() (n int) {
 	if m == nil {
 		return 0
 	}
-	var l int
-	_ = l
-	return n
-}
+	var l int
+	_ = l
+	if m.Code != 0 {
+		n += 1 + sovControl(uint64(m.Code))
+	}
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovControl(uint64(l))
+	}
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovControl(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovControl(uint64(m.Height))
+	}
+	if m.Timestamp != 0 {
+		n += 1 + sovControl(uint64(m.Timestamp))
+	}
+	return n
+}
+
+func sovControl(x uint64) (n int) {
+	for {
+		n++
+		x >>= 7
+		if x == 0 {
+			break
+		}
+	}
+	return n
+}
+func sozControl(x uint64) (n int) {
+	return sovControl(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *DebugLevelRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowControl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DebugLevelRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DebugLevelRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Level", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthControl
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Level = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Module", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthControl
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Module = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipControl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthControl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateNetworkIDRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowControl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateNetworkIDRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateNetworkIDRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipControl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthControl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetBlockHeightRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowControl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetBlockHeightRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetBlockHeightRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipControl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthControl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetBlockHeightResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowControl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetBlockHeightResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetBlockHeightResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			m.Code = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Code |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthControl
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipControl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthControl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetBlockHashRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowControl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetBlockHashRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetBlockHashRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipControl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthControl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetBlockHashResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowControl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetBlockHashResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetBlockHashResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			m.Code = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Code |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthControl
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthControl
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipControl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthControl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetBlockRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowControl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetBlockRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetBlockRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthControl
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipControl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthControl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetBlockHeaderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowControl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetBlockHeaderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetBlockHeaderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			m.Code = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Code |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthControl
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthControl
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Header == nil {
+				m.Header = &pb.BlockHeader{}
+			}
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipControl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthControl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetBlockResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowControl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetBlockResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetBlockResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			m.Code = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Code |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthControl
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Block", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthControl
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Block == nil {
+				m.Block = &pb.Block{}
+			}
+			if err := m.Block.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipControl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthControl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetBlockVerboseRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowControl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetBlockVerboseRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetBlockVerboseRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthControl
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Verbosity", wireType)
+			}
+			m.Verbosity = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Verbosity |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipControl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthControl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlockInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowControl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlockInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlockInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthControl
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Confirmations", wireType)
+			}
+			m.Confirmations = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Confirmations |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockSize", wireType)
+			}
+			m.BlockSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockSize |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthControl
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Header == nil {
+				m.Header = &pb.BlockHeader{}
+			}
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxCount", wireType)
+			}
+			m.TxCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TxCount |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthControl
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NextHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHashes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthControl
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxHashes = append(m.TxHashes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Txs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthControl
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Txs = append(m.Txs, &TransactionDetail{})
+			if err := m.Txs[len(m.Txs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipControl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthControl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetBlockVerboseResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowControl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetBlockVerboseResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetBlockVerboseResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			m.Code = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Code |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthControl
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Raw", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthControl
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Raw = append(m.Raw[:0], dAtA[iNdEx:postIndex]...)
+			if m.Raw == nil {
+				m.Raw = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Block", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthControl
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Block == nil {
+				m.Block = &BlockInfo{}
+			}
+			if err := m.Block.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipControl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthControl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Node) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowControl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Node: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Node: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthControl
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addrs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthControl
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addrs = append(m.Addrs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ttl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthControl
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ttl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipControl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthControl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetNodeInfoRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowControl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetNodeInfoRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetNodeInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipControl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthControl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetNodeInfoResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowControl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetNodeInfoResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetNodeInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nodes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthControl
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Nodes = append(m.Nodes, &Node{})
+			if err := m.Nodes[len(m.Nodes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipControl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthControl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetNetworkInfoRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowControl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetNetworkInfoRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetNetworkInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipControl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthControl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetNetworkInfoResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowControl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetNetworkInfoResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetNetworkInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			m.Code = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Code |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthControl
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthControl
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PeerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NetworkId", wireType)
+			}
+			m.NetworkId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NetworkId |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ListenAddrs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthControl
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ListenAddrs = append(m.ListenAddrs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeerCount", wireType)
+			}
+			m.PeerCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PeerCount |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Synced", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Synced = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipControl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthControl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetPeerInfoRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowControl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetPeerInfoRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetPeerInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthControl
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PeerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipControl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthControl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PeerInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowControl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PeerInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PeerInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthControl
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthControl
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Inbound", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Inbound = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Synced", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Synced = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Score", wireType)
+			}
+			m.Score = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Score |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Latency", wireType)
+			}
+			m.Latency = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Latency |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BytesSent", wireType)
+			}
+			m.BytesSent = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BytesSent |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BytesRecv", wireType)
+			}
+			m.BytesRecv = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BytesRecv |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastMsgTime", wireType)
+			}
+			m.LastMsgTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastMsgTime |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Traffic", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthControl
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Traffic = append(m.Traffic, &MessageTraffic{})
+			if err := m.Traffic[len(m.Traffic)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipControl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthControl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MessageTraffic) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowControl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MessageTraffic: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MessageTraffic: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			m.Code = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Code |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgsSent", wireType)
+			}
+			m.MsgsSent = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MsgsSent |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BytesSent", wireType)
+			}
+			m.BytesSent = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BytesSent |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgsRecv", wireType)
+			}
+			m.MsgsRecv = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MsgsRecv |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BytesRecv", wireType)
+			}
+			m.BytesRecv = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BytesRecv |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipControl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthControl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetPeerInfoResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowControl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetPeerInfoResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetPeerInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			m.Code = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Code |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthControl
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Peers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthControl
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Peers = append(m.Peers, &PeerInfo{})
+			if err := m.Peers[len(m.Peers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipControl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthControl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConnectPeerRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowControl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConnectPeerRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConnectPeerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthControl
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipControl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthControl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DisconnectPeerRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowControl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DisconnectPeerRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DisconnectPeerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthControl
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PeerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipControl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthControl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BanPeerRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowControl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BanPeerRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BanPeerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Target", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthControl
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Target = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			m.Duration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Duration |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipControl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthControl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UnbanPeerRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowControl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UnbanPeerRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UnbanPeerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Target", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthControl
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Target = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipControl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthControl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *GetNodeInfoResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	if len(m.Nodes) > 0 {
-		for _, e := range m.Nodes {
-			l = e.Size()
-			n += 1 + l + sovControl(uint64(l))
+	return nil
+}
+func (m *ListBansRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowControl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListBansRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListBansRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipControl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthControl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func sovControl(x uint64) (n int) {
-	for {
-		n++
-		x >>= 7
-		if x == 0 {
-			break
-		}
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-func sozControl(x uint64) (n int) {
-	return sovControl(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *DebugLevelRequest) Unmarshal(dAtA []byte) error {
+func (m *Ban) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1489,15 +8283,15 @@ func (m *DebugLevelRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DebugLevelRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: Ban: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DebugLevelRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Ban: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Level", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Target", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1522,8 +8316,27 @@ func (m *DebugLevelRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Level = string(dAtA[iNdEx:postIndex])
+			m.Target = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Until", wireType)
+			}
+			m.Until = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Until |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipControl(dAtA[iNdEx:])
@@ -1545,7 +8358,7 @@ func (m *DebugLevelRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UpdateNetworkIDRequest) Unmarshal(dAtA []byte) error {
+func (m *ListBansResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1568,17 +8381,17 @@ func (m *UpdateNetworkIDRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UpdateNetworkIDRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListBansResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UpdateNetworkIDRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListBansResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
 			}
-			m.Id = 0
+			m.Code = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowControl
@@ -1588,11 +8401,71 @@ func (m *UpdateNetworkIDRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= (uint32(b) & 0x7F) << shift
+				m.Code |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthControl
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bans", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthControl
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bans = append(m.Bans, &Ban{})
+			if err := m.Bans[len(m.Bans)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipControl(dAtA[iNdEx:])
@@ -1614,7 +8487,7 @@ func (m *UpdateNetworkIDRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetBlockHeightRequest) Unmarshal(dAtA []byte) error {
+func (m *ConvertAddressRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1637,12 +8510,41 @@ func (m *GetBlockHeightRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetBlockHeightRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ConvertAddressRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetBlockHeightRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ConvertAddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthControl
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipControl(dAtA[iNdEx:])
@@ -1664,7 +8566,7 @@ func (m *GetBlockHeightRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetBlockHeightResponse) Unmarshal(dAtA []byte) error {
+func (m *ConvertAddressResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1687,10 +8589,10 @@ func (m *GetBlockHeightResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetBlockHeightResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ConvertAddressResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetBlockHeightResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ConvertAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1742,10 +8644,10 @@ func (m *GetBlockHeightResponse) Unmarshal(dAtA []byte) error {
 			m.Message = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Base58", wireType)
 			}
-			m.Height = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowControl
@@ -1755,11 +8657,50 @@ func (m *GetBlockHeightResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Height |= (uint32(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthControl
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Base58 = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bech32", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthControl
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bech32 = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipControl(dAtA[iNdEx:])
@@ -1781,7 +8722,7 @@ func (m *GetBlockHeightResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetBlockHashRequest) Unmarshal(dAtA []byte) error {
+func (m *CaptureProfileRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1804,17 +8745,46 @@ func (m *GetBlockHashRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetBlockHashRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: CaptureProfileRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetBlockHashRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CaptureProfileRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Profile", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthControl
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Profile = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Seconds", wireType)
 			}
-			m.Height = 0
+			m.Seconds = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowControl
@@ -1824,11 +8794,40 @@ func (m *GetBlockHashRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Height |= (uint32(b) & 0x7F) << shift
+				m.Seconds |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthControl
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipControl(dAtA[iNdEx:])
@@ -1850,7 +8849,7 @@ func (m *GetBlockHashRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetBlockHashResponse) Unmarshal(dAtA []byte) error {
+func (m *CaptureProfileResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1873,10 +8872,10 @@ func (m *GetBlockHashResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetBlockHashResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: CaptureProfileResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetBlockHashResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CaptureProfileResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1929,7 +8928,7 @@ func (m *GetBlockHashResponse) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1954,7 +8953,7 @@ func (m *GetBlockHashResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Hash = string(dAtA[iNdEx:postIndex])
+			m.Path = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1977,7 +8976,7 @@ func (m *GetBlockHashResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetBlockRequest) Unmarshal(dAtA []byte) error {
+func (m *GetNodeStatusRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2000,41 +8999,12 @@ func (m *GetBlockRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetBlockRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetNodeStatusRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetBlockRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetNodeStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockHash", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowControl
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthControl
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.BlockHash = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipControl(dAtA[iNdEx:])
@@ -2056,7 +9026,7 @@ func (m *GetBlockRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetBlockHeaderResponse) Unmarshal(dAtA []byte) error {
+func (m *GetNodeStatusResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2079,10 +9049,10 @@ func (m *GetBlockHeaderResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetBlockHeaderResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetNodeStatusResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetBlockHeaderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetNodeStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2134,10 +9104,29 @@ func (m *GetBlockHeaderResponse) Unmarshal(dAtA []byte) error {
 			m.Message = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TipHash", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowControl
@@ -2147,80 +9136,209 @@ func (m *GetBlockHeaderResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthControl
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Header == nil {
-				m.Header = &pb.BlockHeader{}
+			m.TipHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TipTime", wireType)
 			}
-			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.TipTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TipTime |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipControl(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EternalHeight", wireType)
 			}
-			if skippy < 0 {
+			m.EternalHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EternalHeight |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EternalHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthControl
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *GetBlockResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowControl
+			m.EternalHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Synced", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Synced = bool(v != 0)
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeerCount", wireType)
+			}
+			m.PeerCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PeerCount |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MempoolSize", wireType)
+			}
+			m.MempoolSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MempoolSize |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MempoolBytes", wireType)
+			}
+			m.MempoolBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MempoolBytes |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MempoolOrphans", wireType)
+			}
+			m.MempoolOrphans = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MempoolOrphans |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WalletEnabled", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GetBlockResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetBlockResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.WalletEnabled = bool(v != 0)
+		case 14:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WalletUnlocked", wireType)
 			}
-			m.Code = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowControl
@@ -2230,16 +9348,37 @@ func (m *GetBlockResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Code |= (int32(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+			m.WalletUnlocked = bool(v != 0)
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Healthy", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Healthy = bool(v != 0)
+		case 16:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Subsystems", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowControl
@@ -2249,24 +9388,104 @@ func (m *GetBlockResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthControl
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Message = string(dAtA[iNdEx:postIndex])
+			if m.Subsystems == nil {
+				m.Subsystems = make(map[string]bool)
+			}
+			var mapkey string
+			var mapvalue bool
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowControl
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowControl
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthControl
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapvaluetemp int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowControl
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvaluetemp |= (int(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					mapvalue = bool(mapvaluetemp != 0)
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipControl(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthControl
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Subsystems[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 3:
+		case 17:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Block", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Services", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2290,10 +9509,8 @@ func (m *GetBlockResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Block == nil {
-				m.Block = &pb.Block{}
-			}
-			if err := m.Block.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Services = append(m.Services, &ServiceStatus{})
+			if err := m.Services[len(m.Services)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2318,7 +9535,7 @@ func (m *GetBlockResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Node) Unmarshal(dAtA []byte) error {
+func (m *ServiceStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2341,15 +9558,15 @@ func (m *Node) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Node: wiretype end group for non-group")
+			return fmt.Errorf("proto: ServiceStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Node: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ServiceStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2374,11 +9591,11 @@ func (m *Node) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Addrs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2403,11 +9620,50 @@ func (m *Node) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Addrs = append(m.Addrs, string(dAtA[iNdEx:postIndex]))
+			m.State = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Critical", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Critical = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Restarts", wireType)
+			}
+			m.Restarts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Restarts |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ttl", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2432,8 +9688,27 @@ func (m *Node) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Ttl = string(dAtA[iNdEx:postIndex])
+			m.Error = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Since", wireType)
+			}
+			m.Since = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Since |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipControl(dAtA[iNdEx:])
@@ -2455,7 +9730,7 @@ func (m *Node) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetNodeInfoRequest) Unmarshal(dAtA []byte) error {
+func (m *SubscribeEternalBlocksRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2478,10 +9753,10 @@ func (m *GetNodeInfoRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetNodeInfoRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: SubscribeEternalBlocksRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetNodeInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SubscribeEternalBlocksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2505,7 +9780,7 @@ func (m *GetNodeInfoRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetNodeInfoResponse) Unmarshal(dAtA []byte) error {
+func (m *EternalBlock) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2528,17 +9803,36 @@ func (m *GetNodeInfoResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetNodeInfoResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: EternalBlock: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetNodeInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EternalBlock: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			m.Code = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Code |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nodes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowControl
@@ -2548,23 +9842,88 @@ func (m *GetNodeInfoResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthControl
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Nodes = append(m.Nodes, &Node{})
-			if err := m.Nodes[len(m.Nodes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthControl
 			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipControl(dAtA[iNdEx:])
@@ -2691,52 +10050,140 @@ var (
 	ErrIntOverflowControl   = fmt.Errorf("proto: integer overflow")
 )
 
-func init() { proto.RegisterFile("control.proto", fileDescriptor_control_64397726cecc4593) }
-
-var fileDescriptor_control_64397726cecc4593 = []byte{
-	// 702 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0x4f, 0x6f, 0xd3, 0x4c,
-	0x10, 0xc6, 0xeb, 0xa4, 0xe9, 0xfb, 0x66, 0xd2, 0xf4, 0xcf, 0x26, 0x4d, 0x5d, 0xb7, 0xc9, 0xdb,
-	0xee, 0x7b, 0x29, 0x45, 0xc4, 0xb4, 0x5c, 0x50, 0x0f, 0x1c, 0xd2, 0x8a, 0x52, 0x09, 0x15, 0xc9,
-	0x08, 0xa9, 0x17, 0x40, 0xb6, 0x77, 0x6b, 0x9b, 0x3a, 0x5e, 0x63, 0x6f, 0x0a, 0x67, 0x3e, 0x01,
-	0x12, 0x5f, 0x8a, 0x63, 0x25, 0x2e, 0x1c, 0x38, 0xa0, 0x96, 0x0f, 0x82, 0x76, 0xbd, 0xc6, 0x4e,
-	0x9a, 0x72, 0xe8, 0xcd, 0x93, 0x99, 0x79, 0x7e, 0xb3, 0xbb, 0xf3, 0x04, 0x9a, 0x2e, 0x8b, 0x78,
-	0xc2, 0xc2, 0x7e, 0x9c, 0x30, 0xce, 0x50, 0x2d, 0x89, 0xdd, 0xd8, 0x31, 0x76, 0xbd, 0x80, 0xfb,
-	0x23, 0xa7, 0xef, 0xb2, 0xa1, 0x39, 0x78, 0x71, 0xfa, 0x94, 0x8d, 0x22, 0x62, 0xf3, 0x80, 0x45,
-	0xa6, 0xc3, 0x3e, 0x12, 0xd3, 0x65, 0x09, 0x35, 0x63, 0xc7, 0x74, 0x42, 0xe6, 0x9e, 0x67, 0x9d,
-	0xc6, 0xbc, 0xcb, 0x86, 0x43, 0x16, 0xa9, 0x68, 0xc3, 0x63, 0xcc, 0x0b, 0xa9, 0x69, 0xc7, 0x81,
-	0x69, 0x47, 0x11, 0xe3, 0xb2, 0x3b, 0xcd, 0xb2, 0xf8, 0x1e, 0x2c, 0x1f, 0x52, 0x67, 0xe4, 0x3d,
-	0xa7, 0x17, 0x34, 0xb4, 0xe8, 0xfb, 0x11, 0x4d, 0x39, 0x6a, 0x43, 0x2d, 0x14, 0xb1, 0xae, 0x6d,
-	0x6a, 0xdb, 0x75, 0x2b, 0x0b, 0xf0, 0x36, 0x74, 0x5e, 0xc5, 0xc4, 0xe6, 0xf4, 0x84, 0xf2, 0x0f,
-	0x2c, 0x39, 0x3f, 0x3e, 0xcc, 0xeb, 0x17, 0xa0, 0x12, 0x10, 0x59, 0xdc, 0xb4, 0x2a, 0x01, 0xc1,
-	0xab, 0xb0, 0x72, 0x44, 0xf9, 0x40, 0x8c, 0xf4, 0x8c, 0x06, 0x9e, 0xcf, 0x55, 0x21, 0x7e, 0x03,
-	0x9d, 0xc9, 0x44, 0x1a, 0xb3, 0x28, 0xa5, 0x08, 0xc1, 0xac, 0xcb, 0x08, 0x95, 0x22, 0x35, 0x4b,
-	0x7e, 0x23, 0x1d, 0xfe, 0x19, 0xd2, 0x34, 0xb5, 0x3d, 0xaa, 0x57, 0xe4, 0x20, 0x79, 0x88, 0x3a,
-	0x30, 0xe7, 0xcb, 0x7e, 0xbd, 0x2a, 0xa1, 0x2a, 0xc2, 0x0f, 0xa0, 0xf5, 0x47, 0xdf, 0x4e, 0xfd,
-	0x7c, 0xbe, 0xa2, 0x5c, 0x1b, 0x2b, 0x3f, 0x85, 0xf6, 0x78, 0xf9, 0x9d, 0x86, 0x41, 0x30, 0xeb,
-	0xdb, 0xa9, 0x2f, 0x47, 0xa9, 0x5b, 0xf2, 0x1b, 0x3f, 0x84, 0xc5, 0x5c, 0x39, 0x1f, 0xa2, 0x0b,
-	0x20, 0x1f, 0xe9, 0xad, 0x2c, 0xce, 0x6e, 0xb6, 0xee, 0xe4, 0x6c, 0x9c, 0x96, 0xaf, 0xc6, 0x26,
-	0x34, 0xb9, 0xe3, 0x34, 0xf7, 0xc5, 0x59, 0x45, 0xbf, 0x9c, 0xa7, 0xb1, 0xd7, 0xea, 0x8b, 0x15,
-	0x89, 0x9d, 0x7e, 0x59, 0x5a, 0x95, 0x60, 0x0a, 0x4b, 0xc5, 0x98, 0x77, 0xc2, 0xfd, 0x0f, 0x35,
-	0x79, 0x06, 0x45, 0x6b, 0x8e, 0xd1, 0xac, 0x2c, 0x87, 0x9f, 0xc0, 0xec, 0x89, 0x90, 0x29, 0xf6,
-	0xa4, 0x2e, 0xf6, 0x44, 0xec, 0x99, 0x4d, 0x48, 0x92, 0xea, 0x95, 0xcd, 0xaa, 0xd8, 0x33, 0x19,
-	0xa0, 0x25, 0xa8, 0x72, 0x1e, 0xaa, 0xeb, 0x14, 0x9f, 0xb8, 0x0d, 0xe8, 0x88, 0x72, 0x21, 0x71,
-	0x1c, 0x9d, 0xb1, 0x7c, 0x99, 0x1e, 0xcb, 0xc7, 0x2e, 0x7e, 0x55, 0xf3, 0x6f, 0x41, 0x2d, 0x62,
-	0x84, 0xa6, 0xba, 0xb6, 0x59, 0xdd, 0x6e, 0xec, 0x35, 0xfa, 0xd2, 0x47, 0x7d, 0x51, 0x67, 0x65,
-	0x99, 0xbd, 0x1f, 0x35, 0x58, 0x38, 0x60, 0x11, 0x67, 0x49, 0x78, 0xc0, 0x86, 0x43, 0x3b, 0x22,
-	0xe8, 0x35, 0x34, 0x5f, 0x52, 0x5e, 0x58, 0x01, 0xe9, 0xaa, 0xef, 0x86, 0x3b, 0x8c, 0x96, 0xca,
-	0x0c, 0xec, 0x94, 0xe6, 0x54, 0xdc, 0xfd, 0xf4, 0xed, 0xd7, 0x97, 0xca, 0x2a, 0x46, 0xe6, 0xc5,
-	0xae, 0xe9, 0xf2, 0xd0, 0x24, 0xa2, 0x4f, 0x1a, 0x67, 0x5f, 0xdb, 0x41, 0x2e, 0x2c, 0x4e, 0x78,
-	0x07, 0x75, 0x95, 0xcc, 0x74, 0x4f, 0x4d, 0xa7, 0x6c, 0x48, 0x4a, 0x07, 0x2f, 0xe7, 0x94, 0x28,
-	0x6b, 0x0b, 0x88, 0x80, 0xc4, 0xb0, 0x30, 0xee, 0x2e, 0xb4, 0xa1, 0x44, 0xa6, 0xba, 0xd1, 0xe8,
-	0xde, 0x92, 0x55, 0xb0, 0x2d, 0x09, 0x5b, 0xc7, 0x9d, 0x1c, 0xe6, 0x51, 0x2e, 0xdf, 0x33, 0x73,
-	0x8f, 0x20, 0xfa, 0x30, 0x5f, 0x36, 0x10, 0x32, 0x26, 0x15, 0x0b, 0x13, 0x1a, 0xeb, 0x53, 0x73,
-	0x8a, 0xf5, 0x9f, 0x64, 0xad, 0xe1, 0xf6, 0x0d, 0x96, 0x9d, 0xfa, 0x82, 0xf4, 0xae, 0x7c, 0x36,
-	0xb1, 0xbb, 0xa8, 0x33, 0xa1, 0x77, 0xfb, 0xa9, 0xca, 0x6e, 0xfa, 0xdb, 0xa9, 0x44, 0x9d, 0x60,
-	0x9d, 0xc2, 0xbf, 0x79, 0xf3, 0xad, 0x94, 0xd5, 0x1b, 0xbf, 0x2b, 0xfd, 0x75, 0xa9, 0xbf, 0x82,
-	0x97, 0x26, 0xf5, 0x85, 0x32, 0x81, 0x46, 0x69, 0x65, 0xd1, 0x5a, 0x21, 0x32, 0xb1, 0xdc, 0x86,
-	0x31, 0x2d, 0xa5, 0x10, 0x3d, 0x89, 0xd0, 0x71, 0xab, 0x84, 0x10, 0x8b, 0x1d, 0x44, 0x67, 0x6c,
-	0x5f, 0xdb, 0x19, 0xe8, 0x5f, 0xaf, 0x7a, 0xda, 0xe5, 0x55, 0x4f, 0xfb, 0x79, 0xd5, 0xd3, 0x3e,
-	0x5f, 0xf7, 0x66, 0x2e, 0xaf, 0x7b, 0x33, 0xdf, 0xaf, 0x7b, 0x33, 0xce, 0x9c, 0xfc, 0xd3, 0x7f,
-	0xf4, 0x3b, 0x00, 0x00, 0xff, 0xff, 0xe4, 0x31, 0xa6, 0xf7, 0x6b, 0x06, 0x00, 0x00,
+func init() { proto.RegisterFile("control.proto", fileDescriptor_control_5ba66d7dcfcd869f) }
+
+var fileDescriptor_control_5ba66d7dcfcd869f = []byte{
+	// 2104 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0xcd, 0x6f, 0x1b, 0xc7,
+	0x15, 0xf7, 0x4a, 0xa4, 0x44, 0x3e, 0x89, 0x92, 0x3c, 0x96, 0xa8, 0x35, 0xf5, 0x11, 0x7b, 0x9c,
+	0xb4, 0xae, 0xd3, 0x88, 0x8e, 0x8d, 0x02, 0x86, 0x81, 0x16, 0x88, 0x64, 0x37, 0x31, 0x60, 0x27,
+	0xe9, 0xca, 0x6e, 0x8d, 0x02, 0xa9, 0x3a, 0xdc, 0x1d, 0x91, 0x1b, 0x2f, 0x67, 0xd9, 0x9d, 0xa1,
+	0x2c, 0xe5, 0xd4, 0xf6, 0xd0, 0x73, 0x81, 0x5e, 0xdb, 0x53, 0x81, 0x1e, 0x7a, 0xe8, 0xdf, 0xd1,
+	0x63, 0x80, 0x5e, 0x72, 0x2c, 0xec, 0xfe, 0x21, 0xc5, 0xbc, 0x99, 0xd9, 0x0f, 0x8a, 0xb4, 0x53,
+	0x35, 0x37, 0xbe, 0x8f, 0xfd, 0xbd, 0x8f, 0x79, 0xf3, 0xe6, 0x3d, 0x42, 0x2b, 0x4c, 0x85, 0xca,
+	0xd2, 0x64, 0x6f, 0x94, 0xa5, 0x2a, 0x25, 0xf5, 0x6c, 0x14, 0x8e, 0x7a, 0x9d, 0x0f, 0xfb, 0xb1,
+	0x1a, 0x8c, 0x7b, 0x7b, 0x61, 0x3a, 0xec, 0xee, 0x7f, 0xf6, 0xfc, 0xa7, 0xe9, 0x58, 0x44, 0x4c,
+	0xc5, 0xa9, 0xe8, 0xf6, 0xd2, 0xd3, 0xa8, 0x1b, 0xa6, 0x19, 0xef, 0x8e, 0x7a, 0xdd, 0x5e, 0x92,
+	0x86, 0x2f, 0xcc, 0x97, 0x9d, 0xe5, 0x30, 0x1d, 0x0e, 0x53, 0x61, 0xa9, 0xcb, 0x2a, 0x63, 0x42,
+	0xb2, 0x50, 0xc5, 0x39, 0x6b, 0xbb, 0x9f, 0xa6, 0xfd, 0x84, 0x77, 0xd9, 0x28, 0xee, 0x32, 0x21,
+	0x52, 0x85, 0x80, 0xd2, 0x48, 0xe9, 0x47, 0x70, 0xf9, 0x01, 0xef, 0x8d, 0xfb, 0x8f, 0xf9, 0x09,
+	0x4f, 0x02, 0xfe, 0x9b, 0x31, 0x97, 0x8a, 0xac, 0x43, 0x3d, 0xd1, 0xb4, 0xef, 0x5d, 0xf3, 0x6e,
+	0x36, 0x03, 0x43, 0x90, 0x36, 0x2c, 0x0c, 0xd3, 0x68, 0x9c, 0x70, 0x7f, 0x0e, 0xd9, 0x96, 0xa2,
+	0x37, 0xa1, 0xfd, 0x6c, 0x14, 0x31, 0xc5, 0x3f, 0xe5, 0xea, 0x65, 0x9a, 0xbd, 0x78, 0xf4, 0xc0,
+	0xe1, 0xac, 0xc0, 0x5c, 0x1c, 0x21, 0x48, 0x2b, 0x98, 0x8b, 0x23, 0xba, 0x09, 0x1b, 0x1f, 0x73,
+	0xb5, 0xaf, 0xbd, 0xff, 0x84, 0xc7, 0xfd, 0x81, 0xb2, 0x8a, 0xf4, 0x57, 0xd0, 0x9e, 0x14, 0xc8,
+	0x51, 0x2a, 0x24, 0x27, 0x04, 0x6a, 0x61, 0x1a, 0x71, 0x04, 0xa9, 0x07, 0xf8, 0x9b, 0xf8, 0xb0,
+	0x38, 0xe4, 0x52, 0xb2, 0xbe, 0xf3, 0xc4, 0x91, 0xda, 0xc5, 0x01, 0x7e, 0xef, 0xcf, 0xa3, 0x51,
+	0x4b, 0xd1, 0x0f, 0xe0, 0x4a, 0x8e, 0xcf, 0xe4, 0xc0, 0xf9, 0x57, 0xa8, 0x7b, 0x15, 0xf5, 0xe7,
+	0xb0, 0x5e, 0x55, 0xbf, 0x90, 0x33, 0x04, 0x6a, 0x03, 0x26, 0x07, 0xe8, 0x4a, 0x33, 0xc0, 0xdf,
+	0xf4, 0x36, 0xac, 0x3a, 0x64, 0xe7, 0xc4, 0x0e, 0x00, 0x9e, 0xe7, 0x11, 0x2a, 0x9b, 0x8c, 0x37,
+	0x7b, 0xce, 0x36, 0x95, 0xe5, 0xd4, 0xb0, 0x88, 0x67, 0x17, 0xf4, 0xe6, 0x7d, 0x1d, 0xab, 0xfe,
+	0x1e, 0xfd, 0x59, 0xba, 0x73, 0x65, 0x4f, 0x57, 0xd3, 0xa8, 0xb7, 0x57, 0x86, 0xb6, 0x2a, 0x94,
+	0xc3, 0x5a, 0xe1, 0xe6, 0x85, 0xcc, 0xdd, 0x80, 0x3a, 0xc6, 0x60, 0xad, 0xb5, 0x2a, 0xd6, 0x02,
+	0x23, 0xa3, 0xbd, 0x22, 0xb6, 0x9f, 0xf3, 0xac, 0x97, 0x4a, 0xee, 0x92, 0xe2, 0x72, 0xe7, 0x15,
+	0xb9, 0x2b, 0x9d, 0xd6, 0x5c, 0xf9, 0xb4, 0xc8, 0x36, 0x34, 0x4f, 0xf0, 0xeb, 0x58, 0x9d, 0xd9,
+	0x73, 0x2f, 0x18, 0xf4, 0xef, 0x73, 0xd0, 0x44, 0x0b, 0x8f, 0xc4, 0x71, 0xfa, 0x3f, 0xe1, 0xbe,
+	0x8b, 0x97, 0xf4, 0x38, 0xce, 0x86, 0xe6, 0xc6, 0x58, 0xec, 0x2a, 0xb3, 0x38, 0x3e, 0x19, 0x7f,
+	0xc5, 0xfd, 0x9a, 0x31, 0x8f, 0x9c, 0xc3, 0xf8, 0xab, 0x72, 0xda, 0xeb, 0x6f, 0x4d, 0x3b, 0xb9,
+	0x0a, 0x0d, 0x75, 0x7a, 0x14, 0xa6, 0x63, 0xa1, 0xfc, 0x05, 0x44, 0x5a, 0x54, 0xa7, 0x07, 0x9a,
+	0x24, 0x5b, 0xd0, 0x14, 0xfc, 0x54, 0x99, 0x22, 0x59, 0x44, 0xef, 0x1b, 0x9a, 0xa1, 0x6b, 0x44,
+	0x0b, 0xd5, 0x29, 0x8a, 0xb8, 0xf4, 0x1b, 0xd7, 0xe6, 0xb5, 0x50, 0x9d, 0x7e, 0x82, 0x34, 0xb9,
+	0x05, 0xf3, 0xea, 0x54, 0xfa, 0xcd, 0x6b, 0xf3, 0x37, 0x97, 0xee, 0xf8, 0x7b, 0xd8, 0x68, 0xf6,
+	0x9e, 0x16, 0x6d, 0xe2, 0x01, 0x57, 0x2c, 0x4e, 0x02, 0xad, 0x44, 0x7f, 0xe7, 0xc1, 0xe6, 0xb9,
+	0x13, 0xb9, 0xd0, 0xf9, 0xaf, 0xc1, 0x7c, 0xc6, 0x5e, 0x62, 0xca, 0x96, 0x03, 0xfd, 0x93, 0x7c,
+	0xcf, 0x55, 0x44, 0x0d, 0x13, 0xb1, 0x66, 0x3d, 0xc9, 0xcf, 0xc6, 0x15, 0xc5, 0x4f, 0xa0, 0xf6,
+	0xa9, 0xc6, 0x2e, 0x9a, 0x47, 0x53, 0x37, 0x0f, 0xdd, 0x94, 0x58, 0x14, 0x65, 0xd2, 0x9f, 0xc3,
+	0x00, 0x0d, 0xa1, 0xed, 0x28, 0x95, 0xd8, 0x3b, 0xa6, 0x7f, 0xd2, 0x75, 0x20, 0x1f, 0x73, 0xa5,
+	0x21, 0x10, 0xd5, 0x76, 0x98, 0x7b, 0x70, 0xa5, 0xc2, 0xb5, 0x41, 0x5d, 0x87, 0xba, 0x48, 0x23,
+	0x2e, 0x7d, 0x0f, 0xd3, 0xb3, 0x64, 0x9d, 0xd2, 0x7a, 0x81, 0x91, 0xd8, 0xa6, 0xe5, 0x7a, 0x5b,
+	0x09, 0xf2, 0x1b, 0x0f, 0xda, 0x93, 0x92, 0x0b, 0xe5, 0x6a, 0x13, 0x16, 0x47, 0x9c, 0x67, 0x47,
+	0x71, 0x64, 0xe3, 0x58, 0xd0, 0xe4, 0xa3, 0x48, 0xd7, 0x96, 0x30, 0xe8, 0x5a, 0x66, 0x6b, 0xcb,
+	0x72, 0x1e, 0x45, 0xe4, 0x3a, 0x2c, 0x27, 0xb1, 0x54, 0x5c, 0x1c, 0x99, 0xc4, 0xd4, 0x31, 0x31,
+	0x4b, 0x86, 0xf7, 0x11, 0xa6, 0x67, 0x07, 0x00, 0xa1, 0xcb, 0x35, 0xd5, 0xd4, 0x1c, 0x53, 0x55,
+	0x6d, 0x58, 0x90, 0x67, 0x22, 0xe4, 0x11, 0x96, 0x54, 0x23, 0xb0, 0x14, 0xfd, 0x00, 0x73, 0xf8,
+	0xb9, 0xf6, 0xa2, 0x08, 0xb8, 0xec, 0xa7, 0x57, 0xf6, 0x93, 0xfe, 0x75, 0x0e, 0x1a, 0x4e, 0xf9,
+	0xdc, 0xb9, 0x11, 0xa8, 0x69, 0xf7, 0x6c, 0xd0, 0xf8, 0x5b, 0xe7, 0x22, 0x16, 0x3d, 0xfd, 0xba,
+	0x61, 0xc4, 0x8d, 0xc0, 0x91, 0x25, 0x8f, 0x6a, 0x65, 0x8f, 0xf4, 0xe9, 0x4b, 0x7d, 0x73, 0xf0,
+	0x1a, 0xcd, 0x07, 0x86, 0xd0, 0x38, 0x09, 0x53, 0x5c, 0x84, 0x67, 0x18, 0xdb, 0x7c, 0xe0, 0x48,
+	0xbc, 0x96, 0x67, 0x8a, 0xcb, 0x23, 0xc9, 0x85, 0xc2, 0xe8, 0x6a, 0x41, 0x13, 0x39, 0x87, 0x5c,
+	0xa8, 0x42, 0x9c, 0xf1, 0xf0, 0xc4, 0x6f, 0x94, 0xc4, 0x01, 0x0f, 0x4f, 0x08, 0x85, 0x56, 0xc2,
+	0xa4, 0x3a, 0x1a, 0xca, 0xfe, 0x91, 0x8a, 0x87, 0xdc, 0x6f, 0x22, 0xfa, 0x92, 0x66, 0x3e, 0x91,
+	0xfd, 0xa7, 0xf1, 0x90, 0x93, 0x2e, 0x2c, 0xaa, 0x8c, 0x1d, 0x1f, 0xc7, 0xa1, 0x0f, 0x58, 0x3c,
+	0x1b, 0xb6, 0x78, 0x9e, 0x98, 0x63, 0x7d, 0x6a, 0x84, 0x81, 0xd3, 0xa2, 0x7f, 0xf6, 0x60, 0xa5,
+	0x2a, 0xab, 0xd4, 0x49, 0xcb, 0xd6, 0xc9, 0x16, 0x34, 0x87, 0xb2, 0x6f, 0x1d, 0x9f, 0x43, 0xcf,
+	0x1a, 0x9a, 0x51, 0xf5, 0x1b, 0xa5, 0xf3, 0x93, 0x61, 0xb9, 0x6f, 0x31, 0xaa, 0x5a, 0xf1, 0x2d,
+	0x06, 0x55, 0x8d, 0xb9, 0x3e, 0x11, 0x33, 0xfd, 0x12, 0x6f, 0x48, 0x71, 0xe6, 0x17, 0x2a, 0xe5,
+	0xf7, 0xa0, 0xae, 0x6b, 0x42, 0xf7, 0x4a, 0x9d, 0x92, 0x55, 0x9b, 0x92, 0x1c, 0xd5, 0x48, 0xe9,
+	0x4d, 0x20, 0x07, 0xa9, 0x10, 0x3c, 0x44, 0x7b, 0xa5, 0xa6, 0x8f, 0x95, 0xe2, 0x15, 0x95, 0x42,
+	0x6f, 0xc3, 0xc6, 0x83, 0x58, 0x86, 0xe7, 0x95, 0x67, 0x16, 0xe3, 0x03, 0x58, 0xd9, 0x67, 0xa2,
+	0xac, 0xda, 0x86, 0x05, 0xc5, 0xb2, 0x3e, 0x57, 0x4e, 0xd3, 0x50, 0xa4, 0x03, 0x8d, 0x68, 0x9c,
+	0x61, 0x1f, 0xc7, 0x38, 0xe6, 0x83, 0x9c, 0xa6, 0xb7, 0x60, 0xed, 0x99, 0xe8, 0x7d, 0x2b, 0x1c,
+	0x7a, 0x19, 0x56, 0x1f, 0xc7, 0x52, 0xed, 0x33, 0x21, 0x5d, 0x6f, 0xb8, 0x0b, 0xf3, 0xfb, 0x4c,
+	0xcc, 0xb4, 0xbc, 0x0e, 0xf5, 0xb1, 0x50, 0x71, 0x62, 0xcd, 0x1a, 0x82, 0xfe, 0x1a, 0xd6, 0x0a,
+	0x9c, 0x0b, 0xa5, 0x7f, 0x17, 0x6a, 0x3d, 0x26, 0x5c, 0xf6, 0xc1, 0xb5, 0x58, 0x26, 0x02, 0xe4,
+	0xd3, 0xf7, 0x61, 0xe3, 0x20, 0x15, 0x27, 0x3c, 0x53, 0xba, 0x3d, 0x70, 0x29, 0xdf, 0x94, 0xfa,
+	0x13, 0x68, 0x4f, 0x2a, 0x5f, 0x74, 0x28, 0xeb, 0x31, 0xc9, 0x7f, 0x74, 0xcf, 0x75, 0x37, 0x43,
+	0x21, 0x9f, 0x87, 0x83, 0xbb, 0x77, 0xfc, 0x9a, 0xe5, 0x23, 0x45, 0x8f, 0x60, 0xe3, 0x80, 0x8d,
+	0xd4, 0x38, 0xe3, 0x9f, 0x67, 0xe9, 0x71, 0x9c, 0xe4, 0x43, 0x81, 0x0f, 0x8b, 0x23, 0xc3, 0xb1,
+	0x7e, 0x3a, 0x52, 0x4b, 0x24, 0x0f, 0x53, 0x11, 0x49, 0xfb, 0x86, 0x3b, 0x52, 0xbb, 0x2a, 0xd8,
+	0x90, 0xbb, 0x21, 0x4c, 0xff, 0xa6, 0xbf, 0x84, 0xf6, 0xa4, 0x81, 0x8b, 0x0e, 0x78, 0x23, 0xa6,
+	0xf2, 0x01, 0x4f, 0xff, 0xa6, 0x6d, 0x1c, 0x1d, 0xf5, 0xfb, 0x71, 0xa8, 0x98, 0x1a, 0xe7, 0x05,
+	0xf1, 0x8f, 0x3a, 0x6c, 0x4c, 0x08, 0xbe, 0xcb, 0x09, 0x17, 0x47, 0x87, 0x78, 0x64, 0xc6, 0x03,
+	0x93, 0xce, 0x45, 0x15, 0x8f, 0x70, 0x3a, 0xb0, 0x22, 0xec, 0x63, 0xa6, 0x7b, 0x6a, 0x11, 0xf6,
+	0xb0, 0xf7, 0x60, 0x85, 0x2b, 0x9e, 0x09, 0x96, 0x1c, 0x59, 0x54, 0xf3, 0x44, 0xb4, 0x2c, 0xd7,
+	0x0c, 0xe3, 0xfa, 0xa1, 0xc9, 0xd5, 0x8a, 0xf9, 0x63, 0xc9, 0x29, 0xd9, 0x21, 0xca, 0xf6, 0xed,
+	0x46, 0xa5, 0x6f, 0x57, 0x1f, 0xa0, 0xe6, 0xe4, 0x03, 0x74, 0x1d, 0x96, 0x87, 0x7c, 0x38, 0x4a,
+	0xd3, 0xc4, 0xcc, 0x4f, 0x80, 0x0a, 0x4b, 0x96, 0x87, 0x13, 0xd4, 0x0d, 0x68, 0x39, 0x15, 0x6c,
+	0x56, 0xfe, 0x12, 0xea, 0xb8, 0xef, 0xf6, 0x35, 0x8f, 0x7c, 0x1f, 0x56, 0x9d, 0x52, 0x9a, 0x8d,
+	0x06, 0xfa, 0x0e, 0x2c, 0xa3, 0xda, 0x8a, 0x65, 0x7f, 0x66, 0xb8, 0x3a, 0xe2, 0x97, 0x2c, 0x49,
+	0xb8, 0x3a, 0xe2, 0x82, 0xf5, 0x12, 0x1e, 0xf9, 0x2d, 0xf4, 0xb7, 0x65, 0xb8, 0x0f, 0x0d, 0x53,
+	0xe3, 0x59, 0xb5, 0xb1, 0xd0, 0x53, 0x09, 0x8f, 0xfc, 0x15, 0xd4, 0xb3, 0x5f, 0x3f, 0xb3, 0x5c,
+	0x7d, 0x52, 0x03, 0xce, 0x12, 0x35, 0x38, 0xf3, 0x57, 0xcd, 0x4b, 0x66, 0x49, 0xf2, 0x18, 0x40,
+	0x8e, 0x7b, 0xf2, 0x4c, 0x2a, 0x3e, 0x94, 0xfe, 0x1a, 0xde, 0xc8, 0x1f, 0xda, 0x1b, 0x39, 0xb5,
+	0x12, 0xf6, 0x0e, 0x73, 0xf5, 0x87, 0x42, 0x65, 0x67, 0x41, 0xe9, 0x7b, 0x72, 0x1b, 0x1a, 0x92,
+	0x67, 0x27, 0x71, 0xc8, 0xa5, 0x7f, 0x19, 0xb1, 0xd6, 0x2d, 0xd6, 0xa1, 0x61, 0x5b, 0xac, 0x5c,
+	0xab, 0xf3, 0x63, 0x58, 0x9d, 0x00, 0xd4, 0xc3, 0xd2, 0x0b, 0x7e, 0x66, 0x2f, 0x8f, 0xfe, 0xa9,
+	0x1b, 0xd1, 0x09, 0x4b, 0xc6, 0xa6, 0xcc, 0x1a, 0x81, 0x21, 0xee, 0xcf, 0xdd, 0xf3, 0xe8, 0x5f,
+	0x3c, 0x68, 0x55, 0xa0, 0xf3, 0xab, 0xe4, 0x15, 0x57, 0x49, 0x7f, 0x2f, 0x15, 0x53, 0xae, 0x4c,
+	0x0d, 0xa1, 0x1b, 0x6b, 0x98, 0xc5, 0x2a, 0x0e, 0x59, 0x62, 0xdf, 0xf7, 0x9c, 0xd6, 0xb2, 0x8c,
+	0x4b, 0xc5, 0x32, 0x25, 0xed, 0x44, 0x93, 0xd3, 0x1a, 0x8d, 0x67, 0x59, 0x6a, 0x66, 0xe5, 0x66,
+	0x60, 0x08, 0xb4, 0x11, 0x8b, 0x90, 0xdb, 0x27, 0xde, 0x10, 0xf4, 0x1d, 0xd8, 0xd1, 0xe1, 0x85,
+	0x59, 0xdc, 0xe3, 0x0f, 0x4d, 0x21, 0xe2, 0x28, 0x99, 0xdf, 0xb8, 0x3f, 0x78, 0xb0, 0x5c, 0x16,
+	0xfc, 0xff, 0xdb, 0x5b, 0xe9, 0xf2, 0xd5, 0x26, 0x37, 0x10, 0x7d, 0xbb, 0xa4, 0x62, 0xc3, 0x91,
+	0xbd, 0x62, 0x05, 0xe3, 0xce, 0xdf, 0xd6, 0x60, 0xe5, 0x20, 0x15, 0x2a, 0xcd, 0x92, 0x83, 0x74,
+	0x38, 0x64, 0x22, 0x22, 0x5f, 0xe8, 0xdc, 0xaa, 0x62, 0xf1, 0x26, 0x6e, 0x2e, 0x3f, 0xb7, 0x8b,
+	0x77, 0xae, 0xe4, 0x4d, 0xbc, 0x98, 0xc5, 0xe9, 0xce, 0xef, 0xff, 0xf5, 0x9f, 0x3f, 0xcd, 0x6d,
+	0x52, 0xd2, 0x3d, 0xf9, 0xb0, 0x1b, 0xaa, 0xa4, 0x1b, 0xe9, 0xef, 0x70, 0x4d, 0xbf, 0xef, 0xdd,
+	0x22, 0x21, 0xac, 0x4e, 0x6c, 0xe4, 0x64, 0xc7, 0xc2, 0x4c, 0xdf, 0xd4, 0xa7, 0x5b, 0xd9, 0x46,
+	0x2b, 0x6d, 0x7a, 0xd9, 0x59, 0xb1, 0xa3, 0x67, 0x1c, 0x69, 0x23, 0x23, 0x58, 0xa9, 0xee, 0xec,
+	0x64, 0xbb, 0xa8, 0xee, 0xf3, 0x3b, 0x7e, 0x67, 0x67, 0x86, 0xd4, 0x1a, 0xbb, 0x8e, 0xc6, 0xb6,
+	0xee, 0x7b, 0xb7, 0x68, 0xdb, 0xd9, 0xeb, 0x73, 0x85, 0x3b, 0x81, 0x4d, 0xf3, 0x00, 0x96, 0xcb,
+	0x6b, 0x39, 0xe9, 0x4c, 0x22, 0x16, 0xab, 0x7d, 0x67, 0x6b, 0xaa, 0xcc, 0xda, 0x7a, 0x07, 0x6d,
+	0x5d, 0xa5, 0xeb, 0xe7, 0x0c, 0x31, 0x39, 0xd0, 0xb1, 0x7d, 0x59, 0x8e, 0x0d, 0x57, 0xb3, 0xf6,
+	0x04, 0xde, 0xec, 0xa8, 0xca, 0x3b, 0xfa, 0x5b, 0xa2, 0x42, 0xe4, 0xe7, 0xd0, 0x70, 0x1f, 0xcf,
+	0xb4, 0xb2, 0x79, 0x8e, 0x6f, 0xf1, 0xb7, 0x10, 0x7f, 0x43, 0xe3, 0xaf, 0x4d, 0xe2, 0x13, 0x05,
+	0xab, 0x13, 0xcb, 0x1c, 0x99, 0x74, 0xb7, 0xba, 0x76, 0x77, 0x76, 0x67, 0x89, 0xad, 0x39, 0x8a,
+	0xe6, 0xb6, 0xe9, 0xe6, 0xa4, 0x2d, 0xb3, 0x6f, 0x73, 0x9d, 0xbb, 0xdf, 0x7a, 0xe5, 0x7f, 0x79,
+	0x74, 0x88, 0xdf, 0x91, 0xf1, 0x9b, 0x68, 0x9c, 0xea, 0x58, 0x77, 0xa6, 0xe7, 0xd2, 0x7a, 0xa1,
+	0x5d, 0x68, 0x4f, 0x6f, 0x0e, 0xe4, 0x5d, 0xd7, 0x35, 0xdf, 0xd4, 0x3b, 0xf2, 0xeb, 0x50, 0x16,
+	0xd2, 0x1f, 0xa0, 0xfd, 0x1b, 0x74, 0xd7, 0x19, 0x97, 0x0e, 0xc3, 0x3e, 0x84, 0xe8, 0x89, 0xbc,
+	0xef, 0xdd, 0xba, 0xed, 0x91, 0x08, 0x96, 0x4a, 0xfb, 0x26, 0xb9, 0x5a, 0x6d, 0xfc, 0xa5, 0xad,
+	0xaa, 0xd3, 0x99, 0x26, 0xb2, 0x21, 0xef, 0xa2, 0x49, 0x9f, 0x5e, 0x29, 0xc5, 0xab, 0xb7, 0xd2,
+	0x58, 0x1c, 0xa7, 0xc5, 0x1d, 0x2c, 0x6d, 0xa0, 0xe5, 0x3b, 0x78, 0x7e, 0x65, 0xed, 0xec, 0xcc,
+	0x90, 0x56, 0xab, 0xb5, 0x52, 0xaa, 0xee, 0xce, 0x5b, 0x8b, 0x26, 0xae, 0x7c, 0xd9, 0x2b, 0xc5,
+	0x35, 0xb1, 0x2d, 0x76, 0x3a, 0xd3, 0x44, 0xd5, 0xb8, 0xf4, 0x51, 0x96, 0x43, 0x1b, 0x71, 0x9e,
+	0x69, 0x43, 0xe4, 0x0b, 0x58, 0x2a, 0xed, 0x07, 0xb9, 0x95, 0xf3, 0x3b, 0xc3, 0xf4, 0xc6, 0x35,
+	0x0d, 0xde, 0xae, 0x10, 0xda, 0x04, 0x39, 0x86, 0x95, 0xea, 0x52, 0x91, 0xa7, 0x6d, 0xea, 0xae,
+	0x31, 0xdd, 0xc8, 0xb4, 0xab, 0x1d, 0xc5, 0xb2, 0x6c, 0xe7, 0x67, 0xb0, 0x68, 0x57, 0x11, 0xb2,
+	0x91, 0x43, 0x88, 0xb7, 0x22, 0x77, 0x10, 0x79, 0x9d, 0xae, 0x3a, 0xd8, 0x1e, 0x13, 0x1a, 0x4f,
+	0xe7, 0xff, 0x17, 0xd0, 0xcc, 0xf7, 0x12, 0xe2, 0xda, 0xc2, 0xe4, 0xa6, 0xf2, 0x2d, 0xdb, 0xf9,
+	0x58, 0x94, 0x80, 0x9f, 0x43, 0xc3, 0x2d, 0x1f, 0x79, 0x1b, 0x9a, 0xd8, 0x6a, 0x3a, 0x9b, 0xe7,
+	0xf8, 0xd5, 0x36, 0x54, 0xf4, 0x20, 0xfd, 0x1f, 0x84, 0xde, 0x38, 0x6c, 0x91, 0x56, 0xf7, 0x88,
+	0x3c, 0xdb, 0x53, 0x77, 0x91, 0xce, 0xce, 0x0c, 0xe9, 0xac, 0x22, 0x0d, 0x8d, 0x1e, 0x33, 0x7a,
+	0xda, 0xe2, 0x13, 0x58, 0xa9, 0x0e, 0xf8, 0x85, 0xc5, 0x69, 0x8b, 0x45, 0x67, 0x67, 0x86, 0xd4,
+	0x58, 0x24, 0xc7, 0xd0, 0xaa, 0x0c, 0x6c, 0x64, 0x6b, 0xfa, 0x18, 0x67, 0xc0, 0xb6, 0xdf, 0x34,
+	0xe3, 0xb9, 0xb3, 0x25, 0xf9, 0xcb, 0x8d, 0x7f, 0x32, 0xa1, 0xce, 0xbe, 0xff, 0xcf, 0x57, 0xbb,
+	0xde, 0xd7, 0xaf, 0x76, 0xbd, 0x7f, 0xbf, 0xda, 0xf5, 0xfe, 0xf8, 0x7a, 0xf7, 0xd2, 0xd7, 0xaf,
+	0x77, 0x2f, 0x7d, 0xf3, 0x7a, 0xf7, 0x52, 0x6f, 0x01, 0xff, 0xac, 0xbf, 0xfb, 0xdf, 0x01, 0x00,
+	0x9b, 0x3c, 0x9d, 0x6c, 0x36, 0x18, 0x00, 0x00,
 }
@@ -37,7 +37,7 @@ func (m *GetDatabaseKeysRequest) Reset()         { *m = GetDatabaseKeysRequest{}
 func (m *GetDatabaseKeysRequest) String() string { return proto.CompactTextString(m) }
 func (*GetDatabaseKeysRequest) ProtoMessage()    {}
 func (*GetDatabaseKeysRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db_242678ef2bd82154, []int{0}
+	return fileDescriptor_db_2c69931ee65685b6, []int{0}
 }
 func (m *GetDatabaseKeysRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -105,7 +105,7 @@ func (m *GetDatabaseKeysResponse) Reset()         { *m = GetDatabaseKeysResponse
 func (m *GetDatabaseKeysResponse) String() string { return proto.CompactTextString(m) }
 func (*GetDatabaseKeysResponse) ProtoMessage()    {}
 func (*GetDatabaseKeysResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db_242678ef2bd82154, []int{1}
+	return fileDescriptor_db_2c69931ee65685b6, []int{1}
 }
 func (m *GetDatabaseKeysResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -171,7 +171,7 @@ func (m *GetDatabaseValueRequest) Reset()         { *m = GetDatabaseValueRequest
 func (m *GetDatabaseValueRequest) String() string { return proto.CompactTextString(m) }
 func (*GetDatabaseValueRequest) ProtoMessage()    {}
 func (*GetDatabaseValueRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db_242678ef2bd82154, []int{2}
+	return fileDescriptor_db_2c69931ee65685b6, []int{2}
 }
 func (m *GetDatabaseValueRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -224,7 +224,7 @@ func (m *GetDatabaseValueResponse) Reset()         { *m = GetDatabaseValueRespon
 func (m *GetDatabaseValueResponse) String() string { return proto.CompactTextString(m) }
 func (*GetDatabaseValueResponse) ProtoMessage()    {}
 func (*GetDatabaseValueResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db_242678ef2bd82154, []int{3}
+	return fileDescriptor_db_2c69931ee65685b6, []int{3}
 }
 func (m *GetDatabaseValueResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -274,11 +274,120 @@ func (m *GetDatabaseValueResponse) GetValue() []byte {
 	return nil
 }
 
+type BackupDatabaseRequest struct {
+	// name of the backup, without path separators. The backup time is used
+	// if it's empty
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (m *BackupDatabaseRequest) Reset()         { *m = BackupDatabaseRequest{} }
+func (m *BackupDatabaseRequest) String() string { return proto.CompactTextString(m) }
+func (*BackupDatabaseRequest) ProtoMessage()    {}
+func (*BackupDatabaseRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db_2c69931ee65685b6, []int{4}
+}
+func (m *BackupDatabaseRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BackupDatabaseRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BackupDatabaseRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *BackupDatabaseRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BackupDatabaseRequest.Merge(dst, src)
+}
+func (m *BackupDatabaseRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *BackupDatabaseRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BackupDatabaseRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BackupDatabaseRequest proto.InternalMessageInfo
+
+func (m *BackupDatabaseRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+type BackupDatabaseResponse struct {
+	Code    int32  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	// the backup directory, to be passed to --restore
+	Dir string `protobuf:"bytes,3,opt,name=dir,proto3" json:"dir,omitempty"`
+}
+
+func (m *BackupDatabaseResponse) Reset()         { *m = BackupDatabaseResponse{} }
+func (m *BackupDatabaseResponse) String() string { return proto.CompactTextString(m) }
+func (*BackupDatabaseResponse) ProtoMessage()    {}
+func (*BackupDatabaseResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db_2c69931ee65685b6, []int{5}
+}
+func (m *BackupDatabaseResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BackupDatabaseResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BackupDatabaseResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *BackupDatabaseResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BackupDatabaseResponse.Merge(dst, src)
+}
+func (m *BackupDatabaseResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *BackupDatabaseResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BackupDatabaseResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BackupDatabaseResponse proto.InternalMessageInfo
+
+func (m *BackupDatabaseResponse) GetCode() int32 {
+	if m != nil {
+		return m.Code
+	}
+	return 0
+}
+
+func (m *BackupDatabaseResponse) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+func (m *BackupDatabaseResponse) GetDir() string {
+	if m != nil {
+		return m.Dir
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*GetDatabaseKeysRequest)(nil), "rpcpb.GetDatabaseKeysRequest")
 	proto.RegisterType((*GetDatabaseKeysResponse)(nil), "rpcpb.GetDatabaseKeysResponse")
 	proto.RegisterType((*GetDatabaseValueRequest)(nil), "rpcpb.GetDatabaseValueRequest")
 	proto.RegisterType((*GetDatabaseValueResponse)(nil), "rpcpb.GetDatabaseValueResponse")
+	proto.RegisterType((*BackupDatabaseRequest)(nil), "rpcpb.BackupDatabaseRequest")
+	proto.RegisterType((*BackupDatabaseResponse)(nil), "rpcpb.BackupDatabaseResponse")
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -297,6 +406,9 @@ type DatabaseCommandClient interface {
 	GetDatabaseKeys(ctx context.Context, in *GetDatabaseKeysRequest, opts ...grpc.CallOption) (*GetDatabaseKeysResponse, error)
 	// get value of associate with passed key in database
 	GetDatabaseValue(ctx context.Context, in *GetDatabaseValueRequest, opts ...grpc.CallOption) (*GetDatabaseValueResponse, error)
+	// back up database to the backup directory of workspace without stopping
+	// the node
+	BackupDatabase(ctx context.Context, in *BackupDatabaseRequest, opts ...grpc.CallOption) (*BackupDatabaseResponse, error)
 }
 
 type databaseCommandClient struct {
@@ -325,12 +437,24 @@ func (c *databaseCommandClient) GetDatabaseValue(ctx context.Context, in *GetDat
 	return out, nil
 }
 
+func (c *databaseCommandClient) BackupDatabase(ctx context.Context, in *BackupDatabaseRequest, opts ...grpc.CallOption) (*BackupDatabaseResponse, error) {
+	out := new(BackupDatabaseResponse)
+	err := c.cc.Invoke(ctx, "/rpcpb.DatabaseCommand/BackupDatabase", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // DatabaseCommandServer is the server API for DatabaseCommand service.
 type DatabaseCommandServer interface {
 	// get all keys of database
 	GetDatabaseKeys(context.Context, *GetDatabaseKeysRequest) (*GetDatabaseKeysResponse, error)
 	// get value of associate with passed key in database
 	GetDatabaseValue(context.Context, *GetDatabaseValueRequest) (*GetDatabaseValueResponse, error)
+	// back up database to the backup directory of workspace without stopping
+	// the node
+	BackupDatabase(context.Context, *BackupDatabaseRequest) (*BackupDatabaseResponse, error)
 }
 
 func RegisterDatabaseCommandServer(s *grpc.Server, srv DatabaseCommandServer) {
@@ -373,6 +497,24 @@ func _DatabaseCommand_GetDatabaseValue_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DatabaseCommand_BackupDatabase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BackupDatabaseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatabaseCommandServer).BackupDatabase(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.DatabaseCommand/BackupDatabase",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatabaseCommandServer).BackupDatabase(ctx, req.(*BackupDatabaseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _DatabaseCommand_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "rpcpb.DatabaseCommand",
 	HandlerType: (*DatabaseCommandServer)(nil),
@@ -385,6 +527,10 @@ var _DatabaseCommand_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetDatabaseValue",
 			Handler:    _DatabaseCommand_GetDatabaseValue_Handler,
 		},
+		{
+			MethodName: "BackupDatabase",
+			Handler:    _DatabaseCommand_BackupDatabase_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "db.proto",
@@ -544,6 +690,65 @@ func (m *GetDatabaseValueResponse) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
+func (m *BackupDatabaseRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BackupDatabaseRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Name) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintDb(dAtA, i, uint64(len(m.Name)))
+		i += copy(dAtA[i:], m.Name)
+	}
+	return i, nil
+}
+
+func (m *BackupDatabaseResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BackupDatabaseResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Code != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintDb(dAtA, i, uint64(m.Code))
+	}
+	if len(m.Message) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintDb(dAtA, i, uint64(len(m.Message)))
+		i += copy(dAtA[i:], m.Message)
+	}
+	if len(m.Dir) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintDb(dAtA, i, uint64(len(m.Dir)))
+		i += copy(dAtA[i:], m.Dir)
+	}
+	return i, nil
+}
+
 func encodeVarintDb(dAtA []byte, offset int, v uint64) int {
 	for v >= 1<<7 {
 		dAtA[offset] = uint8(v&0x7f | 0x80)
@@ -638,6 +843,39 @@ func (m *GetDatabaseValueResponse) Size() (n int) {
 	return n
 }
 
+func (m *BackupDatabaseRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovDb(uint64(l))
+	}
+	return n
+}
+
+func (m *BackupDatabaseResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Code != 0 {
+		n += 1 + sovDb(uint64(m.Code))
+	}
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovDb(uint64(l))
+	}
+	l = len(m.Dir)
+	if l > 0 {
+		n += 1 + l + sovDb(uint64(l))
+	}
+	return n
+}
+
 func sovDb(x uint64) (n int) {
 	for {
 		n++
@@ -1180,6 +1418,212 @@ func (m *GetDatabaseValueResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *BackupDatabaseRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BackupDatabaseRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BackupDatabaseRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BackupDatabaseResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BackupDatabaseResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BackupDatabaseResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			m.Code = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Code |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Dir", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Dir = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipDb(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -1285,32 +1729,36 @@ var (
 	ErrIntOverflowDb   = fmt.Errorf("proto: integer overflow")
 )
 
-func init() { proto.RegisterFile("db.proto", fileDescriptor_db_242678ef2bd82154) }
-
-var fileDescriptor_db_242678ef2bd82154 = []byte{
-	// 371 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x92, 0xbb, 0x4e, 0xf3, 0x30,
-	0x1c, 0xc5, 0xeb, 0x36, 0xe9, 0xf7, 0xd5, 0x20, 0xb5, 0xb2, 0x4a, 0xb1, 0x2a, 0x08, 0x55, 0xa6,
-	0xaa, 0x43, 0x23, 0x60, 0xeb, 0xc6, 0x45, 0x62, 0x60, 0xcb, 0xc0, 0x88, 0xe4, 0x34, 0x7f, 0x42,
-	0x68, 0x12, 0x9b, 0xda, 0xad, 0xe8, 0xca, 0x13, 0x20, 0xf1, 0x52, 0x8c, 0x95, 0x58, 0x18, 0x51,
-	0x8b, 0xc4, 0x6b, 0xa0, 0x38, 0xa9, 0x04, 0xbd, 0x30, 0xb0, 0x9d, 0x63, 0x27, 0xe7, 0xf7, 0xbf,
-	0x18, 0xff, 0xf7, 0xbd, 0xae, 0x18, 0x72, 0xc5, 0x89, 0x39, 0x14, 0x7d, 0xe1, 0x35, 0xf7, 0x02,
-	0xce, 0x83, 0x08, 0x1c, 0x26, 0x42, 0x87, 0x25, 0x09, 0x57, 0x4c, 0x85, 0x3c, 0x91, 0xd9, 0x47,
-	0xb6, 0xc0, 0x8d, 0x0b, 0x50, 0xe7, 0x4c, 0x31, 0x8f, 0x49, 0xb8, 0x84, 0x89, 0x74, 0xe1, 0x7e,
-	0x04, 0x52, 0x91, 0x3a, 0x36, 0x15, 0xf3, 0x22, 0xa0, 0xa8, 0x85, 0xda, 0x15, 0x37, 0x33, 0xa4,
-	0x81, 0xcb, 0x62, 0x08, 0x37, 0xe1, 0x03, 0x2d, 0xea, 0xe3, 0xdc, 0x11, 0x82, 0x0d, 0x39, 0x08,
-	0x05, 0x2d, 0xb5, 0x50, 0xdb, 0x74, 0xb5, 0x4e, 0x13, 0xa2, 0x30, 0x0e, 0x15, 0x35, 0xf4, 0x61,
-	0x66, 0x6c, 0x8e, 0x77, 0x57, 0x88, 0x52, 0xf0, 0x44, 0x42, 0x1a, 0xd2, 0xe7, 0x7e, 0x46, 0x34,
-	0x5d, 0xad, 0x09, 0xc5, 0xff, 0x62, 0x90, 0x92, 0x05, 0x90, 0x13, 0x17, 0x76, 0x2d, 0x92, 0x60,
-	0x63, 0x00, 0x13, 0x49, 0x8d, 0x56, 0xa9, 0x5d, 0x71, 0xb5, 0xb6, 0x4f, 0x7e, 0x00, 0xaf, 0x58,
-	0x34, 0x82, 0xdf, 0x7b, 0xac, 0xe1, 0xd2, 0x00, 0x26, 0x39, 0x2e, 0x95, 0xf6, 0x35, 0xa6, 0xab,
-	0x11, 0x7f, 0x2a, 0xba, 0x8e, 0xcd, 0x71, 0xfa, 0xbb, 0xae, 0x7a, 0xdb, 0xcd, 0xcc, 0xd1, 0x27,
-	0xc2, 0xd5, 0x45, 0xfa, 0x19, 0x8f, 0x63, 0x96, 0xf8, 0xe4, 0x16, 0x57, 0x97, 0xe6, 0x44, 0xf6,
-	0xbb, 0x7a, 0xa5, 0xdd, 0xf5, 0x1b, 0x6b, 0x5a, 0x9b, 0xae, 0xb3, 0x4a, 0xed, 0xc6, 0xe3, 0xeb,
-	0xc7, 0x73, 0xb1, 0x66, 0x6f, 0x39, 0xe3, 0x43, 0xc7, 0xf7, 0x9c, 0x74, 0x3a, 0x3d, 0xd4, 0x21,
-	0x77, 0xb8, 0xb6, 0xdc, 0x1d, 0x59, 0x93, 0xf5, 0x7d, 0x72, 0xcd, 0x83, 0x8d, 0xf7, 0x39, 0x6c,
-	0x47, 0xc3, 0xaa, 0x36, 0xce, 0x61, 0x01, 0xa8, 0x1e, 0xea, 0x9c, 0xd2, 0x97, 0x99, 0x85, 0xa6,
-	0x33, 0x0b, 0xbd, 0xcf, 0x2c, 0xf4, 0x34, 0xb7, 0x0a, 0xd3, 0xb9, 0x55, 0x78, 0x9b, 0x5b, 0x05,
-	0xaf, 0xac, 0x1f, 0xe4, 0xf1, 0x57, 0x00, 0x00, 0x00, 0xff, 0xff, 0x59, 0x55, 0xaa, 0x97, 0xc1,
-	0x02, 0x00, 0x00,
+func init() { proto.RegisterFile("db.proto", fileDescriptor_db_2c69931ee65685b6) }
+
+var fileDescriptor_db_2c69931ee65685b6 = []byte{
+	// 435 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x93, 0xcd, 0x8e, 0xd3, 0x30,
+	0x14, 0x85, 0xeb, 0x36, 0x19, 0xe8, 0xe5, 0xa7, 0x95, 0x35, 0x13, 0xac, 0x68, 0x26, 0x54, 0x5e,
+	0x55, 0x83, 0xd4, 0x08, 0xd8, 0xcd, 0x8e, 0x01, 0x89, 0x05, 0xbb, 0x2c, 0x10, 0x2b, 0x24, 0xa7,
+	0x31, 0x21, 0xe4, 0xc7, 0x26, 0x76, 0x47, 0x74, 0xcb, 0x13, 0x20, 0xf1, 0x52, 0x2c, 0x2b, 0xb1,
+	0x61, 0x89, 0x5a, 0x1e, 0x04, 0xc5, 0x71, 0x24, 0xa6, 0x93, 0xb2, 0x98, 0xdd, 0xb9, 0xbe, 0xb7,
+	0xe7, 0x3b, 0xf5, 0x75, 0xe0, 0x6e, 0x12, 0x2f, 0x64, 0x2d, 0xb4, 0xc0, 0x6e, 0x2d, 0x97, 0x32,
+	0xf6, 0x4f, 0x53, 0x21, 0xd2, 0x82, 0x87, 0x4c, 0x66, 0x21, 0xab, 0x2a, 0xa1, 0x99, 0xce, 0x44,
+	0xa5, 0xda, 0x21, 0x2a, 0xc1, 0x7b, 0xcd, 0xf5, 0x2b, 0xa6, 0x59, 0xcc, 0x14, 0x7f, 0xc3, 0xd7,
+	0x2a, 0xe2, 0x9f, 0x57, 0x5c, 0x69, 0x7c, 0x0c, 0xae, 0x66, 0x71, 0xc1, 0x09, 0x9a, 0xa1, 0xf9,
+	0x38, 0x6a, 0x0b, 0xec, 0xc1, 0x91, 0xac, 0xf9, 0x87, 0xec, 0x0b, 0x19, 0x9a, 0x63, 0x5b, 0x61,
+	0x0c, 0x8e, 0xca, 0x33, 0x49, 0x46, 0x33, 0x34, 0x77, 0x23, 0xa3, 0x1b, 0x87, 0x22, 0x2b, 0x33,
+	0x4d, 0x1c, 0x73, 0xd8, 0x16, 0x54, 0xc0, 0xa3, 0x1b, 0x44, 0x25, 0x45, 0xa5, 0x78, 0x63, 0xb2,
+	0x14, 0x49, 0x4b, 0x74, 0x23, 0xa3, 0x31, 0x81, 0x3b, 0x25, 0x57, 0x8a, 0xa5, 0xdc, 0x12, 0xbb,
+	0xb2, 0x17, 0x89, 0xc1, 0xc9, 0xf9, 0x5a, 0x11, 0x67, 0x36, 0x9a, 0x8f, 0x23, 0xa3, 0xe9, 0x8b,
+	0x6b, 0xc0, 0xb7, 0xac, 0x58, 0xf1, 0xff, 0xff, 0xc7, 0x29, 0x8c, 0x72, 0xbe, 0xb6, 0xb8, 0x46,
+	0xd2, 0xf7, 0x40, 0x6e, 0x5a, 0xdc, 0x2a, 0xf4, 0x31, 0xb8, 0x57, 0xcd, 0xcf, 0x4d, 0xea, 0xfb,
+	0x51, 0x5b, 0xd0, 0x27, 0x70, 0x72, 0xc9, 0x96, 0xf9, 0x4a, 0x76, 0x88, 0x2e, 0x20, 0x06, 0xa7,
+	0x62, 0x65, 0x97, 0xcf, 0x68, 0xfa, 0x0e, 0xbc, 0xfd, 0xe1, 0x5b, 0x45, 0x99, 0xc2, 0x28, 0xc9,
+	0x6a, 0x13, 0x64, 0x1c, 0x35, 0xf2, 0xd9, 0x66, 0x08, 0x93, 0xce, 0xf4, 0xa5, 0x28, 0x4b, 0x56,
+	0x25, 0xf8, 0x23, 0x4c, 0xf6, 0xd6, 0x85, 0xcf, 0x16, 0xe6, 0x65, 0x2d, 0xfa, 0x1f, 0x8e, 0x1f,
+	0x1c, 0x6a, 0xb7, 0x29, 0xa9, 0xf7, 0xf5, 0xe7, 0x9f, 0xef, 0xc3, 0x29, 0xbd, 0x17, 0x5e, 0x3d,
+	0x0d, 0x93, 0x38, 0x6c, 0x96, 0x74, 0x81, 0xce, 0xf1, 0x27, 0x98, 0xee, 0x5f, 0x32, 0xee, 0xf1,
+	0xfa, 0x77, 0x81, 0xfe, 0xe3, 0x83, 0x7d, 0x0b, 0x3b, 0x31, 0xb0, 0xc9, 0x05, 0x3a, 0xa7, 0x60,
+	0x79, 0x29, 0xd7, 0x38, 0x85, 0x87, 0xd7, 0xef, 0x10, 0x9f, 0x5a, 0xa7, 0xde, 0x3d, 0xf8, 0x67,
+	0x07, 0xba, 0x96, 0x42, 0x0c, 0x05, 0x37, 0x94, 0x07, 0x96, 0x12, 0x9b, 0xc9, 0x4b, 0xf2, 0x63,
+	0x1b, 0xa0, 0xcd, 0x36, 0x40, 0xbf, 0xb7, 0x01, 0xfa, 0xb6, 0x0b, 0x06, 0x9b, 0x5d, 0x30, 0xf8,
+	0xb5, 0x0b, 0x06, 0xf1, 0x91, 0xf9, 0x00, 0x9f, 0xff, 0x1d, 0x00, 0xb5, 0x6d, 0x57, 0x48, 0xb1,
+	0x03, 0x00, 0x00,
 }
@@ -54,6 +54,19 @@ func request_DatabaseCommand_GetDatabaseValue_0(ctx context.Context, marshaler r
 
 }
 
+func request_DatabaseCommand_BackupDatabase_0(ctx context.Context, marshaler runtime.Marshaler, client DatabaseCommandClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var protoReq BackupDatabaseRequest
+	var metadata runtime.ServerMetadata
+
+	if err := marshaler.NewDecoder(req.Body).Decode(&protoReq); err != nil && err != io.EOF {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	msg, err := client.BackupDatabase(ctx, &protoReq, grpc.Header(&metadata.HeaderMD), grpc.Trailer(&metadata.TrailerMD))
+	return msg, metadata, err
+
+}
+
 // RegisterDatabaseCommandHandlerFromEndpoint is same as RegisterDatabaseCommandHandler but
 // automatically dials to "endpoint" and closes the connection when "ctx" gets done.
 func RegisterDatabaseCommandHandlerFromEndpoint(ctx context.Context, mux *runtime.ServeMux, endpoint string, opts []grpc.DialOption) (err error) {
@@ -132,6 +145,26 @@ func RegisterDatabaseCommandHandlerClient(ctx context.Context, mux *runtime.Serv
 
 	})
 
+	mux.Handle("POST", pattern_DatabaseCommand_BackupDatabase_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		rctx, err := runtime.AnnotateContext(ctx, mux, req)
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := request_DatabaseCommand_BackupDatabase_0(rctx, inboundMarshaler, client, req, pathParams)
+		ctx = runtime.NewServerMetadataContext(ctx, md)
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+
+		forward_DatabaseCommand_BackupDatabase_0(ctx, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+
+	})
+
 	return nil
 }
 
@@ -139,10 +172,14 @@ var (
 	pattern_DatabaseCommand_GetDatabaseKeys_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2}, []string{"v1", "db", "keys"}, ""))
 
 	pattern_DatabaseCommand_GetDatabaseValue_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2}, []string{"v1", "db", "get"}, ""))
+
+	pattern_DatabaseCommand_BackupDatabase_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2}, []string{"v1", "db", "backup"}, ""))
 )
 
 var (
 	forward_DatabaseCommand_GetDatabaseKeys_0 = runtime.ForwardResponseMessage
 
 	forward_DatabaseCommand_GetDatabaseValue_0 = runtime.ForwardResponseMessage
+
+	forward_DatabaseCommand_BackupDatabase_0 = runtime.ForwardResponseMessage
 )
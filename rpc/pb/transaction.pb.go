@@ -28,13 +28,17 @@ var _ = math.Inf
 const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
 
 type ListUtxosRequest struct {
+	// only list utxos of the addresses if any
+	Addrs []string `protobuf:"bytes,1,rep,name=addrs" json:"addrs,omitempty"`
+	// also list utxos of accounts in node wallet, watch-only ones included
+	Wallet bool `protobuf:"varint,2,opt,name=wallet,proto3" json:"wallet,omitempty"`
 }
 
 func (m *ListUtxosRequest) Reset()         { *m = ListUtxosRequest{} }
 func (m *ListUtxosRequest) String() string { return proto.CompactTextString(m) }
 func (*ListUtxosRequest) ProtoMessage()    {}
 func (*ListUtxosRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_transaction_5b40de20ba40892e, []int{0}
+	return fileDescriptor_transaction_228ec4dbc4f69eeb, []int{0}
 }
 func (m *ListUtxosRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -63,6 +67,20 @@ func (m *ListUtxosRequest) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ListUtxosRequest proto.InternalMessageInfo
 
+func (m *ListUtxosRequest) GetAddrs() []string {
+	if m != nil {
+		return m.Addrs
+	}
+	return nil
+}
+
+func (m *ListUtxosRequest) GetWallet() bool {
+	if m != nil {
+		return m.Wallet
+	}
+	return false
+}
+
 type GetRawTransactionRequest struct {
 	Hash []byte `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
 }
@@ -71,7 +89,7 @@ func (m *GetRawTransactionRequest) Reset()         { *m = GetRawTransactionReque
 func (m *GetRawTransactionRequest) String() string { return proto.CompactTextString(m) }
 func (*GetRawTransactionRequest) ProtoMessage()    {}
 func (*GetRawTransactionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_transaction_5b40de20ba40892e, []int{1}
+	return fileDescriptor_transaction_228ec4dbc4f69eeb, []int{1}
 }
 func (m *GetRawTransactionRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -109,13 +127,18 @@ func (m *GetRawTransactionRequest) GetHash() []byte {
 
 type GetRawTransactionResponse struct {
 	Tx *pb.Transaction `protobuf:"bytes,1,opt,name=tx" json:"tx,omitempty"`
+	// serialized tx
+	Raw           []byte `protobuf:"bytes,2,opt,name=raw,proto3" json:"raw,omitempty"`
+	BlockHash     string `protobuf:"bytes,3,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+	BlockHeight   uint32 `protobuf:"varint,4,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	Confirmations uint32 `protobuf:"varint,5,opt,name=confirmations,proto3" json:"confirmations,omitempty"`
 }
 
 func (m *GetRawTransactionResponse) Reset()         { *m = GetRawTransactionResponse{} }
 func (m *GetRawTransactionResponse) String() string { return proto.CompactTextString(m) }
 func (*GetRawTransactionResponse) ProtoMessage()    {}
 func (*GetRawTransactionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_transaction_5b40de20ba40892e, []int{2}
+	return fileDescriptor_transaction_228ec4dbc4f69eeb, []int{2}
 }
 func (m *GetRawTransactionResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -151,21 +174,50 @@ func (m *GetRawTransactionResponse) GetTx() *pb.Transaction {
 	return nil
 }
 
-type GetTransactionPoolRequest struct {
+func (m *GetRawTransactionResponse) GetRaw() []byte {
+	if m != nil {
+		return m.Raw
+	}
+	return nil
 }
 
-func (m *GetTransactionPoolRequest) Reset()         { *m = GetTransactionPoolRequest{} }
-func (m *GetTransactionPoolRequest) String() string { return proto.CompactTextString(m) }
-func (*GetTransactionPoolRequest) ProtoMessage()    {}
-func (*GetTransactionPoolRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_transaction_5b40de20ba40892e, []int{3}
+func (m *GetRawTransactionResponse) GetBlockHash() string {
+	if m != nil {
+		return m.BlockHash
+	}
+	return ""
 }
-func (m *GetTransactionPoolRequest) XXX_Unmarshal(b []byte) error {
+
+func (m *GetRawTransactionResponse) GetBlockHeight() uint32 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *GetRawTransactionResponse) GetConfirmations() uint32 {
+	if m != nil {
+		return m.Confirmations
+	}
+	return 0
+}
+
+type GetTransactionDetailRequest struct {
+	Hash string `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+}
+
+func (m *GetTransactionDetailRequest) Reset()         { *m = GetTransactionDetailRequest{} }
+func (m *GetTransactionDetailRequest) String() string { return proto.CompactTextString(m) }
+func (*GetTransactionDetailRequest) ProtoMessage()    {}
+func (*GetTransactionDetailRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_transaction_228ec4dbc4f69eeb, []int{3}
+}
+func (m *GetTransactionDetailRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *GetTransactionPoolRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *GetTransactionDetailRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_GetTransactionPoolRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_GetTransactionDetailRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalTo(b)
@@ -175,34 +227,46 @@ func (m *GetTransactionPoolRequest) XXX_Marshal(b []byte, deterministic bool) ([
 		return b[:n], nil
 	}
 }
-func (dst *GetTransactionPoolRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetTransactionPoolRequest.Merge(dst, src)
+func (dst *GetTransactionDetailRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetTransactionDetailRequest.Merge(dst, src)
 }
-func (m *GetTransactionPoolRequest) XXX_Size() int {
+func (m *GetTransactionDetailRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *GetTransactionPoolRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetTransactionPoolRequest.DiscardUnknown(m)
+func (m *GetTransactionDetailRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetTransactionDetailRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetTransactionPoolRequest proto.InternalMessageInfo
+var xxx_messageInfo_GetTransactionDetailRequest proto.InternalMessageInfo
 
-type GetTransactionsResponse struct {
-	Txs []*pb.Transaction `protobuf:"bytes,1,rep,name=txs" json:"txs,omitempty"`
+func (m *GetTransactionDetailRequest) GetHash() string {
+	if m != nil {
+		return m.Hash
+	}
+	return ""
 }
 
-func (m *GetTransactionsResponse) Reset()         { *m = GetTransactionsResponse{} }
-func (m *GetTransactionsResponse) String() string { return proto.CompactTextString(m) }
-func (*GetTransactionsResponse) ProtoMessage()    {}
-func (*GetTransactionsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_transaction_5b40de20ba40892e, []int{4}
+type TxInDetail struct {
+	PrevOutPoint *pb.OutPoint `protobuf:"bytes,1,opt,name=prev_out_point,json=prevOutPoint" json:"prev_out_point,omitempty"`
+	ScriptSig    []byte       `protobuf:"bytes,2,opt,name=script_sig,json=scriptSig,proto3" json:"script_sig,omitempty"`
+	Sequence     uint32       `protobuf:"varint,3,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// value and owner of the output being spent, empty for coinbase inputs
+	Value uint64 `protobuf:"varint,4,opt,name=value,proto3" json:"value,omitempty"`
+	Addr  string `protobuf:"bytes,5,opt,name=addr,proto3" json:"addr,omitempty"`
 }
-func (m *GetTransactionsResponse) XXX_Unmarshal(b []byte) error {
+
+func (m *TxInDetail) Reset()         { *m = TxInDetail{} }
+func (m *TxInDetail) String() string { return proto.CompactTextString(m) }
+func (*TxInDetail) ProtoMessage()    {}
+func (*TxInDetail) Descriptor() ([]byte, []int) {
+	return fileDescriptor_transaction_228ec4dbc4f69eeb, []int{4}
+}
+func (m *TxInDetail) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *GetTransactionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *TxInDetail) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_GetTransactionsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_TxInDetail.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalTo(b)
@@ -212,42 +276,71 @@ func (m *GetTransactionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return b[:n], nil
 	}
 }
-func (dst *GetTransactionsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetTransactionsResponse.Merge(dst, src)
+func (dst *TxInDetail) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TxInDetail.Merge(dst, src)
 }
-func (m *GetTransactionsResponse) XXX_Size() int {
+func (m *TxInDetail) XXX_Size() int {
 	return m.Size()
 }
-func (m *GetTransactionsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetTransactionsResponse.DiscardUnknown(m)
+func (m *TxInDetail) XXX_DiscardUnknown() {
+	xxx_messageInfo_TxInDetail.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetTransactionsResponse proto.InternalMessageInfo
+var xxx_messageInfo_TxInDetail proto.InternalMessageInfo
 
-func (m *GetTransactionsResponse) GetTxs() []*pb.Transaction {
+func (m *TxInDetail) GetPrevOutPoint() *pb.OutPoint {
 	if m != nil {
-		return m.Txs
+		return m.PrevOutPoint
 	}
 	return nil
 }
 
-type TokenAmount struct {
-	Token  *pb.OutPoint `protobuf:"bytes,1,opt,name=token" json:"token,omitempty"`
-	Amount uint64       `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+func (m *TxInDetail) GetScriptSig() []byte {
+	if m != nil {
+		return m.ScriptSig
+	}
+	return nil
 }
 
-func (m *TokenAmount) Reset()         { *m = TokenAmount{} }
-func (m *TokenAmount) String() string { return proto.CompactTextString(m) }
-func (*TokenAmount) ProtoMessage()    {}
-func (*TokenAmount) Descriptor() ([]byte, []int) {
-	return fileDescriptor_transaction_5b40de20ba40892e, []int{5}
+func (m *TxInDetail) GetSequence() uint32 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
 }
-func (m *TokenAmount) XXX_Unmarshal(b []byte) error {
+
+func (m *TxInDetail) GetValue() uint64 {
+	if m != nil {
+		return m.Value
+	}
+	return 0
+}
+
+func (m *TxInDetail) GetAddr() string {
+	if m != nil {
+		return m.Addr
+	}
+	return ""
+}
+
+type TxOutDetail struct {
+	Value        uint64 `protobuf:"varint,1,opt,name=value,proto3" json:"value,omitempty"`
+	ScriptPubKey []byte `protobuf:"bytes,2,opt,name=script_pub_key,json=scriptPubKey,proto3" json:"script_pub_key,omitempty"`
+	Addr         string `protobuf:"bytes,3,opt,name=addr,proto3" json:"addr,omitempty"`
+}
+
+func (m *TxOutDetail) Reset()         { *m = TxOutDetail{} }
+func (m *TxOutDetail) String() string { return proto.CompactTextString(m) }
+func (*TxOutDetail) ProtoMessage()    {}
+func (*TxOutDetail) Descriptor() ([]byte, []int) {
+	return fileDescriptor_transaction_228ec4dbc4f69eeb, []int{5}
+}
+func (m *TxOutDetail) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *TokenAmount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *TxOutDetail) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_TokenAmount.Marshal(b, m, deterministic)
+		return xxx_messageInfo_TxOutDetail.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalTo(b)
@@ -257,50 +350,62 @@ func (m *TokenAmount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (dst *TokenAmount) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_TokenAmount.Merge(dst, src)
+func (dst *TxOutDetail) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TxOutDetail.Merge(dst, src)
 }
-func (m *TokenAmount) XXX_Size() int {
+func (m *TxOutDetail) XXX_Size() int {
 	return m.Size()
 }
-func (m *TokenAmount) XXX_DiscardUnknown() {
-	xxx_messageInfo_TokenAmount.DiscardUnknown(m)
+func (m *TxOutDetail) XXX_DiscardUnknown() {
+	xxx_messageInfo_TxOutDetail.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_TokenAmount proto.InternalMessageInfo
+var xxx_messageInfo_TxOutDetail proto.InternalMessageInfo
 
-func (m *TokenAmount) GetToken() *pb.OutPoint {
+func (m *TxOutDetail) GetValue() uint64 {
 	if m != nil {
-		return m.Token
+		return m.Value
+	}
+	return 0
+}
+
+func (m *TxOutDetail) GetScriptPubKey() []byte {
+	if m != nil {
+		return m.ScriptPubKey
 	}
 	return nil
 }
 
-func (m *TokenAmount) GetAmount() uint64 {
+func (m *TxOutDetail) GetAddr() string {
 	if m != nil {
-		return m.Amount
+		return m.Addr
 	}
-	return 0
+	return ""
 }
 
-type FundTransactionRequest struct {
-	Addr         string         `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
-	Amount       uint64         `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
-	TokenBudgets []*TokenAmount `protobuf:"bytes,3,rep,name=tokenBudgets" json:"tokenBudgets,omitempty"`
+type TransactionDetail struct {
+	Hash       string         `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	Version    int32          `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	Vin        []*TxInDetail  `protobuf:"bytes,3,rep,name=vin" json:"vin,omitempty"`
+	Vout       []*TxOutDetail `protobuf:"bytes,4,rep,name=vout" json:"vout,omitempty"`
+	LockTime   int64          `protobuf:"varint,5,opt,name=lock_time,json=lockTime,proto3" json:"lock_time,omitempty"`
+	Fee        uint64         `protobuf:"varint,6,opt,name=fee,proto3" json:"fee,omitempty"`
+	TxSize     uint32         `protobuf:"varint,7,opt,name=tx_size,json=txSize,proto3" json:"tx_size,omitempty"`
+	IsCoinbase bool           `protobuf:"varint,8,opt,name=is_coinbase,json=isCoinbase,proto3" json:"is_coinbase,omitempty"`
 }
 
-func (m *FundTransactionRequest) Reset()         { *m = FundTransactionRequest{} }
-func (m *FundTransactionRequest) String() string { return proto.CompactTextString(m) }
-func (*FundTransactionRequest) ProtoMessage()    {}
-func (*FundTransactionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_transaction_5b40de20ba40892e, []int{6}
+func (m *TransactionDetail) Reset()         { *m = TransactionDetail{} }
+func (m *TransactionDetail) String() string { return proto.CompactTextString(m) }
+func (*TransactionDetail) ProtoMessage()    {}
+func (*TransactionDetail) Descriptor() ([]byte, []int) {
+	return fileDescriptor_transaction_228ec4dbc4f69eeb, []int{6}
 }
-func (m *FundTransactionRequest) XXX_Unmarshal(b []byte) error {
+func (m *TransactionDetail) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *FundTransactionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *TransactionDetail) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_FundTransactionRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_TransactionDetail.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalTo(b)
@@ -310,102 +415,96 @@ func (m *FundTransactionRequest) XXX_Marshal(b []byte, deterministic bool) ([]by
 		return b[:n], nil
 	}
 }
-func (dst *FundTransactionRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_FundTransactionRequest.Merge(dst, src)
+func (dst *TransactionDetail) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TransactionDetail.Merge(dst, src)
 }
-func (m *FundTransactionRequest) XXX_Size() int {
+func (m *TransactionDetail) XXX_Size() int {
 	return m.Size()
 }
-func (m *FundTransactionRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_FundTransactionRequest.DiscardUnknown(m)
+func (m *TransactionDetail) XXX_DiscardUnknown() {
+	xxx_messageInfo_TransactionDetail.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_FundTransactionRequest proto.InternalMessageInfo
+var xxx_messageInfo_TransactionDetail proto.InternalMessageInfo
 
-func (m *FundTransactionRequest) GetAddr() string {
+func (m *TransactionDetail) GetHash() string {
 	if m != nil {
-		return m.Addr
+		return m.Hash
 	}
 	return ""
 }
 
-func (m *FundTransactionRequest) GetAmount() uint64 {
+func (m *TransactionDetail) GetVersion() int32 {
 	if m != nil {
-		return m.Amount
+		return m.Version
 	}
 	return 0
 }
 
-func (m *FundTransactionRequest) GetTokenBudgets() []*TokenAmount {
+func (m *TransactionDetail) GetVin() []*TxInDetail {
 	if m != nil {
-		return m.TokenBudgets
+		return m.Vin
 	}
 	return nil
 }
 
-type SendTransactionRequest struct {
-	Tx *pb.Transaction `protobuf:"bytes,1,opt,name=tx" json:"tx,omitempty"`
+func (m *TransactionDetail) GetVout() []*TxOutDetail {
+	if m != nil {
+		return m.Vout
+	}
+	return nil
 }
 
-func (m *SendTransactionRequest) Reset()         { *m = SendTransactionRequest{} }
-func (m *SendTransactionRequest) String() string { return proto.CompactTextString(m) }
-func (*SendTransactionRequest) ProtoMessage()    {}
-func (*SendTransactionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_transaction_5b40de20ba40892e, []int{7}
-}
-func (m *SendTransactionRequest) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
-}
-func (m *SendTransactionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_SendTransactionRequest.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
+func (m *TransactionDetail) GetLockTime() int64 {
+	if m != nil {
+		return m.LockTime
 	}
+	return 0
 }
-func (dst *SendTransactionRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SendTransactionRequest.Merge(dst, src)
-}
-func (m *SendTransactionRequest) XXX_Size() int {
-	return m.Size()
-}
-func (m *SendTransactionRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_SendTransactionRequest.DiscardUnknown(m)
+
+func (m *TransactionDetail) GetFee() uint64 {
+	if m != nil {
+		return m.Fee
+	}
+	return 0
 }
 
-var xxx_messageInfo_SendTransactionRequest proto.InternalMessageInfo
+func (m *TransactionDetail) GetTxSize() uint32 {
+	if m != nil {
+		return m.TxSize
+	}
+	return 0
+}
 
-func (m *SendTransactionRequest) GetTx() *pb.Transaction {
+func (m *TransactionDetail) GetIsCoinbase() bool {
 	if m != nil {
-		return m.Tx
+		return m.IsCoinbase
 	}
-	return nil
+	return false
 }
 
-type ListUtxosResponse struct {
-	Code    int32   `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
-	Message string  `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	Count   uint32  `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
-	Utxos   []*Utxo `protobuf:"bytes,4,rep,name=utxos" json:"utxos,omitempty"`
+type GetTransactionDetailResponse struct {
+	Code          int32              `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message       string             `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Detail        *TransactionDetail `protobuf:"bytes,3,opt,name=detail" json:"detail,omitempty"`
+	BlockHash     string             `protobuf:"bytes,4,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+	BlockHeight   uint32             `protobuf:"varint,5,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	Timestamp     int64              `protobuf:"varint,6,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Confirmations uint32             `protobuf:"varint,7,opt,name=confirmations,proto3" json:"confirmations,omitempty"`
 }
 
-func (m *ListUtxosResponse) Reset()         { *m = ListUtxosResponse{} }
-func (m *ListUtxosResponse) String() string { return proto.CompactTextString(m) }
-func (*ListUtxosResponse) ProtoMessage()    {}
-func (*ListUtxosResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_transaction_5b40de20ba40892e, []int{8}
+func (m *GetTransactionDetailResponse) Reset()         { *m = GetTransactionDetailResponse{} }
+func (m *GetTransactionDetailResponse) String() string { return proto.CompactTextString(m) }
+func (*GetTransactionDetailResponse) ProtoMessage()    {}
+func (*GetTransactionDetailResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_transaction_228ec4dbc4f69eeb, []int{7}
 }
-func (m *ListUtxosResponse) XXX_Unmarshal(b []byte) error {
+func (m *GetTransactionDetailResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ListUtxosResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *GetTransactionDetailResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ListUtxosResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_GetTransactionDetailResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalTo(b)
@@ -415,62 +514,82 @@ func (m *ListUtxosResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, e
 		return b[:n], nil
 	}
 }
-func (dst *ListUtxosResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ListUtxosResponse.Merge(dst, src)
+func (dst *GetTransactionDetailResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetTransactionDetailResponse.Merge(dst, src)
 }
-func (m *ListUtxosResponse) XXX_Size() int {
+func (m *GetTransactionDetailResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *ListUtxosResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_ListUtxosResponse.DiscardUnknown(m)
+func (m *GetTransactionDetailResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetTransactionDetailResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ListUtxosResponse proto.InternalMessageInfo
+var xxx_messageInfo_GetTransactionDetailResponse proto.InternalMessageInfo
 
-func (m *ListUtxosResponse) GetCode() int32 {
+func (m *GetTransactionDetailResponse) GetCode() int32 {
 	if m != nil {
 		return m.Code
 	}
 	return 0
 }
 
-func (m *ListUtxosResponse) GetMessage() string {
+func (m *GetTransactionDetailResponse) GetMessage() string {
 	if m != nil {
 		return m.Message
 	}
 	return ""
 }
 
-func (m *ListUtxosResponse) GetCount() uint32 {
+func (m *GetTransactionDetailResponse) GetDetail() *TransactionDetail {
 	if m != nil {
-		return m.Count
+		return m.Detail
+	}
+	return nil
+}
+
+func (m *GetTransactionDetailResponse) GetBlockHash() string {
+	if m != nil {
+		return m.BlockHash
+	}
+	return ""
+}
+
+func (m *GetTransactionDetailResponse) GetBlockHeight() uint32 {
+	if m != nil {
+		return m.BlockHeight
 	}
 	return 0
 }
 
-func (m *ListUtxosResponse) GetUtxos() []*Utxo {
+func (m *GetTransactionDetailResponse) GetTimestamp() int64 {
 	if m != nil {
-		return m.Utxos
+		return m.Timestamp
 	}
-	return nil
+	return 0
 }
 
-type GetBalanceRequest struct {
-	Addrs []string `protobuf:"bytes,1,rep,name=addrs" json:"addrs,omitempty"`
+func (m *GetTransactionDetailResponse) GetConfirmations() uint32 {
+	if m != nil {
+		return m.Confirmations
+	}
+	return 0
 }
 
-func (m *GetBalanceRequest) Reset()         { *m = GetBalanceRequest{} }
-func (m *GetBalanceRequest) String() string { return proto.CompactTextString(m) }
-func (*GetBalanceRequest) ProtoMessage()    {}
-func (*GetBalanceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_transaction_5b40de20ba40892e, []int{9}
+type GetTransactionPoolRequest struct {
 }
-func (m *GetBalanceRequest) XXX_Unmarshal(b []byte) error {
+
+func (m *GetTransactionPoolRequest) Reset()         { *m = GetTransactionPoolRequest{} }
+func (m *GetTransactionPoolRequest) String() string { return proto.CompactTextString(m) }
+func (*GetTransactionPoolRequest) ProtoMessage()    {}
+func (*GetTransactionPoolRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_transaction_228ec4dbc4f69eeb, []int{8}
+}
+func (m *GetTransactionPoolRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *GetBalanceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *GetTransactionPoolRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_GetBalanceRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_GetTransactionPoolRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalTo(b)
@@ -480,43 +599,77 @@ func (m *GetBalanceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, e
 		return b[:n], nil
 	}
 }
-func (dst *GetBalanceRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetBalanceRequest.Merge(dst, src)
+func (dst *GetTransactionPoolRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetTransactionPoolRequest.Merge(dst, src)
 }
-func (m *GetBalanceRequest) XXX_Size() int {
+func (m *GetTransactionPoolRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *GetBalanceRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetBalanceRequest.DiscardUnknown(m)
+func (m *GetTransactionPoolRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetTransactionPoolRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetBalanceRequest proto.InternalMessageInfo
+var xxx_messageInfo_GetTransactionPoolRequest proto.InternalMessageInfo
 
-func (m *GetBalanceRequest) GetAddrs() []string {
+type GetTransactionsResponse struct {
+	Txs []*pb.Transaction `protobuf:"bytes,1,rep,name=txs" json:"txs,omitempty"`
+}
+
+func (m *GetTransactionsResponse) Reset()         { *m = GetTransactionsResponse{} }
+func (m *GetTransactionsResponse) String() string { return proto.CompactTextString(m) }
+func (*GetTransactionsResponse) ProtoMessage()    {}
+func (*GetTransactionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_transaction_228ec4dbc4f69eeb, []int{9}
+}
+func (m *GetTransactionsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetTransactionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetTransactionsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *GetTransactionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetTransactionsResponse.Merge(dst, src)
+}
+func (m *GetTransactionsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetTransactionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetTransactionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetTransactionsResponse proto.InternalMessageInfo
+
+func (m *GetTransactionsResponse) GetTxs() []*pb.Transaction {
 	if m != nil {
-		return m.Addrs
+		return m.Txs
 	}
 	return nil
 }
 
-type GetBalanceResponse struct {
-	Code     int32             `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
-	Message  string            `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	Balances map[string]uint64 `protobuf:"bytes,3,rep,name=balances" json:"balances,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+type GetMempoolInfoRequest struct {
 }
 
-func (m *GetBalanceResponse) Reset()         { *m = GetBalanceResponse{} }
-func (m *GetBalanceResponse) String() string { return proto.CompactTextString(m) }
-func (*GetBalanceResponse) ProtoMessage()    {}
-func (*GetBalanceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_transaction_5b40de20ba40892e, []int{10}
+func (m *GetMempoolInfoRequest) Reset()         { *m = GetMempoolInfoRequest{} }
+func (m *GetMempoolInfoRequest) String() string { return proto.CompactTextString(m) }
+func (*GetMempoolInfoRequest) ProtoMessage()    {}
+func (*GetMempoolInfoRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_transaction_228ec4dbc4f69eeb, []int{10}
 }
-func (m *GetBalanceResponse) XXX_Unmarshal(b []byte) error {
+func (m *GetMempoolInfoRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *GetBalanceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *GetMempoolInfoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_GetBalanceResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_GetMempoolInfoRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalTo(b)
@@ -526,56 +679,121 @@ func (m *GetBalanceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (dst *GetBalanceResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetBalanceResponse.Merge(dst, src)
+func (dst *GetMempoolInfoRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetMempoolInfoRequest.Merge(dst, src)
 }
-func (m *GetBalanceResponse) XXX_Size() int {
+func (m *GetMempoolInfoRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *GetBalanceResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetBalanceResponse.DiscardUnknown(m)
+func (m *GetMempoolInfoRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetMempoolInfoRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetBalanceResponse proto.InternalMessageInfo
+var xxx_messageInfo_GetMempoolInfoRequest proto.InternalMessageInfo
 
-func (m *GetBalanceResponse) GetCode() int32 {
+type GetMempoolInfoResponse struct {
+	Code    int32  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	// number of txs in mempool, orphans excluded
+	TxCount     uint32 `protobuf:"varint,3,opt,name=tx_count,json=txCount,proto3" json:"tx_count,omitempty"`
+	Bytes       uint64 `protobuf:"varint,4,opt,name=bytes,proto3" json:"bytes,omitempty"`
+	Orphans     uint32 `protobuf:"varint,5,opt,name=orphans,proto3" json:"orphans,omitempty"`
+	MinFeePerKb uint64 `protobuf:"varint,6,opt,name=min_fee_per_kb,json=minFeePerKb,proto3" json:"min_fee_per_kb,omitempty"`
+}
+
+func (m *GetMempoolInfoResponse) Reset()         { *m = GetMempoolInfoResponse{} }
+func (m *GetMempoolInfoResponse) String() string { return proto.CompactTextString(m) }
+func (*GetMempoolInfoResponse) ProtoMessage()    {}
+func (*GetMempoolInfoResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_transaction_228ec4dbc4f69eeb, []int{11}
+}
+func (m *GetMempoolInfoResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetMempoolInfoResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetMempoolInfoResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *GetMempoolInfoResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetMempoolInfoResponse.Merge(dst, src)
+}
+func (m *GetMempoolInfoResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetMempoolInfoResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetMempoolInfoResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetMempoolInfoResponse proto.InternalMessageInfo
+
+func (m *GetMempoolInfoResponse) GetCode() int32 {
 	if m != nil {
 		return m.Code
 	}
 	return 0
 }
 
-func (m *GetBalanceResponse) GetMessage() string {
+func (m *GetMempoolInfoResponse) GetMessage() string {
 	if m != nil {
 		return m.Message
 	}
 	return ""
 }
 
-func (m *GetBalanceResponse) GetBalances() map[string]uint64 {
+func (m *GetMempoolInfoResponse) GetTxCount() uint32 {
 	if m != nil {
-		return m.Balances
+		return m.TxCount
 	}
-	return nil
+	return 0
 }
 
-type GetTokenBalanceRequest struct {
-	Addrs []string     `protobuf:"bytes,1,rep,name=addrs" json:"addrs,omitempty"`
-	Token *pb.OutPoint `protobuf:"bytes,2,opt,name=token" json:"token,omitempty"`
+func (m *GetMempoolInfoResponse) GetBytes() uint64 {
+	if m != nil {
+		return m.Bytes
+	}
+	return 0
 }
 
-func (m *GetTokenBalanceRequest) Reset()         { *m = GetTokenBalanceRequest{} }
-func (m *GetTokenBalanceRequest) String() string { return proto.CompactTextString(m) }
-func (*GetTokenBalanceRequest) ProtoMessage()    {}
-func (*GetTokenBalanceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_transaction_5b40de20ba40892e, []int{11}
+func (m *GetMempoolInfoResponse) GetOrphans() uint32 {
+	if m != nil {
+		return m.Orphans
+	}
+	return 0
 }
-func (m *GetTokenBalanceRequest) XXX_Unmarshal(b []byte) error {
+
+func (m *GetMempoolInfoResponse) GetMinFeePerKb() uint64 {
+	if m != nil {
+		return m.MinFeePerKb
+	}
+	return 0
+}
+
+type GetMempoolFeeHistogramRequest struct {
+	// lower bounds of buckets in fee per 1000 bytes, default ones are used
+	// if empty
+	Bounds []uint64 `protobuf:"varint,1,rep,packed,name=bounds" json:"bounds,omitempty"`
+}
+
+func (m *GetMempoolFeeHistogramRequest) Reset()         { *m = GetMempoolFeeHistogramRequest{} }
+func (m *GetMempoolFeeHistogramRequest) String() string { return proto.CompactTextString(m) }
+func (*GetMempoolFeeHistogramRequest) ProtoMessage()    {}
+func (*GetMempoolFeeHistogramRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_transaction_228ec4dbc4f69eeb, []int{12}
+}
+func (m *GetMempoolFeeHistogramRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *GetTokenBalanceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *GetMempoolFeeHistogramRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_GetTokenBalanceRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_GetMempoolFeeHistogramRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalTo(b)
@@ -585,50 +803,124 @@ func (m *GetTokenBalanceRequest) XXX_Marshal(b []byte, deterministic bool) ([]by
 		return b[:n], nil
 	}
 }
-func (dst *GetTokenBalanceRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetTokenBalanceRequest.Merge(dst, src)
+func (dst *GetMempoolFeeHistogramRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetMempoolFeeHistogramRequest.Merge(dst, src)
 }
-func (m *GetTokenBalanceRequest) XXX_Size() int {
+func (m *GetMempoolFeeHistogramRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *GetTokenBalanceRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetTokenBalanceRequest.DiscardUnknown(m)
+func (m *GetMempoolFeeHistogramRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetMempoolFeeHistogramRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetTokenBalanceRequest proto.InternalMessageInfo
+var xxx_messageInfo_GetMempoolFeeHistogramRequest proto.InternalMessageInfo
 
-func (m *GetTokenBalanceRequest) GetAddrs() []string {
+func (m *GetMempoolFeeHistogramRequest) GetBounds() []uint64 {
 	if m != nil {
-		return m.Addrs
+		return m.Bounds
 	}
 	return nil
 }
 
-func (m *GetTokenBalanceRequest) GetToken() *pb.OutPoint {
+type FeeBucket struct {
+	// txs paying fee rates from min_fee_per_kb up to, but not including,
+	// max_fee_per_kb, which is 0 for the highest bucket
+	MinFeePerKb uint64 `protobuf:"varint,1,opt,name=min_fee_per_kb,json=minFeePerKb,proto3" json:"min_fee_per_kb,omitempty"`
+	MaxFeePerKb uint64 `protobuf:"varint,2,opt,name=max_fee_per_kb,json=maxFeePerKb,proto3" json:"max_fee_per_kb,omitempty"`
+	TxCount     uint32 `protobuf:"varint,3,opt,name=tx_count,json=txCount,proto3" json:"tx_count,omitempty"`
+	Bytes       uint64 `protobuf:"varint,4,opt,name=bytes,proto3" json:"bytes,omitempty"`
+	// bytes of txs in this and higher buckets, queued ahead of a tx paying
+	// min_fee_per_kb
+	CumulativeBytes uint64 `protobuf:"varint,5,opt,name=cumulative_bytes,json=cumulativeBytes,proto3" json:"cumulative_bytes,omitempty"`
+}
+
+func (m *FeeBucket) Reset()         { *m = FeeBucket{} }
+func (m *FeeBucket) String() string { return proto.CompactTextString(m) }
+func (*FeeBucket) ProtoMessage()    {}
+func (*FeeBucket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_transaction_228ec4dbc4f69eeb, []int{13}
+}
+func (m *FeeBucket) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeBucket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeBucket.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *FeeBucket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeBucket.Merge(dst, src)
+}
+func (m *FeeBucket) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeBucket) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeBucket.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeBucket proto.InternalMessageInfo
+
+func (m *FeeBucket) GetMinFeePerKb() uint64 {
 	if m != nil {
-		return m.Token
+		return m.MinFeePerKb
 	}
-	return nil
+	return 0
 }
 
-type GetTokenBalanceResponse struct {
-	Code     int32             `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
-	Message  string            `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	Balances map[string]uint64 `protobuf:"bytes,3,rep,name=balances" json:"balances,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+func (m *FeeBucket) GetMaxFeePerKb() uint64 {
+	if m != nil {
+		return m.MaxFeePerKb
+	}
+	return 0
 }
 
-func (m *GetTokenBalanceResponse) Reset()         { *m = GetTokenBalanceResponse{} }
-func (m *GetTokenBalanceResponse) String() string { return proto.CompactTextString(m) }
-func (*GetTokenBalanceResponse) ProtoMessage()    {}
-func (*GetTokenBalanceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_transaction_5b40de20ba40892e, []int{12}
+func (m *FeeBucket) GetTxCount() uint32 {
+	if m != nil {
+		return m.TxCount
+	}
+	return 0
 }
-func (m *GetTokenBalanceResponse) XXX_Unmarshal(b []byte) error {
+
+func (m *FeeBucket) GetBytes() uint64 {
+	if m != nil {
+		return m.Bytes
+	}
+	return 0
+}
+
+func (m *FeeBucket) GetCumulativeBytes() uint64 {
+	if m != nil {
+		return m.CumulativeBytes
+	}
+	return 0
+}
+
+type GetMempoolFeeHistogramResponse struct {
+	Code    int32  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	// buckets ordered by fee rate, highest first
+	Buckets []*FeeBucket `protobuf:"bytes,3,rep,name=buckets" json:"buckets,omitempty"`
+}
+
+func (m *GetMempoolFeeHistogramResponse) Reset()         { *m = GetMempoolFeeHistogramResponse{} }
+func (m *GetMempoolFeeHistogramResponse) String() string { return proto.CompactTextString(m) }
+func (*GetMempoolFeeHistogramResponse) ProtoMessage()    {}
+func (*GetMempoolFeeHistogramResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_transaction_228ec4dbc4f69eeb, []int{14}
+}
+func (m *GetMempoolFeeHistogramResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *GetTokenBalanceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *GetMempoolFeeHistogramResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_GetTokenBalanceResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_GetMempoolFeeHistogramResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalTo(b)
@@ -638,54 +930,56 @@ func (m *GetTokenBalanceResponse) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return b[:n], nil
 	}
 }
-func (dst *GetTokenBalanceResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetTokenBalanceResponse.Merge(dst, src)
+func (dst *GetMempoolFeeHistogramResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetMempoolFeeHistogramResponse.Merge(dst, src)
 }
-func (m *GetTokenBalanceResponse) XXX_Size() int {
+func (m *GetMempoolFeeHistogramResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *GetTokenBalanceResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetTokenBalanceResponse.DiscardUnknown(m)
+func (m *GetMempoolFeeHistogramResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetMempoolFeeHistogramResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetTokenBalanceResponse proto.InternalMessageInfo
+var xxx_messageInfo_GetMempoolFeeHistogramResponse proto.InternalMessageInfo
 
-func (m *GetTokenBalanceResponse) GetCode() int32 {
+func (m *GetMempoolFeeHistogramResponse) GetCode() int32 {
 	if m != nil {
 		return m.Code
 	}
 	return 0
 }
 
-func (m *GetTokenBalanceResponse) GetMessage() string {
+func (m *GetMempoolFeeHistogramResponse) GetMessage() string {
 	if m != nil {
 		return m.Message
 	}
 	return ""
 }
 
-func (m *GetTokenBalanceResponse) GetBalances() map[string]uint64 {
+func (m *GetMempoolFeeHistogramResponse) GetBuckets() []*FeeBucket {
 	if m != nil {
-		return m.Balances
+		return m.Buckets
 	}
 	return nil
 }
 
-type GetFeePriceRequest struct {
+type ListMempoolTransactionsRequest struct {
+	// return decoded entries instead of hashes only
+	Verbose bool `protobuf:"varint,1,opt,name=verbose,proto3" json:"verbose,omitempty"`
 }
 
-func (m *GetFeePriceRequest) Reset()         { *m = GetFeePriceRequest{} }
-func (m *GetFeePriceRequest) String() string { return proto.CompactTextString(m) }
-func (*GetFeePriceRequest) ProtoMessage()    {}
-func (*GetFeePriceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_transaction_5b40de20ba40892e, []int{13}
+func (m *ListMempoolTransactionsRequest) Reset()         { *m = ListMempoolTransactionsRequest{} }
+func (m *ListMempoolTransactionsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListMempoolTransactionsRequest) ProtoMessage()    {}
+func (*ListMempoolTransactionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_transaction_228ec4dbc4f69eeb, []int{15}
 }
-func (m *GetFeePriceRequest) XXX_Unmarshal(b []byte) error {
+func (m *ListMempoolTransactionsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *GetFeePriceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ListMempoolTransactionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_GetFeePriceRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ListMempoolTransactionsRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalTo(b)
@@ -695,34 +989,55 @@ func (m *GetFeePriceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (dst *GetFeePriceRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetFeePriceRequest.Merge(dst, src)
+func (dst *ListMempoolTransactionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListMempoolTransactionsRequest.Merge(dst, src)
 }
-func (m *GetFeePriceRequest) XXX_Size() int {
+func (m *ListMempoolTransactionsRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *GetFeePriceRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetFeePriceRequest.DiscardUnknown(m)
+func (m *ListMempoolTransactionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListMempoolTransactionsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetFeePriceRequest proto.InternalMessageInfo
-
-type GetFeePriceResponse struct {
-	BoxPerByte uint64 `protobuf:"varint,1,opt,name=box_per_byte,json=boxPerByte,proto3" json:"box_per_byte,omitempty"`
-}
+var xxx_messageInfo_ListMempoolTransactionsRequest proto.InternalMessageInfo
 
-func (m *GetFeePriceResponse) Reset()         { *m = GetFeePriceResponse{} }
-func (m *GetFeePriceResponse) String() string { return proto.CompactTextString(m) }
-func (*GetFeePriceResponse) ProtoMessage()    {}
-func (*GetFeePriceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_transaction_5b40de20ba40892e, []int{14}
-}
-func (m *GetFeePriceResponse) XXX_Unmarshal(b []byte) error {
+func (m *ListMempoolTransactionsRequest) GetVerbose() bool {
+	if m != nil {
+		return m.Verbose
+	}
+	return false
+}
+
+type MempoolEntry struct {
+	Hash     string          `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	Tx       *pb.Transaction `protobuf:"bytes,2,opt,name=tx" json:"tx,omitempty"`
+	TxSize   uint32          `protobuf:"varint,3,opt,name=tx_size,json=txSize,proto3" json:"tx_size,omitempty"`
+	Fee      uint64          `protobuf:"varint,4,opt,name=fee,proto3" json:"fee,omitempty"`
+	FeePerKb uint64          `protobuf:"varint,5,opt,name=fee_per_kb,json=feePerKb,proto3" json:"fee_per_kb,omitempty"`
+	// unix time the tx entered mempool
+	AddedTime int64 `protobuf:"varint,6,opt,name=added_time,json=addedTime,proto3" json:"added_time,omitempty"`
+	// seconds the tx has been in mempool
+	Age int64 `protobuf:"varint,7,opt,name=age,proto3" json:"age,omitempty"`
+	// totals of the tx and its ancestors in mempool
+	AncestorFee  uint64 `protobuf:"varint,8,opt,name=ancestor_fee,json=ancestorFee,proto3" json:"ancestor_fee,omitempty"`
+	AncestorSize uint32 `protobuf:"varint,9,opt,name=ancestor_size,json=ancestorSize,proto3" json:"ancestor_size,omitempty"`
+	// totals of the tx and its descendants in mempool
+	DescendantFee  uint64 `protobuf:"varint,10,opt,name=descendant_fee,json=descendantFee,proto3" json:"descendant_fee,omitempty"`
+	DescendantSize uint32 `protobuf:"varint,11,opt,name=descendant_size,json=descendantSize,proto3" json:"descendant_size,omitempty"`
+}
+
+func (m *MempoolEntry) Reset()         { *m = MempoolEntry{} }
+func (m *MempoolEntry) String() string { return proto.CompactTextString(m) }
+func (*MempoolEntry) ProtoMessage()    {}
+func (*MempoolEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_transaction_228ec4dbc4f69eeb, []int{16}
+}
+func (m *MempoolEntry) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *GetFeePriceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MempoolEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_GetFeePriceResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MempoolEntry.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalTo(b)
@@ -732,1082 +1047,12041 @@ func (m *GetFeePriceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (dst *GetFeePriceResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetFeePriceResponse.Merge(dst, src)
+func (dst *MempoolEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MempoolEntry.Merge(dst, src)
 }
-func (m *GetFeePriceResponse) XXX_Size() int {
+func (m *MempoolEntry) XXX_Size() int {
 	return m.Size()
 }
-func (m *GetFeePriceResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetFeePriceResponse.DiscardUnknown(m)
+func (m *MempoolEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_MempoolEntry.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetFeePriceResponse proto.InternalMessageInfo
+var xxx_messageInfo_MempoolEntry proto.InternalMessageInfo
 
-func (m *GetFeePriceResponse) GetBoxPerByte() uint64 {
+func (m *MempoolEntry) GetHash() string {
 	if m != nil {
-		return m.BoxPerByte
+		return m.Hash
 	}
-	return 0
+	return ""
 }
 
-func init() {
-	proto.RegisterType((*ListUtxosRequest)(nil), "rpcpb.ListUtxosRequest")
-	proto.RegisterType((*GetRawTransactionRequest)(nil), "rpcpb.GetRawTransactionRequest")
-	proto.RegisterType((*GetRawTransactionResponse)(nil), "rpcpb.GetRawTransactionResponse")
-	proto.RegisterType((*GetTransactionPoolRequest)(nil), "rpcpb.GetTransactionPoolRequest")
-	proto.RegisterType((*GetTransactionsResponse)(nil), "rpcpb.GetTransactionsResponse")
-	proto.RegisterType((*TokenAmount)(nil), "rpcpb.TokenAmount")
-	proto.RegisterType((*FundTransactionRequest)(nil), "rpcpb.FundTransactionRequest")
-	proto.RegisterType((*SendTransactionRequest)(nil), "rpcpb.SendTransactionRequest")
-	proto.RegisterType((*ListUtxosResponse)(nil), "rpcpb.ListUtxosResponse")
-	proto.RegisterType((*GetBalanceRequest)(nil), "rpcpb.GetBalanceRequest")
-	proto.RegisterType((*GetBalanceResponse)(nil), "rpcpb.GetBalanceResponse")
-	proto.RegisterMapType((map[string]uint64)(nil), "rpcpb.GetBalanceResponse.BalancesEntry")
-	proto.RegisterType((*GetTokenBalanceRequest)(nil), "rpcpb.GetTokenBalanceRequest")
-	proto.RegisterType((*GetTokenBalanceResponse)(nil), "rpcpb.GetTokenBalanceResponse")
-	proto.RegisterMapType((map[string]uint64)(nil), "rpcpb.GetTokenBalanceResponse.BalancesEntry")
-	proto.RegisterType((*GetFeePriceRequest)(nil), "rpcpb.GetFeePriceRequest")
-	proto.RegisterType((*GetFeePriceResponse)(nil), "rpcpb.GetFeePriceResponse")
+func (m *MempoolEntry) GetTx() *pb.Transaction {
+	if m != nil {
+		return m.Tx
+	}
+	return nil
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+func (m *MempoolEntry) GetTxSize() uint32 {
+	if m != nil {
+		return m.TxSize
+	}
+	return 0
+}
 
-// TransactionCommandClient is the client API for TransactionCommand service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type TransactionCommandClient interface {
-	ListUtxos(ctx context.Context, in *ListUtxosRequest, opts ...grpc.CallOption) (*ListUtxosResponse, error)
-	FundTransaction(ctx context.Context, in *FundTransactionRequest, opts ...grpc.CallOption) (*ListUtxosResponse, error)
-	SendTransaction(ctx context.Context, in *SendTransactionRequest, opts ...grpc.CallOption) (*BaseResponse, error)
-	GetRawTransaction(ctx context.Context, in *GetRawTransactionRequest, opts ...grpc.CallOption) (*GetRawTransactionResponse, error)
-	GetBalance(ctx context.Context, in *GetBalanceRequest, opts ...grpc.CallOption) (*GetBalanceResponse, error)
-	GetTokenBalance(ctx context.Context, in *GetTokenBalanceRequest, opts ...grpc.CallOption) (*GetTokenBalanceResponse, error)
-	GetFeePrice(ctx context.Context, in *GetFeePriceRequest, opts ...grpc.CallOption) (*GetFeePriceResponse, error)
-	GetTransactionPool(ctx context.Context, in *GetTransactionPoolRequest, opts ...grpc.CallOption) (*GetTransactionsResponse, error)
+func (m *MempoolEntry) GetFee() uint64 {
+	if m != nil {
+		return m.Fee
+	}
+	return 0
 }
 
-type transactionCommandClient struct {
-	cc *grpc.ClientConn
+func (m *MempoolEntry) GetFeePerKb() uint64 {
+	if m != nil {
+		return m.FeePerKb
+	}
+	return 0
 }
 
-func NewTransactionCommandClient(cc *grpc.ClientConn) TransactionCommandClient {
-	return &transactionCommandClient{cc}
+func (m *MempoolEntry) GetAddedTime() int64 {
+	if m != nil {
+		return m.AddedTime
+	}
+	return 0
 }
 
-func (c *transactionCommandClient) ListUtxos(ctx context.Context, in *ListUtxosRequest, opts ...grpc.CallOption) (*ListUtxosResponse, error) {
-	out := new(ListUtxosResponse)
-	err := c.cc.Invoke(ctx, "/rpcpb.TransactionCommand/ListUtxos", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MempoolEntry) GetAge() int64 {
+	if m != nil {
+		return m.Age
 	}
-	return out, nil
+	return 0
 }
 
-func (c *transactionCommandClient) FundTransaction(ctx context.Context, in *FundTransactionRequest, opts ...grpc.CallOption) (*ListUtxosResponse, error) {
-	out := new(ListUtxosResponse)
-	err := c.cc.Invoke(ctx, "/rpcpb.TransactionCommand/FundTransaction", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MempoolEntry) GetAncestorFee() uint64 {
+	if m != nil {
+		return m.AncestorFee
 	}
-	return out, nil
+	return 0
 }
 
-func (c *transactionCommandClient) SendTransaction(ctx context.Context, in *SendTransactionRequest, opts ...grpc.CallOption) (*BaseResponse, error) {
-	out := new(BaseResponse)
-	err := c.cc.Invoke(ctx, "/rpcpb.TransactionCommand/SendTransaction", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MempoolEntry) GetAncestorSize() uint32 {
+	if m != nil {
+		return m.AncestorSize
 	}
-	return out, nil
+	return 0
 }
 
-func (c *transactionCommandClient) GetRawTransaction(ctx context.Context, in *GetRawTransactionRequest, opts ...grpc.CallOption) (*GetRawTransactionResponse, error) {
-	out := new(GetRawTransactionResponse)
-	err := c.cc.Invoke(ctx, "/rpcpb.TransactionCommand/GetRawTransaction", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MempoolEntry) GetDescendantFee() uint64 {
+	if m != nil {
+		return m.DescendantFee
 	}
-	return out, nil
+	return 0
 }
 
-func (c *transactionCommandClient) GetBalance(ctx context.Context, in *GetBalanceRequest, opts ...grpc.CallOption) (*GetBalanceResponse, error) {
-	out := new(GetBalanceResponse)
-	err := c.cc.Invoke(ctx, "/rpcpb.TransactionCommand/GetBalance", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MempoolEntry) GetDescendantSize() uint32 {
+	if m != nil {
+		return m.DescendantSize
 	}
-	return out, nil
+	return 0
 }
 
-func (c *transactionCommandClient) GetTokenBalance(ctx context.Context, in *GetTokenBalanceRequest, opts ...grpc.CallOption) (*GetTokenBalanceResponse, error) {
-	out := new(GetTokenBalanceResponse)
-	err := c.cc.Invoke(ctx, "/rpcpb.TransactionCommand/GetTokenBalance", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type TestMempoolAcceptRequest struct {
+	// serialized tx
+	Raw []byte `protobuf:"bytes,1,opt,name=raw,proto3" json:"raw,omitempty"`
 }
 
-func (c *transactionCommandClient) GetFeePrice(ctx context.Context, in *GetFeePriceRequest, opts ...grpc.CallOption) (*GetFeePriceResponse, error) {
-	out := new(GetFeePriceResponse)
-	err := c.cc.Invoke(ctx, "/rpcpb.TransactionCommand/GetFeePrice", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *TestMempoolAcceptRequest) Reset()         { *m = TestMempoolAcceptRequest{} }
+func (m *TestMempoolAcceptRequest) String() string { return proto.CompactTextString(m) }
+func (*TestMempoolAcceptRequest) ProtoMessage()    {}
+func (*TestMempoolAcceptRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_transaction_228ec4dbc4f69eeb, []int{17}
+}
+func (m *TestMempoolAcceptRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TestMempoolAcceptRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TestMempoolAcceptRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (dst *TestMempoolAcceptRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TestMempoolAcceptRequest.Merge(dst, src)
+}
+func (m *TestMempoolAcceptRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *TestMempoolAcceptRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_TestMempoolAcceptRequest.DiscardUnknown(m)
 }
 
-func (c *transactionCommandClient) GetTransactionPool(ctx context.Context, in *GetTransactionPoolRequest, opts ...grpc.CallOption) (*GetTransactionsResponse, error) {
-	out := new(GetTransactionsResponse)
-	err := c.cc.Invoke(ctx, "/rpcpb.TransactionCommand/GetTransactionPool", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_TestMempoolAcceptRequest proto.InternalMessageInfo
+
+func (m *TestMempoolAcceptRequest) GetRaw() []byte {
+	if m != nil {
+		return m.Raw
 	}
-	return out, nil
+	return nil
 }
 
-// TransactionCommandServer is the server API for TransactionCommand service.
-type TransactionCommandServer interface {
-	ListUtxos(context.Context, *ListUtxosRequest) (*ListUtxosResponse, error)
-	FundTransaction(context.Context, *FundTransactionRequest) (*ListUtxosResponse, error)
-	SendTransaction(context.Context, *SendTransactionRequest) (*BaseResponse, error)
-	GetRawTransaction(context.Context, *GetRawTransactionRequest) (*GetRawTransactionResponse, error)
-	GetBalance(context.Context, *GetBalanceRequest) (*GetBalanceResponse, error)
-	GetTokenBalance(context.Context, *GetTokenBalanceRequest) (*GetTokenBalanceResponse, error)
-	GetFeePrice(context.Context, *GetFeePriceRequest) (*GetFeePriceResponse, error)
-	GetTransactionPool(context.Context, *GetTransactionPoolRequest) (*GetTransactionsResponse, error)
+type TestMempoolAcceptResponse struct {
+	Code    int32  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Hash    string `protobuf:"bytes,3,opt,name=hash,proto3" json:"hash,omitempty"`
+	// whether mempool would accept the tx, reject_reason tells why not
+	Allowed      bool   `protobuf:"varint,4,opt,name=allowed,proto3" json:"allowed,omitempty"`
+	RejectReason string `protobuf:"bytes,5,opt,name=reject_reason,json=rejectReason,proto3" json:"reject_reason,omitempty"`
+	// fee, size and fee rate of an allowed tx
+	Fee      uint64 `protobuf:"varint,6,opt,name=fee,proto3" json:"fee,omitempty"`
+	TxSize   uint32 `protobuf:"varint,7,opt,name=tx_size,json=txSize,proto3" json:"tx_size,omitempty"`
+	FeePerKb uint64 `protobuf:"varint,8,opt,name=fee_per_kb,json=feePerKb,proto3" json:"fee_per_kb,omitempty"`
 }
 
-func RegisterTransactionCommandServer(s *grpc.Server, srv TransactionCommandServer) {
-	s.RegisterService(&_TransactionCommand_serviceDesc, srv)
+func (m *TestMempoolAcceptResponse) Reset()         { *m = TestMempoolAcceptResponse{} }
+func (m *TestMempoolAcceptResponse) String() string { return proto.CompactTextString(m) }
+func (*TestMempoolAcceptResponse) ProtoMessage()    {}
+func (*TestMempoolAcceptResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_transaction_228ec4dbc4f69eeb, []int{18}
 }
-
-func _TransactionCommand_ListUtxos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListUtxosRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(TransactionCommandServer).ListUtxos(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/rpcpb.TransactionCommand/ListUtxos",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TransactionCommandServer).ListUtxos(ctx, req.(*ListUtxosRequest))
+func (m *TestMempoolAcceptResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TestMempoolAcceptResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TestMempoolAcceptResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (dst *TestMempoolAcceptResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TestMempoolAcceptResponse.Merge(dst, src)
+}
+func (m *TestMempoolAcceptResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *TestMempoolAcceptResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_TestMempoolAcceptResponse.DiscardUnknown(m)
 }
 
-func _TransactionCommand_FundTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(FundTransactionRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(TransactionCommandServer).FundTransaction(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/rpcpb.TransactionCommand/FundTransaction",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TransactionCommandServer).FundTransaction(ctx, req.(*FundTransactionRequest))
+var xxx_messageInfo_TestMempoolAcceptResponse proto.InternalMessageInfo
+
+func (m *TestMempoolAcceptResponse) GetCode() int32 {
+	if m != nil {
+		return m.Code
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _TransactionCommand_SendTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SendTransactionRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(TransactionCommandServer).SendTransaction(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/rpcpb.TransactionCommand/SendTransaction",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TransactionCommandServer).SendTransaction(ctx, req.(*SendTransactionRequest))
+func (m *TestMempoolAcceptResponse) GetMessage() string {
+	if m != nil {
+		return m.Message
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _TransactionCommand_GetRawTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetRawTransactionRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(TransactionCommandServer).GetRawTransaction(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/rpcpb.TransactionCommand/GetRawTransaction",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TransactionCommandServer).GetRawTransaction(ctx, req.(*GetRawTransactionRequest))
+func (m *TestMempoolAcceptResponse) GetHash() string {
+	if m != nil {
+		return m.Hash
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _TransactionCommand_GetBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetBalanceRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(TransactionCommandServer).GetBalance(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/rpcpb.TransactionCommand/GetBalance",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TransactionCommandServer).GetBalance(ctx, req.(*GetBalanceRequest))
+func (m *TestMempoolAcceptResponse) GetAllowed() bool {
+	if m != nil {
+		return m.Allowed
 	}
-	return interceptor(ctx, in, info, handler)
+	return false
 }
 
-func _TransactionCommand_GetTokenBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetTokenBalanceRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(TransactionCommandServer).GetTokenBalance(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/rpcpb.TransactionCommand/GetTokenBalance",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TransactionCommandServer).GetTokenBalance(ctx, req.(*GetTokenBalanceRequest))
+func (m *TestMempoolAcceptResponse) GetRejectReason() string {
+	if m != nil {
+		return m.RejectReason
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _TransactionCommand_GetFeePrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetFeePriceRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(TransactionCommandServer).GetFeePrice(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/rpcpb.TransactionCommand/GetFeePrice",
+func (m *TestMempoolAcceptResponse) GetFee() uint64 {
+	if m != nil {
+		return m.Fee
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TransactionCommandServer).GetFeePrice(ctx, req.(*GetFeePriceRequest))
+	return 0
+}
+
+func (m *TestMempoolAcceptResponse) GetTxSize() uint32 {
+	if m != nil {
+		return m.TxSize
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _TransactionCommand_GetTransactionPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetTransactionPoolRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *TestMempoolAcceptResponse) GetFeePerKb() uint64 {
+	if m != nil {
+		return m.FeePerKb
 	}
-	if interceptor == nil {
-		return srv.(TransactionCommandServer).GetTransactionPool(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/rpcpb.TransactionCommand/GetTransactionPool",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TransactionCommandServer).GetTransactionPool(ctx, req.(*GetTransactionPoolRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-var _TransactionCommand_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "rpcpb.TransactionCommand",
-	HandlerType: (*TransactionCommandServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "ListUtxos",
-			Handler:    _TransactionCommand_ListUtxos_Handler,
-		},
-		{
-			MethodName: "FundTransaction",
-			Handler:    _TransactionCommand_FundTransaction_Handler,
-		},
-		{
-			MethodName: "SendTransaction",
-			Handler:    _TransactionCommand_SendTransaction_Handler,
-		},
-		{
-			MethodName: "GetRawTransaction",
-			Handler:    _TransactionCommand_GetRawTransaction_Handler,
-		},
-		{
-			MethodName: "GetBalance",
-			Handler:    _TransactionCommand_GetBalance_Handler,
-		},
-		{
-			MethodName: "GetTokenBalance",
-			Handler:    _TransactionCommand_GetTokenBalance_Handler,
-		},
-		{
-			MethodName: "GetFeePrice",
-			Handler:    _TransactionCommand_GetFeePrice_Handler,
-		},
-		{
-			MethodName: "GetTransactionPool",
-			Handler:    _TransactionCommand_GetTransactionPool_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "transaction.proto",
+type ListMempoolTransactionsResponse struct {
+	Code    int32    `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message string   `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Hashes  []string `protobuf:"bytes,3,rep,name=hashes" json:"hashes,omitempty"`
+	// set if verbose
+	Entries []*MempoolEntry `protobuf:"bytes,4,rep,name=entries" json:"entries,omitempty"`
 }
 
-func (m *ListUtxosRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *ListMempoolTransactionsResponse) Reset()         { *m = ListMempoolTransactionsResponse{} }
+func (m *ListMempoolTransactionsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListMempoolTransactionsResponse) ProtoMessage()    {}
+func (*ListMempoolTransactionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_transaction_228ec4dbc4f69eeb, []int{19}
+}
+func (m *ListMempoolTransactionsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListMempoolTransactionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListMempoolTransactionsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (dst *ListMempoolTransactionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListMempoolTransactionsResponse.Merge(dst, src)
+}
+func (m *ListMempoolTransactionsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListMempoolTransactionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListMempoolTransactionsResponse.DiscardUnknown(m)
 }
 
-func (m *ListUtxosRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	return i, nil
+var xxx_messageInfo_ListMempoolTransactionsResponse proto.InternalMessageInfo
+
+func (m *ListMempoolTransactionsResponse) GetCode() int32 {
+	if m != nil {
+		return m.Code
+	}
+	return 0
 }
 
-func (m *GetRawTransactionRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *ListMempoolTransactionsResponse) GetMessage() string {
+	if m != nil {
+		return m.Message
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *GetRawTransactionRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Hash) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintTransaction(dAtA, i, uint64(len(m.Hash)))
-		i += copy(dAtA[i:], m.Hash)
+func (m *ListMempoolTransactionsResponse) GetHashes() []string {
+	if m != nil {
+		return m.Hashes
 	}
-	return i, nil
+	return nil
 }
 
-func (m *GetRawTransactionResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *ListMempoolTransactionsResponse) GetEntries() []*MempoolEntry {
+	if m != nil {
+		return m.Entries
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *GetRawTransactionResponse) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Tx != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintTransaction(dAtA, i, uint64(m.Tx.Size()))
-		n1, err := m.Tx.MarshalTo(dAtA[i:])
+type TokenAmount struct {
+	Token  *pb.OutPoint `protobuf:"bytes,1,opt,name=token" json:"token,omitempty"`
+	Amount uint64       `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (m *TokenAmount) Reset()         { *m = TokenAmount{} }
+func (m *TokenAmount) String() string { return proto.CompactTextString(m) }
+func (*TokenAmount) ProtoMessage()    {}
+func (*TokenAmount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_transaction_228ec4dbc4f69eeb, []int{20}
+}
+func (m *TokenAmount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TokenAmount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TokenAmount.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i += n1
+		return b[:n], nil
 	}
-	return i, nil
 }
-
-func (m *GetTransactionPoolRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (dst *TokenAmount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenAmount.Merge(dst, src)
 }
-
-func (m *GetTransactionPoolRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	return i, nil
+func (m *TokenAmount) XXX_Size() int {
+	return m.Size()
+}
+func (m *TokenAmount) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenAmount.DiscardUnknown(m)
 }
 
-func (m *GetTransactionsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_TokenAmount proto.InternalMessageInfo
+
+func (m *TokenAmount) GetToken() *pb.OutPoint {
+	if m != nil {
+		return m.Token
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *GetTransactionsResponse) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Txs) > 0 {
-		for _, msg := range m.Txs {
-			dAtA[i] = 0xa
-			i++
-			i = encodeVarintTransaction(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
+func (m *TokenAmount) GetAmount() uint64 {
+	if m != nil {
+		return m.Amount
 	}
-	return i, nil
+	return 0
 }
 
-func (m *TokenAmount) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+type FundTransactionRequest struct {
+	Addr         string         `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
+	Amount       uint64         `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	TokenBudgets []*TokenAmount `protobuf:"bytes,3,rep,name=tokenBudgets" json:"tokenBudgets,omitempty"`
 }
 
-func (m *TokenAmount) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Token != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintTransaction(dAtA, i, uint64(m.Token.Size()))
-		n2, err := m.Token.MarshalTo(dAtA[i:])
+func (m *FundTransactionRequest) Reset()         { *m = FundTransactionRequest{} }
+func (m *FundTransactionRequest) String() string { return proto.CompactTextString(m) }
+func (*FundTransactionRequest) ProtoMessage()    {}
+func (*FundTransactionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_transaction_228ec4dbc4f69eeb, []int{21}
+}
+func (m *FundTransactionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FundTransactionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FundTransactionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i += n2
-	}
-	if m.Amount != 0 {
-		dAtA[i] = 0x10
-		i++
-		i = encodeVarintTransaction(dAtA, i, uint64(m.Amount))
+		return b[:n], nil
 	}
-	return i, nil
 }
-
-func (m *FundTransactionRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (dst *FundTransactionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FundTransactionRequest.Merge(dst, src)
+}
+func (m *FundTransactionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *FundTransactionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_FundTransactionRequest.DiscardUnknown(m)
 }
 
-func (m *FundTransactionRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Addr) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintTransaction(dAtA, i, uint64(len(m.Addr)))
-		i += copy(dAtA[i:], m.Addr)
-	}
-	if m.Amount != 0 {
-		dAtA[i] = 0x10
-		i++
-		i = encodeVarintTransaction(dAtA, i, uint64(m.Amount))
+var xxx_messageInfo_FundTransactionRequest proto.InternalMessageInfo
+
+func (m *FundTransactionRequest) GetAddr() string {
+	if m != nil {
+		return m.Addr
 	}
-	if len(m.TokenBudgets) > 0 {
-		for _, msg := range m.TokenBudgets {
-			dAtA[i] = 0x1a
-			i++
-			i = encodeVarintTransaction(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
+	return ""
+}
+
+func (m *FundTransactionRequest) GetAmount() uint64 {
+	if m != nil {
+		return m.Amount
 	}
-	return i, nil
+	return 0
 }
 
-func (m *SendTransactionRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *FundTransactionRequest) GetTokenBudgets() []*TokenAmount {
+	if m != nil {
+		return m.TokenBudgets
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *SendTransactionRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Tx != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintTransaction(dAtA, i, uint64(m.Tx.Size()))
-		n3, err := m.Tx.MarshalTo(dAtA[i:])
+type TxOutTarget struct {
+	Addr   string `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
+	Amount uint64 `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (m *TxOutTarget) Reset()         { *m = TxOutTarget{} }
+func (m *TxOutTarget) String() string { return proto.CompactTextString(m) }
+func (*TxOutTarget) ProtoMessage()    {}
+func (*TxOutTarget) Descriptor() ([]byte, []int) {
+	return fileDescriptor_transaction_228ec4dbc4f69eeb, []int{22}
+}
+func (m *TxOutTarget) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TxOutTarget) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TxOutTarget.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i += n3
+		return b[:n], nil
 	}
-	return i, nil
+}
+func (dst *TxOutTarget) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TxOutTarget.Merge(dst, src)
+}
+func (m *TxOutTarget) XXX_Size() int {
+	return m.Size()
+}
+func (m *TxOutTarget) XXX_DiscardUnknown() {
+	xxx_messageInfo_TxOutTarget.DiscardUnknown(m)
 }
 
-func (m *ListUtxosResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_TxOutTarget proto.InternalMessageInfo
+
+func (m *TxOutTarget) GetAddr() string {
+	if m != nil {
+		return m.Addr
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *ListUtxosResponse) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Code != 0 {
-		dAtA[i] = 0x8
-		i++
-		i = encodeVarintTransaction(dAtA, i, uint64(m.Code))
-	}
-	if len(m.Message) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintTransaction(dAtA, i, uint64(len(m.Message)))
-		i += copy(dAtA[i:], m.Message)
-	}
-	if m.Count != 0 {
-		dAtA[i] = 0x18
-		i++
-		i = encodeVarintTransaction(dAtA, i, uint64(m.Count))
+func (m *TxOutTarget) GetAmount() uint64 {
+	if m != nil {
+		return m.Amount
 	}
-	if len(m.Utxos) > 0 {
-		for _, msg := range m.Utxos {
-			dAtA[i] = 0x22
-			i++
-			i = encodeVarintTransaction(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
+	return 0
+}
+
+type CreateRawTransactionRequest struct {
+	From    string         `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	Outputs []*TxOutTarget `protobuf:"bytes,2,rep,name=outputs" json:"outputs,omitempty"`
+	// change goes back to from if not set
+	ChangeAddr string `protobuf:"bytes,3,opt,name=change_addr,json=changeAddr,proto3" json:"change_addr,omitempty"`
+	// node fee price is used if not set
+	FeePerByte uint64 `protobuf:"varint,4,opt,name=fee_per_byte,json=feePerByte,proto3" json:"fee_per_byte,omitempty"`
+	// inputs signal the tx may be replaced in mempool by a conflicting one
+	// paying more, the tx is final otherwise
+	Replaceable bool `protobuf:"varint,5,opt,name=replaceable,proto3" json:"replaceable,omitempty"`
+}
+
+func (m *CreateRawTransactionRequest) Reset()         { *m = CreateRawTransactionRequest{} }
+func (m *CreateRawTransactionRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateRawTransactionRequest) ProtoMessage()    {}
+func (*CreateRawTransactionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_transaction_228ec4dbc4f69eeb, []int{23}
+}
+func (m *CreateRawTransactionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CreateRawTransactionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CreateRawTransactionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return i, nil
+}
+func (dst *CreateRawTransactionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateRawTransactionRequest.Merge(dst, src)
+}
+func (m *CreateRawTransactionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *CreateRawTransactionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateRawTransactionRequest.DiscardUnknown(m)
 }
 
-func (m *GetBalanceRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_CreateRawTransactionRequest proto.InternalMessageInfo
+
+func (m *CreateRawTransactionRequest) GetFrom() string {
+	if m != nil {
+		return m.From
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *GetBalanceRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Addrs) > 0 {
-		for _, s := range m.Addrs {
-			dAtA[i] = 0xa
-			i++
-			l = len(s)
-			for l >= 1<<7 {
-				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
-				l >>= 7
-				i++
-			}
-			dAtA[i] = uint8(l)
-			i++
-			i += copy(dAtA[i:], s)
-		}
+func (m *CreateRawTransactionRequest) GetOutputs() []*TxOutTarget {
+	if m != nil {
+		return m.Outputs
 	}
-	return i, nil
+	return nil
 }
 
-func (m *GetBalanceResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *CreateRawTransactionRequest) GetChangeAddr() string {
+	if m != nil {
+		return m.ChangeAddr
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *GetBalanceResponse) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Code != 0 {
-		dAtA[i] = 0x8
-		i++
-		i = encodeVarintTransaction(dAtA, i, uint64(m.Code))
+func (m *CreateRawTransactionRequest) GetFeePerByte() uint64 {
+	if m != nil {
+		return m.FeePerByte
 	}
-	if len(m.Message) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintTransaction(dAtA, i, uint64(len(m.Message)))
-		i += copy(dAtA[i:], m.Message)
+	return 0
+}
+
+func (m *CreateRawTransactionRequest) GetReplaceable() bool {
+	if m != nil {
+		return m.Replaceable
 	}
-	if len(m.Balances) > 0 {
-		for k, _ := range m.Balances {
-			dAtA[i] = 0x1a
-			i++
-			v := m.Balances[k]
-			mapSize := 1 + len(k) + sovTransaction(uint64(len(k))) + 1 + sovTransaction(uint64(v))
-			i = encodeVarintTransaction(dAtA, i, uint64(mapSize))
-			dAtA[i] = 0xa
-			i++
-			i = encodeVarintTransaction(dAtA, i, uint64(len(k)))
-			i += copy(dAtA[i:], k)
-			dAtA[i] = 0x10
-			i++
-			i = encodeVarintTransaction(dAtA, i, uint64(v))
+	return false
+}
+
+type CreateRawTransactionResponse struct {
+	Code    int32           `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message string          `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Tx      *pb.Transaction `protobuf:"bytes,3,opt,name=tx" json:"tx,omitempty"`
+	// utxos spent by tx, in the order of its inputs
+	Utxos []*Utxo `protobuf:"bytes,4,rep,name=utxos" json:"utxos,omitempty"`
+	Fee   uint64  `protobuf:"varint,5,opt,name=fee,proto3" json:"fee,omitempty"`
+}
+
+func (m *CreateRawTransactionResponse) Reset()         { *m = CreateRawTransactionResponse{} }
+func (m *CreateRawTransactionResponse) String() string { return proto.CompactTextString(m) }
+func (*CreateRawTransactionResponse) ProtoMessage()    {}
+func (*CreateRawTransactionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_transaction_228ec4dbc4f69eeb, []int{24}
+}
+func (m *CreateRawTransactionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CreateRawTransactionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CreateRawTransactionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return i, nil
+}
+func (dst *CreateRawTransactionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateRawTransactionResponse.Merge(dst, src)
+}
+func (m *CreateRawTransactionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *CreateRawTransactionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateRawTransactionResponse.DiscardUnknown(m)
 }
 
-func (m *GetTokenBalanceRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_CreateRawTransactionResponse proto.InternalMessageInfo
+
+func (m *CreateRawTransactionResponse) GetCode() int32 {
+	if m != nil {
+		return m.Code
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *GetTokenBalanceRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Addrs) > 0 {
-		for _, s := range m.Addrs {
-			dAtA[i] = 0xa
-			i++
-			l = len(s)
-			for l >= 1<<7 {
-				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
-				l >>= 7
-				i++
-			}
-			dAtA[i] = uint8(l)
-			i++
-			i += copy(dAtA[i:], s)
-		}
-	}
-	if m.Token != nil {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintTransaction(dAtA, i, uint64(m.Token.Size()))
-		n4, err := m.Token.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n4
+func (m *CreateRawTransactionResponse) GetMessage() string {
+	if m != nil {
+		return m.Message
 	}
-	return i, nil
+	return ""
 }
 
-func (m *GetTokenBalanceResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *CreateRawTransactionResponse) GetTx() *pb.Transaction {
+	if m != nil {
+		return m.Tx
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *GetTokenBalanceResponse) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Code != 0 {
-		dAtA[i] = 0x8
-		i++
-		i = encodeVarintTransaction(dAtA, i, uint64(m.Code))
-	}
-	if len(m.Message) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintTransaction(dAtA, i, uint64(len(m.Message)))
-		i += copy(dAtA[i:], m.Message)
-	}
-	if len(m.Balances) > 0 {
-		for k, _ := range m.Balances {
-			dAtA[i] = 0x1a
-			i++
-			v := m.Balances[k]
-			mapSize := 1 + len(k) + sovTransaction(uint64(len(k))) + 1 + sovTransaction(uint64(v))
-			i = encodeVarintTransaction(dAtA, i, uint64(mapSize))
-			dAtA[i] = 0xa
-			i++
-			i = encodeVarintTransaction(dAtA, i, uint64(len(k)))
-			i += copy(dAtA[i:], k)
-			dAtA[i] = 0x10
-			i++
-			i = encodeVarintTransaction(dAtA, i, uint64(v))
-		}
+func (m *CreateRawTransactionResponse) GetUtxos() []*Utxo {
+	if m != nil {
+		return m.Utxos
 	}
-	return i, nil
+	return nil
 }
 
-func (m *GetFeePriceRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *CreateRawTransactionResponse) GetFee() uint64 {
+	if m != nil {
+		return m.Fee
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *GetFeePriceRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	return i, nil
+type SignRawTransactionRequest struct {
+	Tx *pb.Transaction `protobuf:"bytes,1,opt,name=tx" json:"tx,omitempty"`
 }
 
-func (m *GetFeePriceResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *SignRawTransactionRequest) Reset()         { *m = SignRawTransactionRequest{} }
+func (m *SignRawTransactionRequest) String() string { return proto.CompactTextString(m) }
+func (*SignRawTransactionRequest) ProtoMessage()    {}
+func (*SignRawTransactionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_transaction_228ec4dbc4f69eeb, []int{25}
+}
+func (m *SignRawTransactionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SignRawTransactionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SignRawTransactionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (dst *SignRawTransactionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignRawTransactionRequest.Merge(dst, src)
+}
+func (m *SignRawTransactionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SignRawTransactionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignRawTransactionRequest.DiscardUnknown(m)
 }
 
-func (m *GetFeePriceResponse) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.BoxPerByte != 0 {
-		dAtA[i] = 0x8
-		i++
-		i = encodeVarintTransaction(dAtA, i, uint64(m.BoxPerByte))
+var xxx_messageInfo_SignRawTransactionRequest proto.InternalMessageInfo
+
+func (m *SignRawTransactionRequest) GetTx() *pb.Transaction {
+	if m != nil {
+		return m.Tx
 	}
-	return i, nil
+	return nil
 }
 
-func encodeVarintTransaction(dAtA []byte, offset int, v uint64) int {
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return offset + 1
+type SignRawTransactionResponse struct {
+	Code    int32           `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message string          `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Tx      *pb.Transaction `protobuf:"bytes,3,opt,name=tx" json:"tx,omitempty"`
+	// set if all inputs are signed
+	Complete bool `protobuf:"varint,4,opt,name=complete,proto3" json:"complete,omitempty"`
 }
-func (m *ListUtxosRequest) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *SignRawTransactionResponse) Reset()         { *m = SignRawTransactionResponse{} }
+func (m *SignRawTransactionResponse) String() string { return proto.CompactTextString(m) }
+func (*SignRawTransactionResponse) ProtoMessage()    {}
+func (*SignRawTransactionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_transaction_228ec4dbc4f69eeb, []int{26}
+}
+func (m *SignRawTransactionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SignRawTransactionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SignRawTransactionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	return n
+}
+func (dst *SignRawTransactionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignRawTransactionResponse.Merge(dst, src)
+}
+func (m *SignRawTransactionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *SignRawTransactionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignRawTransactionResponse.DiscardUnknown(m)
 }
 
-func (m *GetRawTransactionRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Hash)
-	if l > 0 {
-		n += 1 + l + sovTransaction(uint64(l))
+var xxx_messageInfo_SignRawTransactionResponse proto.InternalMessageInfo
+
+func (m *SignRawTransactionResponse) GetCode() int32 {
+	if m != nil {
+		return m.Code
 	}
-	return n
+	return 0
 }
 
-func (m *GetRawTransactionResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Tx != nil {
-		l = m.Tx.Size()
-		n += 1 + l + sovTransaction(uint64(l))
+func (m *SignRawTransactionResponse) GetMessage() string {
+	if m != nil {
+		return m.Message
 	}
-	return n
+	return ""
 }
 
-func (m *GetTransactionPoolRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *SignRawTransactionResponse) GetTx() *pb.Transaction {
+	if m != nil {
+		return m.Tx
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
 
-func (m *GetTransactionsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *SignRawTransactionResponse) GetComplete() bool {
+	if m != nil {
+		return m.Complete
 	}
-	var l int
-	_ = l
-	if len(m.Txs) > 0 {
-		for _, e := range m.Txs {
-			l = e.Size()
-			n += 1 + l + sovTransaction(uint64(l))
+	return false
+}
+
+type SendTransactionRequest struct {
+	Tx *pb.Transaction `protobuf:"bytes,1,opt,name=tx" json:"tx,omitempty"`
+}
+
+func (m *SendTransactionRequest) Reset()         { *m = SendTransactionRequest{} }
+func (m *SendTransactionRequest) String() string { return proto.CompactTextString(m) }
+func (*SendTransactionRequest) ProtoMessage()    {}
+func (*SendTransactionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_transaction_228ec4dbc4f69eeb, []int{27}
+}
+func (m *SendTransactionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SendTransactionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SendTransactionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (dst *SendTransactionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SendTransactionRequest.Merge(dst, src)
+}
+func (m *SendTransactionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SendTransactionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SendTransactionRequest.DiscardUnknown(m)
 }
 
-func (m *TokenAmount) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Token != nil {
-		l = m.Token.Size()
-		n += 1 + l + sovTransaction(uint64(l))
-	}
-	if m.Amount != 0 {
-		n += 1 + sovTransaction(uint64(m.Amount))
+var xxx_messageInfo_SendTransactionRequest proto.InternalMessageInfo
+
+func (m *SendTransactionRequest) GetTx() *pb.Transaction {
+	if m != nil {
+		return m.Tx
 	}
-	return n
+	return nil
 }
 
-func (m *FundTransactionRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Addr)
-	if l > 0 {
-		n += 1 + l + sovTransaction(uint64(l))
-	}
-	if m.Amount != 0 {
-		n += 1 + sovTransaction(uint64(m.Amount))
-	}
-	if len(m.TokenBudgets) > 0 {
-		for _, e := range m.TokenBudgets {
-			l = e.Size()
-			n += 1 + l + sovTransaction(uint64(l))
+type ListUtxosResponse struct {
+	Code    int32   `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message string  `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Count   uint32  `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	Utxos   []*Utxo `protobuf:"bytes,4,rep,name=utxos" json:"utxos,omitempty"`
+}
+
+func (m *ListUtxosResponse) Reset()         { *m = ListUtxosResponse{} }
+func (m *ListUtxosResponse) String() string { return proto.CompactTextString(m) }
+func (*ListUtxosResponse) ProtoMessage()    {}
+func (*ListUtxosResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_transaction_228ec4dbc4f69eeb, []int{28}
+}
+func (m *ListUtxosResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListUtxosResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListUtxosResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (dst *ListUtxosResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListUtxosResponse.Merge(dst, src)
+}
+func (m *ListUtxosResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListUtxosResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListUtxosResponse.DiscardUnknown(m)
 }
 
-func (m *SendTransactionRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Tx != nil {
-		l = m.Tx.Size()
-		n += 1 + l + sovTransaction(uint64(l))
+var xxx_messageInfo_ListUtxosResponse proto.InternalMessageInfo
+
+func (m *ListUtxosResponse) GetCode() int32 {
+	if m != nil {
+		return m.Code
 	}
-	return n
+	return 0
 }
 
-func (m *ListUtxosResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Code != 0 {
-		n += 1 + sovTransaction(uint64(m.Code))
-	}
-	l = len(m.Message)
-	if l > 0 {
-		n += 1 + l + sovTransaction(uint64(l))
-	}
-	if m.Count != 0 {
-		n += 1 + sovTransaction(uint64(m.Count))
+func (m *ListUtxosResponse) GetMessage() string {
+	if m != nil {
+		return m.Message
 	}
-	if len(m.Utxos) > 0 {
-		for _, e := range m.Utxos {
-			l = e.Size()
-			n += 1 + l + sovTransaction(uint64(l))
-		}
+	return ""
+}
+
+func (m *ListUtxosResponse) GetCount() uint32 {
+	if m != nil {
+		return m.Count
 	}
-	return n
+	return 0
 }
 
-func (m *GetBalanceRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ListUtxosResponse) GetUtxos() []*Utxo {
+	if m != nil {
+		return m.Utxos
 	}
-	var l int
-	_ = l
-	if len(m.Addrs) > 0 {
-		for _, s := range m.Addrs {
-			l = len(s)
-			n += 1 + l + sovTransaction(uint64(l))
+	return nil
+}
+
+type GetBalanceRequest struct {
+	Addrs []string `protobuf:"bytes,1,rep,name=addrs" json:"addrs,omitempty"`
+	// also return balances of accounts in node wallet, watch-only ones included
+	Wallet bool `protobuf:"varint,2,opt,name=wallet,proto3" json:"wallet,omitempty"`
+}
+
+func (m *GetBalanceRequest) Reset()         { *m = GetBalanceRequest{} }
+func (m *GetBalanceRequest) String() string { return proto.CompactTextString(m) }
+func (*GetBalanceRequest) ProtoMessage()    {}
+func (*GetBalanceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_transaction_228ec4dbc4f69eeb, []int{29}
+}
+func (m *GetBalanceRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetBalanceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetBalanceRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (dst *GetBalanceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetBalanceRequest.Merge(dst, src)
+}
+func (m *GetBalanceRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetBalanceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetBalanceRequest.DiscardUnknown(m)
 }
 
-func (m *GetBalanceResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Code != 0 {
-		n += 1 + sovTransaction(uint64(m.Code))
+var xxx_messageInfo_GetBalanceRequest proto.InternalMessageInfo
+
+func (m *GetBalanceRequest) GetAddrs() []string {
+	if m != nil {
+		return m.Addrs
 	}
-	l = len(m.Message)
-	if l > 0 {
-		n += 1 + l + sovTransaction(uint64(l))
+	return nil
+}
+
+func (m *GetBalanceRequest) GetWallet() bool {
+	if m != nil {
+		return m.Wallet
 	}
-	if len(m.Balances) > 0 {
-		for k, v := range m.Balances {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovTransaction(uint64(len(k))) + 1 + sovTransaction(uint64(v))
-			n += mapEntrySize + 1 + sovTransaction(uint64(mapEntrySize))
+	return false
+}
+
+type GetBalanceResponse struct {
+	Code     int32             `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message  string            `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Balances map[string]uint64 `protobuf:"bytes,3,rep,name=balances" json:"balances,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+}
+
+func (m *GetBalanceResponse) Reset()         { *m = GetBalanceResponse{} }
+func (m *GetBalanceResponse) String() string { return proto.CompactTextString(m) }
+func (*GetBalanceResponse) ProtoMessage()    {}
+func (*GetBalanceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_transaction_228ec4dbc4f69eeb, []int{30}
+}
+func (m *GetBalanceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetBalanceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetBalanceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (dst *GetBalanceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetBalanceResponse.Merge(dst, src)
+}
+func (m *GetBalanceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetBalanceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetBalanceResponse.DiscardUnknown(m)
 }
 
-func (m *GetTokenBalanceRequest) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_GetBalanceResponse proto.InternalMessageInfo
+
+func (m *GetBalanceResponse) GetCode() int32 {
+	if m != nil {
+		return m.Code
 	}
-	var l int
-	_ = l
-	if len(m.Addrs) > 0 {
-		for _, s := range m.Addrs {
-			l = len(s)
-			n += 1 + l + sovTransaction(uint64(l))
-		}
+	return 0
+}
+
+func (m *GetBalanceResponse) GetMessage() string {
+	if m != nil {
+		return m.Message
 	}
-	if m.Token != nil {
-		l = m.Token.Size()
-		n += 1 + l + sovTransaction(uint64(l))
+	return ""
+}
+
+func (m *GetBalanceResponse) GetBalances() map[string]uint64 {
+	if m != nil {
+		return m.Balances
 	}
-	return n
+	return nil
 }
 
-func (m *GetTokenBalanceResponse) Size() (n int) {
-	if m == nil {
-		return 0
+type ListTokensRequest struct {
+	Offset uint32 `protobuf:"varint,1,opt,name=offset,proto3" json:"offset,omitempty"`
+	// 0 means the default 100
+	Limit uint32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (m *ListTokensRequest) Reset()         { *m = ListTokensRequest{} }
+func (m *ListTokensRequest) String() string { return proto.CompactTextString(m) }
+func (*ListTokensRequest) ProtoMessage()    {}
+func (*ListTokensRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_transaction_228ec4dbc4f69eeb, []int{31}
+}
+func (m *ListTokensRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListTokensRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListTokensRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	if m.Code != 0 {
-		n += 1 + sovTransaction(uint64(m.Code))
+}
+func (dst *ListTokensRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListTokensRequest.Merge(dst, src)
+}
+func (m *ListTokensRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListTokensRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListTokensRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListTokensRequest proto.InternalMessageInfo
+
+func (m *ListTokensRequest) GetOffset() uint32 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+func (m *ListTokensRequest) GetLimit() uint32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+type ListTokensResponse struct {
+	Code    int32  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	// number of all tokens on the chain
+	Total  uint32       `protobuf:"varint,3,opt,name=total,proto3" json:"total,omitempty"`
+	Tokens []*TokenInfo `protobuf:"bytes,4,rep,name=tokens" json:"tokens,omitempty"`
+}
+
+func (m *ListTokensResponse) Reset()         { *m = ListTokensResponse{} }
+func (m *ListTokensResponse) String() string { return proto.CompactTextString(m) }
+func (*ListTokensResponse) ProtoMessage()    {}
+func (*ListTokensResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_transaction_228ec4dbc4f69eeb, []int{32}
+}
+func (m *ListTokensResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListTokensResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListTokensResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *ListTokensResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListTokensResponse.Merge(dst, src)
+}
+func (m *ListTokensResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListTokensResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListTokensResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListTokensResponse proto.InternalMessageInfo
+
+func (m *ListTokensResponse) GetCode() int32 {
+	if m != nil {
+		return m.Code
+	}
+	return 0
+}
+
+func (m *ListTokensResponse) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+func (m *ListTokensResponse) GetTotal() uint32 {
+	if m != nil {
+		return m.Total
+	}
+	return 0
+}
+
+func (m *ListTokensResponse) GetTokens() []*TokenInfo {
+	if m != nil {
+		return m.Tokens
+	}
+	return nil
+}
+
+type TokenInfo struct {
+	// the token is identified by the outpoint it's issued at
+	Token       *pb.OutPoint `protobuf:"bytes,1,opt,name=token" json:"token,omitempty"`
+	Name        string       `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	TotalSupply uint64       `protobuf:"varint,3,opt,name=total_supply,json=totalSupply,proto3" json:"total_supply,omitempty"`
+	// the address the whole supply is issued to
+	Issuer string `protobuf:"bytes,4,opt,name=issuer,proto3" json:"issuer,omitempty"`
+	Height uint32 `protobuf:"varint,5,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *TokenInfo) Reset()         { *m = TokenInfo{} }
+func (m *TokenInfo) String() string { return proto.CompactTextString(m) }
+func (*TokenInfo) ProtoMessage()    {}
+func (*TokenInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_transaction_228ec4dbc4f69eeb, []int{33}
+}
+func (m *TokenInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TokenInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TokenInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *TokenInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenInfo.Merge(dst, src)
+}
+func (m *TokenInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *TokenInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TokenInfo proto.InternalMessageInfo
+
+func (m *TokenInfo) GetToken() *pb.OutPoint {
+	if m != nil {
+		return m.Token
+	}
+	return nil
+}
+
+func (m *TokenInfo) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *TokenInfo) GetTotalSupply() uint64 {
+	if m != nil {
+		return m.TotalSupply
+	}
+	return 0
+}
+
+func (m *TokenInfo) GetIssuer() string {
+	if m != nil {
+		return m.Issuer
+	}
+	return ""
+}
+
+func (m *TokenInfo) GetHeight() uint32 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+type GetTokenTransactionsRequest struct {
+	Token  *pb.OutPoint `protobuf:"bytes,1,opt,name=token" json:"token,omitempty"`
+	Offset uint32       `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	// 0 means the default 100
+	Limit uint32 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (m *GetTokenTransactionsRequest) Reset()         { *m = GetTokenTransactionsRequest{} }
+func (m *GetTokenTransactionsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetTokenTransactionsRequest) ProtoMessage()    {}
+func (*GetTokenTransactionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_transaction_228ec4dbc4f69eeb, []int{34}
+}
+func (m *GetTokenTransactionsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetTokenTransactionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetTokenTransactionsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *GetTokenTransactionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetTokenTransactionsRequest.Merge(dst, src)
+}
+func (m *GetTokenTransactionsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetTokenTransactionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetTokenTransactionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetTokenTransactionsRequest proto.InternalMessageInfo
+
+func (m *GetTokenTransactionsRequest) GetToken() *pb.OutPoint {
+	if m != nil {
+		return m.Token
+	}
+	return nil
+}
+
+func (m *GetTokenTransactionsRequest) GetOffset() uint32 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+func (m *GetTokenTransactionsRequest) GetLimit() uint32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+type GetTokenTransactionsResponse struct {
+	Code    int32  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	// number of all txs issuing or transferring the token
+	Total uint32              `protobuf:"varint,3,opt,name=total,proto3" json:"total,omitempty"`
+	Txs   []*TokenTransaction `protobuf:"bytes,4,rep,name=txs" json:"txs,omitempty"`
+}
+
+func (m *GetTokenTransactionsResponse) Reset()         { *m = GetTokenTransactionsResponse{} }
+func (m *GetTokenTransactionsResponse) String() string { return proto.CompactTextString(m) }
+func (*GetTokenTransactionsResponse) ProtoMessage()    {}
+func (*GetTokenTransactionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_transaction_228ec4dbc4f69eeb, []int{35}
+}
+func (m *GetTokenTransactionsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetTokenTransactionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetTokenTransactionsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *GetTokenTransactionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetTokenTransactionsResponse.Merge(dst, src)
+}
+func (m *GetTokenTransactionsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetTokenTransactionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetTokenTransactionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetTokenTransactionsResponse proto.InternalMessageInfo
+
+func (m *GetTokenTransactionsResponse) GetCode() int32 {
+	if m != nil {
+		return m.Code
+	}
+	return 0
+}
+
+func (m *GetTokenTransactionsResponse) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+func (m *GetTokenTransactionsResponse) GetTotal() uint32 {
+	if m != nil {
+		return m.Total
+	}
+	return 0
+}
+
+func (m *GetTokenTransactionsResponse) GetTxs() []*TokenTransaction {
+	if m != nil {
+		return m.Txs
+	}
+	return nil
+}
+
+type TokenTransaction struct {
+	Tx          *pb.Transaction `protobuf:"bytes,1,opt,name=tx" json:"tx,omitempty"`
+	Hash        string          `protobuf:"bytes,2,opt,name=hash,proto3" json:"hash,omitempty"`
+	BlockHash   string          `protobuf:"bytes,3,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+	BlockHeight uint32          `protobuf:"varint,4,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	Timestamp   int64           `protobuf:"varint,5,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+}
+
+func (m *TokenTransaction) Reset()         { *m = TokenTransaction{} }
+func (m *TokenTransaction) String() string { return proto.CompactTextString(m) }
+func (*TokenTransaction) ProtoMessage()    {}
+func (*TokenTransaction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_transaction_228ec4dbc4f69eeb, []int{36}
+}
+func (m *TokenTransaction) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TokenTransaction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TokenTransaction.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *TokenTransaction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenTransaction.Merge(dst, src)
+}
+func (m *TokenTransaction) XXX_Size() int {
+	return m.Size()
+}
+func (m *TokenTransaction) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenTransaction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TokenTransaction proto.InternalMessageInfo
+
+func (m *TokenTransaction) GetTx() *pb.Transaction {
+	if m != nil {
+		return m.Tx
+	}
+	return nil
+}
+
+func (m *TokenTransaction) GetHash() string {
+	if m != nil {
+		return m.Hash
+	}
+	return ""
+}
+
+func (m *TokenTransaction) GetBlockHash() string {
+	if m != nil {
+		return m.BlockHash
+	}
+	return ""
+}
+
+func (m *TokenTransaction) GetBlockHeight() uint32 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *TokenTransaction) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+type GetTokenBalanceRequest struct {
+	Addrs []string     `protobuf:"bytes,1,rep,name=addrs" json:"addrs,omitempty"`
+	Token *pb.OutPoint `protobuf:"bytes,2,opt,name=token" json:"token,omitempty"`
+}
+
+func (m *GetTokenBalanceRequest) Reset()         { *m = GetTokenBalanceRequest{} }
+func (m *GetTokenBalanceRequest) String() string { return proto.CompactTextString(m) }
+func (*GetTokenBalanceRequest) ProtoMessage()    {}
+func (*GetTokenBalanceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_transaction_228ec4dbc4f69eeb, []int{37}
+}
+func (m *GetTokenBalanceRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetTokenBalanceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetTokenBalanceRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *GetTokenBalanceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetTokenBalanceRequest.Merge(dst, src)
+}
+func (m *GetTokenBalanceRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetTokenBalanceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetTokenBalanceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetTokenBalanceRequest proto.InternalMessageInfo
+
+func (m *GetTokenBalanceRequest) GetAddrs() []string {
+	if m != nil {
+		return m.Addrs
+	}
+	return nil
+}
+
+func (m *GetTokenBalanceRequest) GetToken() *pb.OutPoint {
+	if m != nil {
+		return m.Token
+	}
+	return nil
+}
+
+type GetTokenBalanceResponse struct {
+	Code     int32             `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message  string            `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Balances map[string]uint64 `protobuf:"bytes,3,rep,name=balances" json:"balances,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+}
+
+func (m *GetTokenBalanceResponse) Reset()         { *m = GetTokenBalanceResponse{} }
+func (m *GetTokenBalanceResponse) String() string { return proto.CompactTextString(m) }
+func (*GetTokenBalanceResponse) ProtoMessage()    {}
+func (*GetTokenBalanceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_transaction_228ec4dbc4f69eeb, []int{38}
+}
+func (m *GetTokenBalanceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetTokenBalanceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetTokenBalanceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *GetTokenBalanceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetTokenBalanceResponse.Merge(dst, src)
+}
+func (m *GetTokenBalanceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetTokenBalanceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetTokenBalanceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetTokenBalanceResponse proto.InternalMessageInfo
+
+func (m *GetTokenBalanceResponse) GetCode() int32 {
+	if m != nil {
+		return m.Code
+	}
+	return 0
+}
+
+func (m *GetTokenBalanceResponse) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+func (m *GetTokenBalanceResponse) GetBalances() map[string]uint64 {
+	if m != nil {
+		return m.Balances
+	}
+	return nil
+}
+
+type GetFeePriceRequest struct {
+}
+
+func (m *GetFeePriceRequest) Reset()         { *m = GetFeePriceRequest{} }
+func (m *GetFeePriceRequest) String() string { return proto.CompactTextString(m) }
+func (*GetFeePriceRequest) ProtoMessage()    {}
+func (*GetFeePriceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_transaction_228ec4dbc4f69eeb, []int{39}
+}
+func (m *GetFeePriceRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetFeePriceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetFeePriceRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *GetFeePriceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetFeePriceRequest.Merge(dst, src)
+}
+func (m *GetFeePriceRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetFeePriceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetFeePriceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetFeePriceRequest proto.InternalMessageInfo
+
+type GetFeePriceResponse struct {
+	BoxPerByte uint64 `protobuf:"varint,1,opt,name=box_per_byte,json=boxPerByte,proto3" json:"box_per_byte,omitempty"`
+}
+
+func (m *GetFeePriceResponse) Reset()         { *m = GetFeePriceResponse{} }
+func (m *GetFeePriceResponse) String() string { return proto.CompactTextString(m) }
+func (*GetFeePriceResponse) ProtoMessage()    {}
+func (*GetFeePriceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_transaction_228ec4dbc4f69eeb, []int{40}
+}
+func (m *GetFeePriceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetFeePriceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetFeePriceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *GetFeePriceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetFeePriceResponse.Merge(dst, src)
+}
+func (m *GetFeePriceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetFeePriceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetFeePriceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetFeePriceResponse proto.InternalMessageInfo
+
+func (m *GetFeePriceResponse) GetBoxPerByte() uint64 {
+	if m != nil {
+		return m.BoxPerByte
+	}
+	return 0
+}
+
+type EstimateFeeRequest struct {
+	// number of blocks the tx is expected to be packed within
+	TargetBlocks uint32 `protobuf:"varint,1,opt,name=target_blocks,json=targetBlocks,proto3" json:"target_blocks,omitempty"`
+}
+
+func (m *EstimateFeeRequest) Reset()         { *m = EstimateFeeRequest{} }
+func (m *EstimateFeeRequest) String() string { return proto.CompactTextString(m) }
+func (*EstimateFeeRequest) ProtoMessage()    {}
+func (*EstimateFeeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_transaction_228ec4dbc4f69eeb, []int{41}
+}
+func (m *EstimateFeeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EstimateFeeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EstimateFeeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *EstimateFeeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EstimateFeeRequest.Merge(dst, src)
+}
+func (m *EstimateFeeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *EstimateFeeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_EstimateFeeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EstimateFeeRequest proto.InternalMessageInfo
+
+func (m *EstimateFeeRequest) GetTargetBlocks() uint32 {
+	if m != nil {
+		return m.TargetBlocks
+	}
+	return 0
+}
+
+type EstimateFeeResponse struct {
+	Code       int32  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message    string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	BoxPerByte uint64 `protobuf:"varint,3,opt,name=box_per_byte,json=boxPerByte,proto3" json:"box_per_byte,omitempty"`
+	// number of txs the estimation is based on
+	SampleSize uint32 `protobuf:"varint,4,opt,name=sample_size,json=sampleSize,proto3" json:"sample_size,omitempty"`
+}
+
+func (m *EstimateFeeResponse) Reset()         { *m = EstimateFeeResponse{} }
+func (m *EstimateFeeResponse) String() string { return proto.CompactTextString(m) }
+func (*EstimateFeeResponse) ProtoMessage()    {}
+func (*EstimateFeeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_transaction_228ec4dbc4f69eeb, []int{42}
+}
+func (m *EstimateFeeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EstimateFeeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EstimateFeeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *EstimateFeeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EstimateFeeResponse.Merge(dst, src)
+}
+func (m *EstimateFeeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *EstimateFeeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_EstimateFeeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EstimateFeeResponse proto.InternalMessageInfo
+
+func (m *EstimateFeeResponse) GetCode() int32 {
+	if m != nil {
+		return m.Code
+	}
+	return 0
+}
+
+func (m *EstimateFeeResponse) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+func (m *EstimateFeeResponse) GetBoxPerByte() uint64 {
+	if m != nil {
+		return m.BoxPerByte
+	}
+	return 0
+}
+
+func (m *EstimateFeeResponse) GetSampleSize() uint32 {
+	if m != nil {
+		return m.SampleSize
+	}
+	return 0
+}
+
+type CreatePSBTRequest struct {
+	// unsigned tx, inputs already signed are taken as finalized
+	Tx *pb.Transaction `protobuf:"bytes,1,opt,name=tx" json:"tx,omitempty"`
+	// redeem scripts of pay to script hash inputs keyed by input index
+	RedeemScripts map[uint32][]byte `protobuf:"bytes,2,rep,name=redeem_scripts,json=redeemScripts" json:"redeem_scripts,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (m *CreatePSBTRequest) Reset()         { *m = CreatePSBTRequest{} }
+func (m *CreatePSBTRequest) String() string { return proto.CompactTextString(m) }
+func (*CreatePSBTRequest) ProtoMessage()    {}
+func (*CreatePSBTRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_transaction_228ec4dbc4f69eeb, []int{43}
+}
+func (m *CreatePSBTRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CreatePSBTRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CreatePSBTRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *CreatePSBTRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreatePSBTRequest.Merge(dst, src)
+}
+func (m *CreatePSBTRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *CreatePSBTRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreatePSBTRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreatePSBTRequest proto.InternalMessageInfo
+
+func (m *CreatePSBTRequest) GetTx() *pb.Transaction {
+	if m != nil {
+		return m.Tx
+	}
+	return nil
+}
+
+func (m *CreatePSBTRequest) GetRedeemScripts() map[uint32][]byte {
+	if m != nil {
+		return m.RedeemScripts
+	}
+	return nil
+}
+
+// PSBT is a base64 encoded partially signed transaction
+type PSBTResponse struct {
+	Code    int32  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Psbt    string `protobuf:"bytes,3,opt,name=psbt,proto3" json:"psbt,omitempty"`
+}
+
+func (m *PSBTResponse) Reset()         { *m = PSBTResponse{} }
+func (m *PSBTResponse) String() string { return proto.CompactTextString(m) }
+func (*PSBTResponse) ProtoMessage()    {}
+func (*PSBTResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_transaction_228ec4dbc4f69eeb, []int{44}
+}
+func (m *PSBTResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PSBTResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PSBTResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *PSBTResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PSBTResponse.Merge(dst, src)
+}
+func (m *PSBTResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *PSBTResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PSBTResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PSBTResponse proto.InternalMessageInfo
+
+func (m *PSBTResponse) GetCode() int32 {
+	if m != nil {
+		return m.Code
+	}
+	return 0
+}
+
+func (m *PSBTResponse) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+func (m *PSBTResponse) GetPsbt() string {
+	if m != nil {
+		return m.Psbt
+	}
+	return ""
+}
+
+type SignPSBTRequest struct {
+	Psbt string `protobuf:"bytes,1,opt,name=psbt,proto3" json:"psbt,omitempty"`
+}
+
+func (m *SignPSBTRequest) Reset()         { *m = SignPSBTRequest{} }
+func (m *SignPSBTRequest) String() string { return proto.CompactTextString(m) }
+func (*SignPSBTRequest) ProtoMessage()    {}
+func (*SignPSBTRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_transaction_228ec4dbc4f69eeb, []int{45}
+}
+func (m *SignPSBTRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SignPSBTRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SignPSBTRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *SignPSBTRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignPSBTRequest.Merge(dst, src)
+}
+func (m *SignPSBTRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SignPSBTRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignPSBTRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SignPSBTRequest proto.InternalMessageInfo
+
+func (m *SignPSBTRequest) GetPsbt() string {
+	if m != nil {
+		return m.Psbt
+	}
+	return ""
+}
+
+type SignPSBTResponse struct {
+	Code    int32  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Psbt    string `protobuf:"bytes,3,opt,name=psbt,proto3" json:"psbt,omitempty"`
+	// inputs signed by accounts unlocked on the node
+	Signed uint32 `protobuf:"varint,4,opt,name=signed,proto3" json:"signed,omitempty"`
+}
+
+func (m *SignPSBTResponse) Reset()         { *m = SignPSBTResponse{} }
+func (m *SignPSBTResponse) String() string { return proto.CompactTextString(m) }
+func (*SignPSBTResponse) ProtoMessage()    {}
+func (*SignPSBTResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_transaction_228ec4dbc4f69eeb, []int{46}
+}
+func (m *SignPSBTResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SignPSBTResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SignPSBTResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *SignPSBTResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignPSBTResponse.Merge(dst, src)
+}
+func (m *SignPSBTResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *SignPSBTResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignPSBTResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SignPSBTResponse proto.InternalMessageInfo
+
+func (m *SignPSBTResponse) GetCode() int32 {
+	if m != nil {
+		return m.Code
+	}
+	return 0
+}
+
+func (m *SignPSBTResponse) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+func (m *SignPSBTResponse) GetPsbt() string {
+	if m != nil {
+		return m.Psbt
+	}
+	return ""
+}
+
+func (m *SignPSBTResponse) GetSigned() uint32 {
+	if m != nil {
+		return m.Signed
+	}
+	return 0
+}
+
+type MergePSBTRequest struct {
+	Psbts []string `protobuf:"bytes,1,rep,name=psbts" json:"psbts,omitempty"`
+}
+
+func (m *MergePSBTRequest) Reset()         { *m = MergePSBTRequest{} }
+func (m *MergePSBTRequest) String() string { return proto.CompactTextString(m) }
+func (*MergePSBTRequest) ProtoMessage()    {}
+func (*MergePSBTRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_transaction_228ec4dbc4f69eeb, []int{47}
+}
+func (m *MergePSBTRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MergePSBTRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MergePSBTRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *MergePSBTRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MergePSBTRequest.Merge(dst, src)
+}
+func (m *MergePSBTRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MergePSBTRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MergePSBTRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MergePSBTRequest proto.InternalMessageInfo
+
+func (m *MergePSBTRequest) GetPsbts() []string {
+	if m != nil {
+		return m.Psbts
+	}
+	return nil
+}
+
+type FinalizePSBTRequest struct {
+	Psbt string `protobuf:"bytes,1,opt,name=psbt,proto3" json:"psbt,omitempty"`
+}
+
+func (m *FinalizePSBTRequest) Reset()         { *m = FinalizePSBTRequest{} }
+func (m *FinalizePSBTRequest) String() string { return proto.CompactTextString(m) }
+func (*FinalizePSBTRequest) ProtoMessage()    {}
+func (*FinalizePSBTRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_transaction_228ec4dbc4f69eeb, []int{48}
+}
+func (m *FinalizePSBTRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FinalizePSBTRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FinalizePSBTRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *FinalizePSBTRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FinalizePSBTRequest.Merge(dst, src)
+}
+func (m *FinalizePSBTRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *FinalizePSBTRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_FinalizePSBTRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FinalizePSBTRequest proto.InternalMessageInfo
+
+func (m *FinalizePSBTRequest) GetPsbt() string {
+	if m != nil {
+		return m.Psbt
+	}
+	return ""
+}
+
+type FinalizePSBTResponse struct {
+	Code    int32  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Psbt    string `protobuf:"bytes,3,opt,name=psbt,proto3" json:"psbt,omitempty"`
+	// set if all inputs are finalized, tx is the signed transaction then
+	Complete bool            `protobuf:"varint,4,opt,name=complete,proto3" json:"complete,omitempty"`
+	Tx       *pb.Transaction `protobuf:"bytes,5,opt,name=tx" json:"tx,omitempty"`
+}
+
+func (m *FinalizePSBTResponse) Reset()         { *m = FinalizePSBTResponse{} }
+func (m *FinalizePSBTResponse) String() string { return proto.CompactTextString(m) }
+func (*FinalizePSBTResponse) ProtoMessage()    {}
+func (*FinalizePSBTResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_transaction_228ec4dbc4f69eeb, []int{49}
+}
+func (m *FinalizePSBTResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FinalizePSBTResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FinalizePSBTResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *FinalizePSBTResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FinalizePSBTResponse.Merge(dst, src)
+}
+func (m *FinalizePSBTResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *FinalizePSBTResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_FinalizePSBTResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FinalizePSBTResponse proto.InternalMessageInfo
+
+func (m *FinalizePSBTResponse) GetCode() int32 {
+	if m != nil {
+		return m.Code
+	}
+	return 0
+}
+
+func (m *FinalizePSBTResponse) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+func (m *FinalizePSBTResponse) GetPsbt() string {
+	if m != nil {
+		return m.Psbt
+	}
+	return ""
+}
+
+func (m *FinalizePSBTResponse) GetComplete() bool {
+	if m != nil {
+		return m.Complete
+	}
+	return false
+}
+
+func (m *FinalizePSBTResponse) GetTx() *pb.Transaction {
+	if m != nil {
+		return m.Tx
+	}
+	return nil
+}
+
+type SubscribeFilteredBlocksRequest struct {
+	// bloom filter serialized by Filter.Marshal. A tx matches if the filter
+	// contains its hash, the script or pubkey hash of one of its outputs or
+	// one of the outpoints it spends, serialized as tx hash followed by the
+	// little endian uint32 index. Outpoints of matched outputs are added to
+	// the filter, so that txs spending them match as well
+	Filter []byte `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+}
+
+func (m *SubscribeFilteredBlocksRequest) Reset()         { *m = SubscribeFilteredBlocksRequest{} }
+func (m *SubscribeFilteredBlocksRequest) String() string { return proto.CompactTextString(m) }
+func (*SubscribeFilteredBlocksRequest) ProtoMessage()    {}
+func (*SubscribeFilteredBlocksRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_transaction_228ec4dbc4f69eeb, []int{50}
+}
+func (m *SubscribeFilteredBlocksRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SubscribeFilteredBlocksRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SubscribeFilteredBlocksRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *SubscribeFilteredBlocksRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubscribeFilteredBlocksRequest.Merge(dst, src)
+}
+func (m *SubscribeFilteredBlocksRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SubscribeFilteredBlocksRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubscribeFilteredBlocksRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SubscribeFilteredBlocksRequest proto.InternalMessageInfo
+
+func (m *SubscribeFilteredBlocksRequest) GetFilter() []byte {
+	if m != nil {
+		return m.Filter
+	}
+	return nil
+}
+
+type MatchedTransaction struct {
+	Hash string          `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	Tx   *pb.Transaction `protobuf:"bytes,2,opt,name=tx" json:"tx,omitempty"`
+	// position of tx in block
+	Index uint32 `protobuf:"varint,3,opt,name=index,proto3" json:"index,omitempty"`
+	// hashes to combine with tx hash to get merkle root of block, bottom up
+	MerkleBranch [][]byte `protobuf:"bytes,4,rep,name=merkle_branch,json=merkleBranch" json:"merkle_branch,omitempty"`
+}
+
+func (m *MatchedTransaction) Reset()         { *m = MatchedTransaction{} }
+func (m *MatchedTransaction) String() string { return proto.CompactTextString(m) }
+func (*MatchedTransaction) ProtoMessage()    {}
+func (*MatchedTransaction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_transaction_228ec4dbc4f69eeb, []int{51}
+}
+func (m *MatchedTransaction) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MatchedTransaction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MatchedTransaction.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *MatchedTransaction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MatchedTransaction.Merge(dst, src)
+}
+func (m *MatchedTransaction) XXX_Size() int {
+	return m.Size()
+}
+func (m *MatchedTransaction) XXX_DiscardUnknown() {
+	xxx_messageInfo_MatchedTransaction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MatchedTransaction proto.InternalMessageInfo
+
+func (m *MatchedTransaction) GetHash() string {
+	if m != nil {
+		return m.Hash
+	}
+	return ""
+}
+
+func (m *MatchedTransaction) GetTx() *pb.Transaction {
+	if m != nil {
+		return m.Tx
+	}
+	return nil
+}
+
+func (m *MatchedTransaction) GetIndex() uint32 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *MatchedTransaction) GetMerkleBranch() [][]byte {
+	if m != nil {
+		return m.MerkleBranch
+	}
+	return nil
+}
+
+type FilteredBlock struct {
+	Code    int32           `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message string          `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Hash    string          `protobuf:"bytes,3,opt,name=hash,proto3" json:"hash,omitempty"`
+	Height  uint32          `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+	Header  *pb.BlockHeader `protobuf:"bytes,5,opt,name=header" json:"header,omitempty"`
+	// false if block is disconnected from main chain on reorganization
+	Connected bool                  `protobuf:"varint,6,opt,name=connected,proto3" json:"connected,omitempty"`
+	TotalTxs  uint32                `protobuf:"varint,7,opt,name=total_txs,json=totalTxs,proto3" json:"total_txs,omitempty"`
+	Txs       []*MatchedTransaction `protobuf:"bytes,8,rep,name=txs" json:"txs,omitempty"`
+}
+
+func (m *FilteredBlock) Reset()         { *m = FilteredBlock{} }
+func (m *FilteredBlock) String() string { return proto.CompactTextString(m) }
+func (*FilteredBlock) ProtoMessage()    {}
+func (*FilteredBlock) Descriptor() ([]byte, []int) {
+	return fileDescriptor_transaction_228ec4dbc4f69eeb, []int{52}
+}
+func (m *FilteredBlock) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FilteredBlock) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FilteredBlock.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *FilteredBlock) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FilteredBlock.Merge(dst, src)
+}
+func (m *FilteredBlock) XXX_Size() int {
+	return m.Size()
+}
+func (m *FilteredBlock) XXX_DiscardUnknown() {
+	xxx_messageInfo_FilteredBlock.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FilteredBlock proto.InternalMessageInfo
+
+func (m *FilteredBlock) GetCode() int32 {
+	if m != nil {
+		return m.Code
+	}
+	return 0
+}
+
+func (m *FilteredBlock) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+func (m *FilteredBlock) GetHash() string {
+	if m != nil {
+		return m.Hash
+	}
+	return ""
+}
+
+func (m *FilteredBlock) GetHeight() uint32 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *FilteredBlock) GetHeader() *pb.BlockHeader {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *FilteredBlock) GetConnected() bool {
+	if m != nil {
+		return m.Connected
+	}
+	return false
+}
+
+func (m *FilteredBlock) GetTotalTxs() uint32 {
+	if m != nil {
+		return m.TotalTxs
+	}
+	return 0
+}
+
+func (m *FilteredBlock) GetTxs() []*MatchedTransaction {
+	if m != nil {
+		return m.Txs
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*ListUtxosRequest)(nil), "rpcpb.ListUtxosRequest")
+	proto.RegisterType((*GetRawTransactionRequest)(nil), "rpcpb.GetRawTransactionRequest")
+	proto.RegisterType((*GetRawTransactionResponse)(nil), "rpcpb.GetRawTransactionResponse")
+	proto.RegisterType((*GetTransactionDetailRequest)(nil), "rpcpb.GetTransactionDetailRequest")
+	proto.RegisterType((*TxInDetail)(nil), "rpcpb.TxInDetail")
+	proto.RegisterType((*TxOutDetail)(nil), "rpcpb.TxOutDetail")
+	proto.RegisterType((*TransactionDetail)(nil), "rpcpb.TransactionDetail")
+	proto.RegisterType((*GetTransactionDetailResponse)(nil), "rpcpb.GetTransactionDetailResponse")
+	proto.RegisterType((*GetTransactionPoolRequest)(nil), "rpcpb.GetTransactionPoolRequest")
+	proto.RegisterType((*GetTransactionsResponse)(nil), "rpcpb.GetTransactionsResponse")
+	proto.RegisterType((*GetMempoolInfoRequest)(nil), "rpcpb.GetMempoolInfoRequest")
+	proto.RegisterType((*GetMempoolInfoResponse)(nil), "rpcpb.GetMempoolInfoResponse")
+	proto.RegisterType((*GetMempoolFeeHistogramRequest)(nil), "rpcpb.GetMempoolFeeHistogramRequest")
+	proto.RegisterType((*FeeBucket)(nil), "rpcpb.FeeBucket")
+	proto.RegisterType((*GetMempoolFeeHistogramResponse)(nil), "rpcpb.GetMempoolFeeHistogramResponse")
+	proto.RegisterType((*ListMempoolTransactionsRequest)(nil), "rpcpb.ListMempoolTransactionsRequest")
+	proto.RegisterType((*MempoolEntry)(nil), "rpcpb.MempoolEntry")
+	proto.RegisterType((*TestMempoolAcceptRequest)(nil), "rpcpb.TestMempoolAcceptRequest")
+	proto.RegisterType((*TestMempoolAcceptResponse)(nil), "rpcpb.TestMempoolAcceptResponse")
+	proto.RegisterType((*ListMempoolTransactionsResponse)(nil), "rpcpb.ListMempoolTransactionsResponse")
+	proto.RegisterType((*TokenAmount)(nil), "rpcpb.TokenAmount")
+	proto.RegisterType((*FundTransactionRequest)(nil), "rpcpb.FundTransactionRequest")
+	proto.RegisterType((*TxOutTarget)(nil), "rpcpb.TxOutTarget")
+	proto.RegisterType((*CreateRawTransactionRequest)(nil), "rpcpb.CreateRawTransactionRequest")
+	proto.RegisterType((*CreateRawTransactionResponse)(nil), "rpcpb.CreateRawTransactionResponse")
+	proto.RegisterType((*SignRawTransactionRequest)(nil), "rpcpb.SignRawTransactionRequest")
+	proto.RegisterType((*SignRawTransactionResponse)(nil), "rpcpb.SignRawTransactionResponse")
+	proto.RegisterType((*SendTransactionRequest)(nil), "rpcpb.SendTransactionRequest")
+	proto.RegisterType((*ListUtxosResponse)(nil), "rpcpb.ListUtxosResponse")
+	proto.RegisterType((*GetBalanceRequest)(nil), "rpcpb.GetBalanceRequest")
+	proto.RegisterType((*GetBalanceResponse)(nil), "rpcpb.GetBalanceResponse")
+	proto.RegisterMapType((map[string]uint64)(nil), "rpcpb.GetBalanceResponse.BalancesEntry")
+	proto.RegisterType((*ListTokensRequest)(nil), "rpcpb.ListTokensRequest")
+	proto.RegisterType((*ListTokensResponse)(nil), "rpcpb.ListTokensResponse")
+	proto.RegisterType((*TokenInfo)(nil), "rpcpb.TokenInfo")
+	proto.RegisterType((*GetTokenTransactionsRequest)(nil), "rpcpb.GetTokenTransactionsRequest")
+	proto.RegisterType((*GetTokenTransactionsResponse)(nil), "rpcpb.GetTokenTransactionsResponse")
+	proto.RegisterType((*TokenTransaction)(nil), "rpcpb.TokenTransaction")
+	proto.RegisterType((*GetTokenBalanceRequest)(nil), "rpcpb.GetTokenBalanceRequest")
+	proto.RegisterType((*GetTokenBalanceResponse)(nil), "rpcpb.GetTokenBalanceResponse")
+	proto.RegisterMapType((map[string]uint64)(nil), "rpcpb.GetTokenBalanceResponse.BalancesEntry")
+	proto.RegisterType((*GetFeePriceRequest)(nil), "rpcpb.GetFeePriceRequest")
+	proto.RegisterType((*GetFeePriceResponse)(nil), "rpcpb.GetFeePriceResponse")
+	proto.RegisterType((*EstimateFeeRequest)(nil), "rpcpb.EstimateFeeRequest")
+	proto.RegisterType((*EstimateFeeResponse)(nil), "rpcpb.EstimateFeeResponse")
+	proto.RegisterType((*CreatePSBTRequest)(nil), "rpcpb.CreatePSBTRequest")
+	proto.RegisterMapType((map[uint32][]byte)(nil), "rpcpb.CreatePSBTRequest.RedeemScriptsEntry")
+	proto.RegisterType((*PSBTResponse)(nil), "rpcpb.PSBTResponse")
+	proto.RegisterType((*SignPSBTRequest)(nil), "rpcpb.SignPSBTRequest")
+	proto.RegisterType((*SignPSBTResponse)(nil), "rpcpb.SignPSBTResponse")
+	proto.RegisterType((*MergePSBTRequest)(nil), "rpcpb.MergePSBTRequest")
+	proto.RegisterType((*FinalizePSBTRequest)(nil), "rpcpb.FinalizePSBTRequest")
+	proto.RegisterType((*FinalizePSBTResponse)(nil), "rpcpb.FinalizePSBTResponse")
+	proto.RegisterType((*SubscribeFilteredBlocksRequest)(nil), "rpcpb.SubscribeFilteredBlocksRequest")
+	proto.RegisterType((*MatchedTransaction)(nil), "rpcpb.MatchedTransaction")
+	proto.RegisterType((*FilteredBlock)(nil), "rpcpb.FilteredBlock")
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// TransactionCommandClient is the client API for TransactionCommand service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type TransactionCommandClient interface {
+	ListUtxos(ctx context.Context, in *ListUtxosRequest, opts ...grpc.CallOption) (*ListUtxosResponse, error)
+	FundTransaction(ctx context.Context, in *FundTransactionRequest, opts ...grpc.CallOption) (*ListUtxosResponse, error)
+	SendTransaction(ctx context.Context, in *SendTransactionRequest, opts ...grpc.CallOption) (*BaseResponse, error)
+	CreateRawTransaction(ctx context.Context, in *CreateRawTransactionRequest, opts ...grpc.CallOption) (*CreateRawTransactionResponse, error)
+	SignRawTransaction(ctx context.Context, in *SignRawTransactionRequest, opts ...grpc.CallOption) (*SignRawTransactionResponse, error)
+	GetRawTransaction(ctx context.Context, in *GetRawTransactionRequest, opts ...grpc.CallOption) (*GetRawTransactionResponse, error)
+	GetTransactionDetail(ctx context.Context, in *GetTransactionDetailRequest, opts ...grpc.CallOption) (*GetTransactionDetailResponse, error)
+	GetBalance(ctx context.Context, in *GetBalanceRequest, opts ...grpc.CallOption) (*GetBalanceResponse, error)
+	GetTokenBalance(ctx context.Context, in *GetTokenBalanceRequest, opts ...grpc.CallOption) (*GetTokenBalanceResponse, error)
+	ListTokens(ctx context.Context, in *ListTokensRequest, opts ...grpc.CallOption) (*ListTokensResponse, error)
+	GetTokenTransactions(ctx context.Context, in *GetTokenTransactionsRequest, opts ...grpc.CallOption) (*GetTokenTransactionsResponse, error)
+	GetFeePrice(ctx context.Context, in *GetFeePriceRequest, opts ...grpc.CallOption) (*GetFeePriceResponse, error)
+	EstimateFee(ctx context.Context, in *EstimateFeeRequest, opts ...grpc.CallOption) (*EstimateFeeResponse, error)
+	GetTransactionPool(ctx context.Context, in *GetTransactionPoolRequest, opts ...grpc.CallOption) (*GetTransactionsResponse, error)
+	GetMempoolInfo(ctx context.Context, in *GetMempoolInfoRequest, opts ...grpc.CallOption) (*GetMempoolInfoResponse, error)
+	// bytes of txs in mempool by fee rate bucket, highest first
+	GetMempoolFeeHistogram(ctx context.Context, in *GetMempoolFeeHistogramRequest, opts ...grpc.CallOption) (*GetMempoolFeeHistogramResponse, error)
+	// list txs in mempool ordered by fee rate, highest first
+	ListMempoolTransactions(ctx context.Context, in *ListMempoolTransactionsRequest, opts ...grpc.CallOption) (*ListMempoolTransactionsResponse, error)
+	// run all mempool checks on a tx without adding or relaying it
+	TestMempoolAccept(ctx context.Context, in *TestMempoolAcceptRequest, opts ...grpc.CallOption) (*TestMempoolAcceptResponse, error)
+	CreatePSBT(ctx context.Context, in *CreatePSBTRequest, opts ...grpc.CallOption) (*PSBTResponse, error)
+	SignPSBT(ctx context.Context, in *SignPSBTRequest, opts ...grpc.CallOption) (*SignPSBTResponse, error)
+	MergePSBT(ctx context.Context, in *MergePSBTRequest, opts ...grpc.CallOption) (*PSBTResponse, error)
+	FinalizePSBT(ctx context.Context, in *FinalizePSBTRequest, opts ...grpc.CallOption) (*FinalizePSBTResponse, error)
+	// stream blocks connected to or disconnected from main chain, each with
+	// txs matching a client bloom filter and their merkle branches, so that
+	// light clients can follow their txs without downloading whole blocks
+	SubscribeFilteredBlocks(ctx context.Context, in *SubscribeFilteredBlocksRequest, opts ...grpc.CallOption) (TransactionCommand_SubscribeFilteredBlocksClient, error)
+}
+
+type transactionCommandClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewTransactionCommandClient(cc *grpc.ClientConn) TransactionCommandClient {
+	return &transactionCommandClient{cc}
+}
+
+func (c *transactionCommandClient) ListUtxos(ctx context.Context, in *ListUtxosRequest, opts ...grpc.CallOption) (*ListUtxosResponse, error) {
+	out := new(ListUtxosResponse)
+	err := c.cc.Invoke(ctx, "/rpcpb.TransactionCommand/ListUtxos", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionCommandClient) FundTransaction(ctx context.Context, in *FundTransactionRequest, opts ...grpc.CallOption) (*ListUtxosResponse, error) {
+	out := new(ListUtxosResponse)
+	err := c.cc.Invoke(ctx, "/rpcpb.TransactionCommand/FundTransaction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionCommandClient) SendTransaction(ctx context.Context, in *SendTransactionRequest, opts ...grpc.CallOption) (*BaseResponse, error) {
+	out := new(BaseResponse)
+	err := c.cc.Invoke(ctx, "/rpcpb.TransactionCommand/SendTransaction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionCommandClient) CreateRawTransaction(ctx context.Context, in *CreateRawTransactionRequest, opts ...grpc.CallOption) (*CreateRawTransactionResponse, error) {
+	out := new(CreateRawTransactionResponse)
+	err := c.cc.Invoke(ctx, "/rpcpb.TransactionCommand/CreateRawTransaction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionCommandClient) SignRawTransaction(ctx context.Context, in *SignRawTransactionRequest, opts ...grpc.CallOption) (*SignRawTransactionResponse, error) {
+	out := new(SignRawTransactionResponse)
+	err := c.cc.Invoke(ctx, "/rpcpb.TransactionCommand/SignRawTransaction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionCommandClient) GetRawTransaction(ctx context.Context, in *GetRawTransactionRequest, opts ...grpc.CallOption) (*GetRawTransactionResponse, error) {
+	out := new(GetRawTransactionResponse)
+	err := c.cc.Invoke(ctx, "/rpcpb.TransactionCommand/GetRawTransaction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionCommandClient) GetTransactionDetail(ctx context.Context, in *GetTransactionDetailRequest, opts ...grpc.CallOption) (*GetTransactionDetailResponse, error) {
+	out := new(GetTransactionDetailResponse)
+	err := c.cc.Invoke(ctx, "/rpcpb.TransactionCommand/GetTransactionDetail", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionCommandClient) GetBalance(ctx context.Context, in *GetBalanceRequest, opts ...grpc.CallOption) (*GetBalanceResponse, error) {
+	out := new(GetBalanceResponse)
+	err := c.cc.Invoke(ctx, "/rpcpb.TransactionCommand/GetBalance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionCommandClient) GetTokenBalance(ctx context.Context, in *GetTokenBalanceRequest, opts ...grpc.CallOption) (*GetTokenBalanceResponse, error) {
+	out := new(GetTokenBalanceResponse)
+	err := c.cc.Invoke(ctx, "/rpcpb.TransactionCommand/GetTokenBalance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionCommandClient) ListTokens(ctx context.Context, in *ListTokensRequest, opts ...grpc.CallOption) (*ListTokensResponse, error) {
+	out := new(ListTokensResponse)
+	err := c.cc.Invoke(ctx, "/rpcpb.TransactionCommand/ListTokens", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionCommandClient) GetTokenTransactions(ctx context.Context, in *GetTokenTransactionsRequest, opts ...grpc.CallOption) (*GetTokenTransactionsResponse, error) {
+	out := new(GetTokenTransactionsResponse)
+	err := c.cc.Invoke(ctx, "/rpcpb.TransactionCommand/GetTokenTransactions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionCommandClient) GetFeePrice(ctx context.Context, in *GetFeePriceRequest, opts ...grpc.CallOption) (*GetFeePriceResponse, error) {
+	out := new(GetFeePriceResponse)
+	err := c.cc.Invoke(ctx, "/rpcpb.TransactionCommand/GetFeePrice", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionCommandClient) EstimateFee(ctx context.Context, in *EstimateFeeRequest, opts ...grpc.CallOption) (*EstimateFeeResponse, error) {
+	out := new(EstimateFeeResponse)
+	err := c.cc.Invoke(ctx, "/rpcpb.TransactionCommand/EstimateFee", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionCommandClient) GetTransactionPool(ctx context.Context, in *GetTransactionPoolRequest, opts ...grpc.CallOption) (*GetTransactionsResponse, error) {
+	out := new(GetTransactionsResponse)
+	err := c.cc.Invoke(ctx, "/rpcpb.TransactionCommand/GetTransactionPool", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionCommandClient) GetMempoolInfo(ctx context.Context, in *GetMempoolInfoRequest, opts ...grpc.CallOption) (*GetMempoolInfoResponse, error) {
+	out := new(GetMempoolInfoResponse)
+	err := c.cc.Invoke(ctx, "/rpcpb.TransactionCommand/GetMempoolInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionCommandClient) GetMempoolFeeHistogram(ctx context.Context, in *GetMempoolFeeHistogramRequest, opts ...grpc.CallOption) (*GetMempoolFeeHistogramResponse, error) {
+	out := new(GetMempoolFeeHistogramResponse)
+	err := c.cc.Invoke(ctx, "/rpcpb.TransactionCommand/GetMempoolFeeHistogram", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionCommandClient) ListMempoolTransactions(ctx context.Context, in *ListMempoolTransactionsRequest, opts ...grpc.CallOption) (*ListMempoolTransactionsResponse, error) {
+	out := new(ListMempoolTransactionsResponse)
+	err := c.cc.Invoke(ctx, "/rpcpb.TransactionCommand/ListMempoolTransactions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionCommandClient) TestMempoolAccept(ctx context.Context, in *TestMempoolAcceptRequest, opts ...grpc.CallOption) (*TestMempoolAcceptResponse, error) {
+	out := new(TestMempoolAcceptResponse)
+	err := c.cc.Invoke(ctx, "/rpcpb.TransactionCommand/TestMempoolAccept", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionCommandClient) CreatePSBT(ctx context.Context, in *CreatePSBTRequest, opts ...grpc.CallOption) (*PSBTResponse, error) {
+	out := new(PSBTResponse)
+	err := c.cc.Invoke(ctx, "/rpcpb.TransactionCommand/CreatePSBT", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionCommandClient) SignPSBT(ctx context.Context, in *SignPSBTRequest, opts ...grpc.CallOption) (*SignPSBTResponse, error) {
+	out := new(SignPSBTResponse)
+	err := c.cc.Invoke(ctx, "/rpcpb.TransactionCommand/SignPSBT", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionCommandClient) MergePSBT(ctx context.Context, in *MergePSBTRequest, opts ...grpc.CallOption) (*PSBTResponse, error) {
+	out := new(PSBTResponse)
+	err := c.cc.Invoke(ctx, "/rpcpb.TransactionCommand/MergePSBT", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionCommandClient) FinalizePSBT(ctx context.Context, in *FinalizePSBTRequest, opts ...grpc.CallOption) (*FinalizePSBTResponse, error) {
+	out := new(FinalizePSBTResponse)
+	err := c.cc.Invoke(ctx, "/rpcpb.TransactionCommand/FinalizePSBT", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionCommandClient) SubscribeFilteredBlocks(ctx context.Context, in *SubscribeFilteredBlocksRequest, opts ...grpc.CallOption) (TransactionCommand_SubscribeFilteredBlocksClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_TransactionCommand_serviceDesc.Streams[0], "/rpcpb.TransactionCommand/SubscribeFilteredBlocks", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &transactionCommandSubscribeFilteredBlocksClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type TransactionCommand_SubscribeFilteredBlocksClient interface {
+	Recv() (*FilteredBlock, error)
+	grpc.ClientStream
+}
+
+type transactionCommandSubscribeFilteredBlocksClient struct {
+	grpc.ClientStream
+}
+
+func (x *transactionCommandSubscribeFilteredBlocksClient) Recv() (*FilteredBlock, error) {
+	m := new(FilteredBlock)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// TransactionCommandServer is the server API for TransactionCommand service.
+type TransactionCommandServer interface {
+	ListUtxos(context.Context, *ListUtxosRequest) (*ListUtxosResponse, error)
+	FundTransaction(context.Context, *FundTransactionRequest) (*ListUtxosResponse, error)
+	SendTransaction(context.Context, *SendTransactionRequest) (*BaseResponse, error)
+	CreateRawTransaction(context.Context, *CreateRawTransactionRequest) (*CreateRawTransactionResponse, error)
+	SignRawTransaction(context.Context, *SignRawTransactionRequest) (*SignRawTransactionResponse, error)
+	GetRawTransaction(context.Context, *GetRawTransactionRequest) (*GetRawTransactionResponse, error)
+	GetTransactionDetail(context.Context, *GetTransactionDetailRequest) (*GetTransactionDetailResponse, error)
+	GetBalance(context.Context, *GetBalanceRequest) (*GetBalanceResponse, error)
+	GetTokenBalance(context.Context, *GetTokenBalanceRequest) (*GetTokenBalanceResponse, error)
+	ListTokens(context.Context, *ListTokensRequest) (*ListTokensResponse, error)
+	GetTokenTransactions(context.Context, *GetTokenTransactionsRequest) (*GetTokenTransactionsResponse, error)
+	GetFeePrice(context.Context, *GetFeePriceRequest) (*GetFeePriceResponse, error)
+	EstimateFee(context.Context, *EstimateFeeRequest) (*EstimateFeeResponse, error)
+	GetTransactionPool(context.Context, *GetTransactionPoolRequest) (*GetTransactionsResponse, error)
+	GetMempoolInfo(context.Context, *GetMempoolInfoRequest) (*GetMempoolInfoResponse, error)
+	// bytes of txs in mempool by fee rate bucket, highest first
+	GetMempoolFeeHistogram(context.Context, *GetMempoolFeeHistogramRequest) (*GetMempoolFeeHistogramResponse, error)
+	// list txs in mempool ordered by fee rate, highest first
+	ListMempoolTransactions(context.Context, *ListMempoolTransactionsRequest) (*ListMempoolTransactionsResponse, error)
+	// run all mempool checks on a tx without adding or relaying it
+	TestMempoolAccept(context.Context, *TestMempoolAcceptRequest) (*TestMempoolAcceptResponse, error)
+	CreatePSBT(context.Context, *CreatePSBTRequest) (*PSBTResponse, error)
+	SignPSBT(context.Context, *SignPSBTRequest) (*SignPSBTResponse, error)
+	MergePSBT(context.Context, *MergePSBTRequest) (*PSBTResponse, error)
+	FinalizePSBT(context.Context, *FinalizePSBTRequest) (*FinalizePSBTResponse, error)
+	// stream blocks connected to or disconnected from main chain, each with
+	// txs matching a client bloom filter and their merkle branches, so that
+	// light clients can follow their txs without downloading whole blocks
+	SubscribeFilteredBlocks(*SubscribeFilteredBlocksRequest, TransactionCommand_SubscribeFilteredBlocksServer) error
+}
+
+func RegisterTransactionCommandServer(s *grpc.Server, srv TransactionCommandServer) {
+	s.RegisterService(&_TransactionCommand_serviceDesc, srv)
+}
+
+func _TransactionCommand_ListUtxos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUtxosRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionCommandServer).ListUtxos(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.TransactionCommand/ListUtxos",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionCommandServer).ListUtxos(ctx, req.(*ListUtxosRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransactionCommand_FundTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FundTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionCommandServer).FundTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.TransactionCommand/FundTransaction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionCommandServer).FundTransaction(ctx, req.(*FundTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransactionCommand_SendTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionCommandServer).SendTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.TransactionCommand/SendTransaction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionCommandServer).SendTransaction(ctx, req.(*SendTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransactionCommand_CreateRawTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateRawTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionCommandServer).CreateRawTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.TransactionCommand/CreateRawTransaction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionCommandServer).CreateRawTransaction(ctx, req.(*CreateRawTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransactionCommand_SignRawTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignRawTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionCommandServer).SignRawTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.TransactionCommand/SignRawTransaction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionCommandServer).SignRawTransaction(ctx, req.(*SignRawTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransactionCommand_GetRawTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRawTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionCommandServer).GetRawTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.TransactionCommand/GetRawTransaction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionCommandServer).GetRawTransaction(ctx, req.(*GetRawTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransactionCommand_GetTransactionDetail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTransactionDetailRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionCommandServer).GetTransactionDetail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.TransactionCommand/GetTransactionDetail",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionCommandServer).GetTransactionDetail(ctx, req.(*GetTransactionDetailRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransactionCommand_GetBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBalanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionCommandServer).GetBalance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.TransactionCommand/GetBalance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionCommandServer).GetBalance(ctx, req.(*GetBalanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransactionCommand_GetTokenBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTokenBalanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionCommandServer).GetTokenBalance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.TransactionCommand/GetTokenBalance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionCommandServer).GetTokenBalance(ctx, req.(*GetTokenBalanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransactionCommand_ListTokens_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTokensRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionCommandServer).ListTokens(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.TransactionCommand/ListTokens",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionCommandServer).ListTokens(ctx, req.(*ListTokensRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransactionCommand_GetTokenTransactions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTokenTransactionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionCommandServer).GetTokenTransactions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.TransactionCommand/GetTokenTransactions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionCommandServer).GetTokenTransactions(ctx, req.(*GetTokenTransactionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransactionCommand_GetFeePrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFeePriceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionCommandServer).GetFeePrice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.TransactionCommand/GetFeePrice",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionCommandServer).GetFeePrice(ctx, req.(*GetFeePriceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransactionCommand_EstimateFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EstimateFeeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionCommandServer).EstimateFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.TransactionCommand/EstimateFee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionCommandServer).EstimateFee(ctx, req.(*EstimateFeeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransactionCommand_GetTransactionPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTransactionPoolRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionCommandServer).GetTransactionPool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.TransactionCommand/GetTransactionPool",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionCommandServer).GetTransactionPool(ctx, req.(*GetTransactionPoolRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransactionCommand_GetMempoolInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMempoolInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionCommandServer).GetMempoolInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.TransactionCommand/GetMempoolInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionCommandServer).GetMempoolInfo(ctx, req.(*GetMempoolInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransactionCommand_GetMempoolFeeHistogram_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMempoolFeeHistogramRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionCommandServer).GetMempoolFeeHistogram(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.TransactionCommand/GetMempoolFeeHistogram",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionCommandServer).GetMempoolFeeHistogram(ctx, req.(*GetMempoolFeeHistogramRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransactionCommand_ListMempoolTransactions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMempoolTransactionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionCommandServer).ListMempoolTransactions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.TransactionCommand/ListMempoolTransactions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionCommandServer).ListMempoolTransactions(ctx, req.(*ListMempoolTransactionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransactionCommand_TestMempoolAccept_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TestMempoolAcceptRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionCommandServer).TestMempoolAccept(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.TransactionCommand/TestMempoolAccept",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionCommandServer).TestMempoolAccept(ctx, req.(*TestMempoolAcceptRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransactionCommand_CreatePSBT_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePSBTRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionCommandServer).CreatePSBT(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.TransactionCommand/CreatePSBT",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionCommandServer).CreatePSBT(ctx, req.(*CreatePSBTRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransactionCommand_SignPSBT_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignPSBTRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionCommandServer).SignPSBT(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.TransactionCommand/SignPSBT",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionCommandServer).SignPSBT(ctx, req.(*SignPSBTRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransactionCommand_MergePSBT_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MergePSBTRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionCommandServer).MergePSBT(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.TransactionCommand/MergePSBT",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionCommandServer).MergePSBT(ctx, req.(*MergePSBTRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransactionCommand_FinalizePSBT_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FinalizePSBTRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionCommandServer).FinalizePSBT(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.TransactionCommand/FinalizePSBT",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionCommandServer).FinalizePSBT(ctx, req.(*FinalizePSBTRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransactionCommand_SubscribeFilteredBlocks_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeFilteredBlocksRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(TransactionCommandServer).SubscribeFilteredBlocks(m, &transactionCommandSubscribeFilteredBlocksServer{stream})
+}
+
+type TransactionCommand_SubscribeFilteredBlocksServer interface {
+	Send(*FilteredBlock) error
+	grpc.ServerStream
+}
+
+type transactionCommandSubscribeFilteredBlocksServer struct {
+	grpc.ServerStream
+}
+
+func (x *transactionCommandSubscribeFilteredBlocksServer) Send(m *FilteredBlock) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+var _TransactionCommand_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "rpcpb.TransactionCommand",
+	HandlerType: (*TransactionCommandServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListUtxos",
+			Handler:    _TransactionCommand_ListUtxos_Handler,
+		},
+		{
+			MethodName: "FundTransaction",
+			Handler:    _TransactionCommand_FundTransaction_Handler,
+		},
+		{
+			MethodName: "SendTransaction",
+			Handler:    _TransactionCommand_SendTransaction_Handler,
+		},
+		{
+			MethodName: "CreateRawTransaction",
+			Handler:    _TransactionCommand_CreateRawTransaction_Handler,
+		},
+		{
+			MethodName: "SignRawTransaction",
+			Handler:    _TransactionCommand_SignRawTransaction_Handler,
+		},
+		{
+			MethodName: "GetRawTransaction",
+			Handler:    _TransactionCommand_GetRawTransaction_Handler,
+		},
+		{
+			MethodName: "GetTransactionDetail",
+			Handler:    _TransactionCommand_GetTransactionDetail_Handler,
+		},
+		{
+			MethodName: "GetBalance",
+			Handler:    _TransactionCommand_GetBalance_Handler,
+		},
+		{
+			MethodName: "GetTokenBalance",
+			Handler:    _TransactionCommand_GetTokenBalance_Handler,
+		},
+		{
+			MethodName: "ListTokens",
+			Handler:    _TransactionCommand_ListTokens_Handler,
+		},
+		{
+			MethodName: "GetTokenTransactions",
+			Handler:    _TransactionCommand_GetTokenTransactions_Handler,
+		},
+		{
+			MethodName: "GetFeePrice",
+			Handler:    _TransactionCommand_GetFeePrice_Handler,
+		},
+		{
+			MethodName: "EstimateFee",
+			Handler:    _TransactionCommand_EstimateFee_Handler,
+		},
+		{
+			MethodName: "GetTransactionPool",
+			Handler:    _TransactionCommand_GetTransactionPool_Handler,
+		},
+		{
+			MethodName: "GetMempoolInfo",
+			Handler:    _TransactionCommand_GetMempoolInfo_Handler,
+		},
+		{
+			MethodName: "GetMempoolFeeHistogram",
+			Handler:    _TransactionCommand_GetMempoolFeeHistogram_Handler,
+		},
+		{
+			MethodName: "ListMempoolTransactions",
+			Handler:    _TransactionCommand_ListMempoolTransactions_Handler,
+		},
+		{
+			MethodName: "TestMempoolAccept",
+			Handler:    _TransactionCommand_TestMempoolAccept_Handler,
+		},
+		{
+			MethodName: "CreatePSBT",
+			Handler:    _TransactionCommand_CreatePSBT_Handler,
+		},
+		{
+			MethodName: "SignPSBT",
+			Handler:    _TransactionCommand_SignPSBT_Handler,
+		},
+		{
+			MethodName: "MergePSBT",
+			Handler:    _TransactionCommand_MergePSBT_Handler,
+		},
+		{
+			MethodName: "FinalizePSBT",
+			Handler:    _TransactionCommand_FinalizePSBT_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "SubscribeFilteredBlocks",
+			Handler:       _TransactionCommand_SubscribeFilteredBlocks_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "transaction.proto",
+}
+
+func (m *ListUtxosRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListUtxosRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Addrs) > 0 {
+		for _, s := range m.Addrs {
+			dAtA[i] = 0xa
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if m.Wallet {
+		dAtA[i] = 0x10
+		i++
+		if m.Wallet {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	return i, nil
+}
+
+func (m *GetRawTransactionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetRawTransactionRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Hash) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintTransaction(dAtA, i, uint64(len(m.Hash)))
+		i += copy(dAtA[i:], m.Hash)
+	}
+	return i, nil
+}
+
+func (m *GetRawTransactionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetRawTransactionResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Tx != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintTransaction(dAtA, i, uint64(m.Tx.Size()))
+		n1, err := m.Tx.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n1
+	}
+	if len(m.Raw) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintTransaction(dAtA, i, uint64(len(m.Raw)))
+		i += copy(dAtA[i:], m.Raw)
+	}
+	if len(m.BlockHash) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintTransaction(dAtA, i, uint64(len(m.BlockHash)))
+		i += copy(dAtA[i:], m.BlockHash)
+	}
+	if m.BlockHeight != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintTransaction(dAtA, i, uint64(m.BlockHeight))
+	}
+	if m.Confirmations != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintTransaction(dAtA, i, uint64(m.Confirmations))
+	}
+	return i, nil
+}
+
+func (m *GetTransactionDetailRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetTransactionDetailRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Hash) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintTransaction(dAtA, i, uint64(len(m.Hash)))
+		i += copy(dAtA[i:], m.Hash)
+	}
+	return i, nil
+}
+
+func (m *TxInDetail) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TxInDetail) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.PrevOutPoint != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintTransaction(dAtA, i, uint64(m.PrevOutPoint.Size()))
+		n2, err := m.PrevOutPoint.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n2
+	}
+	if len(m.ScriptSig) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintTransaction(dAtA, i, uint64(len(m.ScriptSig)))
+		i += copy(dAtA[i:], m.ScriptSig)
+	}
+	if m.Sequence != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintTransaction(dAtA, i, uint64(m.Sequence))
+	}
+	if m.Value != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintTransaction(dAtA, i, uint64(m.Value))
+	}
+	if len(m.Addr) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintTransaction(dAtA, i, uint64(len(m.Addr)))
+		i += copy(dAtA[i:], m.Addr)
+	}
+	return i, nil
+}
+
+func (m *TxOutDetail) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TxOutDetail) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Value != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintTransaction(dAtA, i, uint64(m.Value))
+	}
+	if len(m.ScriptPubKey) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintTransaction(dAtA, i, uint64(len(m.ScriptPubKey)))
+		i += copy(dAtA[i:], m.ScriptPubKey)
+	}
+	if len(m.Addr) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintTransaction(dAtA, i, uint64(len(m.Addr)))
+		i += copy(dAtA[i:], m.Addr)
+	}
+	return i, nil
+}
+
+func (m *TransactionDetail) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TransactionDetail) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Hash) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintTransaction(dAtA, i, uint64(len(m.Hash)))
+		i += copy(dAtA[i:], m.Hash)
+	}
+	if m.Version != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintTransaction(dAtA, i, uint64(m.Version))
+	}
+	if len(m.Vin) > 0 {
+		for _, msg := range m.Vin {
+			dAtA[i] = 0x1a
+			i++
+			i = encodeVarintTransaction(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.Vout) > 0 {
+		for _, msg := range m.Vout {
+			dAtA[i] = 0x22
+			i++
+			i = encodeVarintTransaction(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.LockTime != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintTransaction(dAtA, i, uint64(m.LockTime))
+	}
+	if m.Fee != 0 {
+		dAtA[i] = 0x30
+		i++
+		i = encodeVarintTransaction(dAtA, i, uint64(m.Fee))
+	}
+	if m.TxSize != 0 {
+		dAtA[i] = 0x38
+		i++
+		i = encodeVarintTransaction(dAtA, i, uint64(m.TxSize))
+	}
+	if m.IsCoinbase {
+		dAtA[i] = 0x40
+		i++
+		if m.IsCoinbase {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	return i, nil
+}
+
+func (m *GetTransactionDetailResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetTransactionDetailResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Code != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintTransaction(dAtA, i, uint64(m.Code))
+	}
+	if len(m.Message) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintTransaction(dAtA, i, uint64(len(m.Message)))
+		i += copy(dAtA[i:], m.Message)
+	}
+	if m.Detail != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintTransaction(dAtA, i, uint64(m.Detail.Size()))
+		n3, err := m.Detail.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n3
+	}
+	if len(m.BlockHash) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintTransaction(dAtA, i, uint64(len(m.BlockHash)))
+		i += copy(dAtA[i:], m.BlockHash)
+	}
+	if m.BlockHeight != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintTransaction(dAtA, i, uint64(m.BlockHeight))
+	}
+	if m.Timestamp != 0 {
+		dAtA[i] = 0x30
+		i++
+		i = encodeVarintTransaction(dAtA, i, uint64(m.Timestamp))
+	}
+	if m.Confirmations != 0 {
+		dAtA[i] = 0x38
+		i++
+		i = encodeVarintTransaction(dAtA, i, uint64(m.Confirmations))
+	}
+	return i, nil
+}
+
+func (m *GetTransactionPoolRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetTransactionPoolRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	return i, nil
+}
+
+func (m *GetTransactionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetTransactionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Txs) > 0 {
+		for _, msg := range m.Txs {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintTransaction(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *GetMempoolInfoRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetMempoolInfoRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	return i, nil
+}
+
+func (m *GetMempoolInfoResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetMempoolInfoResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Code != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintTransaction(dAtA, i, uint64(m.Code))
+	}
+	if len(m.Message) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintTransaction(dAtA, i, uint64(len(m.Message)))
+		i += copy(dAtA[i:], m.Message)
+	}
+	if m.TxCount != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintTransaction(dAtA, i, uint64(m.TxCount))
+	}
+	if m.Bytes != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintTransaction(dAtA, i, uint64(m.Bytes))
+	}
+	if m.Orphans != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintTransaction(dAtA, i, uint64(m.Orphans))
+	}
+	if m.MinFeePerKb != 0 {
+		dAtA[i] = 0x30
+		i++
+		i = encodeVarintTransaction(dAtA, i, uint64(m.MinFeePerKb))
+	}
+	return i, nil
+}
+
+func (m *GetMempoolFeeHistogramRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetMempoolFeeHistogramRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Bounds) > 0 {
+		dAtA5 := make([]byte, len(m.Bounds)*10)
+		var j4 int
+		for _, num := range m.Bounds {
+			for num >= 1<<7 {
+				dAtA5[j4] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j4++
+			}
+			dAtA5[j4] = uint8(num)
+			j4++
+		}
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintTransaction(dAtA, i, uint64(j4))
+		i += copy(dAtA[i:], dAtA5[:j4])
+	}
+	return i, nil
+}
+
+func (m *FeeBucket) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeBucket) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.MinFeePerKb != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintTransaction(dAtA, i, uint64(m.MinFeePerKb))
+	}
+	if m.MaxFeePerKb != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintTransaction(dAtA, i, uint64(m.MaxFeePerKb))
+	}
+	if m.TxCount != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintTransaction(dAtA, i, uint64(m.TxCount))
+	}
+	if m.Bytes != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintTransaction(dAtA, i, uint64(m.Bytes))
+	}
+	if m.CumulativeBytes != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintTransaction(dAtA, i, uint64(m.CumulativeBytes))
+	}
+	return i, nil
+}
+
+func (m *GetMempoolFeeHistogramResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetMempoolFeeHistogramResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Code != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintTransaction(dAtA, i, uint64(m.Code))
+	}
+	if len(m.Message) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintTransaction(dAtA, i, uint64(len(m.Message)))
+		i += copy(dAtA[i:], m.Message)
+	}
+	if len(m.Buckets) > 0 {
+		for _, msg := range m.Buckets {
+			dAtA[i] = 0x1a
+			i++
+			i = encodeVarintTransaction(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *ListMempoolTransactionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListMempoolTransactionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Verbose {
+		dAtA[i] = 0x8
+		i++
+		if m.Verbose {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	return i, nil
+}
+
+func (m *MempoolEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MempoolEntry) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Hash) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintTransaction(dAtA, i, uint64(len(m.Hash)))
+		i += copy(dAtA[i:], m.Hash)
+	}
+	if m.Tx != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintTransaction(dAtA, i, uint64(m.Tx.Size()))
+		n6, err := m.Tx.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n6
+	}
+	if m.TxSize != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintTransaction(dAtA, i, uint64(m.TxSize))
+	}
+	if m.Fee != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintTransaction(dAtA, i, uint64(m.Fee))
+	}
+	if m.FeePerKb != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintTransaction(dAtA, i, uint64(m.FeePerKb))
+	}
+	if m.AddedTime != 0 {
+		dAtA[i] = 0x30
+		i++
+		i = encodeVarintTransaction(dAtA, i, uint64(m.AddedTime))
+	}
+	if m.Age != 0 {
+		dAtA[i] = 0x38
+		i++
+		i = encodeVarintTransaction(dAtA, i, uint64(m.Age))
+	}
+	if m.AncestorFee != 0 {
+		dAtA[i] = 0x40
+		i++
+		i = encodeVarintTransaction(dAtA, i, uint64(m.AncestorFee))
+	}
+	if m.AncestorSize != 0 {
+		dAtA[i] = 0x48
+		i++
+		i = encodeVarintTransaction(dAtA, i, uint64(m.AncestorSize))
+	}
+	if m.DescendantFee != 0 {
+		dAtA[i] = 0x50
+		i++
+		i = encodeVarintTransaction(dAtA, i, uint64(m.DescendantFee))
+	}
+	if m.DescendantSize != 0 {
+		dAtA[i] = 0x58
+		i++
+		i = encodeVarintTransaction(dAtA, i, uint64(m.DescendantSize))
+	}
+	return i, nil
+}
+
+func (m *TestMempoolAcceptRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TestMempoolAcceptRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Raw) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintTransaction(dAtA, i, uint64(len(m.Raw)))
+		i += copy(dAtA[i:], m.Raw)
+	}
+	return i, nil
+}
+
+func (m *TestMempoolAcceptResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TestMempoolAcceptResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Code != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintTransaction(dAtA, i, uint64(m.Code))
+	}
+	if len(m.Message) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintTransaction(dAtA, i, uint64(len(m.Message)))
+		i += copy(dAtA[i:], m.Message)
+	}
+	if len(m.Hash) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintTransaction(dAtA, i, uint64(len(m.Hash)))
+		i += copy(dAtA[i:], m.Hash)
+	}
+	if m.Allowed {
+		dAtA[i] = 0x20
+		i++
+		if m.Allowed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if len(m.RejectReason) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintTransaction(dAtA, i, uint64(len(m.RejectReason)))
+		i += copy(dAtA[i:], m.RejectReason)
+	}
+	if m.Fee != 0 {
+		dAtA[i] = 0x30
+		i++
+		i = encodeVarintTransaction(dAtA, i, uint64(m.Fee))
+	}
+	if m.TxSize != 0 {
+		dAtA[i] = 0x38
+		i++
+		i = encodeVarintTransaction(dAtA, i, uint64(m.TxSize))
+	}
+	if m.FeePerKb != 0 {
+		dAtA[i] = 0x40
+		i++
+		i = encodeVarintTransaction(dAtA, i, uint64(m.FeePerKb))
+	}
+	return i, nil
+}
+
+func (m *ListMempoolTransactionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListMempoolTransactionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Code != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintTransaction(dAtA, i, uint64(m.Code))
+	}
+	if len(m.Message) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintTransaction(dAtA, i, uint64(len(m.Message)))
+		i += copy(dAtA[i:], m.Message)
+	}
+	if len(m.Hashes) > 0 {
+		for _, s := range m.Hashes {
+			dAtA[i] = 0x1a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.Entries) > 0 {
+		for _, msg := range m.Entries {
+			dAtA[i] = 0x22
+			i++
+			i = encodeVarintTransaction(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *TokenAmount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TokenAmount) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Token != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintTransaction(dAtA, i, uint64(m.Token.Size()))
+		n7, err := m.Token.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n7
+	}
+	if m.Amount != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintTransaction(dAtA, i, uint64(m.Amount))
+	}
+	return i, nil
+}
+
+func (m *FundTransactionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FundTransactionRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Addr) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintTransaction(dAtA, i, uint64(len(m.Addr)))
+		i += copy(dAtA[i:], m.Addr)
+	}
+	if m.Amount != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintTransaction(dAtA, i, uint64(m.Amount))
+	}
+	if len(m.TokenBudgets) > 0 {
+		for _, msg := range m.TokenBudgets {
+			dAtA[i] = 0x1a
+			i++
+			i = encodeVarintTransaction(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *TxOutTarget) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TxOutTarget) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Addr) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintTransaction(dAtA, i, uint64(len(m.Addr)))
+		i += copy(dAtA[i:], m.Addr)
+	}
+	if m.Amount != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintTransaction(dAtA, i, uint64(m.Amount))
+	}
+	return i, nil
+}
+
+func (m *CreateRawTransactionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CreateRawTransactionRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.From) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintTransaction(dAtA, i, uint64(len(m.From)))
+		i += copy(dAtA[i:], m.From)
+	}
+	if len(m.Outputs) > 0 {
+		for _, msg := range m.Outputs {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintTransaction(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.ChangeAddr) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintTransaction(dAtA, i, uint64(len(m.ChangeAddr)))
+		i += copy(dAtA[i:], m.ChangeAddr)
+	}
+	if m.FeePerByte != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintTransaction(dAtA, i, uint64(m.FeePerByte))
+	}
+	if m.Replaceable {
+		dAtA[i] = 0x28
+		i++
+		if m.Replaceable {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	return i, nil
+}
+
+func (m *CreateRawTransactionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CreateRawTransactionResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Code != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintTransaction(dAtA, i, uint64(m.Code))
+	}
+	if len(m.Message) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintTransaction(dAtA, i, uint64(len(m.Message)))
+		i += copy(dAtA[i:], m.Message)
+	}
+	if m.Tx != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintTransaction(dAtA, i, uint64(m.Tx.Size()))
+		n8, err := m.Tx.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n8
+	}
+	if len(m.Utxos) > 0 {
+		for _, msg := range m.Utxos {
+			dAtA[i] = 0x22
+			i++
+			i = encodeVarintTransaction(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.Fee != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintTransaction(dAtA, i, uint64(m.Fee))
+	}
+	return i, nil
+}
+
+func (m *SignRawTransactionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SignRawTransactionRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Tx != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintTransaction(dAtA, i, uint64(m.Tx.Size()))
+		n9, err := m.Tx.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n9
+	}
+	return i, nil
+}
+
+func (m *SignRawTransactionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SignRawTransactionResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Code != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintTransaction(dAtA, i, uint64(m.Code))
+	}
+	if len(m.Message) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintTransaction(dAtA, i, uint64(len(m.Message)))
+		i += copy(dAtA[i:], m.Message)
+	}
+	if m.Tx != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintTransaction(dAtA, i, uint64(m.Tx.Size()))
+		n10, err := m.Tx.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n10
+	}
+	if m.Complete {
+		dAtA[i] = 0x20
+		i++
+		if m.Complete {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	return i, nil
+}
+
+func (m *SendTransactionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SendTransactionRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Tx != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintTransaction(dAtA, i, uint64(m.Tx.Size()))
+		n11, err := m.Tx.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n11
+	}
+	return i, nil
+}
+
+func (m *ListUtxosResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListUtxosResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Code != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintTransaction(dAtA, i, uint64(m.Code))
+	}
+	if len(m.Message) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintTransaction(dAtA, i, uint64(len(m.Message)))
+		i += copy(dAtA[i:], m.Message)
+	}
+	if m.Count != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintTransaction(dAtA, i, uint64(m.Count))
+	}
+	if len(m.Utxos) > 0 {
+		for _, msg := range m.Utxos {
+			dAtA[i] = 0x22
+			i++
+			i = encodeVarintTransaction(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *GetBalanceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetBalanceRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Addrs) > 0 {
+		for _, s := range m.Addrs {
+			dAtA[i] = 0xa
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if m.Wallet {
+		dAtA[i] = 0x10
+		i++
+		if m.Wallet {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	return i, nil
+}
+
+func (m *GetBalanceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetBalanceResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Code != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintTransaction(dAtA, i, uint64(m.Code))
+	}
+	if len(m.Message) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintTransaction(dAtA, i, uint64(len(m.Message)))
+		i += copy(dAtA[i:], m.Message)
+	}
+	if len(m.Balances) > 0 {
+		for k, _ := range m.Balances {
+			dAtA[i] = 0x1a
+			i++
+			v := m.Balances[k]
+			mapSize := 1 + len(k) + sovTransaction(uint64(len(k))) + 1 + sovTransaction(uint64(v))
+			i = encodeVarintTransaction(dAtA, i, uint64(mapSize))
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintTransaction(dAtA, i, uint64(len(k)))
+			i += copy(dAtA[i:], k)
+			dAtA[i] = 0x10
+			i++
+			i = encodeVarintTransaction(dAtA, i, uint64(v))
+		}
+	}
+	return i, nil
+}
+
+func (m *ListTokensRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListTokensRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Offset != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintTransaction(dAtA, i, uint64(m.Offset))
+	}
+	if m.Limit != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintTransaction(dAtA, i, uint64(m.Limit))
+	}
+	return i, nil
+}
+
+func (m *ListTokensResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListTokensResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Code != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintTransaction(dAtA, i, uint64(m.Code))
+	}
+	if len(m.Message) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintTransaction(dAtA, i, uint64(len(m.Message)))
+		i += copy(dAtA[i:], m.Message)
+	}
+	if m.Total != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintTransaction(dAtA, i, uint64(m.Total))
+	}
+	if len(m.Tokens) > 0 {
+		for _, msg := range m.Tokens {
+			dAtA[i] = 0x22
+			i++
+			i = encodeVarintTransaction(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *TokenInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TokenInfo) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Token != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintTransaction(dAtA, i, uint64(m.Token.Size()))
+		n12, err := m.Token.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n12
+	}
+	if len(m.Name) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintTransaction(dAtA, i, uint64(len(m.Name)))
+		i += copy(dAtA[i:], m.Name)
+	}
+	if m.TotalSupply != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintTransaction(dAtA, i, uint64(m.TotalSupply))
+	}
+	if len(m.Issuer) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintTransaction(dAtA, i, uint64(len(m.Issuer)))
+		i += copy(dAtA[i:], m.Issuer)
+	}
+	if m.Height != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintTransaction(dAtA, i, uint64(m.Height))
+	}
+	return i, nil
+}
+
+func (m *GetTokenTransactionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetTokenTransactionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Token != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintTransaction(dAtA, i, uint64(m.Token.Size()))
+		n13, err := m.Token.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n13
+	}
+	if m.Offset != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintTransaction(dAtA, i, uint64(m.Offset))
+	}
+	if m.Limit != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintTransaction(dAtA, i, uint64(m.Limit))
+	}
+	return i, nil
+}
+
+func (m *GetTokenTransactionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetTokenTransactionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Code != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintTransaction(dAtA, i, uint64(m.Code))
+	}
+	if len(m.Message) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintTransaction(dAtA, i, uint64(len(m.Message)))
+		i += copy(dAtA[i:], m.Message)
+	}
+	if m.Total != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintTransaction(dAtA, i, uint64(m.Total))
+	}
+	if len(m.Txs) > 0 {
+		for _, msg := range m.Txs {
+			dAtA[i] = 0x22
+			i++
+			i = encodeVarintTransaction(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *TokenTransaction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TokenTransaction) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Tx != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintTransaction(dAtA, i, uint64(m.Tx.Size()))
+		n14, err := m.Tx.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n14
+	}
+	if len(m.Hash) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintTransaction(dAtA, i, uint64(len(m.Hash)))
+		i += copy(dAtA[i:], m.Hash)
+	}
+	if len(m.BlockHash) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintTransaction(dAtA, i, uint64(len(m.BlockHash)))
+		i += copy(dAtA[i:], m.BlockHash)
+	}
+	if m.BlockHeight != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintTransaction(dAtA, i, uint64(m.BlockHeight))
+	}
+	if m.Timestamp != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintTransaction(dAtA, i, uint64(m.Timestamp))
+	}
+	return i, nil
+}
+
+func (m *GetTokenBalanceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetTokenBalanceRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Addrs) > 0 {
+		for _, s := range m.Addrs {
+			dAtA[i] = 0xa
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if m.Token != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintTransaction(dAtA, i, uint64(m.Token.Size()))
+		n15, err := m.Token.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n15
+	}
+	return i, nil
+}
+
+func (m *GetTokenBalanceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetTokenBalanceResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Code != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintTransaction(dAtA, i, uint64(m.Code))
+	}
+	if len(m.Message) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintTransaction(dAtA, i, uint64(len(m.Message)))
+		i += copy(dAtA[i:], m.Message)
+	}
+	if len(m.Balances) > 0 {
+		for k, _ := range m.Balances {
+			dAtA[i] = 0x1a
+			i++
+			v := m.Balances[k]
+			mapSize := 1 + len(k) + sovTransaction(uint64(len(k))) + 1 + sovTransaction(uint64(v))
+			i = encodeVarintTransaction(dAtA, i, uint64(mapSize))
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintTransaction(dAtA, i, uint64(len(k)))
+			i += copy(dAtA[i:], k)
+			dAtA[i] = 0x10
+			i++
+			i = encodeVarintTransaction(dAtA, i, uint64(v))
+		}
+	}
+	return i, nil
+}
+
+func (m *GetFeePriceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetFeePriceRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	return i, nil
+}
+
+func (m *GetFeePriceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetFeePriceResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.BoxPerByte != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintTransaction(dAtA, i, uint64(m.BoxPerByte))
+	}
+	return i, nil
+}
+
+func (m *EstimateFeeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EstimateFeeRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.TargetBlocks != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintTransaction(dAtA, i, uint64(m.TargetBlocks))
+	}
+	return i, nil
+}
+
+func (m *EstimateFeeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EstimateFeeResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Code != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintTransaction(dAtA, i, uint64(m.Code))
+	}
+	if len(m.Message) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintTransaction(dAtA, i, uint64(len(m.Message)))
+		i += copy(dAtA[i:], m.Message)
+	}
+	if m.BoxPerByte != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintTransaction(dAtA, i, uint64(m.BoxPerByte))
+	}
+	if m.SampleSize != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintTransaction(dAtA, i, uint64(m.SampleSize))
+	}
+	return i, nil
+}
+
+func (m *CreatePSBTRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CreatePSBTRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Tx != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintTransaction(dAtA, i, uint64(m.Tx.Size()))
+		n16, err := m.Tx.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n16
+	}
+	if len(m.RedeemScripts) > 0 {
+		for k, _ := range m.RedeemScripts {
+			dAtA[i] = 0x12
+			i++
+			v := m.RedeemScripts[k]
+			byteSize := 0
+			if len(v) > 0 {
+				byteSize = 1 + len(v) + sovTransaction(uint64(len(v)))
+			}
+			mapSize := 1 + sovTransaction(uint64(k)) + byteSize
+			i = encodeVarintTransaction(dAtA, i, uint64(mapSize))
+			dAtA[i] = 0x8
+			i++
+			i = encodeVarintTransaction(dAtA, i, uint64(k))
+			if len(v) > 0 {
+				dAtA[i] = 0x12
+				i++
+				i = encodeVarintTransaction(dAtA, i, uint64(len(v)))
+				i += copy(dAtA[i:], v)
+			}
+		}
+	}
+	return i, nil
+}
+
+func (m *PSBTResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PSBTResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Code != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintTransaction(dAtA, i, uint64(m.Code))
+	}
+	if len(m.Message) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintTransaction(dAtA, i, uint64(len(m.Message)))
+		i += copy(dAtA[i:], m.Message)
+	}
+	if len(m.Psbt) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintTransaction(dAtA, i, uint64(len(m.Psbt)))
+		i += copy(dAtA[i:], m.Psbt)
+	}
+	return i, nil
+}
+
+func (m *SignPSBTRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SignPSBTRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Psbt) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintTransaction(dAtA, i, uint64(len(m.Psbt)))
+		i += copy(dAtA[i:], m.Psbt)
+	}
+	return i, nil
+}
+
+func (m *SignPSBTResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SignPSBTResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Code != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintTransaction(dAtA, i, uint64(m.Code))
+	}
+	if len(m.Message) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintTransaction(dAtA, i, uint64(len(m.Message)))
+		i += copy(dAtA[i:], m.Message)
+	}
+	if len(m.Psbt) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintTransaction(dAtA, i, uint64(len(m.Psbt)))
+		i += copy(dAtA[i:], m.Psbt)
+	}
+	if m.Signed != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintTransaction(dAtA, i, uint64(m.Signed))
+	}
+	return i, nil
+}
+
+func (m *MergePSBTRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MergePSBTRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Psbts) > 0 {
+		for _, s := range m.Psbts {
+			dAtA[i] = 0xa
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	return i, nil
+}
+
+func (m *FinalizePSBTRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FinalizePSBTRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Psbt) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintTransaction(dAtA, i, uint64(len(m.Psbt)))
+		i += copy(dAtA[i:], m.Psbt)
+	}
+	return i, nil
+}
+
+func (m *FinalizePSBTResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FinalizePSBTResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Code != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintTransaction(dAtA, i, uint64(m.Code))
+	}
+	if len(m.Message) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintTransaction(dAtA, i, uint64(len(m.Message)))
+		i += copy(dAtA[i:], m.Message)
+	}
+	if len(m.Psbt) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintTransaction(dAtA, i, uint64(len(m.Psbt)))
+		i += copy(dAtA[i:], m.Psbt)
+	}
+	if m.Complete {
+		dAtA[i] = 0x20
+		i++
+		if m.Complete {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.Tx != nil {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintTransaction(dAtA, i, uint64(m.Tx.Size()))
+		n17, err := m.Tx.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n17
+	}
+	return i, nil
+}
+
+func (m *SubscribeFilteredBlocksRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SubscribeFilteredBlocksRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Filter) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintTransaction(dAtA, i, uint64(len(m.Filter)))
+		i += copy(dAtA[i:], m.Filter)
+	}
+	return i, nil
+}
+
+func (m *MatchedTransaction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MatchedTransaction) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Hash) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintTransaction(dAtA, i, uint64(len(m.Hash)))
+		i += copy(dAtA[i:], m.Hash)
+	}
+	if m.Tx != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintTransaction(dAtA, i, uint64(m.Tx.Size()))
+		n18, err := m.Tx.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n18
+	}
+	if m.Index != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintTransaction(dAtA, i, uint64(m.Index))
+	}
+	if len(m.MerkleBranch) > 0 {
+		for _, b := range m.MerkleBranch {
+			dAtA[i] = 0x22
+			i++
+			i = encodeVarintTransaction(dAtA, i, uint64(len(b)))
+			i += copy(dAtA[i:], b)
+		}
+	}
+	return i, nil
+}
+
+func (m *FilteredBlock) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FilteredBlock) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Code != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintTransaction(dAtA, i, uint64(m.Code))
+	}
+	if len(m.Message) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintTransaction(dAtA, i, uint64(len(m.Message)))
+		i += copy(dAtA[i:], m.Message)
+	}
+	if len(m.Hash) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintTransaction(dAtA, i, uint64(len(m.Hash)))
+		i += copy(dAtA[i:], m.Hash)
+	}
+	if m.Height != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintTransaction(dAtA, i, uint64(m.Height))
+	}
+	if m.Header != nil {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintTransaction(dAtA, i, uint64(m.Header.Size()))
+		n19, err := m.Header.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n19
+	}
+	if m.Connected {
+		dAtA[i] = 0x30
+		i++
+		if m.Connected {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.TotalTxs != 0 {
+		dAtA[i] = 0x38
+		i++
+		i = encodeVarintTransaction(dAtA, i, uint64(m.TotalTxs))
+	}
+	if len(m.Txs) > 0 {
+		for _, msg := range m.Txs {
+			dAtA[i] = 0x42
+			i++
+			i = encodeVarintTransaction(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func encodeVarintTransaction(dAtA []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return offset + 1
+}
+func (m *ListUtxosRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Addrs) > 0 {
+		for _, s := range m.Addrs {
+			l = len(s)
+			n += 1 + l + sovTransaction(uint64(l))
+		}
+	}
+	if m.Wallet {
+		n += 2
+	}
+	return n
+}
+
+func (m *GetRawTransactionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovTransaction(uint64(l))
+	}
+	return n
+}
+
+func (m *GetRawTransactionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Tx != nil {
+		l = m.Tx.Size()
+		n += 1 + l + sovTransaction(uint64(l))
+	}
+	l = len(m.Raw)
+	if l > 0 {
+		n += 1 + l + sovTransaction(uint64(l))
+	}
+	l = len(m.BlockHash)
+	if l > 0 {
+		n += 1 + l + sovTransaction(uint64(l))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovTransaction(uint64(m.BlockHeight))
+	}
+	if m.Confirmations != 0 {
+		n += 1 + sovTransaction(uint64(m.Confirmations))
+	}
+	return n
+}
+
+func (m *GetTransactionDetailRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovTransaction(uint64(l))
+	}
+	return n
+}
+
+func (m *TxInDetail) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PrevOutPoint != nil {
+		l = m.PrevOutPoint.Size()
+		n += 1 + l + sovTransaction(uint64(l))
+	}
+	l = len(m.ScriptSig)
+	if l > 0 {
+		n += 1 + l + sovTransaction(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovTransaction(uint64(m.Sequence))
+	}
+	if m.Value != 0 {
+		n += 1 + sovTransaction(uint64(m.Value))
+	}
+	l = len(m.Addr)
+	if l > 0 {
+		n += 1 + l + sovTransaction(uint64(l))
+	}
+	return n
+}
+
+func (m *TxOutDetail) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Value != 0 {
+		n += 1 + sovTransaction(uint64(m.Value))
+	}
+	l = len(m.ScriptPubKey)
+	if l > 0 {
+		n += 1 + l + sovTransaction(uint64(l))
+	}
+	l = len(m.Addr)
+	if l > 0 {
+		n += 1 + l + sovTransaction(uint64(l))
+	}
+	return n
+}
+
+func (m *TransactionDetail) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovTransaction(uint64(l))
+	}
+	if m.Version != 0 {
+		n += 1 + sovTransaction(uint64(m.Version))
+	}
+	if len(m.Vin) > 0 {
+		for _, e := range m.Vin {
+			l = e.Size()
+			n += 1 + l + sovTransaction(uint64(l))
+		}
+	}
+	if len(m.Vout) > 0 {
+		for _, e := range m.Vout {
+			l = e.Size()
+			n += 1 + l + sovTransaction(uint64(l))
+		}
+	}
+	if m.LockTime != 0 {
+		n += 1 + sovTransaction(uint64(m.LockTime))
+	}
+	if m.Fee != 0 {
+		n += 1 + sovTransaction(uint64(m.Fee))
+	}
+	if m.TxSize != 0 {
+		n += 1 + sovTransaction(uint64(m.TxSize))
+	}
+	if m.IsCoinbase {
+		n += 2
+	}
+	return n
+}
+
+func (m *GetTransactionDetailResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Code != 0 {
+		n += 1 + sovTransaction(uint64(m.Code))
+	}
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovTransaction(uint64(l))
+	}
+	if m.Detail != nil {
+		l = m.Detail.Size()
+		n += 1 + l + sovTransaction(uint64(l))
+	}
+	l = len(m.BlockHash)
+	if l > 0 {
+		n += 1 + l + sovTransaction(uint64(l))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovTransaction(uint64(m.BlockHeight))
+	}
+	if m.Timestamp != 0 {
+		n += 1 + sovTransaction(uint64(m.Timestamp))
+	}
+	if m.Confirmations != 0 {
+		n += 1 + sovTransaction(uint64(m.Confirmations))
+	}
+	return n
+}
+
+func (m *GetTransactionPoolRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *GetTransactionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Txs) > 0 {
+		for _, e := range m.Txs {
+			l = e.Size()
+			n += 1 + l + sovTransaction(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GetMempoolInfoRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *GetMempoolInfoResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Code != 0 {
+		n += 1 + sovTransaction(uint64(m.Code))
+	}
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovTransaction(uint64(l))
+	}
+	if m.TxCount != 0 {
+		n += 1 + sovTransaction(uint64(m.TxCount))
+	}
+	if m.Bytes != 0 {
+		n += 1 + sovTransaction(uint64(m.Bytes))
+	}
+	if m.Orphans != 0 {
+		n += 1 + sovTransaction(uint64(m.Orphans))
+	}
+	if m.MinFeePerKb != 0 {
+		n += 1 + sovTransaction(uint64(m.MinFeePerKb))
+	}
+	return n
+}
+
+func (m *GetMempoolFeeHistogramRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Bounds) > 0 {
+		l = 0
+		for _, e := range m.Bounds {
+			l += sovTransaction(uint64(e))
+		}
+		n += 1 + sovTransaction(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *FeeBucket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MinFeePerKb != 0 {
+		n += 1 + sovTransaction(uint64(m.MinFeePerKb))
+	}
+	if m.MaxFeePerKb != 0 {
+		n += 1 + sovTransaction(uint64(m.MaxFeePerKb))
+	}
+	if m.TxCount != 0 {
+		n += 1 + sovTransaction(uint64(m.TxCount))
+	}
+	if m.Bytes != 0 {
+		n += 1 + sovTransaction(uint64(m.Bytes))
+	}
+	if m.CumulativeBytes != 0 {
+		n += 1 + sovTransaction(uint64(m.CumulativeBytes))
+	}
+	return n
+}
+
+func (m *GetMempoolFeeHistogramResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Code != 0 {
+		n += 1 + sovTransaction(uint64(m.Code))
+	}
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovTransaction(uint64(l))
+	}
+	if len(m.Buckets) > 0 {
+		for _, e := range m.Buckets {
+			l = e.Size()
+			n += 1 + l + sovTransaction(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ListMempoolTransactionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Verbose {
+		n += 2
+	}
+	return n
+}
+
+func (m *MempoolEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovTransaction(uint64(l))
+	}
+	if m.Tx != nil {
+		l = m.Tx.Size()
+		n += 1 + l + sovTransaction(uint64(l))
+	}
+	if m.TxSize != 0 {
+		n += 1 + sovTransaction(uint64(m.TxSize))
+	}
+	if m.Fee != 0 {
+		n += 1 + sovTransaction(uint64(m.Fee))
+	}
+	if m.FeePerKb != 0 {
+		n += 1 + sovTransaction(uint64(m.FeePerKb))
+	}
+	if m.AddedTime != 0 {
+		n += 1 + sovTransaction(uint64(m.AddedTime))
+	}
+	if m.Age != 0 {
+		n += 1 + sovTransaction(uint64(m.Age))
+	}
+	if m.AncestorFee != 0 {
+		n += 1 + sovTransaction(uint64(m.AncestorFee))
+	}
+	if m.AncestorSize != 0 {
+		n += 1 + sovTransaction(uint64(m.AncestorSize))
+	}
+	if m.DescendantFee != 0 {
+		n += 1 + sovTransaction(uint64(m.DescendantFee))
+	}
+	if m.DescendantSize != 0 {
+		n += 1 + sovTransaction(uint64(m.DescendantSize))
+	}
+	return n
+}
+
+func (m *TestMempoolAcceptRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Raw)
+	if l > 0 {
+		n += 1 + l + sovTransaction(uint64(l))
+	}
+	return n
+}
+
+func (m *TestMempoolAcceptResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Code != 0 {
+		n += 1 + sovTransaction(uint64(m.Code))
+	}
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovTransaction(uint64(l))
+	}
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovTransaction(uint64(l))
+	}
+	if m.Allowed {
+		n += 2
+	}
+	l = len(m.RejectReason)
+	if l > 0 {
+		n += 1 + l + sovTransaction(uint64(l))
+	}
+	if m.Fee != 0 {
+		n += 1 + sovTransaction(uint64(m.Fee))
+	}
+	if m.TxSize != 0 {
+		n += 1 + sovTransaction(uint64(m.TxSize))
+	}
+	if m.FeePerKb != 0 {
+		n += 1 + sovTransaction(uint64(m.FeePerKb))
+	}
+	return n
+}
+
+func (m *ListMempoolTransactionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Code != 0 {
+		n += 1 + sovTransaction(uint64(m.Code))
+	}
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovTransaction(uint64(l))
+	}
+	if len(m.Hashes) > 0 {
+		for _, s := range m.Hashes {
+			l = len(s)
+			n += 1 + l + sovTransaction(uint64(l))
+		}
+	}
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovTransaction(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *TokenAmount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Token != nil {
+		l = m.Token.Size()
+		n += 1 + l + sovTransaction(uint64(l))
+	}
+	if m.Amount != 0 {
+		n += 1 + sovTransaction(uint64(m.Amount))
+	}
+	return n
+}
+
+func (m *FundTransactionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Addr)
+	if l > 0 {
+		n += 1 + l + sovTransaction(uint64(l))
+	}
+	if m.Amount != 0 {
+		n += 1 + sovTransaction(uint64(m.Amount))
+	}
+	if len(m.TokenBudgets) > 0 {
+		for _, e := range m.TokenBudgets {
+			l = e.Size()
+			n += 1 + l + sovTransaction(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *TxOutTarget) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Addr)
+	if l > 0 {
+		n += 1 + l + sovTransaction(uint64(l))
+	}
+	if m.Amount != 0 {
+		n += 1 + sovTransaction(uint64(m.Amount))
+	}
+	return n
+}
+
+func (m *CreateRawTransactionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.From)
+	if l > 0 {
+		n += 1 + l + sovTransaction(uint64(l))
+	}
+	if len(m.Outputs) > 0 {
+		for _, e := range m.Outputs {
+			l = e.Size()
+			n += 1 + l + sovTransaction(uint64(l))
+		}
+	}
+	l = len(m.ChangeAddr)
+	if l > 0 {
+		n += 1 + l + sovTransaction(uint64(l))
+	}
+	if m.FeePerByte != 0 {
+		n += 1 + sovTransaction(uint64(m.FeePerByte))
+	}
+	if m.Replaceable {
+		n += 2
+	}
+	return n
+}
+
+func (m *CreateRawTransactionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Code != 0 {
+		n += 1 + sovTransaction(uint64(m.Code))
+	}
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovTransaction(uint64(l))
+	}
+	if m.Tx != nil {
+		l = m.Tx.Size()
+		n += 1 + l + sovTransaction(uint64(l))
+	}
+	if len(m.Utxos) > 0 {
+		for _, e := range m.Utxos {
+			l = e.Size()
+			n += 1 + l + sovTransaction(uint64(l))
+		}
+	}
+	if m.Fee != 0 {
+		n += 1 + sovTransaction(uint64(m.Fee))
+	}
+	return n
+}
+
+func (m *SignRawTransactionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Tx != nil {
+		l = m.Tx.Size()
+		n += 1 + l + sovTransaction(uint64(l))
+	}
+	return n
+}
+
+func (m *SignRawTransactionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Code != 0 {
+		n += 1 + sovTransaction(uint64(m.Code))
+	}
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovTransaction(uint64(l))
+	}
+	if m.Tx != nil {
+		l = m.Tx.Size()
+		n += 1 + l + sovTransaction(uint64(l))
+	}
+	if m.Complete {
+		n += 2
+	}
+	return n
+}
+
+func (m *SendTransactionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Tx != nil {
+		l = m.Tx.Size()
+		n += 1 + l + sovTransaction(uint64(l))
+	}
+	return n
+}
+
+func (m *ListUtxosResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Code != 0 {
+		n += 1 + sovTransaction(uint64(m.Code))
+	}
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovTransaction(uint64(l))
+	}
+	if m.Count != 0 {
+		n += 1 + sovTransaction(uint64(m.Count))
+	}
+	if len(m.Utxos) > 0 {
+		for _, e := range m.Utxos {
+			l = e.Size()
+			n += 1 + l + sovTransaction(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GetBalanceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Addrs) > 0 {
+		for _, s := range m.Addrs {
+			l = len(s)
+			n += 1 + l + sovTransaction(uint64(l))
+		}
+	}
+	if m.Wallet {
+		n += 2
+	}
+	return n
+}
+
+func (m *GetBalanceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Code != 0 {
+		n += 1 + sovTransaction(uint64(m.Code))
+	}
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovTransaction(uint64(l))
+	}
+	if len(m.Balances) > 0 {
+		for k, v := range m.Balances {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovTransaction(uint64(len(k))) + 1 + sovTransaction(uint64(v))
+			n += mapEntrySize + 1 + sovTransaction(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *ListTokensRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Offset != 0 {
+		n += 1 + sovTransaction(uint64(m.Offset))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovTransaction(uint64(m.Limit))
+	}
+	return n
+}
+
+func (m *ListTokensResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Code != 0 {
+		n += 1 + sovTransaction(uint64(m.Code))
+	}
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovTransaction(uint64(l))
+	}
+	if m.Total != 0 {
+		n += 1 + sovTransaction(uint64(m.Total))
+	}
+	if len(m.Tokens) > 0 {
+		for _, e := range m.Tokens {
+			l = e.Size()
+			n += 1 + l + sovTransaction(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *TokenInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Token != nil {
+		l = m.Token.Size()
+		n += 1 + l + sovTransaction(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTransaction(uint64(l))
+	}
+	if m.TotalSupply != 0 {
+		n += 1 + sovTransaction(uint64(m.TotalSupply))
+	}
+	l = len(m.Issuer)
+	if l > 0 {
+		n += 1 + l + sovTransaction(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovTransaction(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *GetTokenTransactionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Token != nil {
+		l = m.Token.Size()
+		n += 1 + l + sovTransaction(uint64(l))
+	}
+	if m.Offset != 0 {
+		n += 1 + sovTransaction(uint64(m.Offset))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovTransaction(uint64(m.Limit))
+	}
+	return n
+}
+
+func (m *GetTokenTransactionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Code != 0 {
+		n += 1 + sovTransaction(uint64(m.Code))
+	}
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovTransaction(uint64(l))
+	}
+	if m.Total != 0 {
+		n += 1 + sovTransaction(uint64(m.Total))
+	}
+	if len(m.Txs) > 0 {
+		for _, e := range m.Txs {
+			l = e.Size()
+			n += 1 + l + sovTransaction(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *TokenTransaction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Tx != nil {
+		l = m.Tx.Size()
+		n += 1 + l + sovTransaction(uint64(l))
+	}
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovTransaction(uint64(l))
+	}
+	l = len(m.BlockHash)
+	if l > 0 {
+		n += 1 + l + sovTransaction(uint64(l))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovTransaction(uint64(m.BlockHeight))
+	}
+	if m.Timestamp != 0 {
+		n += 1 + sovTransaction(uint64(m.Timestamp))
+	}
+	return n
+}
+
+func (m *GetTokenBalanceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Addrs) > 0 {
+		for _, s := range m.Addrs {
+			l = len(s)
+			n += 1 + l + sovTransaction(uint64(l))
+		}
+	}
+	if m.Token != nil {
+		l = m.Token.Size()
+		n += 1 + l + sovTransaction(uint64(l))
+	}
+	return n
+}
+
+func (m *GetTokenBalanceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Code != 0 {
+		n += 1 + sovTransaction(uint64(m.Code))
+	}
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovTransaction(uint64(l))
+	}
+	if len(m.Balances) > 0 {
+		for k, v := range m.Balances {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovTransaction(uint64(len(k))) + 1 + sovTransaction(uint64(v))
+			n += mapEntrySize + 1 + sovTransaction(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *GetFeePriceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *GetFeePriceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BoxPerByte != 0 {
+		n += 1 + sovTransaction(uint64(m.BoxPerByte))
+	}
+	return n
+}
+
+func (m *EstimateFeeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TargetBlocks != 0 {
+		n += 1 + sovTransaction(uint64(m.TargetBlocks))
+	}
+	return n
+}
+
+func (m *EstimateFeeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Code != 0 {
+		n += 1 + sovTransaction(uint64(m.Code))
+	}
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovTransaction(uint64(l))
+	}
+	if m.BoxPerByte != 0 {
+		n += 1 + sovTransaction(uint64(m.BoxPerByte))
+	}
+	if m.SampleSize != 0 {
+		n += 1 + sovTransaction(uint64(m.SampleSize))
+	}
+	return n
+}
+
+func (m *CreatePSBTRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Tx != nil {
+		l = m.Tx.Size()
+		n += 1 + l + sovTransaction(uint64(l))
+	}
+	if len(m.RedeemScripts) > 0 {
+		for k, v := range m.RedeemScripts {
+			_ = k
+			_ = v
+			l = 0
+			if len(v) > 0 {
+				l = 1 + len(v) + sovTransaction(uint64(len(v)))
+			}
+			mapEntrySize := 1 + sovTransaction(uint64(k)) + l
+			n += mapEntrySize + 1 + sovTransaction(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *PSBTResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Code != 0 {
+		n += 1 + sovTransaction(uint64(m.Code))
+	}
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovTransaction(uint64(l))
+	}
+	l = len(m.Psbt)
+	if l > 0 {
+		n += 1 + l + sovTransaction(uint64(l))
+	}
+	return n
+}
+
+func (m *SignPSBTRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Psbt)
+	if l > 0 {
+		n += 1 + l + sovTransaction(uint64(l))
+	}
+	return n
+}
+
+func (m *SignPSBTResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Code != 0 {
+		n += 1 + sovTransaction(uint64(m.Code))
+	}
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovTransaction(uint64(l))
+	}
+	l = len(m.Psbt)
+	if l > 0 {
+		n += 1 + l + sovTransaction(uint64(l))
+	}
+	if m.Signed != 0 {
+		n += 1 + sovTransaction(uint64(m.Signed))
+	}
+	return n
+}
+
+func (m *MergePSBTRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Psbts) > 0 {
+		for _, s := range m.Psbts {
+			l = len(s)
+			n += 1 + l + sovTransaction(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *FinalizePSBTRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Psbt)
+	if l > 0 {
+		n += 1 + l + sovTransaction(uint64(l))
+	}
+	return n
+}
+
+func (m *FinalizePSBTResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Code != 0 {
+		n += 1 + sovTransaction(uint64(m.Code))
+	}
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovTransaction(uint64(l))
+	}
+	l = len(m.Psbt)
+	if l > 0 {
+		n += 1 + l + sovTransaction(uint64(l))
+	}
+	if m.Complete {
+		n += 2
+	}
+	if m.Tx != nil {
+		l = m.Tx.Size()
+		n += 1 + l + sovTransaction(uint64(l))
+	}
+	return n
+}
+
+func (m *SubscribeFilteredBlocksRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Filter)
+	if l > 0 {
+		n += 1 + l + sovTransaction(uint64(l))
+	}
+	return n
+}
+
+func (m *MatchedTransaction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovTransaction(uint64(l))
+	}
+	if m.Tx != nil {
+		l = m.Tx.Size()
+		n += 1 + l + sovTransaction(uint64(l))
+	}
+	if m.Index != 0 {
+		n += 1 + sovTransaction(uint64(m.Index))
+	}
+	if len(m.MerkleBranch) > 0 {
+		for _, b := range m.MerkleBranch {
+			l = len(b)
+			n += 1 + l + sovTransaction(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *FilteredBlock) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Code != 0 {
+		n += 1 + sovTransaction(uint64(m.Code))
+	}
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovTransaction(uint64(l))
+	}
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovTransaction(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovTransaction(uint64(m.Height))
+	}
+	if m.Header != nil {
+		l = m.Header.Size()
+		n += 1 + l + sovTransaction(uint64(l))
+	}
+	if m.Connected {
+		n += 2
+	}
+	if m.TotalTxs != 0 {
+		n += 1 + sovTransaction(uint64(m.TotalTxs))
+	}
+	if len(m.Txs) > 0 {
+		for _, e := range m.Txs {
+			l = e.Size()
+			n += 1 + l + sovTransaction(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovTransaction(x uint64) (n int) {
+	for {
+		n++
+		x >>= 7
+		if x == 0 {
+			break
+		}
+	}
+	return n
+}
+func sozTransaction(x uint64) (n int) {
+	return sovTransaction(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ListUtxosRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTransaction
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListUtxosRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListUtxosRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addrs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addrs = append(m.Addrs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Wallet", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Wallet = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTransaction(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetRawTransactionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTransaction
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetRawTransactionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetRawTransactionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = append(m.Hash[:0], dAtA[iNdEx:postIndex]...)
+			if m.Hash == nil {
+				m.Hash = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTransaction(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetRawTransactionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTransaction
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetRawTransactionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetRawTransactionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tx", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Tx == nil {
+				m.Tx = &pb.Transaction{}
+			}
+			if err := m.Tx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Raw", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Raw = append(m.Raw[:0], dAtA[iNdEx:postIndex]...)
+			if m.Raw == nil {
+				m.Raw = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Confirmations", wireType)
+			}
+			m.Confirmations = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Confirmations |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTransaction(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetTransactionDetailRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTransaction
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetTransactionDetailRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetTransactionDetailRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTransaction(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TxInDetail) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTransaction
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TxInDetail: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TxInDetail: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrevOutPoint", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PrevOutPoint == nil {
+				m.PrevOutPoint = &pb.OutPoint{}
+			}
+			if err := m.PrevOutPoint.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScriptSig", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScriptSig = append(m.ScriptSig[:0], dAtA[iNdEx:postIndex]...)
+			if m.ScriptSig == nil {
+				m.ScriptSig = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			m.Value = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Value |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTransaction(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TxOutDetail) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTransaction
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TxOutDetail: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TxOutDetail: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			m.Value = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Value |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScriptPubKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScriptPubKey = append(m.ScriptPubKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.ScriptPubKey == nil {
+				m.ScriptPubKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTransaction(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TransactionDetail) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTransaction
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TransactionDetail: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TransactionDetail: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Vin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Vin = append(m.Vin, &TxInDetail{})
+			if err := m.Vin[len(m.Vin)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Vout", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Vout = append(m.Vout, &TxOutDetail{})
+			if err := m.Vout[len(m.Vout)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockTime", wireType)
+			}
+			m.LockTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LockTime |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			m.Fee = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Fee |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxSize", wireType)
+			}
+			m.TxSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TxSize |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsCoinbase", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsCoinbase = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTransaction(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetTransactionDetailResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTransaction
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetTransactionDetailResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetTransactionDetailResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			m.Code = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Code |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Detail", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Detail == nil {
+				m.Detail = &TransactionDetail{}
+			}
+			if err := m.Detail.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Confirmations", wireType)
+			}
+			m.Confirmations = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Confirmations |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTransaction(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetTransactionPoolRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTransaction
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetTransactionPoolRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetTransactionPoolRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTransaction(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetTransactionsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTransaction
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetTransactionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetTransactionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Txs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Txs = append(m.Txs, &pb.Transaction{})
+			if err := m.Txs[len(m.Txs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTransaction(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetMempoolInfoRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTransaction
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetMempoolInfoRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetMempoolInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTransaction(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetMempoolInfoResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTransaction
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetMempoolInfoResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetMempoolInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			m.Code = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Code |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxCount", wireType)
+			}
+			m.TxCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TxCount |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bytes", wireType)
+			}
+			m.Bytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Bytes |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Orphans", wireType)
+			}
+			m.Orphans = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Orphans |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinFeePerKb", wireType)
+			}
+			m.MinFeePerKb = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinFeePerKb |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTransaction(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetMempoolFeeHistogramRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTransaction
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetMempoolFeeHistogramRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetMempoolFeeHistogramRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTransaction
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Bounds = append(m.Bounds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTransaction
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTransaction
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Bounds) == 0 {
+					m.Bounds = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTransaction
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Bounds = append(m.Bounds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bounds", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTransaction(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeeBucket) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTransaction
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeBucket: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeBucket: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinFeePerKb", wireType)
+			}
+			m.MinFeePerKb = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinFeePerKb |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxFeePerKb", wireType)
+			}
+			m.MaxFeePerKb = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxFeePerKb |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxCount", wireType)
+			}
+			m.TxCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TxCount |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bytes", wireType)
+			}
+			m.Bytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Bytes |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CumulativeBytes", wireType)
+			}
+			m.CumulativeBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CumulativeBytes |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTransaction(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetMempoolFeeHistogramResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTransaction
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetMempoolFeeHistogramResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetMempoolFeeHistogramResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			m.Code = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Code |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Buckets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Buckets = append(m.Buckets, &FeeBucket{})
+			if err := m.Buckets[len(m.Buckets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTransaction(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListMempoolTransactionsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTransaction
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListMempoolTransactionsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListMempoolTransactionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Verbose", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Verbose = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTransaction(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MempoolEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTransaction
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MempoolEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MempoolEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tx", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Tx == nil {
+				m.Tx = &pb.Transaction{}
+			}
+			if err := m.Tx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxSize", wireType)
+			}
+			m.TxSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TxSize |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			m.Fee = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Fee |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeePerKb", wireType)
+			}
+			m.FeePerKb = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FeePerKb |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddedTime", wireType)
+			}
+			m.AddedTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AddedTime |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Age", wireType)
+			}
+			m.Age = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Age |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AncestorFee", wireType)
+			}
+			m.AncestorFee = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AncestorFee |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AncestorSize", wireType)
+			}
+			m.AncestorSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AncestorSize |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DescendantFee", wireType)
+			}
+			m.DescendantFee = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DescendantFee |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DescendantSize", wireType)
+			}
+			m.DescendantSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DescendantSize |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTransaction(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TestMempoolAcceptRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTransaction
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TestMempoolAcceptRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TestMempoolAcceptRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Raw", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Raw = append(m.Raw[:0], dAtA[iNdEx:postIndex]...)
+			if m.Raw == nil {
+				m.Raw = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTransaction(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TestMempoolAcceptResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTransaction
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TestMempoolAcceptResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TestMempoolAcceptResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			m.Code = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Code |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Allowed = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RejectReason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RejectReason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			m.Fee = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Fee |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxSize", wireType)
+			}
+			m.TxSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TxSize |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeePerKb", wireType)
+			}
+			m.FeePerKb = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FeePerKb |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTransaction(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListMempoolTransactionsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTransaction
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListMempoolTransactionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListMempoolTransactionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			m.Code = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Code |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hashes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hashes = append(m.Hashes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Entries = append(m.Entries, &MempoolEntry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTransaction(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TokenAmount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTransaction
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TokenAmount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TokenAmount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Token == nil {
+				m.Token = &pb.OutPoint{}
+			}
+			if err := m.Token.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			m.Amount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Amount |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTransaction(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FundTransactionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTransaction
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FundTransactionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FundTransactionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			m.Amount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Amount |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenBudgets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenBudgets = append(m.TokenBudgets, &TokenAmount{})
+			if err := m.TokenBudgets[len(m.TokenBudgets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTransaction(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TxOutTarget) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTransaction
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TxOutTarget: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TxOutTarget: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			m.Amount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Amount |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTransaction(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CreateRawTransactionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTransaction
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CreateRawTransactionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CreateRawTransactionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.From = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Outputs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Outputs = append(m.Outputs, &TxOutTarget{})
+			if err := m.Outputs[len(m.Outputs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChangeAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChangeAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeePerByte", wireType)
+			}
+			m.FeePerByte = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FeePerByte |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Replaceable", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Replaceable = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTransaction(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CreateRawTransactionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTransaction
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CreateRawTransactionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CreateRawTransactionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			m.Code = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Code |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tx", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Tx == nil {
+				m.Tx = &pb.Transaction{}
+			}
+			if err := m.Tx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Utxos", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Utxos = append(m.Utxos, &Utxo{})
+			if err := m.Utxos[len(m.Utxos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			m.Fee = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Fee |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTransaction(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SignRawTransactionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTransaction
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SignRawTransactionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SignRawTransactionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tx", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Tx == nil {
+				m.Tx = &pb.Transaction{}
+			}
+			if err := m.Tx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTransaction(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SignRawTransactionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTransaction
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SignRawTransactionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SignRawTransactionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			m.Code = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Code |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tx", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Tx == nil {
+				m.Tx = &pb.Transaction{}
+			}
+			if err := m.Tx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Complete", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Complete = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTransaction(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SendTransactionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTransaction
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SendTransactionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SendTransactionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tx", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Tx == nil {
+				m.Tx = &pb.Transaction{}
+			}
+			if err := m.Tx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTransaction(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListUtxosResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTransaction
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListUtxosResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListUtxosResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			m.Code = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Code |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Utxos", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Utxos = append(m.Utxos, &Utxo{})
+			if err := m.Utxos[len(m.Utxos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTransaction(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetBalanceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTransaction
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetBalanceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetBalanceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addrs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addrs = append(m.Addrs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Wallet", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Wallet = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTransaction(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetBalanceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTransaction
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetBalanceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetBalanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			m.Code = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Code |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balances", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Balances == nil {
+				m.Balances = make(map[string]uint64)
+			}
+			var mapkey string
+			var mapvalue uint64
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTransaction
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTransaction
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthTransaction
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTransaction
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTransaction(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthTransaction
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Balances[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTransaction(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListTokensRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTransaction
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListTokensRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListTokensRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
+			}
+			m.Offset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Offset |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTransaction(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListTokensResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTransaction
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListTokensResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListTokensResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			m.Code = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Code |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+			}
+			m.Total = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Total |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tokens", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tokens = append(m.Tokens, &TokenInfo{})
+			if err := m.Tokens[len(m.Tokens)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTransaction(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TokenInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTransaction
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TokenInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TokenInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Token == nil {
+				m.Token = &pb.OutPoint{}
+			}
+			if err := m.Token.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalSupply", wireType)
+			}
+			m.TotalSupply = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalSupply |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Issuer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Issuer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTransaction(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetTokenTransactionsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTransaction
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetTokenTransactionsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetTokenTransactionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Token == nil {
+				m.Token = &pb.OutPoint{}
+			}
+			if err := m.Token.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
+			}
+			m.Offset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Offset |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTransaction(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetTokenTransactionsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTransaction
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetTokenTransactionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetTokenTransactionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			m.Code = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Code |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+			}
+			m.Total = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Total |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Txs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Txs = append(m.Txs, &TokenTransaction{})
+			if err := m.Txs[len(m.Txs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTransaction(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TokenTransaction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTransaction
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TokenTransaction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TokenTransaction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tx", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Tx == nil {
+				m.Tx = &pb.Transaction{}
+			}
+			if err := m.Tx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTransaction(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetTokenBalanceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTransaction
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetTokenBalanceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetTokenBalanceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addrs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addrs = append(m.Addrs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Token == nil {
+				m.Token = &pb.OutPoint{}
+			}
+			if err := m.Token.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTransaction(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetTokenBalanceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTransaction
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetTokenBalanceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetTokenBalanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			m.Code = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Code |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balances", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Balances == nil {
+				m.Balances = make(map[string]uint64)
+			}
+			var mapkey string
+			var mapvalue uint64
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTransaction
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTransaction
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthTransaction
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTransaction
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTransaction(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthTransaction
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Balances[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTransaction(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	l = len(m.Message)
-	if l > 0 {
-		n += 1 + l + sovTransaction(uint64(l))
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	if len(m.Balances) > 0 {
-		for k, v := range m.Balances {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovTransaction(uint64(len(k))) + 1 + sovTransaction(uint64(v))
-			n += mapEntrySize + 1 + sovTransaction(uint64(mapEntrySize))
+	return nil
+}
+func (m *GetFeePriceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTransaction
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetFeePriceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetFeePriceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTransaction(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetFeePriceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTransaction
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetFeePriceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetFeePriceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BoxPerByte", wireType)
+			}
+			m.BoxPerByte = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BoxPerByte |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTransaction(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
-
-func (m *GetFeePriceRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
-}
-
-func (m *GetFeePriceResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.BoxPerByte != 0 {
-		n += 1 + sovTransaction(uint64(m.BoxPerByte))
-	}
-	return n
-}
 
-func sovTransaction(x uint64) (n int) {
-	for {
-		n++
-		x >>= 7
-		if x == 0 {
-			break
-		}
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-func sozTransaction(x uint64) (n int) {
-	return sovTransaction(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *ListUtxosRequest) Unmarshal(dAtA []byte) error {
+func (m *EstimateFeeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1830,12 +13104,31 @@ func (m *ListUtxosRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListUtxosRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: EstimateFeeRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListUtxosRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EstimateFeeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetBlocks", wireType)
+			}
+			m.TargetBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TargetBlocks |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTransaction(dAtA[iNdEx:])
@@ -1857,7 +13150,7 @@ func (m *ListUtxosRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetRawTransactionRequest) Unmarshal(dAtA []byte) error {
+func (m *EstimateFeeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1880,17 +13173,36 @@ func (m *GetRawTransactionRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetRawTransactionRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: EstimateFeeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetRawTransactionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EstimateFeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			m.Code = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Code |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTransaction
@@ -1900,23 +13212,59 @@ func (m *GetRawTransactionRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTransaction
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Hash = append(m.Hash[:0], dAtA[iNdEx:postIndex]...)
-			if m.Hash == nil {
-				m.Hash = []byte{}
-			}
+			m.Message = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BoxPerByte", wireType)
+			}
+			m.BoxPerByte = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BoxPerByte |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SampleSize", wireType)
+			}
+			m.SampleSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SampleSize |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTransaction(dAtA[iNdEx:])
@@ -1938,7 +13286,7 @@ func (m *GetRawTransactionRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetRawTransactionResponse) Unmarshal(dAtA []byte) error {
+func (m *CreatePSBTRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1961,10 +13309,10 @@ func (m *GetRawTransactionResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetRawTransactionResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: CreatePSBTRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetRawTransactionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CreatePSBTRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1986,19 +13334,127 @@ func (m *GetRawTransactionResponse) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTransaction
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Tx == nil {
-				m.Tx = &pb.Transaction{}
-			}
-			if err := m.Tx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			if msglen < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Tx == nil {
+				m.Tx = &pb.Transaction{}
+			}
+			if err := m.Tx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedeemScripts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RedeemScripts == nil {
+				m.RedeemScripts = make(map[uint32][]byte)
+			}
+			var mapkey uint32
+			mapvalue := []byte{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTransaction
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTransaction
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= (uint32(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else if fieldNum == 2 {
+					var mapbyteLen uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTransaction
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapbyteLen |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intMapbyteLen := int(mapbyteLen)
+					if intMapbyteLen < 0 {
+						return ErrInvalidLengthTransaction
+					}
+					postbytesIndex := iNdEx + intMapbyteLen
+					if postbytesIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = make([]byte, mapbyteLen)
+					copy(mapvalue, dAtA[iNdEx:postbytesIndex])
+					iNdEx = postbytesIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTransaction(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthTransaction
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.RedeemScripts[mapkey] = mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2021,7 +13477,7 @@ func (m *GetRawTransactionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetTransactionPoolRequest) Unmarshal(dAtA []byte) error {
+func (m *PSBTResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2044,12 +13500,89 @@ func (m *GetTransactionPoolRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetTransactionPoolRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: PSBTResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetTransactionPoolRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PSBTResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			m.Code = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Code |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Psbt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Psbt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTransaction(dAtA[iNdEx:])
@@ -2071,7 +13604,7 @@ func (m *GetTransactionPoolRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetTransactionsResponse) Unmarshal(dAtA []byte) error {
+func (m *SignPSBTRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2094,17 +13627,17 @@ func (m *GetTransactionsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetTransactionsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: SignPSBTRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetTransactionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SignPSBTRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Txs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Psbt", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTransaction
@@ -2114,22 +13647,20 @@ func (m *GetTransactionsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTransaction
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Txs = append(m.Txs, &pb.Transaction{})
-			if err := m.Txs[len(m.Txs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Psbt = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2152,7 +13683,7 @@ func (m *GetTransactionsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TokenAmount) Unmarshal(dAtA []byte) error {
+func (m *SignPSBTResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2175,17 +13706,36 @@ func (m *TokenAmount) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TokenAmount: wiretype end group for non-group")
+			return fmt.Errorf("proto: SignPSBTResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TokenAmount: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SignPSBTResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			m.Code = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Code |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTransaction
@@ -2195,30 +13745,55 @@ func (m *TokenAmount) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTransaction
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Token == nil {
-				m.Token = &pb.OutPoint{}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Psbt", wireType)
 			}
-			if err := m.Token.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.Psbt = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signed", wireType)
 			}
-			m.Amount = 0
+			m.Signed = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTransaction
@@ -2228,7 +13803,7 @@ func (m *TokenAmount) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Amount |= (uint64(b) & 0x7F) << shift
+				m.Signed |= (uint32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -2254,7 +13829,7 @@ func (m *TokenAmount) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *FundTransactionRequest) Unmarshal(dAtA []byte) error {
+func (m *MergePSBTRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2277,15 +13852,15 @@ func (m *FundTransactionRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: FundTransactionRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MergePSBTRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FundTransactionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MergePSBTRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Addr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Psbts", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2310,57 +13885,7 @@ func (m *FundTransactionRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Addr = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
-			}
-			m.Amount = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTransaction
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Amount |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenBudgets", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTransaction
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTransaction
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.TokenBudgets = append(m.TokenBudgets, &TokenAmount{})
-			if err := m.TokenBudgets[len(m.TokenBudgets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Psbts = append(m.Psbts, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2383,7 +13908,7 @@ func (m *FundTransactionRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SendTransactionRequest) Unmarshal(dAtA []byte) error {
+func (m *FinalizePSBTRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2406,17 +13931,17 @@ func (m *SendTransactionRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SendTransactionRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: FinalizePSBTRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SendTransactionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FinalizePSBTRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tx", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Psbt", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTransaction
@@ -2426,24 +13951,20 @@ func (m *SendTransactionRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTransaction
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Tx == nil {
-				m.Tx = &pb.Transaction{}
-			}
-			if err := m.Tx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Psbt = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2466,7 +13987,7 @@ func (m *SendTransactionRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListUtxosResponse) Unmarshal(dAtA []byte) error {
+func (m *FinalizePSBTResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2489,10 +14010,10 @@ func (m *ListUtxosResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListUtxosResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: FinalizePSBTResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListUtxosResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FinalizePSBTResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2544,10 +14065,10 @@ func (m *ListUtxosResponse) Unmarshal(dAtA []byte) error {
 			m.Message = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Psbt", wireType)
 			}
-			m.Count = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTransaction
@@ -2557,14 +14078,44 @@ func (m *ListUtxosResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Count |= (uint32(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Psbt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Complete", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Complete = bool(v != 0)
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Utxos", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tx", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2588,8 +14139,10 @@ func (m *ListUtxosResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Utxos = append(m.Utxos, &Utxo{})
-			if err := m.Utxos[len(m.Utxos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Tx == nil {
+				m.Tx = &pb.Transaction{}
+			}
+			if err := m.Tx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2614,7 +14167,7 @@ func (m *ListUtxosResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetBalanceRequest) Unmarshal(dAtA []byte) error {
+func (m *SubscribeFilteredBlocksRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2637,17 +14190,17 @@ func (m *GetBalanceRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetBalanceRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: SubscribeFilteredBlocksRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetBalanceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SubscribeFilteredBlocksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Addrs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Filter", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTransaction
@@ -2657,20 +14210,22 @@ func (m *GetBalanceRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				byteLen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTransaction
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Addrs = append(m.Addrs, string(dAtA[iNdEx:postIndex]))
+			m.Filter = append(m.Filter[:0], dAtA[iNdEx:postIndex]...)
+			if m.Filter == nil {
+				m.Filter = []byte{}
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2693,7 +14248,7 @@ func (m *GetBalanceRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetBalanceResponse) Unmarshal(dAtA []byte) error {
+func (m *MatchedTransaction) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2716,34 +14271,15 @@ func (m *GetBalanceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetBalanceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MatchedTransaction: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetBalanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MatchedTransaction: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
-			}
-			m.Code = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTransaction
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Code |= (int32(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2768,11 +14304,11 @@ func (m *GetBalanceResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Message = string(dAtA[iNdEx:postIndex])
+			m.Hash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Balances", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tx", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2796,142 +14332,18 @@ func (m *GetBalanceResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Balances == nil {
-				m.Balances = make(map[string]uint64)
-			}
-			var mapkey string
-			var mapvalue uint64
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTransaction
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= (uint64(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowTransaction
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= (uint64(b) & 0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthTransaction
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowTransaction
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapvalue |= (uint64(b) & 0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipTransaction(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if skippy < 0 {
-						return ErrInvalidLengthTransaction
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
+			if m.Tx == nil {
+				m.Tx = &pb.Transaction{}
 			}
-			m.Balances[mapkey] = mapvalue
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTransaction(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.Tx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthTransaction
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *GetTokenBalanceRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTransaction
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GetTokenBalanceRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetTokenBalanceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Addrs", wireType)
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
 			}
-			var stringLen uint64
+			m.Index = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTransaction
@@ -2941,26 +14353,16 @@ func (m *GetTokenBalanceRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				m.Index |= (uint32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTransaction
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Addrs = append(m.Addrs, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MerkleBranch", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTransaction
@@ -2970,24 +14372,20 @@ func (m *GetTokenBalanceRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				byteLen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTransaction
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Token == nil {
-				m.Token = &pb.OutPoint{}
-			}
-			if err := m.Token.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.MerkleBranch = append(m.MerkleBranch, make([]byte, postIndex-iNdEx))
+			copy(m.MerkleBranch[len(m.MerkleBranch)-1], dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3010,7 +14408,7 @@ func (m *GetTokenBalanceRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetTokenBalanceResponse) Unmarshal(dAtA []byte) error {
+func (m *FilteredBlock) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3033,10 +14431,10 @@ func (m *GetTokenBalanceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetTokenBalanceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: FilteredBlock: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetTokenBalanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FilteredBlock: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3089,9 +14487,9 @@ func (m *GetTokenBalanceResponse) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Balances", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTransaction
@@ -3101,204 +14499,117 @@ func (m *GetTokenBalanceResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTransaction
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Balances == nil {
-				m.Balances = make(map[string]uint64)
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			var mapkey string
-			var mapvalue uint64
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTransaction
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= (uint64(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
 				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowTransaction
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= (uint64(b) & 0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthTransaction
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowTransaction
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapvalue |= (uint64(b) & 0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipTransaction(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if skippy < 0 {
-						return ErrInvalidLengthTransaction
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
 				}
 			}
-			m.Balances[mapkey] = mapvalue
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTransaction(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthTransaction
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *GetFeePriceRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTransaction
+			if msglen < 0 {
+				return ErrInvalidLengthTransaction
 			}
-			if iNdEx >= l {
+			postIndex := iNdEx + msglen
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.Header == nil {
+				m.Header = &pb.BlockHeader{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GetFeePriceRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetFeePriceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTransaction(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthTransaction
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Connected", wireType)
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *GetFeePriceResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTransaction
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			m.Connected = bool(v != 0)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalTxs", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
+			m.TotalTxs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalTxs |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GetFeePriceResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetFeePriceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BoxPerByte", wireType)
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Txs", wireType)
 			}
-			m.BoxPerByte = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTransaction
@@ -3308,11 +14619,23 @@ func (m *GetFeePriceResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BoxPerByte |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Txs = append(m.Txs, &MatchedTransaction{})
+			if err := m.Txs[len(m.Txs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTransaction(dAtA[iNdEx:])
@@ -3439,62 +14762,183 @@ var (
 	ErrIntOverflowTransaction   = fmt.Errorf("proto: integer overflow")
 )
 
-func init() { proto.RegisterFile("transaction.proto", fileDescriptor_transaction_5b40de20ba40892e) }
-
-var fileDescriptor_transaction_5b40de20ba40892e = []byte{
-	// 862 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0x4f, 0x6f, 0xe3, 0x44,
-	0x14, 0xaf, 0xf3, 0xa7, 0x90, 0x97, 0xac, 0x9a, 0x4c, 0x4b, 0xea, 0x75, 0xb7, 0x21, 0x3b, 0x2b,
-	0xa0, 0xac, 0x90, 0xad, 0x5d, 0x24, 0x40, 0x45, 0x48, 0x4b, 0x56, 0x74, 0x39, 0x80, 0x1a, 0x99,
-	0x82, 0x90, 0x38, 0x54, 0x63, 0x7b, 0x9a, 0x44, 0x8d, 0x3d, 0xc6, 0x33, 0x6e, 0x1d, 0xe0, 0xc4,
-	0x27, 0x40, 0xe2, 0x2b, 0x71, 0xe0, 0x84, 0x2a, 0x71, 0xe1, 0x88, 0x5a, 0xbe, 0x03, 0x57, 0xe4,
-	0xf1, 0x24, 0x71, 0x12, 0xa7, 0xaa, 0x2a, 0x71, 0x9b, 0xe7, 0xf7, 0xe6, 0xfd, 0xde, 0xfb, 0xbd,
-	0x3f, 0x63, 0x68, 0x89, 0x88, 0x04, 0x9c, 0xb8, 0x62, 0xc4, 0x02, 0x33, 0x8c, 0x98, 0x60, 0xa8,
-	0x1a, 0x85, 0x6e, 0xe8, 0x18, 0xcf, 0x06, 0x23, 0x31, 0x8c, 0x1d, 0xd3, 0x65, 0xbe, 0xd5, 0x3b,
-	0xfe, 0xf6, 0x88, 0xc5, 0x81, 0x47, 0x52, 0x33, 0xcb, 0x61, 0x89, 0x67, 0xb9, 0x2c, 0xa2, 0x56,
-	0xe8, 0x58, 0xce, 0x98, 0xb9, 0xe7, 0xd9, 0x4d, 0xe3, 0xd1, 0x80, 0xb1, 0xc1, 0x98, 0x5a, 0x24,
-	0x1c, 0x59, 0x24, 0x08, 0x98, 0x90, 0xf6, 0x5c, 0x69, 0x1b, 0x2e, 0xf3, 0xfd, 0x29, 0x0a, 0x46,
-	0xd0, 0xfc, 0x62, 0xc4, 0xc5, 0xd7, 0x22, 0x61, 0xdc, 0xa6, 0xdf, 0xc7, 0x94, 0x0b, 0x6c, 0x82,
-	0xfe, 0x8a, 0x0a, 0x9b, 0x5c, 0x9e, 0xcc, 0x83, 0x52, 0x3a, 0x84, 0xa0, 0x32, 0x24, 0x7c, 0xa8,
-	0x6b, 0x5d, 0xed, 0xa0, 0x61, 0xcb, 0x33, 0x7e, 0x01, 0x0f, 0x0b, 0xec, 0x79, 0xc8, 0x02, 0x4e,
-	0xd1, 0x13, 0x28, 0x89, 0x44, 0x9a, 0xd7, 0x9f, 0x6f, 0x9b, 0x69, 0xb8, 0xa1, 0x63, 0xe6, 0x0d,
-	0x4b, 0x22, 0xc1, 0x7b, 0xd2, 0x43, 0xee, 0x6b, 0x9f, 0xb1, 0xf1, 0x34, 0x9c, 0x17, 0xb0, 0xbb,
-	0xa8, 0xe4, 0x33, 0xe7, 0x6f, 0x41, 0x59, 0x24, 0x5c, 0xd7, 0xba, 0xe5, 0x75, 0xde, 0x53, 0x3d,
-	0xfe, 0x12, 0xea, 0x27, 0xec, 0x9c, 0x06, 0x9f, 0xfa, 0x2c, 0x0e, 0x04, 0x7a, 0x1b, 0xaa, 0x22,
-	0x15, 0x55, 0x54, 0xcd, 0xe9, 0xbd, 0xe3, 0x58, 0xf4, 0xd9, 0x28, 0x10, 0x76, 0xa6, 0x46, 0x6d,
-	0xd8, 0x24, 0xf2, 0x86, 0x5e, 0xea, 0x6a, 0x07, 0x15, 0x5b, 0x49, 0xf8, 0x27, 0x68, 0x1f, 0xc5,
-	0x81, 0x57, 0xcc, 0x0e, 0xf1, 0xbc, 0x48, 0x3a, 0xae, 0xd9, 0xf2, 0xbc, 0xce, 0x0b, 0xfa, 0x00,
-	0x1a, 0x12, 0xa6, 0x17, 0x7b, 0x03, 0x2a, 0xb8, 0x5e, 0x96, 0x49, 0x20, 0x53, 0x96, 0xdd, 0xcc,
-	0xc5, 0x6b, 0x2f, 0xd8, 0xe1, 0x4f, 0xa0, 0xfd, 0x15, 0x2d, 0x44, 0xbf, 0x13, 0xd5, 0x3f, 0x40,
-	0x2b, 0x57, 0x70, 0xc5, 0x23, 0x82, 0x8a, 0xcb, 0x3c, 0x2a, 0xef, 0x56, 0x6d, 0x79, 0x46, 0x3a,
-	0xbc, 0xe6, 0x53, 0xce, 0xc9, 0x80, 0xca, 0xc0, 0x6b, 0xf6, 0x54, 0x44, 0x3b, 0x50, 0x75, 0x65,
-	0x42, 0xe5, 0xae, 0x76, 0xf0, 0xc0, 0xce, 0x04, 0xf4, 0x18, 0xaa, 0x71, 0xea, 0x54, 0xaf, 0xc8,
-	0x44, 0xea, 0x2a, 0x91, 0x14, 0xc8, 0xce, 0x34, 0xf8, 0x5d, 0x68, 0xbd, 0xa2, 0xa2, 0x47, 0xc6,
-	0x24, 0x70, 0xe9, 0x34, 0xea, 0x1d, 0xa8, 0xa6, 0x3c, 0x65, 0x55, 0xac, 0xd9, 0x99, 0x80, 0x7f,
-	0xd3, 0x00, 0xe5, 0x6d, 0xef, 0x15, 0xe8, 0x4b, 0x78, 0xdd, 0xc9, 0x1c, 0x4c, 0xe9, 0x7d, 0x47,
-	0x45, 0xb5, 0xea, 0xda, 0x54, 0x32, 0xff, 0x2c, 0x10, 0xd1, 0xc4, 0x9e, 0x5d, 0x34, 0x3e, 0x86,
-	0x07, 0x0b, 0x2a, 0xd4, 0x84, 0xf2, 0x39, 0x9d, 0xa8, 0x1a, 0xa7, 0xc7, 0x34, 0x85, 0x0b, 0x32,
-	0x8e, 0xa9, 0xaa, 0x70, 0x26, 0x1c, 0x96, 0x3e, 0xd2, 0xf0, 0x37, 0xd0, 0x4e, 0x7b, 0x57, 0xd6,
-	0xef, 0x0e, 0x69, 0xcf, 0x5b, 0xb3, 0x74, 0x6b, 0x6b, 0xe2, 0x3f, 0xb4, 0x6c, 0x28, 0x16, 0x1c,
-	0xdf, 0x8b, 0xa3, 0xcf, 0x57, 0x38, 0x7a, 0x6f, 0xce, 0x51, 0x91, 0xff, 0xff, 0x87, 0xa8, 0x1d,
-	0x59, 0xee, 0x23, 0x4a, 0xfb, 0xd1, 0x68, 0x46, 0x12, 0xfe, 0x10, 0xb6, 0x17, 0xbe, 0xaa, 0x0c,
-	0xbb, 0xd0, 0x70, 0x58, 0x72, 0x1a, 0xd2, 0xe8, 0xd4, 0x99, 0x88, 0x2c, 0xd3, 0x8a, 0x0d, 0x0e,
-	0x4b, 0xfa, 0x34, 0xea, 0x4d, 0x04, 0x7d, 0xfe, 0xef, 0x26, 0xa0, 0x5c, 0xe7, 0xbf, 0x64, 0xbe,
-	0x4f, 0x02, 0x0f, 0x7d, 0x07, 0xb5, 0x59, 0xf3, 0xa3, 0x5d, 0x95, 0xe7, 0xf2, 0xfe, 0x33, 0xf4,
-	0x55, 0x45, 0x06, 0x8c, 0xf7, 0x7e, 0xfe, 0xf3, 0x9f, 0x5f, 0x4b, 0x6f, 0xe0, 0xa6, 0x75, 0xf1,
-	0xcc, 0x12, 0x89, 0x35, 0x1e, 0x71, 0x21, 0x5b, 0xfb, 0x50, 0x7b, 0x8a, 0x7c, 0xd8, 0x5a, 0x5a,
-	0x0b, 0x68, 0x5f, 0x79, 0x2a, 0x5e, 0x17, 0xb7, 0x00, 0x3d, 0x96, 0x40, 0x7b, 0xb8, 0xad, 0x80,
-	0xce, 0xe2, 0xc0, 0xcb, 0x3d, 0x11, 0x29, 0xdc, 0x10, 0xb6, 0x96, 0xf6, 0xc0, 0x0c, 0xae, 0x78,
-	0x3f, 0x18, 0xdb, 0x4a, 0xdd, 0x23, 0x9c, 0xae, 0x45, 0xe2, 0x74, 0x05, 0xe9, 0x47, 0x39, 0xb6,
-	0x8b, 0xfb, 0x1d, 0xbd, 0x39, 0xef, 0x92, 0xc2, 0x97, 0xc2, 0xe8, 0xae, 0x37, 0x50, 0xd0, 0x4f,
-	0x24, 0xf4, 0x3e, 0xd6, 0x15, 0xf4, 0x80, 0x8a, 0x88, 0x5c, 0x2e, 0x81, 0x9f, 0x02, 0xcc, 0x87,
-	0x15, 0xe9, 0x05, 0xf3, 0x9b, 0xc1, 0x3d, 0x5c, 0x3b, 0xd9, 0xf8, 0x91, 0xc4, 0x69, 0xe3, 0xd6,
-	0x1c, 0x47, 0x35, 0x6d, 0x0a, 0xc0, 0x61, 0x6b, 0xa9, 0xd3, 0x67, 0x3c, 0x16, 0x8f, 0xae, 0xd1,
-	0xb9, 0x7d, 0x40, 0x56, 0x28, 0x1d, 0x50, 0x21, 0xa7, 0x36, 0x07, 0xea, 0x42, 0x3d, 0xd7, 0xd8,
-	0x28, 0x17, 0xfc, 0xd2, 0x08, 0x18, 0x46, 0x91, 0x4a, 0x01, 0xed, 0x4b, 0xa0, 0x5d, 0x8c, 0xe6,
-	0x40, 0x67, 0x94, 0x86, 0xa9, 0x4d, 0x96, 0x19, 0x5a, 0x7d, 0x55, 0x51, 0xae, 0x2e, 0xc5, 0x0f,
-	0xee, 0x42, 0x7e, 0x05, 0xaf, 0xee, 0xca, 0x14, 0xa4, 0xf9, 0x25, 0x21, 0x63, 0xe3, 0x43, 0xed,
-	0x69, 0x4f, 0xff, 0xfd, 0xba, 0xa3, 0x5d, 0x5d, 0x77, 0xb4, 0xbf, 0xaf, 0x3b, 0xda, 0x2f, 0x37,
-	0x9d, 0x8d, 0xab, 0x9b, 0xce, 0xc6, 0x5f, 0x37, 0x9d, 0x0d, 0x67, 0x53, 0xfe, 0x71, 0xbc, 0xff,
-	0x5f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xd3, 0x4d, 0xd4, 0x5e, 0xec, 0x08, 0x00, 0x00,
+func init() { proto.RegisterFile("transaction.proto", fileDescriptor_transaction_228ec4dbc4f69eeb) }
+
+var fileDescriptor_transaction_228ec4dbc4f69eeb = []byte{
+	// 2795 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x1a, 0xcd, 0x6f, 0x23, 0x57,
+	0xbd, 0x63, 0xc7, 0x89, 0xfd, 0xb3, 0xbd, 0x49, 0xde, 0xa6, 0x89, 0x33, 0x49, 0x5c, 0xef, 0xcb,
+	0xee, 0x36, 0xdb, 0x96, 0xa4, 0x5d, 0xa4, 0x7e, 0x2c, 0x42, 0xda, 0xcd, 0xd2, 0x6c, 0xab, 0xb2,
+	0xea, 0x6a, 0x12, 0x0a, 0x08, 0x21, 0x6b, 0x3c, 0x7e, 0x76, 0x86, 0xf5, 0xcc, 0x98, 0x99, 0xe7,
+	0xac, 0x77, 0x8b, 0x84, 0x54, 0x10, 0x20, 0x21, 0x04, 0x52, 0x25, 0x24, 0xf8, 0x03, 0x90, 0x10,
+	0x27, 0x2e, 0x1c, 0x38, 0xf7, 0xc0, 0xa9, 0xaa, 0xc4, 0x05, 0x6e, 0xa8, 0x0b, 0x47, 0xce, 0xdc,
+	0x10, 0x7a, 0x5f, 0x33, 0x6f, 0x3c, 0x63, 0x6f, 0xd6, 0x6c, 0x6f, 0xf3, 0x7e, 0xef, 0x37, 0xbf,
+	0xef, 0x4f, 0x8f, 0x61, 0x95, 0x86, 0xb6, 0x1f, 0xd9, 0x0e, 0x75, 0x03, 0x7f, 0x7f, 0x18, 0x06,
+	0x34, 0x40, 0xa5, 0x70, 0xe8, 0x0c, 0x3b, 0xe6, 0x6b, 0x7d, 0x97, 0x9e, 0x8e, 0x3a, 0xfb, 0x4e,
+	0xe0, 0x1d, 0x1c, 0xbe, 0xff, 0xad, 0xa3, 0x60, 0xe4, 0x77, 0x6d, 0x86, 0x76, 0xd0, 0x09, 0xc6,
+	0xdd, 0x03, 0x27, 0x08, 0xc9, 0xc1, 0xb0, 0x73, 0xd0, 0x19, 0x04, 0xce, 0x7d, 0xf1, 0xa6, 0xb9,
+	0xdd, 0x0f, 0x82, 0xfe, 0x80, 0x1c, 0xd8, 0x43, 0xf7, 0xc0, 0xf6, 0xfd, 0x80, 0x72, 0xfc, 0x48,
+	0xde, 0xd6, 0x9c, 0xc0, 0xf3, 0x14, 0x17, 0x7c, 0x13, 0x56, 0xbe, 0xee, 0x46, 0xf4, 0x1b, 0x74,
+	0x1c, 0x44, 0x16, 0xf9, 0xfe, 0x88, 0x44, 0x14, 0xad, 0x41, 0xc9, 0xee, 0x76, 0xc3, 0xa8, 0x61,
+	0xb4, 0x8a, 0x7b, 0x15, 0x4b, 0x1c, 0xd0, 0x3a, 0x2c, 0x3e, 0xb0, 0x07, 0x03, 0x42, 0x1b, 0x85,
+	0x96, 0xb1, 0x57, 0xb6, 0xe4, 0x09, 0xef, 0x43, 0xe3, 0x0e, 0xa1, 0x96, 0xfd, 0xe0, 0x24, 0x51,
+	0x41, 0x51, 0x42, 0xb0, 0x70, 0x6a, 0x47, 0xa7, 0x0d, 0xa3, 0x65, 0xec, 0xd5, 0x2c, 0xfe, 0x8c,
+	0xff, 0x6c, 0xc0, 0x66, 0xce, 0x0b, 0xd1, 0x30, 0xf0, 0x23, 0x82, 0x76, 0xa1, 0x40, 0xc7, 0x1c,
+	0xbf, 0x7a, 0xfd, 0xe2, 0x3e, 0xd3, 0x6e, 0xd8, 0xd9, 0xd7, 0x11, 0x0b, 0x74, 0x8c, 0x56, 0xa0,
+	0x18, 0xda, 0x0f, 0xb8, 0x1c, 0x35, 0x8b, 0x3d, 0xa2, 0x1d, 0x00, 0x6e, 0x81, 0x36, 0x67, 0x57,
+	0x6c, 0x19, 0x7b, 0x15, 0xab, 0xc2, 0x21, 0xef, 0xd8, 0xd1, 0x29, 0xba, 0x04, 0x35, 0x79, 0x4d,
+	0xdc, 0xfe, 0x29, 0x6d, 0x2c, 0xb4, 0x8c, 0xbd, 0xba, 0x55, 0x15, 0x08, 0x1c, 0x84, 0x2e, 0x43,
+	0xdd, 0x09, 0xfc, 0x9e, 0x1b, 0x7a, 0xc2, 0x5a, 0x8d, 0x12, 0xc7, 0x49, 0x03, 0xf1, 0x6b, 0xb0,
+	0x75, 0x87, 0x50, 0x4d, 0x9e, 0xaf, 0x11, 0x6a, 0xbb, 0x83, 0x3c, 0x7d, 0x2b, 0x52, 0xdf, 0xdf,
+	0x1b, 0x00, 0x27, 0xe3, 0x77, 0x25, 0x26, 0x7a, 0x1d, 0x2e, 0x0c, 0x43, 0x72, 0xd6, 0x0e, 0x46,
+	0xb4, 0x3d, 0x0c, 0x5c, 0x9f, 0x4a, 0x65, 0x57, 0x94, 0xb2, 0xef, 0x8f, 0xe8, 0x3d, 0x06, 0xb7,
+	0x6a, 0x0c, 0x4f, 0x9d, 0x98, 0x86, 0x91, 0x13, 0xba, 0x43, 0xda, 0x8e, 0xdc, 0xbe, 0x54, 0xbd,
+	0x22, 0x20, 0xc7, 0x6e, 0x1f, 0x99, 0x50, 0x8e, 0x98, 0x10, 0xbe, 0x43, 0xb8, 0xfa, 0x75, 0x2b,
+	0x3e, 0x33, 0x7f, 0x9e, 0xd9, 0x83, 0x11, 0xe1, 0x6a, 0x2f, 0x58, 0xe2, 0xc0, 0x64, 0x65, 0x8e,
+	0xe5, 0x7a, 0x56, 0x2c, 0xfe, 0x8c, 0xbf, 0x0b, 0xd5, 0x93, 0xf1, 0xfb, 0x23, 0x2a, 0x65, 0x8d,
+	0x5f, 0x34, 0xf4, 0x17, 0x2f, 0xc3, 0x05, 0x29, 0xc9, 0x70, 0xd4, 0x69, 0xdf, 0x27, 0x0f, 0xa5,
+	0x34, 0x35, 0x01, 0xbd, 0x37, 0xea, 0xbc, 0x47, 0x1e, 0xc6, 0xe4, 0x8b, 0x1a, 0xf9, 0xff, 0x18,
+	0xb0, 0x9a, 0xb1, 0x5d, 0x9e, 0xd1, 0x50, 0x03, 0x96, 0xce, 0x48, 0x18, 0xb9, 0x81, 0xcf, 0x89,
+	0x97, 0x2c, 0x75, 0x44, 0xbb, 0x50, 0x3c, 0x73, 0xfd, 0x46, 0xb1, 0x55, 0xdc, 0xab, 0x5e, 0x5f,
+	0xdd, 0xe7, 0x49, 0xb2, 0x9f, 0xd8, 0xd7, 0x62, 0xb7, 0xe8, 0x2a, 0x2c, 0x9c, 0x05, 0x23, 0xe6,
+	0x67, 0x86, 0x85, 0x62, 0xac, 0x58, 0x35, 0x8b, 0xdf, 0xa3, 0x2d, 0xa8, 0xf0, 0xb0, 0xa0, 0xae,
+	0x47, 0xb8, 0x21, 0x8a, 0x56, 0x99, 0x01, 0x4e, 0x5c, 0x8f, 0xb0, 0x28, 0xeb, 0x11, 0xd2, 0x58,
+	0xe4, 0xba, 0xb3, 0x47, 0xb4, 0x01, 0x4b, 0x74, 0xdc, 0x8e, 0xdc, 0x47, 0xa4, 0xb1, 0xc4, 0x6d,
+	0xbc, 0x48, 0xc7, 0xc7, 0xee, 0x23, 0x82, 0x5e, 0x80, 0xaa, 0x1b, 0xb5, 0x9d, 0xc0, 0xf5, 0x3b,
+	0x76, 0x44, 0x1a, 0x65, 0x9e, 0x20, 0xe0, 0x46, 0xb7, 0x25, 0x04, 0xff, 0xb8, 0x00, 0xdb, 0xf9,
+	0x81, 0x23, 0xe3, 0x1e, 0xc1, 0x82, 0x13, 0x74, 0x85, 0xa5, 0x4b, 0x16, 0x7f, 0x66, 0x46, 0xf0,
+	0x48, 0x14, 0xd9, 0x7d, 0xc2, 0x8d, 0x50, 0xb1, 0xd4, 0x11, 0xbd, 0x0a, 0x8b, 0x5d, 0xfe, 0x3e,
+	0x37, 0x6f, 0xf5, 0x7a, 0x43, 0x69, 0x98, 0xa1, 0x2f, 0xf1, 0x26, 0x12, 0x64, 0xe1, 0x49, 0x09,
+	0x52, 0xca, 0x26, 0xc8, 0x36, 0x54, 0x98, 0x99, 0x22, 0x6a, 0x7b, 0x43, 0x6e, 0x94, 0xa2, 0x95,
+	0x00, 0xb2, 0xe9, 0xb3, 0x94, 0x97, 0x3e, 0x5b, 0x3c, 0xf5, 0x35, 0x29, 0xef, 0x05, 0x81, 0x4a,
+	0x1e, 0x7c, 0x13, 0x36, 0xd2, 0x97, 0x51, 0x6c, 0x9d, 0x2b, 0x50, 0xa4, 0x63, 0x51, 0x8f, 0xa6,
+	0x94, 0x05, 0x76, 0x8f, 0x37, 0xe0, 0xf9, 0x3b, 0x84, 0xde, 0x25, 0xde, 0x30, 0x08, 0x06, 0xef,
+	0xfa, 0xbd, 0x40, 0x91, 0xfe, 0x93, 0x01, 0xeb, 0x93, 0x37, 0x73, 0x19, 0x7e, 0x13, 0xca, 0x74,
+	0xdc, 0x76, 0x82, 0x91, 0x4f, 0x65, 0x9a, 0x2d, 0xd1, 0xf1, 0x6d, 0x76, 0x64, 0xc9, 0xd2, 0x79,
+	0x48, 0x49, 0xa4, 0xb2, 0x8c, 0x1f, 0x18, 0xa9, 0x20, 0x1c, 0x9e, 0xda, 0x71, 0x41, 0x51, 0x47,
+	0xb4, 0x0b, 0x17, 0x3c, 0xd7, 0x6f, 0xf7, 0x08, 0x69, 0x0f, 0x49, 0xd8, 0xbe, 0xdf, 0x91, 0x91,
+	0x56, 0xf5, 0x5c, 0xff, 0x88, 0x90, 0x7b, 0x24, 0x7c, 0xaf, 0x83, 0xdf, 0x80, 0x9d, 0x44, 0xee,
+	0x23, 0x42, 0xde, 0x71, 0x23, 0x1a, 0xf4, 0x43, 0xdb, 0x53, 0x15, 0x67, 0x1d, 0x16, 0x3b, 0xac,
+	0x25, 0x08, 0xe3, 0x2c, 0x58, 0xf2, 0x84, 0xff, 0x68, 0x40, 0xe5, 0x88, 0x90, 0xc3, 0x91, 0x73,
+	0x9f, 0xd0, 0x1c, 0x5e, 0x46, 0x86, 0x17, 0x47, 0xb2, 0xc7, 0x3a, 0x52, 0x41, 0x22, 0xd9, 0xe3,
+	0x18, 0xe9, 0xa9, 0x0d, 0x70, 0x0d, 0x56, 0x9c, 0x91, 0x37, 0x1a, 0xd8, 0xd4, 0x3d, 0x23, 0x6d,
+	0x81, 0x50, 0xe2, 0x08, 0xcb, 0x09, 0xfc, 0x90, 0x81, 0xf1, 0x23, 0x68, 0x4e, 0x53, 0x76, 0x2e,
+	0x67, 0xbd, 0x04, 0x4b, 0x1d, 0xae, 0x7f, 0x24, 0xcb, 0xc5, 0x8a, 0x4c, 0x93, 0xd8, 0x30, 0x96,
+	0x42, 0xc0, 0x37, 0xa0, 0xc9, 0xfa, 0xa0, 0x64, 0x9e, 0x0e, 0x42, 0x61, 0x69, 0x51, 0x92, 0x3a,
+	0x41, 0x24, 0xd8, 0x97, 0x2d, 0x75, 0xc4, 0x7f, 0x2f, 0x40, 0x4d, 0xbe, 0xf8, 0xb6, 0x4f, 0xc3,
+	0x87, 0xb9, 0x15, 0x4d, 0x34, 0xb6, 0xc2, 0xec, 0xc6, 0xa6, 0x15, 0x98, 0x62, 0xaa, 0xc0, 0xc8,
+	0x5a, 0xb4, 0x90, 0xd4, 0xa2, 0x6d, 0x00, 0xcd, 0x53, 0xc2, 0xa2, 0xe5, 0x9e, 0x72, 0xd3, 0x0e,
+	0x80, 0xdd, 0xed, 0x92, 0xae, 0xa8, 0x6c, 0x32, 0x5b, 0x39, 0x44, 0x95, 0x36, 0x66, 0xaf, 0x25,
+	0x0e, 0x67, 0x8f, 0xac, 0x00, 0xd8, 0xbe, 0x43, 0x22, 0x1a, 0x84, 0x2c, 0x02, 0x78, 0x09, 0x5b,
+	0xb0, 0xaa, 0x0a, 0x76, 0x44, 0x58, 0x6b, 0xae, 0xc7, 0x28, 0x5c, 0xc4, 0x0a, 0x17, 0x31, 0x7e,
+	0x8f, 0x0b, 0x7a, 0x05, 0x2e, 0x74, 0x49, 0xe4, 0x10, 0xbf, 0x6b, 0xfb, 0x94, 0x53, 0x02, 0x4e,
+	0xa9, 0x9e, 0x40, 0x19, 0xad, 0x17, 0x61, 0x59, 0x43, 0xe3, 0xd4, 0xaa, 0x9c, 0x9a, 0xf6, 0x36,
+	0xa3, 0x87, 0x5f, 0x81, 0xc6, 0x09, 0x89, 0xfd, 0x72, 0xcb, 0x71, 0xc8, 0x90, 0x2a, 0x8f, 0xc8,
+	0x31, 0xc0, 0x88, 0xc7, 0x00, 0xfc, 0x2f, 0x03, 0x36, 0x73, 0xd0, 0xe7, 0x8a, 0x1e, 0xe5, 0xc4,
+	0x62, 0xba, 0x2d, 0xd9, 0x83, 0x41, 0xf0, 0x80, 0x74, 0xb9, 0x2b, 0xca, 0x96, 0x3a, 0x32, 0xe3,
+	0x84, 0xe4, 0x7b, 0xc4, 0xa1, 0xed, 0x90, 0xd8, 0x51, 0xe0, 0xcb, 0xb6, 0x5a, 0x13, 0x40, 0x8b,
+	0xc3, 0x9e, 0xa6, 0xa3, 0xa4, 0xdd, 0x5b, 0x4e, 0xbb, 0x17, 0xff, 0xda, 0x80, 0x17, 0xa6, 0x86,
+	0xeb, 0x5c, 0xda, 0xae, 0xc3, 0x22, 0xd3, 0x90, 0x88, 0x54, 0xa9, 0x58, 0xf2, 0x84, 0xbe, 0x04,
+	0x4b, 0xc4, 0xa7, 0xa1, 0xcb, 0xd3, 0x5a, 0x54, 0x5f, 0x91, 0x43, 0x7a, 0xc0, 0x5b, 0x0a, 0x07,
+	0xdf, 0x85, 0xea, 0x49, 0x70, 0x9f, 0xf8, 0xb7, 0x3c, 0x5e, 0x12, 0xae, 0x42, 0x89, 0xb2, 0xe3,
+	0xd4, 0x19, 0x47, 0x5c, 0x33, 0xee, 0x36, 0x7f, 0x43, 0x96, 0x1c, 0x79, 0xc2, 0x3f, 0x80, 0xf5,
+	0xa3, 0x91, 0xdf, 0xcd, 0x9f, 0x2c, 0xf9, 0x78, 0x61, 0x24, 0xe3, 0xc5, 0x34, 0x2a, 0xe8, 0x75,
+	0xa8, 0x71, 0x36, 0x87, 0xa3, 0x6e, 0x3f, 0x29, 0x06, 0xf1, 0x54, 0x90, 0xc8, 0x6b, 0xa5, 0xf0,
+	0xf0, 0x5b, 0x72, 0x1a, 0x3a, 0xb1, 0xc3, 0x3e, 0x79, 0x2a, 0x96, 0xf8, 0x13, 0x03, 0xb6, 0x6e,
+	0x87, 0xc4, 0xa6, 0x64, 0xea, 0x60, 0xdc, 0x0b, 0x03, 0x4f, 0xd1, 0x62, 0xcf, 0xe8, 0x15, 0x58,
+	0x0a, 0x46, 0x74, 0x38, 0xa2, 0x51, 0xa3, 0x90, 0x9d, 0x5b, 0x84, 0x10, 0x96, 0x42, 0x61, 0x23,
+	0x87, 0x73, 0x6a, 0xfb, 0x7d, 0xd2, 0xd6, 0xc6, 0x2c, 0x10, 0xa0, 0x5b, 0x4c, 0xb4, 0x16, 0xd4,
+	0x54, 0x04, 0xb1, 0xaa, 0x2b, 0x6b, 0x07, 0x88, 0x18, 0x62, 0x05, 0x17, 0xb5, 0xa0, 0x1a, 0x92,
+	0xe1, 0xc0, 0x76, 0x88, 0xdd, 0x19, 0x88, 0xf9, 0xa7, 0x6c, 0xe9, 0x20, 0xfc, 0x3b, 0x03, 0xb6,
+	0xf3, 0xd5, 0x98, 0x2b, 0xc8, 0x44, 0x0d, 0x2c, 0xce, 0xae, 0x81, 0x97, 0xa0, 0x34, 0x62, 0xdb,
+	0x88, 0x8c, 0xb7, 0xaa, 0x34, 0x02, 0xdb, 0x50, 0x2c, 0x71, 0xa3, 0xf2, 0xa8, 0x14, 0xe7, 0x11,
+	0xbe, 0x09, 0x9b, 0xc7, 0x6e, 0xdf, 0xcf, 0x37, 0xf6, 0x79, 0x76, 0x0a, 0xfc, 0x73, 0x03, 0xcc,
+	0x3c, 0x12, 0x5f, 0x9c, 0xa2, 0x26, 0x94, 0x9d, 0xc0, 0x1b, 0x0e, 0x88, 0x74, 0x4e, 0xd9, 0x8a,
+	0xcf, 0xf8, 0xab, 0xb0, 0x7e, 0x4c, 0x72, 0x03, 0xff, 0x5c, 0xca, 0x3c, 0x82, 0x55, 0x6d, 0xab,
+	0x9b, 0x4b, 0x85, 0x35, 0x28, 0xe9, 0x5d, 0x5e, 0x1c, 0xce, 0xe1, 0x1c, 0x7c, 0x0b, 0x56, 0xef,
+	0x10, 0x7a, 0x68, 0x0f, 0x58, 0x5f, 0x98, 0x6f, 0xa5, 0xfc, 0xc4, 0x00, 0xa4, 0xd3, 0x98, 0x4b,
+	0x81, 0xdb, 0x50, 0xee, 0x08, 0x02, 0x2a, 0xe3, 0x5f, 0x94, 0xd2, 0x66, 0x49, 0xef, 0xcb, 0x73,
+	0x24, 0xca, 0x59, 0xfc, 0xa2, 0xf9, 0x15, 0xa8, 0xa7, 0xae, 0x58, 0xe8, 0xb1, 0x8d, 0x47, 0xe4,
+	0x2d, 0x7b, 0x4c, 0x96, 0xa4, 0x82, 0xb6, 0x24, 0xdd, 0x28, 0xbc, 0x69, 0xe0, 0x5b, 0xc2, 0x0b,
+	0xbc, 0xc0, 0x44, 0xda, 0xc0, 0x16, 0xf4, 0x7a, 0x11, 0x11, 0x7b, 0x5f, 0xdd, 0x92, 0x27, 0x46,
+	0x66, 0xe0, 0x7a, 0xae, 0x30, 0x45, 0xdd, 0x12, 0x07, 0xfc, 0x91, 0x01, 0x48, 0xa7, 0x31, 0xaf,
+	0x2b, 0x69, 0x40, 0xed, 0x81, 0x72, 0x25, 0x3f, 0xa0, 0x3d, 0x58, 0xe4, 0xd5, 0x4e, 0xf9, 0x72,
+	0x45, 0xaf, 0x87, 0x7c, 0x44, 0x96, 0xf7, 0xf8, 0xb7, 0x06, 0x54, 0x62, 0xe8, 0xb9, 0x6b, 0x3a,
+	0x82, 0x05, 0xdf, 0xf6, 0x94, 0x30, 0xfc, 0x99, 0x4d, 0x19, 0x9c, 0x79, 0x3b, 0x1a, 0x0d, 0x87,
+	0x83, 0x87, 0x5c, 0xa0, 0x05, 0xab, 0xca, 0x61, 0xc7, 0x1c, 0xc4, 0xec, 0xe3, 0x46, 0xd1, 0x88,
+	0x84, 0x72, 0x49, 0x91, 0x27, 0xde, 0xa0, 0xf4, 0xdd, 0x44, 0x9e, 0x70, 0x24, 0x36, 0x72, 0xc6,
+	0x32, 0x6f, 0x6a, 0x7b, 0x8a, 0x0e, 0x24, 0xdd, 0x52, 0xc8, 0x77, 0x4b, 0x51, 0x77, 0xcb, 0x2f,
+	0x0c, 0xb1, 0xce, 0x65, 0xb9, 0x3e, 0x43, 0x07, 0x5d, 0x13, 0x4b, 0x8f, 0xf0, 0xce, 0x86, 0xee,
+	0x9d, 0xcc, 0xe2, 0xf3, 0x07, 0x03, 0x56, 0x26, 0x6f, 0xce, 0xf7, 0x53, 0x8a, 0x9a, 0x72, 0x0a,
+	0xda, 0x94, 0xf3, 0xff, 0xff, 0x98, 0x92, 0xda, 0x15, 0x4b, 0x13, 0xbb, 0x22, 0xfe, 0x80, 0x2f,
+	0x63, 0x5c, 0xde, 0x73, 0x95, 0x89, 0xd8, 0x87, 0x85, 0x99, 0x3e, 0xc4, 0x9f, 0x1a, 0x62, 0x83,
+	0x4c, 0x11, 0x9e, 0xcb, 0x21, 0xef, 0x64, 0x6a, 0xc7, 0x2b, 0x49, 0xed, 0xc8, 0xa3, 0xff, 0xc5,
+	0x14, 0x90, 0x35, 0x5e, 0x06, 0xd9, 0xee, 0x15, 0xba, 0xb1, 0x91, 0xf0, 0x1b, 0x70, 0x31, 0x05,
+	0x95, 0x1a, 0xb6, 0xa0, 0xd6, 0x09, 0xc6, 0x49, 0xbf, 0x17, 0x1b, 0x1e, 0x74, 0x82, 0xb1, 0xec,
+	0xf7, 0xf8, 0x2d, 0x40, 0x6f, 0x47, 0xd4, 0xf5, 0x6c, 0x4a, 0x8e, 0x08, 0x49, 0x1a, 0x4a, 0x9d,
+	0xf2, 0xd9, 0xa2, 0xcd, 0x3d, 0x18, 0xc9, 0xba, 0x54, 0x13, 0xc0, 0x43, 0x0e, 0xc3, 0x3f, 0x31,
+	0xe0, 0x62, 0xea, 0xdd, 0xb9, 0xcc, 0x3a, 0x29, 0x62, 0x71, 0x52, 0x44, 0x36, 0xd5, 0x44, 0x36,
+	0xeb, 0x81, 0x62, 0x26, 0x16, 0xa1, 0x05, 0x02, 0xc4, 0xf7, 0x81, 0x4f, 0x0d, 0x58, 0x15, 0x13,
+	0xc9, 0xbd, 0xe3, 0xc3, 0x93, 0xa7, 0x69, 0x8a, 0xc8, 0x82, 0x0b, 0x21, 0xe9, 0x12, 0xe2, 0xb5,
+	0xc5, 0x0f, 0x55, 0x6a, 0xcc, 0x7a, 0x59, 0xba, 0x36, 0x43, 0x76, 0xdf, 0xe2, 0xe8, 0xc7, 0x02,
+	0x5b, 0x78, 0xb6, 0x1e, 0xea, 0x30, 0xf3, 0x26, 0xa0, 0x2c, 0x92, 0xee, 0xe3, 0x7a, 0x8e, 0x8f,
+	0x6b, 0xba, 0x8f, 0xef, 0x41, 0x4d, 0xb0, 0x9c, 0x77, 0x49, 0x19, 0x46, 0x1d, 0xaa, 0x96, 0x14,
+	0xf6, 0x8c, 0xaf, 0xc0, 0x32, 0x1b, 0x64, 0x74, 0xfb, 0x28, 0x34, 0x43, 0x43, 0x1b, 0xc0, 0x4a,
+	0x82, 0xf6, 0xac, 0x98, 0xb3, 0x3a, 0x1a, 0xb9, 0x7d, 0x5f, 0x2e, 0x48, 0x75, 0x4b, 0x9e, 0xf0,
+	0x1e, 0xac, 0xdc, 0x25, 0x61, 0x3f, 0xe5, 0xb5, 0x35, 0x28, 0xb1, 0x77, 0xe2, 0x6c, 0xe7, 0x07,
+	0x7c, 0x0d, 0x2e, 0x1e, 0xb9, 0xbe, 0x3d, 0x70, 0x1f, 0x91, 0x27, 0xa9, 0xf0, 0x1b, 0x03, 0xd6,
+	0xd2, 0xb8, 0xcf, 0x4c, 0x8f, 0x19, 0xc3, 0x99, 0x8c, 0xb6, 0xd2, 0xec, 0x11, 0xec, 0x4d, 0x68,
+	0x1e, 0x8f, 0x3a, 0x2c, 0xd2, 0x3a, 0xe4, 0xc8, 0x1d, 0x50, 0x12, 0x92, 0xae, 0x48, 0x26, 0x6d,
+	0x12, 0xe8, 0xf1, 0x0b, 0xb9, 0xc1, 0xca, 0x13, 0xfe, 0x99, 0x01, 0xe8, 0xae, 0x4d, 0x9d, 0x53,
+	0xa2, 0xcf, 0x7f, 0xf3, 0xff, 0xa8, 0xb0, 0x06, 0x25, 0xd7, 0xef, 0x92, 0xb1, 0xea, 0x2e, 0xfc,
+	0xc0, 0xd2, 0xde, 0x23, 0xe1, 0xfd, 0x01, 0x69, 0x77, 0x42, 0xdb, 0x77, 0x4e, 0x79, 0x9f, 0xa9,
+	0x59, 0x35, 0x01, 0x3c, 0xe4, 0x30, 0xfc, 0x5f, 0x03, 0xea, 0x29, 0xe1, 0x9f, 0xc1, 0x0e, 0x9d,
+	0x34, 0xf2, 0x05, 0xbd, 0x91, 0xa3, 0x97, 0x19, 0xdc, 0xee, 0x92, 0x70, 0xd2, 0xb2, 0x87, 0xa2,
+	0xb1, 0xb0, 0x2b, 0x4b, 0xa2, 0xb0, 0x06, 0xe3, 0x04, 0xbe, 0x4f, 0x1c, 0x4a, 0xba, 0x7c, 0x9f,
+	0x2e, 0x5b, 0x09, 0x80, 0xfd, 0xac, 0x2b, 0xc6, 0x0c, 0xd6, 0x3f, 0xc5, 0x5e, 0x5d, 0xe6, 0x80,
+	0x93, 0x71, 0x84, 0x5e, 0x16, 0x6d, 0xb5, 0xcc, 0x73, 0x7f, 0x53, 0x6d, 0xb3, 0x19, 0x7b, 0xf3,
+	0xc6, 0x7a, 0xfd, 0xdf, 0x6b, 0x80, 0x34, 0xe0, 0xed, 0xc0, 0xf3, 0x6c, 0xbf, 0x8b, 0xbe, 0x03,
+	0x95, 0x78, 0xbe, 0x46, 0xaa, 0x35, 0x4f, 0x7e, 0x47, 0x31, 0x1b, 0xd9, 0x0b, 0x11, 0x9f, 0x78,
+	0xeb, 0xa3, 0xbf, 0xfe, 0xf3, 0xe3, 0xc2, 0xf3, 0x78, 0xe5, 0xe0, 0xec, 0xb5, 0x03, 0x3a, 0x3e,
+	0x18, 0xb8, 0x11, 0xe5, 0xd3, 0xf3, 0x0d, 0xe3, 0x25, 0xe4, 0xc1, 0xf2, 0xc4, 0xd2, 0x8b, 0x76,
+	0xd4, 0x0f, 0x57, 0xb9, 0xcb, 0xf0, 0x0c, 0x46, 0x97, 0x38, 0xa3, 0xad, 0x1b, 0xc6, 0x4b, 0x78,
+	0x5d, 0xf2, 0xea, 0x8d, 0xfc, 0xae, 0xf6, 0xb5, 0x09, 0x9d, 0xc2, 0xf2, 0x31, 0xc9, 0x67, 0x97,
+	0xbf, 0x82, 0x98, 0xea, 0x27, 0x80, 0x43, 0x3b, 0x22, 0x93, 0x9c, 0x62, 0x36, 0x11, 0x49, 0xb1,
+	0x61, 0x8a, 0xfd, 0xd4, 0x80, 0xb5, 0xbc, 0x6d, 0x12, 0xe1, 0x54, 0x05, 0xce, 0x5d, 0xe2, 0xcc,
+	0xdd, 0x99, 0x38, 0x52, 0x88, 0xab, 0x5c, 0x88, 0x16, 0x53, 0x77, 0x4b, 0xca, 0xe1, 0x70, 0xfc,
+	0xd0, 0x7e, 0xa0, 0xeb, 0xfc, 0x43, 0x40, 0xd9, 0x5d, 0x0f, 0xb5, 0x94, 0xda, 0xd3, 0x36, 0x49,
+	0xf3, 0xd2, 0x0c, 0x0c, 0x29, 0xc2, 0x65, 0x2e, 0x42, 0x13, 0x6f, 0x2a, 0x3b, 0xb8, 0x7d, 0x3f,
+	0xcd, 0x9d, 0x99, 0xe2, 0x43, 0xbe, 0x24, 0x4d, 0xf0, 0x7f, 0x21, 0x99, 0x31, 0xf2, 0xd9, 0xb7,
+	0xa6, 0x23, 0x48, 0xee, 0xbb, 0x9c, 0xfb, 0x0e, 0x33, 0x40, 0x43, 0x0a, 0xd0, 0x27, 0x74, 0x42,
+	0x7b, 0xe6, 0x87, 0xbc, 0x8f, 0x11, 0xb1, 0x1f, 0x66, 0x7c, 0xe2, 0x32, 0x77, 0x67, 0xe2, 0xa4,
+	0xfd, 0x10, 0x3b, 0xa1, 0x4f, 0xa8, 0x26, 0x80, 0xf8, 0x24, 0xc1, 0xcc, 0xd0, 0x06, 0x48, 0x96,
+	0x31, 0xd4, 0xc8, 0xd9, 0xcf, 0x04, 0xd3, 0xcd, 0xa9, 0x9b, 0x1b, 0xde, 0xe6, 0xac, 0xd6, 0xf1,
+	0x6a, 0xc2, 0x4a, 0x0e, 0x5f, 0x8c, 0x41, 0x04, 0xcb, 0x13, 0x13, 0x5b, 0x1c, 0xdc, 0xf9, 0x23,
+	0xa8, 0xd9, 0x9c, 0x3d, 0xe8, 0x65, 0xe2, 0x9c, 0xa9, 0xc6, 0xf0, 0x34, 0xa6, 0x6d, 0x80, 0x64,
+	0x67, 0x43, 0x7a, 0x72, 0xa6, 0x56, 0x41, 0x73, 0x33, 0xe7, 0x66, 0x8a, 0x56, 0xac, 0x40, 0x70,
+	0x36, 0x91, 0x4a, 0xa4, 0xbc, 0xf5, 0x23, 0xe5, 0xc0, 0x29, 0x1b, 0x91, 0xb9, 0x3b, 0x13, 0x67,
+	0x86, 0x03, 0x19, 0xb2, 0xe6, 0x45, 0x2e, 0x89, 0x03, 0x55, 0x6d, 0x16, 0x45, 0x9a, 0x9f, 0x26,
+	0xa6, 0x56, 0xd3, 0xcc, 0xbb, 0x92, 0xdc, 0x76, 0x38, 0xb7, 0x0d, 0x8c, 0x12, 0x6e, 0x3d, 0x42,
+	0x86, 0x0c, 0x47, 0x32, 0xd1, 0x66, 0xcf, 0x98, 0x49, 0x76, 0x96, 0x35, 0xcd, 0xbc, 0xab, 0x29,
+	0x4c, 0x88, 0xc4, 0xe9, 0x11, 0x19, 0x29, 0x28, 0xfb, 0x69, 0x0a, 0xb5, 0x72, 0xa3, 0x5d, 0xfb,
+	0x6a, 0x65, 0x36, 0x73, 0x31, 0x32, 0xa5, 0x9e, 0x65, 0xe4, 0x8a, 0x66, 0xcc, 0x31, 0xfb, 0xf1,
+	0x14, 0x05, 0x70, 0x21, 0xfd, 0x59, 0x0a, 0x6d, 0x27, 0xe4, 0xb2, 0xdf, 0xb1, 0xcc, 0x9d, 0x29,
+	0xb7, 0x92, 0x57, 0x8b, 0xf3, 0x32, 0x19, 0xaf, 0xe7, 0x13, 0x5e, 0x9e, 0xc0, 0x74, 0x19, 0xf9,
+	0x5f, 0xa6, 0x3e, 0x84, 0xe9, 0xdf, 0x58, 0xd0, 0xe5, 0x0c, 0xed, 0x9c, 0xef, 0x4d, 0xe6, 0x95,
+	0x27, 0x60, 0x49, 0x49, 0xf6, 0xb8, 0x24, 0x98, 0x49, 0xb2, 0x93, 0x91, 0xa4, 0x47, 0xc8, 0x69,
+	0xcc, 0xf6, 0x63, 0x03, 0x36, 0xa6, 0xfc, 0x94, 0x8d, 0xae, 0x68, 0x09, 0x32, 0xfd, 0xcb, 0x8c,
+	0x79, 0xf5, 0x49, 0x68, 0x52, 0xa8, 0x6b, 0x5c, 0xa8, 0x5d, 0xdc, 0xd4, 0x92, 0x4a, 0x8a, 0x34,
+	0x19, 0xd7, 0x1f, 0xc2, 0x6a, 0xe6, 0x3b, 0x42, 0x5c, 0x9f, 0xa7, 0x7d, 0x90, 0x30, 0x5b, 0xd3,
+	0x11, 0xd2, 0xf5, 0x39, 0x2e, 0xce, 0x94, 0xc4, 0x22, 0xd8, 0x1c, 0x93, 0x31, 0xff, 0x36, 0x40,
+	0xb2, 0x8b, 0xc4, 0xf5, 0x23, 0xb3, 0x9e, 0xc4, 0x7d, 0x58, 0x1f, 0x7d, 0x33, 0x95, 0x43, 0xf4,
+	0x3f, 0x36, 0xd3, 0x32, 0xd2, 0xdf, 0x84, 0xb2, 0x1a, 0xfa, 0xd1, 0xba, 0xd6, 0xcc, 0x74, 0xb2,
+	0x1b, 0x19, 0xb8, 0x24, 0x6d, 0x72, 0xd2, 0x6b, 0x78, 0x59, 0x6b, 0x6d, 0x8a, 0xf0, 0x07, 0x50,
+	0x89, 0xe7, 0xfb, 0x78, 0x22, 0x9a, 0x9c, 0xf8, 0xf3, 0x25, 0xce, 0xc9, 0x10, 0x8f, 0xbd, 0xc8,
+	0x48, 0xa3, 0x3e, 0xd4, 0xf4, 0x09, 0x1f, 0xa9, 0x0c, 0xcf, 0x59, 0x11, 0xcc, 0xad, 0xdc, 0x3b,
+	0xc9, 0xa5, 0xc9, 0xb9, 0x34, 0xf0, 0x45, 0x35, 0x06, 0x49, 0x24, 0xa5, 0xc0, 0x8f, 0x0c, 0xd8,
+	0x98, 0x32, 0xb0, 0xc7, 0x71, 0x38, 0x7b, 0xa0, 0x37, 0xd7, 0x62, 0xfe, 0xda, 0xad, 0x8a, 0x3a,
+	0xa6, 0x9e, 0x0a, 0xbc, 0x48, 0xd1, 0xe9, 0x49, 0x4c, 0xb1, 0x79, 0xbf, 0x6a, 0x1c, 0x36, 0xfe,
+	0xf2, 0x79, 0xd3, 0xf8, 0xec, 0xf3, 0xa6, 0xf1, 0x8f, 0xcf, 0x9b, 0xc6, 0xaf, 0x1e, 0x37, 0x9f,
+	0xfb, 0xec, 0x71, 0xf3, 0xb9, 0xbf, 0x3d, 0x6e, 0x3e, 0xd7, 0x59, 0xe4, 0xff, 0xd7, 0xf9, 0xf2,
+	0xff, 0x06, 0x00, 0x1d, 0x31, 0x85, 0x12, 0x2a, 0x24, 0x00, 0x00,
 }
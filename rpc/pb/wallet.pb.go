@@ -27,17 +27,84 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
 
+type TxDirection int32
+
+const (
+	TxDirection_ALL      TxDirection = 0
+	TxDirection_SENT     TxDirection = 1
+	TxDirection_RECEIVED TxDirection = 2
+)
+
+var TxDirection_name = map[int32]string{
+	0: "ALL",
+	1: "SENT",
+	2: "RECEIVED",
+}
+var TxDirection_value = map[string]int32{
+	"ALL":      0,
+	"SENT":     1,
+	"RECEIVED": 2,
+}
+
+func (x TxDirection) String() string {
+	return proto.EnumName(TxDirection_name, int32(x))
+}
+func (TxDirection) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_wallet_806da22b74875d93, []int{0}
+}
+
+type TxStatus int32
+
+const (
+	TxStatus_CONFIRMED TxStatus = 0
+	TxStatus_PENDING   TxStatus = 1
+	// inputs are spent by another confirmed transaction
+	TxStatus_CONFLICTED TxStatus = 2
+	// replaced in mempool by a conflicting transaction paying more
+	TxStatus_REPLACED TxStatus = 3
+)
+
+var TxStatus_name = map[int32]string{
+	0: "CONFIRMED",
+	1: "PENDING",
+	2: "CONFLICTED",
+	3: "REPLACED",
+}
+var TxStatus_value = map[string]int32{
+	"CONFIRMED":  0,
+	"PENDING":    1,
+	"CONFLICTED": 2,
+	"REPLACED":   3,
+}
+
+func (x TxStatus) String() string {
+	return proto.EnumName(TxStatus_name, int32(x))
+}
+func (TxStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_wallet_806da22b74875d93, []int{1}
+}
+
 type ListTransactionsRequest struct {
-	Addr   string `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
-	Offset uint32 `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
-	Limit  uint32 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	Addr      string      `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
+	Limit     uint32      `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	Direction TxDirection `protobuf:"varint,4,opt,name=direction,proto3,enum=rpcpb.TxDirection" json:"direction,omitempty"`
+	// unix timestamps of the block range, 0 means unbounded
+	StartTime int64  `protobuf:"varint,5,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime   int64  `protobuf:"varint,6,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	MinAmount uint64 `protobuf:"varint,7,opt,name=min_amount,json=minAmount,proto3" json:"min_amount,omitempty"`
+	TokenOnly bool   `protobuf:"varint,8,opt,name=token_only,json=tokenOnly,proto3" json:"token_only,omitempty"`
+	// opaque cursor returned by the previous page, empty for the first page
+	Cursor string `protobuf:"bytes,9,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	// list transactions of all accounts in node wallet, watch-only ones
+	// included, instead of addr
+	Wallet bool `protobuf:"varint,10,opt,name=wallet,proto3" json:"wallet,omitempty"`
 }
 
 func (m *ListTransactionsRequest) Reset()         { *m = ListTransactionsRequest{} }
 func (m *ListTransactionsRequest) String() string { return proto.CompactTextString(m) }
 func (*ListTransactionsRequest) ProtoMessage()    {}
 func (*ListTransactionsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_wallet_925d89b13bb40012, []int{0}
+	return fileDescriptor_wallet_806da22b74875d93, []int{0}
 }
 func (m *ListTransactionsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -73,32 +140,75 @@ func (m *ListTransactionsRequest) GetAddr() string {
 	return ""
 }
 
-func (m *ListTransactionsRequest) GetOffset() uint32 {
+func (m *ListTransactionsRequest) GetLimit() uint32 {
 	if m != nil {
-		return m.Offset
+		return m.Limit
 	}
 	return 0
 }
 
-func (m *ListTransactionsRequest) GetLimit() uint32 {
+func (m *ListTransactionsRequest) GetDirection() TxDirection {
 	if m != nil {
-		return m.Limit
+		return m.Direction
+	}
+	return TxDirection_ALL
+}
+
+func (m *ListTransactionsRequest) GetStartTime() int64 {
+	if m != nil {
+		return m.StartTime
+	}
+	return 0
+}
+
+func (m *ListTransactionsRequest) GetEndTime() int64 {
+	if m != nil {
+		return m.EndTime
+	}
+	return 0
+}
+
+func (m *ListTransactionsRequest) GetMinAmount() uint64 {
+	if m != nil {
+		return m.MinAmount
 	}
 	return 0
 }
 
+func (m *ListTransactionsRequest) GetTokenOnly() bool {
+	if m != nil {
+		return m.TokenOnly
+	}
+	return false
+}
+
+func (m *ListTransactionsRequest) GetCursor() string {
+	if m != nil {
+		return m.Cursor
+	}
+	return ""
+}
+
+func (m *ListTransactionsRequest) GetWallet() bool {
+	if m != nil {
+		return m.Wallet
+	}
+	return false
+}
+
 type ListTransactionsResponse struct {
-	Code         int32             `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
-	Message      string            `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	Count        uint32            `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
-	Transactions []*pb.Transaction `protobuf:"bytes,4,rep,name=transactions" json:"transactions,omitempty"`
+	Code         int32               `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message      string              `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Count        uint32              `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	Transactions []*TransactionEntry `protobuf:"bytes,5,rep,name=transactions" json:"transactions,omitempty"`
+	NextCursor   string              `protobuf:"bytes,6,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
 }
 
 func (m *ListTransactionsResponse) Reset()         { *m = ListTransactionsResponse{} }
 func (m *ListTransactionsResponse) String() string { return proto.CompactTextString(m) }
 func (*ListTransactionsResponse) ProtoMessage()    {}
 func (*ListTransactionsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_wallet_925d89b13bb40012, []int{1}
+	return fileDescriptor_wallet_806da22b74875d93, []int{1}
 }
 func (m *ListTransactionsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -148,13 +258,171 @@ func (m *ListTransactionsResponse) GetCount() uint32 {
 	return 0
 }
 
-func (m *ListTransactionsResponse) GetTransactions() []*pb.Transaction {
+func (m *ListTransactionsResponse) GetTransactions() []*TransactionEntry {
 	if m != nil {
 		return m.Transactions
 	}
 	return nil
 }
 
+func (m *ListTransactionsResponse) GetNextCursor() string {
+	if m != nil {
+		return m.NextCursor
+	}
+	return ""
+}
+
+type TransactionEntry struct {
+	Tx          *pb.Transaction `protobuf:"bytes,1,opt,name=tx" json:"tx,omitempty"`
+	Hash        string          `protobuf:"bytes,2,opt,name=hash,proto3" json:"hash,omitempty"`
+	BlockHash   string          `protobuf:"bytes,3,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+	BlockHeight uint32          `protobuf:"varint,4,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	Timestamp   int64           `protobuf:"varint,5,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Direction   TxDirection     `protobuf:"varint,6,opt,name=direction,proto3,enum=rpcpb.TxDirection" json:"direction,omitempty"`
+	Amount      uint64          `protobuf:"varint,7,opt,name=amount,proto3" json:"amount,omitempty"`
+	HasToken    bool            `protobuf:"varint,8,opt,name=has_token,json=hasToken,proto3" json:"has_token,omitempty"`
+	// the address the entry is about
+	Addr      string `protobuf:"bytes,9,opt,name=addr,proto3" json:"addr,omitempty"`
+	WatchOnly bool   `protobuf:"varint,10,opt,name=watch_only,json=watchOnly,proto3" json:"watch_only,omitempty"`
+	// only known for transactions sent by node wallet
+	Fee    uint64   `protobuf:"varint,11,opt,name=fee,proto3" json:"fee,omitempty"`
+	Label  string   `protobuf:"bytes,12,opt,name=label,proto3" json:"label,omitempty"`
+	Status TxStatus `protobuf:"varint,13,opt,name=status,proto3,enum=rpcpb.TxStatus" json:"status,omitempty"`
+	// hash of the transaction replacing a replaced one
+	ReplacedBy string `protobuf:"bytes,14,opt,name=replaced_by,json=replacedBy,proto3" json:"replaced_by,omitempty"`
+}
+
+func (m *TransactionEntry) Reset()         { *m = TransactionEntry{} }
+func (m *TransactionEntry) String() string { return proto.CompactTextString(m) }
+func (*TransactionEntry) ProtoMessage()    {}
+func (*TransactionEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_wallet_806da22b74875d93, []int{2}
+}
+func (m *TransactionEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TransactionEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TransactionEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *TransactionEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TransactionEntry.Merge(dst, src)
+}
+func (m *TransactionEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *TransactionEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_TransactionEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TransactionEntry proto.InternalMessageInfo
+
+func (m *TransactionEntry) GetTx() *pb.Transaction {
+	if m != nil {
+		return m.Tx
+	}
+	return nil
+}
+
+func (m *TransactionEntry) GetHash() string {
+	if m != nil {
+		return m.Hash
+	}
+	return ""
+}
+
+func (m *TransactionEntry) GetBlockHash() string {
+	if m != nil {
+		return m.BlockHash
+	}
+	return ""
+}
+
+func (m *TransactionEntry) GetBlockHeight() uint32 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *TransactionEntry) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+func (m *TransactionEntry) GetDirection() TxDirection {
+	if m != nil {
+		return m.Direction
+	}
+	return TxDirection_ALL
+}
+
+func (m *TransactionEntry) GetAmount() uint64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *TransactionEntry) GetHasToken() bool {
+	if m != nil {
+		return m.HasToken
+	}
+	return false
+}
+
+func (m *TransactionEntry) GetAddr() string {
+	if m != nil {
+		return m.Addr
+	}
+	return ""
+}
+
+func (m *TransactionEntry) GetWatchOnly() bool {
+	if m != nil {
+		return m.WatchOnly
+	}
+	return false
+}
+
+func (m *TransactionEntry) GetFee() uint64 {
+	if m != nil {
+		return m.Fee
+	}
+	return 0
+}
+
+func (m *TransactionEntry) GetLabel() string {
+	if m != nil {
+		return m.Label
+	}
+	return ""
+}
+
+func (m *TransactionEntry) GetStatus() TxStatus {
+	if m != nil {
+		return m.Status
+	}
+	return TxStatus_CONFIRMED
+}
+
+func (m *TransactionEntry) GetReplacedBy() string {
+	if m != nil {
+		return m.ReplacedBy
+	}
+	return ""
+}
+
 type Transaction struct {
 	TxHash   string `protobuf:"bytes,1,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
 	RawBytes []byte `protobuf:"bytes,2,opt,name=raw_bytes,json=rawBytes,proto3" json:"raw_bytes,omitempty"`
@@ -164,7 +432,7 @@ func (m *Transaction) Reset()         { *m = Transaction{} }
 func (m *Transaction) String() string { return proto.CompactTextString(m) }
 func (*Transaction) ProtoMessage()    {}
 func (*Transaction) Descriptor() ([]byte, []int) {
-	return fileDescriptor_wallet_925d89b13bb40012, []int{2}
+	return fileDescriptor_wallet_806da22b74875d93, []int{3}
 }
 func (m *Transaction) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -215,7 +483,7 @@ func (m *GetTransactionCountRequest) Reset()         { *m = GetTransactionCountR
 func (m *GetTransactionCountRequest) String() string { return proto.CompactTextString(m) }
 func (*GetTransactionCountRequest) ProtoMessage()    {}
 func (*GetTransactionCountRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_wallet_925d89b13bb40012, []int{3}
+	return fileDescriptor_wallet_806da22b74875d93, []int{4}
 }
 func (m *GetTransactionCountRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -261,7 +529,7 @@ func (m *GetTransactionCountResponse) Reset()         { *m = GetTransactionCount
 func (m *GetTransactionCountResponse) String() string { return proto.CompactTextString(m) }
 func (*GetTransactionCountResponse) ProtoMessage()    {}
 func (*GetTransactionCountResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_wallet_925d89b13bb40012, []int{4}
+	return fileDescriptor_wallet_806da22b74875d93, []int{5}
 }
 func (m *GetTransactionCountResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -311,403 +579,7652 @@ func (m *GetTransactionCountResponse) GetCount() uint32 {
 	return 0
 }
 
-func init() {
-	proto.RegisterType((*ListTransactionsRequest)(nil), "rpcpb.ListTransactionsRequest")
-	proto.RegisterType((*ListTransactionsResponse)(nil), "rpcpb.ListTransactionsResponse")
-	proto.RegisterType((*Transaction)(nil), "rpcpb.Transaction")
-	proto.RegisterType((*GetTransactionCountRequest)(nil), "rpcpb.GetTransactionCountRequest")
-	proto.RegisterType((*GetTransactionCountResponse)(nil), "rpcpb.GetTransactionCountResponse")
+type UnlockAccountRequest struct {
+	Addr       string `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
+	Passphrase string `protobuf:"bytes,2,opt,name=passphrase,proto3" json:"passphrase,omitempty"`
+	// seconds before the account is locked again, 0 keeps it unlocked
+	Timeout uint32 `protobuf:"varint,3,opt,name=timeout,proto3" json:"timeout,omitempty"`
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+func (m *UnlockAccountRequest) Reset()         { *m = UnlockAccountRequest{} }
+func (m *UnlockAccountRequest) String() string { return proto.CompactTextString(m) }
+func (*UnlockAccountRequest) ProtoMessage()    {}
+func (*UnlockAccountRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_wallet_806da22b74875d93, []int{6}
+}
+func (m *UnlockAccountRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UnlockAccountRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UnlockAccountRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *UnlockAccountRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnlockAccountRequest.Merge(dst, src)
+}
+func (m *UnlockAccountRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *UnlockAccountRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnlockAccountRequest.DiscardUnknown(m)
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_UnlockAccountRequest proto.InternalMessageInfo
 
-// WalletCommandClient is the client API for WalletCommand service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type WalletCommandClient interface {
-	ListTransactions(ctx context.Context, in *ListTransactionsRequest, opts ...grpc.CallOption) (*ListTransactionsResponse, error)
-	GetTransactionCount(ctx context.Context, in *GetTransactionCountRequest, opts ...grpc.CallOption) (*GetTransactionCountResponse, error)
+func (m *UnlockAccountRequest) GetAddr() string {
+	if m != nil {
+		return m.Addr
+	}
+	return ""
 }
 
-type walletCommandClient struct {
-	cc *grpc.ClientConn
+func (m *UnlockAccountRequest) GetPassphrase() string {
+	if m != nil {
+		return m.Passphrase
+	}
+	return ""
 }
 
-func NewWalletCommandClient(cc *grpc.ClientConn) WalletCommandClient {
-	return &walletCommandClient{cc}
+func (m *UnlockAccountRequest) GetTimeout() uint32 {
+	if m != nil {
+		return m.Timeout
+	}
+	return 0
 }
 
-func (c *walletCommandClient) ListTransactions(ctx context.Context, in *ListTransactionsRequest, opts ...grpc.CallOption) (*ListTransactionsResponse, error) {
-	out := new(ListTransactionsResponse)
-	err := c.cc.Invoke(ctx, "/rpcpb.WalletCommand/ListTransactions", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type LockAccountRequest struct {
+	Addr string `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
 }
 
-func (c *walletCommandClient) GetTransactionCount(ctx context.Context, in *GetTransactionCountRequest, opts ...grpc.CallOption) (*GetTransactionCountResponse, error) {
-	out := new(GetTransactionCountResponse)
-	err := c.cc.Invoke(ctx, "/rpcpb.WalletCommand/GetTransactionCount", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *LockAccountRequest) Reset()         { *m = LockAccountRequest{} }
+func (m *LockAccountRequest) String() string { return proto.CompactTextString(m) }
+func (*LockAccountRequest) ProtoMessage()    {}
+func (*LockAccountRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_wallet_806da22b74875d93, []int{7}
+}
+func (m *LockAccountRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LockAccountRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LockAccountRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (dst *LockAccountRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LockAccountRequest.Merge(dst, src)
+}
+func (m *LockAccountRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *LockAccountRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_LockAccountRequest.DiscardUnknown(m)
 }
 
-// WalletCommandServer is the server API for WalletCommand service.
-type WalletCommandServer interface {
-	ListTransactions(context.Context, *ListTransactionsRequest) (*ListTransactionsResponse, error)
-	GetTransactionCount(context.Context, *GetTransactionCountRequest) (*GetTransactionCountResponse, error)
+var xxx_messageInfo_LockAccountRequest proto.InternalMessageInfo
+
+func (m *LockAccountRequest) GetAddr() string {
+	if m != nil {
+		return m.Addr
+	}
+	return ""
 }
 
-func RegisterWalletCommandServer(s *grpc.Server, srv WalletCommandServer) {
-	s.RegisterService(&_WalletCommand_serviceDesc, srv)
+type DeriveAddressRequest struct {
+	Account uint32 `protobuf:"varint,1,opt,name=account,proto3" json:"account,omitempty"`
+	// derive a change address instead of a receive address
+	Change bool `protobuf:"varint,2,opt,name=change,proto3" json:"change,omitempty"`
 }
 
-func _WalletCommand_ListTransactions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListTransactionsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *DeriveAddressRequest) Reset()         { *m = DeriveAddressRequest{} }
+func (m *DeriveAddressRequest) String() string { return proto.CompactTextString(m) }
+func (*DeriveAddressRequest) ProtoMessage()    {}
+func (*DeriveAddressRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_wallet_806da22b74875d93, []int{8}
+}
+func (m *DeriveAddressRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeriveAddressRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeriveAddressRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if interceptor == nil {
-		return srv.(WalletCommandServer).ListTransactions(ctx, in)
+}
+func (dst *DeriveAddressRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeriveAddressRequest.Merge(dst, src)
+}
+func (m *DeriveAddressRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeriveAddressRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeriveAddressRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeriveAddressRequest proto.InternalMessageInfo
+
+func (m *DeriveAddressRequest) GetAccount() uint32 {
+	if m != nil {
+		return m.Account
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/rpcpb.WalletCommand/ListTransactions",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(WalletCommandServer).ListTransactions(ctx, req.(*ListTransactionsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _WalletCommand_GetTransactionCount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetTransactionCountRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(WalletCommandServer).GetTransactionCount(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/rpcpb.WalletCommand/GetTransactionCount",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(WalletCommandServer).GetTransactionCount(ctx, req.(*GetTransactionCountRequest))
+func (m *DeriveAddressRequest) GetChange() bool {
+	if m != nil {
+		return m.Change
 	}
-	return interceptor(ctx, in, info, handler)
+	return false
 }
 
-var _WalletCommand_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "rpcpb.WalletCommand",
-	HandlerType: (*WalletCommandServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "ListTransactions",
-			Handler:    _WalletCommand_ListTransactions_Handler,
-		},
-		{
-			MethodName: "GetTransactionCount",
-			Handler:    _WalletCommand_GetTransactionCount_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "wallet.proto",
+type DeriveAddressResponse struct {
+	Code    int32  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Addr    string `protobuf:"bytes,3,opt,name=addr,proto3" json:"addr,omitempty"`
+	Path    string `protobuf:"bytes,4,opt,name=path,proto3" json:"path,omitempty"`
 }
 
-func (m *ListTransactionsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *DeriveAddressResponse) Reset()         { *m = DeriveAddressResponse{} }
+func (m *DeriveAddressResponse) String() string { return proto.CompactTextString(m) }
+func (*DeriveAddressResponse) ProtoMessage()    {}
+func (*DeriveAddressResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_wallet_806da22b74875d93, []int{9}
+}
+func (m *DeriveAddressResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeriveAddressResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeriveAddressResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (dst *DeriveAddressResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeriveAddressResponse.Merge(dst, src)
+}
+func (m *DeriveAddressResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeriveAddressResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeriveAddressResponse.DiscardUnknown(m)
 }
 
-func (m *ListTransactionsRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Addr) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintWallet(dAtA, i, uint64(len(m.Addr)))
-		i += copy(dAtA[i:], m.Addr)
-	}
-	if m.Offset != 0 {
-		dAtA[i] = 0x10
-		i++
-		i = encodeVarintWallet(dAtA, i, uint64(m.Offset))
-	}
-	if m.Limit != 0 {
-		dAtA[i] = 0x18
-		i++
-		i = encodeVarintWallet(dAtA, i, uint64(m.Limit))
+var xxx_messageInfo_DeriveAddressResponse proto.InternalMessageInfo
+
+func (m *DeriveAddressResponse) GetCode() int32 {
+	if m != nil {
+		return m.Code
 	}
-	return i, nil
+	return 0
 }
 
-func (m *ListTransactionsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *DeriveAddressResponse) GetMessage() string {
+	if m != nil {
+		return m.Message
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *ListTransactionsResponse) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Code != 0 {
-		dAtA[i] = 0x8
-		i++
-		i = encodeVarintWallet(dAtA, i, uint64(m.Code))
-	}
-	if len(m.Message) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintWallet(dAtA, i, uint64(len(m.Message)))
-		i += copy(dAtA[i:], m.Message)
+func (m *DeriveAddressResponse) GetAddr() string {
+	if m != nil {
+		return m.Addr
 	}
-	if m.Count != 0 {
-		dAtA[i] = 0x18
-		i++
-		i = encodeVarintWallet(dAtA, i, uint64(m.Count))
+	return ""
+}
+
+func (m *DeriveAddressResponse) GetPath() string {
+	if m != nil {
+		return m.Path
 	}
-	if len(m.Transactions) > 0 {
-		for _, msg := range m.Transactions {
-			dAtA[i] = 0x22
-			i++
-			i = encodeVarintWallet(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
+	return ""
+}
+
+type ScanHDWalletRequest struct {
+	// consecutive unused addresses to stop at, 0 means the default 20
+	GapLimit uint32 `protobuf:"varint,1,opt,name=gap_limit,json=gapLimit,proto3" json:"gap_limit,omitempty"`
+}
+
+func (m *ScanHDWalletRequest) Reset()         { *m = ScanHDWalletRequest{} }
+func (m *ScanHDWalletRequest) String() string { return proto.CompactTextString(m) }
+func (*ScanHDWalletRequest) ProtoMessage()    {}
+func (*ScanHDWalletRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_wallet_806da22b74875d93, []int{10}
+}
+func (m *ScanHDWalletRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScanHDWalletRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScanHDWalletRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return i, nil
+}
+func (dst *ScanHDWalletRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScanHDWalletRequest.Merge(dst, src)
+}
+func (m *ScanHDWalletRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScanHDWalletRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScanHDWalletRequest.DiscardUnknown(m)
 }
 
-func (m *Transaction) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ScanHDWalletRequest proto.InternalMessageInfo
+
+func (m *ScanHDWalletRequest) GetGapLimit() uint32 {
+	if m != nil {
+		return m.GapLimit
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *Transaction) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.TxHash) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintWallet(dAtA, i, uint64(len(m.TxHash)))
-		i += copy(dAtA[i:], m.TxHash)
-	}
-	if len(m.RawBytes) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintWallet(dAtA, i, uint64(len(m.RawBytes)))
-		i += copy(dAtA[i:], m.RawBytes)
-	}
-	return i, nil
+type ScanHDWalletResponse struct {
+	Code    int32  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	// addresses in use found by the scan
+	Addrs []string `protobuf:"bytes,3,rep,name=addrs" json:"addrs,omitempty"`
+	// total balance of all hd addresses rebuilt from chain
+	Balance uint64 `protobuf:"varint,4,opt,name=balance,proto3" json:"balance,omitempty"`
 }
 
-func (m *GetTransactionCountRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *ScanHDWalletResponse) Reset()         { *m = ScanHDWalletResponse{} }
+func (m *ScanHDWalletResponse) String() string { return proto.CompactTextString(m) }
+func (*ScanHDWalletResponse) ProtoMessage()    {}
+func (*ScanHDWalletResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_wallet_806da22b74875d93, []int{11}
+}
+func (m *ScanHDWalletResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScanHDWalletResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScanHDWalletResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (dst *ScanHDWalletResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScanHDWalletResponse.Merge(dst, src)
+}
+func (m *ScanHDWalletResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScanHDWalletResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScanHDWalletResponse.DiscardUnknown(m)
 }
 
-func (m *GetTransactionCountRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Addr) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintWallet(dAtA, i, uint64(len(m.Addr)))
-		i += copy(dAtA[i:], m.Addr)
+var xxx_messageInfo_ScanHDWalletResponse proto.InternalMessageInfo
+
+func (m *ScanHDWalletResponse) GetCode() int32 {
+	if m != nil {
+		return m.Code
 	}
-	return i, nil
+	return 0
 }
 
-func (m *GetTransactionCountResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *ScanHDWalletResponse) GetMessage() string {
+	if m != nil {
+		return m.Message
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *GetTransactionCountResponse) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Code != 0 {
-		dAtA[i] = 0x8
-		i++
-		i = encodeVarintWallet(dAtA, i, uint64(m.Code))
-	}
-	if len(m.Message) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintWallet(dAtA, i, uint64(len(m.Message)))
-		i += copy(dAtA[i:], m.Message)
+func (m *ScanHDWalletResponse) GetAddrs() []string {
+	if m != nil {
+		return m.Addrs
 	}
-	if m.Count != 0 {
-		dAtA[i] = 0x18
-		i++
-		i = encodeVarintWallet(dAtA, i, uint64(m.Count))
+	return nil
+}
+
+func (m *ScanHDWalletResponse) GetBalance() uint64 {
+	if m != nil {
+		return m.Balance
 	}
-	return i, nil
+	return 0
 }
 
-func encodeVarintWallet(dAtA []byte, offset int, v uint64) int {
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+type ImportMnemonicRequest struct {
+	// 12 or 24 words separated by spaces
+	Mnemonic string `protobuf:"bytes,1,opt,name=mnemonic,proto3" json:"mnemonic,omitempty"`
+	// optional BIP39 passphrase mixed into the seed
+	MnemonicPassphrase string `protobuf:"bytes,2,opt,name=mnemonic_passphrase,json=mnemonicPassphrase,proto3" json:"mnemonic_passphrase,omitempty"`
+	// passphrase to encrypt the seed with
+	Passphrase string `protobuf:"bytes,3,opt,name=passphrase,proto3" json:"passphrase,omitempty"`
+	// gap limit of the rescan following import, 0 means the default 20
+	GapLimit uint32 `protobuf:"varint,4,opt,name=gap_limit,json=gapLimit,proto3" json:"gap_limit,omitempty"`
+}
+
+func (m *ImportMnemonicRequest) Reset()         { *m = ImportMnemonicRequest{} }
+func (m *ImportMnemonicRequest) String() string { return proto.CompactTextString(m) }
+func (*ImportMnemonicRequest) ProtoMessage()    {}
+func (*ImportMnemonicRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_wallet_806da22b74875d93, []int{12}
+}
+func (m *ImportMnemonicRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ImportMnemonicRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ImportMnemonicRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	dAtA[offset] = uint8(v)
-	return offset + 1
 }
-func (m *ListTransactionsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (dst *ImportMnemonicRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImportMnemonicRequest.Merge(dst, src)
+}
+func (m *ImportMnemonicRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ImportMnemonicRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImportMnemonicRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ImportMnemonicRequest proto.InternalMessageInfo
+
+func (m *ImportMnemonicRequest) GetMnemonic() string {
+	if m != nil {
+		return m.Mnemonic
 	}
-	var l int
-	_ = l
-	l = len(m.Addr)
-	if l > 0 {
-		n += 1 + l + sovWallet(uint64(l))
+	return ""
+}
+
+func (m *ImportMnemonicRequest) GetMnemonicPassphrase() string {
+	if m != nil {
+		return m.MnemonicPassphrase
 	}
-	if m.Offset != 0 {
-		n += 1 + sovWallet(uint64(m.Offset))
+	return ""
+}
+
+func (m *ImportMnemonicRequest) GetPassphrase() string {
+	if m != nil {
+		return m.Passphrase
 	}
-	if m.Limit != 0 {
-		n += 1 + sovWallet(uint64(m.Limit))
+	return ""
+}
+
+func (m *ImportMnemonicRequest) GetGapLimit() uint32 {
+	if m != nil {
+		return m.GapLimit
 	}
-	return n
+	return 0
 }
 
-func (m *ListTransactionsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+type ChangePassphraseRequest struct {
+	// accounts derived from hd wallet share the passphrase of the seed
+	Addr          string `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
+	OldPassphrase string `protobuf:"bytes,2,opt,name=old_passphrase,json=oldPassphrase,proto3" json:"old_passphrase,omitempty"`
+	NewPassphrase string `protobuf:"bytes,3,opt,name=new_passphrase,json=newPassphrase,proto3" json:"new_passphrase,omitempty"`
+}
+
+func (m *ChangePassphraseRequest) Reset()         { *m = ChangePassphraseRequest{} }
+func (m *ChangePassphraseRequest) String() string { return proto.CompactTextString(m) }
+func (*ChangePassphraseRequest) ProtoMessage()    {}
+func (*ChangePassphraseRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_wallet_806da22b74875d93, []int{13}
+}
+func (m *ChangePassphraseRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChangePassphraseRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChangePassphraseRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	if m.Code != 0 {
-		n += 1 + sovWallet(uint64(m.Code))
+}
+func (dst *ChangePassphraseRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChangePassphraseRequest.Merge(dst, src)
+}
+func (m *ChangePassphraseRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChangePassphraseRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChangePassphraseRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChangePassphraseRequest proto.InternalMessageInfo
+
+func (m *ChangePassphraseRequest) GetAddr() string {
+	if m != nil {
+		return m.Addr
 	}
-	l = len(m.Message)
-	if l > 0 {
-		n += 1 + l + sovWallet(uint64(l))
+	return ""
+}
+
+func (m *ChangePassphraseRequest) GetOldPassphrase() string {
+	if m != nil {
+		return m.OldPassphrase
 	}
-	if m.Count != 0 {
-		n += 1 + sovWallet(uint64(m.Count))
+	return ""
+}
+
+func (m *ChangePassphraseRequest) GetNewPassphrase() string {
+	if m != nil {
+		return m.NewPassphrase
 	}
-	if len(m.Transactions) > 0 {
-		for _, e := range m.Transactions {
-			l = e.Size()
-			n += 1 + l + sovWallet(uint64(l))
+	return ""
+}
+
+type ImportAddressRequest struct {
+	// address tracked as watch-only, without private key
+	Addr string `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
+}
+
+func (m *ImportAddressRequest) Reset()         { *m = ImportAddressRequest{} }
+func (m *ImportAddressRequest) String() string { return proto.CompactTextString(m) }
+func (*ImportAddressRequest) ProtoMessage()    {}
+func (*ImportAddressRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_wallet_806da22b74875d93, []int{14}
+}
+func (m *ImportAddressRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ImportAddressRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ImportAddressRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (dst *ImportAddressRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImportAddressRequest.Merge(dst, src)
+}
+func (m *ImportAddressRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ImportAddressRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImportAddressRequest.DiscardUnknown(m)
 }
 
-func (m *Transaction) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_ImportAddressRequest proto.InternalMessageInfo
+
+func (m *ImportAddressRequest) GetAddr() string {
+	if m != nil {
+		return m.Addr
 	}
-	var l int
-	_ = l
-	l = len(m.TxHash)
-	if l > 0 {
-		n += 1 + l + sovWallet(uint64(l))
+	return ""
+}
+
+type ListAccountsRequest struct {
+	// case insensitive substring of labels to search, empty lists all
+	Label string `protobuf:"bytes,1,opt,name=label,proto3" json:"label,omitempty"`
+}
+
+func (m *ListAccountsRequest) Reset()         { *m = ListAccountsRequest{} }
+func (m *ListAccountsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListAccountsRequest) ProtoMessage()    {}
+func (*ListAccountsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_wallet_806da22b74875d93, []int{15}
+}
+func (m *ListAccountsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListAccountsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListAccountsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = len(m.RawBytes)
-	if l > 0 {
-		n += 1 + l + sovWallet(uint64(l))
+}
+func (dst *ListAccountsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListAccountsRequest.Merge(dst, src)
+}
+func (m *ListAccountsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListAccountsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListAccountsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListAccountsRequest proto.InternalMessageInfo
+
+func (m *ListAccountsRequest) GetLabel() string {
+	if m != nil {
+		return m.Label
+	}
+	return ""
+}
+
+type ListAccountsResponse struct {
+	Code     int32          `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message  string         `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Accounts []*AccountInfo `protobuf:"bytes,3,rep,name=accounts" json:"accounts,omitempty"`
+}
+
+func (m *ListAccountsResponse) Reset()         { *m = ListAccountsResponse{} }
+func (m *ListAccountsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListAccountsResponse) ProtoMessage()    {}
+func (*ListAccountsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_wallet_806da22b74875d93, []int{16}
+}
+func (m *ListAccountsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListAccountsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListAccountsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *ListAccountsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListAccountsResponse.Merge(dst, src)
+}
+func (m *ListAccountsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListAccountsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListAccountsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListAccountsResponse proto.InternalMessageInfo
+
+func (m *ListAccountsResponse) GetCode() int32 {
+	if m != nil {
+		return m.Code
+	}
+	return 0
+}
+
+func (m *ListAccountsResponse) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+func (m *ListAccountsResponse) GetAccounts() []*AccountInfo {
+	if m != nil {
+		return m.Accounts
+	}
+	return nil
+}
+
+type AccountInfo struct {
+	Addr  string `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
+	Label string `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
+	// unix timestamp the account is created or imported at
+	Created   int64             `protobuf:"varint,3,opt,name=created,proto3" json:"created,omitempty"`
+	Notes     map[string]string `protobuf:"bytes,4,rep,name=notes" json:"notes,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	WatchOnly bool              `protobuf:"varint,5,opt,name=watch_only,json=watchOnly,proto3" json:"watch_only,omitempty"`
+	// derivation path if the account is derived from hd wallet
+	HdPath string `protobuf:"bytes,6,opt,name=hd_path,json=hdPath,proto3" json:"hd_path,omitempty"`
+}
+
+func (m *AccountInfo) Reset()         { *m = AccountInfo{} }
+func (m *AccountInfo) String() string { return proto.CompactTextString(m) }
+func (*AccountInfo) ProtoMessage()    {}
+func (*AccountInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_wallet_806da22b74875d93, []int{17}
+}
+func (m *AccountInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AccountInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AccountInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *AccountInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountInfo.Merge(dst, src)
+}
+func (m *AccountInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *AccountInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccountInfo proto.InternalMessageInfo
+
+func (m *AccountInfo) GetAddr() string {
+	if m != nil {
+		return m.Addr
+	}
+	return ""
+}
+
+func (m *AccountInfo) GetLabel() string {
+	if m != nil {
+		return m.Label
+	}
+	return ""
+}
+
+func (m *AccountInfo) GetCreated() int64 {
+	if m != nil {
+		return m.Created
+	}
+	return 0
+}
+
+func (m *AccountInfo) GetNotes() map[string]string {
+	if m != nil {
+		return m.Notes
+	}
+	return nil
+}
+
+func (m *AccountInfo) GetWatchOnly() bool {
+	if m != nil {
+		return m.WatchOnly
+	}
+	return false
+}
+
+func (m *AccountInfo) GetHdPath() string {
+	if m != nil {
+		return m.HdPath
+	}
+	return ""
+}
+
+type SetAccountLabelRequest struct {
+	Addr string `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
+	// empty label clears it
+	Label string `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
+}
+
+func (m *SetAccountLabelRequest) Reset()         { *m = SetAccountLabelRequest{} }
+func (m *SetAccountLabelRequest) String() string { return proto.CompactTextString(m) }
+func (*SetAccountLabelRequest) ProtoMessage()    {}
+func (*SetAccountLabelRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_wallet_806da22b74875d93, []int{18}
+}
+func (m *SetAccountLabelRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SetAccountLabelRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetAccountLabelRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *SetAccountLabelRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetAccountLabelRequest.Merge(dst, src)
+}
+func (m *SetAccountLabelRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SetAccountLabelRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetAccountLabelRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetAccountLabelRequest proto.InternalMessageInfo
+
+func (m *SetAccountLabelRequest) GetAddr() string {
+	if m != nil {
+		return m.Addr
+	}
+	return ""
+}
+
+func (m *SetAccountLabelRequest) GetLabel() string {
+	if m != nil {
+		return m.Label
+	}
+	return ""
+}
+
+type SetAccountNoteRequest struct {
+	Addr string `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
+	Key  string `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	// empty value removes the note
+	Value string `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (m *SetAccountNoteRequest) Reset()         { *m = SetAccountNoteRequest{} }
+func (m *SetAccountNoteRequest) String() string { return proto.CompactTextString(m) }
+func (*SetAccountNoteRequest) ProtoMessage()    {}
+func (*SetAccountNoteRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_wallet_806da22b74875d93, []int{19}
+}
+func (m *SetAccountNoteRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SetAccountNoteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetAccountNoteRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *SetAccountNoteRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetAccountNoteRequest.Merge(dst, src)
+}
+func (m *SetAccountNoteRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SetAccountNoteRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetAccountNoteRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetAccountNoteRequest proto.InternalMessageInfo
+
+func (m *SetAccountNoteRequest) GetAddr() string {
+	if m != nil {
+		return m.Addr
+	}
+	return ""
+}
+
+func (m *SetAccountNoteRequest) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *SetAccountNoteRequest) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+type SetTransactionLabelRequest struct {
+	Hash string `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	// empty label clears it
+	Label string `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
+}
+
+func (m *SetTransactionLabelRequest) Reset()         { *m = SetTransactionLabelRequest{} }
+func (m *SetTransactionLabelRequest) String() string { return proto.CompactTextString(m) }
+func (*SetTransactionLabelRequest) ProtoMessage()    {}
+func (*SetTransactionLabelRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_wallet_806da22b74875d93, []int{20}
+}
+func (m *SetTransactionLabelRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SetTransactionLabelRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetTransactionLabelRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *SetTransactionLabelRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetTransactionLabelRequest.Merge(dst, src)
+}
+func (m *SetTransactionLabelRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SetTransactionLabelRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetTransactionLabelRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetTransactionLabelRequest proto.InternalMessageInfo
+
+func (m *SetTransactionLabelRequest) GetHash() string {
+	if m != nil {
+		return m.Hash
+	}
+	return ""
+}
+
+func (m *SetTransactionLabelRequest) GetLabel() string {
+	if m != nil {
+		return m.Label
+	}
+	return ""
+}
+
+type ConsolidateUtxosRequest struct {
+	// the account whose utxos are swept, it must be unlocked on the node
+	// unless dry_run is set
+	Addr string `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
+	// where utxos are swept to, addr if not set
+	ToAddr string `protobuf:"bytes,2,opt,name=to_addr,json=toAddr,proto3" json:"to_addr,omitempty"`
+	// node fee price is used if not set
+	FeePerByte uint64 `protobuf:"varint,3,opt,name=fee_per_byte,json=feePerByte,proto3" json:"fee_per_byte,omitempty"`
+	// only utxos worth less are swept, 0 sweeps all
+	Threshold uint64 `protobuf:"varint,4,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	// inputs per transaction, 0 means the default 100
+	MaxInputs uint32 `protobuf:"varint,5,opt,name=max_inputs,json=maxInputs,proto3" json:"max_inputs,omitempty"`
+	// return unsigned transactions without sending them
+	DryRun bool `protobuf:"varint,6,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+}
+
+func (m *ConsolidateUtxosRequest) Reset()         { *m = ConsolidateUtxosRequest{} }
+func (m *ConsolidateUtxosRequest) String() string { return proto.CompactTextString(m) }
+func (*ConsolidateUtxosRequest) ProtoMessage()    {}
+func (*ConsolidateUtxosRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_wallet_806da22b74875d93, []int{21}
+}
+func (m *ConsolidateUtxosRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConsolidateUtxosRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConsolidateUtxosRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *ConsolidateUtxosRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConsolidateUtxosRequest.Merge(dst, src)
+}
+func (m *ConsolidateUtxosRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConsolidateUtxosRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConsolidateUtxosRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConsolidateUtxosRequest proto.InternalMessageInfo
+
+func (m *ConsolidateUtxosRequest) GetAddr() string {
+	if m != nil {
+		return m.Addr
+	}
+	return ""
+}
+
+func (m *ConsolidateUtxosRequest) GetToAddr() string {
+	if m != nil {
+		return m.ToAddr
+	}
+	return ""
+}
+
+func (m *ConsolidateUtxosRequest) GetFeePerByte() uint64 {
+	if m != nil {
+		return m.FeePerByte
+	}
+	return 0
+}
+
+func (m *ConsolidateUtxosRequest) GetThreshold() uint64 {
+	if m != nil {
+		return m.Threshold
+	}
+	return 0
+}
+
+func (m *ConsolidateUtxosRequest) GetMaxInputs() uint32 {
+	if m != nil {
+		return m.MaxInputs
+	}
+	return 0
+}
+
+func (m *ConsolidateUtxosRequest) GetDryRun() bool {
+	if m != nil {
+		return m.DryRun
+	}
+	return false
+}
+
+type ConsolidateUtxosResponse struct {
+	Code    int32              `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message string             `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Txs     []*ConsolidationTx `protobuf:"bytes,3,rep,name=txs" json:"txs,omitempty"`
+	// utxos left out as they cost more fee to spend than they are worth
+	Dust uint32 `protobuf:"varint,4,opt,name=dust,proto3" json:"dust,omitempty"`
+}
+
+func (m *ConsolidateUtxosResponse) Reset()         { *m = ConsolidateUtxosResponse{} }
+func (m *ConsolidateUtxosResponse) String() string { return proto.CompactTextString(m) }
+func (*ConsolidateUtxosResponse) ProtoMessage()    {}
+func (*ConsolidateUtxosResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_wallet_806da22b74875d93, []int{22}
+}
+func (m *ConsolidateUtxosResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConsolidateUtxosResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConsolidateUtxosResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *ConsolidateUtxosResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConsolidateUtxosResponse.Merge(dst, src)
+}
+func (m *ConsolidateUtxosResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConsolidateUtxosResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConsolidateUtxosResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConsolidateUtxosResponse proto.InternalMessageInfo
+
+func (m *ConsolidateUtxosResponse) GetCode() int32 {
+	if m != nil {
+		return m.Code
+	}
+	return 0
+}
+
+func (m *ConsolidateUtxosResponse) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+func (m *ConsolidateUtxosResponse) GetTxs() []*ConsolidationTx {
+	if m != nil {
+		return m.Txs
+	}
+	return nil
+}
+
+func (m *ConsolidateUtxosResponse) GetDust() uint32 {
+	if m != nil {
+		return m.Dust
+	}
+	return 0
+}
+
+type ConsolidationTx struct {
+	Hash   string `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	Inputs uint32 `protobuf:"varint,2,opt,name=inputs,proto3" json:"inputs,omitempty"`
+	// value of the single output
+	Value uint64          `protobuf:"varint,3,opt,name=value,proto3" json:"value,omitempty"`
+	Fee   uint64          `protobuf:"varint,4,opt,name=fee,proto3" json:"fee,omitempty"`
+	Tx    *pb.Transaction `protobuf:"bytes,5,opt,name=tx" json:"tx,omitempty"`
+}
+
+func (m *ConsolidationTx) Reset()         { *m = ConsolidationTx{} }
+func (m *ConsolidationTx) String() string { return proto.CompactTextString(m) }
+func (*ConsolidationTx) ProtoMessage()    {}
+func (*ConsolidationTx) Descriptor() ([]byte, []int) {
+	return fileDescriptor_wallet_806da22b74875d93, []int{23}
+}
+func (m *ConsolidationTx) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConsolidationTx) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConsolidationTx.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *ConsolidationTx) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConsolidationTx.Merge(dst, src)
+}
+func (m *ConsolidationTx) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConsolidationTx) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConsolidationTx.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConsolidationTx proto.InternalMessageInfo
+
+func (m *ConsolidationTx) GetHash() string {
+	if m != nil {
+		return m.Hash
+	}
+	return ""
+}
+
+func (m *ConsolidationTx) GetInputs() uint32 {
+	if m != nil {
+		return m.Inputs
+	}
+	return 0
+}
+
+func (m *ConsolidationTx) GetValue() uint64 {
+	if m != nil {
+		return m.Value
+	}
+	return 0
+}
+
+func (m *ConsolidationTx) GetFee() uint64 {
+	if m != nil {
+		return m.Fee
+	}
+	return 0
+}
+
+func (m *ConsolidationTx) GetTx() *pb.Transaction {
+	if m != nil {
+		return m.Tx
+	}
+	return nil
+}
+
+type IssueTokenRequest struct {
+	// the account funding the issuance, it must be unlocked on the node
+	Addr string `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
+	// who the whole supply is issued to, addr if not set
+	ToAddr      string `protobuf:"bytes,2,opt,name=to_addr,json=toAddr,proto3" json:"to_addr,omitempty"`
+	Name        string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	TotalSupply uint64 `protobuf:"varint,4,opt,name=total_supply,json=totalSupply,proto3" json:"total_supply,omitempty"`
+	// node fee price is used if not set
+	FeePerByte uint64 `protobuf:"varint,5,opt,name=fee_per_byte,json=feePerByte,proto3" json:"fee_per_byte,omitempty"`
+}
+
+func (m *IssueTokenRequest) Reset()         { *m = IssueTokenRequest{} }
+func (m *IssueTokenRequest) String() string { return proto.CompactTextString(m) }
+func (*IssueTokenRequest) ProtoMessage()    {}
+func (*IssueTokenRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_wallet_806da22b74875d93, []int{24}
+}
+func (m *IssueTokenRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IssueTokenRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IssueTokenRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *IssueTokenRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IssueTokenRequest.Merge(dst, src)
+}
+func (m *IssueTokenRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *IssueTokenRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_IssueTokenRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IssueTokenRequest proto.InternalMessageInfo
+
+func (m *IssueTokenRequest) GetAddr() string {
+	if m != nil {
+		return m.Addr
+	}
+	return ""
+}
+
+func (m *IssueTokenRequest) GetToAddr() string {
+	if m != nil {
+		return m.ToAddr
+	}
+	return ""
+}
+
+func (m *IssueTokenRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *IssueTokenRequest) GetTotalSupply() uint64 {
+	if m != nil {
+		return m.TotalSupply
+	}
+	return 0
+}
+
+func (m *IssueTokenRequest) GetFeePerByte() uint64 {
+	if m != nil {
+		return m.FeePerByte
+	}
+	return 0
+}
+
+type TransferTokenRequest struct {
+	// the account tokens and fee are paid from, it must be unlocked on the node
+	Addr string `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
+	// the token is identified by the outpoint it's issued at
+	TokenHash  string `protobuf:"bytes,2,opt,name=token_hash,json=tokenHash,proto3" json:"token_hash,omitempty"`
+	TokenIndex uint32 `protobuf:"varint,3,opt,name=token_index,json=tokenIndex,proto3" json:"token_index,omitempty"`
+	ToAddr     string `protobuf:"bytes,4,opt,name=to_addr,json=toAddr,proto3" json:"to_addr,omitempty"`
+	Amount     uint64 `protobuf:"varint,5,opt,name=amount,proto3" json:"amount,omitempty"`
+	// node fee price is used if not set
+	FeePerByte uint64 `protobuf:"varint,6,opt,name=fee_per_byte,json=feePerByte,proto3" json:"fee_per_byte,omitempty"`
+}
+
+func (m *TransferTokenRequest) Reset()         { *m = TransferTokenRequest{} }
+func (m *TransferTokenRequest) String() string { return proto.CompactTextString(m) }
+func (*TransferTokenRequest) ProtoMessage()    {}
+func (*TransferTokenRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_wallet_806da22b74875d93, []int{25}
+}
+func (m *TransferTokenRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TransferTokenRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TransferTokenRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *TransferTokenRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TransferTokenRequest.Merge(dst, src)
+}
+func (m *TransferTokenRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *TransferTokenRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_TransferTokenRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TransferTokenRequest proto.InternalMessageInfo
+
+func (m *TransferTokenRequest) GetAddr() string {
+	if m != nil {
+		return m.Addr
+	}
+	return ""
+}
+
+func (m *TransferTokenRequest) GetTokenHash() string {
+	if m != nil {
+		return m.TokenHash
+	}
+	return ""
+}
+
+func (m *TransferTokenRequest) GetTokenIndex() uint32 {
+	if m != nil {
+		return m.TokenIndex
+	}
+	return 0
+}
+
+func (m *TransferTokenRequest) GetToAddr() string {
+	if m != nil {
+		return m.ToAddr
+	}
+	return ""
+}
+
+func (m *TransferTokenRequest) GetAmount() uint64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *TransferTokenRequest) GetFeePerByte() uint64 {
+	if m != nil {
+		return m.FeePerByte
+	}
+	return 0
+}
+
+type TokenTxResponse struct {
+	Code    int32  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	// hash of the tx sent, tokens issued by it are identified by the hash
+	// and output index 0
+	Hash string          `protobuf:"bytes,3,opt,name=hash,proto3" json:"hash,omitempty"`
+	Fee  uint64          `protobuf:"varint,4,opt,name=fee,proto3" json:"fee,omitempty"`
+	Tx   *pb.Transaction `protobuf:"bytes,5,opt,name=tx" json:"tx,omitempty"`
+}
+
+func (m *TokenTxResponse) Reset()         { *m = TokenTxResponse{} }
+func (m *TokenTxResponse) String() string { return proto.CompactTextString(m) }
+func (*TokenTxResponse) ProtoMessage()    {}
+func (*TokenTxResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_wallet_806da22b74875d93, []int{26}
+}
+func (m *TokenTxResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TokenTxResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TokenTxResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *TokenTxResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenTxResponse.Merge(dst, src)
+}
+func (m *TokenTxResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *TokenTxResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenTxResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TokenTxResponse proto.InternalMessageInfo
+
+func (m *TokenTxResponse) GetCode() int32 {
+	if m != nil {
+		return m.Code
+	}
+	return 0
+}
+
+func (m *TokenTxResponse) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+func (m *TokenTxResponse) GetHash() string {
+	if m != nil {
+		return m.Hash
+	}
+	return ""
+}
+
+func (m *TokenTxResponse) GetFee() uint64 {
+	if m != nil {
+		return m.Fee
+	}
+	return 0
+}
+
+func (m *TokenTxResponse) GetTx() *pb.Transaction {
+	if m != nil {
+		return m.Tx
+	}
+	return nil
+}
+
+type CreateMultisigRequest struct {
+	// number of signatures required to spend
+	M uint32 `protobuf:"varint,1,opt,name=m,proto3" json:"m,omitempty"`
+	// hex public keys of participants, or addresses of accounts unlocked on
+	// the node. Participants may list them in any order
+	Participants []string `protobuf:"bytes,2,rep,name=participants" json:"participants,omitempty"`
+}
+
+func (m *CreateMultisigRequest) Reset()         { *m = CreateMultisigRequest{} }
+func (m *CreateMultisigRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateMultisigRequest) ProtoMessage()    {}
+func (*CreateMultisigRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_wallet_806da22b74875d93, []int{27}
+}
+func (m *CreateMultisigRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CreateMultisigRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CreateMultisigRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *CreateMultisigRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateMultisigRequest.Merge(dst, src)
+}
+func (m *CreateMultisigRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *CreateMultisigRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateMultisigRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateMultisigRequest proto.InternalMessageInfo
+
+func (m *CreateMultisigRequest) GetM() uint32 {
+	if m != nil {
+		return m.M
+	}
+	return 0
+}
+
+func (m *CreateMultisigRequest) GetParticipants() []string {
+	if m != nil {
+		return m.Participants
+	}
+	return nil
+}
+
+type CreateMultisigResponse struct {
+	Code    int32  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	// pay to script hash address of the multisig
+	Addr string `protobuf:"bytes,3,opt,name=addr,proto3" json:"addr,omitempty"`
+	// redeem script to create PSBTs spending from addr with
+	RedeemScript []byte `protobuf:"bytes,4,opt,name=redeem_script,json=redeemScript,proto3" json:"redeem_script,omitempty"`
+	// hex public keys of participants in the order of the redeem script
+	PubKeys []string `protobuf:"bytes,5,rep,name=pub_keys,json=pubKeys" json:"pub_keys,omitempty"`
+}
+
+func (m *CreateMultisigResponse) Reset()         { *m = CreateMultisigResponse{} }
+func (m *CreateMultisigResponse) String() string { return proto.CompactTextString(m) }
+func (*CreateMultisigResponse) ProtoMessage()    {}
+func (*CreateMultisigResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_wallet_806da22b74875d93, []int{28}
+}
+func (m *CreateMultisigResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CreateMultisigResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CreateMultisigResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *CreateMultisigResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateMultisigResponse.Merge(dst, src)
+}
+func (m *CreateMultisigResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *CreateMultisigResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateMultisigResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateMultisigResponse proto.InternalMessageInfo
+
+func (m *CreateMultisigResponse) GetCode() int32 {
+	if m != nil {
+		return m.Code
+	}
+	return 0
+}
+
+func (m *CreateMultisigResponse) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+func (m *CreateMultisigResponse) GetAddr() string {
+	if m != nil {
+		return m.Addr
+	}
+	return ""
+}
+
+func (m *CreateMultisigResponse) GetRedeemScript() []byte {
+	if m != nil {
+		return m.RedeemScript
+	}
+	return nil
+}
+
+func (m *CreateMultisigResponse) GetPubKeys() []string {
+	if m != nil {
+		return m.PubKeys
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*ListTransactionsRequest)(nil), "rpcpb.ListTransactionsRequest")
+	proto.RegisterType((*ListTransactionsResponse)(nil), "rpcpb.ListTransactionsResponse")
+	proto.RegisterType((*TransactionEntry)(nil), "rpcpb.TransactionEntry")
+	proto.RegisterType((*Transaction)(nil), "rpcpb.Transaction")
+	proto.RegisterType((*GetTransactionCountRequest)(nil), "rpcpb.GetTransactionCountRequest")
+	proto.RegisterType((*GetTransactionCountResponse)(nil), "rpcpb.GetTransactionCountResponse")
+	proto.RegisterType((*UnlockAccountRequest)(nil), "rpcpb.UnlockAccountRequest")
+	proto.RegisterType((*LockAccountRequest)(nil), "rpcpb.LockAccountRequest")
+	proto.RegisterType((*DeriveAddressRequest)(nil), "rpcpb.DeriveAddressRequest")
+	proto.RegisterType((*DeriveAddressResponse)(nil), "rpcpb.DeriveAddressResponse")
+	proto.RegisterType((*ScanHDWalletRequest)(nil), "rpcpb.ScanHDWalletRequest")
+	proto.RegisterType((*ScanHDWalletResponse)(nil), "rpcpb.ScanHDWalletResponse")
+	proto.RegisterType((*ImportMnemonicRequest)(nil), "rpcpb.ImportMnemonicRequest")
+	proto.RegisterType((*ChangePassphraseRequest)(nil), "rpcpb.ChangePassphraseRequest")
+	proto.RegisterType((*ImportAddressRequest)(nil), "rpcpb.ImportAddressRequest")
+	proto.RegisterType((*ListAccountsRequest)(nil), "rpcpb.ListAccountsRequest")
+	proto.RegisterType((*ListAccountsResponse)(nil), "rpcpb.ListAccountsResponse")
+	proto.RegisterType((*AccountInfo)(nil), "rpcpb.AccountInfo")
+	proto.RegisterMapType((map[string]string)(nil), "rpcpb.AccountInfo.NotesEntry")
+	proto.RegisterType((*SetAccountLabelRequest)(nil), "rpcpb.SetAccountLabelRequest")
+	proto.RegisterType((*SetAccountNoteRequest)(nil), "rpcpb.SetAccountNoteRequest")
+	proto.RegisterType((*SetTransactionLabelRequest)(nil), "rpcpb.SetTransactionLabelRequest")
+	proto.RegisterType((*ConsolidateUtxosRequest)(nil), "rpcpb.ConsolidateUtxosRequest")
+	proto.RegisterType((*ConsolidateUtxosResponse)(nil), "rpcpb.ConsolidateUtxosResponse")
+	proto.RegisterType((*ConsolidationTx)(nil), "rpcpb.ConsolidationTx")
+	proto.RegisterType((*IssueTokenRequest)(nil), "rpcpb.IssueTokenRequest")
+	proto.RegisterType((*TransferTokenRequest)(nil), "rpcpb.TransferTokenRequest")
+	proto.RegisterType((*TokenTxResponse)(nil), "rpcpb.TokenTxResponse")
+	proto.RegisterType((*CreateMultisigRequest)(nil), "rpcpb.CreateMultisigRequest")
+	proto.RegisterType((*CreateMultisigResponse)(nil), "rpcpb.CreateMultisigResponse")
+	proto.RegisterEnum("rpcpb.TxDirection", TxDirection_name, TxDirection_value)
+	proto.RegisterEnum("rpcpb.TxStatus", TxStatus_name, TxStatus_value)
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// WalletCommandClient is the client API for WalletCommand service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type WalletCommandClient interface {
+	ListTransactions(ctx context.Context, in *ListTransactionsRequest, opts ...grpc.CallOption) (*ListTransactionsResponse, error)
+	StreamTransactions(ctx context.Context, in *ListTransactionsRequest, opts ...grpc.CallOption) (WalletCommand_StreamTransactionsClient, error)
+	GetTransactionCount(ctx context.Context, in *GetTransactionCountRequest, opts ...grpc.CallOption) (*GetTransactionCountResponse, error)
+	UnlockAccount(ctx context.Context, in *UnlockAccountRequest, opts ...grpc.CallOption) (*BaseResponse, error)
+	LockAccount(ctx context.Context, in *LockAccountRequest, opts ...grpc.CallOption) (*BaseResponse, error)
+	DeriveAddress(ctx context.Context, in *DeriveAddressRequest, opts ...grpc.CallOption) (*DeriveAddressResponse, error)
+	ScanHDWallet(ctx context.Context, in *ScanHDWalletRequest, opts ...grpc.CallOption) (*ScanHDWalletResponse, error)
+	// ImportMnemonic is left off the http gateway, mnemonics are sent over
+	// grpc only. There is no rpc to export the mnemonic, which is read from
+	// keystore files locally
+	ImportMnemonic(ctx context.Context, in *ImportMnemonicRequest, opts ...grpc.CallOption) (*ScanHDWalletResponse, error)
+	ChangePassphrase(ctx context.Context, in *ChangePassphraseRequest, opts ...grpc.CallOption) (*BaseResponse, error)
+	ImportAddress(ctx context.Context, in *ImportAddressRequest, opts ...grpc.CallOption) (*BaseResponse, error)
+	ListAccounts(ctx context.Context, in *ListAccountsRequest, opts ...grpc.CallOption) (*ListAccountsResponse, error)
+	SetAccountLabel(ctx context.Context, in *SetAccountLabelRequest, opts ...grpc.CallOption) (*BaseResponse, error)
+	SetAccountNote(ctx context.Context, in *SetAccountNoteRequest, opts ...grpc.CallOption) (*BaseResponse, error)
+	ConsolidateUtxos(ctx context.Context, in *ConsolidateUtxosRequest, opts ...grpc.CallOption) (*ConsolidateUtxosResponse, error)
+	SetTransactionLabel(ctx context.Context, in *SetTransactionLabelRequest, opts ...grpc.CallOption) (*BaseResponse, error)
+	IssueToken(ctx context.Context, in *IssueTokenRequest, opts ...grpc.CallOption) (*TokenTxResponse, error)
+	TransferToken(ctx context.Context, in *TransferTokenRequest, opts ...grpc.CallOption) (*TokenTxResponse, error)
+	CreateMultisig(ctx context.Context, in *CreateMultisigRequest, opts ...grpc.CallOption) (*CreateMultisigResponse, error)
+}
+
+type walletCommandClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewWalletCommandClient(cc *grpc.ClientConn) WalletCommandClient {
+	return &walletCommandClient{cc}
+}
+
+func (c *walletCommandClient) ListTransactions(ctx context.Context, in *ListTransactionsRequest, opts ...grpc.CallOption) (*ListTransactionsResponse, error) {
+	out := new(ListTransactionsResponse)
+	err := c.cc.Invoke(ctx, "/rpcpb.WalletCommand/ListTransactions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletCommandClient) StreamTransactions(ctx context.Context, in *ListTransactionsRequest, opts ...grpc.CallOption) (WalletCommand_StreamTransactionsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_WalletCommand_serviceDesc.Streams[0], "/rpcpb.WalletCommand/StreamTransactions", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &walletCommandStreamTransactionsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type WalletCommand_StreamTransactionsClient interface {
+	Recv() (*ListTransactionsResponse, error)
+	grpc.ClientStream
+}
+
+type walletCommandStreamTransactionsClient struct {
+	grpc.ClientStream
+}
+
+func (x *walletCommandStreamTransactionsClient) Recv() (*ListTransactionsResponse, error) {
+	m := new(ListTransactionsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *walletCommandClient) GetTransactionCount(ctx context.Context, in *GetTransactionCountRequest, opts ...grpc.CallOption) (*GetTransactionCountResponse, error) {
+	out := new(GetTransactionCountResponse)
+	err := c.cc.Invoke(ctx, "/rpcpb.WalletCommand/GetTransactionCount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletCommandClient) UnlockAccount(ctx context.Context, in *UnlockAccountRequest, opts ...grpc.CallOption) (*BaseResponse, error) {
+	out := new(BaseResponse)
+	err := c.cc.Invoke(ctx, "/rpcpb.WalletCommand/UnlockAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletCommandClient) LockAccount(ctx context.Context, in *LockAccountRequest, opts ...grpc.CallOption) (*BaseResponse, error) {
+	out := new(BaseResponse)
+	err := c.cc.Invoke(ctx, "/rpcpb.WalletCommand/LockAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletCommandClient) DeriveAddress(ctx context.Context, in *DeriveAddressRequest, opts ...grpc.CallOption) (*DeriveAddressResponse, error) {
+	out := new(DeriveAddressResponse)
+	err := c.cc.Invoke(ctx, "/rpcpb.WalletCommand/DeriveAddress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletCommandClient) ScanHDWallet(ctx context.Context, in *ScanHDWalletRequest, opts ...grpc.CallOption) (*ScanHDWalletResponse, error) {
+	out := new(ScanHDWalletResponse)
+	err := c.cc.Invoke(ctx, "/rpcpb.WalletCommand/ScanHDWallet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletCommandClient) ImportMnemonic(ctx context.Context, in *ImportMnemonicRequest, opts ...grpc.CallOption) (*ScanHDWalletResponse, error) {
+	out := new(ScanHDWalletResponse)
+	err := c.cc.Invoke(ctx, "/rpcpb.WalletCommand/ImportMnemonic", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletCommandClient) ChangePassphrase(ctx context.Context, in *ChangePassphraseRequest, opts ...grpc.CallOption) (*BaseResponse, error) {
+	out := new(BaseResponse)
+	err := c.cc.Invoke(ctx, "/rpcpb.WalletCommand/ChangePassphrase", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletCommandClient) ImportAddress(ctx context.Context, in *ImportAddressRequest, opts ...grpc.CallOption) (*BaseResponse, error) {
+	out := new(BaseResponse)
+	err := c.cc.Invoke(ctx, "/rpcpb.WalletCommand/ImportAddress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletCommandClient) ListAccounts(ctx context.Context, in *ListAccountsRequest, opts ...grpc.CallOption) (*ListAccountsResponse, error) {
+	out := new(ListAccountsResponse)
+	err := c.cc.Invoke(ctx, "/rpcpb.WalletCommand/ListAccounts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletCommandClient) SetAccountLabel(ctx context.Context, in *SetAccountLabelRequest, opts ...grpc.CallOption) (*BaseResponse, error) {
+	out := new(BaseResponse)
+	err := c.cc.Invoke(ctx, "/rpcpb.WalletCommand/SetAccountLabel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletCommandClient) SetAccountNote(ctx context.Context, in *SetAccountNoteRequest, opts ...grpc.CallOption) (*BaseResponse, error) {
+	out := new(BaseResponse)
+	err := c.cc.Invoke(ctx, "/rpcpb.WalletCommand/SetAccountNote", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletCommandClient) ConsolidateUtxos(ctx context.Context, in *ConsolidateUtxosRequest, opts ...grpc.CallOption) (*ConsolidateUtxosResponse, error) {
+	out := new(ConsolidateUtxosResponse)
+	err := c.cc.Invoke(ctx, "/rpcpb.WalletCommand/ConsolidateUtxos", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletCommandClient) SetTransactionLabel(ctx context.Context, in *SetTransactionLabelRequest, opts ...grpc.CallOption) (*BaseResponse, error) {
+	out := new(BaseResponse)
+	err := c.cc.Invoke(ctx, "/rpcpb.WalletCommand/SetTransactionLabel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletCommandClient) IssueToken(ctx context.Context, in *IssueTokenRequest, opts ...grpc.CallOption) (*TokenTxResponse, error) {
+	out := new(TokenTxResponse)
+	err := c.cc.Invoke(ctx, "/rpcpb.WalletCommand/IssueToken", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletCommandClient) TransferToken(ctx context.Context, in *TransferTokenRequest, opts ...grpc.CallOption) (*TokenTxResponse, error) {
+	out := new(TokenTxResponse)
+	err := c.cc.Invoke(ctx, "/rpcpb.WalletCommand/TransferToken", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletCommandClient) CreateMultisig(ctx context.Context, in *CreateMultisigRequest, opts ...grpc.CallOption) (*CreateMultisigResponse, error) {
+	out := new(CreateMultisigResponse)
+	err := c.cc.Invoke(ctx, "/rpcpb.WalletCommand/CreateMultisig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// WalletCommandServer is the server API for WalletCommand service.
+type WalletCommandServer interface {
+	ListTransactions(context.Context, *ListTransactionsRequest) (*ListTransactionsResponse, error)
+	StreamTransactions(*ListTransactionsRequest, WalletCommand_StreamTransactionsServer) error
+	GetTransactionCount(context.Context, *GetTransactionCountRequest) (*GetTransactionCountResponse, error)
+	UnlockAccount(context.Context, *UnlockAccountRequest) (*BaseResponse, error)
+	LockAccount(context.Context, *LockAccountRequest) (*BaseResponse, error)
+	DeriveAddress(context.Context, *DeriveAddressRequest) (*DeriveAddressResponse, error)
+	ScanHDWallet(context.Context, *ScanHDWalletRequest) (*ScanHDWalletResponse, error)
+	// ImportMnemonic is left off the http gateway, mnemonics are sent over
+	// grpc only. There is no rpc to export the mnemonic, which is read from
+	// keystore files locally
+	ImportMnemonic(context.Context, *ImportMnemonicRequest) (*ScanHDWalletResponse, error)
+	ChangePassphrase(context.Context, *ChangePassphraseRequest) (*BaseResponse, error)
+	ImportAddress(context.Context, *ImportAddressRequest) (*BaseResponse, error)
+	ListAccounts(context.Context, *ListAccountsRequest) (*ListAccountsResponse, error)
+	SetAccountLabel(context.Context, *SetAccountLabelRequest) (*BaseResponse, error)
+	SetAccountNote(context.Context, *SetAccountNoteRequest) (*BaseResponse, error)
+	ConsolidateUtxos(context.Context, *ConsolidateUtxosRequest) (*ConsolidateUtxosResponse, error)
+	SetTransactionLabel(context.Context, *SetTransactionLabelRequest) (*BaseResponse, error)
+	IssueToken(context.Context, *IssueTokenRequest) (*TokenTxResponse, error)
+	TransferToken(context.Context, *TransferTokenRequest) (*TokenTxResponse, error)
+	CreateMultisig(context.Context, *CreateMultisigRequest) (*CreateMultisigResponse, error)
+}
+
+func RegisterWalletCommandServer(s *grpc.Server, srv WalletCommandServer) {
+	s.RegisterService(&_WalletCommand_serviceDesc, srv)
+}
+
+func _WalletCommand_ListTransactions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTransactionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletCommandServer).ListTransactions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.WalletCommand/ListTransactions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletCommandServer).ListTransactions(ctx, req.(*ListTransactionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletCommand_StreamTransactions_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListTransactionsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(WalletCommandServer).StreamTransactions(m, &walletCommandStreamTransactionsServer{stream})
+}
+
+type WalletCommand_StreamTransactionsServer interface {
+	Send(*ListTransactionsResponse) error
+	grpc.ServerStream
+}
+
+type walletCommandStreamTransactionsServer struct {
+	grpc.ServerStream
+}
+
+func (x *walletCommandStreamTransactionsServer) Send(m *ListTransactionsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _WalletCommand_GetTransactionCount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTransactionCountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletCommandServer).GetTransactionCount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.WalletCommand/GetTransactionCount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletCommandServer).GetTransactionCount(ctx, req.(*GetTransactionCountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletCommand_UnlockAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnlockAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletCommandServer).UnlockAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.WalletCommand/UnlockAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletCommandServer).UnlockAccount(ctx, req.(*UnlockAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletCommand_LockAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LockAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletCommandServer).LockAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.WalletCommand/LockAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletCommandServer).LockAccount(ctx, req.(*LockAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletCommand_DeriveAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeriveAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletCommandServer).DeriveAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.WalletCommand/DeriveAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletCommandServer).DeriveAddress(ctx, req.(*DeriveAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletCommand_ScanHDWallet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScanHDWalletRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletCommandServer).ScanHDWallet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.WalletCommand/ScanHDWallet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletCommandServer).ScanHDWallet(ctx, req.(*ScanHDWalletRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletCommand_ImportMnemonic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportMnemonicRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletCommandServer).ImportMnemonic(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.WalletCommand/ImportMnemonic",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletCommandServer).ImportMnemonic(ctx, req.(*ImportMnemonicRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletCommand_ChangePassphrase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangePassphraseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletCommandServer).ChangePassphrase(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.WalletCommand/ChangePassphrase",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletCommandServer).ChangePassphrase(ctx, req.(*ChangePassphraseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletCommand_ImportAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletCommandServer).ImportAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.WalletCommand/ImportAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletCommandServer).ImportAddress(ctx, req.(*ImportAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletCommand_ListAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAccountsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletCommandServer).ListAccounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.WalletCommand/ListAccounts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletCommandServer).ListAccounts(ctx, req.(*ListAccountsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletCommand_SetAccountLabel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetAccountLabelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletCommandServer).SetAccountLabel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.WalletCommand/SetAccountLabel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletCommandServer).SetAccountLabel(ctx, req.(*SetAccountLabelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletCommand_SetAccountNote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetAccountNoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletCommandServer).SetAccountNote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.WalletCommand/SetAccountNote",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletCommandServer).SetAccountNote(ctx, req.(*SetAccountNoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletCommand_ConsolidateUtxos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConsolidateUtxosRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletCommandServer).ConsolidateUtxos(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.WalletCommand/ConsolidateUtxos",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletCommandServer).ConsolidateUtxos(ctx, req.(*ConsolidateUtxosRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletCommand_SetTransactionLabel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetTransactionLabelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletCommandServer).SetTransactionLabel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.WalletCommand/SetTransactionLabel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletCommandServer).SetTransactionLabel(ctx, req.(*SetTransactionLabelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletCommand_IssueToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IssueTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletCommandServer).IssueToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.WalletCommand/IssueToken",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletCommandServer).IssueToken(ctx, req.(*IssueTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletCommand_TransferToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransferTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletCommandServer).TransferToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.WalletCommand/TransferToken",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletCommandServer).TransferToken(ctx, req.(*TransferTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletCommand_CreateMultisig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateMultisigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletCommandServer).CreateMultisig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.WalletCommand/CreateMultisig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletCommandServer).CreateMultisig(ctx, req.(*CreateMultisigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _WalletCommand_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "rpcpb.WalletCommand",
+	HandlerType: (*WalletCommandServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListTransactions",
+			Handler:    _WalletCommand_ListTransactions_Handler,
+		},
+		{
+			MethodName: "GetTransactionCount",
+			Handler:    _WalletCommand_GetTransactionCount_Handler,
+		},
+		{
+			MethodName: "UnlockAccount",
+			Handler:    _WalletCommand_UnlockAccount_Handler,
+		},
+		{
+			MethodName: "LockAccount",
+			Handler:    _WalletCommand_LockAccount_Handler,
+		},
+		{
+			MethodName: "DeriveAddress",
+			Handler:    _WalletCommand_DeriveAddress_Handler,
+		},
+		{
+			MethodName: "ScanHDWallet",
+			Handler:    _WalletCommand_ScanHDWallet_Handler,
+		},
+		{
+			MethodName: "ImportMnemonic",
+			Handler:    _WalletCommand_ImportMnemonic_Handler,
+		},
+		{
+			MethodName: "ChangePassphrase",
+			Handler:    _WalletCommand_ChangePassphrase_Handler,
+		},
+		{
+			MethodName: "ImportAddress",
+			Handler:    _WalletCommand_ImportAddress_Handler,
+		},
+		{
+			MethodName: "ListAccounts",
+			Handler:    _WalletCommand_ListAccounts_Handler,
+		},
+		{
+			MethodName: "SetAccountLabel",
+			Handler:    _WalletCommand_SetAccountLabel_Handler,
+		},
+		{
+			MethodName: "SetAccountNote",
+			Handler:    _WalletCommand_SetAccountNote_Handler,
+		},
+		{
+			MethodName: "ConsolidateUtxos",
+			Handler:    _WalletCommand_ConsolidateUtxos_Handler,
+		},
+		{
+			MethodName: "SetTransactionLabel",
+			Handler:    _WalletCommand_SetTransactionLabel_Handler,
+		},
+		{
+			MethodName: "IssueToken",
+			Handler:    _WalletCommand_IssueToken_Handler,
+		},
+		{
+			MethodName: "TransferToken",
+			Handler:    _WalletCommand_TransferToken_Handler,
+		},
+		{
+			MethodName: "CreateMultisig",
+			Handler:    _WalletCommand_CreateMultisig_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamTransactions",
+			Handler:       _WalletCommand_StreamTransactions_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "wallet.proto",
+}
+
+func (m *ListTransactionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListTransactionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Addr) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintWallet(dAtA, i, uint64(len(m.Addr)))
+		i += copy(dAtA[i:], m.Addr)
+	}
+	if m.Limit != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintWallet(dAtA, i, uint64(m.Limit))
+	}
+	if m.Direction != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintWallet(dAtA, i, uint64(m.Direction))
+	}
+	if m.StartTime != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintWallet(dAtA, i, uint64(m.StartTime))
+	}
+	if m.EndTime != 0 {
+		dAtA[i] = 0x30
+		i++
+		i = encodeVarintWallet(dAtA, i, uint64(m.EndTime))
+	}
+	if m.MinAmount != 0 {
+		dAtA[i] = 0x38
+		i++
+		i = encodeVarintWallet(dAtA, i, uint64(m.MinAmount))
+	}
+	if m.TokenOnly {
+		dAtA[i] = 0x40
+		i++
+		if m.TokenOnly {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if len(m.Cursor) > 0 {
+		dAtA[i] = 0x4a
+		i++
+		i = encodeVarintWallet(dAtA, i, uint64(len(m.Cursor)))
+		i += copy(dAtA[i:], m.Cursor)
+	}
+	if m.Wallet {
+		dAtA[i] = 0x50
+		i++
+		if m.Wallet {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	return i, nil
+}
+
+func (m *ListTransactionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListTransactionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Code != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintWallet(dAtA, i, uint64(m.Code))
+	}
+	if len(m.Message) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintWallet(dAtA, i, uint64(len(m.Message)))
+		i += copy(dAtA[i:], m.Message)
+	}
+	if m.Count != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintWallet(dAtA, i, uint64(m.Count))
+	}
+	if len(m.Transactions) > 0 {
+		for _, msg := range m.Transactions {
+			dAtA[i] = 0x2a
+			i++
+			i = encodeVarintWallet(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.NextCursor) > 0 {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintWallet(dAtA, i, uint64(len(m.NextCursor)))
+		i += copy(dAtA[i:], m.NextCursor)
+	}
+	return i, nil
+}
+
+func (m *TransactionEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TransactionEntry) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Tx != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintWallet(dAtA, i, uint64(m.Tx.Size()))
+		n1, err := m.Tx.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n1
+	}
+	if len(m.Hash) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintWallet(dAtA, i, uint64(len(m.Hash)))
+		i += copy(dAtA[i:], m.Hash)
+	}
+	if len(m.BlockHash) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintWallet(dAtA, i, uint64(len(m.BlockHash)))
+		i += copy(dAtA[i:], m.BlockHash)
+	}
+	if m.BlockHeight != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintWallet(dAtA, i, uint64(m.BlockHeight))
+	}
+	if m.Timestamp != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintWallet(dAtA, i, uint64(m.Timestamp))
+	}
+	if m.Direction != 0 {
+		dAtA[i] = 0x30
+		i++
+		i = encodeVarintWallet(dAtA, i, uint64(m.Direction))
+	}
+	if m.Amount != 0 {
+		dAtA[i] = 0x38
+		i++
+		i = encodeVarintWallet(dAtA, i, uint64(m.Amount))
+	}
+	if m.HasToken {
+		dAtA[i] = 0x40
+		i++
+		if m.HasToken {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if len(m.Addr) > 0 {
+		dAtA[i] = 0x4a
+		i++
+		i = encodeVarintWallet(dAtA, i, uint64(len(m.Addr)))
+		i += copy(dAtA[i:], m.Addr)
+	}
+	if m.WatchOnly {
+		dAtA[i] = 0x50
+		i++
+		if m.WatchOnly {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.Fee != 0 {
+		dAtA[i] = 0x58
+		i++
+		i = encodeVarintWallet(dAtA, i, uint64(m.Fee))
+	}
+	if len(m.Label) > 0 {
+		dAtA[i] = 0x62
+		i++
+		i = encodeVarintWallet(dAtA, i, uint64(len(m.Label)))
+		i += copy(dAtA[i:], m.Label)
+	}
+	if m.Status != 0 {
+		dAtA[i] = 0x68
+		i++
+		i = encodeVarintWallet(dAtA, i, uint64(m.Status))
+	}
+	if len(m.ReplacedBy) > 0 {
+		dAtA[i] = 0x72
+		i++
+		i = encodeVarintWallet(dAtA, i, uint64(len(m.ReplacedBy)))
+		i += copy(dAtA[i:], m.ReplacedBy)
+	}
+	return i, nil
+}
+
+func (m *Transaction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Transaction) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.TxHash) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintWallet(dAtA, i, uint64(len(m.TxHash)))
+		i += copy(dAtA[i:], m.TxHash)
+	}
+	if len(m.RawBytes) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintWallet(dAtA, i, uint64(len(m.RawBytes)))
+		i += copy(dAtA[i:], m.RawBytes)
+	}
+	return i, nil
+}
+
+func (m *GetTransactionCountRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetTransactionCountRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Addr) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintWallet(dAtA, i, uint64(len(m.Addr)))
+		i += copy(dAtA[i:], m.Addr)
+	}
+	return i, nil
+}
+
+func (m *GetTransactionCountResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetTransactionCountResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Code != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintWallet(dAtA, i, uint64(m.Code))
+	}
+	if len(m.Message) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintWallet(dAtA, i, uint64(len(m.Message)))
+		i += copy(dAtA[i:], m.Message)
+	}
+	if m.Count != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintWallet(dAtA, i, uint64(m.Count))
+	}
+	return i, nil
+}
+
+func (m *UnlockAccountRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UnlockAccountRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Addr) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintWallet(dAtA, i, uint64(len(m.Addr)))
+		i += copy(dAtA[i:], m.Addr)
+	}
+	if len(m.Passphrase) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintWallet(dAtA, i, uint64(len(m.Passphrase)))
+		i += copy(dAtA[i:], m.Passphrase)
+	}
+	if m.Timeout != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintWallet(dAtA, i, uint64(m.Timeout))
+	}
+	return i, nil
+}
+
+func (m *LockAccountRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LockAccountRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Addr) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintWallet(dAtA, i, uint64(len(m.Addr)))
+		i += copy(dAtA[i:], m.Addr)
+	}
+	return i, nil
+}
+
+func (m *DeriveAddressRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeriveAddressRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Account != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintWallet(dAtA, i, uint64(m.Account))
+	}
+	if m.Change {
+		dAtA[i] = 0x10
+		i++
+		if m.Change {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	return i, nil
+}
+
+func (m *DeriveAddressResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeriveAddressResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Code != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintWallet(dAtA, i, uint64(m.Code))
+	}
+	if len(m.Message) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintWallet(dAtA, i, uint64(len(m.Message)))
+		i += copy(dAtA[i:], m.Message)
+	}
+	if len(m.Addr) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintWallet(dAtA, i, uint64(len(m.Addr)))
+		i += copy(dAtA[i:], m.Addr)
+	}
+	if len(m.Path) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintWallet(dAtA, i, uint64(len(m.Path)))
+		i += copy(dAtA[i:], m.Path)
+	}
+	return i, nil
+}
+
+func (m *ScanHDWalletRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScanHDWalletRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.GapLimit != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintWallet(dAtA, i, uint64(m.GapLimit))
+	}
+	return i, nil
+}
+
+func (m *ScanHDWalletResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScanHDWalletResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Code != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintWallet(dAtA, i, uint64(m.Code))
+	}
+	if len(m.Message) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintWallet(dAtA, i, uint64(len(m.Message)))
+		i += copy(dAtA[i:], m.Message)
+	}
+	if len(m.Addrs) > 0 {
+		for _, s := range m.Addrs {
+			dAtA[i] = 0x1a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if m.Balance != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintWallet(dAtA, i, uint64(m.Balance))
+	}
+	return i, nil
+}
+
+func (m *ImportMnemonicRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ImportMnemonicRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Mnemonic) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintWallet(dAtA, i, uint64(len(m.Mnemonic)))
+		i += copy(dAtA[i:], m.Mnemonic)
+	}
+	if len(m.MnemonicPassphrase) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintWallet(dAtA, i, uint64(len(m.MnemonicPassphrase)))
+		i += copy(dAtA[i:], m.MnemonicPassphrase)
+	}
+	if len(m.Passphrase) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintWallet(dAtA, i, uint64(len(m.Passphrase)))
+		i += copy(dAtA[i:], m.Passphrase)
+	}
+	if m.GapLimit != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintWallet(dAtA, i, uint64(m.GapLimit))
+	}
+	return i, nil
+}
+
+func (m *ChangePassphraseRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChangePassphraseRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Addr) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintWallet(dAtA, i, uint64(len(m.Addr)))
+		i += copy(dAtA[i:], m.Addr)
+	}
+	if len(m.OldPassphrase) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintWallet(dAtA, i, uint64(len(m.OldPassphrase)))
+		i += copy(dAtA[i:], m.OldPassphrase)
+	}
+	if len(m.NewPassphrase) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintWallet(dAtA, i, uint64(len(m.NewPassphrase)))
+		i += copy(dAtA[i:], m.NewPassphrase)
+	}
+	return i, nil
+}
+
+func (m *ImportAddressRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ImportAddressRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Addr) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintWallet(dAtA, i, uint64(len(m.Addr)))
+		i += copy(dAtA[i:], m.Addr)
+	}
+	return i, nil
+}
+
+func (m *ListAccountsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListAccountsRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Label) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintWallet(dAtA, i, uint64(len(m.Label)))
+		i += copy(dAtA[i:], m.Label)
+	}
+	return i, nil
+}
+
+func (m *ListAccountsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListAccountsResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Code != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintWallet(dAtA, i, uint64(m.Code))
+	}
+	if len(m.Message) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintWallet(dAtA, i, uint64(len(m.Message)))
+		i += copy(dAtA[i:], m.Message)
+	}
+	if len(m.Accounts) > 0 {
+		for _, msg := range m.Accounts {
+			dAtA[i] = 0x1a
+			i++
+			i = encodeVarintWallet(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *AccountInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AccountInfo) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Addr) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintWallet(dAtA, i, uint64(len(m.Addr)))
+		i += copy(dAtA[i:], m.Addr)
+	}
+	if len(m.Label) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintWallet(dAtA, i, uint64(len(m.Label)))
+		i += copy(dAtA[i:], m.Label)
+	}
+	if m.Created != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintWallet(dAtA, i, uint64(m.Created))
+	}
+	if len(m.Notes) > 0 {
+		for k, _ := range m.Notes {
+			dAtA[i] = 0x22
+			i++
+			v := m.Notes[k]
+			mapSize := 1 + len(k) + sovWallet(uint64(len(k))) + 1 + len(v) + sovWallet(uint64(len(v)))
+			i = encodeVarintWallet(dAtA, i, uint64(mapSize))
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintWallet(dAtA, i, uint64(len(k)))
+			i += copy(dAtA[i:], k)
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintWallet(dAtA, i, uint64(len(v)))
+			i += copy(dAtA[i:], v)
+		}
+	}
+	if m.WatchOnly {
+		dAtA[i] = 0x28
+		i++
+		if m.WatchOnly {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if len(m.HdPath) > 0 {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintWallet(dAtA, i, uint64(len(m.HdPath)))
+		i += copy(dAtA[i:], m.HdPath)
+	}
+	return i, nil
+}
+
+func (m *SetAccountLabelRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SetAccountLabelRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Addr) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintWallet(dAtA, i, uint64(len(m.Addr)))
+		i += copy(dAtA[i:], m.Addr)
+	}
+	if len(m.Label) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintWallet(dAtA, i, uint64(len(m.Label)))
+		i += copy(dAtA[i:], m.Label)
+	}
+	return i, nil
+}
+
+func (m *SetAccountNoteRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SetAccountNoteRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Addr) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintWallet(dAtA, i, uint64(len(m.Addr)))
+		i += copy(dAtA[i:], m.Addr)
+	}
+	if len(m.Key) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintWallet(dAtA, i, uint64(len(m.Key)))
+		i += copy(dAtA[i:], m.Key)
+	}
+	if len(m.Value) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintWallet(dAtA, i, uint64(len(m.Value)))
+		i += copy(dAtA[i:], m.Value)
+	}
+	return i, nil
+}
+
+func (m *SetTransactionLabelRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SetTransactionLabelRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Hash) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintWallet(dAtA, i, uint64(len(m.Hash)))
+		i += copy(dAtA[i:], m.Hash)
+	}
+	if len(m.Label) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintWallet(dAtA, i, uint64(len(m.Label)))
+		i += copy(dAtA[i:], m.Label)
+	}
+	return i, nil
+}
+
+func (m *ConsolidateUtxosRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConsolidateUtxosRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Addr) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintWallet(dAtA, i, uint64(len(m.Addr)))
+		i += copy(dAtA[i:], m.Addr)
+	}
+	if len(m.ToAddr) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintWallet(dAtA, i, uint64(len(m.ToAddr)))
+		i += copy(dAtA[i:], m.ToAddr)
+	}
+	if m.FeePerByte != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintWallet(dAtA, i, uint64(m.FeePerByte))
+	}
+	if m.Threshold != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintWallet(dAtA, i, uint64(m.Threshold))
+	}
+	if m.MaxInputs != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintWallet(dAtA, i, uint64(m.MaxInputs))
+	}
+	if m.DryRun {
+		dAtA[i] = 0x30
+		i++
+		if m.DryRun {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	return i, nil
+}
+
+func (m *ConsolidateUtxosResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConsolidateUtxosResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Code != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintWallet(dAtA, i, uint64(m.Code))
+	}
+	if len(m.Message) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintWallet(dAtA, i, uint64(len(m.Message)))
+		i += copy(dAtA[i:], m.Message)
+	}
+	if len(m.Txs) > 0 {
+		for _, msg := range m.Txs {
+			dAtA[i] = 0x1a
+			i++
+			i = encodeVarintWallet(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.Dust != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintWallet(dAtA, i, uint64(m.Dust))
+	}
+	return i, nil
+}
+
+func (m *ConsolidationTx) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConsolidationTx) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Hash) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintWallet(dAtA, i, uint64(len(m.Hash)))
+		i += copy(dAtA[i:], m.Hash)
+	}
+	if m.Inputs != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintWallet(dAtA, i, uint64(m.Inputs))
+	}
+	if m.Value != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintWallet(dAtA, i, uint64(m.Value))
+	}
+	if m.Fee != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintWallet(dAtA, i, uint64(m.Fee))
+	}
+	if m.Tx != nil {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintWallet(dAtA, i, uint64(m.Tx.Size()))
+		n2, err := m.Tx.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n2
+	}
+	return i, nil
+}
+
+func (m *IssueTokenRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IssueTokenRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Addr) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintWallet(dAtA, i, uint64(len(m.Addr)))
+		i += copy(dAtA[i:], m.Addr)
+	}
+	if len(m.ToAddr) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintWallet(dAtA, i, uint64(len(m.ToAddr)))
+		i += copy(dAtA[i:], m.ToAddr)
+	}
+	if len(m.Name) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintWallet(dAtA, i, uint64(len(m.Name)))
+		i += copy(dAtA[i:], m.Name)
+	}
+	if m.TotalSupply != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintWallet(dAtA, i, uint64(m.TotalSupply))
+	}
+	if m.FeePerByte != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintWallet(dAtA, i, uint64(m.FeePerByte))
+	}
+	return i, nil
+}
+
+func (m *TransferTokenRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TransferTokenRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Addr) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintWallet(dAtA, i, uint64(len(m.Addr)))
+		i += copy(dAtA[i:], m.Addr)
+	}
+	if len(m.TokenHash) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintWallet(dAtA, i, uint64(len(m.TokenHash)))
+		i += copy(dAtA[i:], m.TokenHash)
+	}
+	if m.TokenIndex != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintWallet(dAtA, i, uint64(m.TokenIndex))
+	}
+	if len(m.ToAddr) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintWallet(dAtA, i, uint64(len(m.ToAddr)))
+		i += copy(dAtA[i:], m.ToAddr)
+	}
+	if m.Amount != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintWallet(dAtA, i, uint64(m.Amount))
+	}
+	if m.FeePerByte != 0 {
+		dAtA[i] = 0x30
+		i++
+		i = encodeVarintWallet(dAtA, i, uint64(m.FeePerByte))
+	}
+	return i, nil
+}
+
+func (m *TokenTxResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TokenTxResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Code != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintWallet(dAtA, i, uint64(m.Code))
+	}
+	if len(m.Message) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintWallet(dAtA, i, uint64(len(m.Message)))
+		i += copy(dAtA[i:], m.Message)
+	}
+	if len(m.Hash) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintWallet(dAtA, i, uint64(len(m.Hash)))
+		i += copy(dAtA[i:], m.Hash)
+	}
+	if m.Fee != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintWallet(dAtA, i, uint64(m.Fee))
+	}
+	if m.Tx != nil {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintWallet(dAtA, i, uint64(m.Tx.Size()))
+		n3, err := m.Tx.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n3
+	}
+	return i, nil
+}
+
+func (m *CreateMultisigRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CreateMultisigRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.M != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintWallet(dAtA, i, uint64(m.M))
+	}
+	if len(m.Participants) > 0 {
+		for _, s := range m.Participants {
+			dAtA[i] = 0x12
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	return i, nil
+}
+
+func (m *CreateMultisigResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CreateMultisigResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Code != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintWallet(dAtA, i, uint64(m.Code))
+	}
+	if len(m.Message) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintWallet(dAtA, i, uint64(len(m.Message)))
+		i += copy(dAtA[i:], m.Message)
+	}
+	if len(m.Addr) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintWallet(dAtA, i, uint64(len(m.Addr)))
+		i += copy(dAtA[i:], m.Addr)
+	}
+	if len(m.RedeemScript) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintWallet(dAtA, i, uint64(len(m.RedeemScript)))
+		i += copy(dAtA[i:], m.RedeemScript)
+	}
+	if len(m.PubKeys) > 0 {
+		for _, s := range m.PubKeys {
+			dAtA[i] = 0x2a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	return i, nil
+}
+
+func encodeVarintWallet(dAtA []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return offset + 1
+}
+func (m *ListTransactionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Addr)
+	if l > 0 {
+		n += 1 + l + sovWallet(uint64(l))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovWallet(uint64(m.Limit))
+	}
+	if m.Direction != 0 {
+		n += 1 + sovWallet(uint64(m.Direction))
+	}
+	if m.StartTime != 0 {
+		n += 1 + sovWallet(uint64(m.StartTime))
+	}
+	if m.EndTime != 0 {
+		n += 1 + sovWallet(uint64(m.EndTime))
+	}
+	if m.MinAmount != 0 {
+		n += 1 + sovWallet(uint64(m.MinAmount))
+	}
+	if m.TokenOnly {
+		n += 2
+	}
+	l = len(m.Cursor)
+	if l > 0 {
+		n += 1 + l + sovWallet(uint64(l))
+	}
+	if m.Wallet {
+		n += 2
+	}
+	return n
+}
+
+func (m *ListTransactionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Code != 0 {
+		n += 1 + sovWallet(uint64(m.Code))
+	}
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovWallet(uint64(l))
+	}
+	if m.Count != 0 {
+		n += 1 + sovWallet(uint64(m.Count))
+	}
+	if len(m.Transactions) > 0 {
+		for _, e := range m.Transactions {
+			l = e.Size()
+			n += 1 + l + sovWallet(uint64(l))
+		}
+	}
+	l = len(m.NextCursor)
+	if l > 0 {
+		n += 1 + l + sovWallet(uint64(l))
+	}
+	return n
+}
+
+func (m *TransactionEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Tx != nil {
+		l = m.Tx.Size()
+		n += 1 + l + sovWallet(uint64(l))
+	}
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovWallet(uint64(l))
+	}
+	l = len(m.BlockHash)
+	if l > 0 {
+		n += 1 + l + sovWallet(uint64(l))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovWallet(uint64(m.BlockHeight))
+	}
+	if m.Timestamp != 0 {
+		n += 1 + sovWallet(uint64(m.Timestamp))
+	}
+	if m.Direction != 0 {
+		n += 1 + sovWallet(uint64(m.Direction))
+	}
+	if m.Amount != 0 {
+		n += 1 + sovWallet(uint64(m.Amount))
+	}
+	if m.HasToken {
+		n += 2
+	}
+	l = len(m.Addr)
+	if l > 0 {
+		n += 1 + l + sovWallet(uint64(l))
+	}
+	if m.WatchOnly {
+		n += 2
+	}
+	if m.Fee != 0 {
+		n += 1 + sovWallet(uint64(m.Fee))
+	}
+	l = len(m.Label)
+	if l > 0 {
+		n += 1 + l + sovWallet(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovWallet(uint64(m.Status))
+	}
+	l = len(m.ReplacedBy)
+	if l > 0 {
+		n += 1 + l + sovWallet(uint64(l))
+	}
+	return n
+}
+
+func (m *Transaction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TxHash)
+	if l > 0 {
+		n += 1 + l + sovWallet(uint64(l))
+	}
+	l = len(m.RawBytes)
+	if l > 0 {
+		n += 1 + l + sovWallet(uint64(l))
+	}
+	return n
+}
+
+func (m *GetTransactionCountRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Addr)
+	if l > 0 {
+		n += 1 + l + sovWallet(uint64(l))
+	}
+	return n
+}
+
+func (m *GetTransactionCountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Code != 0 {
+		n += 1 + sovWallet(uint64(m.Code))
+	}
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovWallet(uint64(l))
+	}
+	if m.Count != 0 {
+		n += 1 + sovWallet(uint64(m.Count))
+	}
+	return n
+}
+
+func (m *UnlockAccountRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Addr)
+	if l > 0 {
+		n += 1 + l + sovWallet(uint64(l))
+	}
+	l = len(m.Passphrase)
+	if l > 0 {
+		n += 1 + l + sovWallet(uint64(l))
+	}
+	if m.Timeout != 0 {
+		n += 1 + sovWallet(uint64(m.Timeout))
+	}
+	return n
+}
+
+func (m *LockAccountRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Addr)
+	if l > 0 {
+		n += 1 + l + sovWallet(uint64(l))
+	}
+	return n
+}
+
+func (m *DeriveAddressRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Account != 0 {
+		n += 1 + sovWallet(uint64(m.Account))
+	}
+	if m.Change {
+		n += 2
+	}
+	return n
+}
+
+func (m *DeriveAddressResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Code != 0 {
+		n += 1 + sovWallet(uint64(m.Code))
+	}
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovWallet(uint64(l))
+	}
+	l = len(m.Addr)
+	if l > 0 {
+		n += 1 + l + sovWallet(uint64(l))
+	}
+	l = len(m.Path)
+	if l > 0 {
+		n += 1 + l + sovWallet(uint64(l))
+	}
+	return n
+}
+
+func (m *ScanHDWalletRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GapLimit != 0 {
+		n += 1 + sovWallet(uint64(m.GapLimit))
+	}
+	return n
+}
+
+func (m *ScanHDWalletResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Code != 0 {
+		n += 1 + sovWallet(uint64(m.Code))
+	}
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovWallet(uint64(l))
+	}
+	if len(m.Addrs) > 0 {
+		for _, s := range m.Addrs {
+			l = len(s)
+			n += 1 + l + sovWallet(uint64(l))
+		}
+	}
+	if m.Balance != 0 {
+		n += 1 + sovWallet(uint64(m.Balance))
+	}
+	return n
+}
+
+func (m *ImportMnemonicRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Mnemonic)
+	if l > 0 {
+		n += 1 + l + sovWallet(uint64(l))
+	}
+	l = len(m.MnemonicPassphrase)
+	if l > 0 {
+		n += 1 + l + sovWallet(uint64(l))
+	}
+	l = len(m.Passphrase)
+	if l > 0 {
+		n += 1 + l + sovWallet(uint64(l))
+	}
+	if m.GapLimit != 0 {
+		n += 1 + sovWallet(uint64(m.GapLimit))
+	}
+	return n
+}
+
+func (m *ChangePassphraseRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Addr)
+	if l > 0 {
+		n += 1 + l + sovWallet(uint64(l))
+	}
+	l = len(m.OldPassphrase)
+	if l > 0 {
+		n += 1 + l + sovWallet(uint64(l))
+	}
+	l = len(m.NewPassphrase)
+	if l > 0 {
+		n += 1 + l + sovWallet(uint64(l))
+	}
+	return n
+}
+
+func (m *ImportAddressRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Addr)
+	if l > 0 {
+		n += 1 + l + sovWallet(uint64(l))
+	}
+	return n
+}
+
+func (m *ListAccountsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Label)
+	if l > 0 {
+		n += 1 + l + sovWallet(uint64(l))
+	}
+	return n
+}
+
+func (m *ListAccountsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Code != 0 {
+		n += 1 + sovWallet(uint64(m.Code))
+	}
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovWallet(uint64(l))
+	}
+	if len(m.Accounts) > 0 {
+		for _, e := range m.Accounts {
+			l = e.Size()
+			n += 1 + l + sovWallet(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *AccountInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Addr)
+	if l > 0 {
+		n += 1 + l + sovWallet(uint64(l))
+	}
+	l = len(m.Label)
+	if l > 0 {
+		n += 1 + l + sovWallet(uint64(l))
+	}
+	if m.Created != 0 {
+		n += 1 + sovWallet(uint64(m.Created))
+	}
+	if len(m.Notes) > 0 {
+		for k, v := range m.Notes {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovWallet(uint64(len(k))) + 1 + len(v) + sovWallet(uint64(len(v)))
+			n += mapEntrySize + 1 + sovWallet(uint64(mapEntrySize))
+		}
+	}
+	if m.WatchOnly {
+		n += 2
+	}
+	l = len(m.HdPath)
+	if l > 0 {
+		n += 1 + l + sovWallet(uint64(l))
+	}
+	return n
+}
+
+func (m *SetAccountLabelRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Addr)
+	if l > 0 {
+		n += 1 + l + sovWallet(uint64(l))
+	}
+	l = len(m.Label)
+	if l > 0 {
+		n += 1 + l + sovWallet(uint64(l))
+	}
+	return n
+}
+
+func (m *SetAccountNoteRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Addr)
+	if l > 0 {
+		n += 1 + l + sovWallet(uint64(l))
+	}
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovWallet(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovWallet(uint64(l))
+	}
+	return n
+}
+
+func (m *SetTransactionLabelRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovWallet(uint64(l))
+	}
+	l = len(m.Label)
+	if l > 0 {
+		n += 1 + l + sovWallet(uint64(l))
+	}
+	return n
+}
+
+func (m *ConsolidateUtxosRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Addr)
+	if l > 0 {
+		n += 1 + l + sovWallet(uint64(l))
+	}
+	l = len(m.ToAddr)
+	if l > 0 {
+		n += 1 + l + sovWallet(uint64(l))
+	}
+	if m.FeePerByte != 0 {
+		n += 1 + sovWallet(uint64(m.FeePerByte))
+	}
+	if m.Threshold != 0 {
+		n += 1 + sovWallet(uint64(m.Threshold))
+	}
+	if m.MaxInputs != 0 {
+		n += 1 + sovWallet(uint64(m.MaxInputs))
+	}
+	if m.DryRun {
+		n += 2
+	}
+	return n
+}
+
+func (m *ConsolidateUtxosResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Code != 0 {
+		n += 1 + sovWallet(uint64(m.Code))
+	}
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovWallet(uint64(l))
+	}
+	if len(m.Txs) > 0 {
+		for _, e := range m.Txs {
+			l = e.Size()
+			n += 1 + l + sovWallet(uint64(l))
+		}
+	}
+	if m.Dust != 0 {
+		n += 1 + sovWallet(uint64(m.Dust))
+	}
+	return n
+}
+
+func (m *ConsolidationTx) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovWallet(uint64(l))
+	}
+	if m.Inputs != 0 {
+		n += 1 + sovWallet(uint64(m.Inputs))
+	}
+	if m.Value != 0 {
+		n += 1 + sovWallet(uint64(m.Value))
+	}
+	if m.Fee != 0 {
+		n += 1 + sovWallet(uint64(m.Fee))
+	}
+	if m.Tx != nil {
+		l = m.Tx.Size()
+		n += 1 + l + sovWallet(uint64(l))
+	}
+	return n
+}
+
+func (m *IssueTokenRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Addr)
+	if l > 0 {
+		n += 1 + l + sovWallet(uint64(l))
+	}
+	l = len(m.ToAddr)
+	if l > 0 {
+		n += 1 + l + sovWallet(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovWallet(uint64(l))
+	}
+	if m.TotalSupply != 0 {
+		n += 1 + sovWallet(uint64(m.TotalSupply))
+	}
+	if m.FeePerByte != 0 {
+		n += 1 + sovWallet(uint64(m.FeePerByte))
+	}
+	return n
+}
+
+func (m *TransferTokenRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Addr)
+	if l > 0 {
+		n += 1 + l + sovWallet(uint64(l))
+	}
+	l = len(m.TokenHash)
+	if l > 0 {
+		n += 1 + l + sovWallet(uint64(l))
+	}
+	if m.TokenIndex != 0 {
+		n += 1 + sovWallet(uint64(m.TokenIndex))
+	}
+	l = len(m.ToAddr)
+	if l > 0 {
+		n += 1 + l + sovWallet(uint64(l))
+	}
+	if m.Amount != 0 {
+		n += 1 + sovWallet(uint64(m.Amount))
+	}
+	if m.FeePerByte != 0 {
+		n += 1 + sovWallet(uint64(m.FeePerByte))
+	}
+	return n
+}
+
+func (m *TokenTxResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Code != 0 {
+		n += 1 + sovWallet(uint64(m.Code))
+	}
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovWallet(uint64(l))
+	}
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovWallet(uint64(l))
+	}
+	if m.Fee != 0 {
+		n += 1 + sovWallet(uint64(m.Fee))
+	}
+	if m.Tx != nil {
+		l = m.Tx.Size()
+		n += 1 + l + sovWallet(uint64(l))
+	}
+	return n
+}
+
+func (m *CreateMultisigRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.M != 0 {
+		n += 1 + sovWallet(uint64(m.M))
+	}
+	if len(m.Participants) > 0 {
+		for _, s := range m.Participants {
+			l = len(s)
+			n += 1 + l + sovWallet(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *CreateMultisigResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Code != 0 {
+		n += 1 + sovWallet(uint64(m.Code))
+	}
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovWallet(uint64(l))
+	}
+	l = len(m.Addr)
+	if l > 0 {
+		n += 1 + l + sovWallet(uint64(l))
+	}
+	l = len(m.RedeemScript)
+	if l > 0 {
+		n += 1 + l + sovWallet(uint64(l))
+	}
+	if len(m.PubKeys) > 0 {
+		for _, s := range m.PubKeys {
+			l = len(s)
+			n += 1 + l + sovWallet(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovWallet(x uint64) (n int) {
+	for {
+		n++
+		x >>= 7
+		if x == 0 {
+			break
+		}
+	}
+	return n
+}
+func sozWallet(x uint64) (n int) {
+	return sovWallet(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ListTransactionsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWallet
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListTransactionsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListTransactionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWallet
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWallet
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWallet
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Direction", wireType)
+			}
+			m.Direction = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWallet
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Direction |= (TxDirection(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			m.StartTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWallet
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartTime |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			m.EndTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWallet
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndTime |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinAmount", wireType)
+			}
+			m.MinAmount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWallet
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinAmount |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOnly", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWallet
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.TokenOnly = bool(v != 0)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cursor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWallet
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWallet
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Cursor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Wallet", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWallet
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Wallet = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWallet(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthWallet
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListTransactionsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWallet
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListTransactionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListTransactionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			m.Code = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWallet
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Code |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWallet
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWallet
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWallet
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Transactions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWallet
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthWallet
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Transactions = append(m.Transactions, &TransactionEntry{})
+			if err := m.Transactions[len(m.Transactions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextCursor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWallet
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWallet
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NextCursor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWallet(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthWallet
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TransactionEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWallet
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TransactionEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TransactionEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tx", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWallet
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthWallet
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Tx == nil {
+				m.Tx = &pb.Transaction{}
+			}
+			if err := m.Tx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWallet
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWallet
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWallet
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWallet
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWallet
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWallet
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Direction", wireType)
+			}
+			m.Direction = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWallet
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Direction |= (TxDirection(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			m.Amount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWallet
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Amount |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HasToken", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWallet
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.HasToken = bool(v != 0)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWallet
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWallet
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WatchOnly", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWallet
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.WatchOnly = bool(v != 0)
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			m.Fee = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWallet
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Fee |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Label", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWallet
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWallet
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Label = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWallet
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= (TxStatus(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReplacedBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWallet
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWallet
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReplacedBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWallet(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthWallet
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Transaction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWallet
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Transaction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Transaction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWallet
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWallet
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RawBytes", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWallet
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthWallet
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RawBytes = append(m.RawBytes[:0], dAtA[iNdEx:postIndex]...)
+			if m.RawBytes == nil {
+				m.RawBytes = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWallet(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthWallet
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetTransactionCountRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWallet
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetTransactionCountRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetTransactionCountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWallet
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWallet
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWallet(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthWallet
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetTransactionCountResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWallet
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetTransactionCountResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetTransactionCountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			m.Code = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWallet
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Code |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWallet
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWallet
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWallet
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWallet(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthWallet
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UnlockAccountRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWallet
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UnlockAccountRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UnlockAccountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWallet
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWallet
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Passphrase", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWallet
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWallet
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Passphrase = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+			}
+			m.Timeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWallet
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timeout |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWallet(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthWallet
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LockAccountRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWallet
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LockAccountRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LockAccountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWallet
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWallet
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWallet(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthWallet
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeriveAddressRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWallet
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeriveAddressRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeriveAddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			m.Account = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWallet
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Account |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Change", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWallet
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Change = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWallet(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthWallet
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeriveAddressResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWallet
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeriveAddressResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeriveAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			m.Code = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWallet
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Code |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWallet
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWallet
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWallet
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWallet
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWallet
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWallet
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Path = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWallet(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthWallet
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ScanHDWalletRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWallet
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScanHDWalletRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScanHDWalletRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GapLimit", wireType)
+			}
+			m.GapLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWallet
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GapLimit |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWallet(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthWallet
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ScanHDWalletResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWallet
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScanHDWalletResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScanHDWalletResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			m.Code = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWallet
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Code |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWallet
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWallet
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addrs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWallet
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWallet
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addrs = append(m.Addrs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+			}
+			m.Balance = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWallet
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Balance |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWallet(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthWallet
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ImportMnemonicRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWallet
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ImportMnemonicRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ImportMnemonicRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mnemonic", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWallet
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWallet
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Mnemonic = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MnemonicPassphrase", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWallet
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWallet
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MnemonicPassphrase = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Passphrase", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWallet
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWallet
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Passphrase = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GapLimit", wireType)
+			}
+			m.GapLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWallet
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GapLimit |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWallet(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthWallet
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChangePassphraseRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWallet
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChangePassphraseRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChangePassphraseRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWallet
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWallet
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldPassphrase", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWallet
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWallet
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OldPassphrase = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewPassphrase", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWallet
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWallet
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewPassphrase = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWallet(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthWallet
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ImportAddressRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWallet
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ImportAddressRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ImportAddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWallet
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWallet
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWallet(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthWallet
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListAccountsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWallet
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListAccountsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListAccountsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Label", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWallet
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWallet
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Label = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWallet(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthWallet
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListAccountsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWallet
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListAccountsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListAccountsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			m.Code = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWallet
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Code |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWallet
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWallet
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Accounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWallet
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthWallet
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Accounts = append(m.Accounts, &AccountInfo{})
+			if err := m.Accounts[len(m.Accounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWallet(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthWallet
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AccountInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWallet
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AccountInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AccountInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWallet
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWallet
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Label", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWallet
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWallet
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Label = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
+			}
+			m.Created = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWallet
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Created |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Notes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWallet
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthWallet
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Notes == nil {
+				m.Notes = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowWallet
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowWallet
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthWallet
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowWallet
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthWallet
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipWallet(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthWallet
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Notes[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WatchOnly", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWallet
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.WatchOnly = bool(v != 0)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HdPath", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWallet
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWallet
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HdPath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWallet(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthWallet
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SetAccountLabelRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWallet
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SetAccountLabelRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SetAccountLabelRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWallet
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWallet
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Label", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWallet
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWallet
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Label = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWallet(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthWallet
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SetAccountNoteRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWallet
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SetAccountNoteRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SetAccountNoteRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWallet
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWallet
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWallet
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWallet
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWallet
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWallet
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWallet(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthWallet
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SetTransactionLabelRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWallet
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SetTransactionLabelRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SetTransactionLabelRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWallet
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWallet
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Label", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWallet
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWallet
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Label = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWallet(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthWallet
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConsolidateUtxosRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWallet
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConsolidateUtxosRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConsolidateUtxosRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWallet
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWallet
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWallet
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWallet
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeePerByte", wireType)
+			}
+			m.FeePerByte = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWallet
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FeePerByte |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			m.Threshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWallet
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Threshold |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxInputs", wireType)
+			}
+			m.MaxInputs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWallet
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxInputs |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DryRun", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWallet
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DryRun = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWallet(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthWallet
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConsolidateUtxosResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWallet
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConsolidateUtxosResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConsolidateUtxosResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			m.Code = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWallet
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Code |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWallet
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWallet
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Txs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWallet
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthWallet
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Txs = append(m.Txs, &ConsolidationTx{})
+			if err := m.Txs[len(m.Txs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Dust", wireType)
+			}
+			m.Dust = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWallet
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Dust |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWallet(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthWallet
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *GetTransactionCountRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Addr)
-	if l > 0 {
-		n += 1 + l + sovWallet(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
-
-func (m *GetTransactionCountResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Code != 0 {
-		n += 1 + sovWallet(uint64(m.Code))
-	}
-	l = len(m.Message)
-	if l > 0 {
-		n += 1 + l + sovWallet(uint64(l))
-	}
-	if m.Count != 0 {
-		n += 1 + sovWallet(uint64(m.Count))
+func (m *ConsolidationTx) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWallet
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConsolidationTx: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConsolidationTx: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWallet
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWallet
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Inputs", wireType)
+			}
+			m.Inputs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWallet
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Inputs |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			m.Value = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWallet
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Value |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			m.Fee = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWallet
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Fee |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tx", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWallet
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthWallet
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Tx == nil {
+				m.Tx = &pb.Transaction{}
+			}
+			if err := m.Tx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWallet(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthWallet
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func sovWallet(x uint64) (n int) {
-	for {
-		n++
-		x >>= 7
-		if x == 0 {
-			break
-		}
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-func sozWallet(x uint64) (n int) {
-	return sovWallet(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *ListTransactionsRequest) Unmarshal(dAtA []byte) error {
+func (m *IssueTokenRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -730,10 +8247,10 @@ func (m *ListTransactionsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListTransactionsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: IssueTokenRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListTransactionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: IssueTokenRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -766,10 +8283,10 @@ func (m *ListTransactionsRequest) Unmarshal(dAtA []byte) error {
 			m.Addr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToAddr", wireType)
 			}
-			m.Offset = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowWallet
@@ -779,16 +8296,74 @@ func (m *ListTransactionsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Offset |= (uint32(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWallet
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWallet
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWallet
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalSupply", wireType)
+			}
+			m.TotalSupply = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWallet
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalSupply |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FeePerByte", wireType)
 			}
-			m.Limit = 0
+			m.FeePerByte = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowWallet
@@ -798,7 +8373,7 @@ func (m *ListTransactionsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Limit |= (uint32(b) & 0x7F) << shift
+				m.FeePerByte |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -824,7 +8399,7 @@ func (m *ListTransactionsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListTransactionsResponse) Unmarshal(dAtA []byte) error {
+func (m *TransferTokenRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -847,17 +8422,17 @@ func (m *ListTransactionsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListTransactionsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: TransferTokenRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListTransactionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TransferTokenRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addr", wireType)
 			}
-			m.Code = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowWallet
@@ -867,14 +8442,24 @@ func (m *ListTransactionsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Code |= (int32(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWallet
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenHash", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -899,13 +8484,13 @@ func (m *ListTransactionsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Message = string(dAtA[iNdEx:postIndex])
+			m.TokenHash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenIndex", wireType)
 			}
-			m.Count = 0
+			m.TokenIndex = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowWallet
@@ -915,16 +8500,16 @@ func (m *ListTransactionsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Count |= (uint32(b) & 0x7F) << shift
+				m.TokenIndex |= (uint32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Transactions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ToAddr", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowWallet
@@ -934,23 +8519,59 @@ func (m *ListTransactionsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthWallet
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Transactions = append(m.Transactions, &pb.Transaction{})
-			if err := m.Transactions[len(m.Transactions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ToAddr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			m.Amount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWallet
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Amount |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeePerByte", wireType)
+			}
+			m.FeePerByte = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWallet
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FeePerByte |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipWallet(dAtA[iNdEx:])
@@ -972,7 +8593,7 @@ func (m *ListTransactionsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Transaction) Unmarshal(dAtA []byte) error {
+func (m *TokenTxResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -995,15 +8616,34 @@ func (m *Transaction) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Transaction: wiretype end group for non-group")
+			return fmt.Errorf("proto: TokenTxResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Transaction: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TokenTxResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			m.Code = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWallet
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Code |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1028,13 +8668,13 @@ func (m *Transaction) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TxHash = string(dAtA[iNdEx:postIndex])
+			m.Message = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RawBytes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowWallet
@@ -1044,21 +8684,71 @@ func (m *Transaction) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthWallet
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RawBytes = append(m.RawBytes[:0], dAtA[iNdEx:postIndex]...)
-			if m.RawBytes == nil {
-				m.RawBytes = []byte{}
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			m.Fee = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWallet
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Fee |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tx", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWallet
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthWallet
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Tx == nil {
+				m.Tx = &pb.Transaction{}
+			}
+			if err := m.Tx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -1082,7 +8772,7 @@ func (m *Transaction) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetTransactionCountRequest) Unmarshal(dAtA []byte) error {
+func (m *CreateMultisigRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1105,15 +8795,34 @@ func (m *GetTransactionCountRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetTransactionCountRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: CreateMultisigRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetTransactionCountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CreateMultisigRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field M", wireType)
+			}
+			m.M = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWallet
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.M |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Addr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Participants", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1138,7 +8847,7 @@ func (m *GetTransactionCountRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Addr = string(dAtA[iNdEx:postIndex])
+			m.Participants = append(m.Participants, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1161,7 +8870,7 @@ func (m *GetTransactionCountRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetTransactionCountResponse) Unmarshal(dAtA []byte) error {
+func (m *CreateMultisigResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1184,10 +8893,10 @@ func (m *GetTransactionCountResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetTransactionCountResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: CreateMultisigResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetTransactionCountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CreateMultisigResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1239,10 +8948,10 @@ func (m *GetTransactionCountResponse) Unmarshal(dAtA []byte) error {
 			m.Message = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addr", wireType)
 			}
-			m.Count = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowWallet
@@ -1252,11 +8961,81 @@ func (m *GetTransactionCountResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Count |= (uint32(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWallet
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedeemScript", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWallet
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthWallet
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RedeemScript = append(m.RedeemScript[:0], dAtA[iNdEx:postIndex]...)
+			if m.RedeemScript == nil {
+				m.RedeemScript = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKeys", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWallet
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWallet
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PubKeys = append(m.PubKeys, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipWallet(dAtA[iNdEx:])
@@ -1383,37 +9162,139 @@ var (
 	ErrIntOverflowWallet   = fmt.Errorf("proto: integer overflow")
 )
 
-func init() { proto.RegisterFile("wallet.proto", fileDescriptor_wallet_925d89b13bb40012) }
-
-var fileDescriptor_wallet_925d89b13bb40012 = []byte{
-	// 463 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x53, 0x41, 0x6b, 0x13, 0x41,
-	0x14, 0xce, 0xa4, 0x4d, 0x6a, 0x5e, 0x53, 0x90, 0xa9, 0xd8, 0x65, 0xa3, 0x6b, 0x1c, 0x41, 0x42,
-	0x0f, 0x3b, 0xb6, 0x1e, 0x84, 0x1e, 0x13, 0x50, 0x0f, 0x82, 0xb0, 0x08, 0x0a, 0x1e, 0xca, 0xec,
-	0xee, 0x74, 0xb3, 0xb8, 0x3b, 0x6f, 0xdd, 0x99, 0x98, 0x78, 0x15, 0x7f, 0x40, 0xc1, 0x83, 0x7f,
-	0xc9, 0x63, 0xc1, 0x8b, 0x47, 0x49, 0xfc, 0x21, 0xb2, 0x93, 0x54, 0x56, 0x6d, 0x72, 0xea, 0x6d,
-	0xbe, 0xfd, 0xde, 0x7c, 0xef, 0x7b, 0xdf, 0xbc, 0x85, 0xee, 0x54, 0x64, 0x99, 0x34, 0x7e, 0x51,
-	0xa2, 0x41, 0xda, 0x2a, 0x8b, 0xa8, 0x08, 0xdd, 0xa3, 0x24, 0x35, 0xe3, 0x49, 0xe8, 0x47, 0x98,
-	0xf3, 0xe1, 0xcb, 0x37, 0x4f, 0x71, 0xa2, 0x62, 0x61, 0x52, 0x54, 0x3c, 0xc4, 0x59, 0xcc, 0x23,
-	0x2c, 0x25, 0x2f, 0x42, 0x1e, 0x66, 0x18, 0xbd, 0x5b, 0xde, 0x74, 0xef, 0x24, 0x88, 0x49, 0x26,
-	0xb9, 0x28, 0x52, 0x2e, 0x94, 0x42, 0x63, 0xeb, 0xf5, 0x92, 0x65, 0x6f, 0xe1, 0xe0, 0x45, 0xaa,
-	0xcd, 0xab, 0x52, 0x28, 0x2d, 0x22, 0xcb, 0x04, 0xf2, 0xfd, 0x44, 0x6a, 0x43, 0x29, 0x6c, 0x8b,
-	0x38, 0x2e, 0x1d, 0xd2, 0x27, 0x83, 0x4e, 0x60, 0xcf, 0xf4, 0x36, 0xb4, 0xf1, 0xec, 0x4c, 0x4b,
-	0xe3, 0x34, 0xfb, 0x64, 0xb0, 0x17, 0xac, 0x10, 0xbd, 0x05, 0xad, 0x2c, 0xcd, 0x53, 0xe3, 0x6c,
-	0xd9, 0xcf, 0x4b, 0xc0, 0xbe, 0x12, 0x70, 0xfe, 0x57, 0xd7, 0x05, 0x2a, 0x2d, 0x2b, 0xf9, 0x08,
-	0x63, 0x69, 0xe5, 0x5b, 0x81, 0x3d, 0x53, 0x07, 0x76, 0x72, 0xa9, 0xb5, 0x48, 0xa4, 0xd5, 0xef,
-	0x04, 0x97, 0xb0, 0x6a, 0x10, 0xe1, 0x44, 0xfd, 0x69, 0x60, 0x01, 0x7d, 0x02, 0x5d, 0x53, 0xd3,
-	0x76, 0xb6, 0xfb, 0x5b, 0x83, 0xdd, 0xe3, 0x7d, 0xbf, 0xca, 0xa1, 0x08, 0xfd, 0x5a, 0xdf, 0xe0,
-	0xaf, 0x42, 0x36, 0x82, 0xdd, 0x1a, 0x49, 0x0f, 0x60, 0xc7, 0xcc, 0x4e, 0xc7, 0x42, 0x8f, 0x57,
-	0xd3, 0xb6, 0xcd, 0xec, 0xb9, 0xd0, 0x63, 0xda, 0x83, 0x4e, 0x29, 0xa6, 0xa7, 0xe1, 0x47, 0x23,
-	0xb5, 0xb5, 0xd4, 0x0d, 0x6e, 0x94, 0x62, 0x3a, 0xac, 0x30, 0x7b, 0x04, 0xee, 0x33, 0x59, 0x1f,
-	0x6e, 0x54, 0x99, 0xda, 0x10, 0x1f, 0x13, 0xd0, 0xbb, 0xf2, 0xc6, 0xf5, 0x45, 0x72, 0x7c, 0xde,
-	0x84, 0xbd, 0xd7, 0x76, 0x73, 0x46, 0x98, 0xe7, 0x42, 0xc5, 0x74, 0x06, 0x37, 0xff, 0x7d, 0x04,
-	0xea, 0xf9, 0x76, 0x9f, 0xfc, 0x35, 0x6f, 0xef, 0xde, 0x5b, 0xcb, 0x2f, 0xad, 0xb2, 0x07, 0x9f,
-	0xbe, 0xff, 0xfa, 0xd2, 0xbc, 0xcb, 0x1c, 0xfe, 0xe1, 0x88, 0x4f, 0x33, 0xc3, 0xb3, 0x54, 0x9b,
-	0x7a, 0xc4, 0x27, 0xe4, 0x90, 0x7e, 0x26, 0xb0, 0x7f, 0xc5, 0xbc, 0xf4, 0xfe, 0x4a, 0x7d, 0x7d,
-	0x7a, 0x2e, 0xdb, 0x54, 0xb2, 0xf2, 0xf0, 0xd0, 0x7a, 0xe8, 0xb3, 0xde, 0xa5, 0x87, 0x44, 0xd6,
-	0x2d, 0xd8, 0x3c, 0x4e, 0xc8, 0xe1, 0xd0, 0xf9, 0x36, 0xf7, 0xc8, 0xc5, 0xdc, 0x23, 0x3f, 0xe7,
-	0x1e, 0x39, 0x5f, 0x78, 0x8d, 0x8b, 0x85, 0xd7, 0xf8, 0xb1, 0xf0, 0x1a, 0x61, 0xdb, 0xfe, 0x04,
-	0x8f, 0x7f, 0x07, 0x00, 0x00, 0xff, 0xff, 0x0e, 0x55, 0x16, 0x67, 0x6c, 0x03, 0x00, 0x00,
+func init() { proto.RegisterFile("wallet.proto", fileDescriptor_wallet_806da22b74875d93) }
+
+var fileDescriptor_wallet_806da22b74875d93 = []byte{
+	// 2091 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0x4f, 0x6f, 0xdb, 0xca,
+	0x11, 0x0f, 0xf5, 0xcf, 0xd2, 0x48, 0x72, 0xd4, 0xb5, 0x63, 0xf3, 0xc9, 0xb1, 0xad, 0x30, 0x48,
+	0x6b, 0xb8, 0x80, 0x95, 0x97, 0x77, 0x79, 0x48, 0x4f, 0xb1, 0xec, 0xbc, 0xf8, 0x3d, 0x27, 0x31,
+	0x68, 0xbf, 0xb6, 0x97, 0x42, 0x58, 0x91, 0x6b, 0x8b, 0x08, 0xff, 0x95, 0x5c, 0xc6, 0x12, 0x0a,
+	0xf4, 0x50, 0x14, 0x3d, 0xf4, 0x54, 0xa0, 0xa7, 0xf6, 0x56, 0xf4, 0x23, 0xf4, 0xd0, 0x53, 0x7b,
+	0xe8, 0xa9, 0xc7, 0x07, 0xf4, 0xd2, 0x63, 0x91, 0xf4, 0x43, 0xf4, 0x58, 0xec, 0x70, 0x49, 0x91,
+	0x12, 0xe5, 0x04, 0x46, 0x6e, 0xbb, 0x33, 0xb3, 0x3b, 0xb3, 0x33, 0x3f, 0x0d, 0x7f, 0x23, 0x68,
+	0x5d, 0x53, 0xdb, 0x66, 0xfc, 0xc0, 0x0f, 0x3c, 0xee, 0x91, 0x6a, 0xe0, 0x1b, 0xfe, 0xa8, 0xfb,
+	0xf9, 0x95, 0xc5, 0xc7, 0xd1, 0xe8, 0xc0, 0xf0, 0x9c, 0xfe, 0xe1, 0xeb, 0x9f, 0x3e, 0xf7, 0x22,
+	0xd7, 0xa4, 0xdc, 0xf2, 0xdc, 0xfe, 0xc8, 0x9b, 0x98, 0x7d, 0xc3, 0x0b, 0x58, 0xdf, 0x1f, 0xf5,
+	0x47, 0xb6, 0x67, 0xbc, 0x89, 0x4f, 0x76, 0xef, 0x5f, 0x79, 0xde, 0x95, 0xcd, 0xfa, 0xd4, 0xb7,
+	0xfa, 0xd4, 0x75, 0x3d, 0x8e, 0xf6, 0xa1, 0xd4, 0xb6, 0x0c, 0xcf, 0x71, 0x3c, 0x37, 0xde, 0x69,
+	0x7f, 0x2e, 0xc1, 0xe6, 0xa9, 0x15, 0xf2, 0x8b, 0x80, 0xba, 0x21, 0x35, 0xd0, 0x50, 0x67, 0x3f,
+	0x8f, 0x58, 0xc8, 0x09, 0x81, 0x0a, 0x35, 0xcd, 0x40, 0x55, 0x7a, 0xca, 0x5e, 0x43, 0xc7, 0x35,
+	0x59, 0x87, 0xaa, 0x6d, 0x39, 0x16, 0x57, 0xcb, 0x3d, 0x65, 0xaf, 0xad, 0xc7, 0x1b, 0xf2, 0x18,
+	0x1a, 0xa6, 0x15, 0x30, 0x3c, 0xae, 0x56, 0x7a, 0xca, 0xde, 0xea, 0x13, 0x72, 0x80, 0xf1, 0x1f,
+	0x5c, 0x4c, 0x8e, 0x12, 0x8d, 0x3e, 0x33, 0x22, 0xdb, 0x00, 0x21, 0xa7, 0x01, 0x1f, 0x72, 0xcb,
+	0x61, 0x6a, 0xb5, 0xa7, 0xec, 0x95, 0xf5, 0x06, 0x4a, 0x2e, 0x2c, 0x87, 0x91, 0xcf, 0xa0, 0xce,
+	0x5c, 0x33, 0x56, 0xd6, 0x50, 0xb9, 0xc2, 0x5c, 0x13, 0x55, 0xdb, 0x00, 0x8e, 0xe5, 0x0e, 0xa9,
+	0xe3, 0x45, 0x2e, 0x57, 0x57, 0x7a, 0xca, 0x5e, 0x45, 0x6f, 0x38, 0x96, 0xfb, 0x0c, 0x05, 0x42,
+	0xcd, 0xbd, 0x37, 0xcc, 0x1d, 0x7a, 0xae, 0x3d, 0x55, 0xeb, 0x3d, 0x65, 0xaf, 0xae, 0x37, 0x50,
+	0xf2, 0xda, 0xb5, 0xa7, 0x64, 0x03, 0x6a, 0x46, 0x14, 0x84, 0x5e, 0xa0, 0x36, 0xf0, 0x55, 0x72,
+	0x27, 0xe4, 0x71, 0xf6, 0x55, 0xc0, 0x23, 0x72, 0xf7, 0x75, 0xa5, 0x5e, 0xea, 0x94, 0xb5, 0x7f,
+	0x28, 0xa0, 0x2e, 0x66, 0x29, 0xf4, 0x3d, 0x37, 0x64, 0x22, 0x4d, 0x86, 0x67, 0x32, 0x4c, 0x53,
+	0x55, 0xc7, 0x35, 0x51, 0x61, 0xc5, 0x61, 0x61, 0x48, 0xaf, 0x98, 0x5a, 0x42, 0x3f, 0xc9, 0x56,
+	0x24, 0xd0, 0xc0, 0xc8, 0x65, 0x02, 0x71, 0x43, 0x7e, 0x04, 0x2d, 0x9e, 0xb9, 0x5b, 0xad, 0xf6,
+	0xca, 0x7b, 0xcd, 0x27, 0x9b, 0x49, 0x0e, 0x67, 0xaa, 0x63, 0x97, 0x07, 0x53, 0x3d, 0x67, 0x4c,
+	0x76, 0xa1, 0xe9, 0xb2, 0x09, 0x1f, 0xca, 0x87, 0xd5, 0xd0, 0x21, 0x08, 0xd1, 0x00, 0x25, 0x5f,
+	0x57, 0xea, 0x95, 0x4e, 0x55, 0xfb, 0x6b, 0x19, 0x3a, 0xf3, 0x37, 0x91, 0x87, 0x50, 0xe2, 0x13,
+	0x0c, 0xbd, 0xf9, 0x64, 0xed, 0x40, 0xa0, 0x29, 0xef, 0x4f, 0x2f, 0xf1, 0x89, 0x78, 0xe1, 0x98,
+	0x86, 0x63, 0xf9, 0x14, 0x5c, 0x8b, 0x3c, 0x23, 0xe6, 0x86, 0xa8, 0x29, 0xa3, 0xa6, 0x81, 0x92,
+	0x17, 0x42, 0xfd, 0x00, 0x5a, 0x52, 0xcd, 0xac, 0xab, 0x31, 0x47, 0x50, 0xb4, 0xf5, 0x66, 0x6c,
+	0x80, 0x22, 0x72, 0x1f, 0x1a, 0xa2, 0xbe, 0x21, 0xa7, 0x8e, 0x9f, 0x20, 0x20, 0x15, 0xe4, 0x21,
+	0x55, 0xfb, 0x18, 0x48, 0x6d, 0x40, 0x2d, 0x07, 0x0a, 0xb9, 0x23, 0x5b, 0xd0, 0x18, 0xd3, 0x70,
+	0x88, 0x18, 0x90, 0x80, 0xa8, 0x8f, 0x69, 0x78, 0x21, 0xf6, 0x29, 0xc6, 0x1b, 0x19, 0x8c, 0x6f,
+	0x03, 0x5c, 0x53, 0x6e, 0x8c, 0x63, 0x08, 0xc5, 0x78, 0x68, 0xa0, 0x04, 0x21, 0xd4, 0x81, 0xf2,
+	0x25, 0x63, 0x6a, 0x13, 0x9d, 0x88, 0x25, 0xfe, 0x28, 0xe8, 0x88, 0xd9, 0x6a, 0x0b, 0x6f, 0x89,
+	0x37, 0xe4, 0x07, 0x50, 0x0b, 0x39, 0xe5, 0x51, 0xa8, 0xb6, 0x31, 0xfc, 0xbb, 0x69, 0xf8, 0xe7,
+	0x28, 0xd6, 0xa5, 0x5a, 0xd4, 0x2f, 0x60, 0xbe, 0x4d, 0x0d, 0x66, 0x0e, 0x47, 0x53, 0x75, 0x35,
+	0xae, 0x5f, 0x22, 0x3a, 0x9c, 0x6a, 0x03, 0x68, 0x66, 0x4a, 0x42, 0x36, 0x61, 0x85, 0x4f, 0xe2,
+	0xbc, 0xc7, 0x3f, 0xcd, 0x1a, 0x9f, 0x60, 0xd2, 0xb7, 0xa0, 0x11, 0xd0, 0xeb, 0xe1, 0x68, 0xca,
+	0x59, 0x88, 0xc5, 0x6a, 0xe9, 0xf5, 0x80, 0x5e, 0x1f, 0x8a, 0xbd, 0xf6, 0x18, 0xba, 0x5f, 0xb1,
+	0x2c, 0x82, 0x07, 0x22, 0x3b, 0x37, 0xfc, 0xd6, 0x35, 0x0a, 0x5b, 0x85, 0x27, 0x3e, 0x1d, 0xee,
+	0x35, 0x13, 0xd6, 0xbf, 0x75, 0x05, 0x26, 0x9e, 0x19, 0xc6, 0x07, 0xc2, 0x21, 0x3b, 0x00, 0x3e,
+	0x0d, 0x43, 0x7f, 0x1c, 0xd0, 0x30, 0xb9, 0x3e, 0x23, 0x11, 0xbe, 0x05, 0x7c, 0xbc, 0x28, 0xf1,
+	0x91, 0x6c, 0xb5, 0x3d, 0x20, 0xa7, 0x1f, 0xe5, 0x43, 0x7b, 0x01, 0xeb, 0x47, 0x2c, 0xb0, 0xde,
+	0xb2, 0x67, 0xa6, 0x19, 0xb0, 0x30, 0x6d, 0x85, 0x2a, 0xac, 0xd0, 0xf8, 0x34, 0x9a, 0xb7, 0xf5,
+	0x64, 0x8b, 0x0d, 0x65, 0x4c, 0x5d, 0xf9, 0xe0, 0xba, 0x2e, 0x77, 0x9a, 0x03, 0xf7, 0xe6, 0x6e,
+	0xba, 0x55, 0xda, 0x92, 0x20, 0xcb, 0x99, 0x44, 0x10, 0xa8, 0xf8, 0x94, 0x8f, 0xf1, 0x37, 0xd5,
+	0xd0, 0x71, 0xad, 0x3d, 0x81, 0xb5, 0x73, 0x83, 0xba, 0x2f, 0x8e, 0x7e, 0x82, 0x7d, 0x2b, 0x89,
+	0x7b, 0x0b, 0x1a, 0x57, 0xd4, 0x1f, 0xc6, 0x2d, 0x3b, 0x8e, 0xbc, 0x7e, 0x45, 0xfd, 0x53, 0xb1,
+	0xd7, 0x38, 0xac, 0xe7, 0xcf, 0xdc, 0xb6, 0xb0, 0x22, 0xaa, 0x50, 0x2d, 0xf7, 0xca, 0x02, 0xfc,
+	0xb8, 0x11, 0xf6, 0x23, 0x6a, 0x53, 0xd7, 0x60, 0x18, 0x66, 0x45, 0x4f, 0xb6, 0xda, 0x9f, 0x14,
+	0xb8, 0x77, 0xe2, 0xf8, 0x5e, 0xc0, 0x5f, 0xba, 0xcc, 0xf1, 0x5c, 0xcb, 0x48, 0x82, 0xed, 0x42,
+	0xdd, 0x91, 0x22, 0x59, 0x94, 0x74, 0x4f, 0xfa, 0xb0, 0x96, 0xac, 0x87, 0x0b, 0x28, 0x20, 0x89,
+	0xea, 0x2c, 0xd5, 0xcc, 0xa1, 0xa5, 0xbc, 0x80, 0x96, 0x5c, 0x66, 0x2a, 0x73, 0x99, 0xf9, 0x05,
+	0x6c, 0x0e, 0xb0, 0x8c, 0xb3, 0x0b, 0x6f, 0x42, 0xe6, 0x23, 0x58, 0xf5, 0x6c, 0x73, 0x31, 0xae,
+	0xb6, 0x67, 0x9b, 0x99, 0x90, 0x1e, 0xc1, 0xaa, 0xcb, 0xae, 0x87, 0x0b, 0x61, 0xb5, 0x5d, 0x76,
+	0x3d, 0x33, 0xd3, 0xf6, 0x61, 0x3d, 0xce, 0xcf, 0x1c, 0x06, 0x8b, 0xf0, 0xfa, 0x43, 0x58, 0x13,
+	0xdf, 0x25, 0x89, 0xec, 0xd4, 0x34, 0x6d, 0x48, 0x4a, 0xa6, 0x21, 0x89, 0x7a, 0xe7, 0x8d, 0x6f,
+	0x55, 0xef, 0x03, 0xa8, 0x4b, 0xec, 0xc7, 0x25, 0x6f, 0xa6, 0x7d, 0x59, 0x5e, 0x7c, 0xe2, 0x5e,
+	0x7a, 0x7a, 0x6a, 0xa3, 0xfd, 0x4f, 0x81, 0x66, 0x46, 0xb3, 0x94, 0x55, 0x60, 0xbc, 0xa5, 0x6c,
+	0x03, 0x55, 0x61, 0xc5, 0x08, 0x18, 0xe5, 0xcc, 0xc4, 0x44, 0x95, 0xf5, 0x64, 0x4b, 0xbe, 0x80,
+	0xaa, 0xeb, 0x89, 0x26, 0x57, 0xc1, 0x00, 0xb6, 0x17, 0x03, 0x38, 0x78, 0x25, 0xf4, 0xf1, 0xd7,
+	0x32, 0xb6, 0x9d, 0x6b, 0xeb, 0xd5, 0xf9, 0xb6, 0xbe, 0x09, 0x2b, 0x63, 0x51, 0x43, 0x3e, 0x96,
+	0x5f, 0xd0, 0xda, 0xd8, 0x3c, 0xa3, 0x7c, 0xdc, 0xfd, 0x12, 0x60, 0x76, 0x99, 0xe8, 0xfe, 0x6f,
+	0xd8, 0x54, 0x46, 0x2f, 0x96, 0x22, 0xf8, 0xb7, 0xd4, 0x8e, 0x92, 0x44, 0xc5, 0x9b, 0xa7, 0xa5,
+	0x2f, 0x15, 0xed, 0x10, 0x36, 0xce, 0x59, 0x92, 0xef, 0x53, 0xf1, 0xa6, 0x0f, 0x51, 0xab, 0x85,
+	0x24, 0x68, 0xe7, 0x70, 0x6f, 0x76, 0x87, 0x88, 0xe3, 0xa6, 0x2b, 0x64, 0x70, 0xa5, 0x82, 0xe0,
+	0xca, 0x99, 0xe0, 0xb4, 0xe7, 0xd0, 0x3d, 0xcf, 0x75, 0xf6, 0xf9, 0xe0, 0x32, 0x1f, 0x17, 0x5c,
+	0x2f, 0x09, 0xee, 0xef, 0x0a, 0x6c, 0x0e, 0x3c, 0x37, 0xf4, 0x6c, 0xcb, 0xa4, 0x9c, 0x7d, 0xcb,
+	0x27, 0xde, 0x8d, 0xec, 0x51, 0x7c, 0xb9, 0xbc, 0x21, 0x8a, 0x4b, 0xf2, 0xcb, 0xe5, 0x09, 0x94,
+	0x93, 0x1e, 0xb4, 0x2e, 0x19, 0x1b, 0xfa, 0x2c, 0xc0, 0xaf, 0x17, 0x46, 0x5b, 0xd1, 0xe1, 0x92,
+	0xb1, 0x33, 0x16, 0x88, 0xef, 0x17, 0xb2, 0x85, 0x71, 0xc0, 0xc2, 0xb1, 0x67, 0x9b, 0xb2, 0xa5,
+	0xcc, 0x04, 0x48, 0x0a, 0xe9, 0x64, 0x68, 0xb9, 0x7e, 0xc4, 0x43, 0xac, 0x6d, 0x5b, 0x6f, 0x38,
+	0x74, 0x72, 0x82, 0x02, 0xe1, 0xd7, 0x0c, 0xa6, 0xc3, 0x20, 0x8a, 0xa9, 0x44, 0x5d, 0xaf, 0x99,
+	0xc1, 0x54, 0x8f, 0x5c, 0xed, 0x37, 0x0a, 0xa8, 0x8b, 0x0f, 0xb8, 0xd5, 0xef, 0x62, 0x0f, 0xca,
+	0x7c, 0x92, 0xfc, 0x24, 0x36, 0x24, 0x22, 0x67, 0x77, 0x5b, 0x9e, 0x7b, 0x31, 0xd1, 0x85, 0x89,
+	0xb8, 0xd7, 0x8c, 0xc2, 0xa4, 0xeb, 0xe0, 0x5a, 0xfb, 0xad, 0x02, 0x77, 0xe7, 0x8c, 0x0b, 0xeb,
+	0xb0, 0x01, 0x35, 0xf9, 0xc8, 0x12, 0x9e, 0x96, 0xbb, 0x7c, 0x9d, 0x2b, 0xb2, 0xce, 0x09, 0x55,
+	0xa9, 0xcc, 0xa8, 0x4a, 0xcc, 0xf7, 0xaa, 0x37, 0xf2, 0x3d, 0xed, 0x0f, 0x0a, 0x7c, 0xef, 0x24,
+	0x0c, 0x23, 0x86, 0x1c, 0xe9, 0x56, 0x05, 0x25, 0x50, 0x71, 0xa9, 0x93, 0xc0, 0x0e, 0xd7, 0x82,
+	0x13, 0x72, 0x8f, 0x53, 0x7b, 0x18, 0x46, 0xbe, 0x6f, 0x4f, 0x65, 0x58, 0x4d, 0x94, 0x9d, 0xa3,
+	0x68, 0x01, 0x07, 0xd5, 0x79, 0x1c, 0x68, 0x7f, 0x53, 0x60, 0x1d, 0xe3, 0xbd, 0x64, 0xc1, 0x07,
+	0xc3, 0x4b, 0x87, 0x81, 0x0c, 0x7d, 0x8d, 0x87, 0x01, 0xe4, 0x4b, 0xbb, 0xd0, 0x8c, 0xd5, 0x96,
+	0x6b, 0xb2, 0x89, 0x64, 0x0d, 0xf1, 0x89, 0x13, 0x21, 0xc9, 0x3e, 0xaf, 0x92, 0x7b, 0xde, 0x8c,
+	0x6b, 0x56, 0x73, 0x5c, 0x73, 0x3e, 0xfe, 0xda, 0x42, 0xfc, 0xa2, 0xd0, 0x18, 0xf7, 0xc5, 0xe4,
+	0xf6, 0x94, 0x20, 0xc3, 0xb9, 0x71, 0x7d, 0xdb, 0x42, 0x9f, 0xc0, 0xbd, 0x01, 0xb6, 0xd4, 0x97,
+	0x91, 0xcd, 0xad, 0xd0, 0xba, 0x4a, 0x92, 0xd9, 0x02, 0xc5, 0x91, 0x7c, 0x41, 0x71, 0x88, 0x06,
+	0x2d, 0x9f, 0x06, 0xdc, 0x32, 0x2c, 0x9f, 0xba, 0x08, 0x3d, 0xf1, 0xa5, 0xcf, 0xc9, 0xb4, 0x3f,
+	0x2a, 0xb0, 0x31, 0x7f, 0xd7, 0x27, 0x63, 0x3c, 0x0f, 0xa1, 0x1d, 0x30, 0x93, 0x31, 0x67, 0x18,
+	0x1a, 0x81, 0xe5, 0xc7, 0x3f, 0x9d, 0x96, 0xde, 0x8a, 0x85, 0xe7, 0x28, 0x13, 0x33, 0xa3, 0x1f,
+	0x8d, 0x86, 0x6f, 0xd8, 0x34, 0x9e, 0x9f, 0x1a, 0xfa, 0x8a, 0x1f, 0x8d, 0xbe, 0x61, 0xd3, 0x70,
+	0xff, 0x00, 0x9a, 0x99, 0xa1, 0x81, 0xac, 0x40, 0xf9, 0xd9, 0xe9, 0x69, 0xe7, 0x0e, 0xa9, 0x43,
+	0xe5, 0xfc, 0xf8, 0xd5, 0x45, 0x47, 0x21, 0x2d, 0xa8, 0xeb, 0xc7, 0x83, 0xe3, 0x93, 0x1f, 0x1f,
+	0x1f, 0x75, 0x4a, 0xfb, 0x47, 0x50, 0x4f, 0x58, 0x3a, 0x69, 0x43, 0x63, 0xf0, 0xfa, 0xd5, 0xf3,
+	0x13, 0xfd, 0xe5, 0xf1, 0x51, 0xe7, 0x0e, 0x69, 0xc2, 0xca, 0xd9, 0xf1, 0xab, 0xa3, 0x93, 0x57,
+	0x5f, 0x75, 0x14, 0xb2, 0x0a, 0x20, 0x74, 0xa7, 0x27, 0x83, 0x0b, 0x71, 0x2e, 0xbe, 0xe5, 0xec,
+	0xf4, 0xd9, 0xe0, 0xf8, 0xa8, 0x53, 0x7e, 0xf2, 0x97, 0xbb, 0xd0, 0x8e, 0xa9, 0xd5, 0xc0, 0x73,
+	0x1c, 0xea, 0x9a, 0x64, 0x02, 0x9d, 0xf9, 0x31, 0x92, 0xec, 0xc8, 0x56, 0xb1, 0x64, 0x0a, 0xef,
+	0xee, 0x2e, 0xd5, 0xc7, 0xe9, 0xd5, 0x1e, 0xfe, 0xea, 0x5f, 0xff, 0xfd, 0x7d, 0x69, 0x5b, 0x53,
+	0xfb, 0x6f, 0x3f, 0xef, 0x5f, 0xdb, 0xbc, 0x6f, 0x5b, 0x21, 0xcf, 0x0e, 0x88, 0x4f, 0x95, 0x7d,
+	0xf2, 0x4b, 0x20, 0xe7, 0x3c, 0x60, 0xd4, 0xf9, 0xb4, 0xbe, 0x1f, 0xa1, 0xef, 0x5d, 0xad, 0x9b,
+	0xf8, 0x0e, 0xd1, 0xc9, 0x9c, 0xf7, 0xc7, 0x0a, 0xf9, 0xb5, 0x02, 0x6b, 0x05, 0xc3, 0x04, 0x79,
+	0x20, 0x3d, 0x2c, 0x1f, 0x4d, 0xba, 0xda, 0x4d, 0x26, 0x32, 0x8e, 0xef, 0x63, 0x1c, 0xbd, 0xa7,
+	0xca, 0xbe, 0xb6, 0x95, 0x84, 0x72, 0xc5, 0xb2, 0x59, 0x88, 0xd9, 0xba, 0x01, 0xed, 0xdc, 0xbc,
+	0x41, 0xb6, 0xe4, 0xe5, 0x45, 0x53, 0x48, 0x77, 0x4d, 0x2a, 0x0f, 0x91, 0xff, 0x49, 0x57, 0x3d,
+	0x74, 0xd5, 0xd5, 0xee, 0x25, 0x7e, 0x22, 0x3c, 0x2a, 0xf9, 0x8e, 0xc8, 0xf5, 0xcf, 0xa0, 0x99,
+	0x19, 0x37, 0xc8, 0x67, 0x49, 0x12, 0x3f, 0xd2, 0xc1, 0x0e, 0x3a, 0x50, 0xb5, 0xb5, 0xb4, 0x9e,
+	0xf9, 0xeb, 0x6d, 0x68, 0xe7, 0x26, 0x8b, 0xf4, 0x0d, 0x45, 0x93, 0x4b, 0xf7, 0x7e, 0xb1, 0x72,
+	0xd9, 0x63, 0x4c, 0x34, 0xa3, 0xb1, 0x99, 0xf0, 0x36, 0x86, 0x56, 0x76, 0x48, 0x20, 0x5d, 0x79,
+	0x5f, 0xc1, 0xb4, 0xd1, 0xdd, 0x2a, 0xd4, 0x49, 0x57, 0xbb, 0xe8, 0xea, 0x33, 0x6d, 0x3d, 0x85,
+	0x8a, 0x41, 0xdd, 0xb1, 0x19, 0xff, 0xcf, 0x22, 0x3c, 0x7d, 0x03, 0xab, 0xf9, 0xb9, 0x80, 0x24,
+	0xb1, 0x17, 0x8e, 0x0b, 0x37, 0x7a, 0x23, 0x36, 0x74, 0xe6, 0x19, 0x7c, 0x8a, 0xf6, 0x25, 0xd4,
+	0xbe, 0xb8, 0x1a, 0xf2, 0xd7, 0x25, 0x90, 0x95, 0xfe, 0xc0, 0xe2, 0x11, 0x2f, 0x33, 0x4c, 0x18,
+	0xd0, 0xce, 0x51, 0xf6, 0xb4, 0x24, 0x45, 0x44, 0xfe, 0x23, 0x61, 0x65, 0xe1, 0xd1, 0x7c, 0x25,
+	0xb2, 0xf4, 0x3d, 0xad, 0x44, 0xc1, 0x00, 0xd0, 0xdd, 0x2a, 0xd4, 0xe5, 0x2b, 0x21, 0x9e, 0xb4,
+	0x9e, 0xed, 0x19, 0x09, 0x65, 0x27, 0x16, 0xdc, 0x9d, 0xe3, 0xad, 0x24, 0xa1, 0xd8, 0xc5, 0x7c,
+	0xb6, 0xf8, 0x49, 0x1a, 0xfa, 0xb9, 0x2f, 0xfc, 0x6c, 0xa6, 0x45, 0x67, 0x89, 0x9b, 0x98, 0xe3,
+	0x5f, 0xc2, 0x6a, 0x9e, 0xde, 0xa6, 0x45, 0x2f, 0x64, 0xbd, 0xc5, 0x8e, 0x1e, 0xa0, 0xa3, 0x2d,
+	0x6d, 0x63, 0xd1, 0x8b, 0xa0, 0xfe, 0x22, 0x79, 0x13, 0xe8, 0xcc, 0xf3, 0xbc, 0x19, 0x1e, 0x8a,
+	0x19, 0x6c, 0x77, 0x77, 0xa9, 0x7e, 0x59, 0xe7, 0x35, 0x66, 0x96, 0x91, 0xb0, 0x14, 0x9e, 0x23,
+	0x58, 0x2b, 0xe0, 0xda, 0x69, 0xe3, 0x5b, 0xce, 0xc3, 0x8b, 0xdf, 0x2a, 0x3b, 0xdd, 0xac, 0xcd,
+	0x85, 0xb9, 0x36, 0x87, 0x59, 0x8d, 0x9b, 0x10, 0xcc, 0x28, 0x1c, 0x51, 0x13, 0x3c, 0xce, 0xb3,
+	0xba, 0x6e, 0xc2, 0x54, 0xe7, 0x38, 0x89, 0xb6, 0x8d, 0x7e, 0x36, 0x35, 0x92, 0xe2, 0x51, 0x1c,
+	0x45, 0x6e, 0x24, 0xae, 0xbf, 0x84, 0x76, 0x8e, 0x85, 0xa5, 0x88, 0x2f, 0xe2, 0x66, 0x4b, 0x9d,
+	0x2c, 0x80, 0x9e, 0xcb, 0xd3, 0xa9, 0x1f, 0x1f, 0x56, 0xf3, 0xac, 0x22, 0xc5, 0x47, 0x21, 0x71,
+	0xe9, 0x6e, 0x2f, 0xd1, 0x2e, 0x43, 0x4a, 0x3c, 0x51, 0x3a, 0xd2, 0xee, 0xa9, 0xb2, 0x7f, 0xa8,
+	0xfe, 0xf3, 0xdd, 0x8e, 0xf2, 0xdd, 0xbb, 0x1d, 0xe5, 0x3f, 0xef, 0x76, 0x94, 0xdf, 0xbd, 0xdf,
+	0xb9, 0xf3, 0xdd, 0xfb, 0x9d, 0x3b, 0xff, 0x7e, 0xbf, 0x73, 0x67, 0x54, 0xc3, 0xbf, 0xcc, 0xbf,
+	0xf8, 0xff, 0x00, 0xc7, 0x1b, 0xbc, 0xa7, 0xa8, 0x17, 0x00, 0x00,
 }
@@ -0,0 +1,169 @@
+// Copyright (c) 2018 ContentBox Authors.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package rpc
+
+import (
+	"context"
+	"errors"
+
+	"github.com/BOXFoundation/boxd/core"
+	"github.com/BOXFoundation/boxd/core/types"
+	"github.com/BOXFoundation/boxd/crypto"
+	"github.com/BOXFoundation/boxd/metrics"
+	"github.com/BOXFoundation/boxd/p2p"
+	"github.com/BOXFoundation/boxd/rpc/pb"
+	"github.com/BOXFoundation/boxd/storage"
+	"github.com/BOXFoundation/boxd/wallet"
+	"github.com/golang/protobuf/ptypes/any"
+	spb "google.golang.org/genproto/googleapis/rpc/status"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// errorDetailTypeURL is the type url of ErrorDetail packed in grpc status
+const errorDetailTypeURL = "type.googleapis.com/rpcpb.ErrorDetail"
+
+var errNotSynced = errors.New("Node is still syncing blocks")
+
+// rpcError is an error replied with a registered code
+type rpcError struct {
+	code rpcpb.ErrorCode
+	err  error
+}
+
+func (e *rpcError) Error() string {
+	return e.err.Error()
+}
+
+// newRPCError wraps err to be replied with code
+func newRPCError(code rpcpb.ErrorCode, err error) error {
+	return &rpcError{code: code, err: err}
+}
+
+// errorCodes registers codes of errors returned by packages rpc calls go
+// into. Errors neither registered nor wrapped by newRPCError are UNKNOWN
+var errorCodes = map[error]rpcpb.ErrorCode{
+	core.ErrInvalidAddressString:        rpcpb.ErrorCode_INVALID_ADDRESS,
+	core.ErrInvalidPKHash:               rpcpb.ErrorCode_INVALID_ADDRESS,
+	core.ErrInvalidAddressNetwork:       rpcpb.ErrorCode_INVALID_ADDRESS,
+	crypto.ErrInvalidBech32Encoding:     rpcpb.ErrorCode_INVALID_ADDRESS,
+	crypto.ErrInvalidBase58Encoding:     rpcpb.ErrorCode_INVALID_ADDRESS,
+	crypto.ErrInvalidBase58StringLength: rpcpb.ErrorCode_INVALID_ADDRESS,
+	crypto.ErrInvalidBase58Checksum:     rpcpb.ErrorCode_INVALID_ADDRESS,
+	core.ErrInvalidOutPointProtoMessage: rpcpb.ErrorCode_INVALID_ARGUMENT,
+	core.ErrInvalidTxProtoMessage:       rpcpb.ErrorCode_INVALID_ARGUMENT,
+	errInvalidTxCursor:                  rpcpb.ErrorCode_INVALID_ARGUMENT,
+	errInvalidFilter:                    rpcpb.ErrorCode_INVALID_ARGUMENT,
+	core.ErrBlockIsNil:                  rpcpb.ErrorCode_NOT_FOUND,
+	errNotEnoughBalance:                 rpcpb.ErrorCode_INSUFFICIENT_FUNDS,
+	errNotSynced:                        rpcpb.ErrorCode_NOT_SYNCED,
+	errWalletDisabled:                   rpcpb.ErrorCode_WALLET_DISABLED,
+	wallet.ErrWatchOnly:                 rpcpb.ErrorCode_ACCOUNT_LOCKED,
+	wallet.ErrUnknownParticipant:        rpcpb.ErrorCode_ACCOUNT_LOCKED,
+	crypto.ErrInvalidMultisigThreshold:  rpcpb.ErrorCode_INVALID_ARGUMENT,
+	crypto.ErrDuplicateMultisigKey:      rpcpb.ErrorCode_INVALID_ARGUMENT,
+	p2p.ErrInvalidPeerAddr:              rpcpb.ErrorCode_INVALID_ARGUMENT,
+	p2p.ErrInvalidPeerID:                rpcpb.ErrorCode_INVALID_ARGUMENT,
+	p2p.ErrInvalidBanTarget:             rpcpb.ErrorCode_INVALID_ARGUMENT,
+	p2p.ErrInvalidBanDuration:           rpcpb.ErrorCode_INVALID_ARGUMENT,
+	p2p.ErrPeerBanned:                   rpcpb.ErrorCode_INVALID_ARGUMENT,
+	p2p.ErrPeerNotConnected:             rpcpb.ErrorCode_NOT_FOUND,
+	p2p.ErrNotBanned:                    rpcpb.ErrorCode_NOT_FOUND,
+	errInvalidBackupName:                rpcpb.ErrorCode_INVALID_ARGUMENT,
+	errInvalidProfileName:               rpcpb.ErrorCode_INVALID_ARGUMENT,
+	metrics.ErrUnknownProfile:           rpcpb.ErrorCode_INVALID_ARGUMENT,
+	metrics.ErrProfileDuration:          rpcpb.ErrorCode_INVALID_ARGUMENT,
+	storage.ErrBackupExists:             rpcpb.ErrorCode_INVALID_ARGUMENT,
+}
+
+// grpcCodes maps error codes to grpc status codes
+var grpcCodes = map[rpcpb.ErrorCode]codes.Code{
+	rpcpb.ErrorCode_OK:                 codes.OK,
+	rpcpb.ErrorCode_UNKNOWN:            codes.Unknown,
+	rpcpb.ErrorCode_INVALID_ARGUMENT:   codes.InvalidArgument,
+	rpcpb.ErrorCode_INVALID_ADDRESS:    codes.InvalidArgument,
+	rpcpb.ErrorCode_NOT_FOUND:          codes.NotFound,
+	rpcpb.ErrorCode_INSUFFICIENT_FUNDS: codes.FailedPrecondition,
+	rpcpb.ErrorCode_NOT_SYNCED:         codes.Unavailable,
+	rpcpb.ErrorCode_TX_REJECTED:        codes.FailedPrecondition,
+	rpcpb.ErrorCode_WALLET_DISABLED:    codes.Unimplemented,
+	rpcpb.ErrorCode_ACCOUNT_LOCKED:     codes.PermissionDenied,
+	rpcpb.ErrorCode_TIMEOUT:            codes.DeadlineExceeded,
+}
+
+// errorCode returns the registered code of err
+func errorCode(err error) rpcpb.ErrorCode {
+	if err == nil {
+		return rpcpb.ErrorCode_OK
+	}
+	if e, ok := err.(*rpcError); ok {
+		return e.code
+	}
+	if code, ok := errorCodes[err]; ok {
+		return code
+	}
+	return rpcpb.ErrorCode_UNKNOWN
+}
+
+// errorStatus converts err returned by rpc calls into a grpc status error
+// carrying its code in an ErrorDetail. Status errors are returned as is
+func errorStatus(err error) error {
+	if err == nil {
+		return nil
+	}
+	if _, ok := err.(interface{ GRPCStatus() *status.Status }); ok {
+		return err
+	}
+	code := errorCode(err)
+	detail := &rpcpb.ErrorDetail{Code: code, Reason: err.Error()}
+	st := &spb.Status{Code: int32(grpcCodes[code]), Message: err.Error()}
+	if data, e := detail.Marshal(); e == nil {
+		st.Details = []*any.Any{{TypeUrl: errorDetailTypeURL, Value: data}}
+	}
+	return status.ErrorProto(st)
+}
+
+func errorUnaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	resp, err := handler(ctx, req)
+	return resp, errorStatus(err)
+}
+
+func errorStreamInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	return errorStatus(handler(srv, ss))
+}
+
+// chainUnaryInterceptors runs inner within outer, as grpc server takes only
+// one unary interceptor
+func chainUnaryInterceptors(outer, inner grpc.UnaryServerInterceptor) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		return outer(ctx, req, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+			return inner(ctx, req, info, handler)
+		})
+	}
+}
+
+// chainStreamInterceptors runs inner within outer, as grpc server takes only
+// one stream interceptor
+func chainStreamInterceptors(outer, inner grpc.StreamServerInterceptor) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		return outer(srv, ss, info, func(srv interface{}, ss grpc.ServerStream) error {
+			return inner(srv, ss, info, handler)
+		})
+	}
+}
+
+// relayTx admits tx into tx pool and relays it. It fails with NOT_SYNCED
+// until blocks are synced, and with TX_REJECTED if tx pool refuses tx
+func relayTx(server GRPCServer, tx *types.Transaction) error {
+	if !p2p.IsSynced() {
+		return errNotSynced
+	}
+	if err := server.GetTxHandler().ProcessTx(tx, true /* relay */); err != nil {
+		return newRPCError(rpcpb.ErrorCode_TX_REJECTED, err)
+	}
+	recordLocalTx(server, tx)
+	return nil
+}
@@ -0,0 +1,52 @@
+// Copyright (c) 2018 ContentBox Authors.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package rpc
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/BOXFoundation/boxd/core"
+	"github.com/BOXFoundation/boxd/rpc/pb"
+	"github.com/facebookgo/ensure"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func statusDetail(t *testing.T, err error) (codes.Code, *rpcpb.ErrorDetail) {
+	st, ok := status.FromError(err)
+	ensure.True(t, ok)
+	details := st.Proto().Details
+	ensure.DeepEqual(t, len(details), 1)
+	ensure.DeepEqual(t, details[0].TypeUrl, errorDetailTypeURL)
+	detail := &rpcpb.ErrorDetail{}
+	ensure.Nil(t, detail.Unmarshal(details[0].Value))
+	return st.Code(), detail
+}
+
+func TestErrorStatus(t *testing.T) {
+	ensure.Nil(t, errorStatus(nil))
+
+	// registered errors
+	code, detail := statusDetail(t, errorStatus(core.ErrInvalidAddressString))
+	ensure.DeepEqual(t, code, codes.InvalidArgument)
+	ensure.DeepEqual(t, detail.Code, rpcpb.ErrorCode_INVALID_ADDRESS)
+
+	// wrapped errors keep their cause as reason
+	cause := errors.New("double spend")
+	code, detail = statusDetail(t, errorStatus(newRPCError(rpcpb.ErrorCode_TX_REJECTED, cause)))
+	ensure.DeepEqual(t, code, codes.FailedPrecondition)
+	ensure.DeepEqual(t, detail.Code, rpcpb.ErrorCode_TX_REJECTED)
+	ensure.DeepEqual(t, detail.Reason, cause.Error())
+
+	// others are unknown
+	code, detail = statusDetail(t, errorStatus(errors.New("oops")))
+	ensure.DeepEqual(t, code, codes.Unknown)
+	ensure.DeepEqual(t, detail.Code, rpcpb.ErrorCode_UNKNOWN)
+
+	// status errors are kept as they are
+	limited := status.Error(codes.ResourceExhausted, "too many requests")
+	ensure.DeepEqual(t, errorStatus(limited), limited)
+}
@@ -0,0 +1,111 @@
+// Copyright (c) 2018 ContentBox Authors.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package rpc
+
+import (
+	"time"
+
+	"github.com/BOXFoundation/boxd/boxd/eventbus"
+	"github.com/BOXFoundation/boxd/boxd/service"
+	"github.com/BOXFoundation/boxd/p2p"
+	"github.com/jbenet/goprocess"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// health check service names of subsystems, the empty name stands for the
+// whole node, which is serving once chain is synced and peers are connected,
+// with critical services running. Services the node runs are checked by
+// HealthServicePrefix followed by their names, e.g. boxd.service.rpc
+const (
+	HealthServiceChain  = "boxd.chain"
+	HealthServiceP2P    = "boxd.p2p"
+	HealthServiceWallet = "boxd.wallet"
+	HealthServicePrefix = "boxd.service."
+
+	healthCheckInterval = 5 * time.Second
+	healthQueryTimeout  = time.Second
+)
+
+// subsystemHealth returns whether the node, its subsystems and services are
+// serving by health check service names
+func subsystemHealth(synced bool, peerCount int, walletUnlocked bool, services []service.Status) map[string]bool {
+	health := map[string]bool{
+		HealthServiceChain:  synced,
+		HealthServiceP2P:    peerCount > 0,
+		HealthServiceWallet: walletUnlocked,
+	}
+	serving := synced && peerCount > 0
+	for _, status := range services {
+		running := status.State == service.StateRunning
+		health[HealthServicePrefix+status.Name] = running
+		if status.Critical && !running {
+			serving = false
+		}
+	}
+	health[""] = serving
+	return health
+}
+
+// walletUnlocked returns whether node side signing is enabled with any
+// account unlocked
+func walletUnlocked(server GRPCServer) bool {
+	wltMgr := server.GetWalletManager()
+	return wltMgr != nil && wltMgr.HasUnlockedAccount()
+}
+
+func servingStatus(ok bool) healthpb.HealthCheckResponse_ServingStatus {
+	if ok {
+		return healthpb.HealthCheckResponse_SERVING
+	}
+	return healthpb.HealthCheckResponse_NOT_SERVING
+}
+
+// updateHealth refreshes serving status of subsystems periodically until proc closes
+func (s *Server) updateHealth(proc goprocess.Process, hs *health.Server) {
+	ticker := time.NewTicker(healthCheckInterval)
+	defer ticker.Stop()
+	var health map[string]bool
+	for {
+		health = subsystemHealth(p2p.IsSynced(), connectedPeerCount(s), walletUnlocked(s), serviceStatuses(s))
+		for name, ok := range health {
+			hs.SetServingStatus(name, servingStatus(ok))
+		}
+
+		select {
+		case <-ticker.C:
+		case <-proc.Closing():
+			for name := range health {
+				hs.SetServingStatus(name, healthpb.HealthCheckResponse_NOT_SERVING)
+			}
+			return
+		}
+	}
+}
+
+// connectedPeerCount returns the number of connected peers, or 0 if p2p doesn't answer in time
+func connectedPeerCount(server GRPCServer) int {
+	ch := make(chan int, 1)
+	server.GetEventBus().Send(eventbus.TopicGetConnectedPeerCount, ch)
+	select {
+	case count := <-ch:
+		return count
+	case <-time.After(healthQueryTimeout):
+		return 0
+	}
+}
+
+// serviceStatuses returns statuses of services the node runs, or none if the
+// node doesn't answer in time
+func serviceStatuses(server GRPCServer) []service.Status {
+	ch := make(chan []service.Status, 1)
+	server.GetEventBus().Send(eventbus.TopicGetServiceStatus, ch)
+	select {
+	case statuses := <-ch:
+		return statuses
+	case <-time.After(healthQueryTimeout):
+		return nil
+	}
+}
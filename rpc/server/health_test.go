@@ -0,0 +1,41 @@
+// Copyright (c) 2018 ContentBox Authors.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package rpc
+
+import (
+	"testing"
+
+	"github.com/BOXFoundation/boxd/boxd/service"
+	"github.com/facebookgo/ensure"
+)
+
+func TestSubsystemHealth(t *testing.T) {
+	health := subsystemHealth(true, 3, false, nil)
+	ensure.True(t, health[""])
+	ensure.True(t, health[HealthServiceChain])
+	ensure.True(t, health[HealthServiceP2P])
+	ensure.False(t, health[HealthServiceWallet])
+
+	// the node is not serving until synced with peers connected
+	ensure.False(t, subsystemHealth(false, 3, true, nil)[""])
+	health = subsystemHealth(true, 0, true, nil)
+	ensure.False(t, health[""])
+	ensure.False(t, health[HealthServiceP2P])
+	ensure.True(t, health[HealthServiceWallet])
+
+	// the node is not serving with any critical service down
+	services := []service.Status{
+		{Name: "chain", State: service.StateRunning, Critical: true},
+		{Name: "rpc", State: service.StateRestarting},
+	}
+	health = subsystemHealth(true, 3, false, services)
+	ensure.True(t, health[""])
+	ensure.True(t, health[HealthServicePrefix+"chain"])
+	ensure.False(t, health[HealthServicePrefix+"rpc"])
+	services[0].State = service.StateFailed
+	health = subsystemHealth(true, 3, false, services)
+	ensure.False(t, health[""])
+	ensure.False(t, health[HealthServicePrefix+"chain"])
+}
@@ -0,0 +1,434 @@
+// Copyright (c) 2018 ContentBox Authors.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package rpc
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/BOXFoundation/boxd/core/types"
+	"github.com/BOXFoundation/boxd/crypto"
+	"github.com/BOXFoundation/boxd/rpc/pb"
+	"github.com/jbenet/goprocess"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// JSONRPCConfig defines the address/port of bitcoin compatible json-rpc server
+type JSONRPCConfig struct {
+	Enabled bool   `mapstructure:"enabled"`
+	Address string `mapstructure:"address"`
+	Port    int    `mapstructure:"port"`
+}
+
+const (
+	// maxJSONRPCBodySize is the max size of a json-rpc request body
+	maxJSONRPCBodySize = 4 * 1024 * 1024
+	// maxJSONRPCBatchSize is the max number of requests in a batch
+	maxJSONRPCBatchSize = 100
+)
+
+// json-rpc error codes, the standard ones plus those bitcoind uses
+const (
+	jsonrpcParseError     = -32700
+	jsonrpcInvalidRequest = -32600
+	jsonrpcMethodNotFound = -32601
+	jsonrpcInvalidParams  = -32602
+	jsonrpcInternalError  = -32603
+	jsonrpcLimitExceeded  = -32005
+
+	jsonrpcInvalidAddress  = -5
+	jsonrpcDeserialization = -22
+	jsonrpcVerifyRejected  = -26
+)
+
+var errInvalidParams = errors.New("invalid params")
+
+type jsonrpcRequest struct {
+	JSONRPC string            `json:"jsonrpc"`
+	Method  string            `json:"method"`
+	Params  []json.RawMessage `json:"params"`
+	ID      *json.RawMessage  `json:"id"`
+}
+
+type jsonrpcError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+func (e *jsonrpcError) Error() string {
+	return fmt.Sprintf("%d: %s", e.Code, e.Message)
+}
+
+// jsonrpcResponse is a 1.0 response, which always carries result, error and id
+type jsonrpcResponse struct {
+	Result interface{}      `json:"result"`
+	Error  *jsonrpcError    `json:"error"`
+	ID     *json.RawMessage `json:"id"`
+}
+
+// jsonrpc2Response is a 2.0 response, which carries either result or error
+type jsonrpc2Response struct {
+	JSONRPC string           `json:"jsonrpc"`
+	Result  interface{}      `json:"result,omitempty"`
+	Error   *jsonrpcError    `json:"error,omitempty"`
+	ID      *json.RawMessage `json:"id"`
+}
+
+type jsonrpcHandler func(ctx context.Context, params []json.RawMessage) (interface{}, error)
+
+// jsonrpcServer maps bitcoin style json-rpc methods onto rpc services
+type jsonrpcServer struct {
+	tx       *txServer
+	ctl      *ctlserver
+	handlers map[string]jsonrpcHandler
+	// interceptor observes and limits each call as grpc calls are, calls are
+	// run directly if nil
+	interceptor grpc.UnaryServerInterceptor
+}
+
+func newJSONRPCServer(s GRPCServer, interceptor grpc.UnaryServerInterceptor) *jsonrpcServer {
+	js := &jsonrpcServer{
+		tx:          &txServer{server: s},
+		ctl:         &ctlserver{server: s},
+		interceptor: interceptor,
+	}
+	js.handlers = map[string]jsonrpcHandler{
+		"getblockcount":      js.getBlockCount,
+		"getblockhash":       js.getBlockHash,
+		"getblock":           js.getBlock,
+		"getblockheader":     js.getBlockHeader,
+		"getrawtransaction":  js.getRawTransaction,
+		"sendrawtransaction": js.sendRawTransaction,
+		"getbalance":         js.getBalance,
+	}
+	return js
+}
+
+// ServeHTTP handles a single request or a batch of requests. Notifications,
+// requests without id, are run but not answered
+func (js *jsonrpcServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "json-rpc requests must be POST", http.StatusMethodNotAllowed)
+		return
+	}
+	body, err := ioutil.ReadAll(http.MaxBytesReader(w, r.Body, maxJSONRPCBodySize))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	ctx := r.Context()
+	if addr, err := net.ResolveTCPAddr("tcp", r.RemoteAddr); err == nil {
+		// the client is limited by its address as grpc clients are
+		ctx = peer.NewContext(ctx, &peer.Peer{Addr: addr})
+	}
+	var resp interface{}
+	if batch := bytes.TrimLeft(body, " \t\r\n"); len(batch) > 0 && batch[0] == '[' {
+		var reqs []json.RawMessage
+		if err := json.Unmarshal(body, &reqs); err != nil {
+			resp = newJSONRPCResponse(nil, nil, &jsonrpcError{Code: jsonrpcParseError, Message: err.Error()})
+		} else if len(reqs) > maxJSONRPCBatchSize {
+			resp = newJSONRPCResponse(nil, nil, &jsonrpcError{Code: jsonrpcInvalidRequest,
+				Message: fmt.Sprintf("Too many requests in batch: %d, the max is %d", len(reqs), maxJSONRPCBatchSize)})
+		} else {
+			results := make([]interface{}, 0, len(reqs))
+			for _, raw := range reqs {
+				if result := js.handle(ctx, raw); result != nil {
+					results = append(results, result)
+				}
+			}
+			if len(results) > 0 {
+				resp = results
+			}
+		}
+	} else {
+		resp = js.handle(ctx, body)
+	}
+
+	if resp == nil {
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		logger.Warnf("failed to write json-rpc response: %v", err)
+	}
+}
+
+// handle runs the request in raw and returns its response, nil if it's a
+// notification
+func (js *jsonrpcServer) handle(ctx context.Context, raw []byte) interface{} {
+	req := &jsonrpcRequest{}
+	if err := json.Unmarshal(raw, req); err != nil {
+		return newJSONRPCResponse(nil, nil, &jsonrpcError{Code: jsonrpcParseError, Message: err.Error()})
+	}
+	resp := js.call(ctx, req)
+	if req.ID == nil {
+		// id null is decoded as no id, tell them apart by the member
+		var members map[string]json.RawMessage
+		if json.Unmarshal(raw, &members) == nil {
+			if _, ok := members["id"]; !ok {
+				return nil
+			}
+		}
+	}
+	return resp
+}
+
+func (js *jsonrpcServer) call(ctx context.Context, req *jsonrpcRequest) interface{} {
+	if req.Method == "" {
+		return newJSONRPCResponse(req, nil, &jsonrpcError{Code: jsonrpcInvalidRequest, Message: "method not specified"})
+	}
+	handler, ok := js.handlers[req.Method]
+	if !ok {
+		return newJSONRPCResponse(req, nil, &jsonrpcError{Code: jsonrpcMethodNotFound, Message: "Method not found"})
+	}
+	var result interface{}
+	var err error
+	if js.interceptor != nil {
+		info := &grpc.UnaryServerInfo{Server: js, FullMethod: "/jsonrpc/" + req.Method}
+		result, err = js.interceptor(ctx, req.Params, info, func(ctx context.Context, params interface{}) (interface{}, error) {
+			return handler(ctx, params.([]json.RawMessage))
+		})
+	} else {
+		result, err = handler(ctx, req.Params)
+	}
+	if err != nil {
+		logger.Debugf("json-rpc %s failed: %v", req.Method, err)
+		rpcErr, ok := err.(*jsonrpcError)
+		if !ok {
+			code := jsonrpcInternalError
+			if err == errInvalidParams {
+				code = jsonrpcInvalidParams
+			} else if status.Code(err) == codes.ResourceExhausted {
+				code = jsonrpcLimitExceeded
+				err = errors.New(status.Convert(err).Message())
+			}
+			rpcErr = &jsonrpcError{Code: code, Message: err.Error()}
+		}
+		return newJSONRPCResponse(req, nil, rpcErr)
+	}
+	return newJSONRPCResponse(req, result, nil)
+}
+
+func newJSONRPCResponse(req *jsonrpcRequest, result interface{}, err *jsonrpcError) interface{} {
+	var id *json.RawMessage
+	if req != nil {
+		id = req.ID
+	}
+	if req != nil && req.JSONRPC == "2.0" {
+		return &jsonrpc2Response{JSONRPC: "2.0", Result: result, Error: err, ID: id}
+	}
+	return &jsonrpcResponse{Result: result, Error: err, ID: id}
+}
+
+func (js *jsonrpcServer) getBlockCount(ctx context.Context, params []json.RawMessage) (interface{}, error) {
+	resp, err := js.ctl.GetBlockHeight(ctx, &rpcpb.GetBlockHeightRequest{})
+	if err != nil {
+		return nil, err
+	}
+	return resp.Height, nil
+}
+
+func (js *jsonrpcServer) getBlockHash(ctx context.Context, params []json.RawMessage) (interface{}, error) {
+	var height uint32
+	if len(params) != 1 || json.Unmarshal(params[0], &height) != nil {
+		return nil, errInvalidParams
+	}
+	resp, err := js.ctl.GetBlockHash(ctx, &rpcpb.GetBlockHashRequest{Height: height})
+	if err != nil {
+		return nil, &jsonrpcError{Code: jsonrpcInvalidParams, Message: "Block height out of range"}
+	}
+	return resp.Hash, nil
+}
+
+// getBlock returns the serialized block in hex if verbosity is 0, the block
+// with tx hashes if 1, which is the default, or with decoded txs if 2
+func (js *jsonrpcServer) getBlock(ctx context.Context, params []json.RawMessage) (interface{}, error) {
+	var hash string
+	if len(params) < 1 || json.Unmarshal(params[0], &hash) != nil {
+		return nil, errInvalidParams
+	}
+	verbosity := uint32(1)
+	if len(params) > 1 && !parseVerbosity(params[1], &verbosity) {
+		return nil, errInvalidParams
+	}
+	resp, err := js.ctl.GetBlockVerbose(ctx, &rpcpb.GetBlockVerboseRequest{Hash: hash, Verbosity: verbosity})
+	if err != nil {
+		if errorCode(err) == rpcpb.ErrorCode_INVALID_ARGUMENT {
+			return nil, errInvalidParams
+		}
+		return nil, &jsonrpcError{Code: jsonrpcInvalidAddress, Message: "Block not found"}
+	}
+	if verbosity == 0 {
+		return hex.EncodeToString(resp.Raw), nil
+	}
+	return resp.Block, nil
+}
+
+// getBlockHeader returns the decoded header, or the serialized one in hex if
+// not verbose
+func (js *jsonrpcServer) getBlockHeader(ctx context.Context, params []json.RawMessage) (interface{}, error) {
+	var hash string
+	if len(params) < 1 || json.Unmarshal(params[0], &hash) != nil {
+		return nil, errInvalidParams
+	}
+	verbose := true
+	if len(params) > 1 && !parseVerbose(params[1], &verbose) {
+		return nil, errInvalidParams
+	}
+	var verbosity uint32
+	if verbose {
+		verbosity = 1
+	}
+	resp, err := js.ctl.GetBlockHeaderVerbose(ctx, &rpcpb.GetBlockVerboseRequest{Hash: hash, Verbosity: verbosity})
+	if err != nil {
+		if errorCode(err) == rpcpb.ErrorCode_INVALID_ARGUMENT {
+			return nil, errInvalidParams
+		}
+		return nil, &jsonrpcError{Code: jsonrpcInvalidAddress, Message: "Block not found"}
+	}
+	if !verbose {
+		return hex.EncodeToString(resp.Raw), nil
+	}
+	return resp.Block, nil
+}
+
+// getRawTransaction returns the serialized tx in hex, or the decoded tx if verbose
+func (js *jsonrpcServer) getRawTransaction(ctx context.Context, params []json.RawMessage) (interface{}, error) {
+	var txid string
+	if len(params) < 1 || json.Unmarshal(params[0], &txid) != nil {
+		return nil, errInvalidParams
+	}
+	verbose := false
+	if len(params) > 1 && !parseVerbose(params[1], &verbose) {
+		return nil, errInvalidParams
+	}
+	if verbose {
+		resp, err := js.tx.GetTransactionDetail(ctx, &rpcpb.GetTransactionDetailRequest{Hash: txid})
+		if err != nil {
+			return nil, &jsonrpcError{Code: jsonrpcInvalidAddress, Message: "No such transaction"}
+		}
+		return resp, nil
+	}
+	hash := crypto.HashType{}
+	if err := hash.SetString(txid); err != nil {
+		return nil, errInvalidParams
+	}
+	resp, err := js.tx.GetRawTransaction(ctx, &rpcpb.GetRawTransactionRequest{Hash: hash.GetBytes()})
+	if err != nil {
+		return nil, &jsonrpcError{Code: jsonrpcInvalidAddress, Message: "No such transaction"}
+	}
+	return hex.EncodeToString(resp.Raw), nil
+}
+
+// sendRawTransaction submits a hex serialized tx and returns its hash
+func (js *jsonrpcServer) sendRawTransaction(ctx context.Context, params []json.RawMessage) (interface{}, error) {
+	var rawHex string
+	if len(params) < 1 || json.Unmarshal(params[0], &rawHex) != nil {
+		return nil, errInvalidParams
+	}
+	raw, err := hex.DecodeString(rawHex)
+	if err != nil {
+		return nil, &jsonrpcError{Code: jsonrpcDeserialization, Message: "TX decode failed"}
+	}
+	tx := &types.Transaction{}
+	if err := tx.Unmarshal(raw); err != nil {
+		return nil, &jsonrpcError{Code: jsonrpcDeserialization, Message: "TX decode failed"}
+	}
+	if err := js.tx.server.GetTxHandler().ProcessTx(tx, true /* relay */); err != nil {
+		return nil, &jsonrpcError{Code: jsonrpcVerifyRejected, Message: err.Error()}
+	}
+	recordLocalTx(js.tx.server, tx)
+	hash, err := tx.TxHash()
+	if err != nil {
+		return nil, err
+	}
+	return hash.String(), nil
+}
+
+// getBalance returns the total balance of the given addresses in box
+func (js *jsonrpcServer) getBalance(ctx context.Context, params []json.RawMessage) (interface{}, error) {
+	if len(params) == 0 {
+		return nil, errInvalidParams
+	}
+	addrs := make([]string, 0, len(params))
+	for _, param := range params {
+		var addr string
+		if json.Unmarshal(param, &addr) != nil {
+			return nil, errInvalidParams
+		}
+		addrs = append(addrs, addr)
+	}
+	resp, err := js.tx.GetBalance(ctx, &rpcpb.GetBalanceRequest{Addrs: addrs})
+	if err != nil {
+		return nil, &jsonrpcError{Code: jsonrpcInvalidAddress, Message: err.Error()}
+	}
+	var total uint64
+	for _, balance := range resp.Balances {
+		total += balance
+	}
+	return total, nil
+}
+
+// parseVerbose accepts both bool and int verbose flags as bitcoind does
+func parseVerbose(param json.RawMessage, verbose *bool) bool {
+	if json.Unmarshal(param, verbose) == nil {
+		return true
+	}
+	var n int
+	if json.Unmarshal(param, &n) != nil {
+		return false
+	}
+	*verbose = n != 0
+	return true
+}
+
+// parseVerbosity accepts both int verbosity and bool verbose flags, which
+// mean 1 if true
+func parseVerbosity(param json.RawMessage, verbosity *uint32) bool {
+	if json.Unmarshal(param, verbosity) == nil {
+		return true
+	}
+	var verbose bool
+	if json.Unmarshal(param, &verbose) != nil {
+		return false
+	}
+	*verbosity = 0
+	if verbose {
+		*verbosity = 1
+	}
+	return true
+}
+
+func (s *Server) serveJSONRPC(proc goprocess.Process) {
+	var endpoint = fmt.Sprintf("%s:%d", s.cfg.JSONRPC.Address, s.cfg.JSONRPC.Port)
+	server := &http.Server{Addr: endpoint, Handler: newJSONRPCServer(s, s.jsonrpcInterceptor)}
+	go func() {
+		logger.Infof("Starting RPC:json-rpc server at %s", endpoint)
+		if err := server.ListenAndServe(); err != http.ErrServerClosed {
+			logger.Errorf("json-rpc server error: %v", err)
+			go proc.Close()
+		}
+	}()
+
+	<-proc.Closing()
+	logger.Info("Shutting down RPC:json-rpc server...")
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	server.Shutdown(ctx)
+	logger.Info("RPC:json-rpc server is down.")
+}
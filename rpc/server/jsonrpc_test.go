@@ -0,0 +1,135 @@
+// Copyright (c) 2018 ContentBox Authors.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package rpc
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/facebookgo/ensure"
+)
+
+func newTestJSONRPCServer() *jsonrpcServer {
+	return &jsonrpcServer{
+		handlers: map[string]jsonrpcHandler{
+			"getblockcount": func(ctx context.Context, params []json.RawMessage) (interface{}, error) {
+				return 100, nil
+			},
+			"getblockhash": func(ctx context.Context, params []json.RawMessage) (interface{}, error) {
+				return nil, errInvalidParams
+			},
+		},
+	}
+}
+
+func postJSONRPC(t *testing.T, js *jsonrpcServer, body string) string {
+	req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(body))
+	w := httptest.NewRecorder()
+	js.ServeHTTP(w, req)
+	ensure.DeepEqual(t, w.Code, http.StatusOK)
+	return strings.TrimSpace(w.Body.String())
+}
+
+func TestJSONRPCVersions(t *testing.T) {
+	js := newTestJSONRPCServer()
+
+	// 1.0 responses carry both result and error
+	resp := postJSONRPC(t, js, `{"method":"getblockcount","params":[],"id":1}`)
+	ensure.DeepEqual(t, resp, `{"result":100,"error":null,"id":1}`)
+
+	// 2.0 responses carry either of them
+	resp = postJSONRPC(t, js, `{"jsonrpc":"2.0","method":"getblockcount","id":"a"}`)
+	ensure.DeepEqual(t, resp, `{"jsonrpc":"2.0","result":100,"id":"a"}`)
+
+	resp = postJSONRPC(t, js, `{"jsonrpc":"2.0","method":"getblockhash","params":["x"],"id":2}`)
+	ensure.DeepEqual(t, resp, `{"jsonrpc":"2.0","error":{"code":-32602,"message":"invalid params"},"id":2}`)
+}
+
+func TestJSONRPCErrors(t *testing.T) {
+	js := newTestJSONRPCServer()
+
+	resp := postJSONRPC(t, js, `{"method":"getinfo","id":1}`)
+	ensure.DeepEqual(t, resp, `{"result":null,"error":{"code":-32601,"message":"Method not found"},"id":1}`)
+
+	resp = postJSONRPC(t, js, `{"method":`)
+	ensure.StringContains(t, resp, `"code":-32700`)
+
+	// batch requests are answered in order
+	resp = postJSONRPC(t, js, ` [{"method":"getblockcount","id":1},{"method":"getinfo","id":2}]`)
+	ensure.DeepEqual(t, resp, `[{"result":100,"error":null,"id":1},`+
+		`{"result":null,"error":{"code":-32601,"message":"Method not found"},"id":2}]`)
+
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	w := httptest.NewRecorder()
+	js.ServeHTTP(w, req)
+	ensure.DeepEqual(t, w.Code, http.StatusMethodNotAllowed)
+}
+
+func TestJSONRPCNotifications(t *testing.T) {
+	js := newTestJSONRPCServer()
+	called := 0
+	js.handlers["ping"] = func(ctx context.Context, params []json.RawMessage) (interface{}, error) {
+		called++
+		return nil, nil
+	}
+
+	// notifications are run without responses
+	req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`{"jsonrpc":"2.0","method":"ping"}`))
+	w := httptest.NewRecorder()
+	js.ServeHTTP(w, req)
+	ensure.DeepEqual(t, w.Code, http.StatusNoContent)
+	ensure.DeepEqual(t, w.Body.Len(), 0)
+	ensure.DeepEqual(t, called, 1)
+
+	resp := postJSONRPC(t, js, `[{"method":"ping"},{"method":"getblockcount","id":1},{"method":"getinfo"}]`)
+	ensure.DeepEqual(t, resp, `[{"result":100,"error":null,"id":1}]`)
+	ensure.DeepEqual(t, called, 2)
+
+	// requests with null id are answered
+	resp = postJSONRPC(t, js, `{"method":"getblockcount","id":null}`)
+	ensure.DeepEqual(t, resp, `{"result":100,"error":null,"id":null}`)
+}
+
+func TestJSONRPCLimits(t *testing.T) {
+	js := newTestJSONRPCServer()
+
+	reqs := make([]string, maxJSONRPCBatchSize+1)
+	for i := range reqs {
+		reqs[i] = `{"method":"getblockcount","id":1}`
+	}
+	resp := postJSONRPC(t, js, "["+strings.Join(reqs, ",")+"]")
+	ensure.StringContains(t, resp, `"code":-32600`)
+
+	// each call of a batch is limited
+	js.interceptor = newRateLimiter(&RateLimitConfig{Enabled: true, ClientRate: 0.001, ClientBurst: 2}).unaryInterceptor
+	resp = postJSONRPC(t, js, `[{"method":"getblockcount","id":1},{"method":"getblockcount","id":2},{"method":"getblockcount","id":3}]`)
+	ensure.DeepEqual(t, resp, `[{"result":100,"error":null,"id":1},{"result":100,"error":null,"id":2},`+
+		`{"result":null,"error":{"code":-32005,"message":"too many requests from 192.0.2.1"},"id":3}]`)
+}
+
+func TestParseVerbose(t *testing.T) {
+	for param, expect := range map[string]bool{"true": true, "false": false, "1": true, "0": false} {
+		verbose := !expect
+		ensure.True(t, parseVerbose(json.RawMessage(param), &verbose))
+		ensure.DeepEqual(t, verbose, expect)
+	}
+	var verbose bool
+	ensure.False(t, parseVerbose(json.RawMessage(`"yes"`), &verbose))
+}
+
+func TestParseVerbosity(t *testing.T) {
+	for param, expect := range map[string]uint32{"0": 0, "1": 1, "2": 2, "true": 1, "false": 0} {
+		verbosity := uint32(9)
+		ensure.True(t, parseVerbosity(json.RawMessage(param), &verbosity))
+		ensure.DeepEqual(t, verbosity, expect)
+	}
+	var verbosity uint32
+	ensure.False(t, parseVerbosity(json.RawMessage(`-1`), &verbosity))
+	ensure.False(t, parseVerbosity(json.RawMessage(`"2"`), &verbosity))
+}
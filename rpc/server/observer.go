@@ -0,0 +1,74 @@
+// Copyright (c) 2018 ContentBox Authors.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package rpc
+
+import (
+	"context"
+	"time"
+
+	"github.com/BOXFoundation/boxd/metrics"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+// rpcMetricsPrefix is the prefix of metrics of rpc methods, e.g.,
+// box.rpc.listtransactions.latency
+const rpcMetricsPrefix = "box.rpc."
+
+// observer records latency and errors of rpc methods into metrics, traces
+// calls if tracing is on, and logs requests if enabled
+type observer struct {
+	logRequests bool
+}
+
+func newObserver(logRequests bool) *observer {
+	return &observer{logRequests: logRequests}
+}
+
+func (o *observer) unaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	start := time.Now()
+	ctx, span := startRPCSpan(ctx, info.FullMethod)
+	resp, err := handler(ctx, req)
+	finishRPCSpan(span, err)
+	o.observe(ctx, info.FullMethod, time.Since(start), err)
+	return resp, err
+}
+
+func (o *observer) streamInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	start := time.Now()
+	_, span := startRPCSpan(ss.Context(), info.FullMethod)
+	err := handler(srv, ss)
+	finishRPCSpan(span, err)
+	o.observe(ss.Context(), info.FullMethod, time.Since(start), err)
+	return err
+}
+
+// observe records a call of method which took elapsed and failed with err
+// if not nil. Streams are recorded once they end
+func (o *observer) observe(ctx context.Context, fullMethod string, elapsed time.Duration, err error) {
+	method := methodName(fullMethod)
+	metrics.NewTimer(rpcMetricsPrefix + method + ".latency").Update(elapsed)
+	if err != nil {
+		metrics.NewCounter(rpcMetricsPrefix + method + ".errors").Inc(1)
+	}
+	if o.logRequests {
+		logger.Infof("rpc request method=%s client=%s duration=%s code=%s",
+			method, clientIP(ctx), elapsed, status.Code(err))
+	}
+}
+
+// startRPCSpan starts a span of a call of method
+func startRPCSpan(ctx context.Context, fullMethod string) (context.Context, *metrics.Span) {
+	ctx, span := metrics.StartSpan(ctx, "rpc "+methodName(fullMethod))
+	span.SetAttribute("rpc.method", fullMethod)
+	return ctx, span
+}
+
+// finishRPCSpan ends span of a call failed with err if not nil
+func finishRPCSpan(span *metrics.Span, err error) {
+	span.SetAttribute("rpc.grpc.status_code", int(status.Code(err)))
+	span.SetError(err)
+	span.Finish()
+}
@@ -0,0 +1,39 @@
+// Copyright (c) 2018 ContentBox Authors.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package rpc
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/BOXFoundation/boxd/metrics"
+	"github.com/facebookgo/ensure"
+	"google.golang.org/grpc"
+)
+
+func TestObserverUnaryInterceptor(t *testing.T) {
+	o := newObserver(true)
+	info := &grpc.UnaryServerInfo{FullMethod: "/rpcpb.TransactionCommand/GetFeePrice"}
+	timer := metrics.NewTimer(rpcMetricsPrefix + "getfeeprice.latency")
+	errCounter := metrics.NewCounter(rpcMetricsPrefix + "getfeeprice.errors")
+	calls, errs := timer.Count(), errCounter.Count()
+
+	resp, err := o.unaryInterceptor(context.Background(), nil, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+		return "ok", nil
+	})
+	ensure.Nil(t, err)
+	ensure.DeepEqual(t, resp, "ok")
+	ensure.DeepEqual(t, timer.Count(), calls+1)
+	ensure.DeepEqual(t, errCounter.Count(), errs)
+
+	failure := errors.New("failure")
+	_, err = o.unaryInterceptor(context.Background(), nil, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+		return nil, failure
+	})
+	ensure.DeepEqual(t, err, failure)
+	ensure.DeepEqual(t, timer.Count(), calls+2)
+	ensure.DeepEqual(t, errCounter.Count(), errs+1)
+}
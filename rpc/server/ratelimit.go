@@ -0,0 +1,207 @@
+// Copyright (c) 2018 ContentBox Authors.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package rpc
+
+import (
+	"context"
+	"net"
+	"strings"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// clientIdleTimeout is how long the bucket of a quiet client is kept
+const clientIdleTimeout = 10 * time.Minute
+
+// RateLimitConfig defines the limits of requests served by rpc server
+type RateLimitConfig struct {
+	Enabled bool `mapstructure:"enabled"`
+	// requests per second and burst allowed for each client ip, 0 means unlimited
+	ClientRate  float64 `mapstructure:"client_rate"`
+	ClientBurst int     `mapstructure:"client_burst"`
+	// limits of methods keyed by method name in lower case, e.g., listtransactions
+	Methods map[string]MethodLimit `mapstructure:"methods"`
+}
+
+// MethodLimit defines the limits of a rpc method over all clients, 0 means unlimited
+type MethodLimit struct {
+	Rate          float64 `mapstructure:"rate"`
+	Burst         int     `mapstructure:"burst"`
+	MaxConcurrent int     `mapstructure:"max_concurrent"`
+}
+
+// tokenBucket allows rate requests per second on average and up to burst at once
+type tokenBucket struct {
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+func newTokenBucket(rate float64, burst int, now time.Time) *tokenBucket {
+	if burst < 1 {
+		burst = 1
+	}
+	return &tokenBucket{
+		rate:   rate,
+		burst:  float64(burst),
+		tokens: float64(burst),
+		last:   now,
+	}
+}
+
+func (b *tokenBucket) allow(now time.Time) bool {
+	if elapsed := now.Sub(b.last).Seconds(); elapsed > 0 {
+		b.tokens += elapsed * b.rate
+		if b.tokens > b.burst {
+			b.tokens = b.burst
+		}
+	}
+	b.last = now
+	if b.tokens < 1 {
+		return false
+	}
+	b.tokens--
+	return true
+}
+
+// rateLimiter rejects requests beyond the configured limits with RESOURCE_EXHAUSTED
+type rateLimiter struct {
+	cfg *RateLimitConfig
+
+	mtx       sync.Mutex
+	clients   map[string]*tokenBucket
+	methods   map[string]*tokenBucket
+	slots     map[string]chan struct{}
+	lastPrune time.Time
+}
+
+func newRateLimiter(cfg *RateLimitConfig) *rateLimiter {
+	now := time.Now()
+	l := &rateLimiter{
+		cfg:       cfg,
+		clients:   make(map[string]*tokenBucket),
+		methods:   make(map[string]*tokenBucket),
+		slots:     make(map[string]chan struct{}),
+		lastPrune: now,
+	}
+	for name, limit := range cfg.Methods {
+		name = strings.ToLower(name)
+		if limit.Rate > 0 {
+			l.methods[name] = newTokenBucket(limit.Rate, limit.Burst, now)
+		}
+		if limit.MaxConcurrent > 0 {
+			l.slots[name] = make(chan struct{}, limit.MaxConcurrent)
+		}
+	}
+	return l
+}
+
+// acquire checks the request against limits, the returned func must be
+// called once the request is done if no error occurs
+func (l *rateLimiter) acquire(ctx context.Context, fullMethod string) (func(), error) {
+	name := methodName(fullMethod)
+	now := time.Now()
+
+	l.mtx.Lock()
+	if l.cfg.ClientRate > 0 {
+		ip := clientIP(ctx)
+		bucket, ok := l.clients[ip]
+		if !ok {
+			bucket = newTokenBucket(l.cfg.ClientRate, l.cfg.ClientBurst, now)
+			l.clients[ip] = bucket
+		}
+		if !bucket.allow(now) {
+			l.mtx.Unlock()
+			return nil, status.Errorf(codes.ResourceExhausted, "too many requests from %s", ip)
+		}
+		l.pruneClients(now)
+	}
+	if bucket, ok := l.methods[name]; ok && !bucket.allow(now) {
+		l.mtx.Unlock()
+		return nil, status.Errorf(codes.ResourceExhausted, "too many requests of %s", fullMethod)
+	}
+	l.mtx.Unlock()
+
+	slots, ok := l.slots[name]
+	if !ok {
+		return func() {}, nil
+	}
+	select {
+	case slots <- struct{}{}:
+		return func() { <-slots }, nil
+	default:
+		return nil, status.Errorf(codes.ResourceExhausted, "too many concurrent requests of %s", fullMethod)
+	}
+}
+
+// pruneClients drops buckets of clients idle for a while, it's called with mtx held
+func (l *rateLimiter) pruneClients(now time.Time) {
+	if now.Sub(l.lastPrune) < clientIdleTimeout {
+		return
+	}
+	for ip, bucket := range l.clients {
+		if now.Sub(bucket.last) > clientIdleTimeout {
+			delete(l.clients, ip)
+		}
+	}
+	l.lastPrune = now
+}
+
+func (l *rateLimiter) unaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	release, err := l.acquire(ctx, info.FullMethod)
+	if err != nil {
+		logger.Debugf("reject rpc request %s: %v", info.FullMethod, err)
+		return nil, err
+	}
+	defer release()
+	return handler(ctx, req)
+}
+
+func (l *rateLimiter) streamInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	release, err := l.acquire(ss.Context(), info.FullMethod)
+	if err != nil {
+		logger.Debugf("reject rpc stream %s: %v", info.FullMethod, err)
+		return err
+	}
+	defer release()
+	return handler(srv, ss)
+}
+
+// methodName returns the method name in lower case from /package.Service/Method
+func methodName(fullMethod string) string {
+	return strings.ToLower(fullMethod[strings.LastIndex(fullMethod, "/")+1:])
+}
+
+// clientIP returns the ip of the client sending request. Requests relayed by
+// the local http gateway carry the original client in x-forwarded-for, which
+// the gateway appends to any value sent by the client. Only the last entry is
+// trusted, so that clients can not dodge limits by faking the header
+func clientIP(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return ""
+	}
+	ip := p.Addr.String()
+	if host, _, err := net.SplitHostPort(ip); err == nil {
+		ip = host
+	}
+	if parsed := net.ParseIP(ip); parsed == nil || !parsed.IsLoopback() {
+		return ip
+	}
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if forwarded := md.Get("x-forwarded-for"); len(forwarded) > 0 {
+			entries := strings.Split(forwarded[len(forwarded)-1], ",")
+			return strings.TrimSpace(entries[len(entries)-1])
+		}
+	}
+	return ip
+}
@@ -0,0 +1,125 @@
+// Copyright (c) 2018 ContentBox Authors.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package rpc
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/facebookgo/ensure"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+func peerContext(ip string) context.Context {
+	addr := &net.TCPAddr{IP: net.ParseIP(ip), Port: 19191}
+	return peer.NewContext(context.Background(), &peer.Peer{Addr: addr})
+}
+
+func TestTokenBucket(t *testing.T) {
+	now := time.Now()
+	bucket := newTokenBucket(2, 3, now)
+	for i := 0; i < 3; i++ {
+		ensure.True(t, bucket.allow(now))
+	}
+	ensure.False(t, bucket.allow(now))
+
+	// 2 tokens refilled after a second
+	now = now.Add(time.Second)
+	ensure.True(t, bucket.allow(now))
+	ensure.True(t, bucket.allow(now))
+	ensure.False(t, bucket.allow(now))
+
+	// never more than burst
+	now = now.Add(time.Minute)
+	for i := 0; i < 3; i++ {
+		ensure.True(t, bucket.allow(now))
+	}
+	ensure.False(t, bucket.allow(now))
+}
+
+func TestRateLimiterClient(t *testing.T) {
+	limiter := newRateLimiter(&RateLimitConfig{Enabled: true, ClientRate: 0.001, ClientBurst: 2})
+	method := "/rpcpb.TransactionCommand/GetBalance"
+
+	ctx := peerContext("10.0.0.1")
+	for i := 0; i < 2; i++ {
+		release, err := limiter.acquire(ctx, method)
+		ensure.Nil(t, err)
+		release()
+	}
+	_, err := limiter.acquire(ctx, method)
+	ensure.DeepEqual(t, status.Code(err), codes.ResourceExhausted)
+
+	// other clients are not affected
+	_, err = limiter.acquire(peerContext("10.0.0.2"), method)
+	ensure.Nil(t, err)
+
+	// clients behind the local http gateway are told apart by x-forwarded-for
+	gwCtx := metadata.NewIncomingContext(peerContext("127.0.0.1"),
+		metadata.Pairs("x-forwarded-for", "10.0.0.1"))
+	ensure.DeepEqual(t, clientIP(gwCtx), "10.0.0.1")
+	_, err = limiter.acquire(gwCtx, method)
+	ensure.DeepEqual(t, status.Code(err), codes.ResourceExhausted)
+}
+
+func TestRateLimiterSpoofedForwarded(t *testing.T) {
+	limiter := newRateLimiter(&RateLimitConfig{Enabled: true, ClientRate: 0.001, ClientBurst: 2})
+	method := "/rpcpb.TransactionCommand/GetBalance"
+
+	// the gateway appends the real client after the header sent by it
+	for i := 0; i < 5; i++ {
+		spoofed := fmt.Sprintf("192.168.1.%d, 10.0.0.1", i)
+		ctx := metadata.NewIncomingContext(peerContext("127.0.0.1"),
+			metadata.Pairs("x-forwarded-for", spoofed))
+		ensure.DeepEqual(t, clientIP(ctx), "10.0.0.1")
+		release, err := limiter.acquire(ctx, method)
+		if i < 2 {
+			ensure.Nil(t, err)
+			release()
+		} else {
+			ensure.DeepEqual(t, status.Code(err), codes.ResourceExhausted)
+		}
+	}
+
+	// x-forwarded-for sent as grpc metadata through the gateway comes first
+	ctx := metadata.NewIncomingContext(peerContext("127.0.0.1"),
+		metadata.Pairs("x-forwarded-for", "192.168.1.100", "x-forwarded-for", "10.0.0.2"))
+	ensure.DeepEqual(t, clientIP(ctx), "10.0.0.2")
+}
+
+func TestRateLimiterMethod(t *testing.T) {
+	limiter := newRateLimiter(&RateLimitConfig{
+		Enabled: true,
+		Methods: map[string]MethodLimit{
+			"ListTransactions": {Rate: 0.001, Burst: 3, MaxConcurrent: 2},
+		},
+	})
+	method := "/rpcpb.WalletCommand/ListTransactions"
+	ctx := peerContext("10.0.0.1")
+
+	release1, err := limiter.acquire(ctx, method)
+	ensure.Nil(t, err)
+	release2, err := limiter.acquire(ctx, method)
+	ensure.Nil(t, err)
+	// no slot left while both requests are running
+	_, err = limiter.acquire(ctx, method)
+	ensure.DeepEqual(t, status.Code(err), codes.ResourceExhausted)
+	release1()
+	release2()
+
+	// burst used up
+	_, err = limiter.acquire(ctx, method)
+	ensure.DeepEqual(t, status.Code(err), codes.ResourceExhausted)
+
+	// other methods are not limited
+	_, err = limiter.acquire(ctx, "/rpcpb.WalletCommand/GetTransactionCount")
+	ensure.Nil(t, err)
+}
@@ -0,0 +1,72 @@
+// Copyright (c) 2018 ContentBox Authors.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package rpc
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io/ioutil"
+	"path"
+
+	gogoproto "github.com/gogo/protobuf/proto"
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/protoc-gen-go/descriptor"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/reflection"
+)
+
+// registerReflection enables server reflection on s. Descriptors of protos
+// generated by gogo live in gogo registry, while reflection looks them up in
+// golang/protobuf registry, so they are copied over first
+func registerReflection(s *grpc.Server) {
+	for _, info := range s.GetServiceInfo() {
+		if file, ok := info.Metadata.(string); ok {
+			bridgeFileDescriptor(file)
+		}
+	}
+	reflection.Register(s)
+}
+
+// bridgeFileDescriptor registers the gogo descriptor of file and its
+// dependencies to golang/protobuf registry
+func bridgeFileDescriptor(file string) {
+	if proto.FileDescriptor(file) != nil {
+		return
+	}
+	enc := gogoproto.FileDescriptor(file)
+	if enc == nil {
+		// imported with full path but registered with base name
+		if enc = gogoproto.FileDescriptor(path.Base(file)); enc == nil {
+			return
+		}
+	}
+	proto.RegisterFile(file, enc)
+
+	fd, err := decodeFileDescriptor(enc)
+	if err != nil {
+		logger.Warnf("failed to decode descriptor of %s: %v", file, err)
+		return
+	}
+	for _, dep := range fd.Dependency {
+		bridgeFileDescriptor(dep)
+	}
+}
+
+func decodeFileDescriptor(enc []byte) (*descriptor.FileDescriptorProto, error) {
+	r, err := gzip.NewReader(bytes.NewReader(enc))
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+	raw, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	fd := &descriptor.FileDescriptorProto{}
+	if err := proto.Unmarshal(raw, fd); err != nil {
+		return nil, err
+	}
+	return fd, nil
+}
@@ -0,0 +1,25 @@
+// Copyright (c) 2018 ContentBox Authors.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package rpc
+
+import (
+	"testing"
+
+	"github.com/BOXFoundation/boxd/rpc/pb"
+	"github.com/facebookgo/ensure"
+	"github.com/golang/protobuf/proto"
+	"google.golang.org/grpc"
+)
+
+func TestRegisterReflection(t *testing.T) {
+	s := grpc.NewServer()
+	rpcpb.RegisterTransactionCommandServer(s, &txServer{})
+	registerReflection(s)
+
+	ensure.NotNil(t, proto.FileDescriptor("transaction.proto"))
+	// dependencies are bridged too
+	ensure.NotNil(t, proto.FileDescriptor("common.proto"))
+	ensure.NotNil(t, proto.FileDescriptor("github.com/BOXFoundation/boxd/core/pb/block.proto"))
+}
@@ -0,0 +1,123 @@
+// Copyright (c) 2018 ContentBox Authors.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package rpc
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/BOXFoundation/boxd/rpc/pb"
+)
+
+// batches are limited as the chain can't move on while they run
+const (
+	// maxBatchCalls is the max number of calls in a batch
+	maxBatchCalls = 100
+	// maxBatchListCalls is the max number of calls listing utxos or txs of
+	// addresses in a batch, which may load many entries from db
+	maxBatchListCalls = 10
+	// maxBatchDuration is the time after which a batch holding the chain
+	// still is aborted
+	maxBatchDuration = 3 * time.Second
+)
+
+func registerBatch(s *Server) {
+	rpcpb.RegisterBatchCommandServer(s.server, &batchServer{server: s})
+}
+
+func init() {
+	RegisterServiceWithGatewayHandler(
+		"batch",
+		registerBatch,
+		rpcpb.RegisterBatchCommandHandlerFromEndpoint,
+	)
+}
+
+type batchServer struct {
+	server GRPCServer
+}
+
+// Batch runs read-only calls in order while the main chain is held still, so
+// that results of all calls agree with each other. Each result carries its
+// own code, only malformed batches and those taking over maxBatchDuration
+// fail as a whole
+func (s *batchServer) Batch(ctx context.Context, req *rpcpb.BatchRequest) (*rpcpb.BatchResponse, error) {
+	if len(req.Calls) > maxBatchCalls {
+		err := newRPCError(rpcpb.ErrorCode_INVALID_ARGUMENT,
+			fmt.Errorf("Too many calls in batch: %d, the max is %d", len(req.Calls), maxBatchCalls))
+		return &rpcpb.BatchResponse{Code: int32(errorCode(err)), Message: err.Error()}, err
+	}
+	listCalls := 0
+	for i, call := range req.Calls {
+		switch call.GetCall().(type) {
+		case nil:
+			err := newRPCError(rpcpb.ErrorCode_INVALID_ARGUMENT, fmt.Errorf("Unknown call at %d", i))
+			return &rpcpb.BatchResponse{Code: int32(errorCode(err)), Message: err.Error()}, err
+		case *rpcpb.BatchCall_ListUtxos, *rpcpb.BatchCall_ListTransactions:
+			listCalls++
+		}
+	}
+	if listCalls > maxBatchListCalls {
+		err := newRPCError(rpcpb.ErrorCode_INVALID_ARGUMENT,
+			fmt.Errorf("Too many list calls in batch: %d, the max is %d", listCalls, maxBatchListCalls))
+		return &rpcpb.BatchResponse{Code: int32(errorCode(err)), Message: err.Error()}, err
+	}
+	ctl := &ctlserver{server: s.server}
+	tx := &txServer{server: s.server}
+	wlt := &wltServer{server: s.server}
+
+	chainReader := s.server.GetChainReader()
+	res := &rpcpb.BatchResponse{Code: 0, Message: "ok"}
+	err := chainReader.ReadSnapshot(func() error {
+		ctx, cancel := context.WithTimeout(ctx, maxBatchDuration)
+		defer cancel()
+		res.Height = chainReader.GetBlockHeight()
+		hash, err := chainReader.GetBlockHash(res.Height)
+		if err != nil {
+			return err
+		}
+		res.TipHash = hash.String()
+		for i, call := range req.Calls {
+			if err := ctx.Err(); err == context.DeadlineExceeded {
+				return newRPCError(rpcpb.ErrorCode_TIMEOUT,
+					fmt.Errorf("Batch timed out after %d calls in %v", i, maxBatchDuration))
+			} else if err != nil {
+				return err
+			}
+			// errors of calls are reported in their responses
+			result := &rpcpb.BatchResult{}
+			switch c := call.Call.(type) {
+			case *rpcpb.BatchCall_GetBlockHeight:
+				r, _ := ctl.GetBlockHeight(ctx, c.GetBlockHeight)
+				result.Result = &rpcpb.BatchResult_GetBlockHeight{GetBlockHeight: r}
+			case *rpcpb.BatchCall_GetBlockHash:
+				r, _ := ctl.GetBlockHash(ctx, c.GetBlockHash)
+				result.Result = &rpcpb.BatchResult_GetBlockHash{GetBlockHash: r}
+			case *rpcpb.BatchCall_GetBalance:
+				r, _ := tx.GetBalance(ctx, c.GetBalance)
+				result.Result = &rpcpb.BatchResult_GetBalance{GetBalance: r}
+			case *rpcpb.BatchCall_GetTokenBalance:
+				r, _ := tx.GetTokenBalance(ctx, c.GetTokenBalance)
+				result.Result = &rpcpb.BatchResult_GetTokenBalance{GetTokenBalance: r}
+			case *rpcpb.BatchCall_ListUtxos:
+				r, _ := tx.ListUtxos(ctx, c.ListUtxos)
+				result.Result = &rpcpb.BatchResult_ListUtxos{ListUtxos: r}
+			case *rpcpb.BatchCall_ListTransactions:
+				r, _ := wlt.ListTransactions(ctx, c.ListTransactions)
+				result.Result = &rpcpb.BatchResult_ListTransactions{ListTransactions: r}
+			case *rpcpb.BatchCall_GetRawTransaction:
+				r, _ := tx.GetRawTransaction(ctx, c.GetRawTransaction)
+				result.Result = &rpcpb.BatchResult_GetRawTransaction{GetRawTransaction: r}
+			}
+			res.Results = append(res.Results, result)
+		}
+		return nil
+	})
+	if err != nil {
+		return &rpcpb.BatchResponse{Code: int32(errorCode(err)), Message: err.Error()}, err
+	}
+	return res, nil
+}
@@ -0,0 +1,126 @@
+// Copyright (c) 2018 ContentBox Authors.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package rpc
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/BOXFoundation/boxd/boxd/service"
+	"github.com/BOXFoundation/boxd/crypto"
+	"github.com/BOXFoundation/boxd/rpc/pb"
+	"github.com/facebookgo/ensure"
+)
+
+// batchTestChain is a chain whose tip moves on as blocks are connected
+type batchTestChain struct {
+	service.ChainReader
+	mtx    sync.RWMutex
+	height uint32
+}
+
+func (c *batchTestChain) GetBlockHeight() uint32 {
+	return c.height
+}
+
+func (c *batchTestChain) GetBlockHash(height uint32) (*crypto.HashType, error) {
+	hash := crypto.DoubleHashH([]byte{byte(height >> 24), byte(height >> 16), byte(height >> 8), byte(height)})
+	return &hash, nil
+}
+
+func (c *batchTestChain) ReadSnapshot(fn func() error) error {
+	c.mtx.RLock()
+	defer c.mtx.RUnlock()
+	return fn()
+}
+
+func (c *batchTestChain) connectBlock() {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	c.height++
+}
+
+type batchTestServer struct {
+	GRPCServer
+	chain *batchTestChain
+}
+
+func (s *batchTestServer) GetChainReader() service.ChainReader {
+	return s.chain
+}
+
+func TestBatchLimits(t *testing.T) {
+	s := &batchServer{server: &batchTestServer{chain: &batchTestChain{}}}
+	heightCall := &rpcpb.BatchCall{Call: &rpcpb.BatchCall_GetBlockHeight{GetBlockHeight: &rpcpb.GetBlockHeightRequest{}}}
+	listCall := &rpcpb.BatchCall{Call: &rpcpb.BatchCall_ListUtxos{ListUtxos: &rpcpb.ListUtxosRequest{}}}
+
+	calls := make([]*rpcpb.BatchCall, maxBatchCalls+1)
+	for i := range calls {
+		calls[i] = heightCall
+	}
+	r, err := s.Batch(context.Background(), &rpcpb.BatchRequest{Calls: calls})
+	ensure.NotNil(t, err)
+	ensure.DeepEqual(t, r.Code, int32(rpcpb.ErrorCode_INVALID_ARGUMENT))
+
+	calls = make([]*rpcpb.BatchCall, maxBatchListCalls+1)
+	for i := range calls {
+		calls[i] = listCall
+	}
+	r, err = s.Batch(context.Background(), &rpcpb.BatchRequest{Calls: calls})
+	ensure.NotNil(t, err)
+	ensure.DeepEqual(t, r.Code, int32(rpcpb.ErrorCode_INVALID_ARGUMENT))
+
+	// calls unknown to the server are rejected before any is run
+	r, err = s.Batch(context.Background(), &rpcpb.BatchRequest{Calls: []*rpcpb.BatchCall{heightCall, {}}})
+	ensure.NotNil(t, err)
+	ensure.DeepEqual(t, r.Code, int32(rpcpb.ErrorCode_INVALID_ARGUMENT))
+	ensure.DeepEqual(t, len(r.Results), 0)
+}
+
+func TestBatchSnapshot(t *testing.T) {
+	chain := &batchTestChain{}
+	s := &batchServer{server: &batchTestServer{chain: chain}}
+
+	// blocks are connected while batches run
+	done := make(chan struct{})
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for {
+			select {
+			case <-done:
+				return
+			default:
+				chain.connectBlock()
+			}
+		}
+	}()
+	defer func() {
+		close(done)
+		wg.Wait()
+	}()
+
+	calls := make([]*rpcpb.BatchCall, 0, maxBatchCalls)
+	for i := 0; i < maxBatchCalls/2; i++ {
+		calls = append(calls,
+			&rpcpb.BatchCall{Call: &rpcpb.BatchCall_GetBlockHeight{GetBlockHeight: &rpcpb.GetBlockHeightRequest{}}},
+			&rpcpb.BatchCall{Call: &rpcpb.BatchCall_GetBlockHash{GetBlockHash: &rpcpb.GetBlockHashRequest{Height: uint32(i)}}})
+	}
+	for n := 0; n < 10; n++ {
+		r, err := s.Batch(context.Background(), &rpcpb.BatchRequest{Calls: calls})
+		ensure.Nil(t, err)
+		ensure.DeepEqual(t, len(r.Results), len(calls))
+		tipHash, _ := chain.GetBlockHash(r.Height)
+		ensure.DeepEqual(t, r.TipHash, tipHash.String())
+		// all calls see the tip the batch reports
+		for _, result := range r.Results {
+			if height := result.GetGetBlockHeight(); height != nil {
+				ensure.DeepEqual(t, height.Height, r.Height)
+			}
+		}
+	}
+}
@@ -0,0 +1,201 @@
+// Copyright (c) 2018 ContentBox Authors.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package rpc
+
+import (
+	"context"
+	"errors"
+	"math"
+
+	"github.com/BOXFoundation/boxd/core/pb"
+	"github.com/BOXFoundation/boxd/core/types"
+	"github.com/BOXFoundation/boxd/rpc/pb"
+)
+
+func registerCandidate(s *Server) {
+	rpcpb.RegisterCandidateCommandServer(s.server, &candidateServer{server: s})
+}
+
+func init() {
+	RegisterServiceWithGatewayHandler(
+		"candidate",
+		registerCandidate,
+		rpcpb.RegisterCandidateCommandHandlerFromEndpoint,
+	)
+}
+
+var (
+	errCandidateRegistered = errors.New("Address is already a candidate")
+	errCandidateNotFound   = errors.New("Candidate not found")
+	errInvalidVotes        = errors.New("Votes must be positive")
+	errInsufficientVotes   = errors.New("Candidate has fewer votes than withdrawn")
+	errInvalidChainParams  = errors.New("Chain parameters must all be positive")
+)
+
+type candidateServer struct {
+	server GRPCServer
+}
+
+// RegisterCandidate sends a tx registering an account as a candidate
+func (s *candidateServer) RegisterCandidate(ctx context.Context, req *rpcpb.RegisterCandidateRequest) (*rpcpb.CandidateTxResponse, error) {
+	wltMgr := s.server.GetWalletManager()
+	if wltMgr == nil {
+		return &rpcpb.CandidateTxResponse{Code: int32(rpcpb.ErrorCode_WALLET_DISABLED), Message: errWalletDisabled.Error()}, errWalletDisabled
+	}
+	from, _, err := unlockedSender(wltMgr, req.Addr)
+	if err != nil {
+		return &rpcpb.CandidateTxResponse{Code: int32(errorCode(err)), Message: err.Error()}, err
+	}
+	candidate, err := s.findCandidate(*from.Hash160())
+	if err != nil {
+		return &rpcpb.CandidateTxResponse{Code: int32(errorCode(err)), Message: err.Error()}, err
+	}
+	if candidate != nil {
+		err := newRPCError(rpcpb.ErrorCode_INVALID_ARGUMENT, errCandidateRegistered)
+		return &rpcpb.CandidateTxResponse{Code: int32(errorCode(err)), Message: err.Error()}, err
+	}
+	content, err := types.NewSignUpContent(*from.Hash160()).Marshal()
+	if err != nil {
+		return &rpcpb.CandidateTxResponse{Code: int32(errorCode(err)), Message: err.Error()}, err
+	}
+	return s.sendCandidateTx(req.Addr, types.RegisterCandidateTx, content, req.FeePerByte)
+}
+
+// Vote sends a tx casting votes for a candidate
+func (s *candidateServer) Vote(ctx context.Context, req *rpcpb.VoteRequest) (*rpcpb.CandidateTxResponse, error) {
+	return s.vote(req, false)
+}
+
+// WithdrawVote sends a tx withdrawing votes from a candidate
+func (s *candidateServer) WithdrawVote(ctx context.Context, req *rpcpb.VoteRequest) (*rpcpb.CandidateTxResponse, error) {
+	return s.vote(req, true)
+}
+
+func (s *candidateServer) vote(req *rpcpb.VoteRequest, withdraw bool) (*rpcpb.CandidateTxResponse, error) {
+	if s.server.GetWalletManager() == nil {
+		return &rpcpb.CandidateTxResponse{Code: int32(rpcpb.ErrorCode_WALLET_DISABLED), Message: errWalletDisabled.Error()}, errWalletDisabled
+	}
+	if req.Votes == 0 || req.Votes > math.MaxInt64 {
+		err := newRPCError(rpcpb.ErrorCode_INVALID_ARGUMENT, errInvalidVotes)
+		return &rpcpb.CandidateTxResponse{Code: int32(errorCode(err)), Message: err.Error()}, err
+	}
+	addr, err := types.NewAddress(req.Candidate)
+	if err != nil {
+		return &rpcpb.CandidateTxResponse{Code: int32(errorCode(err)), Message: err.Error()}, err
+	}
+	candidate, err := s.findCandidate(*addr.Hash160())
+	if err != nil {
+		return &rpcpb.CandidateTxResponse{Code: int32(errorCode(err)), Message: err.Error()}, err
+	}
+	if candidate == nil {
+		err := newRPCError(rpcpb.ErrorCode_NOT_FOUND, errCandidateNotFound)
+		return &rpcpb.CandidateTxResponse{Code: int32(errorCode(err)), Message: err.Error()}, err
+	}
+	votes := int64(req.Votes)
+	if withdraw {
+		if candidate.Votes < votes {
+			err := newRPCError(rpcpb.ErrorCode_INVALID_ARGUMENT, errInsufficientVotes)
+			return &rpcpb.CandidateTxResponse{Code: int32(errorCode(err)), Message: err.Error()}, err
+		}
+		votes = -votes
+	}
+	content, err := types.NewVoteContent(*addr.Hash160(), votes).Marshal()
+	if err != nil {
+		return &rpcpb.CandidateTxResponse{Code: int32(errorCode(err)), Message: err.Error()}, err
+	}
+	return s.sendCandidateTx(req.Addr, types.VoteTx, content, req.FeePerByte)
+}
+
+// ProposeChainParams sends a governance tx proposing chain parameters
+func (s *candidateServer) ProposeChainParams(ctx context.Context, req *rpcpb.ProposeChainParamsRequest) (*rpcpb.CandidateTxResponse, error) {
+	if s.server.GetWalletManager() == nil {
+		return &rpcpb.CandidateTxResponse{Code: int32(rpcpb.ErrorCode_WALLET_DISABLED), Message: errWalletDisabled.Error()}, errWalletDisabled
+	}
+	if req.BlockInterval <= 0 || req.PeriodDuration == 0 || req.MaxBlockSize == 0 {
+		err := newRPCError(rpcpb.ErrorCode_INVALID_ARGUMENT, errInvalidChainParams)
+		return &rpcpb.CandidateTxResponse{Code: int32(errorCode(err)), Message: err.Error()}, err
+	}
+	content, err := types.NewGovernanceContent(&types.ChainParams{
+		BlockInterval:  req.BlockInterval,
+		PeriodDuration: req.PeriodDuration,
+		MaxBlockSize:   req.MaxBlockSize,
+	}).Marshal()
+	if err != nil {
+		return &rpcpb.CandidateTxResponse{Code: int32(errorCode(err)), Message: err.Error()}, err
+	}
+	return s.sendCandidateTx(req.Addr, types.GovernanceTx, content, req.FeePerByte)
+}
+
+// ListCandidates lists candidates with their votes as of the tail block
+func (s *candidateServer) ListCandidates(ctx context.Context, req *rpcpb.ListCandidatesRequest) (*rpcpb.ListCandidatesResponse, error) {
+	infos, height, err := s.server.GetConsensusReader().GetCandidates()
+	if err != nil {
+		return &rpcpb.ListCandidatesResponse{Code: int32(errorCode(err)), Message: err.Error()}, err
+	}
+	candidates := make([]*rpcpb.Candidate, 0, len(infos))
+	for _, info := range infos {
+		addr, err := addressString(info.Addr)
+		if err != nil {
+			return &rpcpb.ListCandidatesResponse{Code: int32(errorCode(err)), Message: err.Error()}, err
+		}
+		candidates = append(candidates, &rpcpb.Candidate{Addr: addr, Votes: info.Votes, Absent: info.Absent})
+	}
+	return &rpcpb.ListCandidatesResponse{
+		Code:       0,
+		Message:    "ok",
+		Height:     height,
+		Candidates: candidates,
+	}, nil
+}
+
+// findCandidate returns the candidate of addr as of the tail block, nil if
+// it's not registered
+func (s *candidateServer) findCandidate(addr types.AddressHash) (*types.CandidateInfo, error) {
+	infos, _, err := s.server.GetConsensusReader().GetCandidates()
+	if err != nil {
+		return nil, err
+	}
+	for _, info := range infos {
+		if info.Addr == addr {
+			return info, nil
+		}
+	}
+	return nil, nil
+}
+
+// sendCandidateTx sends a tx carrying candidate data, funded and signed by
+// account addr unlocked in node wallet
+func (s *candidateServer) sendCandidateTx(addr string, txType int, content []byte,
+	feePerByte uint64) (*rpcpb.CandidateTxResponse, error) {
+	from, account, err := unlockedSender(s.server.GetWalletManager(), addr)
+	if err != nil {
+		return &rpcpb.CandidateTxResponse{Code: int32(errorCode(err)), Message: err.Error()}, err
+	}
+	txs := &txServer{server: s.server}
+	utxos, err := txs.loadSpendableUtxos(from)
+	if err != nil {
+		return &rpcpb.CandidateTxResponse{Code: int32(errorCode(err)), Message: err.Error()}, err
+	}
+	tx := &types.Transaction{Data: &corepb.Data{Type: int32(txType), Content: content}}
+	fee, err := fundAndSendTx(s.server, tx, utxos, account, feePerByte)
+	if err != nil {
+		return &rpcpb.CandidateTxResponse{Code: int32(errorCode(err)), Message: err.Error()}, err
+	}
+	hash, err := tx.CalcTxHash()
+	if err != nil {
+		return &rpcpb.CandidateTxResponse{Code: int32(errorCode(err)), Message: err.Error()}, err
+	}
+	msg, err := tx.ToProtoMessage()
+	if err != nil {
+		return &rpcpb.CandidateTxResponse{Code: int32(errorCode(err)), Message: err.Error()}, err
+	}
+	return &rpcpb.CandidateTxResponse{
+		Code:    0,
+		Message: "ok",
+		Hash:    hash.String(),
+		Fee:     fee,
+		Tx:      msg.(*corepb.Transaction),
+	}, nil
+}
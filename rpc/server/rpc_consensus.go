@@ -0,0 +1,105 @@
+// Copyright (c) 2018 ContentBox Authors.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package rpc
+
+import (
+	"context"
+
+	"github.com/BOXFoundation/boxd/core/types"
+	"github.com/BOXFoundation/boxd/rpc/pb"
+)
+
+func registerConsensus(s *Server) {
+	rpcpb.RegisterConsensusCommandServer(s.server, &consensusServer{server: s})
+}
+
+func init() {
+	RegisterServiceWithGatewayHandler(
+		"consensus",
+		registerConsensus,
+		rpcpb.RegisterConsensusCommandHandlerFromEndpoint,
+	)
+}
+
+type consensusServer struct {
+	server GRPCServer
+}
+
+// GetMinerSchedule returns miners of the current epoch with their slots, and
+// when the next epoch starts
+func (s *consensusServer) GetMinerSchedule(ctx context.Context, req *rpcpb.GetMinerScheduleRequest) (*rpcpb.GetMinerScheduleResponse, error) {
+	schedule, err := s.server.GetConsensusReader().GetMinerSchedule()
+	if err != nil {
+		return &rpcpb.GetMinerScheduleResponse{Code: int32(errorCode(err)), Message: err.Error()}, err
+	}
+	resp := &rpcpb.GetMinerScheduleResponse{
+		Code:            0,
+		Message:         "ok",
+		Epoch:           schedule.Epoch,
+		SlotInterval:    schedule.SlotInterval,
+		NextEpochTime:   schedule.NextEpochTime,
+		NextEpochHeight: schedule.NextEpochHeight,
+	}
+	seen := make(map[types.AddressHash]bool)
+	for _, slot := range schedule.Slots {
+		miner, err := addressString(slot.Miner)
+		if err != nil {
+			return &rpcpb.GetMinerScheduleResponse{Code: int32(errorCode(err)), Message: err.Error()}, err
+		}
+		resp.Slots = append(resp.Slots, &rpcpb.MinerSlot{Time: slot.Time, Miner: miner, PeerId: slot.PeerID})
+		if !seen[slot.Miner] {
+			seen[slot.Miner] = true
+			resp.Miners = append(resp.Miners, miner)
+		}
+	}
+	for _, addrHash := range schedule.NextMiners {
+		miner, err := addressString(addrHash)
+		if err != nil {
+			return &rpcpb.GetMinerScheduleResponse{Code: int32(errorCode(err)), Message: err.Error()}, err
+		}
+		resp.NextMiners = append(resp.NextMiners, miner)
+	}
+	return resp, nil
+}
+
+// GetMinerStats returns blocks each miner produced and was expected to produce
+// in recent epochs
+func (s *consensusServer) GetMinerStats(ctx context.Context, req *rpcpb.GetMinerStatsRequest) (*rpcpb.GetMinerStatsResponse, error) {
+	stats, err := s.server.GetConsensusReader().GetMinerStats(req.Epochs)
+	if err != nil {
+		return &rpcpb.GetMinerStatsResponse{Code: int32(errorCode(err)), Message: err.Error()}, err
+	}
+	resp := &rpcpb.GetMinerStatsResponse{Code: 0, Message: "ok"}
+	for _, st := range stats {
+		miner, err := addressString(st.Miner)
+		if err != nil {
+			return &rpcpb.GetMinerStatsResponse{Code: int32(errorCode(err)), Message: err.Error()}, err
+		}
+		minerStats := &rpcpb.MinerStats{
+			Miner:    miner,
+			Produced: st.Produced,
+			Expected: st.Expected,
+			Absent:   st.Absent,
+		}
+		for _, es := range st.Epochs {
+			minerStats.Epochs = append(minerStats.Epochs, &rpcpb.EpochMinerStats{
+				Epoch:    es.Epoch,
+				Produced: es.Produced,
+				Expected: es.Expected,
+			})
+		}
+		resp.Miners = append(resp.Miners, minerStats)
+	}
+	return resp, nil
+}
+
+// addressString returns the p2pkh address of hash
+func addressString(hash types.AddressHash) (string, error) {
+	addr, err := types.NewAddressPubKeyHash(hash[:])
+	if err != nil {
+		return "", err
+	}
+	return addr.String(), nil
+}
@@ -6,15 +6,27 @@ package rpc
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"path/filepath"
+	"time"
 
 	"github.com/BOXFoundation/boxd/boxd/eventbus"
+	"github.com/BOXFoundation/boxd/core/chain"
 	"github.com/BOXFoundation/boxd/core/pb"
+	"github.com/BOXFoundation/boxd/core/types"
 	"github.com/BOXFoundation/boxd/crypto"
+	"github.com/BOXFoundation/boxd/log"
+	"github.com/BOXFoundation/boxd/p2p"
 	"github.com/BOXFoundation/boxd/p2p/pstore"
 	"github.com/BOXFoundation/boxd/rpc/pb"
 )
 
+var errInvalidProfileName = errors.New("Invalid profile name")
+
+// connectPeerTimeout is how long ConnectPeer waits for the peer to be dialed
+const connectPeerTimeout = 10 * time.Second
+
 func registerControl(s *Server) {
 	rpcpb.RegisterContorlCommandServer(s.server, &ctlserver{server: s})
 }
@@ -52,13 +64,21 @@ func (s *ctlserver) GetNodeInfo(ctx context.Context, req *rpcpb.GetNodeInfoReque
 func (s *ctlserver) SetDebugLevel(ctx context.Context, in *rpcpb.DebugLevelRequest) (*rpcpb.BaseResponse, error) {
 	bus := s.server.GetEventBus()
 	ch := make(chan bool)
-	bus.Send(eventbus.TopicSetDebugLevel, in.Level, ch)
+	bus.Send(eventbus.TopicSetDebugLevel, in.Level, in.Module, ch)
+	if in.Module != "" {
+		if <-ch {
+			var info = fmt.Sprintf("Set debug level of %s: %s", in.Module, log.ModuleLogLevels()[in.Module])
+			return &rpcpb.BaseResponse{Code: 0, Message: info}, nil
+		}
+		var info = fmt.Sprintf("Wrong debug level %s or module %s", in.Level, in.Module)
+		return &rpcpb.BaseResponse{Code: int32(rpcpb.ErrorCode_INVALID_ARGUMENT), Message: info}, nil
+	}
 	if <-ch {
-		var info = fmt.Sprintf("Set debug level: %s", logger.LogLevel())
+		var info = fmt.Sprintf("Set debug level: %s", in.Level)
 		return &rpcpb.BaseResponse{Code: 0, Message: info}, nil
 	}
 	var info = fmt.Sprintf("Wrong debug level: %s", in.Level)
-	return &rpcpb.BaseResponse{Code: 1, Message: info}, nil
+	return &rpcpb.BaseResponse{Code: int32(rpcpb.ErrorCode_INVALID_ARGUMENT), Message: info}, nil
 }
 
 // UpdateNetworkID implements UpdateNetworkID
@@ -71,7 +91,7 @@ func (s *ctlserver) UpdateNetworkID(ctx context.Context, in *rpcpb.UpdateNetwork
 		return &rpcpb.BaseResponse{Code: 0, Message: info}, nil
 	}
 	var info = fmt.Sprintf("Wrong NetworkID: %d", in.Id)
-	return &rpcpb.BaseResponse{Code: 1, Message: info}, nil
+	return &rpcpb.BaseResponse{Code: int32(rpcpb.ErrorCode_INVALID_ARGUMENT), Message: info}, nil
 }
 
 func (s *ctlserver) GetBlockHeight(ctx context.Context, req *rpcpb.GetBlockHeightRequest) (*rpcpb.GetBlockHeightResponse, error) {
@@ -87,7 +107,7 @@ func (s *ctlserver) GetBlockHash(ctx context.Context, req *rpcpb.GetBlockHashReq
 	hash, err := s.server.GetChainReader().GetBlockHash(req.Height)
 	if err != nil {
 		return &rpcpb.GetBlockHashResponse{
-			Code:    -1,
+			Code:    int32(errorCode(err)),
 			Message: err.Error(),
 		}, err
 	}
@@ -103,21 +123,21 @@ func (s *ctlserver) GetBlockHeader(ctx context.Context, req *rpcpb.GetBlockReque
 	err := hash.SetString(req.BlockHash)
 	if err != nil {
 		return &rpcpb.GetBlockHeaderResponse{
-			Code:    -1,
+			Code:    int32(rpcpb.ErrorCode_INVALID_ARGUMENT),
 			Message: fmt.Sprintf("Invalid hash: %s", req.BlockHash),
-		}, err
+		}, newRPCError(rpcpb.ErrorCode_INVALID_ARGUMENT, err)
 	}
 	block, err := s.server.GetChainReader().LoadBlockByHash(*hash)
 	if err != nil {
 		return &rpcpb.GetBlockHeaderResponse{
-			Code:    -1,
+			Code:    int32(errorCode(err)),
 			Message: err.Error(),
 		}, err
 	}
 	msg, err := block.Header.ToProtoMessage()
 	if err != nil {
 		return &rpcpb.GetBlockHeaderResponse{
-			Code:    -1,
+			Code:    int32(errorCode(err)),
 			Message: err.Error(),
 		}, err
 	}
@@ -129,7 +149,7 @@ func (s *ctlserver) GetBlockHeader(ctx context.Context, req *rpcpb.GetBlockReque
 		}, nil
 	}
 	return &rpcpb.GetBlockHeaderResponse{
-		Code:    -1,
+		Code:    int32(rpcpb.ErrorCode_UNKNOWN),
 		Message: "Internal Error",
 	}, fmt.Errorf("Error converting proto message")
 }
@@ -139,21 +159,21 @@ func (s *ctlserver) GetBlock(ctx context.Context, req *rpcpb.GetBlockRequest) (*
 	err := hash.SetString(req.BlockHash)
 	if err != nil {
 		return &rpcpb.GetBlockResponse{
-			Code:    -1,
+			Code:    int32(rpcpb.ErrorCode_INVALID_ARGUMENT),
 			Message: fmt.Sprintf("Invalid hash: %s", req.BlockHash),
-		}, err
+		}, newRPCError(rpcpb.ErrorCode_INVALID_ARGUMENT, err)
 	}
 	block, err := s.server.GetChainReader().LoadBlockByHash(*hash)
 	if err != nil {
 		return &rpcpb.GetBlockResponse{
-			Code:    -1,
+			Code:    int32(rpcpb.ErrorCode_NOT_FOUND),
 			Message: fmt.Sprintf("Error searching block: %s", req.BlockHash),
-		}, err
+		}, newRPCError(rpcpb.ErrorCode_NOT_FOUND, err)
 	}
 	msg, err := block.ToProtoMessage()
 	if err != nil {
 		return &rpcpb.GetBlockResponse{
-			Code:    -1,
+			Code:    int32(errorCode(err)),
 			Message: err.Error(),
 		}, err
 	}
@@ -165,7 +185,357 @@ func (s *ctlserver) GetBlock(ctx context.Context, req *rpcpb.GetBlockRequest) (*
 		}, nil
 	}
 	return &rpcpb.GetBlockResponse{
-		Code:    -1,
+		Code:    int32(rpcpb.ErrorCode_UNKNOWN),
 		Message: "Internal Error",
 	}, fmt.Errorf("Error converting proto message")
 }
+
+// GetBlockVerbose returns a block by hash or height, as raw bytes, with its
+// tx hashes, or with its txs decoded by verbosity
+func (s *ctlserver) GetBlockVerbose(ctx context.Context, req *rpcpb.GetBlockVerboseRequest) (*rpcpb.GetBlockVerboseResponse, error) {
+	if req.Verbosity > 2 {
+		err := newRPCError(rpcpb.ErrorCode_INVALID_ARGUMENT, fmt.Errorf("Invalid verbosity: %d", req.Verbosity))
+		return &rpcpb.GetBlockVerboseResponse{Code: int32(errorCode(err)), Message: err.Error()}, err
+	}
+	block, err := s.loadBlock(req.Hash, req.Height)
+	if err != nil {
+		return &rpcpb.GetBlockVerboseResponse{Code: int32(errorCode(err)), Message: err.Error()}, err
+	}
+	raw, err := block.Marshal()
+	if err != nil {
+		return &rpcpb.GetBlockVerboseResponse{Code: int32(errorCode(err)), Message: err.Error()}, err
+	}
+	if req.Verbosity == 0 {
+		return &rpcpb.GetBlockVerboseResponse{Code: 0, Message: "ok", Raw: raw}, nil
+	}
+	info, err := s.blockInfo(block)
+	if err != nil {
+		return &rpcpb.GetBlockVerboseResponse{Code: int32(errorCode(err)), Message: err.Error()}, err
+	}
+	info.BlockSize = uint32(len(raw))
+	tx := &txServer{server: s.server}
+	for _, t := range block.Txs {
+		if req.Verbosity == 1 {
+			hash, err := t.TxHash()
+			if err != nil {
+				return &rpcpb.GetBlockVerboseResponse{Code: int32(errorCode(err)), Message: err.Error()}, err
+			}
+			info.TxHashes = append(info.TxHashes, hash.String())
+			continue
+		}
+		detail, err := tx.decodeTransaction(t)
+		if err != nil {
+			return &rpcpb.GetBlockVerboseResponse{Code: int32(errorCode(err)), Message: err.Error()}, err
+		}
+		info.Txs = append(info.Txs, detail)
+	}
+	return &rpcpb.GetBlockVerboseResponse{Code: 0, Message: "ok", Block: info}, nil
+}
+
+// GetBlockHeaderVerbose returns a block header by hash or height, as raw
+// bytes if verbosity is 0, or decoded with the block's position otherwise
+func (s *ctlserver) GetBlockHeaderVerbose(ctx context.Context, req *rpcpb.GetBlockVerboseRequest) (*rpcpb.GetBlockVerboseResponse, error) {
+	block, err := s.loadBlock(req.Hash, req.Height)
+	if err != nil {
+		return &rpcpb.GetBlockVerboseResponse{Code: int32(errorCode(err)), Message: err.Error()}, err
+	}
+	if req.Verbosity == 0 {
+		raw, err := block.Header.Marshal()
+		if err != nil {
+			return &rpcpb.GetBlockVerboseResponse{Code: int32(errorCode(err)), Message: err.Error()}, err
+		}
+		return &rpcpb.GetBlockVerboseResponse{Code: 0, Message: "ok", Raw: raw}, nil
+	}
+	info, err := s.blockInfo(block)
+	if err != nil {
+		return &rpcpb.GetBlockVerboseResponse{Code: int32(errorCode(err)), Message: err.Error()}, err
+	}
+	return &rpcpb.GetBlockVerboseResponse{Code: 0, Message: "ok", Block: info}, nil
+}
+
+// loadBlock loads the block of hash, or the main chain block at height if
+// hash is empty
+func (s *ctlserver) loadBlock(hashStr string, height uint32) (*types.Block, error) {
+	chainReader := s.server.GetChainReader()
+	hash := &crypto.HashType{}
+	if hashStr != "" {
+		if err := hash.SetString(hashStr); err != nil {
+			return nil, newRPCError(rpcpb.ErrorCode_INVALID_ARGUMENT, fmt.Errorf("Invalid hash: %s", hashStr))
+		}
+	} else {
+		h, err := chainReader.GetBlockHash(height)
+		if err != nil {
+			return nil, newRPCError(rpcpb.ErrorCode_NOT_FOUND, fmt.Errorf("No block at height %d", height))
+		}
+		hash = h
+	}
+	block, err := chainReader.LoadBlockByHash(*hash)
+	if err != nil {
+		return nil, newRPCError(rpcpb.ErrorCode_NOT_FOUND, err)
+	}
+	return block, nil
+}
+
+// blockInfo describes the header of block and its position in the chain
+func (s *ctlserver) blockInfo(block *types.Block) (*rpcpb.BlockInfo, error) {
+	msg, err := block.Header.ToProtoMessage()
+	if err != nil {
+		return nil, err
+	}
+	hash := block.BlockHash()
+	info := &rpcpb.BlockInfo{
+		Hash:    hash.String(),
+		Height:  block.Height,
+		Header:  msg.(*corepb.BlockHeader),
+		TxCount: uint32(len(block.Txs)),
+	}
+	chainReader := s.server.GetChainReader()
+	tip := chainReader.GetBlockHeight()
+	if mainHash, err := chainReader.GetBlockHash(block.Height); err == nil && *mainHash == *hash {
+		info.Confirmations = tip - block.Height + 1
+		if block.Height < tip {
+			if next, err := chainReader.GetBlockHash(block.Height + 1); err == nil {
+				info.NextHash = next.String()
+			}
+		}
+	}
+	return info, nil
+}
+
+// SubscribeEternalBlocks streams the eternal block, then each one it advances
+// to. Only the latest advance is kept for a slow client, as each implies the
+// finality of all blocks below it
+func (s *ctlserver) SubscribeEternalBlocks(req *rpcpb.SubscribeEternalBlocksRequest, stream rpcpb.ContorlCommand_SubscribeEternalBlocksServer) error {
+	// subscribe before reading the eternal block, so that no advance is missed.
+	// The handler is kept to unsubscribe with the same func value
+	latest := make(chan *types.Block, 1)
+	onEternalBlock := func(block *types.Block) {
+		select {
+		case <-latest:
+		default:
+		}
+		latest <- block
+	}
+	bus := s.server.GetEventBus()
+	chain.SubscribeEternalBlock(bus, onEternalBlock)
+	defer chain.UnsubscribeEternalBlock(bus, onEternalBlock)
+
+	block := s.server.GetChainReader().EternalBlock()
+	for {
+		if err := stream.Send(&rpcpb.EternalBlock{
+			Code:      0,
+			Message:   "ok",
+			Hash:      block.BlockHash().String(),
+			Height:    block.Height,
+			Timestamp: block.Header.TimeStamp,
+		}); err != nil {
+			return err
+		}
+		for sent := block.Height; block.Height <= sent; {
+			select {
+			case block = <-latest:
+			case <-stream.Context().Done():
+				return stream.Context().Err()
+			}
+		}
+	}
+}
+
+// GetNetworkInfo returns the state of the node in p2p network
+func (s *ctlserver) GetNetworkInfo(ctx context.Context, req *rpcpb.GetNetworkInfoRequest) (*rpcpb.GetNetworkInfoResponse, error) {
+	ch := make(chan p2p.NetworkInfo)
+	s.server.GetEventBus().Send(eventbus.TopicGetNetworkInfo, ch)
+	info := <-ch
+	return &rpcpb.GetNetworkInfoResponse{
+		Code:        0,
+		Message:     "ok",
+		PeerId:      info.ID.Pretty(),
+		NetworkId:   info.NetworkID,
+		ListenAddrs: info.ListenAddrs,
+		PeerCount:   uint32(info.PeerCount),
+		Synced:      info.Synced,
+	}, nil
+}
+
+// GetPeerInfo returns states of connected peers, or of the requested one
+func (s *ctlserver) GetPeerInfo(ctx context.Context, req *rpcpb.GetPeerInfoRequest) (*rpcpb.GetPeerInfoResponse, error) {
+	ch := make(chan []p2p.ConnInfo)
+	s.server.GetEventBus().Send(eventbus.TopicGetPeerInfo, ch)
+	resp := &rpcpb.GetPeerInfoResponse{Code: 0, Message: "ok"}
+	for _, info := range <-ch {
+		if req.PeerId != "" && info.ID.Pretty() != req.PeerId {
+			continue
+		}
+		peerInfo := &rpcpb.PeerInfo{
+			Id:        info.ID.Pretty(),
+			Addr:      info.Addr,
+			Inbound:   info.Inbound,
+			Synced:    info.Synced,
+			Score:     info.Score,
+			Latency:   int64(info.Latency / time.Millisecond),
+			BytesSent: info.BytesSent,
+			BytesRecv: info.BytesRecv,
+		}
+		if !info.LastMsgTime.IsZero() {
+			peerInfo.LastMsgTime = info.LastMsgTime.Unix()
+		}
+		for _, t := range info.Traffic {
+			peerInfo.Traffic = append(peerInfo.Traffic, &rpcpb.MessageTraffic{
+				Code:      t.Code,
+				MsgsSent:  t.MsgsSent,
+				BytesSent: t.BytesSent,
+				MsgsRecv:  t.MsgsRecv,
+				BytesRecv: t.BytesRecv,
+			})
+		}
+		resp.Peers = append(resp.Peers, peerInfo)
+	}
+	if req.PeerId != "" && len(resp.Peers) == 0 {
+		err := p2p.ErrPeerNotConnected
+		return &rpcpb.GetPeerInfoResponse{Code: int32(errorCode(err)), Message: err.Error()}, err
+	}
+	return resp, nil
+}
+
+// sendPeerCmd sends a peer command to p2p and waits for its result
+func (s *ctlserver) sendPeerCmd(topic string, args ...interface{}) error {
+	ch := make(chan error)
+	s.server.GetEventBus().Send(topic, append(args, ch)...)
+	return <-ch
+}
+
+// ConnectPeer connects to the peer at a multiaddr ending with its peer id
+func (s *ctlserver) ConnectPeer(ctx context.Context, req *rpcpb.ConnectPeerRequest) (*rpcpb.BaseResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, connectPeerTimeout)
+	defer cancel()
+	if err := s.sendPeerCmd(eventbus.TopicConnectPeer, ctx, req.Addr); err != nil {
+		return &rpcpb.BaseResponse{Code: int32(errorCode(err)), Message: err.Error()}, err
+	}
+	return &rpcpb.BaseResponse{Code: 0, Message: "ok"}, nil
+}
+
+// DisconnectPeer closes connections with a peer
+func (s *ctlserver) DisconnectPeer(ctx context.Context, req *rpcpb.DisconnectPeerRequest) (*rpcpb.BaseResponse, error) {
+	if err := s.sendPeerCmd(eventbus.TopicDisconnectPeer, req.PeerId); err != nil {
+		return &rpcpb.BaseResponse{Code: int32(errorCode(err)), Message: err.Error()}, err
+	}
+	return &rpcpb.BaseResponse{Code: 0, Message: "ok"}, nil
+}
+
+// BanPeer bans a peer id, ip or subnet for some seconds, and drops connections with
+// peers it covers
+func (s *ctlserver) BanPeer(ctx context.Context, req *rpcpb.BanPeerRequest) (*rpcpb.BaseResponse, error) {
+	d := time.Duration(req.Duration) * time.Second
+	if err := s.sendPeerCmd(eventbus.TopicBanPeer, req.Target, d); err != nil {
+		return &rpcpb.BaseResponse{Code: int32(errorCode(err)), Message: err.Error()}, err
+	}
+	return &rpcpb.BaseResponse{Code: 0, Message: "ok"}, nil
+}
+
+// UnbanPeer lifts the ban of a peer id, ip or subnet
+func (s *ctlserver) UnbanPeer(ctx context.Context, req *rpcpb.UnbanPeerRequest) (*rpcpb.BaseResponse, error) {
+	if err := s.sendPeerCmd(eventbus.TopicUnbanPeer, req.Target); err != nil {
+		return &rpcpb.BaseResponse{Code: int32(errorCode(err)), Message: err.Error()}, err
+	}
+	return &rpcpb.BaseResponse{Code: 0, Message: "ok"}, nil
+}
+
+// ConvertAddress returns an address in both base58 and bech32 formats
+func (s *ctlserver) ConvertAddress(ctx context.Context, req *rpcpb.ConvertAddressRequest) (*rpcpb.ConvertAddressResponse, error) {
+	addr, err := types.NewAddress(req.Addr)
+	if err != nil {
+		return &rpcpb.ConvertAddressResponse{Code: int32(errorCode(err)), Message: err.Error()}, err
+	}
+	return &rpcpb.ConvertAddressResponse{
+		Code:    0,
+		Message: "ok",
+		Base58:  addr.String(),
+		Bech32:  addr.Bech32(),
+	}, nil
+}
+
+// CaptureProfile captures a runtime profile of the node to a file in its
+// workspace, which go tool pprof or go tool trace reads
+func (s *ctlserver) CaptureProfile(ctx context.Context, req *rpcpb.CaptureProfileRequest) (*rpcpb.CaptureProfileResponse, error) {
+	name := req.Name
+	if name == "" {
+		name = fmt.Sprintf("%s-%s.prof", req.Profile, time.Now().Format("20060102150405"))
+	}
+	if name != filepath.Base(name) || name == "." || name == ".." {
+		err := errInvalidProfileName
+		return &rpcpb.CaptureProfileResponse{Code: int32(errorCode(err)), Message: err.Error()}, err
+	}
+
+	// buffered so that the capture is not blocked replying after timeout
+	out := make(chan interface{}, 1)
+	s.server.GetEventBus().Send(eventbus.TopicCaptureProfile, req.Profile, time.Duration(req.Seconds)*time.Second, name, out)
+
+	select {
+	case <-ctx.Done():
+		err := newRPCError(rpcpb.ErrorCode_TIMEOUT, ctx.Err())
+		return &rpcpb.CaptureProfileResponse{Code: int32(errorCode(err)), Message: err.Error()}, err
+	case result := <-out:
+		if err, ok := result.(error); ok {
+			return &rpcpb.CaptureProfileResponse{Code: int32(errorCode(err)), Message: err.Error()}, err
+		}
+		return &rpcpb.CaptureProfileResponse{Code: 0, Message: "ok", Path: result.(string)}, nil
+	}
+}
+
+// ListBans lists banned peer ids, ips and subnets
+func (s *ctlserver) ListBans(ctx context.Context, req *rpcpb.ListBansRequest) (*rpcpb.ListBansResponse, error) {
+	ch := make(chan []p2p.Ban)
+	s.server.GetEventBus().Send(eventbus.TopicListBans, ch)
+	resp := &rpcpb.ListBansResponse{Code: 0, Message: "ok"}
+	for _, ban := range <-ch {
+		resp.Bans = append(resp.Bans, &rpcpb.Ban{Target: ban.Target, Until: ban.Until.Unix()})
+	}
+	return resp, nil
+}
+
+// GetNodeStatus returns chain, sync, peer, mempool, wallet, service and health
+// status of the node at once
+func (s *ctlserver) GetNodeStatus(ctx context.Context, req *rpcpb.GetNodeStatusRequest) (*rpcpb.GetNodeStatusResponse, error) {
+	resp := &rpcpb.GetNodeStatusResponse{Code: 0, Message: "ok"}
+	chainReader := s.server.GetChainReader()
+	if err := chainReader.ReadSnapshot(func() error {
+		resp.Height = chainReader.GetBlockHeight()
+		hash, err := chainReader.GetBlockHash(resp.Height)
+		if err != nil {
+			return err
+		}
+		tip, err := chainReader.LoadBlockByHash(*hash)
+		if err != nil {
+			return err
+		}
+		resp.TipHash, resp.TipTime = hash.String(), tip.Header.TimeStamp
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	if eternal := chainReader.EternalBlock(); eternal != nil {
+		resp.EternalHeight, resp.EternalHash = eternal.Height, eternal.BlockHash().String()
+	}
+
+	info := s.server.GetTxHandler().GetMempoolInfo()
+	resp.MempoolSize, resp.MempoolBytes, resp.MempoolOrphans = uint32(info.Size), uint32(info.Bytes), uint32(info.Orphans)
+
+	peerCount := connectedPeerCount(s.server)
+	resp.Synced, resp.PeerCount = p2p.IsSynced(), uint32(peerCount)
+	resp.WalletEnabled, resp.WalletUnlocked = s.server.GetWalletManager() != nil, walletUnlocked(s.server)
+	services := serviceStatuses(s.server)
+	resp.Subsystems = subsystemHealth(resp.Synced, peerCount, resp.WalletUnlocked, services)
+	resp.Healthy = resp.Subsystems[""]
+	delete(resp.Subsystems, "")
+	for _, status := range services {
+		resp.Services = append(resp.Services, &rpcpb.ServiceStatus{
+			Name:     status.Name,
+			State:    string(status.State),
+			Critical: status.Critical,
+			Restarts: uint32(status.Restarts),
+			Error:    status.Err,
+			Since:    status.Since.Unix(),
+		})
+	}
+	return resp, nil
+}
@@ -0,0 +1,76 @@
+// Copyright (c) 2018 ContentBox Authors.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package rpc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/BOXFoundation/boxd/boxd/eventbus"
+	"github.com/BOXFoundation/boxd/p2p"
+	"github.com/BOXFoundation/boxd/rpc/pb"
+	"github.com/facebookgo/ensure"
+	peer "github.com/libp2p/go-libp2p-peer"
+)
+
+// busTestServer serves rpcs answered over bus
+type busTestServer struct {
+	GRPCServer
+	bus eventbus.Bus
+}
+
+func (s *busTestServer) GetEventBus() eventbus.Bus { return s.bus }
+
+func TestGetPeerInfo(t *testing.T) {
+	bus := eventbus.New()
+	s := &ctlserver{server: &busTestServer{bus: bus}}
+	self, _ := peer.IDB58Decode("QmVjYrCGiysX3FjeqyVWTCtgTrnYWdBkkPwFWrDMGRMhSy")
+	inbound, _ := peer.IDB58Decode("QmPbXnwgNDMYTPhrzyGzcQBkWGBkAhxmXB3w4EQgFVTTxr")
+	outbound, _ := peer.IDB58Decode("QmYyQSo1c1Ym7orWxLYvCrM2EmxFTANf8wXmmE7DWjhx5N")
+	lastMsgTime := time.Unix(1000, 0)
+	bus.Reply(eventbus.TopicGetNetworkInfo, func(out chan<- p2p.NetworkInfo) {
+		out <- p2p.NetworkInfo{ID: self, NetworkID: p2p.Mainnet, ListenAddrs: []string{"/ip4/127.0.0.1/tcp/19199"}, PeerCount: 2, Synced: true}
+	}, false)
+	bus.Reply(eventbus.TopicGetPeerInfo, func(out chan<- []p2p.ConnInfo) {
+		out <- []p2p.ConnInfo{
+			{ID: inbound, Inbound: true, Score: 80, BytesRecv: 100},
+			{
+				ID: outbound, Addr: "/ip4/10.0.0.1/tcp/19199", Synced: true, Score: 100,
+				Latency: 1500 * time.Microsecond, BytesSent: 200, BytesRecv: 300, LastMsgTime: lastMsgTime,
+				Traffic: []p2p.MsgTraffic{{Code: p2p.Ping, MsgsSent: 1, BytesSent: 200}},
+			},
+		}
+	}, false)
+
+	netInfo, err := s.GetNetworkInfo(context.Background(), &rpcpb.GetNetworkInfoRequest{})
+	ensure.Nil(t, err)
+	ensure.DeepEqual(t, netInfo.PeerId, self.Pretty())
+	ensure.DeepEqual(t, netInfo.NetworkId, p2p.Mainnet)
+	ensure.DeepEqual(t, netInfo.ListenAddrs, []string{"/ip4/127.0.0.1/tcp/19199"})
+	ensure.DeepEqual(t, netInfo.PeerCount, uint32(2))
+	ensure.True(t, netInfo.Synced)
+
+	// stats are converted to milliseconds and unix seconds, unset times
+	// left 0
+	resp, err := s.GetPeerInfo(context.Background(), &rpcpb.GetPeerInfoRequest{})
+	ensure.Nil(t, err)
+	ensure.DeepEqual(t, len(resp.Peers), 2)
+	ensure.DeepEqual(t, resp.Peers[0], &rpcpb.PeerInfo{Id: inbound.Pretty(), Inbound: true, Score: 80, BytesRecv: 100})
+	ensure.DeepEqual(t, resp.Peers[1], &rpcpb.PeerInfo{
+		Id: outbound.Pretty(), Addr: "/ip4/10.0.0.1/tcp/19199", Synced: true, Score: 100,
+		Latency: 1, BytesSent: 200, BytesRecv: 300, LastMsgTime: 1000,
+		Traffic: []*rpcpb.MessageTraffic{{Code: p2p.Ping, MsgsSent: 1, BytesSent: 200}},
+	})
+
+	// a peer is looked up by id, which must be connected
+	resp, err = s.GetPeerInfo(context.Background(), &rpcpb.GetPeerInfoRequest{PeerId: outbound.Pretty()})
+	ensure.Nil(t, err)
+	ensure.DeepEqual(t, len(resp.Peers), 1)
+	ensure.DeepEqual(t, resp.Peers[0].Id, outbound.Pretty())
+	resp, err = s.GetPeerInfo(context.Background(), &rpcpb.GetPeerInfoRequest{PeerId: self.Pretty()})
+	ensure.DeepEqual(t, err, p2p.ErrPeerNotConnected)
+	ensure.DeepEqual(t, resp.Code, int32(rpcpb.ErrorCode_NOT_FOUND))
+}
@@ -6,6 +6,9 @@ package rpc
 
 import (
 	"context"
+	"errors"
+	"path/filepath"
+	"time"
 
 	"github.com/BOXFoundation/boxd/boxd/eventbus"
 	"github.com/BOXFoundation/boxd/rpc/pb"
@@ -23,6 +26,8 @@ func init() {
 	)
 }
 
+var errInvalidBackupName = errors.New("Invalid backup name")
+
 type dbserver struct {
 	server GRPCServer
 }
@@ -36,14 +41,15 @@ func (svr *dbserver) GetDatabaseKeys(ctx context.Context, in *rpcpb.GetDatabaseK
 		in.Limit = 20
 	}
 
-	out := make(chan []string)
-	defer close(out)
+	// buffered so that the reply is not blocked after timeout
+	out := make(chan []string, 1)
 
 	svr.server.GetEventBus().Send(eventbus.TopicGetDatabaseKeys, ctx, in.Table, in.Prefix, in.Skip, in.Limit, out)
 
 	select {
 	case <-ctx.Done():
-		return &rpcpb.GetDatabaseKeysResponse{Code: 1, Message: "timeout"}, nil
+		err := newRPCError(rpcpb.ErrorCode_TIMEOUT, ctx.Err())
+		return &rpcpb.GetDatabaseKeysResponse{Code: int32(errorCode(err)), Message: err.Error()}, err
 	case result := <-out:
 		return &rpcpb.GetDatabaseKeysResponse{Code: 0, Message: "ok", Skip: in.Skip, Keys: result}, nil
 	}
@@ -51,15 +57,43 @@ func (svr *dbserver) GetDatabaseKeys(ctx context.Context, in *rpcpb.GetDatabaseK
 
 // get value of associate with passed key in database
 func (svr *dbserver) GetDatabaseValue(ctx context.Context, in *rpcpb.GetDatabaseValueRequest) (*rpcpb.GetDatabaseValueResponse, error) {
-	out := make(chan []byte)
-	defer close(out)
+	// buffered so that the reply is not blocked after timeout
+	out := make(chan []byte, 1)
 
 	svr.server.GetEventBus().Send(eventbus.TopicGetDatabaseValue, in.Table, in.Key, out)
 
 	select {
 	case <-ctx.Done():
-		return &rpcpb.GetDatabaseValueResponse{Code: 1, Message: "Timeout"}, nil
+		err := newRPCError(rpcpb.ErrorCode_TIMEOUT, ctx.Err())
+		return &rpcpb.GetDatabaseValueResponse{Code: int32(errorCode(err)), Message: err.Error()}, err
 	case v := <-out:
 		return &rpcpb.GetDatabaseValueResponse{Code: 0, Message: "ok", Value: v}, nil
 	}
 }
+
+// back up database without stopping the node
+func (svr *dbserver) BackupDatabase(ctx context.Context, in *rpcpb.BackupDatabaseRequest) (*rpcpb.BackupDatabaseResponse, error) {
+	name := in.Name
+	if name == "" {
+		name = time.Now().Format("20060102150405")
+	}
+	if name != filepath.Base(name) || name == "." || name == ".." {
+		err := errInvalidBackupName
+		return &rpcpb.BackupDatabaseResponse{Code: int32(errorCode(err)), Message: err.Error()}, err
+	}
+
+	// buffered so that the backup is not blocked replying after timeout
+	out := make(chan interface{}, 1)
+	svr.server.GetEventBus().Send(eventbus.TopicBackupDatabase, name, out)
+
+	select {
+	case <-ctx.Done():
+		err := newRPCError(rpcpb.ErrorCode_TIMEOUT, ctx.Err())
+		return &rpcpb.BackupDatabaseResponse{Code: int32(errorCode(err)), Message: err.Error()}, err
+	case result := <-out:
+		if err, ok := result.(error); ok {
+			return &rpcpb.BackupDatabaseResponse{Code: int32(errorCode(err)), Message: err.Error()}, err
+		}
+		return &rpcpb.BackupDatabaseResponse{Code: 0, Message: "ok", Dir: result.(string)}, nil
+	}
+}
@@ -7,11 +7,16 @@ package rpc
 import (
 	"context"
 	"fmt"
+	"sort"
+	"sync"
+	"time"
 
+	"github.com/BOXFoundation/boxd/core"
 	"github.com/BOXFoundation/boxd/core/chain"
 	"github.com/BOXFoundation/boxd/core/pb"
 	"github.com/BOXFoundation/boxd/script"
 	"github.com/BOXFoundation/boxd/util"
+	"github.com/BOXFoundation/boxd/wallet"
 
 	"github.com/BOXFoundation/boxd/core/types"
 	"github.com/BOXFoundation/boxd/crypto"
@@ -30,6 +35,20 @@ func init() {
 	)
 }
 
+const (
+	// defaultFeePerByte is the fee price in box per byte of tx scripts
+	defaultFeePerByte = 1
+	// p2pkhScriptSigLen is the max length of a p2pkh scriptSig, i.e., a
+	// pushed DER signature followed by a pushed compressed public key
+	p2pkhScriptSigLen = 1 + 72 + 1 + 33
+	// feeSampleBlocks is the number of recent blocks fee rates are sampled from
+	feeSampleBlocks = 20
+	// defaultListTokensLimit is the page size of ListTokens if not specified
+	defaultListTokensLimit = 100
+	// defaultTokenTxsLimit is the page size of GetTokenTransactions if not specified
+	defaultTokenTxsLimit = 100
+)
+
 type txServer struct {
 	server GRPCServer
 }
@@ -48,18 +67,317 @@ func (s *txServer) GetTransactionPool(ctx context.Context, req *rpcpb.GetTransac
 	return &rpcpb.GetTransactionsResponse{Txs: respTxs}, nil
 }
 
+// GetMempoolInfo summarizes txs in mempool
+func (s *txServer) GetMempoolInfo(ctx context.Context, req *rpcpb.GetMempoolInfoRequest) (*rpcpb.GetMempoolInfoResponse, error) {
+	info := s.server.GetTxHandler().GetMempoolInfo()
+	return &rpcpb.GetMempoolInfoResponse{
+		Code:        0,
+		Message:     "ok",
+		TxCount:     uint32(info.Size),
+		Bytes:       uint64(info.Bytes),
+		Orphans:     uint32(info.Orphans),
+		MinFeePerKb: info.MinFeePerKB,
+	}, nil
+}
+
+// defaultFeeBuckets are lower bounds of fee rate buckets in mempool fee
+// histograms if not requested
+var defaultFeeBuckets = []uint64{0, 1000, 2000, 5000, 10000, 20000, 50000, 100000, 200000, 500000, 1000000}
+
+// GetMempoolFeeHistogram sums bytes of txs in mempool by fee rate bucket,
+// so that clients can tell how much is queued ahead of a fee rate
+func (s *txServer) GetMempoolFeeHistogram(ctx context.Context, req *rpcpb.GetMempoolFeeHistogramRequest) (*rpcpb.GetMempoolFeeHistogramResponse, error) {
+	bounds := req.Bounds
+	if len(bounds) == 0 {
+		bounds = defaultFeeBuckets
+	}
+	buckets := feeHistogram(s.server.GetTxHandler().GetMempoolEntries(), bounds)
+	return &rpcpb.GetMempoolFeeHistogramResponse{Code: 0, Message: "ok", Buckets: buckets}, nil
+}
+
+// feeHistogram sorts entries into buckets with the given lower bounds,
+// ordered by fee rate from the highest. Txs paying less than the lowest
+// bound fall into the lowest bucket
+func feeHistogram(entries []*types.MempoolEntry, bounds []uint64) []*rpcpb.FeeBucket {
+	sorted := make([]uint64, len(bounds))
+	copy(sorted, bounds)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] > sorted[j] })
+	var buckets []*rpcpb.FeeBucket
+	for i, bound := range sorted {
+		if i > 0 && bound == sorted[i-1] {
+			continue
+		}
+		bucket := &rpcpb.FeeBucket{MinFeePerKb: bound}
+		if len(buckets) > 0 {
+			bucket.MaxFeePerKb = buckets[len(buckets)-1].MinFeePerKb
+		}
+		buckets = append(buckets, bucket)
+	}
+	for _, entry := range entries {
+		idx := sort.Search(len(buckets), func(i int) bool { return entry.FeePerKB >= buckets[i].MinFeePerKb })
+		if idx == len(buckets) {
+			idx--
+		}
+		buckets[idx].TxCount++
+		buckets[idx].Bytes += uint64(entry.Size)
+	}
+	var cumulative uint64
+	for _, bucket := range buckets {
+		cumulative += bucket.Bytes
+		bucket.CumulativeBytes = cumulative
+	}
+	return buckets
+}
+
+// ListMempoolTransactions lists txs in mempool by fee rate from the highest,
+// earlier ones first on ties, the order they are picked to be mined in
+func (s *txServer) ListMempoolTransactions(ctx context.Context, req *rpcpb.ListMempoolTransactionsRequest) (*rpcpb.ListMempoolTransactionsResponse, error) {
+	entries := s.server.GetTxHandler().GetMempoolEntries()
+	sortMempoolEntries(entries)
+	resp := &rpcpb.ListMempoolTransactionsResponse{Code: 0, Message: "ok"}
+	now := time.Now().Unix()
+	for _, entry := range entries {
+		hash, err := entry.Tx.TxHash()
+		if err != nil {
+			return &rpcpb.ListMempoolTransactionsResponse{Code: int32(errorCode(err)), Message: err.Error()}, err
+		}
+		resp.Hashes = append(resp.Hashes, hash.String())
+		if !req.Verbose {
+			continue
+		}
+		msg, err := entry.Tx.ToProtoMessage()
+		if err != nil {
+			return &rpcpb.ListMempoolTransactionsResponse{Code: int32(errorCode(err)), Message: err.Error()}, err
+		}
+		resp.Entries = append(resp.Entries, &rpcpb.MempoolEntry{
+			Hash:           hash.String(),
+			Tx:             msg.(*corepb.Transaction),
+			TxSize:         uint32(entry.Size),
+			Fee:            entry.Fee,
+			FeePerKb:       entry.FeePerKB,
+			AddedTime:      entry.AddedTimestamp,
+			Age:            now - entry.AddedTimestamp,
+			AncestorFee:    entry.AncestorFee,
+			AncestorSize:   uint32(entry.AncestorSize),
+			DescendantFee:  entry.DescendantFee,
+			DescendantSize: uint32(entry.DescendantSize),
+		})
+	}
+	return resp, nil
+}
+
+// TestMempoolAccept runs all mempool checks on a raw tx without adding or
+// relaying it. A rejected tx is not an error of the call, the reason is
+// replied instead
+func (s *txServer) TestMempoolAccept(ctx context.Context, req *rpcpb.TestMempoolAcceptRequest) (*rpcpb.TestMempoolAcceptResponse, error) {
+	tx := new(types.Transaction)
+	if err := tx.Unmarshal(req.Raw); err != nil {
+		err = newRPCError(rpcpb.ErrorCode_INVALID_ARGUMENT, err)
+		return &rpcpb.TestMempoolAcceptResponse{Code: int32(errorCode(err)), Message: err.Error()}, err
+	}
+	hash, err := tx.TxHash()
+	if err != nil {
+		return &rpcpb.TestMempoolAcceptResponse{Code: int32(errorCode(err)), Message: err.Error()}, err
+	}
+	resp := &rpcpb.TestMempoolAcceptResponse{Code: 0, Message: "ok", Hash: hash.String()}
+	fee, size, err := s.server.GetTxHandler().TestAcceptTx(tx)
+	if err != nil {
+		resp.RejectReason = err.Error()
+		return resp, nil
+	}
+	resp.Allowed = true
+	resp.Fee = fee
+	resp.TxSize = uint32(size)
+	if size > 0 {
+		resp.FeePerKb = fee * 1000 / uint64(size)
+	}
+	return resp, nil
+}
+
+// sortMempoolEntries sorts entries by fee rate desc, then by added time
+func sortMempoolEntries(entries []*types.MempoolEntry) {
+	sort.SliceStable(entries, func(i, j int) bool {
+		if entries[i].FeePerKB != entries[j].FeePerKB {
+			return entries[i].FeePerKB > entries[j].FeePerKB
+		}
+		return entries[i].AddedTimestamp < entries[j].AddedTimestamp
+	})
+}
+
 func (s *txServer) GetFeePrice(ctx context.Context, req *rpcpb.GetFeePriceRequest) (*rpcpb.GetFeePriceResponse, error) {
-	return &rpcpb.GetFeePriceResponse{BoxPerByte: 1}, nil
+	return &rpcpb.GetFeePriceResponse{BoxPerByte: defaultFeePerByte}, nil
 }
 
-func (s *txServer) ListUtxos(ctx context.Context, req *rpcpb.ListUtxosRequest) (*rpcpb.ListUtxosResponse, error) {
+func (s *txServer) EstimateFee(ctx context.Context, req *rpcpb.EstimateFeeRequest) (*rpcpb.EstimateFeeResponse, error) {
+	target := req.TargetBlocks
+	if target == 0 {
+		target = 1
+	} else if target > feeSampleBlocks {
+		target = feeSampleBlocks
+	}
+	feeRate, sampleSize, err := s.estimateFeeRate(target)
+	if err != nil {
+		return &rpcpb.EstimateFeeResponse{Code: int32(errorCode(err)), Message: err.Error()}, err
+	}
+	return &rpcpb.EstimateFeeResponse{
+		Code:       0,
+		Message:    "ok",
+		BoxPerByte: feeRate,
+		SampleSize: sampleSize,
+	}, nil
+}
+
+// feeSamples caches fee rates of txs in recent blocks, sampled at a tip
+var feeSamples feeSampleCache
+
+// feeSampleCache keeps fee rates sampled at the latest tip asked for, as
+// sampling loads blocks and the outputs their txs spend
+type feeSampleCache struct {
+	mtx sync.Mutex
+	tip crypto.HashType
+	// rates in ascending order
+	rates       []uint64
+	maxBlockTxs int
+}
+
+// estimateFeeRate returns the fee rate a tx should pay to be packed within
+// target blocks, along with the number of txs sampled. Rates of txs in recent
+// blocks are picked at a percentile falling with target, and raised if the
+// mempool txs paying more would fill the target blocks on their own
+func (s *txServer) estimateFeeRate(target uint32) (uint64, uint32, error) {
+	rates, maxBlockTxs, err := s.sampleFeeRates()
+	if err != nil {
+		return 0, 0, err
+	}
+	sampleSize := uint32(len(rates))
+	feeRate := uint64(defaultFeePerByte)
+	if len(rates) > 0 {
+		// a closer target takes a higher percentile, from the 90th down to the median
+		percentile := 100 - 10*int(target)
+		if percentile < 50 {
+			percentile = 50
+		}
+		if rate := rates[(len(rates)-1)*percentile/100]; rate > feeRate {
+			feeRate = rate
+		}
+	}
+
+	capacity := maxBlockTxs * int(target)
+	poolTxs := s.server.GetTxHandler().GetTransactionsInPool()
+	if capacity == 0 || len(poolTxs) < capacity {
+		return feeRate, sampleSize, nil
+	}
+	poolRates := make([]uint64, 0, len(poolTxs))
+	for _, tx := range poolTxs {
+		rate, err := s.feeRate(tx)
+		if err != nil {
+			// its parent may have been evicted from mempool
+			continue
+		}
+		poolRates = append(poolRates, rate)
+	}
+	sampleSize += uint32(len(poolRates))
+	// txs paying more are packed first, so the tx has to outbid those beyond capacity
+	sort.Slice(poolRates, func(i, j int) bool { return poolRates[i] > poolRates[j] })
+	if len(poolRates) >= capacity && poolRates[capacity-1] > feeRate {
+		feeRate = poolRates[capacity-1]
+	}
+	return feeRate, sampleSize, nil
+}
+
+// sampleFeeRates returns ascending fee rates of txs in feeSampleBlocks blocks
+// back from the tip, excluding genesis, and the most txs one of them has
+func (s *txServer) sampleFeeRates() ([]uint64, int, error) {
 	bc := s.server.GetChainReader()
-	utxos, err := bc.ListAllUtxos()
+	hash, err := bc.GetBlockHash(bc.GetBlockHeight())
 	if err != nil {
-		return &rpcpb.ListUtxosResponse{
-			Code:    1,
-			Message: err.Error(),
-		}, err
+		return nil, 0, err
+	}
+	feeSamples.mtx.Lock()
+	defer feeSamples.mtx.Unlock()
+	if feeSamples.rates != nil && feeSamples.tip == *hash {
+		return feeSamples.rates, feeSamples.maxBlockTxs, nil
+	}
+	// blocks are walked back by hash, so that they stay on the same branch
+	// if the tip moves meanwhile
+	rates := make([]uint64, 0)
+	maxBlockTxs := 0
+	for prev, i := *hash, 0; i < feeSampleBlocks; i++ {
+		block, err := bc.LoadBlockByHash(prev)
+		if err != nil {
+			return nil, 0, err
+		}
+		if block.Height == 0 {
+			break
+		}
+		if len(block.Txs)-1 > maxBlockTxs {
+			maxBlockTxs = len(block.Txs) - 1
+		}
+		for _, tx := range block.Txs {
+			if chain.IsCoinBase(tx) {
+				continue
+			}
+			rate, err := s.feeRate(tx)
+			if err != nil {
+				return nil, 0, err
+			}
+			rates = append(rates, rate)
+		}
+		prev = block.Header.PrevBlockHash
+	}
+	sort.Slice(rates, func(i, j int) bool { return rates[i] < rates[j] })
+	feeSamples.tip, feeSamples.rates, feeSamples.maxBlockTxs = *hash, rates, maxBlockTxs
+	return rates, maxBlockTxs, nil
+}
+
+// feeRate returns the fee tx pays per byte of its scripts, which is how fees are charged
+func (s *txServer) feeRate(tx *types.Transaction) (uint64, error) {
+	var totalIn, totalOut uint64
+	var scriptBytes int
+	for _, txIn := range tx.Vin {
+		prevOut, err := s.loadPrevOutput(&txIn.PrevOutPoint)
+		if err != nil {
+			return 0, err
+		}
+		totalIn += prevOut.Value
+		scriptBytes += len(txIn.ScriptSig)
+	}
+	for _, txOut := range tx.Vout {
+		totalOut += txOut.Value
+		scriptBytes += len(txOut.ScriptPubKey)
+	}
+	if totalIn <= totalOut || scriptBytes == 0 {
+		return 0, nil
+	}
+	return (totalIn - totalOut) / uint64(scriptBytes), nil
+}
+
+func (s *txServer) ListUtxos(ctx context.Context, req *rpcpb.ListUtxosRequest) (*rpcpb.ListUtxosResponse, error) {
+	bc := s.server.GetChainReader()
+	var utxos map[types.OutPoint]*types.UtxoWrap
+	if len(req.Addrs) == 0 && !req.Wallet {
+		var err error
+		if utxos, err = bc.ListAllUtxos(); err != nil {
+			return &rpcpb.ListUtxosResponse{
+				Code:    int32(errorCode(err)),
+				Message: err.Error(),
+			}, err
+		}
+	} else {
+		addrs, err := s.requestAddresses(req.Addrs, req.Wallet)
+		if err != nil {
+			return &rpcpb.ListUtxosResponse{Code: int32(errorCode(err)), Message: err.Error()}, err
+		}
+		utxos = make(map[types.OutPoint]*types.UtxoWrap)
+		for _, addr := range addrs {
+			addrUtxos, err := bc.LoadUtxoByAddress(addr)
+			if err != nil {
+				return &rpcpb.ListUtxosResponse{Code: int32(errorCode(err)), Message: err.Error()}, err
+			}
+			for out, utxo := range addrUtxos {
+				utxos[out] = utxo
+			}
+		}
 	}
 	res := &rpcpb.ListUtxosResponse{
 		Code:    0,
@@ -74,19 +392,40 @@ func (s *txServer) ListUtxos(ctx context.Context, req *rpcpb.ListUtxosRequest) (
 }
 
 func (s *txServer) GetBalance(ctx context.Context, req *rpcpb.GetBalanceRequest) (*rpcpb.GetBalanceResponse, error) {
+	addrs, err := s.requestAddresses(req.Addrs, req.Wallet)
+	if err != nil {
+		return &rpcpb.GetBalanceResponse{Code: int32(errorCode(err)), Message: err.Error()}, err
+	}
 	balances := make(map[string]uint64)
-	for _, addrStr := range req.Addrs {
+	for _, addr := range addrs {
+		amount, err := s.getbalance(ctx, addr)
+		if err != nil {
+			return &rpcpb.GetBalanceResponse{Code: int32(errorCode(err)), Message: err.Error()}, err
+		}
+		balances[addr.String()] = amount
+	}
+	return &rpcpb.GetBalanceResponse{Code: 0, Message: "ok", Balances: balances}, nil
+}
+
+// requestAddresses parses addresses of a request, together with those of
+// accounts in node wallet if wallet is set
+func (s *txServer) requestAddresses(addrStrs []string, wallet bool) ([]types.Address, error) {
+	addrs := make([]types.Address, 0, len(addrStrs))
+	for _, addrStr := range addrStrs {
 		addr, err := types.NewAddress(addrStr)
 		if err != nil {
-			return &rpcpb.GetBalanceResponse{Code: -1, Message: err.Error()}, err
+			return nil, err
 		}
-		amount, err := s.getbalance(ctx, addr)
+		addrs = append(addrs, addr)
+	}
+	if wallet {
+		walletAddrs, _, err := walletAddresses(s.server)
 		if err != nil {
-			return &rpcpb.GetBalanceResponse{Code: -1, Message: err.Error()}, err
+			return nil, err
 		}
-		balances[addrStr] = amount
+		addrs = append(addrs, walletAddrs...)
 	}
-	return &rpcpb.GetBalanceResponse{Code: 0, Message: "ok", Balances: balances}, nil
+	return addrs, nil
 }
 
 func (s *txServer) GetTokenBalance(ctx context.Context, req *rpcpb.GetTokenBalanceRequest) (*rpcpb.GetTokenBalanceResponse, error) {
@@ -94,7 +433,7 @@ func (s *txServer) GetTokenBalance(ctx context.Context, req *rpcpb.GetTokenBalan
 	token := &types.OutPoint{}
 	if err := token.FromProtoMessage(req.Token); err != nil {
 		return &rpcpb.GetTokenBalanceResponse{
-			Code:    -1,
+			Code:    int32(errorCode(err)),
 			Message: err.Error(),
 		}, err
 	}
@@ -102,13 +441,13 @@ func (s *txServer) GetTokenBalance(ctx context.Context, req *rpcpb.GetTokenBalan
 		addr, err := types.NewAddress(addrStr)
 		if err != nil {
 			return &rpcpb.GetTokenBalanceResponse{
-				Code:    -1,
+				Code:    int32(errorCode(err)),
 				Message: err.Error(),
 			}, err
 		}
 		amount, err := s.getTokenBalance(ctx, addr, token)
 		if err != nil {
-			return &rpcpb.GetTokenBalanceResponse{Code: -1, Message: err.Error()}, err
+			return &rpcpb.GetTokenBalanceResponse{Code: int32(errorCode(err)), Message: err.Error()}, err
 		}
 		balances[addrStr] = amount
 	}
@@ -119,6 +458,70 @@ func (s *txServer) GetTokenBalance(ctx context.Context, req *rpcpb.GetTokenBalan
 	}, nil
 }
 
+func (s *txServer) ListTokens(ctx context.Context, req *rpcpb.ListTokensRequest) (*rpcpb.ListTokensResponse, error) {
+	limit := req.Limit
+	if limit == 0 {
+		limit = defaultListTokensLimit
+	}
+	tokens, total, err := s.server.GetChainReader().ListTokens(req.Offset, limit)
+	if err != nil {
+		return &rpcpb.ListTokensResponse{Code: int32(errorCode(err)), Message: err.Error()}, err
+	}
+	res := &rpcpb.ListTokensResponse{Code: 0, Message: "ok", Total: total}
+	for _, info := range tokens {
+		token, err := info.Token.ToProtoMessage()
+		if err != nil {
+			return &rpcpb.ListTokensResponse{Code: int32(errorCode(err)), Message: err.Error()}, err
+		}
+		res.Tokens = append(res.Tokens, &rpcpb.TokenInfo{
+			Token:       token.(*corepb.OutPoint),
+			Name:        info.Name,
+			TotalSupply: info.TotalSupply,
+			Issuer:      info.Issuer,
+			Height:      info.Height,
+		})
+	}
+	return res, nil
+}
+
+func (s *txServer) GetTokenTransactions(ctx context.Context, req *rpcpb.GetTokenTransactionsRequest) (*rpcpb.GetTokenTransactionsResponse, error) {
+	if req.Token == nil {
+		err := fmt.Errorf("Token is required")
+		return &rpcpb.GetTokenTransactionsResponse{Code: int32(errorCode(err)), Message: err.Error()}, err
+	}
+	token := &types.OutPoint{}
+	if err := token.FromProtoMessage(req.Token); err != nil {
+		return &rpcpb.GetTokenTransactionsResponse{Code: int32(errorCode(err)), Message: err.Error()}, err
+	}
+	limit := req.Limit
+	if limit == 0 {
+		limit = defaultTokenTxsLimit
+	}
+	records, total, err := s.server.GetChainReader().GetTokenTransactions(*token, req.Offset, limit)
+	if err != nil {
+		return &rpcpb.GetTokenTransactionsResponse{Code: int32(errorCode(err)), Message: err.Error()}, err
+	}
+	res := &rpcpb.GetTokenTransactionsResponse{Code: 0, Message: "ok", Total: total}
+	for _, record := range records {
+		txProto, err := record.Tx.ToProtoMessage()
+		if err != nil {
+			return &rpcpb.GetTokenTransactionsResponse{Code: int32(errorCode(err)), Message: err.Error()}, err
+		}
+		hash, err := record.Tx.TxHash()
+		if err != nil {
+			return &rpcpb.GetTokenTransactionsResponse{Code: int32(errorCode(err)), Message: err.Error()}, err
+		}
+		res.Txs = append(res.Txs, &rpcpb.TokenTransaction{
+			Tx:          txProto.(*corepb.Transaction),
+			Hash:        hash.String(),
+			BlockHash:   record.BlockHash.String(),
+			BlockHeight: record.Height,
+			Timestamp:   record.TimeStamp,
+		})
+	}
+	return res, nil
+}
+
 func (s *txServer) getbalance(ctx context.Context, addr types.Address) (uint64, error) {
 	utxos, err := s.server.GetChainReader().LoadUtxoByAddress(addr)
 	if err != nil {
@@ -166,42 +569,14 @@ func (s *txServer) getTokenBalance(ctx context.Context, addr types.Address, toke
 }
 
 func (s *txServer) FundTransaction(ctx context.Context, req *rpcpb.FundTransactionRequest) (*rpcpb.ListUtxosResponse, error) {
-	bc := s.server.GetChainReader()
 	addr, err := types.NewAddress(req.Addr)
-	payToPubKeyHashScript := *script.PayToPubKeyHashScript(addr.Hash())
 	if err != nil {
-		return &rpcpb.ListUtxosResponse{Code: 1, Message: err.Error()}, nil
+		return &rpcpb.ListUtxosResponse{Code: int32(rpcpb.ErrorCode_INVALID_ADDRESS), Message: err.Error()}, nil
 	}
-	utxos, err := bc.LoadUtxoByAddress(addr)
+	utxos, err := s.loadSpendableUtxos(addr)
 	if err != nil {
-		return &rpcpb.ListUtxosResponse{Code: 1, Message: err.Error()}, nil
-	}
-
-	nextHeight := s.server.GetChainReader().GetBlockHeight() + 1
-
-	// apply mempool txs as if they were mined into a block with 0 confirmation
-	utxoSet := chain.NewUtxoSetFromMap(utxos)
-	memPoolTxs := s.server.GetTxHandler().GetTransactionsInPool()
-	// Note: we add utxo first and spend them later to maintain tx topological order within mempool. Since memPoolTxs may not
-	// be topologically ordered, if tx1 spends tx2 but tx1 comes after tx2, tx1's output is mistakenly marked as unspent
-	// Add utxos first
-	for _, tx := range memPoolTxs {
-		for txOutIdx, txOut := range tx.Vout {
-			// utxo for this address
-			if util.IsPrefixed(txOut.ScriptPubKey, payToPubKeyHashScript) {
-				if err := utxoSet.AddUtxo(tx, uint32(txOutIdx), nextHeight); err != nil {
-					return &rpcpb.ListUtxosResponse{Code: 1, Message: err.Error()}, nil
-				}
-			}
-		}
+		return &rpcpb.ListUtxosResponse{Code: int32(errorCode(err)), Message: err.Error()}, nil
 	}
-	// Then spend
-	for _, tx := range memPoolTxs {
-		for _, txIn := range tx.Vin {
-			utxoSet.SpendUtxo(txIn.PrevOutPoint)
-		}
-	}
-	utxos = utxoSet.GetUtxos()
 
 	res := &rpcpb.ListUtxosResponse{
 		Code:    0,
@@ -242,52 +617,482 @@ func (s *txServer) FundTransaction(ctx context.Context, req *rpcpb.FundTransacti
 		}
 	}
 	if current < req.GetAmount() || len(tokenAmount) > 0 {
-		errMsg := "Not enough balance"
 		return &rpcpb.ListUtxosResponse{
-			Code:    -1,
-			Message: errMsg,
-		}, fmt.Errorf(errMsg)
+			Code:    int32(rpcpb.ErrorCode_INSUFFICIENT_FUNDS),
+			Message: errNotEnoughBalance.Error(),
+		}, errNotEnoughBalance
 	}
 	return res, nil
 }
 
+// loadSpendableUtxos returns utxos of addr, with txs in mempool applied
+func (s *txServer) loadSpendableUtxos(addr types.Address) (map[types.OutPoint]*types.UtxoWrap, error) {
+	bc := s.server.GetChainReader()
+	payToPubKeyHashScript := *script.PayToPubKeyHashScript(addr.Hash())
+	utxos, err := bc.LoadUtxoByAddress(addr)
+	if err != nil {
+		return nil, err
+	}
+
+	nextHeight := bc.GetBlockHeight() + 1
+
+	// apply mempool txs as if they were mined into a block with 0 confirmation
+	utxoSet := chain.NewUtxoSetFromMap(utxos)
+	memPoolTxs := s.server.GetTxHandler().GetTransactionsInPool()
+	// Note: we add utxo first and spend them later to maintain tx topological order within mempool. Since memPoolTxs may not
+	// be topologically ordered, if tx1 spends tx2 but tx1 comes after tx2, tx1's output is mistakenly marked as unspent
+	// Add utxos first
+	for _, tx := range memPoolTxs {
+		for txOutIdx, txOut := range tx.Vout {
+			// utxo for this address
+			if util.IsPrefixed(txOut.ScriptPubKey, payToPubKeyHashScript) {
+				if err := utxoSet.AddUtxo(tx, uint32(txOutIdx), nextHeight); err != nil {
+					return nil, err
+				}
+			}
+		}
+	}
+	// Then spend
+	for _, tx := range memPoolTxs {
+		for _, txIn := range tx.Vin {
+			utxoSet.SpendUtxo(txIn.PrevOutPoint)
+		}
+	}
+	return utxoSet.GetUtxos(), nil
+}
+
 func getTokenInfo(outpoint types.OutPoint, wrap *types.UtxoWrap) (types.OutPoint, uint64, bool) {
 	s := script.NewScriptFromBytes(wrap.Output.ScriptPubKey)
-	if issueParam, err := s.GetIssueParams(); err == nil {
-		return outpoint, issueParam.TotalSupply, true
+	// params are only parsed from scripts of the matching kind, as parsing
+	// the other kind panics on operands of unexpected lengths
+	if s.IsTokenIssue() {
+		if issueParam, err := s.GetIssueParams(); err == nil {
+			return outpoint, issueParam.TotalSupply, true
+		}
 	}
-	if transferParam, err := s.GetTransferParams(); err == nil {
-		return transferParam.OutPoint, transferParam.Amount, true
+	if s.IsTokenTransfer() {
+		if transferParam, err := s.GetTransferParams(); err == nil {
+			return transferParam.OutPoint, transferParam.Amount, true
+		}
 	}
 	return types.OutPoint{}, 0, false
 }
 
+func (s *txServer) CreateRawTransaction(ctx context.Context, req *rpcpb.CreateRawTransactionRequest) (*rpcpb.CreateRawTransactionResponse, error) {
+	from, err := types.NewAddress(req.From)
+	if err != nil {
+		return &rpcpb.CreateRawTransactionResponse{Code: int32(errorCode(err)), Message: err.Error()}, err
+	}
+	changeAddr := from
+	if req.ChangeAddr != "" {
+		if changeAddr, err = types.NewAddress(req.ChangeAddr); err != nil {
+			return &rpcpb.CreateRawTransactionResponse{Code: int32(errorCode(err)), Message: err.Error()}, err
+		}
+	}
+	if len(req.Outputs) == 0 {
+		err := fmt.Errorf("no outputs specified")
+		return &rpcpb.CreateRawTransactionResponse{Code: int32(errorCode(err)), Message: err.Error()}, err
+	}
+	feePerByte := req.FeePerByte
+	if feePerByte == 0 {
+		feePerByte = defaultFeePerByte
+	}
+
+	tx := &corepb.Transaction{}
+	var amount uint64
+	var scriptBytes int
+	for _, target := range req.Outputs {
+		addr, err := types.NewAddress(target.Addr)
+		if err != nil {
+			return &rpcpb.CreateRawTransactionResponse{Code: int32(errorCode(err)), Message: err.Error()}, err
+		}
+		if target.Amount == 0 {
+			err := fmt.Errorf("invalid amount for %s", target.Addr)
+			return &rpcpb.CreateRawTransactionResponse{Code: int32(errorCode(err)), Message: err.Error()}, err
+		}
+		scriptPubKey := *script.PayToPubKeyHashScript(addr.Hash())
+		tx.Vout = append(tx.Vout, &corepb.TxOut{Value: target.Amount, ScriptPubKey: scriptPubKey})
+		amount += target.Amount
+		scriptBytes += len(scriptPubKey)
+	}
+
+	utxos, err := s.loadSpendableUtxos(from)
+	if err != nil {
+		return &rpcpb.CreateRawTransactionResponse{Code: int32(errorCode(err)), Message: err.Error()}, err
+	}
+	// only plain box utxos are spent, smallest first
+	outPoints := make([]types.OutPoint, 0, len(utxos))
+	for out, utxo := range utxos {
+		if utxo.IsSpent || !script.NewScriptFromBytes(utxo.Output.ScriptPubKey).IsPayToPubKeyHash() {
+			continue
+		}
+		outPoints = append(outPoints, out)
+	}
+	sort.Slice(outPoints, func(i, j int) bool {
+		return utxos[outPoints[i]].Value() < utxos[outPoints[j]].Value()
+	})
+
+	// txs are final unless they opt in to be replaced by one paying more
+	sequence := types.SequenceFinal
+	if req.Replaceable {
+		sequence = types.SequenceReplaceable
+	}
+	changeScript := *script.PayToPubKeyHashScript(changeAddr.Hash())
+	res := &rpcpb.CreateRawTransactionResponse{Code: 0, Message: "ok"}
+	var total uint64
+	balanced := false
+	for i, out := range outPoints {
+		utxo := utxos[out]
+		tx.Vin = append(tx.Vin, &corepb.TxIn{
+			PrevOutPoint: &corepb.OutPoint{Hash: out.Hash.GetBytes(), Index: out.Index},
+			ScriptSig:    []byte{},
+			Sequence:     sequence,
+		})
+		res.Utxos = append(res.Utxos, generateUtxoMessage(&outPoints[i], utxo))
+		total += utxo.Value()
+
+		// fee is charged on script bytes, with scriptSigs not signed yet estimated
+		fee := uint64((i+1)*p2pkhScriptSigLen+scriptBytes) * feePerByte
+		feeWithChange := fee + uint64(len(changeScript))*feePerByte
+		if total > amount+feeWithChange {
+			tx.Vout = append(tx.Vout, &corepb.TxOut{
+				Value:        total - amount - feeWithChange,
+				ScriptPubKey: changeScript,
+			})
+			res.Fee = feeWithChange
+			balanced = true
+			break
+		} else if total >= amount+fee {
+			// change is not worth its own fee, leave the remainder to miners
+			res.Fee = total - amount
+			balanced = true
+			break
+		}
+	}
+	if !balanced {
+		return &rpcpb.CreateRawTransactionResponse{Code: int32(rpcpb.ErrorCode_INSUFFICIENT_FUNDS), Message: errNotEnoughBalance.Error()}, errNotEnoughBalance
+	}
+	res.Tx = tx
+	return res, nil
+}
+
+func (s *txServer) SignRawTransaction(ctx context.Context, req *rpcpb.SignRawTransactionRequest) (*rpcpb.SignRawTransactionResponse, error) {
+	wltMgr := s.server.GetWalletManager()
+	if wltMgr == nil {
+		return &rpcpb.SignRawTransactionResponse{Code: int32(rpcpb.ErrorCode_WALLET_DISABLED), Message: errWalletDisabled.Error()}, errWalletDisabled
+	}
+	tx, err := generateTransaction(req.Tx)
+	if err != nil {
+		return &rpcpb.SignRawTransactionResponse{Code: int32(errorCode(err)), Message: err.Error()}, err
+	}
+	complete := true
+	for txInIdx, txIn := range tx.Vin {
+		if len(txIn.ScriptSig) > 0 {
+			continue
+		}
+		prevOut, err := s.loadPrevOutput(&txIn.PrevOutPoint)
+		if err != nil {
+			return &rpcpb.SignRawTransactionResponse{Code: int32(errorCode(err)), Message: err.Error()}, err
+		}
+		prevScriptPubKey := script.NewScriptFromBytes(prevOut.ScriptPubKey)
+		addr, err := prevScriptPubKey.ExtractAddress()
+		if err != nil {
+			complete = false
+			continue
+		}
+		if account, ok := wltMgr.GetAccount(addr.String()); ok && account.WatchOnly() {
+			return &rpcpb.SignRawTransactionResponse{Code: int32(rpcpb.ErrorCode_ACCOUNT_LOCKED), Message: wallet.ErrWatchOnly.Error()}, wallet.ErrWatchOnly
+		}
+		account, ok := wltMgr.UnlockedAccount(addr.String())
+		if !ok {
+			// not managed by the node, or still locked
+			complete = false
+			continue
+		}
+		sigHash, err := script.CalcTxHashForSig(prevOut.ScriptPubKey, tx, txInIdx)
+		if err != nil {
+			return &rpcpb.SignRawTransactionResponse{Code: int32(errorCode(err)), Message: err.Error()}, err
+		}
+		sig, err := account.Sign(sigHash)
+		if err != nil {
+			return &rpcpb.SignRawTransactionResponse{Code: int32(errorCode(err)), Message: err.Error()}, err
+		}
+		scriptSig := script.SignatureScript(sig, account.PublicKey())
+		txIn.ScriptSig = *scriptSig
+		if err := script.Validate(scriptSig, prevScriptPubKey, tx, txInIdx); err != nil {
+			return &rpcpb.SignRawTransactionResponse{Code: int32(errorCode(err)), Message: err.Error()}, err
+		}
+	}
+	msg, err := tx.ToProtoMessage()
+	if err != nil {
+		return &rpcpb.SignRawTransactionResponse{Code: int32(errorCode(err)), Message: err.Error()}, err
+	}
+	return &rpcpb.SignRawTransactionResponse{
+		Code:     0,
+		Message:  "ok",
+		Tx:       msg.(*corepb.Transaction),
+		Complete: complete,
+	}, nil
+}
+
+// loadPrevOutput returns the output an input spends, looking up mempool txs
+// if it's not on chain yet
+func (s *txServer) loadPrevOutput(outPoint *types.OutPoint) (*corepb.TxOut, error) {
+	prevTx, err := s.server.GetChainReader().LoadTxByHash(outPoint.Hash)
+	if err != nil {
+		prevTx = nil
+		for _, tx := range s.server.GetTxHandler().GetTransactionsInPool() {
+			if hash, _ := tx.TxHash(); hash != nil && *hash == outPoint.Hash {
+				prevTx = tx
+				break
+			}
+		}
+		if prevTx == nil {
+			return nil, err
+		}
+	}
+	if outPoint.Index >= uint32(len(prevTx.Vout)) {
+		return nil, core.ErrTxOutIndexOob
+	}
+	return prevTx.Vout[outPoint.Index], nil
+}
+
+func (s *txServer) CreatePSBT(ctx context.Context, req *rpcpb.CreatePSBTRequest) (*rpcpb.PSBTResponse, error) {
+	tx, err := generateTransaction(req.Tx)
+	if err != nil {
+		return &rpcpb.PSBTResponse{Code: int32(errorCode(err)), Message: err.Error()}, err
+	}
+	utxos := make([]*corepb.TxOut, 0, len(tx.Vin))
+	for _, txIn := range tx.Vin {
+		prevOut, err := s.loadPrevOutput(&txIn.PrevOutPoint)
+		if err != nil {
+			return &rpcpb.PSBTResponse{Code: int32(errorCode(err)), Message: err.Error()}, err
+		}
+		utxos = append(utxos, prevOut)
+	}
+	p, err := wallet.NewPSBT(tx, utxos)
+	if err != nil {
+		return &rpcpb.PSBTResponse{Code: int32(errorCode(err)), Message: err.Error()}, err
+	}
+	for i, redeemScript := range req.RedeemScripts {
+		if int(i) >= len(p.Inputs) {
+			err := fmt.Errorf("Redeem script of nonexistent input %d", i)
+			return &rpcpb.PSBTResponse{Code: int32(errorCode(err)), Message: err.Error()}, err
+		}
+		p.Inputs[i].RedeemScript = redeemScript
+	}
+	encoded, err := p.Encode()
+	if err != nil {
+		return &rpcpb.PSBTResponse{Code: int32(errorCode(err)), Message: err.Error()}, err
+	}
+	return &rpcpb.PSBTResponse{Code: 0, Message: "ok", Psbt: encoded}, nil
+}
+
+func (s *txServer) SignPSBT(ctx context.Context, req *rpcpb.SignPSBTRequest) (*rpcpb.SignPSBTResponse, error) {
+	wltMgr := s.server.GetWalletManager()
+	if wltMgr == nil {
+		return &rpcpb.SignPSBTResponse{Code: int32(rpcpb.ErrorCode_WALLET_DISABLED), Message: errWalletDisabled.Error()}, errWalletDisabled
+	}
+	p, err := wallet.DecodePSBT(req.Psbt)
+	if err != nil {
+		return &rpcpb.SignPSBTResponse{Code: int32(errorCode(err)), Message: err.Error()}, err
+	}
+	signed, err := p.SignInputs(func(addr types.Address) (*wallet.Account, bool) {
+		return wltMgr.UnlockedAccount(addr.String())
+	})
+	if err != nil {
+		return &rpcpb.SignPSBTResponse{Code: int32(errorCode(err)), Message: err.Error()}, err
+	}
+	encoded, err := p.Encode()
+	if err != nil {
+		return &rpcpb.SignPSBTResponse{Code: int32(errorCode(err)), Message: err.Error()}, err
+	}
+	return &rpcpb.SignPSBTResponse{Code: 0, Message: "ok", Psbt: encoded, Signed: uint32(signed)}, nil
+}
+
+func (s *txServer) MergePSBT(ctx context.Context, req *rpcpb.MergePSBTRequest) (*rpcpb.PSBTResponse, error) {
+	if len(req.Psbts) == 0 {
+		err := fmt.Errorf("No PSBT to merge")
+		return &rpcpb.PSBTResponse{Code: int32(errorCode(err)), Message: err.Error()}, err
+	}
+	psbts := make([]*wallet.PSBT, 0, len(req.Psbts))
+	for _, encoded := range req.Psbts {
+		p, err := wallet.DecodePSBT(encoded)
+		if err != nil {
+			return &rpcpb.PSBTResponse{Code: int32(errorCode(err)), Message: err.Error()}, err
+		}
+		psbts = append(psbts, p)
+	}
+	if err := psbts[0].Merge(psbts[1:]...); err != nil {
+		return &rpcpb.PSBTResponse{Code: int32(errorCode(err)), Message: err.Error()}, err
+	}
+	encoded, err := psbts[0].Encode()
+	if err != nil {
+		return &rpcpb.PSBTResponse{Code: int32(errorCode(err)), Message: err.Error()}, err
+	}
+	return &rpcpb.PSBTResponse{Code: 0, Message: "ok", Psbt: encoded}, nil
+}
+
+func (s *txServer) FinalizePSBT(ctx context.Context, req *rpcpb.FinalizePSBTRequest) (*rpcpb.FinalizePSBTResponse, error) {
+	p, err := wallet.DecodePSBT(req.Psbt)
+	if err != nil {
+		return &rpcpb.FinalizePSBTResponse{Code: int32(errorCode(err)), Message: err.Error()}, err
+	}
+	complete, err := p.Finalize()
+	if err != nil {
+		return &rpcpb.FinalizePSBTResponse{Code: int32(errorCode(err)), Message: err.Error()}, err
+	}
+	encoded, err := p.Encode()
+	if err != nil {
+		return &rpcpb.FinalizePSBTResponse{Code: int32(errorCode(err)), Message: err.Error()}, err
+	}
+	resp := &rpcpb.FinalizePSBTResponse{Code: 0, Message: "ok", Psbt: encoded, Complete: complete}
+	if complete {
+		tx, err := p.Extract()
+		if err != nil {
+			return &rpcpb.FinalizePSBTResponse{Code: int32(errorCode(err)), Message: err.Error()}, err
+		}
+		msg, err := tx.ToProtoMessage()
+		if err != nil {
+			return &rpcpb.FinalizePSBTResponse{Code: int32(errorCode(err)), Message: err.Error()}, err
+		}
+		resp.Tx = msg.(*corepb.Transaction)
+	}
+	return resp, nil
+}
+
 func (s *txServer) SendTransaction(ctx context.Context, req *rpcpb.SendTransactionRequest) (*rpcpb.BaseResponse, error) {
 	for _, v := range req.Tx.Vin {
 		hash := new(crypto.HashType)
 		copy(hash[:], v.PrevOutPoint.Hash[:])
 	}
-	txpool := s.server.GetTxHandler()
 	tx, err := generateTransaction(req.Tx)
 	if err != nil {
-		return nil, err
+		return nil, newRPCError(rpcpb.ErrorCode_INVALID_ARGUMENT, err)
 	}
-	err = txpool.ProcessTx(tx, true /* relay */)
-	return &rpcpb.BaseResponse{}, err
+	err = relayTx(s.server, tx)
+	return &rpcpb.BaseResponse{Code: int32(errorCode(err))}, err
 }
 
+// GetRawTransaction returns a tx on main chain, serialized and decoded, with
+// the block it's in
 func (s *txServer) GetRawTransaction(ctx context.Context, req *rpcpb.GetRawTransactionRequest) (*rpcpb.GetRawTransactionResponse, error) {
 	hash := crypto.HashType{}
 	if err := hash.SetBytes(req.Hash); err != nil {
-		return &rpcpb.GetRawTransactionResponse{}, err
+		return &rpcpb.GetRawTransactionResponse{}, newRPCError(rpcpb.ErrorCode_INVALID_ARGUMENT, err)
 	}
-	tx, err := s.server.GetChainReader().LoadTxByHash(hash)
+	bc := s.server.GetChainReader()
+	block, tx, err := bc.LoadBlockInfoByTxHash(hash)
 	if err != nil {
 		logger.Debug(err)
-		return &rpcpb.GetRawTransactionResponse{}, err
+		return &rpcpb.GetRawTransactionResponse{}, newRPCError(rpcpb.ErrorCode_NOT_FOUND, err)
 	}
 	rpcTx, err := tx.ToProtoMessage()
-	return &rpcpb.GetRawTransactionResponse{Tx: rpcTx.(*corepb.Transaction)}, err
+	if err != nil {
+		return &rpcpb.GetRawTransactionResponse{}, err
+	}
+	raw, err := tx.Marshal()
+	if err != nil {
+		return &rpcpb.GetRawTransactionResponse{}, err
+	}
+	return &rpcpb.GetRawTransactionResponse{
+		Tx:            rpcTx.(*corepb.Transaction),
+		Raw:           raw,
+		BlockHash:     block.BlockHash().String(),
+		BlockHeight:   block.Height,
+		Confirmations: bc.GetBlockHeight() - block.Height + 1,
+	}, nil
+}
+
+func (s *txServer) GetTransactionDetail(ctx context.Context, req *rpcpb.GetTransactionDetailRequest) (*rpcpb.GetTransactionDetailResponse, error) {
+	hash := crypto.HashType{}
+	if err := hash.SetString(req.Hash); err != nil {
+		return &rpcpb.GetTransactionDetailResponse{Code: int32(errorCode(err)), Message: err.Error()}, err
+	}
+	bc := s.server.GetChainReader()
+	block, tx, err := bc.LoadBlockInfoByTxHash(hash)
+	if err != nil {
+		logger.Debug(err)
+		return &rpcpb.GetTransactionDetailResponse{Code: int32(errorCode(err)), Message: err.Error()}, err
+	}
+	detail, err := s.decodeTransaction(tx)
+	if err != nil {
+		return &rpcpb.GetTransactionDetailResponse{Code: int32(errorCode(err)), Message: err.Error()}, err
+	}
+	return &rpcpb.GetTransactionDetailResponse{
+		Code:          0,
+		Message:       "ok",
+		Detail:        detail,
+		BlockHash:     block.BlockHash().String(),
+		BlockHeight:   block.Height,
+		Timestamp:     block.Header.TimeStamp,
+		Confirmations: bc.GetBlockHeight() - block.Height + 1,
+	}, nil
+}
+
+// decodeTransaction resolves the outputs spent by tx and summarizes it
+func (s *txServer) decodeTransaction(tx *types.Transaction) (*rpcpb.TransactionDetail, error) {
+	hash, err := tx.TxHash()
+	if err != nil {
+		return nil, err
+	}
+	size, err := tx.SerializeSize()
+	if err != nil {
+		return nil, err
+	}
+	detail := &rpcpb.TransactionDetail{
+		Hash:       hash.String(),
+		Version:    tx.Version,
+		LockTime:   tx.LockTime,
+		TxSize:     uint32(size),
+		IsCoinbase: chain.IsCoinBase(tx),
+	}
+	var totalIn, totalOut uint64
+	for _, txIn := range tx.Vin {
+		inDetail := &rpcpb.TxInDetail{
+			PrevOutPoint: &corepb.OutPoint{
+				Hash:  txIn.PrevOutPoint.Hash.GetBytes(),
+				Index: txIn.PrevOutPoint.Index,
+			},
+			ScriptSig: txIn.ScriptSig,
+			Sequence:  txIn.Sequence,
+		}
+		if !detail.IsCoinbase {
+			prevTx, err := s.server.GetChainReader().LoadTxByHash(txIn.PrevOutPoint.Hash)
+			if err != nil {
+				return nil, err
+			}
+			if txIn.PrevOutPoint.Index >= uint32(len(prevTx.Vout)) {
+				return nil, core.ErrTxOutIndexOob
+			}
+			prevOut := prevTx.Vout[txIn.PrevOutPoint.Index]
+			inDetail.Value = prevOut.Value
+			inDetail.Addr = extractAddress(prevOut.ScriptPubKey)
+			totalIn += prevOut.Value
+		}
+		detail.Vin = append(detail.Vin, inDetail)
+	}
+	for _, txOut := range tx.Vout {
+		detail.Vout = append(detail.Vout, &rpcpb.TxOutDetail{
+			Value:        txOut.Value,
+			ScriptPubKey: txOut.ScriptPubKey,
+			Addr:         extractAddress(txOut.ScriptPubKey),
+		})
+		totalOut += txOut.Value
+	}
+	if !detail.IsCoinbase && totalIn > totalOut {
+		detail.Fee = totalIn - totalOut
+	}
+	return detail, nil
+}
+
+// extractAddress returns the address a script pays to, or empty string if it's not standard
+func extractAddress(scriptPubKey []byte) string {
+	addr, err := script.NewScriptFromBytes(scriptPubKey).ExtractAddress()
+	if err != nil {
+		return ""
+	}
+	return addr.String()
 }
 
 func generateUtxoMessage(outPoint *types.OutPoint, entry *types.UtxoWrap) *rpcpb.Utxo {
@@ -0,0 +1,437 @@
+// Copyright (c) 2018 ContentBox Authors.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package rpc
+
+import (
+	"context"
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/BOXFoundation/boxd/boxd/service"
+	"github.com/BOXFoundation/boxd/core/pb"
+	"github.com/BOXFoundation/boxd/core/types"
+	"github.com/BOXFoundation/boxd/crypto"
+	"github.com/BOXFoundation/boxd/rpc/pb"
+	"github.com/BOXFoundation/boxd/script"
+	"github.com/facebookgo/ensure"
+)
+
+func TestSortMempoolEntries(t *testing.T) {
+	entries := []*types.MempoolEntry{
+		{FeePerKB: 10, AddedTimestamp: 3},
+		{FeePerKB: 20, AddedTimestamp: 5},
+		{FeePerKB: 10, AddedTimestamp: 1},
+		{FeePerKB: 0, AddedTimestamp: 0},
+	}
+	sortMempoolEntries(entries)
+	var rates, times []int64
+	for _, e := range entries {
+		rates = append(rates, int64(e.FeePerKB))
+		times = append(times, e.AddedTimestamp)
+	}
+	ensure.DeepEqual(t, rates, []int64{20, 10, 10, 0})
+	ensure.DeepEqual(t, times, []int64{5, 1, 3, 0})
+}
+
+func TestFeeHistogram(t *testing.T) {
+	entries := []*types.MempoolEntry{
+		{FeePerKB: 5, Size: 100},
+		{FeePerKB: 10, Size: 200},
+		{FeePerKB: 15, Size: 300},
+		{FeePerKB: 30, Size: 400},
+	}
+	buckets := feeHistogram(entries, []uint64{10, 20, 10})
+	ensure.DeepEqual(t, len(buckets), 2)
+	ensure.DeepEqual(t, buckets[0].MinFeePerKb, uint64(20))
+	ensure.DeepEqual(t, buckets[0].MaxFeePerKb, uint64(0))
+	ensure.DeepEqual(t, buckets[0].Bytes, uint64(400))
+	// txs below the lowest bound fall into the lowest bucket
+	ensure.DeepEqual(t, buckets[1].MinFeePerKb, uint64(10))
+	ensure.DeepEqual(t, buckets[1].MaxFeePerKb, uint64(20))
+	ensure.DeepEqual(t, buckets[1].TxCount, uint32(3))
+	ensure.DeepEqual(t, buckets[1].Bytes, uint64(600))
+	ensure.DeepEqual(t, buckets[1].CumulativeBytes, uint64(1000))
+}
+
+// feeTestChain is a chain of fixture blocks, whose txs spend outputs of
+// funding txs
+type feeTestChain struct {
+	service.ChainReader
+	blocks  []*types.Block
+	funding map[crypto.HashType]*types.Transaction
+	loads   int
+}
+
+func (c *feeTestChain) GetBlockHeight() uint32 {
+	return uint32(len(c.blocks) - 1)
+}
+
+func (c *feeTestChain) GetBlockHash(height uint32) (*crypto.HashType, error) {
+	return c.blocks[height].Hash, nil
+}
+
+func (c *feeTestChain) LoadBlockByHash(hash crypto.HashType) (*types.Block, error) {
+	c.loads++
+	for _, block := range c.blocks {
+		if *block.Hash == hash {
+			return block, nil
+		}
+	}
+	return nil, errors.New("block not found")
+}
+
+func (c *feeTestChain) LoadTxByHash(hash crypto.HashType) (*types.Transaction, error) {
+	if tx, ok := c.funding[hash]; ok {
+		return tx, nil
+	}
+	return nil, errors.New("tx not found")
+}
+
+// addBlock appends a block of a coinbase and txs paying the given fee rates
+func (c *feeTestChain) addBlock(rates ...uint64) {
+	coinbase := &types.Transaction{
+		Vin:  []*types.TxIn{{PrevOutPoint: types.OutPoint{Index: math.MaxUint32}}},
+		Vout: []*corepb.TxOut{{Value: 50}},
+	}
+	block := &types.Block{Header: &types.BlockHeader{}, Txs: []*types.Transaction{coinbase}, Height: uint32(len(c.blocks))}
+	if len(c.blocks) > 0 {
+		block.Header.PrevBlockHash = *c.blocks[len(c.blocks)-1].Hash
+	}
+	hash := crypto.DoubleHashH([]byte{byte(len(c.blocks))})
+	block.Hash = &hash
+	for _, rate := range rates {
+		block.Txs = append(block.Txs, c.newTx(rate))
+	}
+	c.blocks = append(c.blocks, block)
+}
+
+// newTx returns a tx with 20 bytes of scripts paying rate per byte
+func (c *feeTestChain) newTx(rate uint64) *types.Transaction {
+	n := len(c.funding)
+	funding := &types.Transaction{Vout: []*corepb.TxOut{{Value: 10000, ScriptPubKey: []byte{byte(n), byte(n >> 8)}}}}
+	fundingHash, _ := funding.TxHash()
+	c.funding[*fundingHash] = funding
+	prevOut := types.OutPoint{Hash: *fundingHash}
+	return &types.Transaction{
+		Vin:  []*types.TxIn{{PrevOutPoint: prevOut, ScriptSig: make([]byte, 10)}},
+		Vout: []*corepb.TxOut{{Value: 10000 - rate*20, ScriptPubKey: make([]byte, 10)}},
+	}
+}
+
+type feeTestTxHandler struct {
+	service.TxHandler
+	pool []*types.Transaction
+}
+
+func (h *feeTestTxHandler) GetTransactionsInPool() []*types.Transaction {
+	return h.pool
+}
+
+type feeTestServer struct {
+	GRPCServer
+	chain     *feeTestChain
+	txHandler *feeTestTxHandler
+}
+
+func (s *feeTestServer) GetChainReader() service.ChainReader { return s.chain }
+func (s *feeTestServer) GetTxHandler() service.TxHandler     { return s.txHandler }
+
+func TestEstimateFeeRate(t *testing.T) {
+	bc := &feeTestChain{funding: make(map[crypto.HashType]*types.Transaction)}
+	txHandler := &feeTestTxHandler{}
+	s := &txServer{server: &feeTestServer{chain: bc, txHandler: txHandler}}
+	// txs in genesis are not sampled
+	bc.addBlock(1000)
+	bc.addBlock(1, 2)
+	bc.addBlock(3, 4, 5)
+	bc.addBlock(6)
+	pool := []*types.Transaction{bc.newTx(20), bc.newTx(30), bc.newTx(40)}
+
+	// the 90th percentile for the next block, down to the median
+	rate, sampleSize, err := s.estimateFeeRate(1)
+	ensure.Nil(t, err)
+	ensure.DeepEqual(t, rate, uint64(5))
+	ensure.DeepEqual(t, sampleSize, uint32(6))
+	rate, _, err = s.estimateFeeRate(6)
+	ensure.Nil(t, err)
+	ensure.DeepEqual(t, rate, uint64(3))
+
+	// samples are loaded once per tip
+	loads := bc.loads
+	ensure.DeepEqual(t, loads, 4)
+	_, _, err = s.estimateFeeRate(3)
+	ensure.Nil(t, err)
+	ensure.DeepEqual(t, bc.loads, loads)
+
+	// mempool txs paying more filling the target blocks raise the rate
+	txHandler.pool = pool[:2]
+	rate, _, err = s.estimateFeeRate(1)
+	ensure.Nil(t, err)
+	ensure.DeepEqual(t, rate, uint64(5))
+	txHandler.pool = pool
+	rate, sampleSize, err = s.estimateFeeRate(1)
+	ensure.Nil(t, err)
+	ensure.DeepEqual(t, rate, uint64(20))
+	ensure.DeepEqual(t, sampleSize, uint32(9))
+
+	// a new tip is sampled again, no more than feeSampleBlocks back
+	txHandler.pool = nil
+	for i := 0; i < feeSampleBlocks; i++ {
+		bc.addBlock(7)
+	}
+	rate, sampleSize, err = s.estimateFeeRate(1)
+	ensure.Nil(t, err)
+	ensure.DeepEqual(t, rate, uint64(7))
+	ensure.DeepEqual(t, sampleSize, uint32(feeSampleBlocks))
+	ensure.DeepEqual(t, bc.loads, loads+feeSampleBlocks)
+}
+
+// detailTestChain holds the block of a tx and the txs it spends
+type detailTestChain struct {
+	service.ChainReader
+	block *types.Block
+	txs   map[crypto.HashType]*types.Transaction
+}
+
+func (c *detailTestChain) GetBlockHeight() uint32 {
+	return c.block.Height + 2
+}
+
+func (c *detailTestChain) LoadBlockInfoByTxHash(hash crypto.HashType) (*types.Block, *types.Transaction, error) {
+	for _, tx := range c.block.Txs {
+		if txHash, _ := tx.TxHash(); *txHash == hash {
+			return c.block, tx, nil
+		}
+	}
+	return nil, nil, errors.New("tx not found")
+}
+
+func (c *detailTestChain) LoadTxByHash(hash crypto.HashType) (*types.Transaction, error) {
+	if tx, ok := c.txs[hash]; ok {
+		return tx, nil
+	}
+	return nil, errors.New("tx not found")
+}
+
+type detailTestServer struct {
+	GRPCServer
+	chain *detailTestChain
+}
+
+func (s *detailTestServer) GetChainReader() service.ChainReader { return s.chain }
+
+func TestGetTransactionDetail(t *testing.T) {
+	issuer, err := types.NewAddressPubKeyHash(append(make([]byte, 19), 1))
+	ensure.Nil(t, err)
+	receiver, err := types.NewAddressPubKeyHash(append(make([]byte, 19), 2))
+	ensure.Nil(t, err)
+	funding := &types.Transaction{Vout: []*corepb.TxOut{{Value: 100, ScriptPubKey: *script.PayToPubKeyHashScript(issuer.Hash())}}}
+	fundingHash, _ := funding.TxHash()
+
+	// the issuer issues a token to itself, then transfers part of it
+	issueScript := script.IssueTokenScript(issuer.Hash(), &script.IssueParams{Name: "box token", TotalSupply: 100})
+	issue := &types.Transaction{
+		Vin: []*types.TxIn{{PrevOutPoint: types.OutPoint{Hash: *fundingHash}}},
+		Vout: []*corepb.TxOut{
+			{Value: 1, ScriptPubKey: *issueScript},
+			{Value: 90, ScriptPubKey: *script.PayToPubKeyHashScript(issuer.Hash())},
+		},
+	}
+	issueHash, _ := issue.TxHash()
+	tokenID := script.NewTokenID(*issueHash, 0)
+	transfer := &types.Transaction{
+		Vin: []*types.TxIn{{PrevOutPoint: types.OutPoint{Hash: *issueHash}}, {PrevOutPoint: types.OutPoint{Hash: *issueHash, Index: 1}}},
+		Vout: []*corepb.TxOut{
+			{Value: 1, ScriptPubKey: *script.TransferTokenScript(receiver.Hash(), &script.TransferParams{TokenID: tokenID, Amount: 40})},
+			{Value: 1, ScriptPubKey: *script.TransferTokenScript(issuer.Hash(), &script.TransferParams{TokenID: tokenID, Amount: 60})},
+			{Value: 80, ScriptPubKey: []byte{0x6a}},
+		},
+	}
+	transferHash, _ := transfer.TxHash()
+	coinbase := &types.Transaction{
+		Vin:  []*types.TxIn{{PrevOutPoint: types.OutPoint{Index: math.MaxUint32}}},
+		Vout: []*corepb.TxOut{{Value: 50}},
+	}
+	block := &types.Block{
+		Header: &types.BlockHeader{TimeStamp: 1000},
+		Txs:    []*types.Transaction{coinbase, issue, transfer},
+		Height: 5,
+	}
+	bc := &detailTestChain{block: block, txs: map[crypto.HashType]*types.Transaction{*fundingHash: funding, *issueHash: issue}}
+	s := &txServer{server: &detailTestServer{chain: bc}}
+
+	resp, err := s.GetTransactionDetail(context.Background(), &rpcpb.GetTransactionDetailRequest{Hash: transferHash.String()})
+	ensure.Nil(t, err)
+	ensure.DeepEqual(t, resp.BlockHeight, uint32(5))
+	ensure.DeepEqual(t, resp.Timestamp, int64(1000))
+	ensure.DeepEqual(t, resp.Confirmations, uint32(3))
+	detail := resp.Detail
+	ensure.DeepEqual(t, detail.Hash, transferHash.String())
+	ensure.False(t, detail.IsCoinbase)
+
+	// inputs resolve to the token and change outputs spent, outputs to the
+	// owners of tokens and none for non standard scripts
+	ensure.DeepEqual(t, len(detail.Vin), 2)
+	ensure.DeepEqual(t, detail.Vin[0].Value, uint64(1))
+	ensure.DeepEqual(t, detail.Vin[0].Addr, issuer.String())
+	ensure.DeepEqual(t, detail.Vin[1].Value, uint64(90))
+	ensure.DeepEqual(t, detail.Vin[1].Addr, issuer.String())
+	ensure.DeepEqual(t, len(detail.Vout), 3)
+	ensure.DeepEqual(t, detail.Vout[0].Addr, receiver.String())
+	ensure.DeepEqual(t, detail.Vout[1].Addr, issuer.String())
+	ensure.DeepEqual(t, detail.Vout[2].Addr, "")
+	ensure.DeepEqual(t, detail.Fee, uint64(9))
+
+	// the coinbase spends nothing and pays no fee
+	coinbaseHash, _ := coinbase.TxHash()
+	resp, err = s.GetTransactionDetail(context.Background(), &rpcpb.GetTransactionDetailRequest{Hash: coinbaseHash.String()})
+	ensure.Nil(t, err)
+	ensure.True(t, resp.Detail.IsCoinbase)
+	ensure.DeepEqual(t, resp.Detail.Vin[0].Addr, "")
+	ensure.DeepEqual(t, resp.Detail.Fee, uint64(0))
+
+	// spending an output the prev tx doesn't have fails
+	transfer.Vin[1].PrevOutPoint.Index = 2
+	_, err = s.decodeTransaction(transfer)
+	ensure.NotNil(t, err)
+}
+
+func TestGetRawTransaction(t *testing.T) {
+	confirmed := &types.Transaction{
+		Vin:  []*types.TxIn{{PrevOutPoint: types.OutPoint{Hash: crypto.DoubleHashH([]byte("in"))}}},
+		Vout: []*corepb.TxOut{{Value: 100}},
+	}
+	block := &types.Block{Header: &types.BlockHeader{}, Txs: []*types.Transaction{confirmed}, Height: 5}
+	bc := &detailTestChain{block: block}
+	s := &txServer{server: &detailTestServer{chain: bc}}
+
+	// a tx on chain is located by its block, confirmed by it and those after
+	hash, _ := confirmed.TxHash()
+	resp, err := s.GetRawTransaction(context.Background(), &rpcpb.GetRawTransactionRequest{Hash: hash[:]})
+	ensure.Nil(t, err)
+	raw, _ := confirmed.Marshal()
+	ensure.DeepEqual(t, resp.Raw, raw)
+	ensure.DeepEqual(t, resp.BlockHash, block.BlockHash().String())
+	ensure.DeepEqual(t, resp.BlockHeight, uint32(5))
+	ensure.DeepEqual(t, resp.Confirmations, uint32(3))
+
+	// unconfirmed txs are in no block, and not found
+	unconfirmed := &types.Transaction{
+		Vin:  []*types.TxIn{{PrevOutPoint: types.OutPoint{Hash: *hash}}},
+		Vout: []*corepb.TxOut{{Value: 90}},
+	}
+	hash, _ = unconfirmed.TxHash()
+	resp, err = s.GetRawTransaction(context.Background(), &rpcpb.GetRawTransactionRequest{Hash: hash[:]})
+	ensure.DeepEqual(t, errorCode(err), rpcpb.ErrorCode_NOT_FOUND)
+	ensure.DeepEqual(t, resp.BlockHash, "")
+	ensure.DeepEqual(t, resp.Confirmations, uint32(0))
+
+	_, err = s.GetRawTransaction(context.Background(), &rpcpb.GetRawTransactionRequest{Hash: hash[1:]})
+	ensure.DeepEqual(t, errorCode(err), rpcpb.ErrorCode_INVALID_ARGUMENT)
+}
+
+// utxoTestChain holds utxos of addresses
+type utxoTestChain struct {
+	service.ChainReader
+	utxos map[types.OutPoint]*types.UtxoWrap
+}
+
+func (c *utxoTestChain) GetBlockHeight() uint32 {
+	return 10
+}
+
+func (c *utxoTestChain) LoadUtxoByAddress(addr types.Address) (map[types.OutPoint]*types.UtxoWrap, error) {
+	utxos := make(map[types.OutPoint]*types.UtxoWrap, len(c.utxos))
+	for out, utxo := range c.utxos {
+		utxos[out] = utxo
+	}
+	return utxos, nil
+}
+
+type utxoTestServer struct {
+	GRPCServer
+	chain *utxoTestChain
+}
+
+func (s *utxoTestServer) GetChainReader() service.ChainReader { return s.chain }
+func (s *utxoTestServer) GetTxHandler() service.TxHandler     { return &feeTestTxHandler{} }
+
+func TestCreateRawTransaction(t *testing.T) {
+	from, err := types.NewAddressPubKeyHash(append(make([]byte, 19), 1))
+	ensure.Nil(t, err)
+	to, err := types.NewAddressPubKeyHash(append(make([]byte, 19), 2))
+	ensure.Nil(t, err)
+	fromScript := *script.PayToPubKeyHashScript(from.Hash())
+	// fee of n inputs and an output at 1 box per byte, scriptSigs estimated
+	fee := func(inputs int) uint64 {
+		return uint64(inputs*p2pkhScriptSigLen + len(fromScript))
+	}
+	changeFee := uint64(len(fromScript))
+
+	tests := []struct {
+		name   string
+		values []uint64
+		amount uint64
+		// values of utxos spent in order, the fee and the change if any
+		spent  []uint64
+		fee    uint64
+		change uint64
+		err    error
+	}{
+		{"exact", []uint64{1000 + fee(1)}, 1000, []uint64{1000 + fee(1)}, fee(1), 0, nil},
+		{"change", []uint64{5000}, 1000, []uint64{5000}, fee(1) + changeFee, 5000 - 1000 - fee(1) - changeFee, nil},
+		// change not worth its own fee is left to miners
+		{"dust change", []uint64{1000 + fee(1) + changeFee - 1}, 1000, []uint64{1000 + fee(1) + changeFee - 1}, fee(1) + changeFee - 1, 0, nil},
+		{"insufficient", []uint64{500, 600}, 1000, nil, 0, 0, errNotEnoughBalance},
+		// the fee grows with inputs, each spent smallest first
+		{"fee per input", []uint64{2000, 300, 600}, 800, []uint64{300, 600, 2000}, fee(3) + changeFee, 2900 - 800 - fee(3) - changeFee, nil},
+		{"two inputs", []uint64{600, 700}, 1000, []uint64{600, 700}, fee(2) + changeFee, 1300 - 1000 - fee(2) - changeFee, nil},
+	}
+	for _, tc := range tests {
+		bc := &utxoTestChain{utxos: make(map[types.OutPoint]*types.UtxoWrap)}
+		for i, value := range tc.values {
+			out := types.OutPoint{Hash: crypto.DoubleHashH([]byte{byte(i)})}
+			bc.utxos[out] = &types.UtxoWrap{Output: &corepb.TxOut{Value: value, ScriptPubKey: fromScript}}
+		}
+		// utxos of other scripts, e.g. tokens, are not spent
+		token := types.OutPoint{Hash: crypto.DoubleHashH([]byte("token"))}
+		bc.utxos[token] = &types.UtxoWrap{Output: &corepb.TxOut{Value: 1, ScriptPubKey: []byte{0x6a}}}
+		s := &txServer{server: &utxoTestServer{chain: bc}}
+
+		resp, err := s.CreateRawTransaction(context.Background(), &rpcpb.CreateRawTransactionRequest{
+			From:    from.String(),
+			Outputs: []*rpcpb.TxOutTarget{{Addr: to.String(), Amount: tc.amount}},
+		})
+		ensure.DeepEqual(t, err, tc.err, tc.name)
+		if tc.err != nil {
+			ensure.DeepEqual(t, resp.Code, int32(rpcpb.ErrorCode_INSUFFICIENT_FUNDS), tc.name)
+			continue
+		}
+		var spent, in uint64
+		for i, utxo := range resp.Utxos {
+			ensure.DeepEqual(t, utxo.TxOut.Value, tc.spent[i], tc.name)
+			in += utxo.TxOut.Value
+		}
+		ensure.DeepEqual(t, len(resp.Utxos), len(tc.spent), tc.name)
+		ensure.DeepEqual(t, len(resp.Tx.Vin), len(tc.spent), tc.name)
+		ensure.DeepEqual(t, resp.Fee, tc.fee, tc.name)
+		ensure.DeepEqual(t, resp.Tx.Vout[0].Value, tc.amount, tc.name)
+		if tc.change > 0 {
+			ensure.DeepEqual(t, len(resp.Tx.Vout), 2, tc.name)
+			ensure.DeepEqual(t, resp.Tx.Vout[1].Value, tc.change, tc.name)
+			ensure.DeepEqual(t, resp.Tx.Vout[1].ScriptPubKey, fromScript, tc.name)
+		} else {
+			ensure.DeepEqual(t, len(resp.Tx.Vout), 1, tc.name)
+		}
+		for _, out := range resp.Tx.Vout {
+			spent += out.Value
+		}
+		ensure.DeepEqual(t, in, spent+resp.Fee, tc.name)
+		for _, txIn := range resp.Tx.Vin {
+			ensure.DeepEqual(t, txIn.Sequence, types.SequenceFinal, tc.name)
+		}
+	}
+}
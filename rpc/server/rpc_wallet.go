@@ -6,10 +6,22 @@ package rpc
 
 import (
 	"context"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"time"
 
+	"github.com/BOXFoundation/boxd/boxd/service"
+	"github.com/BOXFoundation/boxd/core/chain"
 	"github.com/BOXFoundation/boxd/core/pb"
 	"github.com/BOXFoundation/boxd/core/types"
 	"github.com/BOXFoundation/boxd/rpc/pb"
+	"github.com/BOXFoundation/boxd/script"
+	"github.com/BOXFoundation/boxd/wallet"
 )
 
 func registerWallet(s *Server) {
@@ -24,30 +36,942 @@ func init() {
 	)
 }
 
+const (
+	defaultListTxLimit = 20
+	maxListTxLimit     = 1000
+	txCursorLen        = 8
+	// unconfirmedTxHeight places unconfirmed txs after all blocks in history
+	unconfirmedTxHeight = math.MaxUint32
+)
+
+var (
+	errInvalidTxCursor = errors.New("invalid transaction cursor")
+	errWalletDisabled  = errors.New("node wallet is disabled")
+)
+
 type wltServer struct {
 	server GRPCServer
 }
 
 func (s *wltServer) ListTransactions(ctx context.Context, req *rpcpb.ListTransactionsRequest) (*rpcpb.ListTransactionsResponse, error) {
-	addr := &types.AddressPubKeyHash{}
-	if err := addr.SetString(req.Addr); err != nil {
-		return &rpcpb.ListTransactionsResponse{Code: -1, Message: "Invalid Address"}, err
+	records, watchOnly, cursor, err := s.listTxRecords(req)
+	if err != nil {
+		return &rpcpb.ListTransactionsResponse{Code: int32(errorCode(err)), Message: err.Error()}, err
+	}
+	limit := listTxLimit(req.Limit)
+	entries, last, _, err := txEntriesPage(req, records, watchOnly, cursor, limit)
+	if err != nil {
+		return &rpcpb.ListTransactionsResponse{Code: int32(errorCode(err)), Message: "Error Searching Transactions"}, err
+	}
+	var nextCursor string
+	if uint32(len(entries)) >= limit && last != nil {
+		nextCursor = encodeTxCursor(last)
+	}
+	return &rpcpb.ListTransactionsResponse{
+		Code:         0,
+		Message:      "Ok",
+		Count:        uint32(len(entries)),
+		Transactions: entries,
+		NextCursor:   nextCursor,
+	}, nil
+}
+
+// StreamTransactions sends the same history as ListTransactions in chunks of
+// limit entries, latest first. Each chunk carries the cursor to resume from
+// if the stream breaks. Send blocks when the client falls behind, so a large
+// history is never buffered as a whole
+func (s *wltServer) StreamTransactions(req *rpcpb.ListTransactionsRequest, stream rpcpb.WalletCommand_StreamTransactionsServer) error {
+	records, watchOnly, cursor, err := s.listTxRecords(req)
+	if err != nil {
+		stream.Send(&rpcpb.ListTransactionsResponse{Code: int32(errorCode(err)), Message: err.Error()})
+		return err
 	}
+	limit := listTxLimit(req.Limit)
+	for first := true; ; first = false {
+		if err := stream.Context().Err(); err != nil {
+			return err
+		}
+		entries, last, rest, err := txEntriesPage(req, records, watchOnly, cursor, limit)
+		if err != nil {
+			stream.Send(&rpcpb.ListTransactionsResponse{Code: int32(errorCode(err)), Message: "Error Searching Transactions"})
+			return err
+		}
+		var nextCursor string
+		if uint32(len(entries)) >= limit && last != nil {
+			nextCursor = encodeTxCursor(last)
+		}
+		// the last chunk may turn out empty when no remaining tx matches
+		if len(entries) > 0 || first {
+			if err := stream.Send(&rpcpb.ListTransactionsResponse{
+				Code:         0,
+				Message:      "Ok",
+				Count:        uint32(len(entries)),
+				Transactions: entries,
+				NextCursor:   nextCursor,
+			}); err != nil {
+				return err
+			}
+		}
+		if nextCursor == "" {
+			return nil
+		}
+		records, cursor = records[:rest], last
+	}
+}
+
+// listTxRecords returns records of addresses requested in chain order, the
+// set of watch-only ones among them and the cursor to list from
+func (s *wltServer) listTxRecords(req *rpcpb.ListTransactionsRequest) ([]*addrTxRecord, map[string]bool, *txCursor, error) {
+	var addrs []types.Address
+	var watchOnly map[string]bool
+	if req.Wallet {
+		var err error
+		if addrs, watchOnly, err = walletAddresses(s.server); err != nil {
+			return nil, nil, nil, err
+		}
+	} else {
+		addr := &types.AddressPubKeyHash{}
+		if err := addr.SetString(req.Addr); err != nil {
+			return nil, nil, nil, newRPCError(rpcpb.ErrorCode_INVALID_ADDRESS, fmt.Errorf("Invalid Address"))
+		}
+		addrs = []types.Address{addr}
+	}
+	var cursor *txCursor
+	if req.Cursor != "" {
+		c, err := decodeTxCursor(req.Cursor)
+		if err != nil {
+			return nil, nil, nil, newRPCError(rpcpb.ErrorCode_INVALID_ARGUMENT, fmt.Errorf("Invalid Cursor"))
+		}
+		cursor = c
+	}
+	var records []*addrTxRecord
+	for _, addr := range addrs {
+		addrRecords, err := s.addrTxRecords(addr)
+		if err != nil {
+			return nil, nil, nil, fmt.Errorf("Error Searching Transactions")
+		}
+		records = append(records, addrRecords...)
+	}
+	if len(addrs) > 1 {
+		sort.SliceStable(records, func(i, j int) bool {
+			hi, ii := records[i].position()
+			hj, ij := records[j].position()
+			if hi != hj {
+				return hi < hj
+			}
+			if ii != ij {
+				return ii < ij
+			}
+			return records[i].addr < records[j].addr
+		})
+	}
+	return records, watchOnly, cursor, nil
+}
+
+// txEntriesPage returns at most limit entries of records matching req before
+// cursor, the position of the last tx returned and the number of leading
+// records left unvisited
+func txEntriesPage(req *rpcpb.ListTransactionsRequest, records []*addrTxRecord, watchOnly map[string]bool,
+	cursor *txCursor, limit uint32) ([]*rpcpb.TransactionEntry, *txCursor, int, error) {
+	// walk from the newest record backwards so that history reads latest first.
+	// Records of the same tx are kept on one page since the cursor points to a tx
+	entries := make([]*rpcpb.TransactionEntry, 0, limit)
+	var last *txCursor
+	i := len(records) - 1
+	for ; i >= 0; i-- {
+		record := records[i]
+		height, index := record.position()
+		if uint32(len(entries)) >= limit && (height != last.height || index != last.index) {
+			break
+		}
+		if cursor != nil && !cursor.before(height, index) {
+			continue
+		}
+		if !matchTxFilter(req, record.TxRecord) {
+			continue
+		}
+		entry, err := newTransactionEntry(record)
+		if err != nil {
+			return nil, nil, 0, err
+		}
+		entry.WatchOnly = watchOnly[record.addr]
+		entries = append(entries, entry)
+		last = &txCursor{height: height, index: index}
+	}
+	return entries, last, i + 1, nil
+}
+
+// addrTxRecord is a tx record together with the address it's about, and
+// its entry in the wallet tx store if the address belongs to node wallet
+type addrTxRecord struct {
+	addr string
+	*types.TxRecord
+	wtx *wallet.WalletTx
+}
+
+// position returns where the record is listed. Unconfirmed txs follow all
+// blocks, ordered by the time they're seen
+func (r *addrTxRecord) position() (uint32, uint32) {
+	if r.wtx != nil && r.wtx.Status != wallet.TxConfirmed {
+		return unconfirmedTxHeight, uint32(r.wtx.Seen)
+	}
+	return r.Height, r.Index
+}
+
+// addrTxRecords returns txs of addr ordered by position. Txs of node wallet
+// addresses are read from the wallet tx store, others are searched on chain
+func (s *wltServer) addrTxRecords(addr types.Address) ([]*addrTxRecord, error) {
+	wltMgr := s.server.GetWalletManager()
+	if wltMgr == nil {
+		return chainTxRecords(s.server.GetChainReader(), addr)
+	}
+	if _, ok := wltMgr.GetAccount(addr.String()); !ok {
+		return chainTxRecords(s.server.GetChainReader(), addr)
+	}
+	store := wltMgr.TxStore()
+	if err := trackWalletAddr(s.server.GetChainReader(), store, addr.String()); err != nil {
+		return nil, err
+	}
+	wtxs := store.List([]string{addr.String()})
+	records := make([]*addrTxRecord, 0, len(wtxs))
+	for _, wtx := range wtxs {
+		record, err := wtx.Record()
+		if err != nil {
+			return nil, err
+		}
+		records = append(records, &addrTxRecord{addr: wtx.Addr, TxRecord: record, wtx: wtx})
+	}
+	return records, nil
+}
+
+func chainTxRecords(cr service.ChainReader, addr types.Address) ([]*addrTxRecord, error) {
 	logger.Infof("Search Transaction related to address: %s", addr.String())
-	txs, err := s.server.GetChainReader().GetTransactionsByAddr(addr)
+	addrRecords, err := cr.GetTransactionsByAddr(addr)
 	if err != nil {
-		return &rpcpb.ListTransactionsResponse{Code: -1, Message: "Error Searching Transactions"}, err
+		return nil, err
+	}
+	records := make([]*addrTxRecord, 0, len(addrRecords))
+	for _, record := range addrRecords {
+		records = append(records, &addrTxRecord{addr: addr.String(), TxRecord: record})
 	}
-	transactions := make([]*corepb.Transaction, len(txs))
-	for i, tx := range txs {
-		txProto, err := tx.ToProtoMessage()
+	return records, nil
+}
+
+// walletAddresses returns addresses of all accounts in node wallet, and
+// whether each of them is watch-only
+func walletAddresses(server GRPCServer) ([]types.Address, map[string]bool, error) {
+	wltMgr := server.GetWalletManager()
+	if wltMgr == nil {
+		return nil, nil, errWalletDisabled
+	}
+	accounts := wltMgr.ListAccounts()
+	addrs := make([]types.Address, 0, len(accounts))
+	watchOnly := make(map[string]bool, len(accounts))
+	for _, acc := range accounts {
+		addr, err := types.NewAddress(acc.Addr())
 		if err != nil {
-			return &rpcpb.ListTransactionsResponse{Code: -1, Message: "Error Searching Transactions"}, err
+			return nil, nil, err
 		}
-		transactions[i] = txProto.(*corepb.Transaction)
+		addrs = append(addrs, addr)
+		watchOnly[acc.Addr()] = acc.WatchOnly()
 	}
-	return &rpcpb.ListTransactionsResponse{Code: 0, Message: "Ok", Transactions: transactions}, nil
+	return addrs, watchOnly, nil
 }
+
 func (s *wltServer) GetTransactionCount(context.Context, *rpcpb.GetTransactionCountRequest) (*rpcpb.GetTransactionCountResponse, error) {
 	return &rpcpb.GetTransactionCountResponse{}, nil
 }
+
+func (s *wltServer) UnlockAccount(ctx context.Context, req *rpcpb.UnlockAccountRequest) (*rpcpb.BaseResponse, error) {
+	wltMgr := s.server.GetWalletManager()
+	if wltMgr == nil {
+		return &rpcpb.BaseResponse{Code: int32(rpcpb.ErrorCode_WALLET_DISABLED), Message: errWalletDisabled.Error()}, errWalletDisabled
+	}
+	timeout := time.Duration(req.Timeout) * time.Second
+	if err := wltMgr.UnlockAccount(req.Addr, req.Passphrase, timeout); err != nil {
+		return &rpcpb.BaseResponse{Code: int32(errorCode(err)), Message: err.Error()}, err
+	}
+	return &rpcpb.BaseResponse{Code: 0, Message: "ok"}, nil
+}
+
+func (s *wltServer) LockAccount(ctx context.Context, req *rpcpb.LockAccountRequest) (*rpcpb.BaseResponse, error) {
+	wltMgr := s.server.GetWalletManager()
+	if wltMgr == nil {
+		return &rpcpb.BaseResponse{Code: int32(rpcpb.ErrorCode_WALLET_DISABLED), Message: errWalletDisabled.Error()}, errWalletDisabled
+	}
+	if err := wltMgr.LockAccount(req.Addr); err != nil {
+		return &rpcpb.BaseResponse{Code: int32(errorCode(err)), Message: err.Error()}, err
+	}
+	return &rpcpb.BaseResponse{Code: 0, Message: "ok"}, nil
+}
+
+func (s *wltServer) DeriveAddress(ctx context.Context, req *rpcpb.DeriveAddressRequest) (*rpcpb.DeriveAddressResponse, error) {
+	wltMgr := s.server.GetWalletManager()
+	if wltMgr == nil {
+		return &rpcpb.DeriveAddressResponse{Code: int32(rpcpb.ErrorCode_WALLET_DISABLED), Message: errWalletDisabled.Error()}, errWalletDisabled
+	}
+	acc, err := wltMgr.DeriveAccount(req.Account, req.Change)
+	if err != nil {
+		return &rpcpb.DeriveAddressResponse{Code: int32(errorCode(err)), Message: err.Error()}, err
+	}
+	return &rpcpb.DeriveAddressResponse{
+		Code:    0,
+		Message: "ok",
+		Addr:    acc.Addr(),
+		Path:    acc.HDPath().String(),
+	}, nil
+}
+
+func (s *wltServer) ScanHDWallet(ctx context.Context, req *rpcpb.ScanHDWalletRequest) (*rpcpb.ScanHDWalletResponse, error) {
+	wltMgr := s.server.GetWalletManager()
+	if wltMgr == nil {
+		return &rpcpb.ScanHDWalletResponse{Code: int32(rpcpb.ErrorCode_WALLET_DISABLED), Message: errWalletDisabled.Error()}, errWalletDisabled
+	}
+	return s.rescanHDWallet(wltMgr, req.GapLimit)
+}
+
+func (s *wltServer) ImportMnemonic(ctx context.Context, req *rpcpb.ImportMnemonicRequest) (*rpcpb.ScanHDWalletResponse, error) {
+	wltMgr := s.server.GetWalletManager()
+	if wltMgr == nil {
+		return &rpcpb.ScanHDWalletResponse{Code: int32(rpcpb.ErrorCode_WALLET_DISABLED), Message: errWalletDisabled.Error()}, errWalletDisabled
+	}
+	if err := wltMgr.ImportMnemonic(req.Mnemonic, req.MnemonicPassphrase, req.Passphrase); err != nil {
+		return &rpcpb.ScanHDWalletResponse{Code: int32(errorCode(err)), Message: err.Error()}, err
+	}
+	return s.rescanHDWallet(wltMgr, req.GapLimit)
+}
+
+func (s *wltServer) ChangePassphrase(ctx context.Context, req *rpcpb.ChangePassphraseRequest) (*rpcpb.BaseResponse, error) {
+	wltMgr := s.server.GetWalletManager()
+	if wltMgr == nil {
+		return &rpcpb.BaseResponse{Code: int32(rpcpb.ErrorCode_WALLET_DISABLED), Message: errWalletDisabled.Error()}, errWalletDisabled
+	}
+	if err := wltMgr.ChangePassphrase(req.Addr, req.OldPassphrase, req.NewPassphrase); err != nil {
+		return &rpcpb.BaseResponse{Code: int32(errorCode(err)), Message: err.Error()}, err
+	}
+	return &rpcpb.BaseResponse{Code: 0, Message: "ok"}, nil
+}
+
+func (s *wltServer) ImportAddress(ctx context.Context, req *rpcpb.ImportAddressRequest) (*rpcpb.BaseResponse, error) {
+	wltMgr := s.server.GetWalletManager()
+	if wltMgr == nil {
+		return &rpcpb.BaseResponse{Code: int32(rpcpb.ErrorCode_WALLET_DISABLED), Message: errWalletDisabled.Error()}, errWalletDisabled
+	}
+	if _, err := wltMgr.ImportAddress(req.Addr); err != nil {
+		return &rpcpb.BaseResponse{Code: int32(errorCode(err)), Message: err.Error()}, err
+	}
+	return &rpcpb.BaseResponse{Code: 0, Message: "ok"}, nil
+}
+
+func (s *wltServer) ListAccounts(ctx context.Context, req *rpcpb.ListAccountsRequest) (*rpcpb.ListAccountsResponse, error) {
+	wltMgr := s.server.GetWalletManager()
+	if wltMgr == nil {
+		return &rpcpb.ListAccountsResponse{Code: int32(rpcpb.ErrorCode_WALLET_DISABLED), Message: errWalletDisabled.Error()}, errWalletDisabled
+	}
+	accounts := wltMgr.FindAccountsByLabel(req.Label)
+	infos := make([]*rpcpb.AccountInfo, 0, len(accounts))
+	for _, acc := range accounts {
+		info := &rpcpb.AccountInfo{Addr: acc.Addr(), WatchOnly: acc.WatchOnly()}
+		if meta, ok := wltMgr.AccountMeta(acc.Addr()); ok {
+			info.Label, info.Created, info.Notes = meta.Label, meta.Created, meta.Notes
+		}
+		if p := acc.HDPath(); p != nil {
+			info.HdPath = p.String()
+		}
+		infos = append(infos, info)
+	}
+	return &rpcpb.ListAccountsResponse{Code: 0, Message: "ok", Accounts: infos}, nil
+}
+
+func (s *wltServer) SetAccountLabel(ctx context.Context, req *rpcpb.SetAccountLabelRequest) (*rpcpb.BaseResponse, error) {
+	wltMgr := s.server.GetWalletManager()
+	if wltMgr == nil {
+		return &rpcpb.BaseResponse{Code: int32(rpcpb.ErrorCode_WALLET_DISABLED), Message: errWalletDisabled.Error()}, errWalletDisabled
+	}
+	if err := wltMgr.SetAccountLabel(req.Addr, req.Label); err != nil {
+		return &rpcpb.BaseResponse{Code: int32(errorCode(err)), Message: err.Error()}, err
+	}
+	return &rpcpb.BaseResponse{Code: 0, Message: "ok"}, nil
+}
+
+func (s *wltServer) SetAccountNote(ctx context.Context, req *rpcpb.SetAccountNoteRequest) (*rpcpb.BaseResponse, error) {
+	wltMgr := s.server.GetWalletManager()
+	if wltMgr == nil {
+		return &rpcpb.BaseResponse{Code: int32(rpcpb.ErrorCode_WALLET_DISABLED), Message: errWalletDisabled.Error()}, errWalletDisabled
+	}
+	if err := wltMgr.SetAccountNote(req.Addr, req.Key, req.Value); err != nil {
+		return &rpcpb.BaseResponse{Code: int32(errorCode(err)), Message: err.Error()}, err
+	}
+	return &rpcpb.BaseResponse{Code: 0, Message: "ok"}, nil
+}
+
+// rescanHDWallet looks for used addresses of hd wallet on chain, and sums up
+// balances of all hd addresses
+func (s *wltServer) SetTransactionLabel(ctx context.Context, req *rpcpb.SetTransactionLabelRequest) (*rpcpb.BaseResponse, error) {
+	wltMgr := s.server.GetWalletManager()
+	if wltMgr == nil {
+		return &rpcpb.BaseResponse{Code: int32(rpcpb.ErrorCode_WALLET_DISABLED), Message: errWalletDisabled.Error()}, errWalletDisabled
+	}
+	if err := wltMgr.TxStore().SetTxLabel(req.Hash, req.Label); err != nil {
+		return &rpcpb.BaseResponse{Code: int32(errorCode(err)), Message: err.Error()}, err
+	}
+	return &rpcpb.BaseResponse{Code: 0, Message: "ok"}, nil
+}
+
+func (s *wltServer) rescanHDWallet(wltMgr *wallet.Manager, gapLimit uint32) (*rpcpb.ScanHDWalletResponse, error) {
+	chain := s.server.GetChainReader()
+	accounts, err := wltMgr.ScanHDWallet(gapLimit, func(addr types.Address) (bool, error) {
+		records, err := chain.GetTransactionsByAddr(addr)
+		return len(records) > 0, err
+	})
+	if err != nil {
+		return &rpcpb.ScanHDWalletResponse{Code: int32(errorCode(err)), Message: err.Error()}, err
+	}
+	addrs := make([]string, 0, len(accounts))
+	for _, acc := range accounts {
+		addrs = append(addrs, acc.Addr())
+	}
+	var balance uint64
+	for _, acc := range wltMgr.ListAccounts() {
+		if acc.HDPath() == nil {
+			continue
+		}
+		addr, err := types.NewAddress(acc.Addr())
+		if err != nil {
+			return &rpcpb.ScanHDWalletResponse{Code: int32(errorCode(err)), Message: err.Error()}, err
+		}
+		utxos, err := chain.LoadUtxoByAddress(addr)
+		if err != nil {
+			return &rpcpb.ScanHDWalletResponse{Code: int32(errorCode(err)), Message: err.Error()}, err
+		}
+		for _, utxo := range utxos {
+			balance += utxo.Output.Value
+		}
+	}
+	return &rpcpb.ScanHDWalletResponse{Code: 0, Message: "ok", Addrs: addrs, Balance: balance}, nil
+}
+
+// listTxLimit returns the number of txs listed per page for limit
+// requested, the default for 0 and at most maxListTxLimit
+func listTxLimit(limit uint32) uint32 {
+	if limit == 0 {
+		return defaultListTxLimit
+	}
+	if limit > maxListTxLimit {
+		return maxListTxLimit
+	}
+	return limit
+}
+
+// txCursor marks the position of the last tx returned by ListTransactions
+type txCursor struct {
+	height uint32
+	index  uint32
+}
+
+// before returns whether the position is older than the cursor position
+func (c *txCursor) before(height, index uint32) bool {
+	return height < c.height || (height == c.height && index < c.index)
+}
+
+func encodeTxCursor(c *txCursor) string {
+	buf := make([]byte, txCursorLen)
+	binary.BigEndian.PutUint32(buf, c.height)
+	binary.BigEndian.PutUint32(buf[4:], c.index)
+	return base64.RawURLEncoding.EncodeToString(buf)
+}
+
+func decodeTxCursor(s string) (*txCursor, error) {
+	buf, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil || len(buf) != txCursorLen {
+		return nil, errInvalidTxCursor
+	}
+	return &txCursor{
+		height: binary.BigEndian.Uint32(buf),
+		index:  binary.BigEndian.Uint32(buf[4:]),
+	}, nil
+}
+
+func matchTxFilter(req *rpcpb.ListTransactionsRequest, record *types.TxRecord) bool {
+	switch req.Direction {
+	case rpcpb.TxDirection_SENT:
+		if !record.IsSent {
+			return false
+		}
+	case rpcpb.TxDirection_RECEIVED:
+		if record.IsSent {
+			return false
+		}
+	}
+	if req.StartTime > 0 && record.TimeStamp < req.StartTime {
+		return false
+	}
+	if req.EndTime > 0 && record.TimeStamp > req.EndTime {
+		return false
+	}
+	if req.TokenOnly && !record.HasToken {
+		return false
+	}
+	return record.Amount() >= req.MinAmount
+}
+
+func newTransactionEntry(record *addrTxRecord) (*rpcpb.TransactionEntry, error) {
+	txProto, err := record.Tx.ToProtoMessage()
+	if err != nil {
+		return nil, err
+	}
+	hash, err := record.Tx.TxHash()
+	if err != nil {
+		return nil, err
+	}
+	direction := rpcpb.TxDirection_RECEIVED
+	if record.IsSent {
+		direction = rpcpb.TxDirection_SENT
+	}
+	entry := &rpcpb.TransactionEntry{
+		Tx:          txProto.(*corepb.Transaction),
+		Hash:        hash.String(),
+		BlockHash:   record.BlockHash.String(),
+		BlockHeight: record.Height,
+		Timestamp:   record.TimeStamp,
+		Direction:   direction,
+		Amount:      record.Amount(),
+		HasToken:    record.HasToken,
+		Addr:        record.addr,
+	}
+	if record.wtx != nil {
+		entry.Fee = record.wtx.Fee
+		entry.Label = record.wtx.Label
+		entry.Status = txStatus(record.wtx.Status)
+		entry.ReplacedBy = record.wtx.ReplacedBy
+		if record.wtx.Status != wallet.TxConfirmed {
+			entry.BlockHash = ""
+		}
+	}
+	return entry, nil
+}
+
+func txStatus(status wallet.TxStatus) rpcpb.TxStatus {
+	switch status {
+	case wallet.TxPending:
+		return rpcpb.TxStatus_PENDING
+	case wallet.TxConflicted:
+		return rpcpb.TxStatus_CONFLICTED
+	case wallet.TxReplaced:
+		return rpcpb.TxStatus_REPLACED
+	}
+	return rpcpb.TxStatus_CONFIRMED
+}
+
+const defaultConsolidateInputs = 100
+
+func (s *wltServer) ConsolidateUtxos(ctx context.Context, req *rpcpb.ConsolidateUtxosRequest) (*rpcpb.ConsolidateUtxosResponse, error) {
+	wltMgr := s.server.GetWalletManager()
+	if wltMgr == nil {
+		return &rpcpb.ConsolidateUtxosResponse{Code: int32(rpcpb.ErrorCode_WALLET_DISABLED), Message: errWalletDisabled.Error()}, errWalletDisabled
+	}
+	from, err := types.NewAddress(req.Addr)
+	if err != nil {
+		return &rpcpb.ConsolidateUtxosResponse{Code: int32(errorCode(err)), Message: err.Error()}, err
+	}
+	to := from
+	if req.ToAddr != "" {
+		if to, err = types.NewAddress(req.ToAddr); err != nil {
+			return &rpcpb.ConsolidateUtxosResponse{Code: int32(errorCode(err)), Message: err.Error()}, err
+		}
+	}
+	var account *wallet.Account
+	if !req.DryRun {
+		var ok bool
+		if account, ok = wltMgr.UnlockedAccount(from.String()); !ok {
+			err := fmt.Errorf("Account %s is not managed or still locked", from)
+			return &rpcpb.ConsolidateUtxosResponse{Code: int32(errorCode(err)), Message: err.Error()}, err
+		}
+	}
+	feePerByte := req.FeePerByte
+	if feePerByte == 0 {
+		feePerByte = defaultFeePerByte
+	}
+	maxInputs := int(req.MaxInputs)
+	if maxInputs == 0 {
+		maxInputs = defaultConsolidateInputs
+	}
+	if maxInputs < 2 {
+		err := fmt.Errorf("At least 2 inputs per transaction are needed to consolidate")
+		return &rpcpb.ConsolidateUtxosResponse{Code: int32(errorCode(err)), Message: err.Error()}, err
+	}
+
+	txs := &txServer{server: s.server}
+	utxos, err := txs.loadSpendableUtxos(from)
+	if err != nil {
+		return &rpcpb.ConsolidateUtxosResponse{Code: int32(errorCode(err)), Message: err.Error()}, err
+	}
+	nextHeight := s.server.GetChainReader().GetBlockHeight() + 1
+	for out, utxo := range utxos {
+		// only mature plain box utxos below threshold are swept
+		if utxo.IsSpent || !script.NewScriptFromBytes(utxo.Output.ScriptPubKey).IsPayToPubKeyHash() ||
+			(req.Threshold > 0 && utxo.Value() >= req.Threshold) ||
+			(utxo.IsCoinBase && nextHeight-utxo.BlockHeight < chain.CoinbaseMaturity) {
+			delete(utxos, out)
+		}
+	}
+	toScript := *script.PayToPubKeyHashScript(to.Hash())
+	batches, dust := planConsolidation(utxos, feePerByte, maxInputs, len(toScript))
+	if len(batches) == 0 {
+		err := fmt.Errorf("No utxos to consolidate, %d dust utxos left out", dust)
+		return &rpcpb.ConsolidateUtxosResponse{Code: int32(errorCode(err)), Message: err.Error()}, err
+	}
+
+	res := &rpcpb.ConsolidateUtxosResponse{Code: 0, Message: "ok", Dust: uint32(dust)}
+	for _, batch := range batches {
+		tx := &types.Transaction{Vout: []*corepb.TxOut{{Value: batch.total - batch.fee, ScriptPubKey: toScript}}}
+		for _, out := range batch.outPoints {
+			tx.Vin = append(tx.Vin, &types.TxIn{PrevOutPoint: out, Sequence: types.SequenceFinal})
+		}
+		if !req.DryRun {
+			if err := signAccountInputs(tx, utxos, account); err != nil {
+				return &rpcpb.ConsolidateUtxosResponse{Code: int32(errorCode(err)), Message: err.Error()}, err
+			}
+			if err := relayTx(s.server, tx); err != nil {
+				// txs already sent are reported along with the error
+				res.Code, res.Message = int32(errorCode(err)), err.Error()
+				return res, err
+			}
+		}
+		hash, err := tx.CalcTxHash()
+		if err != nil {
+			return &rpcpb.ConsolidateUtxosResponse{Code: int32(errorCode(err)), Message: err.Error()}, err
+		}
+		msg, err := tx.ToProtoMessage()
+		if err != nil {
+			return &rpcpb.ConsolidateUtxosResponse{Code: int32(errorCode(err)), Message: err.Error()}, err
+		}
+		res.Txs = append(res.Txs, &rpcpb.ConsolidationTx{
+			Hash:   hash.String(),
+			Inputs: uint32(len(batch.outPoints)),
+			Value:  batch.total - batch.fee,
+			Fee:    batch.fee,
+			Tx:     msg.(*corepb.Transaction),
+		})
+	}
+	return res, nil
+}
+
+// consolidation is a batch of utxos swept into a single output
+type consolidation struct {
+	outPoints []types.OutPoint
+	total     uint64
+	fee       uint64
+}
+
+// planConsolidation batches utxos, smallest first, into consolidations of at
+// most maxInputs inputs. Utxos costing more fee to spend than they are worth
+// are left out as dust, whose number is returned. A batch of a single utxo
+// left at last is not worth a transaction and skipped
+func planConsolidation(utxos map[types.OutPoint]*types.UtxoWrap, feePerByte uint64,
+	maxInputs, outScriptLen int) ([]*consolidation, int) {
+
+	inputFee := uint64(p2pkhScriptSigLen) * feePerByte
+	outPoints := make([]types.OutPoint, 0, len(utxos))
+	dust := 0
+	for out, utxo := range utxos {
+		if utxo.Value() <= inputFee {
+			dust++
+			continue
+		}
+		outPoints = append(outPoints, out)
+	}
+	sort.Slice(outPoints, func(i, j int) bool {
+		vi, vj := utxos[outPoints[i]].Value(), utxos[outPoints[j]].Value()
+		if vi != vj {
+			return vi < vj
+		}
+		if outPoints[i].Hash != outPoints[j].Hash {
+			return outPoints[i].Hash.String() < outPoints[j].Hash.String()
+		}
+		return outPoints[i].Index < outPoints[j].Index
+	})
+
+	var batches []*consolidation
+	for start := 0; start < len(outPoints); start += maxInputs {
+		end := start + maxInputs
+		if end > len(outPoints) {
+			end = len(outPoints)
+		}
+		if end-start < 2 {
+			break
+		}
+		batch := &consolidation{
+			outPoints: outPoints[start:end],
+			fee:       uint64((end-start)*p2pkhScriptSigLen+outScriptLen) * feePerByte,
+		}
+		for _, out := range batch.outPoints {
+			batch.total += utxos[out].Value()
+		}
+		if batch.total <= batch.fee {
+			continue
+		}
+		batches = append(batches, batch)
+	}
+	return batches, dust
+}
+
+// signAccountInputs signs all inputs of tx spending utxos of account
+func signAccountInputs(tx *types.Transaction, utxos map[types.OutPoint]*types.UtxoWrap, account *wallet.Account) error {
+	for txInIdx, txIn := range tx.Vin {
+		prevScriptPubKey := utxos[txIn.PrevOutPoint].Output.ScriptPubKey
+		sigHash, err := script.CalcTxHashForSig(prevScriptPubKey, tx, txInIdx)
+		if err != nil {
+			return err
+		}
+		sig, err := account.Sign(sigHash)
+		if err != nil {
+			return err
+		}
+		txIn.ScriptSig = *script.SignatureScript(sig, account.PublicKey())
+	}
+	return nil
+}
+
+// tokenOutputValue is the box value carried by each token output
+const tokenOutputValue = 1
+
+var errNotEnoughBalance = errors.New("Not enough balance")
+
+func (s *wltServer) IssueToken(ctx context.Context, req *rpcpb.IssueTokenRequest) (*rpcpb.TokenTxResponse, error) {
+	wltMgr := s.server.GetWalletManager()
+	if wltMgr == nil {
+		return &rpcpb.TokenTxResponse{Code: int32(rpcpb.ErrorCode_WALLET_DISABLED), Message: errWalletDisabled.Error()}, errWalletDisabled
+	}
+	if req.Name == "" || req.TotalSupply == 0 {
+		err := fmt.Errorf("Token name and total supply are required")
+		return &rpcpb.TokenTxResponse{Code: int32(errorCode(err)), Message: err.Error()}, err
+	}
+	from, account, err := unlockedSender(wltMgr, req.Addr)
+	if err != nil {
+		return &rpcpb.TokenTxResponse{Code: int32(errorCode(err)), Message: err.Error()}, err
+	}
+	to := from
+	if req.ToAddr != "" {
+		if to, err = types.NewAddress(req.ToAddr); err != nil {
+			return &rpcpb.TokenTxResponse{Code: int32(errorCode(err)), Message: err.Error()}, err
+		}
+	}
+	txs := &txServer{server: s.server}
+	utxos, err := txs.loadSpendableUtxos(from)
+	if err != nil {
+		return &rpcpb.TokenTxResponse{Code: int32(errorCode(err)), Message: err.Error()}, err
+	}
+
+	// the issue output goes first, so that the token is identified by output 0
+	issueScript := script.IssueTokenScript(to.Hash(), &script.IssueParams{Name: req.Name, TotalSupply: req.TotalSupply})
+	tx := &types.Transaction{Vout: []*corepb.TxOut{{Value: tokenOutputValue, ScriptPubKey: *issueScript}}}
+	return s.sendTokenTx(tx, utxos, account, req.FeePerByte)
+}
+
+func (s *wltServer) TransferToken(ctx context.Context, req *rpcpb.TransferTokenRequest) (*rpcpb.TokenTxResponse, error) {
+	wltMgr := s.server.GetWalletManager()
+	if wltMgr == nil {
+		return &rpcpb.TokenTxResponse{Code: int32(rpcpb.ErrorCode_WALLET_DISABLED), Message: errWalletDisabled.Error()}, errWalletDisabled
+	}
+	if req.Amount == 0 {
+		err := fmt.Errorf("Token amount is required")
+		return &rpcpb.TokenTxResponse{Code: int32(errorCode(err)), Message: err.Error()}, err
+	}
+	token := types.OutPoint{Index: req.TokenIndex}
+	if err := token.Hash.SetString(req.TokenHash); err != nil {
+		return &rpcpb.TokenTxResponse{Code: int32(errorCode(err)), Message: err.Error()}, err
+	}
+	from, account, err := unlockedSender(wltMgr, req.Addr)
+	if err != nil {
+		return &rpcpb.TokenTxResponse{Code: int32(errorCode(err)), Message: err.Error()}, err
+	}
+	to, err := types.NewAddress(req.ToAddr)
+	if err != nil {
+		return &rpcpb.TokenTxResponse{Code: int32(errorCode(err)), Message: err.Error()}, err
+	}
+	txs := &txServer{server: s.server}
+	utxos, err := txs.loadSpendableUtxos(from)
+	if err != nil {
+		return &rpcpb.TokenTxResponse{Code: int32(errorCode(err)), Message: err.Error()}, err
+	}
+
+	tx := &types.Transaction{}
+	tokenIn, err := addTokenInputs(tx, utxos, token, req.Amount)
+	if err != nil {
+		return &rpcpb.TokenTxResponse{Code: int32(errorCode(err)), Message: err.Error()}, err
+	}
+	tx.Vout = append(tx.Vout, &corepb.TxOut{
+		Value:        tokenOutputValue,
+		ScriptPubKey: *script.TransferTokenScript(to.Hash(), &script.TransferParams{TokenID: script.TokenID{OutPoint: token}, Amount: req.Amount}),
+	})
+	if tokenIn > req.Amount {
+		// token change goes back to the sender
+		tx.Vout = append(tx.Vout, &corepb.TxOut{
+			Value:        tokenOutputValue,
+			ScriptPubKey: *script.TransferTokenScript(from.Hash(), &script.TransferParams{TokenID: script.TokenID{OutPoint: token}, Amount: tokenIn - req.Amount}),
+		})
+	}
+	return s.sendTokenTx(tx, utxos, account, req.FeePerByte)
+}
+
+// unlockedSender returns the address and account of addr, which must be
+// unlocked in node wallet
+func unlockedSender(wltMgr *wallet.Manager, addr string) (types.Address, *wallet.Account, error) {
+	from, err := types.NewAddress(addr)
+	if err != nil {
+		return nil, nil, err
+	}
+	account, ok := wltMgr.UnlockedAccount(from.String())
+	if !ok {
+		return nil, nil, newRPCError(rpcpb.ErrorCode_ACCOUNT_LOCKED, fmt.Errorf("Account %s is not managed or still locked", from))
+	}
+	return from, account, nil
+}
+
+// sendTokenTx funds tx with box utxos of account, then signs and sends it
+func (s *wltServer) sendTokenTx(tx *types.Transaction, utxos map[types.OutPoint]*types.UtxoWrap,
+	account *wallet.Account, feePerByte uint64) (*rpcpb.TokenTxResponse, error) {
+	fee, err := fundAndSendTx(s.server, tx, utxos, account, feePerByte)
+	if err != nil {
+		return &rpcpb.TokenTxResponse{Code: int32(errorCode(err)), Message: err.Error()}, err
+	}
+	hash, err := tx.CalcTxHash()
+	if err != nil {
+		return &rpcpb.TokenTxResponse{Code: int32(errorCode(err)), Message: err.Error()}, err
+	}
+	msg, err := tx.ToProtoMessage()
+	if err != nil {
+		return &rpcpb.TokenTxResponse{Code: int32(errorCode(err)), Message: err.Error()}, err
+	}
+	return &rpcpb.TokenTxResponse{
+		Code:    0,
+		Message: "ok",
+		Hash:    hash.String(),
+		Fee:     fee,
+		Tx:      msg.(*corepb.Transaction),
+	}, nil
+}
+
+// fundAndSendTx funds tx with box utxos of account, then signs and relays it.
+// Node fee price is used if feePerByte is 0. The fee is returned
+func fundAndSendTx(server GRPCServer, tx *types.Transaction, utxos map[types.OutPoint]*types.UtxoWrap,
+	account *wallet.Account, feePerByte uint64) (uint64, error) {
+	if feePerByte == 0 {
+		feePerByte = defaultFeePerByte
+	}
+	changeScript := *script.PayToPubKeyHashScript(account.PubKeyHash())
+	fee, err := fundTx(tx, utxos, changeScript, feePerByte)
+	if err != nil {
+		return 0, err
+	}
+	if err := signAccountInputs(tx, utxos, account); err != nil {
+		return 0, err
+	}
+	if err := server.GetTxHandler().ProcessTx(tx, true /* relay */); err != nil {
+		return 0, err
+	}
+	recordLocalTx(server, tx)
+	return fee, nil
+}
+
+// addTokenInputs adds utxos of token to tx, largest first, until they hold
+// amount tokens. The tokens held by the inputs added are returned
+func addTokenInputs(tx *types.Transaction, utxos map[types.OutPoint]*types.UtxoWrap,
+	token types.OutPoint, amount uint64) (uint64, error) {
+	outPoints := make([]types.OutPoint, 0)
+	amounts := make(map[types.OutPoint]uint64)
+	for out, utxo := range utxos {
+		if utxo.IsSpent {
+			continue
+		}
+		if id, value, ok := getTokenInfo(out, utxo); ok && id == token {
+			outPoints = append(outPoints, out)
+			amounts[out] = value
+		}
+	}
+	sort.Slice(outPoints, func(i, j int) bool {
+		return amounts[outPoints[i]] > amounts[outPoints[j]]
+	})
+	var total uint64
+	for _, out := range outPoints {
+		if total >= amount {
+			break
+		}
+		tx.Vin = append(tx.Vin, &types.TxIn{PrevOutPoint: out, Sequence: types.SequenceFinal})
+		total += amounts[out]
+	}
+	if total < amount {
+		return 0, fmt.Errorf("Not enough token balance")
+	}
+	return total, nil
+}
+
+// fundTx adds plain box utxos to tx, smallest first, until they cover its
+// outputs and fee. Inputs already in tx are counted in. Change worth more
+// than its own fee is paid to changeScript. The fee is returned
+func fundTx(tx *types.Transaction, utxos map[types.OutPoint]*types.UtxoWrap,
+	changeScript []byte, feePerByte uint64) (uint64, error) {
+	var in, out uint64
+	var scriptBytes int
+	spent := make(map[types.OutPoint]bool, len(tx.Vin))
+	for _, txIn := range tx.Vin {
+		in += utxos[txIn.PrevOutPoint].Value()
+		spent[txIn.PrevOutPoint] = true
+	}
+	for _, txOut := range tx.Vout {
+		out += txOut.Value
+		scriptBytes += len(txOut.ScriptPubKey)
+	}
+	outPoints := make([]types.OutPoint, 0, len(utxos))
+	for op, utxo := range utxos {
+		if spent[op] || utxo.IsSpent || !script.NewScriptFromBytes(utxo.Output.ScriptPubKey).IsPayToPubKeyHash() {
+			continue
+		}
+		outPoints = append(outPoints, op)
+	}
+	sort.Slice(outPoints, func(i, j int) bool {
+		return utxos[outPoints[i]].Value() < utxos[outPoints[j]].Value()
+	})
+
+	for i := 0; ; i++ {
+		// fee is charged on script bytes, with scriptSigs not signed yet estimated
+		fee := uint64(len(tx.Vin)*p2pkhScriptSigLen+scriptBytes) * feePerByte
+		feeWithChange := fee + uint64(len(changeScript))*feePerByte
+		if in > out+feeWithChange {
+			tx.Vout = append(tx.Vout, &corepb.TxOut{Value: in - out - feeWithChange, ScriptPubKey: changeScript})
+			return feeWithChange, nil
+		} else if len(tx.Vin) > 0 && in >= out+fee {
+			// change is not worth its own fee, leave the remainder to miners
+			return in - out, nil
+		}
+		if i == len(outPoints) {
+			return 0, errNotEnoughBalance
+		}
+		tx.Vin = append(tx.Vin, &types.TxIn{PrevOutPoint: outPoints[i], Sequence: types.SequenceFinal})
+		in += utxos[outPoints[i]].Value()
+	}
+}
+
+func (s *wltServer) CreateMultisig(ctx context.Context, req *rpcpb.CreateMultisigRequest) (*rpcpb.CreateMultisigResponse, error) {
+	// participants given by hex public keys need no wallet
+	var lookup func(string) (*wallet.Account, bool)
+	if wltMgr := s.server.GetWalletManager(); wltMgr != nil {
+		lookup = wltMgr.UnlockedAccount
+	}
+	multisig, err := wallet.NewMultisig(int(req.M), req.Participants, lookup)
+	if err != nil {
+		return &rpcpb.CreateMultisigResponse{Code: int32(errorCode(err)), Message: err.Error()}, err
+	}
+	pubKeys := make([]string, 0, multisig.Keys.N())
+	for _, pubKey := range multisig.Keys.PubKeys {
+		pubKeys = append(pubKeys, hex.EncodeToString(pubKey.Serialize()))
+	}
+	return &rpcpb.CreateMultisigResponse{
+		Code:         0,
+		Message:      "ok",
+		Addr:         multisig.Address.String(),
+		RedeemScript: multisig.RedeemScript,
+		PubKeys:      pubKeys,
+	}, nil
+}
@@ -0,0 +1,152 @@
+// Copyright (c) 2018 ContentBox Authors.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package rpc
+
+import (
+	"testing"
+
+	"github.com/BOXFoundation/boxd/core/pb"
+	"github.com/BOXFoundation/boxd/core/types"
+	"github.com/BOXFoundation/boxd/crypto"
+	"github.com/BOXFoundation/boxd/rpc/pb"
+	"github.com/BOXFoundation/boxd/script"
+	"github.com/facebookgo/ensure"
+)
+
+func TestPlanConsolidation(t *testing.T) {
+	utxos := make(map[types.OutPoint]*types.UtxoWrap)
+	// 2 dust utxos not worth their scriptSigs, and 5 spendable ones
+	for i, value := range []uint64{10, 107, 1000, 500, 2000, 300, 400} {
+		out := types.OutPoint{Hash: crypto.DoubleHashH([]byte{byte(i)})}
+		utxos[out] = &types.UtxoWrap{Output: &corepb.TxOut{Value: value}}
+	}
+
+	batches, dust := planConsolidation(utxos, 1, 2, 25)
+	ensure.DeepEqual(t, dust, 2)
+	// smallest first, the single utxo left at last is skipped
+	ensure.DeepEqual(t, len(batches), 2)
+	ensure.DeepEqual(t, batches[0].total, uint64(300+400))
+	ensure.DeepEqual(t, batches[0].fee, uint64(2*p2pkhScriptSigLen+25))
+	ensure.DeepEqual(t, batches[1].total, uint64(500+1000))
+
+	batches, dust = planConsolidation(utxos, 1, 100, 25)
+	ensure.DeepEqual(t, dust, 2)
+	ensure.DeepEqual(t, len(batches), 1)
+	ensure.DeepEqual(t, len(batches[0].outPoints), 5)
+	ensure.DeepEqual(t, batches[0].total, uint64(4200))
+
+	// all utxos are dust at a high fee rate
+	batches, dust = planConsolidation(utxos, 100, 100, 25)
+	ensure.DeepEqual(t, dust, 7)
+	ensure.DeepEqual(t, len(batches), 0)
+}
+
+func TestFundTokenTx(t *testing.T) {
+	owner := []byte("01234567890123456789")
+	token := types.OutPoint{Hash: crypto.DoubleHashH([]byte("token"))}
+	transfer := func(amount uint64) []byte {
+		return *script.TransferTokenScript(owner, &script.TransferParams{TokenID: script.TokenID{OutPoint: token}, Amount: amount})
+	}
+	p2pkh := *script.PayToPubKeyHashScript(owner)
+	utxos := make(map[types.OutPoint]*types.UtxoWrap)
+	for i, out := range []*corepb.TxOut{
+		{Value: tokenOutputValue, ScriptPubKey: transfer(30)},
+		{Value: tokenOutputValue, ScriptPubKey: transfer(50)},
+		{Value: tokenOutputValue, ScriptPubKey: transfer(5)},
+		{Value: 100, ScriptPubKey: p2pkh},
+		{Value: 1000, ScriptPubKey: p2pkh},
+	} {
+		utxos[types.OutPoint{Hash: crypto.DoubleHashH([]byte{byte(i)})}] = &types.UtxoWrap{Output: out}
+	}
+
+	// largest token utxos first
+	tx := &types.Transaction{}
+	total, err := addTokenInputs(tx, utxos, token, 60)
+	ensure.Nil(t, err)
+	ensure.DeepEqual(t, total, uint64(80))
+	ensure.DeepEqual(t, len(tx.Vin), 2)
+	_, err = addTokenInputs(&types.Transaction{}, utxos, token, 100)
+	ensure.NotNil(t, err)
+
+	// box utxos are added smallest first until the fee is covered
+	tx.Vout = append(tx.Vout, &corepb.TxOut{Value: tokenOutputValue, ScriptPubKey: transfer(60)})
+	fee, err := fundTx(tx, utxos, p2pkh, 1)
+	ensure.Nil(t, err)
+	ensure.DeepEqual(t, len(tx.Vin), 4)
+	ensure.DeepEqual(t, len(tx.Vout), 2)
+	var in, out uint64
+	for _, txIn := range tx.Vin {
+		in += utxos[txIn.PrevOutPoint].Value()
+	}
+	for _, txOut := range tx.Vout {
+		out += txOut.Value
+	}
+	ensure.DeepEqual(t, in-out, fee)
+	// txs built by the node are final unless they opt in to be replaced
+	for _, txIn := range tx.Vin {
+		ensure.DeepEqual(t, txIn.Sequence, types.SequenceFinal)
+	}
+
+	_, err = fundTx(&types.Transaction{Vout: []*corepb.TxOut{{Value: 2000, ScriptPubKey: p2pkh}}}, utxos, p2pkh, 1)
+	ensure.DeepEqual(t, err, errNotEnoughBalance)
+}
+
+func TestTxEntriesPage(t *testing.T) {
+	var records []*addrTxRecord
+	for height := uint32(1); height <= 10; height++ {
+		tx := &types.Transaction{Vout: []*corepb.TxOut{{Value: uint64(height)}}}
+		records = append(records, &addrTxRecord{
+			addr:     "addr",
+			TxRecord: &types.TxRecord{Tx: tx, Height: height, Received: uint64(height)},
+		})
+	}
+	req := &rpcpb.ListTransactionsRequest{MinAmount: 3}
+
+	// chunks walk latest first, shrinking records to the part not visited
+	var heights []uint32
+	var cursor *txCursor
+	rest := records
+	for {
+		entries, last, n, err := txEntriesPage(req, rest, nil, cursor, 3)
+		ensure.Nil(t, err)
+		for _, entry := range entries {
+			heights = append(heights, entry.BlockHeight)
+		}
+		if len(entries) < 3 {
+			break
+		}
+		rest, cursor = rest[:n], last
+	}
+	ensure.DeepEqual(t, heights, []uint32{10, 9, 8, 7, 6, 5, 4, 3})
+
+	// the cursor alone resumes the same chunk on full records
+	entries, _, _, err := txEntriesPage(req, records, nil, &txCursor{height: 7}, 3)
+	ensure.Nil(t, err)
+	ensure.DeepEqual(t, len(entries), 3)
+	ensure.DeepEqual(t, entries[0].BlockHeight, uint32(6))
+}
+
+func TestTxCursor(t *testing.T) {
+	cursor := &txCursor{height: 7, index: 2}
+	decoded, err := decodeTxCursor(encodeTxCursor(cursor))
+	ensure.Nil(t, err)
+	ensure.DeepEqual(t, decoded, cursor)
+	// txs of earlier blocks, or earlier in the same block, come after it
+	ensure.True(t, cursor.before(6, 9))
+	ensure.True(t, cursor.before(7, 1))
+	ensure.False(t, cursor.before(7, 2))
+	ensure.False(t, cursor.before(8, 0))
+
+	_, err = decodeTxCursor("not a cursor")
+	ensure.DeepEqual(t, err, errInvalidTxCursor)
+	_, err = decodeTxCursor(encodeTxCursor(cursor)[1:])
+	ensure.DeepEqual(t, err, errInvalidTxCursor)
+}
+
+func TestListTxLimit(t *testing.T) {
+	ensure.DeepEqual(t, listTxLimit(0), uint32(defaultListTxLimit))
+	ensure.DeepEqual(t, listTxLimit(5), uint32(5))
+	ensure.DeepEqual(t, listTxLimit(maxListTxLimit+1), uint32(maxListTxLimit))
+}
@@ -15,10 +15,13 @@ import (
 	"github.com/BOXFoundation/boxd/boxd/eventbus"
 	"github.com/BOXFoundation/boxd/boxd/service"
 	"github.com/BOXFoundation/boxd/log"
+	"github.com/BOXFoundation/boxd/wallet"
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
 	"github.com/jbenet/goprocess"
 	goprocessctx "github.com/jbenet/goprocess/context"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 )
 
 var logger = log.NewLogger("rpc")
@@ -29,28 +32,63 @@ type Config struct {
 	Address string     `mapstructure:"address"`
 	Port    int        `mapstructure:"port"`
 	HTTP    HTTPConfig `mapstructure:"http"`
+	// WalletDir is the keystore directory of accounts the node signs with,
+	// node side signing is disabled if empty
+	WalletDir string `mapstructure:"wallet_dir"`
+	// WalletKDF is the kdf encrypting keystores of node wallet, keystores
+	// encrypted otherwise are migrated on unlock. Default kdf is used if empty
+	WalletKDF wallet.KDFParams `mapstructure:"wallet_kdf"`
+	// WalletRebroadcastBlocks is the number of blocks after which pending
+	// wallet txs sent from this node are broadcast again. Default is used
+	// if not set
+	WalletRebroadcastBlocks uint32 `mapstructure:"wallet_rebroadcast_blocks"`
+	// RemoteSigner is the signing daemon holding keys of external accounts
+	// of node wallet, which requires WalletDir
+	RemoteSigner wallet.RemoteSignerConfig `mapstructure:"remote_signer"`
+	RateLimit    RateLimitConfig           `mapstructure:"ratelimit"`
+	JSONRPC      JSONRPCConfig             `mapstructure:"jsonrpc"`
+	// LogRequests logs method, client ip, duration and status code of
+	// every rpc request
+	LogRequests bool `mapstructure:"log_requests"`
 }
 
 // HTTPConfig defines the address/port of rest api over http
 type HTTPConfig struct {
 	Address string `mapstructure:"address"`
 	Port    int    `mapstructure:"port"`
+	// EnableWebSocket serves eventbus notifications over websocket at /ws
+	EnableWebSocket bool `mapstructure:"enable_websocket"`
+	// WebSocketQueueSize is the number of eventbus events queued for
+	// websocket clients. Default is used if not set
+	WebSocketQueueSize int `mapstructure:"websocket_queue_size"`
+	// WebSocketQueuePolicy tells what to do with events when the queue is
+	// full, "drop" them (default) or "block" publishers
+	WebSocketQueuePolicy string `mapstructure:"websocket_queue_policy"`
+	// WebSocketOrigins are origins of web pages allowed to connect to
+	// websocket besides the node's own, * allows any
+	WebSocketOrigins []string `mapstructure:"websocket_origins"`
 }
 
 // Server defines the rpc server
 type Server struct {
 	cfg *Config
 
-	ChainReader service.ChainReader
-	TxHandler   service.TxHandler
-	eventBus    eventbus.Bus
-	server      *grpc.Server
-	gRPCProc    goprocess.Process
-	wggRPC      sync.WaitGroup
+	ChainReader     service.ChainReader
+	TxHandler       service.TxHandler
+	ConsensusReader service.ConsensusReader
+	eventBus        eventbus.Bus
+	walletMgr       *wallet.Manager
+	signer          *wallet.RemoteSigner
+	server          *grpc.Server
+	gRPCProc        goprocess.Process
+	wggRPC          sync.WaitGroup
 
 	httpserver *http.Server
 	httpProc   goprocess.Process
 	wgHTTP     sync.WaitGroup
+
+	// jsonrpcInterceptor wraps calls of json-rpc methods
+	jsonrpcInterceptor grpc.UnaryServerInterceptor
 }
 
 // Service defines the grpc service func
@@ -83,18 +121,47 @@ func RegisterServiceWithGatewayHandler(name string, s Service, h GatewayHandler)
 type GRPCServer interface {
 	GetChainReader() service.ChainReader
 	GetTxHandler() service.TxHandler
+	GetConsensusReader() service.ConsensusReader
 	GetEventBus() eventbus.Bus
+	GetWalletManager() *wallet.Manager
 	Stop()
 }
 
 // NewServer creates a RPC server instance.
-func NewServer(parent goprocess.Process, cfg *Config, cr service.ChainReader, txh service.TxHandler, bus eventbus.Bus) (*Server, error) {
+func NewServer(parent goprocess.Process, cfg *Config, cr service.ChainReader, txh service.TxHandler,
+	csr service.ConsensusReader, bus eventbus.Bus) (*Server, error) {
 	var server = &Server{
-		cfg:         cfg,
-		ChainReader: cr,
-		TxHandler:   txh,
-		eventBus:    bus,
-		gRPCProc:    goprocess.WithParent(parent),
+		cfg:             cfg,
+		ChainReader:     cr,
+		TxHandler:       txh,
+		ConsensusReader: csr,
+		eventBus:        bus,
+		gRPCProc:        goprocess.WithParent(parent),
+	}
+	if len(cfg.WalletKDF.Kdf) > 0 {
+		if err := wallet.SetKDFParams(cfg.WalletKDF); err != nil {
+			return nil, err
+		}
+	}
+	if len(cfg.WalletDir) > 0 {
+		wltMgr, err := wallet.NewWalletManager(cfg.WalletDir)
+		if err != nil {
+			return nil, err
+		}
+		server.walletMgr = wltMgr
+		if len(cfg.RemoteSigner.Address) > 0 {
+			signer, err := wallet.NewRemoteSigner(&cfg.RemoteSigner)
+			if err != nil {
+				return nil, err
+			}
+			accounts, err := wltMgr.AttachSigner(signer)
+			if err != nil {
+				signer.Close()
+				return nil, err
+			}
+			logger.Infof("Attached remote signer %s with %d external accounts", cfg.RemoteSigner.Address, len(accounts))
+			server.signer = signer
+		}
 	}
 
 	return server, nil
@@ -118,6 +185,9 @@ func (s *Server) Proc() goprocess.Process {
 // Stop gRPC service
 func (s *Server) Stop() {
 	s.gRPCProc.Close()
+	if s.signer != nil {
+		s.signer.Close()
+	}
 }
 
 // GetChainReader returns an interface to observe chain state
@@ -130,26 +200,64 @@ func (s *Server) GetTxHandler() service.TxHandler {
 	return s.TxHandler
 }
 
+// GetConsensusReader returns an interface to observe miners and candidates
+func (s *Server) GetConsensusReader() service.ConsensusReader {
+	return s.ConsensusReader
+}
+
 // GetEventBus returns a interface to publish events
 func (s *Server) GetEventBus() eventbus.Bus {
 	return s.eventBus
 }
 
+// GetWalletManager returns the manager of accounts the node signs with,
+// nil if node side signing is disabled
+func (s *Server) GetWalletManager() *wallet.Manager {
+	return s.walletMgr
+}
+
 func (s *Server) servegRPC(proc goprocess.Process) {
 	var addr = fmt.Sprintf("%s:%d", s.cfg.Address, s.cfg.Port)
 	logger.Infof("Starting RPC:gRPC server at %s", addr)
 	lis, err := net.Listen("tcp4", addr)
 	if err != nil {
-		logger.Fatalf("failed to listen: %v", err)
+		// the server is closed to be restarted by its supervisor
+		logger.Errorf("failed to listen: %v", err)
+		go s.gRPCProc.Close()
+		return
 	}
 
-	s.server = grpc.NewServer()
+	// errors are converted into status with registered codes outside rate
+	// limiter, so that its rejections are kept as they are. Calls are observed
+	// outermost to count rejections and converted errors
+	observer := newObserver(s.cfg.LogRequests)
+	unary := chainUnaryInterceptors(observer.unaryInterceptor, errorUnaryInterceptor)
+	stream := chainStreamInterceptors(observer.streamInterceptor, errorStreamInterceptor)
+	// json-rpc calls share the limits and are observed alike, their errors
+	// are kept to reply json-rpc codes
+	s.jsonrpcInterceptor = observer.unaryInterceptor
+	if s.cfg.RateLimit.Enabled {
+		limiter := newRateLimiter(&s.cfg.RateLimit)
+		unary = chainUnaryInterceptors(unary, limiter.unaryInterceptor)
+		stream = chainStreamInterceptors(stream, limiter.streamInterceptor)
+		s.jsonrpcInterceptor = chainUnaryInterceptors(s.jsonrpcInterceptor, limiter.unaryInterceptor)
+	}
+	s.server = grpc.NewServer(grpc.UnaryInterceptor(unary), grpc.StreamInterceptor(stream))
 
 	// regist all gRPC services for the server
 	for name, service := range services {
 		logger.Debugf("register gRPC service: %s", name)
 		service(s)
 	}
+	hs := health.NewServer()
+	healthpb.RegisterHealthServer(s.server, hs)
+	registerReflection(s.server)
+	proc.Go(func(p goprocess.Process) {
+		s.updateHealth(p, hs)
+	})
+	if s.walletMgr != nil {
+		proc.Go(newWalletSyncer(s.eventBus, s.ChainReader, s.TxHandler, s.walletMgr, s.cfg.WalletRebroadcastBlocks).run)
+	}
 
 	go func() {
 		s.wggRPC.Add(1)
@@ -157,12 +265,15 @@ func (s *Server) servegRPC(proc goprocess.Process) {
 
 		if err := s.server.Serve(lis); err != nil {
 			logger.Errorf("failed to serve gRPC: %v", err)
-			go proc.Close()
+			go s.gRPCProc.Close()
 		}
 	}()
 
 	// start gRPC gateway
 	s.httpProc = proc.Go(s.serveHTTP)
+	if s.cfg.JSONRPC.Enabled {
+		proc.Go(s.serveJSONRPC)
+	}
 
 	select {
 	case <-proc.Closing():
@@ -196,8 +307,28 @@ func (s *Server) serveHTTP(proc goprocess.Process) {
 		}
 	}
 
+	var handler http.Handler = mux
+	if s.cfg.HTTP.EnableWebSocket {
+		size, policy := s.cfg.HTTP.WebSocketQueueSize, eventbus.QueueDrop
+		if size <= 0 {
+			size = wsEventBufferSize
+		}
+		if len(s.cfg.HTTP.WebSocketQueuePolicy) > 0 {
+			var err error
+			if policy, err = eventbus.ParseQueuePolicy(s.cfg.HTTP.WebSocketQueuePolicy); err != nil {
+				logger.Fatalf("Invalid websocket queue policy: %v", err)
+			}
+		}
+		hub := newWSHub(s.eventBus, s.ChainReader, size, policy, s.cfg.HTTP.WebSocketOrigins)
+		proc.Go(hub.run)
+		root := http.NewServeMux()
+		root.Handle(wsPath, hub.handler())
+		root.Handle("/", mux)
+		handler = root
+	}
+
 	var httpendpoint = fmt.Sprintf("%s:%d", s.cfg.HTTP.Address, s.cfg.HTTP.Port)
-	s.httpserver = &http.Server{Addr: httpendpoint, Handler: mux}
+	s.httpserver = &http.Server{Addr: httpendpoint, Handler: handler}
 	go func() {
 		s.wgHTTP.Add(1)
 		defer s.wgHTTP.Done()
@@ -0,0 +1,174 @@
+// Copyright (c) 2018 ContentBox Authors.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package rpc
+
+import (
+	"encoding/binary"
+	"errors"
+
+	"github.com/BOXFoundation/boxd/core/chain"
+	"github.com/BOXFoundation/boxd/core/pb"
+	"github.com/BOXFoundation/boxd/core/types"
+	"github.com/BOXFoundation/boxd/crypto"
+	"github.com/BOXFoundation/boxd/rpc/pb"
+	"github.com/BOXFoundation/boxd/script"
+	"github.com/BOXFoundation/boxd/util"
+	"github.com/BOXFoundation/boxd/util/bloom"
+)
+
+// filteredBlockBufferSize is the number of chain updates queued for a
+// subscriber before it is considered too slow
+const filteredBlockBufferSize = 64
+
+var (
+	errInvalidFilter       = errors.New("invalid bloom filter")
+	errFilterSubscriberLag = errors.New("filtered block subscriber falls behind")
+)
+
+// SubscribeFilteredBlocks streams chain updates filtered by the bloom filter
+// of a light client until it cancels. A subscriber that can't keep up is
+// dropped rather than skipping blocks silently, and has to subscribe again
+func (s *txServer) SubscribeFilteredBlocks(req *rpcpb.SubscribeFilteredBlocksRequest, stream rpcpb.TransactionCommand_SubscribeFilteredBlocksServer) error {
+	filter, err := loadClientFilter(req.Filter)
+	if err != nil {
+		stream.Send(&rpcpb.FilteredBlock{Code: int32(errorCode(err)), Message: err.Error()})
+		return err
+	}
+
+	// eventbus handlers run in publishers' goroutine, so updates are only
+	// queued. The handler is kept to unsubscribe with the same func value
+	updates := make(chan *chain.UpdateMsg, filteredBlockBufferSize)
+	lagged := make(chan struct{})
+	onChainUpdate := func(msg *chain.UpdateMsg) {
+		select {
+		case updates <- msg:
+		default:
+			select {
+			case <-lagged:
+			default:
+				close(lagged)
+			}
+		}
+	}
+	bus := s.server.GetEventBus()
+	chain.SubscribeChainUpdate(bus, onChainUpdate)
+	defer chain.UnsubscribeChainUpdate(bus, onChainUpdate)
+
+	for {
+		select {
+		case msg := <-updates:
+			resp, err := filterBlock(filter, msg.Block, msg.Connected)
+			if err != nil {
+				stream.Send(&rpcpb.FilteredBlock{Code: int32(errorCode(err)), Message: err.Error()})
+				return err
+			}
+			if err := stream.Send(resp); err != nil {
+				return err
+			}
+		case <-lagged:
+			return errFilterSubscriberLag
+		case <-stream.Context().Done():
+			return stream.Context().Err()
+		}
+	}
+}
+
+// loadClientFilter deserializes a bloom filter uploaded by a client, within
+// the limits of size and hash functions
+func loadClientFilter(data []byte) (bloom.Filter, error) {
+	filter, err := bloom.LoadFilter(data)
+	if err != nil || filter.Size() == 0 || filter.Size() > bloom.MaxFilterSize<<3 ||
+		filter.K() == 0 || filter.K() > bloom.MaxFilterHashFuncs {
+		return nil, errInvalidFilter
+	}
+	return filter, nil
+}
+
+// filterBlock returns block with txs matching filter and their merkle
+// branches
+func filterBlock(filter bloom.Filter, block *types.Block, connected bool) (*rpcpb.FilteredBlock, error) {
+	header, err := block.Header.ToProtoMessage()
+	if err != nil {
+		return nil, err
+	}
+	resp := &rpcpb.FilteredBlock{
+		Code:      0,
+		Message:   "Ok",
+		Hash:      block.BlockHash().String(),
+		Height:    block.Height,
+		Header:    header.(*corepb.BlockHeader),
+		Connected: connected,
+		TotalTxs:  uint32(len(block.Txs)),
+	}
+	var matched []int
+	hashes := make([]*crypto.HashType, 0, len(block.Txs))
+	for i, tx := range block.Txs {
+		hash, err := tx.TxHash()
+		if err != nil {
+			return nil, err
+		}
+		hashes = append(hashes, hash)
+		if matchTx(filter, hash, tx) {
+			matched = append(matched, i)
+		}
+	}
+	if len(matched) == 0 {
+		return resp, nil
+	}
+	merkles := util.BuildMerkleRoot(hashes)
+	for _, i := range matched {
+		tx, err := block.Txs[i].ToProtoMessage()
+		if err != nil {
+			return nil, err
+		}
+		var branch [][]byte
+		for _, hash := range util.BuildMerkleBranch(merkles, i) {
+			branch = append(branch, hash[:])
+		}
+		resp.Txs = append(resp.Txs, &rpcpb.MatchedTransaction{
+			Hash:         hashes[i].String(),
+			Tx:           tx.(*corepb.Transaction),
+			Index:        uint32(i),
+			MerkleBranch: branch,
+		})
+	}
+	return resp, nil
+}
+
+// matchTx checks if tx matches filter as BIP37 does with BLOOM_UPDATE_ALL,
+// adding outpoints of matched outputs to filter
+func matchTx(filter bloom.Filter, hash *crypto.HashType, tx *types.Transaction) bool {
+	matched := filter.Matches(hash[:])
+	for i, txOut := range tx.Vout {
+		if !filter.Matches(txOut.ScriptPubKey) && !matchPubKeyHash(filter, txOut.ScriptPubKey) {
+			continue
+		}
+		matched = true
+		filter.Add(filterOutPoint(hash, uint32(i)))
+	}
+	if matched {
+		return true
+	}
+	for _, txIn := range tx.Vin {
+		if filter.Matches(filterOutPoint(&txIn.PrevOutPoint.Hash, txIn.PrevOutPoint.Index)) {
+			return true
+		}
+	}
+	return false
+}
+
+func matchPubKeyHash(filter bloom.Filter, scriptPubKey []byte) bool {
+	addr, err := script.NewScriptFromBytes(scriptPubKey).ExtractAddress()
+	return err == nil && filter.Matches(addr.Hash())
+}
+
+// filterOutPoint serializes an outpoint as tx hash followed by the little
+// endian index, the form clients add to filters
+func filterOutPoint(hash *crypto.HashType, index uint32) []byte {
+	data := make([]byte, crypto.HashSize+4)
+	copy(data, hash[:])
+	binary.LittleEndian.PutUint32(data[crypto.HashSize:], index)
+	return data
+}
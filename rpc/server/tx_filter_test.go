@@ -0,0 +1,66 @@
+// Copyright (c) 2018 ContentBox Authors.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package rpc
+
+import (
+	"testing"
+
+	"github.com/BOXFoundation/boxd/core/types"
+	"github.com/BOXFoundation/boxd/crypto"
+	"github.com/BOXFoundation/boxd/script"
+	"github.com/BOXFoundation/boxd/util"
+	"github.com/BOXFoundation/boxd/util/bloom"
+	"github.com/facebookgo/ensure"
+)
+
+func TestFilterBlock(t *testing.T) {
+	pubKeyHash := make([]byte, 20)
+	pubKeyHash[0] = 1
+	// tx0 pays to pubKeyHash, tx1 is unrelated and tx2 spends tx0
+	tx0 := types.NewTransaction(types.OutPoint{}, 100, 0)
+	tx0.Vout[0].ScriptPubKey = []byte(*script.PayToPubKeyHashScript(pubKeyHash))
+	tx0Hash, _ := tx0.TxHash()
+	tx1 := types.NewTransaction(types.OutPoint{Index: 3}, 50, 0)
+	tx2 := types.NewTransaction(types.OutPoint{Hash: *tx0Hash, Index: 0}, 90, 0)
+	block := types.NewBlocks(crypto.HashType{}, crypto.HashType{}, 0, types.OutPoint{}, 0, 0, 10)
+	block.Txs = []*types.Transaction{tx0, tx1, tx2}
+
+	filter := bloom.NewFilter(10, 0.0001)
+	filter.Add(pubKeyHash)
+	data, _ := filter.Marshal()
+	filter, err := loadClientFilter(data)
+	ensure.Nil(t, err)
+
+	resp, err := filterBlock(filter, block, true)
+	ensure.Nil(t, err)
+	ensure.DeepEqual(t, resp.Height, uint32(10))
+	ensure.DeepEqual(t, resp.TotalTxs, uint32(3))
+	ensure.True(t, resp.Connected)
+	ensure.DeepEqual(t, len(resp.Txs), 2)
+	ensure.DeepEqual(t, resp.Txs[0].Index, uint32(0))
+	ensure.DeepEqual(t, resp.Txs[1].Index, uint32(2))
+
+	var hashes []*crypto.HashType
+	for _, tx := range block.Txs {
+		hash, _ := tx.TxHash()
+		hashes = append(hashes, hash)
+	}
+	merkles := util.BuildMerkleRoot(hashes)
+	root := merkles[len(merkles)-1]
+	for _, mtx := range resp.Txs {
+		var branch []*crypto.HashType
+		for _, b := range mtx.MerkleBranch {
+			hash := crypto.HashType{}
+			hash.SetBytes(b)
+			branch = append(branch, &hash)
+		}
+		leaf := hashes[mtx.Index]
+		ensure.DeepEqual(t, mtx.Hash, leaf.String())
+		ensure.DeepEqual(t, util.MerkleBranchRoot(leaf, int(mtx.Index), branch), root)
+	}
+
+	_, err = loadClientFilter([]byte{1, 2})
+	ensure.DeepEqual(t, err, errInvalidFilter)
+}
@@ -0,0 +1,321 @@
+// Copyright (c) 2018 ContentBox Authors.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package rpc
+
+import (
+	"sort"
+
+	"github.com/BOXFoundation/boxd/boxd/eventbus"
+	"github.com/BOXFoundation/boxd/boxd/service"
+	"github.com/BOXFoundation/boxd/core/chain"
+	"github.com/BOXFoundation/boxd/core/txpool"
+	"github.com/BOXFoundation/boxd/core/types"
+	"github.com/BOXFoundation/boxd/script"
+	"github.com/BOXFoundation/boxd/wallet"
+	"github.com/jbenet/goprocess"
+)
+
+// defaultRebroadcastBlocks is about 10 minutes of blocks, so that peers
+// don't drop rebroadcast txs as already seen
+const defaultRebroadcastBlocks = 120
+
+// walletEventQueueSize is the number of chain and txpool events queued for
+// the wallet syncer, past which publishers block, as the wallet must not
+// miss any
+const walletEventQueueSize = 1024
+
+// walletSyncer keeps the tx store of node wallet in step with the chain, and
+// rebroadcasts txs sent from this node till they confirm
+type walletSyncer struct {
+	bus    eventbus.Bus
+	cr     service.ChainReader
+	txh    service.TxHandler
+	wltMgr *wallet.Manager
+	// rebroadcastBlocks is the number of blocks local txs stay pending for
+	// before they're broadcast again
+	rebroadcastBlocks uint32
+	// rebroadcastCh receives chain heights to rebroadcast local txs at.
+	// Rebroadcasting publishes new txs, which can't be done inside eventbus
+	// handlers
+	rebroadcastCh chan uint32
+}
+
+func newWalletSyncer(bus eventbus.Bus, cr service.ChainReader, txh service.TxHandler,
+	wltMgr *wallet.Manager, rebroadcastBlocks uint32) *walletSyncer {
+	if rebroadcastBlocks == 0 {
+		rebroadcastBlocks = defaultRebroadcastBlocks
+	}
+	return &walletSyncer{
+		bus:               bus,
+		cr:                cr,
+		txh:               txh,
+		wltMgr:            wltMgr,
+		rebroadcastBlocks: rebroadcastBlocks,
+		rebroadcastCh:     make(chan uint32, 1),
+	}
+}
+
+// run records wallet txs from chain events and rebroadcasts pending local
+// ones until proc closes
+func (ws *walletSyncer) run(proc goprocess.Process) {
+	store := ws.wltMgr.TxStore()
+	// a store synced to another tip may have missed blocks, so its history
+	// is filled again from the chain
+	if tip, err := ws.cr.GetBlockHash(ws.cr.GetBlockHeight()); err == nil && store.Tip() != tip.String() {
+		if err := store.Reset(tip.String()); err != nil {
+			logger.Errorf("Failed to reset wallet transactions: %v", err)
+			return
+		}
+	}
+
+	// eventbus handlers share a queue, which keeps events in order without
+	// stalling publishers till it's full. Handlers are kept to unsubscribe
+	// with the same func values
+	queue := eventbus.NewQueue(walletEventQueueSize, eventbus.QueueBlock)
+	onChainUpdate := func(msg *chain.UpdateMsg) { ws.onChainUpdate(msg) }
+	onNewTx := func(tx *types.Transaction) { ws.onNewTx(tx) }
+	onTxReplaced := func(tx, by *types.Transaction) { ws.onTxReplaced(tx, by) }
+	chain.SubscribeChainUpdateQueued(ws.bus, onChainUpdate, queue)
+	txpool.SubscribeMempoolTxAddedQueued(ws.bus, onNewTx, queue)
+	txpool.SubscribeTxReplacedQueued(ws.bus, onTxReplaced, queue)
+	defer func() {
+		chain.UnsubscribeChainUpdate(ws.bus, onChainUpdate)
+		txpool.UnsubscribeMempoolTxAdded(ws.bus, onNewTx)
+		txpool.UnsubscribeTxReplaced(ws.bus, onTxReplaced)
+		queue.Close()
+	}()
+
+	for _, acc := range ws.wltMgr.ListAccounts() {
+		if err := trackWalletAddr(ws.cr, store, acc.Addr()); err != nil {
+			logger.Errorf("Failed to fill transactions of %s: %v", acc.Addr(), err)
+		}
+	}
+	for {
+		select {
+		case height := <-ws.rebroadcastCh:
+			ws.rebroadcast(height)
+		case <-proc.Closing():
+			return
+		}
+	}
+}
+
+func (ws *walletSyncer) onChainUpdate(msg *chain.UpdateMsg) {
+	store := ws.wltMgr.TxStore()
+	block := msg.Block
+	hash := block.BlockHash().String()
+	if !msg.Connected {
+		if err := store.DisconnectBlock(hash, block.Header.PrevBlockHash.String()); err != nil {
+			logger.Errorf("Failed to detach wallet transactions of block %s: %v", hash, err)
+		}
+		return
+	}
+	var wtxs []*wallet.WalletTx
+	for idx, tx := range block.Txs {
+		entries, err := walletTxs(ws.cr, store, tx)
+		if err != nil {
+			logger.Errorf("Failed to parse wallet transaction in block %s: %v", hash, err)
+			continue
+		}
+		for _, wtx := range entries {
+			wtx.BlockHash = hash
+			wtx.Height = block.Height
+			wtx.Index = uint32(idx)
+			wtx.TimeStamp = block.Header.TimeStamp
+		}
+		wtxs = append(wtxs, entries...)
+	}
+	if err := store.ConnectBlock(hash, wtxs); err != nil {
+		logger.Errorf("Failed to store wallet transactions of block %s: %v", hash, err)
+	}
+	// a pending signal covers this block as well
+	select {
+	case ws.rebroadcastCh <- block.Height:
+	default:
+	}
+}
+
+// rebroadcast sends again local txs pending for rebroadcastBlocks since
+// they're last broadcast at height
+func (ws *walletSyncer) rebroadcast(height uint32) {
+	if height < ws.rebroadcastBlocks {
+		return
+	}
+	store := ws.wltMgr.TxStore()
+	for _, wtx := range store.LocalPending(height - ws.rebroadcastBlocks) {
+		tx := new(types.Transaction)
+		if err := tx.Unmarshal(wtx.Raw); err != nil {
+			logger.Errorf("Failed to decode wallet transaction %s: %v", wtx.Hash, err)
+			continue
+		}
+		if err := ws.txh.ResendTx(tx); err != nil {
+			logger.Warnf("Failed to rebroadcast wallet transaction %s: %v", wtx.Hash, err)
+		} else {
+			logger.Infof("Rebroadcast wallet transaction %s pending since height %d", wtx.Hash, wtx.BroadcastHeight)
+		}
+		// retry failed ones later too, they stop once confirmed or conflicted
+		if err := store.MarkLocal(wtx.Hash, height); err != nil {
+			logger.Errorf("Failed to mark wallet transaction %s rebroadcast: %v", wtx.Hash, err)
+		}
+	}
+}
+
+func (ws *walletSyncer) onNewTx(tx *types.Transaction) {
+	store := ws.wltMgr.TxStore()
+	wtxs, err := walletTxs(ws.cr, store, tx)
+	if err != nil {
+		logger.Errorf("Failed to parse wallet transaction: %v", err)
+		return
+	}
+	if err := store.AddPending(wtxs); err != nil {
+		logger.Errorf("Failed to store pending wallet transaction: %v", err)
+	}
+}
+
+func (ws *walletSyncer) onTxReplaced(tx, by *types.Transaction) {
+	hash, err := tx.TxHash()
+	if err != nil {
+		return
+	}
+	byHash, err := by.TxHash()
+	if err != nil {
+		return
+	}
+	if err := ws.wltMgr.TxStore().Replace(hash.String(), byHash.String()); err != nil {
+		logger.Errorf("Failed to mark wallet transaction %s replaced: %v", hash, err)
+	}
+}
+
+// recordLocalTx marks tx sent from this node in node wallet, if any, to
+// rebroadcast it till it confirms. Only txs of tracked addresses are kept
+func recordLocalTx(server GRPCServer, tx *types.Transaction) {
+	wltMgr := server.GetWalletManager()
+	if wltMgr == nil {
+		return
+	}
+	hash, err := tx.TxHash()
+	if err != nil {
+		return
+	}
+	height := server.GetChainReader().GetBlockHeight()
+	if err := wltMgr.TxStore().MarkLocal(hash.String(), height); err != nil {
+		logger.Errorf("Failed to mark wallet transaction %s local: %v", hash, err)
+	}
+}
+
+// trackWalletAddr starts recording txs of addr and fills its history from
+// the chain, if it's not tracked yet
+func trackWalletAddr(cr service.ChainReader, store *wallet.TxStore, addr string) error {
+	if store.Tracked(addr) {
+		return nil
+	}
+	address, err := types.NewAddress(addr)
+	if err != nil {
+		return err
+	}
+	// track before searching so that txs of blocks connected meanwhile are kept
+	if err := store.Track(addr); err != nil {
+		return err
+	}
+	records, err := cr.GetTransactionsByAddr(address)
+	if err != nil {
+		return err
+	}
+	var wtxs []*wallet.WalletTx
+	for _, record := range records {
+		entries, err := walletTxs(cr, store, record.Tx)
+		if err != nil {
+			return err
+		}
+		for _, wtx := range entries {
+			if wtx.Addr != addr {
+				continue
+			}
+			wtx.BlockHash = record.BlockHash.String()
+			wtx.Height = record.Height
+			wtx.Index = record.Index
+			wtx.TimeStamp = record.TimeStamp
+			wtxs = append(wtxs, wtx)
+		}
+	}
+	return store.Backfill(addr, wtxs)
+}
+
+// walletTxs returns an entry of tx for each tracked address it spends from or
+// pays to. Previous outputs are looked up on chain, so spends of unconfirmed
+// outputs are missed and fees of such txs are left unknown
+func walletTxs(cr service.ChainReader, store *wallet.TxStore, tx *types.Transaction) ([]*wallet.WalletTx, error) {
+	hash, err := tx.TxHash()
+	if err != nil {
+		return nil, err
+	}
+	raw, err := tx.Marshal()
+	if err != nil {
+		return nil, err
+	}
+	inputs := make([]string, 0, len(tx.Vin))
+	entries := make(map[string]*wallet.WalletTx)
+	entry := func(addr string) *wallet.WalletTx {
+		wtx, ok := entries[addr]
+		if !ok {
+			wtx = &wallet.WalletTx{Addr: addr, Hash: hash.String(), Raw: raw}
+			entries[addr] = wtx
+		}
+		return wtx
+	}
+	ownerOf := func(scriptPubKey []byte) (string, bool) {
+		addr, err := script.NewScriptFromBytes(scriptPubKey).ExtractAddress()
+		if err != nil || !store.Tracked(addr.String()) {
+			return "", false
+		}
+		return addr.String(), true
+	}
+
+	var totalIn, totalOut uint64
+	resolved := !chain.IsCoinBase(tx)
+	for _, vin := range tx.Vin {
+		inputs = append(inputs, wallet.FormatOutPoint(&vin.PrevOutPoint))
+		if !resolved {
+			continue
+		}
+		prevTx, err := cr.LoadTxByHash(vin.PrevOutPoint.Hash)
+		if err != nil || int(vin.PrevOutPoint.Index) >= len(prevTx.Vout) {
+			resolved = false
+			continue
+		}
+		prevOut := prevTx.Vout[vin.PrevOutPoint.Index]
+		totalIn += prevOut.Value
+		if addr, ok := ownerOf(prevOut.ScriptPubKey); ok {
+			wtx := entry(addr)
+			wtx.IsSent = true
+			wtx.Spent += prevOut.Value
+			wtx.HasToken = wtx.HasToken || isTokenScript(prevOut.ScriptPubKey)
+		}
+	}
+	for _, vout := range tx.Vout {
+		totalOut += vout.Value
+		if addr, ok := ownerOf(vout.ScriptPubKey); ok {
+			wtx := entry(addr)
+			wtx.Received += vout.Value
+			wtx.HasToken = wtx.HasToken || isTokenScript(vout.ScriptPubKey)
+		}
+	}
+
+	wtxs := make([]*wallet.WalletTx, 0, len(entries))
+	for _, wtx := range entries {
+		wtx.Inputs = inputs
+		if wtx.IsSent && resolved && totalIn >= totalOut {
+			wtx.Fee = totalIn - totalOut
+		}
+		wtxs = append(wtxs, wtx)
+	}
+	sort.Slice(wtxs, func(i, j int) bool { return wtxs[i].Addr < wtxs[j].Addr })
+	return wtxs, nil
+}
+
+func isTokenScript(scriptBytes []byte) bool {
+	s := script.NewScriptFromBytes(scriptBytes)
+	return s.IsTokenIssue() || s.IsTokenTransfer()
+}
@@ -0,0 +1,497 @@
+// Copyright (c) 2018 ContentBox Authors.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package rpc
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sync"
+
+	"github.com/BOXFoundation/boxd/boxd/eventbus"
+	"github.com/BOXFoundation/boxd/boxd/service"
+	"github.com/BOXFoundation/boxd/core/chain"
+	"github.com/BOXFoundation/boxd/core/txpool"
+	"github.com/BOXFoundation/boxd/core/types"
+	"github.com/BOXFoundation/boxd/p2p"
+	"github.com/jbenet/goprocess"
+	peer "github.com/libp2p/go-libp2p-peer"
+	"golang.org/x/net/websocket"
+)
+
+// topics websocket clients can subscribe to
+const (
+	WSTopicNewBlock = "newblock"
+	WSTopicNewTx    = "newtx"
+	WSTopicAddress  = "address"
+	WSTopicPeer     = "peer"
+	WSTopicMempool  = "mempool"
+)
+
+const (
+	wsPath = "/ws"
+
+	maxWSClients        = 1024
+	maxWSAddrsPerClient = 1000
+	wsSendBufferSize    = 256
+	wsEventBufferSize   = 1024
+)
+
+// wsRequest is the frame sent by clients to subscribe or unsubscribe a topic
+type wsRequest struct {
+	ID     uint64   `json:"id"`
+	Method string   `json:"method"`
+	Topic  string   `json:"topic"`
+	Addrs  []string `json:"addrs,omitempty"`
+}
+
+// wsResponse answers a wsRequest with the same id
+type wsResponse struct {
+	ID     uint64 `json:"id"`
+	Result string `json:"result,omitempty"`
+	Error  string `json:"error,omitempty"`
+}
+
+// wsNotification is pushed to clients subscribing the topic
+type wsNotification struct {
+	Topic string      `json:"topic"`
+	Data  interface{} `json:"data"`
+}
+
+type wsBlockData struct {
+	Hash      string `json:"hash"`
+	Height    uint32 `json:"height"`
+	TimeStamp int64  `json:"timestamp"`
+	TxCount   int    `json:"tx_count"`
+	// false if the block is detached from main chain by reorg
+	Connected bool `json:"connected"`
+}
+
+type wsTxData struct {
+	Hash    string `json:"hash"`
+	Inputs  int    `json:"inputs"`
+	Outputs int    `json:"outputs"`
+	Value   uint64 `json:"value"`
+}
+
+type wsAddressData struct {
+	Addr   string `json:"addr"`
+	TxHash string `json:"tx_hash"`
+	// 0 for txs in txpool
+	Height uint32 `json:"height"`
+	// pending, connected or disconnected, or why a pending tx leaves txpool
+	// unconfirmed: conflicted, evicted, expired or replaced
+	Status string `json:"status"`
+}
+
+type wsMempoolData struct {
+	Hash string `json:"hash"`
+	// added or removed
+	Event string `json:"event"`
+	// why a removed tx leaves txpool: confirmed, conflicted, evicted,
+	// expired or replaced
+	Reason string `json:"reason,omitempty"`
+}
+
+// wsTxRemoved is a tx leaving txpool queued to dispatch
+type wsTxRemoved struct {
+	tx     *types.Transaction
+	reason types.TxRemoveReason
+}
+
+type wsPeerData struct {
+	PeerID string `json:"peer_id"`
+	// connected or disconnected
+	Event string `json:"event"`
+}
+
+// wsClient holds a websocket connection and its subscriptions
+type wsClient struct {
+	conn *websocket.Conn
+	send chan []byte
+
+	mtx    sync.RWMutex
+	topics map[string]bool
+	addrs  map[string]bool
+}
+
+func newWSClient(conn *websocket.Conn) *wsClient {
+	return &wsClient{
+		conn:   conn,
+		send:   make(chan []byte, wsSendBufferSize),
+		topics: make(map[string]bool),
+		addrs:  make(map[string]bool),
+	}
+}
+
+func (c *wsClient) subscribed(topic string) bool {
+	c.mtx.RLock()
+	defer c.mtx.RUnlock()
+	return c.topics[topic]
+}
+
+// watched returns addrs among the given ones the client subscribes to
+func (c *wsClient) watched(addrs []string) []string {
+	c.mtx.RLock()
+	defer c.mtx.RUnlock()
+	if !c.topics[WSTopicAddress] {
+		return nil
+	}
+	var matched []string
+	for _, addr := range addrs {
+		if c.addrs[addr] {
+			matched = append(matched, addr)
+		}
+	}
+	return matched
+}
+
+// push queues msg to send, it's dropped if the client falls behind
+func (c *wsClient) push(msg []byte) {
+	select {
+	case c.send <- msg:
+	default:
+		logger.Debugf("websocket client %s falls behind, message dropped", c.conn.Request().RemoteAddr)
+	}
+}
+
+func (c *wsClient) writeLoop() {
+	for msg := range c.send {
+		if err := websocket.Message.Send(c.conn, string(msg)); err != nil {
+			// closing conn makes the read loop quit, which closes send
+			c.conn.Close()
+		}
+	}
+}
+
+func (c *wsClient) handle(req *wsRequest) error {
+	switch req.Topic {
+	case WSTopicNewBlock, WSTopicNewTx, WSTopicPeer, WSTopicMempool:
+	case WSTopicAddress:
+		for _, addr := range req.Addrs {
+			if _, err := types.NewAddress(addr); err != nil {
+				return fmt.Errorf("invalid address %s", addr)
+			}
+		}
+	default:
+		return fmt.Errorf("unknown topic %s", req.Topic)
+	}
+
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	switch req.Method {
+	case "subscribe":
+		if req.Topic == WSTopicAddress {
+			if len(req.Addrs) == 0 {
+				return fmt.Errorf("addrs required")
+			}
+			if len(c.addrs)+len(req.Addrs) > maxWSAddrsPerClient {
+				return fmt.Errorf("too many addresses, at most %d", maxWSAddrsPerClient)
+			}
+			for _, addr := range req.Addrs {
+				c.addrs[addr] = true
+			}
+		}
+		c.topics[req.Topic] = true
+	case "unsubscribe":
+		if req.Topic == WSTopicAddress && len(req.Addrs) > 0 {
+			for _, addr := range req.Addrs {
+				delete(c.addrs, addr)
+			}
+			if len(c.addrs) > 0 {
+				return nil
+			}
+		} else if req.Topic == WSTopicAddress {
+			c.addrs = make(map[string]bool)
+		}
+		delete(c.topics, req.Topic)
+	default:
+		return fmt.Errorf("unknown method %s", req.Method)
+	}
+	return nil
+}
+
+// wsHub bridges eventbus topics to websocket clients
+type wsHub struct {
+	bus   eventbus.Bus
+	chain service.ChainReader
+	// size and policy of the queue of events to dispatch
+	queueSize   int
+	queuePolicy eventbus.QueuePolicy
+	// origins browsers may connect from besides the node's own
+	origins []string
+
+	mtx     sync.RWMutex
+	clients map[*wsClient]struct{}
+}
+
+func newWSHub(bus eventbus.Bus, cr service.ChainReader, queueSize int, queuePolicy eventbus.QueuePolicy, origins []string) *wsHub {
+	return &wsHub{
+		bus:         bus,
+		chain:       cr,
+		queueSize:   queueSize,
+		queuePolicy: queuePolicy,
+		origins:     origins,
+		clients:     make(map[*wsClient]struct{}),
+	}
+}
+
+// handler returns the http handler upgrading requests to websocket
+func (h *wsHub) handler() http.Handler {
+	return websocket.Server{
+		Handler:   h.serve,
+		Handshake: h.checkOrigin,
+	}
+}
+
+// checkOrigin rejects requests browsers send from pages of other origins
+// than the node and the allowed ones, * allows any. Clients are not limited
+// to browsers, requests without origin are accepted
+func (h *wsHub) checkOrigin(config *websocket.Config, req *http.Request) error {
+	origin := req.Header.Get("Origin")
+	if origin == "" {
+		return nil
+	}
+	u, err := url.Parse(origin)
+	if err != nil {
+		return fmt.Errorf("invalid origin %s", origin)
+	}
+	if u.Host == req.Host {
+		return nil
+	}
+	for _, allowed := range h.origins {
+		if allowed == "*" || allowed == origin {
+			return nil
+		}
+	}
+	return fmt.Errorf("origin %s not allowed", origin)
+}
+
+// run dispatches events to clients until proc closes
+func (h *wsHub) run(proc goprocess.Process) {
+	// handlers share a bounded queue, so that events are dispatched in order
+	// without stalling publishers more than the queue policy allows.
+	// Handlers are kept to unsubscribe with the same func values
+	queue := eventbus.NewQueue(h.queueSize, h.queuePolicy)
+	onChainUpdate := func(msg *chain.UpdateMsg) { h.dispatch(msg) }
+	onNewTx := func(tx *types.Transaction) { h.dispatch(tx) }
+	onTxRemoved := func(tx *types.Transaction, reason types.TxRemoveReason) {
+		h.dispatch(&wsTxRemoved{tx: tx, reason: reason})
+	}
+	onConnEvent := func(pid peer.ID, event eventbus.BusEvent) {
+		switch event {
+		case eventbus.PeerConnEvent:
+			h.dispatch(&wsPeerData{PeerID: pid.Pretty(), Event: "connected"})
+		case eventbus.PeerDisconnEvent:
+			h.dispatch(&wsPeerData{PeerID: pid.Pretty(), Event: "disconnected"})
+		}
+	}
+	chain.SubscribeChainUpdateQueued(h.bus, onChainUpdate, queue)
+	txpool.SubscribeMempoolTxAddedQueued(h.bus, onNewTx, queue)
+	txpool.SubscribeMempoolTxRemovedQueued(h.bus, onTxRemoved, queue)
+	p2p.SubscribeConnEventQueued(h.bus, onConnEvent, queue)
+	defer func() {
+		chain.UnsubscribeChainUpdate(h.bus, onChainUpdate)
+		txpool.UnsubscribeMempoolTxAdded(h.bus, onNewTx)
+		txpool.UnsubscribeMempoolTxRemoved(h.bus, onTxRemoved)
+		p2p.UnsubscribeConnEvent(h.bus, onConnEvent)
+		queue.Close()
+	}()
+
+	<-proc.Closing()
+	h.mtx.RLock()
+	for c := range h.clients {
+		c.conn.Close()
+	}
+	h.mtx.RUnlock()
+}
+
+func (h *wsHub) dispatch(event interface{}) {
+	switch ev := event.(type) {
+	case *chain.UpdateMsg:
+		hash := ev.Block.BlockHash()
+		h.broadcast(WSTopicNewBlock, &wsBlockData{
+			Hash:      hash.String(),
+			Height:    ev.Block.Height,
+			TimeStamp: ev.Block.Header.TimeStamp,
+			TxCount:   len(ev.Block.Txs),
+			Connected: ev.Connected,
+		})
+		status := "connected"
+		if !ev.Connected {
+			status = "disconnected"
+		}
+		for _, tx := range ev.Block.Txs {
+			h.notifyAddresses(tx, ev.Block.Height, status)
+		}
+	case *types.Transaction:
+		hash, err := ev.TxHash()
+		if err != nil {
+			return
+		}
+		var value uint64
+		for _, txOut := range ev.Vout {
+			value += txOut.Value
+		}
+		h.broadcast(WSTopicNewTx, &wsTxData{
+			Hash:    hash.String(),
+			Inputs:  len(ev.Vin),
+			Outputs: len(ev.Vout),
+			Value:   value,
+		})
+		h.broadcast(WSTopicMempool, &wsMempoolData{Hash: hash.String(), Event: "added"})
+		h.notifyAddresses(ev, 0, "pending")
+	case *wsTxRemoved:
+		hash, err := ev.tx.TxHash()
+		if err != nil {
+			return
+		}
+		h.broadcast(WSTopicMempool, &wsMempoolData{Hash: hash.String(), Event: "removed", Reason: ev.reason.String()})
+		// confirmed txs are notified with their blocks
+		if ev.reason != types.TxRemoveConfirmed {
+			h.notifyAddresses(ev.tx, 0, ev.reason.String())
+		}
+	case *wsPeerData:
+		h.broadcast(WSTopicPeer, ev)
+	}
+}
+
+func (h *wsHub) broadcast(topic string, data interface{}) {
+	msg, err := json.Marshal(&wsNotification{Topic: topic, Data: data})
+	if err != nil {
+		logger.Errorf("failed to marshal websocket notification: %v", err)
+		return
+	}
+	h.mtx.RLock()
+	defer h.mtx.RUnlock()
+	for c := range h.clients {
+		if c.subscribed(topic) {
+			c.push(msg)
+		}
+	}
+}
+
+// watchingAddresses tells if any client subscribes to addresses
+func (h *wsHub) watchingAddresses() bool {
+	h.mtx.RLock()
+	defer h.mtx.RUnlock()
+	for c := range h.clients {
+		if c.subscribed(WSTopicAddress) {
+			return true
+		}
+	}
+	return false
+}
+
+// notifyAddresses tells clients watching any address tx sends from or pays to
+func (h *wsHub) notifyAddresses(tx *types.Transaction, height uint32, status string) {
+	if !h.watchingAddresses() {
+		return
+	}
+	hash, err := tx.TxHash()
+	if err != nil {
+		return
+	}
+	// spent outputs are loaded from db without blocking clients to register
+	addrs := h.txAddresses(tx)
+	h.mtx.RLock()
+	defer h.mtx.RUnlock()
+	for c := range h.clients {
+		for _, addr := range c.watched(addrs) {
+			msg, err := json.Marshal(&wsNotification{
+				Topic: WSTopicAddress,
+				Data: &wsAddressData{
+					Addr:   addr,
+					TxHash: hash.String(),
+					Height: height,
+					Status: status,
+				},
+			})
+			if err != nil {
+				continue
+			}
+			c.push(msg)
+		}
+	}
+}
+
+// txAddresses returns addresses tx pays to, and those it spends from if the
+// spent outputs are found on chain
+func (h *wsHub) txAddresses(tx *types.Transaction) []string {
+	seen := make(map[string]bool)
+	var addrs []string
+	add := func(scriptPubKey []byte) {
+		if addr := extractAddress(scriptPubKey); addr != "" && !seen[addr] {
+			seen[addr] = true
+			addrs = append(addrs, addr)
+		}
+	}
+	if !chain.IsCoinBase(tx) {
+		for _, txIn := range tx.Vin {
+			prevTx, err := h.chain.LoadTxByHash(txIn.PrevOutPoint.Hash)
+			if err != nil || txIn.PrevOutPoint.Index >= uint32(len(prevTx.Vout)) {
+				continue
+			}
+			add(prevTx.Vout[txIn.PrevOutPoint.Index].ScriptPubKey)
+		}
+	}
+	for _, txOut := range tx.Vout {
+		add(txOut.ScriptPubKey)
+	}
+	return addrs
+}
+
+func (h *wsHub) register(c *wsClient) bool {
+	h.mtx.Lock()
+	defer h.mtx.Unlock()
+	if len(h.clients) >= maxWSClients {
+		return false
+	}
+	h.clients[c] = struct{}{}
+	return true
+}
+
+func (h *wsHub) unregister(c *wsClient) {
+	h.mtx.Lock()
+	defer h.mtx.Unlock()
+	delete(h.clients, c)
+	close(c.send)
+}
+
+func (h *wsHub) serve(conn *websocket.Conn) {
+	defer conn.Close()
+	c := newWSClient(conn)
+	if !h.register(c) {
+		websocket.JSON.Send(conn, &wsResponse{Error: "too many websocket clients"})
+		return
+	}
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		c.writeLoop()
+	}()
+
+	for {
+		var data []byte
+		if err := websocket.Message.Receive(conn, &data); err != nil {
+			break
+		}
+		resp := &wsResponse{}
+		req := &wsRequest{}
+		if err := json.Unmarshal(data, req); err != nil {
+			resp.Error = "invalid request"
+		} else if err := c.handle(req); err != nil {
+			resp.ID, resp.Error = req.ID, err.Error()
+		} else {
+			resp.ID, resp.Result = req.ID, "ok"
+		}
+		if msg, err := json.Marshal(resp); err == nil {
+			c.push(msg)
+		}
+	}
+	h.unregister(c)
+	<-done
+}
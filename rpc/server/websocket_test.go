@@ -0,0 +1,94 @@
+// Copyright (c) 2018 ContentBox Authors.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package rpc
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/BOXFoundation/boxd/boxd/eventbus"
+	"github.com/BOXFoundation/boxd/boxd/service"
+	corepb "github.com/BOXFoundation/boxd/core/pb"
+	"github.com/BOXFoundation/boxd/core/types"
+	"github.com/BOXFoundation/boxd/crypto"
+	"github.com/BOXFoundation/boxd/script"
+	"github.com/facebookgo/ensure"
+	"golang.org/x/net/websocket"
+)
+
+// wsTestChain serves txs spent by notified txs
+type wsTestChain struct {
+	service.ChainReader
+	txs map[crypto.HashType]*types.Transaction
+}
+
+func (c *wsTestChain) LoadTxByHash(hash crypto.HashType) (*types.Transaction, error) {
+	if tx, ok := c.txs[hash]; ok {
+		return tx, nil
+	}
+	return nil, errors.New("tx not found")
+}
+
+func newWSTestAddress(t *testing.T, b byte) (string, []byte) {
+	addr, err := types.NewAddressPubKeyHash(append(make([]byte, 19), b))
+	ensure.Nil(t, err)
+	return addr.String(), *script.PayToPubKeyHashScript(addr.Hash())
+}
+
+func TestWSHub(t *testing.T) {
+	alice, aliceScript := newWSTestAddress(t, 1)
+	bob, bobScript := newWSTestAddress(t, 2)
+	prevTx := &types.Transaction{Vout: []*corepb.TxOut{{Value: 100, ScriptPubKey: aliceScript}}}
+	prevHash, err := prevTx.TxHash()
+	ensure.Nil(t, err)
+	tx := &types.Transaction{
+		Vin:  []*types.TxIn{{PrevOutPoint: types.OutPoint{Hash: *prevHash}}},
+		Vout: []*corepb.TxOut{{Value: 90, ScriptPubKey: bobScript}},
+	}
+	txHash, err := tx.TxHash()
+	ensure.Nil(t, err)
+
+	cr := &wsTestChain{txs: map[crypto.HashType]*types.Transaction{*prevHash: prevTx}}
+	hub := newWSHub(nil, cr, wsEventBufferSize, eventbus.QueueDrop, []string{"http://wallet.example"})
+	server := httptest.NewServer(hub.handler())
+	defer server.Close()
+	wsURL := "ws" + strings.TrimPrefix(server.URL, "http")
+
+	// pages of other origins are rejected, the node's own and allowed ones not
+	_, err = websocket.Dial(wsURL, "", "http://evil.example")
+	ensure.NotNil(t, err)
+	own, err := websocket.Dial(wsURL, "", server.URL)
+	ensure.Nil(t, err)
+	own.Close()
+	conn, err := websocket.Dial(wsURL, "", "http://wallet.example")
+	ensure.Nil(t, err)
+	defer conn.Close()
+
+	ensure.Nil(t, websocket.JSON.Send(conn, &wsRequest{ID: 1, Method: "subscribe", Topic: WSTopicAddress, Addrs: []string{alice}}))
+	resp := &wsResponse{}
+	ensure.Nil(t, websocket.JSON.Receive(conn, resp))
+	ensure.DeepEqual(t, resp, &wsResponse{ID: 1, Result: "ok"})
+	ensure.Nil(t, websocket.JSON.Send(conn, &wsRequest{ID: 2, Method: "subscribe", Topic: "unknown"}))
+	resp = &wsResponse{}
+	ensure.Nil(t, websocket.JSON.Receive(conn, resp))
+	ensure.DeepEqual(t, resp, &wsResponse{ID: 2, Error: "unknown topic unknown"})
+
+	// alice is notified of the tx spending from the address, topics not
+	// subscribed are not notified
+	hub.dispatch(tx)
+	var msg []byte
+	ensure.Nil(t, websocket.Message.Receive(conn, &msg))
+	notification := &struct {
+		Topic string
+		Data  *wsAddressData
+	}{}
+	ensure.Nil(t, json.Unmarshal(msg, notification))
+	ensure.DeepEqual(t, notification.Topic, WSTopicAddress)
+	ensure.DeepEqual(t, notification.Data, &wsAddressData{Addr: alice, TxHash: txHash.String(), Status: "pending"})
+	ensure.DeepEqual(t, hub.txAddresses(tx), []string{alice, bob})
+}
@@ -0,0 +1,34 @@
+// Copyright (c) 2018 ContentBox Authors.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package script
+
+import (
+	"github.com/BOXFoundation/boxd/crypto"
+)
+
+// SigBatch collects signatures checked by scripts validated with
+// ValidateDeferred, to verify them at once with crypto.VerifyBatch
+type SigBatch struct {
+	sigs    []*crypto.Signature
+	pubKeys []*crypto.PublicKey
+	hashes  []*crypto.HashType
+}
+
+// add adds signature sig of pubKey over hash to the batch
+func (b *SigBatch) add(sig *crypto.Signature, pubKey *crypto.PublicKey, hash *crypto.HashType) {
+	b.sigs = append(b.sigs, sig)
+	b.pubKeys = append(b.pubKeys, pubKey)
+	b.hashes = append(b.hashes, hash)
+}
+
+// Len returns the number of signatures in the batch
+func (b *SigBatch) Len() int {
+	return len(b.sigs)
+}
+
+// Verify checks if all signatures in the batch are valid
+func (b *SigBatch) Verify() bool {
+	return crypto.VerifyBatch(b.sigs, b.pubKeys, b.hashes)
+}
@@ -23,6 +23,9 @@ var (
 	ErrInputIndexOutOfBound      = errors.New("input index out of bound")
 	ErrAddressNotApplicable      = errors.New("Address only applies to p2pkh and token txs")
 
+	// multisig.go
+	ErrNotMultisigScript = errors.New("Not a multisig redeem script")
+
 	// stack.go
 	ErrFinalStackEmpty       = errors.New("Final stack empty")
 	ErrFinalTopStackEleFalse = errors.New("Final top stack element false")
@@ -0,0 +1,87 @@
+// Copyright (c) 2018 ContentBox Authors.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package script
+
+import (
+	"bytes"
+	"reflect"
+
+	"github.com/BOXFoundation/boxd/crypto"
+)
+
+// MultisigScript creates the redeem script of a m-of-n multisig:
+// m <Public Key A> <Public Key B> ... n OP_CHECKMULTISIG
+func MultisigScript(keys *crypto.MultisigKeys) *Script {
+	s := NewScript().AddOpCode(smallIntOpCode(keys.M))
+	for _, pubKey := range keys.PubKeys {
+		s.AddOperand(pubKey.Serialize())
+	}
+	return s.AddOpCode(smallIntOpCode(keys.N())).AddOpCode(OPCHECKMULTISIG)
+}
+
+// PayToScriptHashScript creates a script to lock a transaction output to the
+// hash of a redeem script: OP_HASH160 <script hash> OP_EQUAL
+func PayToScriptHashScript(scriptHash []byte) *Script {
+	return NewScript().AddOpCode(OPHASH160).AddOperand(scriptHash).AddOpCode(OPEQUAL)
+}
+
+// MultisigSignatureScript creates a script to unlock a utxo paid to the hash
+// of a multisig redeem script, with signatures in the order of their keys
+func MultisigSignatureScript(sigs []*crypto.Signature, redeemScript []byte) *Script {
+	s := NewScript()
+	for _, sig := range sigs {
+		s.AddOperand(sig.Serialize())
+	}
+	return s.AddOperand(redeemScript)
+}
+
+// ExtractMultisigKeys returns participant keys of a multisig redeem script
+// created by MultisigScript
+func (s *Script) ExtractMultisigKeys() (*crypto.MultisigKeys, error) {
+	r := s.parse()
+	if len(r) < 4 || !reflect.DeepEqual(r[len(r)-1], OPCHECKMULTISIG) {
+		return nil, ErrNotMultisigScript
+	}
+	m, n := smallInt(r[0]), smallInt(r[len(r)-2])
+	if m < 0 || n != len(r)-3 {
+		return nil, ErrNotMultisigScript
+	}
+	pubKeys := make([]*crypto.PublicKey, 0, n)
+	for _, e := range r[1 : len(r)-2] {
+		operand, ok := e.(Operand)
+		if !ok {
+			return nil, ErrNotMultisigScript
+		}
+		pubKey, err := crypto.PublicKeyFromBytes(operand)
+		if err != nil {
+			return nil, ErrNotMultisigScript
+		}
+		pubKeys = append(pubKeys, pubKey)
+	}
+	keys, err := crypto.NewMultisigKeys(m, pubKeys)
+	if err != nil {
+		return nil, err
+	}
+	// keys not in order are not of MultisigScript
+	if !bytes.Equal(*MultisigScript(keys), *s) {
+		return nil, ErrNotMultisigScript
+	}
+	return keys, nil
+}
+
+// smallIntOpCode returns the opcode pushing n in [1, 16]
+func smallIntOpCode(n int) OpCode {
+	return OpCode(int(OP1) + n - 1)
+}
+
+// smallInt returns the number an element of OP_1 to OP_16 pushes, -1 if it's
+// not one of them
+func smallInt(e interface{}) int {
+	opCode, ok := e.(OpCode)
+	if !ok || opCode < OP1 || opCode > OP16 {
+		return -1
+	}
+	return int(opCode-OP1) + 1
+}
@@ -0,0 +1,65 @@
+// Copyright (c) 2018 ContentBox Authors.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package script
+
+import (
+	"testing"
+
+	"github.com/BOXFoundation/boxd/crypto"
+	"github.com/facebookgo/ensure"
+)
+
+func TestMultisigScript(t *testing.T) {
+	_, keys, err := crypto.GenerateMultisigKeys(2, 3)
+	ensure.Nil(t, err)
+	redeemScript := MultisigScript(keys)
+	ensure.DeepEqual(t, redeemScript.GetSigOpCount(), 1)
+
+	extracted, err := redeemScript.ExtractMultisigKeys()
+	ensure.Nil(t, err)
+	ensure.DeepEqual(t, extracted, keys)
+
+	// keys out of order
+	unsorted := NewScript().AddOpCode(OP2).AddOperand(keys.PubKeys[1].Serialize()).
+		AddOperand(keys.PubKeys[0].Serialize()).AddOperand(keys.PubKeys[2].Serialize()).
+		AddOpCode(OP3).AddOpCode(OPCHECKMULTISIG)
+	_, err = unsorted.ExtractMultisigKeys()
+	ensure.DeepEqual(t, err, ErrNotMultisigScript)
+
+	scriptPubKey, _, _ := genP2PKHScript(false)
+	_, err = scriptPubKey.ExtractMultisigKeys()
+	ensure.DeepEqual(t, err, ErrNotMultisigScript)
+}
+
+func TestP2SHMultisig(t *testing.T) {
+	privKeys, keys, err := crypto.GenerateMultisigKeys(2, 3)
+	ensure.Nil(t, err)
+	redeemScript := MultisigScript(keys)
+	scriptPubKey := PayToScriptHashScript(crypto.Hash160(*redeemScript))
+	ensure.True(t, scriptPubKey.IsPayToScriptHash())
+
+	hash, err := CalcTxHashForSig(*redeemScript, tx, 0)
+	ensure.Nil(t, err)
+	partials := make([]*crypto.PartialSignature, 0, len(privKeys))
+	for _, privKey := range privKeys {
+		partial, err := crypto.SignPartial(privKey, hash)
+		ensure.Nil(t, err)
+		partials = append(partials, partial)
+	}
+
+	// any 2 of 3 participants unlock
+	for _, signers := range [][]int{{0, 1}, {0, 2}, {2, 1}} {
+		sigs, err := keys.Combine([]*crypto.PartialSignature{partials[signers[0]], partials[signers[1]]}, hash)
+		ensure.Nil(t, err)
+		scriptSig := MultisigSignatureScript(sigs, *redeemScript)
+		ensure.Nil(t, Validate(scriptSig, scriptPubKey, tx, 0))
+	}
+
+	// signatures out of key order or not enough do not
+	sigs := []*crypto.Signature{partials[1].Sig, partials[0].Sig}
+	ensure.NotNil(t, Validate(MultisigSignatureScript(sigs, *redeemScript), scriptPubKey, tx, 0))
+	sigs = []*crypto.Signature{partials[0].Sig}
+	ensure.NotNil(t, Validate(MultisigSignatureScript(sigs, *redeemScript), scriptPubKey, tx, 0))
+}
@@ -93,9 +93,21 @@ func (s *Script) AddScript(script *Script) *Script {
 
 // Validate verifies the script
 func Validate(scriptSig, scriptPubKey *Script, tx *types.Transaction, txInIdx int) error {
+	return validate(scriptSig, scriptPubKey, tx, txInIdx, nil)
+}
+
+// ValidateDeferred verifies the script assuming signatures it checks are
+// valid, collecting them into batch to verify at once. The script is valid if
+// it succeeds and batch verifies; otherwise it must be validated again with
+// Validate, since a script may succeed on invalid signatures
+func ValidateDeferred(scriptSig, scriptPubKey *Script, tx *types.Transaction, txInIdx int, batch *SigBatch) error {
+	return validate(scriptSig, scriptPubKey, tx, txInIdx, batch)
+}
+
+func validate(scriptSig, scriptPubKey *Script, tx *types.Transaction, txInIdx int, batch *SigBatch) error {
 	// concatenate unlocking & locking scripts
 	catScript := NewScript().AddScript(scriptSig).AddOpCode(OPCODESEPARATOR).AddScript(scriptPubKey)
-	if err := catScript.evaluate(tx, txInIdx); err != nil {
+	if err := catScript.evaluateBatched(tx, txInIdx, batch); err != nil {
 		return err
 	}
 
@@ -104,25 +116,44 @@ func Validate(scriptSig, scriptPubKey *Script, tx *types.Transaction, txInIdx in
 	}
 
 	// Handle p2sh
-	// scriptSig: signature <serialized redeemScript>
+	// scriptSig: signature <serialized redeemScript>, or
+	// signature A, signature B, ... <serialized redeemScript> of multisig
 	//
 
-	// First operand is signature
-	_, sig, newPc, _ := scriptSig.parseNextOp(0)
-	newScriptSig := NewScript().AddOperand(sig)
-
-	// Second operand is serialized redeem script
-	_, redeemScriptBytes, _, _ := scriptSig.parseNextOp(newPc)
+	// Last operand is serialized redeem script, those before are signatures
+	elements := scriptSig.parse()
+	if len(elements) == 0 {
+		return ErrInvalidStackOperation
+	}
+	newScriptSig := NewScript()
+	for _, e := range elements[:len(elements)-1] {
+		switch v := e.(type) {
+		case Operand:
+			newScriptSig.AddOperand(v)
+		case OpCode:
+			newScriptSig.AddOpCode(v)
+		}
+	}
+	redeemScriptBytes, ok := elements[len(elements)-1].(Operand)
+	if !ok {
+		return ErrInvalidStackOperation
+	}
 	redeemScript := NewScriptFromBytes(redeemScriptBytes)
 
-	// signature becomes the new scriptSig, redeemScript becomes the new scriptPubKey
+	// signatures become the new scriptSig, redeemScript becomes the new scriptPubKey
 	catScript = NewScript().AddScript(newScriptSig).AddOpCode(OPCODESEPARATOR).AddScript(redeemScript)
-	return catScript.evaluate(tx, txInIdx)
+	return catScript.evaluateBatched(tx, txInIdx, batch)
 }
 
 // Evaluate interprets the script and returns error if it fails
 // It succeeds if the script runs to completion and the top stack element exists and is true
 func (s *Script) evaluate(tx *types.Transaction, txInIdx int) error {
+	return s.evaluateBatched(tx, txInIdx, nil)
+}
+
+// evaluateBatched interprets the script like evaluate, collecting signatures
+// checked into batch if it's not nil
+func (s *Script) evaluateBatched(tx *types.Transaction, txInIdx int, batch *SigBatch) error {
 	script := *s
 	scriptLen := len(script)
 	logger.Debugf("script len %d: %s", scriptLen, s.Disasm())
@@ -135,7 +166,7 @@ func (s *Script) evaluate(tx *types.Transaction, txInIdx int) error {
 		}
 		pc = newPc
 
-		if err := s.execOp(opCode, operand, tx, txInIdx, pc, &scriptPubKeyStart, stack); err != nil {
+		if err := s.execOp(opCode, operand, tx, txInIdx, pc, &scriptPubKeyStart, stack, batch); err != nil {
 			return err
 		}
 	}
@@ -197,7 +228,7 @@ func (s *Script) parseNextOp(pc int) (OpCode, Operand, int /* pc */, error) {
 
 // Execute an operation
 func (s *Script) execOp(opCode OpCode, pushData Operand, tx *types.Transaction,
-	txInIdx int, pc int, scriptPubKeyStart *int, stack *Stack) error {
+	txInIdx int, pc int, scriptPubKeyStart *int, stack *Stack, batch *SigBatch) error {
 
 	// Push value
 	if opCode <= OPPUSHDATA4 {
@@ -299,7 +330,7 @@ func (s *Script) execOp(opCode OpCode, pushData Operand, tx *types.Transaction,
 		// script consists of: scriptSig + OPCODESEPARATOR + scriptPubKey
 		scriptPubKey := (*s)[*scriptPubKeyStart:]
 
-		isVerified := verifySig(signature, pubKey, scriptPubKey, tx, txInIdx)
+		isVerified := verifySig(signature, pubKey, scriptPubKey, tx, txInIdx, batch)
 
 		stack.pop()
 		stack.pop()
@@ -369,7 +400,7 @@ func (s *Script) execOp(opCode OpCode, pushData Operand, tx *types.Transaction,
 			signature := stack.topN(sigIdx)
 			pubKey := stack.topN(pubKeyIdx)
 
-			if verifySig(signature, pubKey, scriptPubKey, tx, txInIdx) {
+			if verifySig(signature, pubKey, scriptPubKey, tx, txInIdx, batch) {
 				sigIdx++
 				sigCount--
 			}
@@ -406,7 +437,9 @@ func (s *Script) execOp(opCode OpCode, pushData Operand, tx *types.Transaction,
 
 // verify if signature is right
 // scriptPubKey is the locking script of the utxo tx input tx.Vin[txInIdx] references
-func verifySig(sigStr []byte, publicKeyStr []byte, scriptPubKey []byte, tx *types.Transaction, txInIdx int) bool {
+// If batch is not nil, the signature is added to it and taken as right
+func verifySig(sigStr []byte, publicKeyStr []byte, scriptPubKey []byte, tx *types.Transaction, txInIdx int,
+	batch *SigBatch) bool {
 	sig, err := crypto.SigFromBytes(sigStr)
 	if err != nil {
 		logger.Debugf("Deserialize signature failed")
@@ -424,6 +457,10 @@ func verifySig(sigStr []byte, publicKeyStr []byte, scriptPubKey []byte, tx *type
 		return false
 	}
 
+	if batch != nil {
+		batch.add(sig, publicKey, sigHash)
+		return true
+	}
 	return sig.VerifySignature(publicKey, sigHash)
 }
 
@@ -524,6 +561,39 @@ func (s *Script) IsPayToScriptHash() bool {
 	return len(r) == 3 && reflect.DeepEqual(r[0], OPHASH160) && isOperandOfLen(r[1], 20) && reflect.DeepEqual(r[2], OPEQUAL)
 }
 
+// IsOpReturn returns if the script is a data carrier output, OP_RETURN
+// followed by pushed data only, which is provably unspendable
+func (s *Script) IsOpReturn() bool {
+	r := s.parse()
+	if len(r) == 0 || !reflect.DeepEqual(r[0], OPRETURN) {
+		return false
+	}
+	return isPushOnly(r[1:])
+}
+
+// IsPushOnly returns if the script only pushes data, as signature scripts do
+func (s *Script) IsPushOnly() bool {
+	return isPushOnly(s.parse())
+}
+
+// are all parsed elements data pushes, small integers included
+func isPushOnly(elements []interface{}) bool {
+	for _, e := range elements {
+		switch v := e.(type) {
+		case Operand:
+		case OpCode:
+			// empty pushes and small integers
+			if v > OP16 || v == OPRESERVED {
+				return false
+			}
+		default:
+			// parse error
+			return false
+		}
+	}
+	return true
+}
+
 // is i of type Operand and of specified length
 func isOperandOfLen(i interface{}, length int) bool {
 	operand, ok := i.(Operand)
@@ -560,6 +630,28 @@ func (s *Script) ExtractAddress() (types.Address, error) {
 	return types.NewAddressPubKeyHash(pubKeyHash)
 }
 
+// ExtractSignerAddress returns the address of the public key within a p2pkh
+// signature script: <signature> <public key>
+func (s *Script) ExtractSignerAddress() (types.Address, error) {
+	r := s.parse()
+	if len(r) != 2 {
+		return nil, ErrAddressNotApplicable
+	}
+	sig, ok := r[0].(Operand)
+	if !ok || len(sig) == 0 {
+		return nil, ErrAddressNotApplicable
+	}
+	pubKeyBytes, ok := r[1].(Operand)
+	if !ok {
+		return nil, ErrAddressNotApplicable
+	}
+	pubKey, err := crypto.PublicKeyFromBytes(pubKeyBytes)
+	if err != nil {
+		return nil, err
+	}
+	return types.NewAddressFromPubKey(pubKey)
+}
+
 // GetSigOpCount returns number of signature operations in a script
 func (s *Script) GetSigOpCount() int {
 	numSigs := 0
@@ -183,6 +183,26 @@ func genMultisigScript(minSigCount, sigCount int) (*Script, *Script) {
 }
 
 // test multisig script
+func TestValidateDeferred(t *testing.T) {
+	// signatures are collected instead of verified
+	batch := new(SigBatch)
+	scriptSig, scriptPubKey, _ := genP2PKHScript(false)
+	ensure.Nil(t, ValidateDeferred(scriptSig, scriptPubKey, tx, 0, batch))
+	scriptSig, scriptPubKey = genP2SHScript()
+	ensure.Nil(t, ValidateDeferred(scriptSig, scriptPubKey, tx, 0, batch))
+	ensure.DeepEqual(t, batch.Len(), 2)
+	ensure.True(t, batch.Verify())
+
+	// an invalid signature passes the script, but fails the batch
+	_, scriptPubKey, _ = genP2PKHScript(false)
+	sig, _ := crypto.Sign(testPrivKey, &crypto.HashType{0x01})
+	scriptSig = NewScript().AddOperand(sig.Serialize()).AddOperand(testPubKeyBytes)
+	ensure.Nil(t, ValidateDeferred(scriptSig, scriptPubKey, tx, 0, batch))
+	ensure.DeepEqual(t, batch.Len(), 3)
+	ensure.False(t, batch.Verify())
+	ensure.NotNil(t, Validate(scriptSig, scriptPubKey, tx, 0))
+}
+
 func TestMultisig(t *testing.T) {
 	for minSigCount := 1; minSigCount <= 3; minSigCount++ {
 		for sigCount := 1; sigCount <= 3; sigCount++ {
@@ -273,6 +293,20 @@ func TestExtractAddress(t *testing.T) {
 	ensure.NotNil(t, err)
 }
 
+func TestExtractSignerAddress(t *testing.T) {
+	scriptSig, scriptPubKey, _ := genP2PKHScript(false)
+	addr, err := scriptSig.ExtractSignerAddress()
+	ensure.Nil(t, err)
+	expectedAddr, _ := types.NewAddressFromPubKey(testPubKey)
+	ensure.DeepEqual(t, expectedAddr, addr)
+
+	// not a p2pkh signature script
+	_, err = scriptPubKey.ExtractSignerAddress()
+	ensure.NotNil(t, err)
+	_, err = NewScript().AddOperand(testPubKeyBytes).ExtractSignerAddress()
+	ensure.NotNil(t, err)
+}
+
 func TestGetNthOp(t *testing.T) {
 	// OPDUP, OPHASH160, testPubKeyHash, OPEQUALVERIFY, OPCHECKSIG
 	_, scriptPubKey, _ := genP2PKHScript(false)
@@ -307,3 +341,35 @@ func TestGetNthOp(t *testing.T) {
 	opCode, _, _, err = scriptPubKey.getNthOp(pc /* start pc */, 3 /* n-th */)
 	ensure.NotNil(t, err)
 }
+
+func TestIsOpReturn(t *testing.T) {
+	ensure.True(t, NewScript().AddOpCode(OPRETURN).IsOpReturn())
+	ensure.True(t, NewScript().AddOpCode(OPRETURN).AddOperand([]byte("data")).IsOpReturn())
+	ensure.True(t, NewScript().AddOpCode(OPRETURN).AddOpCode(OP0).AddOpCode(OP16).IsOpReturn())
+	ensure.False(t, NewScript().AddOpCode(OPRETURN).AddOpCode(OPDUP).IsOpReturn())
+	ensure.False(t, NewScript().AddOperand([]byte("data")).AddOpCode(OPRETURN).IsOpReturn())
+	ensure.False(t, NewScript().IsOpReturn())
+}
+
+func TestIsPushOnly(t *testing.T) {
+	scriptSig, scriptPubKey, _ := genP2PKHScript(false)
+	ensure.True(t, scriptSig.IsPushOnly())
+	ensure.False(t, scriptPubKey.IsPushOnly())
+	ensure.True(t, NewScript().AddOpCode(OP1NEGATE).AddOpCode(OP0).AddOpCode(OP16).IsPushOnly())
+	ensure.False(t, NewScript().AddOpCode(OPRESERVED).IsPushOnly())
+	// truncated push
+	ensure.False(t, NewScriptFromBytes([]byte{0x14, 0x00}).IsPushOnly())
+}
+
+func TestMalformedTokenScript(t *testing.T) {
+	// opcodes where token scripts hold operands must not panic
+	_, s, _ := genP2PKHScript(false)
+	for i := 0; i < 8; i++ {
+		s.AddOpCode(OPDROP)
+	}
+	ensure.False(t, s.IsTokenIssue())
+	for i := 0; i < 4; i++ {
+		s.AddOpCode(OPDROP)
+	}
+	ensure.False(t, s.IsTokenTransfer())
+}
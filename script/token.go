@@ -5,6 +5,7 @@
 package script
 
 import (
+	"bytes"
 	"encoding/binary"
 	"fmt"
 	"reflect"
@@ -137,6 +138,9 @@ func (s *Script) GetTransferParams() (*TransferParams, error) {
 // IsTokenIssue returns if the script is token issurance
 func (s *Script) IsTokenIssue() bool {
 	// two parts: p2pkh + issue parameters
+	if len(*s) < p2PKHScriptLen {
+		return false
+	}
 
 	p2PKHSubScript := NewScriptFromBytes((*s)[:p2PKHScriptLen])
 	if !p2PKHSubScript.IsPayToPubKeyHash() {
@@ -145,14 +149,17 @@ func (s *Script) IsTokenIssue() bool {
 
 	paramsSubScript := NewScriptFromBytes((*s)[p2PKHScriptLen:])
 	r := paramsSubScript.parse()
-	return len(r) == 8 && reflect.DeepEqual([]byte(r[0].(Operand)), TokenNameKey) && reflect.DeepEqual(r[1], OPDROP) &&
-		reflect.DeepEqual(r[3], OPDROP) && reflect.DeepEqual([]byte(r[4].(Operand)), TokenAmountKey) &&
+	return len(r) == 8 && isOperandOf(r[0], TokenNameKey) && reflect.DeepEqual(r[1], OPDROP) &&
+		reflect.DeepEqual(r[3], OPDROP) && isOperandOf(r[4], TokenAmountKey) &&
 		reflect.DeepEqual(r[5], OPDROP) && reflect.DeepEqual(r[7], OPDROP)
 }
 
 // IsTokenTransfer returns if the script is token issurance
 func (s *Script) IsTokenTransfer() bool {
 	// two parts: p2pkh + issue parameters
+	if len(*s) < p2PKHScriptLen {
+		return false
+	}
 
 	p2PKHSubScript := NewScriptFromBytes((*s)[:p2PKHScriptLen])
 	if !p2PKHSubScript.IsPayToPubKeyHash() {
@@ -161,12 +168,19 @@ func (s *Script) IsTokenTransfer() bool {
 
 	paramsSubScript := NewScriptFromBytes((*s)[p2PKHScriptLen:])
 	r := paramsSubScript.parse()
-	return len(r) == 12 && reflect.DeepEqual([]byte(r[0].(Operand)), TokenTxHashKey) && reflect.DeepEqual(r[1], OPDROP) &&
-		reflect.DeepEqual(r[3], OPDROP) && reflect.DeepEqual([]byte(r[4].(Operand)), TokenTxOutIdxKey) &&
-		reflect.DeepEqual(r[5], OPDROP) && reflect.DeepEqual(r[7], OPDROP) && reflect.DeepEqual([]byte(r[8].(Operand)), TokenAmountKey) &&
+	return len(r) == 12 && isOperandOf(r[0], TokenTxHashKey) && reflect.DeepEqual(r[1], OPDROP) &&
+		reflect.DeepEqual(r[3], OPDROP) && isOperandOf(r[4], TokenTxOutIdxKey) &&
+		reflect.DeepEqual(r[5], OPDROP) && reflect.DeepEqual(r[7], OPDROP) && isOperandOf(r[8], TokenAmountKey) &&
 		reflect.DeepEqual(r[9], OPDROP) && reflect.DeepEqual(r[11], OPDROP)
 }
 
+// is i an operand equal to b. Scripts of untrusted txs may hold opcodes
+// where operands are expected
+func isOperandOf(i interface{}, b []byte) bool {
+	operand, ok := i.(Operand)
+	return ok && bytes.Equal(operand, b)
+}
+
 // P2PKHScriptPrefix returns p2pkh prefix of token script
 func (s *Script) P2PKHScriptPrefix() *Script {
 	return NewScriptFromBytes((*s)[:p2PKHScriptLen])
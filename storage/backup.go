@@ -0,0 +1,174 @@
+// Copyright (c) 2018 ContentBox Authors.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package storage
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// manifestFile is the file describing a backup, in the backup directory
+const manifestFile = "BACKUP_MANIFEST.json"
+
+// Backuper defines the storage able to back up without stopping
+type Backuper interface {
+	// Backup writes a consistent point-in-time copy of the storage to dir,
+	// which must not exist
+	Backup(dir string) error
+}
+
+// manifest describes a backup, to verify it before restoring
+type manifest struct {
+	// Name is the name of the storage backed up
+	Name string `json:"name"`
+	// Time is the unix time in seconds the backup was taken at
+	Time int64 `json:"time"`
+	// Files maps paths of files in the backup to their sha256 checksums
+	Files map[string]string `json:"files"`
+}
+
+// Backup writes a consistent point-in-time copy of the database to dir,
+// which must not exist. Tables stored apart are copied to directories next
+// to dir, named after dir and the tables, each consistent on its own. The
+// copy can be restored at startup with the restore option of database config
+func (db *Database) Backup(dir string) error {
+	var dirs = map[string]Storage{dir: db.Storage}
+	for name, s := range db.apart {
+		dirs[apartDir(dir, name)] = s
+	}
+	for dir, s := range dirs {
+		if _, ok := s.(Backuper); !ok {
+			return ErrBackupNotSupported
+		}
+		if _, err := os.Stat(dir); !os.IsNotExist(err) {
+			return ErrBackupExists
+		}
+	}
+	if err := os.MkdirAll(filepath.Dir(dir), 0700); err != nil {
+		return err
+	}
+
+	logger.Infof("Backing up database to %s", dir)
+	for dir, s := range dirs {
+		if err := backup(s.(Backuper), db.name, dir); err != nil {
+			for dir := range dirs {
+				os.RemoveAll(dir)
+			}
+			return err
+		}
+	}
+	return nil
+}
+
+// backup writes a copy of storage name to dir along with its manifest
+func backup(backuper Backuper, name, dir string) error {
+	if err := backuper.Backup(dir); err != nil {
+		os.RemoveAll(dir)
+		return err
+	}
+	files, err := checksums(dir)
+	if err != nil {
+		os.RemoveAll(dir)
+		return err
+	}
+	data, err := json.MarshalIndent(&manifest{Name: name, Time: time.Now().Unix(), Files: files}, "", "  ")
+	if err != nil {
+		os.RemoveAll(dir)
+		return err
+	}
+	return ioutil.WriteFile(filepath.Join(dir, manifestFile), data, 0600)
+}
+
+// restore verifies the backup in dir taken of storage name, and copies it to
+// path, which must be empty if it exists
+func restore(name, dir, path string) error {
+	data, err := ioutil.ReadFile(filepath.Join(dir, manifestFile))
+	if err != nil {
+		return ErrInvalidBackup
+	}
+	var m manifest
+	if err := json.Unmarshal(data, &m); err != nil || m.Name != name {
+		return ErrInvalidBackup
+	}
+	files, err := checksums(dir)
+	if err != nil {
+		return err
+	}
+	delete(files, manifestFile)
+	if len(files) != len(m.Files) {
+		return ErrInvalidBackup
+	}
+	for file, sum := range m.Files {
+		if files[file] != sum {
+			return ErrInvalidBackup
+		}
+	}
+
+	if entries, err := ioutil.ReadDir(path); err == nil && len(entries) > 0 {
+		return ErrDatabaseNotEmpty
+	}
+	logger.Infof("Restoring database from backup %s taken at %s", dir, time.Unix(m.Time, 0))
+	for file := range m.Files {
+		if err := copyFile(filepath.Join(dir, file), filepath.Join(path, file)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// checksums returns sha256 checksums of files in dir by their relative paths
+func checksums(dir string) (map[string]string, error) {
+	var files = make(map[string]string)
+	err := filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil || info.IsDir() {
+			return err
+		}
+		file, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+
+		hash := sha256.New()
+		if _, err := io.Copy(hash, file); err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+		files[rel] = hex.EncodeToString(hash.Sum(nil))
+		return nil
+	})
+	return files, err
+}
+
+// copyFile copies file src to dst, creating directories of dst if missing
+func copyFile(src, dst string) error {
+	if err := os.MkdirAll(filepath.Dir(dst), 0700); err != nil {
+		return err
+	}
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+
+	out, err := os.OpenFile(dst, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0600)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(out, in); err != nil {
+		out.Close()
+		return err
+	}
+	return out.Close()
+}
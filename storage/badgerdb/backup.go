@@ -0,0 +1,38 @@
+// Copyright (c) 2018 ContentBox Authors.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package badgerdb
+
+import (
+	"io"
+
+	"github.com/dgraph-io/badger"
+)
+
+// maxPendingWrites is the max number of batches pending loading a backup
+const maxPendingWrites = 256
+
+// Backup writes a copy of the database at the time it is called to dir, as
+// a badger database
+func (db *bdb) Backup(dir string) error {
+	backup, err := badger.Open(badger.DefaultOptions(dir).WithLogger(badgerLogger{}))
+	if err != nil {
+		return err
+	}
+
+	// badger backs up entries of a read timestamp, streaming them into the
+	// new database
+	r, w := io.Pipe()
+	go func() {
+		_, err := db.badger.Backup(w, 0)
+		w.CloseWithError(err)
+	}()
+	err = backup.Load(r, maxPendingWrites)
+	r.CloseWithError(err)
+
+	if cerr := backup.Close(); err == nil {
+		err = cerr
+	}
+	return err
+}
@@ -0,0 +1,117 @@
+// Copyright (c) 2018 ContentBox Authors.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package badgerdb
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	storage "github.com/BOXFoundation/boxd/storage"
+	"github.com/BOXFoundation/boxd/storage/dbtest"
+	"github.com/facebookgo/ensure"
+	"github.com/jbenet/goprocess"
+)
+
+func TestBackupRestore(t *testing.T) {
+	root := randomPath(t)
+	defer os.RemoveAll(root)
+
+	cfg := &storage.Config{Name: "badgerdb", Path: filepath.Join(root, "db")}
+	db, err := storage.NewDatabase(goprocess.Background(), cfg)
+	ensure.Nil(t, err)
+	table, err := db.Table("t1")
+	ensure.Nil(t, err)
+	verify := dbtest.StorageFillData(t, db, 1000)
+	verifyTable := dbtest.StorageFillData(t, table, 1000)
+
+	backup := filepath.Join(root, "backup")
+	ensure.Nil(t, db.Backup(backup))
+	ensure.DeepEqual(t, db.Backup(backup), storage.ErrBackupExists)
+	// writes after backup are not in it
+	ensure.Nil(t, db.Put([]byte("later"), []byte("later")))
+	ensure.Nil(t, db.Proc().Close())
+
+	// restore only to an empty database
+	cfg.Restore = backup
+	_, err = storage.NewDatabase(goprocess.Background(), cfg)
+	ensure.DeepEqual(t, err, storage.ErrDatabaseNotEmpty)
+
+	cfg.Path = filepath.Join(root, "restored")
+	db, err = storage.NewDatabase(goprocess.Background(), cfg)
+	ensure.Nil(t, err)
+	defer db.Proc().Close()
+
+	verify(db)
+	table, err = db.Table("t1")
+	ensure.Nil(t, err)
+	verifyTable(table)
+	has, err := db.Has([]byte("later"))
+	ensure.Nil(t, err)
+	ensure.False(t, has)
+}
+
+func TestRestoreInvalidBackup(t *testing.T) {
+	root := randomPath(t)
+	defer os.RemoveAll(root)
+
+	cfg := &storage.Config{Name: "badgerdb", Path: filepath.Join(root, "db")}
+	db, err := storage.NewDatabase(goprocess.Background(), cfg)
+	ensure.Nil(t, err)
+	dbtest.StorageFillData(t, db, 100)
+	backup := filepath.Join(root, "backup")
+	ensure.Nil(t, db.Backup(backup))
+	ensure.Nil(t, db.Proc().Close())
+
+	// backup of another storage
+	cfg = &storage.Config{Name: "memdb", Path: filepath.Join(root, "r1"), Restore: backup}
+	_, err = storage.NewDatabase(goprocess.Background(), cfg)
+	ensure.DeepEqual(t, err, storage.ErrInvalidBackup)
+
+	// corrupted backup
+	files, err := filepath.Glob(filepath.Join(backup, "*.vlog"))
+	ensure.Nil(t, err)
+	ensure.True(t, len(files) > 0)
+	ensure.Nil(t, ioutil.WriteFile(files[0], []byte("corrupted"), 0600))
+	cfg = &storage.Config{Name: "badgerdb", Path: filepath.Join(root, "r2"), Restore: backup}
+	_, err = storage.NewDatabase(goprocess.Background(), cfg)
+	ensure.DeepEqual(t, err, storage.ErrInvalidBackup)
+}
+
+func TestBackupRestoreTablesApart(t *testing.T) {
+	root := randomPath(t)
+	defer os.RemoveAll(root)
+
+	cfg := &storage.Config{
+		Name:   "badgerdb",
+		Path:   filepath.Join(root, "db"),
+		Tables: map[string]string{"archive": filepath.Join(root, "archive")},
+	}
+	db, err := storage.NewDatabase(goprocess.Background(), cfg)
+	ensure.Nil(t, err)
+	archive, err := db.Table("archive")
+	ensure.Nil(t, err)
+	verify := dbtest.StorageFillData(t, db, 100)
+	verifyArchive := dbtest.StorageFillData(t, archive, 100)
+
+	backup := filepath.Join(root, "backup")
+	ensure.Nil(t, db.Backup(backup))
+	_, err = os.Stat(backup + ".archive")
+	ensure.Nil(t, err)
+	ensure.Nil(t, db.Proc().Close())
+
+	cfg.Restore = backup
+	cfg.Path = filepath.Join(root, "restored")
+	cfg.Tables = map[string]string{"archive": filepath.Join(root, "restored-archive")}
+	db, err = storage.NewDatabase(goprocess.Background(), cfg)
+	ensure.Nil(t, err)
+	defer db.Proc().Close()
+
+	verify(db)
+	archive, err = db.Table("archive")
+	ensure.Nil(t, err)
+	verifyArchive(archive)
+}
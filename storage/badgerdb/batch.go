@@ -0,0 +1,112 @@
+// Copyright (c) 2018 ContentBox Authors.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package badgerdb
+
+import (
+	storage "github.com/BOXFoundation/boxd/storage"
+	"github.com/dgraph-io/badger"
+)
+
+// operation is a put, or a delete if value is nil, enqueued in a batch
+type operation struct {
+	key   []byte
+	value []byte
+}
+
+type bbatch struct {
+	badger *badger.DB
+	prefix []byte
+	ops    []operation
+}
+
+// put the value to entry associate with the key
+func (b *bbatch) Put(key, value []byte) {
+	b.put(b.prefix, key, value)
+}
+
+// delete the entry associate with the key in the Storage
+func (b *bbatch) Del(key []byte) {
+	b.del(b.prefix, key)
+}
+
+// enqueue put of the value to entry associate with the key in the key space
+// of the prefix
+func (b *bbatch) put(prefix, key, value []byte) {
+	var v = make([]byte, len(value))
+	copy(v, value)
+	b.ops = append(b.ops, operation{key: prefixed(prefix, key), value: v})
+}
+
+// enqueue delete of the entry associate with the key in the key space of the
+// prefix
+func (b *bbatch) del(prefix, key []byte) {
+	b.ops = append(b.ops, operation{key: prefixed(prefix, key)})
+}
+
+// remove all the enqueued put/delete
+func (b *bbatch) Clear() {
+	b.ops = nil
+}
+
+// returns the number of updates in the batch
+func (b *bbatch) Count() int {
+	return len(b.ops)
+}
+
+// atomic writes all enqueued put/delete
+func (b *bbatch) Write() error {
+	return b.badger.Update(func(txn *badger.Txn) error {
+		for _, op := range b.ops {
+			var err error
+			if op.value == nil {
+				err = txn.Delete(op.key)
+			} else {
+				err = txn.Set(op.key, op.value)
+			}
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// close the batch, it must be called to close the batch
+func (b *bbatch) Close() {
+	b.ops = nil
+}
+
+// bmbatch is a batch across tables, whose operations are all enqueued in the
+// batch of the default table
+type bmbatch struct {
+	*bbatch
+
+	db *bdb
+}
+
+// return the writer enqueuing put/delete of the table into the batch
+func (b *bmbatch) Table(name string) (storage.BatchWriter, error) {
+	t, err := b.db.Table(name)
+	if err != nil {
+		return nil, err
+	}
+	return &tbatch{batch: b.bbatch, prefix: t.(*btable).prefix}, nil
+}
+
+// tbatch enqueues put/delete of a table into a batch across tables
+type tbatch struct {
+	batch  *bbatch
+	prefix []byte
+}
+
+// put the value to entry associate with the key
+func (b *tbatch) Put(key, value []byte) {
+	b.batch.put(b.prefix, key, value)
+}
+
+// delete the entry associate with the key in the Storage
+func (b *tbatch) Del(key []byte) {
+	b.batch.del(b.prefix, key)
+}
@@ -0,0 +1,88 @@
+// Copyright (c) 2018 ContentBox Authors.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package badgerdb
+
+import (
+	"encoding/binary"
+
+	"github.com/BOXFoundation/boxd/log"
+	storage "github.com/BOXFoundation/boxd/storage"
+	"github.com/dgraph-io/badger"
+)
+
+var logger = log.NewLogger("badgerdb")
+
+// key spaces of the default table and named tables. Badger has no column
+// families, so tables are emulated by prefixing their keys
+const (
+	defaultSpace byte = iota
+	tableSpace
+)
+
+func init() {
+	// register badgerdb impl
+	storage.Register("badgerdb", NewBadgerDB)
+}
+
+// badgerLogger adapts the boxd logger to the one badger logs with
+type badgerLogger struct{}
+
+func (badgerLogger) Errorf(f string, v ...interface{})   { logger.Errorf(f, v...) }
+func (badgerLogger) Warningf(f string, v ...interface{}) { logger.Warnf(f, v...) }
+func (badgerLogger) Infof(f string, v ...interface{})    { logger.Debugf(f, v...) }
+func (badgerLogger) Debugf(f string, v ...interface{})   { logger.Debugf(f, v...) }
+
+// NewBadgerDB creates a badgerdb instance
+func NewBadgerDB(name string, o *storage.Options) (storage.Storage, error) {
+	logger.Infof("Creating badgerdb at %s", name)
+
+	options := badger.DefaultOptions(name).WithLogger(badgerLogger{})
+	// memtables of badger buffer writes
+	writeBufferSize, err := o.Int64(storage.OptWriteBufferSize, options.MaxTableSize)
+	if err != nil {
+		return nil, err
+	}
+	options = options.WithMaxTableSize(writeBufferSize)
+	for _, key := range []string{storage.OptBlockCacheSize, storage.OptMaxOpenFiles} {
+		if o.IsSet(key) {
+			logger.Warnf("Storage option %s is not supported by badgerdb, ignored", key)
+		}
+	}
+	db, err := badger.Open(options)
+	if err != nil {
+		return nil, err
+	}
+
+	d := &bdb{
+		badger:    db,
+		tables:    map[string]*btable{},
+		writeLock: make(chan struct{}, 1),
+	}
+	d.btable = &btable{
+		badger:    db,
+		prefix:    []byte{defaultSpace},
+		writeLock: d.writeLock,
+	}
+	return d, nil
+}
+
+// tablePrefix returns the prefix of keys in the table associate with the
+// name. The length of the name goes first, so no table prefix is a prefix of
+// another one
+func tablePrefix(name string) []byte {
+	var buf = make([]byte, 1+binary.MaxVarintLen64+len(name))
+	buf[0] = tableSpace
+	n := 1 + binary.PutUvarint(buf[1:], uint64(len(name)))
+	n += copy(buf[n:], name)
+	return buf[:n]
+}
+
+// helper function to prepend prefix to key
+func prefixed(prefix, key []byte) []byte {
+	var buf = make([]byte, len(prefix)+len(key))
+	copy(buf, prefix)
+	copy(buf[len(prefix):], key)
+	return buf
+}
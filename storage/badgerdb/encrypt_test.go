@@ -0,0 +1,58 @@
+// Copyright (c) 2018 ContentBox Authors.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package badgerdb
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	storage "github.com/BOXFoundation/boxd/storage"
+	"github.com/BOXFoundation/boxd/storage/dbtest"
+	"github.com/facebookgo/ensure"
+	"github.com/jbenet/goprocess"
+)
+
+func TestEncryptExistingTable(t *testing.T) {
+	root := randomPath(t)
+	defer os.RemoveAll(root)
+
+	cfg := &storage.Config{Name: "badgerdb", Path: filepath.Join(root, "db"), KeyPath: filepath.Join(root, "db.key")}
+	db, err := storage.NewDatabase(goprocess.Background(), cfg)
+	ensure.Nil(t, err)
+	table, err := db.Table("t1")
+	ensure.Nil(t, err)
+	verify := dbtest.StorageFillData(t, table, 100)
+	ensure.Nil(t, db.Proc().Close())
+
+	// values written before are encrypted
+	cfg.Encrypt = []string{"t1"}
+	db, err = storage.NewDatabase(goprocess.Background(), cfg)
+	ensure.Nil(t, err)
+	table, err = db.Table("t1")
+	ensure.Nil(t, err)
+	verify(table)
+	ensure.Nil(t, db.Proc().Close())
+
+	db, err = storage.NewDatabase(goprocess.Background(), cfg)
+	ensure.Nil(t, err)
+	table, err = db.Table("t1")
+	ensure.Nil(t, err)
+	verify(table)
+	ensure.Nil(t, db.Proc().Close())
+
+	// encrypted tables must stay configured
+	cfg.Encrypt = nil
+	_, err = storage.NewDatabase(goprocess.Background(), cfg)
+	ensure.DeepEqual(t, err, storage.ErrTableEncrypted)
+
+	// with the same key
+	cfg.Encrypt = []string{"t1"}
+	ensure.Nil(t, ioutil.WriteFile(cfg.KeyPath, []byte(strings.Repeat("ab", 32)), 0600))
+	_, err = storage.NewDatabase(goprocess.Background(), cfg)
+	ensure.DeepEqual(t, err, storage.ErrInvalidKey)
+}
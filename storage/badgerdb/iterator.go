@@ -0,0 +1,101 @@
+// Copyright (c) 2018 ContentBox Authors.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package badgerdb
+
+import (
+	"bytes"
+
+	"github.com/dgraph-io/badger"
+)
+
+type biterator struct {
+	// a read-only transaction reads the snapshot of the time it is created
+	txn    *badger.Txn
+	iter   *badger.Iterator
+	prefix []byte
+
+	start   []byte
+	limit   []byte
+	started bool
+
+	key   []byte
+	value []byte
+	err   error
+}
+
+// newIterator creates an iterator over entries of the table with keys in
+// [start, limit)
+func newIterator(t *btable, start, limit []byte) *biterator {
+	var options = badger.DefaultIteratorOptions
+	options.Prefix = t.prefix
+
+	txn := t.badger.NewTransaction(false)
+	it := &biterator{
+		txn:    txn,
+		iter:   txn.NewIterator(options),
+		prefix: t.prefix,
+		start:  prefixed(t.prefix, start),
+	}
+	if limit != nil {
+		it.limit = prefixed(t.prefix, limit)
+	}
+	return it
+}
+
+// move to the next entry, the first one on the first call
+func (it *biterator) Next() bool {
+	if it.iter == nil || it.err != nil {
+		return false
+	}
+
+	if !it.started {
+		it.started = true
+		it.iter.Seek(it.start)
+	} else {
+		it.iter.Next()
+	}
+
+	if !it.iter.Valid() {
+		return false
+	}
+	item := it.iter.Item()
+	key := item.KeyCopy(nil)
+	if it.limit != nil && bytes.Compare(key, it.limit) >= 0 {
+		return false
+	}
+	value, err := item.ValueCopy(nil)
+	if err != nil {
+		it.err = err
+		return false
+	}
+	it.key = key[len(it.prefix):]
+	it.value = value
+	return true
+}
+
+// return the key of the current entry
+func (it *biterator) Key() []byte {
+	return it.key
+}
+
+// return the value of the current entry
+func (it *biterator) Value() []byte {
+	return it.value
+}
+
+// return the error occurred iterating, if any
+func (it *biterator) Error() error {
+	return it.err
+}
+
+// release the snapshot, it must be called to close the iterator
+func (it *biterator) Release() {
+	if it.iter == nil {
+		return
+	}
+	it.iter.Close()
+	it.txn.Discard()
+	it.iter = nil
+}
@@ -0,0 +1,43 @@
+// Copyright (c) 2018 ContentBox Authors.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package badgerdb
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	storage "github.com/BOXFoundation/boxd/storage"
+	"github.com/facebookgo/ensure"
+	"github.com/jbenet/goprocess"
+)
+
+func TestUncleanShutdown(t *testing.T) {
+	root := randomPath(t)
+	defer os.RemoveAll(root)
+
+	cfg := &storage.Config{Name: "badgerdb", Path: filepath.Join(root, "db")}
+	db, err := storage.NewDatabase(goprocess.Background(), cfg)
+	ensure.Nil(t, err)
+	// a new database is clean
+	ensure.False(t, db.Unclean())
+	ensure.Nil(t, db.Proc().Close())
+
+	db, err = storage.NewDatabase(goprocess.Background(), cfg)
+	ensure.Nil(t, err)
+	ensure.False(t, db.Unclean())
+	ensure.Nil(t, db.Proc().Close())
+
+	// the database is left running on a crash
+	s, err := NewBadgerDB(cfg.Path, &cfg.Options)
+	ensure.Nil(t, err)
+	ensure.Nil(t, s.Put([]byte(storage.ShutdownKey), []byte("running")))
+	ensure.Nil(t, s.Close())
+
+	db, err = storage.NewDatabase(goprocess.Background(), cfg)
+	ensure.Nil(t, err)
+	ensure.True(t, db.Unclean())
+	ensure.Nil(t, db.Proc().Close())
+}
@@ -0,0 +1,39 @@
+// Copyright (c) 2018 ContentBox Authors.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package badgerdb
+
+import (
+	storage "github.com/BOXFoundation/boxd/storage"
+	"github.com/dgraph-io/badger"
+)
+
+var _ storage.TableSizer = (*bdb)(nil)
+
+// TableSizes returns approximate sizes of tables opened, summing estimated
+// sizes of their entries
+func (db *bdb) TableSizes() (map[string]int64, error) {
+	db.smtables.Lock()
+	var prefixes = map[string][]byte{storage.DefaultTableName: db.prefix}
+	for name, t := range db.tables {
+		prefixes[name] = t.prefix
+	}
+	db.smtables.Unlock()
+
+	var sizes = make(map[string]int64, len(prefixes))
+	err := db.badger.View(func(txn *badger.Txn) error {
+		for name, prefix := range prefixes {
+			opts := badger.DefaultIteratorOptions
+			opts.PrefetchValues = false
+			opts.Prefix = prefix
+			it := txn.NewIterator(opts)
+			for it.Rewind(); it.Valid(); it.Next() {
+				sizes[name] += it.Item().EstimatedSize()
+			}
+			it.Close()
+		}
+		return nil
+	})
+	return sizes, err
+}
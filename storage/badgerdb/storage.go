@@ -0,0 +1,92 @@
+// Copyright (c) 2018 ContentBox Authors.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package badgerdb
+
+import (
+	"sync"
+	"time"
+
+	storage "github.com/BOXFoundation/boxd/storage"
+	"github.com/dgraph-io/badger"
+)
+
+type bdb struct {
+	// the default table, keys of which are not in any named table
+	*btable
+
+	sm     sync.Mutex
+	badger *badger.DB
+
+	writeLock chan struct{}
+
+	smtables sync.Mutex
+	tables   map[string]*btable
+}
+
+// Create or Get the table associate with the name
+func (db *bdb) Table(name string) (storage.Table, error) {
+	db.smtables.Lock()
+	defer db.smtables.Unlock()
+
+	t, ok := db.tables[name]
+	if !ok {
+		t = &btable{
+			badger:    db.badger,
+			prefix:    tablePrefix(name),
+			writeLock: make(chan struct{}, 1),
+		}
+		db.tables[name] = t
+	}
+
+	return t, nil
+}
+
+// create a new write batch across tables
+func (db *bdb) NewMultiBatch() storage.MultiBatch {
+	return &bmbatch{
+		bbatch: db.NewBatch().(*bbatch),
+		db:     db,
+	}
+}
+
+// Drop the table associate with the name and all its keys
+func (db *bdb) DropTable(name string) error {
+	db.smtables.Lock()
+	defer db.smtables.Unlock()
+
+	delete(db.tables, name)
+	return db.badger.DropPrefix(tablePrefix(name))
+}
+
+func waitLock(c chan<- struct{}) {
+	timer := time.NewTimer(time.Second * 3)
+	defer timer.Stop()
+	select {
+	case c <- struct{}{}:
+	case <-timer.C:
+		logger.Warn("Locking db write timeout...")
+	}
+}
+
+// Close closes the database
+func (db *bdb) Close() error {
+	db.sm.Lock()
+	defer db.sm.Unlock()
+
+	waitLock(db.writeLock)
+	for _, t := range db.tables {
+		waitLock(t.writeLock)
+	}
+
+	for _, t := range db.tables {
+		t.Close()
+	}
+	err := db.badger.Close()
+
+	close(db.writeLock)
+	db.tables = nil
+
+	return err
+}
@@ -0,0 +1,194 @@
+// Copyright (c) 2018 ContentBox Authors.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package badgerdb
+
+import (
+	"context"
+
+	storage "github.com/BOXFoundation/boxd/storage"
+	"github.com/dgraph-io/badger"
+)
+
+type btable struct {
+	badger *badger.DB
+	prefix []byte
+
+	writeLock chan struct{}
+}
+
+// create a new write batch
+func (t *btable) NewBatch() storage.Batch {
+	return &bbatch{
+		badger: t.badger,
+		prefix: t.prefix,
+	}
+}
+
+func (t *btable) NewTransaction() (tr storage.Transaction, err error) {
+	defer func() {
+		if recover() != nil {
+			tr = nil
+			err = storage.ErrDatabasePanic
+		}
+	}()
+
+	// lock all write operations
+	t.writeLock <- struct{}{}
+	tr = &dbtx{
+		db:        t,
+		batch:     t.NewBatch(),
+		closed:    false,
+		writeLock: t.writeLock,
+	}
+
+	return tr, nil
+}
+
+// create an iterator over entries with keys of the prefix
+func (t *btable) NewIterator(prefix []byte) storage.Iterator {
+	return t.NewRangeIterator(storage.PrefixRange(prefix))
+}
+
+// create an iterator over entries with keys in [start, limit)
+func (t *btable) NewRangeIterator(start, limit []byte) storage.Iterator {
+	return newIterator(t, start, limit)
+}
+
+// put the value to entry associate with the key
+func (t *btable) Put(key, value []byte) (err error) {
+	defer func() {
+		if recover() != nil {
+			err = storage.ErrDatabasePanic
+		}
+	}()
+
+	t.writeLock <- struct{}{}
+	err = t.badger.Update(func(txn *badger.Txn) error {
+		return txn.Set(prefixed(t.prefix, key), value)
+	})
+	<-t.writeLock
+	return err
+}
+
+// delete the entry associate with the key in the Storage
+func (t *btable) Del(key []byte) (err error) {
+	defer func() {
+		if recover() != nil {
+			err = storage.ErrDatabasePanic
+		}
+	}()
+
+	t.writeLock <- struct{}{}
+	err = t.badger.Update(func(txn *badger.Txn) error {
+		return txn.Delete(prefixed(t.prefix, key))
+	})
+	<-t.writeLock
+	return err
+}
+
+// return value associate with the key in the Storage
+func (t *btable) Get(key []byte) ([]byte, error) {
+	var value []byte
+	err := t.badger.View(func(txn *badger.Txn) error {
+		item, err := txn.Get(prefixed(t.prefix, key))
+		if err == badger.ErrKeyNotFound {
+			return nil
+		} else if err != nil {
+			return err
+		}
+		value, err = item.ValueCopy(nil)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return value, nil
+}
+
+// check if the entry associate with key exists
+func (t *btable) Has(key []byte) (bool, error) {
+	var exists bool
+	err := t.badger.View(func(txn *badger.Txn) error {
+		_, err := txn.Get(prefixed(t.prefix, key))
+		if err == badger.ErrKeyNotFound {
+			return nil
+		} else if err != nil {
+			return err
+		}
+		exists = true
+		return nil
+	})
+
+	return exists, err
+}
+
+// return a set of keys in the Storage
+func (t *btable) Keys() [][]byte {
+	return t.KeysWithPrefix(nil)
+}
+
+// return a set of keys with specified prefix in the Storage
+func (t *btable) KeysWithPrefix(prefix []byte) [][]byte {
+	var keys [][]byte
+	t.badger.View(func(txn *badger.Txn) error {
+		var iter = t.newIterator(txn, prefix)
+		defer iter.Close()
+
+		for ; iter.Valid(); iter.Next() {
+			keys = append(keys, t.key(iter.Item()))
+		}
+		return nil
+	})
+	return keys
+}
+
+// return a chan to iter all keys
+func (t *btable) IterKeys(ctx context.Context) <-chan []byte {
+	return t.IterKeysWithPrefix(ctx, nil)
+}
+
+// return a chan to iter all keys with specified prefix in the Storage
+func (t *btable) IterKeysWithPrefix(ctx context.Context, prefix []byte) <-chan []byte {
+	var txn = t.badger.NewTransaction(false)
+	var iter = t.newIterator(txn, prefix)
+
+	out := make(chan []byte)
+	go func() {
+		defer close(out)
+		defer txn.Discard()
+		defer iter.Close()
+
+		for ; iter.Valid(); iter.Next() {
+			select {
+			case <-ctx.Done():
+				return
+			case out <- t.key(iter.Item()):
+			}
+		}
+	}()
+	return out
+}
+
+// newIterator creates an iterator over keys in the table with specified
+// prefix, positioned at the first one
+func (t *btable) newIterator(txn *badger.Txn, prefix []byte) *badger.Iterator {
+	var options = badger.DefaultIteratorOptions
+	options.PrefetchValues = false
+	options.Prefix = prefixed(t.prefix, prefix)
+
+	iter := txn.NewIterator(options)
+	iter.Rewind()
+	return iter
+}
+
+// helper function to copy the key of item out of the table prefix
+func (t *btable) key(item *badger.Item) []byte {
+	return item.KeyCopy(nil)[len(t.prefix):]
+}
+
+func (t *btable) Close() {
+	close(t.writeLock)
+}
@@ -0,0 +1,370 @@
+// Copyright (c) 2018 ContentBox Authors.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package badgerdb
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	storage "github.com/BOXFoundation/boxd/storage"
+	"github.com/BOXFoundation/boxd/storage/dbtest"
+	"github.com/facebookgo/ensure"
+)
+
+func TestTableCreateDrop(t *testing.T) {
+	dbpath, db, err := getDatabase()
+	ensure.Nil(t, err)
+	defer releaseDatabase(dbpath, db)
+
+	table, err := db.Table("t1")
+	ensure.Nil(t, err)
+
+	ensure.Nil(t, table.Put([]byte("1234"), []byte("4321")))
+	ensure.Nil(t, table.Put([]byte("!&@%hdg"), []byte("djksfusm, dl")))
+	ensure.Nil(t, db.DropTable("t1"))
+
+	table, err = db.Table("t1")
+	ensure.Nil(t, err)
+	has, err := table.Has([]byte("1234"))
+	ensure.Nil(t, err)
+	ensure.False(t, has)
+}
+
+func TestTableIsolation(t *testing.T) {
+	dbpath, db, err := getDatabase()
+	ensure.Nil(t, err)
+	defer releaseDatabase(dbpath, db)
+
+	// a table name being a prefix of another one must not share keys
+	t1, err := db.Table("t")
+	ensure.Nil(t, err)
+	t2, err := db.Table("t1")
+	ensure.Nil(t, err)
+
+	ensure.Nil(t, db.Put([]byte("k0"), []byte("v0")))
+	ensure.Nil(t, t1.Put([]byte("1k1"), []byte("v1")))
+	ensure.Nil(t, t2.Put([]byte("k2"), []byte("v2")))
+
+	ensure.DeepEqual(t, db.Keys(), [][]byte{[]byte("k0")})
+	ensure.DeepEqual(t, t1.Keys(), [][]byte{[]byte("1k1")})
+	ensure.DeepEqual(t, t2.Keys(), [][]byte{[]byte("k2")})
+
+	ensure.Nil(t, db.DropTable("t"))
+	ensure.DeepEqual(t, len(t1.Keys()), 0)
+	ensure.DeepEqual(t, t2.Keys(), [][]byte{[]byte("k2")})
+	ensure.DeepEqual(t, db.Keys(), [][]byte{[]byte("k0")})
+}
+
+func TestTableCreate(t *testing.T) {
+	dbpath, db, err := getDatabase()
+	ensure.Nil(t, err)
+	defer releaseDatabase(dbpath, db)
+
+	t1, err := db.Table("t1")
+	ensure.Nil(t, err)
+
+	t2, err := db.Table("t1")
+	ensure.Nil(t, err)
+
+	ensure.True(t, t1 == t2)
+}
+
+func TestTablePutGetDel(t *testing.T) {
+	dbpath, db, err := getDatabase()
+	ensure.Nil(t, err)
+	defer releaseDatabase(dbpath, db)
+
+	t1, err := db.Table("t1")
+	ensure.Nil(t, err)
+
+	t.Run("put1", dbtest.StoragePutGetDelTest(t1, []byte("tk1"), []byte("tv1")))
+	t.Run("put2", dbtest.StoragePutGetDelTest(t1, []byte("tk2"), []byte("tv2")))
+	t.Run("put3", dbtest.StoragePutGetDelTest(t1, []byte("tk3"), []byte("tv3")))
+	t.Run("put4", dbtest.StoragePutGetDelTest(t1, []byte("tk4"), []byte("tv4")))
+}
+
+func TestTableDelNotExists(t *testing.T) {
+	dbpath, db, err := getDatabase()
+	ensure.Nil(t, err)
+	defer releaseDatabase(dbpath, db)
+
+	table, err := db.Table("t1")
+	ensure.Nil(t, err)
+	ensure.Nil(t, table.Del([]byte{0x00, 0x01}))
+}
+
+func TestTableDel(t *testing.T) {
+	dbpath, db, err := getDatabase()
+	ensure.Nil(t, err)
+	defer releaseDatabase(dbpath, db)
+
+	table, err := db.Table("t1")
+	ensure.Nil(t, err)
+
+	dbtest.StorageDel(t, table)
+}
+
+func TestTableBatch(t *testing.T) {
+	dbpath, db, err := getDatabase()
+	ensure.Nil(t, err)
+	defer releaseDatabase(dbpath, db)
+
+	table, err := db.Table("t1")
+	ensure.Nil(t, err)
+
+	dbtest.StorageBatch(t, table)
+}
+
+func TestTableBatchs(t *testing.T) {
+	for i := 0; i < 10; i++ {
+		t.Run(fmt.Sprint("t", i), TestTableBatch)
+	}
+}
+
+func TestTableKeys(t *testing.T) {
+	dbpath, db, err := getDatabase()
+	ensure.Nil(t, err)
+	defer releaseDatabase(dbpath, db)
+
+	table, err := db.Table("t1")
+	ensure.Nil(t, err)
+
+	dbtest.StorageKeys(t, table)(t, table)
+}
+
+func TestTableIterKeys(t *testing.T) {
+	dbpath, db, err := getDatabase()
+	ensure.Nil(t, err)
+	defer releaseDatabase(dbpath, db)
+
+	table, _ := db.Table("t1")
+	verify := dbtest.StorageIterKeys(t, table)
+
+	verify(t, table)
+}
+
+func TestTableIterKeysCancel(t *testing.T) {
+	dbpath, db, err := getDatabase()
+	ensure.Nil(t, err)
+	defer releaseDatabase(dbpath, db)
+
+	table, _ := db.Table("t1")
+	dbtest.StorageIterKeysCancel(t, table)(t, table)
+}
+
+func TestTableKeysWithPrefix(t *testing.T) {
+	dbpath, db, err := getDatabase()
+	ensure.Nil(t, err)
+	defer releaseDatabase(dbpath, db)
+
+	table, err := db.Table("t1")
+	ensure.Nil(t, err)
+
+	dbtest.StoragePrefixKeys(t, table, 10000)(t, table)
+}
+
+func TestTableKeysWithPrefixRand(t *testing.T) {
+	dbpath, db, err := getDatabase()
+	ensure.Nil(t, err)
+	defer releaseDatabase(dbpath, db)
+
+	table, err := db.Table("t1")
+	ensure.Nil(t, err)
+
+	dbtest.StoragePrefixKeysRand(t, table)(t, table)
+}
+
+func TestTableIterKeysWithPrefix(t *testing.T) {
+	dbpath, db, err := getDatabase()
+	ensure.Nil(t, err)
+	defer releaseDatabase(dbpath, db)
+
+	table, _ := db.Table("t1")
+	dbtest.StorageIterKeysWithPrefix(t, table)(t, table)
+}
+
+func TestTableIterKeysWithPrefixCancel(t *testing.T) {
+	dbpath, db, err := getDatabase()
+	ensure.Nil(t, err)
+	defer releaseDatabase(dbpath, db)
+
+	table, _ := db.Table("t1")
+	dbtest.StorageIterKeysWithPrefixCancel(t, table)(t, table)
+}
+
+func TestTableIterator(t *testing.T) {
+	dbpath, db, err := getDatabase()
+	ensure.Nil(t, err)
+	defer releaseDatabase(dbpath, db)
+
+	table, err := db.Table("t1")
+	ensure.Nil(t, err)
+
+	dbtest.StorageIterator(t, table)
+}
+
+func TestTableRangeIterator(t *testing.T) {
+	dbpath, db, err := getDatabase()
+	ensure.Nil(t, err)
+	defer releaseDatabase(dbpath, db)
+
+	table, err := db.Table("t1")
+	ensure.Nil(t, err)
+
+	dbtest.StorageRangeIterator(t, table)
+}
+
+func TestTableIteratorSnapshot(t *testing.T) {
+	dbpath, db, err := getDatabase()
+	ensure.Nil(t, err)
+	defer releaseDatabase(dbpath, db)
+
+	table, err := db.Table("t1")
+	ensure.Nil(t, err)
+
+	dbtest.StorageIteratorSnapshot(t, table)
+}
+
+func TestTablePersistent(t *testing.T) {
+	dbpath, db, err := getDatabase()
+	ensure.Nil(t, err)
+	defer os.RemoveAll(dbpath)
+
+	table, err := db.Table("t")
+	ensure.Nil(t, err)
+
+	verify := dbtest.StorageFillData(t, table, 1000)
+	db.Close()
+
+	db, err = NewBadgerDB(dbpath, &storage.Options{})
+	ensure.Nil(t, err)
+	defer db.Close()
+
+	table, err = db.Table("t")
+	ensure.Nil(t, err)
+
+	verify(table)
+}
+
+func TestTableTransaction(t *testing.T) {
+	dbpath, db, err := getDatabase()
+	ensure.Nil(t, err)
+	defer os.RemoveAll(dbpath)
+	defer db.Close()
+
+	table, _ := db.Table("t1")
+	dbtest.StorageTransOps(t, table)
+}
+
+func TestTableMulTransactions(t *testing.T) {
+	dbpath, db, err := getDatabase()
+	ensure.Nil(t, err)
+	defer os.RemoveAll(dbpath)
+	defer db.Close()
+
+	t1, _ := db.Table("tx")
+	dbtest.StorageMultiTransTable(t, t1)
+}
+
+func TestTableTransactionsClose(t *testing.T) {
+	dbpath, db, err := getDatabase()
+	ensure.Nil(t, err)
+	defer os.RemoveAll(dbpath)
+
+	table, _ := db.Table("t1")
+
+	dbtest.StorageDBCloseForTransOpen(t, table, db)
+}
+
+func TestTableSyncTransaction(t *testing.T) {
+	dbpath, db, err := getDatabase()
+	ensure.Nil(t, err)
+	defer os.RemoveAll(dbpath)
+	defer db.Close()
+
+	table, _ := db.Table("t1")
+	dbtest.StorageSyncTransaction(t, table)
+}
+
+func TestTableBatchAndTransaction(t *testing.T) {
+	dbpath, db, err := getDatabase()
+	ensure.Nil(t, err)
+	defer os.RemoveAll(dbpath)
+	defer db.Close()
+
+	table, _ := db.Table("t1")
+	dbtest.StorageBatchAndTrans(t, table)
+}
+
+func TestTableTransactionsClosed(t *testing.T) {
+	dbpath, db, err := getDatabase()
+	ensure.Nil(t, err)
+	defer os.RemoveAll(dbpath)
+
+	table, _ := db.Table("t1")
+	dbtest.StorageTransClosed(t, table)
+}
+
+func TestTableTransactionKeys(t *testing.T) {
+	dbpath, db, err := getDatabase()
+	ensure.Nil(t, err)
+	defer releaseDatabase(dbpath, db)
+
+	table, _ := db.Table("trans")
+	verify := dbtest.StorageKeys(t, table)
+
+	tx, _ := table.NewTransaction()
+	defer tx.Discard()
+	verify(t, tx)
+}
+
+func TestTableTransactionKeysWithPrefix(t *testing.T) {
+	dbpath, db, err := getDatabase()
+	ensure.Nil(t, err)
+	defer releaseDatabase(dbpath, db)
+
+	table, _ := db.Table("trans")
+	dbtest.StorageTransKeysWithPrefix(t, table)
+}
+
+func TestTableTransactionKeysWithPrefixRand(t *testing.T) {
+	dbpath, db, err := getDatabase()
+	ensure.Nil(t, err)
+	defer releaseDatabase(dbpath, db)
+
+	table, _ := db.Table("trans")
+
+	verify := dbtest.StoragePrefixKeysRand(t, table)
+	tx, _ := table.NewTransaction()
+	defer tx.Discard()
+	verify(t, tx)
+}
+
+func TestTableTransactionIterKeysWithPrefix(t *testing.T) {
+	dbpath, db, err := getDatabase()
+	ensure.Nil(t, err)
+	defer releaseDatabase(dbpath, db)
+
+	table, _ := db.Table("trans")
+	verify := dbtest.StorageIterKeysWithPrefix(t, table)
+
+	tx, _ := table.NewTransaction()
+	defer tx.Discard()
+	verify(t, tx)
+}
+
+func TestTableTransactionIterKeys(t *testing.T) {
+	dbpath, db, err := getDatabase()
+	ensure.Nil(t, err)
+	defer releaseDatabase(dbpath, db)
+
+	table, _ := db.Table("trans")
+	verify := dbtest.StorageIterKeys(t, table)
+
+	tx, _ := table.NewTransaction()
+	defer tx.Discard()
+	verify(t, tx)
+}
@@ -4,13 +4,18 @@
 
 package storage
 
-// Batch defines the batch of put, del operations
-type Batch interface {
+// BatchWriter defines the put, del operations enqueued in a batch
+type BatchWriter interface {
 	// put the value to entry associate with the key
 	Put(key, value []byte)
 
 	// delete the entry associate with the key in the Storage
 	Del(key []byte)
+}
+
+// Batch defines the batch of put, del operations
+type Batch interface {
+	BatchWriter
 
 	// remove all the enqueued put/delete
 	Clear()
@@ -24,3 +29,14 @@ type Batch interface {
 	// close the batch, it must be called to close the batch
 	Close()
 }
+
+// MultiBatch defines the batch of put, del operations on multiple tables of
+// a storage, which are written atomically. Put and Del of the batch itself
+// go to the default table of the storage
+type MultiBatch interface {
+	Batch
+
+	// return the writer enqueuing put/delete of the table associate with the
+	// name into the batch
+	Table(name string) (BatchWriter, error)
+}
@@ -18,30 +18,234 @@ type Config struct {
 	Name    string  `mapstructure:"name"`
 	Path    string  `mapstructure:"path"`
 	Options Options `mapstructure:"options"`
+	// Restore is the directory of a backup to restore the database from at
+	// startup. The database must be empty then
+	Restore string `mapstructure:"restore"`
+	// Tables maps names of tables to paths to store them at apart from the
+	// database, e.g. to keep append-only archives on cheaper volumes. Writes
+	// to tables apart are not atomic with those to the database
+	Tables map[string]string `mapstructure:"tables"`
+	// Encrypt lists names of tables whose values are encrypted at rest with
+	// the node key in file KeyPath, generated if missing. Keys of entries are
+	// kept plain for lookups and iteration
+	Encrypt []string `mapstructure:"encrypt"`
+	KeyPath string   `mapstructure:"key_path"`
+	// DiskSpace defines thresholds of free space on volumes of the database
+	// below which writes are refused
+	DiskSpace DiskSpaceConfig `mapstructure:"disk_space"`
 }
 
-// Database is a wrapper of Storage, implementing the database life cycle
+// Database is a wrapper of Storage, implementing the database life cycle.
+// Operations on the database and its tables are timed
 type Database struct {
+	// free disk space in bytes, first to be 64-bit aligned for atomic access
+	diskFree uint64
+
 	Storage
+	name string
 	proc goprocess.Process
 	sm   sync.Mutex
+
+	// the default table, timed
+	root     *ttable
+	smtables sync.Mutex
+	tables   map[string]*ttable
+	// storages of tables stored apart, by names of the tables
+	apart map[string]Storage
+	// sealer of tables encrypted, nil if none is
+	sealer    *sealer
+	encrypted map[string]bool
+
+	// level of free disk space, checked periodically
+	diskCfg       DiskSpaceConfig
+	diskLevel     int32
+	smdisk        sync.Mutex
+	diskNotifiees []func(DiskSpaceLevel, uint64)
+
+	// if the database was not closed cleanly the last time it was open
+	unclean bool
 }
 
 // NewDatabase creates a database instance
 func NewDatabase(parent goprocess.Process, cfg *Config) (*Database, error) {
+	if len(cfg.Restore) > 0 {
+		if err := restore(cfg.Name, cfg.Restore, cfg.Path); err != nil {
+			return nil, err
+		}
+	}
+
 	var storage, err = newStorage(cfg.Name, cfg.Path, &cfg.Options)
 	if err != nil {
 		return nil, err
 	}
+	apart, err := openApart(cfg)
+	if err != nil {
+		storage.Close()
+		return nil, err
+	}
 
 	var database = &Database{
 		Storage: storage,
+		name:    cfg.Name,
 		proc:    goprocess.WithParent(parent),
+		root:    &ttable{Table: storage},
+		tables:  make(map[string]*ttable),
+		apart:   apart,
+		diskCfg: diskSpaceConfig(&cfg.DiskSpace),
+	}
+	if err := database.initEncryption(cfg); err != nil {
+		storage.Close()
+		closeAll(apart)
+		return nil, err
+	}
+	if database.unclean, err = markRunning(storage); err != nil {
+		storage.Close()
+		closeAll(apart)
+		return nil, err
+	}
+	if database.unclean {
+		logger.Warnf("Database %s was not closed cleanly", cfg.Path)
 	}
 	database.proc.SetTeardown(database.shutdown)
+	database.proc.Go(func(p goprocess.Process) {
+		reportStats(p, database)
+	})
+	if paths := diskSpacePaths(cfg); len(paths) > 0 {
+		database.proc.Go(func(p goprocess.Process) {
+			database.monitorDiskSpace(p, paths)
+		})
+	}
 	return database, nil
 }
 
+// openApart opens storages of tables stored apart, restoring them from
+// backups of them first if the database is restored
+func openApart(cfg *Config) (map[string]Storage, error) {
+	var apart = make(map[string]Storage)
+	for name, path := range cfg.Tables {
+		if len(cfg.Restore) > 0 {
+			if err := restore(cfg.Name, apartDir(cfg.Restore, name), path); err != nil {
+				closeAll(apart)
+				return nil, err
+			}
+		}
+		logger.Infof("Storing table %s apart at %s", name, path)
+		s, err := newStorage(cfg.Name, path, &cfg.Options)
+		if err != nil {
+			closeAll(apart)
+			return nil, err
+		}
+		apart[name] = s
+	}
+	return apart, nil
+}
+
+// apartDir returns the directory of table name stored apart relative to the
+// one of the database, e.g. of a backup
+func apartDir(dir, name string) string {
+	return dir + "." + name
+}
+
+// closeAll closes storages
+func closeAll(storages map[string]Storage) {
+	for _, s := range storages {
+		s.Close()
+	}
+}
+
+// Table creates or gets the table associate with the name
+func (db *Database) Table(name string) (Table, error) {
+	db.smtables.Lock()
+	defer db.smtables.Unlock()
+
+	if t, ok := db.tables[name]; ok {
+		return t, nil
+	}
+	t, err := db.table(name)
+	if err != nil {
+		return nil, err
+	}
+	if db.encrypted[name] {
+		t = &etable{Table: t, sealer: db.sealer}
+	}
+	db.tables[name] = &ttable{Table: t}
+	return db.tables[name], nil
+}
+
+// table returns the table associate with the name as it's stored
+func (db *Database) table(name string) (Table, error) {
+	if s, ok := db.apart[name]; ok {
+		return s, nil
+	}
+	return db.Storage.Table(name)
+}
+
+// DropTable drops the table associate with the name and all its keys
+func (db *Database) DropTable(name string) error {
+	db.smtables.Lock()
+	defer db.smtables.Unlock()
+
+	delete(db.tables, name)
+	if s, ok := db.apart[name]; ok {
+		// the storage apart is kept, with all its keys deleted
+		batch := s.NewBatch()
+		defer batch.Close()
+		for _, key := range s.Keys() {
+			batch.Del(key)
+		}
+		return batch.Write()
+	}
+	return db.Storage.DropTable(name)
+}
+
+// Get gets the value of entry associate with the key
+func (db *Database) Get(key []byte) ([]byte, error) {
+	return db.root.Get(key)
+}
+
+// Has checks if the entry associate with key exists
+func (db *Database) Has(key []byte) (bool, error) {
+	return db.root.Has(key)
+}
+
+// Put puts the value to entry associate with the key
+func (db *Database) Put(key, value []byte) error {
+	return db.root.Put(key, value)
+}
+
+// Del deletes the entry associate with the key
+func (db *Database) Del(key []byte) error {
+	return db.root.Del(key)
+}
+
+// NewBatch creates a new write batch
+func (db *Database) NewBatch() Batch {
+	return db.root.NewBatch()
+}
+
+// NewMultiBatch creates a new write batch across tables
+func (db *Database) NewMultiBatch() MultiBatch {
+	var batch = db.newMultiBatch()
+	if len(db.encrypted) > 0 {
+		batch = &emultibatch{MultiBatch: batch, sealer: db.sealer, encrypted: db.encrypted}
+	}
+	return &tmbatch{MultiBatch: batch}
+}
+
+// newMultiBatch creates a new write batch across tables as they are stored
+func (db *Database) newMultiBatch() MultiBatch {
+	var batch = db.Storage.NewMultiBatch()
+	if len(db.apart) > 0 {
+		batch = &amultibatch{MultiBatch: batch, apart: db.apart, batches: map[string]Batch{}}
+	}
+	return batch
+}
+
+// NewTransaction creates a new transaction
+func (db *Database) NewTransaction() (Transaction, error) {
+	return db.root.NewTransaction()
+}
+
 // Proc returns the gopreocess of database
 func (db *Database) Proc() goprocess.Process {
 	return db.proc
@@ -65,6 +269,103 @@ func (db *Database) shutdown() error {
 	defer db.sm.Unlock()
 
 	logger.Info("Shutdown database...")
+	// services writing to the database are closed before it as its children
+	if err := markClean(db.Storage); err != nil {
+		logger.Errorf("Failed to mark database closed cleanly. Err: %v", err)
+	}
 	db.Storage.Close()
+	closeAll(db.apart)
 	return nil
 }
+
+// TableSizes returns approximate sizes in bytes of tables of the database
+// and those stored apart
+func (db *Database) TableSizes() (map[string]int64, error) {
+	var sizes = make(map[string]int64)
+	if sizer, ok := db.Storage.(TableSizer); ok {
+		s, err := sizer.TableSizes()
+		if err != nil {
+			return nil, err
+		}
+		for name, size := range s {
+			sizes[name] = size
+		}
+	}
+	for name, storage := range db.apart {
+		if sizer, ok := storage.(TableSizer); ok {
+			s, err := sizer.TableSizes()
+			if err != nil {
+				return nil, err
+			}
+			sizes[name] = s[DefaultTableName]
+		}
+	}
+	return sizes, nil
+}
+
+// CacheStats returns the number of cache hits and misses of the database
+// since opened
+func (db *Database) CacheStats() (hits, misses int64) {
+	if stater, ok := db.Storage.(CacheStater); ok {
+		return stater.CacheStats()
+	}
+	return 0, 0
+}
+
+// amultibatch is a batch across tables of the database, some of which are
+// stored apart. Batches of tables apart are written before the one of the
+// database, so that data archived apart is written before data refering to
+// it
+type amultibatch struct {
+	MultiBatch
+	apart   map[string]Storage
+	batches map[string]Batch
+}
+
+// return the writer of the table associate with the name
+func (b *amultibatch) Table(name string) (BatchWriter, error) {
+	s, ok := b.apart[name]
+	if !ok {
+		return b.MultiBatch.Table(name)
+	}
+	if batch, ok := b.batches[name]; ok {
+		return batch, nil
+	}
+	b.batches[name] = s.NewBatch()
+	return b.batches[name], nil
+}
+
+// remove all the enqueued put/delete
+func (b *amultibatch) Clear() {
+	for _, batch := range b.batches {
+		batch.Clear()
+	}
+	b.MultiBatch.Clear()
+}
+
+// returns the number of updates in the batch
+func (b *amultibatch) Count() int {
+	var count = b.MultiBatch.Count()
+	for _, batch := range b.batches {
+		count += batch.Count()
+	}
+	return count
+}
+
+// writes all enqueued put/delete, atomically per storage
+func (b *amultibatch) Write() error {
+	for _, batch := range b.batches {
+		if err := batch.Write(); err != nil {
+			return err
+		}
+	}
+	return b.MultiBatch.Write()
+}
+
+// close the batch
+func (b *amultibatch) Close() {
+	for _, batch := range b.batches {
+		batch.Close()
+	}
+	b.MultiBatch.Close()
+}
@@ -571,6 +571,58 @@ func StorageBatch(t *testing.T, s storage.Table) {
 	}
 }
 
+// StorageMultiBatch tests batches across tables
+func StorageMultiBatch(t *testing.T, s storage.Storage) {
+	t1, err := s.Table("mb1")
+	ensure.Nil(t, err)
+	t2, err := s.Table("mb2")
+	ensure.Nil(t, err)
+	ensure.Nil(t, t2.Put([]byte("deleted"), []byte("value")))
+
+	var batch = s.NewMultiBatch()
+	defer batch.Close()
+
+	b1, err := batch.Table("mb1")
+	ensure.Nil(t, err)
+	b2, err := batch.Table("mb2")
+	ensure.Nil(t, err)
+	batch.Put([]byte("key-0"), []byte("value-0"))
+	b1.Put([]byte("key-1"), []byte("value-1"))
+	b2.Put([]byte("key-2"), []byte("value-2"))
+	b2.Del([]byte("deleted"))
+	ensure.DeepEqual(t, batch.Count(), 4)
+
+	// nothing is written before the batch is
+	has, err := t1.Has([]byte("key-1"))
+	ensure.Nil(t, err)
+	ensure.False(t, has)
+	ensure.Nil(t, batch.Write())
+
+	for _, c := range []struct {
+		table storage.Table
+		key   string
+		value string
+	}{
+		{s, "key-0", "value-0"},
+		{t1, "key-1", "value-1"},
+		{t2, "key-2", "value-2"},
+		{s, "key-1", ""},
+		{t1, "key-2", ""},
+		{t2, "deleted", ""},
+	} {
+		value, err := c.table.Get([]byte(c.key))
+		ensure.Nil(t, err)
+		if c.value == "" {
+			ensure.True(t, value == nil)
+		} else {
+			ensure.DeepEqual(t, value, []byte(c.value))
+		}
+	}
+
+	batch.Clear()
+	ensure.DeepEqual(t, batch.Count(), 0)
+}
+
 // StorageDel is a dbtest helper method
 func StorageDel(t *testing.T, s storage.Table) {
 	var keys = [][]byte{}
@@ -729,3 +781,100 @@ func StorageIterKeysWithPrefixCancel(t *testing.T, s storage.Operations) func(*t
 		ensure.True(t, i < 100)
 	}
 }
+
+func iterate(t *testing.T, it storage.Iterator) (keys, values [][]byte) {
+	defer it.Release()
+	for it.Next() {
+		keys = append(keys, it.Key())
+		values = append(values, it.Value())
+	}
+	ensure.Nil(t, it.Error())
+	return keys, values
+}
+
+func fillOrdered(t *testing.T, s storage.Table) {
+	for _, i := range rand.Perm(100) {
+		k := []byte(fmt.Sprintf("key-%04d", i))
+		v := []byte(fmt.Sprintf("value-%d", i))
+		ensure.Nil(t, s.Put(k, v))
+	}
+	ensure.Nil(t, s.Put([]byte("other"), []byte("other")))
+}
+
+func ensureOrdered(t *testing.T, keys, values [][]byte, from, to int) {
+	ensure.DeepEqual(t, len(keys), to-from)
+	for i := from; i < to; i++ {
+		ensure.DeepEqual(t, keys[i-from], []byte(fmt.Sprintf("key-%04d", i)))
+		ensure.DeepEqual(t, values[i-from], []byte(fmt.Sprintf("value-%d", i)))
+	}
+}
+
+// StorageIterator is a dbtest helper method
+func StorageIterator(t *testing.T, s storage.Table) {
+	fillOrdered(t, s)
+
+	keys, values := iterate(t, s.NewIterator([]byte("key-001")))
+	ensureOrdered(t, keys, values, 10, 20)
+
+	keys, values = iterate(t, s.NewIterator([]byte("key-")))
+	ensureOrdered(t, keys, values, 0, 100)
+
+	keys, _ = iterate(t, s.NewIterator(nil))
+	ensure.DeepEqual(t, len(keys), 101)
+	ensure.DeepEqual(t, keys[100], []byte("other"))
+
+	keys, _ = iterate(t, s.NewIterator([]byte("none")))
+	ensure.DeepEqual(t, len(keys), 0)
+}
+
+// StorageRangeIterator is a dbtest helper method
+func StorageRangeIterator(t *testing.T, s storage.Table) {
+	fillOrdered(t, s)
+
+	keys, values := iterate(t, s.NewRangeIterator([]byte("key-0010"), []byte("key-0020")))
+	ensureOrdered(t, keys, values, 10, 20)
+
+	keys, values = iterate(t, s.NewRangeIterator([]byte("key-00155"), []byte("key-0017")))
+	ensureOrdered(t, keys, values, 16, 17)
+
+	keys, _ = iterate(t, s.NewRangeIterator([]byte("key-0095"), nil))
+	ensure.DeepEqual(t, len(keys), 6)
+	ensure.DeepEqual(t, keys[5], []byte("other"))
+
+	keys, values = iterate(t, s.NewRangeIterator(nil, []byte("key-0005")))
+	ensureOrdered(t, keys, values, 0, 5)
+}
+
+// StorageIteratorSnapshot is a dbtest helper method
+func StorageIteratorSnapshot(t *testing.T, s storage.Table) {
+	fillOrdered(t, s)
+
+	it := s.NewIterator([]byte("key-000"))
+	ensure.Nil(t, s.Put([]byte("key-0005"), []byte("changed")))
+	ensure.Nil(t, s.Put([]byte("key-0005a"), []byte("added")))
+	ensure.Nil(t, s.Del([]byte("key-0003")))
+
+	keys, values := iterate(t, it)
+	ensureOrdered(t, keys, values, 0, 10)
+
+	keys, _ = iterate(t, s.NewIterator([]byte("key-000")))
+	ensure.DeepEqual(t, len(keys), 10)
+}
+
+// StorageTableSizes tests sizes of tables told by storage
+func StorageTableSizes(t *testing.T, s storage.Storage) {
+	sizer, ok := s.(storage.TableSizer)
+	ensure.True(t, ok)
+
+	t1, err := s.Table("sized")
+	ensure.Nil(t, err)
+	ensure.Nil(t, s.Put([]byte("key"), make([]byte, 100)))
+	for i := 0; i < 100; i++ {
+		ensure.Nil(t, t1.Put([]byte(fmt.Sprintf("key-%d", i)), make([]byte, 100)))
+	}
+
+	sizes, err := sizer.TableSizes()
+	ensure.Nil(t, err)
+	ensure.True(t, sizes[storage.DefaultTableName] >= 100)
+	ensure.True(t, sizes["sized"] >= 100*100)
+}
@@ -0,0 +1,203 @@
+// Copyright (c) 2018 ContentBox Authors.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package storage
+
+import (
+	"sync/atomic"
+	"time"
+
+	"github.com/BOXFoundation/boxd/metrics"
+	"github.com/jbenet/goprocess"
+)
+
+// default thresholds of free disk space in megabytes, and interval to check
+const (
+	defaultDiskSpaceLow      = 1024
+	defaultDiskSpaceCritical = 256
+	defaultDiskSpaceInterval = 10 * time.Second
+
+	megabyte = 1024 * 1024
+)
+
+// MetricsDiskFreeGauge records free space in bytes of the volume of the
+// database with the least of it
+var MetricsDiskFreeGauge = metrics.NewGauge("box.storage.disk.free")
+
+// DiskSpaceConfig defines thresholds of free space on volumes of the database
+// and tables stored apart, in megabytes
+type DiskSpaceConfig struct {
+	// Low is the free space below which alerts are emitted and non-essential
+	// writes, e.g. backups, are refused
+	Low uint64 `mapstructure:"low"`
+	// Critical is the free space below which essential writes, e.g. blocks,
+	// are refused too, so that the database is not left half written when
+	// the disk is full
+	Critical uint64        `mapstructure:"critical"`
+	Interval time.Duration `mapstructure:"interval"`
+}
+
+// DiskSpaceLevel is the level of free disk space against thresholds
+type DiskSpaceLevel int32
+
+// levels of free disk space
+const (
+	DiskSpaceOK DiskSpaceLevel = iota
+	DiskSpaceLow
+	DiskSpaceCritical
+)
+
+func (l DiskSpaceLevel) String() string {
+	switch l {
+	case DiskSpaceOK:
+		return "ok"
+	case DiskSpaceLow:
+		return "low"
+	case DiskSpaceCritical:
+		return "critical"
+	}
+	return "unknown"
+}
+
+// DiskSpaceChecker defines the storage monitoring free space of its volumes
+type DiskSpaceChecker interface {
+	// CheckDiskSpace returns an error if there is not enough free space to
+	// write. Writes essential to the node are allowed until it's critical
+	CheckDiskSpace(essential bool) error
+}
+
+// diskSpaceConfig returns cfg with defaults for thresholds and interval not
+// configured
+func diskSpaceConfig(cfg *DiskSpaceConfig) DiskSpaceConfig {
+	var c = *cfg
+	if c.Critical == 0 {
+		c.Critical = defaultDiskSpaceCritical
+	}
+	if c.Low == 0 {
+		c.Low = defaultDiskSpaceLow
+	}
+	if c.Low < c.Critical {
+		c.Low = c.Critical
+	}
+	if c.Interval <= 0 {
+		c.Interval = defaultDiskSpaceInterval
+	}
+	return c
+}
+
+// diskSpaceLevel returns the level of free space in bytes against cfg
+func diskSpaceLevel(cfg *DiskSpaceConfig, free uint64) DiskSpaceLevel {
+	switch {
+	case free < cfg.Critical*megabyte:
+		return DiskSpaceCritical
+	case free < cfg.Low*megabyte:
+		return DiskSpaceLow
+	}
+	return DiskSpaceOK
+}
+
+// diskSpacePaths returns directories of volumes of the database to monitor,
+// none if the database is in memory
+func diskSpacePaths(cfg *Config) []string {
+	if len(cfg.Path) == 0 {
+		return nil
+	}
+	var paths = []string{cfg.Path}
+	for _, path := range cfg.Tables {
+		paths = append(paths, path)
+	}
+	return paths
+}
+
+// monitorDiskSpace checks free space on volumes at paths periodically until
+// p is closing
+func (db *Database) monitorDiskSpace(p goprocess.Process, paths []string) {
+	ticker := time.NewTicker(db.diskCfg.Interval)
+	defer ticker.Stop()
+	for {
+		if free, err := leastFreeSpace(paths); err == nil {
+			db.updateDiskSpace(free)
+		} else {
+			logger.Warnf("Failed to get free disk space. Err: %v", err)
+		}
+
+		select {
+		case <-p.Closing():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// leastFreeSpace returns the least free space in bytes of volumes at paths
+func leastFreeSpace(paths []string) (uint64, error) {
+	var least uint64
+	for i, path := range paths {
+		free, err := freeSpace(path)
+		if err != nil {
+			return 0, err
+		}
+		if i == 0 || free < least {
+			least = free
+		}
+	}
+	return least, nil
+}
+
+// updateDiskSpace updates free space in bytes and its level, alerting and
+// notifying if the level changes
+func (db *Database) updateDiskSpace(free uint64) {
+	MetricsDiskFreeGauge.Update(int64(free))
+	atomic.StoreUint64(&db.diskFree, free)
+	level := diskSpaceLevel(&db.diskCfg, free)
+	old := DiskSpaceLevel(atomic.SwapInt32(&db.diskLevel, int32(level)))
+	if level == old {
+		return
+	}
+
+	switch level {
+	case DiskSpaceOK:
+		logger.Infof("Free disk space recovered to %d MB", free/megabyte)
+	case DiskSpaceLow:
+		logger.Warnf("Free disk space is low: %d MB, below %d MB. Non-essential writes are refused",
+			free/megabyte, db.diskCfg.Low)
+	case DiskSpaceCritical:
+		logger.Errorf("Free disk space is critical: %d MB, below %d MB. Blocks are no longer processed",
+			free/megabyte, db.diskCfg.Critical)
+	}
+	db.smdisk.Lock()
+	notifiees := db.diskNotifiees
+	db.smdisk.Unlock()
+	for _, notifiee := range notifiees {
+		notifiee(level, free)
+	}
+}
+
+// DiskSpace returns the level of free disk space and free space in bytes of
+// the volume of the database with the least of it
+func (db *Database) DiskSpace() (DiskSpaceLevel, uint64) {
+	return DiskSpaceLevel(atomic.LoadInt32(&db.diskLevel)), atomic.LoadUint64(&db.diskFree)
+}
+
+// CheckDiskSpace returns an error if there is not enough free space to write.
+// Writes essential to the node are allowed until it's critical
+func (db *Database) CheckDiskSpace(essential bool) error {
+	switch level, _ := db.DiskSpace(); level {
+	case DiskSpaceCritical:
+		return ErrDiskSpaceCritical
+	case DiskSpaceLow:
+		if !essential {
+			return ErrDiskSpaceLow
+		}
+	}
+	return nil
+}
+
+// OnDiskSpace registers fn to be called with the level of free disk space and
+// free space in bytes when the level changes, e.g. to trigger pruning
+func (db *Database) OnDiskSpace(fn func(level DiskSpaceLevel, free uint64)) {
+	db.smdisk.Lock()
+	defer db.smdisk.Unlock()
+	db.diskNotifiees = append(db.diskNotifiees, fn)
+}
@@ -0,0 +1,69 @@
+// Copyright (c) 2018 ContentBox Authors.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package storage
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/facebookgo/ensure"
+)
+
+func TestDiskSpaceConfig(t *testing.T) {
+	cfg := diskSpaceConfig(&DiskSpaceConfig{})
+	ensure.DeepEqual(t, cfg, DiskSpaceConfig{
+		Low:      defaultDiskSpaceLow,
+		Critical: defaultDiskSpaceCritical,
+		Interval: defaultDiskSpaceInterval,
+	})
+	// low is never below critical
+	cfg = diskSpaceConfig(&DiskSpaceConfig{Low: 100, Critical: 500, Interval: time.Minute})
+	ensure.DeepEqual(t, cfg, DiskSpaceConfig{Low: 500, Critical: 500, Interval: time.Minute})
+}
+
+func TestDiskSpaceLevel(t *testing.T) {
+	db := &Database{diskCfg: DiskSpaceConfig{Low: 100, Critical: 10}}
+	var notified []DiskSpaceLevel
+	db.OnDiskSpace(func(level DiskSpaceLevel, free uint64) {
+		notified = append(notified, level)
+	})
+
+	tests := []struct {
+		free      uint64
+		level     DiskSpaceLevel
+		essential error
+		other     error
+	}{
+		{200 * megabyte, DiskSpaceOK, nil, nil},
+		{100*megabyte - 1, DiskSpaceLow, nil, ErrDiskSpaceLow},
+		{50 * megabyte, DiskSpaceLow, nil, ErrDiskSpaceLow},
+		{10*megabyte - 1, DiskSpaceCritical, ErrDiskSpaceCritical, ErrDiskSpaceCritical},
+		{100 * megabyte, DiskSpaceOK, nil, nil},
+	}
+	for _, test := range tests {
+		db.updateDiskSpace(test.free)
+		level, free := db.DiskSpace()
+		ensure.DeepEqual(t, level, test.level)
+		ensure.DeepEqual(t, free, test.free)
+		ensure.DeepEqual(t, db.CheckDiskSpace(true), test.essential)
+		ensure.DeepEqual(t, db.CheckDiskSpace(false), test.other)
+	}
+	// notified only when the level changes
+	ensure.DeepEqual(t, notified, []DiskSpaceLevel{DiskSpaceLow, DiskSpaceCritical, DiskSpaceOK})
+}
+
+func TestLeastFreeSpace(t *testing.T) {
+	dir, err := ioutil.TempDir("", "diskspace")
+	ensure.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	free, err := leastFreeSpace([]string{dir, os.TempDir()})
+	ensure.Nil(t, err)
+	ensure.True(t, free > 0)
+	_, err = leastFreeSpace([]string{dir + "/missing"})
+	ensure.NotNil(t, err)
+}
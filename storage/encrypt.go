@@ -0,0 +1,299 @@
+// Copyright (c) 2018 ContentBox Authors.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package storage
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/hex"
+	"io"
+	"io/ioutil"
+	"os"
+)
+
+// keySize is the size of the node key encrypting tables, for AES-256
+const keySize = 32
+
+var (
+	// prefix of keys in the default table marking tables encrypted
+	encryptedPrefix = []byte("/storage/encrypted/")
+	// content of markers, encrypted to check the node key
+	markerContent = []byte("encrypted")
+)
+
+// loadKey loads the hex encoded node key in file path, generating it if the
+// file doesn't exist
+func loadKey(path string) ([]byte, error) {
+	data, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		key := make([]byte, keySize)
+		if _, err := io.ReadFull(rand.Reader, key); err != nil {
+			return nil, err
+		}
+		logger.Infof("Generating node key to encrypt tables at %s", path)
+		return key, ioutil.WriteFile(path, []byte(hex.EncodeToString(key)), 0600)
+	}
+	if err != nil {
+		return nil, err
+	}
+	key, err := hex.DecodeString(string(bytes.TrimSpace(data)))
+	if err != nil || len(key) != keySize {
+		return nil, ErrInvalidKey
+	}
+	return key, nil
+}
+
+// sealer encrypts values with AES-GCM, authenticating the keys they are
+// stored with, so that values can't be moved to other keys
+type sealer struct {
+	aead cipher.AEAD
+}
+
+func newSealer(key []byte) (*sealer, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+	return &sealer{aead: aead}, nil
+}
+
+// seal encrypts value stored with key, prefixing it with a random nonce
+func (s *sealer) seal(key, value []byte) []byte {
+	nonce := make([]byte, s.aead.NonceSize(), s.aead.NonceSize()+len(value)+s.aead.Overhead())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		panic(err)
+	}
+	return s.aead.Seal(nonce, nonce, value, key)
+}
+
+// open decrypts data stored with key, nil staying nil
+func (s *sealer) open(key, data []byte) ([]byte, error) {
+	if data == nil {
+		return nil, nil
+	}
+	size := s.aead.NonceSize()
+	if len(data) < size {
+		return nil, ErrDecryptFailed
+	}
+	value, err := s.aead.Open(nil, data[:size], data[size:], key)
+	if err != nil {
+		return nil, ErrDecryptFailed
+	}
+	if value == nil {
+		value = []byte{}
+	}
+	return value, nil
+}
+
+// initEncryption checks the tables encrypted with markers in the default
+// table against those configured to, loads the node key and encrypts values
+// of tables newly configured. Values of tables stored apart are encrypted
+// before the markers are written, not atomically
+func (db *Database) initEncryption(cfg *Config) error {
+	db.encrypted = make(map[string]bool)
+	for _, name := range cfg.Encrypt {
+		db.encrypted[name] = true
+	}
+	for _, k := range db.Storage.KeysWithPrefix(encryptedPrefix) {
+		name := string(k[len(encryptedPrefix):])
+		if !db.encrypted[name] {
+			logger.Errorf("Table %s is encrypted but not configured to", name)
+			return ErrTableEncrypted
+		}
+	}
+	if len(db.encrypted) == 0 {
+		return nil
+	}
+
+	key, err := loadKey(cfg.KeyPath)
+	if err != nil {
+		return err
+	}
+	if db.sealer, err = newSealer(key); err != nil {
+		return err
+	}
+	for name := range db.encrypted {
+		marker := append(append([]byte{}, encryptedPrefix...), name...)
+		data, err := db.Storage.Get(marker)
+		if err != nil {
+			return err
+		}
+		if data == nil {
+			if err := db.encryptTable(name, marker); err != nil {
+				return err
+			}
+			continue
+		}
+		// the node key must be the one the table is encrypted with
+		if content, err := db.sealer.open(marker, data); err != nil || !bytes.Equal(content, markerContent) {
+			return ErrInvalidKey
+		}
+	}
+	return nil
+}
+
+// encryptTable encrypts values of table name and marks it encrypted
+func (db *Database) encryptTable(name string, marker []byte) error {
+	logger.Infof("Encrypting table %s", name)
+	t, err := db.table(name)
+	if err != nil {
+		return err
+	}
+	batch := db.newMultiBatch()
+	defer batch.Close()
+	w, err := batch.Table(name)
+	if err != nil {
+		return err
+	}
+	for _, k := range t.Keys() {
+		value, err := t.Get(k)
+		if err != nil {
+			return err
+		}
+		w.Put(k, db.sealer.seal(k, value))
+	}
+	batch.Put(marker, db.sealer.seal(marker, markerContent))
+	return batch.Write()
+}
+
+// etable encrypts values of a table
+type etable struct {
+	Table
+	sealer *sealer
+}
+
+// get the value of entry associate with the key
+func (t *etable) Get(key []byte) ([]byte, error) {
+	data, err := t.Table.Get(key)
+	if err != nil {
+		return nil, err
+	}
+	return t.sealer.open(key, data)
+}
+
+// put the value to entry associate with the key
+func (t *etable) Put(key, value []byte) error {
+	return t.Table.Put(key, t.sealer.seal(key, value))
+}
+
+// create a new write batch
+func (t *etable) NewBatch() Batch {
+	return &ebatch{Batch: t.Table.NewBatch(), sealer: t.sealer}
+}
+
+// create a new transaction
+func (t *etable) NewTransaction() (Transaction, error) {
+	tx, err := t.Table.NewTransaction()
+	if err != nil {
+		return nil, err
+	}
+	return &etx{Transaction: tx, sealer: t.sealer}, nil
+}
+
+// create an iterator over entries with keys of the prefix
+func (t *etable) NewIterator(prefix []byte) Iterator {
+	return &eiterator{Iterator: t.Table.NewIterator(prefix), sealer: t.sealer}
+}
+
+// create an iterator over entries with keys in [start, limit)
+func (t *etable) NewRangeIterator(start, limit []byte) Iterator {
+	return &eiterator{Iterator: t.Table.NewRangeIterator(start, limit), sealer: t.sealer}
+}
+
+// ewriter encrypts values put into a batch
+type ewriter struct {
+	BatchWriter
+	sealer *sealer
+}
+
+// put the value to entry associate with the key
+func (w *ewriter) Put(key, value []byte) {
+	w.BatchWriter.Put(key, w.sealer.seal(key, value))
+}
+
+// ebatch encrypts values put into a batch of a table
+type ebatch struct {
+	Batch
+	sealer *sealer
+}
+
+// put the value to entry associate with the key
+func (b *ebatch) Put(key, value []byte) {
+	b.Batch.Put(key, b.sealer.seal(key, value))
+}
+
+// emultibatch encrypts values put into encrypted tables of a batch across
+// tables
+type emultibatch struct {
+	MultiBatch
+	sealer    *sealer
+	encrypted map[string]bool
+}
+
+// return the writer of the table associate with the name
+func (b *emultibatch) Table(name string) (BatchWriter, error) {
+	w, err := b.MultiBatch.Table(name)
+	if err != nil || !b.encrypted[name] {
+		return w, err
+	}
+	return &ewriter{BatchWriter: w, sealer: b.sealer}, nil
+}
+
+// etx encrypts values of a transaction on a table
+type etx struct {
+	Transaction
+	sealer *sealer
+}
+
+// get the value of entry associate with the key
+func (tx *etx) Get(key []byte) ([]byte, error) {
+	data, err := tx.Transaction.Get(key)
+	if err != nil {
+		return nil, err
+	}
+	return tx.sealer.open(key, data)
+}
+
+// put the value to entry associate with the key
+func (tx *etx) Put(key, value []byte) error {
+	return tx.Transaction.Put(key, tx.sealer.seal(key, value))
+}
+
+// eiterator decrypts values of an iterator, stopping at the first one
+// failing to
+type eiterator struct {
+	Iterator
+	sealer *sealer
+	value  []byte
+	err    error
+}
+
+// move to the next entry
+func (it *eiterator) Next() bool {
+	if it.err != nil || !it.Iterator.Next() {
+		return false
+	}
+	it.value, it.err = it.sealer.open(it.Iterator.Key(), it.Iterator.Value())
+	return it.err == nil
+}
+
+// return the value of the current entry
+func (it *eiterator) Value() []byte {
+	return it.value
+}
+
+// return the error occurred iterating, if any
+func (it *eiterator) Error() error {
+	if it.err != nil {
+		return it.err
+	}
+	return it.Iterator.Error()
+}
@@ -13,4 +13,18 @@ var (
 	ErrTransactionExists = errors.New("can not create two transactions")
 	ErrTransactionClosed = errors.New("the transaction is closed")
 	ErrDatabasePanic     = errors.New("database panic")
+
+	ErrBackupNotSupported = errors.New("storage does not support backup")
+	ErrBackupExists       = errors.New("backup directory already exists")
+	ErrInvalidBackup      = errors.New("backup is invalid or of another storage")
+	ErrDatabaseNotEmpty   = errors.New("database to restore to is not empty")
+
+	ErrInvalidOption = errors.New("invalid storage option")
+
+	ErrInvalidKey     = errors.New("invalid key to encrypt tables")
+	ErrDecryptFailed  = errors.New("failed to decrypt value")
+	ErrTableEncrypted = errors.New("table is encrypted but not configured to")
+
+	ErrDiskSpaceLow      = errors.New("free disk space is low, non-essential writes are refused")
+	ErrDiskSpaceCritical = errors.New("free disk space is critical, writes are refused")
 )
@@ -0,0 +1,40 @@
+// Copyright (c) 2018 ContentBox Authors.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package storage
+
+// Iterator iterates over entries of a table in the order of keys. It reads a
+// snapshot of the table taken when it is created, so writes made afterwards
+// are invisible to it.
+type Iterator interface {
+	// move to the next entry, the first one on the first call. It returns
+	// false when there are no more entries or an error occurs
+	Next() bool
+
+	// return the key of the current entry
+	Key() []byte
+
+	// return the value of the current entry
+	Value() []byte
+
+	// return the error occurred iterating, if any
+	Error() error
+
+	// release the snapshot, it must be called to close the iterator
+	Release()
+}
+
+// PrefixRange returns the range of keys with the prefix, in the form of
+// [start, limit). A nil limit means no upper bound
+func PrefixRange(prefix []byte) (start, limit []byte) {
+	for i := len(prefix) - 1; i >= 0; i-- {
+		if prefix[i] < 0xff {
+			limit = make([]byte, i+1)
+			copy(limit, prefix)
+			limit[i]++
+			break
+		}
+	}
+	return prefix, limit
+}
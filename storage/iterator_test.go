@@ -0,0 +1,30 @@
+// Copyright (c) 2018 ContentBox Authors.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package storage
+
+import (
+	"testing"
+
+	"github.com/facebookgo/ensure"
+)
+
+func TestPrefixRange(t *testing.T) {
+	tests := []struct {
+		prefix []byte
+		limit  []byte
+	}{
+		{nil, nil},
+		{[]byte{}, nil},
+		{[]byte("abc"), []byte("abd")},
+		{[]byte{0x01, 0xff}, []byte{0x02}},
+		{[]byte{0x01, 0xfe, 0xff, 0xff}, []byte{0x01, 0xff}},
+		{[]byte{0xff, 0xff}, nil},
+	}
+	for _, test := range tests {
+		start, limit := PrefixRange(test.prefix)
+		ensure.DeepEqual(t, start, test.prefix)
+		ensure.DeepEqual(t, limit, test.limit)
+	}
+}
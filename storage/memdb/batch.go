@@ -5,17 +5,17 @@
 package memdb
 
 import (
+	"sort"
 	"sync"
 
 	storage "github.com/BOXFoundation/boxd/storage"
 )
 
 type mbatch struct {
-	*memorydb
+	*mtable
 
-	bsm    sync.Mutex
-	prefix string
-	ops    []*bop
+	bsm sync.Mutex
+	ops []*bop
 }
 
 var _ storage.Batch = (*mbatch)(nil)
@@ -33,14 +33,6 @@ type bop struct {
 	v []byte
 }
 
-func (b *mbatch) realkey(key []byte) []byte {
-	var k = make([]byte, len(b.prefix)+len(key))
-	copy(k, []byte(b.prefix))
-	copy(k[len(b.prefix):], key)
-
-	return k
-}
-
 // put the value to entry associate with the key
 func (b *mbatch) Put(key, value []byte) {
 	b.bsm.Lock()
@@ -48,8 +40,8 @@ func (b *mbatch) Put(key, value []byte) {
 
 	b.ops = append(b.ops, &bop{
 		o: opPut,
-		k: key,
-		v: value,
+		k: clone(key),
+		v: clone(value),
 	})
 }
 
@@ -60,7 +52,7 @@ func (b *mbatch) Del(key []byte) {
 
 	b.ops = append(b.ops, &bop{
 		o: opDel,
-		k: key,
+		k: clone(key),
 		v: nil,
 	})
 }
@@ -87,9 +79,6 @@ func (b *mbatch) Write() error {
 }
 
 func (b *mbatch) write(wlock bool) error {
-	b.bsm.Lock()
-	defer b.bsm.Unlock()
-
 	if wlock {
 		b.writeLock <- struct{}{}
 		defer func() {
@@ -100,20 +89,112 @@ func (b *mbatch) write(wlock bool) error {
 	b.sm.Lock()
 	defer b.sm.Unlock()
 
+	b.apply()
+	return nil
+}
+
+// apply enqueued put/delete to the table, the lock of which must be held
+func (b *mbatch) apply() {
+	b.bsm.Lock()
+	defer b.bsm.Unlock()
+
 	for _, o := range b.ops {
-		k := b.realkey(o.k)
 		switch o.o {
 		case opPut:
-			b.db[string(k)] = o.v
+			b.db[string(o.k)] = o.v
 		case opDel:
-			delete(b.db, string(k))
+			delete(b.db, string(o.k))
 		}
 	}
+}
+
+// close the batch, it must be called to close the batch
+func (b *mbatch) Close() {
+	b.Clear()
+}
+
+// mmbatch is a batch across tables, made of a batch for each table
+type mmbatch struct {
+	// the batch of the default table
+	*mbatch
+
+	db      *memorydb
+	smtb    sync.Mutex
+	batches map[string]*mbatch
+}
+
+var _ storage.MultiBatch = (*mmbatch)(nil)
+
+// return the writer enqueuing put/delete of the table into the batch
+func (b *mmbatch) Table(name string) (storage.BatchWriter, error) {
+	b.smtb.Lock()
+	defer b.smtb.Unlock()
+
+	if batch, ok := b.batches[name]; ok {
+		return batch, nil
+	}
+	t, err := b.db.Table(name)
+	if err != nil {
+		return nil, err
+	}
+	batch := &mbatch{mtable: t.(*mtable)}
+	b.batches[name] = batch
+	return batch, nil
+}
+
+// return batches of all tables, that of the default table first and the
+// others ordered by table names, which is the order their tables are locked
+func (b *mmbatch) all() []*mbatch {
+	b.smtb.Lock()
+	defer b.smtb.Unlock()
+
+	names := make([]string, 0, len(b.batches))
+	for name := range b.batches {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	batches := []*mbatch{b.mbatch}
+	for _, name := range names {
+		batches = append(batches, b.batches[name])
+	}
+	return batches
+}
 
+// remove all the enqueued put/delete
+func (b *mmbatch) Clear() {
+	for _, batch := range b.all() {
+		batch.Clear()
+	}
+}
+
+// returns the number of updates in the batch
+func (b *mmbatch) Count() int {
+	var count int
+	for _, batch := range b.all() {
+		count += batch.Count()
+	}
+	return count
+}
+
+// atomic writes all enqueued put/delete
+func (b *mmbatch) Write() error {
+	batches := b.all()
+	for _, batch := range batches {
+		batch.writeLock <- struct{}{}
+		batch.sm.Lock()
+	}
+	for _, batch := range batches {
+		batch.apply()
+	}
+	for _, batch := range batches {
+		batch.sm.Unlock()
+		<-batch.writeLock
+	}
 	return nil
 }
 
 // close the batch, it must be called to close the batch
-func (b *mbatch) Close() {
+func (b *mmbatch) Close() {
 	b.Clear()
 }
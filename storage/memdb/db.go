@@ -16,11 +16,12 @@ func init() {
 	storage.Register("memdb", NewMemoryDB)
 }
 
-// NewMemoryDB creates a memorydb instance
+// NewMemoryDB creates a memorydb instance, keeping all the data in memory
+// till it is closed. It suits tests and ephemeral nodes
 func NewMemoryDB(_ string, _ *storage.Options) (storage.Storage, error) {
 	logger.Debug("Creating memdb")
 	return &memorydb{
-		db:        make(map[string][]byte),
-		writeLock: make(chan struct{}, 1),
+		mtable: newTable(),
+		tables: make(map[string]*mtable),
 	}, nil
 }
@@ -5,11 +5,17 @@
 package memdb
 
 import (
+	"bytes"
 	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
 	"testing"
 
+	storage "github.com/BOXFoundation/boxd/storage"
 	"github.com/BOXFoundation/boxd/storage/dbtest"
 	"github.com/facebookgo/ensure"
+	"github.com/jbenet/goprocess"
 )
 
 func TestDBCreateClose(t *testing.T) {
@@ -47,6 +53,22 @@ func TestDBBatch(t *testing.T) {
 	dbtest.StorageBatch(t, db)
 }
 
+func TestDBMultiBatch(t *testing.T) {
+	var db, err = NewMemoryDB("", nil)
+	ensure.Nil(t, err)
+	defer db.Close()
+
+	dbtest.StorageMultiBatch(t, db)
+}
+
+func TestDBTableSizes(t *testing.T) {
+	var db, err = NewMemoryDB("", nil)
+	ensure.Nil(t, err)
+	defer db.Close()
+
+	dbtest.StorageTableSizes(t, db)
+}
+
 func TestDBBatchs(t *testing.T) {
 	for i := 0; i < 10; i++ {
 		t.Run(fmt.Sprint("t", i), TestDBBatch)
@@ -109,6 +131,30 @@ func TestDBIterKeysWithPrefixCancel(t *testing.T) {
 	dbtest.StorageIterKeysWithPrefixCancel(t, db)(t, db)
 }
 
+func TestDBIterator(t *testing.T) {
+	var db, err = NewMemoryDB("", nil)
+	ensure.Nil(t, err)
+	defer db.Close()
+
+	dbtest.StorageIterator(t, db)
+}
+
+func TestDBRangeIterator(t *testing.T) {
+	var db, err = NewMemoryDB("", nil)
+	ensure.Nil(t, err)
+	defer db.Close()
+
+	dbtest.StorageRangeIterator(t, db)
+}
+
+func TestDBIteratorSnapshot(t *testing.T) {
+	var db, err = NewMemoryDB("", nil)
+	ensure.Nil(t, err)
+	defer db.Close()
+
+	dbtest.StorageIteratorSnapshot(t, db)
+}
+
 func TestDBTransaction(t *testing.T) {
 	db, _ := NewMemoryDB("", nil)
 	defer db.Close()
@@ -143,3 +189,113 @@ func TestDBTransKeysWithPrefixRand(t *testing.T) {
 	defer tx.Discard()
 	verify(t, tx)
 }
+
+func TestDatabaseMetrics(t *testing.T) {
+	db, err := storage.NewDatabase(goprocess.Background(), &storage.Config{Name: "memdb"})
+	ensure.Nil(t, err)
+	defer db.Proc().Close()
+
+	table, err := db.Table("t1")
+	ensure.Nil(t, err)
+	same, err := db.Table("t1")
+	ensure.Nil(t, err)
+	ensure.True(t, table == same)
+
+	gets := storage.MetricsGetTimer.Count()
+	puts := storage.MetricsPutTimer.Count()
+	writes := storage.MetricsBatchWriteTimer.Count()
+	ensure.Nil(t, table.Put([]byte("k"), []byte("v")))
+	_, err = db.Get([]byte("k"))
+	ensure.Nil(t, err)
+	_, err = table.Get([]byte("k"))
+	ensure.Nil(t, err)
+	batch := db.NewMultiBatch()
+	defer batch.Close()
+	ensure.Nil(t, batch.Write())
+
+	ensure.DeepEqual(t, storage.MetricsGetTimer.Count(), gets+2)
+	ensure.DeepEqual(t, storage.MetricsPutTimer.Count(), puts+1)
+	ensure.DeepEqual(t, storage.MetricsBatchWriteTimer.Count(), writes+1)
+}
+
+func TestDatabaseTablesApart(t *testing.T) {
+	cfg := &storage.Config{Name: "memdb", Tables: map[string]string{"archive": "archive"}}
+	db, err := storage.NewDatabase(goprocess.Background(), cfg)
+	ensure.Nil(t, err)
+	defer db.Proc().Close()
+
+	archive, err := db.Table("archive")
+	ensure.Nil(t, err)
+	table, err := db.Table("t1")
+	ensure.Nil(t, err)
+	ensure.Nil(t, archive.Put([]byte("a1"), []byte("v")))
+
+	batch := db.NewMultiBatch()
+	defer batch.Close()
+	w, err := batch.Table("archive")
+	ensure.Nil(t, err)
+	w.Put([]byte("a2"), []byte("v"))
+	w, err = batch.Table("t1")
+	ensure.Nil(t, err)
+	w.Put([]byte("k1"), []byte("v"))
+	ensure.DeepEqual(t, batch.Count(), 2)
+	ensure.Nil(t, batch.Write())
+
+	ensure.DeepEqual(t, len(archive.Keys()), 2)
+	ensure.DeepEqual(t, len(table.Keys()), 1)
+	// tables apart are not in the database
+	ensure.DeepEqual(t, len(db.Keys()), 0)
+	sizes, err := db.TableSizes()
+	ensure.Nil(t, err)
+	ensure.True(t, sizes["archive"] > 0)
+
+	ensure.Nil(t, db.DropTable("archive"))
+	archive, err = db.Table("archive")
+	ensure.Nil(t, err)
+	ensure.DeepEqual(t, len(archive.Keys()), 0)
+}
+
+func TestDatabaseEncryption(t *testing.T) {
+	dir, err := ioutil.TempDir("", "memdb")
+	ensure.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	cfg := &storage.Config{Name: "memdb", Encrypt: []string{"t1"}, KeyPath: filepath.Join(dir, "db.key")}
+	db, err := storage.NewDatabase(goprocess.Background(), cfg)
+	ensure.Nil(t, err)
+	defer db.Proc().Close()
+	_, err = os.Stat(cfg.KeyPath)
+	ensure.Nil(t, err)
+
+	table, err := db.Table("t1")
+	ensure.Nil(t, err)
+	raw, err := db.Storage.Table("t1")
+	ensure.Nil(t, err)
+	ensure.Nil(t, table.Put([]byte("k1"), []byte("v1")))
+	batch := db.NewMultiBatch()
+	defer batch.Close()
+	w, err := batch.Table("t1")
+	ensure.Nil(t, err)
+	w.Put([]byte("k2"), []byte("v2"))
+	ensure.Nil(t, batch.Write())
+
+	for _, k := range []string{"k1", "k2"} {
+		value, err := table.Get([]byte(k))
+		ensure.Nil(t, err)
+		ensure.DeepEqual(t, value, []byte("v"+k[1:]))
+		data, err := raw.Get([]byte(k))
+		ensure.Nil(t, err)
+		ensure.False(t, bytes.Contains(data, value))
+	}
+	iter := table.NewIterator([]byte("k"))
+	defer iter.Release()
+	ensure.True(t, iter.Next())
+	ensure.DeepEqual(t, iter.Value(), []byte("v1"))
+
+	// values are bound to their keys
+	data, err := raw.Get([]byte("k1"))
+	ensure.Nil(t, err)
+	ensure.Nil(t, raw.Put([]byte("k3"), data))
+	_, err = table.Get([]byte("k3"))
+	ensure.DeepEqual(t, err, storage.ErrDecryptFailed)
+}
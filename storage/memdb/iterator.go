@@ -0,0 +1,71 @@
+// Copyright (c) 2018 ContentBox Authors.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package memdb
+
+import (
+	"sort"
+)
+
+// miterator iterates over entries copied out of a table when it is created
+type miterator struct {
+	keys   []string
+	values map[string][]byte
+	pos    int
+}
+
+// newIterator creates an iterator over entries of the table with keys in
+// [start, limit)
+func newIterator(t *mtable, start, limit []byte) *miterator {
+	t.sm.RLock()
+	defer t.sm.RUnlock()
+
+	it := &miterator{values: make(map[string][]byte), pos: -1}
+	for key, value := range t.db {
+		if key < string(start) || (limit != nil && key >= string(limit)) {
+			continue
+		}
+		// stored values are never modified in place, so sharing them is safe
+		it.keys = append(it.keys, key)
+		it.values[key] = value
+	}
+	sort.Strings(it.keys)
+
+	return it
+}
+
+// move to the next entry, the first one on the first call
+func (it *miterator) Next() bool {
+	if it.pos < len(it.keys) {
+		it.pos++
+	}
+	return it.pos < len(it.keys)
+}
+
+// return the key of the current entry
+func (it *miterator) Key() []byte {
+	if it.pos < 0 || it.pos >= len(it.keys) {
+		return nil
+	}
+	return []byte(it.keys[it.pos])
+}
+
+// return the value of the current entry
+func (it *miterator) Value() []byte {
+	if it.pos < 0 || it.pos >= len(it.keys) {
+		return nil
+	}
+	return clone(it.values[it.keys[it.pos]])
+}
+
+// return the error occurred iterating, if any
+func (it *miterator) Error() error {
+	return nil
+}
+
+// release the snapshot, it must be called to close the iterator
+func (it *miterator) Release() {
+	it.keys = nil
+	it.values = nil
+}
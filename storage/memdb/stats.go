@@ -0,0 +1,35 @@
+// Copyright (c) 2018 ContentBox Authors.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package memdb
+
+import (
+	storage "github.com/BOXFoundation/boxd/storage"
+)
+
+var _ storage.TableSizer = (*memorydb)(nil)
+
+// TableSizes returns sizes of keys and values held by tables
+func (db *memorydb) TableSizes() (map[string]int64, error) {
+	db.smtables.Lock()
+	defer db.smtables.Unlock()
+
+	var sizes = map[string]int64{storage.DefaultTableName: db.size()}
+	for name, t := range db.tables {
+		sizes[name] = t.size()
+	}
+	return sizes, nil
+}
+
+// size returns the size of keys and values in the table
+func (t *mtable) size() int64 {
+	t.sm.RLock()
+	defer t.sm.RUnlock()
+
+	var size int64
+	for k, v := range t.db {
+		size += int64(len(k) + len(v))
+	}
+	return size
+}
@@ -6,38 +6,41 @@ package memdb
 
 import (
 	"context"
+	"sort"
 	"strings"
+	"sync"
 	"time"
 
 	storage "github.com/BOXFoundation/boxd/storage"
 )
 
+// mtable is a key space in memory. The database and each of its tables have
+// their own ones
 type mtable struct {
-	*memorydb
-
-	prefix string
+	sm        sync.RWMutex
+	writeLock chan struct{}
+	db        map[string][]byte
 }
 
 var _ storage.Table = (*mtable)(nil)
 
+func newTable() *mtable {
+	return &mtable{
+		db:        make(map[string][]byte),
+		writeLock: make(chan struct{}, 1),
+	}
+}
+
 // create a new write batch
 func (t *mtable) NewBatch() storage.Batch {
 	return &mbatch{
-		memorydb: t.memorydb,
-		prefix:   t.prefix,
+		mtable: t,
 	}
 }
 
-func (t *mtable) realkey(key []byte) []byte {
-	var k = make([]byte, len(t.prefix)+len(key))
-	copy(k, []byte(t.prefix))
-	copy(k[len(t.prefix):], key)
-
-	return k
-}
-
 func (t *mtable) NewTransaction() (storage.Transaction, error) {
 	timer := time.NewTimer(time.Millisecond * 100)
+	defer timer.Stop()
 	select {
 	case <-timer.C:
 		return nil, storage.ErrTransactionExists
@@ -47,11 +50,21 @@ func (t *mtable) NewTransaction() (storage.Transaction, error) {
 	return &mtx{
 		db:        t,
 		closed:    false,
-		batch:     &mbatch{memorydb: t.memorydb, prefix: t.prefix},
+		batch:     &mbatch{mtable: t},
 		writeLock: t.writeLock,
 	}, nil
 }
 
+// create an iterator over entries with keys of the prefix
+func (t *mtable) NewIterator(prefix []byte) storage.Iterator {
+	return t.NewRangeIterator(storage.PrefixRange(prefix))
+}
+
+// create an iterator over entries with keys in [start, limit)
+func (t *mtable) NewRangeIterator(start, limit []byte) storage.Iterator {
+	return newIterator(t, start, limit)
+}
+
 // put the value to entry associate with the key
 func (t *mtable) Put(key, value []byte) error {
 	t.writeLock <- struct{}{}
@@ -62,7 +75,7 @@ func (t *mtable) Put(key, value []byte) error {
 	t.sm.Lock()
 	defer t.sm.Unlock()
 
-	t.db[string(t.realkey(key))] = value
+	t.db[string(key)] = clone(value)
 
 	return nil
 }
@@ -77,7 +90,7 @@ func (t *mtable) Del(key []byte) error {
 	t.sm.Lock()
 	defer t.sm.Unlock()
 
-	delete(t.db, string(t.realkey(key)))
+	delete(t.db, string(key))
 
 	return nil
 }
@@ -87,8 +100,8 @@ func (t *mtable) Get(key []byte) ([]byte, error) {
 	t.sm.RLock()
 	defer t.sm.RUnlock()
 
-	if value, ok := t.db[string(t.realkey(key))]; ok {
-		return value, nil
+	if value, ok := t.db[string(key)]; ok {
+		return clone(value), nil
 	}
 	return nil, nil
 }
@@ -98,47 +111,40 @@ func (t *mtable) Has(key []byte) (bool, error) {
 	t.sm.RLock()
 	defer t.sm.RUnlock()
 
-	_, ok := t.db[string(t.realkey(key))]
+	_, ok := t.db[string(key)]
 
 	return ok, nil
 }
 
 // return a set of keys in the Storage
 func (t *mtable) Keys() [][]byte {
-	return t.KeysWithPrefix([]byte{})
+	return t.KeysWithPrefix(nil)
 }
 
+// return a set of keys with specified prefix in the Storage, in the order of
+// keys as rocksdb iterates them
 func (t *mtable) KeysWithPrefix(prefix []byte) [][]byte {
 	t.sm.RLock()
-	defer t.sm.RUnlock()
-
-	var keys [][]byte
+	var matched []string
 	for key := range t.db {
-		if strings.HasPrefix(key, t.prefix+string(prefix)) {
-			keys = append(keys, []byte(key)[len(t.prefix):])
+		if strings.HasPrefix(key, string(prefix)) {
+			matched = append(matched, key)
 		}
 	}
+	t.sm.RUnlock()
+
+	sort.Strings(matched)
+	var keys [][]byte
+	for _, key := range matched {
+		keys = append(keys, []byte(key))
+	}
 
 	return keys
 }
 
 // return a chan to iter all keys
 func (t *mtable) IterKeys(ctx context.Context) <-chan []byte {
-	keys := t.Keys()
-
-	out := make(chan []byte)
-	go func() {
-		defer close(out)
-
-		for _, k := range keys {
-			select {
-			case <-ctx.Done():
-				return
-			case out <- k:
-			}
-		}
-	}()
-	return out
+	return t.IterKeysWithPrefix(ctx, nil)
 }
 
 // return a set of keys with specified prefix in the Storage
@@ -159,3 +165,21 @@ func (t *mtable) IterKeysWithPrefix(ctx context.Context, prefix []byte) <-chan [
 	}()
 	return out
 }
+
+// clear removes all the entries
+func (t *mtable) clear() {
+	t.sm.Lock()
+	defer t.sm.Unlock()
+
+	t.db = make(map[string][]byte)
+}
+
+// helper function to copy value, so callers cannot alter stored ones
+func clone(value []byte) []byte {
+	if value == nil {
+		return nil
+	}
+	var buf = make([]byte, len(value))
+	copy(buf, value)
+	return buf
+}
@@ -128,6 +128,39 @@ func TestTableIterKeysWithPrefixCancel(t *testing.T) {
 	dbtest.StorageIterKeysWithPrefixCancel(t, table)(t, table)
 }
 
+func TestTableIterator(t *testing.T) {
+	var db, err = NewMemoryDB("", nil)
+	ensure.Nil(t, err)
+	defer db.Close()
+
+	table, err := db.Table("t1")
+	ensure.Nil(t, err)
+
+	dbtest.StorageIterator(t, table)
+}
+
+func TestTableRangeIterator(t *testing.T) {
+	var db, err = NewMemoryDB("", nil)
+	ensure.Nil(t, err)
+	defer db.Close()
+
+	table, err := db.Table("t1")
+	ensure.Nil(t, err)
+
+	dbtest.StorageRangeIterator(t, table)
+}
+
+func TestTableIteratorSnapshot(t *testing.T) {
+	var db, err = NewMemoryDB("", nil)
+	ensure.Nil(t, err)
+	defer db.Close()
+
+	table, err := db.Table("t1")
+	ensure.Nil(t, err)
+
+	dbtest.StorageIteratorSnapshot(t, table)
+}
+
 func TestTableTransaction(t *testing.T) {
 	db, _ := NewMemoryDB("", nil)
 	defer db.Close()
@@ -218,3 +251,78 @@ func TestTableTransIterKeysWithPrefix(t *testing.T) {
 	defer tx.Discard()
 	verify(t, tx)
 }
+
+func TestTableIsolation(t *testing.T) {
+	var db, err = NewMemoryDB("", nil)
+	ensure.Nil(t, err)
+	defer db.Close()
+
+	t1, err := db.Table("t")
+	ensure.Nil(t, err)
+	t2, err := db.Table("t.1")
+	ensure.Nil(t, err)
+	t3, err := db.Table("t")
+	ensure.Nil(t, err)
+	ensure.True(t, t1 == t3)
+
+	ensure.Nil(t, db.Put([]byte("k0"), []byte("v0")))
+	ensure.Nil(t, t1.Put([]byte("1.k1"), []byte("v1")))
+	ensure.Nil(t, t2.Put([]byte("k2"), []byte("v2")))
+
+	ensure.DeepEqual(t, db.Keys(), [][]byte{[]byte("k0")})
+	ensure.DeepEqual(t, t1.Keys(), [][]byte{[]byte("1.k1")})
+	ensure.DeepEqual(t, t2.Keys(), [][]byte{[]byte("k2")})
+
+	ensure.Nil(t, db.DropTable("t"))
+	t1, err = db.Table("t")
+	ensure.Nil(t, err)
+	ensure.DeepEqual(t, len(t1.Keys()), 0)
+	ensure.DeepEqual(t, t2.Keys(), [][]byte{[]byte("k2")})
+	ensure.DeepEqual(t, db.Keys(), [][]byte{[]byte("k0")})
+}
+
+func TestTableKeysOrder(t *testing.T) {
+	var db, err = NewMemoryDB("", nil)
+	ensure.Nil(t, err)
+	defer db.Close()
+
+	table, err := db.Table("t1")
+	ensure.Nil(t, err)
+
+	for _, k := range []string{"b2", "a", "b1", "c", "b"} {
+		ensure.Nil(t, table.Put([]byte(k), []byte(k)))
+	}
+	ensure.DeepEqual(t, table.Keys(), [][]byte{
+		[]byte("a"), []byte("b"), []byte("b1"), []byte("b2"), []byte("c"),
+	})
+	ensure.DeepEqual(t, table.KeysWithPrefix([]byte("b")), [][]byte{
+		[]byte("b"), []byte("b1"), []byte("b2"),
+	})
+}
+
+func TestTableValueCopied(t *testing.T) {
+	var db, err = NewMemoryDB("", nil)
+	ensure.Nil(t, err)
+	defer db.Close()
+
+	table, err := db.Table("t1")
+	ensure.Nil(t, err)
+
+	value := []byte("v1")
+	ensure.Nil(t, table.Put([]byte("k1"), value))
+	batch := table.NewBatch()
+	batch.Put([]byte("k2"), value)
+	ensure.Nil(t, batch.Write())
+	value[0] = 'x'
+
+	got, err := table.Get([]byte("k1"))
+	ensure.Nil(t, err)
+	ensure.DeepEqual(t, got, []byte("v1"))
+	got[0] = 'x'
+	got, err = table.Get([]byte("k1"))
+	ensure.Nil(t, err)
+	ensure.DeepEqual(t, got, []byte("v1"))
+	got, err = table.Get([]byte("k2"))
+	ensure.Nil(t, err)
+	ensure.DeepEqual(t, got, []byte("v1"))
+}
@@ -0,0 +1,153 @@
+// Copyright (c) 2018 ContentBox Authors.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package storage
+
+import (
+	"time"
+
+	"github.com/BOXFoundation/boxd/metrics"
+	"github.com/jbenet/goprocess"
+)
+
+// DefaultTableName is the name sizes of the default table are reported by
+const DefaultTableName = "default"
+
+// interval to report table sizes and cache stats
+const statsInterval = time.Minute
+
+var (
+	// MetricsGetTimer records latencies of gets
+	MetricsGetTimer = metrics.NewTimer("box.storage.get")
+	// MetricsHasTimer records latencies of existence checks
+	MetricsHasTimer = metrics.NewTimer("box.storage.has")
+	// MetricsPutTimer records latencies of puts
+	MetricsPutTimer = metrics.NewTimer("box.storage.put")
+	// MetricsDelTimer records latencies of deletes
+	MetricsDelTimer = metrics.NewTimer("box.storage.del")
+	// MetricsBatchWriteTimer records latencies of batch writes
+	MetricsBatchWriteTimer = metrics.NewTimer("box.storage.batch.write")
+	// MetricsTxCommitTimer records latencies of transaction commits
+	MetricsTxCommitTimer = metrics.NewTimer("box.storage.tx.commit")
+	// MetricsCacheHitRateGauge records the hit rate of the read cache
+	MetricsCacheHitRateGauge = metrics.NewGaugeFloat64("box.storage.cache.hitrate")
+)
+
+// TableSizer defines the storage able to tell sizes of its tables
+type TableSizer interface {
+	// TableSizes returns approximate sizes in bytes of tables by their names,
+	// the default table named DefaultTableName
+	TableSizes() (map[string]int64, error)
+}
+
+// CacheStater defines the storage with a read cache
+type CacheStater interface {
+	// CacheStats returns the number of cache hits and misses since opened
+	CacheStats() (hits, misses int64)
+}
+
+// reportStats reports sizes of tables and the cache hit rate of storage
+// periodically until p is closing
+func reportStats(p goprocess.Process, s Storage) {
+	ticker := time.NewTicker(statsInterval)
+	defer ticker.Stop()
+	for {
+		if sizer, ok := s.(TableSizer); ok {
+			if sizes, err := sizer.TableSizes(); err == nil {
+				for name, size := range sizes {
+					metrics.NewGauge("box.storage.table." + name + ".size").Update(size)
+				}
+			} else {
+				logger.Warnf("Failed to get table sizes. Err: %v", err)
+			}
+		}
+		if stater, ok := s.(CacheStater); ok {
+			if hits, misses := stater.CacheStats(); hits+misses > 0 {
+				MetricsCacheHitRateGauge.Update(float64(hits) / float64(hits+misses))
+			}
+		}
+
+		select {
+		case <-p.Closing():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// ttable times operations of a table
+type ttable struct {
+	Table
+}
+
+// get the value of entry associate with the key
+func (t *ttable) Get(key []byte) ([]byte, error) {
+	defer MetricsGetTimer.UpdateSince(time.Now())
+	return t.Table.Get(key)
+}
+
+// check if the entry associate with key exists
+func (t *ttable) Has(key []byte) (bool, error) {
+	defer MetricsHasTimer.UpdateSince(time.Now())
+	return t.Table.Has(key)
+}
+
+// put the value to entry associate with the key
+func (t *ttable) Put(key, value []byte) error {
+	defer MetricsPutTimer.UpdateSince(time.Now())
+	return t.Table.Put(key, value)
+}
+
+// delete the entry associate with the key
+func (t *ttable) Del(key []byte) error {
+	defer MetricsDelTimer.UpdateSince(time.Now())
+	return t.Table.Del(key)
+}
+
+// create a new write batch
+func (t *ttable) NewBatch() Batch {
+	return &tbatch{Batch: t.Table.NewBatch()}
+}
+
+// create a new transaction
+func (t *ttable) NewTransaction() (Transaction, error) {
+	tx, err := t.Table.NewTransaction()
+	if err != nil {
+		return nil, err
+	}
+	return &ttx{Transaction: tx}, nil
+}
+
+// tbatch times writes of a batch
+type tbatch struct {
+	Batch
+}
+
+// atomic writes all enqueued put/delete
+func (b *tbatch) Write() error {
+	defer MetricsBatchWriteTimer.UpdateSince(time.Now())
+	return b.Batch.Write()
+}
+
+// tmbatch times writes of a batch across tables
+type tmbatch struct {
+	MultiBatch
+}
+
+// atomic writes all enqueued put/delete
+func (b *tmbatch) Write() error {
+	defer MetricsBatchWriteTimer.UpdateSince(time.Now())
+	return b.MultiBatch.Write()
+}
+
+// ttx times commits of a transaction
+type ttx struct {
+	Transaction
+}
+
+// commit the transaction
+func (tx *ttx) Commit() error {
+	defer MetricsTxCommitTimer.UpdateSince(time.Now())
+	return tx.Transaction.Commit()
+}
@@ -0,0 +1,88 @@
+// Copyright (c) 2018 ContentBox Authors.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package storage
+
+import (
+	"strconv"
+	"strings"
+)
+
+// keys of options tuning storage backends, sizes in bytes or with a KB, MB
+// or GB suffix
+const (
+	// OptWriteBufferSize is the size of data buffered in memory before being
+	// flushed to disk
+	OptWriteBufferSize = "write_buffer_size"
+	// OptBlockCacheSize is the size of the cache of data blocks read
+	OptBlockCacheSize = "block_cache_size"
+	// OptMaxOpenFiles is the max number of files kept open, -1 for unlimited
+	OptMaxOpenFiles = "max_open_files"
+)
+
+// size units of options
+var units = []struct {
+	suffix string
+	size   int64
+}{
+	{"KB", 1 << 10},
+	{"MB", 1 << 20},
+	{"GB", 1 << 30},
+}
+
+// IsSet checks if option of key is set
+func (o *Options) IsSet(key string) bool {
+	if o == nil {
+		return false
+	}
+	_, ok := (*o)[key]
+	return ok
+}
+
+// Int64 returns the integer option of key, def if it's not set
+func (o *Options) Int64(key string, def int64) (int64, error) {
+	if o == nil {
+		return def, nil
+	}
+	v, ok := (*o)[key]
+	if !ok || v == nil {
+		return def, nil
+	}
+	switch v := v.(type) {
+	case int:
+		return int64(v), nil
+	case int64:
+		return v, nil
+	case float64:
+		if v == float64(int64(v)) {
+			return int64(v), nil
+		}
+	case string:
+		return parseSize(v)
+	}
+	return 0, ErrInvalidOption
+}
+
+// Int returns the integer option of key, def if it's not set
+func (o *Options) Int(key string, def int) (int, error) {
+	v, err := o.Int64(key, int64(def))
+	return int(v), err
+}
+
+// parseSize parses a size with an optional unit suffix
+func parseSize(s string) (int64, error) {
+	s = strings.ToUpper(strings.TrimSpace(s))
+	unit := int64(1)
+	for _, u := range units {
+		if strings.HasSuffix(s, u.suffix) {
+			s, unit = strings.TrimSpace(strings.TrimSuffix(s, u.suffix)), u.size
+			break
+		}
+	}
+	n, err := strconv.ParseInt(s, 10, 64)
+	if err != nil {
+		return 0, ErrInvalidOption
+	}
+	return n * unit, nil
+}
@@ -0,0 +1,55 @@
+// Copyright (c) 2018 ContentBox Authors.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package storage
+
+import (
+	"testing"
+
+	"github.com/facebookgo/ensure"
+)
+
+func TestOptionsInt64(t *testing.T) {
+	o := &Options{
+		"int":     64,
+		"int64":   int64(1) << 40,
+		"float":   float64(128),
+		"bytes":   "1024",
+		"kb":      "4KB",
+		"mb":      "64 mb",
+		"gb":      "2GB",
+		"invalid": "64M",
+		"frac":    1.5,
+		"bool":    true,
+	}
+	tests := []struct {
+		key      string
+		expected int64
+		err      error
+	}{
+		{"int", 64, nil},
+		{"int64", 1 << 40, nil},
+		{"float", 128, nil},
+		{"bytes", 1024, nil},
+		{"kb", 4 << 10, nil},
+		{"mb", 64 << 20, nil},
+		{"gb", 2 << 30, nil},
+		{"missing", -1, nil},
+		{"invalid", 0, ErrInvalidOption},
+		{"frac", 0, ErrInvalidOption},
+		{"bool", 0, ErrInvalidOption},
+	}
+	for _, test := range tests {
+		v, err := o.Int64(test.key, -1)
+		ensure.DeepEqual(t, err, test.err)
+		ensure.DeepEqual(t, v, test.expected)
+	}
+
+	var nilOptions *Options
+	v, err := nilOptions.Int(OptMaxOpenFiles, 100)
+	ensure.Nil(t, err)
+	ensure.DeepEqual(t, v, 100)
+	ensure.False(t, nilOptions.IsSet(OptMaxOpenFiles))
+	ensure.True(t, o.IsSet("int"))
+}
@@ -0,0 +1,18 @@
+// Copyright (c) 2018 ContentBox Authors.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package rocksdb
+
+// Backup writes a checkpoint of the database to dir, which hard links sst
+// files of the database if dir is on the same filesystem
+func (db *rocksdb) Backup(dir string) error {
+	checkpoint, err := db.rocksdb.NewCheckpoint()
+	if err != nil {
+		return err
+	}
+	defer checkpoint.Destroy()
+
+	// flush memtables first, so no log is copied
+	return checkpoint.CreateCheckpoint(dir, 0)
+}
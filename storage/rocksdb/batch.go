@@ -5,6 +5,7 @@
 package rocksdb
 
 import (
+	storage "github.com/BOXFoundation/boxd/storage"
 	"github.com/tecbot/gorocksdb"
 )
 
@@ -53,3 +54,25 @@ func (b *rbatch) Write() error {
 func (b *rbatch) Close() {
 	b.wb.Destroy()
 }
+
+// rmbatch is a batch across tables, whose operations are all enqueued in the
+// write batch of the default table
+type rmbatch struct {
+	*rbatch
+
+	db *rocksdb
+}
+
+// return the writer enqueuing put/delete of the table into the batch
+func (b *rmbatch) Table(name string) (storage.BatchWriter, error) {
+	t, err := b.db.Table(name)
+	if err != nil {
+		return nil, err
+	}
+	return &rbatch{
+		rocksdb:      b.rocksdb,
+		cf:           t.(*rtable).cf,
+		wb:           b.wb,
+		writeOptions: b.writeOptions,
+	}, nil
+}
@@ -15,7 +15,13 @@ import (
 var logger = log.NewLogger("rocksdb")
 
 const number = 10
-const cache = 3 << 30
+
+// defaults of options tuning rocksdb, the same as those of rocksdb
+const (
+	defaultWriteBufferSize = 64 << 20
+	defaultBlockCacheSize  = 8 << 20
+	defaultMaxOpenFiles    = -1
+)
 
 func init() {
 	// register rocksdb impl
@@ -34,16 +40,34 @@ func prepare(path string) {
 func NewRocksDB(name string, o *storage.Options) (storage.Storage, error) {
 	logger.Infof("Creating rocksdb at %s", name)
 
-	// bbto := gorocksdb.NewDefaultBlockBasedTableOptions()
+	writeBufferSize, err := o.Int(storage.OptWriteBufferSize, defaultWriteBufferSize)
+	if err != nil {
+		return nil, err
+	}
+	blockCacheSize, err := o.Int64(storage.OptBlockCacheSize, defaultBlockCacheSize)
+	if err != nil {
+		return nil, err
+	}
+	maxOpenFiles, err := o.Int(storage.OptMaxOpenFiles, defaultMaxOpenFiles)
+	if err != nil {
+		return nil, err
+	}
+	logger.Infof("Rocksdb write buffer size: %d, block cache size: %d, max open files: %d",
+		writeBufferSize, blockCacheSize, maxOpenFiles)
+
+	bbto := gorocksdb.NewDefaultBlockBasedTableOptions()
 	// filter := gorocksdb.NewBloomFilter(number)
 	// bbto.SetFilterPolicy(filter)
-
-	// bbto.SetBlockCache(gorocksdb.NewLRUCache(cache))
+	bbto.SetBlockCache(gorocksdb.NewLRUCache(uint64(blockCacheSize)))
 	options := gorocksdb.NewDefaultOptions()
-	// options.SetBlockBasedTableFactory(bbto)
+	options.SetBlockBasedTableFactory(bbto)
+	options.SetWriteBufferSize(writeBufferSize)
+	options.SetMaxOpenFiles(maxOpenFiles)
 	options.SetCreateIfMissing(true)
 	options.SetCreateIfMissingColumnFamilies(true)
 	options.SetMaxBackgroundFlushes(4)
+	// statistics tell block cache hits and misses
+	options.EnableStatistics()
 
 	prepare(name)
 	// get all column families
@@ -91,6 +91,22 @@ func TestDBBatch(t *testing.T) {
 	dbtest.StorageBatch(t, db)
 }
 
+func TestDBMultiBatch(t *testing.T) {
+	dbpath, db, err := getDatabase()
+	ensure.Nil(t, err)
+	defer releaseDatabase(dbpath, db)
+
+	dbtest.StorageMultiBatch(t, db)
+}
+
+func TestDBTableSizes(t *testing.T) {
+	dbpath, db, err := getDatabase()
+	ensure.Nil(t, err)
+	defer releaseDatabase(dbpath, db)
+
+	dbtest.StorageTableSizes(t, db)
+}
+
 func TestDBBatchs(t *testing.T) {
 	for i := 0; i < 10; i++ {
 		t.Run(fmt.Sprint("t", i), TestDBBatch)
@@ -153,6 +169,30 @@ func TestDBIterKeysWithPrefixCancel(t *testing.T) {
 	dbtest.StorageIterKeysWithPrefixCancel(t, db)(t, db)
 }
 
+func TestDBIterator(t *testing.T) {
+	dbpath, db, err := getDatabase()
+	ensure.Nil(t, err)
+	defer releaseDatabase(dbpath, db)
+
+	dbtest.StorageIterator(t, db)
+}
+
+func TestDBRangeIterator(t *testing.T) {
+	dbpath, db, err := getDatabase()
+	ensure.Nil(t, err)
+	defer releaseDatabase(dbpath, db)
+
+	dbtest.StorageRangeIterator(t, db)
+}
+
+func TestDBIteratorSnapshot(t *testing.T) {
+	dbpath, db, err := getDatabase()
+	ensure.Nil(t, err)
+	defer releaseDatabase(dbpath, db)
+
+	dbtest.StorageIteratorSnapshot(t, db)
+}
+
 func TestDBPersistent(t *testing.T) {
 	dbpath, db, err := getDatabase()
 	ensure.Nil(t, err)
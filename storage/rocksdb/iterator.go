@@ -0,0 +1,107 @@
+// Copyright (c) 2018 ContentBox Authors.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package rocksdb
+
+import (
+	"bytes"
+
+	"github.com/tecbot/gorocksdb"
+)
+
+type riterator struct {
+	rocksdb     *gorocksdb.DB
+	snapshot    *gorocksdb.Snapshot
+	readOptions *gorocksdb.ReadOptions
+	iter        *gorocksdb.Iterator
+
+	start   []byte
+	limit   []byte
+	started bool
+
+	key   []byte
+	value []byte
+}
+
+// newIterator creates an iterator over entries of the column family with
+// keys in [start, limit), the default column family if cf is nil
+func newIterator(db *gorocksdb.DB, cf *gorocksdb.ColumnFamilyHandle, start, limit []byte) *riterator {
+	snapshot := db.NewSnapshot()
+	readOptions := gorocksdb.NewDefaultReadOptions()
+	readOptions.SetSnapshot(snapshot)
+
+	var iter *gorocksdb.Iterator
+	if cf != nil {
+		iter = db.NewIteratorCF(readOptions, cf)
+	} else {
+		iter = db.NewIterator(readOptions)
+	}
+
+	return &riterator{
+		rocksdb:     db,
+		snapshot:    snapshot,
+		readOptions: readOptions,
+		iter:        iter,
+		start:       start,
+		limit:       limit,
+	}
+}
+
+// move to the next entry, the first one on the first call
+func (it *riterator) Next() bool {
+	if it.iter == nil {
+		return false
+	}
+
+	if !it.started {
+		it.started = true
+		if len(it.start) == 0 {
+			it.iter.SeekToFirst()
+		} else {
+			it.iter.Seek(it.start)
+		}
+	} else {
+		it.iter.Next()
+	}
+
+	if !it.iter.Valid() {
+		return false
+	}
+	key := data(it.iter.Key())
+	if it.limit != nil && bytes.Compare(key, it.limit) >= 0 {
+		return false
+	}
+	it.key = key
+	it.value = data(it.iter.Value())
+	return true
+}
+
+// return the key of the current entry
+func (it *riterator) Key() []byte {
+	return it.key
+}
+
+// return the value of the current entry
+func (it *riterator) Value() []byte {
+	return it.value
+}
+
+// return the error occurred iterating, if any
+func (it *riterator) Error() error {
+	if it.iter == nil {
+		return nil
+	}
+	return it.iter.Err()
+}
+
+// release the snapshot, it must be called to close the iterator
+func (it *riterator) Release() {
+	if it.iter == nil {
+		return
+	}
+	it.iter.Close()
+	it.readOptions.Destroy()
+	it.rocksdb.ReleaseSnapshot(it.snapshot)
+	it.iter = nil
+}
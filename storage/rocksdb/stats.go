@@ -0,0 +1,65 @@
+// Copyright (c) 2018 ContentBox Authors.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package rocksdb
+
+import (
+	"bufio"
+	"strconv"
+	"strings"
+
+	storage "github.com/BOXFoundation/boxd/storage"
+)
+
+var _ storage.TableSizer = (*rocksdb)(nil)
+var _ storage.CacheStater = (*rocksdb)(nil)
+
+// properties summed up as the size of a column family
+var sizeProperties = []string{
+	"rocksdb.total-sst-files-size",
+	"rocksdb.size-all-mem-tables",
+}
+
+// TableSizes returns sizes of sst files and memtables of column families
+func (db *rocksdb) TableSizes() (map[string]int64, error) {
+	db.smcfhandlers.Lock()
+	defer db.smcfhandlers.Unlock()
+
+	var sizes = make(map[string]int64, len(db.cfs)+1)
+	for _, prop := range sizeProperties {
+		// the default column family is the default table
+		sizes[storage.DefaultTableName] += parseInt(db.rocksdb.GetProperty(prop))
+		for name, cf := range db.cfs {
+			if name != storage.DefaultTableName {
+				sizes[name] += parseInt(db.rocksdb.GetPropertyCF(prop, cf))
+			}
+		}
+	}
+	return sizes, nil
+}
+
+// CacheStats returns block cache hits and misses in statistics
+func (db *rocksdb) CacheStats() (hits, misses int64) {
+	scanner := bufio.NewScanner(strings.NewReader(db.dboptions.GetStatisticsString()))
+	for scanner.Scan() {
+		// lines are like "rocksdb.block.cache.hit COUNT : 10"
+		fields := strings.Fields(scanner.Text())
+		if len(fields) != 4 || fields[1] != "COUNT" {
+			continue
+		}
+		switch fields[0] {
+		case "rocksdb.block.cache.hit":
+			hits = parseInt(fields[3])
+		case "rocksdb.block.cache.miss":
+			misses = parseInt(fields[3])
+		}
+	}
+	return hits, misses
+}
+
+// parseInt parses a property or statistic value, 0 if it's invalid
+func parseInt(s string) int64 {
+	n, _ := strconv.ParseInt(strings.TrimSpace(s), 10, 64)
+	return n
+}
@@ -87,6 +87,14 @@ func (db *rocksdb) NewBatch() storage.Batch {
 	}
 }
 
+// create a new write batch across tables
+func (db *rocksdb) NewMultiBatch() storage.MultiBatch {
+	return &rmbatch{
+		rbatch: db.NewBatch().(*rbatch),
+		db:     db,
+	}
+}
+
 func (db *rocksdb) NewTransaction() (storage.Transaction, error) {
 	db.sm.Lock()
 	defer db.sm.Unlock()
@@ -111,6 +119,16 @@ func (db *rocksdb) NewTransaction() (storage.Transaction, error) {
 	return db.tr, nil
 }
 
+// create an iterator over entries with keys of the prefix
+func (db *rocksdb) NewIterator(prefix []byte) storage.Iterator {
+	return db.NewRangeIterator(storage.PrefixRange(prefix))
+}
+
+// create an iterator over entries with keys in [start, limit)
+func (db *rocksdb) NewRangeIterator(start, limit []byte) storage.Iterator {
+	return newIterator(db.rocksdb, nil, start, limit)
+}
+
 func waitLock(c chan<- struct{}) {
 	timer := time.NewTimer(time.Second * 3)
 	defer timer.Stop()
@@ -51,6 +51,16 @@ func (t *rtable) NewTransaction() (tr storage.Transaction, err error) {
 	return tr, nil
 }
 
+// create an iterator over entries with keys of the prefix
+func (t *rtable) NewIterator(prefix []byte) storage.Iterator {
+	return t.NewRangeIterator(storage.PrefixRange(prefix))
+}
+
+// create an iterator over entries with keys in [start, limit)
+func (t *rtable) NewRangeIterator(start, limit []byte) storage.Iterator {
+	return newIterator(t.rocksdb, t.cf, start, limit)
+}
+
 // put the value to entry associate with the key
 func (t *rtable) Put(key, value []byte) (err error) {
 	defer func() {
@@ -170,6 +170,39 @@ func TestTableIterKeysWithPrefixCancel(t *testing.T) {
 	dbtest.StorageIterKeysWithPrefixCancel(t, table)(t, table)
 }
 
+func TestTableIterator(t *testing.T) {
+	dbpath, db, err := getDatabase()
+	ensure.Nil(t, err)
+	defer releaseDatabase(dbpath, db)
+
+	table, err := db.Table("t1")
+	ensure.Nil(t, err)
+
+	dbtest.StorageIterator(t, table)
+}
+
+func TestTableRangeIterator(t *testing.T) {
+	dbpath, db, err := getDatabase()
+	ensure.Nil(t, err)
+	defer releaseDatabase(dbpath, db)
+
+	table, err := db.Table("t1")
+	ensure.Nil(t, err)
+
+	dbtest.StorageRangeIterator(t, table)
+}
+
+func TestTableIteratorSnapshot(t *testing.T) {
+	dbpath, db, err := getDatabase()
+	ensure.Nil(t, err)
+	defer releaseDatabase(dbpath, db)
+
+	table, err := db.Table("t1")
+	ensure.Nil(t, err)
+
+	dbtest.StorageIteratorSnapshot(t, table)
+}
+
 func TestTablePersistent(t *testing.T) {
 	dbpath, db, err := getDatabase()
 	ensure.Nil(t, err)
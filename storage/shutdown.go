@@ -0,0 +1,40 @@
+// Copyright (c) 2018 ContentBox Authors.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package storage
+
+import "bytes"
+
+// ShutdownKey is the db key of the default table recording if the database
+// is open or was closed cleanly
+const ShutdownKey = "/db/shutdown"
+
+var (
+	shutdownRunning = []byte("running")
+	shutdownClean   = []byte("clean")
+)
+
+// markRunning records that the database is open, and returns if it was not
+// closed cleanly the last time it was open. A new database is clean
+func markRunning(s Storage) (bool, error) {
+	state, err := s.Get([]byte(ShutdownKey))
+	if err != nil {
+		return false, err
+	}
+	unclean := state != nil && !bytes.Equal(state, shutdownClean)
+	return unclean, s.Put([]byte(ShutdownKey), shutdownRunning)
+}
+
+// markClean records that the database is closed cleanly. It's the last write
+// before the database is closed
+func markClean(s Storage) error {
+	return s.Put([]byte(ShutdownKey), shutdownClean)
+}
+
+// Unclean returns if the database was not closed cleanly the last time it
+// was open, e.g. on a crash or a shutdown timeout, when data written last may
+// be inconsistent
+func (db *Database) Unclean() bool {
+	return db.unclean
+}
@@ -12,6 +12,9 @@ type Storage interface {
 	Table(string) (Table, error)
 	DropTable(string) error
 
+	// create a new write batch across tables of the storage
+	NewMultiBatch() MultiBatch
+
 	Close() error
 }
 
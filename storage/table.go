@@ -13,4 +13,11 @@ type Table interface {
 
 	// NewTransaction creates a new transaction on the Storage.
 	NewTransaction() (Transaction, error)
+
+	// NewIterator creates an iterator over entries with keys of the prefix
+	NewIterator(prefix []byte) Iterator
+
+	// NewRangeIterator creates an iterator over entries with keys in
+	// [start, limit). A nil limit means no upper bound
+	NewRangeIterator(start, limit []byte) Iterator
 }
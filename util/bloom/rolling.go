@@ -0,0 +1,78 @@
+// Copyright (c) 2018 ContentBox Authors.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package bloom
+
+import (
+	"sync"
+	"time"
+)
+
+// RollingFilter is a bloom filter forgetting elements over time. Elements
+// are added to the current of two generations, which becomes the previous
+// one once it holds the elements it's sized for or period passes. Added
+// elements are thus matched for at least one period, unless pushed out by
+// more than the elements of a generation
+type RollingFilter struct {
+	sm        sync.Mutex
+	elements  uint32
+	fprate    float64
+	period    time.Duration
+	current   Filter
+	previous  Filter
+	added     uint32
+	rotatedAt time.Time
+}
+
+// NewRollingFilter returns a RollingFilter with generations sized for
+// elements at fprate, rotated every period
+func NewRollingFilter(elements uint32, fprate float64, period time.Duration) *RollingFilter {
+	return &RollingFilter{
+		elements:  elements,
+		fprate:    fprate,
+		period:    period,
+		current:   NewFilter(elements, fprate),
+		previous:  NewFilter(elements, fprate),
+		rotatedAt: time.Now(),
+	}
+}
+
+// Matches checks if data is in either generation
+func (rf *RollingFilter) Matches(data []byte) bool {
+	rf.sm.Lock()
+	defer rf.sm.Unlock()
+	return rf.current.Matches(data) || rf.previous.Matches(data)
+}
+
+// Add adds data to the current generation
+func (rf *RollingFilter) Add(data []byte) {
+	rf.sm.Lock()
+	rf.add(data, time.Now())
+	rf.sm.Unlock()
+}
+
+// MatchesAndAdd matches data, and adds it to the filter in case of false
+func (rf *RollingFilter) MatchesAndAdd(data []byte) bool {
+	rf.sm.Lock()
+	defer rf.sm.Unlock()
+	return rf.matchesAndAdd(data, time.Now())
+}
+
+func (rf *RollingFilter) matchesAndAdd(data []byte, now time.Time) bool {
+	if rf.current.Matches(data) || rf.previous.Matches(data) {
+		return true
+	}
+	rf.add(data, now)
+	return false
+}
+
+func (rf *RollingFilter) add(data []byte, now time.Time) {
+	if rf.added >= rf.elements || now.Sub(rf.rotatedAt) >= rf.period {
+		rf.previous, rf.current = rf.current, NewFilter(rf.elements, rf.fprate)
+		rf.added = 0
+		rf.rotatedAt = now
+	}
+	rf.current.Add(data)
+	rf.added++
+}
@@ -0,0 +1,48 @@
+// Copyright (c) 2018 ContentBox Authors.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package bloom
+
+import (
+	"testing"
+	"time"
+
+	"github.com/facebookgo/ensure"
+)
+
+func TestRollingFilterPeriod(t *testing.T) {
+	rf := NewRollingFilter(100, 0.0001, time.Minute)
+	now := rf.rotatedAt
+	ensure.False(t, rf.matchesAndAdd([]byte("a"), now))
+	ensure.True(t, rf.matchesAndAdd([]byte("a"), now))
+
+	// a is kept in the previous generation for another period
+	now = now.Add(time.Minute)
+	ensure.False(t, rf.matchesAndAdd([]byte("b"), now))
+	ensure.True(t, rf.Matches([]byte("a")))
+
+	now = now.Add(time.Minute)
+	ensure.False(t, rf.matchesAndAdd([]byte("c"), now))
+	ensure.False(t, rf.Matches([]byte("a")))
+	ensure.True(t, rf.Matches([]byte("b")))
+	ensure.True(t, rf.Matches([]byte("c")))
+}
+
+func TestRollingFilterElements(t *testing.T) {
+	rf := NewRollingFilter(2, 0.0001, time.Hour)
+	now := rf.rotatedAt
+	for _, data := range []string{"a", "b", "c", "d", "e", "f"} {
+		ensure.False(t, rf.matchesAndAdd([]byte(data), now))
+	}
+	// a and b are pushed out by two generations of elements
+	ensure.False(t, rf.Matches([]byte("a")))
+	ensure.False(t, rf.Matches([]byte("b")))
+	for _, data := range []string{"c", "d", "e", "f"} {
+		ensure.True(t, rf.Matches([]byte(data)))
+	}
+
+	rf.Add([]byte("g"))
+	ensure.True(t, rf.Matches([]byte("g")))
+	ensure.False(t, rf.Matches([]byte("c")))
+}
@@ -66,3 +66,36 @@ func CombineHash(left *crypto.HashType, right *crypto.HashType) *crypto.HashType
 	newHash := crypto.DoubleHashH(hash[:])
 	return &newHash
 }
+
+// BuildMerkleBranch returns the hashes to combine with the leaf at index,
+// bottom up, to get the root of merkles built by BuildMerkleRoot
+func BuildMerkleBranch(merkles []*crypto.HashType, index int) []*crypto.HashType {
+	var branch []*crypto.HashType
+	offset := 0
+	for width := (len(merkles) + 1) / 2; width > 1; width /= 2 {
+		sibling := merkles[offset+(index^1)]
+		if sibling == nil {
+			// the last node of an odd level is combined with itself
+			sibling = merkles[offset+index]
+		}
+		branch = append(branch, sibling)
+		offset += width
+		index /= 2
+	}
+	return branch
+}
+
+// MerkleBranchRoot returns the root which leaf at index proves to be in by
+// branch got from BuildMerkleBranch
+func MerkleBranchRoot(leaf *crypto.HashType, index int, branch []*crypto.HashType) *crypto.HashType {
+	hash := leaf
+	for _, sibling := range branch {
+		if index&1 == 0 {
+			hash = CombineHash(hash, sibling)
+		} else {
+			hash = CombineHash(sibling, hash)
+		}
+		index /= 2
+	}
+	return hash
+}
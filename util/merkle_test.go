@@ -0,0 +1,32 @@
+// Copyright (c) 2018 ContentBox Authors.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package util
+
+import (
+	"testing"
+
+	"github.com/BOXFoundation/boxd/crypto"
+	"github.com/facebookgo/ensure"
+)
+
+func TestMerkleBranch(t *testing.T) {
+	for n := 1; n <= 9; n++ {
+		var leaves []*crypto.HashType
+		for i := 0; i < n; i++ {
+			hash := crypto.DoubleHashH([]byte{byte(i)})
+			leaves = append(leaves, &hash)
+		}
+		merkles := BuildMerkleRoot(leaves)
+		root := merkles[len(merkles)-1]
+		for i, leaf := range leaves {
+			branch := BuildMerkleBranch(merkles, i)
+			ensure.DeepEqual(t, MerkleBranchRoot(leaf, i, branch), root)
+		}
+		if n > 1 {
+			branch := BuildMerkleBranch(merkles, 0)
+			ensure.NotDeepEqual(t, MerkleBranchRoot(leaves[0], 1, branch), root)
+		}
+	}
+}
@@ -0,0 +1,171 @@
+// Copyright (c) 2018 ContentBox Authors.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path"
+	"sort"
+	"strings"
+	"time"
+)
+
+const accountMetaFile = "accounts_meta.json"
+
+// AccountMeta is the user facing metadata of an account
+type AccountMeta struct {
+	Label string `json:"label,omitempty"`
+	// Created is the unix timestamp the account is created or imported at
+	Created int64             `json:"created"`
+	Notes   map[string]string `json:"notes,omitempty"`
+}
+
+func (meta *AccountMeta) copy() AccountMeta {
+	cp := *meta
+	if meta.Notes != nil {
+		cp.Notes = make(map[string]string, len(meta.Notes))
+		for k, v := range meta.Notes {
+			cp.Notes[k] = v
+		}
+	}
+	return cp
+}
+
+// loadAccountMeta reads metadata of accounts keyed by address in dir
+func loadAccountMeta(dir string) (map[string]*AccountMeta, error) {
+	meta := make(map[string]*AccountMeta)
+	content, err := ioutil.ReadFile(path.Join(dir, accountMetaFile))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return meta, nil
+		}
+		return nil, err
+	}
+	if err := json.Unmarshal(content, &meta); err != nil {
+		return nil, err
+	}
+	return meta, nil
+}
+
+// saveAccountMeta writes metadata of accounts, it's called with mtx held
+func (wlt *Manager) saveAccountMeta() error {
+	content, err := json.Marshal(wlt.meta)
+	if err != nil {
+		return err
+	}
+	filePath := path.Join(wlt.path, accountMetaFile)
+	tmpPath, err := tryWriteTempFile(filePath, content)
+	if err != nil {
+		return err
+	}
+	return os.Rename(tmpPath, filePath)
+}
+
+// fillAccountMeta makes up creation time of accounts created before metadata
+// is kept, with the modification time of keystore files if any
+func (wlt *Manager) fillAccountMeta() {
+	for addr, acc := range wlt.accounts {
+		if _, ok := wlt.meta[addr]; ok {
+			continue
+		}
+		meta := &AccountMeta{}
+		if acc.path != "" {
+			if fi, err := os.Stat(acc.path); err == nil {
+				meta.Created = fi.ModTime().Unix()
+			}
+		}
+		wlt.meta[addr] = meta
+	}
+}
+
+// recordCreation keeps the creation time of a new account, it's called with mtx held
+func (wlt *Manager) recordCreation(addr string) error {
+	if _, ok := wlt.meta[addr]; ok {
+		return nil
+	}
+	wlt.meta[addr] = &AccountMeta{Created: time.Now().Unix()}
+	return wlt.saveAccountMeta()
+}
+
+// AccountMeta returns metadata of the account of address
+func (wlt *Manager) AccountMeta(address string) (AccountMeta, bool) {
+	wlt.mtx.Lock()
+	defer wlt.mtx.Unlock()
+	meta, ok := wlt.meta[address]
+	if !ok {
+		return AccountMeta{}, false
+	}
+	return meta.copy(), true
+}
+
+// SetAccountLabel labels the account of address, an empty label clears it
+func (wlt *Manager) SetAccountLabel(address, label string) error {
+	return wlt.updateAccountMeta(address, func(meta *AccountMeta) {
+		meta.Label = label
+	})
+}
+
+// SetAccountNote sets a key/value note of the account of address, an empty
+// value removes the note
+func (wlt *Manager) SetAccountNote(address, key, value string) error {
+	if key == "" {
+		return fmt.Errorf("Note key should not be empty")
+	}
+	return wlt.updateAccountMeta(address, func(meta *AccountMeta) {
+		if value == "" {
+			delete(meta.Notes, key)
+			return
+		}
+		if meta.Notes == nil {
+			meta.Notes = make(map[string]string)
+		}
+		meta.Notes[key] = value
+	})
+}
+
+func (wlt *Manager) updateAccountMeta(address string, update func(*AccountMeta)) error {
+	address = accountKey(address)
+	wlt.mtx.Lock()
+	defer wlt.mtx.Unlock()
+	if _, ok := wlt.accounts[address]; !ok {
+		return fmt.Errorf("Address not found: %s", address)
+	}
+	meta, ok := wlt.meta[address]
+	if !ok {
+		meta = &AccountMeta{}
+		wlt.meta[address] = meta
+	}
+	update(meta)
+	return wlt.saveAccountMeta()
+}
+
+// FindAccountsByLabel returns accounts whose labels contain query case
+// insensitively, ordered by creation time. All accounts match an empty query
+func (wlt *Manager) FindAccountsByLabel(query string) []*Account {
+	wlt.mtx.Lock()
+	defer wlt.mtx.Unlock()
+	query = strings.ToLower(query)
+	accounts := make([]*Account, 0)
+	for addr, acc := range wlt.accounts {
+		label := ""
+		if meta, ok := wlt.meta[addr]; ok {
+			label = meta.Label
+		}
+		if strings.Contains(strings.ToLower(label), query) {
+			accounts = append(accounts, acc)
+		}
+	}
+	sort.Slice(accounts, func(i, j int) bool {
+		ci, cj := wlt.meta[accounts[i].Addr()], wlt.meta[accounts[j].Addr()]
+		if ci.Created != cj.Created {
+			return ci.Created < cj.Created
+		}
+		return accounts[i].Addr() < accounts[j].Addr()
+	})
+	return accounts
+}
@@ -0,0 +1,59 @@
+// Copyright (c) 2018 ContentBox Authors.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	btypes "github.com/BOXFoundation/boxd/core/types"
+	"github.com/BOXFoundation/boxd/crypto"
+	"github.com/facebookgo/ensure"
+)
+
+func TestAccountMeta(t *testing.T) {
+	dir, err := ioutil.TempDir("", "accountmeta")
+	ensure.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	wltMgr, err := NewWalletManager(dir)
+	ensure.Nil(t, err)
+	var addrs []string
+	for i := 0; i < 2; i++ {
+		_, pubKey, err := crypto.NewKeyPair()
+		ensure.Nil(t, err)
+		addr, err := btypes.NewAddressFromPubKey(pubKey)
+		ensure.Nil(t, err)
+		_, err = wltMgr.ImportAddress(addr.String())
+		ensure.Nil(t, err)
+		addrs = append(addrs, addr.String())
+	}
+	meta, ok := wltMgr.AccountMeta(addrs[0])
+	ensure.True(t, ok)
+	ensure.True(t, meta.Created > 0)
+
+	ensure.Nil(t, wltMgr.SetAccountLabel(addrs[0], "Test Faucet"))
+	ensure.Nil(t, wltMgr.SetAccountLabel(addrs[1], "production"))
+	ensure.Nil(t, wltMgr.SetAccountNote(addrs[0], "owner", "alice"))
+	ensure.Nil(t, wltMgr.SetAccountNote(addrs[0], "env", "testnet"))
+	ensure.Nil(t, wltMgr.SetAccountNote(addrs[0], "env", ""))
+	ensure.NotNil(t, wltMgr.SetAccountNote(addrs[0], "", "value"))
+	ensure.NotNil(t, wltMgr.SetAccountLabel("unknown", "label"))
+
+	// metadata is loaded again and searchable by label
+	wltMgr, err = NewWalletManager(dir)
+	ensure.Nil(t, err)
+	meta, ok = wltMgr.AccountMeta(addrs[0])
+	ensure.True(t, ok)
+	ensure.DeepEqual(t, meta.Label, "Test Faucet")
+	ensure.DeepEqual(t, meta.Notes, map[string]string{"owner": "alice"})
+
+	accounts := wltMgr.FindAccountsByLabel("faucet")
+	ensure.DeepEqual(t, len(accounts), 1)
+	ensure.DeepEqual(t, accounts[0].Addr(), addrs[0])
+	ensure.DeepEqual(t, len(wltMgr.FindAccountsByLabel("")), 2)
+	ensure.DeepEqual(t, len(wltMgr.FindAccountsByLabel("staging")), 0)
+}
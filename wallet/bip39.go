@@ -0,0 +1,131 @@
+// Copyright (c) 2018 ContentBox Authors.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/sha512"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"golang.org/x/crypto/pbkdf2"
+)
+
+// BIP39 parameters
+const (
+	// MnemonicWords12 and MnemonicWords24 are the supported mnemonic lengths
+	MnemonicWords12 = 12
+	MnemonicWords24 = 24
+
+	mnemonicSeedIterations = 2048
+	mnemonicSeedLen        = 64
+	bitsPerWord            = 11
+)
+
+var (
+	errInvalidMnemonicLen = errors.New("Mnemonic must be 12 or 24 words")
+	errMnemonicChecksum   = errors.New("Invalid mnemonic checksum")
+
+	wordIndexes = func() map[string]int {
+		indexes := make(map[string]int, len(englishWords))
+		for i, word := range englishWords {
+			indexes[word] = i
+		}
+		return indexes
+	}()
+)
+
+// mnemonicEntropyLen returns the entropy bytes of a mnemonic of words
+func mnemonicEntropyLen(words int) (int, error) {
+	switch words {
+	case MnemonicWords12:
+		return 16, nil
+	case MnemonicWords24:
+		return 32, nil
+	default:
+		return 0, errInvalidMnemonicLen
+	}
+}
+
+// NewMnemonic generates a random mnemonic of 12 or 24 words
+func NewMnemonic(words int) (string, error) {
+	entropyLen, err := mnemonicEntropyLen(words)
+	if err != nil {
+		return "", err
+	}
+	entropy := make([]byte, entropyLen)
+	if _, err := rand.Read(entropy); err != nil {
+		return "", err
+	}
+	return entropyToMnemonic(entropy)
+}
+
+// entropyToMnemonic encodes entropy followed by its checksum, the first
+// len(entropy)/4 bits of its sha256, in words of 11 bits each
+func entropyToMnemonic(entropy []byte) (string, error) {
+	words := len(entropy) * 3 / 4
+	if _, err := mnemonicEntropyLen(words); err != nil {
+		return "", err
+	}
+	checksumBits := uint(len(entropy) / 4)
+	hash := sha256.Sum256(entropy)
+	data := new(big.Int).SetBytes(entropy)
+	data.Lsh(data, checksumBits)
+	data.Or(data, big.NewInt(int64(hash[0]>>(8-checksumBits))))
+
+	mask := big.NewInt(1<<bitsPerWord - 1)
+	phrase := make([]string, words)
+	for i := words - 1; i >= 0; i-- {
+		index := new(big.Int).And(data, mask).Int64()
+		phrase[i] = englishWords[index]
+		data.Rsh(data, bitsPerWord)
+	}
+	return strings.Join(phrase, " "), nil
+}
+
+// MnemonicToEntropy decodes mnemonic and verifies its checksum
+func MnemonicToEntropy(mnemonic string) ([]byte, error) {
+	words := strings.Fields(mnemonic)
+	entropyLen, err := mnemonicEntropyLen(len(words))
+	if err != nil {
+		return nil, err
+	}
+	data := new(big.Int)
+	for _, word := range words {
+		index, ok := wordIndexes[word]
+		if !ok {
+			return nil, fmt.Errorf("Invalid mnemonic word: %s", word)
+		}
+		data.Lsh(data, bitsPerWord)
+		data.Or(data, big.NewInt(int64(index)))
+	}
+	checksumBits := uint(entropyLen / 4)
+	checksum := byte(new(big.Int).And(data, big.NewInt(1<<checksumBits-1)).Int64())
+	data.Rsh(data, checksumBits)
+
+	entropy := make([]byte, entropyLen)
+	raw := data.Bytes()
+	copy(entropy[entropyLen-len(raw):], raw)
+	hash := sha256.Sum256(entropy)
+	if hash[0]>>(8-checksumBits) != checksum {
+		return nil, errMnemonicChecksum
+	}
+	return entropy, nil
+}
+
+// MnemonicToSeed validates mnemonic and derives the 64 bytes hd wallet seed from
+// it and an optional passphrase. Mnemonic and passphrase are expected in NFKD,
+// which ascii text always is
+func MnemonicToSeed(mnemonic, passphrase string) ([]byte, error) {
+	if _, err := MnemonicToEntropy(mnemonic); err != nil {
+		return nil, err
+	}
+	normalized := strings.Join(strings.Fields(mnemonic), " ")
+	return pbkdf2.Key([]byte(normalized), []byte("mnemonic"+passphrase),
+		mnemonicSeedIterations, mnemonicSeedLen, sha512.New), nil
+}
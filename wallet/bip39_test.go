@@ -0,0 +1,78 @@
+// Copyright (c) 2018 ContentBox Authors.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	"github.com/facebookgo/ensure"
+)
+
+// test vectors from https://github.com/trezor/python-mnemonic/blob/master/vectors.json
+var bip39Vectors = []struct {
+	entropy  string
+	mnemonic string
+	seed     string
+}{
+	{
+		"00000000000000000000000000000000",
+		"abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about",
+		"c55257c360c07c72029aebc1b53c05ed0362ada38ead3e3e9efa3708e53495531f09a6987599d18264c1e1c92f2cf141630c7a3c4ab7c81b2f001698e7463b04",
+	},
+	{
+		"7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f",
+		"legal winner thank year wave sausage worth useful legal winner thank yellow",
+		"2e8905819b8723fe2c1d161860e5ee1830318dbf49a83bd451cfb8440c28bd6fa457fe1296106559a3c80937a1c1069be3a3a5bd381ee6260e8d9739fce1f607",
+	},
+	{
+		"0000000000000000000000000000000000000000000000000000000000000000",
+		"abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon " +
+			"abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon art",
+		"bda85446c68413707090a52022edd26a1c9462295029f2e60cd7c4f2bbd3097170af7a4d73245cafa9c3cca8d561a7c3de6f5d4a10be8ed2a5e608d68f92fcc8",
+	},
+}
+
+func TestMnemonicVectors(t *testing.T) {
+	for _, v := range bip39Vectors {
+		entropy, _ := hex.DecodeString(v.entropy)
+		mnemonic, err := entropyToMnemonic(entropy)
+		ensure.Nil(t, err)
+		ensure.DeepEqual(t, mnemonic, v.mnemonic)
+
+		decoded, err := MnemonicToEntropy(mnemonic)
+		ensure.Nil(t, err)
+		ensure.DeepEqual(t, decoded, entropy)
+
+		seed, err := MnemonicToSeed(mnemonic, "TREZOR")
+		ensure.Nil(t, err)
+		ensure.DeepEqual(t, hex.EncodeToString(seed), v.seed)
+	}
+}
+
+func TestNewMnemonic(t *testing.T) {
+	for _, words := range []int{MnemonicWords12, MnemonicWords24} {
+		mnemonic, err := NewMnemonic(words)
+		ensure.Nil(t, err)
+		ensure.DeepEqual(t, len(strings.Fields(mnemonic)), words)
+		_, err = MnemonicToEntropy(mnemonic)
+		ensure.Nil(t, err)
+	}
+	_, err := NewMnemonic(15)
+	ensure.DeepEqual(t, err, errInvalidMnemonicLen)
+}
+
+func TestInvalidMnemonic(t *testing.T) {
+	// last word changed breaks the checksum
+	_, err := MnemonicToEntropy("abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon")
+	ensure.DeepEqual(t, err, errMnemonicChecksum)
+
+	_, err = MnemonicToEntropy("abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon boxd")
+	ensure.NotNil(t, err)
+
+	_, err = MnemonicToSeed("abandon about", "")
+	ensure.DeepEqual(t, err, errInvalidMnemonicLen)
+}
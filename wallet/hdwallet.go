@@ -0,0 +1,346 @@
+// Copyright (c) 2018 ContentBox Authors.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path"
+
+	btypes "github.com/BOXFoundation/boxd/core/types"
+	"github.com/BOXFoundation/boxd/crypto"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcutil/hdkeychain"
+)
+
+const (
+	// HDPurpose is the purpose level of BIP44 derivation path
+	HDPurpose = 44
+	// HDCoinType is the coin type level of BIP44 derivation path for box,
+	// which is not registered in SLIP-0044 yet
+	HDCoinType = 1010
+
+	// ExternalChain is the chain of receive addresses
+	ExternalChain = 0
+	// InternalChain is the chain of change addresses
+	InternalChain = 1
+
+	// DefaultGapLimit is the number of consecutive unused addresses after
+	// which scanning stops, as BIP44 suggests
+	DefaultGapLimit = 20
+
+	hdWalletFile = "hdwallet.json"
+)
+
+// HDPath is the BIP44 derivation path m/44'/coin'/account'/chain/index of a key
+type HDPath struct {
+	Account uint32
+	Chain   uint32
+	Index   uint32
+}
+
+func (p *HDPath) String() string {
+	return fmt.Sprintf("m/%d'/%d'/%d'/%d/%d", HDPurpose, HDCoinType, p.Account, p.Chain, p.Index)
+}
+
+// HDWallet derives keys of accounts from a master seed following BIP32/BIP44.
+// The seed is stored encrypted, while extended public keys of accounts are
+// stored in plain so that fresh addresses can be derived without passphrase
+type HDWallet struct {
+	path string
+	seed cryptoJSON
+	// mnemonic keeps the encrypted BIP39 entropy for backup, nil if the
+	// wallet is created from a raw seed
+	mnemonic *cryptoJSON
+	accounts []*hdAccount
+}
+
+type hdAccount struct {
+	xpub *hdkeychain.ExtendedKey
+	// next unused index of external and internal chains
+	next [2]uint32
+}
+
+type hdWalletJSON struct {
+	Seed     cryptoJSON      `json:"seed"`
+	Mnemonic *cryptoJSON     `json:"mnemonic,omitempty"`
+	Accounts []hdAccountJSON `json:"accounts"`
+}
+
+type hdAccountJSON struct {
+	XPub         string `json:"xpub"`
+	NextExternal uint32 `json:"next_external"`
+	NextInternal uint32 `json:"next_internal"`
+}
+
+// newHDWallet creates a hd wallet from seed with its first account. entropy
+// is the BIP39 entropy seed is generated from, nil if there is none
+func newHDWallet(dir string, seed, entropy []byte, passphrase string) (*HDWallet, error) {
+	if len(seed) < hdkeychain.MinSeedBytes || len(seed) > hdkeychain.MaxSeedBytes {
+		return nil, hdkeychain.ErrInvalidSeedLen
+	}
+	hd := &HDWallet{path: path.Join(dir, hdWalletFile)}
+	if err := hd.encrypt(seed, entropy, passphrase); err != nil {
+		return nil, err
+	}
+	if _, err := hd.newAccount(passphrase); err != nil {
+		return nil, err
+	}
+	return hd, nil
+}
+
+// loadHDWallet loads the hd wallet in dir, nil is returned if there is none
+func loadHDWallet(dir string) (*HDWallet, error) {
+	filePath := path.Join(dir, hdWalletFile)
+	content, err := ioutil.ReadFile(filePath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	hdJSON := &hdWalletJSON{}
+	if err := json.Unmarshal(content, hdJSON); err != nil {
+		return nil, err
+	}
+	hd := &HDWallet{path: filePath, seed: hdJSON.Seed, mnemonic: hdJSON.Mnemonic}
+	for _, accJSON := range hdJSON.Accounts {
+		xpub, err := hdkeychain.NewKeyFromString(accJSON.XPub)
+		if err != nil {
+			return nil, err
+		}
+		hd.accounts = append(hd.accounts, &hdAccount{
+			xpub: xpub,
+			next: [2]uint32{accJSON.NextExternal, accJSON.NextInternal},
+		})
+	}
+	return hd, nil
+}
+
+func (hd *HDWallet) save() error {
+	hdJSON := &hdWalletJSON{Seed: hd.seed, Mnemonic: hd.mnemonic}
+	for _, acc := range hd.accounts {
+		hdJSON.Accounts = append(hdJSON.Accounts, hdAccountJSON{
+			XPub:         acc.xpub.String(),
+			NextExternal: acc.next[ExternalChain],
+			NextInternal: acc.next[InternalChain],
+		})
+	}
+	content, err := json.Marshal(hdJSON)
+	if err != nil {
+		return err
+	}
+	tmpPath, err := tryWriteTempFile(hd.path, content)
+	if err != nil {
+		return err
+	}
+	return os.Rename(tmpPath, hd.path)
+}
+
+// encrypt encrypts seed and the BIP39 entropy if any with passphrase
+func (hd *HDWallet) encrypt(seed, entropy []byte, passphrase string) error {
+	cpt, err := encryptWithPassphrase(seed, passphrase)
+	if err != nil {
+		return err
+	}
+	var mnemonic *cryptoJSON
+	if entropy != nil {
+		cpt, err := encryptWithPassphrase(entropy, passphrase)
+		if err != nil {
+			return err
+		}
+		mnemonic = &cpt
+	}
+	hd.seed, hd.mnemonic = cpt, mnemonic
+	return nil
+}
+
+// changePassphrase encrypts seed and mnemonic again with newPassphrase
+func (hd *HDWallet) changePassphrase(oldPassphrase, newPassphrase string) error {
+	seed, err := decryptWithPassphrase(&hd.seed, oldPassphrase)
+	if err != nil {
+		return err
+	}
+	var entropy []byte
+	if hd.mnemonic != nil {
+		if entropy, err = decryptWithPassphrase(hd.mnemonic, oldPassphrase); err != nil {
+			return err
+		}
+	}
+	if err := hd.encrypt(seed, entropy, newPassphrase); err != nil {
+		return err
+	}
+	return hd.save()
+}
+
+// exportMnemonic decrypts the BIP39 entropy and encodes it as mnemonic
+func (hd *HDWallet) exportMnemonic(passphrase string) (string, error) {
+	if hd.mnemonic == nil {
+		return "", errors.New("HD wallet is not created from mnemonic")
+	}
+	entropy, err := decryptWithPassphrase(hd.mnemonic, passphrase)
+	if err != nil {
+		return "", err
+	}
+	return entropyToMnemonic(entropy)
+}
+
+// accountKey derives the private extended key at m/44'/coin'/account'. The
+// seed is encrypted again if it's encrypted with an outdated kdf
+func (hd *HDWallet) accountKey(passphrase string, account uint32) (*hdkeychain.ExtendedKey, error) {
+	seed, err := decryptWithPassphrase(&hd.seed, passphrase)
+	if err != nil {
+		return nil, err
+	}
+	if hd.seed.outdated() {
+		// migrate to the current kdf, unlocking goes on even if it fails
+		hd.changePassphrase(passphrase, passphrase)
+	}
+	key, err := hdkeychain.NewMaster(seed, &chaincfg.MainNetParams)
+	if err != nil {
+		return nil, err
+	}
+	for _, i := range []uint32{HDPurpose, HDCoinType, account} {
+		if key, err = key.Child(hdkeychain.HardenedKeyStart + i); err != nil {
+			return nil, err
+		}
+	}
+	return key, nil
+}
+
+// newAccount derives the next account and returns its index
+func (hd *HDWallet) newAccount(passphrase string) (uint32, error) {
+	index := uint32(len(hd.accounts))
+	key, err := hd.accountKey(passphrase, index)
+	if err != nil {
+		return 0, err
+	}
+	xpub, err := key.Neuter()
+	if err != nil {
+		return 0, err
+	}
+	hd.accounts = append(hd.accounts, &hdAccount{xpub: xpub})
+	return index, hd.save()
+}
+
+// address derives the address at path from the extended public key of its account
+func (hd *HDWallet) address(p *HDPath) (*btypes.AddressPubKeyHash, error) {
+	if p.Account >= uint32(len(hd.accounts)) {
+		return nil, fmt.Errorf("HD account not found: %d", p.Account)
+	}
+	key, err := hd.accounts[p.Account].xpub.Child(p.Chain)
+	if err != nil {
+		return nil, err
+	}
+	if key, err = key.Child(p.Index); err != nil {
+		return nil, err
+	}
+	pubKey, err := key.ECPubKey()
+	if err != nil {
+		return nil, err
+	}
+	return btypes.NewAddressFromPubKey((*crypto.PublicKey)(pubKey))
+}
+
+// privKey derives the private key at path, which requires the passphrase of seed
+func (hd *HDWallet) privKey(passphrase string, p *HDPath) (*crypto.PrivateKey, error) {
+	key, err := hd.accountKey(passphrase, p.Account)
+	if err != nil {
+		return nil, err
+	}
+	if key, err = key.Child(p.Chain); err != nil {
+		return nil, err
+	}
+	if key, err = key.Child(p.Index); err != nil {
+		return nil, err
+	}
+	privKey, err := key.ECPrivKey()
+	if err != nil {
+		return nil, err
+	}
+	return (*crypto.PrivateKey)(privKey), nil
+}
+
+// nextAddress derives the address at the next unused index of chain. Indexes
+// yielding invalid keys are skipped as BIP32 specifies
+func (hd *HDWallet) nextAddress(account, chain uint32) (*btypes.AddressPubKeyHash, *HDPath, error) {
+	if account >= uint32(len(hd.accounts)) {
+		return nil, nil, fmt.Errorf("HD account not found: %d", account)
+	}
+	if chain != ExternalChain && chain != InternalChain {
+		return nil, nil, fmt.Errorf("Invalid HD chain: %d", chain)
+	}
+	acc := hd.accounts[account]
+	for {
+		p := &HDPath{Account: account, Chain: chain, Index: acc.next[chain]}
+		acc.next[chain]++
+		addr, err := hd.address(p)
+		if err == hdkeychain.ErrInvalidChild {
+			continue
+		}
+		if err != nil {
+			acc.next[chain]--
+			return nil, nil, err
+		}
+		return addr, p, hd.save()
+	}
+}
+
+// paths returns paths of all addresses derived so far
+func (hd *HDWallet) paths() []*HDPath {
+	var paths []*HDPath
+	for account, acc := range hd.accounts {
+		for _, chain := range []uint32{ExternalChain, InternalChain} {
+			for index := uint32(0); index < acc.next[chain]; index++ {
+				paths = append(paths, &HDPath{Account: uint32(account), Chain: chain, Index: index})
+			}
+		}
+	}
+	return paths
+}
+
+// scan looks for used addresses beyond derived ones until gapLimit consecutive
+// unused addresses are met on each chain, and moves next indexes past them.
+// Paths of addresses newly taken into use are returned
+func (hd *HDWallet) scan(gapLimit uint32, used func(btypes.Address) (bool, error)) ([]*HDPath, error) {
+	var found []*HDPath
+	for account, acc := range hd.accounts {
+		for _, chain := range []uint32{ExternalChain, InternalChain} {
+			start := acc.next[chain]
+			next := start
+			for index, gap := start, uint32(0); gap < gapLimit; index++ {
+				p := &HDPath{Account: uint32(account), Chain: chain, Index: index}
+				addr, err := hd.address(p)
+				if err == hdkeychain.ErrInvalidChild {
+					continue
+				}
+				if err != nil {
+					return nil, err
+				}
+				ok, err := used(addr)
+				if err != nil {
+					return nil, err
+				}
+				if !ok {
+					gap++
+					continue
+				}
+				gap = 0
+				next = index + 1
+			}
+			for index := start; index < next; index++ {
+				found = append(found, &HDPath{Account: uint32(account), Chain: chain, Index: index})
+			}
+			acc.next[chain] = next
+		}
+	}
+	if len(found) == 0 {
+		return nil, nil
+	}
+	return found, hd.save()
+}
@@ -17,6 +17,7 @@ import (
 	"os"
 	"path/filepath"
 
+	"golang.org/x/crypto/argon2"
 	"golang.org/x/crypto/scrypt"
 
 	btypes "github.com/BOXFoundation/boxd/core/types"
@@ -28,8 +29,59 @@ const (
 	scryptR     = 8
 	scryptP     = 1
 	scryptDklen = 32
+
+	// keystoreVersion is the version of keystore files written now, files
+	// without version are of version 1 and always encrypted with scrypt
+	keystoreVersion = 2
+
+	// KDFScrypt and KDFArgon2id are the supported key derivation functions
+	KDFScrypt   = "scrypt"
+	KDFArgon2id = "argon2id"
 )
 
+// KDFParams defines the key derivation function deriving encryption keys from
+// passphrases and its cost parameters
+type KDFParams struct {
+	Kdf string `mapstructure:"kdf"`
+	// scrypt cost parameters
+	N int `mapstructure:"n"`
+	R int `mapstructure:"r"`
+	P int `mapstructure:"p"`
+	// argon2id cost parameters, memory is in KiB
+	Time    uint32 `mapstructure:"time"`
+	Memory  uint32 `mapstructure:"memory"`
+	Threads uint8  `mapstructure:"threads"`
+}
+
+// DefaultKDFParams is the kdf used to encrypt keystores unless SetKDFParams is called
+var DefaultKDFParams = KDFParams{Kdf: KDFArgon2id, Time: 3, Memory: 64 * 1024, Threads: 4}
+
+var kdfParams = DefaultKDFParams
+
+// SetKDFParams sets the kdf used to encrypt keystores from now on. Keystores
+// encrypted with other params are migrated when they are unlocked. Unset
+// scrypt params default to those of version 1 keystores
+func SetKDFParams(params KDFParams) error {
+	switch params.Kdf {
+	case KDFScrypt:
+		if params.N == 0 && params.R == 0 && params.P == 0 {
+			params.N, params.R, params.P = scryptN, scryptR, scryptP
+		}
+		if params.N <= 1 || params.N&(params.N-1) != 0 || params.R <= 0 || params.P <= 0 {
+			return fmt.Errorf("Invalid scrypt params: n=%d r=%d p=%d", params.N, params.R, params.P)
+		}
+	case KDFArgon2id:
+		if params.Time == 0 || params.Threads == 0 || params.Memory < 8*uint32(params.Threads) {
+			return fmt.Errorf("Invalid argon2id params: time=%d memory=%d threads=%d",
+				params.Time, params.Memory, params.Threads)
+		}
+	default:
+		return fmt.Errorf("Unsupported kdf: %s", params.Kdf)
+	}
+	kdfParams = params
+	return nil
+}
+
 type keystorePassphrase struct {
 	path         string
 	pubicKeyHash string
@@ -37,6 +89,7 @@ type keystorePassphrase struct {
 }
 
 type keyStoreJSON struct {
+	Version int        `json:"version,omitempty"`
 	ID      string     `json:"id"`
 	Address string     `json:"address"`
 	Crypto  cryptoJSON `json:"crypto"`
@@ -47,7 +100,9 @@ type cryptoJSON struct {
 	Cipher       string           `json:"cipher"`
 	Cipherparams cipherParamsJSON `json:"cipherparams"`
 	Mac          string           `json:"mac"`
-	KdfParams    kdfParamsJSON    `json:"kdfparams"`
+	// Kdf is empty in version 1 keystores, which means scrypt
+	Kdf       string        `json:"kdf,omitempty"`
+	KdfParams kdfParamsJSON `json:"kdfparams"`
 }
 
 type cipherParamsJSON struct {
@@ -55,11 +110,48 @@ type cipherParamsJSON struct {
 }
 
 type kdfParamsJSON struct {
-	Salt  string `json:"salt"`
-	Dklen int    `json:"dklen"`
-	N     int    `json:"n"`
-	R     int    `json:"r"`
-	P     int    `json:"p"`
+	Salt    string `json:"salt"`
+	Dklen   int    `json:"dklen"`
+	N       int    `json:"n,omitempty"`
+	R       int    `json:"r,omitempty"`
+	P       int    `json:"p,omitempty"`
+	Time    uint32 `json:"time,omitempty"`
+	Memory  uint32 `json:"memory,omitempty"`
+	Threads uint8  `json:"threads,omitempty"`
+}
+
+// deriveKey derives the encryption key from passphrase with the kdf of cpt
+func (cpt *cryptoJSON) deriveKey(passphrase string) ([]byte, error) {
+	params := cpt.KdfParams
+	salt, err := hex.DecodeString(params.Salt)
+	if err != nil {
+		return nil, err
+	}
+	switch cpt.Kdf {
+	case "", KDFScrypt:
+		return scrypt.Key([]byte(passphrase), salt, params.N, params.R, params.P, params.Dklen)
+	case KDFArgon2id:
+		if params.Time == 0 || params.Threads == 0 {
+			return nil, fmt.Errorf("Invalid argon2id params")
+		}
+		return argon2.IDKey([]byte(passphrase), salt, params.Time, params.Memory, params.Threads,
+			uint32(params.Dklen)), nil
+	default:
+		return nil, fmt.Errorf("Unsupported kdf: %s", cpt.Kdf)
+	}
+}
+
+// outdated returns whether cpt is encrypted with a kdf other than the current one
+func (cpt *cryptoJSON) outdated() bool {
+	params := cpt.KdfParams
+	switch kdfParams.Kdf {
+	case KDFScrypt:
+		return (cpt.Kdf != "" && cpt.Kdf != KDFScrypt) ||
+			params.N != kdfParams.N || params.R != kdfParams.R || params.P != kdfParams.P
+	default:
+		return cpt.Kdf != kdfParams.Kdf || params.Time != kdfParams.Time ||
+			params.Memory != kdfParams.Memory || params.Threads != kdfParams.Threads
+	}
 }
 
 func savePrivateKeyWithPassphrase(privatekey *bcrypto.PrivateKey, passphrase, path string) error {
@@ -72,6 +164,7 @@ func savePrivateKeyWithPassphrase(privatekey *bcrypto.PrivateKey, passphrase, pa
 		return err
 	}
 	ksJSON := &keyStoreJSON{
+		Version: keystoreVersion,
 		Crypto:  cpt,
 		Address: hex.EncodeToString(addr.Hash()),
 	}
@@ -107,6 +200,12 @@ func tryWriteTempFile(path string, content []byte) (string, error) {
 }
 
 func newCryptoJSON(privateKey *bcrypto.PrivateKey, passphrase string) (cryptoJSON, error) {
+	return encryptWithPassphrase(privateKey.Serialize(), passphrase)
+}
+
+// encryptWithPassphrase encrypts data with a key derived from passphrase by
+// the current kdf
+func encryptWithPassphrase(data []byte, passphrase string) (cryptoJSON, error) {
 	if len(passphrase) == 0 {
 		return cryptoJSON{}, fmt.Errorf("Passphrase should not be empty")
 	}
@@ -114,7 +213,21 @@ func newCryptoJSON(privateKey *bcrypto.PrivateKey, passphrase string) (cryptoJSO
 	if _, err := io.ReadFull(rand.Reader, salt); err != nil {
 		return cryptoJSON{}, err
 	}
-	derivedKey, err := scrypt.Key([]byte(passphrase), salt, scryptN, scryptR, scryptP, scryptDklen)
+	cpt := cryptoJSON{
+		Cipher: "aes-128-ctr",
+		Kdf:    kdfParams.Kdf,
+		KdfParams: kdfParamsJSON{
+			Salt:    hex.EncodeToString(salt),
+			Dklen:   scryptDklen,
+			N:       kdfParams.N,
+			R:       kdfParams.R,
+			P:       kdfParams.P,
+			Time:    kdfParams.Time,
+			Memory:  kdfParams.Memory,
+			Threads: kdfParams.Threads,
+		},
+	}
+	derivedKey, err := cpt.deriveKey(passphrase)
 	if err != nil {
 		return cryptoJSON{}, err
 	}
@@ -124,53 +237,40 @@ func newCryptoJSON(privateKey *bcrypto.PrivateKey, passphrase string) (cryptoJSO
 	if _, err := io.ReadFull(rand.Reader, iv); err != nil {
 		return cryptoJSON{}, err
 	}
-	cipherText, err := aesCtr(aesKey, privateKey.Serialize(), iv)
+	cipherText, err := aesCtr(aesKey, data, iv)
 	if err != nil {
 		return cryptoJSON{}, err
 	}
 	mac := bcrypto.Sha256Multi(derivedKey[16:32], cipherText)
-	kdfParam := kdfParamsJSON{
-		Salt:  hex.EncodeToString(salt),
-		Dklen: scryptDklen,
-		N:     scryptN,
-		R:     scryptR,
-		P:     scryptP,
-	}
-	cipherParam := cipherParamsJSON{
-		Iv: hex.EncodeToString(iv),
-	}
-	cpt := cryptoJSON{
-		Ciphertext:   hex.EncodeToString(cipherText),
-		Cipherparams: cipherParam,
-		Cipher:       "aes-128-ctr",
-		KdfParams:    kdfParam,
-		Mac:          hex.EncodeToString(mac),
-	}
+	cpt.Ciphertext = hex.EncodeToString(cipherText)
+	cpt.Cipherparams = cipherParamsJSON{Iv: hex.EncodeToString(iv)}
+	cpt.Mac = hex.EncodeToString(mac)
 	return cpt, nil
 }
 
-func unlockPrivateKeyWithPassphrase(path, passphrase string) ([]byte, error) {
+// unlockPrivateKeyWithPassphrase decrypts the private key in keystore file, and
+// reports whether the file is outdated and should be encrypted again
+func unlockPrivateKeyWithPassphrase(path, passphrase string) ([]byte, bool, error) {
 	ksJSON, err := readKeystoreJSON(path)
 	if err != nil {
-		return nil, err
+		return nil, false, err
+	}
+	privKey, err := decryptWithPassphrase(&ksJSON.Crypto, passphrase)
+	if err != nil {
+		return nil, false, err
 	}
+	return privKey, ksJSON.Version < keystoreVersion || ksJSON.Crypto.outdated(), nil
+}
+
+// decryptWithPassphrase decrypts data encrypted by encryptWithPassphrase
+func decryptWithPassphrase(cpt *cryptoJSON, passphrase string) ([]byte, error) {
 	if len(passphrase) == 0 {
 		return nil, fmt.Errorf("Passphrase should not be empty")
 	}
-	cpt := ksJSON.Crypto
-	kdfParams := cpt.KdfParams
-	salt, err := hex.DecodeString(kdfParams.Salt)
-	if err != nil {
-		return nil, err
+	if cpt.KdfParams.Dklen < 32 {
+		return nil, fmt.Errorf("Invalid derived key length: %d", cpt.KdfParams.Dklen)
 	}
-	derivedKey, err := scrypt.Key(
-		[]byte(passphrase),
-		salt,
-		kdfParams.N,
-		kdfParams.R,
-		kdfParams.P,
-		kdfParams.Dklen,
-	)
+	derivedKey, err := cpt.deriveKey(passphrase)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,112 @@
+// Copyright (c) 2018 ContentBox Authors.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path"
+	"testing"
+
+	btypes "github.com/BOXFoundation/boxd/core/types"
+	"github.com/BOXFoundation/boxd/crypto"
+	"github.com/facebookgo/ensure"
+)
+
+// cheap kdf params to keep tests fast
+var (
+	testScryptParams = KDFParams{Kdf: KDFScrypt, N: 1 << 10, R: 8, P: 1}
+	testArgon2Params = KDFParams{Kdf: KDFArgon2id, Time: 1, Memory: 64, Threads: 1}
+)
+
+func setTestKDFParams(t *testing.T, params KDFParams) {
+	ensure.Nil(t, SetKDFParams(params))
+}
+
+// writeV1Keystore writes privKey in the format before keystore version 2
+func writeV1Keystore(t *testing.T, privKey *crypto.PrivateKey, passphrase, filePath string) {
+	setTestKDFParams(t, testScryptParams)
+	cpt, err := newCryptoJSON(privKey, passphrase)
+	ensure.Nil(t, err)
+	cpt.Kdf = ""
+	addr, err := btypes.NewAddressFromPubKey(privKey.PubKey())
+	ensure.Nil(t, err)
+	content, err := json.Marshal(&keyStoreJSON{Address: hex.EncodeToString(addr.Hash()), Crypto: cpt})
+	ensure.Nil(t, err)
+	ensure.Nil(t, ioutil.WriteFile(filePath, content, 0600))
+}
+
+func TestEncryptWithPassphrase(t *testing.T) {
+	defer SetKDFParams(DefaultKDFParams)
+	data := []byte("box keystore")
+	for _, params := range []KDFParams{testScryptParams, testArgon2Params} {
+		setTestKDFParams(t, params)
+		cpt, err := encryptWithPassphrase(data, "passphrase")
+		ensure.Nil(t, err)
+		ensure.DeepEqual(t, cpt.Kdf, params.Kdf)
+		ensure.False(t, cpt.outdated())
+
+		plain, err := decryptWithPassphrase(&cpt, "passphrase")
+		ensure.Nil(t, err)
+		ensure.DeepEqual(t, plain, data)
+		_, err = decryptWithPassphrase(&cpt, "wrong")
+		ensure.NotNil(t, err)
+	}
+	ensure.NotNil(t, SetKDFParams(KDFParams{Kdf: "bcrypt"}))
+	ensure.NotNil(t, SetKDFParams(KDFParams{Kdf: KDFScrypt, N: 1000, R: 8, P: 1}))
+}
+
+func TestKeystoreMigration(t *testing.T) {
+	defer SetKDFParams(DefaultKDFParams)
+	dir, err := ioutil.TempDir("", "keystore")
+	ensure.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	privKey, _, err := crypto.NewKeyPair()
+	ensure.Nil(t, err)
+	addr, err := btypes.NewAddressFromPubKey(privKey.PubKey())
+	ensure.Nil(t, err)
+	filePath := path.Join(dir, hex.EncodeToString(addr.Hash())+".keystore")
+	writeV1Keystore(t, privKey, "passphrase", filePath)
+
+	setTestKDFParams(t, testArgon2Params)
+	wltMgr, err := NewWalletManager(dir)
+	ensure.Nil(t, err)
+	ensure.Nil(t, wltMgr.UnlockAccount(addr.String(), "passphrase", 0))
+	acc, ok := wltMgr.UnlockedAccount(addr.String())
+	ensure.True(t, ok)
+	ensure.DeepEqual(t, acc.PrivateKey().Serialize(), privKey.Serialize())
+
+	// unlocking migrates the file to the current format
+	ksJSON, err := readKeystoreJSON(filePath)
+	ensure.Nil(t, err)
+	ensure.DeepEqual(t, ksJSON.Version, keystoreVersion)
+	ensure.DeepEqual(t, ksJSON.Crypto.Kdf, KDFArgon2id)
+	ensure.Nil(t, wltMgr.UnlockAccount(addr.String(), "passphrase", 0))
+}
+
+func TestChangePassphrase(t *testing.T) {
+	defer SetKDFParams(DefaultKDFParams)
+	setTestKDFParams(t, testArgon2Params)
+	dir, err := ioutil.TempDir("", "keystore")
+	ensure.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	privKey, _, err := crypto.NewKeyPair()
+	ensure.Nil(t, err)
+	addr, err := btypes.NewAddressFromPubKey(privKey.PubKey())
+	ensure.Nil(t, err)
+	filePath := path.Join(dir, hex.EncodeToString(addr.Hash())+".keystore")
+	ensure.Nil(t, savePrivateKeyWithPassphrase(privKey, "old", filePath))
+
+	wltMgr, err := NewWalletManager(dir)
+	ensure.Nil(t, err)
+	ensure.NotNil(t, wltMgr.ChangePassphrase(addr.String(), "wrong", "new"))
+	ensure.Nil(t, wltMgr.ChangePassphrase(addr.String(), "old", "new"))
+	ensure.NotNil(t, wltMgr.UnlockAccount(addr.String(), "old", 0))
+	ensure.Nil(t, wltMgr.UnlockAccount(addr.String(), "new", 0))
+}
@@ -0,0 +1,62 @@
+// Copyright (c) 2018 ContentBox Authors.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"encoding/hex"
+	"errors"
+
+	btypes "github.com/BOXFoundation/boxd/core/types"
+	"github.com/BOXFoundation/boxd/crypto"
+	"github.com/BOXFoundation/boxd/script"
+)
+
+// ErrUnknownParticipant is returned when the public key of a multisig
+// participant given by address is not known
+var ErrUnknownParticipant = errors.New("Public key of participant unknown, unlock its account or use the hex public key")
+
+// Multisig is an m-of-n multisig participants pay to and spend from jointly
+type Multisig struct {
+	Keys         *crypto.MultisigKeys
+	RedeemScript []byte
+	Address      *btypes.AddressScriptHash
+}
+
+// NewMultisig combines public keys of participants into an m-of-n multisig.
+// Participants are given as hex encoded public keys, or addresses of
+// accounts lookup returns, whose keys are known only once unlocked
+func NewMultisig(m int, participants []string, lookup func(addr string) (*Account, bool)) (*Multisig, error) {
+	pubKeys := make([]*crypto.PublicKey, 0, len(participants))
+	for _, participant := range participants {
+		pubKey, err := participantKey(participant, lookup)
+		if err != nil {
+			return nil, err
+		}
+		pubKeys = append(pubKeys, pubKey)
+	}
+	keys, err := crypto.NewMultisigKeys(m, pubKeys)
+	if err != nil {
+		return nil, err
+	}
+	redeemScript := *script.MultisigScript(keys)
+	addr, err := btypes.NewAddressFromScript(redeemScript)
+	if err != nil {
+		return nil, err
+	}
+	return &Multisig{Keys: keys, RedeemScript: redeemScript, Address: addr}, nil
+}
+
+// participantKey returns the public key of a participant of a multisig
+func participantKey(participant string, lookup func(addr string) (*Account, bool)) (*crypto.PublicKey, error) {
+	if raw, err := hex.DecodeString(participant); err == nil {
+		return crypto.PublicKeyFromBytes(raw)
+	}
+	if lookup != nil {
+		if acc, ok := lookup(participant); ok {
+			return crypto.PublicKeyFromBytes(acc.PublicKey())
+		}
+	}
+	return nil, ErrUnknownParticipant
+}
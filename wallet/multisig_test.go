@@ -0,0 +1,79 @@
+// Copyright (c) 2018 ContentBox Authors.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"encoding/hex"
+	"testing"
+
+	corepb "github.com/BOXFoundation/boxd/core/pb"
+	btypes "github.com/BOXFoundation/boxd/core/types"
+	"github.com/BOXFoundation/boxd/crypto"
+	"github.com/BOXFoundation/boxd/script"
+	"github.com/facebookgo/ensure"
+)
+
+func TestMultisigPSBT(t *testing.T) {
+	alice, bob, carol := newUnlockedAccount(t), newUnlockedAccount(t), newUnlockedAccount(t)
+	lookup := func(addr string) (*Account, bool) {
+		for _, acc := range []*Account{bob, carol} {
+			if acc.Addr() == addr {
+				return acc, true
+			}
+		}
+		return nil, false
+	}
+	participants := []string{hex.EncodeToString(alice.PublicKey()), bob.Addr(), carol.Addr()}
+	multisig, err := NewMultisig(2, participants, lookup)
+	ensure.Nil(t, err)
+	ensure.DeepEqual(t, multisig.Keys.N(), 3)
+	ensure.DeepEqual(t, multisig.Address.Hash(), crypto.Hash160(multisig.RedeemScript))
+
+	// participants agree on the multisig whatever order keys are given in
+	reversed := []string{hex.EncodeToString(carol.PublicKey()), hex.EncodeToString(bob.PublicKey()),
+		hex.EncodeToString(alice.PublicKey())}
+	same, err := NewMultisig(2, reversed, nil)
+	ensure.Nil(t, err)
+	ensure.DeepEqual(t, same.RedeemScript, multisig.RedeemScript)
+
+	// keys of accounts not unlocked are unknown
+	_, err = NewMultisig(1, []string{alice.Addr()}, lookup)
+	ensure.NotNil(t, err)
+
+	utxos := []*corepb.TxOut{{Value: 100, ScriptPubKey: *script.PayToScriptHashScript(multisig.Address.Hash())}}
+	prevHash := crypto.DoubleHashH([]byte("multisig"))
+	tx := &btypes.Transaction{
+		Vin:  []*btypes.TxIn{{PrevOutPoint: btypes.OutPoint{Hash: prevHash}}},
+		Vout: []*corepb.TxOut{{Value: 90, ScriptPubKey: *script.PayToPubKeyHashScript(alice.PubKeyHash())}},
+	}
+	p, err := NewPSBT(tx, utxos)
+	ensure.Nil(t, err)
+	p.Inputs[0].RedeemScript = multisig.RedeemScript
+	encoded, err := p.Encode()
+	ensure.Nil(t, err)
+
+	// participants sign their copies offline, one signature is not enough
+	signed, err := p.SignInputs(lookupAccount(alice))
+	ensure.Nil(t, err)
+	ensure.DeepEqual(t, signed, 1)
+	complete, err := p.Finalize()
+	ensure.Nil(t, err)
+	ensure.False(t, complete)
+
+	carolPSBT, err := DecodePSBT(encoded)
+	ensure.Nil(t, err)
+	signed, err = carolPSBT.SignInputs(lookupAccount(carol))
+	ensure.Nil(t, err)
+	ensure.DeepEqual(t, signed, 1)
+
+	ensure.Nil(t, p.Merge(carolPSBT))
+	complete, err = p.Finalize()
+	ensure.Nil(t, err)
+	ensure.True(t, complete)
+	signedTx, err := p.Extract()
+	ensure.Nil(t, err)
+	ensure.Nil(t, script.Validate(script.NewScriptFromBytes(signedTx.Vin[0].ScriptSig),
+		script.NewScriptFromBytes(utxos[0].ScriptPubKey), signedTx, 0))
+}
@@ -0,0 +1,986 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: psbt.proto
+
+package walletpb
+
+import proto "github.com/gogo/protobuf/proto"
+import fmt "fmt"
+import math "math"
+import pb "github.com/BOXFoundation/boxd/core/pb"
+
+import io "io"
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
+
+// PartiallySignedTx carries an unsigned transaction along with everything
+// signers need, so that it can be signed offline and by several parties
+type PartiallySignedTx struct {
+	Version uint32 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	// the transaction with empty script sigs
+	Tx *pb.Transaction `protobuf:"bytes,2,opt,name=tx" json:"tx,omitempty"`
+	// in the order of tx inputs
+	Inputs []*PsbtInput `protobuf:"bytes,3,rep,name=inputs" json:"inputs,omitempty"`
+}
+
+func (m *PartiallySignedTx) Reset()         { *m = PartiallySignedTx{} }
+func (m *PartiallySignedTx) String() string { return proto.CompactTextString(m) }
+func (*PartiallySignedTx) ProtoMessage()    {}
+func (*PartiallySignedTx) Descriptor() ([]byte, []int) {
+	return fileDescriptor_psbt_7d6fa6592f806f1a, []int{0}
+}
+func (m *PartiallySignedTx) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PartiallySignedTx) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PartiallySignedTx.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *PartiallySignedTx) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PartiallySignedTx.Merge(dst, src)
+}
+func (m *PartiallySignedTx) XXX_Size() int {
+	return m.Size()
+}
+func (m *PartiallySignedTx) XXX_DiscardUnknown() {
+	xxx_messageInfo_PartiallySignedTx.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PartiallySignedTx proto.InternalMessageInfo
+
+func (m *PartiallySignedTx) GetVersion() uint32 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *PartiallySignedTx) GetTx() *pb.Transaction {
+	if m != nil {
+		return m.Tx
+	}
+	return nil
+}
+
+func (m *PartiallySignedTx) GetInputs() []*PsbtInput {
+	if m != nil {
+		return m.Inputs
+	}
+	return nil
+}
+
+type PsbtInput struct {
+	// the output the input spends
+	Utxo *pb.TxOut `protobuf:"bytes,1,opt,name=utxo" json:"utxo,omitempty"`
+	// redeem script if utxo is pay to script hash
+	RedeemScript []byte        `protobuf:"bytes,2,opt,name=redeem_script,json=redeemScript,proto3" json:"redeem_script,omitempty"`
+	PartialSigs  []*PartialSig `protobuf:"bytes,3,rep,name=partial_sigs,json=partialSigs" json:"partial_sigs,omitempty"`
+	// script sig built from partial sigs once the input is finalized
+	FinalScriptSig []byte `protobuf:"bytes,4,opt,name=final_script_sig,json=finalScriptSig,proto3" json:"final_script_sig,omitempty"`
+}
+
+func (m *PsbtInput) Reset()         { *m = PsbtInput{} }
+func (m *PsbtInput) String() string { return proto.CompactTextString(m) }
+func (*PsbtInput) ProtoMessage()    {}
+func (*PsbtInput) Descriptor() ([]byte, []int) {
+	return fileDescriptor_psbt_7d6fa6592f806f1a, []int{1}
+}
+func (m *PsbtInput) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PsbtInput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PsbtInput.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *PsbtInput) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PsbtInput.Merge(dst, src)
+}
+func (m *PsbtInput) XXX_Size() int {
+	return m.Size()
+}
+func (m *PsbtInput) XXX_DiscardUnknown() {
+	xxx_messageInfo_PsbtInput.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PsbtInput proto.InternalMessageInfo
+
+func (m *PsbtInput) GetUtxo() *pb.TxOut {
+	if m != nil {
+		return m.Utxo
+	}
+	return nil
+}
+
+func (m *PsbtInput) GetRedeemScript() []byte {
+	if m != nil {
+		return m.RedeemScript
+	}
+	return nil
+}
+
+func (m *PsbtInput) GetPartialSigs() []*PartialSig {
+	if m != nil {
+		return m.PartialSigs
+	}
+	return nil
+}
+
+func (m *PsbtInput) GetFinalScriptSig() []byte {
+	if m != nil {
+		return m.FinalScriptSig
+	}
+	return nil
+}
+
+type PartialSig struct {
+	// compressed public key
+	PubKey []byte `protobuf:"bytes,1,opt,name=pub_key,json=pubKey,proto3" json:"pub_key,omitempty"`
+	// DER encoded signature
+	Signature []byte `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+}
+
+func (m *PartialSig) Reset()         { *m = PartialSig{} }
+func (m *PartialSig) String() string { return proto.CompactTextString(m) }
+func (*PartialSig) ProtoMessage()    {}
+func (*PartialSig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_psbt_7d6fa6592f806f1a, []int{2}
+}
+func (m *PartialSig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PartialSig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PartialSig.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *PartialSig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PartialSig.Merge(dst, src)
+}
+func (m *PartialSig) XXX_Size() int {
+	return m.Size()
+}
+func (m *PartialSig) XXX_DiscardUnknown() {
+	xxx_messageInfo_PartialSig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PartialSig proto.InternalMessageInfo
+
+func (m *PartialSig) GetPubKey() []byte {
+	if m != nil {
+		return m.PubKey
+	}
+	return nil
+}
+
+func (m *PartialSig) GetSignature() []byte {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*PartiallySignedTx)(nil), "walletpb.PartiallySignedTx")
+	proto.RegisterType((*PsbtInput)(nil), "walletpb.PsbtInput")
+	proto.RegisterType((*PartialSig)(nil), "walletpb.PartialSig")
+}
+func (m *PartiallySignedTx) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PartiallySignedTx) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Version != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintPsbt(dAtA, i, uint64(m.Version))
+	}
+	if m.Tx != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintPsbt(dAtA, i, uint64(m.Tx.Size()))
+		n1, err := m.Tx.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n1
+	}
+	if len(m.Inputs) > 0 {
+		for _, msg := range m.Inputs {
+			dAtA[i] = 0x1a
+			i++
+			i = encodeVarintPsbt(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *PsbtInput) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PsbtInput) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Utxo != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPsbt(dAtA, i, uint64(m.Utxo.Size()))
+		n2, err := m.Utxo.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n2
+	}
+	if len(m.RedeemScript) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintPsbt(dAtA, i, uint64(len(m.RedeemScript)))
+		i += copy(dAtA[i:], m.RedeemScript)
+	}
+	if len(m.PartialSigs) > 0 {
+		for _, msg := range m.PartialSigs {
+			dAtA[i] = 0x1a
+			i++
+			i = encodeVarintPsbt(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.FinalScriptSig) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintPsbt(dAtA, i, uint64(len(m.FinalScriptSig)))
+		i += copy(dAtA[i:], m.FinalScriptSig)
+	}
+	return i, nil
+}
+
+func (m *PartialSig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PartialSig) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.PubKey) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPsbt(dAtA, i, uint64(len(m.PubKey)))
+		i += copy(dAtA[i:], m.PubKey)
+	}
+	if len(m.Signature) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintPsbt(dAtA, i, uint64(len(m.Signature)))
+		i += copy(dAtA[i:], m.Signature)
+	}
+	return i, nil
+}
+
+func encodeVarintPsbt(dAtA []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return offset + 1
+}
+func (m *PartiallySignedTx) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Version != 0 {
+		n += 1 + sovPsbt(uint64(m.Version))
+	}
+	if m.Tx != nil {
+		l = m.Tx.Size()
+		n += 1 + l + sovPsbt(uint64(l))
+	}
+	if len(m.Inputs) > 0 {
+		for _, e := range m.Inputs {
+			l = e.Size()
+			n += 1 + l + sovPsbt(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PsbtInput) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Utxo != nil {
+		l = m.Utxo.Size()
+		n += 1 + l + sovPsbt(uint64(l))
+	}
+	l = len(m.RedeemScript)
+	if l > 0 {
+		n += 1 + l + sovPsbt(uint64(l))
+	}
+	if len(m.PartialSigs) > 0 {
+		for _, e := range m.PartialSigs {
+			l = e.Size()
+			n += 1 + l + sovPsbt(uint64(l))
+		}
+	}
+	l = len(m.FinalScriptSig)
+	if l > 0 {
+		n += 1 + l + sovPsbt(uint64(l))
+	}
+	return n
+}
+
+func (m *PartialSig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PubKey)
+	if l > 0 {
+		n += 1 + l + sovPsbt(uint64(l))
+	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovPsbt(uint64(l))
+	}
+	return n
+}
+
+func sovPsbt(x uint64) (n int) {
+	for {
+		n++
+		x >>= 7
+		if x == 0 {
+			break
+		}
+	}
+	return n
+}
+func sozPsbt(x uint64) (n int) {
+	return sovPsbt(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *PartiallySignedTx) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPsbt
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PartiallySignedTx: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PartiallySignedTx: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPsbt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tx", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPsbt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPsbt
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Tx == nil {
+				m.Tx = &pb.Transaction{}
+			}
+			if err := m.Tx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Inputs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPsbt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPsbt
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Inputs = append(m.Inputs, &PsbtInput{})
+			if err := m.Inputs[len(m.Inputs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPsbt(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPsbt
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PsbtInput) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPsbt
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PsbtInput: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PsbtInput: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Utxo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPsbt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPsbt
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Utxo == nil {
+				m.Utxo = &pb.TxOut{}
+			}
+			if err := m.Utxo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedeemScript", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPsbt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPsbt
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RedeemScript = append(m.RedeemScript[:0], dAtA[iNdEx:postIndex]...)
+			if m.RedeemScript == nil {
+				m.RedeemScript = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PartialSigs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPsbt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPsbt
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PartialSigs = append(m.PartialSigs, &PartialSig{})
+			if err := m.PartialSigs[len(m.PartialSigs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalScriptSig", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPsbt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPsbt
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FinalScriptSig = append(m.FinalScriptSig[:0], dAtA[iNdEx:postIndex]...)
+			if m.FinalScriptSig == nil {
+				m.FinalScriptSig = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPsbt(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPsbt
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PartialSig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPsbt
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PartialSig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PartialSig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPsbt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPsbt
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PubKey = append(m.PubKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PubKey == nil {
+				m.PubKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPsbt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPsbt
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signature = append(m.Signature[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signature == nil {
+				m.Signature = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPsbt(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPsbt
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipPsbt(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowPsbt
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPsbt
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+			return iNdEx, nil
+		case 1:
+			iNdEx += 8
+			return iNdEx, nil
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPsbt
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			iNdEx += length
+			if length < 0 {
+				return 0, ErrInvalidLengthPsbt
+			}
+			return iNdEx, nil
+		case 3:
+			for {
+				var innerWire uint64
+				var start int = iNdEx
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return 0, ErrIntOverflowPsbt
+					}
+					if iNdEx >= l {
+						return 0, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					innerWire |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				innerWireType := int(innerWire & 0x7)
+				if innerWireType == 4 {
+					break
+				}
+				next, err := skipPsbt(dAtA[start:])
+				if err != nil {
+					return 0, err
+				}
+				iNdEx = start + next
+			}
+			return iNdEx, nil
+		case 4:
+			return iNdEx, nil
+		case 5:
+			iNdEx += 4
+			return iNdEx, nil
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+	}
+	panic("unreachable")
+}
+
+var (
+	ErrInvalidLengthPsbt = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowPsbt   = fmt.Errorf("proto: integer overflow")
+)
+
+func init() { proto.RegisterFile("psbt.proto", fileDescriptor_psbt_7d6fa6592f806f1a) }
+
+var fileDescriptor_psbt_7d6fa6592f806f1a = []byte{
+	// 362 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x4c, 0x91, 0xcd, 0x4e, 0xf2, 0x40,
+	0x18, 0x85, 0x29, 0x10, 0xf8, 0x18, 0xca, 0x17, 0x1d, 0x4d, 0x6c, 0x8c, 0x69, 0x10, 0x36, 0x24,
+	0x26, 0x6d, 0xc4, 0x85, 0x7b, 0x4c, 0x4c, 0x8c, 0x0b, 0x48, 0xcb, 0xc2, 0x1d, 0xe9, 0xb4, 0x63,
+	0x9d, 0x50, 0x66, 0x26, 0xf3, 0xa3, 0x65, 0xe5, 0x2d, 0x78, 0x35, 0x5e, 0x83, 0x4b, 0x96, 0x2e,
+	0x0d, 0xdc, 0x88, 0xe9, 0x94, 0x1f, 0x77, 0x33, 0xe7, 0x7d, 0xcf, 0x39, 0xcf, 0x64, 0x00, 0xe0,
+	0x12, 0x29, 0x8f, 0x0b, 0xa6, 0x18, 0xfc, 0xf7, 0x16, 0x65, 0x19, 0x56, 0x1c, 0x9d, 0x5f, 0xa7,
+	0x44, 0xbd, 0x68, 0xe4, 0xc5, 0x6c, 0xe1, 0x8f, 0xc6, 0x4f, 0xf7, 0x4c, 0xd3, 0x24, 0x52, 0x84,
+	0x51, 0x1f, 0xb1, 0x3c, 0xf1, 0x63, 0x26, 0xb0, 0xcf, 0x91, 0x8f, 0x32, 0x16, 0xcf, 0x4b, 0x73,
+	0xef, 0x1d, 0x1c, 0x4f, 0x22, 0xa1, 0x48, 0x94, 0x65, 0xcb, 0x90, 0xa4, 0x14, 0x27, 0xd3, 0x1c,
+	0x3a, 0xa0, 0xf9, 0x8a, 0x85, 0x24, 0x8c, 0x3a, 0x56, 0xd7, 0x1a, 0x74, 0x82, 0xdd, 0x15, 0xf6,
+	0x41, 0x55, 0xe5, 0x4e, 0xb5, 0x6b, 0x0d, 0xda, 0xc3, 0x13, 0xaf, 0x08, 0xe4, 0xc8, 0x9b, 0x8a,
+	0x88, 0xca, 0x28, 0x2e, 0x8a, 0x82, 0xaa, 0xca, 0xe1, 0x15, 0x68, 0x10, 0xca, 0xb5, 0x92, 0x4e,
+	0xad, 0x5b, 0x33, 0x8b, 0x3b, 0x42, 0x6f, 0x22, 0x91, 0x7a, 0x28, 0x66, 0xc1, 0x76, 0xa5, 0xf7,
+	0x69, 0x81, 0xd6, 0x5e, 0x85, 0x97, 0xa0, 0xae, 0x55, 0xce, 0x4c, 0x6d, 0x7b, 0xd8, 0xd9, 0x37,
+	0xe4, 0x63, 0xad, 0x02, 0x33, 0x82, 0x7d, 0xd0, 0x11, 0x38, 0xc1, 0x78, 0x31, 0x93, 0xb1, 0x20,
+	0x5c, 0x19, 0x1a, 0x3b, 0xb0, 0x4b, 0x31, 0x34, 0x1a, 0xbc, 0x05, 0x36, 0x2f, 0x9f, 0x35, 0x93,
+	0x24, 0xdd, 0x81, 0x9c, 0xfe, 0x01, 0x29, 0xa7, 0x21, 0x49, 0x83, 0x36, 0xdf, 0x9f, 0x25, 0x1c,
+	0x80, 0xa3, 0x67, 0x42, 0x0b, 0x9b, 0x09, 0x2a, 0xdc, 0x4e, 0xdd, 0x14, 0xfc, 0x37, 0x7a, 0x99,
+	0x1f, 0x92, 0xb4, 0x77, 0x07, 0xc0, 0x21, 0x04, 0x9e, 0x81, 0x26, 0xd7, 0x68, 0x36, 0xc7, 0x4b,
+	0xc3, 0x6e, 0x07, 0x0d, 0xae, 0xd1, 0x23, 0x5e, 0xc2, 0x0b, 0xd0, 0x92, 0x24, 0xa5, 0x91, 0xd2,
+	0x02, 0x6f, 0x51, 0x0f, 0xc2, 0xc8, 0xf9, 0x5a, 0xbb, 0xd6, 0x6a, 0xed, 0x5a, 0x3f, 0x6b, 0xd7,
+	0xfa, 0xd8, 0xb8, 0x95, 0xd5, 0xc6, 0xad, 0x7c, 0x6f, 0xdc, 0x0a, 0x6a, 0x98, 0xff, 0xb9, 0xf9,
+	0x1d, 0x00, 0x24, 0xf9, 0xa8, 0xc9, 0xea, 0x01, 0x00, 0x00,
+}
@@ -0,0 +1,460 @@
+// Copyright (c) 2018 ContentBox Authors.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"bytes"
+	"encoding/base64"
+	"errors"
+	"fmt"
+
+	corepb "github.com/BOXFoundation/boxd/core/pb"
+	btypes "github.com/BOXFoundation/boxd/core/types"
+	"github.com/BOXFoundation/boxd/crypto"
+	"github.com/BOXFoundation/boxd/script"
+	"github.com/BOXFoundation/boxd/wallet/pb"
+	proto "github.com/gogo/protobuf/proto"
+)
+
+// PSBTVersion is the version of the partially signed transaction format
+const PSBTVersion = 1
+
+var (
+	errPSBTVersion       = errors.New("Unsupported PSBT version")
+	errPSBTInputMismatch = errors.New("PSBT inputs don't match tx inputs")
+	errPSBTTxMismatch    = errors.New("PSBTs spend different transactions")
+	errPSBTIncomplete    = errors.New("PSBT is not finalized")
+)
+
+// PSBT is a partially signed transaction. It carries the unsigned tx along
+// with outputs it spends and signatures collected so far, so that inputs can
+// be signed on air-gapped machines and by several parties, and finalized once
+// enough signatures are present
+type PSBT struct {
+	Tx     *btypes.Transaction
+	Inputs []*PSBTInput
+}
+
+// PSBTInput is what signers need to sign an input, and its signatures
+type PSBTInput struct {
+	Utxo *corepb.TxOut
+	// RedeemScript is required if Utxo is pay to script hash, only
+	// <pubkey> OP_CHECKSIG and multisig redeem scripts are signable
+	RedeemScript   []byte
+	PartialSigs    []*PartialSig
+	FinalScriptSig []byte
+}
+
+// PartialSig is a signature of an input along with its public key
+type PartialSig struct {
+	PubKey    []byte
+	Signature []byte
+}
+
+// parse parses the signature as one of a participant of a multisig
+func (s *PartialSig) parse() (*crypto.PartialSignature, error) {
+	pubKey, err := crypto.PublicKeyFromBytes(s.PubKey)
+	if err != nil {
+		return nil, err
+	}
+	sig, err := crypto.SigFromBytes(s.Signature)
+	if err != nil {
+		return nil, err
+	}
+	return &crypto.PartialSignature{PubKey: pubKey, Sig: sig}, nil
+}
+
+// NewPSBT creates a PSBT of tx spending utxos in the order of its inputs.
+// Inputs already signed are taken as finalized
+func NewPSBT(tx *btypes.Transaction, utxos []*corepb.TxOut) (*PSBT, error) {
+	if len(tx.Vin) != len(utxos) {
+		return nil, errPSBTInputMismatch
+	}
+	unsignedTx, err := withScriptSigs(tx, make([][]byte, len(tx.Vin)))
+	if err != nil {
+		return nil, err
+	}
+	p := &PSBT{Tx: unsignedTx}
+	for i, txIn := range tx.Vin {
+		p.Inputs = append(p.Inputs, &PSBTInput{Utxo: utxos[i], FinalScriptSig: txIn.ScriptSig})
+	}
+	return p, nil
+}
+
+// withScriptSigs returns a copy of tx with script sigs of inputs replaced
+func withScriptSigs(tx *btypes.Transaction, scriptSigs [][]byte) (*btypes.Transaction, error) {
+	msg, err := tx.ToProtoMessage()
+	if err != nil {
+		return nil, err
+	}
+	txMsg := msg.(*corepb.Transaction)
+	for i, txIn := range txMsg.Vin {
+		txIn.ScriptSig = scriptSigs[i]
+	}
+	cp := &btypes.Transaction{}
+	return cp, cp.FromProtoMessage(txMsg)
+}
+
+// ToProtoMessage converts PSBT to proto message
+func (p *PSBT) ToProtoMessage() (proto.Message, error) {
+	tx, err := p.Tx.ToProtoMessage()
+	if err != nil {
+		return nil, err
+	}
+	msg := &walletpb.PartiallySignedTx{Version: PSBTVersion, Tx: tx.(*corepb.Transaction)}
+	for _, in := range p.Inputs {
+		input := &walletpb.PsbtInput{
+			Utxo:           in.Utxo,
+			RedeemScript:   in.RedeemScript,
+			FinalScriptSig: in.FinalScriptSig,
+		}
+		for _, sig := range in.PartialSigs {
+			input.PartialSigs = append(input.PartialSigs, &walletpb.PartialSig{PubKey: sig.PubKey, Signature: sig.Signature})
+		}
+		msg.Inputs = append(msg.Inputs, input)
+	}
+	return msg, nil
+}
+
+// FromProtoMessage converts proto message to PSBT
+func (p *PSBT) FromProtoMessage(message proto.Message) error {
+	msg, ok := message.(*walletpb.PartiallySignedTx)
+	if !ok || msg == nil || msg.Tx == nil {
+		return fmt.Errorf("Invalid PSBT proto message")
+	}
+	if msg.Version != PSBTVersion {
+		return errPSBTVersion
+	}
+	tx := &btypes.Transaction{}
+	if err := tx.FromProtoMessage(msg.Tx); err != nil {
+		return err
+	}
+	if len(tx.Vin) != len(msg.Inputs) {
+		return errPSBTInputMismatch
+	}
+	inputs := make([]*PSBTInput, 0, len(msg.Inputs))
+	for _, input := range msg.Inputs {
+		if input.Utxo == nil {
+			return fmt.Errorf("PSBT input misses the output it spends")
+		}
+		in := &PSBTInput{
+			Utxo:           input.Utxo,
+			RedeemScript:   input.RedeemScript,
+			FinalScriptSig: input.FinalScriptSig,
+		}
+		for _, sig := range input.PartialSigs {
+			in.PartialSigs = append(in.PartialSigs, &PartialSig{PubKey: sig.PubKey, Signature: sig.Signature})
+		}
+		inputs = append(inputs, in)
+	}
+	p.Tx, p.Inputs = tx, inputs
+	return nil
+}
+
+// Marshal marshals PSBT to binary
+func (p *PSBT) Marshal() ([]byte, error) {
+	msg, err := p.ToProtoMessage()
+	if err != nil {
+		return nil, err
+	}
+	return proto.Marshal(msg)
+}
+
+// Unmarshal unmarshals PSBT from binary
+func (p *PSBT) Unmarshal(data []byte) error {
+	msg := &walletpb.PartiallySignedTx{}
+	if err := proto.Unmarshal(data, msg); err != nil {
+		return err
+	}
+	return p.FromProtoMessage(msg)
+}
+
+// Encode encodes PSBT in base64 for transport
+func (p *PSBT) Encode() (string, error) {
+	data, err := p.Marshal()
+	if err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(data), nil
+}
+
+// DecodePSBT decodes a base64 encoded PSBT
+func DecodePSBT(s string) (*PSBT, error) {
+	data, err := base64.StdEncoding.DecodeString(s)
+	if err != nil {
+		return nil, err
+	}
+	p := &PSBT{}
+	return p, p.Unmarshal(data)
+}
+
+// p2pkPubKey returns the public key of a <pubkey> OP_CHECKSIG script, nil if
+// the script is not of the form
+func p2pkPubKey(s []byte) []byte {
+	if len(s) < 2 {
+		return nil
+	}
+	pubKey := s[1 : len(s)-1]
+	if !bytes.Equal(s, *script.NewScript().AddOperand(pubKey).AddOpCode(script.OPCHECKSIG)) {
+		return nil
+	}
+	return pubKey
+}
+
+// signingInfo returns the script the sighash of input i commits to, and
+// addresses whose keys sign it. Keys of participants are returned too if
+// input i is spent by a multisig redeem script
+func (p *PSBT) signingInfo(i int) ([]byte, []btypes.Address, *crypto.MultisigKeys, error) {
+	in := p.Inputs[i]
+	utxoScript := script.NewScriptFromBytes(in.Utxo.ScriptPubKey)
+	if !utxoScript.IsPayToScriptHash() {
+		addr, err := utxoScript.ExtractAddress()
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		return in.Utxo.ScriptPubKey, []btypes.Address{addr}, nil, nil
+	}
+	if len(in.RedeemScript) == 0 {
+		return nil, nil, nil, fmt.Errorf("Redeem script of input %d required", i)
+	}
+	expect := script.PayToScriptHashScript(crypto.Hash160(in.RedeemScript))
+	if !bytes.Equal(in.Utxo.ScriptPubKey, *expect) {
+		return nil, nil, nil, fmt.Errorf("Redeem script of input %d doesn't match its output", i)
+	}
+	if keys, err := script.NewScriptFromBytes(in.RedeemScript).ExtractMultisigKeys(); err == nil {
+		addrs := make([]btypes.Address, 0, keys.N())
+		for _, pubKey := range keys.PubKeys {
+			addr, err := btypes.NewAddressFromPubKey(pubKey)
+			if err != nil {
+				return nil, nil, nil, err
+			}
+			addrs = append(addrs, addr)
+		}
+		return in.RedeemScript, addrs, keys, nil
+	}
+	pubKey := p2pkPubKey(in.RedeemScript)
+	if pubKey == nil {
+		return nil, nil, nil, fmt.Errorf("Unsupported redeem script of input %d", i)
+	}
+	pk, err := crypto.PublicKeyFromBytes(pubKey)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	addr, err := btypes.NewAddressFromPubKey(pk)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	return in.RedeemScript, []btypes.Address{addr}, nil, nil
+}
+
+// SigHash returns the hash input i is signed over
+func (p *PSBT) SigHash(i int) (*crypto.HashType, error) {
+	if i < 0 || i >= len(p.Inputs) {
+		return nil, script.ErrInputIndexOutOfBound
+	}
+	s, _, _, err := p.signingInfo(i)
+	if err != nil {
+		return nil, err
+	}
+	return script.CalcTxHashForSig(s, p.Tx, i)
+}
+
+// AddSignature verifies and adds a signature of input i, replacing the
+// previous one of the same public key if any
+func (p *PSBT) AddSignature(i int, pubKey []byte, sig *crypto.Signature) error {
+	hash, err := p.SigHash(i)
+	if err != nil {
+		return err
+	}
+	pk, err := crypto.PublicKeyFromBytes(pubKey)
+	if err != nil {
+		return err
+	}
+	if !sig.VerifySignature(pk, hash) {
+		return script.ErrScriptSignatureVerifyFail
+	}
+	p.Inputs[i].addPartialSig(&PartialSig{PubKey: pubKey, Signature: sig.Serialize()})
+	return nil
+}
+
+func (in *PSBTInput) addPartialSig(sig *PartialSig) {
+	for j, s := range in.PartialSigs {
+		if bytes.Equal(s.PubKey, sig.PubKey) {
+			in.PartialSigs[j] = sig
+			return
+		}
+	}
+	in.PartialSigs = append(in.PartialSigs, sig)
+}
+
+// SignInputs signs inputs not finalized yet with accounts lookup returns
+// for their addresses, and returns the number of inputs signed. Inputs of
+// multisigs are signed by all participants lookup returns
+func (p *PSBT) SignInputs(lookup func(addr btypes.Address) (*Account, bool)) (int, error) {
+	signed := 0
+	for i, in := range p.Inputs {
+		if len(in.FinalScriptSig) > 0 {
+			continue
+		}
+		_, addrs, _, err := p.signingInfo(i)
+		if err != nil {
+			// not signable by wallet, left to other signers
+			continue
+		}
+		signedInput := false
+		for _, addr := range addrs {
+			acc, ok := lookup(addr)
+			if !ok {
+				continue
+			}
+			hash, err := p.SigHash(i)
+			if err != nil {
+				return signed, err
+			}
+			sig, err := acc.Sign(hash)
+			if err != nil {
+				return signed, err
+			}
+			if err := p.AddSignature(i, acc.PublicKey(), sig); err != nil {
+				return signed, err
+			}
+			signedInput = true
+		}
+		if signedInput {
+			signed++
+		}
+	}
+	return signed, nil
+}
+
+// Merge combines signatures and redeem scripts of PSBTs of the same tx, as
+// signed by different parties, into p
+func (p *PSBT) Merge(others ...*PSBT) error {
+	hash, err := p.Tx.CalcTxHash()
+	if err != nil {
+		return err
+	}
+	for _, other := range others {
+		otherHash, err := other.Tx.CalcTxHash()
+		if err != nil {
+			return err
+		}
+		if *hash != *otherHash || len(p.Inputs) != len(other.Inputs) {
+			return errPSBTTxMismatch
+		}
+		for i, in := range other.Inputs {
+			if !proto.Equal(p.Inputs[i].Utxo, in.Utxo) {
+				return fmt.Errorf("PSBTs spend different outputs at input %d", i)
+			}
+			if len(p.Inputs[i].RedeemScript) == 0 {
+				p.Inputs[i].RedeemScript = in.RedeemScript
+			}
+			if len(p.Inputs[i].FinalScriptSig) == 0 {
+				p.Inputs[i].FinalScriptSig = in.FinalScriptSig
+			}
+			for _, sig := range in.PartialSigs {
+				p.Inputs[i].addPartialSig(sig)
+			}
+		}
+	}
+	return nil
+}
+
+// Finalize builds script sigs of inputs from their signatures, and returns
+// whether all inputs are finalized
+func (p *PSBT) Finalize() (bool, error) {
+	complete := true
+	for i, in := range p.Inputs {
+		if len(in.FinalScriptSig) > 0 {
+			continue
+		}
+		scriptSig, err := p.buildScriptSig(i)
+		if err != nil {
+			return false, err
+		}
+		if scriptSig == nil {
+			complete = false
+			continue
+		}
+		in.FinalScriptSig, in.PartialSigs = *scriptSig, nil
+	}
+	return complete, nil
+}
+
+// buildScriptSig returns the script sig of input i, nil if the signature
+// required is missing
+func (p *PSBT) buildScriptSig(i int) (*script.Script, error) {
+	in := p.Inputs[i]
+	s, addrs, keys, err := p.signingInfo(i)
+	if err != nil {
+		return nil, err
+	}
+	if keys != nil {
+		return p.buildMultisigScriptSig(i, s, keys)
+	}
+	addr := addrs[0]
+	for _, partial := range in.PartialSigs {
+		if !bytes.Equal(crypto.Hash160(partial.PubKey), addr.Hash()) {
+			continue
+		}
+		sig, err := crypto.SigFromBytes(partial.Signature)
+		if err != nil {
+			return nil, err
+		}
+		var scriptSig *script.Script
+		if len(in.RedeemScript) > 0 {
+			scriptSig = script.NewScript().AddOperand(partial.Signature).AddOperand(in.RedeemScript)
+		} else {
+			scriptSig = script.SignatureScript(sig, partial.PubKey)
+		}
+		if err := script.Validate(scriptSig, script.NewScriptFromBytes(in.Utxo.ScriptPubKey), p.Tx, i); err != nil {
+			return nil, err
+		}
+		return scriptSig, nil
+	}
+	return nil, nil
+}
+
+// buildMultisigScriptSig returns the script sig of multisig input i, nil if
+// not enough participants have signed
+func (p *PSBT) buildMultisigScriptSig(i int, redeemScript []byte, keys *crypto.MultisigKeys) (*script.Script, error) {
+	in := p.Inputs[i]
+	hash, err := script.CalcTxHashForSig(redeemScript, p.Tx, i)
+	if err != nil {
+		return nil, err
+	}
+	partials := make([]*crypto.PartialSignature, 0, len(in.PartialSigs))
+	for _, partial := range in.PartialSigs {
+		parsed, err := partial.parse()
+		if err != nil {
+			return nil, err
+		}
+		partials = append(partials, parsed)
+	}
+	sigs, err := keys.Combine(partials, hash)
+	if err == crypto.ErrNotEnoughPartialSignatures {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	scriptSig := script.MultisigSignatureScript(sigs, redeemScript)
+	if err := script.Validate(scriptSig, script.NewScriptFromBytes(in.Utxo.ScriptPubKey), p.Tx, i); err != nil {
+		return nil, err
+	}
+	return scriptSig, nil
+}
+
+// Extract returns the signed transaction of a finalized PSBT
+func (p *PSBT) Extract() (*btypes.Transaction, error) {
+	scriptSigs := make([][]byte, 0, len(p.Inputs))
+	for _, in := range p.Inputs {
+		if len(in.FinalScriptSig) == 0 {
+			return nil, errPSBTIncomplete
+		}
+		scriptSigs = append(scriptSigs, in.FinalScriptSig)
+	}
+	return withScriptSigs(p.Tx, scriptSigs)
+}
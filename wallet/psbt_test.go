@@ -0,0 +1,95 @@
+// Copyright (c) 2018 ContentBox Authors.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"testing"
+
+	corepb "github.com/BOXFoundation/boxd/core/pb"
+	btypes "github.com/BOXFoundation/boxd/core/types"
+	"github.com/BOXFoundation/boxd/crypto"
+	"github.com/BOXFoundation/boxd/script"
+	"github.com/facebookgo/ensure"
+)
+
+func newUnlockedAccount(t *testing.T) *Account {
+	privKey, pubKey, err := crypto.NewKeyPair()
+	ensure.Nil(t, err)
+	addr, err := btypes.NewAddressFromPubKey(pubKey)
+	ensure.Nil(t, err)
+	return &Account{addr: addr, privKey: privKey, unlocked: true}
+}
+
+func lookupAccount(acc *Account) func(btypes.Address) (*Account, bool) {
+	return func(addr btypes.Address) (*Account, bool) {
+		return acc, addr.String() == acc.Addr()
+	}
+}
+
+func TestPSBT(t *testing.T) {
+	alice, bob := newUnlockedAccount(t), newUnlockedAccount(t)
+	redeemScript := *script.NewScript().AddOperand(alice.PublicKey()).AddOpCode(script.OPCHECKSIG)
+	p2shScript := *script.NewScript().AddOpCode(script.OPHASH160).
+		AddOperand(crypto.Hash160(redeemScript)).AddOpCode(script.OPEQUAL)
+	utxos := []*corepb.TxOut{
+		{Value: 100, ScriptPubKey: *script.PayToPubKeyHashScript(alice.PubKeyHash())},
+		{Value: 200, ScriptPubKey: *script.PayToPubKeyHashScript(bob.PubKeyHash())},
+		{Value: 300, ScriptPubKey: p2shScript},
+	}
+	tx := &btypes.Transaction{Vout: []*corepb.TxOut{utxos[1]}}
+	for i := range utxos {
+		prevHash := crypto.DoubleHashH([]byte{byte(i)})
+		tx.Vin = append(tx.Vin, &btypes.TxIn{PrevOutPoint: btypes.OutPoint{Hash: prevHash}})
+	}
+
+	p, err := NewPSBT(tx, utxos)
+	ensure.Nil(t, err)
+	p.Inputs[2].RedeemScript = redeemScript
+	encoded, err := p.Encode()
+	ensure.Nil(t, err)
+
+	// alice and bob sign their copies offline
+	alicePSBT, err := DecodePSBT(encoded)
+	ensure.Nil(t, err)
+	signed, err := alicePSBT.SignInputs(lookupAccount(alice))
+	ensure.Nil(t, err)
+	ensure.DeepEqual(t, signed, 2)
+	bobPSBT, err := DecodePSBT(encoded)
+	ensure.Nil(t, err)
+	signed, err = bobPSBT.SignInputs(lookupAccount(bob))
+	ensure.Nil(t, err)
+	ensure.DeepEqual(t, signed, 1)
+
+	complete, err := alicePSBT.Finalize()
+	ensure.Nil(t, err)
+	ensure.False(t, complete)
+	_, err = alicePSBT.Extract()
+	ensure.DeepEqual(t, err, errPSBTIncomplete)
+
+	// signatures over other hashes are rejected
+	hash := crypto.DoubleHashH([]byte("other"))
+	sig, err := bob.Sign(&hash)
+	ensure.Nil(t, err)
+	ensure.NotNil(t, bobPSBT.AddSignature(1, bob.PublicKey(), sig))
+
+	ensure.Nil(t, alicePSBT.Merge(bobPSBT))
+	complete, err = alicePSBT.Finalize()
+	ensure.Nil(t, err)
+	ensure.True(t, complete)
+	signedTx, err := alicePSBT.Extract()
+	ensure.Nil(t, err)
+	for i, txIn := range signedTx.Vin {
+		ensure.Nil(t, script.Validate(script.NewScriptFromBytes(txIn.ScriptSig),
+			script.NewScriptFromBytes(utxos[i].ScriptPubKey), signedTx, i))
+	}
+	unsignedHash, _ := tx.TxHash()
+	signedHash, _ := signedTx.TxHash()
+	ensure.NotDeepEqual(t, *signedHash, *unsignedHash)
+
+	// PSBTs of other txs can't be merged
+	other, err := NewPSBT(&btypes.Transaction{Vin: tx.Vin[:1]}, utxos[:1])
+	ensure.Nil(t, err)
+	ensure.DeepEqual(t, alicePSBT.Merge(other), errPSBTTxMismatch)
+}
@@ -0,0 +1,243 @@
+// Copyright (c) 2018 ContentBox Authors.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"time"
+
+	btypes "github.com/BOXFoundation/boxd/core/types"
+	"github.com/BOXFoundation/boxd/crypto"
+	"github.com/BOXFoundation/boxd/wallet/pb"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+)
+
+// ErrExternalAccount is returned when the key of an account held by an
+// external signer is asked for
+var ErrExternalAccount = errors.New("Key of external account is held by its signer")
+
+// ErrInsecureSigner is returned when a signing daemon not on a loopback
+// address is to be connected to without tls
+var ErrInsecureSigner = errors.New("Signing daemon not on loopback address requires tls_cert")
+
+// Signer signs sighashes of transaction inputs with keys it holds, e.g. a
+// hardware wallet or a remote signing daemon, so that keys need not live
+// in keystore files
+type Signer interface {
+	// Addresses returns addresses whose keys are held by the signer
+	Addresses() ([]btypes.Address, error)
+	// PublicKey returns the compressed public key of addr
+	PublicKey(addr btypes.Address) ([]byte, error)
+	// SignHash signs hash with the key of addr
+	SignHash(addr btypes.Address, hash *crypto.HashType) (*crypto.Signature, error)
+}
+
+// RemoteSignerConfig defines the signing daemon the node wallet signs with
+type RemoteSignerConfig struct {
+	// Address is host:port of the daemon, remote signing is disabled if empty
+	Address string `mapstructure:"address"`
+	// Timeout is the seconds to wait for each request, 0 means 10
+	Timeout uint32 `mapstructure:"timeout"`
+	// TLSCert is the certificate file of the daemon or its CA, securing the
+	// connection. Daemons without it must listen on loopback addresses
+	TLSCert string `mapstructure:"tls_cert"`
+	// TLSServerName overrides the host name the certificate is checked for
+	TLSServerName string `mapstructure:"tls_server_name"`
+}
+
+// RemoteSigner is a Signer forwarding requests to a signing daemon over gRPC
+type RemoteSigner struct {
+	conn    *grpc.ClientConn
+	client  walletpb.RemoteSignerClient
+	timeout time.Duration
+}
+
+var _ Signer = (*RemoteSigner)(nil)
+
+// NewRemoteSigner connects to the signing daemon in cfg, over tls if its
+// certificate is set. Requests are sent in plaintext otherwise, which is only
+// allowed to daemons on loopback addresses
+func NewRemoteSigner(cfg *RemoteSignerConfig) (*RemoteSigner, error) {
+	opt := grpc.WithInsecure()
+	if cfg.TLSCert != "" {
+		creds, err := credentials.NewClientTLSFromFile(cfg.TLSCert, cfg.TLSServerName)
+		if err != nil {
+			return nil, err
+		}
+		opt = grpc.WithTransportCredentials(creds)
+	} else if !isLoopback(cfg.Address) {
+		return nil, ErrInsecureSigner
+	}
+	conn, err := grpc.Dial(cfg.Address, opt)
+	if err != nil {
+		return nil, err
+	}
+	timeout := 10 * time.Second
+	if cfg.Timeout > 0 {
+		timeout = time.Duration(cfg.Timeout) * time.Second
+	}
+	return &RemoteSigner{
+		conn:    conn,
+		client:  walletpb.NewRemoteSignerClient(conn),
+		timeout: timeout,
+	}, nil
+}
+
+// isLoopback tells if host:port address is on the local machine
+func isLoopback(address string) bool {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return false
+	}
+	if host == "localhost" {
+		return true
+	}
+	ip := net.ParseIP(host)
+	return ip != nil && ip.IsLoopback()
+}
+
+// Close closes the connection to the signing daemon
+func (rs *RemoteSigner) Close() error {
+	return rs.conn.Close()
+}
+
+// Addresses returns addresses whose keys are held by the signing daemon
+func (rs *RemoteSigner) Addresses() ([]btypes.Address, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), rs.timeout)
+	defer cancel()
+	r, err := rs.client.ListAddresses(ctx, &walletpb.ListAddressesRequest{})
+	if err != nil {
+		return nil, err
+	}
+	if r.Code != 0 {
+		return nil, errors.New(r.Message)
+	}
+	addrs := make([]btypes.Address, 0, len(r.Addrs))
+	for _, addrStr := range r.Addrs {
+		addr, err := btypes.NewAddress(addrStr)
+		if err != nil {
+			return nil, err
+		}
+		addrs = append(addrs, addr)
+	}
+	return addrs, nil
+}
+
+// PublicKey returns the public key of addr, which is checked against addr
+func (rs *RemoteSigner) PublicKey(addr btypes.Address) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), rs.timeout)
+	defer cancel()
+	r, err := rs.client.GetPublicKey(ctx, &walletpb.GetPublicKeyRequest{Addr: addr.String()})
+	if err != nil {
+		return nil, err
+	}
+	if r.Code != 0 {
+		return nil, errors.New(r.Message)
+	}
+	pubKey, err := crypto.PublicKeyFromBytes(r.PublicKey)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkPubKey(pubKey, addr); err != nil {
+		return nil, err
+	}
+	return pubKey.Serialize(), nil
+}
+
+// SignHash asks the signing daemon to sign hash with the key of addr
+func (rs *RemoteSigner) SignHash(addr btypes.Address, hash *crypto.HashType) (*crypto.Signature, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), rs.timeout)
+	defer cancel()
+	r, err := rs.client.SignHash(ctx, &walletpb.SignHashRequest{Addr: addr.String(), Hash: hash[:]})
+	if err != nil {
+		return nil, err
+	}
+	if r.Code != 0 {
+		return nil, errors.New(r.Message)
+	}
+	return crypto.SigFromBytes(r.Signature)
+}
+
+// SignCompact asks the signing daemon to sign hash with the key of addr into a
+// compact signature, which is checked to recover to addr
+func (rs *RemoteSigner) SignCompact(addr btypes.Address, hash *crypto.HashType) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), rs.timeout)
+	defer cancel()
+	r, err := rs.client.SignCompact(ctx, &walletpb.SignCompactRequest{Addr: addr.String(), Hash: hash[:]})
+	if err != nil {
+		return nil, err
+	}
+	if r.Code != 0 {
+		return nil, errors.New(r.Message)
+	}
+	pubKey, ok := crypto.RecoverCompact(hash[:], r.Signature)
+	if !ok {
+		return nil, fmt.Errorf("Invalid compact signature of address %s", addr)
+	}
+	if err := checkPubKey(pubKey, addr); err != nil {
+		return nil, err
+	}
+	return r.Signature, nil
+}
+
+func checkPubKey(pubKey *crypto.PublicKey, addr btypes.Address) error {
+	pubKeyAddr, err := btypes.NewAddressFromPubKey(pubKey)
+	if err != nil {
+		return err
+	}
+	if pubKeyAddr.String() != addr.String() {
+		return fmt.Errorf("Public key doesn't match address %s", addr)
+	}
+	return nil
+}
+
+// AttachSigner registers addresses held by signer as external accounts,
+// whose sighashes are routed to signer. Existing accounts of the same
+// addresses are left as they are. Newly registered accounts are returned
+func (wlt *Manager) AttachSigner(signer Signer) ([]*Account, error) {
+	addrs, err := signer.Addresses()
+	if err != nil {
+		return nil, err
+	}
+	// public keys are fetched from signer without holding the lock, which
+	// may take network round trips
+	found := make([]*Account, 0, len(addrs))
+	for _, addr := range addrs {
+		if _, ok := wlt.GetAccount(addr.String()); ok {
+			continue
+		}
+		pubKeyBytes, err := signer.PublicKey(addr)
+		if err != nil {
+			return nil, err
+		}
+		pubKey, err := crypto.PublicKeyFromBytes(pubKeyBytes)
+		if err != nil {
+			return nil, err
+		}
+		if err := checkPubKey(pubKey, addr); err != nil {
+			return nil, err
+		}
+		found = append(found, &Account{addr: addr, pubKey: pubKey, signer: signer})
+	}
+	wlt.mtx.Lock()
+	defer wlt.mtx.Unlock()
+	accounts := make([]*Account, 0, len(found))
+	for _, acc := range found {
+		// accounts may be added meanwhile
+		if _, ok := wlt.accounts[acc.Addr()]; ok {
+			continue
+		}
+		wlt.accounts[acc.Addr()] = acc
+		if err := wlt.recordCreation(acc.Addr()); err != nil {
+			return nil, err
+		}
+		accounts = append(accounts, acc)
+	}
+	return accounts, nil
+}
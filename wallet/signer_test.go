@@ -0,0 +1,104 @@
+// Copyright (c) 2018 ContentBox Authors.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	btypes "github.com/BOXFoundation/boxd/core/types"
+	"github.com/BOXFoundation/boxd/crypto"
+	"github.com/facebookgo/ensure"
+)
+
+// memSigner holds keys in memory, it signs with a wrong key if faulty
+type memSigner struct {
+	keys   map[string]*crypto.PrivateKey
+	faulty bool
+}
+
+func (ms *memSigner) Addresses() ([]btypes.Address, error) {
+	addrs := make([]btypes.Address, 0, len(ms.keys))
+	for _, privKey := range ms.keys {
+		addr, err := btypes.NewAddressFromPubKey(privKey.PubKey())
+		if err != nil {
+			return nil, err
+		}
+		addrs = append(addrs, addr)
+	}
+	return addrs, nil
+}
+
+func (ms *memSigner) PublicKey(addr btypes.Address) ([]byte, error) {
+	privKey, ok := ms.keys[addr.String()]
+	if !ok {
+		return nil, fmt.Errorf("Address not found: %s", addr)
+	}
+	return privKey.PubKey().Serialize(), nil
+}
+
+func (ms *memSigner) SignHash(addr btypes.Address, hash *crypto.HashType) (*crypto.Signature, error) {
+	privKey, ok := ms.keys[addr.String()]
+	if !ok {
+		return nil, fmt.Errorf("Address not found: %s", addr)
+	}
+	if ms.faulty {
+		privKey, _, _ = crypto.NewKeyPair()
+	}
+	return crypto.Sign(privKey, hash)
+}
+
+func TestExternalSigner(t *testing.T) {
+	dir, err := ioutil.TempDir("", "signer")
+	ensure.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	privKey, pubKey, err := crypto.NewKeyPair()
+	ensure.Nil(t, err)
+	addr, err := btypes.NewAddressFromPubKey(pubKey)
+	ensure.Nil(t, err)
+	signer := &memSigner{keys: map[string]*crypto.PrivateKey{addr.String(): privKey}}
+
+	wltMgr, err := NewWalletManager(dir)
+	ensure.Nil(t, err)
+	accounts, err := wltMgr.AttachSigner(signer)
+	ensure.Nil(t, err)
+	ensure.DeepEqual(t, len(accounts), 1)
+	acc := accounts[0]
+	ensure.True(t, acc.External())
+	ensure.DeepEqual(t, acc.PublicKey(), pubKey.Serialize())
+
+	// external accounts sign without passphrase but never expose keys
+	unlocked, ok := wltMgr.UnlockedAccount(addr.String())
+	ensure.True(t, ok)
+	ensure.DeepEqual(t, unlocked, acc)
+	hash := crypto.DoubleHashH([]byte("sighash"))
+	sig, err := acc.Sign(&hash)
+	ensure.Nil(t, err)
+	ensure.True(t, sig.VerifySignature(pubKey, &hash))
+	ensure.DeepEqual(t, acc.UnlockWithPassphrase("passphrase"), ErrExternalAccount)
+	_, err = wltMgr.DumpPrivKey(addr.String(), "passphrase")
+	ensure.DeepEqual(t, err, ErrExternalAccount)
+
+	// signatures not matching the key of address are rejected
+	signer.faulty = true
+	_, err = acc.Sign(&hash)
+	ensure.NotNil(t, err)
+}
+
+func TestRemoteSignerInsecure(t *testing.T) {
+	// keys and sighashes must not go to other machines in plaintext
+	_, err := NewRemoteSigner(&RemoteSignerConfig{Address: "10.0.0.1:9000"})
+	ensure.DeepEqual(t, err, ErrInsecureSigner)
+	_, err = NewRemoteSigner(&RemoteSignerConfig{Address: "signer.example:9000"})
+	ensure.DeepEqual(t, err, ErrInsecureSigner)
+	for _, addr := range []string{"127.0.0.1:9000", "[::1]:9000", "localhost:9000"} {
+		signer, err := NewRemoteSigner(&RemoteSignerConfig{Address: addr})
+		ensure.Nil(t, err)
+		signer.Close()
+	}
+}
@@ -0,0 +1,449 @@
+// Copyright (c) 2018 ContentBox Authors.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path"
+	"sort"
+	"sync"
+	"time"
+
+	btypes "github.com/BOXFoundation/boxd/core/types"
+)
+
+const txStoreFile = "transactions.json"
+
+// TxStatus is the state of a wallet transaction
+type TxStatus int
+
+// status of wallet transactions
+const (
+	// TxConfirmed is a tx on the main chain
+	TxConfirmed TxStatus = iota
+	// TxPending is a tx seen in mempool or detached from the main chain
+	TxPending
+	// TxConflicted is a tx whose inputs are spent by another confirmed tx
+	TxConflicted
+	// TxReplaced is a pending tx replaced in mempool by another paying more
+	TxReplaced
+)
+
+func (s TxStatus) String() string {
+	switch s {
+	case TxConfirmed:
+		return "confirmed"
+	case TxPending:
+		return "pending"
+	case TxConflicted:
+		return "conflicted"
+	case TxReplaced:
+		return "replaced"
+	}
+	return fmt.Sprintf("TxStatus(%d)", int(s))
+}
+
+// WalletTx is a transaction from the view of one wallet address
+type WalletTx struct {
+	Addr string `json:"addr"`
+	Hash string `json:"hash"`
+	// Raw is the serialized tx
+	Raw []byte `json:"raw"`
+	// Inputs are outpoints the tx spends, used to find double spends
+	Inputs []string `json:"inputs,omitempty"`
+
+	BlockHash string `json:"block_hash,omitempty"`
+	Height    uint32 `json:"height,omitempty"`
+	Index     uint32 `json:"index,omitempty"`
+	TimeStamp int64  `json:"timestamp,omitempty"`
+
+	IsSent   bool   `json:"is_sent,omitempty"`
+	Spent    uint64 `json:"spent,omitempty"`
+	Received uint64 `json:"received,omitempty"`
+	// Fee is what the tx pays to miners, only known for txs sent by the
+	// address whose inputs are all found
+	Fee      uint64   `json:"fee,omitempty"`
+	HasToken bool     `json:"has_token,omitempty"`
+	Status   TxStatus `json:"status"`
+	// ReplacedBy is the hash of the tx replacing a replaced one
+	ReplacedBy string `json:"replaced_by,omitempty"`
+	// Local marks txs sent from this node, which are rebroadcast while
+	// pending. BroadcastHeight is the chain height they're last sent at
+	Local           bool   `json:"local,omitempty"`
+	BroadcastHeight uint32 `json:"broadcast_height,omitempty"`
+	// Seen is the unix timestamp the tx is first stored at
+	Seen int64 `json:"seen"`
+	// Label is the user label of the tx, it's filled by List
+	Label string `json:"-"`
+}
+
+// Record converts wtx to a tx record of its address. Block fields are left
+// zero for unconfirmed txs
+func (wtx *WalletTx) Record() (*btypes.TxRecord, error) {
+	tx := new(btypes.Transaction)
+	if err := tx.Unmarshal(wtx.Raw); err != nil {
+		return nil, err
+	}
+	record := &btypes.TxRecord{
+		Tx:        tx,
+		Height:    wtx.Height,
+		Index:     wtx.Index,
+		TimeStamp: wtx.TimeStamp,
+		IsSent:    wtx.IsSent,
+		Spent:     wtx.Spent,
+		Received:  wtx.Received,
+		HasToken:  wtx.HasToken,
+	}
+	if wtx.BlockHash != "" {
+		if err := record.BlockHash.SetString(wtx.BlockHash); err != nil {
+			return nil, err
+		}
+	}
+	return record, nil
+}
+
+func (wtx *WalletTx) key() string {
+	return wtx.Hash + "/" + wtx.Addr
+}
+
+// FormatOutPoint formats op as an input of WalletTx
+func FormatOutPoint(op *btypes.OutPoint) string {
+	return fmt.Sprintf("%s:%d", op.Hash, op.Index)
+}
+
+type txStoreData struct {
+	// Tip is the hash of the last block the store is synced to
+	Tip string `json:"tip"`
+	// Tracked are addresses whose history has been filled
+	Tracked map[string]bool      `json:"tracked"`
+	Txs     map[string]*WalletTx `json:"txs"`
+	// Labels are user labels keyed by tx hash
+	Labels map[string]string `json:"labels,omitempty"`
+}
+
+// TxStore keeps transactions affecting wallet addresses in the wallet
+// directory, so that history is listed without scanning the chain
+type TxStore struct {
+	path string
+	mtx  sync.Mutex
+	data txStoreData
+}
+
+// loadTxStore reads the tx store in dir, an empty store is returned if
+// there is none
+func loadTxStore(dir string) (*TxStore, error) {
+	store := &TxStore{path: path.Join(dir, txStoreFile)}
+	content, err := ioutil.ReadFile(store.path)
+	if err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+	if err == nil {
+		if err := json.Unmarshal(content, &store.data); err != nil {
+			return nil, err
+		}
+	}
+	if store.data.Tracked == nil {
+		store.data.Tracked = make(map[string]bool)
+	}
+	if store.data.Txs == nil {
+		store.data.Txs = make(map[string]*WalletTx)
+	}
+	if store.data.Labels == nil {
+		store.data.Labels = make(map[string]string)
+	}
+	return store, nil
+}
+
+// save writes the store, it's called with mtx held
+func (store *TxStore) save() error {
+	content, err := json.Marshal(&store.data)
+	if err != nil {
+		return err
+	}
+	tmpPath, err := tryWriteTempFile(store.path, content)
+	if err != nil {
+		return err
+	}
+	return os.Rename(tmpPath, store.path)
+}
+
+// Tip returns the hash of the last block the store is synced to
+func (store *TxStore) Tip() string {
+	store.mtx.Lock()
+	defer store.mtx.Unlock()
+	return store.data.Tip
+}
+
+// Tracked returns whether history of addr has been filled
+func (store *TxStore) Tracked(addr string) bool {
+	store.mtx.Lock()
+	defer store.mtx.Unlock()
+	return store.data.Tracked[addr]
+}
+
+// Track starts recording txs of addr from chain events, the history before
+// is expected to be filled with Backfill
+func (store *TxStore) Track(addr string) error {
+	store.mtx.Lock()
+	defer store.mtx.Unlock()
+	if store.data.Tracked[addr] {
+		return nil
+	}
+	store.data.Tracked[addr] = true
+	return store.save()
+}
+
+// Reset drops all txs and tracked addresses, labels are kept. It's used when
+// the store is found out of sync with the chain
+func (store *TxStore) Reset(tip string) error {
+	store.mtx.Lock()
+	defer store.mtx.Unlock()
+	store.data.Tip = tip
+	store.data.Tracked = make(map[string]bool)
+	store.data.Txs = make(map[string]*WalletTx)
+	return store.save()
+}
+
+// Backfill stores confirmed txs of a tracked address found on chain
+func (store *TxStore) Backfill(addr string, txs []*WalletTx) error {
+	store.mtx.Lock()
+	defer store.mtx.Unlock()
+	if !store.data.Tracked[addr] {
+		return fmt.Errorf("Address not tracked: %s", addr)
+	}
+	for _, wtx := range txs {
+		if wtx.Addr != addr {
+			continue
+		}
+		store.put(wtx, TxConfirmed)
+	}
+	store.refreshConflicts()
+	return store.save()
+}
+
+// AddPending stores txs seen in mempool, txs of untracked addresses and txs
+// already stored are ignored
+func (store *TxStore) AddPending(txs []*WalletTx) error {
+	store.mtx.Lock()
+	defer store.mtx.Unlock()
+	added := false
+	for _, wtx := range txs {
+		if !store.data.Tracked[wtx.Addr] {
+			continue
+		}
+		if _, ok := store.data.Txs[wtx.key()]; ok {
+			continue
+		}
+		store.put(wtx, TxPending)
+		added = true
+	}
+	if !added {
+		return nil
+	}
+	store.refreshConflicts()
+	return store.save()
+}
+
+// Replace marks pending txs with hash as replaced by the tx with byHash
+func (store *TxStore) Replace(hash, byHash string) error {
+	store.mtx.Lock()
+	defer store.mtx.Unlock()
+	replaced := false
+	for _, wtx := range store.data.Txs {
+		if wtx.Hash == hash && wtx.Status == TxPending {
+			wtx.Status, wtx.ReplacedBy = TxReplaced, byHash
+			replaced = true
+		}
+	}
+	if !replaced {
+		return nil
+	}
+	return store.save()
+}
+
+// MarkLocal marks txs with hash as sent from this node at height, to be
+// rebroadcast if they stay pending
+func (store *TxStore) MarkLocal(hash string, height uint32) error {
+	store.mtx.Lock()
+	defer store.mtx.Unlock()
+	marked := false
+	for _, wtx := range store.data.Txs {
+		if wtx.Hash == hash {
+			wtx.Local, wtx.BroadcastHeight = true, height
+			marked = true
+		}
+	}
+	if !marked {
+		return nil
+	}
+	return store.save()
+}
+
+// LocalPending returns pending txs sent from this node last broadcast at or
+// below height, one entry per tx. Confirmed, conflicted and replaced txs are
+// no longer rebroadcast
+func (store *TxStore) LocalPending(height uint32) []*WalletTx {
+	store.mtx.Lock()
+	defer store.mtx.Unlock()
+	seen := make(map[string]bool)
+	var txs []*WalletTx
+	for _, wtx := range store.data.Txs {
+		if !wtx.Local || wtx.Status != TxPending || wtx.BroadcastHeight > height || seen[wtx.Hash] {
+			continue
+		}
+		seen[wtx.Hash] = true
+		cp := *wtx
+		txs = append(txs, &cp)
+	}
+	sort.Slice(txs, func(i, j int) bool { return txs[i].Seen < txs[j].Seen })
+	return txs
+}
+
+// ConnectBlock confirms txs of a block connected to the main chain. Pending
+// txs double spent by them turn conflicted
+func (store *TxStore) ConnectBlock(blockHash string, txs []*WalletTx) error {
+	store.mtx.Lock()
+	defer store.mtx.Unlock()
+	for _, wtx := range txs {
+		if !store.data.Tracked[wtx.Addr] {
+			continue
+		}
+		store.put(wtx, TxConfirmed)
+	}
+	store.refreshConflicts()
+	store.data.Tip = blockHash
+	return store.save()
+}
+
+// DisconnectBlock turns txs of a block detached from the main chain back to
+// pending, prevHash becomes the tip
+func (store *TxStore) DisconnectBlock(blockHash, prevHash string) error {
+	store.mtx.Lock()
+	defer store.mtx.Unlock()
+	for _, wtx := range store.data.Txs {
+		if wtx.Status == TxConfirmed && wtx.BlockHash == blockHash {
+			wtx.Status = TxPending
+			wtx.BlockHash, wtx.Height, wtx.Index, wtx.TimeStamp = "", 0, 0, 0
+		}
+	}
+	store.refreshConflicts()
+	store.data.Tip = prevHash
+	return store.save()
+}
+
+// put stores a copy of wtx with status, the first seen time and local mark
+// of an existing entry are kept. It's called with mtx held
+func (store *TxStore) put(wtx *WalletTx, status TxStatus) {
+	cp := *wtx
+	cp.Status = status
+	if status != TxConfirmed {
+		cp.BlockHash, cp.Height, cp.Index, cp.TimeStamp = "", 0, 0, 0
+	}
+	cp.Seen = time.Now().Unix()
+	if old, ok := store.data.Txs[cp.key()]; ok {
+		cp.Seen = old.Seen
+		cp.Local, cp.BroadcastHeight = old.Local, old.BroadcastHeight
+	}
+	store.data.Txs[cp.key()] = &cp
+}
+
+// refreshConflicts marks unconfirmed txs spending outpoints also spent by
+// other confirmed txs as conflicted, and the others pending unless replaced
+func (store *TxStore) refreshConflicts() {
+	spentBy := make(map[string]string)
+	for _, wtx := range store.data.Txs {
+		if wtx.Status != TxConfirmed {
+			continue
+		}
+		for _, in := range wtx.Inputs {
+			spentBy[in] = wtx.Hash
+		}
+	}
+	for _, wtx := range store.data.Txs {
+		if wtx.Status == TxConfirmed {
+			continue
+		}
+		if wtx.Status != TxReplaced {
+			wtx.Status = TxPending
+		}
+		for _, in := range wtx.Inputs {
+			if hash, ok := spentBy[in]; ok && hash != wtx.Hash {
+				wtx.Status = TxConflicted
+				break
+			}
+		}
+	}
+}
+
+// List returns txs of addrs, confirmed ones ordered by their positions on
+// chain followed by unconfirmed ones ordered by the time they're seen
+func (store *TxStore) List(addrs []string) []*WalletTx {
+	store.mtx.Lock()
+	defer store.mtx.Unlock()
+	wanted := make(map[string]bool, len(addrs))
+	for _, addr := range addrs {
+		wanted[addr] = true
+	}
+	txs := make([]*WalletTx, 0)
+	for _, wtx := range store.data.Txs {
+		if wanted[wtx.Addr] {
+			cp := *wtx
+			cp.Label = store.data.Labels[wtx.Hash]
+			txs = append(txs, &cp)
+		}
+	}
+	sort.Slice(txs, func(i, j int) bool {
+		ci, cj := txs[i].Status == TxConfirmed, txs[j].Status == TxConfirmed
+		if ci != cj {
+			return ci
+		}
+		if ci && txs[i].Height != txs[j].Height {
+			return txs[i].Height < txs[j].Height
+		}
+		if ci && txs[i].Index != txs[j].Index {
+			return txs[i].Index < txs[j].Index
+		}
+		if !ci && txs[i].Seen != txs[j].Seen {
+			return txs[i].Seen < txs[j].Seen
+		}
+		if txs[i].Hash != txs[j].Hash {
+			return txs[i].Hash < txs[j].Hash
+		}
+		return txs[i].Addr < txs[j].Addr
+	})
+	return txs
+}
+
+// SetTxLabel labels a stored tx, an empty label clears it
+func (store *TxStore) SetTxLabel(hash, label string) error {
+	store.mtx.Lock()
+	defer store.mtx.Unlock()
+	found := false
+	for _, wtx := range store.data.Txs {
+		if wtx.Hash == hash {
+			found = true
+			break
+		}
+	}
+	if !found {
+		return fmt.Errorf("Transaction not found: %s", hash)
+	}
+	if label == "" {
+		delete(store.data.Labels, hash)
+	} else {
+		store.data.Labels[hash] = label
+	}
+	return store.save()
+}
+
+// TxLabel returns the label of tx hash
+func (store *TxStore) TxLabel(hash string) string {
+	store.mtx.Lock()
+	defer store.mtx.Unlock()
+	return store.data.Labels[hash]
+}
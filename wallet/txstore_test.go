@@ -0,0 +1,141 @@
+// Copyright (c) 2018 ContentBox Authors.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	corepb "github.com/BOXFoundation/boxd/core/pb"
+	btypes "github.com/BOXFoundation/boxd/core/types"
+	"github.com/BOXFoundation/boxd/crypto"
+	"github.com/facebookgo/ensure"
+)
+
+func newWalletTx(t *testing.T, addr string, prev byte, value uint64) *WalletTx {
+	op := btypes.OutPoint{Hash: crypto.DoubleHashH([]byte{prev})}
+	tx := &btypes.Transaction{
+		Vin:  []*btypes.TxIn{{PrevOutPoint: op}},
+		Vout: []*corepb.TxOut{{Value: value}},
+	}
+	hash, err := tx.TxHash()
+	ensure.Nil(t, err)
+	raw, err := tx.Marshal()
+	ensure.Nil(t, err)
+	return &WalletTx{
+		Addr:   addr,
+		Hash:   hash.String(),
+		Raw:    raw,
+		Inputs: []string{FormatOutPoint(&op)},
+		IsSent: true,
+		Spent:  value + 10,
+		Fee:    10,
+	}
+}
+
+func TestTxStore(t *testing.T) {
+	dir, err := ioutil.TempDir("", "txstore")
+	ensure.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	store, err := loadTxStore(dir)
+	ensure.Nil(t, err)
+	ensure.Nil(t, store.Track("alice"))
+
+	// txs of untracked addresses are ignored
+	first := newWalletTx(t, "alice", 1, 100)
+	ensure.Nil(t, store.AddPending([]*WalletTx{first, newWalletTx(t, "bob", 2, 100)}))
+	txs := store.List([]string{"alice", "bob"})
+	ensure.DeepEqual(t, len(txs), 1)
+	ensure.DeepEqual(t, txs[0].Status, TxPending)
+
+	// a confirmed double spend makes the pending tx conflicted
+	double := newWalletTx(t, "alice", 1, 90)
+	double.BlockHash, double.Height = "block1", 1
+	ensure.Nil(t, store.ConnectBlock("block1", []*WalletTx{double}))
+	txs = store.List([]string{"alice"})
+	ensure.DeepEqual(t, len(txs), 2)
+	ensure.DeepEqual(t, txs[0].Hash, double.Hash)
+	ensure.DeepEqual(t, txs[0].Status, TxConfirmed)
+	ensure.DeepEqual(t, txs[1].Status, TxConflicted)
+	ensure.DeepEqual(t, store.Tip(), "block1")
+
+	// detaching the block turns both pending again
+	ensure.Nil(t, store.DisconnectBlock("block1", "block0"))
+	for _, wtx := range store.List([]string{"alice"}) {
+		ensure.DeepEqual(t, wtx.Status, TxPending)
+		ensure.DeepEqual(t, wtx.BlockHash, "")
+	}
+	ensure.DeepEqual(t, store.Tip(), "block0")
+
+	// a pending tx replaced in mempool stays replaced until confirmed
+	ensure.Nil(t, store.Replace(first.Hash, double.Hash))
+	for _, wtx := range store.List([]string{"alice"}) {
+		if wtx.Hash == first.Hash {
+			ensure.DeepEqual(t, wtx.Status, TxReplaced)
+			ensure.DeepEqual(t, wtx.ReplacedBy, double.Hash)
+		}
+	}
+
+	// txs and labels are kept across loads
+	ensure.Nil(t, store.SetTxLabel(first.Hash, "rent"))
+	ensure.NotNil(t, store.SetTxLabel("unknown", "rent"))
+	store, err = loadTxStore(dir)
+	ensure.Nil(t, err)
+	ensure.True(t, store.Tracked("alice"))
+	txs = store.List([]string{"alice"})
+	ensure.DeepEqual(t, len(txs), 2)
+	for _, wtx := range txs {
+		if wtx.Hash == first.Hash {
+			ensure.DeepEqual(t, wtx.Label, "rent")
+			record, err := wtx.Record()
+			ensure.Nil(t, err)
+			ensure.DeepEqual(t, record.Amount(), uint64(110))
+		}
+	}
+
+	// reset drops txs but keeps labels
+	ensure.Nil(t, store.Reset("block2"))
+	ensure.False(t, store.Tracked("alice"))
+	ensure.DeepEqual(t, len(store.List([]string{"alice"})), 0)
+	ensure.DeepEqual(t, store.TxLabel(first.Hash), "rent")
+}
+
+func TestTxStoreLocalPending(t *testing.T) {
+	dir, err := ioutil.TempDir("", "txstore")
+	ensure.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	store, err := loadTxStore(dir)
+	ensure.Nil(t, err)
+	ensure.Nil(t, store.Track("alice"))
+	ensure.Nil(t, store.Track("bob"))
+
+	// txs of two addresses are returned once
+	local := newWalletTx(t, "alice", 1, 100)
+	toBob := *local
+	toBob.Addr = "bob"
+	remote := newWalletTx(t, "alice", 2, 100)
+	ensure.Nil(t, store.AddPending([]*WalletTx{local, &toBob, remote}))
+	ensure.Nil(t, store.MarkLocal(local.Hash, 10))
+	ensure.DeepEqual(t, len(store.LocalPending(9)), 0)
+	txs := store.LocalPending(10)
+	ensure.DeepEqual(t, len(txs), 1)
+	ensure.DeepEqual(t, txs[0].Hash, local.Hash)
+
+	// the local mark survives seeing the tx again
+	ensure.Nil(t, store.AddPending([]*WalletTx{local}))
+	ensure.Nil(t, store.MarkLocal(local.Hash, 20))
+	ensure.DeepEqual(t, len(store.LocalPending(19)), 0)
+	ensure.DeepEqual(t, len(store.LocalPending(20)), 1)
+
+	// confirmed txs are no longer rebroadcast
+	confirmed, bobConfirmed := *local, toBob
+	confirmed.BlockHash, confirmed.Height = "block1", 21
+	bobConfirmed.BlockHash, bobConfirmed.Height = "block1", 21
+	ensure.Nil(t, store.ConnectBlock("block1", []*WalletTx{&confirmed, &bobConfirmed}))
+	ensure.DeepEqual(t, len(store.LocalPending(30)), 0)
+}
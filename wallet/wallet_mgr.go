@@ -7,22 +7,35 @@ package wallet
 import (
 	"bytes"
 	"encoding/hex"
+	"errors"
 	"fmt"
 	"io/ioutil"
 	"os"
 	"path"
 	"strings"
+	"sync"
+	"time"
 
 	btypes "github.com/BOXFoundation/boxd/core/types"
 	"github.com/BOXFoundation/boxd/crypto"
 	"golang.org/x/crypto/ssh/terminal"
 )
 
+var errNoHDWallet = errors.New("HD wallet not created")
+
 // Manager is a directory based type to manipulate account
 // Operation add/delete/query, import/export and sign are supported
 type Manager struct {
 	path     string
 	accounts map[string]*Account
+	hd       *HDWallet
+	meta     map[string]*AccountMeta
+	txs      *TxStore
+
+	// mtx guards accounts and their lock state, which rpc goroutines read
+	// while accounts are derived, imported or unlocked
+	mtx        sync.RWMutex
+	lockTimers map[string]*time.Timer
 }
 
 // NewWalletManager creates a wallet manager from files in the path
@@ -39,8 +52,8 @@ func NewWalletManager(path string) (*Manager, error) {
 			return nil, err
 		}
 	}
-	wlt := Manager{path: path}
-	return &wlt, wlt.loadAccounts()
+	wlt := &Manager{path: path, lockTimers: make(map[string]*time.Timer)}
+	return wlt, wlt.loadAccounts()
 }
 
 func (wlt *Manager) loadAccounts() error {
@@ -56,7 +69,47 @@ func (wlt *Manager) loadAccounts() error {
 	for _, account := range accounts {
 		wlt.accounts[account.addr.String()] = account
 	}
-	return nil
+	hd, err := loadHDWallet(wlt.path)
+	if err != nil {
+		return err
+	}
+	if hd != nil {
+		wlt.hd = hd
+		for _, p := range hd.paths() {
+			wlt.addHDAccount(p)
+		}
+	}
+	watchOnlyAddrs, err := loadWatchOnlyAddrs(wlt.path)
+	if err != nil {
+		return err
+	}
+	for _, addr := range watchOnlyAddrs {
+		if _, ok := wlt.accounts[addr.String()]; !ok {
+			wlt.accounts[addr.String()] = &Account{addr: addr, watchOnly: true}
+		}
+	}
+	if wlt.meta, err = loadAccountMeta(wlt.path); err != nil {
+		return err
+	}
+	wlt.fillAccountMeta()
+	wlt.txs, err = loadTxStore(wlt.path)
+	return err
+}
+
+// TxStore returns the store of transactions affecting wallet addresses
+func (wlt *Manager) TxStore() *TxStore {
+	return wlt.txs
+}
+
+// addHDAccount registers the account derived at path p
+func (wlt *Manager) addHDAccount(p *HDPath) (*Account, error) {
+	addr, err := wlt.hd.address(p)
+	if err != nil {
+		return nil, err
+	}
+	acc := &Account{addr: addr, hd: wlt.hd, hdPath: p}
+	wlt.accounts[addr.String()] = acc
+	return acc, nil
 }
 
 func getKeystoreFilePaths(baseDir string) (files []string) {
@@ -79,6 +132,8 @@ func getKeystoreFilePaths(baseDir string) (files []string) {
 
 // ListAccounts returns all the addresses of keystore files in directory
 func (wlt *Manager) ListAccounts() []*Account {
+	wlt.mtx.RLock()
+	defer wlt.mtx.RUnlock()
 	accounts := make([]*Account, len(wlt.accounts))
 	i := 0
 	for _, acc := range wlt.accounts {
@@ -89,9 +144,17 @@ func (wlt *Manager) ListAccounts() []*Account {
 }
 
 // NewAccount creates a ecdsa key pair and store them in a file encrypted
-// by the passphrase user entered
+// by the passphrase user entered. If the wallet is hierarchical deterministic,
+// the next receive address of the first hd account is derived instead
 // returns a hexstring format public key hash, address and error
 func (wlt *Manager) NewAccount(passphrase string) (string, string, error) {
+	if wlt.HasHDWallet() {
+		acc, err := wlt.DeriveAccount(0, false)
+		if err != nil {
+			return "", "", err
+		}
+		return hex.EncodeToString(acc.PubKeyHash()), acc.Addr(), nil
+	}
 	privateKey, _, err := crypto.NewKeyPair()
 	if err != nil {
 		return "", "", err
@@ -110,34 +173,280 @@ func (wlt *Manager) NewAccountWithPrivKey(privKey *crypto.PrivateKey, passphrase
 	account := &Account{
 		path:     path.Join(wlt.path, fmt.Sprintf("%x.keystore", address.Hash())),
 		privKey:  privKey,
+		pubKey:   privKey.PubKey(),
 		addr:     address,
 		unlocked: true,
 	}
 	if err := account.saveWithPassphrase(passphrase); err != nil {
 		return "", "", err
 	}
+	wlt.mtx.Lock()
+	defer wlt.mtx.Unlock()
+	wlt.accounts[address.String()] = account
+	if err := wlt.recordCreation(address.String()); err != nil {
+		return "", "", err
+	}
 	return hex.EncodeToString(address.Hash()), address.String(), nil
 }
 
+// accountKey returns the base58 address accounts are kept by for address in
+// any format, address itself if it's invalid
+func accountKey(address string) string {
+	addr, err := btypes.NewAddress(address)
+	if err != nil {
+		return address
+	}
+	return addr.String()
+}
+
 // DumpPrivKey returns an account's private key bytes in hex string format
 func (wlt *Manager) DumpPrivKey(address, passphrase string) (string, error) {
+	address = accountKey(address)
+	wlt.mtx.Lock()
+	defer wlt.mtx.Unlock()
 	acc, ok := wlt.accounts[address]
 	if !ok {
 		return "", fmt.Errorf("Address not found: %s", address)
 	}
-	if err := acc.UnlockWithPassphrase(passphrase); err != nil {
+	privKey, err := acc.decryptPrivKey(passphrase)
+	if err != nil {
 		return "", err
 	}
-	return hex.EncodeToString(acc.privKey.Serialize()), nil
+	defer privKey.Erase()
+	return hex.EncodeToString(privKey.Serialize()), nil
 }
 
 // GetAccount checks if this Manager contains this public key
 // and returns the related account if it exists
 func (wlt *Manager) GetAccount(pubKeyHash string) (account *Account, exist bool) {
+	wlt.mtx.RLock()
+	defer wlt.mtx.RUnlock()
 	account, exist = wlt.accounts[pubKeyHash]
 	return
 }
 
+// UnlockAccount unlocks the account of address with passphrase, and locks it
+// again after timeout. A zero timeout keeps it unlocked until LockAccount
+func (wlt *Manager) UnlockAccount(address, passphrase string, timeout time.Duration) error {
+	address = accountKey(address)
+	wlt.mtx.Lock()
+	defer wlt.mtx.Unlock()
+	acc, ok := wlt.accounts[address]
+	if !ok {
+		return fmt.Errorf("Address not found: %s", address)
+	}
+	if err := acc.UnlockWithPassphrase(passphrase); err != nil {
+		return err
+	}
+	if timer, ok := wlt.lockTimers[address]; ok {
+		timer.Stop()
+		delete(wlt.lockTimers, address)
+	}
+	if timeout > 0 {
+		wlt.lockTimers[address] = time.AfterFunc(timeout, func() {
+			wlt.LockAccount(address)
+		})
+	}
+	return nil
+}
+
+// LockAccount locks the account of address
+func (wlt *Manager) LockAccount(address string) error {
+	address = accountKey(address)
+	wlt.mtx.Lock()
+	defer wlt.mtx.Unlock()
+	acc, ok := wlt.accounts[address]
+	if !ok {
+		return fmt.Errorf("Address not found: %s", address)
+	}
+	if timer, ok := wlt.lockTimers[address]; ok {
+		timer.Stop()
+		delete(wlt.lockTimers, address)
+	}
+	acc.lock()
+	return nil
+}
+
+// UnlockedAccount returns the account of address if it is unlocked
+func (wlt *Manager) UnlockedAccount(address string) (*Account, bool) {
+	address = accountKey(address)
+	wlt.mtx.Lock()
+	defer wlt.mtx.Unlock()
+	acc, ok := wlt.accounts[address]
+	if !ok {
+		return nil, false
+	}
+	if acc.signer != nil {
+		// external signers authorize signing on their own
+		return acc, true
+	}
+	if !acc.isUnlocked() {
+		return nil, false
+	}
+	return acc, true
+}
+
+// HasUnlockedAccount returns whether any account is unlocked
+func (wlt *Manager) HasUnlockedAccount() bool {
+	wlt.mtx.Lock()
+	defer wlt.mtx.Unlock()
+	for _, acc := range wlt.accounts {
+		if acc.isUnlocked() {
+			return true
+		}
+	}
+	return false
+}
+
+// ChangePassphrase encrypts the key of the account of address with newPassphrase
+func (wlt *Manager) ChangePassphrase(address, oldPassphrase, newPassphrase string) error {
+	address = accountKey(address)
+	wlt.mtx.Lock()
+	defer wlt.mtx.Unlock()
+	acc, ok := wlt.accounts[address]
+	if !ok {
+		return fmt.Errorf("Address not found: %s", address)
+	}
+	return acc.ChangePassphrase(oldPassphrase, newPassphrase)
+}
+
+// HasHDWallet returns whether keys are derived from a hd wallet seed
+func (wlt *Manager) HasHDWallet() bool {
+	wlt.mtx.Lock()
+	defer wlt.mtx.Unlock()
+	return wlt.hd != nil
+}
+
+// CreateHDWallet generates a random mnemonic of words, and creates the hd
+// wallet from the seed of it and the optional mnemonic passphrase. The seed
+// is stored encrypted by passphrase. The mnemonic is returned for backup
+func (wlt *Manager) CreateHDWallet(words int, mnemonicPassphrase, passphrase string) (string, error) {
+	mnemonic, err := NewMnemonic(words)
+	if err != nil {
+		return "", err
+	}
+	if err := wlt.ImportMnemonic(mnemonic, mnemonicPassphrase, passphrase); err != nil {
+		return "", err
+	}
+	return mnemonic, nil
+}
+
+// ImportMnemonic restores the hd wallet from a mnemonic and its optional
+// mnemonic passphrase, call ScanHDWallet afterwards to recover addresses in use
+func (wlt *Manager) ImportMnemonic(mnemonic, mnemonicPassphrase, passphrase string) error {
+	entropy, err := MnemonicToEntropy(mnemonic)
+	if err != nil {
+		return err
+	}
+	seed, err := MnemonicToSeed(mnemonic, mnemonicPassphrase)
+	if err != nil {
+		return err
+	}
+	return wlt.importHDSeed(seed, entropy, passphrase)
+}
+
+// ExportMnemonic returns the mnemonic the hd wallet is created from
+func (wlt *Manager) ExportMnemonic(passphrase string) (string, error) {
+	wlt.mtx.Lock()
+	defer wlt.mtx.Unlock()
+	if wlt.hd == nil {
+		return "", errNoHDWallet
+	}
+	return wlt.hd.exportMnemonic(passphrase)
+}
+
+// ImportHDSeed creates the hd wallet from an existing master seed, call
+// ScanHDWallet afterwards to recover addresses in use
+func (wlt *Manager) ImportHDSeed(seed []byte, passphrase string) error {
+	return wlt.importHDSeed(seed, nil, passphrase)
+}
+
+func (wlt *Manager) importHDSeed(seed, entropy []byte, passphrase string) error {
+	wlt.mtx.Lock()
+	defer wlt.mtx.Unlock()
+	if wlt.hd != nil {
+		return fmt.Errorf("HD wallet already exists in %s", wlt.path)
+	}
+	hd, err := newHDWallet(wlt.path, seed, entropy, passphrase)
+	if err != nil {
+		return err
+	}
+	wlt.hd = hd
+	return nil
+}
+
+// NewHDAccount derives a new hd account and returns its index
+func (wlt *Manager) NewHDAccount(passphrase string) (uint32, error) {
+	wlt.mtx.Lock()
+	defer wlt.mtx.Unlock()
+	if wlt.hd == nil {
+		return 0, errNoHDWallet
+	}
+	return wlt.hd.newAccount(passphrase)
+}
+
+// DeriveAccount derives the account of a fresh receive address, or of a
+// change address if change is true, in the hd account
+func (wlt *Manager) DeriveAccount(account uint32, change bool) (*Account, error) {
+	wlt.mtx.Lock()
+	defer wlt.mtx.Unlock()
+	if wlt.hd == nil {
+		return nil, errNoHDWallet
+	}
+	chain := uint32(ExternalChain)
+	if change {
+		chain = InternalChain
+	}
+	_, p, err := wlt.hd.nextAddress(account, chain)
+	if err != nil {
+		return nil, err
+	}
+	acc, err := wlt.addHDAccount(p)
+	if err != nil {
+		return nil, err
+	}
+	if err := wlt.recordCreation(acc.Addr()); err != nil {
+		return nil, err
+	}
+	return acc, nil
+}
+
+// ScanHDWallet discovers addresses in use that are not derived yet, stopping
+// at gapLimit consecutive unused addresses on each chain of hd accounts.
+// used tells whether an address has appeared on chain. Accounts of newly
+// found addresses are returned
+func (wlt *Manager) ScanHDWallet(gapLimit uint32, used func(btypes.Address) (bool, error)) ([]*Account, error) {
+	wlt.mtx.Lock()
+	defer wlt.mtx.Unlock()
+	if wlt.hd == nil {
+		return nil, errNoHDWallet
+	}
+	if gapLimit == 0 {
+		gapLimit = DefaultGapLimit
+	}
+	paths, err := wlt.hd.scan(gapLimit, used)
+	if err != nil {
+		return nil, err
+	}
+	accounts := make([]*Account, 0, len(paths))
+	for _, p := range paths {
+		acc, err := wlt.addHDAccount(p)
+		if err != nil {
+			continue
+		}
+		if _, ok := wlt.meta[acc.Addr()]; !ok {
+			wlt.meta[acc.Addr()] = &AccountMeta{Created: time.Now().Unix()}
+		}
+		accounts = append(accounts, acc)
+	}
+	if len(accounts) > 0 {
+		if err := wlt.saveAccountMeta(); err != nil {
+			return nil, err
+		}
+	}
+	return accounts, nil
+}
+
 // Sign create signature of message bytes using private key related to input public key
 func (wlt *Manager) Sign(msg []byte, pubKeyHash, passphrase string) ([]byte, error) {
 	account, exist := wlt.GetAccount(pubKeyHash)
@@ -150,9 +459,21 @@ func (wlt *Manager) Sign(msg []byte, pubKeyHash, passphrase string) ([]byte, err
 	hash := &crypto.HashType{}
 	hash.SetBytes(msg)
 
-	account.UnlockWithPassphrase(passphrase)
+	if account.External() {
+		sig, err := account.Sign(hash)
+		if err != nil {
+			return nil, err
+		}
+		return sig.Serialize(), nil
+	}
+	// the key is used once, the account is left locked as it is
+	privKey, err := account.decryptPrivKey(passphrase)
+	if err != nil {
+		return nil, err
+	}
+	defer privKey.Erase()
 
-	sig, err := crypto.Sign(account.privKey, hash)
+	sig, err := crypto.Sign(privKey, hash)
 	if err != nil {
 		return nil, err
 	}
@@ -161,10 +482,23 @@ func (wlt *Manager) Sign(msg []byte, pubKeyHash, passphrase string) ([]byte, err
 
 // Account offers method to operate ecdsa keys stored in a keystore file path
 type Account struct {
-	path     string
-	addr     btypes.Address
+	path string
+	addr btypes.Address
+	// mtx guards privKey and unlocked, which lock timers reset while the
+	// account signs
+	mtx      sync.Mutex
 	privKey  *crypto.PrivateKey
 	unlocked bool
+	// hd and hdPath are set if the key is derived from hd wallet seed
+	hd     *HDWallet
+	hdPath *HDPath
+	// watchOnly is set if the account is imported by address without key
+	watchOnly bool
+	// signer is set if the key is held by an external signer, pubKey is
+	// fetched from it then. Otherwise pubKey is kept once the account is
+	// unlocked, after the key is dropped
+	signer Signer
+	pubKey *crypto.PublicKey
 }
 
 // NewAccountFromFile create account from file.
@@ -194,6 +528,21 @@ func (acc *Account) Addr() string {
 	return acc.addr.String()
 }
 
+// HDPath returns the derivation path of the account, nil if it's not derived from hd wallet
+func (acc *Account) HDPath() *HDPath {
+	return acc.hdPath
+}
+
+// WatchOnly returns whether the account is tracked without private key
+func (acc *Account) WatchOnly() bool {
+	return acc.watchOnly
+}
+
+// External returns whether the key of account is held by an external signer
+func (acc *Account) External() bool {
+	return acc.signer != nil
+}
+
 // PubKeyHash returns Public Key Hash of the account
 func (acc *Account) PubKeyHash() []byte {
 	return acc.addr.Hash()
@@ -201,48 +550,149 @@ func (acc *Account) PubKeyHash() []byte {
 
 // PublicKey returns the account's public key in compressed byte format
 func (acc *Account) PublicKey() []byte {
-	return acc.privKey.PubKey().Serialize()
+	acc.mtx.Lock()
+	defer acc.mtx.Unlock()
+	if acc.pubKey == nil {
+		return nil
+	}
+	return acc.pubKey.Serialize()
 }
 
-// PrivateKey returns the accounts private key in compressed byte format
+// PrivateKey returns the accounts private key in compressed byte format, nil
+// if it's locked
 func (acc *Account) PrivateKey() *crypto.PrivateKey {
+	acc.mtx.Lock()
+	defer acc.mtx.Unlock()
 	return acc.privKey
 }
 
 func (acc *Account) saveWithPassphrase(passphrase string) error {
-	savePrivateKeyWithPassphrase(acc.privKey, passphrase, acc.path)
-	return nil
+	return savePrivateKeyWithPassphrase(acc.privKey, passphrase, acc.path)
 }
 
 // UnlockWithPassphrase unlocks an account and generate its private key
 func (acc *Account) UnlockWithPassphrase(passphrase string) error {
-	privateKeyBytes, err := unlockPrivateKeyWithPassphrase(acc.path, passphrase)
+	privKey, err := acc.decryptPrivKey(passphrase)
 	if err != nil {
 		return err
 	}
-	if acc.privKey == nil {
-		acc.privKey = &crypto.PrivateKey{}
+	acc.mtx.Lock()
+	defer acc.mtx.Unlock()
+	if acc.privKey != nil && acc.privKey != privKey {
+		acc.privKey.Erase()
+	}
+	acc.privKey, acc.pubKey, acc.unlocked = privKey, privKey.PubKey(), true
+	return nil
+}
+
+// decryptPrivKey returns the private key of the account decrypted with
+// passphrase, without unlocking the account
+func (acc *Account) decryptPrivKey(passphrase string) (*crypto.PrivateKey, error) {
+	if acc.watchOnly {
+		return nil, ErrWatchOnly
+	}
+	if acc.signer != nil {
+		return nil, ErrExternalAccount
+	}
+	if acc.hd != nil {
+		privKey, err := acc.hd.privKey(passphrase, acc.hdPath)
+		if err != nil {
+			return nil, err
+		}
+		return privKey, acc.verifyPrivKey(privKey)
+	}
+	privateKeyBytes, outdated, err := unlockPrivateKeyWithPassphrase(acc.path, passphrase)
+	if err != nil {
+		return nil, err
+	}
+	privKey, _, err := crypto.KeyPairFromBytes(privateKeyBytes)
+	if err != nil {
+		return nil, err
+	}
+	if err := acc.verifyPrivKey(privKey); err != nil {
+		return nil, err
+	}
+	if outdated {
+		// migrate to the current keystore format, the key is usable anyway
+		savePrivateKeyWithPassphrase(privKey, passphrase, acc.path)
+	}
+	return privKey, nil
+}
+
+// ChangePassphrase encrypts the key of account with newPassphrase. Accounts
+// derived from hd wallet share the passphrase of the seed, which is changed
+func (acc *Account) ChangePassphrase(oldPassphrase, newPassphrase string) error {
+	if acc.watchOnly {
+		return ErrWatchOnly
+	}
+	if acc.signer != nil {
+		return ErrExternalAccount
 	}
-	acc.privKey, _, err = crypto.KeyPairFromBytes(privateKeyBytes)
+	if acc.hd != nil {
+		return acc.hd.changePassphrase(oldPassphrase, newPassphrase)
+	}
+	privateKeyBytes, _, err := unlockPrivateKeyWithPassphrase(acc.path, oldPassphrase)
 	if err != nil {
 		return err
 	}
-	addr, err := btypes.NewAddressFromPubKey(acc.privKey.PubKey())
+	privKey, _, err := crypto.KeyPairFromBytes(privateKeyBytes)
+	if err != nil {
+		return err
+	}
+	return savePrivateKeyWithPassphrase(privKey, newPassphrase, acc.path)
+}
+
+func (acc *Account) verifyPrivKey(privKey *crypto.PrivateKey) error {
+	addr, err := btypes.NewAddressFromPubKey(privKey.PubKey())
 	if err != nil {
 		return err
 	}
 	if !bytes.Equal(addr.Hash(), acc.addr.Hash()) {
 		return fmt.Errorf("Private key doesn't match address, the keystore file may be broken")
 	}
-	acc.unlocked = true
 	return nil
 }
 
+// lock marks the account as locked and erases its private key, Sign fails
+// until it's unlocked again
+func (acc *Account) lock() {
+	acc.mtx.Lock()
+	defer acc.mtx.Unlock()
+	if acc.privKey != nil {
+		acc.privKey.Erase()
+		acc.privKey = nil
+	}
+	acc.unlocked = false
+}
+
+// isUnlocked returns whether the private key of the account is usable
+func (acc *Account) isUnlocked() bool {
+	acc.mtx.Lock()
+	defer acc.mtx.Unlock()
+	return acc.unlocked && acc.privKey != nil
+}
+
 var _ crypto.Signer = (*Account)(nil)
 
-// Sign calculates an ECDSA signature of messageHash using privateKey.
+// Sign calculates an ECDSA signature of messageHash using privateKey, or
+// routes messageHash to the signer of an external account.
 // returns error if account is locked or sign process failed
 func (acc *Account) Sign(messageHash *crypto.HashType) (*crypto.Signature, error) {
+	if acc.watchOnly {
+		return nil, ErrWatchOnly
+	}
+	if acc.signer != nil {
+		sig, err := acc.signer.SignHash(acc.addr, messageHash)
+		if err != nil {
+			return nil, err
+		}
+		if !sig.VerifySignature(acc.pubKey, messageHash) {
+			return nil, fmt.Errorf("Invalid signature from signer of %s", acc.addr)
+		}
+		return sig, nil
+	}
+	acc.mtx.Lock()
+	defer acc.mtx.Unlock()
 	if acc.unlocked == false || acc.privKey == nil {
 		return nil, fmt.Errorf("Address unlocked")
 	}
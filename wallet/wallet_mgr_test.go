@@ -0,0 +1,90 @@
+// Copyright (c) 2018 ContentBox Authors.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"io/ioutil"
+	"math/big"
+	"os"
+	"sync"
+	"testing"
+
+	btypes "github.com/BOXFoundation/boxd/core/types"
+	"github.com/BOXFoundation/boxd/crypto"
+	"github.com/facebookgo/ensure"
+)
+
+// run with -race, accounts are read by rpc goroutines while others are added
+func TestManagerConcurrentAccess(t *testing.T) {
+	dir, err := ioutil.TempDir("", "wallet")
+	ensure.Nil(t, err)
+	defer os.RemoveAll(dir)
+	wltMgr, err := NewWalletManager(dir)
+	ensure.Nil(t, err)
+
+	addrs := make([]string, 20)
+	for i := range addrs {
+		_, pubKey, err := crypto.NewKeyPair()
+		ensure.Nil(t, err)
+		addr, err := btypes.NewAddressFromPubKey(pubKey)
+		ensure.Nil(t, err)
+		addrs[i] = addr.String()
+	}
+
+	var wg sync.WaitGroup
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		for _, addr := range addrs {
+			if _, err := wltMgr.ImportAddress(addr); err != nil {
+				t.Error(err)
+			}
+		}
+	}()
+	go func() {
+		defer wg.Done()
+		for _, addr := range addrs {
+			wltMgr.ListAccounts()
+			wltMgr.GetAccount(addr)
+			wltMgr.UnlockedAccount(addr)
+			wltMgr.LockAccount(addr)
+		}
+	}()
+	wg.Wait()
+	ensure.DeepEqual(t, len(wltMgr.ListAccounts()), len(addrs))
+}
+
+func TestLockAccountErasesKey(t *testing.T) {
+	dir, err := ioutil.TempDir("", "wallet")
+	ensure.Nil(t, err)
+	defer os.RemoveAll(dir)
+	wltMgr, err := NewWalletManager(dir)
+	ensure.Nil(t, err)
+	_, addr, err := wltMgr.NewAccount("passphrase")
+	ensure.Nil(t, err)
+	ensure.Nil(t, wltMgr.LockAccount(addr))
+
+	// dumping the key leaves the account locked
+	_, err = wltMgr.DumpPrivKey(addr, "passphrase")
+	ensure.Nil(t, err)
+	_, ok := wltMgr.UnlockedAccount(addr)
+	ensure.False(t, ok)
+
+	ensure.Nil(t, wltMgr.UnlockAccount(addr, "passphrase", 0))
+	acc, ok := wltMgr.UnlockedAccount(addr)
+	ensure.True(t, ok)
+	privKey := acc.PrivateKey()
+	ensure.NotNil(t, privKey)
+
+	ensure.Nil(t, wltMgr.LockAccount(addr))
+	_, ok = wltMgr.UnlockedAccount(addr)
+	ensure.False(t, ok)
+	ensure.True(t, acc.PrivateKey() == nil)
+	ensure.DeepEqual(t, new(big.Int).SetBits(privKey.D.Bits()).Sign(), 0)
+	// the public key is still known
+	ensure.DeepEqual(t, len(acc.PublicKey()), 33)
+	_, err = acc.Sign(&crypto.HashType{})
+	ensure.NotNil(t, err)
+}
@@ -0,0 +1,93 @@
+// Copyright (c) 2018 ContentBox Authors.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path"
+
+	btypes "github.com/BOXFoundation/boxd/core/types"
+)
+
+const watchOnlyFile = "watchonly.json"
+
+// ErrWatchOnly is returned when a watch-only account is used to sign
+var ErrWatchOnly = errors.New("Watch-only account has no private key to sign with")
+
+type watchOnlyJSON struct {
+	Addrs []string `json:"addrs"`
+}
+
+// loadWatchOnlyAddrs reads addresses in the watch-only file of dir
+func loadWatchOnlyAddrs(dir string) ([]btypes.Address, error) {
+	content, err := ioutil.ReadFile(path.Join(dir, watchOnlyFile))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	woJSON := &watchOnlyJSON{}
+	if err := json.Unmarshal(content, woJSON); err != nil {
+		return nil, err
+	}
+	addrs := make([]btypes.Address, 0, len(woJSON.Addrs))
+	for _, addrStr := range woJSON.Addrs {
+		addr, err := btypes.NewAddress(addrStr)
+		if err != nil {
+			return nil, err
+		}
+		addrs = append(addrs, addr)
+	}
+	return addrs, nil
+}
+
+// saveWatchOnlyAddrs writes watch-only addresses of the manager, it's called with mtx held
+func (wlt *Manager) saveWatchOnlyAddrs() error {
+	woJSON := &watchOnlyJSON{Addrs: []string{}}
+	for addr, acc := range wlt.accounts {
+		if acc.watchOnly {
+			woJSON.Addrs = append(woJSON.Addrs, addr)
+		}
+	}
+	content, err := json.Marshal(woJSON)
+	if err != nil {
+		return err
+	}
+	filePath := path.Join(wlt.path, watchOnlyFile)
+	tmpPath, err := tryWriteTempFile(filePath, content)
+	if err != nil {
+		return err
+	}
+	return os.Rename(tmpPath, filePath)
+}
+
+// ImportAddress imports an address without private key, which is tracked
+// for balance, utxos and transactions but can't sign
+func (wlt *Manager) ImportAddress(address string) (*Account, error) {
+	addr, err := btypes.NewAddress(address)
+	if err != nil {
+		return nil, err
+	}
+	wlt.mtx.Lock()
+	defer wlt.mtx.Unlock()
+	if _, ok := wlt.accounts[addr.String()]; ok {
+		return nil, fmt.Errorf("Address already managed: %s", address)
+	}
+	acc := &Account{addr: addr, watchOnly: true}
+	wlt.accounts[addr.String()] = acc
+	if err := wlt.saveWatchOnlyAddrs(); err != nil {
+		delete(wlt.accounts, addr.String())
+		return nil, err
+	}
+	if err := wlt.recordCreation(addr.String()); err != nil {
+		return nil, err
+	}
+	return acc, nil
+}
@@ -0,0 +1,47 @@
+// Copyright (c) 2018 ContentBox Authors.
+// Use of this source code is governed by a MIT-style
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	btypes "github.com/BOXFoundation/boxd/core/types"
+	"github.com/BOXFoundation/boxd/crypto"
+	"github.com/facebookgo/ensure"
+)
+
+func TestImportAddress(t *testing.T) {
+	dir, err := ioutil.TempDir("", "watchonly")
+	ensure.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	_, pubKey, err := crypto.NewKeyPair()
+	ensure.Nil(t, err)
+	addr, err := btypes.NewAddressFromPubKey(pubKey)
+	ensure.Nil(t, err)
+
+	wltMgr, err := NewWalletManager(dir)
+	ensure.Nil(t, err)
+	acc, err := wltMgr.ImportAddress(addr.String())
+	ensure.Nil(t, err)
+	ensure.True(t, acc.WatchOnly())
+	_, err = wltMgr.ImportAddress(addr.String())
+	ensure.NotNil(t, err)
+
+	// watch-only accounts are loaded again but never sign
+	wltMgr, err = NewWalletManager(dir)
+	ensure.Nil(t, err)
+	acc, ok := wltMgr.GetAccount(addr.String())
+	ensure.True(t, ok)
+	ensure.True(t, acc.WatchOnly())
+	ensure.DeepEqual(t, wltMgr.UnlockAccount(addr.String(), "passphrase", 0), ErrWatchOnly)
+	_, err = acc.Sign(&crypto.HashType{})
+	ensure.DeepEqual(t, err, ErrWatchOnly)
+	_, err = wltMgr.DumpPrivKey(addr.String(), "passphrase")
+	ensure.DeepEqual(t, err, ErrWatchOnly)
+	ensure.False(t, wltMgr.HasUnlockedAccount())
+}